@@ -46,7 +46,7 @@ export function useRefreshRooms(): () => Promise<void> {
 	const [, setRooms] = useContext(RoomsCtx)!
 
 	return async () => {
-		const rooms = (await client.getRooms({})).rooms
+		const rooms = (await client.getRooms({ includeUnlisted: true })).rooms
 		rooms.sort((a, b) => a.name.localeCompare(b.name))
 
 		setRooms(rooms)
@@ -17,7 +17,12 @@ export const Layout: Component<LayoutProps> = (props) => {
 	return (
 		<div class={styles.container}>
 			<header>
-				<span class={styles.headerTitle}>{AppName}</span>
+				<span
+					class={styles.headerTitle}
+					title={`Server v${serverInfo.version}, protocol v${serverInfo.protocolVersion}${serverInfo.buildCommit ? `, build ${serverInfo.buildCommit.slice(0, 12)}` : ''}`}
+				>
+					{AppName}
+				</span>
 
 				<div class={styles.options}>
 					<A href="/createroom" class={styles.option}>
@@ -382,9 +382,16 @@ const ManageAccounts: Component<AccountsProps> = (props) => {
 	const [accounts, setAccounts] = props.accountsSignal
 
 	const initialLoad = createAsync(async () => {
-		const { accounts } = await client.getAccounts({
-			room: props.room.name,
-		})
+		const accounts: AccountInfo[] = []
+		let cursor = ''
+		do {
+			const res = await client.getAccounts({
+				room: props.room.name,
+				cursor,
+			})
+			accounts.push(...res.accounts)
+			cursor = res.nextCursor
+		} while (cursor)
 		setAccounts(
 			accounts.sort((a, b) => a.username.localeCompare(b.username)),
 		)
@@ -459,6 +466,122 @@ const ManageAccounts: Component<AccountsProps> = (props) => {
 	)
 }
 
+const RoomMetadata: Component<{ room: RoomInfo }> = (props) => {
+	const client = useRpcClient()
+
+	const [description, setDescription] = createSignal(
+		props.room.description,
+	)
+	const [listed, setListed] = createSignal(props.room.listed)
+	const [isSaving, setSaving] = createSignal(false)
+	const [error, setError] = createSignal('')
+	const [saved, setSaved] = createSignal(false)
+
+	const submit = async (e: Event) => {
+		e.preventDefault()
+
+		if (isSaving()) {
+			return
+		}
+
+		try {
+			setSaving(true)
+			setError('')
+			setSaved(false)
+
+			await client.setRoomMetadata({
+				name: props.room.name,
+				description: description().trim(),
+				listed: listed(),
+			})
+
+			setSaved(true)
+		} catch (err) {
+			if (err instanceof ConnectError) {
+				if (err.code === Code.PermissionDenied) {
+					setError(
+						'The RPC method required to update room details is not available.',
+					)
+					return
+				}
+
+				setError(err.message)
+				return
+			}
+
+			console.error('failed to update room metadata:', err)
+
+			setError('Internal error, check console')
+		} finally {
+			setSaving(false)
+		}
+	}
+
+	return (
+		<>
+			<p>
+				Created{' '}
+				{new Date(
+					Number(props.room.createdTs) * 1000,
+				).toLocaleString()}
+			</p>
+
+			<Show when={error()}>
+				<div class={stylesCommon.errorMessage}>{error()}</div>
+				<br />
+			</Show>
+
+			<form class={stylesCommon.form} onSubmit={submit}>
+				<table>
+					<tbody>
+						<tr>
+							<td>
+								<label for="room-description">
+									Description
+								</label>
+							</td>
+							<td>
+								<textarea
+									id="room-description"
+									name="room-description"
+									maxlength={500}
+									value={description()}
+									onInput={(e) => {
+										setDescription(e.currentTarget.value)
+										setSaved(false)
+									}}
+								/>
+							</td>
+						</tr>
+						<tr>
+							<td>
+								<label for="room-listed">Listed</label>
+							</td>
+							<td>
+								<input
+									type="checkbox"
+									id="room-listed"
+									name="room-listed"
+									checked={listed()}
+									onChange={(e) => {
+										setListed(e.currentTarget.checked)
+										setSaved(false)
+									}}
+								/>
+							</td>
+						</tr>
+					</tbody>
+				</table>
+
+				<input type="submit" value="Save" disabled={isSaving()} />
+				<Show when={saved()}>
+					<span> Saved.</span>
+				</Show>
+			</form>
+		</>
+	)
+}
+
 const Page: Component<{ room: RoomInfo }> = (props) => {
 	const room = props.room
 
@@ -556,6 +679,9 @@ const Page: Component<{ room: RoomInfo }> = (props) => {
 					🗑️ Delete Room
 				</button>
 
+				<h2>Details</h2>
+				<RoomMetadata room={room} />
+
 				<h2>Manage Accounts</h2>
 				<ManageAccounts room={room} accountsSignal={accountsSignal} />
 			</div>
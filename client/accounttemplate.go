@@ -0,0 +1,141 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+)
+
+// ErrAccountTemplateNotFound is returned when an account template does not exist.
+var ErrAccountTemplateNotFound = errors.New("account template not found")
+
+// AccountTemplate is an account template and its shares.
+type AccountTemplate struct {
+	storage.AccountTemplateRecord
+
+	Shares []storage.AccountTemplateShareRecord
+}
+
+// GetAccountTemplate returns the account template with the specified UUID, along with its shares.
+// Returns ErrAccountTemplateNotFound if there is none.
+func (c *MultiClient) GetAccountTemplate(ctx context.Context, uuid string) (AccountTemplate, error) {
+	record, has, err := c.storage.GetAccountTemplateByUuid(ctx, uuid)
+	if err != nil {
+		return AccountTemplate{}, err
+	}
+	if !has {
+		return AccountTemplate{}, ErrAccountTemplateNotFound
+	}
+
+	shares, err := c.storage.GetAccountTemplateShares(ctx, uuid)
+	if err != nil {
+		return AccountTemplate{}, err
+	}
+
+	return AccountTemplate{
+		AccountTemplateRecord: record,
+		Shares:                shares,
+	}, nil
+}
+
+// GetAccountTemplates returns all account templates, along with their shares.
+func (c *MultiClient) GetAccountTemplates(ctx context.Context) ([]AccountTemplate, error) {
+	records, err := c.storage.GetAccountTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	templates := make([]AccountTemplate, 0, len(records))
+	for _, record := range records {
+		shares, shareErr := c.storage.GetAccountTemplateShares(ctx, record.Uuid)
+		if shareErr != nil {
+			return nil, shareErr
+		}
+
+		templates = append(templates, AccountTemplate{
+			AccountTemplateRecord: record,
+			Shares:                shares,
+		})
+	}
+
+	return templates, nil
+}
+
+// CreateAccountTemplateFromServer creates an account template with the credentials and shares of an existing server.
+// Internal shares are not included.
+// If name is empty, the server's name is used.
+func (c *MultiClient) CreateAccountTemplateFromServer(ctx context.Context, srv *Server, name string) (AccountTemplate, error) {
+	record, has, err := c.storage.GetServerByUuid(ctx, srv.Uuid)
+	if err != nil {
+		return AccountTemplate{}, err
+	}
+	if !has {
+		return AccountTemplate{}, fmt.Errorf(`server record with UUID %q not found`, srv.Uuid)
+	}
+	if name == "" {
+		name = record.Name
+	}
+
+	shareRecs, err := c.storage.GetSharesByServer(ctx, srv.Uuid)
+	if err != nil {
+		return AccountTemplate{}, err
+	}
+	shares := make([]storage.AccountTemplateShareRecord, 0, len(shareRecs))
+	for _, shareRec := range shareRecs {
+		if shareRec.IsInternal {
+			continue
+		}
+
+		shares = append(shares, storage.AccountTemplateShareRecord{
+			Name:        shareRec.Name,
+			Path:        shareRec.Path.String(),
+			FollowLinks: shareRec.FollowLinks,
+		})
+	}
+
+	uuid, err := c.storage.CreateAccountTemplate(ctx, name, record.Username, record.Password, shares)
+	if err != nil {
+		return AccountTemplate{}, err
+	}
+	return c.GetAccountTemplate(ctx, uuid)
+}
+
+// CreateFromTemplate creates a new server record with the credentials of an account template, starts managing a
+// connection to it, and creates the template's shares on it.
+//
+// Shares that cannot be created do not fail the call, and are returned as descriptions instead.
+// Returns ErrAccountTemplateNotFound if the template does not exist.
+func (c *MultiClient) CreateFromTemplate(
+	ctx context.Context,
+	templateUuid string,
+	name string,
+	address string,
+	room common.NormalizedRoomName,
+) (srv *Server, failedShares []string, err error) {
+	template, err := c.GetAccountTemplate(ctx, templateUuid)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	srv, err = c.Create(ctx, name, address, room, template.Username, template.Password)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return srv, c.addTemplateShares(ctx, srv, template), nil
+}
+
+// addTemplateShares creates the shares of an account template on a server.
+// Returns descriptions of the shares that could not be created, such as because their name is already taken.
+func (c *MultiClient) addTemplateShares(ctx context.Context, srv *Server, template AccountTemplate) []string {
+	var failed []string
+	for _, templateShare := range template.Shares {
+		if _, err := srv.ShareMgr.Add(ctx, templateShare.Name, templateShare.Path, templateShare.FollowLinks); err != nil {
+			failed = append(failed, fmt.Sprintf(`%s (%s): %v`, templateShare.Name, templateShare.Path, err))
+		}
+	}
+	return failed
+}
@@ -0,0 +1,92 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+)
+
+func TestAccountTemplates(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := storage.NewStorage(filepath.Join(t.TempDir(), "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	c := &MultiClient{
+		logger:  slog.New(slog.DiscardHandler),
+		storage: store,
+		servers: map[string]*Server{},
+	}
+
+	if _, err = c.GetAccountTemplate(ctx, "missing"); !errors.Is(err, ErrAccountTemplateNotFound) {
+		t.Fatalf("expected ErrAccountTemplateNotFound, got %v", err)
+	}
+
+	serverUuid, err := store.CreateServer(
+		ctx,
+		"home",
+		"127.0.0.1:20038",
+		common.UncheckedCreateNormalizedRoomName("room"),
+		common.UncheckedCreateNormalizedUsername("user"),
+		"password",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	musicPath := t.TempDir()
+	if err = store.CreateShare(ctx, serverUuid, "music", musicPath, true); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.CreateShare(ctx, serverUuid, "trashed", t.TempDir(), false); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = store.TrashShareByServerUuidAndName(ctx, serverUuid, "trashed"); err != nil {
+		t.Fatal(err)
+	}
+
+	// The template gets the server's credentials and the shares that are not in the trash.
+	template, err := c.CreateAccountTemplateFromServer(ctx, &Server{Uuid: serverUuid}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if template.Name != "home" || template.Username.String() != "user" || template.Password != "password" {
+		t.Fatalf("unexpected template %+v", template.AccountTemplateRecord)
+	}
+	if len(template.Shares) != 1 || template.Shares[0].Name != "music" ||
+		template.Shares[0].Path != musicPath || !template.Shares[0].FollowLinks {
+		t.Fatalf("unexpected template shares %+v", template.Shares)
+	}
+
+	// Deleting the server does not affect the template.
+	if err = store.DeleteServerByUuid(ctx, serverUuid); err != nil {
+		t.Fatal(err)
+	}
+	templates, err := c.GetAccountTemplates(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(templates) != 1 || templates[0].Uuid != template.Uuid || len(templates[0].Shares) != 1 {
+		t.Fatalf("unexpected templates %+v", templates)
+	}
+
+	if has, err := store.DeleteAccountTemplate(ctx, template.Uuid); err != nil || !has {
+		t.Fatalf("got %t, %v when deleting template", has, err)
+	}
+	if has, err := store.DeleteAccountTemplate(ctx, template.Uuid); err != nil || has {
+		t.Fatalf("got %t, %v when deleting template again", has, err)
+	}
+	var shares int
+	if err = store.Db.QueryRow(`select count(*) from account_template_share`).Scan(&shares); err != nil || shares != 0 {
+		t.Fatalf("expected shares of deleted template to be deleted, got %d, %v", shares, err)
+	}
+}
@@ -0,0 +1,230 @@
+package client
+
+import (
+	"archive/tar"
+	"archive/zip"
+	"compress/gzip"
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	"friendnet.org/client/room"
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// ArchiveFormat is an archive format that a peer directory can be streamed as.
+type ArchiveFormat int
+
+const (
+	// ArchiveFormatZip is a zip archive.
+	ArchiveFormatZip ArchiveFormat = iota
+
+	// ArchiveFormatTarGz is a gzip-compressed tar archive.
+	ArchiveFormatTarGz
+)
+
+// Ext returns the file extension for the format, without a leading dot.
+func (f ArchiveFormat) Ext() string {
+	switch f {
+	case ArchiveFormatTarGz:
+		return "tar.gz"
+	default:
+		return "zip"
+	}
+}
+
+// MimeType returns the MIME type for the format.
+func (f ArchiveFormat) MimeType() string {
+	switch f {
+	case ArchiveFormatTarGz:
+		return "application/gzip"
+	default:
+		return "application/zip"
+	}
+}
+
+// archiveWriter writes entries to an archive.
+type archiveWriter interface {
+	io.Closer
+
+	// AddDir adds a directory entry.
+	AddDir(name string) error
+
+	// AddFile adds a file entry with the specified size and content.
+	AddFile(name string, size int64, content io.Reader) error
+}
+
+type zipArchiveWriter struct {
+	zw *zip.Writer
+}
+
+func (a *zipArchiveWriter) AddDir(name string) error {
+	_, err := a.zw.Create(name + "/")
+	return err
+}
+
+func (a *zipArchiveWriter) AddFile(name string, _ int64, content io.Reader) error {
+	fileW, err := a.zw.Create(name)
+	if err != nil {
+		return err
+	}
+	_, err = io.Copy(fileW, content)
+	return err
+}
+
+func (a *zipArchiveWriter) Close() error {
+	return a.zw.Close()
+}
+
+type tarGzArchiveWriter struct {
+	gw *gzip.Writer
+	tw *tar.Writer
+}
+
+func (a *tarGzArchiveWriter) AddDir(name string) error {
+	return a.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeDir,
+		Name:     name + "/",
+		Mode:     0o755,
+		ModTime:  time.Now(),
+	})
+}
+
+func (a *tarGzArchiveWriter) AddFile(name string, size int64, content io.Reader) error {
+	err := a.tw.WriteHeader(&tar.Header{
+		Typeflag: tar.TypeReg,
+		Name:     name,
+		Size:     size,
+		Mode:     0o644,
+		ModTime:  time.Now(),
+	})
+	if err != nil {
+		return err
+	}
+
+	// Tar headers include the size, so the content must match it exactly.
+	n, err := io.Copy(a.tw, content)
+	if err != nil {
+		return err
+	}
+	if n != size {
+		return fmt.Errorf(`file %q changed size while archiving (expected %d bytes, got %d)`, name, size, n)
+	}
+	return nil
+}
+
+func (a *tarGzArchiveWriter) Close() error {
+	if err := a.tw.Close(); err != nil {
+		return err
+	}
+	return a.gw.Close()
+}
+
+func newArchiveWriter(format ArchiveFormat, w io.Writer) archiveWriter {
+	switch format {
+	case ArchiveFormatTarGz:
+		gw := gzip.NewWriter(w)
+		return &tarGzArchiveWriter{
+			gw: gw,
+			tw: tar.NewWriter(gw),
+		}
+	default:
+		return &zipArchiveWriter{
+			zw: zip.NewWriter(w),
+		}
+	}
+}
+
+// WritePeerDirArchive writes the contents of a directory on a peer to w as an archive in the specified format.
+// The archive is built on the fly; the directory is walked in the background while files are fetched and written.
+// Entry names are relative to the directory.
+func WritePeerDirArchive(
+	ctx context.Context,
+	peer room.VirtualC2cConn,
+	path common.ProtoPath,
+	format ArchiveFormat,
+	w io.Writer,
+) error {
+	type archiveEntry struct {
+		path common.ProtoPath
+		meta *pb.MsgFileMeta
+	}
+
+	walkCtx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	entries := make(chan archiveEntry, 1_000)
+
+	go func() {
+		defer close(entries)
+
+		walkErr := WalkPeerPath(walkCtx, peer, path, func(path common.ProtoPath, meta *pb.MsgFileMeta) bool {
+			select {
+			case entries <- archiveEntry{path: path, meta: meta}:
+				return true
+			case <-walkCtx.Done():
+				return false
+			}
+		})
+		if walkErr != nil {
+			cancel(walkErr)
+		}
+	}()
+
+	// Length of the directory path prefix to strip from entry paths, including the trailing slash.
+	prefixLen := len(path.String()) + 1
+	if path.IsRoot() {
+		prefixLen = 1
+	}
+
+	aw := newArchiveWriter(format, w)
+
+	for {
+		var entry archiveEntry
+		var ok bool
+		select {
+		case <-walkCtx.Done():
+			return context.Cause(walkCtx)
+		case entry, ok = <-entries:
+		}
+		if !ok {
+			break
+		}
+
+		name := entry.path.String()[prefixLen:]
+
+		if entry.meta.IsDir {
+			if err := aw.AddDir(name); err != nil {
+				return err
+			}
+			continue
+		}
+
+		err := func() error {
+			_, reader, err := peer.GetFileContext(walkCtx, &pb.MsgGetFile{
+				Path:  entry.path.String(),
+				Limit: entry.meta.Size,
+			})
+			if err != nil {
+				return err
+			}
+			defer func() {
+				_ = reader.Close()
+			}()
+
+			return aw.AddFile(name, int64(entry.meta.Size), reader)
+		}()
+		if err != nil {
+			return fmt.Errorf(`failed to archive file %q: %w`, entry.path.String(), err)
+		}
+	}
+
+	// The walk may have failed after the last entry was sent.
+	if err := context.Cause(walkCtx); err != nil {
+		return err
+	}
+
+	return aw.Close()
+}
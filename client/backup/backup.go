@@ -0,0 +1,143 @@
+package backup
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/pbkdf2"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+)
+
+// magic is the header every sealed bundle starts with.
+// The last byte is the format version.
+var magic = []byte("FNCONF\x00\x01")
+
+const saltLen = 16
+const keyLen = 32
+
+// kdfIterations is the number of PBKDF2-SHA256 iterations used to derive the key from the password.
+const kdfIterations = 600_000
+
+// ErrEmptyPassword is returned when sealing a bundle with an empty password.
+var ErrEmptyPassword = errors.New("bundle password cannot be empty")
+
+// ErrInvalidBundle is returned when opening data that is not a sealed bundle, or a bundle of an unsupported version.
+var ErrInvalidBundle = errors.New("not a valid configuration bundle")
+
+// ErrDecryptFailed is returned when a bundle cannot be decrypted.
+// Either the password is wrong or the bundle is corrupted; there is no way to tell which.
+var ErrDecryptFailed = errors.New("failed to decrypt bundle; the password is incorrect or the bundle is corrupted")
+
+// Bundle is a client's configuration, as exported for backups or migrating to another machine.
+type Bundle struct {
+	// The server records.
+	Servers []Server `json:"servers"`
+
+	// The trusted server certificates.
+	Certs []Cert `json:"certs"`
+}
+
+// Server is a server record in a Bundle.
+type Server struct {
+	Name     string  `json:"name"`
+	Address  string  `json:"address"`
+	Room     string  `json:"room"`
+	Username string  `json:"username"`
+	Password string  `json:"password"`
+	Shares   []Share `json:"shares"`
+}
+
+// Share is a server share in a Bundle.
+type Share struct {
+	Name        string `json:"name"`
+	Path        string `json:"path"`
+	FollowLinks bool   `json:"follow_links"`
+}
+
+// Cert is a trusted server certificate in a Bundle.
+type Cert struct {
+	Hostname string `json:"hostname"`
+	Der      []byte `json:"der"`
+}
+
+// deriveAead derives the AEAD for a bundle from the password and salt.
+func deriveAead(password string, salt []byte) (cipher.AEAD, error) {
+	key, err := pbkdf2.Key(sha256.New, password, salt, kdfIterations, keyLen)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to derive bundle key: %w`, err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Seal encodes the bundle and encrypts it with a key derived from the password.
+// Returns ErrEmptyPassword if the password is empty.
+func Seal(bundle Bundle, password string) ([]byte, error) {
+	if password == "" {
+		return nil, ErrEmptyPassword
+	}
+
+	plaintext, err := json.Marshal(bundle)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to encode bundle: %w`, err)
+	}
+
+	salt := make([]byte, saltLen)
+	_, _ = rand.Read(salt)
+
+	aead, err := deriveAead(password, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, _ = rand.Read(nonce)
+
+	out := make([]byte, 0, len(magic)+len(salt)+len(nonce)+len(plaintext)+aead.Overhead())
+	out = append(out, magic...)
+	out = append(out, salt...)
+	out = append(out, nonce...)
+
+	// The header is authenticated so that it cannot be swapped out.
+	return aead.Seal(out, nonce, plaintext, out[:len(magic)+len(salt)]), nil
+}
+
+// Open decrypts and decodes a bundle created by Seal.
+// Returns ErrInvalidBundle if the data is not a bundle, or ErrDecryptFailed if it cannot be decrypted with the password.
+func Open(data []byte, password string) (Bundle, error) {
+	if !bytes.HasPrefix(data, magic) || len(data) < len(magic)+saltLen {
+		return Bundle{}, ErrInvalidBundle
+	}
+
+	salt := data[len(magic) : len(magic)+saltLen]
+	aead, err := deriveAead(password, salt)
+	if err != nil {
+		return Bundle{}, err
+	}
+
+	headerLen := len(magic) + saltLen
+	if len(data) < headerLen+aead.NonceSize()+aead.Overhead() {
+		return Bundle{}, ErrInvalidBundle
+	}
+	nonce := data[headerLen : headerLen+aead.NonceSize()]
+
+	plaintext, err := aead.Open(nil, nonce, data[headerLen+aead.NonceSize():], data[:headerLen])
+	if err != nil {
+		return Bundle{}, ErrDecryptFailed
+	}
+
+	var bundle Bundle
+	if err = json.Unmarshal(plaintext, &bundle); err != nil {
+		return Bundle{}, fmt.Errorf(`%w: %w`, ErrInvalidBundle, err)
+	}
+
+	return bundle, nil
+}
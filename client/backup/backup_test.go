@@ -0,0 +1,59 @@
+package backup
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestSealOpen(t *testing.T) {
+	t.Parallel()
+
+	bundle := Bundle{
+		Servers: []Server{
+			{
+				Name:     "Test",
+				Address:  "example.com",
+				Room:     "room",
+				Username: "user",
+				Password: "hunter2",
+				Shares: []Share{
+					{Name: "music", Path: "/home/user/Music", FollowLinks: true},
+				},
+			},
+		},
+		Certs: []Cert{
+			{Hostname: "example.com", Der: []byte{1, 2, 3}},
+		},
+	}
+
+	data, err := Seal(bundle, "password")
+	if err != nil {
+		t.Fatalf("failed to seal bundle: %v", err)
+	}
+
+	opened, err := Open(data, "password")
+	if err != nil {
+		t.Fatalf("failed to open bundle: %v", err)
+	}
+	if !reflect.DeepEqual(opened, bundle) {
+		t.Fatalf("expected %+v, got %+v", bundle, opened)
+	}
+
+	if _, err = Open(data, "wrong"); !errors.Is(err, ErrDecryptFailed) {
+		t.Fatalf("expected ErrDecryptFailed for wrong password, got %v", err)
+	}
+
+	data[len(data)-1] ^= 1
+	if _, err = Open(data, "password"); !errors.Is(err, ErrDecryptFailed) {
+		t.Fatalf("expected ErrDecryptFailed for tampered bundle, got %v", err)
+	}
+
+	if _, err = Open([]byte("not a bundle"), "password"); !errors.Is(err, ErrInvalidBundle) {
+		t.Fatalf("expected ErrInvalidBundle, got %v", err)
+	}
+
+	if _, err = Seal(bundle, ""); !errors.Is(err, ErrEmptyPassword) {
+		t.Fatalf("expected ErrEmptyPassword, got %v", err)
+	}
+}
@@ -0,0 +1,288 @@
+package client
+
+import (
+	"bufio"
+	"context"
+	"crypto/subtle"
+	"crypto/tls"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"friendnet.org/client/room"
+	"friendnet.org/client/webtransport"
+	"friendnet.org/common"
+	"friendnet.org/protocol"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+	pb "friendnet.org/protocol/pb/v1"
+	"github.com/quic-go/quic-go"
+	"google.golang.org/protobuf/encoding/protodelim"
+)
+
+// BridgePath is the path browsers open WebTransport sessions to the bridge at.
+const BridgePath = "/bridge"
+
+// bridgeMaxRequestSize is the maximum size of a bridge request message.
+const bridgeMaxRequestSize = 64 * 1024
+
+// bridgeStreamErrorCode is the error code streams are reset with when a request fails after the response started.
+const bridgeStreamErrorCode quic.StreamErrorCode = 1
+
+// Bridge lets the web UI browse and download peers' files over WebTransport, so that file data can be streamed into
+// the browser directly instead of through the file server's HTTP responses.
+//
+// The browser opens a WebTransport session to BridgePath with the bearer token in the "token" query parameter, or
+// with the session cookie if the browser sends it. Each bidirectional stream carries one request: the browser sends a
+// v1.BridgeRequest, and the client answers with one or more v1.BridgeResponse messages and, for downloads, the raw file
+// content. Messages are length-delimited with a varint prefix.
+type Bridge struct {
+	logger   *slog.Logger
+	multi    *MultiClient
+	token    string
+	sessions *SessionHandler
+
+	wt *webtransport.Server
+}
+
+// NewBridge creates a new Bridge that authenticates browsers with the specified bearer token or a session from the
+// specified SessionHandler.
+func NewBridge(logger *slog.Logger, multi *MultiClient, token string, sessions *SessionHandler) *Bridge {
+	b := &Bridge{
+		logger:   logger,
+		multi:    multi,
+		token:    token,
+		sessions: sessions,
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(BridgePath, b)
+	b.wt = webtransport.NewServer(mux)
+	return b
+}
+
+// ListenAndServe listens on the specified UDP address and serves the bridge until Close is called.
+// The certificate in tlsCfg must be trusted by the browser, like the web UI's.
+func (b *Bridge) ListenAndServe(addr string, tlsCfg *tls.Config) error {
+	return b.wt.ListenAndServe(addr, tlsCfg)
+}
+
+// Close stops the bridge and closes all sessions.
+func (b *Bridge) Close() error {
+	return b.wt.Close()
+}
+
+var _ http.Handler = (*Bridge)(nil)
+
+func (b *Bridge) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	token := r.URL.Query().Get("token")
+	var ok bool
+	if token == "" {
+		ok = b.sessions.IsValidRequest(r)
+	} else {
+		ok = subtle.ConstantTimeCompare([]byte(token), []byte(b.token)) == 1
+	}
+	if !ok {
+		http.Error(w, "invalid token", http.StatusForbidden)
+		return
+	}
+
+	sess, err := b.wt.Upgrade(w, r)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusBadRequest)
+		return
+	}
+
+	go b.serveSession(sess)
+}
+
+// serveSession serves the streams of a session until it is closed.
+func (b *Bridge) serveSession(sess *webtransport.Session) {
+	for {
+		str, err := sess.AcceptStream(sess.Context())
+		if err != nil {
+			return
+		}
+		go b.serveStream(sess.Context(), str)
+	}
+}
+
+// serveStream serves the single request on a bridge stream.
+func (b *Bridge) serveStream(ctx context.Context, str *quic.Stream) {
+	defer func() {
+		_ = str.Close()
+	}()
+
+	var req v1.BridgeRequest
+	err := protodelim.UnmarshalOptions{MaxSize: bridgeMaxRequestSize}.UnmarshalFrom(bufio.NewReader(io.LimitReader(str, bridgeMaxRequestSize+binary.MaxVarintLen64)), &req)
+	if err != nil {
+		str.CancelRead(bridgeStreamErrorCode)
+		str.CancelWrite(bridgeStreamErrorCode)
+		return
+	}
+	// Nothing else is read from the stream.
+	str.CancelRead(0)
+
+	err = b.handleRequest(ctx, &req, str)
+	if err == nil {
+		return
+	}
+
+	code, info, _ := classifyRpcError(err)
+	if connectErr, ok := errors.AsType[*connect.Error](err); ok {
+		code = connectErr.Code()
+	} else if info == nil {
+		code = connect.CodeInternal
+	}
+	if code == connect.CodeInternal {
+		b.logger.Error("bridge request failed",
+			"service", "client.Bridge",
+			"type", req.Type.String(),
+			"server", req.ServerUuid,
+			"username", req.Username,
+			"path", req.Path,
+			"err", err,
+		)
+	}
+
+	writeErr := writeBridgeResponse(str, &v1.BridgeResponse{
+		Error: &v1.BridgeError{
+			Code:    code.String(),
+			Message: err.Error(),
+			Info:    info,
+		},
+	})
+	if writeErr != nil {
+		str.CancelWrite(bridgeStreamErrorCode)
+	}
+}
+
+// handleRequest answers req on str.
+// Errors returned before anything was written are sent to the browser as a v1.BridgeError. Requests that fail after
+// that reset the stream themselves.
+func (b *Bridge) handleRequest(ctx context.Context, req *v1.BridgeRequest, str *quic.Stream) error {
+	username, usernameOk := common.NormalizeUsername(req.Username)
+	if !usernameOk {
+		return errInvalidUsername
+	}
+
+	path, pathErr := common.ValidatePath(req.Path)
+	if pathErr != nil {
+		return connect.NewError(connect.CodeInvalidArgument, pathErr)
+	}
+
+	srv, has := b.multi.GetByUuid(req.ServerUuid)
+	if !has {
+		return errServerNotFound
+	}
+
+	return srv.Do(ctx, func(ctx context.Context, c *room.Conn) error {
+		peer := c.GetVirtualC2cConn(username, false)
+
+		switch req.Type {
+		case v1.BridgeRequestType_BRIDGE_REQUEST_TYPE_GET_FILE_META:
+			meta, err := peer.GetFileMetaContext(ctx, path)
+			if err != nil {
+				return bridgePeerErr(err)
+			}
+			return writeBridgeResponse(str, &v1.BridgeResponse{
+				Meta: bridgeMetaToInfo(meta),
+			})
+
+		case v1.BridgeRequestType_BRIDGE_REQUEST_TYPE_GET_DIR_FILES:
+			stream, err := peer.GetDirFilesContext(ctx, path)
+			if err != nil {
+				return bridgePeerErr(err)
+			}
+			defer func() {
+				_ = stream.Close()
+			}()
+
+			wroteAny := false
+			for msg, readErr := range protocol.All(ctx, stream) {
+				if readErr != nil {
+					if !wroteAny {
+						return bridgePeerErr(readErr)
+					}
+					str.CancelWrite(bridgeStreamErrorCode)
+					return nil
+				}
+
+				files := make([]*v1.FileMeta, len(msg.Files))
+				for i, file := range msg.Files {
+					files[i] = bridgeMetaToInfo(file)
+				}
+				if err = writeBridgeResponse(str, &v1.BridgeResponse{Files: files}); err != nil {
+					str.CancelWrite(bridgeStreamErrorCode)
+					return nil
+				}
+				wroteAny = true
+			}
+			return nil
+
+		case v1.BridgeRequestType_BRIDGE_REQUEST_TYPE_GET_FILE:
+			meta, reader, err := peer.GetFileContext(ctx, &pb.MsgGetFile{
+				Path:   path.String(),
+				Offset: req.Offset,
+				Limit:  req.Limit,
+			})
+			if err != nil {
+				return bridgePeerErr(err)
+			}
+			defer func() {
+				_ = reader.Close()
+			}()
+
+			if err = writeBridgeResponse(str, &v1.BridgeResponse{Meta: bridgeMetaToInfo(meta)}); err != nil {
+				str.CancelWrite(bridgeStreamErrorCode)
+				return nil
+			}
+			if _, err = io.Copy(str, reader); err != nil {
+				b.logger.Debug("bridge download interrupted",
+					"service", "client.Bridge",
+					"server", req.ServerUuid,
+					"username", username.String(),
+					"path", path.String(),
+					"err", err,
+				)
+				str.CancelWrite(bridgeStreamErrorCode)
+			}
+			return nil
+
+		default:
+			return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("unsupported bridge request type %s", req.Type.String()))
+		}
+	})
+}
+
+// bridgePeerErr maps errors returned by peers to the errors the equivalent RPCs return.
+func bridgePeerErr(err error) error {
+	if protoMsgErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok {
+		switch protoMsgErr.Msg.Type {
+		case pb.ErrType_ERR_TYPE_FILE_NOT_EXIST:
+			return errFileNotFound
+		case pb.ErrType_ERR_TYPE_PATH_NOT_DIRECTORY:
+			return errPathNotDir
+		}
+	}
+	return err
+}
+
+// bridgeMetaToInfo converts file metadata received from a peer to its RPC form.
+func bridgeMetaToInfo(meta *pb.MsgFileMeta) *v1.FileMeta {
+	return &v1.FileMeta{
+		Name:       meta.Name,
+		IsDir:      meta.IsDir,
+		Size:       meta.Size,
+		ModifiedTs: meta.ModifiedTs,
+	}
+}
+
+// writeBridgeResponse writes a length-delimited bridge response to w.
+func writeBridgeResponse(w io.Writer, res *v1.BridgeResponse) error {
+	_, err := protodelim.MarshalTo(w, res)
+	return err
+}
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"friendnet.org/client/cert"
+	"friendnet.org/client/room"
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+	"friendnet.org/protocol"
+	pb "friendnet.org/protocol/pb/v1"
+	"golang.org/x/term"
+)
+
+// readPassword prompts for a password on the terminal without echoing it.
+func readPassword(prompt string) (string, error) {
+	fmt.Print(prompt)
+	pass, err := term.ReadPassword(int(os.Stdin.Fd()))
+	fmt.Println()
+	if err != nil {
+		return "", fmt.Errorf(`failed to read password: %w`, err)
+	}
+	return string(pass), nil
+}
+
+// changePasswordCli interactively changes the account password for the server with the specified UUID.
+// The current password is taken from storage, and the new password is prompted for.
+// On success, the new password is saved to storage.
+func changePasswordCli(ctx context.Context, store *storage.Storage, certStore cert.Store, serverUuid string) error {
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return errors.New("changing password requires an interactive terminal")
+	}
+
+	record, has, err := store.GetServerByUuid(ctx, serverUuid)
+	if err != nil {
+		return fmt.Errorf(`failed to get server %q: %w`, serverUuid, err)
+	}
+	if !has {
+		return fmt.Errorf(`no server with UUID %q exists`, serverUuid)
+	}
+
+	fmt.Printf("Changing password for %q in room %q on %q (%s).\n",
+		record.Username.String(),
+		record.Room.String(),
+		record.Name,
+		record.Address,
+	)
+
+	newPass, err := readPassword("New password: ")
+	if err != nil {
+		return err
+	}
+	confirmPass, err := readPassword("Confirm new password: ")
+	if err != nil {
+		return err
+	}
+	if newPass != confirmPass {
+		return errors.New("passwords do not match")
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, 30*time.Second)
+	defer cancel()
+
+	err = room.ChangeAccountPasswordStandalone(
+		timeoutCtx,
+		certStore,
+		record.Address,
+		room.Credentials{
+			Room:     record.Room,
+			Username: record.Username,
+			Password: record.Password,
+		},
+		newPass,
+	)
+	if err != nil {
+		if protoErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok {
+			switch protoErr.Msg.Type {
+			case pb.ErrType_ERR_TYPE_PERMISSION_DENIED:
+				return errors.New("the server rejected the saved password; update it in the web UI first")
+			case pb.ErrType_ERR_TYPE_INVALID_FIELDS:
+				return errors.New(common.StrPtrOr(protoErr.Msg.Message, "password does not meet requirements"))
+			}
+		}
+
+		return err
+	}
+
+	err = store.UpdateServer(ctx, serverUuid, storage.UpdateServerFields{
+		Password: &newPass,
+	})
+	if err != nil {
+		return fmt.Errorf(`password was changed on the server but could not be saved locally: %w`, err)
+	}
+
+	return nil
+}
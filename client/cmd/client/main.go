@@ -30,11 +30,13 @@ import (
 	"friendnet.org/client/event"
 	"friendnet.org/client/fsys"
 	"friendnet.org/client/fsys/multifs"
+	"friendnet.org/client/secret"
 	"friendnet.org/client/storage"
 	"friendnet.org/common"
 	"friendnet.org/common/machine"
 	"friendnet.org/common/webserver"
 	"friendnet.org/mkcert"
+	"friendnet.org/protocol"
 	v1 "friendnet.org/protocol/pb/clientrpc/v1"
 	"friendnet.org/protocol/pb/clientrpc/v1/clientrpcv1connect"
 	"friendnet.org/updater"
@@ -45,6 +47,13 @@ import (
 
 const lockFilename = "client-lock.json"
 
+// sessionPath is the path of the web UI session endpoint.
+// See client.SessionHandler.
+const sessionPath = "/auth/session"
+
+// rpcTokenSetting is the secret setting that holds the RPC bearer token.
+const rpcTokenSetting = "rpc_bearer_token"
+
 type LockData struct {
 	Ts      int64  `json:"ts"`
 	RpcAddr string `json:"rpc_addr"`
@@ -115,6 +124,11 @@ func main() {
 	var dataDir string
 	var webAddr string
 	var davAddr string
+	var gatewayAddr string
+	var bridgeAddr string
+	var gatewayUrl string
+	var gatewayCertFile string
+	var gatewayKeyFile string
 	var headless bool
 	var noBrowser bool
 	var noLock bool
@@ -123,10 +137,20 @@ func main() {
 	var resetToken bool
 	var pprofFile string
 	var rmCertHost string
+	var changePasswordServer string
+	var noKeychain bool
+	var devServer string
+	var noSelfUpdate bool
+	var lowMemory bool
 
 	flag.StringVar(&dataDir, "datadir", "", "path to the client's data directory")
 	flag.StringVar(&webAddr, "webaddr", "https://127.0.0.1:20042", "web UI and RPC address")
 	flag.StringVar(&davAddr, "davaddr", "https://127.0.0.1:20043", "WebDAV server address")
+	flag.StringVar(&gatewayAddr, "gatewayaddr", "", "address of the public share link gateway, e.g. \"https://0.0.0.0:20044\" (disabled if empty)")
+	flag.StringVar(&gatewayUrl, "gatewayurl", "", "public URL that share links point to, e.g. \"https://files.example.com:20044\" (defaults to -gatewayaddr)")
+	flag.StringVar(&gatewayCertFile, "gatewaycert", "", "PEM certificate file for the share link gateway (defaults to the web UI's self-signed certificate)")
+	flag.StringVar(&gatewayKeyFile, "gatewaykey", "", "PEM private key file for -gatewaycert")
+	flag.StringVar(&bridgeAddr, "bridgeaddr", "", "UDP address of the WebTransport bridge the web UI can stream files through, e.g. \"127.0.0.1:20042\" (disabled if empty)")
 	flag.BoolVar(&noBrowser, "nobrowser", false, "do not open web UI in browser")
 	flag.BoolVar(&noLock, "nolock", false, "do not use a lock to prevent multiple instances of the client from running")
 	flag.BoolVar(&installCa, "installca", false, "if set, tries to install the client's root CA for HTTPS on the web UI")
@@ -134,6 +158,11 @@ func main() {
 	flag.BoolVar(&resetToken, "resettoken", false, "if set, resets the bearer token for the RPC server")
 	flag.StringVar(&pprofFile, "pproffile", "", "write CPU profile data in the pprof format to this file, e.g. \"cpu.pprof\"")
 	flag.StringVar(&rmCertHost, "rmcerthost", "", "removes the specified host from the certificate store (like removing a host from SSH known_hosts)")
+	flag.StringVar(&changePasswordServer, "changepassword", "", "changes your account password on the server with the specified UUID, then exits (the client must not be running)")
+	flag.BoolVar(&noKeychain, "nokeychain", false, "do not store server passwords and the RPC bearer token in the OS keychain, even if it is available")
+	flag.BoolVar(&noSelfUpdate, "noselfupdate", false, "do not allow the client to replace its own executable with updates, such as when it was installed by a package manager")
+	flag.BoolVar(&lowMemory, "lowmem", false, "run in low-memory mode, capping caches, buffers and concurrent streams (for devices like a Raspberry Pi or a NAS), even if the low_memory setting is off")
+	flag.StringVar(&devServer, "dev", "", "serve the web UI by proxying to the frontend dev server at this URL instead of using the embedded files, e.g. \"http://localhost:5173\"")
 
 	// Prevent headless mode on Windows.
 	// It just causes the process to go to the background and not stay in the terminal.
@@ -141,8 +170,23 @@ func main() {
 		flag.BoolVar(&headless, "headless", false, "run client in headless mode (RPC-only, no web UI, no locking, no GUI or browser functionality)")
 	}
 
+	flag.Usage = func() {
+		_, _ = fmt.Fprintf(flag.CommandLine.Output(), "Usage: %s [flags] [invite URL]\n", os.Args[0])
+		flag.PrintDefaults()
+	}
+
 	flag.Parse()
 
+	// An invite URL may be passed as an argument, usually by the OS's friendnet:// URL handler.
+	var inviteUrl string
+	if arg := flag.Arg(0); arg != "" {
+		if _, parseErr := protocol.ParseInviteUrl(arg); parseErr != nil {
+			println(parseErr.Error())
+			os.Exit(1)
+		}
+		inviteUrl = arg
+	}
+
 	var profilerFile *os.File
 	if pprofFile != "" {
 		var err error
@@ -187,11 +231,37 @@ func main() {
 		panic(fmt.Errorf(`failed to parse web UI server address %q: %w`, webAddr, err))
 	}
 
+	var devServerUrl *url.URL
+	if devServer != "" {
+		devServerUrl, err = url.Parse(devServer)
+		if err != nil {
+			panic(fmt.Errorf(`failed to parse web UI dev server address %q: %w`, devServer, err))
+		}
+	}
+
 	_, err = url.Parse(davAddr)
 	if err != nil {
 		panic(fmt.Errorf(`failed to parse WebDAV server address %q: %w`, davAddr, err))
 	}
 
+	if gatewayAddr != "" {
+		gatewayAddrUrl, parseErr := url.Parse(gatewayAddr)
+		if parseErr != nil {
+			panic(fmt.Errorf(`failed to parse share link gateway address %q: %w`, gatewayAddr, parseErr))
+		}
+		if gatewayAddrUrl.Scheme != "https" {
+			panic(fmt.Errorf(`share link gateway address %q must use HTTPS`, gatewayAddr))
+		}
+		if gatewayUrl == "" {
+			gatewayUrl = gatewayAddr
+		}
+	} else {
+		gatewayUrl = ""
+	}
+	if (gatewayCertFile == "") != (gatewayKeyFile == "") {
+		panic(errors.New("-gatewaycert and -gatewaykey must be specified together"))
+	}
+
 	dbDir := filepath.Join(dataDir, "client.db")
 
 	store, err := storage.NewStorage(dbDir)
@@ -199,6 +269,32 @@ func main() {
 		panic(fmt.Errorf(`failed to create storage: %w`, err))
 	}
 
+	// Secrets that are kept in the database are encrypted with a key stored next to it.
+	dbKey, err := secret.LoadOrCreateKey(filepath.Join(dataDir, "secret.key"))
+	if err != nil {
+		panic(fmt.Errorf(`failed to load secret key: %w`, err))
+	}
+	if err = store.UseDatabaseKey(context.Background(), dbKey); err != nil {
+		panic(fmt.Errorf(`failed to encrypt secrets in database: %w`, err))
+	}
+
+	// Keep secrets in the OS keychain if possible.
+	// If the keychain is disabled, secrets that were put in it earlier are moved back to the database.
+	// Failures are only reported once the logger exists, since they are not fatal.
+	var keychainErr error
+	keychain, kcErr := secret.NewKeychain(context.Background())
+	if kcErr == nil {
+		if noKeychain {
+			keychainErr = store.MoveSecretsToDatabase(context.Background(), keychain, rpcTokenSetting)
+		} else {
+			keychainErr = store.UseSecretStore(context.Background(), keychain)
+		}
+	} else if kcErr != secret.ErrUnavailable && !noKeychain {
+		// The keychain exists but could not be used, which is worth telling the user about.
+		// A plain ErrUnavailable just means there is no keychain on this system.
+		keychainErr = kcErr
+	}
+
 	certStore := cert.NewSqliteStore(store)
 
 	if rmCertHost != "" {
@@ -215,6 +311,20 @@ func main() {
 		return
 	}
 
+	if changePasswordServer != "" {
+		if !noLock && (&Locker{lockDir: dataDir}).CheckLock() != nil {
+			println("Client is running; change your password in the web UI instead, or close the client first")
+			os.Exit(1)
+		}
+
+		if cpErr := changePasswordCli(context.Background(), store, certStore, changePasswordServer); cpErr != nil {
+			println(cpErr.Error())
+			os.Exit(1)
+		}
+		println("Password changed")
+		return
+	}
+
 	// Create logger after storage is initialized, as it depends on migrations being run.
 	logHandler := clog.NewHandler(
 		store,
@@ -225,6 +335,14 @@ func main() {
 	)
 	logger := slog.New(logHandler)
 
+	if keychainErr != nil {
+		if noKeychain {
+			logger.Warn(`failed to move secrets from OS keychain back to the database`, "err", keychainErr)
+		} else {
+			logger.Warn(`failed to use OS keychain, some secrets will be stored in the database instead`, "err", keychainErr)
+		}
+	}
+
 	mc, err := mkcert.NewMkCert(dataDir)
 	if err != nil {
 		logger.Error(`failed to initialize mkcert`, "err", err)
@@ -248,14 +366,17 @@ func main() {
 
 	// Get or set bearer token.
 	var rpcBearerToken string
-	const rpcTokenSetting = "rpc_bearer_token"
 	{
 		const byteLen = 32
 		if resetToken {
 			rpcBearerToken = common.RandomB64UrlStr(byteLen)
-			err = store.PutSetting(context.Background(), rpcTokenSetting, rpcBearerToken)
+			err = store.PutSecretSetting(context.Background(), rpcTokenSetting, rpcBearerToken)
+			if err == nil {
+				// Sign out every browser along with the old token.
+				err = store.DeleteWebSessions(context.Background())
+			}
 		} else {
-			rpcBearerToken, err = store.GetSettingOrPut(context.Background(), rpcTokenSetting, common.RandomB64UrlStr(byteLen))
+			rpcBearerToken, err = store.GetSecretSettingOrPut(context.Background(), rpcTokenSetting, common.RandomB64UrlStr(byteLen))
 		}
 		if err != nil {
 			logger.Error(`failed to get or set RPC bearer token`, "err", err)
@@ -263,7 +384,18 @@ func main() {
 		}
 	}
 
-	webUrlWithCreds := strings.ReplaceAll(fmt.Sprintf("%s?token=%s", webUrl.String(), rpcBearerToken), "127.0.0.1", "localhost")
+	// The web UI is opened through the session endpoint with a short-lived launch code, so that the bearer token
+	// does not end up in browser history.
+	webUiPath := "/"
+	if inviteUrl != "" {
+		// Open the page for adding a server with the invite pre-filled instead.
+		webUiPath = "/createserver?invite=" + url.QueryEscape(inviteUrl)
+	}
+	webUrlWithCreds := strings.ReplaceAll(
+		fmt.Sprintf("%s?code=%s&next=%s", webUrl.JoinPath(sessionPath).String(), client.NewLaunchCode(rpcBearerToken), url.QueryEscape(webUiPath)),
+		"127.0.0.1",
+		"localhost",
+	)
 
 	if !noLock {
 		locker := &Locker{
@@ -300,11 +432,22 @@ func main() {
 		}
 	}
 
+	budget, err := client.LoadMemoryBudget(context.Background(), store, lowMemory)
+	if err != nil {
+		logger.Error(`failed to load low-memory setting`, "err", err)
+		os.Exit(1)
+	}
+	budget.Apply()
+	if budget.LowMemory {
+		logger.Info("running in low-memory mode")
+	}
+
 	directCfg, err := direct.ConfigFromSettings(context.Background(), store)
 	if err != nil {
 		logger.Error(`failed to load direct configuration`, "err", err)
 		os.Exit(1)
 	}
+	budget.CapDirectConfig(directCfg)
 	directMgr, err := direct.NewManager(logger, directCfg)
 	if err != nil {
 		logger.Error(`failed to create direct manager`, "err", err)
@@ -321,6 +464,13 @@ func main() {
 
 	eventBus := event.NewBus()
 
+	uptimeTracker, err := client.NewUptimeTracker(logger, store)
+	if err != nil {
+		panic(fmt.Errorf(`failed to create uptime tracker: %w`, err))
+	}
+
+	plugins := client.NewPluginManager(logger, store, eventBus)
+
 	multi, err := client.NewMultiClient(
 		logger,
 		store,
@@ -328,6 +478,9 @@ func main() {
 		connMethodSupport,
 		directMgr,
 		eventBus,
+		uptimeTracker,
+		plugins,
+		budget,
 	)
 	if err != nil {
 		panic(fmt.Errorf(`failed to create multi client: %w`, err))
@@ -351,13 +504,30 @@ func main() {
 		}
 	}
 
+	updateCheckUrl, err := client.UpdateCheckUrl(context.Background(), store)
+	if err != nil {
+		panic(fmt.Errorf(`failed to get update settings: %w`, err))
+	}
 	updateChecker := updater.NewUpdateChecker(
 		logger,
-		updater.UpdateCheckerBaseUrl,
+		updateCheckUrl,
 		updater.CurrentUpdate,
 		updater.Ed25519Pubkey,
 		updater.UpdateCheckerInterval,
 	)
+
+	var exePath string
+	if !noSelfUpdate {
+		exePath, err = updater.ExecutablePath()
+		if err != nil {
+			logger.Warn("failed to find the client's executable, self-updating is disabled",
+				"err", err,
+			)
+			exePath = ""
+		}
+	}
+	selfUpdater := client.NewSelfUpdater(logger, updateChecker, exePath)
+
 	go func() {
 		for {
 			newChan := updateChecker.NewUpdateChan()
@@ -380,6 +550,7 @@ func main() {
 						Version:     update.Version,
 						Description: update.Description,
 						Url:         update.Url,
+						CanApply:    selfUpdater.CanApply(*update),
 					}
 				} else {
 					continue
@@ -397,16 +568,33 @@ func main() {
 		}
 	}()
 
+	notifier := client.NewNotifier(logger, store, multi, eventBus)
+
+	host := client.NewHost(logger, multi, store, filepath.Join(dataDir, "hosted"))
+	go func() {
+		if hostErr := host.Resume(context.Background()); hostErr != nil {
+			logger.Error("failed to resume hosting room",
+				"err", hostErr,
+			)
+		}
+	}()
+
 	downloadManager, err := client.NewDownloadManager(
 		logger,
 		multi,
 		eventBus,
 		store,
+		notifier,
 	)
 	if err != nil {
 		panic(fmt.Errorf(`failed to create download manager: %w`, err))
 	}
 
+	uploadTracker := client.NewUploadTracker(logger, store, eventBus)
+
+	shareGateway := client.NewShareGateway(logger, multi, store, gatewayUrl)
+	fileLinks := client.NewFileLinkStore()
+
 	httpsKeyPair, err := tls.X509KeyPair(httpsCertPem, httpsKeyPem)
 	if err != nil {
 		panic(fmt.Errorf(`failed to parse HTTPS certificate key pair: %w`, err))
@@ -417,6 +605,11 @@ func main() {
 		webserver.WithHttpsSupport(httpsKeyPair),
 	)
 
+	sessions, err := client.NewSessionHandler(context.Background(), logger, store, rpcBearerToken)
+	if err != nil {
+		panic(fmt.Errorf(`failed to create web UI session handler: %w`, err))
+	}
+
 	rpc, err := common.NewRpcServer(
 		logger,
 		webServer,
@@ -424,18 +617,32 @@ func main() {
 			Address:             webAddr,
 			AllowedMethods:      []string{"*"},
 			BearerToken:         rpcBearerToken,
+			SessionCookie:       client.SessionCookieName,
+			SessionValidator:    sessions.IsValidSession,
+			TokenAuthorizer:     plugins.Authorize,
 			CorsAllowAllOrigins: true,
+			// The web UI polls the RPC server constantly, so only log a sample of its requests.
+			LogSampleEvery: 100,
 		},
+		nil,
 		client.NewRpcServer(
 			logHandler,
 			multi,
 			eventBus,
 			updateChecker,
+			selfUpdater,
 			downloadManager,
+			uploadTracker,
+			uptimeTracker,
+			shareGateway,
+			fileLinks,
+			plugins,
+			host,
 			store,
 			stop,
 		),
 		func(impl *client.RpcServer, options ...connect.HandlerOption) (string, http.Handler) {
+			options = append(options, connect.WithInterceptors(client.RpcErrorInterceptor{}))
 			return clientrpcv1connect.NewClientRpcServiceHandler(impl, options...)
 		},
 	)
@@ -444,19 +651,33 @@ func main() {
 		panic(fmt.Errorf(`failed to create RPC server: %w`, err))
 	}
 
-	err = webServer.Mount(webAddr, "/content/", client.NewFileServer(logger, multi, rpcBearerToken))
+	readAheadMib, err := store.GetSettingIntOr(context.Background(), fsys.ReadAheadSetting, fsys.DefaultReadAheadMib)
+	if err != nil {
+		panic(fmt.Errorf(`failed to get read-ahead setting: %w`, err))
+	}
+	readAhead := budget.ReadAhead(int(min(max(readAheadMib, 0), fsys.MaxReadAheadMib)) * 1024 * 1024)
+
+	err = webServer.Mount(webAddr, "/content/", client.NewFileServer(logger, multi, rpcBearerToken, fileLinks, sessions, readAhead))
 	if err != nil {
 		panic(fmt.Errorf(`failed to mount file proxy: %w`, err))
 	}
 
-	err = webServer.Mount(webAddr, "/", webui.Handler{})
+	err = webServer.Mount(webAddr, sessionPath, sessions)
+	if err != nil {
+		panic(fmt.Errorf(`failed to mount web UI session endpoint: %w`, err))
+	}
+
+	err = webServer.Mount(webAddr, "/", webui.Handler{
+		DevServer: devServerUrl,
+	})
 	if err != nil {
 		panic(fmt.Errorf(`failed to mount web UI: %w`, err))
 	}
 
-	metaCache := fsys.NewMetaCache(30*time.Second, 5*time.Minute)
+	metaCache := fsys.NewMetaCache(30*time.Second, 5*time.Minute, budget.MetaCacheMaxEntries)
 	multiFs := multifs.NewMultiFs(multi,
 		multifs.WithMetaCache(metaCache),
+		multifs.WithReadAhead(readAhead),
 	)
 	webdavHandler := &webdav.Handler{
 		FileSystem: multifs.NewWebDavWrapper(multiFs),
@@ -467,12 +688,55 @@ func main() {
 		panic(fmt.Errorf(`failed to mount WebDAV handler: %w`, err))
 	}
 
+	// The gateway is public, so it gets its own server that can use a certificate people's browsers trust.
+	var gatewayServer *webserver.WebServer
+	if gatewayAddr != "" {
+		gatewayKeyPair := httpsKeyPair
+		if gatewayCertFile != "" {
+			gatewayKeyPair, err = tls.LoadX509KeyPair(gatewayCertFile, gatewayKeyFile)
+			if err != nil {
+				panic(fmt.Errorf(`failed to load share link gateway certificate: %w`, err))
+			}
+		}
+
+		gatewayServer = webserver.NewWebServer(
+			logger,
+			webserver.WithHttpsSupport(gatewayKeyPair),
+		)
+		err = gatewayServer.Mount(gatewayAddr, client.GatewayPathPrefix, shareGateway)
+		if err != nil {
+			panic(fmt.Errorf(`failed to mount share link gateway: %w`, err))
+		}
+	}
+
+	var bridge *client.Bridge
+	if bridgeAddr != "" {
+		bridge = client.NewBridge(logger, multi, rpcBearerToken, sessions)
+	}
+
 	// Close client on SIGTERM.
 	var shutdownWg sync.WaitGroup
 	defer stop()
 	shutdownWg.Go(func() {
 		<-ctx.Done()
 
+		// Let active uploads finish first. Interrupting again skips waiting.
+		grace, graceErr := store.GetSettingIntOr(context.Background(), client.ShutdownGraceSetting, client.DefaultShutdownGrace)
+		if graceErr != nil {
+			logger.Error("failed to get shutdown grace period setting",
+				"err", graceErr,
+			)
+		}
+		if grace > 0 {
+			logger.Info("shutdown signal received, waiting for uploads to finish",
+				"grace", time.Duration(grace)*time.Second,
+			)
+
+			drainCtx, drainCancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+			multi.DrainUploads(drainCtx, time.Duration(grace)*time.Second)
+			drainCancel()
+		}
+
 		// Send stop event to all subscribers.
 		eventBus.
 			CreatePublisher(&v1.EventContext{}).
@@ -495,15 +759,42 @@ func main() {
 		doWithTimeout(1*time.Second, func(ctx context.Context) {
 			_ = webServer.Close()
 		})
+		if gatewayServer != nil {
+			doWithTimeout(1*time.Second, func(_ context.Context) {
+				_ = gatewayServer.Close()
+			})
+		}
+		if bridge != nil {
+			doWithTimeout(1*time.Second, func(_ context.Context) {
+				_ = bridge.Close()
+			})
+		}
 		doWithTimeout(1*time.Second, func(_ context.Context) {
 			_ = updateChecker.Close()
 		})
 		doWithTimeout(1*time.Second, func(_ context.Context) {
 			_ = rpc.Close()
 		})
+
+		// The RPC server is closed, so no more updates can be applied.
+		// Replacing the executable does not affect the running client.
+		selfUpdater.Install()
+
+		doWithTimeout(1*time.Second, func(_ context.Context) {
+			_ = uploadTracker.Close()
+		})
+		doWithTimeout(1*time.Second, func(_ context.Context) {
+			_ = notifier.Close()
+		})
+		doWithTimeout(1*time.Second, func(_ context.Context) {
+			_ = uptimeTracker.Close()
+		})
 		doWithTimeout(5*time.Second, func(_ context.Context) {
 			_ = multi.Close()
 		})
+		doWithTimeout(5*time.Second, func(_ context.Context) {
+			_ = host.Close()
+		})
 		doWithTimeout(5*time.Second, func(_ context.Context) {
 			_ = logHandler.Close()
 		})
@@ -537,6 +828,44 @@ func main() {
 		stop()
 	}()
 
+	if gatewayServer != nil {
+		logger.Info(`share link gateway listening`,
+			"addr", gatewayAddr,
+			"url", gatewayUrl,
+		)
+
+		go func() {
+			serveErr := gatewayServer.Serve()
+			if serveErr != nil {
+				if errors.Is(serveErr, http.ErrServerClosed) {
+					return
+				}
+				logger.Error(`share link gateway failed to serve`,
+					"err", serveErr,
+				)
+			}
+
+			stop()
+		}()
+	}
+
+	if bridge != nil {
+		logger.Info(`WebTransport bridge listening`,
+			"addr", bridgeAddr,
+		)
+
+		go func() {
+			serveErr := bridge.ListenAndServe(bridgeAddr, &tls.Config{
+				Certificates: []tls.Certificate{httpsKeyPair},
+			})
+			if serveErr != nil {
+				logger.Error(`WebTransport bridge failed to serve`,
+					"err", serveErr,
+				)
+			}
+		}()
+	}
+
 	shutdownWg.Wait()
 
 	if profilerFile != nil {
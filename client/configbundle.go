@@ -0,0 +1,140 @@
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"friendnet.org/client/backup"
+	"friendnet.org/common"
+)
+
+// ImportConfigResult is the result of MultiClient.ImportConfig.
+type ImportConfigResult struct {
+	// The servers that were created.
+	Servers []*Server
+
+	// The number of servers that were skipped because a server with the same address, room and username already exists.
+	SkippedServers int
+
+	// Descriptions of the shares that could not be created, such as because their path does not exist on this machine.
+	FailedShares []string
+}
+
+// ExportConfig returns the client's server records, their shares and the trusted server certificates as a bundle.
+// Internal shares are not included.
+func (c *MultiClient) ExportConfig(ctx context.Context) (backup.Bundle, error) {
+	records, err := c.storage.GetServers(ctx)
+	if err != nil {
+		return backup.Bundle{}, err
+	}
+
+	bundle := backup.Bundle{
+		Servers: make([]backup.Server, 0, len(records)),
+	}
+
+	for _, record := range records {
+		shareRecs, shareErr := c.storage.GetSharesByServer(ctx, record.Uuid)
+		if shareErr != nil {
+			return backup.Bundle{}, shareErr
+		}
+
+		shares := make([]backup.Share, 0, len(shareRecs))
+		for _, shareRec := range shareRecs {
+			if shareRec.IsInternal {
+				continue
+			}
+
+			shares = append(shares, backup.Share{
+				Name:        shareRec.Name,
+				Path:        shareRec.Path.String(),
+				FollowLinks: shareRec.FollowLinks,
+			})
+		}
+
+		bundle.Servers = append(bundle.Servers, backup.Server{
+			Name:     record.Name,
+			Address:  record.Address,
+			Room:     record.Room.String(),
+			Username: record.Username.String(),
+			Password: record.Password,
+			Shares:   shares,
+		})
+	}
+
+	certRecs, err := c.storage.GetServerCerts(ctx)
+	if err != nil {
+		return backup.Bundle{}, err
+	}
+	bundle.Certs = make([]backup.Cert, 0, len(certRecs))
+	for _, certRec := range certRecs {
+		bundle.Certs = append(bundle.Certs, backup.Cert{
+			Hostname: certRec.Hostname,
+			Der:      certRec.CertDer,
+		})
+	}
+
+	return bundle, nil
+}
+
+// ImportConfig creates the servers and shares in the bundle and trusts its certificates.
+// Servers with the same address, room and username as an existing server are skipped.
+// Certificates for hostnames that already have a trusted certificate are skipped, so an import never overrides trust
+// established on this machine.
+//
+// Shares that cannot be created do not fail the import, and are listed in the result instead.
+func (c *MultiClient) ImportConfig(ctx context.Context, bundle backup.Bundle) (ImportConfigResult, error) {
+	var result ImportConfigResult
+
+	for _, bundleCert := range bundle.Certs {
+		existing, err := c.certStore.GetDer(ctx, bundleCert.Hostname)
+		if err != nil {
+			return result, fmt.Errorf(`failed to look up stored certificate for %q: %w`, bundleCert.Hostname, err)
+		}
+		if len(existing) > 0 {
+			continue
+		}
+
+		if err = c.certStore.PutDer(ctx, bundleCert.Hostname, bundleCert.Der); err != nil {
+			return result, fmt.Errorf(`failed to store certificate for %q: %w`, bundleCert.Hostname, err)
+		}
+	}
+
+	existing := c.GetAll()
+
+	for _, bundleSrv := range bundle.Servers {
+		roomName, roomOk := common.NormalizeRoomName(bundleSrv.Room)
+		if !roomOk {
+			return result, fmt.Errorf(`server %q in bundle has invalid room name %q`, bundleSrv.Name, bundleSrv.Room)
+		}
+		username, usernameOk := common.NormalizeUsername(bundleSrv.Username)
+		if !usernameOk {
+			return result, fmt.Errorf(`server %q in bundle has invalid username %q`, bundleSrv.Name, bundleSrv.Username)
+		}
+
+		isDuplicate := false
+		for _, srv := range existing {
+			if srv.Address() == bundleSrv.Address && srv.Room() == roomName && srv.Username() == username {
+				isDuplicate = true
+				break
+			}
+		}
+		if isDuplicate {
+			result.SkippedServers++
+			continue
+		}
+
+		srv, err := c.Create(ctx, bundleSrv.Name, bundleSrv.Address, roomName, username, bundleSrv.Password)
+		if err != nil {
+			return result, err
+		}
+		result.Servers = append(result.Servers, srv)
+
+		for _, bundleShare := range bundleSrv.Shares {
+			if _, err = srv.ShareMgr.Add(ctx, bundleShare.Name, bundleShare.Path, bundleShare.FollowLinks); err != nil {
+				result.FailedShares = append(result.FailedShares, fmt.Sprintf(`%s/%s (%s): %v`, bundleSrv.Name, bundleShare.Name, bundleShare.Path, err))
+			}
+		}
+	}
+
+	return result, nil
+}
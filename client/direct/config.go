@@ -11,6 +11,7 @@ import (
 
 	"friendnet.org/client/storage"
 	"friendnet.org/common"
+	"friendnet.org/protocol"
 )
 
 // CommonName is the common name used in self-signed direct server certificates.
@@ -27,10 +28,35 @@ const SettingDisableUPnP = "direct_server_disable_upnp"
 const SettingUpnpTimeoutMs = "direct_server_upnp_timeout_ms"
 const SettingEnableNatHolePunching = "direct_server_enable_nat_hole_punching"
 const SettingNatHolePunchingBindPort = "direct_server_nat_hole_punching_bind_port"
+const SettingMaxIncomingStreams = "peer_max_incoming_streams"
+const SettingMaxConcurrentRequests = "peer_max_concurrent_requests"
+const SettingRequestTimeoutMs = "peer_request_timeout_ms"
+const SettingRequestRetries = "peer_request_retries"
 
 const DefaultDirectPort = 20048
 const DefaultUpnpTimeout = 10 * time.Second
 
+// RequestPolicy is the timeout and retry policy for requests made to peers.
+type RequestPolicy struct {
+	// How long to wait for each reply from the peer before giving up on the attempt.
+	// 0 means no timeout.
+	Timeout time.Duration
+
+	// How many times to retry an idempotent request after an attempt times out or fails because of a network error.
+	MaxRetries int
+
+	// How long to wait before the first retry.
+	// It doubles after each retry.
+	RetryBackoff time.Duration
+}
+
+// DefaultRequestPolicy is the default policy for requests made to peers.
+var DefaultRequestPolicy = RequestPolicy{
+	Timeout:      20 * time.Second,
+	MaxRetries:   2,
+	RetryBackoff: 500 * time.Millisecond,
+}
+
 // ConfigFromSettings loads the configuration from the settings.
 func ConfigFromSettings(ctx context.Context, store *storage.Storage) (*Config, error) {
 	var err error
@@ -45,6 +71,10 @@ func ConfigFromSettings(ctx context.Context, store *storage.Storage) (*Config, e
 	var upnpTimeoutMs int64
 	var disableNatHolePunching bool
 	var natHolePunchingBindPort int64
+	var maxIncomingStreams int64
+	var maxConcurrentRequests int64
+	var requestTimeoutMs int64
+	var requestRetries int64
 
 	if disable, err = store.GetSettingBoolOrPut(ctx, SettingDisable, false); err != nil {
 		return nil, err
@@ -86,6 +116,19 @@ func ConfigFromSettings(ctx context.Context, store *storage.Storage) (*Config, e
 		return nil, err
 	}
 
+	if maxIncomingStreams, err = store.GetSettingIntOrPut(ctx, SettingMaxIncomingStreams, protocol.DefaultMaxIncomingStreams); err != nil {
+		return nil, err
+	}
+	if maxConcurrentRequests, err = store.GetSettingIntOrPut(ctx, SettingMaxConcurrentRequests, protocol.DefaultMaxConcurrentRequests); err != nil {
+		return nil, err
+	}
+	if requestTimeoutMs, err = store.GetSettingIntOrPut(ctx, SettingRequestTimeoutMs, int64(DefaultRequestPolicy.Timeout/time.Millisecond)); err != nil {
+		return nil, err
+	}
+	if requestRetries, err = store.GetSettingIntOrPut(ctx, SettingRequestRetries, int64(DefaultRequestPolicy.MaxRetries)); err != nil {
+		return nil, err
+	}
+
 	var addrs []string
 	if err = json.Unmarshal([]byte(addrsJson), &addrs); err != nil {
 		return nil, err
@@ -109,6 +152,15 @@ func ConfigFromSettings(ctx context.Context, store *storage.Storage) (*Config, e
 		UpnpTimeout:                time.Duration(upnpTimeoutMs) * time.Millisecond,
 		DisableNatHolePunching:     disableNatHolePunching,
 		NatHolePunchingBindPort:    uint16(natHolePunchingBindPort),
+		ConnLimits: protocol.ConnLimits{
+			MaxIncomingStreams:    maxIncomingStreams,
+			MaxConcurrentRequests: int(maxConcurrentRequests),
+		},
+		RequestPolicy: RequestPolicy{
+			Timeout:      time.Duration(max(requestTimeoutMs, 0)) * time.Millisecond,
+			MaxRetries:   int(max(requestRetries, 0)),
+			RetryBackoff: DefaultRequestPolicy.RetryBackoff,
+		},
 	}, nil
 }
 
@@ -173,6 +225,14 @@ type Config struct {
 	// If 0, it will choose a random port.
 	// Defaults to 0.
 	NatHolePunchingBindPort uint16
+
+	// The limits for connections with peers.
+	// MaxIncomingStreams only applies to connections accepted by direct servers,
+	// while MaxConcurrentRequests applies to each peer regardless of how it reaches the client.
+	ConnLimits protocol.ConnLimits
+
+	// The timeout and retry policy for requests made to peers, whether direct or proxied.
+	RequestPolicy RequestPolicy
 }
 
 // Validate validates a Config and returns its parsed IP-port values.
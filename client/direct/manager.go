@@ -222,7 +222,7 @@ func (m *Manager) startServers() {
 	// Create servers for each address.
 	servers := make([]*Server, 0, len(listenAddrPorts))
 	for _, addrPort := range listenAddrPorts {
-		server, err := NewServer(m.logger, m.ctx, m, addrPort, m.cfg.Cert)
+		server, err := NewServer(m.logger, m.ctx, m, addrPort, m.cfg.Cert, m.cfg.ConnLimits)
 		if err != nil {
 			m.logger.Error("failed to create direct server",
 				"service", "direct.Manager",
@@ -279,6 +279,16 @@ func (m *Manager) AdvertisePrivateIps() bool {
 	return m.cfg.AdvertisePrivateIps
 }
 
+// ConnLimits returns the limits for connections with peers.
+func (m *Manager) ConnLimits() protocol.ConnLimits {
+	return m.cfg.ConnLimits
+}
+
+// RequestPolicy returns the timeout and retry policy for requests made to peers.
+func (m *Manager) RequestPolicy() RequestPolicy {
+	return m.cfg.RequestPolicy
+}
+
 // NotifyIpAvailable notifies the Manager that an IP address is available for use.
 // If there is not already a direct server running on that IP with the default port,
 // a new one will be started for it in the background.
@@ -303,7 +313,7 @@ func (m *Manager) NotifyIpAvailable(ip netip.Addr) {
 
 	go func() {
 		addrPort := netip.AddrPortFrom(ip, m.defaultPort)
-		server, err := NewServer(m.logger, m.ctx, m, addrPort, m.cfg.Cert)
+		server, err := NewServer(m.logger, m.ctx, m, addrPort, m.cfg.Cert, m.cfg.ConnLimits)
 		if err != nil {
 			m.logger.Error("failed to start direct server after IP notification",
 				"service", "direct.Manager",
@@ -426,7 +436,7 @@ func (i *IncomingDirectConn) SendResultAndClose(result pb.DirectConnHandshakeRes
 		go func() {
 			// Give some time for the result message to be received.
 			time.Sleep(500 * time.Millisecond)
-			_ = i.conn.CloseWithReason(closeMsg)
+			_ = i.conn.CloseWithCode(protocol.CloseCodeNormal, closeMsg)
 		}()
 	}()
 
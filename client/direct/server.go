@@ -87,18 +87,20 @@ func NewServerFromListener(
 // NewServer creates a new direct connect server.
 // It returns an error if a listener could not be created.
 // Once created, it listens and handles incoming connections on its own.
+// The limits apply to each accepted connection.
 func NewServer(
 	logger *slog.Logger,
 	ctx context.Context,
 	m *Manager,
 	addrPort netip.AddrPort,
 	cert tls.Certificate,
+	limits protocol.ConnLimits,
 ) (*Server, error) {
 	listener, err := protocol.NewQuicProtoListener(addrPort.String(), &tls.Config{
 		MinVersion:   tls.VersionTLS13,
 		Certificates: []tls.Certificate{cert},
 		NextProtos:   []string{protocol.DirectAlpnProtoName},
-	})
+	}, limits)
 	if err != nil {
 		return nil, err
 	}
@@ -164,7 +166,7 @@ func (s *Server) connHandler(conn protocol.ProtoConn) {
 	go func() {
 		<-ctx.Done()
 		if !isOk {
-			_ = conn.CloseWithReason("handshake timed out")
+			_ = conn.CloseWithCode(protocol.CloseCodeNormal, "handshake timed out")
 		}
 	}()
 
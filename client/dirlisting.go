@@ -0,0 +1,181 @@
+package client
+
+import (
+	"encoding/json"
+	"html/template"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+var dirListingTmpl = template.Must(template.New("dirListing").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Index of {{.Path}}</title>
+<style>
+body { font-family: sans-serif; margin: 2em; }
+table { border-collapse: collapse; }
+th, td { padding: 0.2em 1em; text-align: left; }
+td.size { text-align: right; }
+</style>
+</head>
+<body>
+<h1>Index of {{.Path}}</h1>
+{{if .Archives}}<p>Download this directory as <a href="{{.SelfHref}}?zip=1">zip</a> or <a href="{{.SelfHref}}?tar=1">tar.gz</a>.</p>
+{{end}}<table>
+<tr><th>Name</th><th>Size</th><th>Modified</th></tr>
+{{if .ParentHref}}<tr><td><a href="{{.ParentHref}}">../</a></td><td class="size">-</td><td>-</td></tr>
+{{end}}{{range .Entries}}<tr><td><a href="{{.Href}}">{{.Name}}{{if .IsDir}}/{{end}}</a></td><td class="size">{{.Size}}</td><td>{{.Modified}}</td></tr>
+{{end}}</table>
+</body>
+</html>
+`))
+
+type dirListingEntry struct {
+	Name     string
+	Href     string
+	IsDir    bool
+	Size     string
+	Modified string
+}
+
+type dirListingPage struct {
+	Path       string
+	SelfHref   string
+	ParentHref string
+	Archives   bool
+	Entries    []dirListingEntry
+}
+
+// dirListingJsonFile is a file in a JSON directory listing.
+type dirListingJsonFile struct {
+	Name       string `json:"name"`
+	IsDir      bool   `json:"is_dir"`
+	Size       uint64 `json:"size"`
+	ModifiedTs *int64 `json:"modified_ts"`
+}
+
+// dirListingJson is a JSON directory listing.
+type dirListingJson struct {
+	Path  string               `json:"path"`
+	Files []dirListingJsonFile `json:"files"`
+}
+
+// fmtListingSize formats a file size in bytes for display.
+func fmtListingSize(size uint64) string {
+	const unit = 1024
+	if size < unit {
+		return strconv.FormatUint(size, 10) + " B"
+	}
+
+	div, exp := uint64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return strconv.FormatFloat(float64(size)/float64(div), 'f', 1, 64) + " " + string("KMGTPE"[exp]) + "iB"
+}
+
+// writeDirListing writes a listing of the files in a peer directory.
+// If the request accepts JSON, a JSON listing is written, otherwise an HTML page with links is written.
+// basePath is the file server path that peer paths are appended to, without a trailing slash.
+// If archives is true, the HTML page links to zip and tar.gz archives of the directory.
+func writeDirListing(
+	w http.ResponseWriter,
+	r *http.Request,
+	basePath string,
+	path common.ProtoPath,
+	files []*pb.MsgFileMeta,
+	archives bool,
+) error {
+	// Directories first, then alphabetical.
+	slices.SortFunc(files, func(a, b *pb.MsgFileMeta) int {
+		if a.IsDir != b.IsDir {
+			if a.IsDir {
+				return -1
+			}
+			return 1
+		}
+		return strings.Compare(strings.ToLower(a.Name), strings.ToLower(b.Name))
+	})
+
+	if strings.Contains(r.Header.Get("Accept"), "application/json") {
+		listing := dirListingJson{
+			Path:  path.String(),
+			Files: make([]dirListingJsonFile, len(files)),
+		}
+		for i, file := range files {
+			listing.Files[i] = dirListingJsonFile{
+				Name:       file.Name,
+				IsDir:      file.IsDir,
+				Size:       file.Size,
+				ModifiedTs: file.ModifiedTs,
+			}
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusOK)
+		if r.Method == http.MethodHead {
+			return nil
+		}
+		return json.NewEncoder(w).Encode(listing)
+	}
+
+	// Escape each segment so that names with special characters produce valid links.
+	hrefFor := func(p common.ProtoPath) string {
+		segments := p.ToSegments()
+		for i, seg := range segments {
+			segments[i] = url.PathEscape(seg)
+		}
+		if len(segments) == 0 {
+			return basePath
+		}
+		return basePath + "/" + strings.Join(segments, "/")
+	}
+
+	page := dirListingPage{
+		Path:     path.String(),
+		SelfHref: hrefFor(path),
+		Archives: archives,
+		Entries:  make([]dirListingEntry, len(files)),
+	}
+	if !path.IsRoot() {
+		segments := path.ToSegments()
+		parent, err := common.SegmentsToPath(segments[:len(segments)-1])
+		if err == nil {
+			page.ParentHref = hrefFor(parent)
+		}
+	}
+
+	for i, file := range files {
+		entry := dirListingEntry{
+			Name:     file.Name,
+			Href:     hrefFor(common.JoinPaths(path, common.UncheckedCreateProtoPath("/"+file.Name))),
+			IsDir:    file.IsDir,
+			Size:     "-",
+			Modified: "-",
+		}
+		if !file.IsDir {
+			entry.Size = fmtListingSize(file.Size)
+		}
+		if file.ModifiedTs != nil {
+			entry.Modified = time.Unix(*file.ModifiedTs, 0).UTC().Format("2006-01-02 15:04:05")
+		}
+		page.Entries[i] = entry
+	}
+
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+	if r.Method == http.MethodHead {
+		return nil
+	}
+	return dirListingTmpl.Execute(w, page)
+}
@@ -0,0 +1,250 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+
+	"friendnet.org/client/room"
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// DuplicateCheckTimeout is how long to wait for a peer to reply with a file's hash when checking for duplicates.
+// Peers only reply with hashes they already know, so this only matters for peers that are slow to reach. If they take
+// longer, the file is treated as having no duplicate.
+const DuplicateCheckTimeout = 5 * time.Second
+
+// hashPollInterval is how often a peer is asked again for a file's hash while it works it out.
+const hashPollInterval = 2 * time.Second
+
+// ErrDuplicateLinkFailed is returned when an existing file cannot be hard linked, such as when the target is on
+// another filesystem.
+var ErrDuplicateLinkFailed = errors.New("failed to hard link existing file")
+
+// recordDownloadedFile records a completed download for duplicate detection.
+func (dm *DownloadManager) recordDownloadedFile(path string, hash []byte, size int64) {
+	if err := dm.storage.PutDownloadedFile(dm.ctx, path, hash, size); err != nil {
+		dm.logger.Error("failed to record downloaded file for duplicate detection",
+			"service", "client.DownloadManager",
+			"path", path,
+			"err", err,
+		)
+	}
+}
+
+// getPeerFileMetaWithHash asks the peer for the metadata of a file, including its SHA-256 hash if the peer knows it.
+// Returns nil if the peer is unreachable, the path is a directory, or it takes longer than timeout.
+func (dm *DownloadManager) getPeerFileMetaWithHash(
+	ctx context.Context,
+	server *Server,
+	peer common.NormalizedUsername,
+	filePath common.ProtoPath,
+	timeout time.Duration,
+) (*pb.MsgFileMeta, error) {
+	type metaResult struct {
+		meta *pb.MsgFileMeta
+		err  error
+	}
+	resChan := make(chan metaResult, 1)
+	go func() {
+		var res metaResult
+		res.err = server.TryDo(func(conn *room.Conn) error {
+			res.meta, res.err = conn.GetVirtualC2cConn(peer, false).GetFileMetaWithHash(filePath)
+			return res.err
+		})
+		resChan <- res
+	}()
+
+	select {
+	case res := <-resChan:
+		if res.err != nil {
+			dm.logger.Debug("could not get file hash from peer",
+				"service", "client.DownloadManager",
+				"server_uuid", server.Uuid,
+				"peer_username", peer.String(),
+				"file_path", filePath.String(),
+				"err", res.err,
+			)
+			return nil, nil
+		}
+		if res.meta.IsDir {
+			return nil, nil
+		}
+		return res.meta, nil
+	case <-time.After(timeout):
+		return nil, nil
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// getPeerSha256 asks the peer for the SHA-256 hash of a file, asking again while the peer works it out.
+// Returns nil if the hash cannot be determined because the peer is unreachable, the path is a directory, the peer does
+// not provide hashes, or it takes longer than timeout.
+func (dm *DownloadManager) getPeerSha256(
+	ctx context.Context,
+	server *Server,
+	peer common.NormalizedUsername,
+	filePath common.ProtoPath,
+	timeout time.Duration,
+) ([]byte, error) {
+	deadline := time.Now().Add(timeout)
+	for {
+		meta, err := dm.getPeerFileMetaWithHash(ctx, server, peer, filePath, time.Until(deadline))
+		if err != nil || meta == nil {
+			return nil, err
+		}
+		if len(meta.Sha256) > 0 {
+			return meta.Sha256, nil
+		}
+		if !meta.Sha256Pending || time.Until(deadline) < hashPollInterval {
+			return nil, nil
+		}
+
+		select {
+		case <-time.After(hashPollInterval):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+// FindDuplicate returns a previously downloaded file with the same content as the specified file on the peer.
+// Files are compared by their size and SHA-256 hash.
+//
+// Local records are checked first, so the peer is not asked at all if nothing was downloaded yet. Peers only provide
+// hashes they already know, and work out unknown ones in the background, so the first check for a file on a peer
+// usually finds no duplicate.
+//
+// Returns nil if there is no such file, or if it cannot be determined because the peer is unreachable, does not know
+// the hash, or takes longer than DuplicateCheckTimeout.
+// Records of downloaded files that were deleted or changed since are forgotten.
+func (dm *DownloadManager) FindDuplicate(
+	ctx context.Context,
+	server *Server,
+	peer common.NormalizedUsername,
+	filePath common.ProtoPath,
+) (*storage.DownloadedFileRecord, error) {
+	has, err := dm.storage.HasDownloadedFiles(ctx)
+	if err != nil || !has {
+		return nil, err
+	}
+
+	meta, err := dm.getPeerFileMetaWithHash(ctx, server, peer, filePath, DuplicateCheckTimeout)
+	if err != nil || meta == nil || len(meta.Sha256) == 0 {
+		return nil, err
+	}
+
+	return dm.findDownloadedFile(ctx, meta.Sha256, int64(meta.Size))
+}
+
+// findDownloadedFile returns a downloaded file with the specified hash and size that still exists unchanged.
+// Returns nil if there is none.
+// Records of downloaded files that were deleted or changed since are forgotten.
+func (dm *DownloadManager) findDownloadedFile(ctx context.Context, sum []byte, size int64) (*storage.DownloadedFileRecord, error) {
+	records, err := dm.storage.GetDownloadedFilesBySha256(ctx, sum)
+	if err != nil {
+		return nil, err
+	}
+	for _, record := range records {
+		if record.Size != size {
+			continue
+		}
+
+		stat, statErr := os.Stat(record.Path)
+		if statErr == nil && stat.Mode().IsRegular() && stat.Size() == record.Size {
+			return &record, nil
+		}
+		if statErr != nil && !errors.Is(statErr, fs.ErrNotExist) {
+			return nil, statErr
+		}
+
+		// The file is gone or was replaced, so it cannot be used anymore.
+		if err = dm.storage.DeleteDownloadedFile(ctx, record.Path); err != nil {
+			return nil, err
+		}
+	}
+
+	return nil, nil
+}
+
+// LinkDuplicate puts an existing file where the specified file on the peer would be saved once downloaded, instead of
+// downloading it. The file is hard linked if copyFile is false, otherwise it is copied.
+// Returns the path of the new file.
+//
+// Returns an error wrapping ErrDuplicateLinkFailed if the file could not be hard linked.
+// Returns an error wrapping fs.ErrExist if a file already exists at the target path.
+func (dm *DownloadManager) LinkDuplicate(
+	ctx context.Context,
+	server *Server,
+	peer common.NormalizedUsername,
+	filePath common.ProtoPath,
+	existing *storage.DownloadedFileRecord,
+	copyFile bool,
+) (string, error) {
+	target, err := dm.mkCompletePath(server, peer, filePath)
+	if err != nil {
+		return "", err
+	}
+	if target == existing.Path {
+		// The file is already where it would be downloaded to.
+		return target, nil
+	}
+
+	dir := filepath.Dir(target)
+	if err = os.MkdirAll(dir, 0755); err != nil {
+		return "", fmt.Errorf(`failed to create directory %q for complete download: %w`, dir, err)
+	}
+
+	if copyFile {
+		err = copyNewFile(existing.Path, target)
+	} else if err = os.Link(existing.Path, target); err != nil && !errors.Is(err, fs.ErrExist) {
+		err = fmt.Errorf(`%w %q to %q: %w`, ErrDuplicateLinkFailed, existing.Path, target, err)
+	}
+	if err != nil {
+		return "", err
+	}
+
+	if err = dm.storage.PutDownloadedFile(ctx, target, existing.Sha256, existing.Size); err != nil {
+		return "", err
+	}
+
+	return target, nil
+}
+
+// copyNewFile copies the file at src to dst, which must not exist yet.
+// If copying fails, dst is removed.
+func copyNewFile(src string, dst string) (err error) {
+	in, err := os.Open(src)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = in.Close()
+	}()
+
+	out, err := os.OpenFile(dst, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0644)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := out.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			_ = os.Remove(dst)
+		}
+	}()
+
+	if _, err = io.Copy(out, in); err != nil {
+		return fmt.Errorf(`failed to copy %q to %q: %w`, src, dst, err)
+	}
+	return out.Sync()
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+)
+
+func TestFindDuplicateChecksLocalRecordsFirst(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := storage.NewStorage(filepath.Join(t.TempDir(), "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	dm := &DownloadManager{
+		logger:  slog.New(slog.DiscardHandler),
+		storage: store,
+	}
+
+	// Without any downloaded files, the peer is not asked. The server would panic if it were used.
+	dupe, err := dm.FindDuplicate(ctx, nil, common.UncheckedCreateNormalizedUsername("peer"), common.UncheckedCreateProtoPath("/file.txt"))
+	if err != nil || dupe != nil {
+		t.Fatalf("expected no duplicate, got %v, %v", dupe, err)
+	}
+
+	content := []byte("downloaded content")
+	sum := sha256.Sum256(content)
+	downloaded := filepath.Join(t.TempDir(), "file.txt")
+	if err = os.WriteFile(downloaded, content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.PutDownloadedFile(ctx, downloaded, sum[:], int64(len(content))); err != nil {
+		t.Fatal(err)
+	}
+
+	// Files only match with the same size as well as hash.
+	if dupe, err = dm.findDownloadedFile(ctx, sum[:], int64(len(content))+1); err != nil || dupe != nil {
+		t.Fatalf("expected no duplicate with another size, got %v, %v", dupe, err)
+	}
+	if dupe, err = dm.findDownloadedFile(ctx, sum[:], int64(len(content))); err != nil || dupe == nil || dupe.Path != downloaded {
+		t.Fatalf("expected %q as the duplicate, got %v, %v", downloaded, dupe, err)
+	}
+
+	// Records of files that are gone are forgotten.
+	if err = os.Remove(downloaded); err != nil {
+		t.Fatal(err)
+	}
+	if dupe, err = dm.findDownloadedFile(ctx, sum[:], int64(len(content))); err != nil || dupe != nil {
+		t.Fatalf("expected no duplicate once the file is gone, got %v, %v", dupe, err)
+	}
+	if has, err := store.HasDownloadedFiles(ctx); err != nil || has {
+		t.Fatalf("expected the record to be forgotten, got %t, %v", has, err)
+	}
+}
@@ -0,0 +1,227 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strconv"
+	"time"
+
+	"friendnet.org/client/storage"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+)
+
+// Download hooks run after a download completes successfully. Global hooks run first, followed by the download's own
+// hooks, one after another in the order they were created. A failing hook is logged and does not stop the hooks after
+// it or affect the download's status.
+
+// DownloadHookCommandTimeout is the maximum amount of time a command hook may run before it is killed.
+// It is generous because hooks like virus scans can take a while on large files.
+const DownloadHookCommandTimeout = 30 * time.Minute
+
+// DownloadHookWebhookTimeout is the maximum amount of time a webhook request may take.
+const DownloadHookWebhookTimeout = 30 * time.Second
+
+// ErrInvalidDownloadHook is returned when a download hook's type or target is invalid.
+var ErrInvalidDownloadHook = errors.New("invalid download hook")
+
+// downloadHookEnvPassthrough is the list of environment variables that command hooks inherit from the client.
+// Everything else in the client's environment is withheld from hooks.
+// These are the variables that most programs need to function at all.
+var downloadHookEnvPassthrough = []string{
+	"PATH",
+	"HOME",
+	"TMPDIR",
+	"TEMP",
+	"TMP",
+	"SYSTEMROOT",
+	"WINDIR",
+	"USERPROFILE",
+}
+
+// downloadHookPayload is the information about a completed download that is passed to hooks.
+type downloadHookPayload struct {
+	Uuid         string `json:"uuid"`
+	ServerUuid   string `json:"server_uuid"`
+	PeerUsername string `json:"peer_username"`
+	FilePath     string `json:"file_path"`
+	LocalPath    string `json:"local_path"`
+	FileSize     int64  `json:"file_size"`
+}
+
+// ValidateDownloadHook validates a download hook type and target.
+// Returns an error wrapping ErrInvalidDownloadHook if invalid.
+func ValidateDownloadHook(hookType v1.DownloadHookType, target string) error {
+	switch hookType {
+	case v1.DownloadHookType_DOWNLOAD_HOOK_TYPE_COMMAND:
+		if !filepath.IsAbs(target) {
+			return fmt.Errorf(`%w: command must be an absolute path`, ErrInvalidDownloadHook)
+		}
+	case v1.DownloadHookType_DOWNLOAD_HOOK_TYPE_WEBHOOK:
+		if err := validateWebhookUrl(target); err != nil {
+			return fmt.Errorf(`%w: %w`, ErrInvalidDownloadHook, err)
+		}
+	default:
+		return fmt.Errorf(`%w: unsupported hook type %s`, ErrInvalidDownloadHook, hookType.String())
+	}
+
+	return nil
+}
+
+// validateWebhookUrl returns an error if target is not an HTTP or HTTPS URL.
+func validateWebhookUrl(target string) error {
+	u, err := url.Parse(target)
+	if err != nil {
+		return fmt.Errorf(`malformed webhook URL: %w`, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("webhook URL must be an HTTP or HTTPS URL")
+	}
+	return nil
+}
+
+// hookEnv returns the sandboxed environment for command hooks.
+func (p *downloadHookPayload) hookEnv() []string {
+	env := make([]string, 0, len(downloadHookEnvPassthrough)+6)
+	for _, key := range downloadHookEnvPassthrough {
+		if val, ok := os.LookupEnv(key); ok {
+			env = append(env, key+"="+val)
+		}
+	}
+
+	return append(env,
+		"FRIENDNET_DOWNLOAD_UUID="+p.Uuid,
+		"FRIENDNET_SERVER_UUID="+p.ServerUuid,
+		"FRIENDNET_PEER_USERNAME="+p.PeerUsername,
+		"FRIENDNET_FILE_PATH="+p.FilePath,
+		"FRIENDNET_LOCAL_PATH="+p.LocalPath,
+		"FRIENDNET_FILE_SIZE="+strconv.FormatInt(p.FileSize, 10),
+	)
+}
+
+func (dm *DownloadManager) runCommandHook(ctx context.Context, target string, payload *downloadHookPayload) error {
+	ctx, cancel := context.WithTimeout(ctx, DownloadHookCommandTimeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, target, payload.LocalPath)
+	cmd.Env = payload.hookEnv()
+	cmd.Dir = filepath.Dir(payload.LocalPath)
+
+	out, err := cmd.CombinedOutput()
+	if err != nil {
+		// Include the tail of the output so failures can be diagnosed from the logs.
+		const maxOut = 1024
+		if len(out) > maxOut {
+			out = out[len(out)-maxOut:]
+		}
+		return fmt.Errorf(`command %q failed: %w (output: %q)`, target, err, string(out))
+	}
+
+	return nil
+}
+
+func (dm *DownloadManager) runWebhook(ctx context.Context, target string, payload *downloadHookPayload) error {
+	ctx, cancel := context.WithTimeout(ctx, DownloadHookWebhookTimeout)
+	defer cancel()
+
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf(`webhook request to %q failed: %w`, target, err)
+	}
+	_ = res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf(`webhook %q returned status %d`, target, res.StatusCode)
+	}
+
+	return nil
+}
+
+// runHooks runs all hooks that apply to a completed download.
+// It blocks until all hooks have finished.
+func (dm *DownloadManager) runHooks(handle *DownloadHandle, localPath string) {
+	hooks, err := dm.storage.GetDownloadHooksForDownload(dm.ctx, handle.uuid)
+	if err != nil {
+		dm.logger.Error("failed to get download hooks",
+			"service", "client.DownloadManager",
+			"uuid", handle.uuid,
+			"err", err,
+		)
+		return
+	}
+
+	payload := &downloadHookPayload{
+		Uuid:         handle.uuid,
+		ServerUuid:   handle.server.Uuid,
+		PeerUsername: handle.peer.String(),
+		FilePath:     handle.filePath.String(),
+		LocalPath:    localPath,
+		FileSize:     handle.fileTotalSize.Load(),
+	}
+
+	for _, hook := range hooks {
+		switch hook.Type {
+		case v1.DownloadHookType_DOWNLOAD_HOOK_TYPE_COMMAND:
+			err = dm.runCommandHook(dm.ctx, hook.Target, payload)
+		case v1.DownloadHookType_DOWNLOAD_HOOK_TYPE_WEBHOOK:
+			err = dm.runWebhook(dm.ctx, hook.Target, payload)
+		default:
+			err = fmt.Errorf(`unsupported hook type %s`, hook.Type.String())
+		}
+
+		if err != nil {
+			dm.logger.Error("download hook failed",
+				"service", "client.DownloadManager",
+				"uuid", handle.uuid,
+				"hook_uuid", hook.Uuid,
+				"err", err,
+			)
+		}
+	}
+}
+
+// CreateHook creates a new download hook.
+// If downloadUuid is nil, the hook applies to all downloads.
+// Returns an error wrapping ErrInvalidDownloadHook if the hook is invalid, or false if downloadUuid was specified but
+// no such download exists.
+func (dm *DownloadManager) CreateHook(
+	ctx context.Context,
+	hookType v1.DownloadHookType,
+	target string,
+	downloadUuid *string,
+) (record storage.DownloadHookRecord, found bool, err error) {
+	if err = ValidateDownloadHook(hookType, target); err != nil {
+		return record, true, err
+	}
+
+	if downloadUuid != nil {
+		if _, has := dm.getByUuid(*downloadUuid); !has {
+			return record, false, nil
+		}
+	}
+
+	record, err = dm.storage.CreateDownloadHook(ctx, hookType, target, downloadUuid)
+	if err != nil {
+		return record, true, err
+	}
+
+	return record, true, nil
+}
@@ -30,6 +30,7 @@ import (
 	"log/slog"
 	"os"
 	"path/filepath"
+	"runtime/debug"
 	"slices"
 	"sync"
 	"sync/atomic"
@@ -44,6 +45,7 @@ import (
 	v1 "friendnet.org/protocol/pb/clientrpc/v1"
 	pb "friendnet.org/protocol/pb/v1"
 	"github.com/google/uuid"
+	"github.com/quic-go/quic-go"
 )
 
 // DmDirIncompleteSetting is the setting key for the download manager's incomplete download directory.
@@ -77,6 +79,23 @@ func (u *dmUpdate) ToProto() *pb.MsgDownloadStatusUpdate {
 var errHandleStopped = errors.New("handle stopped")
 var errIsDir = errors.New("is a directory")
 
+// maxChecksumFailures is how many times a download is retried after receiving a corrupt chunk before it fails.
+const maxChecksumFailures = 3
+
+// maxResumeAttempts is how many times in a row a download is resumed after its transfer is interrupted without
+// receiving any data before it fails.
+const maxResumeAttempts = 10
+
+// resumeBackoff is how long to wait before resuming a download after its transfer is interrupted.
+// It doubles with each attempt in a row, up to maxResumeBackoff.
+const resumeBackoff = 2 * time.Second
+
+// maxResumeBackoff is the longest time to wait before resuming a download after its transfer is interrupted.
+const maxResumeBackoff = 2 * time.Minute
+
+// ErrDownloadNotPausable is returned when trying to pause a download that is already done, canceled or failed.
+var ErrDownloadNotPausable = errors.New("download cannot be paused")
+
 // DownloadHandle is a handle for a download.
 type DownloadHandle struct {
 	dm *DownloadManager
@@ -110,6 +129,56 @@ type DownloadHandle struct {
 
 	// The download error message, if any.
 	errorMessage atomic.Pointer[string]
+
+	// The path of the file the download is written to until it is complete, or nil if it was not started yet.
+	partPath atomic.Pointer[string]
+
+	// Blocks reading from the in-flight transfer while the download is paused.
+	pauseGate common.PauseGate
+
+	// The in-flight transfer, if any.
+	transferOrNil atomic.Pointer[room.FileTransfer]
+
+	// The number of times the download received a chunk that did not match its checksum.
+	checksumFailures atomic.Int32
+
+	// The number of times in a row the download's transfer was interrupted without receiving any data.
+	resumeAttempts atomic.Int32
+
+	// The UNIX timestamp in nanoseconds before which the queued download is not resumed after an interrupted transfer.
+	resumeAfter atomic.Int64
+
+	// The result of scanning the completed download, a v1.ScanStatus.
+	scanStatus atomic.Int32
+}
+
+// scheduleResume delays resuming the download after its transfer was interrupted, backing off further each time in
+// a row it was interrupted without receiving any data.
+// Returns false if it was interrupted too many times in a row and should fail instead.
+func (h *DownloadHandle) scheduleResume(receivedData bool) bool {
+	if receivedData {
+		h.resumeAttempts.Store(0)
+	}
+
+	attempts := h.resumeAttempts.Add(1)
+	if attempts > maxResumeAttempts {
+		h.resumeAttempts.Store(0)
+		return false
+	}
+
+	backoff := min(resumeBackoff<<(attempts-1), maxResumeBackoff)
+	h.resumeAfter.Store(time.Now().Add(backoff).UnixNano())
+	return true
+}
+
+// isTransferInterrupted returns whether a download failed because its connection to the peer or server was lost,
+// so that it can continue from where it stopped.
+func isTransferInterrupted(err error) bool {
+	if protocol.IsErrorConnCloseOrCancel(err) {
+		return true
+	}
+	_, isStreamErr := errors.AsType[*quic.StreamError](err)
+	return isStreamErr
 }
 
 // DownloadManager manages downloads across multiple servers.
@@ -117,11 +186,20 @@ type DownloadHandle struct {
 // comes back later.
 // It is designed to work similarly to the download manager in Nicotine+.
 //
-// In the completed folder, the directory structure is as follows:
+// In the completed folder, paths are made from the template in DmPathTemplateSetting. By default, the directory
+// structure is as follows:
 // `/<peer username>-<server UUID>/<peer path>...`
 //
 // So if you download "/music/song.mp3" from "jimmy" on server "abcd1234", the file will be saved at path:
 // `/jimmy-abcd1234/music/song.mp3`
+//
+// Each server can have its own completed folder, set in DmDirCompleteServersSetting.
+//
+// While a download is in progress, it is written to a file with PartFileSuffix next to where it will be saved, or in
+// the incomplete folder if DmPartFilesInIncompleteDirSetting is set. The incomplete folder always uses the default
+// structure. Once the download is complete, the file is synced to disk, verified against the peer's hash, scanned
+// with the command in DmScanCommandSetting if one is set, and renamed to its final path, so a file without the suffix
+// is never partial or corrupt.
 type DownloadManager struct {
 	mu       sync.RWMutex
 	isClosed bool
@@ -137,16 +215,26 @@ type DownloadManager struct {
 	multi    *MultiClient
 	eventBus *event.Bus
 	storage  *storage.Storage
+	notifier *Notifier
 
 	dirIncomplete string
 	dirComplete   string
+	dirQuarantine string
 
 	handles []*DownloadHandle
 
+	// Downloads that were interrupted when the client last stopped, and were queued again on start.
+	// Cleared once reported.
+	recovered []*v1.RecoveredDownload
+
 	// A queue of pending download progress events to send to the event bus.
 	// It is buffered, but sends should be discarded if the buffer is full instead of blocking.
 	pendingUpdates chan dmUpdate
 
+	// Updates taken from pendingUpdates that are waiting to be sent as a batch.
+	batchMu sync.Mutex
+	batch   []dmUpdate
+
 	// The current number of active workers.
 	activeWorkers atomic.Int64
 }
@@ -157,6 +245,7 @@ func NewDownloadManager(
 	multi *MultiClient,
 	eventBus *event.Bus,
 	storage *storage.Storage,
+	notifier *Notifier,
 ) (*DownloadManager, error) {
 	ctx, ctxCancel := context.WithCancel(context.Background())
 
@@ -169,6 +258,7 @@ func NewDownloadManager(
 	defDlBaseDir := filepath.Join(homeDir, "Downloads", "FriendNet Downloads")
 	defDlIncomplete := filepath.Join(defDlBaseDir, "Incomplete")
 	defDlComplete := filepath.Join(defDlBaseDir, "Complete")
+	defDlQuarantine := filepath.Join(defDlBaseDir, "Quarantine")
 
 	// Get settings.
 	dirIncomplete, err := storage.GetSettingOrPut(ctx, DmDirIncompleteSetting, defDlIncomplete)
@@ -181,6 +271,11 @@ func NewDownloadManager(
 		ctxCancel()
 		return nil, err
 	}
+	dirQuarantine, err := storage.GetSettingOrPut(ctx, DmDirQuarantineSetting, defDlQuarantine)
+	if err != nil {
+		ctxCancel()
+		return nil, err
+	}
 
 	// Get filename replacers for paths.
 	incompleteFnReplacer, err := fsys.GetFilenameReplacerForPath(dirIncomplete)
@@ -206,9 +301,11 @@ func NewDownloadManager(
 		multi:    multi,
 		eventBus: eventBus,
 		storage:  storage,
+		notifier: notifier,
 
 		dirIncomplete: dirIncomplete,
 		dirComplete:   dirComplete,
+		dirQuarantine: dirQuarantine,
 
 		handles: nil,
 
@@ -243,20 +340,25 @@ func NewDownloadManager(
 		state.fileTotalSize.Store(rec.FileTotalSize)
 		state.fileDownloadedBytes.Store(uint64(rec.FileDownloadedBytes))
 		state.errorMessage.Store(rec.Error)
+		state.partPath.Store(rec.PartPath)
+		state.scanStatus.Store(int32(rec.ScanStatus))
 
 		states = append(states, &state)
 	}
 
 	dm.handles = states
+	dm.recoverInterrupted()
 
-	go dm.downloader()
-	go dm.updateDrainer()
+	common.Supervise(ctx, logger, "client.DownloadManager.downloader", dm.downloader)
+	common.Supervise(ctx, logger, "client.DownloadManager.updateDrainer", dm.updateDrainer)
+	common.Supervise(ctx, logger, "client.DownloadManager.updateBatcher", dm.updateBatcher)
 
 	return dm, nil
 }
 
 func (dm *DownloadManager) downloader() {
 	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
 
 	for {
 		select {
@@ -272,10 +374,16 @@ func (dm *DownloadManager) downloader() {
 					"err", settingErr,
 				)
 			}
+			dlConcurrency = dm.multi.MemoryBudget().DownloadConcurrency(dlConcurrency)
 			if dlConcurrency < 1 {
 				dlConcurrency = 1
 			}
 
+			// Queued downloads wait until the snooze ends.
+			if dm.multi.Snoozer().State().IsSnoozed() {
+				continue
+			}
+
 			dm.mu.RLock()
 
 			launched := dm.activeWorkers.Load()
@@ -285,8 +393,13 @@ func (dm *DownloadManager) downloader() {
 				}
 
 				if *state.status.Load() == pb.DownloadStatus_DOWNLOAD_STATUS_QUEUED {
+					// Interrupted downloads resume once the server is connected again and their backoff is over.
+					if state.server.State() != ConnStateOpen || time.Now().UnixNano() < state.resumeAfter.Load() {
+						continue
+					}
+
 					go func() {
-						dlErr := dm.startDownload(state)
+						dlErr := dm.runWorker(state)
 						if dlErr != nil {
 							dm.logger.Error("failed to download queued file",
 								"service", "client.DownloadManager",
@@ -306,123 +419,121 @@ func (dm *DownloadManager) downloader() {
 	}
 }
 
+// updateDrainer takes pending updates and adds them to the batch sent by updateBatcher.
 func (dm *DownloadManager) updateDrainer() {
-	var mu sync.Mutex
-	buf := make([]dmUpdate, 0)
-
-	go func() {
-		// Goroutine that batches updates.
-
-		ticker := time.NewTicker(1 * time.Second)
+	for {
+		select {
+		case <-dm.ctx.Done():
+			return
+		case upd := <-dm.pendingUpdates:
+			dm.batchMu.Lock()
+			dm.batch = append(dm.batch, upd)
+			dm.batchMu.Unlock()
+		}
+	}
+}
 
-		for {
-			select {
-			case <-dm.ctx.Done():
-				return
-			case <-ticker.C:
-				var updates []dmUpdate
-				mu.Lock()
-				if len(buf) == 0 {
-					mu.Unlock()
-					continue
-				}
-				updates = make([]dmUpdate, len(buf))
-				copy(updates, buf)
-				buf = buf[:0]
-				mu.Unlock()
-
-				// Sort updates by server UUID.
-				byServer := make(map[string][]dmUpdate)
-				for _, upd := range updates {
-					byServer[upd.ds.server.Uuid] = append(byServer[upd.ds.server.Uuid], upd)
-				}
+// updateBatcher periodically sends batched updates to the event bus and peers, and writes them to the database.
+func (dm *DownloadManager) updateBatcher() {
+	ticker := time.NewTicker(1 * time.Second)
+	defer ticker.Stop()
 
-				// Send batched client RPC messages.
-				for server, upds := range byServer {
-					pub := dm.eventBus.CreatePublisher(&v1.EventContext{
-						ServerUuid: server,
-					})
+	for {
+		select {
+		case <-dm.ctx.Done():
+			return
+		case <-ticker.C:
+			var updates []dmUpdate
+			dm.batchMu.Lock()
+			if len(dm.batch) == 0 {
+				dm.batchMu.Unlock()
+				continue
+			}
+			updates = make([]dmUpdate, len(dm.batch))
+			copy(updates, dm.batch)
+			dm.batch = dm.batch[:0]
+			dm.batchMu.Unlock()
+
+			// Sort updates by server UUID.
+			byServer := make(map[string][]dmUpdate)
+			for _, upd := range updates {
+				byServer[upd.ds.server.Uuid] = append(byServer[upd.ds.server.Uuid], upd)
+			}
 
-					files := make([]*v1.DownloadStatusUpdate, len(upds))
-					for i, upd := range upds {
-						files[i] = upd.rpc
-					}
+			// Send batched client RPC messages.
+			for server, upds := range byServer {
+				pub := dm.eventBus.CreatePublisher(&v1.EventContext{
+					ServerUuid: server,
+				})
 
-					pub.Publish(&v1.Event{
-						Type: v1.Event_TYPE_DOWNLOAD_STATUS_UPDATES,
-						DownloadStatusUpdates: &v1.Event_DownloadStatusUpdates{
-							Files: files,
-						},
-					})
+				files := make([]*v1.DownloadStatusUpdate, len(upds))
+				for i, upd := range upds {
+					files[i] = upd.rpc
 				}
 
-				// Send batched peer notifications.
-				for _, serverUpds := range byServer {
-					server := serverUpds[0].ds.server
+				pub.Publish(&v1.Event{
+					Type: v1.Event_TYPE_DOWNLOAD_STATUS_UPDATES,
+					DownloadStatusUpdates: &v1.Event_DownloadStatusUpdates{
+						Files: files,
+					},
+				})
+			}
 
-					_ = server.TryDo(func(conn *room.Conn) error {
-						// Sort by peer.
-						byPeer := make(map[common.NormalizedUsername][]dmUpdate)
-						for _, upd := range serverUpds {
-							byPeer[upd.ds.peer] = append(byPeer[upd.ds.peer], upd)
-						}
+			// Send batched peer notifications.
+			for _, serverUpds := range byServer {
+				server := serverUpds[0].ds.server
 
-						// Send updates to peers.
-						for username, upds := range byPeer {
-							peer := conn.GetVirtualC2cConn(username, false)
-
-							go func() {
-								bidi, err := peer.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_DOWNLOAD_STATUS_UPDATE, upds[0].ToProto())
-								if err != nil {
-									return
-								}
-								defer func() {
-									_ = bidi.Close()
-								}()
-
-								for _, upd := range upds[1:] {
-									_ = bidi.Write(pb.MsgType_MSG_TYPE_DOWNLOAD_STATUS_UPDATE, upd.ToProto())
-								}
+				_ = server.TryDo(func(conn *room.Conn) error {
+					// Sort by peer.
+					byPeer := make(map[common.NormalizedUsername][]dmUpdate)
+					for _, upd := range serverUpds {
+						byPeer[upd.ds.peer] = append(byPeer[upd.ds.peer], upd)
+					}
+
+					// Send updates to peers.
+					for username, upds := range byPeer {
+						peer := conn.GetVirtualC2cConn(username, false)
+
+						go func() {
+							bidi, err := peer.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_DOWNLOAD_STATUS_UPDATE, upds[0].ToProto())
+							if err != nil {
+								return
+							}
+							defer func() {
+								_ = bidi.Close()
 							}()
-						}
 
-						return nil
-					})
-				}
+							for _, upd := range upds[1:] {
+								_ = bidi.Write(pb.MsgType_MSG_TYPE_DOWNLOAD_STATUS_UPDATE, upd.ToProto())
+							}
+						}()
+					}
 
-				// Write to DB.
-				for _, upd := range updates {
-					err := dm.storage.UpdateDownloadState(
-						upd.ds.uuid,
-						*upd.ds.status.Load(),
-						upd.ds.fileTotalSize.Load(),
-						int64(upd.ds.fileDownloadedBytes.Load()),
-						upd.ds.errorMessage.Load(),
+					return nil
+				})
+			}
+
+			// Write to DB.
+			for _, upd := range updates {
+				err := dm.storage.UpdateDownloadState(
+					upd.ds.uuid,
+					*upd.ds.status.Load(),
+					upd.ds.fileTotalSize.Load(),
+					int64(upd.ds.fileDownloadedBytes.Load()),
+					upd.ds.errorMessage.Load(),
+				)
+				if err != nil {
+					dm.logger.Error("failed to update download state in database",
+						"service", "client.DownloadManager",
+						"uuid", upd.ds.uuid,
+						"status", upd.ds.status.Load().String(),
+						"fileTotalSize", upd.ds.fileTotalSize.Load(),
+						"fileDownloadedBytes", upd.ds.fileDownloadedBytes.Load(),
+						"errorStr", upd.ds.errorMessage.Load(),
 					)
-					if err != nil {
-						dm.logger.Error("failed to update download state in database",
-							"service", "client.DownloadManager",
-							"uuid", upd.ds.uuid,
-							"status", upd.ds.status.Load().String(),
-							"fileTotalSize", upd.ds.fileTotalSize.Load(),
-							"fileDownloadedBytes", upd.ds.fileDownloadedBytes.Load(),
-							"errorStr", upd.ds.errorMessage.Load(),
-						)
-					}
 				}
 			}
 		}
-	}()
-
-	for {
-		select {
-		case <-dm.ctx.Done():
-			return
-		case upd := <-dm.pendingUpdates:
-			mu.Lock()
-			buf = append(buf, upd)
-			mu.Unlock()
-		}
 	}
 }
 
@@ -456,6 +567,7 @@ func (dm *DownloadManager) SnapshotStates() []*v1.DownloadManagerItem {
 				Downloaded:   state.fileDownloadedBytes.Load(),
 				FileSize:     state.fileTotalSize.Load(),
 				ErrorMessage: state.errorMessage.Load(),
+				ScanStatus:   v1.ScanStatus(state.scanStatus.Load()),
 			},
 		}
 	}
@@ -603,11 +715,53 @@ func (dm *DownloadManager) StopWithStatus(uuid string, status pb.DownloadStatus)
 
 	if fnPtr != nil {
 		(*fnPtr)(status)
+	} else if *handle.status.Load() == pb.DownloadStatus_DOWNLOAD_STATUS_PAUSED {
+		// Paused downloads that are not in progress have no stop function, so update the status directly.
+		handle.status.Store(&status)
+		dm.sendHandleUpdate(handle)
 	}
 
 	return true
 }
 
+// Pause pauses the download for the item with the specified UUID.
+// If the download is in progress, its transfer is paused without closing the stream, so it can continue where it
+// stopped when resumed with DownloadNow. If it is queued, it will not be started until it is resumed.
+// Returns false if the item did not exist.
+// Returns ErrDownloadNotPausable if the download is already done, canceled or failed.
+func (dm *DownloadManager) Pause(uuid string) (bool, error) {
+	handle, has := dm.getByUuid(uuid)
+	if !has {
+		return false, nil
+	}
+
+	switch *handle.status.Load() {
+	case pb.DownloadStatus_DOWNLOAD_STATUS_PAUSED:
+		return true, nil
+	case pb.DownloadStatus_DOWNLOAD_STATUS_QUEUED, pb.DownloadStatus_DOWNLOAD_STATUS_PENDING:
+	default:
+		return true, ErrDownloadNotPausable
+	}
+
+	handle.pauseGate.Pause()
+	handle.status.Store(new(pb.DownloadStatus_DOWNLOAD_STATUS_PAUSED))
+
+	if transfer := handle.transferOrNil.Load(); transfer != nil {
+		// Reads are already blocked by the gate, so this only saves the peer from sending data we will not read yet.
+		if err := transfer.Pause(); err != nil {
+			dm.logger.Warn("failed to ask peer to pause transfer",
+				"service", "client.DownloadManager",
+				"uuid", uuid,
+				"err", err,
+			)
+		}
+	}
+
+	dm.sendHandleUpdate(handle)
+
+	return true, nil
+}
+
 // DownloadNow starts or resumes the download for the item with the specified UUID.
 // Returns true if the item existed, or false otherwise.
 // The download is launched and managed in the background.
@@ -617,8 +771,25 @@ func (dm *DownloadManager) DownloadNow(uuid string) bool {
 		return false
 	}
 
+	// If the download was paused mid-transfer, continue the existing transfer instead of starting a new one.
+	if transfer := handle.transferOrNil.Load(); transfer != nil && *handle.status.Load() == pb.DownloadStatus_DOWNLOAD_STATUS_PAUSED {
+		handle.status.Store(new(pb.DownloadStatus_DOWNLOAD_STATUS_PENDING))
+		if err := transfer.Resume(); err != nil {
+			dm.logger.Warn("failed to ask peer to resume transfer",
+				"service", "client.DownloadManager",
+				"uuid", uuid,
+				"err", err,
+			)
+		}
+		handle.pauseGate.Resume()
+
+		dm.sendHandleUpdate(handle)
+
+		return true
+	}
+
 	go func() {
-		if err := dm.startDownload(handle); err != nil {
+		if err := dm.runWorker(handle); err != nil {
 			dm.logger.Error("failed to do download",
 				"service", "client.DownloadManager",
 				"uuid", uuid,
@@ -636,11 +807,32 @@ func (dm *DownloadManager) mkIncompletePath(serverUuid string, peerUsername comm
 		dm.incompleteFnReplacer.ReplacePath(filepath.Join(peerUsername.String()+"-"+serverUuid, path.String())),
 	)
 }
-func (dm *DownloadManager) mkCompletePath(serverUuid string, peerUsername common.NormalizedUsername, path common.ProtoPath) string {
-	return filepath.Join(
-		dm.dirComplete,
-		dm.completeFnReplacer.ReplacePath(filepath.Join(peerUsername.String()+"-"+serverUuid, path.String())),
-	)
+
+// mkCompletePath creates the path a download is moved to once it is complete, using the current settings.
+func (dm *DownloadManager) mkCompletePath(server *Server, peerUsername common.NormalizedUsername, path common.ProtoPath) (string, error) {
+	tmpl, err := dm.storage.GetSettingOr(dm.ctx, DmPathTemplateSetting, DefaultDownloadPathTemplate)
+	if err != nil {
+		return "", err
+	}
+	serverDirs, err := getServerCompleteDirs(dm.ctx, dm.storage)
+	if err != nil {
+		return "", err
+	}
+
+	dir := dm.dirComplete
+	replacer := dm.completeFnReplacer
+	if serverDir, has := serverDirs[server.Uuid]; has {
+		dir = serverDir
+		if replacer, err = fsys.GetFilenameReplacerForPath(dir); err != nil {
+			return "", fmt.Errorf(`failed to get filename replacer for complete downloads directory %q: %w`, dir, err)
+		}
+	}
+
+	return mkDownloadPath(dir, tmpl, replacer, downloadPathVars{
+		server: server,
+		peer:   peerUsername,
+		path:   path,
+	})
 }
 
 func (dm *DownloadManager) trySendUpdate(update dmUpdate) {
@@ -650,6 +842,47 @@ func (dm *DownloadManager) trySendUpdate(update dmUpdate) {
 	}
 }
 
+// sendHandleUpdate sends an update with the handle's current state.
+func (dm *DownloadManager) sendHandleUpdate(handle *DownloadHandle) {
+	dm.trySendUpdate(dmUpdate{
+		rpc: &v1.DownloadStatusUpdate{
+			Uuid:         handle.uuid,
+			Status:       v1.DownloadStatus(*handle.status.Load()),
+			Downloaded:   handle.fileDownloadedBytes.Load(),
+			FileSize:     handle.fileTotalSize.Load(),
+			Speed:        0,
+			ErrorMessage: handle.errorMessage.Load(),
+			ScanStatus:   v1.ScanStatus(handle.scanStatus.Load()),
+		},
+		ds: handle,
+	})
+}
+
+// runWorker downloads the file of a handle like startDownload.
+// If the download panics, the panic is logged and the download fails with an error, instead of taking down the client.
+func (dm *DownloadManager) runWorker(handle *DownloadHandle) (err error) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			dm.logger.Error("panic in download worker",
+				"service", "client.DownloadManager",
+				"uuid", handle.uuid,
+				"err", rec,
+				"stack", string(debug.Stack()),
+			)
+
+			errMsg := fmt.Sprintf("internal error: %v", rec)
+			handle.stopFnOrNil.Store(nil)
+			handle.status.Store(new(pb.DownloadStatus_DOWNLOAD_STATUS_ERROR))
+			handle.errorMessage.Store(&errMsg)
+			dm.sendHandleUpdate(handle)
+
+			err = errors.New(errMsg)
+		}
+	}()
+
+	return dm.startDownload(handle)
+}
+
 func (dm *DownloadManager) startDownload(handle *DownloadHandle) error {
 	dm.activeWorkers.Add(1)
 	defer dm.activeWorkers.Add(-1)
@@ -663,183 +896,34 @@ func (dm *DownloadManager) startDownload(handle *DownloadHandle) error {
 	}
 
 	handle.status.Store(new(pb.DownloadStatus_DOWNLOAD_STATUS_PENDING))
+	handle.pauseGate.Resume()
 
-	// Create paths.
-	incompletePath := dm.mkIncompletePath(handle.server.Uuid, handle.peer, handle.filePath)
-	completePath := dm.mkCompletePath(handle.server.Uuid, handle.peer, handle.filePath)
-	dir := filepath.Dir(incompletePath)
-	mkErr := os.MkdirAll(dir, 0755)
-	if mkErr != nil {
-		return fmt.Errorf(`failed to create directory %q for incomplete download: %w`, dir, mkErr)
-	}
-	dir = filepath.Dir(completePath)
-	mkErr = os.MkdirAll(dir, 0755)
-	if mkErr != nil {
-		return fmt.Errorf(`failed to create directory %q for complete download: %w`, dir, mkErr)
+	// Open the partial file, continuing from what it already holds.
+	partPath, finalErr := dm.partPathFor(handle)
+	var part *partFile
+	if finalErr == nil {
+		part, finalErr = openPartFile(partPath, handle.fileDownloadedBytes.Load())
 	}
+	var startBytes uint64
+	if finalErr == nil {
+		handle.fileDownloadedBytes.Store(part.offset)
+		startBytes = part.offset
 
-	// Use TryDo because we want to fail fast if there is not an open connection.
-	finalErr := handle.server.TryDo(func(conn *room.Conn) error {
-		peer := conn.GetVirtualC2cConn(handle.peer, false)
-
-		initialDownloaded := handle.fileDownloadedBytes.Load()
-
-		meta, reader, err := peer.GetFile(&pb.MsgGetFile{
-			Path:   handle.filePath.String(),
-			Offset: initialDownloaded,
+		// Use TryDo because we want to fail fast if there is not an open connection.
+		finalErr = handle.server.TryDo(func(conn *room.Conn) error {
+			return dm.transfer(handle, conn, part)
 		})
-		if err != nil {
-			if protoErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok {
-				if protoErr.Msg.Type == pb.ErrType_ERR_TYPE_FILE_NOT_EXIST {
-					return err
-				}
-			}
-
-			return err
-		}
-		defer func() {
-			_ = reader.Close()
-		}()
-
-		if meta.IsDir {
-			// Crawl and queue directory contents in background.
-			go func() {
-				walkErr := WalkPeerPath(peer, handle.filePath, func(path common.ProtoPath, meta *pb.MsgFileMeta) bool {
-					if meta.IsDir {
-						return true
-					}
-
-					queueErr := dm.Queue(handle.server, handle.peer, path)
-					if queueErr != nil {
-						dm.logger.Error("failed to queue file while walking directory",
-							"service", "client.DownloadManager",
-							"server_uuid", handle.server.Uuid,
-							"peer_username", handle.peer.String(),
-							"dir_path", handle.filePath.String(),
-							"file_path", path.String(),
-							"error", queueErr,
-						)
-						return false
-					}
-
-					return true
-				})
-				if walkErr != nil {
-					dm.logger.Error("failed to walk directory contents",
-						"service", "client.DownloadManager",
-						"server_uuid", handle.server.Uuid,
-						"peer_username", handle.peer.String(),
-						"path", handle.filePath.String(),
-						"error", walkErr,
-					)
-				}
-			}()
-
-			return errIsDir
-		}
+	}
 
-		var fileTotalSize uint64
-		if loaded := handle.fileTotalSize.Load(); loaded > -1 {
-			fileTotalSize = uint64(loaded)
+	// Sync the file so that it is fully on disk before it is renamed, and get its hash.
+	var sum []byte
+	if part != nil {
+		if finalErr == nil {
+			sum, finalErr = part.finish()
 		} else {
-			handle.fileTotalSize.Store(int64(meta.Size))
-			fileTotalSize = meta.Size
-		}
-
-		if meta.Size != fileTotalSize {
-			return errors.New("file size different; file has changed")
-		}
-
-		// We have a working stream.
-		// Open file.
-		file, err := os.OpenFile(incompletePath, os.O_WRONLY|os.O_CREATE, 0644)
-		if err != nil {
-			return fmt.Errorf(`failed to open file %q for pending download: %w`, incompletePath, err)
-		}
-		defer func() {
-			_ = file.Close()
-		}()
-
-		// If necessary, seek in the file to the current progress.
-		if initialDownloaded > 0 {
-			_, err = file.Seek(int64(initialDownloaded), io.SeekStart)
-			if err != nil {
-				return fmt.Errorf(`failed to seek in file %q to byte %d to resume pending download: %w`, incompletePath, initialDownloaded, err)
-			}
+			_ = part.Close()
 		}
-
-		ctx, cancel := context.WithCancel(dm.ctx)
-		defer cancel()
-
-		// Dump statistics in event channel every second.
-		go func() {
-			ticker := time.NewTicker(1 * time.Second)
-
-			lastBytes := initialDownloaded
-
-			for {
-				select {
-				case <-ctx.Done():
-					return
-				case <-ticker.C:
-					newBytes := handle.fileDownloadedBytes.Load()
-					speed := newBytes - lastBytes
-
-					dm.trySendUpdate(dmUpdate{
-						rpc: &v1.DownloadStatusUpdate{
-							Uuid:         handle.uuid,
-							Status:       v1.DownloadStatus_DOWNLOAD_STATUS_PENDING,
-							Downloaded:   newBytes,
-							FileSize:     int64(meta.Size),
-							Speed:        speed,
-							ErrorMessage: nil,
-						},
-						ds: handle,
-					})
-
-					lastBytes = newBytes
-				}
-			}
-		}()
-
-		endChan := make(chan error, 2)
-		shouldDl := true
-
-		// Set stopper function.
-		handle.stopFnOrNil.Store(new(func(status pb.DownloadStatus) {
-			if !shouldDl {
-				return
-			}
-			endChan <- errHandleStopped
-			shouldDl = false
-			handle.stopFnOrNil.Store(nil)
-			handle.status.Store(&status)
-		}))
-
-		go func() {
-			endChan <- func() error {
-				buf := make([]byte, 512*1024)
-				for shouldDl {
-					var n int
-					n, err = reader.Read(buf)
-					handle.fileDownloadedBytes.Store(handle.fileDownloadedBytes.Load() + uint64(n))
-					isEof := errors.Is(err, io.EOF)
-					if err != nil && !isEof {
-						return fmt.Errorf(`failed to read from peer %q to file %q: %w`, handle.peer.String(), incompletePath, err)
-					}
-					if _, err = file.Write(buf[:n]); err != nil {
-						return fmt.Errorf(`failed to write to file %q: %w`, incompletePath, err)
-					}
-					if isEof {
-						break
-					}
-				}
-				return nil
-			}()
-		}()
-
-		return <-endChan
-	})
+	}
 
 	fileTotalSize := handle.fileTotalSize.Load()
 	finalBytes := handle.fileDownloadedBytes.Load()
@@ -853,6 +937,7 @@ func (dm *DownloadManager) startDownload(handle *DownloadHandle) error {
 				FileSize:     fileTotalSize,
 				Speed:        0,
 				ErrorMessage: errMsg,
+				ScanStatus:   v1.ScanStatus(handle.scanStatus.Load()),
 			},
 			ds: handle,
 		})
@@ -862,8 +947,7 @@ func (dm *DownloadManager) startDownload(handle *DownloadHandle) error {
 	if finalErr == nil && finalBytes != uint64(fileTotalSize) {
 		// Final downloaded size did not match the total size.
 		// Before setting the error, delete the pending file.
-
-		_ = os.Remove(incompletePath)
+		dm.discardPartFile(handle, partPath)
 
 		finalErr = fmt.Errorf(`finished downloading file %q from peer %q on server %q but its final size was %d/%d bytes`,
 			handle.filePath.String(),
@@ -874,14 +958,54 @@ func (dm *DownloadManager) startDownload(handle *DownloadHandle) error {
 		)
 	}
 
+	// If no error, make sure the file is what the peer has.
+	if finalErr == nil {
+		if finalErr = dm.verifyDownload(handle, sum); errors.Is(finalErr, ErrHashMismatch) {
+			dm.discardPartFile(handle, partPath)
+		}
+	}
+
+	// If no error, scan the file before it is moved to where it can be opened.
+	if finalErr == nil {
+		var scanStatus v1.ScanStatus
+		scanStatus, finalErr = dm.scanDownload(handle, partPath)
+		if scanStatus != v1.ScanStatus(handle.scanStatus.Load()) {
+			dm.setScanStatus(handle, scanStatus)
+		}
+		if scanStatus == v1.ScanStatus_SCAN_STATUS_INFECTED {
+			if quarantinePath, err := dm.quarantineDownload(handle, partPath); err != nil {
+				finalErr = fmt.Errorf(`%w, but it could not be quarantined: %w`, finalErr, err)
+			} else {
+				dm.forgetPartFile(handle)
+				finalErr = fmt.Errorf(`%w to %q: %w`, ErrDownloadQuarantined, quarantinePath, finalErr)
+			}
+		}
+	}
+
 	// If no error, move file to final destination and set error if failed.
+	// The final path is decided now so that it reflects the current settings.
+	var completePath string
 	if finalErr == nil {
-		finalErr = os.Rename(incompletePath, completePath)
+		completePath, finalErr = dm.mkCompletePath(handle.server, handle.peer, handle.filePath)
+	}
+	if finalErr == nil {
+		dir := filepath.Dir(completePath)
+		if finalErr = os.MkdirAll(dir, 0755); finalErr != nil {
+			finalErr = fmt.Errorf(`failed to create directory %q for complete download: %w`, dir, finalErr)
+		}
+	}
+	if finalErr == nil {
+		if finalErr = moveFile(partPath, completePath); finalErr == nil {
+			dm.forgetPartFile(handle)
+		}
 	}
 
 	// Check error.
 	if finalErr != nil {
 		if errors.Is(finalErr, errIsDir) {
+			// Directories are not downloaded, so the partial file is not needed.
+			_ = os.Remove(partPath)
+
 			// The handle was already removed.
 			// Remove handle, since we can't download directories themselves.
 			if _, err := dm.Remove(handle.uuid); err != nil {
@@ -905,6 +1029,12 @@ func (dm *DownloadManager) startDownload(handle *DownloadHandle) error {
 			trySendUpdate(v1.DownloadStatus_DOWNLOAD_STATUS_QUEUED, nil)
 			return nil
 		}
+		if _, ok := errors.AsType[protocol.ChunkChecksumMismatchError](finalErr); ok && handle.checksumFailures.Add(1) < maxChecksumFailures {
+			// Corrupt chunk; queue again to continue from the last good chunk.
+			handle.status.Store(new(pb.DownloadStatus_DOWNLOAD_STATUS_QUEUED))
+			trySendUpdate(v1.DownloadStatus_DOWNLOAD_STATUS_QUEUED, nil)
+			return nil
+		}
 		if errors.Is(finalErr, protocol.ErrPeerUnreachable) {
 			// Peer unreachable; queue again.
 			handle.status.Store(new(pb.DownloadStatus_DOWNLOAD_STATUS_QUEUED))
@@ -917,12 +1047,22 @@ func (dm *DownloadManager) startDownload(handle *DownloadHandle) error {
 			trySendUpdate(v1.DownloadStatus_DOWNLOAD_STATUS_QUEUED, nil)
 			return nil
 		}
-		if protocol.IsErrorConnCloseOrCancel(finalErr) || errors.Is(finalErr, ErrConnNannyClosed) {
-			// Server connection closed, or application is closed; queue again.
+		if errors.Is(finalErr, ErrConnNannyClosed) || dm.ctx.Err() != nil {
+			// Application is closed; queue again.
 			handle.status.Store(new(pb.DownloadStatus_DOWNLOAD_STATUS_QUEUED))
 			trySendUpdate(v1.DownloadStatus_DOWNLOAD_STATUS_QUEUED, nil)
 			return nil
 		}
+		if isTransferInterrupted(finalErr) {
+			if handle.scheduleResume(finalBytes > startBytes) {
+				// Connection lost mid-transfer; queue again to continue into the same partial file.
+				handle.status.Store(new(pb.DownloadStatus_DOWNLOAD_STATUS_QUEUED))
+				trySendUpdate(v1.DownloadStatus_DOWNLOAD_STATUS_QUEUED, nil)
+				return nil
+			}
+
+			finalErr = fmt.Errorf(`transfer was interrupted %d times in a row without receiving data: %w`, maxResumeAttempts, finalErr)
+		}
 
 		errMsg := finalErr.Error()
 		handle.status.Store(new(pb.DownloadStatus_DOWNLOAD_STATUS_ERROR))
@@ -935,5 +1075,183 @@ func (dm *DownloadManager) startDownload(handle *DownloadHandle) error {
 	handle.status.Store(new(pb.DownloadStatus_DOWNLOAD_STATUS_DONE))
 	trySendUpdate(v1.DownloadStatus_DOWNLOAD_STATUS_DONE, nil)
 
+	// Record the file for duplicate detection and run post-processing hooks in the background so the worker can move
+	// on to the next download. The file is recorded first, since hooks may move or delete it.
+	go func() {
+		dm.recordDownloadedFile(completePath, sum, fileTotalSize)
+		dm.runHooks(handle, completePath)
+		dm.notifier.DownloadComplete(handle.server.Uuid, handle.peer, handle.filePath)
+	}()
+
 	return nil
 }
+
+// transfer downloads the rest of the file into the partial file.
+func (dm *DownloadManager) transfer(handle *DownloadHandle, conn *room.Conn, part *partFile) error {
+	peer := conn.GetVirtualC2cConn(handle.peer, false)
+
+	initialDownloaded := part.offset
+
+	meta, reader, err := peer.GetFileTransfer(&pb.MsgGetFile{
+		Path:              handle.filePath.String(),
+		Offset:            initialDownloaded,
+		ChecksumChunkSize: protocol.DefaultChecksumChunkSize,
+	})
+	if err != nil {
+		if protoErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok {
+			if protoErr.Msg.Type == pb.ErrType_ERR_TYPE_FILE_NOT_EXIST {
+				return err
+			}
+		}
+
+		return err
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	handle.transferOrNil.Store(reader)
+	defer handle.transferOrNil.Store(nil)
+
+	if meta.IsDir {
+		// Crawl and queue directory contents in background.
+		go func() {
+			walkErr := WalkPeerPath(dm.ctx, peer, handle.filePath, func(path common.ProtoPath, meta *pb.MsgFileMeta) bool {
+				if meta.IsDir {
+					return true
+				}
+
+				queueErr := dm.Queue(handle.server, handle.peer, path)
+				if queueErr != nil {
+					dm.logger.Error("failed to queue file while walking directory",
+						"service", "client.DownloadManager",
+						"server_uuid", handle.server.Uuid,
+						"peer_username", handle.peer.String(),
+						"dir_path", handle.filePath.String(),
+						"file_path", path.String(),
+						"error", queueErr,
+					)
+					return false
+				}
+
+				return true
+			})
+			if walkErr != nil {
+				dm.logger.Error("failed to walk directory contents",
+					"service", "client.DownloadManager",
+					"server_uuid", handle.server.Uuid,
+					"peer_username", handle.peer.String(),
+					"path", handle.filePath.String(),
+					"error", walkErr,
+				)
+			}
+		}()
+
+		return errIsDir
+	}
+
+	var fileTotalSize uint64
+	if loaded := handle.fileTotalSize.Load(); loaded > -1 {
+		fileTotalSize = uint64(loaded)
+	} else {
+		handle.fileTotalSize.Store(int64(meta.Size))
+		fileTotalSize = meta.Size
+	}
+
+	if meta.Size != fileTotalSize {
+		return errors.New("file size different; file has changed")
+	}
+
+	// Make sure the rest of the file fits before starting to write it.
+	if initialDownloaded < fileTotalSize {
+		if err = checkFreeSpace(filepath.Dir(part.path), fileTotalSize-initialDownloaded); err != nil {
+			return err
+		}
+	}
+
+	// We have a working stream.
+	ctx, cancel := context.WithCancel(dm.ctx)
+	defer cancel()
+
+	// Dump statistics in event channel every second.
+	go func() {
+		ticker := time.NewTicker(1 * time.Second)
+
+		lastBytes := initialDownloaded
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				newBytes := handle.fileDownloadedBytes.Load()
+				speed := newBytes - lastBytes
+
+				dm.trySendUpdate(dmUpdate{
+					rpc: &v1.DownloadStatusUpdate{
+						Uuid:         handle.uuid,
+						Status:       v1.DownloadStatus(*handle.status.Load()),
+						Downloaded:   newBytes,
+						FileSize:     int64(meta.Size),
+						Speed:        speed,
+						ErrorMessage: nil,
+					},
+					ds: handle,
+				})
+
+				lastBytes = newBytes
+			}
+		}
+	}()
+
+	endChan := make(chan error, 2)
+	shouldDl := true
+
+	// Set stopper function.
+	handle.stopFnOrNil.Store(new(func(status pb.DownloadStatus) {
+		if !shouldDl {
+			return
+		}
+		endChan <- errHandleStopped
+		shouldDl = false
+		handle.stopFnOrNil.Store(nil)
+		handle.status.Store(&status)
+	}))
+
+	go func() {
+		endChan <- func() error {
+			buf := make([]byte, dm.multi.MemoryBudget().DownloadBufferSize)
+			for shouldDl {
+				// Block while the download is paused or snoozed.
+				if err = handle.pauseGate.Wait(ctx); err != nil {
+					return err
+				}
+				if err = dm.multi.Snoozer().State().Wait(ctx); err != nil {
+					return err
+				}
+
+				var n int
+				n, err = reader.Read(buf)
+				handle.fileDownloadedBytes.Store(handle.fileDownloadedBytes.Load() + uint64(n))
+				isEof := errors.Is(err, io.EOF)
+				if err != nil && !isEof {
+					return fmt.Errorf(`failed to read from peer %q to file %q: %w`, handle.peer.String(), part.path, err)
+				}
+				if _, err = part.Write(buf[:n]); err != nil {
+					return fmt.Errorf(`failed to write to file %q: %w`, part.path, err)
+				}
+				if isEof {
+					break
+				}
+			}
+			return nil
+		}()
+	}()
+
+	err = <-endChan
+	if mismatchErr, ok := errors.AsType[protocol.ChunkChecksumMismatchError](err); ok {
+		// Everything before the corrupt chunk was verified, so the next attempt continues from there.
+		handle.fileDownloadedBytes.Store(initialDownloaded + uint64(mismatchErr.Verified))
+	}
+	return err
+}
@@ -0,0 +1,35 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestScheduleResumeBacksOffUntilLimit(t *testing.T) {
+	var handle DownloadHandle
+
+	var lastDelay time.Duration
+	for i := 0; i < maxResumeAttempts; i++ {
+		if !handle.scheduleResume(false) {
+			t.Fatalf("expected attempt %d to be allowed", i+1)
+		}
+
+		delay := time.Until(time.Unix(0, handle.resumeAfter.Load()))
+		if delay < lastDelay && delay < maxResumeBackoff-time.Second {
+			t.Fatalf("expected backoff to grow, got %s after %s", delay, lastDelay)
+		}
+		lastDelay = delay
+	}
+
+	if handle.scheduleResume(false) {
+		t.Fatal("expected resuming to fail after too many attempts without data")
+	}
+
+	// Receiving data starts the count over.
+	if !handle.scheduleResume(true) {
+		t.Fatal("expected resuming to be allowed after receiving data")
+	}
+	if delay := time.Until(time.Unix(0, handle.resumeAfter.Load())); delay > resumeBackoff {
+		t.Fatalf("expected backoff to reset to %s, got %s", resumeBackoff, delay)
+	}
+}
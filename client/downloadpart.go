@@ -0,0 +1,228 @@
+package client
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// DmPartFilesInIncompleteDirSetting is the setting key for whether partial downloads are kept in the incomplete
+// download directory instead of next to where they are saved once complete.
+// Updates to this will reflect for downloads that were not started yet.
+const DmPartFilesInIncompleteDirSetting = "dm_part_files_in_incomplete_dir"
+
+// PartFileSuffix is appended to the paths of files that are still being downloaded.
+const PartFileSuffix = ".part"
+
+// HashVerifyTimeout is how long to wait for a peer to provide a file's hash to verify a completed download.
+// If the peer takes longer, the download is not verified.
+const HashVerifyTimeout = 2 * time.Minute
+
+// ErrHashMismatch is returned when a completed download does not have the same hash as the file on the peer.
+var ErrHashMismatch = errors.New("downloaded file does not match the file on the peer")
+
+// partFile is a file that a download is written to until it is complete.
+// It hashes everything it holds, so the download can be verified without reading it again.
+type partFile struct {
+	path   string
+	file   *os.File
+	hasher hash.Hash
+
+	// The number of bytes that were already in the file when it was opened.
+	offset uint64
+}
+
+// openPartFile opens or creates the partial file at path to continue a download from byte resumeFrom.
+// If the file holds fewer bytes than that, the download continues from the end of the file instead. Bytes past
+// resumeFrom are discarded, since they may not have been written completely.
+func openPartFile(path string, resumeFrom uint64) (*partFile, error) {
+	dir := filepath.Dir(path)
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, fmt.Errorf(`failed to create directory %q for incomplete download: %w`, dir, err)
+	}
+
+	file, err := os.OpenFile(path, os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to open file %q for pending download: %w`, path, err)
+	}
+
+	p, err := func() (*partFile, error) {
+		stat, err := file.Stat()
+		if err != nil {
+			return nil, err
+		}
+
+		offset := min(resumeFrom, uint64(stat.Size()))
+		if err = file.Truncate(int64(offset)); err != nil {
+			return nil, err
+		}
+
+		// Hash what is already there, which also leaves the file positioned where writing continues.
+		hasher := sha256.New()
+		if _, err = io.CopyN(hasher, file, int64(offset)); err != nil {
+			return nil, err
+		}
+
+		return &partFile{
+			path:   path,
+			file:   file,
+			hasher: hasher,
+			offset: offset,
+		}, nil
+	}()
+	if err != nil {
+		_ = file.Close()
+		return nil, fmt.Errorf(`failed to resume pending download in file %q: %w`, path, err)
+	}
+
+	return p, nil
+}
+
+func (p *partFile) Write(b []byte) (int, error) {
+	n, err := p.file.Write(b)
+	p.hasher.Write(b[:n])
+	return n, err
+}
+
+// finish flushes the file to disk and closes it.
+// Returns the SHA-256 hash of its contents.
+func (p *partFile) finish() ([]byte, error) {
+	if err := p.file.Sync(); err != nil {
+		_ = p.file.Close()
+		return nil, fmt.Errorf(`failed to sync file %q: %w`, p.path, err)
+	}
+	if err := p.file.Close(); err != nil {
+		return nil, fmt.Errorf(`failed to close file %q: %w`, p.path, err)
+	}
+	return p.hasher.Sum(nil), nil
+}
+
+// Close closes the file without flushing it.
+// It is safe to call after finish.
+func (p *partFile) Close() error {
+	if err := p.file.Close(); err != nil && !errors.Is(err, os.ErrClosed) {
+		return err
+	}
+	return nil
+}
+
+// partPathFor returns the path of the partial file for a download.
+// Once decided, the path is stored with the download, so that it is resumed from the same file even if settings
+// change in the meantime.
+func (dm *DownloadManager) partPathFor(handle *DownloadHandle) (string, error) {
+	if partPath := handle.partPath.Load(); partPath != nil {
+		return *partPath, nil
+	}
+
+	// Downloads that were started before partial files were stored with them are in the incomplete download
+	// directory, without a suffix.
+	partPath := dm.mkIncompletePath(handle.server.Uuid, handle.peer, handle.filePath)
+	if _, err := os.Stat(partPath); handle.fileDownloadedBytes.Load() == 0 || err != nil {
+		inIncompleteDir, err := dm.storage.GetSettingBoolOr(dm.ctx, DmPartFilesInIncompleteDirSetting, false)
+		if err != nil {
+			return "", err
+		}
+		if !inIncompleteDir {
+			if partPath, err = dm.mkCompletePath(handle.server, handle.peer, handle.filePath); err != nil {
+				return "", err
+			}
+		}
+		partPath += PartFileSuffix
+	}
+
+	if err := dm.storage.SetDownloadStatePartPath(dm.ctx, handle.uuid, &partPath); err != nil {
+		return "", err
+	}
+	handle.partPath.Store(&partPath)
+
+	return partPath, nil
+}
+
+// discardPartFile deletes the partial file of a download so that it starts over the next time.
+func (dm *DownloadManager) discardPartFile(handle *DownloadHandle, partPath string) {
+	if err := os.Remove(partPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		dm.logger.Warn("failed to delete partial download",
+			"service", "client.DownloadManager",
+			"uuid", handle.uuid,
+			"path", partPath,
+			"err", err,
+		)
+	}
+
+	handle.fileDownloadedBytes.Store(0)
+	handle.fileTotalSize.Store(-1)
+	dm.forgetPartFile(handle)
+}
+
+// forgetPartFile clears the partial file path of a download, such as once it is complete.
+func (dm *DownloadManager) forgetPartFile(handle *DownloadHandle) {
+	handle.partPath.Store(nil)
+	if err := dm.storage.SetDownloadStatePartPath(dm.ctx, handle.uuid, nil); err != nil {
+		dm.logger.Error("failed to clear partial download path",
+			"service", "client.DownloadManager",
+			"uuid", handle.uuid,
+			"err", err,
+		)
+	}
+}
+
+// verifyDownload checks that a completed download has the same SHA-256 hash as the file on the peer.
+// Returns an error wrapping ErrHashMismatch if it does not.
+// If the peer cannot provide the hash, the download is assumed to be correct.
+func (dm *DownloadManager) verifyDownload(handle *DownloadHandle, sum []byte) error {
+	peerSum, err := dm.getPeerSha256(dm.ctx, handle.server, handle.peer, handle.filePath, HashVerifyTimeout)
+	if err != nil {
+		return err
+	}
+	if peerSum == nil {
+		dm.logger.Debug("peer did not provide file hash, so download was not verified",
+			"service", "client.DownloadManager",
+			"uuid", handle.uuid,
+		)
+		return nil
+	}
+
+	if !bytes.Equal(sum, peerSum) {
+		return fmt.Errorf(`%w: file %q from peer %q on server %q has SHA-256 %x, but the download has %x`,
+			ErrHashMismatch,
+			handle.filePath.String(),
+			handle.peer.String(),
+			handle.server.Uuid,
+			peerSum,
+			sum,
+		)
+	}
+	return nil
+}
+
+// moveFile atomically renames src to dst.
+// If that fails, such as when they are on different filesystems, src is copied next to dst first, then renamed.
+func moveFile(src string, dst string) error {
+	err := os.Rename(src, dst)
+	if err == nil {
+		return nil
+	}
+
+	tmp := dst + PartFileSuffix
+	if tmp == src {
+		return err
+	}
+	_ = os.Remove(tmp)
+	if err = copyNewFile(src, tmp); err != nil {
+		return err
+	}
+	if err = os.Rename(tmp, dst); err != nil {
+		_ = os.Remove(tmp)
+		return fmt.Errorf(`failed to move %q to %q: %w`, src, dst, err)
+	}
+
+	_ = os.Remove(src)
+	return nil
+}
@@ -0,0 +1,57 @@
+package client
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestPartFileResume(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "dir", "song.mp3"+PartFileSuffix)
+	data := bytes.Repeat([]byte("friendnet"), 1000)
+
+	// The file holds more than was recorded as downloaded, so the extra bytes are discarded.
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, data[:600], 0644); err != nil {
+		t.Fatal(err)
+	}
+	part, err := openPartFile(path, 500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if part.offset != 500 {
+		t.Fatalf("got offset %d, want 500", part.offset)
+	}
+	if _, err = part.Write(data[500:]); err != nil {
+		t.Fatal(err)
+	}
+	sum, err := part.finish()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := sha256.Sum256(data); !bytes.Equal(sum, want[:]) {
+		t.Errorf("got hash %x, want %x", sum, want)
+	}
+	if got, _ := os.ReadFile(path); !bytes.Equal(got, data) {
+		t.Errorf("file has %d bytes, want %d bytes of original data", len(got), len(data))
+	}
+
+	// The file holds less than was recorded as downloaded, so the download continues from its end.
+	if err = os.WriteFile(path, data[:100], 0644); err != nil {
+		t.Fatal(err)
+	}
+	part, err = openPartFile(path, 500)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = part.Close()
+	}()
+	if part.offset != 100 {
+		t.Errorf("got offset %d, want 100", part.offset)
+	}
+}
@@ -0,0 +1,197 @@
+package client
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"friendnet.org/client/fsys"
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+)
+
+// DmPathTemplateSetting is the setting key for the template that complete download paths are made from, relative to
+// the complete download directory.
+// Updates to this will reflect immediately.
+const DmPathTemplateSetting = "dm_path_template"
+
+// DmDirCompleteServersSetting is the setting key for per-server complete download directories.
+// Its value is a JSON object that maps server UUIDs to absolute directories. Servers without an entry use the
+// directory in DmDirCompleteSetting.
+// Updates to this will reflect immediately.
+const DmDirCompleteServersSetting = "dm_dir_complete_servers"
+
+// DefaultDownloadPathTemplate is the default download path template.
+// It puts files from each peer in their own directory, with the same structure as on the peer.
+const DefaultDownloadPathTemplate = "{peer}-{server_uuid}/{path}"
+
+// ErrInvalidDownloadPathTemplate is returned when a download path template is invalid.
+var ErrInvalidDownloadPathTemplate = errors.New("invalid download path template")
+
+// ErrInsufficientDiskSpace is returned when there is not enough free disk space for a download.
+var ErrInsufficientDiskSpace = errors.New("insufficient disk space")
+
+// downloadPathVars are the values that can be used in download path templates.
+type downloadPathVars struct {
+	server *Server
+	peer   common.NormalizedUsername
+	path   common.ProtoPath
+}
+
+// downloadPathTemplateVars maps the names of download path template placeholders to functions that return their
+// values. Values other than "path" and "dir" are always a single path segment.
+var downloadPathTemplateVars = map[string]func(v downloadPathVars) string{
+	"peer": func(v downloadPathVars) string {
+		return v.peer.String()
+	},
+	"server": func(v downloadPathVars) string {
+		return v.server.Name
+	},
+	"server_uuid": func(v downloadPathVars) string {
+		return v.server.Uuid
+	},
+	"room": func(v downloadPathVars) string {
+		return v.server.Room().String()
+	},
+	"path": func(v downloadPathVars) string {
+		return strings.TrimPrefix(v.path.String(), "/")
+	},
+	"dir": func(v downloadPathVars) string {
+		return strings.TrimPrefix(path.Dir(v.path.String()), "/")
+	},
+	"name": func(v downloadPathVars) string {
+		return path.Base(v.path.String())
+	},
+}
+
+// ValidateDownloadPathTemplate returns ErrInvalidDownloadPathTemplate if the template is invalid.
+// A valid template only uses known placeholders and includes the file's name through either "{path}" or "{name}", so
+// that different files do not end up at the same path.
+func ValidateDownloadPathTemplate(tmpl string) error {
+	if !strings.Contains(tmpl, "{path}") && !strings.Contains(tmpl, "{name}") {
+		return fmt.Errorf(`%w: must contain {path} or {name}`, ErrInvalidDownloadPathTemplate)
+	}
+
+	_, err := expandDownloadPathTemplate(tmpl, func(string) string { return "x" })
+	return err
+}
+
+// expandDownloadPathTemplate replaces the placeholders in the template with the values returned by value.
+// Returns ErrInvalidDownloadPathTemplate if the template has an unknown or unclosed placeholder.
+func expandDownloadPathTemplate(tmpl string, value func(name string) string) (string, error) {
+	var b strings.Builder
+	for {
+		before, rest, found := strings.Cut(tmpl, "{")
+		b.WriteString(before)
+		if !found {
+			return b.String(), nil
+		}
+
+		name, after, closed := strings.Cut(rest, "}")
+		if !closed {
+			return "", fmt.Errorf(`%w: unclosed placeholder`, ErrInvalidDownloadPathTemplate)
+		}
+		if _, has := downloadPathTemplateVars[name]; !has {
+			return "", fmt.Errorf(`%w: unknown placeholder {%s}`, ErrInvalidDownloadPathTemplate, name)
+		}
+
+		b.WriteString(value(name))
+		tmpl = after
+	}
+}
+
+// mkDownloadPath creates the local path for a download within dir using the template.
+// Path separators in values that are not paths are replaced, and the result cannot escape dir.
+func mkDownloadPath(dir string, tmpl string, replacer fsys.FilenameReplacer, vars downloadPathVars) (string, error) {
+	rel, err := expandDownloadPathTemplate(tmpl, func(name string) string {
+		val := downloadPathTemplateVars[name](vars)
+		if name != "path" && name != "dir" {
+			val = strings.NewReplacer("/", "_", "\\", "_").Replace(val)
+		}
+		return val
+	})
+	if err != nil {
+		return "", err
+	}
+
+	rel = filepath.Clean(filepath.FromSlash(rel))
+	if !filepath.IsLocal(rel) {
+		return "", fmt.Errorf(`%w: path %q is outside of the download directory`, ErrInvalidDownloadPathTemplate, rel)
+	}
+
+	return filepath.Join(dir, replacer.ReplacePath(rel)), nil
+}
+
+// getServerCompleteDirs returns the per-server complete download directories, keyed by server UUID.
+func getServerCompleteDirs(ctx context.Context, store *storage.Storage) (map[string]string, error) {
+	dirsJson, err := store.GetSettingOr(ctx, DmDirCompleteServersSetting, "{}")
+	if err != nil {
+		return nil, err
+	}
+
+	var dirs map[string]string
+	if err = json.Unmarshal([]byte(dirsJson), &dirs); err != nil {
+		return nil, fmt.Errorf(`failed to parse setting %q: %w`, DmDirCompleteServersSetting, err)
+	}
+	return dirs, nil
+}
+
+// dropUnknownServerCompleteDirs removes the entries for servers that no longer exist from dirs.
+// Servers in the trash are kept, so that restoring one keeps its directory.
+func dropUnknownServerCompleteDirs(ctx context.Context, store *storage.Storage, dirs map[string]string) error {
+	uuids, err := store.GetAllServerUuids(ctx)
+	if err != nil {
+		return err
+	}
+
+	for serverUuid := range dirs {
+		if _, has := uuids[serverUuid]; !has {
+			delete(dirs, serverUuid)
+		}
+	}
+	return nil
+}
+
+// pruneServerCompleteDirs removes the per-server complete download directories of servers that were purged.
+func pruneServerCompleteDirs(ctx context.Context, store *storage.Storage) error {
+	dirs, err := getServerCompleteDirs(ctx, store)
+	if err != nil {
+		return err
+	}
+
+	n := len(dirs)
+	if err = dropUnknownServerCompleteDirs(ctx, store, dirs); err != nil {
+		return err
+	}
+	if len(dirs) == n {
+		return nil
+	}
+
+	dirsJson, err := json.Marshal(dirs)
+	if err != nil {
+		return err
+	}
+	return store.PutSetting(ctx, DmDirCompleteServersSetting, string(dirsJson))
+}
+
+// checkFreeSpace returns ErrInsufficientDiskSpace if the filesystem that contains dir has less than the specified
+// number of bytes free.
+// If free space cannot be determined on this platform, the check is skipped.
+func checkFreeSpace(dir string, needed uint64) error {
+	free, err := fsys.FreeSpace(dir)
+	if err != nil {
+		if errors.Is(err, fsys.ErrFreeSpaceUnsupported) {
+			return nil
+		}
+		return fmt.Errorf(`failed to check free disk space in %q: %w`, dir, err)
+	}
+
+	if free < needed {
+		return fmt.Errorf(`%w: download needs %d bytes but only %d bytes are free in %q`, ErrInsufficientDiskSpace, needed, free, dir)
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	"friendnet.org/client/fsys"
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+)
+
+func TestMkDownloadPath(t *testing.T) {
+	peer, _ := common.NormalizeUsername("jimmy")
+	filePath, err := common.NormalizePath("/music/album/song.mp3")
+	if err != nil {
+		t.Fatal(err)
+	}
+	vars := downloadPathVars{
+		server: &Server{Uuid: "abcd1234", Name: "Friends/Family"},
+		peer:   peer,
+		path:   filePath,
+	}
+	noop := fsys.FilenameReplacer(func(s string) string { return s })
+	dir := filepath.FromSlash("/downloads")
+
+	cases := []struct {
+		tmpl string
+		want string
+	}{
+		{DefaultDownloadPathTemplate, "/downloads/jimmy-abcd1234/music/album/song.mp3"},
+		{"{server}/{peer}/{name}", "/downloads/Friends_Family/jimmy/song.mp3"},
+		{"{dir}/{peer} - {name}", "/downloads/music/album/jimmy - song.mp3"},
+	}
+	for _, c := range cases {
+		got, err := mkDownloadPath(dir, c.tmpl, noop, vars)
+		if err != nil {
+			t.Errorf("template %q: unexpected error: %v", c.tmpl, err)
+			continue
+		}
+		if want := filepath.FromSlash(c.want); got != want {
+			t.Errorf("template %q: got %q, want %q", c.tmpl, got, want)
+		}
+	}
+
+	for _, tmpl := range []string{"../{path}", "{peer}/{unknown}/{name}", "{peer"} {
+		if _, err := mkDownloadPath(dir, tmpl, noop, vars); !errors.Is(err, ErrInvalidDownloadPathTemplate) {
+			t.Errorf("template %q: got error %v, want ErrInvalidDownloadPathTemplate", tmpl, err)
+		}
+	}
+	if err := ValidateDownloadPathTemplate("{peer}/{dir}"); !errors.Is(err, ErrInvalidDownloadPathTemplate) {
+		t.Errorf("template without file name: got error %v, want ErrInvalidDownloadPathTemplate", err)
+	}
+}
+
+func TestServerCompleteDirsAfterDelete(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := storage.NewStorage(filepath.Join(t.TempDir(), "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	c := &MultiClient{
+		logger:  slog.New(slog.DiscardHandler),
+		storage: store,
+		servers: map[string]*Server{},
+	}
+	rpc := &RpcServer{
+		client:  c,
+		storage: store,
+	}
+
+	createServer := func(name string) string {
+		serverUuid, err := store.CreateServer(
+			ctx,
+			name,
+			"127.0.0.1:20038",
+			common.UncheckedCreateNormalizedRoomName("room"),
+			common.UncheckedCreateNormalizedUsername("user"),
+			"password",
+		)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return serverUuid
+	}
+	kept := createServer("kept")
+	deleted := createServer("deleted")
+
+	dir := t.TempDir()
+	save := func(serverDirs map[string]string) error {
+		_, err := rpc.UpdateTransferSettings(ctx, &v1.UpdateTransferSettingsRequest{
+			Settings: &v1.TransferSettings{
+				DownloadConcurrency:        1,
+				IncompleteDownloadDir:      dir,
+				CompleteDownloadDir:        dir,
+				ServerCompleteDownloadDirs: serverDirs,
+				QuarantineDir:              dir,
+			},
+		})
+		return err
+	}
+	if err = save(map[string]string{kept: dir, deleted: dir}); err != nil {
+		t.Fatal(err)
+	}
+
+	// A server in the trash keeps its directory, so that restoring it keeps it too.
+	if err = c.DeleteByUuid(ctx, deleted); err != nil {
+		t.Fatal(err)
+	}
+	if err = save(map[string]string{kept: dir, deleted: dir, "missing": dir}); err != nil {
+		t.Fatalf("expected settings with a deleted server to save, got %v", err)
+	}
+	dirs, err := getServerCompleteDirs(ctx, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) != 2 || dirs[kept] != dir || dirs[deleted] != dir {
+		t.Fatalf("expected directories for the kept and trashed servers only, got %v", dirs)
+	}
+
+	// Purging the server removes its directory.
+	if has, err := c.PurgeByUuid(ctx, deleted); err != nil || !has {
+		t.Fatalf("got %t, %v when purging server", has, err)
+	}
+	dirs, err = getServerCompleteDirs(ctx, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dirs) != 1 || dirs[kept] != dir {
+		t.Fatalf("expected only the kept server's directory, got %v", dirs)
+	}
+	if err = save(map[string]string{kept: dir, deleted: dir}); err != nil {
+		t.Fatalf("expected settings with a purged server to save, got %v", err)
+	}
+	if dirs, _ = getServerCompleteDirs(ctx, store); len(dirs) != 1 {
+		t.Fatalf("expected the purged server's directory to be dropped, got %v", dirs)
+	}
+}
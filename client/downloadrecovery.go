@@ -0,0 +1,92 @@
+package client
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// recoverInterrupted queues again the downloads that were in progress when the client last stopped, such as when it
+// crashed or was killed.
+//
+// Download states act as a journal of in-flight downloads. A download left in the PENDING status was interrupted, and
+// its recorded progress may not match its partial file: progress is recorded in batches, and writes that were not
+// flushed to disk may be lost. The partial file is checked so that the download continues from bytes that were
+// actually written. Any corruption past that is caught when the completed download is verified against the peer's hash.
+//
+// Recovered downloads are kept so that they can be reported with TakeRecovered.
+// Must be called before the downloader starts.
+func (dm *DownloadManager) recoverInterrupted() {
+	for _, handle := range dm.handles {
+		if *handle.status.Load() != pb.DownloadStatus_DOWNLOAD_STATUS_PENDING {
+			continue
+		}
+
+		recorded := handle.fileDownloadedBytes.Load()
+		downloaded := recorded
+
+		// Downloads without a partial file path either did not write anything yet or are in the incomplete download
+		// directory, which openPartFile checks when the download is resumed.
+		if partPath := handle.partPath.Load(); partPath != nil {
+			stat, err := os.Stat(*partPath)
+			if err == nil {
+				downloaded = min(recorded, uint64(stat.Size()))
+			} else if errors.Is(err, fs.ErrNotExist) {
+				downloaded = 0
+				dm.forgetPartFile(handle)
+			} else {
+				dm.logger.Warn("failed to check partial file of interrupted download",
+					"service", "client.DownloadManager",
+					"uuid", handle.uuid,
+					"path", *partPath,
+					"err", err,
+				)
+			}
+		}
+
+		handle.fileDownloadedBytes.Store(downloaded)
+		handle.status.Store(new(pb.DownloadStatus_DOWNLOAD_STATUS_QUEUED))
+
+		err := dm.storage.UpdateDownloadState(
+			handle.uuid,
+			pb.DownloadStatus_DOWNLOAD_STATUS_QUEUED,
+			handle.fileTotalSize.Load(),
+			int64(downloaded),
+			handle.errorMessage.Load(),
+		)
+		if err != nil {
+			dm.logger.Error("failed to queue interrupted download again",
+				"service", "client.DownloadManager",
+				"uuid", handle.uuid,
+				"err", err,
+			)
+		}
+
+		dm.logger.Info("recovered interrupted download",
+			"service", "client.DownloadManager",
+			"uuid", handle.uuid,
+			"downloaded", downloaded,
+			"discarded", recorded-downloaded,
+		)
+
+		dm.recovered = append(dm.recovered, &v1.RecoveredDownload{
+			Uuid:       handle.uuid,
+			Downloaded: downloaded,
+			Discarded:  recorded - downloaded,
+		})
+	}
+}
+
+// TakeRecovered returns the downloads that were recovered when the download manager started, and forgets them so that
+// they are only reported once.
+func (dm *DownloadManager) TakeRecovered() []*v1.RecoveredDownload {
+	dm.mu.Lock()
+	defer dm.mu.Unlock()
+
+	recovered := dm.recovered
+	dm.recovered = nil
+	return recovered
+}
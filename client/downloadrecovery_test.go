@@ -0,0 +1,115 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+func TestRecoverInterrupted(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := storage.NewStorage(filepath.Join(dir, "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+	serverUuid, err := store.CreateServer(
+		ctx,
+		"test",
+		"127.0.0.1:20038",
+		common.UncheckedCreateNormalizedRoomName("room"),
+		common.UncheckedCreateNormalizedUsername("user"),
+		"password",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	dm := &DownloadManager{
+		ctx:     ctx,
+		logger:  slog.New(slog.DiscardHandler),
+		storage: store,
+	}
+	newHandle := func(uuid string, status pb.DownloadStatus, downloaded uint64, partPath *string) *DownloadHandle {
+		t.Helper()
+		err := store.CreateDownloadState(ctx, uuid, serverUuid, common.UncheckedCreateNormalizedUsername("peer"), status, common.UncheckedCreateProtoPath("/"+uuid))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = store.SetDownloadStatePartPath(ctx, uuid, partPath); err != nil {
+			t.Fatal(err)
+		}
+
+		handle := &DownloadHandle{dm: dm, uuid: uuid}
+		handle.status.Store(&status)
+		handle.fileTotalSize.Store(1000)
+		handle.fileDownloadedBytes.Store(downloaded)
+		handle.partPath.Store(partPath)
+		return handle
+	}
+
+	// More progress was recorded than was written to the partial file.
+	shortPath := filepath.Join(dir, "short"+PartFileSuffix)
+	if err = os.WriteFile(shortPath, make([]byte, 300), 0644); err != nil {
+		t.Fatal(err)
+	}
+	short := newHandle("short", pb.DownloadStatus_DOWNLOAD_STATUS_PENDING, 500, &shortPath)
+
+	// The partial file is gone.
+	missingPath := filepath.Join(dir, "missing"+PartFileSuffix)
+	missing := newHandle("missing", pb.DownloadStatus_DOWNLOAD_STATUS_PENDING, 500, &missingPath)
+
+	// Downloads that were not in progress are left alone.
+	paused := newHandle("paused", pb.DownloadStatus_DOWNLOAD_STATUS_PAUSED, 500, nil)
+
+	dm.handles = []*DownloadHandle{short, missing, paused}
+	dm.recoverInterrupted()
+
+	if status := *short.status.Load(); status != pb.DownloadStatus_DOWNLOAD_STATUS_QUEUED {
+		t.Errorf("expected the interrupted download to be queued, got %s", status)
+	}
+	if n := short.fileDownloadedBytes.Load(); n != 300 {
+		t.Errorf("expected progress to be capped at the partial file size of 300, got %d", n)
+	}
+	if n := missing.fileDownloadedBytes.Load(); n != 0 || missing.partPath.Load() != nil {
+		t.Errorf("expected the download without a partial file to start over, got %d bytes", n)
+	}
+	if status := *paused.status.Load(); status != pb.DownloadStatus_DOWNLOAD_STATUS_PAUSED {
+		t.Errorf("expected the paused download to stay paused, got %s", status)
+	}
+
+	// The recovered state is what the download continues from after another restart.
+	records, err := store.GetDownloadStates(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, rec := range records {
+		if rec.Uuid != "short" {
+			continue
+		}
+		if rec.Status != pb.DownloadStatus_DOWNLOAD_STATUS_QUEUED || rec.FileDownloadedBytes != 300 {
+			t.Errorf("expected a queued download with 300 bytes to be stored, got %s with %d bytes", rec.Status, rec.FileDownloadedBytes)
+		}
+	}
+
+	recovered := dm.TakeRecovered()
+	if len(recovered) != 2 {
+		t.Fatalf("expected 2 recovered downloads, got %d", len(recovered))
+	}
+	if r := recovered[0]; r.Uuid != "short" || r.Downloaded != 300 || r.Discarded != 200 {
+		t.Errorf("expected 300 bytes kept and 200 discarded, got %d and %d", r.Downloaded, r.Discarded)
+	}
+	if len(dm.TakeRecovered()) != 0 {
+		t.Error("expected recovered downloads to be reported once")
+	}
+}
@@ -0,0 +1,138 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"time"
+
+	"friendnet.org/client/fsys"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+)
+
+// Completed downloads can be scanned with a command, such as clamdscan, before they are moved to the complete
+// download directory. The command gets the partial file's path as its only argument and the same sandboxed
+// environment as command hooks. It follows the exit code convention of ClamAV: 0 means nothing was found, 1 means a
+// threat was found, and anything else means the scan failed.
+//
+// Files with threats are moved to the quarantine directory and the download fails. If the scan fails, the download
+// fails but the partial file is kept, so that retrying the download scans it again.
+
+// DmScanCommandSetting is the setting key for the absolute path of the command that scans completed downloads.
+// Downloads are not scanned if it is empty.
+// Updates to this will reflect for downloads that were not completed yet.
+const DmScanCommandSetting = "dm_scan_command"
+
+// DmDirQuarantineSetting is the setting key for the directory that downloads with threats are moved to.
+// Client must be restarted for it to take effect.
+const DmDirQuarantineSetting = "dm_dir_quarantine"
+
+// DownloadScanTimeout is the maximum amount of time the scan command may run before it is killed and the scan fails.
+const DownloadScanTimeout = 30 * time.Minute
+
+// scanExitCodeInfected is the exit code of the scan command when it finds a threat.
+const scanExitCodeInfected = 1
+
+// ErrDownloadQuarantined is returned when a completed download was moved to the quarantine directory because the
+// scan command found a threat in it.
+var ErrDownloadQuarantined = errors.New("download was quarantined")
+
+// ValidateScanCommand returns an error if command cannot be used as the scan command.
+// An empty command is valid and disables scanning.
+func ValidateScanCommand(command string) error {
+	if command != "" && !filepath.IsAbs(command) {
+		return errors.New("scan command must be an absolute path")
+	}
+	return nil
+}
+
+// scanDownload runs the scan command against the partial file of a completed download, if one is configured.
+// Returns SCAN_STATUS_UNSPECIFIED if there is no scan command. Returns an error along with SCAN_STATUS_FAILED if the
+// scan could not be done.
+func (dm *DownloadManager) scanDownload(handle *DownloadHandle, partPath string) (v1.ScanStatus, error) {
+	command, err := dm.storage.GetSettingOr(dm.ctx, DmScanCommandSetting, "")
+	if err != nil {
+		return v1.ScanStatus_SCAN_STATUS_UNSPECIFIED, err
+	}
+	if command == "" {
+		return v1.ScanStatus_SCAN_STATUS_UNSPECIFIED, nil
+	}
+	if err = ValidateScanCommand(command); err != nil {
+		return v1.ScanStatus_SCAN_STATUS_FAILED, err
+	}
+
+	ctx, cancel := context.WithTimeout(dm.ctx, DownloadScanTimeout)
+	defer cancel()
+
+	payload := &downloadHookPayload{
+		Uuid:         handle.uuid,
+		ServerUuid:   handle.server.Uuid,
+		PeerUsername: handle.peer.String(),
+		FilePath:     handle.filePath.String(),
+		LocalPath:    partPath,
+		FileSize:     handle.fileTotalSize.Load(),
+	}
+
+	cmd := exec.CommandContext(ctx, command, partPath)
+	cmd.Env = payload.hookEnv()
+	cmd.Dir = filepath.Dir(partPath)
+
+	out, err := cmd.CombinedOutput()
+	if err == nil {
+		return v1.ScanStatus_SCAN_STATUS_CLEAN, nil
+	}
+
+	// Include the tail of the output, since that is where scanners report what they found.
+	const maxOut = 1024
+	if len(out) > maxOut {
+		out = out[len(out)-maxOut:]
+	}
+
+	if exitErr, ok := errors.AsType[*exec.ExitError](err); ok && ctx.Err() == nil && exitErr.ExitCode() == scanExitCodeInfected {
+		return v1.ScanStatus_SCAN_STATUS_INFECTED, fmt.Errorf(`scan command %q found a threat (output: %q)`, command, string(out))
+	}
+	return v1.ScanStatus_SCAN_STATUS_FAILED, fmt.Errorf(`scan command %q failed: %w (output: %q)`, command, err, string(out))
+}
+
+// quarantineDownload moves the partial file of a download to the quarantine directory.
+// Returns the path it was moved to.
+func (dm *DownloadManager) quarantineDownload(handle *DownloadHandle, partPath string) (string, error) {
+	replacer, err := fsys.GetFilenameReplacerForPath(dm.dirQuarantine)
+	if err != nil {
+		return "", fmt.Errorf(`failed to get filename replacer for quarantine directory %q: %w`, dm.dirQuarantine, err)
+	}
+
+	// Use the same structure as the incomplete download directory, so it is clear where the file came from.
+	quarantinePath := filepath.Join(
+		dm.dirQuarantine,
+		replacer.ReplacePath(filepath.Join(handle.peer.String()+"-"+handle.server.Uuid, handle.filePath.String())),
+	)
+
+	dir := filepath.Dir(quarantinePath)
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return "", fmt.Errorf(`failed to create quarantine directory %q: %w`, dir, err)
+	}
+	if err = moveFile(partPath, quarantinePath); err != nil {
+		return "", err
+	}
+
+	// Nothing should run the file by accident.
+	_ = os.Chmod(quarantinePath, 0600)
+
+	return quarantinePath, nil
+}
+
+// setScanStatus records the result of scanning a download.
+func (dm *DownloadManager) setScanStatus(handle *DownloadHandle, status v1.ScanStatus) {
+	handle.scanStatus.Store(int32(status))
+	if err := dm.storage.SetDownloadStateScanStatus(dm.ctx, handle.uuid, status); err != nil {
+		dm.logger.Error("failed to save download scan status",
+			"service", "client.DownloadManager",
+			"uuid", handle.uuid,
+			"err", err,
+		)
+	}
+}
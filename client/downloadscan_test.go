@@ -0,0 +1,69 @@
+package client
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"testing"
+
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+)
+
+func TestScanDownloadExitCodes(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("scan commands in this test are shell scripts")
+	}
+
+	dir := t.TempDir()
+	store, err := storage.NewStorage(filepath.Join(dir, "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	dm := &DownloadManager{ctx: context.Background(), storage: store}
+	handle := &DownloadHandle{
+		uuid:     "download",
+		server:   &Server{Uuid: "server"},
+		peer:     common.UncheckedCreateNormalizedUsername("peer"),
+		filePath: common.UncheckedCreateProtoPath("/file.bin"),
+	}
+	partPath := filepath.Join(dir, "file.bin"+PartFileSuffix)
+	if err = os.WriteFile(partPath, []byte("data"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// No scan command means the download is not scanned.
+	if status, err := dm.scanDownload(handle, partPath); err != nil || status != v1.ScanStatus_SCAN_STATUS_UNSPECIFIED {
+		t.Fatalf("got %s, %v without a scan command", status, err)
+	}
+
+	for code, want := range map[int]v1.ScanStatus{
+		0: v1.ScanStatus_SCAN_STATUS_CLEAN,
+		1: v1.ScanStatus_SCAN_STATUS_INFECTED,
+		2: v1.ScanStatus_SCAN_STATUS_FAILED,
+	} {
+		script := filepath.Join(dir, "scan.sh")
+		body := "#!/bin/sh\ntest -f \"$1\" || exit 2\nexit " + strconv.Itoa(code) + "\n"
+		if err = os.WriteFile(script, []byte(body), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err = store.PutSetting(dm.ctx, DmScanCommandSetting, script); err != nil {
+			t.Fatal(err)
+		}
+
+		status, err := dm.scanDownload(handle, partPath)
+		if status != want {
+			t.Errorf("exit code %d: got %s, want %s", code, status, want)
+		}
+		if (err == nil) != (want == v1.ScanStatus_SCAN_STATUS_CLEAN) {
+			t.Errorf("exit code %d: got error %v", code, err)
+		}
+	}
+}
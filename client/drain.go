@@ -0,0 +1,62 @@
+package client
+
+import (
+	"context"
+	"time"
+
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+)
+
+// ShutdownGraceSetting is the setting key for how many seconds active uploads are given to finish when the client
+// shuts down.
+// If 0, the client shuts down without waiting.
+const ShutdownGraceSetting = "shutdown_grace_secs"
+
+// DefaultShutdownGrace is the default value of ShutdownGraceSetting.
+const DefaultShutdownGrace = 30
+
+// MaxShutdownGrace is the maximum value of ShutdownGraceSetting.
+const MaxShutdownGrace = 3600
+
+// DrainUploads stops accepting new uploads on every server, then waits until active uploads finish, grace elapses, or
+// ctx is done.
+// Peers that request files in the meantime are told that the client is going offline, so they try again later.
+// Progress is published as TYPE_SHUTDOWN_DRAIN events.
+func (c *MultiClient) DrainUploads(ctx context.Context, grace time.Duration) {
+	c.drain.Start()
+
+	deadline := time.Now().Add(grace)
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	publisher := c.eventBus.CreatePublisher(&v1.EventContext{})
+	for {
+		active, changed := c.drain.Active()
+		publisher.Publish(&v1.Event{
+			Type: v1.Event_TYPE_SHUTDOWN_DRAIN,
+			ShutdownDrain: &v1.Event_ShutdownDrain{
+				ActiveUploads: uint32(active),
+				DeadlineTs:    deadline.Unix(),
+			},
+		})
+		if active == 0 {
+			return
+		}
+
+		c.logger.Info("waiting for uploads to finish before shutting down",
+			"service", "client.MultiClient",
+			"active_uploads", active,
+			"deadline", deadline,
+		)
+
+		select {
+		case <-changed:
+		case <-ctx.Done():
+			c.logger.Warn("shutting down with uploads still active",
+				"service", "client.MultiClient",
+				"active_uploads", active,
+			)
+			return
+		}
+	}
+}
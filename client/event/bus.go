@@ -59,7 +59,7 @@ func (b *Bus) Unsubscribe(id SubscriptionId) {
 	defer b.mu.Unlock()
 
 	b.subscriptions = slices.DeleteFunc(b.subscriptions, func(sub subscription) bool {
-		return sub.id != id
+		return sub.id == id
 	})
 }
 
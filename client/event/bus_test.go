@@ -0,0 +1,38 @@
+package event
+
+import (
+	"testing"
+	"time"
+
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+)
+
+func TestBusUnsubscribe(t *testing.T) {
+	t.Parallel()
+
+	bus := NewBus()
+
+	kept := make(chan struct{}, 1)
+	removed := make(chan struct{}, 1)
+	bus.Subscribe(func(*v1.Event, *v1.EventContext) {
+		kept <- struct{}{}
+	})
+	id := bus.Subscribe(func(*v1.Event, *v1.EventContext) {
+		removed <- struct{}{}
+	})
+
+	// Only the unsubscribed subscription is removed, not every other one.
+	bus.Unsubscribe(id)
+	bus.CreatePublisher(&v1.EventContext{}).Publish(&v1.Event{})
+
+	select {
+	case <-kept:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the remaining subscription to get the event")
+	}
+	select {
+	case <-removed:
+		t.Fatal("expected the unsubscribed subscription not to get the event")
+	case <-time.After(50 * time.Millisecond):
+	}
+}
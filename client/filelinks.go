@@ -0,0 +1,151 @@
+package client
+
+import (
+	"sync"
+	"time"
+
+	"friendnet.org/common"
+)
+
+// FileLinkPathToken is used in place of the bearer token in file server paths to download a file with a file link,
+// like /content/link/:LINK_TOKEN.
+const FileLinkPathToken = "link"
+
+// DefaultFileLinkTtl is how long file links are valid for if no expiry is specified.
+const DefaultFileLinkTtl = 1 * time.Hour
+
+// MaxFileLinkTtl is the longest that file links can be valid for.
+const MaxFileLinkTtl = 7 * 24 * time.Hour
+
+// fileLinkTokenBytes is the number of random bytes in file link tokens.
+const fileLinkTokenBytes = 24
+
+// fileLinkReuseGrace is how long the client that downloaded a file link can keep using it after the download
+// finished, so that download managers that fetch the file in several requests are not cut off.
+const fileLinkReuseGrace = 1 * time.Minute
+
+// fileLink is a file that can be downloaded once through the file server without the bearer token.
+type fileLink struct {
+	serverUuid string
+	username   common.NormalizedUsername
+	path       common.ProtoPath
+	expiresTs  time.Time
+
+	// The address of the client that the link was first used by.
+	// Empty until then.
+	client string
+
+	// When the client finished downloading the file.
+	// Zero until then.
+	usedTs time.Time
+}
+
+// FileLinkStore keeps file links, which let someone without the bearer token download a single file once through the
+// file server, such as a friend the link was pasted to.
+// A link belongs to the first client that uses it. It stays usable by that client until shortly after a download
+// finishes, so failed or interrupted downloads can be retried.
+// Links are kept in memory, so they stop working when the client restarts.
+// They are served on the web UI address, so they only work for others if that address is reachable by them.
+// It is safe for concurrent use.
+type FileLinkStore struct {
+	mu    sync.Mutex
+	links map[string]fileLink
+}
+
+// NewFileLinkStore creates a new, empty FileLinkStore.
+func NewFileLinkStore() *FileLinkStore {
+	return &FileLinkStore{
+		links: make(map[string]fileLink),
+	}
+}
+
+// Create creates a link to a file shared by a user on a server, valid for ttl, and returns its token.
+func (s *FileLinkStore) Create(
+	serverUuid string,
+	username common.NormalizedUsername,
+	path common.ProtoPath,
+	ttl time.Duration,
+) (token string, expiresTs time.Time) {
+	token = common.RandomB64UrlStr(fileLinkTokenBytes)
+	now := time.Now()
+	expiresTs = now.Add(ttl)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pruneNoLock(now)
+	s.links[token] = fileLink{
+		serverUuid: serverUuid,
+		username:   username,
+		path:       path,
+		expiresTs:  expiresTs,
+	}
+	return token, expiresTs
+}
+
+// peek returns the link with the specified token without using it up.
+// Returns false if there is no such link or it expired.
+func (s *FileLinkStore) peek(token string) (fileLink, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	link, has := s.links[token]
+	if !has || !s.usableNoLock(link, time.Now()) {
+		return fileLink{}, false
+	}
+	return link, true
+}
+
+// use returns the link with the specified token for a download by the client at the specified address.
+// The first client to use a link claims it, and other clients cannot use it afterwards.
+// Returns false if there is no such link, it expired, it was claimed by another client, or it was already downloaded
+// by this client longer than fileLinkReuseGrace ago.
+func (s *FileLinkStore) use(token string, client string) (fileLink, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	link, has := s.links[token]
+	if !has || !s.usableNoLock(link, now) {
+		return fileLink{}, false
+	}
+
+	if link.client == "" {
+		link.client = client
+		s.links[token] = link
+	} else if link.client != client {
+		return fileLink{}, false
+	}
+	return link, true
+}
+
+// markUsed records that the client the link belongs to finished downloading it.
+// The link stops working once fileLinkReuseGrace has passed.
+func (s *FileLinkStore) markUsed(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	link, has := s.links[token]
+	if !has || !link.usedTs.IsZero() {
+		return
+	}
+	link.usedTs = time.Now()
+	s.links[token] = link
+}
+
+// usableNoLock returns whether the link has not expired and was not used up.
+func (s *FileLinkStore) usableNoLock(link fileLink, now time.Time) bool {
+	if !now.Before(link.expiresTs) {
+		return false
+	}
+	return link.usedTs.IsZero() || now.Before(link.usedTs.Add(fileLinkReuseGrace))
+}
+
+// pruneNoLock deletes expired and used up links.
+func (s *FileLinkStore) pruneNoLock(now time.Time) {
+	for token, link := range s.links {
+		if !s.usableNoLock(link, now) {
+			delete(s.links, token)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+)
+
+func TestFileLinkSingleUse(t *testing.T) {
+	store := NewFileLinkStore()
+	path := common.UncheckedCreateProtoPath("/share/file.txt")
+	token, _ := store.Create("server", common.UncheckedCreateNormalizedUsername("alice"), path, time.Minute)
+
+	if _, ok := store.peek(token); !ok {
+		t.Fatal("expected peek to find the link")
+	}
+	link, ok := store.use(token, "10.0.0.1")
+	if !ok {
+		t.Fatal("expected the first use to succeed")
+	}
+	if link.path != path {
+		t.Fatalf("got path %q, want %q", link.path.String(), path.String())
+	}
+
+	// The link belongs to the first client, which can retry until the download finishes.
+	if _, ok = store.use(token, "10.0.0.2"); ok {
+		t.Fatal("expected another client to be rejected")
+	}
+	if _, ok = store.use(token, "10.0.0.1"); !ok {
+		t.Fatal("expected the same client to be able to retry")
+	}
+
+	store.markUsed(token)
+	if _, ok = store.use(token, "10.0.0.1"); !ok {
+		t.Fatal("expected the same client to be able to continue within the grace period")
+	}
+	if _, ok = store.use(token, "10.0.0.2"); ok {
+		t.Fatal("expected another client to be rejected after the download")
+	}
+
+	// Once the grace period passes, the link is used up.
+	store.mu.Lock()
+	link = store.links[token]
+	link.usedTs = link.usedTs.Add(-fileLinkReuseGrace)
+	store.links[token] = link
+	store.mu.Unlock()
+	if _, ok = store.use(token, "10.0.0.1"); ok {
+		t.Fatal("expected the link to be used up after the grace period")
+	}
+	if _, ok = store.peek(token); ok {
+		t.Fatal("expected peek to ignore the used up link")
+	}
+}
+
+func TestFileLinkExpired(t *testing.T) {
+	store := NewFileLinkStore()
+	token, _ := store.Create("server", common.UncheckedCreateNormalizedUsername("alice"), common.UncheckedCreateProtoPath("/file.txt"), -time.Second)
+
+	if _, ok := store.peek(token); ok {
+		t.Fatal("expected peek to ignore the expired link")
+	}
+	if _, ok := store.use(token, "10.0.0.1"); ok {
+		t.Fatal("expected the expired link to be unusable")
+	}
+}
@@ -1,15 +1,16 @@
 package client
 
 import (
-	"archive/zip"
 	"context"
 	"errors"
 	"fmt"
 	"io"
 	"log/slog"
 	"mime"
+	"mime/multipart"
 	"net"
 	"net/http"
+	"net/textproto"
 	"net/url"
 	"path/filepath"
 	"strconv"
@@ -30,17 +31,37 @@ type FileServerHandler struct {
 	logger *slog.Logger
 	multi  *MultiClient
 	token  string
+	links  *FileLinkStore
+
+	// Checks the session cookie when SessionPathToken is used in place of the token.
+	sessions *SessionHandler
+
+	streams *fileStreamPool
+
+	// How many bytes of a file to request ahead of what is being served.
+	readAhead int
 }
 
+// NewFileServer creates a file server that reads up to readAhead bytes ahead of what it is serving from each file.
 func NewFileServer(
 	logger *slog.Logger,
 	multi *MultiClient,
 	token string,
+	links *FileLinkStore,
+	sessions *SessionHandler,
+	readAhead int,
 ) *FileServerHandler {
 	return &FileServerHandler{
 		logger: logger,
 		multi:  multi,
 		token:  token,
+		links:  links,
+
+		sessions: sessions,
+
+		streams: newFileStreamPool(),
+
+		readAhead: readAhead,
 	}
 }
 
@@ -68,8 +89,8 @@ func (s *FileServerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		text(w, r, http.StatusInternalServerError, fmt.Sprintf("internal error:\n\n%v\n", err))
 	}
 
-	const schemeMsg = "Files are served based on the path scheme: /content/:TOKEN/:SERVER/:USERNAME/:PATH..."
-	const indexMsg = "Hi, you've reached the peer proxy HTTP server.\n\n" + schemeMsg + "\n\nPossible query parameter options:\n - ?download=1 signals for the browser to download the file\n - ?allowCache=1 sets caching headers to allow browser to cache the file\n - ?zip=1 on a directory downloads a zip of the directory's contents\n\nHave fun!\n"
+	const schemeMsg = "Files are served based on the path scheme: /content/:TOKEN/:SERVER/:USERNAME/:PATH...\n\nIf :TOKEN is \"" + SessionPathToken + "\", the web UI session cookie is used instead.\n\nFile links are served at /content/" + FileLinkPathToken + "/:LINK_TOKEN and can only be downloaded once."
+	const indexMsg = "Hi, you've reached the peer proxy HTTP server.\n\n" + schemeMsg + "\n\nPossible query parameter options:\n - ?download=1 signals for the browser to download the file\n - ?allowCache=1 sets caching headers to allow browser to cache the file\n - ?zip=1 on a directory downloads a zip of the directory's contents\n - ?tar=1 on a directory downloads a tar.gz of the directory's contents\n\nDirectories without ?zip=1 or ?tar=1 are served as an HTML listing, or as JSON if the request accepts application/json.\n\nHave fun!\n"
 
 	switch r.Method {
 	case http.MethodGet, http.MethodHead:
@@ -114,25 +135,57 @@ func (s *FileServerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 
 	pathParts := strings.Split(strings.TrimSuffix(reqUrl.Path[1:], "/"), "/")
 
-	if len(pathParts) < 4 {
-		text(w, r, http.StatusBadRequest, schemeMsg+"\n")
-		return
-	}
-
-	token := pathParts[1]
-	serverUuid := pathParts[2]
-	usernameRaw := pathParts[3]
-	pathRaw := "/" + strings.Join(pathParts[4:], "/")
+	var token string
+	var serverUuid string
+	var usernameRaw string
+	var pathRaw string
 	var err error
-	pathRaw, err = url.QueryUnescape(pathRaw)
-	if err != nil {
-		internalError(w, r, err)
-		return
-	}
 
-	if token != s.token {
-		text(w, r, http.StatusForbidden, "invalid token\n")
-		return
+	// Whether the end of the file was sent, which uses up file links.
+	var sentToEnd bool
+
+	isLink := len(pathParts) == 3 && pathParts[1] == FileLinkPathToken
+	if isLink {
+		// HEAD requests, such as from chat apps generating link previews, do not use up the link.
+		var link fileLink
+		var ok bool
+		if isHead {
+			link, ok = s.links.peek(pathParts[2])
+		} else {
+			link, ok = s.links.use(pathParts[2], remoteHost(r))
+		}
+		if !ok {
+			text(w, r, http.StatusNotFound, "link does not exist, expired or was already used\n")
+			return
+		}
+
+		serverUuid = link.serverUuid
+		usernameRaw = link.username.String()
+		pathRaw = link.path.String()
+	} else {
+		if len(pathParts) < 4 {
+			text(w, r, http.StatusBadRequest, schemeMsg+"\n")
+			return
+		}
+
+		token = pathParts[1]
+		serverUuid = pathParts[2]
+		usernameRaw = pathParts[3]
+		pathRaw, err = url.PathUnescape("/" + strings.Join(pathParts[4:], "/"))
+		if err != nil {
+			internalError(w, r, err)
+			return
+		}
+
+		if token == SessionPathToken {
+			if !s.sessions.IsValidRequest(r) {
+				text(w, r, http.StatusForbidden, "invalid session\n")
+				return
+			}
+		} else if token != s.token {
+			text(w, r, http.StatusForbidden, "invalid token\n")
+			return
+		}
 	}
 
 	path, err := common.ValidatePath(pathRaw)
@@ -159,12 +212,16 @@ func (s *FileServerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		// Get metadata before getting file.
 		// This is necessary for range requests.
 		var meta *pb.MsgFileMeta
-		meta, err = peer.GetFileMeta(path)
+		meta, err = peer.GetFileMetaContext(ctx, path)
 		if err != nil {
 			if errors.Is(err, protocol.ErrPeerUnreachable) {
 				text(w, r, http.StatusBadGateway, "peer unreachable\n")
 				return nil
 			}
+			if errors.Is(err, room.ErrPeerRequestTimeout) {
+				text(w, r, http.StatusGatewayTimeout, "peer did not reply in time\n")
+				return nil
+			}
 
 			if msgErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok {
 				if msgErr.Msg.Type == pb.ErrType_ERR_TYPE_FILE_NOT_EXIST {
@@ -177,107 +234,48 @@ func (s *FileServerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		}
 
 		if meta.IsDir {
-			doZip := reqUrl.Query().Has("zip")
-			if !doZip {
-				text(w, r, http.StatusNotImplemented, "Path points to a directory.\n\nTo download the directory's content as a zip, specify ?zip=1.\n")
+			if isLink {
+				text(w, r, http.StatusBadRequest, "links can only point to files\n")
 				return nil
 			}
 
-			// Zip folder contents.
-
-			w.Header().Set("Content-Type", "application/zip")
-			w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s.zip"`, meta.Name))
-
-			// We will scan through the directory while writing the files to a zip output stream.
-			// The scanning will happen in the background while we receive the entries in this thread and write them.
-
-			zipCtx, cancel := context.WithCancelCause(ctx)
+			var format ArchiveFormat
+			switch {
+			case reqUrl.Query().Has("zip"):
+				format = ArchiveFormatZip
+			case reqUrl.Query().Has("tar"):
+				format = ArchiveFormatTarGz
+			default:
+				files, listErr := getPeerDirFiles(ctx, peer, path)
+				if listErr != nil {
+					return listErr
+				}
 
-			type zipEntry struct {
-				path common.ProtoPath
-				meta *pb.MsgFileMeta
+				basePath := "/content/" + token + "/" + serverUuid + "/" + usernameRaw
+				wroteHeader = true
+				return writeDirListing(w, r, basePath, path, files, true)
 			}
 
-			entries := make(chan zipEntry, 1_000)
+			w.Header().Set("Content-Type", format.MimeType())
+			w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": meta.Name + "." + format.Ext()}))
 
-			go func() {
-				walkErr := WalkPeerPath(peer, path, func(path common.ProtoPath, meta *pb.MsgFileMeta) bool {
-					entries <- zipEntry{
-						path: path,
-						meta: meta,
-					}
-					return true
-				})
-				if walkErr != nil {
-					if errors.Is(walkErr, protocol.ErrPeerUnreachable) {
-						cancel(protocol.ErrPeerUnreachable)
-						return
-					}
-
-					cancel(walkErr)
-				}
-
-				close(entries)
-			}()
+			// Archives are built on the fly, so there is no length and ranges cannot be supported.
+			w.Header().Del("Accept-Ranges")
 
-			zipErr := func() error {
-				zw := zip.NewWriter(w)
-
-			entryLoop:
-				for {
-					select {
-					case <-zipCtx.Done():
-						if ctxErr := zipCtx.Err(); ctxErr != nil {
-							return ctxErr
-						}
-
-						// If the context was canceled without an error, something weird happened.
-						return errors.New("directory zip streaming context canceled without an error, this should not happen")
-
-					case entry := <-entries:
-						if entry.meta == nil {
-							// No more entries.
-							break entryLoop
-						}
-
-						entryPath := strings.TrimPrefix(entry.path.String(), path.String())[1:]
-
-						if entry.meta.IsDir {
-							_, fileErr := zw.Create(entryPath + "/")
-							if fileErr != nil {
-								return fileErr
-							}
-							continue
-						}
-
-						fileW, fileErr := zw.Create(entryPath)
-						if fileErr != nil {
-							return fileErr
-						}
-
-						_, reader, getErr := peer.GetFile(&pb.MsgGetFile{
-							Path: entry.path.String(),
-						})
-						if getErr != nil {
-							return getErr
-						}
-
-						_, copyErr := io.Copy(fileW, reader)
-						if copyErr != nil {
-							return copyErr
-						}
-					}
-				}
+			if isHead {
+				return nil
+			}
 
-				return zw.Close()
-			}()
-			if zipErr != nil {
-				s.logger.Error("error while streaming directory zip",
+			wroteHeader = true
+			archiveErr := WritePeerDirArchive(ctx, peer, path, format, w)
+			if archiveErr != nil {
+				s.logger.Error("error while streaming directory archive",
 					"service", "client.FileServerHandler",
 					"server", serverUuid,
 					"username", username.String(),
 					"path", path.String(),
-					"err", zipErr,
+					"format", format.Ext(),
+					"err", archiveErr,
 				)
 
 				hijacker, ok := w.(http.Hijacker)
@@ -288,9 +286,9 @@ func (s *FileServerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 						_ = conn.Close()
 					}
 				}
-
-				return nil
 			}
+
+			return nil
 		}
 
 		fileExt := filepath.Ext(path.String())
@@ -299,88 +297,182 @@ func (s *FileServerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 			mimeType = "application/octet-stream"
 		}
 
-		w.Header().Set("Content-Type", mimeType)
-
-		if reqUrl.Query().Has("download") {
-			w.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, meta.Name))
+		if isLink || reqUrl.Query().Has("download") {
+			w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": meta.Name}))
 		}
 
-		// Parse range.
-		rangeHeader := r.Header.Get("Range")
 		fileSize := int64(meta.Size)
-		offset, limit, rangeOk := common.ParseHttpRange(rangeHeader, fileSize)
-		if !rangeOk {
-			text(w, r, http.StatusBadRequest, "invalid range string\n")
+
+		// Validators are derived from the modification time and size, if the peer provided a modification time.
+		// Without them, conditional requests always proceed and If-Range always causes the whole file to be sent.
+		var etag string
+		var lastModified time.Time
+		if meta.ModifiedTs != nil {
+			lastModified = time.Unix(*meta.ModifiedTs, 0)
+			etag = fmt.Sprintf(`"%x-%x"`, *meta.ModifiedTs, meta.Size)
+
+			w.Header().Set("ETag", etag)
+			w.Header().Set("Last-Modified", lastModified.UTC().Format(http.TimeFormat))
+
+			if !reqUrl.Query().Has("allowCache") {
+				// Let the browser keep a copy, but make it revalidate before every use.
+				w.Header().Set("Cache-Control", "private, no-cache")
+				w.Header().Del("Pragma")
+				w.Header().Del("Expires")
+			}
 		}
 
-		// Check if range can be satisfied.
-		if offset+limit > fileSize {
-			text(w, r, http.StatusRequestedRangeNotSatisfiable, fmt.Sprintf("requested range not satisfiable\n"))
+		switch common.EvalHttpConditional(r.Method, r.Header, etag, lastModified) {
+		case http.StatusNotModified:
+			w.WriteHeader(http.StatusNotModified)
+			wroteHeader = true
+			return nil
+		case http.StatusPreconditionFailed:
+			text(w, r, http.StatusPreconditionFailed, "precondition failed\n")
+			return nil
 		}
 
-		{
-			var end int64
-			if limit == 0 {
-				end = fileSize - 1
-			} else {
-				end = offset + limit - 1
-			}
-			w.Header().Set("Content-Range", fmt.Sprintf("bytes %d-%d/%d", offset, end, fileSize))
+		status, ranges := common.EvalHttpRange(r.Header.Get("Range"), r.Header.Get("If-Range"), fileSize, etag, lastModified)
+
+		if status == http.StatusRequestedRangeNotSatisfiable {
+			w.Header().Set("Content-Range", fmt.Sprintf("bytes */%d", fileSize))
+			text(w, r, http.StatusRequestedRangeNotSatisfiable, "requested range not satisfiable\n")
+			return nil
 		}
 
-		{
-			var contentLen int64
-			if limit == 0 {
-				contentLen = fileSize
-			} else {
-				contentLen = limit
+		// copyRange copies a range of the file to dst.
+		// A length of 0 means the rest of the file.
+		copyRange := func(dst io.Writer, offset int64, length int64) error {
+			_, reader, getErr := peer.GetFileContext(ctx, &pb.MsgGetFile{
+				Path: path.String(),
+
+				Offset: uint64(offset),
+				Limit:  uint64(length),
+			})
+			if getErr != nil {
+				return getErr
 			}
+			defer func() {
+				_ = reader.Close()
+			}()
 
-			w.Header().Set("Content-Length", strconv.FormatInt(contentLen, 10))
+			_, copyErr := io.Copy(dst, reader)
+			return copyErr
 		}
 
-		var reader io.ReadCloser
-		_, reader, err = peer.GetFile(&pb.MsgGetFile{
-			Path: path.String(),
+		if len(ranges) > 1 {
+			// Multiple ranges are sent as a multipart/byteranges body, with one part per range.
+			mw := multipart.NewWriter(w)
+			w.Header().Set("Content-Type", "multipart/byteranges; boundary="+mw.Boundary())
+			w.WriteHeader(http.StatusPartialContent)
+			wroteHeader = true
 
-			Offset: uint64(offset),
-			Limit:  uint64(limit),
-		})
-		if err != nil {
-			if errors.Is(err, protocol.ErrPeerUnreachable) {
-				text(w, r, http.StatusBadGateway, "peer unreachable\n")
+			if isHead {
 				return nil
 			}
 
-			return err
-		}
-		defer func() {
-			_ = reader.Close()
-		}()
-		go func() {
-			select {
-			case <-ctx.Done():
-				_ = reader.Close()
+			for _, rng := range ranges {
+				partW, partErr := mw.CreatePart(textproto.MIMEHeader{
+					"Content-Type":  {mimeType},
+					"Content-Range": {rng.ContentRange(fileSize)},
+				})
+				if partErr != nil {
+					return partErr
+				}
+				if err = copyRange(partW, rng.Offset, rng.Length); err != nil {
+					return err
+				}
+				if rng.Offset+rng.Length == fileSize {
+					sentToEnd = true
+				}
 			}
-		}()
 
-		if rangeHeader != "" {
-			w.WriteHeader(http.StatusPartialContent)
+			return mw.Close()
+		}
+
+		w.Header().Set("Content-Type", mimeType)
+
+		var offset, length int64
+		if status == http.StatusPartialContent {
+			offset, length = ranges[0].Offset, ranges[0].Length
+			w.Header().Set("Content-Range", ranges[0].ContentRange(fileSize))
+			w.Header().Set("Content-Length", strconv.FormatInt(length, 10))
+		} else {
+			w.Header().Set("Content-Length", strconv.FormatInt(fileSize, 10))
 		}
 
 		if isHead {
+			w.WriteHeader(status)
+			wroteHeader = true
 			return nil
 		}
 
+		// An empty file has nothing to fetch.
+		if fileSize == 0 {
+			w.WriteHeader(status)
+			wroteHeader = true
+			sentToEnd = true
+			return nil
+		}
+
+		// Reuse the stream from a previous request for the same file if possible.
+		// Fetch before writing the header so that failures can still be reported with a proper status.
+		streamKey := fileStreamKey{
+			server:   serverUuid,
+			username: username,
+			path:     path,
+			etag:     etag,
+			size:     fileSize,
+		}
+		stream := s.streams.Take(ctx, streamKey, offset)
+		if stream == nil {
+			// The stream is requested until the end of the file, rather than just this range, so that it can be reused
+			// if the next request continues where this one stops.
+			var reader io.ReadCloser
+			_, reader, err = peer.GetFile(&pb.MsgGetFile{
+				Path: path.String(),
+
+				Offset: uint64(offset),
+			})
+			if err != nil {
+				if errors.Is(err, protocol.ErrPeerUnreachable) {
+					text(w, r, http.StatusBadGateway, "peer unreachable\n")
+					return nil
+				}
+
+				return err
+			}
+			stream = newPooledFileStream(reader, offset, s.readAhead)
+		}
+
+		if length == 0 {
+			length = fileSize - offset
+		}
+
 		// Write it!
+		w.WriteHeader(status)
 		wroteHeader = true
-		_, err = io.Copy(w, reader)
+		_, err = io.Copy(w, io.LimitReader(stream.Reader(ctx), length))
+
+		// Keep the stream for the next request if it has more to read.
+		// This also applies if the HTTP client went away, since players abort requests when seeking.
+		if stream.pos < fileSize && !stream.ra.Failed() {
+			s.streams.Put(c.Context, streamKey, stream)
+		} else {
+			_ = stream.Close()
+		}
+
 		if err != nil {
 			return err
 		}
 
+		sentToEnd = offset+length == fileSize
 		return nil
 	})
+	if err == nil && isLink && sentToEnd {
+		// Only use up the link once the file was sent, so a download that failed can be retried.
+		s.links.markUsed(pathParts[2])
+	}
 	if err != nil {
 		if opErr, ok := errors.AsType[*net.OpError](err); ok {
 			if errors.Is(opErr.Err, syscall.ECONNRESET) {
@@ -415,3 +507,12 @@ func (s *FileServerHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 }
+
+// remoteHost returns the host of the client that made the request, without the port.
+func remoteHost(r *http.Request) string {
+	host, _, err := net.SplitHostPort(r.RemoteAddr)
+	if err != nil {
+		return r.RemoteAddr
+	}
+	return host
+}
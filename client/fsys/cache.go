@@ -31,11 +31,13 @@ type MetaCache struct {
 	cache      map[metaCacheKey]metaCacheEntry
 	entryTtl   time.Duration
 	gcInterval time.Duration
+	maxEntries int
 }
 
-// NewMetaCache creates a new MetaCache with the specified entry TTL and garbage collection interval.
+// NewMetaCache creates a new MetaCache with the specified entry TTL, garbage collection interval and maximum number of
+// entries, or 0 for no maximum.
 // Entries will be inaccessible if expired even if the GC hasn't run to remove them yet.
-func NewMetaCache(entryTtl time.Duration, gcInterval time.Duration) *MetaCache {
+func NewMetaCache(entryTtl time.Duration, gcInterval time.Duration, maxEntries int) *MetaCache {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	c := &MetaCache{
@@ -45,6 +47,7 @@ func NewMetaCache(entryTtl time.Duration, gcInterval time.Duration) *MetaCache {
 		cache:      make(map[metaCacheKey]metaCacheEntry),
 		entryTtl:   entryTtl,
 		gcInterval: gcInterval,
+		maxEntries: maxEntries,
 	}
 
 	go c.gc()
@@ -76,16 +79,33 @@ func (c *MetaCache) gc() {
 			return
 		case <-ticker.C:
 			c.mu.Lock()
-			for key, entry := range c.cache {
-				if entry.expTs.Before(time.Now()) {
-					delete(c.cache, key)
-				}
-			}
+			c.deleteExpiredNoLock()
 			c.mu.Unlock()
 		}
 	}
 }
 
+func (c *MetaCache) deleteExpiredNoLock() {
+	now := time.Now()
+	for key, entry := range c.cache {
+		if entry.expTs.Before(now) {
+			delete(c.cache, key)
+		}
+	}
+}
+
+// Len returns the number of entries in the cache, including expired ones the GC has not removed yet.
+func (c *MetaCache) Len() int {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return len(c.cache)
+}
+
+// MaxEntries returns the maximum number of entries in the cache, or 0 if there is no maximum.
+func (c *MetaCache) MaxEntries() int {
+	return c.maxEntries
+}
+
 // Get returns a cached file metadata entry for a path.
 // Returns false if the entry does not exist, has expired, or if the cache is closed.
 func (c *MetaCache) Get(keyPrefix string, path common.ProtoPath) (*pb.MsgFileMeta, bool) {
@@ -114,6 +134,7 @@ func (c *MetaCache) Get(keyPrefix string, path common.ProtoPath) (*pb.MsgFileMet
 
 // Set adds a file metadata entry to the cache.
 // If there is a previous entry, it overwrites it.
+// If the cache is full, expired entries are removed, and if that is not enough, an arbitrary entry is evicted.
 // No-op if the cache is closed.
 func (c *MetaCache) Set(keyPrefix string, path common.ProtoPath, meta *pb.MsgFileMeta) {
 	c.mu.Lock()
@@ -127,6 +148,16 @@ func (c *MetaCache) Set(keyPrefix string, path common.ProtoPath, meta *pb.MsgFil
 		path:   path,
 	}
 
+	if _, has := c.cache[key]; !has && c.maxEntries > 0 && len(c.cache) >= c.maxEntries {
+		c.deleteExpiredNoLock()
+		for evictKey := range c.cache {
+			if len(c.cache) < c.maxEntries {
+				break
+			}
+			delete(c.cache, evictKey)
+		}
+	}
+
 	c.cache[key] = metaCacheEntry{
 		meta:  meta,
 		expTs: time.Now().Add(c.entryTtl),
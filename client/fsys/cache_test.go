@@ -0,0 +1,37 @@
+package fsys
+
+import (
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+func TestMetaCache_MaxEntries(t *testing.T) {
+	c := NewMetaCache(time.Minute, time.Hour, 2)
+	defer func() {
+		_ = c.Close()
+	}()
+
+	paths := []common.ProtoPath{
+		common.UncheckedCreateProtoPath("/a"),
+		common.UncheckedCreateProtoPath("/b"),
+		common.UncheckedCreateProtoPath("/c"),
+	}
+	for _, path := range paths {
+		c.Set("peer", path, &pb.MsgFileMeta{Name: path.String()})
+	}
+	if n := c.Len(); n != 2 {
+		t.Fatalf("expected 2 entries, got %d", n)
+	}
+	if _, has := c.Get("peer", paths[2]); !has {
+		t.Fatal("expected the newest entry to be cached")
+	}
+
+	// Overwriting an existing entry does not evict another one.
+	c.Set("peer", paths[2], &pb.MsgFileMeta{Name: "c2"})
+	if n := c.Len(); n != 2 {
+		t.Fatalf("expected 2 entries after overwriting, got %d", n)
+	}
+}
@@ -0,0 +1,34 @@
+package fsys
+
+import (
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// ErrFreeSpaceUnsupported is returned by FreeSpace on platforms where free disk space cannot be determined.
+var ErrFreeSpaceUnsupported = errors.New("checking free disk space is not supported on this platform")
+
+// FreeSpace returns the number of bytes available to the current user on the filesystem that contains the specified
+// path. If the path does not exist yet, its nearest existing parent is used.
+// Returns ErrFreeSpaceUnsupported if free space cannot be determined on this platform.
+func FreeSpace(path string) (uint64, error) {
+	p := filepath.Clean(path)
+
+	for {
+		_, err := os.Stat(p)
+		if err == nil {
+			return freeSpace(p)
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return 0, err
+		}
+
+		parent := filepath.Dir(p)
+		if parent == p {
+			return 0, err
+		}
+		p = parent
+	}
+}
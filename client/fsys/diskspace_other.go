@@ -0,0 +1,7 @@
+//go:build !linux && !darwin && !freebsd && !windows
+
+package fsys
+
+func freeSpace(_ string) (uint64, error) {
+	return 0, ErrFreeSpaceUnsupported
+}
@@ -2,7 +2,6 @@ package multifs
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"io"
 	"io/fs"
@@ -54,6 +53,14 @@ func WithServerConnectTimeout(timeout time.Duration) Option {
 	}
 }
 
+// WithReadAhead configures a MultiFs to read up to size bytes ahead of what is being read from peers' files.
+// See peerfs.WithReadAhead.
+func WithReadAhead(size int) Option {
+	return func(mfs *MultiFs) {
+		mfs.readAhead = size
+	}
+}
+
 // MultiFs implements fs.FS in a way that provides a user-friendly, browsable filesystem for all
 // servers and clients within them.
 //
@@ -78,6 +85,7 @@ type MultiFs struct {
 
 	cacheOrNil     *fsys.MetaCache
 	nannyFsTimeout time.Duration
+	readAhead      int
 }
 
 // NewMultiFs creates a new MultiFs instance with the specified MultiClient.
@@ -171,6 +179,9 @@ func (mfs *MultiFs) mkNannyFs(srv *client.Server, username common.NormalizedUser
 	if mfs.cacheOrNil != nil {
 		opts = append(opts, peerfs.WithMetaCache(mfs.cacheOrNil, srv.Uuid+"/"+username.String()))
 	}
+	if mfs.readAhead > 0 {
+		opts = append(opts, peerfs.WithReadAhead(mfs.readAhead))
+	}
 
 	ctx, cancel := mfs.mkCnTimeoutCtx()
 	return peerfs.NewNannyFs(ctx, srv.ConnNanny, username, opts...), cancel
@@ -348,21 +359,17 @@ func (f *ServerFile) ReadDir(n int) ([]fs.DirEntry, error) {
 		entries = make([]fs.DirEntry, 0)
 	}
 
-	var wasEof bool
+	// Set to false if the limit is reached before the stream ends.
+	wasEof := true
 readLoop:
-	for {
-		next, nextErr := stream.ReadNext()
+	for next, nextErr := range protocol.All(context.Background(), stream) {
 		if nextErr != nil {
-			if errors.Is(nextErr, io.EOF) {
-				wasEof = true
-				_ = stream.Close()
-				break
-			}
 			return entries, nextErr
 		}
 
 		for _, user := range next.Users {
 			if len(entries) >= limit {
+				wasEof = false
 				break readLoop
 			}
 
@@ -371,6 +378,8 @@ readLoop:
 	}
 
 	if wasEof {
+		_ = stream.Close()
+
 		f.mu.Lock()
 		f.ended = true
 		f.mu.Unlock()
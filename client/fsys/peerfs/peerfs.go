@@ -1,6 +1,7 @@
 package peerfs
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -33,6 +34,14 @@ func WithMetaCache(cache *fsys.MetaCache, keyPrefix string) Option {
 	}
 }
 
+// WithReadAhead configures a PeerFs to read up to size bytes ahead of what is being read from files.
+// By default, files are only requested from the peer as they are read.
+func WithReadAhead(size int) Option {
+	return func(pfs *PeerFs) {
+		pfs.readAhead = size
+	}
+}
+
 // PeerFs implements fs.FS that exposes a peer's shares.
 // It is stateless but can optionally use a MetaCache to cache metadata.
 //
@@ -44,6 +53,10 @@ type PeerFs struct {
 
 	cacheOrNil  *fsys.MetaCache
 	cachePrefix string
+
+	// How many bytes to read ahead of what is being read from files.
+	// 0 disables reading ahead.
+	readAhead int
 }
 
 // NewPeerFs creates a new PeerFs with the specified room connection, peer username, and options.
@@ -265,6 +278,9 @@ func (f *RegularFile) Read(bytes []byte) (int, error) {
 		if err != nil {
 			return 0, f.pfs.refineError(err)
 		}
+		if f.pfs.readAhead > 0 {
+			r = fsys.NewReadAheadReader(r, f.pfs.readAhead)
+		}
 		f.mu.Lock()
 		f.curReader = r
 		f.mu.Unlock()
@@ -434,21 +450,17 @@ func (f *DirFile) ReadDir(n int) ([]fs.DirEntry, error) {
 	cache := f.pfs.cacheOrNil
 	keyPrefix := f.pfs.cachePrefix
 
-	var wasEof bool
+	// Set to false if the limit is reached before the stream ends.
+	wasEof := true
 readLoop:
-	for {
-		next, nextErr := stream.ReadNext()
+	for next, nextErr := range protocol.All(context.Background(), stream) {
 		if nextErr != nil {
-			if errors.Is(nextErr, io.EOF) {
-				wasEof = true
-				_ = stream.Close()
-				break
-			}
 			return entries, f.pfs.refineError(nextErr)
 		}
 
 		for _, file := range next.Files {
 			if len(entries) >= limit {
+				wasEof = false
 				break readLoop
 			}
 
@@ -462,6 +474,8 @@ readLoop:
 	}
 
 	if wasEof {
+		_ = stream.Close()
+
 		f.mu.Lock()
 		f.ended = true
 		f.mu.Unlock()
@@ -0,0 +1,147 @@
+package fsys
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+)
+
+// ReadAheadSetting is the setting key for how many MiB of a peer's file are requested ahead of what is being read
+// when streaming it through the file server or WebDAV.
+// Reading ahead hides the latency to the peer, which keeps playback smooth when streaming media over slow links.
+const ReadAheadSetting = "read_ahead_mib"
+
+// DefaultReadAheadMib is the default value of ReadAheadSetting.
+const DefaultReadAheadMib = 4
+
+// MaxReadAheadMib is the maximum value of ReadAheadSetting.
+const MaxReadAheadMib = 256
+
+// readAheadChunkSize is the size of the chunks read ahead from the source.
+const readAheadChunkSize = 64 * 1024
+
+// readAheadBuffered is the number of bytes read ahead by all ReadAheadReaders that have not been read from them yet.
+var readAheadBuffered atomic.Int64
+
+// ReadAheadBufferedBytes returns the number of bytes all ReadAheadReaders are currently holding in their buffers.
+// Bytes are counted from when they are read from the source until they are taken from the reader's queue.
+func ReadAheadBufferedBytes() int64 {
+	return readAheadBuffered.Load()
+}
+
+// ReadAheadReader reads from a source in the background so data is ready before it is needed.
+// Reads and Close must not be called concurrently with each other.
+type ReadAheadReader struct {
+	src    io.ReadCloser
+	chunks chan []byte
+	cur    []byte
+
+	done      chan struct{}
+	closeOnce sync.Once
+
+	errMu sync.Mutex
+	err   error
+}
+
+var _ io.ReadCloser = (*ReadAheadReader)(nil)
+
+// NewReadAheadReader starts reading up to size bytes ahead from src.
+// At least one chunk is always read ahead, even if size is 0.
+// Closing the ReadAheadReader closes src.
+func NewReadAheadReader(src io.ReadCloser, size int) *ReadAheadReader {
+	r := &ReadAheadReader{
+		src:    src,
+		chunks: make(chan []byte, size/readAheadChunkSize),
+		done:   make(chan struct{}),
+	}
+
+	go r.fill()
+
+	return r
+}
+
+func (r *ReadAheadReader) fill() {
+	defer close(r.chunks)
+
+	for {
+		buf := make([]byte, readAheadChunkSize)
+		n, err := r.src.Read(buf)
+		if n > 0 {
+			readAheadBuffered.Add(int64(n))
+			select {
+			case r.chunks <- buf[:n]:
+			case <-r.done:
+				readAheadBuffered.Add(-int64(n))
+				return
+			}
+		}
+		if err != nil {
+			r.errMu.Lock()
+			r.err = err
+			r.errMu.Unlock()
+			return
+		}
+	}
+}
+
+// finalErr returns the error that ended the background reads, or nil if they have not ended.
+func (r *ReadAheadReader) finalErr() error {
+	r.errMu.Lock()
+	defer r.errMu.Unlock()
+	return r.err
+}
+
+// Failed returns whether the source failed with an error other than io.EOF.
+func (r *ReadAheadReader) Failed() bool {
+	err := r.finalErr()
+	return err != nil && !errors.Is(err, io.EOF)
+}
+
+// Read reads into p, waiting for data to be read from the source if none is ready.
+func (r *ReadAheadReader) Read(p []byte) (int, error) {
+	return r.ReadContext(context.Background(), p)
+}
+
+// ReadContext reads into p.
+// If no data is available before ctx is done, returns ctx's error without affecting the stream.
+func (r *ReadAheadReader) ReadContext(ctx context.Context, p []byte) (int, error) {
+	if len(r.cur) == 0 {
+		select {
+		case chunk, ok := <-r.chunks:
+			if !ok {
+				if err := r.finalErr(); err != nil {
+					return 0, err
+				}
+				return 0, io.EOF
+			}
+			readAheadBuffered.Add(-int64(len(chunk)))
+			r.cur = chunk
+		case <-ctx.Done():
+			return 0, ctx.Err()
+		}
+	}
+
+	n := copy(p, r.cur)
+	r.cur = r.cur[n:]
+	return n, nil
+}
+
+// Close stops reading ahead and closes the source.
+// Subsequent calls are no-op.
+func (r *ReadAheadReader) Close() error {
+	var err error
+	r.closeOnce.Do(func() {
+		close(r.done)
+		err = r.src.Close()
+
+		// Uncount the chunks that will never be read.
+		go func() {
+			for chunk := range r.chunks {
+				readAheadBuffered.Add(-int64(len(chunk)))
+			}
+		}()
+	})
+	return err
+}
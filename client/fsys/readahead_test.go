@@ -0,0 +1,68 @@
+package fsys
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"io"
+	"testing"
+	"time"
+)
+
+type blockingReadCloser struct {
+	unblock chan struct{}
+	closed  bool
+}
+
+func (r *blockingReadCloser) Read([]byte) (int, error) {
+	<-r.unblock
+	return 0, io.EOF
+}
+
+func (r *blockingReadCloser) Close() error {
+	r.closed = true
+	close(r.unblock)
+	return nil
+}
+
+func TestReadAheadReader_ReadsWholeSource(t *testing.T) {
+	t.Parallel()
+
+	data := bytes.Repeat([]byte("friendnet"), readAheadChunkSize)
+	r := NewReadAheadReader(io.NopCloser(bytes.NewReader(data)), 4*readAheadChunkSize)
+	defer func() {
+		_ = r.Close()
+	}()
+
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(got, data) {
+		t.Fatalf("expected %d bytes matching the source, got %d bytes", len(data), len(got))
+	}
+	if r.Failed() {
+		t.Fatal("expected reader to not have failed after EOF")
+	}
+}
+
+func TestReadAheadReader_ReadContextDoesNotWaitPastCtx(t *testing.T) {
+	t.Parallel()
+
+	src := &blockingReadCloser{unblock: make(chan struct{})}
+	r := NewReadAheadReader(src, 0)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	if _, err := r.ReadContext(ctx, make([]byte, 1)); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+
+	if err := r.Close(); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !src.closed {
+		t.Fatal("expected source to be closed")
+	}
+}
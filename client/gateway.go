@@ -0,0 +1,306 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/fs"
+	"log/slog"
+	"mime"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"friendnet.org/client/share"
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+)
+
+// GatewayPathPrefix is the path prefix of share links served by ShareGateway.
+// Links are served at /s/:TOKEN/:PATH..., where :PATH is relative to the path the link points to.
+const GatewayPathPrefix = "/s/"
+
+// ShareLinkTokenBytes is the number of random bytes in share link tokens.
+const ShareLinkTokenBytes = 24
+
+// ErrShareLinkNotFound is returned when a share link does not exist or has expired.
+var ErrShareLinkNotFound = errors.New("share link not found")
+
+// ShareGateway is an HTTP handler that serves files in local shares to people who do not use FriendNet, such as over
+// a public port.
+// Access is only given through share links, which are random tokens that point to a path in a share. Everything is
+// read-only.
+type ShareGateway struct {
+	logger  *slog.Logger
+	multi   *MultiClient
+	storage *storage.Storage
+
+	// The public base URL of the gateway, without a trailing slash, or empty if it is disabled.
+	publicUrl string
+}
+
+// NewShareGateway creates a new ShareGateway.
+// publicUrl is the base URL that people reach the gateway at, used to build link URLs. If empty, the gateway is
+// treated as disabled, but links can still be managed.
+func NewShareGateway(
+	logger *slog.Logger,
+	multi *MultiClient,
+	storage *storage.Storage,
+	publicUrl string,
+) *ShareGateway {
+	return &ShareGateway{
+		logger:  logger,
+		multi:   multi,
+		storage: storage,
+
+		publicUrl: strings.TrimSuffix(publicUrl, "/"),
+	}
+}
+
+var _ http.Handler = (*ShareGateway)(nil)
+
+// IsEnabled returns whether the gateway is enabled, meaning share links have URLs.
+func (g *ShareGateway) IsEnabled() bool {
+	return g.publicUrl != ""
+}
+
+// LinkUrl returns the URL of the share link with the specified token.
+// Returns false if the gateway is disabled.
+func (g *ShareGateway) LinkUrl(token string) (string, bool) {
+	if g.publicUrl == "" {
+		return "", false
+	}
+	return g.publicUrl + GatewayPathPrefix + token + "/", true
+}
+
+// CreateLink creates a link to a path in a share.
+// If expiresIn is 0, the link never expires.
+// Returns fs.ErrNotExist if the path does not exist in the share.
+func (g *ShareGateway) CreateLink(
+	ctx context.Context,
+	shareRecord storage.ShareRecord,
+	path common.ProtoPath,
+	expiresIn time.Duration,
+) (storage.ShareLinkRecord, error) {
+	shr, err := g.getShare(shareRecord)
+	if err != nil {
+		return storage.ShareLinkRecord{}, err
+	}
+	if _, err = shr.GetFileMeta(path); err != nil {
+		return storage.ShareLinkRecord{}, err
+	}
+
+	var expiresTs *time.Time
+	if expiresIn > 0 {
+		expiresTs = new(time.Now().Add(expiresIn))
+	}
+
+	return g.storage.CreateShareLink(ctx, common.RandomB64UrlStr(ShareLinkTokenBytes), shareRecord.Uuid, path, expiresTs)
+}
+
+// getShare returns the share that a share record refers to.
+// Returns fs.ErrNotExist if its server or the share itself no longer exists.
+func (g *ShareGateway) getShare(record storage.ShareRecord) (share.Share, error) {
+	server, has := g.multi.GetByUuid(record.Server)
+	if !has {
+		return nil, fs.ErrNotExist
+	}
+	shr, has := server.ShareMgr.GetByName(record.Name)
+	if !has {
+		return nil, fs.ErrNotExist
+	}
+	return shr, nil
+}
+
+// resolveLink returns the share a link points to.
+// Returns ErrShareLinkNotFound if the link does not exist, has expired or its share no longer exists.
+func (g *ShareGateway) resolveLink(ctx context.Context, token string) (storage.ShareLinkRecord, share.Share, error) {
+	link, has, err := g.storage.GetShareLink(ctx, token)
+	if err != nil {
+		return link, nil, err
+	}
+	if !has || link.IsExpired() {
+		return link, nil, ErrShareLinkNotFound
+	}
+
+	shareRecord, has, err := g.storage.GetShareByUuid(ctx, link.Share)
+	if err != nil {
+		return link, nil, err
+	}
+	if !has {
+		return link, nil, ErrShareLinkNotFound
+	}
+
+	shr, err := g.getShare(shareRecord)
+	if err != nil {
+		return link, nil, ErrShareLinkNotFound
+	}
+	return link, shr, nil
+}
+
+func (g *ShareGateway) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	text := func(status int, text string) {
+		w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		w.WriteHeader(status)
+		if r.Method != http.MethodHead {
+			_, _ = w.Write([]byte(text))
+		}
+	}
+
+	switch r.Method {
+	case http.MethodGet, http.MethodHead:
+	default:
+		text(http.StatusMethodNotAllowed, "method not allowed\n")
+		return
+	}
+
+	// Links are secrets, so they must not leak to other sites or caches.
+	w.Header().Set("Content-Security-Policy", "default-src 'none'; img-src 'self' data:; style-src 'self' 'unsafe-inline'; media-src 'self' data:; base-uri 'none'; form-action 'none'; sandbox")
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+	w.Header().Set("Cache-Control", "private, no-cache")
+
+	token, rest, _ := strings.Cut(strings.TrimPrefix(r.URL.Path, GatewayPathPrefix), "/")
+	if token == "" {
+		http.NotFound(w, r)
+		return
+	}
+
+	link, shr, err := g.resolveLink(r.Context(), token)
+	if err != nil {
+		if errors.Is(err, ErrShareLinkNotFound) {
+			http.NotFound(w, r)
+			return
+		}
+		g.logger.Error("failed to resolve share link",
+			"service", "client.ShareGateway",
+			"err", err,
+		)
+		text(http.StatusInternalServerError, "internal error\n")
+		return
+	}
+
+	relPath, err := common.NormalizePath("/" + strings.TrimSuffix(rest, "/"))
+	if err != nil {
+		text(http.StatusBadRequest, "invalid path\n")
+		return
+	}
+	path := common.JoinPaths(link.Path, relPath)
+
+	meta, err := shr.GetFileMeta(path)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) || errors.Is(err, fs.ErrPermission) {
+			http.NotFound(w, r)
+			return
+		}
+		g.logger.Error("failed to get file metadata for share link",
+			"service", "client.ShareGateway",
+			"share", shr.Name(),
+			"path", path.String(),
+			"err", err,
+		)
+		text(http.StatusInternalServerError, "internal error\n")
+		return
+	}
+
+	if meta.IsDir {
+		files, listErr := shr.DirFiles(path)
+		if listErr != nil {
+			g.logger.Error("failed to list directory for share link",
+				"service", "client.ShareGateway",
+				"share", shr.Name(),
+				"path", path.String(),
+				"err", listErr,
+			)
+			text(http.StatusInternalServerError, "internal error\n")
+			return
+		}
+
+		_ = writeDirListing(w, r, GatewayPathPrefix+token, relPath, files, false)
+		return
+	}
+
+	mimeType := mime.TypeByExtension(filepath.Ext(meta.Name))
+	if mimeType == "" {
+		mimeType = "application/octet-stream"
+	}
+	w.Header().Set("Content-Type", mimeType)
+	if r.URL.Query().Has("download") {
+		w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": meta.Name}))
+	}
+
+	var modTime time.Time
+	if meta.ModifiedTs != nil {
+		modTime = time.Unix(*meta.ModifiedTs, 0)
+	}
+
+	content := &shareFileReader{
+		share: shr,
+		path:  path,
+		size:  int64(meta.Size),
+	}
+	defer func() {
+		_ = content.Close()
+	}()
+
+	// ServeContent handles ranges and conditional requests.
+	http.ServeContent(w, r, meta.Name, modTime, content)
+}
+
+// shareFileReader is an io.ReadSeeker over a file in a share.
+// The file is opened at the current offset on the first read after a seek, so seeking is cheap.
+type shareFileReader struct {
+	share share.Share
+	path  common.ProtoPath
+	size  int64
+
+	offset int64
+	rc     io.ReadCloser
+}
+
+func (f *shareFileReader) Read(p []byte) (int, error) {
+	if f.rc == nil {
+		_, rc, err := f.share.GetFile(f.path, uint64(f.offset), 0)
+		if err != nil {
+			return 0, err
+		}
+		f.rc = rc
+	}
+
+	n, err := f.rc.Read(p)
+	f.offset += int64(n)
+	return n, err
+}
+
+func (f *shareFileReader) Seek(offset int64, whence int) (int64, error) {
+	var abs int64
+	switch whence {
+	case io.SeekStart:
+		abs = offset
+	case io.SeekCurrent:
+		abs = f.offset + offset
+	case io.SeekEnd:
+		abs = f.size + offset
+	default:
+		return 0, errors.New("invalid whence")
+	}
+	if abs < 0 {
+		return 0, errors.New("negative position")
+	}
+
+	if abs != f.offset {
+		_ = f.Close()
+		f.offset = abs
+	}
+	return abs, nil
+}
+
+func (f *shareFileReader) Close() error {
+	if f.rc == nil {
+		return nil
+	}
+	err := f.rc.Close()
+	f.rc = nil
+	return err
+}
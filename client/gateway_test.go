@@ -0,0 +1,49 @@
+package client
+
+import (
+	"bytes"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"friendnet.org/client/share"
+	"friendnet.org/common"
+)
+
+func TestShareFileReaderRange(t *testing.T) {
+	dir := t.TempDir()
+	data := bytes.Repeat([]byte("0123456789"), 100)
+	if err := os.WriteFile(filepath.Join(dir, "file.txt"), data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	shr, err := share.NewDirShare("test", dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	content := &shareFileReader{
+		share: shr,
+		path:  common.UncheckedCreateProtoPath("/file.txt"),
+		size:  int64(len(data)),
+	}
+	defer func() {
+		_ = content.Close()
+	}()
+
+	req := httptest.NewRequest(http.MethodGet, "/s/token/file.txt", nil)
+	req.Header.Set("Range", "bytes=105-114")
+	rec := httptest.NewRecorder()
+	http.ServeContent(rec, req, "file.txt", time.Time{}, content)
+
+	if rec.Code != http.StatusPartialContent {
+		t.Fatalf("got status %d, want %d", rec.Code, http.StatusPartialContent)
+	}
+	body, _ := io.ReadAll(rec.Body)
+	if !bytes.Equal(body, data[105:115]) {
+		t.Fatalf("got body %q, want %q", body, data[105:115])
+	}
+}
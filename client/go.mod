@@ -7,26 +7,38 @@ require (
 	friendnet.org/common v0.0.0
 	friendnet.org/mkcert v0.0.0
 	friendnet.org/protocol v0.0.0
+	friendnet.org/server v0.0.0
 	friendnet.org/updater v0.0.0
 	friendnet.org/upnp v0.0.0
 	friendnet.org/webui v0.0.0
+	github.com/fsnotify/fsnotify v1.10.1
 	github.com/google/uuid v1.6.0
 	github.com/pkg/browser v0.0.0-20240102092130-5ac0b6a4141c
 	github.com/quic-go/quic-go v0.59.0
 	golang.org/x/net v0.50.0
-	golang.org/x/sys v0.41.0
+	golang.org/x/sync v0.19.0
+	golang.org/x/sys v0.42.0
+	golang.org/x/term v0.41.0
+	golang.org/x/text v0.34.0
 	google.golang.org/protobuf v1.36.11
 	modernc.org/sqlite v1.46.1
 )
 
 require (
+	connectrpc.com/grpchealth v1.4.0 // indirect
+	connectrpc.com/grpcreflect v1.3.1 // indirect
 	github.com/dustin/go-humanize v1.0.1 // indirect
+	github.com/jackc/pgpassfile v1.0.0 // indirect
+	github.com/jackc/pgservicefile v0.0.0-20240606120523-5a60cdf6a761 // indirect
+	github.com/jackc/pgx/v5 v5.11.0 // indirect
+	github.com/jackc/puddle/v2 v2.2.2 // indirect
 	github.com/mattn/go-isatty v0.0.20 // indirect
 	github.com/ncruces/go-strftime v1.0.0 // indirect
+	github.com/quic-go/qpack v0.6.0 // indirect
 	github.com/remyoudompheng/bigfft v0.0.0-20230129092748-24d4a6f8daec // indirect
+	github.com/termermc/go-mcf-password v1.0.0 // indirect
 	golang.org/x/crypto v0.48.0 // indirect
 	golang.org/x/exp v0.0.0-20260218203240-3dfff04db8fa // indirect
-	golang.org/x/text v0.34.0 // indirect
 	howett.net/plist v1.0.1 // indirect
 	modernc.org/libc v1.68.0 // indirect
 	modernc.org/mathutil v1.7.1 // indirect
@@ -34,9 +46,12 @@ require (
 )
 
 replace (
+	friendnet.org/adminui => ../adminui
 	friendnet.org/common => ../common
 	friendnet.org/mkcert => ../mkcert
 	friendnet.org/protocol => ../protocol
+	friendnet.org/rpcclient => ../rpcclient
+	friendnet.org/server => ../server
 	friendnet.org/updater => ../updater
 	friendnet.org/upnp => ../upnp
 	friendnet.org/webui => ../webui
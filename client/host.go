@@ -0,0 +1,422 @@
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/tls"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync"
+	"time"
+
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+	"friendnet.org/common/machine"
+	"friendnet.org/protocol"
+	"friendnet.org/server"
+	"friendnet.org/server/cert"
+	"friendnet.org/server/config"
+	"friendnet.org/server/lobby"
+	srvroom "friendnet.org/server/room"
+	srvstorage "friendnet.org/server/storage"
+)
+
+// A client can host a room on an embedded FriendNet server, so that a group of friends can start sharing without anyone
+// deploying a separate server. The embedded server is a regular server with the default settings, running in the
+// client's process and keeping its data in its own directory. It has a single room, which friends join with invite
+// links; registration requires an invite code, so nobody else can create an account.
+//
+// The host joins the room like any other member, through a server record pointing at the embedded server on
+// localhost, so everything else in the client works with it as usual.
+
+const (
+	// HostRoomSetting is the setting key for the name of the room hosted on the embedded server.
+	// If empty, the client does not host a room.
+	HostRoomSetting = "host_room"
+
+	// HostPortSetting is the setting key for the UDP port the embedded server listens on.
+	HostPortSetting = "host_port"
+
+	// HostServerUuidSetting is the setting key for the UUID of the server record the host joins its own room with.
+	HostServerUuidSetting = "host_server_uuid"
+)
+
+// DefaultHostPort is the default UDP port the embedded server listens on.
+const DefaultHostPort = protocol.DefaultServerPort
+
+// ErrNotHosting is returned when trying to use the embedded server while the client is not hosting a room.
+var ErrNotHosting = errors.New("not hosting a room")
+
+// ErrAlreadyHosting is returned when trying to host a room while the client is already hosting one.
+var ErrAlreadyHosting = errors.New("already hosting a room")
+
+// HostStatus is the status of the embedded server.
+type HostStatus struct {
+	// Whether the client is hosting a room.
+	// If false, the other fields are empty.
+	Hosting bool
+
+	// The name of the hosted room.
+	Room common.NormalizedRoomName
+
+	// The UDP port the embedded server listens on.
+	Port uint16
+
+	// The fingerprint of the embedded server's certificate.
+	CertFingerprint string
+
+	// The UUID of the server record the host joined the room with, or empty if it was deleted.
+	ServerUuid string
+
+	// The number of clients online in the room, including the host.
+	OnlineClients int
+
+	// When the embedded server started.
+	StartTs time.Time
+}
+
+// Host runs an embedded FriendNet server that hosts a room.
+// It is safe for concurrent use.
+type Host struct {
+	mu sync.Mutex
+
+	logger *slog.Logger
+	multi  *MultiClient
+	store  *storage.Storage
+
+	// The directory the embedded server keeps its database and certificate in.
+	dir string
+
+	// The running embedded server, or nil if not hosting.
+	srv             *server.Server
+	srvStorage      srvstorage.Storage
+	listeners       []protocol.ProtoListener
+	room            *srvroom.Room
+	port            uint16
+	certFingerprint string
+}
+
+// NewHost creates a new Host that keeps the embedded server's data in dir.
+// It does not start hosting until Start or Resume is called.
+func NewHost(logger *slog.Logger, multi *MultiClient, store *storage.Storage, dir string) *Host {
+	return &Host{
+		logger: logger,
+		multi:  multi,
+		store:  store,
+		dir:    dir,
+	}
+}
+
+// Resume starts hosting the room that was being hosted when the client last stopped, if any.
+func (h *Host) Resume(ctx context.Context) error {
+	roomStr, err := h.store.GetSettingOr(ctx, HostRoomSetting, "")
+	if err != nil {
+		return err
+	}
+	if roomStr == "" {
+		return nil
+	}
+	roomName, ok := common.NormalizeRoomName(roomStr)
+	if !ok {
+		return fmt.Errorf(`invalid hosted room name %q in settings`, roomStr)
+	}
+	port, err := h.store.GetSettingIntOr(ctx, HostPortSetting, DefaultHostPort)
+	if err != nil {
+		return err
+	}
+
+	return h.start(ctx, roomName, uint16(port))
+}
+
+// Start starts the embedded server and hosts a room on it, creating the room if it does not exist yet.
+// If port is 0, DefaultHostPort is used.
+//
+// The first time a room is hosted, an account named username is created for the host, along with a server record
+// to join the room with. Later, the existing server record is used, and username is ignored.
+// The room is hosted again when the client restarts, until Stop is called.
+//
+// Returns ErrAlreadyHosting if a room is already being hosted.
+func (h *Host) Start(ctx context.Context, roomName common.NormalizedRoomName, port uint16, username common.NormalizedUsername) (HostStatus, error) {
+	if port == 0 {
+		port = DefaultHostPort
+	}
+
+	if err := h.start(ctx, roomName, port); err != nil {
+		return HostStatus{}, err
+	}
+
+	if err := h.store.PutSetting(ctx, HostRoomSetting, roomName.String()); err != nil {
+		_ = h.Stop(ctx)
+		return HostStatus{}, err
+	}
+	if err := h.store.PutSettingInt(ctx, HostPortSetting, int64(port)); err != nil {
+		_ = h.Stop(ctx)
+		return HostStatus{}, err
+	}
+
+	if err := h.joinAsHost(ctx, username); err != nil {
+		_ = h.Stop(ctx)
+		return HostStatus{}, fmt.Errorf(`failed to join hosted room: %w`, err)
+	}
+
+	return h.Status(), nil
+}
+
+func (h *Host) start(ctx context.Context, roomName common.NormalizedRoomName, port uint16) error {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.srv != nil {
+		return ErrAlreadyHosting
+	}
+
+	if err := os.MkdirAll(h.dir, 0700); err != nil {
+		return fmt.Errorf(`failed to create hosted server directory %q: %w`, h.dir, err)
+	}
+
+	serverCert, err := cert.ReadOrCreatePem(filepath.Join(h.dir, "server.pem"), cert.ServerCommonName, false)
+	if err != nil {
+		return fmt.Errorf(`failed to load hosted server certificate: %w`, err)
+	}
+
+	srvStorage, err := srvstorage.NewSqliteStorage(h.logger, filepath.Join(h.dir, "server.db"))
+	if err != nil {
+		return fmt.Errorf(`failed to open hosted server database: %w`, err)
+	}
+
+	authLimit := config.DefaultAuthRateLimit
+	srv, err := server.NewServer(
+		h.logger,
+		srvStorage,
+		machine.ConnMethodSupport{},
+		config.DefaultPasswordPolicy.ToRequirements(),
+		&lobby.AuthLimiterConfig{
+			MaxIpFailures:      authLimit.MaxIpFailures,
+			MaxAccountFailures: authLimit.MaxAccountFailures,
+			Window:             time.Duration(authLimit.WindowSeconds) * time.Second,
+			Lockout:            time.Duration(authLimit.LockoutSeconds) * time.Second,
+		},
+		lobby.RegistrationConfig{
+			Enabled:           true,
+			RequireInviteCode: true,
+		},
+		lobby.DefaultSettings(),
+		protocol.DefaultConnLimits,
+		srvroom.RelayConfig{},
+	)
+	if err != nil {
+		_ = srvStorage.Close()
+		return fmt.Errorf(`failed to create hosted server: %w`, err)
+	}
+
+	r, has := srv.RoomManager.GetRoomByName(roomName)
+	if !has {
+		r, err = srv.RoomManager.CreateRoom(ctx, roomName, srvroom.Metadata{Listed: true})
+		if err != nil {
+			_ = srv.Close()
+			_ = srvStorage.Close()
+			return fmt.Errorf(`failed to create hosted room %q: %w`, roomName.String(), err)
+		}
+	}
+
+	tlsCfg := &tls.Config{
+		MinVersion:   tls.VersionTLS13,
+		Certificates: []tls.Certificate{serverCert},
+		NextProtos:   []string{protocol.AlpnProtoName},
+	}
+	// Listeners are created here rather than by Server.Listen, so that a port that is already in use is reported.
+	portStr := strconv.Itoa(int(port))
+	var listeners []protocol.ProtoListener
+	for _, addr := range []string{net.JoinHostPort("0.0.0.0", portStr), net.JoinHostPort("::", portStr)} {
+		listener, listenErr := protocol.NewQuicProtoListener(addr, tlsCfg, protocol.DefaultConnLimits)
+		if listenErr != nil {
+			for _, l := range listeners {
+				_ = l.Close()
+			}
+			_ = srv.Close()
+			_ = srvStorage.Close()
+			return fmt.Errorf(`failed to listen on %q: %w`, addr, listenErr)
+		}
+		listeners = append(listeners, listener)
+	}
+	for _, listener := range listeners {
+		go func() {
+			if listenErr := srv.ListenWith(listener); listenErr != nil {
+				h.logger.Error("hosted server stopped listening",
+					"service", "client.Host",
+					"err", listenErr,
+				)
+			}
+		}()
+	}
+
+	h.srv = srv
+	h.listeners = listeners
+	h.srvStorage = srvStorage
+	h.room = r
+	h.port = port
+	h.certFingerprint = protocol.CertFingerprint(serverCert.Certificate[0])
+
+	h.logger.Info("hosting room on embedded server",
+		"service", "client.Host",
+		"room", roomName.String(),
+		"port", port,
+	)
+
+	return nil
+}
+
+// joinAsHost creates the host's account and a server record to join the hosted room with, unless the server record
+// already exists.
+func (h *Host) joinAsHost(ctx context.Context, username common.NormalizedUsername) error {
+	serverUuid, err := h.store.GetSettingOr(ctx, HostServerUuidSetting, "")
+	if err != nil {
+		return err
+	}
+	if _, has := h.multi.GetByUuid(serverUuid); has {
+		return nil
+	}
+
+	h.mu.Lock()
+	r := h.room
+	port := h.port
+	fingerprint := h.certFingerprint
+	h.mu.Unlock()
+	if r == nil {
+		return ErrNotHosting
+	}
+
+	var buf [12]byte
+	_, _ = rand.Read(buf[:])
+	pass := base64.RawURLEncoding.EncodeToString(buf[:])
+
+	err = r.CreateAccount(ctx, username, pass, false)
+	if errors.Is(err, srvroom.ErrAccountExists) {
+		err = r.UpdateAccountPassword(ctx, username, pass)
+	}
+	if err != nil {
+		return err
+	}
+
+	srv, err := h.multi.ImportInvite(ctx,
+		protocol.InviteBundle{
+			Address:         net.JoinHostPort("127.0.0.1", strconv.Itoa(int(port))),
+			Room:            r.Name.String(),
+			CertFingerprint: fingerprint,
+		},
+		r.Name.String()+" (hosted)",
+		username,
+		pass,
+	)
+	if err != nil {
+		return err
+	}
+
+	return h.store.PutSetting(ctx, HostServerUuidSetting, srv.Uuid)
+}
+
+// CreateInvite creates an invite link for the hosted room, with a new invite code friends can register with.
+// The address is the one friends connect to, such as the host's public IP address. If it does not have a port, the
+// embedded server's port is added.
+//
+// Returns ErrNotHosting if no room is being hosted.
+func (h *Host) CreateInvite(ctx context.Context, address string) (string, error) {
+	h.mu.Lock()
+	r := h.room
+	port := h.port
+	fingerprint := h.certFingerprint
+	h.mu.Unlock()
+	if r == nil {
+		return "", ErrNotHosting
+	}
+
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, strconv.Itoa(int(port)))
+	}
+
+	record, err := r.CreateInviteCode(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	bundle := protocol.InviteBundle{
+		Address:         address,
+		Room:            r.Name.String(),
+		CertFingerprint: fingerprint,
+		InviteCode:      record.Code,
+	}
+	return bundle.Url(), nil
+}
+
+// Status returns the status of the embedded server.
+func (h *Host) Status() HostStatus {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.srv == nil {
+		return HostStatus{}
+	}
+
+	serverUuid, _ := h.store.GetSettingOr(context.Background(), HostServerUuidSetting, "")
+	if _, has := h.multi.GetByUuid(serverUuid); !has {
+		serverUuid = ""
+	}
+
+	return HostStatus{
+		Hosting:         true,
+		Room:            h.room.Name,
+		Port:            h.port,
+		CertFingerprint: h.certFingerprint,
+		ServerUuid:      serverUuid,
+		OnlineClients:   len(h.room.GetAllClients()),
+		StartTs:         h.srv.StartTs(),
+	}
+}
+
+// Stop stops the embedded server, disconnecting everyone in the hosted room, and stops hosting it when the client
+// restarts. The room's accounts are kept, so it can be hosted again later.
+// Returns ErrNotHosting if no room is being hosted.
+func (h *Host) Stop(ctx context.Context) error {
+	if err := h.close(); err != nil {
+		return err
+	}
+
+	return h.store.PutSetting(ctx, HostRoomSetting, "")
+}
+
+// Close stops the embedded server, but keeps hosting the room when the client restarts.
+// Subsequent calls are no-op.
+func (h *Host) Close() error {
+	if err := h.close(); err != nil && !errors.Is(err, ErrNotHosting) {
+		return err
+	}
+	return nil
+}
+
+func (h *Host) close() error {
+	h.mu.Lock()
+	srv := h.srv
+	srvStorage := h.srvStorage
+	listeners := h.listeners
+	h.srv = nil
+	h.srvStorage = nil
+	h.listeners = nil
+	h.room = nil
+	h.mu.Unlock()
+
+	if srv == nil {
+		return ErrNotHosting
+	}
+
+	_ = srv.Close()
+	for _, listener := range listeners {
+		_ = listener.Close()
+	}
+	return srvStorage.Close()
+}
@@ -0,0 +1,100 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+	"friendnet.org/protocol"
+)
+
+// freeUdpPort returns a UDP port that was free a moment ago.
+func freeUdpPort(t *testing.T) uint16 {
+	t.Helper()
+
+	conn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4zero})
+	if err != nil {
+		t.Fatal(err)
+	}
+	port := conn.LocalAddr().(*net.UDPAddr).Port
+	_ = conn.Close()
+	return uint16(port)
+}
+
+func TestHost(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.DiscardHandler)
+
+	store, err := storage.NewStorage(filepath.Join(t.TempDir(), "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	c := &MultiClient{
+		logger:  logger,
+		storage: store,
+		servers: map[string]*Server{},
+	}
+	host := NewHost(logger, c, store, filepath.Join(t.TempDir(), "hosted"))
+	defer func() {
+		_ = host.Close()
+	}()
+
+	if status := host.Status(); status.Hosting {
+		t.Fatal("expected not to be hosting")
+	}
+	if _, err = host.CreateInvite(ctx, "203.0.113.1"); !errors.Is(err, ErrNotHosting) {
+		t.Fatalf("expected ErrNotHosting, got %v", err)
+	}
+
+	roomName := common.UncheckedCreateNormalizedRoomName("friends")
+	port := freeUdpPort(t)
+	if err = host.start(ctx, roomName, port); err != nil {
+		t.Fatal(err)
+	}
+	if err = host.start(ctx, roomName, port); !errors.Is(err, ErrAlreadyHosting) {
+		t.Fatalf("expected ErrAlreadyHosting, got %v", err)
+	}
+
+	status := host.Status()
+	if !status.Hosting || status.Room != roomName || status.Port != port || status.CertFingerprint == "" {
+		t.Fatalf("unexpected status %+v", status)
+	}
+
+	inviteUrl, err := host.CreateInvite(ctx, "203.0.113.1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	bundle, err := protocol.ParseInviteUrl(inviteUrl)
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantAddr := net.JoinHostPort("203.0.113.1", strconv.Itoa(int(port)))
+	if bundle.Address != wantAddr || bundle.Room != roomName.String() ||
+		bundle.CertFingerprint != status.CertFingerprint || bundle.InviteCode == "" {
+		t.Fatalf("unexpected invite bundle %+v", bundle)
+	}
+
+	// The room and certificate are kept after stopping.
+	if err = host.Stop(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if err = host.Stop(ctx); !errors.Is(err, ErrNotHosting) {
+		t.Fatalf("expected ErrNotHosting, got %v", err)
+	}
+	if err = host.start(ctx, roomName, port); err != nil {
+		t.Fatal(err)
+	}
+	if fingerprint := host.Status().CertFingerprint; fingerprint != status.CertFingerprint {
+		t.Fatalf("expected certificate to be kept, got fingerprint %q instead of %q", fingerprint, status.CertFingerprint)
+	}
+}
@@ -0,0 +1,65 @@
+package client
+
+import (
+	"context"
+	"strings"
+
+	"friendnet.org/client/room"
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+)
+
+// InterestsSetting is the setting key for the interests published to every server, separated by newlines.
+const InterestsSetting = "interests"
+
+// loadInterests loads the interests stored in settings.
+// Interests that are no longer valid are dropped, as are any beyond common.MaxInterests.
+func loadInterests(ctx context.Context, store *storage.Storage) (*room.Interests, error) {
+	value, err := store.GetSettingOr(ctx, InterestsSetting, "")
+	if err != nil {
+		return nil, err
+	}
+
+	var list []string
+	seen := make(map[string]struct{})
+	for interest := range strings.SplitSeq(value, "\n") {
+		normalized, err := common.NormalizeInterest(interest)
+		if err != nil {
+			continue
+		}
+		if _, has := seen[normalized]; has {
+			continue
+		}
+		if len(list) == common.MaxInterests {
+			break
+		}
+		seen[normalized] = struct{}{}
+		list = append(list, normalized)
+	}
+
+	return room.NewInterests(list), nil
+}
+
+// Interests returns the interests published to every server.
+func (c *MultiClient) Interests() []string {
+	list, _ := c.interests.Get()
+	return list
+}
+
+// SetInterests normalizes and stores the interests published to every server, and publishes them to every connected
+// server.
+// Returns the normalized interests.
+// Returns an error if an interest is invalid or there are more than common.MaxInterests.
+func (c *MultiClient) SetInterests(ctx context.Context, interests []string) ([]string, error) {
+	normalized, err := common.NormalizeInterests(interests)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = c.storage.PutSetting(ctx, InterestsSetting, strings.Join(normalized, "\n")); err != nil {
+		return nil, err
+	}
+	c.interests.Set(normalized)
+
+	return normalized, nil
+}
@@ -0,0 +1,177 @@
+package client
+
+import (
+	"context"
+	"math"
+	"os"
+	"runtime/debug"
+	"runtime/metrics"
+
+	"friendnet.org/client/direct"
+	"friendnet.org/client/fsys"
+	"friendnet.org/client/share"
+	"friendnet.org/client/storage"
+)
+
+// LowMemorySetting is the setting key for whether the client runs in low-memory mode, which caps cache sizes,
+// read-ahead, concurrent streams and buffers for devices with little memory, such as a Raspberry Pi or a NAS.
+// Changes take effect after the client restarts.
+const LowMemorySetting = "low_memory"
+
+// MemoryBudget is how much memory the client's caches, buffers and streams may use.
+// Limits of 0 mean no limit.
+type MemoryBudget struct {
+	// Whether this is the low-memory budget.
+	LowMemory bool
+
+	// The soft memory limit of the Go runtime, in bytes.
+	// The garbage collector works harder as the client gets close to it.
+	// It is not applied if the GOMEMLIMIT environment variable is set.
+	SoftLimit int64
+
+	// The maximum number of file metadata entries cached for WebDAV.
+	MetaCacheMaxEntries int
+
+	// The maximum number of bytes read ahead from each file streamed through the file server or WebDAV.
+	// Lower values of fsys.ReadAheadSetting are kept.
+	MaxReadAhead int
+
+	// The maximum number of concurrent downloads.
+	// Lower values of DmDlConcurrencySetting are kept.
+	MaxDownloadConcurrency int
+
+	// The size of the buffer each download is copied through.
+	DownloadBufferSize int
+
+	// The maximum number of streams a peer may have open at once on a direct connection.
+	// Lower values of direct.SettingMaxIncomingStreams are kept.
+	MaxIncomingStreams int64
+
+	// The maximum number of requests handled for a peer at once.
+	// Lower values of direct.SettingMaxConcurrentRequests are kept.
+	MaxConcurrentRequests int
+
+	// The maximum number of files indexed per share.
+	MaxIndexFiles int
+}
+
+// DefaultMemoryBudget is the memory budget the client runs with normally.
+var DefaultMemoryBudget = MemoryBudget{
+	MaxReadAhead:       fsys.MaxReadAheadMib * 1024 * 1024,
+	DownloadBufferSize: 512 * 1024,
+	MaxIndexFiles:      share.DefaultMaxIndexFiles,
+}
+
+// LowMemoryBudget is the memory budget the client runs with in low-memory mode.
+var LowMemoryBudget = MemoryBudget{
+	LowMemory:              true,
+	SoftLimit:              256 * 1024 * 1024,
+	MetaCacheMaxEntries:    2048,
+	MaxReadAhead:           1024 * 1024,
+	MaxDownloadConcurrency: 2,
+	DownloadBufferSize:     64 * 1024,
+	MaxIncomingStreams:     32,
+	MaxConcurrentRequests:  16,
+	MaxIndexFiles:          200_000,
+}
+
+// LoadMemoryBudget returns LowMemoryBudget if forceLowMemory is true or LowMemorySetting is enabled, otherwise
+// DefaultMemoryBudget.
+func LoadMemoryBudget(ctx context.Context, store *storage.Storage, forceLowMemory bool) (MemoryBudget, error) {
+	if forceLowMemory {
+		return LowMemoryBudget, nil
+	}
+
+	lowMemory, err := store.GetSettingBoolOr(ctx, LowMemorySetting, false)
+	if err != nil {
+		return MemoryBudget{}, err
+	}
+	if lowMemory {
+		return LowMemoryBudget, nil
+	}
+	return DefaultMemoryBudget, nil
+}
+
+// capLimit returns a connection limit capped to maxLimit.
+// A limit of 0 or less means no limit, so it becomes maxLimit.
+func capLimit[T int | int64](limit T, maxLimit T) T {
+	if maxLimit <= 0 {
+		return limit
+	}
+	if limit <= 0 || limit > maxLimit {
+		return maxLimit
+	}
+	return limit
+}
+
+// Apply sets the Go runtime's soft memory limit, unless GOMEMLIMIT is set.
+func (b MemoryBudget) Apply() {
+	if b.SoftLimit > 0 && os.Getenv("GOMEMLIMIT") == "" {
+		debug.SetMemoryLimit(b.SoftLimit)
+	}
+}
+
+// ReadAhead returns readAhead capped to the budget.
+func (b MemoryBudget) ReadAhead(readAhead int) int {
+	if b.MaxReadAhead > 0 {
+		return min(readAhead, b.MaxReadAhead)
+	}
+	return readAhead
+}
+
+// DownloadConcurrency returns concurrency capped to the budget.
+func (b MemoryBudget) DownloadConcurrency(concurrency int64) int64 {
+	if b.MaxDownloadConcurrency > 0 {
+		return min(concurrency, int64(b.MaxDownloadConcurrency))
+	}
+	return concurrency
+}
+
+// CapDirectConfig caps the peer connection limits in cfg to the budget.
+func (b MemoryBudget) CapDirectConfig(cfg *direct.Config) {
+	cfg.ConnLimits.MaxIncomingStreams = capLimit(cfg.ConnLimits.MaxIncomingStreams, b.MaxIncomingStreams)
+	cfg.ConnLimits.MaxConcurrentRequests = capLimit(cfg.ConnLimits.MaxConcurrentRequests, b.MaxConcurrentRequests)
+}
+
+// MemoryUsage is how much memory the client is using.
+type MemoryUsage struct {
+	// The memory the Go runtime has obtained from the OS and not returned, in bytes.
+	// This is what the soft memory limit applies to.
+	Used uint64
+
+	// The memory used by live and not yet collected objects, in bytes.
+	Heap uint64
+
+	// The soft memory limit of the Go runtime, in bytes, or 0 if there is none.
+	SoftLimit uint64
+
+	// The number of bytes held in read-ahead buffers.
+	ReadAheadBuffered uint64
+}
+
+// ReadMemoryUsage returns how much memory the client is currently using.
+func ReadMemoryUsage() MemoryUsage {
+	samples := []metrics.Sample{
+		{Name: "/memory/classes/total:bytes"},
+		{Name: "/memory/classes/heap/released:bytes"},
+		{Name: "/memory/classes/heap/objects:bytes"},
+	}
+	metrics.Read(samples)
+
+	var usage MemoryUsage
+	if samples[0].Value.Kind() == metrics.KindUint64 && samples[1].Value.Kind() == metrics.KindUint64 {
+		usage.Used = samples[0].Value.Uint64() - samples[1].Value.Uint64()
+	}
+	if samples[2].Value.Kind() == metrics.KindUint64 {
+		usage.Heap = samples[2].Value.Uint64()
+	}
+
+	// A negative value reads the limit without changing it.
+	if limit := debug.SetMemoryLimit(-1); limit > 0 && limit < math.MaxInt64 {
+		usage.SoftLimit = uint64(limit)
+	}
+
+	usage.ReadAheadBuffered = uint64(max(fsys.ReadAheadBufferedBytes(), 0))
+
+	return usage
+}
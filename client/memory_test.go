@@ -0,0 +1,66 @@
+package client
+
+import (
+	"testing"
+
+	"friendnet.org/client/direct"
+	"friendnet.org/protocol"
+)
+
+func TestMemoryBudget_CapDirectConfig(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		limits protocol.ConnLimits
+		want   protocol.ConnLimits
+	}{
+		{
+			name:   "defaults are capped",
+			limits: protocol.DefaultConnLimits,
+			want:   protocol.ConnLimits{MaxIncomingStreams: 32, MaxConcurrentRequests: 16},
+		},
+		{
+			name:   "lower limits are kept",
+			limits: protocol.ConnLimits{MaxIncomingStreams: 8, MaxConcurrentRequests: 4},
+			want:   protocol.ConnLimits{MaxIncomingStreams: 8, MaxConcurrentRequests: 4},
+		},
+		{
+			name:   "no limit is capped",
+			limits: protocol.ConnLimits{MaxIncomingStreams: 0, MaxConcurrentRequests: 0},
+			want:   protocol.ConnLimits{MaxIncomingStreams: 32, MaxConcurrentRequests: 16},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := &direct.Config{ConnLimits: tt.limits}
+			LowMemoryBudget.CapDirectConfig(cfg)
+			if cfg.ConnLimits != tt.want {
+				t.Errorf("expected %+v, got %+v", tt.want, cfg.ConnLimits)
+			}
+
+			cfg = &direct.Config{ConnLimits: tt.limits}
+			DefaultMemoryBudget.CapDirectConfig(cfg)
+			if cfg.ConnLimits != tt.limits {
+				t.Errorf("expected default budget to keep %+v, got %+v", tt.limits, cfg.ConnLimits)
+			}
+		})
+	}
+}
+
+func TestMemoryBudget_ReadAheadAndDownloadConcurrency(t *testing.T) {
+	t.Parallel()
+
+	if got := LowMemoryBudget.ReadAhead(4 * 1024 * 1024); got != LowMemoryBudget.MaxReadAhead {
+		t.Errorf("expected read-ahead to be capped to %d, got %d", LowMemoryBudget.MaxReadAhead, got)
+	}
+	if got := LowMemoryBudget.ReadAhead(0); got != 0 {
+		t.Errorf("expected no read-ahead to be kept, got %d", got)
+	}
+	if got := LowMemoryBudget.DownloadConcurrency(4); got != int64(LowMemoryBudget.MaxDownloadConcurrency) {
+		t.Errorf("expected download concurrency to be capped to %d, got %d", LowMemoryBudget.MaxDownloadConcurrency, got)
+	}
+	if got := DefaultMemoryBudget.DownloadConcurrency(16); got != 16 {
+		t.Errorf("expected default budget to keep download concurrency, got %d", got)
+	}
+}
@@ -16,6 +16,7 @@ import (
 	"friendnet.org/client/storage"
 	"friendnet.org/common"
 	"friendnet.org/common/machine"
+	"friendnet.org/protocol"
 	v1 "friendnet.org/protocol/pb/clientrpc/v1"
 )
 
@@ -40,6 +41,10 @@ type Server struct {
 	// Do not update.
 	ShareMgr *share.Manager
 
+	// The peers blocked on the server.
+	// Do not update.
+	BlockList *room.BlockList
+
 	*ConnNanny
 }
 
@@ -56,9 +61,27 @@ type MultiClient struct {
 	logger            *slog.Logger
 	storage           *storage.Storage
 	certStore         cert.Store
+	sessions          *room.SessionPool
 	connMethodSupport machine.ConnMethodSupport
 	directMgr         *direct.Manager
 	eventBus          *event.Bus
+	uptime            *UptimeTracker
+
+	// Adds results to incoming searches on all servers.
+	// May be nil.
+	searchHook room.SearchHook
+
+	// Pauses transfers on all servers.
+	snoozer *Snoozer
+
+	// Tracks uploads on all servers so that they can finish before shutting down.
+	drain room.UploadDrain
+
+	// The interests published to all servers.
+	interests *room.Interests
+
+	// Caps the memory used by shares and downloads.
+	budget MemoryBudget
 
 	// Mapping of server UUIDs to the Server instances that manage connections to them.
 	servers map[string]*Server
@@ -66,6 +89,9 @@ type MultiClient struct {
 
 // NewMultiClient creates a new MultiClient instance.
 // It loads all room data from storage and starts managing connections to them.
+// Connection sessions to each server are recorded in the uptime tracker.
+// If searchHook is not nil, its results are added to incoming searches on all servers.
+// Shares and downloads are capped by budget.
 func NewMultiClient(
 	logger *slog.Logger,
 	storage *storage.Storage,
@@ -73,6 +99,9 @@ func NewMultiClient(
 	connMethodSupport machine.ConnMethodSupport,
 	directMgr *direct.Manager,
 	eventBus *event.Bus,
+	uptime *UptimeTracker,
+	searchHook room.SearchHook,
+	budget MemoryBudget,
 ) (*MultiClient, error) {
 	ctx, ctxCancel := context.WithCancel(context.Background())
 
@@ -82,19 +111,47 @@ func NewMultiClient(
 		return nil, err
 	}
 
+	// Restore the snooze before connecting, so that no transfers slip through.
+	snoozer, err := newSnoozer(ctx, logger, storage)
+	if err != nil {
+		ctxCancel()
+		return nil, err
+	}
+
+	interests, err := loadInterests(ctx, storage)
+	if err != nil {
+		ctxCancel()
+		return nil, err
+	}
+
 	c := &MultiClient{
 		ctx:               ctx,
 		ctxCancel:         ctxCancel,
 		logger:            logger,
 		storage:           storage,
 		certStore:         certStore,
+		sessions:          room.NewSessionPool(),
 		connMethodSupport: connMethodSupport,
 		directMgr:         directMgr,
 		eventBus:          eventBus,
+		uptime:            uptime,
+		searchHook:        searchHook,
+		snoozer:           snoozer,
+		interests:         interests,
+		budget:            budget,
 		servers:           make(map[string]*Server, len(serverRecs)),
 	}
+	snoozer.onSharesHiddenChange = c.bumpSharesRevisions
 
 	for _, record := range serverRecs {
+		if record.PasswordInKeychain && record.Password == "" {
+			logger.Warn(`could not load server password from OS keychain, set it again by editing the server`,
+				"service", "client.MultiClient",
+				"server", record.Uuid,
+				"name", record.Name,
+			)
+		}
+
 		var inst *Server
 		inst, err = c.createServerInstance(record)
 		if err != nil {
@@ -105,6 +162,8 @@ func NewMultiClient(
 		c.servers[record.Uuid] = inst
 	}
 
+	common.Supervise(ctx, logger, "client.MultiClient.trashPurger", c.trashPurger)
+
 	return c, nil
 }
 
@@ -137,9 +196,28 @@ func (c *MultiClient) Close() error {
 	}
 	wg.Wait()
 
+	_ = c.snoozer.Close()
+
 	return nil
 }
 
+// Snoozer returns the Snoozer that pauses transfers on all servers.
+func (c *MultiClient) Snoozer() *Snoozer {
+	return c.snoozer
+}
+
+// MemoryBudget returns the memory budget the client runs with.
+func (c *MultiClient) MemoryBudget() MemoryBudget {
+	return c.budget
+}
+
+// bumpSharesRevisions bumps the shares revision of every server, so that servers discard listings they cached.
+func (c *MultiClient) bumpSharesRevisions() {
+	for _, server := range c.GetAll() {
+		server.ShareMgr.BumpSharesRevision()
+	}
+}
+
 // GetAll returns all server connections under management.
 // Returns an empty slice if the MultiClient is closed.
 // Note that this method creates a new slice each time it is called.
@@ -168,32 +246,55 @@ func (c *MultiClient) GetByUuid(uuid string) (*Server, bool) {
 }
 
 func (c *MultiClient) createServerInstance(record storage.ServerRecord) (*Server, error) {
+	eventPublisher := c.eventBus.CreatePublisher(&v1.EventContext{
+		ServerUuid: record.Uuid,
+	})
+
 	var shareMgr *share.Manager
 	shareMgr, err := share.NewManager(
 		c.logger,
 		record.Uuid,
 		c.storage,
+		eventPublisher,
+		c.budget.MaxIndexFiles,
 	)
 	if err != nil {
 		return nil, err
 	}
 
-	logic := room.NewLogicImpl(shareMgr)
+	blockedRecs, err := c.storage.GetBlockedPeers(c.ctx, record.Uuid)
+	if err != nil {
+		_ = shareMgr.Close()
+		return nil, err
+	}
+	blocked := make([]common.NormalizedUsername, len(blockedRecs))
+	for i, blockedRec := range blockedRecs {
+		blocked[i] = blockedRec.Username
+	}
+	blockList := room.NewBlockList(blocked)
+
+	logic := room.NewLogicImpl(record.Uuid, shareMgr, c.searchHook, blockList, c.snoozer.State(), &c.drain, c.interests)
+
+	windowRecs, err := c.storage.GetConnWindows(c.ctx, record.Uuid)
+	if err != nil {
+		_ = shareMgr.Close()
+		return nil, err
+	}
 
 	return &Server{
 		Uuid:      record.Uuid,
 		Name:      record.Name,
 		CreatedTs: record.CreatedTs,
 		ShareMgr:  shareMgr,
+		BlockList: blockList,
 		ConnNanny: NewConnNanny(
 			c.logger,
 			c.certStore,
+			c.sessions,
 			c.connMethodSupport,
 			c.directMgr,
 			record.Uuid,
-			c.eventBus.CreatePublisher(&v1.EventContext{
-				ServerUuid: record.Uuid,
-			}),
+			eventPublisher,
 			record.Address,
 			room.Credentials{
 				Room:     record.Room,
@@ -201,6 +302,8 @@ func (c *MultiClient) createServerInstance(record storage.ServerRecord) (*Server
 				Password: record.Password,
 			},
 			logic,
+			connScheduleFromRecords(windowRecs),
+			c.uptime.ForServer(record.Uuid),
 		),
 	}, nil
 }
@@ -263,6 +366,64 @@ func (c *MultiClient) Create(
 	return inst, nil
 }
 
+// ImportInvite creates a new server record from an invite bundle and starts managing a connection to it.
+// If the bundle has a certificate fingerprint, the server's certificate is checked against it and stored before the
+// record is created.
+// If the bundle has an invite code, an account with the specified username and password is registered with it first.
+// If name is empty, the bundle's address is used.
+//
+// If the server's certificate does not match the bundle's fingerprint, returns a protocol.CertFingerprintMismatchError.
+// If the server rejects the registration, returns a protocol.AuthRejectedError.
+func (c *MultiClient) ImportInvite(
+	ctx context.Context,
+	bundle protocol.InviteBundle,
+	name string,
+	username common.NormalizedUsername,
+	password string,
+) (*Server, error) {
+	roomName, roomOk := common.NormalizeRoomName(bundle.Room)
+	if !roomOk {
+		return nil, fmt.Errorf(`invite bundle has invalid room name %q`, bundle.Room)
+	}
+	if name == "" {
+		name = bundle.Address
+	}
+
+	c.mu.RLock()
+	isClosed := c.isClosed
+	c.mu.RUnlock()
+	if isClosed {
+		return nil, ErrMultiClientClosed
+	}
+
+	if bundle.InviteCode != "" {
+		err := room.RegisterStandalone(
+			ctx,
+			c.certStore,
+			bundle.Address,
+			room.Credentials{
+				Room:     roomName,
+				Username: username,
+				Password: password,
+			},
+			bundle.InviteCode,
+			bundle.CertFingerprint,
+		)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to register account on server %q: %w`, bundle.Address, err)
+		}
+	} else if bundle.CertFingerprint != "" {
+		// Connecting is enough to check and store the certificate.
+		conn, err := room.ConnectWithFingerprint(ctx, c.certStore, bundle.Address, bundle.CertFingerprint)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to connect to server %q: %w`, bundle.Address, err)
+		}
+		_ = conn.CloseWithCode(protocol.CloseCodeNormal, "goodbye")
+	}
+
+	return c.Create(ctx, name, bundle.Address, roomName, username, password)
+}
+
 // Update updates a server's record in storage and in memory.
 // It does not interrupt any connections, and any changes to the connection parameters will take effect on the next reconnect.
 func (c *MultiClient) Update(
@@ -311,7 +472,8 @@ func (c *MultiClient) Update(
 	return nil
 }
 
-// DeleteByUuid deletes the server record from storage and closes its connection, if any.
+// DeleteByUuid moves the server record to the trash and closes its connection, if any.
+// It can be restored with RestoreByUuid until it is purged.
 // If the server does not exist, this is a no-op.
 func (c *MultiClient) DeleteByUuid(
 	ctx context.Context,
@@ -329,15 +491,17 @@ func (c *MultiClient) DeleteByUuid(
 	}
 	c.mu.Unlock()
 
-	// Delete server in storage.
+	// Move server to the trash in storage.
 	// We do this without checking hasConn because it may still exist in storage even if not in memory.
-	err := c.storage.DeleteServerByUuid(ctx, uuid)
-	if err != nil {
-		return fmt.Errorf(`failed to delete server %q from storage: %w`, uuid, err)
+	if _, err := c.storage.TrashServerByUuid(ctx, uuid); err != nil {
+		return err
 	}
 
 	if hasConn {
 		_ = conn.Close()
+
+		// Stop watching and indexing its shares. Restoring the server creates a new share manager.
+		_ = conn.ShareMgr.Close()
 	}
 
 	return nil
@@ -14,6 +14,7 @@ import (
 	"friendnet.org/client/room"
 	"friendnet.org/common"
 	"friendnet.org/common/machine"
+	"friendnet.org/protocol"
 	v1 "friendnet.org/protocol/pb/clientrpc/v1"
 )
 
@@ -61,6 +62,7 @@ type ConnNanny struct {
 	isClosed bool
 
 	certStore         cert.Store
+	sessions          *room.SessionPool
 	directMgr         *direct.Manager
 	directPartName    string
 	eventPublisher    *event.Publisher
@@ -72,21 +74,40 @@ type ConnNanny struct {
 	shouldReconnect bool
 	connOrNil       *room.Conn
 
+	// Whether the daemon goroutine is running.
+	daemonRunning bool
+
+	// The windows during which the connection is allowed.
+	schedule ConnSchedule
+
+	// Whether the schedule is keeping the connection closed.
+	// Connect clears it, overriding the schedule until the current window starts or ends.
+	scheduleBlocked bool
+
+	// Wakes the scheduler when the schedule changes.
+	scheduleCh chan struct{}
+
+	// Records the connection sessions, or nil to not record them.
+	history *ConnHistory
+
 	backoffWaker context.CancelFunc
 
 	state ConnState
 }
 
 // NewConnNanny creates a new ConnNanny with the specified server address and credentials.
-// It automatically starts trying to connect after instantiation.
+// It automatically starts trying to connect after instantiation, unless the schedule does not allow connecting yet.
 //
 // The directPartitionName value must be unique among open ConnNanny instances that use the same direct.Manager.
 // It could be a server UUID, or something else unique to the connection.
 // If an open ConnNanny instance has the name "abc" and this function is called with directPartitionName "abc",
 // the connection it manages will fail to open.
+//
+// If history is not nil, each time the connection opens and closes is recorded in it.
 func NewConnNanny(
 	logger *slog.Logger,
 	certStore cert.Store,
+	sessions *room.SessionPool,
 	connMethodSupport machine.ConnMethodSupport,
 	directMgr *direct.Manager,
 	directPartitionName string,
@@ -94,8 +115,11 @@ func NewConnNanny(
 	address string,
 	creds room.Credentials,
 	logic room.Logic,
+	schedule ConnSchedule,
+	history *ConnHistory,
 ) *ConnNanny {
 	ctx, ctxCancel := context.WithCancel(context.Background())
+	allowed := schedule.Allows(time.Now())
 
 	n := &ConnNanny{
 		maxWait: 30 * time.Second,
@@ -107,6 +131,7 @@ func NewConnNanny(
 		ctxCancel: ctxCancel,
 
 		certStore:         certStore,
+		sessions:          sessions,
 		directMgr:         directMgr,
 		directPartName:    directPartitionName,
 		eventPublisher:    eventPublisher,
@@ -119,12 +144,22 @@ func NewConnNanny(
 
 		shouldReconnect: true,
 
+		schedule:        schedule,
+		scheduleBlocked: !allowed,
+		scheduleCh:      make(chan struct{}, 1),
+
+		history: history,
+
 		backoffWaker: func() {},
 
 		state: ConnStateClosed,
 	}
 
-	go n.daemon()
+	if allowed {
+		n.daemonRunning = true
+		go n.daemon()
+	}
+	go n.scheduler(allowed)
 
 	return n
 }
@@ -180,6 +215,18 @@ func (n *ConnNanny) SetPassword(password string) {
 	n.creds.Password = password
 }
 
+// Diagnose runs the steps of connecting to the server one by one on a separate connection and returns the result of
+// each, without affecting the managed connection.
+// See room.Diagnose.
+func (n *ConnNanny) Diagnose(ctx context.Context) []room.DiagnosticStep {
+	n.mu.RLock()
+	address := n.address
+	creds := n.creds
+	n.mu.RUnlock()
+
+	return room.Diagnose(ctx, n.certStore, address, creds)
+}
+
 func (n *ConnNanny) setStateNoLock(state ConnState) {
 	n.state = state
 
@@ -285,7 +332,8 @@ func (n *ConnNanny) daemon() {
 			n.connOrNil = nil
 			n.setStateNoLock(ConnStateClosed)
 			n.openCh = make(chan struct{})
-			shouldRestart := !n.isClosed && n.shouldReconnect
+			shouldRestart := !n.isClosed && n.shouldReconnect && !n.scheduleBlocked
+			n.daemonRunning = shouldRestart
 			n.mu.Unlock()
 
 			if orphanedConn != nil {
@@ -301,14 +349,17 @@ func (n *ConnNanny) daemon() {
 	for {
 		n.mu.Lock()
 		if n.isClosed {
+			n.daemonRunning = false
 			n.mu.Unlock()
 			return
 		}
-		if !n.shouldReconnect {
+		if !n.shouldReconnect || n.scheduleBlocked {
+			n.daemonRunning = false
 			n.mu.Unlock()
-			// Return, not doing anything until either Close() or Connect() flips shouldReconnect.
+			// Return, not doing anything until either Close() or Connect() flips shouldReconnect, or the schedule
+			// allows connecting again.
 			// We don't have a dedicated "reconnect signal" channel yet; simplest
-			// is to just return and let Connect() start a new daemon if desired.
+			// is to just return and let Connect() or the scheduler start a new daemon if desired.
 			return
 		}
 		n.setStateNoLock(ConnStateOpening)
@@ -317,6 +368,7 @@ func (n *ConnNanny) daemon() {
 		// Connect outside lock; may block.
 		conn, err := room.NewConn(
 			n.logger,
+			n.sessions,
 			n.logic,
 			n.connMethodSupport,
 			n.certStore,
@@ -338,6 +390,14 @@ func (n *ConnNanny) daemon() {
 				)
 			}
 
+			// Stop if the server told us that trying again will not help.
+			if code, reason, ok := protocol.CloseCodeFromError(err); ok && !code.Retryable() {
+				n.stopReconnectingNoLock(code, reason)
+				n.setStateNoLock(ConnStateClosed)
+				n.mu.Unlock()
+				continue
+			}
+
 			// Connection never opened, so we do not to close or recreate openCh.
 			n.setStateNoLock(ConnStateClosed)
 
@@ -359,7 +419,7 @@ func (n *ConnNanny) daemon() {
 
 		// Check if a Close or Disconnect happened since the connection opened.
 		n.mu.Lock()
-		if n.isClosed || !n.shouldReconnect {
+		if n.isClosed || !n.shouldReconnect || n.scheduleBlocked {
 			n.mu.Unlock()
 			_ = conn.Close()
 			// Loop will return next iteration if this condition stays true.
@@ -378,6 +438,25 @@ func (n *ConnNanny) daemon() {
 		n.curWait = 0
 		n.mu.Unlock()
 
+		connUuid := n.history.Opened()
+
+		if motd := conn.Motd(); motd != "" {
+			n.eventPublisher.Publish(&v1.Event{
+				Type: v1.Event_TYPE_ROOM_MOTD,
+				RoomMotd: &v1.Event_RoomMotd{
+					Text: motd,
+				},
+			})
+		}
+		if skew, ok := conn.ClockSkew(); ok && skew.Abs() >= room.ClockSkewWarnThreshold {
+			n.eventPublisher.Publish(&v1.Event{
+				Type: v1.Event_TYPE_CLOCK_SKEW,
+				ClockSkew: &v1.Event_ClockSkew{
+					SkewMs: skew.Milliseconds(),
+				},
+			})
+		}
+
 		// Wait for connection to end.
 		<-conn.Context.Done()
 
@@ -386,14 +465,57 @@ func (n *ConnNanny) daemon() {
 		if n.connOrNil == conn {
 			n.connOrNil = nil
 		}
+		disconnectReason := n.disconnectReasonNoLock(conn)
+		if code, reason, ok := conn.RemoteClose(); ok && !code.Retryable() {
+			n.stopReconnectingNoLock(code, reason)
+		}
 		n.setStateNoLock(ConnStateClosed)
 		n.openCh = make(chan struct{})
 		n.mu.Unlock()
 
+		n.history.Closed(connUuid, disconnectReason)
+
 		// Loop will reconnect if shouldReconnect remains true.
 	}
 }
 
+// disconnectReasonNoLock returns a human-readable reason why the connection, which has already ended, was closed.
+// It must be called before reconnection is disabled for the server's close code.
+// The caller must hold the lock.
+func (n *ConnNanny) disconnectReasonNoLock(conn *room.Conn) string {
+	if code, reason, ok := conn.RemoteClose(); ok {
+		if reason == "" {
+			return "closed by server: " + code.String()
+		}
+		return "closed by server: " + code.String() + ": " + reason
+	}
+
+	switch {
+	case n.isClosed:
+		return "closed by client"
+	case n.scheduleBlocked:
+		return "outside connection schedule"
+	case !n.shouldReconnect:
+		return "disconnected by user"
+	default:
+		return "connection lost"
+	}
+}
+
+// stopReconnectingNoLock disables reconnection after the server closed the connection with a code that means
+// reconnecting would not help. Reconnection resumes when Connect is called.
+// The caller must hold the lock.
+func (n *ConnNanny) stopReconnectingNoLock(code protocol.CloseCode, reason string) {
+	n.logger.Warn("server closed the connection; not reconnecting",
+		"address", n.address,
+		"room", n.creds.Room,
+		"username", n.creds.Username.String(),
+		"code", code.String(),
+		"reason", reason,
+	)
+	n.shouldReconnect = false
+}
+
 // Close closes the ConnNanny, and as a result, the underlying connection.
 // If you want to disconnect the underlying connection, use Disconnect.
 // Subsequent calls are no-op.
@@ -442,6 +564,7 @@ func (n *ConnNanny) State() ConnState {
 }
 
 // Connect schedules a reconnection (if not already connected), and enables automatic reconnection.
+// If the schedule does not allow connecting right now, it is overridden until the next window starts or ends.
 // No-op if the ConnNanny is closed.
 func (n *ConnNanny) Connect() {
 	n.mu.Lock()
@@ -449,15 +572,13 @@ func (n *ConnNanny) Connect() {
 		n.mu.Unlock()
 		return
 	}
-	was := n.shouldReconnect
 	n.shouldReconnect = true
+	n.scheduleBlocked = false
 	n.backoffWaker()
-	n.mu.Unlock()
 
 	// If we were previously disconnected (daemon returned), start it again.
-	if !was {
-		go n.daemon()
-	}
+	n.startDaemonNoLock()
+	n.mu.Unlock()
 }
 
 // Disconnect closes the current underlying connection and disables reconnection.
@@ -470,10 +591,23 @@ func (n *ConnNanny) Disconnect() {
 		return
 	}
 
+	n.shouldReconnect = false
+	oldConn := n.detachConnNoLock()
+
+	n.mu.Unlock()
+
+	if oldConn != nil {
+		_ = oldConn.Close()
+	}
+}
+
+// detachConnNoLock sets the state to closed and wakes the daemon if it is backing off.
+// Returns the connection that was open, if any, which the caller must close after releasing the lock.
+// The caller must hold the lock.
+func (n *ConnNanny) detachConnNoLock() *room.Conn {
 	oldConn := n.connOrNil
 	n.connOrNil = nil
 
-	n.shouldReconnect = false
 	n.setStateNoLock(ConnStateClosed)
 
 	// Ensure WaitOpen blocks until we open again.
@@ -481,9 +615,93 @@ func (n *ConnNanny) Disconnect() {
 
 	n.backoffWaker()
 
+	return oldConn
+}
+
+// startDaemonNoLock starts the daemon if it is not already running.
+// The caller must hold the lock.
+func (n *ConnNanny) startDaemonNoLock() {
+	if n.daemonRunning {
+		return
+	}
+	n.daemonRunning = true
+	go n.daemon()
+}
+
+// Schedule returns the windows during which the connection is allowed.
+func (n *ConnNanny) Schedule() ConnSchedule {
+	n.mu.RLock()
+	defer n.mu.RUnlock()
+	return n.schedule
+}
+
+// SetSchedule sets the windows during which the connection is allowed.
+// An empty schedule allows connecting at any time.
+// It does not persist any changes to any kind of storage, it is only for this ConnNanny instance.
+func (n *ConnNanny) SetSchedule(schedule ConnSchedule) {
+	n.mu.Lock()
+	n.schedule = schedule
 	n.mu.Unlock()
 
-	if oldConn != nil {
-		_ = oldConn.Close()
+	select {
+	case n.scheduleCh <- struct{}{}:
+	default:
+	}
+}
+
+// scheduler opens and closes the connection as windows in the schedule start and end.
+// It only acts when the schedule starts or stops allowing a connection, so that Connect and Disconnect can override
+// it in between.
+// The allowed value is whether the schedule allowed connecting when the ConnNanny was created.
+func (n *ConnNanny) scheduler(allowed bool) {
+	for {
+		// Check again at the start of the next minute, since windows start and end on minute boundaries.
+		now := time.Now()
+		timer := time.NewTimer(now.Truncate(time.Minute).Add(time.Minute).Sub(now))
+
+		select {
+		case <-n.ctx.Done():
+			timer.Stop()
+			return
+		case <-timer.C:
+		case <-n.scheduleCh:
+			timer.Stop()
+		}
+
+		n.mu.Lock()
+		nowAllowed := n.schedule.Allows(time.Now())
+		if n.isClosed || nowAllowed == allowed {
+			n.mu.Unlock()
+			continue
+		}
+		allowed = nowAllowed
+
+		var oldConn *room.Conn
+		if allowed {
+			n.scheduleBlocked = false
+			if n.shouldReconnect {
+				n.logger.Info("connection window started; connecting",
+					"address", n.address,
+					"room", n.creds.Room,
+					"username", n.creds.Username.String(),
+				)
+				n.startDaemonNoLock()
+			}
+		} else {
+			n.scheduleBlocked = true
+			if n.shouldReconnect {
+				n.logger.Info("connection window ended; disconnecting",
+					"address", n.address,
+					"room", n.creds.Room,
+					"username", n.creds.Username.String(),
+				)
+				oldConn = n.detachConnNoLock()
+			}
+		}
+		n.mu.Unlock()
+
+		if oldConn != nil {
+			_ = oldConn.Close()
+		}
 	}
 }
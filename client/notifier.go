@@ -0,0 +1,252 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"time"
+
+	"friendnet.org/client/event"
+	"friendnet.org/client/notify"
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+)
+
+// NotifyDesktopSetting is the setting key for whether to show desktop notifications.
+const NotifyDesktopSetting = "notify_desktop"
+
+// NotifyWebhookUrlSetting is the setting key for the URL notifications are sent to, or empty for none.
+const NotifyWebhookUrlSetting = "notify_webhook_url"
+
+// NotifyDownloadCompleteSetting is the setting key for whether to notify when a download completes.
+const NotifyDownloadCompleteSetting = "notify_download_complete"
+
+// NotifyFriendOnlineSetting is the setting key for whether to notify when a friend comes online.
+const NotifyFriendOnlineSetting = "notify_friend_online"
+
+// NotificationTimeout is the maximum amount of time showing a desktop notification or sending a webhook may take.
+const NotificationTimeout = 30 * time.Second
+
+// NotificationKind is the kind of event a notification is about.
+type NotificationKind string
+
+const (
+	// NotificationDownloadComplete is sent when a download completes.
+	NotificationDownloadComplete NotificationKind = "download_complete"
+
+	// NotificationFriendOnline is sent when a friend comes online.
+	NotificationFriendOnline NotificationKind = "friend_online"
+)
+
+// notificationPayload is the JSON body sent to the notification webhook.
+type notificationPayload struct {
+	Kind       NotificationKind `json:"kind"`
+	ServerUuid string           `json:"server_uuid"`
+	Title      string           `json:"title"`
+	Body       string           `json:"body"`
+}
+
+// getNotificationSettings returns the notification settings stored in storage.
+// Every kind of notification and desktop notifications are enabled by default.
+func getNotificationSettings(ctx context.Context, store *storage.Storage) (*v1.NotificationSettings, error) {
+	desktop, err := store.GetSettingBoolOr(ctx, NotifyDesktopSetting, true)
+	if err != nil {
+		return nil, err
+	}
+	webhookUrl, err := store.GetSettingOr(ctx, NotifyWebhookUrlSetting, "")
+	if err != nil {
+		return nil, err
+	}
+	downloadComplete, err := store.GetSettingBoolOr(ctx, NotifyDownloadCompleteSetting, true)
+	if err != nil {
+		return nil, err
+	}
+	friendOnline, err := store.GetSettingBoolOr(ctx, NotifyFriendOnlineSetting, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.NotificationSettings{
+		Desktop:          desktop,
+		WebhookUrl:       webhookUrl,
+		DownloadComplete: downloadComplete,
+		FriendOnline:     friendOnline,
+	}, nil
+}
+
+// Notifier notifies the user about client events with desktop notifications and, optionally, a webhook.
+// Which events the user is notified about is configured with settings, which are read for every notification so that
+// changes take effect immediately.
+// It listens for events on the event bus; downloads report completion with DownloadComplete, since download status
+// updates on the bus can be dropped.
+type Notifier struct {
+	logger  *slog.Logger
+	storage *storage.Storage
+	multi   *MultiClient
+
+	bus   *event.Bus
+	subId event.SubscriptionId
+}
+
+// NewNotifier creates a new Notifier that listens for events on the bus.
+// Close must be called to stop listening.
+func NewNotifier(logger *slog.Logger, storage *storage.Storage, multi *MultiClient, bus *event.Bus) *Notifier {
+	n := &Notifier{
+		logger:  logger,
+		storage: storage,
+		multi:   multi,
+		bus:     bus,
+	}
+	n.subId = bus.Subscribe(n.onEvent)
+
+	return n
+}
+
+// Close stops listening for events.
+func (n *Notifier) Close() error {
+	n.bus.Unsubscribe(n.subId)
+	return nil
+}
+
+func (n *Notifier) onEvent(evt *v1.Event, evtCtx *v1.EventContext) {
+	if evt.Type != v1.Event_TYPE_CLIENT_ONLINE {
+		return
+	}
+
+	username, ok := common.NormalizeUsername(evt.ClientOnline.Info.Username)
+	if !ok {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), NotificationTimeout)
+	defer cancel()
+
+	friends, err := n.storage.GetFriends(ctx, evtCtx.ServerUuid)
+	if err != nil {
+		n.logger.Error("failed to get friends for notification",
+			"service", "client.Notifier",
+			"server", evtCtx.ServerUuid,
+			"err", err,
+		)
+		return
+	}
+	for _, friend := range friends {
+		if friend.Username != username {
+			continue
+		}
+
+		name := username.String()
+		if friend.Nickname != "" {
+			name = fmt.Sprintf("%s (%s)", friend.Nickname, name)
+		}
+		n.notify(ctx, NotificationFriendOnline, evtCtx.ServerUuid,
+			"Friend online",
+			fmt.Sprintf("%s is online on %s", name, n.serverName(evtCtx.ServerUuid)),
+		)
+		return
+	}
+}
+
+// DownloadComplete notifies the user that a download completed, if enabled.
+// It blocks until the notification is sent.
+func (n *Notifier) DownloadComplete(serverUuid string, peer common.NormalizedUsername, filePath common.ProtoPath) {
+	ctx, cancel := context.WithTimeout(context.Background(), NotificationTimeout)
+	defer cancel()
+
+	n.notify(ctx, NotificationDownloadComplete, serverUuid,
+		"Download complete",
+		fmt.Sprintf("%s from %s on %s", filePath.Name(), peer.String(), n.serverName(serverUuid)),
+	)
+}
+
+// serverName returns the name of the server with the specified UUID, or the UUID if there is no such server.
+func (n *Notifier) serverName(serverUuid string) string {
+	if server, has := n.multi.GetByUuid(serverUuid); has {
+		return server.Name
+	}
+	return serverUuid
+}
+
+// notify sends a notification through every enabled channel if notifications of its kind are enabled.
+// Failures are logged.
+func (n *Notifier) notify(ctx context.Context, kind NotificationKind, serverUuid string, title string, body string) {
+	settings, err := getNotificationSettings(ctx, n.storage)
+	if err != nil {
+		n.logger.Error("failed to get notification settings",
+			"service", "client.Notifier",
+			"err", err,
+		)
+		return
+	}
+
+	switch kind {
+	case NotificationDownloadComplete:
+		if !settings.DownloadComplete {
+			return
+		}
+	case NotificationFriendOnline:
+		if !settings.FriendOnline {
+			return
+		}
+	}
+
+	if settings.Desktop {
+		if err = notify.Desktop(ctx, title, body); err != nil {
+			level := slog.LevelError
+			if errors.Is(err, notify.ErrUnavailable) {
+				level = slog.LevelDebug
+			}
+			n.logger.Log(ctx, level, "failed to show desktop notification",
+				"service", "client.Notifier",
+				"kind", kind,
+				"err", err,
+			)
+		}
+	}
+
+	if settings.WebhookUrl != "" {
+		err = n.sendWebhook(ctx, settings.WebhookUrl, &notificationPayload{
+			Kind:       kind,
+			ServerUuid: serverUuid,
+			Title:      title,
+			Body:       body,
+		})
+		if err != nil {
+			n.logger.Error("failed to send notification webhook",
+				"service", "client.Notifier",
+				"kind", kind,
+				"err", err,
+			)
+		}
+	}
+}
+
+func (n *Notifier) sendWebhook(ctx context.Context, target string, payload *notificationPayload) error {
+	body, err := json.Marshal(payload)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, target, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf(`webhook request to %q failed: %w`, target, err)
+	}
+	_ = res.Body.Close()
+
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		return fmt.Errorf(`webhook %q returned status %d`, target, res.StatusCode)
+	}
+
+	return nil
+}
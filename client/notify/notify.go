@@ -0,0 +1,41 @@
+package notify
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// AppName is the application name desktop notifications are shown under.
+const AppName = "FriendNet"
+
+// ErrUnavailable is returned by Desktop when desktop notifications cannot be shown.
+var ErrUnavailable = errors.New("desktop notifications are not available")
+
+// Desktop shows a desktop notification with the specified title and body: a toast on Windows, a Notification Center
+// notification on macOS, and a notification through notify-send on Linux.
+// Returns ErrUnavailable if desktop notifications cannot be shown, such as in a headless session.
+func Desktop(ctx context.Context, title string, body string) error {
+	return desktop(ctx, title, body)
+}
+
+// run runs a command and returns an error that includes its stderr if it fails.
+func run(ctx context.Context, env []string, name string, args ...string) error {
+	cmd := exec.CommandContext(ctx, name, args...)
+	if env != nil {
+		cmd.Env = append(cmd.Environ(), env...)
+	}
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf(`%s failed: %s`, name, msg)
+		}
+		return err
+	}
+	return nil
+}
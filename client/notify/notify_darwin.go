@@ -0,0 +1,21 @@
+//go:build darwin
+
+package notify
+
+import (
+	"context"
+	"os/exec"
+)
+
+// darwinScript shows a notification with the title and body passed as arguments, so they do not need to be escaped.
+const darwinScript = `on run argv
+	display notification (item 2 of argv) with title (item 1 of argv)
+end run`
+
+func desktop(ctx context.Context, title string, body string) error {
+	if _, err := exec.LookPath("osascript"); err != nil {
+		return ErrUnavailable
+	}
+
+	return run(ctx, nil, "osascript", "-e", darwinScript, title, body)
+}
@@ -0,0 +1,20 @@
+//go:build linux
+
+package notify
+
+import (
+	"context"
+	"os"
+	"os/exec"
+)
+
+func desktop(ctx context.Context, title string, body string) error {
+	if os.Getenv("DBUS_SESSION_BUS_ADDRESS") == "" {
+		return ErrUnavailable
+	}
+	if _, err := exec.LookPath("notify-send"); err != nil {
+		return ErrUnavailable
+	}
+
+	return run(ctx, nil, "notify-send", "--app-name="+AppName, "--", title, body)
+}
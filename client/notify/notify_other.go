@@ -0,0 +1,9 @@
+//go:build !windows && !linux && !darwin
+
+package notify
+
+import "context"
+
+func desktop(_ context.Context, _ string, _ string) error {
+	return ErrUnavailable
+}
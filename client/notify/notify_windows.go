@@ -0,0 +1,35 @@
+//go:build windows
+
+package notify
+
+import (
+	"context"
+	"os/exec"
+)
+
+// windowsScript shows a toast with the title and body passed as environment variables, so they do not need to be
+// escaped.
+const windowsScript = `
+[Windows.UI.Notifications.ToastNotificationManager, Windows.UI.Notifications, ContentType = WindowsRuntime] > $null
+$template = [Windows.UI.Notifications.ToastNotificationManager]::GetTemplateContent([Windows.UI.Notifications.ToastTemplateType]::ToastText02)
+$text = $template.GetElementsByTagName('text')
+$text.Item(0).AppendChild($template.CreateTextNode($env:FRIENDNET_NOTIFY_TITLE)) > $null
+$text.Item(1).AppendChild($template.CreateTextNode($env:FRIENDNET_NOTIFY_BODY)) > $null
+$toast = [Windows.UI.Notifications.ToastNotification]::new($template)
+[Windows.UI.Notifications.ToastNotificationManager]::CreateToastNotifier($env:FRIENDNET_NOTIFY_APP).Show($toast)
+`
+
+func desktop(ctx context.Context, title string, body string) error {
+	if _, err := exec.LookPath("powershell.exe"); err != nil {
+		return ErrUnavailable
+	}
+
+	return run(ctx,
+		[]string{
+			"FRIENDNET_NOTIFY_APP=" + AppName,
+			"FRIENDNET_NOTIFY_TITLE=" + title,
+			"FRIENDNET_NOTIFY_BODY=" + body,
+		},
+		"powershell.exe", "-NoProfile", "-NonInteractive", "-Command", windowsScript,
+	)
+}
@@ -0,0 +1,454 @@
+package client
+
+import (
+	"context"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+	"friendnet.org/client/event"
+	"friendnet.org/client/room"
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// MaxPluginNameLength is the maximum number of characters in a plugin's name.
+const MaxPluginNameLength = 64
+
+// pluginTokenByteLen is the number of random bytes in a plugin's bearer token.
+const pluginTokenByteLen = 32
+
+// pluginSearchTimeout is how long incoming searches wait for plugins to respond before sending their results without
+// those of plugins that did not respond.
+const pluginSearchTimeout = 3 * time.Second
+
+// pluginStreamBufferSize is the number of events buffered for each plugin event stream.
+// Events are dropped for plugins that fall further behind.
+const pluginStreamBufferSize = 100
+
+// ErrPluginExists is returned when creating a plugin with the same name as an existing one.
+var ErrPluginExists = errors.New("plugin already exists")
+
+// ErrInvalidPluginName is returned when creating a plugin with an invalid name.
+var ErrInvalidPluginName = fmt.Errorf("plugin name must be between 1 and %d characters long", MaxPluginNameLength)
+
+// ErrInvalidPluginScope is returned when creating a plugin with an unspecified or unknown scope.
+var ErrInvalidPluginScope = errors.New("invalid plugin scope")
+
+// ErrPluginSearchNotFound is returned when a plugin responds to a search that is not waiting for its response.
+var ErrPluginSearchNotFound = errors.New("search not found or already responded to")
+
+var errInvalidPluginToken = connect.NewError(connect.CodePermissionDenied, errors.New("invalid bearer token"))
+var errPluginScopeDenied = connect.NewError(connect.CodePermissionDenied, errors.New("plugin does not have the scope for this method"))
+
+// pluginMethodScopes maps the RPC methods that plugins can call to the scope they require.
+// StreamPluginEvents is not included, since any plugin can call it; the scopes required for its event types are
+// checked when it is called.
+var pluginMethodScopes = map[string]v1.PluginScope{
+	"StreamEvents": v1.PluginScope_PLUGIN_SCOPE_EVENTS,
+
+	"RespondToSearch": v1.PluginScope_PLUGIN_SCOPE_SEARCH,
+
+	"GetClientInfo":           v1.PluginScope_PLUGIN_SCOPE_READ,
+	"GetMemoryUsage":          v1.PluginScope_PLUGIN_SCOPE_READ,
+	"GetServers":              v1.PluginScope_PLUGIN_SCOPE_READ,
+	"GetShares":               v1.PluginScope_PLUGIN_SCOPE_READ,
+	"GetOnlineUsers":          v1.PluginScope_PLUGIN_SCOPE_READ,
+	"GetDirFiles":             v1.PluginScope_PLUGIN_SCOPE_READ,
+	"GetFileMeta":             v1.PluginScope_PLUGIN_SCOPE_READ,
+	"StreamSearch":            v1.PluginScope_PLUGIN_SCOPE_READ,
+	"GetDownloadManagerItems": v1.PluginScope_PLUGIN_SCOPE_READ,
+	"GetUploads":              v1.PluginScope_PLUGIN_SCOPE_READ,
+	"GetFriends":              v1.PluginScope_PLUGIN_SCOPE_READ,
+	"GetInterests":            v1.PluginScope_PLUGIN_SCOPE_READ,
+	"GetSimilarUsers":         v1.PluginScope_PLUGIN_SCOPE_READ,
+
+	"QueueFileDownload":         v1.PluginScope_PLUGIN_SCOPE_DOWNLOADS,
+	"CancelFileDownload":        v1.PluginScope_PLUGIN_SCOPE_DOWNLOADS,
+	"PauseFileDownload":         v1.PluginScope_PLUGIN_SCOPE_DOWNLOADS,
+	"ResumeFileDownload":        v1.PluginScope_PLUGIN_SCOPE_DOWNLOADS,
+	"RemoveDownloadManagerItem": v1.PluginScope_PLUGIN_SCOPE_DOWNLOADS,
+
+	"CreateShare":             v1.PluginScope_PLUGIN_SCOPE_SHARES,
+	"IndexShare":              v1.PluginScope_PLUGIN_SCOPE_SHARES,
+	"DeleteShare":             v1.PluginScope_PLUGIN_SCOPE_SHARES,
+	"ImportShares":            v1.PluginScope_PLUGIN_SCOPE_SHARES,
+	"SetShareExcludePatterns": v1.PluginScope_PLUGIN_SCOPE_SHARES,
+	"CheckShareHealth":        v1.PluginScope_PLUGIN_SCOPE_SHARES,
+}
+
+// pluginEventScopes maps plugin event types to the scope required to receive them.
+var pluginEventScopes = map[v1.PluginEventType]v1.PluginScope{
+	v1.PluginEventType_PLUGIN_EVENT_TYPE_CLIENT_EVENT: v1.PluginScope_PLUGIN_SCOPE_EVENTS,
+	v1.PluginEventType_PLUGIN_EVENT_TYPE_SEARCH:       v1.PluginScope_PLUGIN_SCOPE_SEARCH,
+}
+
+type pluginCtxKey struct{}
+
+// PluginFromContext returns the plugin that made an RPC request and true if it was made with a plugin's token,
+// otherwise empty and false.
+func PluginFromContext(ctx context.Context) (storage.PluginRecord, bool) {
+	record, ok := ctx.Value(pluginCtxKey{}).(storage.PluginRecord)
+	return record, ok
+}
+
+// PluginStream is a plugin's subscription to events.
+type PluginStream struct {
+	plugin string
+	types  map[v1.PluginEventType]struct{}
+
+	// Events to send to the plugin.
+	Events chan *v1.PluginEvent
+
+	// Closed when the stream is unsubscribed, or when the plugin is deleted.
+	Done chan struct{}
+
+	closeOnce sync.Once
+}
+
+func (s *PluginStream) wants(eventType v1.PluginEventType) bool {
+	_, has := s.types[eventType]
+	return has
+}
+
+func (s *PluginStream) close() {
+	s.closeOnce.Do(func() {
+		close(s.Done)
+	})
+}
+
+// send sends an event to the plugin, or drops it if the plugin is too far behind.
+// Returns whether the event was sent.
+func (s *PluginStream) send(evt *v1.PluginEvent) bool {
+	select {
+	case <-s.Done:
+		return false
+	case s.Events <- evt:
+		return true
+	default:
+		return false
+	}
+}
+
+// pendingSearch is an incoming search that is waiting for plugins to respond.
+type pendingSearch struct {
+	mu sync.Mutex
+
+	maxResults int
+	results    []pb.MsgSearchResult
+
+	// Whether the results were already returned, after which responses are rejected.
+	isClosed bool
+
+	// The names of the plugins that were sent the search and have not responded yet.
+	waiting map[string]struct{}
+
+	// Closed once no plugins are waited on.
+	done chan struct{}
+}
+
+// doneWaitingFor marks a plugin as having responded.
+// The caller must hold the lock.
+func (p *pendingSearch) doneWaitingFor(plugin string) {
+	delete(p.waiting, plugin)
+	if len(p.waiting) == 0 {
+		select {
+		case <-p.done:
+		default:
+			close(p.done)
+		}
+	}
+}
+
+// PluginManager manages plugins, which are external processes that use the RPC interface with their own tokens and
+// permission scopes.
+// Plugins register for events with event streams, and can add results to searches made by peers.
+type PluginManager struct {
+	logger  *slog.Logger
+	storage *storage.Storage
+
+	mu       sync.Mutex
+	streams  map[*PluginStream]struct{}
+	searches map[string]*pendingSearch
+}
+
+var _ room.SearchHook = (*PluginManager)(nil)
+
+// NewPluginManager creates a new PluginManager.
+// Events published on the event bus are sent to plugins that registered for client events.
+func NewPluginManager(logger *slog.Logger, storage *storage.Storage, eventBus *event.Bus) *PluginManager {
+	m := &PluginManager{
+		logger:  logger,
+		storage: storage,
+
+		streams:  make(map[*PluginStream]struct{}),
+		searches: make(map[string]*pendingSearch),
+	}
+	eventBus.Subscribe(m.publishClientEvent)
+
+	return m
+}
+
+func hashPluginToken(token string) []byte {
+	sum := sha256.Sum256([]byte(token))
+	return sum[:]
+}
+
+// Authorize authorizes RPC requests made with a plugin's token.
+// It can be used as common.RpcServerConfig.TokenAuthorizer.
+// The returned context carries the plugin's record, which can be retrieved with PluginFromContext.
+func (m *PluginManager) Authorize(ctx context.Context, token string, method string) (context.Context, error) {
+	record, has, err := m.storage.GetPluginByTokenSha256(ctx, hashPluginToken(token))
+	if err != nil {
+		return ctx, fmt.Errorf(`failed to get plugin by token: %w`, err)
+	}
+	if !has {
+		return ctx, errInvalidPluginToken
+	}
+
+	if method != "StreamPluginEvents" {
+		scope, ok := pluginMethodScopes[method]
+		if !ok || !record.HasScope(scope) {
+			return ctx, errPluginScopeDenied
+		}
+	}
+
+	return context.WithValue(ctx, pluginCtxKey{}, record), nil
+}
+
+// Create creates a new plugin with the specified scopes.
+// Returns the plugin's record and its bearer token, which is not stored and cannot be retrieved again.
+// Returns ErrInvalidPluginName, ErrInvalidPluginScope or ErrPluginExists if the plugin cannot be created.
+func (m *PluginManager) Create(ctx context.Context, name string, scopes []v1.PluginScope) (storage.PluginRecord, string, error) {
+	name = strings.TrimSpace(name)
+	if name == "" || utf8.RuneCountInString(name) > MaxPluginNameLength {
+		return storage.PluginRecord{}, "", ErrInvalidPluginName
+	}
+	for _, scope := range scopes {
+		if _, known := v1.PluginScope_name[int32(scope)]; !known || scope == v1.PluginScope_PLUGIN_SCOPE_UNSPECIFIED {
+			return storage.PluginRecord{}, "", ErrInvalidPluginScope
+		}
+	}
+
+	_, has, err := m.storage.GetPluginByName(ctx, name)
+	if err != nil {
+		return storage.PluginRecord{}, "", err
+	}
+	if has {
+		return storage.PluginRecord{}, "", ErrPluginExists
+	}
+
+	token := common.RandomB64UrlStr(pluginTokenByteLen)
+	record, err := m.storage.CreatePlugin(ctx, name, hashPluginToken(token), scopes)
+	if err != nil {
+		return storage.PluginRecord{}, "", err
+	}
+
+	m.logger.Info("created plugin",
+		"service", "client.PluginManager",
+		"plugin", name,
+		"scopes", scopes,
+	)
+
+	return record, token, nil
+}
+
+// Delete deletes a plugin and closes its event streams.
+// Returns true if the plugin existed.
+func (m *PluginManager) Delete(ctx context.Context, name string) (bool, error) {
+	has, err := m.storage.DeletePlugin(ctx, name)
+	if err != nil || !has {
+		return has, err
+	}
+
+	m.mu.Lock()
+	for stream := range m.streams {
+		if stream.plugin == name {
+			stream.close()
+		}
+	}
+	m.mu.Unlock()
+
+	m.logger.Info("deleted plugin",
+		"service", "client.PluginManager",
+		"plugin", name,
+	)
+
+	return true, nil
+}
+
+// OpenStreams returns the number of event streams the plugin with the specified name has open.
+func (m *PluginManager) OpenStreams(name string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	count := 0
+	for stream := range m.streams {
+		if stream.plugin == name {
+			count++
+		}
+	}
+	return count
+}
+
+// Subscribe opens an event stream for a plugin that receives events of the specified types.
+// If types is empty, the stream receives all types the plugin's scopes allow.
+// Returns an error if the plugin does not have the scope for one of the types.
+// The stream must be closed with Unsubscribe once it is no longer used.
+func (m *PluginManager) Subscribe(plugin storage.PluginRecord, types []v1.PluginEventType) (*PluginStream, error) {
+	if len(types) == 0 {
+		for eventType, scope := range pluginEventScopes {
+			if plugin.HasScope(scope) {
+				types = append(types, eventType)
+			}
+		}
+	}
+
+	stream := &PluginStream{
+		plugin: plugin.Name,
+		types:  make(map[v1.PluginEventType]struct{}, len(types)),
+		Events: make(chan *v1.PluginEvent, pluginStreamBufferSize),
+		Done:   make(chan struct{}),
+	}
+	for _, eventType := range types {
+		scope, ok := pluginEventScopes[eventType]
+		if !ok || !plugin.HasScope(scope) {
+			return nil, errPluginScopeDenied
+		}
+		stream.types[eventType] = struct{}{}
+	}
+
+	m.mu.Lock()
+	m.streams[stream] = struct{}{}
+	m.mu.Unlock()
+
+	return stream, nil
+}
+
+// Unsubscribe closes a plugin's event stream.
+// Subsequent calls are no-op.
+func (m *PluginManager) Unsubscribe(stream *PluginStream) {
+	m.mu.Lock()
+	delete(m.streams, stream)
+	m.mu.Unlock()
+
+	stream.close()
+}
+
+// publishClientEvent sends a client event to all streams that registered for client events.
+func (m *PluginManager) publishClientEvent(evt *v1.Event, ctx *v1.EventContext) {
+	pluginEvt := &v1.PluginEvent{
+		Type: v1.PluginEventType_PLUGIN_EVENT_TYPE_CLIENT_EVENT,
+		ClientEvent: &v1.PluginEvent_ClientEvent{
+			Event:   evt,
+			Context: ctx,
+		},
+	}
+
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	for stream := range m.streams {
+		if stream.wants(v1.PluginEventType_PLUGIN_EVENT_TYPE_CLIENT_EVENT) {
+			stream.send(pluginEvt)
+		}
+	}
+}
+
+// Search sends a search made by a peer to the plugins that registered for searches, and waits for them to respond
+// with results, for up to pluginSearchTimeout.
+// It returns up to limit results, combined from all plugins that responded in time.
+func (m *PluginManager) Search(ctx context.Context, serverUuid string, query string, limit int) []pb.MsgSearchResult {
+	id := common.RandomB64UrlStr(16)
+	deadline := time.Now().Add(pluginSearchTimeout)
+	pending := &pendingSearch{
+		maxResults: limit,
+		results:    make([]pb.MsgSearchResult, 0),
+		waiting:    make(map[string]struct{}),
+		done:       make(chan struct{}),
+	}
+	evt := &v1.PluginEvent{
+		Type: v1.PluginEventType_PLUGIN_EVENT_TYPE_SEARCH,
+		Search: &v1.PluginEvent_Search{
+			Id:           id,
+			ServerUuid:   serverUuid,
+			Query:        query,
+			MaxResults:   uint32(limit),
+			DeadlineTsMs: deadline.UnixMilli(),
+		},
+	}
+
+	m.mu.Lock()
+	for stream := range m.streams {
+		if !stream.wants(v1.PluginEventType_PLUGIN_EVENT_TYPE_SEARCH) {
+			continue
+		}
+		if _, has := pending.waiting[stream.plugin]; has {
+			// Plugins with multiple streams only get each search once.
+			continue
+		}
+		if stream.send(evt) {
+			pending.waiting[stream.plugin] = struct{}{}
+		}
+	}
+	if len(pending.waiting) == 0 {
+		m.mu.Unlock()
+		return nil
+	}
+	m.searches[id] = pending
+	m.mu.Unlock()
+
+	defer func() {
+		m.mu.Lock()
+		delete(m.searches, id)
+		m.mu.Unlock()
+	}()
+
+	timer := time.NewTimer(time.Until(deadline))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+	case <-timer.C:
+	case <-pending.done:
+	}
+
+	pending.mu.Lock()
+	defer pending.mu.Unlock()
+
+	pending.isClosed = true
+	return slices.Clone(pending.results)
+}
+
+// Respond adds a plugin's results to a search it was sent.
+// Results past the search's limit are ignored.
+// Returns ErrPluginSearchNotFound if the search is not waiting for the plugin's response.
+func (m *PluginManager) Respond(plugin string, searchId string, results []pb.MsgSearchResult) error {
+	m.mu.Lock()
+	pending, has := m.searches[searchId]
+	m.mu.Unlock()
+	if !has {
+		return ErrPluginSearchNotFound
+	}
+
+	pending.mu.Lock()
+	defer pending.mu.Unlock()
+
+	if _, waiting := pending.waiting[plugin]; !waiting || pending.isClosed {
+		return ErrPluginSearchNotFound
+	}
+
+	remaining := max(pending.maxResults-len(pending.results), 0)
+	pending.results = append(pending.results, results[:min(len(results), remaining)]...)
+	pending.doneWaitingFor(plugin)
+
+	return nil
+}
@@ -0,0 +1,102 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	"friendnet.org/client/event"
+	"friendnet.org/client/storage"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+func newTestPluginManager(t *testing.T) *PluginManager {
+	store, err := storage.NewStorage(filepath.Join(t.TempDir(), "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = store.Close()
+	})
+
+	return NewPluginManager(slog.New(slog.DiscardHandler), store, event.NewBus())
+}
+
+func TestPluginAuthorizeScopes(t *testing.T) {
+	ctx := context.Background()
+	m := newTestPluginManager(t)
+
+	_, token, err := m.Create(ctx, "bot", []v1.PluginScope{v1.PluginScope_PLUGIN_SCOPE_READ})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err = m.Create(ctx, "bot", nil); !errors.Is(err, ErrPluginExists) {
+		t.Fatalf("expected ErrPluginExists, got %v", err)
+	}
+
+	pluginCtx, err := m.Authorize(ctx, token, "GetServers")
+	if err != nil {
+		t.Fatalf("expected method in scope to be allowed, got %v", err)
+	}
+	if record, ok := PluginFromContext(pluginCtx); !ok || record.Name != "bot" {
+		t.Fatalf("expected plugin in context, got %v, %t", record, ok)
+	}
+
+	if _, err = m.Authorize(ctx, token, "QueueFileDownload"); err == nil {
+		t.Fatal("expected method outside scope to be denied")
+	}
+	if _, err = m.Authorize(ctx, token, "CreatePlugin"); err == nil {
+		t.Fatal("expected plugin management to be denied")
+	}
+	if _, err = m.Authorize(ctx, "wrong", "GetServers"); err == nil {
+		t.Fatal("expected invalid token to be denied")
+	}
+
+	// Deleting the plugin revokes its token.
+	if has, err := m.Delete(ctx, "bot"); err != nil || !has {
+		t.Fatalf("got %t, %v deleting plugin", has, err)
+	}
+	if _, err = m.Authorize(ctx, token, "GetServers"); err == nil {
+		t.Fatal("expected deleted plugin's token to be denied")
+	}
+}
+
+func TestPluginSearchResponses(t *testing.T) {
+	ctx := context.Background()
+	m := newTestPluginManager(t)
+
+	record, _, err := m.Create(ctx, "indexer", []v1.PluginScope{v1.PluginScope_PLUGIN_SCOPE_SEARCH})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = m.Subscribe(record, []v1.PluginEventType{v1.PluginEventType_PLUGIN_EVENT_TYPE_CLIENT_EVENT}); err == nil {
+		t.Fatal("expected subscribing to events outside scope to fail")
+	}
+
+	stream, err := m.Subscribe(record, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer m.Unsubscribe(stream)
+
+	go func() {
+		evt := <-stream.Events
+		results := []pb.MsgSearchResult{
+			{DirectoryPath: "/music", File: &pb.MsgFileMeta{Name: "a.flac"}},
+			{DirectoryPath: "/music", File: &pb.MsgFileMeta{Name: "b.flac"}},
+			{DirectoryPath: "/music", File: &pb.MsgFileMeta{Name: "c.flac"}},
+		}
+		_ = m.Respond("indexer", evt.Search.Id, results)
+	}()
+
+	results := m.Search(ctx, "server", "flac", 2)
+	if len(results) != 2 {
+		t.Fatalf("expected results to be limited to 2, got %d", len(results))
+	}
+	if err = m.Respond("indexer", "unknown", nil); !errors.Is(err, ErrPluginSearchNotFound) {
+		t.Fatalf("expected ErrPluginSearchNotFound, got %v", err)
+	}
+}
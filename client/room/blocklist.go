@@ -0,0 +1,50 @@
+package room
+
+import (
+	"sync"
+
+	"friendnet.org/common"
+)
+
+// BlockList is a set of peers whose requests are rejected.
+// It is safe for concurrent use.
+type BlockList struct {
+	mu    sync.RWMutex
+	peers map[common.NormalizedUsername]struct{}
+}
+
+// NewBlockList creates a new BlockList with the specified peers blocked.
+func NewBlockList(peers []common.NormalizedUsername) *BlockList {
+	b := &BlockList{
+		peers: make(map[common.NormalizedUsername]struct{}, len(peers)),
+	}
+	for _, peer := range peers {
+		b.peers[peer] = struct{}{}
+	}
+	return b
+}
+
+// Has returns whether the peer is blocked.
+func (b *BlockList) Has(peer common.NormalizedUsername) bool {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+
+	_, has := b.peers[peer]
+	return has
+}
+
+// Add blocks the peer.
+func (b *BlockList) Add(peer common.NormalizedUsername) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.peers[peer] = struct{}{}
+}
+
+// Remove unblocks the peer.
+func (b *BlockList) Remove(peer common.NormalizedUsername) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	delete(b.peers, peer)
+}
@@ -33,6 +33,36 @@ func (b C2cBidi) DisownConn() {
 	}
 }
 
+// requestLimitExemptMsgTypes are the message types that do not count towards a peer's concurrent request limit.
+var requestLimitExemptMsgTypes = map[pb.MsgType]struct{}{
+	pb.MsgType_MSG_TYPE_PING: {},
+	pb.MsgType_MSG_TYPE_BYE:  {},
+}
+
+// acquireRequest reserves a request slot for the peer.
+// Returns false if the peer already has the maximum number of concurrent requests.
+// If it returns true, releaseRequest must be called once the request is finished.
+func (c *Conn) acquireRequest(username common.NormalizedUsername) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.maxRequestsPerPeer > 0 && c.activeRequests[username] >= c.maxRequestsPerPeer {
+		return false
+	}
+	c.activeRequests[username]++
+	return true
+}
+
+// releaseRequest releases a request slot reserved by acquireRequest.
+func (c *Conn) releaseRequest(username common.NormalizedUsername) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.activeRequests[username] <= 1 {
+		delete(c.activeRequests, username)
+	} else {
+		c.activeRequests[username]--
+	}
+}
+
 func (c *Conn) c2cLoop() {
 loop:
 	for {
@@ -59,6 +89,11 @@ loop:
 					if protocol.IsErrorConnCloseOrCancel(err) {
 						return
 					}
+					if unknownErr, ok := errors.AsType[protocol.UnknownMsgTypeError](err); ok {
+						// Probably a message from a newer protocol version.
+						_ = bidi.WriteUnimplementedError(unknownErr.Type)
+						return
+					}
 					if _, ok := errors.AsType[*quic.StreamError](err); ok {
 						return
 					}
@@ -70,6 +105,19 @@ loop:
 					return
 				}
 
+				if rawMsg.Type != pb.MsgType_MSG_TYPE_BYE && c.logic.IsPeerBlocked(bidi.Username) {
+					_ = bidi.WriteError(pb.ErrType_ERR_TYPE_PERMISSION_DENIED, "peer is blocked")
+					return
+				}
+
+				if _, exempt := requestLimitExemptMsgTypes[rawMsg.Type]; !exempt {
+					if !c.acquireRequest(bidi.Username) {
+						_ = bidi.WriteError(pb.ErrType_ERR_TYPE_RATE_LIMITED, "too many concurrent requests")
+						return
+					}
+					defer c.releaseRequest(bidi.Username)
+				}
+
 				// Handle C2C message.
 				err = nil
 				switch rawMsg.Type {
@@ -85,6 +133,8 @@ loop:
 					err = c.logic.OnGetFileMeta(c.Context, c, bidi, protocol.ToTyped[*pb.MsgGetFileMeta](rawMsg))
 				case pb.MsgType_MSG_TYPE_GET_FILE:
 					err = c.logic.OnGetFile(c.Context, c, bidi, protocol.ToTyped[*pb.MsgGetFile](rawMsg))
+				case pb.MsgType_MSG_TYPE_MEASURE:
+					err = c.logic.OnMeasure(c.Context, c, bidi, protocol.ToTyped[*pb.MsgMeasure](rawMsg))
 				case pb.MsgType_MSG_TYPE_CONNECT_TO_ME:
 					err = c.logic.OnConnectToMe(c.Context, c, bidi, protocol.ToTyped[*pb.MsgConnectToMe](rawMsg))
 				case pb.MsgType_MSG_TYPE_SEARCH:
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"log/slog"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"friendnet.org/client/cert"
@@ -16,12 +17,20 @@ import (
 	"friendnet.org/protocol"
 	pb "friendnet.org/protocol/pb/v1"
 	"github.com/quic-go/quic-go"
+	"golang.org/x/sync/singleflight"
 	"google.golang.org/protobuf/proto"
 )
 
 // ServerPingInterval is the interval between pings sent to the server.
 const ServerPingInterval = 10 * time.Second
 
+// ServerMaxMissedPings is the number of pings in a row the server may fail to answer before the connection is closed.
+const ServerMaxMissedPings = 3
+
+// ClockSkewWarnThreshold is how far the local clock may differ from the server's before users are warned about it.
+// Handshake tokens are only valid for a minute, so larger differences can make direct connections fail.
+const ClockSkewWarnThreshold = 30 * time.Second
+
 // ErrRoomConnClosed is returned when trying to interact with a closed room connection.
 var ErrRoomConnClosed = errors.New("room connection closed")
 
@@ -51,6 +60,9 @@ type Conn struct {
 	mu       sync.RWMutex
 	isClosed bool
 
+	// Set if the server closed the connection.
+	remoteClose *remoteCloseInfo
+
 	logger *slog.Logger
 
 	logic             Logic
@@ -65,6 +77,18 @@ type Conn struct {
 	// The current user's username.
 	Username common.NormalizedUsername
 
+	// The room's message of the day, as of when the connection was established.
+	motd string
+
+	// The server's reply to MSG_TYPE_GET_SERVER_INFO, or nil if it was not received yet or the server does not
+	// support it.
+	serverInfo atomic.Pointer[pb.MsgServerInfo]
+
+	// How far the server's clock was ahead of the local clock when the connection was established.
+	// Only valid if clockSkewKnown is true.
+	clockSkew      time.Duration
+	clockSkewKnown bool
+
 	// The room's context.
 	// Done when the connection is closed.
 	Context   context.Context
@@ -73,6 +97,9 @@ type Conn struct {
 	serverConn   protocol.ProtoConn
 	incomingBidi chan C2cBidi
 
+	// Round-trip time statistics for pings sent to the server.
+	rtt common.RttTracker
+
 	// The direct server manager.
 	directMgr *direct.Manager
 
@@ -85,6 +112,11 @@ type Conn struct {
 	// The key is the client username, the value is a set of connections.
 	directConns map[common.NormalizedUsername]map[protocol.ProtoConn]struct{}
 
+	// Round-trip time statistics for pings sent to room clients over direct connections.
+	// The key is the client username.
+	// Entries are removed once there are no direct connections left to the client.
+	directRtt map[common.NormalizedUsername]*common.RttTracker
+
 	// A cache of direct connect methods for room clients.
 	// If no methods are available for a client, the slice will be empty.
 	// If we have not checked methods for a client yet, there will be no value for that client.
@@ -109,15 +141,31 @@ type Conn struct {
 	// The interval at which direct connection-related caches are cleared.
 	directGcInterval time.Duration
 
+	// The maximum number of requests each peer can have in flight at once.
+	// 0 means unlimited.
+	maxRequestsPerPeer int
+
+	// The number of requests each peer currently has in flight.
+	activeRequests map[common.NormalizedUsername]int
+
+	// Warm proxied streams to recently requested peers.
+	proxyPool *proxyPool
+
+	// Coalesces identical concurrent file metadata requests to peers into a single request.
+	// See VirtualC2cConn.GetFileMetaContext.
+	fileMetaFlight singleflight.Group
+
 	eventPublisher *event.Publisher
 }
 
 // negotiateVersion negotiates the protocol version with the server.
 // Returns the server's protocol version if successful.
+// The capabilities accepted by the server are recorded on serverConn with protocol.SetCapabilities.
 // Returns a protocol.VersionRejectedError if the server rejected the client's version.
 func negotiateVersion(serverConn protocol.ProtoConn, clientVer *pb.ProtoVersion) (*pb.ProtoVersion, error) {
 	res, err := serverConn.SendAndReceive(pb.MsgType_MSG_TYPE_VERSION, &pb.MsgVersion{
-		Version: clientVer,
+		Version:      clientVer,
+		Capabilities: protocol.SupportedCapabilities(serverConn),
 	})
 	if err != nil {
 		return nil, err
@@ -125,6 +173,7 @@ func negotiateVersion(serverConn protocol.ProtoConn, clientVer *pb.ProtoVersion)
 
 	switch payload := res.Payload.(type) {
 	case *pb.MsgVersionAccepted:
+		protocol.SetCapabilities(serverConn, payload.Capabilities)
 		return payload.Version, nil
 	case *pb.MsgVersionRejected:
 		return nil, protocol.VersionRejectedError{
@@ -136,28 +185,55 @@ func negotiateVersion(serverConn protocol.ProtoConn, clientVer *pb.ProtoVersion)
 	}
 }
 
-// authenticate authenticates with the server.
+// negotiateVersionEarly is like negotiateVersion, but for connections returned by ConnectWithCertStore, which may use
+// 0-RTT. If the server rejected 0-RTT, negotiation is done again on the connection that replaced it, which is returned.
+// It returns once the handshake completed, so that messages that are not replay-safe, such as credentials, are never
+// sent with 0-RTT.
+// The returned connection is never nil, so that it can be closed on error.
+func negotiateVersionEarly(ctx context.Context, serverConn protocol.ProtoConn, clientVer *pb.ProtoVersion) (protocol.ProtoConn, *pb.ProtoVersion, error) {
+	serverVer, err := negotiateVersion(serverConn, clientVer)
+	if protocol.Is0RTTRejected(err) {
+		nextConn, nextErr := protocol.Next0RTTConn(ctx, serverConn)
+		if nextErr != nil {
+			return serverConn, nil, nextErr
+		}
+		serverConn = nextConn
+		serverVer, err = negotiateVersion(serverConn, clientVer)
+	}
+	if err != nil {
+		return serverConn, nil, err
+	}
+
+	err = protocol.WaitForHandshake(ctx, serverConn)
+	if err != nil {
+		return serverConn, nil, err
+	}
+
+	return serverConn, serverVer, nil
+}
+
+// authenticate authenticates with the server and returns the server's acceptance message.
 // Returns a protocol.AuthRejectedError if the server rejected the request.
-func authenticate(serverConn protocol.ProtoConn, creds Credentials) error {
+func authenticate(serverConn protocol.ProtoConn, creds Credentials) (*pb.MsgAuthAccepted, error) {
 	res, err := serverConn.SendAndReceive(pb.MsgType_MSG_TYPE_AUTHENTICATE, &pb.MsgAuthenticate{
 		Room:     creds.Room.String(),
 		Username: creds.Username.String(),
 		Password: creds.Password,
 	})
 	if err != nil {
-		return err
+		return nil, err
 	}
 
 	switch payload := res.Payload.(type) {
 	case *pb.MsgAuthAccepted:
-		return nil
+		return payload, nil
 	case *pb.MsgAuthRejected:
-		return protocol.AuthRejectedError{
+		return nil, protocol.AuthRejectedError{
 			Reason:  payload.Reason,
 			Message: common.StrPtrOr(payload.Message, ""),
 		}
 	default:
-		return protocol.NewUnexpectedMsgTypeError(pb.MsgType_MSG_TYPE_AUTH_ACCEPTED, res.Type)
+		return nil, protocol.NewUnexpectedMsgTypeError(pb.MsgType_MSG_TYPE_AUTH_ACCEPTED, res.Type)
 	}
 }
 
@@ -168,8 +244,11 @@ func authenticate(serverConn protocol.ProtoConn, creds Credentials) error {
 // The directPartitionName value must be unique among open Conn instances that use the same direct.Manager.
 // It could be a server UUID, or something else unique to the connection.
 // If an open Conn instance has the name "abc" and this function is called with directPartitionName "abc", it will return an error.
+//
+// The server connection is obtained from sessions, so rooms on the same server can share it.
 func NewConn(
 	logger *slog.Logger,
+	sessions *SessionPool,
 	logic Logic,
 	connMethodSupport machine.ConnMethodSupport,
 	certStore cert.Store,
@@ -182,25 +261,24 @@ func NewConn(
 	clientVer := protocol.CurrentProtocolVersion
 
 	ctx, ctxCancel := context.WithCancel(context.Background())
-	conn, err := ConnectWithCertStore(ctx, certStore, address)
+	conn, serverVer, accepted, err := sessions.Connect(ctx, certStore, address, clientVer, creds)
 	if err != nil {
 		ctxCancel()
 		return nil, err
 	}
 
-	serverVer, err := negotiateVersion(conn, clientVer)
-	if err != nil {
-		ctxCancel()
-		return nil, err
-	}
-	err = authenticate(conn, creds)
-	if err != nil {
-		ctxCancel()
-		return nil, err
+	clockSkew, clockSkewKnown := estimateClockSkew(accepted.ServerTsMs, time.Now())
+	if clockSkewKnown && clockSkew.Abs() >= ClockSkewWarnThreshold {
+		logger.Warn("local clock differs from the server's, which can break certificate validation and direct connections",
+			"service", "room.Conn",
+			"room", creds.Room.String(),
+			"skew", clockSkew,
+		)
 	}
 
 	directPart, err := directMgr.CreatePartition(directPartitionName)
 	if err != nil {
+		_ = conn.CloseWithCode(protocol.CloseCodeInternalError, "failed to create direct partition")
 		ctxCancel()
 		return nil, err
 	}
@@ -217,6 +295,11 @@ func NewConn(
 		RoomName: creds.Room,
 		Username: creds.Username,
 
+		motd: accepted.Motd,
+
+		clockSkew:      clockSkew,
+		clockSkewKnown: clockSkewKnown,
+
 		Context:   ctx,
 		ctxCancel: ctxCancel,
 
@@ -226,6 +309,7 @@ func NewConn(
 		directMgr:                     directMgr,
 		directPart:                    directPart,
 		directConns:                   make(map[common.NormalizedUsername]map[protocol.ProtoConn]struct{}),
+		directRtt:                     make(map[common.NormalizedUsername]*common.RttTracker),
 		directPeerMethods:             make(map[common.NormalizedUsername][]*pb.ConnMethod),
 		directSelfMethods:             make(map[string]*pb.ConnMethod),
 		directConnectOutgoingFailures: make(map[common.NormalizedUsername]struct{}),
@@ -233,13 +317,26 @@ func NewConn(
 		directOutgoingTimeout:         10 * time.Second,
 		directGcInterval:              5 * time.Minute,
 
+		maxRequestsPerPeer: directMgr.ConnLimits().MaxConcurrentRequests,
+		activeRequests:     make(map[common.NormalizedUsername]int),
+
 		eventPublisher: eventPublisher,
 	}
 
+	c.proxyPool = newProxyPool(c.openProxiedC2cBidi, c.proxyPoolMaxWarm)
+
 	go c.directCacheGc()
 
 	go c.c2cLoop()
 
+	go c.sharesRevisionLoop()
+
+	go c.followNetworkLoop()
+
+	go c.fetchServerInfo()
+
+	go c.interestsLoop()
+
 	go func() {
 		c.s2cLoop()
 
@@ -260,17 +357,19 @@ func NewConn(
 	return c, nil
 }
 
-// Ping sends a ping request to the client and returns the round-trip time.
-func (c *Conn) Ping() (time.Duration, error) {
-	start := time.Now()
-	_, err := c.serverConn.SendAndReceive(pb.MsgType_MSG_TYPE_PING, &pb.MsgPing{
-		SentTs: start.UnixMilli(),
-	})
+// Ping sends a ping request to the server and returns the round-trip time and, if the server reported it, the
+// offset of its clock.
+// It gives up with protocol.ErrPingTimeout if the server does not answer within ServerPingInterval.
+func (c *Conn) Ping() (common.RttSample, error) {
+	ctx, cancel := context.WithTimeoutCause(c.Context, ServerPingInterval, protocol.ErrPingTimeout)
+	defer cancel()
+
+	sample, err := protocol.PingContext(ctx, c.serverConn)
 	if err != nil {
-		return 0, fmt.Errorf("failed to send ping to server: %w", err)
+		return common.RttSample{}, fmt.Errorf("failed to send ping to server: %w", err)
 	}
 
-	return time.Since(start), nil
+	return sample, nil
 }
 
 // ChangeAccountPassword changes the password on the account the connection is using.
@@ -283,9 +382,204 @@ func (c *Conn) ChangeAccountPassword(currentPassword string, newPassword string)
 		return fmt.Errorf("failed to change account password: %w", err)
 	}
 
-	return err
+	return nil
+}
+
+// ChangeAccountPasswordStandalone connects to the server at the specified address, authenticates with the specified
+// credentials, changes the account's password, then disconnects.
+// It does not create a full room connection, so it does not need a running client.
+//
+// If the server rejects the client's protocol version, returns a protocol.VersionRejectedError.
+// If the server rejects the client's credentials, returns a protocol.AuthRejectedError.
+// If the server rejects the new password, returns a protocol.ProtoMsgError.
+func ChangeAccountPasswordStandalone(
+	ctx context.Context,
+	certStore cert.Store,
+	address string,
+	creds Credentials,
+	newPassword string,
+) error {
+	conn, err := ConnectWithCertStore(ctx, certStore, address)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = conn.SendAndReceive(pb.MsgType_MSG_TYPE_BYE, &pb.MsgBye{})
+		_ = conn.CloseWithCode(protocol.CloseCodeNormal, "goodbye")
+	}()
+
+	conn, _, err = negotiateVersionEarly(ctx, conn, protocol.CurrentProtocolVersion)
+	if err != nil {
+		return err
+	}
+	_, err = authenticate(conn, creds)
+	if err != nil {
+		return err
+	}
+
+	err = conn.SendAndReceiveAck(pb.MsgType_MSG_TYPE_CHANGE_ACCOUNT_PASSWORD, &pb.MsgChangeAccountPassword{
+		CurrentPassword: creds.Password,
+		NewPassword:     newPassword,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to change account password: %w", err)
+	}
+
+	return nil
 }
 
+// RegisterStandalone connects to the server at the specified address, registers an account with the specified
+// credentials, then disconnects.
+// The invite code may be empty if the server does not require one.
+// If fingerprint is not empty, the server is connected to with ConnectWithFingerprint.
+//
+// If the server rejects the registration, returns a protocol.AuthRejectedError.
+func RegisterStandalone(
+	ctx context.Context,
+	certStore cert.Store,
+	address string,
+	creds Credentials,
+	inviteCode string,
+	fingerprint string,
+) error {
+	conn, err := ConnectWithFingerprint(ctx, certStore, address, fingerprint)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_, _ = conn.SendAndReceive(pb.MsgType_MSG_TYPE_BYE, &pb.MsgBye{})
+		_ = conn.CloseWithCode(protocol.CloseCodeNormal, "goodbye")
+	}()
+
+	conn, _, err = negotiateVersionEarly(ctx, conn, protocol.CurrentProtocolVersion)
+	if err != nil {
+		return err
+	}
+
+	var inviteCodePtr *string
+	if inviteCode != "" {
+		inviteCodePtr = &inviteCode
+	}
+	res, err := conn.SendAndReceive(pb.MsgType_MSG_TYPE_REGISTER, &pb.MsgRegister{
+		Room:       creds.Room.String(),
+		Username:   creds.Username.String(),
+		Password:   creds.Password,
+		InviteCode: inviteCodePtr,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to register account: %w", err)
+	}
+
+	switch payload := res.Payload.(type) {
+	case *pb.MsgAuthAccepted:
+		return nil
+	case *pb.MsgAuthRejected:
+		return protocol.AuthRejectedError{
+			Reason:  payload.Reason,
+			Message: common.StrPtrOr(payload.Message, ""),
+		}
+	default:
+		return protocol.NewUnexpectedMsgTypeError(pb.MsgType_MSG_TYPE_AUTH_ACCEPTED, res.Type)
+	}
+}
+
+type remoteCloseInfo struct {
+	code   protocol.CloseCode
+	reason string
+}
+
+// noteCloseErr records the close code if err shows that the server closed the connection.
+// Only the first close is recorded.
+func (c *Conn) noteCloseErr(err error) {
+	code, reason, ok := protocol.CloseCodeFromError(err)
+	if !ok {
+		return
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.remoteClose == nil {
+		c.remoteClose = &remoteCloseInfo{code: code, reason: reason}
+	}
+}
+
+// RemoteClose returns the close code and reason sent by the server if the server closed the connection.
+// Returns false if the connection is open or was closed by us.
+func (c *Conn) RemoteClose() (code protocol.CloseCode, reason string, ok bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	if c.remoteClose == nil {
+		return 0, "", false
+	}
+	return c.remoteClose.code, c.remoteClose.reason, true
+}
+
+// Motd returns the room's message of the day as of when the connection was established, or empty if it has none.
+func (c *Conn) Motd() string {
+	return c.motd
+}
+
+// ServerInfo returns the information the server reported about its software and the room's policies when the
+// connection was established.
+// Returns nil if it has not been received yet or the server does not support reporting it.
+func (c *Conn) ServerInfo() *pb.MsgServerInfo {
+	return c.serverInfo.Load()
+}
+
+// fetchServerInfo asks the server for information about its software and the room's policies.
+func (c *Conn) fetchServerInfo() {
+	msg, err := protocol.SendAndReceiveExpect[*pb.MsgServerInfo](
+		c.serverConn,
+		pb.MsgType_MSG_TYPE_GET_SERVER_INFO,
+		&pb.MsgGetServerInfo{},
+		pb.MsgType_MSG_TYPE_SERVER_INFO,
+	)
+	if err != nil {
+		if protocol.IsErrorConnCloseOrCancel(err) {
+			return
+		}
+		if msgErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok &&
+			msgErr.Msg.Type == pb.ErrType_ERR_TYPE_UNIMPLEMENTED {
+			// Older servers do not report server info.
+			return
+		}
+
+		c.logger.Warn("failed to get server info",
+			"service", "room.Conn",
+			"room", c.RoomName.String(),
+			"err", err,
+		)
+		return
+	}
+
+	c.serverInfo.Store(msg.Payload)
+}
+
+// estimateClockSkew estimates how far the server's clock is ahead of the local clock from the server time reported in
+// MsgAuthAccepted and the local time it was received at.
+// The estimate is off by at most the round-trip time, which is small compared to ClockSkewWarnThreshold.
+// Returns false if the server did not report its time.
+func estimateClockSkew(serverTsMs int64, receivedAt time.Time) (time.Duration, bool) {
+	if serverTsMs == 0 {
+		return 0, false
+	}
+	return time.UnixMilli(serverTsMs).Sub(receivedAt), true
+}
+
+// ClockSkew returns how far the server's clock was ahead of the local clock when the connection was established.
+// It is negative if the server's clock was behind.
+// Returns false if the server did not report its time.
+func (c *Conn) ClockSkew() (time.Duration, bool) {
+	return c.clockSkew, c.clockSkewKnown
+}
+
+// RttStats returns round-trip time statistics for pings sent to the server.
+func (c *Conn) RttStats() common.RttStats {
+	return c.rtt.Stats()
+}
+
+// pingLoop pings the server until the connection closes.
+// Returns early if the server misses ServerMaxMissedPings pings in a row.
 func (c *Conn) pingLoop() {
 	ticker := time.NewTicker(ServerPingInterval)
 	defer ticker.Stop()
@@ -294,21 +588,131 @@ func (c *Conn) pingLoop() {
 		case <-c.Context.Done():
 			return
 		case <-ticker.C:
-			if _, err := c.Ping(); err != nil {
+			sample, err := c.Ping()
+			if err == nil {
+				c.rtt.RecordSample(sample)
+				continue
+			}
+			if protocol.IsErrorConnCloseOrCancel(err) {
+				c.noteCloseErr(err)
+				return
+			}
+
+			missed := c.rtt.RecordLost()
+			c.logger.Error("failed to ping server",
+				"service", "room.Conn",
+				"missed", missed,
+				"err", err,
+			)
+
+			if missed >= ServerMaxMissedPings {
+				c.logger.Warn("closing connection after server missed pings",
+					"service", "room.Conn",
+					"room", c.RoomName.String(),
+					"missed", missed,
+				)
+				_ = c.serverConn.CloseWithCode(protocol.CloseCodePingTimeout, fmt.Sprintf("missed %d pings", missed))
+				return
+			}
+		}
+	}
+}
+
+// sharesRevisionLoop announces the shares revision to the server after connecting and whenever it changes.
+func (c *Conn) sharesRevisionLoop() {
+	for {
+		revision, changed := c.logic.SharesRevision()
+
+		err := c.serverConn.SendAndReceiveAck(pb.MsgType_MSG_TYPE_SHARES_REVISION, &pb.MsgSharesRevision{
+			Revision: revision,
+		})
+		if err != nil {
+			if protocol.IsErrorConnCloseOrCancel(err) {
+				return
+			}
+			if msgErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok &&
+				msgErr.Msg.Type == pb.ErrType_ERR_TYPE_UNIMPLEMENTED {
+				// Older servers do not cache listings.
+				return
+			}
+
+			c.logger.Warn("failed to announce shares revision",
+				"service", "room.Conn",
+				"room", c.RoomName.String(),
+				"err", err,
+			)
+		}
+
+		select {
+		case <-c.Context.Done():
+			return
+		case <-changed:
+		}
+	}
+}
+
+// interestsLoop publishes the client's interests to the server after connecting and whenever they change.
+func (c *Conn) interestsLoop() {
+	// The server forgets interests when the client disconnects, so there is nothing to clear on a new connection.
+	published := false
+
+	for {
+		interests, changed := c.logic.Interests()
+
+		if len(interests) > 0 || published {
+			err := c.serverConn.SendAndReceiveAck(pb.MsgType_MSG_TYPE_SET_INTERESTS, &pb.MsgSetInterests{
+				Interests: interests,
+			})
+			if err == nil {
+				published = len(interests) > 0
+			} else {
 				if protocol.IsErrorConnCloseOrCancel(err) {
 					return
 				}
+				if msgErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok &&
+					(msgErr.Msg.Type == pb.ErrType_ERR_TYPE_UNIMPLEMENTED || msgErr.Msg.Type == pb.ErrType_ERR_TYPE_PERMISSION_DENIED) {
+					// Older servers do not support interests, and guests cannot publish them.
+					return
+				}
 
-				c.logger.Error("failed to ping server",
+				c.logger.Warn("failed to publish interests",
 					"service", "room.Conn",
+					"room", c.RoomName.String(),
 					"err", err,
 				)
 			}
-			_, _ = c.serverConn.SendAndReceive(pb.MsgType_MSG_TYPE_PING, &pb.MsgPing{})
+		}
+
+		select {
+		case <-c.Context.Done():
+			return
+		case <-changed:
 		}
 	}
 }
 
+// GetSimilarUsers returns the online users in the room whose interests overlap with the client's, most overlapping
+// first.
+// If limit is 0, the server picks how many to return.
+// If the server does not support interests, returns a protocol.ProtoMsgError of ERR_TYPE_UNIMPLEMENTED.
+func (c *Conn) GetSimilarUsers(limit uint32) ([]*pb.SimilarUser, error) {
+	msg := &pb.MsgGetSimilarUsers{}
+	if limit > 0 {
+		msg.Limit = &limit
+	}
+
+	res, err := protocol.SendAndReceiveExpect[*pb.MsgSimilarUsers](
+		c.serverConn,
+		pb.MsgType_MSG_TYPE_GET_SIMILAR_USERS,
+		msg,
+		pb.MsgType_MSG_TYPE_SIMILAR_USERS,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return res.Payload.Users, nil
+}
+
 // Close closes the room connection.
 // Subsequent calls are no-op.
 func (c *Conn) Close() error {
@@ -328,6 +732,8 @@ func (c *Conn) Close() error {
 
 	c.mu.Unlock()
 
+	c.proxyPool.Close()
+
 	_ = c.directPart.Close()
 
 	// Signal to the server and direct conns that the client is leaving.
@@ -351,11 +757,11 @@ func (c *Conn) Close() error {
 
 	var closeWg sync.WaitGroup
 	closeWg.Go(func() {
-		_ = c.serverConn.CloseWithReason("goodbye")
+		_ = c.serverConn.CloseWithCode(protocol.CloseCodeNormal, "goodbye")
 	})
 	for _, conn := range directConns {
 		closeWg.Go(func() {
-			_ = conn.CloseWithReason("goodbye")
+			_ = conn.CloseWithCode(protocol.CloseCodeNormal, "goodbye")
 		})
 	}
 	closeWg.Wait()
@@ -395,6 +801,17 @@ func (c *Conn) openProxiedC2cBidi(username common.NormalizedUsername) (protocol.
 	return bidi, nil
 }
 
+// proxyPoolMaxWarm returns the maximum number of warm proxied streams to keep open to all peers together.
+// Warm streams count towards the room's limit on concurrent proxied streams, so only a quarter of it is used for them.
+func (c *Conn) proxyPoolMaxWarm() int {
+	if info := c.ServerInfo(); info != nil {
+		if limit := info.GetRoom().GetMaxProxyStreamsPerClient(); limit > 0 {
+			return min(proxyPoolMaxWarm, int(limit)/4)
+		}
+	}
+	return proxyPoolMaxWarm
+}
+
 // openC2cBidiWithMsg opens a bidi to a destination peer.
 // If the peer is definitely unreachable, returns protocol.ErrPeerUnreachable.
 // It may not return an error if the peer is unreachable immediately, but read methods
@@ -596,16 +1013,13 @@ func (c *Conn) openC2cBidiWithMsg(
 openBidi:
 	var bidi protocol.ProtoBidi
 	var err error
-	if directConn == nil {
-		bidi, err = c.openProxiedC2cBidi(username)
-		if err != nil {
-			return protocol.ProtoBidi{}, err
-		}
-	} else {
-		bidi, err = directConn.OpenBidiWithMsg(typ, msg)
-		if err != nil {
-			return protocol.ProtoBidi{}, err
-		}
+	if directConn != nil {
+		return directConn.OpenBidiWithMsg(typ, msg)
+	}
+
+	bidi, err = c.proxyPool.Get(username)
+	if err != nil {
+		return protocol.ProtoBidi{}, err
 	}
 
 	err = bidi.Write(typ, msg)
@@ -631,12 +1045,19 @@ func (c *Conn) GetVirtualC2cConn(peer common.NormalizedUsername, forceProxy bool
 		ServerConn: c,
 		Username:   peer,
 		ForceProxy: forceProxy,
+		Policy:     c.directMgr.RequestPolicy(),
 	}
 }
 
 // GetOnlineUsers returns a stream of online users.
 func (c *Conn) GetOnlineUsers() (protocol.Stream[*pb.MsgOnlineUsers], error) {
-	bidi, err := c.serverConn.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_GET_ONLINE_USERS, &pb.MsgGetOnlineUsers{})
+	// The whole list is usually read, so ask for as few pages as the server allows.
+	req := &pb.MsgGetOnlineUsers{}
+	if protocol.HasCapability(c.serverConn, pb.Capability_CAPABILITY_PAGE_SIZE) {
+		req.PageSize = protocol.MaxPageSize
+	}
+
+	bidi, err := c.serverConn.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_GET_ONLINE_USERS, req)
 	if err != nil {
 		return nil, err
 	}
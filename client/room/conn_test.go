@@ -0,0 +1,26 @@
+package room
+
+import (
+	"testing"
+	"time"
+)
+
+func TestEstimateClockSkew(t *testing.T) {
+	t.Parallel()
+
+	now := time.UnixMilli(1_700_000_000_000)
+
+	if _, ok := estimateClockSkew(0, now); ok {
+		t.Fatal("expected skew to be unknown when the server did not report its time")
+	}
+
+	skew, ok := estimateClockSkew(now.Add(2*time.Minute).UnixMilli(), now)
+	if !ok || skew != 2*time.Minute {
+		t.Fatalf("expected server to be 2m ahead, got %s, %v", skew, ok)
+	}
+
+	skew, ok = estimateClockSkew(now.Add(-5*time.Second).UnixMilli(), now)
+	if !ok || skew != -5*time.Second {
+		t.Fatalf("expected server to be 5s behind, got %s, %v", skew, ok)
+	}
+}
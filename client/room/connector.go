@@ -4,7 +4,6 @@ import (
 	"bytes"
 	"context"
 	"crypto/tls"
-	"crypto/x509"
 	"fmt"
 	"net"
 	"time"
@@ -12,37 +11,67 @@ import (
 	"friendnet.org/client/cert"
 	"friendnet.org/common"
 	"friendnet.org/protocol"
-	"github.com/quic-go/quic-go"
 )
 
+// sessionCache caches TLS sessions with servers, so that reconnecting to a server can resume its session and use 0-RTT.
+var sessionCache = tls.NewLRUClientSessionCache(0)
+
 // ConnectWithCertStore attempts to connect to the specified address, verifying its certificate using the specified cert.Store for TOFU.
+// The address is resolved with ResolveServerAddress first.
+// Certificates are stored under the hostname of the address as specified, not the resolved one, so that a domain can
+// move its server without clients treating it as a new server.
 //
 // Errors:
 //   - protocol.ErrNoServerCerts: Server returned no certs.
 //   - protocol.ErrServerCertNotValidNow: Server certificate is not valid at the current time.
 //   - protocol.CertMismatchError: Server returned a certificate that is different from the one associated with the hostname in the cert.Store.
+//
+// If the client connected to the server before, the connection may be returned before its handshake completes, so that
+// replay-safe messages can be sent with 0-RTT. Use negotiateVersionEarly to negotiate the version on it.
 func ConnectWithCertStore(ctx context.Context, certStore cert.Store, address string) (protocol.ProtoConn, error) {
+	return connectWithCertStore(ctx, certStore, address, "")
+}
+
+// ConnectWithFingerprint is like ConnectWithCertStore, but if there is no certificate stored for the hostname yet,
+// the server's certificate is only trusted if its fingerprint matches the specified one, as returned by
+// protocol.CertFingerprint.
+//
+// Errors are the same as ConnectWithCertStore, plus:
+//   - protocol.CertFingerprintMismatchError: Server returned a certificate whose fingerprint does not match.
+func ConnectWithFingerprint(ctx context.Context, certStore cert.Store, address string, fingerprint string) (protocol.ProtoConn, error) {
+	return connectWithCertStore(ctx, certStore, address, fingerprint)
+}
+
+// connectWithCertStore implements ConnectWithCertStore and ConnectWithFingerprint.
+// If expectFingerprint is empty, the first certificate seen is trusted.
+func connectWithCertStore(ctx context.Context, certStore cert.Store, address string, expectFingerprint string) (protocol.ProtoConn, error) {
+	dialAddr, err := ResolveServerAddress(ctx, address)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to resolve address %q in ConnectWithCertStore: %w`, address, err)
+	}
+
 	hostname, _, parseErr := net.SplitHostPort(address)
 	if parseErr != nil {
-		return nil, fmt.Errorf(`failed to parse address %q in ConnectWithCertStore: %w`, address, parseErr)
+		// No port was specified.
+		hostname = address
 	}
 	hostname = common.NormalizeHostname(hostname)
+	dialHostname, _, _ := net.SplitHostPort(dialAddr)
 
 	tlsCfg := &tls.Config{
 		MinVersion:         tls.VersionTLS13,
 		NextProtos:         []string{protocol.AlpnProtoName},
-		ServerName:         hostname,
+		ServerName:         dialHostname,
 		InsecureSkipVerify: true,
-		VerifyPeerCertificate: func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
-			if len(rawCerts) == 0 {
+		ClientSessionCache: sessionCache,
+		// VerifyConnection is used instead of VerifyPeerCertificate because it also runs for resumed sessions.
+		VerifyConnection: func(state tls.ConnectionState) error {
+			if len(state.PeerCertificates) == 0 {
 				return protocol.ErrNoServerCerts
 			}
 
-			leafDer := rawCerts[0]
-			leaf, err := x509.ParseCertificate(leafDer)
-			if err != nil {
-				return fmt.Errorf("failed to parse server certificate: %w", err)
-			}
+			leaf := state.PeerCertificates[0]
+			leafDer := leaf.Raw
 
 			now := time.Now()
 			if now.Before(leaf.NotBefore) || now.After(leaf.NotAfter) {
@@ -55,6 +84,9 @@ func ConnectWithCertStore(ctx context.Context, certStore cert.Store, address str
 			}
 
 			if len(storedDer) == 0 {
+				if expectFingerprint != "" && protocol.CertFingerprint(leafDer) != expectFingerprint {
+					return protocol.CertFingerprintMismatchError{Host: hostname}
+				}
 				if err := certStore.PutDer(ctx, hostname, leafDer); err != nil {
 					return fmt.Errorf("failed to store certificate for %q: %w", hostname, err)
 				}
@@ -69,12 +101,9 @@ func ConnectWithCertStore(ctx context.Context, certStore cert.Store, address str
 		},
 	}
 
-	qConn, err := quic.DialAddr(ctx, address, tlsCfg, &quic.Config{
-		KeepAlivePeriod:    protocol.DefaultKeepAlivePeriod,
-		MaxIncomingStreams: protocol.DefaultMaxIncomingStreams,
-	})
+	qConn, err := protocol.DialEarly(ctx, dialAddr, tlsCfg, protocol.DefaultConnLimits.QuicConfig())
 	if err != nil {
-		return nil, fmt.Errorf(`failed to dial QUIC %q: %w`, address, err)
+		return nil, fmt.Errorf(`failed to dial QUIC %q (resolved from %q): %w`, dialAddr, address, err)
 	}
 
 	return protocol.ToProtoConn(qConn), nil
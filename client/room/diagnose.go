@@ -0,0 +1,247 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"syscall"
+	"time"
+
+	"friendnet.org/client/cert"
+	"friendnet.org/protocol"
+	pb "friendnet.org/protocol/pb/v1"
+	"github.com/quic-go/quic-go"
+)
+
+// diagnoseStepTimeout is the maximum time each diagnostic step can take.
+const diagnoseStepTimeout = 10 * time.Second
+
+// udpProbeTimeout is how long the UDP probe waits for the server's host to reject the probe.
+const udpProbeTimeout = 1 * time.Second
+
+// DiagnosticStepKind is a step of connecting to a server that Diagnose checks.
+type DiagnosticStepKind int
+
+const (
+	// DiagnosticStepResolve resolves the server address, including SRV and well-known lookups, to IP addresses.
+	DiagnosticStepResolve DiagnosticStepKind = iota
+
+	// DiagnosticStepUdpProbe sends a UDP packet to the server to check whether its host rejects it.
+	DiagnosticStepUdpProbe
+
+	// DiagnosticStepQuicHandshake connects to the server and verifies its certificate.
+	DiagnosticStepQuicHandshake
+
+	// DiagnosticStepVersion negotiates the protocol version.
+	DiagnosticStepVersion
+
+	// DiagnosticStepAuth authenticates with the server's credentials.
+	DiagnosticStepAuth
+)
+
+// DiagnosticStatus is the outcome of a diagnostic step.
+type DiagnosticStatus int
+
+const (
+	// DiagnosticStatusOk means the step succeeded.
+	DiagnosticStatusOk DiagnosticStatus = iota
+
+	// DiagnosticStatusFailed means the step failed.
+	DiagnosticStatusFailed
+
+	// DiagnosticStatusInconclusive means the step could not tell whether there is a problem.
+	DiagnosticStatusInconclusive
+
+	// DiagnosticStatusSkipped means the step was not run because an earlier step failed.
+	DiagnosticStatusSkipped
+)
+
+// DiagnosticStep is the result of a diagnostic step.
+type DiagnosticStep struct {
+	Kind   DiagnosticStepKind
+	Status DiagnosticStatus
+
+	// A human-readable description of what the step found.
+	Detail string
+
+	// The error the step failed with, if any.
+	Err error
+
+	// How long the step took.
+	Duration time.Duration
+}
+
+// Diagnose runs the steps of connecting to the server at the specified address one by one and returns a result for
+// each step, so that a failing connection can be narrowed down to DNS, the network, the server's certificate, its
+// protocol version or the credentials.
+// Steps after one that failed are skipped.
+//
+// The connection is made like ConnectWithCertStore does, so a server connected to for the first time has its certificate
+// stored. If the account is already connected to the room, authentication reports that the credentials were accepted.
+func Diagnose(ctx context.Context, certStore cert.Store, address string, creds Credentials) []DiagnosticStep {
+	steps := make([]DiagnosticStep, 0, 5)
+	failed := false
+	run := func(kind DiagnosticStepKind, fn func(ctx context.Context) (DiagnosticStatus, string, error)) {
+		if failed {
+			steps = append(steps, DiagnosticStep{
+				Kind:   kind,
+				Status: DiagnosticStatusSkipped,
+				Detail: "skipped because an earlier step failed",
+			})
+			return
+		}
+
+		stepCtx, cancel := context.WithTimeout(ctx, diagnoseStepTimeout)
+		defer cancel()
+
+		start := time.Now()
+		status, detail, err := fn(stepCtx)
+		if err != nil {
+			status = DiagnosticStatusFailed
+			failed = true
+		}
+		steps = append(steps, DiagnosticStep{
+			Kind:     kind,
+			Status:   status,
+			Detail:   detail,
+			Err:      err,
+			Duration: time.Since(start),
+		})
+	}
+
+	var dialAddr string
+	run(DiagnosticStepResolve, func(ctx context.Context) (DiagnosticStatus, string, error) {
+		var err error
+		dialAddr, err = ResolveServerAddress(ctx, address)
+		if err != nil {
+			return 0, "failed to resolve server address", err
+		}
+
+		host, port, err := net.SplitHostPort(dialAddr)
+		if err != nil {
+			return 0, fmt.Sprintf("server address resolved to invalid address %q", dialAddr), err
+		}
+		ips, err := net.DefaultResolver.LookupIPAddr(ctx, host)
+		if err != nil {
+			return 0, fmt.Sprintf("failed to look up IP addresses of %q", host), err
+		}
+
+		ipStrs := make([]string, len(ips))
+		for i, ip := range ips {
+			ipStrs[i] = ip.String()
+		}
+		return DiagnosticStatusOk, fmt.Sprintf("%s resolved to %s, port %s", address, strings.Join(ipStrs, ", "), port), nil
+	})
+
+	run(DiagnosticStepUdpProbe, func(ctx context.Context) (DiagnosticStatus, string, error) {
+		return probeUdp(ctx, dialAddr)
+	})
+
+	var conn protocol.ProtoConn
+	defer func() {
+		if conn != nil {
+			_, _ = conn.SendAndReceive(pb.MsgType_MSG_TYPE_BYE, &pb.MsgBye{})
+			_ = conn.CloseWithCode(protocol.CloseCodeNormal, "goodbye")
+		}
+	}()
+	run(DiagnosticStepQuicHandshake, func(ctx context.Context) (DiagnosticStatus, string, error) {
+		var err error
+		conn, err = ConnectWithCertStore(ctx, certStore, address)
+		if err != nil {
+			return 0, describeConnectErr(err), err
+		}
+		if err = protocol.WaitForHandshake(ctx, conn); err != nil {
+			return 0, describeConnectErr(err), err
+		}
+		return DiagnosticStatusOk, fmt.Sprintf("connected to %s and verified its certificate", conn.RemoteAddr()), nil
+	})
+
+	run(DiagnosticStepVersion, func(ctx context.Context) (DiagnosticStatus, string, error) {
+		var serverVer *pb.ProtoVersion
+		var err error
+		conn, serverVer, err = negotiateVersionEarly(ctx, conn, protocol.CurrentProtocolVersion)
+		if err != nil {
+			if rejErr, ok := errors.AsType[protocol.VersionRejectedError](err); ok {
+				return 0, fmt.Sprintf("server rejected client protocol version %s (%s)",
+					protocol.FormatProtoVersion(protocol.CurrentProtocolVersion),
+					rejErr.Reason.String(),
+				), err
+			}
+			return 0, "failed to negotiate protocol version", err
+		}
+		return DiagnosticStatusOk, fmt.Sprintf("server accepted client protocol version %s, server version is %s",
+			protocol.FormatProtoVersion(protocol.CurrentProtocolVersion),
+			protocol.FormatProtoVersion(serverVer),
+		), nil
+	})
+
+	run(DiagnosticStepAuth, func(ctx context.Context) (DiagnosticStatus, string, error) {
+		_, err := authenticate(conn, creds)
+		if err == nil {
+			return DiagnosticStatusOk, fmt.Sprintf("authenticated as %s in room %s", creds.Username, creds.Room), nil
+		}
+
+		if rejErr, ok := errors.AsType[protocol.AuthRejectedError](err); ok {
+			// Credentials are checked before whether the account is already connected.
+			if rejErr.Reason == pb.AuthRejectionReason_AUTH_REJECTION_REASON_ALREADY_CONNECTED {
+				return DiagnosticStatusOk, "credentials accepted, the account is already connected to the room", nil
+			}
+			return 0, "server rejected credentials", err
+		}
+		return 0, "failed to authenticate", err
+	})
+
+	return steps
+}
+
+// probeUdp sends a UDP packet to addr and waits briefly for the host to reject it.
+// QUIC servers drop packets they cannot parse without replying, so no reply only means that nothing rejected the
+// packet, not that it arrived.
+func probeUdp(ctx context.Context, addr string) (DiagnosticStatus, string, error) {
+	var dialer net.Dialer
+	conn, err := dialer.DialContext(ctx, "udp", addr)
+	if err != nil {
+		return 0, "failed to open UDP socket to server, the network may be unreachable", err
+	}
+	defer func() {
+		_ = conn.Close()
+	}()
+
+	deadline := time.Now().Add(udpProbeTimeout)
+	if ctxDeadline, ok := ctx.Deadline(); ok && ctxDeadline.Before(deadline) {
+		deadline = ctxDeadline
+	}
+	_ = conn.SetDeadline(deadline)
+
+	if _, err = conn.Write([]byte{0}); err != nil {
+		return 0, "failed to send UDP packet to server", err
+	}
+
+	_, err = conn.Read(make([]byte, 1))
+	if errors.Is(err, syscall.ECONNREFUSED) || errors.Is(err, syscall.ECONNRESET) {
+		return 0, "server host rejected UDP packet, nothing is listening on the port", err
+	}
+	return DiagnosticStatusInconclusive, "server host did not reject UDP packet, but it may still be dropped by a firewall", nil
+}
+
+// describeConnectErr returns a human-readable description of an error returned by ConnectWithCertStore.
+func describeConnectErr(err error) string {
+	if _, ok := errors.AsType[protocol.CertMismatchError](err); ok {
+		return "server certificate is different from the one seen on earlier connections"
+	}
+	if errors.Is(err, protocol.ErrServerCertNotValidNow) {
+		return "server certificate is expired or not valid yet, check the clocks of both sides"
+	}
+	if errors.Is(err, protocol.ErrNoServerCerts) {
+		return "server did not present a certificate"
+	}
+	if _, ok := errors.AsType[*quic.IdleTimeoutError](err); ok {
+		return "server did not answer, UDP traffic may be blocked or the server may be down"
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		return "server did not answer in time, UDP traffic may be blocked or the server may be down"
+	}
+	return "failed to connect to server"
+}
@@ -0,0 +1,37 @@
+package room
+
+import (
+	"context"
+	"net"
+	"testing"
+	"time"
+)
+
+func TestDiagnose_SkipsAfterFailure(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// Find a local UDP port that nothing listens on.
+	udpConn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	addr := udpConn.LocalAddr().String()
+	_ = udpConn.Close()
+
+	steps := Diagnose(ctx, nil, addr, Credentials{})
+	if len(steps) != 5 {
+		t.Fatalf("expected 5 steps, got %d", len(steps))
+	}
+	if steps[0].Kind != DiagnosticStepResolve || steps[0].Status != DiagnosticStatusOk {
+		t.Errorf("expected resolving a literal address to succeed, got %+v", steps[0])
+	}
+	if steps[1].Kind != DiagnosticStepUdpProbe || steps[1].Status != DiagnosticStatusFailed {
+		t.Errorf("expected UDP probe to a closed port to fail, got %+v", steps[1])
+	}
+	for _, step := range steps[2:] {
+		if step.Status != DiagnosticStatusSkipped {
+			t.Errorf("expected step %d to be skipped, got %+v", step.Kind, step)
+		}
+	}
+}
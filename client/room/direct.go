@@ -59,6 +59,18 @@ func (c *Conn) GetDirectConns(username common.NormalizedUsername) []protocol.Pro
 	return res
 }
 
+// DirectRttStats returns round-trip time statistics for pings sent to the specified peer over direct connections.
+// Returns false if there is no direct connection to the peer.
+func (c *Conn) DirectRttStats(username common.NormalizedUsername) (common.RttStats, bool) {
+	c.mu.RLock()
+	rtt, has := c.directRtt[username]
+	c.mu.RUnlock()
+	if !has {
+		return common.RttStats{}, false
+	}
+	return rtt.Stats(), true
+}
+
 // AdoptDirectConn puts the specified connection under management as a direct connection to the specified peer.
 // The connection must already have had a successful handshake.
 //
@@ -80,6 +92,12 @@ func (c *Conn) AdoptDirectConn(conn protocol.ProtoConn, username common.Normaliz
 
 	set[conn] = struct{}{}
 
+	rtt, has := c.directRtt[username]
+	if !has {
+		rtt = &common.RttTracker{}
+		c.directRtt[username] = rtt
+	}
+
 	c.mu.Unlock()
 
 	// Ping loop.
@@ -91,15 +109,12 @@ func (c *Conn) AdoptDirectConn(conn protocol.ProtoConn, username common.Normaliz
 		for {
 			select {
 			case <-c.Context.Done():
-				_ = conn.CloseWithReason("goodbye")
+				_ = conn.CloseWithCode(protocol.CloseCodeNormal, "goodbye")
 				return
 			case <-ticker.C:
-				_, pingErr := protocol.SendAndReceiveExpect[*pb.MsgPong](
-					conn,
-					pb.MsgType_MSG_TYPE_PING,
-					&pb.MsgPing{},
-					pb.MsgType_MSG_TYPE_PONG,
-				)
+				pingCtx, pingCancel := context.WithTimeoutCause(c.Context, ServerPingInterval, protocol.ErrPingTimeout)
+				sample, pingErr := protocol.PingContext(pingCtx, conn)
+				pingCancel()
 				if pingErr != nil {
 					if protocol.IsErrorConnCloseOrCancel(pingErr) {
 						return
@@ -114,6 +129,7 @@ func (c *Conn) AdoptDirectConn(conn protocol.ProtoConn, username common.Normaliz
 					)
 					return
 				}
+				rtt.RecordSample(sample)
 			}
 		}
 	}()
@@ -136,6 +152,9 @@ func (c *Conn) AdoptDirectConn(conn protocol.ProtoConn, username common.Normaliz
 			set, has = c.directConns[username]
 			if has {
 				delete(set, conn)
+				if len(set) == 0 {
+					delete(c.directRtt, username)
+				}
 			}
 			c.mu.Unlock()
 		}
@@ -555,7 +574,7 @@ func (c *Conn) incomingDirectConnHandler(incomingConn *direct.IncomingDirectConn
 			"username", username.String(),
 			"remote_addr", incomingConn.RemoteAddr().String(),
 		)
-		_ = conn.CloseWithReason("internal error")
+		_ = conn.CloseWithCode(protocol.CloseCodeInternalError, "internal error")
 		return
 	}
 
@@ -0,0 +1,123 @@
+package room
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"friendnet.org/protocol"
+)
+
+// WellKnownPath is the path of the JSON document a domain can serve over HTTPS to point clients at its FriendNet server.
+const WellKnownPath = "/.well-known/friendnet"
+
+// wellKnownTimeout is how long to wait for a domain's well-known document.
+const wellKnownTimeout = 5 * time.Second
+
+// wellKnownMaxSize is the maximum size of a well-known document that will be read.
+const wellKnownMaxSize = 64 * 1024
+
+// WellKnownDoc is the format of the document served at WellKnownPath.
+type WellKnownDoc struct {
+	// The address of the server, in HOST:PORT format.
+	Address string `json:"address"`
+}
+
+// ResolveServerAddress resolves a server address entered by a user to the HOST:PORT address to dial.
+// Addresses that already have a port and IP addresses are returned with their port, or protocol.DefaultServerPort.
+//
+// For domain names without a port, the following are tried in order:
+//  1. The domain's _friendnet._udp SRV record.
+//  2. The document at WellKnownPath on the domain, over HTTPS.
+//  3. The domain with protocol.DefaultServerPort.
+func ResolveServerAddress(ctx context.Context, address string) (string, error) {
+	if _, _, err := net.SplitHostPort(address); err == nil {
+		return address, nil
+	}
+
+	host := strings.TrimSuffix(strings.Trim(address, "[]"), ".")
+	if host == "" {
+		return "", fmt.Errorf(`invalid server address %q`, address)
+	}
+
+	defaultAddr := net.JoinHostPort(host, strconv.Itoa(protocol.DefaultServerPort))
+	if net.ParseIP(host) != nil {
+		return defaultAddr, nil
+	}
+
+	if addr, ok := lookupSrv(ctx, host); ok {
+		return addr, nil
+	}
+	if ctx.Err() != nil {
+		return "", ctx.Err()
+	}
+
+	addr, err := fetchWellKnown(ctx, http.DefaultClient, "https://"+host+WellKnownPath)
+	if err == nil {
+		return addr, nil
+	}
+	if ctx.Err() != nil {
+		return "", ctx.Err()
+	}
+
+	return defaultAddr, nil
+}
+
+// lookupSrv looks up the _friendnet._udp SRV record of the host.
+// Returns false if there is no usable record.
+func lookupSrv(ctx context.Context, host string) (string, bool) {
+	// Records are already sorted by priority and randomized by weight.
+	_, records, err := net.DefaultResolver.LookupSRV(ctx, "friendnet", "udp", host)
+	if err != nil || len(records) == 0 {
+		return "", false
+	}
+
+	// A target of "." means the service is explicitly not available.
+	target := strings.TrimSuffix(records[0].Target, ".")
+	if target == "" {
+		return "", false
+	}
+
+	return net.JoinHostPort(target, strconv.Itoa(int(records[0].Port))), true
+}
+
+// fetchWellKnown fetches the well-known document at the URL and returns the address in it.
+func fetchWellKnown(ctx context.Context, client *http.Client, url string) (string, error) {
+	ctx, cancel := context.WithTimeout(ctx, wellKnownTimeout)
+	defer cancel()
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Accept", "application/json")
+
+	res, err := client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+
+	if res.StatusCode != http.StatusOK {
+		return "", fmt.Errorf(`got status %d fetching %q`, res.StatusCode, url)
+	}
+
+	var doc WellKnownDoc
+	if err = json.NewDecoder(io.LimitReader(res.Body, wellKnownMaxSize)).Decode(&doc); err != nil {
+		return "", fmt.Errorf(`failed to decode well-known document at %q: %w`, url, err)
+	}
+
+	if _, _, err = net.SplitHostPort(doc.Address); err != nil {
+		return "", fmt.Errorf(`well-known document at %q has invalid address %q: %w`, url, doc.Address, err)
+	}
+
+	return doc.Address, nil
+}
@@ -0,0 +1,64 @@
+package room
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestResolveServerAddress_NoLookup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		address string
+		want    string
+	}{
+		{"example.com:1234", "example.com:1234"},
+		{"127.0.0.1", "127.0.0.1:20038"},
+		{"::1", "[::1]:20038"},
+		{"[::1]", "[::1]:20038"},
+	}
+
+	for _, tt := range tests {
+		got, err := ResolveServerAddress(context.Background(), tt.address)
+		if err != nil {
+			t.Errorf("%q: unexpected error: %v", tt.address, err)
+			continue
+		}
+		if got != tt.want {
+			t.Errorf("%q: expected %q, got %q", tt.address, tt.want, got)
+		}
+	}
+}
+
+func TestFetchWellKnown(t *testing.T) {
+	t.Parallel()
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case WellKnownPath:
+			_, _ = w.Write([]byte(`{"address": "friendnet.example.com:4321"}`))
+		case "/invalid":
+			_, _ = w.Write([]byte(`{"address": "friendnet.example.com"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	addr, err := fetchWellKnown(context.Background(), srv.Client(), srv.URL+WellKnownPath)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if addr != "friendnet.example.com:4321" {
+		t.Fatalf("expected friendnet.example.com:4321, got %q", addr)
+	}
+
+	if _, err = fetchWellKnown(context.Background(), srv.Client(), srv.URL+"/invalid"); err == nil {
+		t.Fatal("expected error for address without port")
+	}
+	if _, err = fetchWellKnown(context.Background(), srv.Client(), srv.URL+"/missing"); err == nil {
+		t.Fatal("expected error for missing document")
+	}
+}
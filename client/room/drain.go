@@ -0,0 +1,69 @@
+package room
+
+import (
+	"sync"
+)
+
+// UploadDrain tracks active uploads across every server, so that they can be let finish before the client shuts
+// down. Once draining starts, new uploads are rejected.
+// The zero value is not draining and has no active uploads.
+// It is safe for concurrent use.
+type UploadDrain struct {
+	mu sync.Mutex
+
+	draining bool
+	active   int
+
+	// Closed and replaced every time active changes.
+	// Nil until first needed.
+	changed chan struct{}
+}
+
+// begin records that an upload started.
+// Returns false if draining, in which case the upload must be rejected.
+func (d *UploadDrain) begin() bool {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.draining {
+		return false
+	}
+	d.active++
+	d.notifyNoLock()
+	return true
+}
+
+// end records that an upload that began ended.
+func (d *UploadDrain) end() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.active--
+	d.notifyNoLock()
+}
+
+func (d *UploadDrain) notifyNoLock() {
+	if d.changed != nil {
+		close(d.changed)
+		d.changed = nil
+	}
+}
+
+// Start starts draining, rejecting new uploads from then on.
+func (d *UploadDrain) Start() {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	d.draining = true
+}
+
+// Active returns the number of active uploads, along with a channel that is closed once it changes.
+func (d *UploadDrain) Active() (int, <-chan struct{}) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+
+	if d.changed == nil {
+		d.changed = make(chan struct{})
+	}
+	return d.active, d.changed
+}
@@ -0,0 +1,94 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"friendnet.org/protocol"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+func TestUploadDrain(t *testing.T) {
+	t.Parallel()
+
+	var drain UploadDrain
+
+	if !drain.begin() {
+		t.Fatal("expected an upload to begin before draining")
+	}
+	active, changed := drain.Active()
+	if active != 1 {
+		t.Fatalf("expected 1 active upload, got %d", active)
+	}
+
+	// Draining lets the active upload finish, but rejects new ones.
+	drain.Start()
+	if drain.begin() {
+		t.Fatal("expected a new upload to be rejected while draining")
+	}
+
+	drain.end()
+	select {
+	case <-changed:
+	default:
+		t.Fatal("expected the channel to be closed once the upload ended")
+	}
+	if active, _ = drain.Active(); active != 0 {
+		t.Fatalf("expected no active uploads, got %d", active)
+	}
+}
+
+func TestOnGetFile_RejectsWhileDraining(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	local, remote, err := protocol.NewMemNetwork().ConnPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+
+	var drain UploadDrain
+	drain.Start()
+	logic := &LogicImpl{drain: &drain}
+
+	handled := make(chan error, 1)
+	go func() {
+		bidi, err := local.WaitForBidi(ctx)
+		if err != nil {
+			handled <- err
+			return
+		}
+		defer func() {
+			_ = bidi.Close()
+		}()
+
+		msg, err := protocol.ReadExpect[*pb.MsgGetFile](bidi.ProtoStreamReader, pb.MsgType_MSG_TYPE_GET_FILE)
+		if err != nil {
+			handled <- err
+			return
+		}
+		handled <- logic.OnGetFile(ctx, nil, C2cBidi{ProtoBidi: bidi}, msg)
+	}()
+
+	bidi, err := remote.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_GET_FILE, &pb.MsgGetFile{Path: "/music/song.flac"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = bidi.Close()
+	}()
+
+	// The peer is told the client is going offline, so its download manager queues the file and tries again later.
+	_, err = protocol.ReadExpect[*pb.MsgFileMeta](bidi.ProtoStreamReader, pb.MsgType_MSG_TYPE_FILE_META)
+	protoErr, ok := errors.AsType[protocol.ProtoMsgError](err)
+	if !ok || protoErr.Msg.Type != pb.ErrType_ERR_TYPE_CLIENT_NOT_ONLINE {
+		t.Fatalf("expected ERR_TYPE_CLIENT_NOT_ONLINE, got %v", err)
+	}
+	if err = <-handled; err != nil {
+		t.Fatal(err)
+	}
+}
@@ -0,0 +1,117 @@
+package room
+
+import (
+	"crypto/sha256"
+	"io"
+	"sync"
+
+	"friendnet.org/client/share"
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// fileHashCacheSize is the maximum number of file hashes a fileHashCache keeps.
+const fileHashCacheSize = 4096
+
+// fileHashMaxInFlight is the maximum number of files a fileHashCache hashes in the background at the same time.
+// Requests for the hashes of other files while it is busy are not queued, since peers ask again later.
+const fileHashMaxInFlight = 2
+
+type fileHashKey struct {
+	share      string
+	path       string
+	size       uint64
+	modifiedTs int64
+}
+
+// fileHashCache caches the SHA-256 hashes of shared files, so that peers can be told a file's hash without reading
+// the whole file while they wait.
+// Entries are keyed by size and modification time as well as path, so files that change are hashed again.
+type fileHashCache struct {
+	mu     sync.Mutex
+	hashes map[fileHashKey][]byte
+
+	// Keys in the order they were added, for evicting the oldest ones.
+	order []fileHashKey
+
+	// Keys of the files being hashed in the background.
+	inFlight map[fileHashKey]struct{}
+}
+
+func newFileHashCache() *fileHashCache {
+	return &fileHashCache{
+		hashes:   make(map[fileHashKey][]byte),
+		inFlight: make(map[fileHashKey]struct{}),
+	}
+}
+
+// get returns the SHA-256 hash of the file at the specified path in the share, if it is cached.
+// The meta must be the file's current metadata.
+//
+// If the hash is not cached, get returns nil and hashes the file in the background, so that it is known the next time
+// it is asked for. pending is true in that case, even if the file has to wait for other files to be hashed first.
+// Files without a modification time are never hashed, since changes to them cannot be detected.
+func (c *fileHashCache) get(sh share.Share, path common.ProtoPath, meta *pb.MsgFileMeta) (hash []byte, pending bool) {
+	if meta.ModifiedTs == nil {
+		return nil, false
+	}
+	key := fileHashKey{
+		share:      sh.Name(),
+		path:       path.String(),
+		size:       meta.Size,
+		modifiedTs: *meta.ModifiedTs,
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if hash, has := c.hashes[key]; has {
+		return hash, false
+	}
+
+	if _, has := c.inFlight[key]; !has && len(c.inFlight) < fileHashMaxInFlight {
+		c.inFlight[key] = struct{}{}
+		go c.hash(sh, path, key)
+	}
+	return nil, true
+}
+
+// hash hashes the file at the specified path in the share and caches the hash under key.
+// Files that cannot be read are not cached, so that they are tried again the next time.
+func (c *fileHashCache) hash(sh share.Share, path common.ProtoPath, key fileHashKey) {
+	hash, err := hashShareFile(sh, path)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	delete(c.inFlight, key)
+	if err != nil {
+		return
+	}
+
+	if _, has := c.hashes[key]; !has {
+		c.hashes[key] = hash
+		c.order = append(c.order, key)
+		if len(c.order) > fileHashCacheSize {
+			delete(c.hashes, c.order[0])
+			c.order = c.order[1:]
+		}
+	}
+}
+
+// hashShareFile returns the SHA-256 hash of the file at the specified path in the share.
+func hashShareFile(sh share.Share, path common.ProtoPath) ([]byte, error) {
+	_, reader, err := sh.GetFile(path, 0, 0)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = reader.Close()
+	}()
+
+	hasher := sha256.New()
+	if _, err = io.Copy(hasher, reader); err != nil {
+		return nil, err
+	}
+	return hasher.Sum(nil), nil
+}
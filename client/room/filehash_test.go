@@ -0,0 +1,65 @@
+package room
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"friendnet.org/client/share"
+	"friendnet.org/common"
+)
+
+func TestFileHashCache_HashesInBackground(t *testing.T) {
+	t.Parallel()
+
+	dir := t.TempDir()
+	content := []byte("some file content")
+	if err := os.WriteFile(filepath.Join(dir, "file.txt"), content, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	sh, err := share.NewDirShare("files", dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := common.UncheckedCreateProtoPath("/file.txt")
+	meta, err := sh.GetFileMeta(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cache := newFileHashCache()
+
+	// The first request does not wait for the file to be read.
+	hash, pending := cache.get(sh, path, meta)
+	if hash != nil || !pending {
+		t.Fatalf("expected the hash to be pending, got %x (pending: %t)", hash, pending)
+	}
+
+	want := sha256.Sum256(content)
+	deadline := time.Now().Add(5 * time.Second)
+	for {
+		hash, pending = cache.get(sh, path, meta)
+		if hash != nil {
+			break
+		}
+		if !pending {
+			t.Fatal("expected the hash to stay pending until it is known")
+		}
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for the file to be hashed")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if !bytes.Equal(hash, want[:]) || pending {
+		t.Fatalf("expected hash %x, got %x (pending: %t)", want, hash, pending)
+	}
+
+	// Files whose changes cannot be detected are not hashed at all.
+	meta.ModifiedTs = nil
+	if hash, pending = cache.get(sh, path, meta); hash != nil || pending {
+		t.Fatalf("expected no hash for a file without a modification time, got %x (pending: %t)", hash, pending)
+	}
+}
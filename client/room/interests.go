@@ -0,0 +1,49 @@
+package room
+
+import (
+	"slices"
+	"sync"
+)
+
+// Interests are the interests the client publishes to every server, so that users with similar interests can find
+// each other.
+// It is safe for concurrent use.
+type Interests struct {
+	mu sync.RWMutex
+
+	// Normalized with common.NormalizeInterests.
+	list []string
+
+	// Closed and replaced when the list changes.
+	changed chan struct{}
+}
+
+// NewInterests creates a new Interests with the specified interests, which must already be normalized with
+// common.NormalizeInterests.
+func NewInterests(list []string) *Interests {
+	return &Interests{
+		list:    list,
+		changed: make(chan struct{}),
+	}
+}
+
+// Get returns the interests, along with a channel that is closed once they change.
+// The returned slice must not be modified.
+func (i *Interests) Get() ([]string, <-chan struct{}) {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+	return i.list, i.changed
+}
+
+// Set replaces the interests, which must already be normalized with common.NormalizeInterests.
+func (i *Interests) Set(list []string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if slices.Equal(i.list, list) {
+		return
+	}
+	i.list = list
+	close(i.changed)
+	i.changed = make(chan struct{})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"time"
 
 	"friendnet.org/client/share"
 	"friendnet.org/common"
@@ -13,6 +14,7 @@ import (
 	v1 "friendnet.org/protocol/pb/clientrpc/v1"
 	pb "friendnet.org/protocol/pb/v1"
 	"github.com/quic-go/quic-go"
+	"google.golang.org/protobuf/proto"
 )
 
 // Logic exposes handlers for incoming client messages, both S2C and C2C.
@@ -43,6 +45,11 @@ type Logic interface {
 	// C2C
 	OnGetFile(ctx context.Context, room *Conn, bidi C2cBidi, msg *protocol.TypedProtoMsg[*pb.MsgGetFile]) error
 
+	// OnMeasure handles an incoming measurement echo request.
+	//
+	// C2C
+	OnMeasure(ctx context.Context, room *Conn, bidi C2cBidi, msg *protocol.TypedProtoMsg[*pb.MsgMeasure]) error
+
 	// OnConnectToMe handles an incoming connect to me request.
 	//
 	// C2C
@@ -58,27 +65,87 @@ type Logic interface {
 	// S2C
 	OnClientOffline(ctx context.Context, room *Conn, bidi protocol.ProtoBidi, msg *protocol.TypedProtoMsg[*pb.MsgClientOffline]) error
 
+	// OnServerNotice handles an incoming notice from the server's operators.
+	//
+	// S2C
+	OnServerNotice(ctx context.Context, room *Conn, bidi protocol.ProtoBidi, msg *protocol.TypedProtoMsg[*pb.MsgServerNotice]) error
+
 	// OnSearch handles an incoming search request.
 	//
 	// C2C, S2C
 	OnSearch(ctx context.Context, room *Conn, bidi protocol.ProtoBidi, msg *protocol.TypedProtoMsg[*pb.MsgSearch]) error
+
+	// SharesRevision returns the revision of the files served by the handlers, along with a channel that is closed
+	// once it changes.
+	// It is announced to the server so that it can cache directory listings.
+	SharesRevision() (uint64, <-chan struct{})
+
+	// Interests returns the interests to publish to the server, along with a channel that is closed once they change.
+	Interests() ([]string, <-chan struct{})
+
+	// IsPeerBlocked returns whether C2C requests from the peer should be rejected.
+	IsPeerBlocked(username common.NormalizedUsername) bool
+}
+
+// SearchHook provides results for incoming searches in addition to those from the client's shares, such as from
+// plugins.
+type SearchHook interface {
+	// Search returns up to limit additional results for a search on the server with the specified UUID.
+	// It must return once ctx is done.
+	Search(ctx context.Context, serverUuid string, query string, limit int) []pb.MsgSearchResult
 }
 
 // LogicImpl implements Logic.
 type LogicImpl struct {
+	serverUuid  string
 	shares      *share.Manager
 	searchLimit int64
+	searchHook  SearchHook
+	hashes      *fileHashCache
+	blocked     *BlockList
+	snooze      *Snooze
+	drain       *UploadDrain
+	interests   *Interests
 }
 
 var _ Logic = (*LogicImpl)(nil)
 
-func NewLogicImpl(shares *share.Manager) *LogicImpl {
+// NewLogicImpl creates a new LogicImpl for the server with the specified UUID.
+// If searchHook is not nil, its results are added to those of incoming searches.
+func NewLogicImpl(
+	serverUuid string,
+	shares *share.Manager,
+	searchHook SearchHook,
+	blocked *BlockList,
+	snooze *Snooze,
+	drain *UploadDrain,
+	interests *Interests,
+) *LogicImpl {
 	return &LogicImpl{
+		serverUuid:  serverUuid,
 		shares:      shares,
 		searchLimit: 100,
+		searchHook:  searchHook,
+		hashes:      newFileHashCache(),
+		blocked:     blocked,
+		snooze:      snooze,
+		drain:       drain,
+		interests:   interests,
 	}
 }
 
+func (l *LogicImpl) SharesRevision() (uint64, <-chan struct{}) {
+	return l.shares.SharesRevision()
+}
+
+func (l *LogicImpl) Interests() ([]string, <-chan struct{}) {
+	return l.interests.Get()
+}
+
+func (l *LogicImpl) IsPeerBlocked(username common.NormalizedUsername) bool {
+	return l.blocked.Has(username)
+}
+
 func (l *LogicImpl) validatePath(bidi protocol.ProtoBidi, path string) (common.ProtoPath, bool) {
 	protoPath, err := common.ValidatePath(path)
 	if err != nil {
@@ -92,32 +159,17 @@ func (l *LogicImpl) Close() error {
 	return l.shares.Close()
 }
 
-func (l *LogicImpl) OnPing(_ context.Context, _ *Conn, bidi protocol.ProtoBidi, _ *protocol.TypedProtoMsg[*pb.MsgPing]) error {
-	return bidi.Write(pb.MsgType_MSG_TYPE_PONG, &pb.MsgPong{})
+func (l *LogicImpl) OnPing(_ context.Context, _ *Conn, bidi protocol.ProtoBidi, msg *protocol.TypedProtoMsg[*pb.MsgPing]) error {
+	return bidi.Write(pb.MsgType_MSG_TYPE_PONG, protocol.NewPong(msg.Payload, time.Now()))
 }
 
-func (l *LogicImpl) sendDirFiles(bidi C2cBidi, files []*pb.MsgFileMeta) error {
-	const pageSize = 50
-
-	// Send paginated.
-	sent := 0
-	for sent < len(files) {
-		end := sent + pageSize
-		if end > len(files) {
-			end = len(files)
-		}
-
-		err := bidi.Write(pb.MsgType_MSG_TYPE_DIR_FILES, &pb.MsgDirFiles{
-			Files: files[sent:end],
-		})
-		if err != nil {
-			return err
-		}
-
-		sent += pageSize
-	}
-
-	return nil
+// sendDirFiles sends files in pages of the requested size.
+func (l *LogicImpl) sendDirFiles(ctx context.Context, bidi C2cBidi, pageSize uint32, files []*pb.MsgFileMeta) error {
+	return protocol.WritePages(ctx, bidi.ProtoBidi, pb.MsgType_MSG_TYPE_DIR_FILES, files, protocol.PageSize(pageSize),
+		func(page []*pb.MsgFileMeta) proto.Message {
+			return &pb.MsgDirFiles{Files: page}
+		},
+	)
 }
 
 // resolveShareAndPath returns share and path within share based on the specified path.
@@ -146,7 +198,7 @@ func (l *LogicImpl) resolveShareAndPath(path common.ProtoPath) (shareOrNil share
 	return
 }
 
-func (l *LogicImpl) OnGetDirFiles(_ context.Context, _ *Conn, bidi C2cBidi, msg *protocol.TypedProtoMsg[*pb.MsgGetDirFiles]) error {
+func (l *LogicImpl) OnGetDirFiles(ctx context.Context, _ *Conn, bidi C2cBidi, msg *protocol.TypedProtoMsg[*pb.MsgGetDirFiles]) error {
 	req := msg.Payload
 	reqPath, ok := l.validatePath(bidi.ProtoBidi, req.Path)
 	if !ok {
@@ -157,11 +209,15 @@ func (l *LogicImpl) OnGetDirFiles(_ context.Context, _ *Conn, bidi C2cBidi, msg
 	if err != nil {
 		return err
 	}
-	if shareNotFound {
+	if shareNotFound || (shareOrNil != nil && l.snooze.SharesHidden()) {
 		return bidi.WriteFileNotExistError(reqPath.String())
 	}
 
 	if shareOrNil == nil {
+		if l.snooze.SharesHidden() {
+			return l.sendDirFiles(ctx, bidi, req.PageSize, nil)
+		}
+
 		// List all shares.
 		shares := l.shares.GetAll()
 		metas := make([]*pb.MsgFileMeta, len(shares))
@@ -172,7 +228,7 @@ func (l *LogicImpl) OnGetDirFiles(_ context.Context, _ *Conn, bidi C2cBidi, msg
 				Size:  0,
 			}
 		}
-		return l.sendDirFiles(bidi, metas)
+		return l.sendDirFiles(ctx, bidi, req.PageSize, metas)
 	}
 
 	files, err := shareOrNil.DirFiles(sharePath)
@@ -184,7 +240,7 @@ func (l *LogicImpl) OnGetDirFiles(_ context.Context, _ *Conn, bidi C2cBidi, msg
 		return err
 	}
 
-	if err = l.sendDirFiles(bidi, files); err != nil {
+	if err = l.sendDirFiles(ctx, bidi, req.PageSize, files); err != nil {
 		return err
 	}
 
@@ -202,7 +258,7 @@ func (l *LogicImpl) OnGetFileMeta(_ context.Context, _ *Conn, bidi C2cBidi, msg
 	if err != nil {
 		return err
 	}
-	if shareNotFound {
+	if shareNotFound || (shareOrNil != nil && l.snooze.SharesHidden()) {
 		return bidi.WriteFileNotExistError(reqPath.String())
 	}
 
@@ -222,23 +278,35 @@ func (l *LogicImpl) OnGetFileMeta(_ context.Context, _ *Conn, bidi C2cBidi, msg
 			}
 			return err
 		}
+
+		if req.IncludeSha256 && !meta.IsDir {
+			// Only a hash that is already known is sent, so that the peer does not wait for the whole file to be read.
+			meta.Sha256, meta.Sha256Pending = l.hashes.get(shareOrNil, sharePath, meta)
+		}
 	}
 
 	return bidi.Write(pb.MsgType_MSG_TYPE_FILE_META, meta)
 }
 
-func (l *LogicImpl) OnGetFile(_ context.Context, _ *Conn, bidi C2cBidi, msg *protocol.TypedProtoMsg[*pb.MsgGetFile]) error {
+func (l *LogicImpl) OnGetFile(_ context.Context, room *Conn, bidi C2cBidi, msg *protocol.TypedProtoMsg[*pb.MsgGetFile]) error {
 	req := msg.Payload
 	reqPath, ok := l.validatePath(bidi.ProtoBidi, req.Path)
 	if !ok {
 		return nil
 	}
 
+	// New uploads are rejected while the client is shutting down.
+	// Peers treat the client as offline and try again later.
+	if !l.drain.begin() {
+		return bidi.WriteError(pb.ErrType_ERR_TYPE_CLIENT_NOT_ONLINE, "peer is shutting down")
+	}
+	defer l.drain.end()
+
 	shareOrNil, sharePath, shareNotFound, err := l.resolveShareAndPath(reqPath)
 	if err != nil {
 		return err
 	}
-	if shareNotFound {
+	if shareNotFound || (shareOrNil != nil && l.snooze.SharesHidden()) {
 		return bidi.WriteFileNotExistError(reqPath.String())
 	}
 
@@ -263,6 +331,17 @@ func (l *LogicImpl) OnGetFile(_ context.Context, _ *Conn, bidi C2cBidi, msg *pro
 			}
 			return err
 		}
+		defer func() {
+			_ = reader.Close()
+		}()
+	}
+
+	// Checksum the content in chunks if the requester asked for it.
+	var chunkWriter *protocol.ChunkChecksumWriter
+	if req.ChecksumChunkSize != 0 && !meta.IsDir {
+		chunkSize := protocol.ClampChecksumChunkSize(req.ChecksumChunkSize)
+		meta.ChecksumChunkSize = &chunkSize
+		chunkWriter = protocol.NewChunkChecksumWriter(bidi.ProtoBidi.Stream, int64(chunkSize))
 	}
 
 	err = bidi.Write(pb.MsgType_MSG_TYPE_FILE_META, meta)
@@ -275,19 +354,124 @@ func (l *LogicImpl) OnGetFile(_ context.Context, _ *Conn, bidi C2cBidi, msg *pro
 		return nil
 	}
 
-	_, err = io.Copy(bidi.ProtoBidi.Stream, reader)
+	// Listen for transfer control messages from the requester while sending.
+	var gate common.PauseGate
+	go func() {
+		// Never leave the sender blocked once the requester stops sending control messages.
+		defer gate.Resume()
+
+		for {
+			ctlMsg, readErr := protocol.ReadExpect[*pb.MsgTransferControl](bidi.ProtoStreamReader, pb.MsgType_MSG_TYPE_TRANSFER_CONTROL)
+			if readErr != nil {
+				return
+			}
+
+			switch ctlMsg.Payload.Action {
+			case pb.TransferControlAction_TRANSFER_CONTROL_ACTION_PAUSE:
+				gate.Pause()
+			case pb.TransferControlAction_TRANSFER_CONTROL_ACTION_RESUME:
+				gate.Resume()
+			default:
+			}
+		}
+	}()
+
+	// Report the upload to the user while sending.
+	var contentWriter io.Writer = bidi.ProtoBidi.Stream
+	if chunkWriter != nil {
+		contentWriter = chunkWriter
+	}
+	upload := newUploadReporter(room.eventPublisher, contentWriter, bidi.Username, reqPath, req.Offset, meta.Size)
+	reportCtx, reportCancel := context.WithCancel(bidi.Stream.Context())
+	defer reportCancel()
+	go upload.run(reportCtx)
+
+	// Chunk checksums cover exactly the content the requester expects, even if the file grows while it is sent.
+	var content io.Reader = reader
+	if chunkWriter != nil {
+		content = io.LimitReader(reader, protocol.GetFileContentSize(meta.Size, req.Offset, req.Limit))
+	}
+
+	_, err = io.Copy(upload, &gatedReader{
+		ctx:    bidi.Stream.Context(),
+		gate:   &gate,
+		snooze: l.snooze,
+		r:      content,
+	})
+	if err == nil && chunkWriter != nil {
+		err = chunkWriter.Flush()
+	}
+	reportCancel()
 	if err != nil {
 		if _, is := errors.AsType[*quic.StreamError](err); is {
 			// If the other side closed, we can just quit.
+			upload.finish(v1.UploadStatus_UPLOAD_STATUS_CANCELED, nil)
+			return nil
+		}
+		if errors.Is(err, context.Canceled) {
+			upload.finish(v1.UploadStatus_UPLOAD_STATUS_CANCELED, nil)
 			return nil
 		}
 
+		upload.finish(v1.UploadStatus_UPLOAD_STATUS_ERROR, err)
 		return err
 	}
 
+	upload.finish(v1.UploadStatus_UPLOAD_STATUS_DONE, nil)
 	return nil
 }
 
+// gatedReader wraps a reader and blocks reads while its gate is paused or transfers are snoozed.
+type gatedReader struct {
+	ctx    context.Context
+	gate   *common.PauseGate
+	snooze *Snooze
+	r      io.Reader
+}
+
+func (g *gatedReader) Read(p []byte) (int, error) {
+	if err := g.gate.Wait(g.ctx); err != nil {
+		return 0, err
+	}
+	if err := g.snooze.Wait(g.ctx); err != nil {
+		return 0, err
+	}
+	return g.r.Read(p)
+}
+
+// MaxMeasureReplySize is the maximum reply size honored for MSG_TYPE_MEASURE requests.
+// It keeps replies comfortably under protocol.MaxPayloadSize.
+const MaxMeasureReplySize = 256 * 1024
+
+func (l *LogicImpl) OnMeasure(_ context.Context, _ *Conn, bidi C2cBidi, msg *protocol.TypedProtoMsg[*pb.MsgMeasure]) error {
+	// Replies are all zeroes, so one buffer can be shared by every reply.
+	padding := make([]byte, MaxMeasureReplySize)
+
+	req := msg.Payload
+	for {
+		replySize := min(req.ReplySize, MaxMeasureReplySize)
+		err := bidi.Write(pb.MsgType_MSG_TYPE_MEASURE_REPLY, &pb.MsgMeasureReply{
+			Payload: padding[:replySize],
+		})
+		if err != nil {
+			return err
+		}
+
+		next, err := protocol.ReadExpect[*pb.MsgMeasure](bidi.ProtoStreamReader, pb.MsgType_MSG_TYPE_MEASURE)
+		if err != nil {
+			// The requester closes the bidi when it is done measuring.
+			if errors.Is(err, io.EOF) || protocol.IsErrorConnCloseOrCancel(err) {
+				return nil
+			}
+			if _, is := errors.AsType[*quic.StreamError](err); is {
+				return nil
+			}
+			return err
+		}
+		req = next.Payload
+	}
+}
+
 func (l *LogicImpl) OnConnectToMe(ctx context.Context, room *Conn, bidi C2cBidi, _ *protocol.TypedProtoMsg[*pb.MsgConnectToMe]) error {
 	if room.directMgr.IsDisabled() {
 		return bidi.Write(pb.MsgType_MSG_TYPE_DIRECT_CONN_RESULT, &pb.MsgDirectConnResult{
@@ -339,6 +523,22 @@ func (l *LogicImpl) OnClientOffline(_ context.Context, room *Conn, _ protocol.Pr
 	return nil
 }
 
+func (l *LogicImpl) OnServerNotice(_ context.Context, room *Conn, _ protocol.ProtoBidi, msg *protocol.TypedProtoMsg[*pb.MsgServerNotice]) error {
+	room.logger.Info("received server notice",
+		"service", "room.LogicImpl",
+		"room", room.RoomName.String(),
+		"text", msg.Payload.Text,
+	)
+
+	room.eventPublisher.Publish(&v1.Event{
+		Type: v1.Event_TYPE_SERVER_NOTICE,
+		ServerNotice: &v1.Event_ServerNotice{
+			Text: msg.Payload.Text,
+		},
+	})
+	return nil
+}
+
 func (l *LogicImpl) OnSearch(ctx context.Context, _ *Conn, bidi protocol.ProtoBidi, msg *protocol.TypedProtoMsg[*pb.MsgSearch]) error {
 	query := msg.Payload.Query
 
@@ -346,21 +546,37 @@ func (l *LogicImpl) OnSearch(ctx context.Context, _ *Conn, bidi protocol.ProtoBi
 		return bidi.WriteError(pb.ErrType_ERR_TYPE_INVALID_FIELDS, "query cannot be empty")
 	}
 
+	// Hidden shares have no results.
+	if l.snooze.SharesHidden() {
+		return nil
+	}
+
 	results, err := l.shares.SearchShares(ctx, query, l.searchLimit)
 	if err != nil {
 		return fmt.Errorf("failed to get search results for %q: %w", query, err)
 	}
 
-	for i := range results {
-		result := &results[i]
-		err = bidi.Write(pb.MsgType_MSG_TYPE_SEARCH_RESULT, result)
-		if err != nil {
-			if protocol.IsErrorConnCloseOrCancel(err) {
-				return nil
-			}
+	send := func(results []pb.MsgSearchResult) error {
+		for i := range results {
+			result := &results[i]
+			err := bidi.Write(pb.MsgType_MSG_TYPE_SEARCH_RESULT, result)
+			if err != nil {
+				if protocol.IsErrorConnCloseOrCancel(err) {
+					return nil
+				}
 
-			return fmt.Errorf("failed to send search result for %q: %w", query, err)
+				return fmt.Errorf("failed to send search result for %q: %w", query, err)
+			}
 		}
+		return nil
+	}
+
+	// Send the client's own results first, since the hook may take a while.
+	if err = send(results); err != nil {
+		return err
+	}
+	if l.searchHook != nil && int64(len(results)) < l.searchLimit {
+		return send(l.searchHook.Search(ctx, l.serverUuid, query, int(l.searchLimit)-len(results)))
 	}
 
 	return nil
@@ -0,0 +1,153 @@
+package room
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"friendnet.org/protocol"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// MeasureResult is the result of measuring a connection to a peer.
+type MeasureResult struct {
+	// The minimum, average and maximum round trip times of empty echoes.
+	LatencyMin time.Duration
+	LatencyAvg time.Duration
+	LatencyMax time.Duration
+
+	// The rate at which the peer sent us data, in bytes per second.
+	DownloadBps float64
+
+	// The rate at which we sent the peer data, in bytes per second.
+	UploadBps float64
+}
+
+// MeasureConn measures the latency and throughput of a connection to a peer using MSG_TYPE_MEASURE.
+// Latency is measured with the specified number of sequential empty echoes.
+// Throughput is measured by transferring roughly throughputBytes in each direction, pipelined in chunks of
+// MaxMeasureReplySize so that the round trip time does not dominate the result.
+//
+// All measurements share a single bidi, so they travel the same path as conn.
+// The measurement is aborted if ctx is done.
+func MeasureConn(ctx context.Context, conn protocol.ProtoConn, pings int, throughputBytes int64) (MeasureResult, error) {
+	var res MeasureResult
+
+	// The first echo is a warm-up; it includes opening the stream and, for proxied connections, the server
+	// connecting to the peer.
+	bidi, err := conn.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_MEASURE, &pb.MsgMeasure{})
+	if err != nil {
+		return res, err
+	}
+	defer func() {
+		_ = bidi.Close()
+	}()
+
+	// Canceling the bidi instead of closing it reaches the peer even through a proxy, so it stops sending immediately.
+	stop := context.AfterFunc(ctx, func() {
+		bidi.Cancel(protocol.RequestCanceledStreamErrorCode)
+	})
+	defer stop()
+
+	// wrapErr prefers the context's error, since canceling the bidi on cancellation surfaces as a stream error.
+	wrapErr := func(what string, err error) error {
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return ctxErr
+		}
+		return fmt.Errorf(`failed to measure %s: %w`, what, err)
+	}
+
+	readReply := func() (int, error) {
+		msg, err := protocol.ReadExpect[*pb.MsgMeasureReply](bidi.ProtoStreamReader, pb.MsgType_MSG_TYPE_MEASURE_REPLY)
+		if err != nil {
+			return 0, err
+		}
+		return len(msg.Payload.Payload), nil
+	}
+
+	if _, err = readReply(); err != nil {
+		return res, wrapErr("latency", err)
+	}
+
+	// Latency.
+	if pings > 0 {
+		var total time.Duration
+		for i := range pings {
+			start := time.Now()
+			if err = bidi.Write(pb.MsgType_MSG_TYPE_MEASURE, &pb.MsgMeasure{}); err != nil {
+				return res, wrapErr("latency", err)
+			}
+			if _, err = readReply(); err != nil {
+				return res, wrapErr("latency", err)
+			}
+			rtt := time.Since(start)
+
+			total += rtt
+			if i == 0 || rtt < res.LatencyMin {
+				res.LatencyMin = rtt
+			}
+			res.LatencyMax = max(res.LatencyMax, rtt)
+		}
+		res.LatencyAvg = total / time.Duration(pings)
+	}
+
+	if throughputBytes <= 0 {
+		return res, nil
+	}
+
+	chunks := int((throughputBytes + MaxMeasureReplySize - 1) / MaxMeasureReplySize)
+
+	// pipeline writes the requests in the background while the replies are read.
+	// It returns the number of reply payload bytes and the time it took to receive every reply.
+	pipeline := func(req *pb.MsgMeasure) (int64, time.Duration, error) {
+		writeErr := make(chan error, 1)
+		start := time.Now()
+		go func() {
+			for range chunks {
+				if err := bidi.Write(pb.MsgType_MSG_TYPE_MEASURE, req); err != nil {
+					writeErr <- err
+					return
+				}
+			}
+			writeErr <- nil
+		}()
+
+		var received int64
+		for range chunks {
+			n, err := readReply()
+			if err != nil {
+				// Closing the bidi unblocks the writer.
+				_ = bidi.Close()
+				<-writeErr
+				return 0, 0, err
+			}
+			received += int64(n)
+		}
+		elapsed := time.Since(start)
+
+		if err := <-writeErr; err != nil {
+			return 0, 0, err
+		}
+		return received, elapsed, nil
+	}
+
+	// Download.
+	received, elapsed, err := pipeline(&pb.MsgMeasure{
+		ReplySize: MaxMeasureReplySize,
+	})
+	if err != nil {
+		return res, wrapErr("download throughput", err)
+	}
+	res.DownloadBps = float64(received) / elapsed.Seconds()
+
+	// Upload.
+	_, elapsed, err = pipeline(&pb.MsgMeasure{
+		Payload: make([]byte, MaxMeasureReplySize),
+	})
+	if err != nil {
+		return res, wrapErr("upload throughput", err)
+	}
+	res.UploadBps = float64(chunks*MaxMeasureReplySize) / elapsed.Seconds()
+
+	return res, nil
+}
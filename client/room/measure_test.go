@@ -0,0 +1,36 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"friendnet.org/protocol"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+func TestMeasureConn_CancelAbortsSlowPeer(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	local, remote, err := protocol.NewMemNetwork().ConnPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+
+	// The peer replies to the warm-up echo, then never to the pings.
+	peerErr := serveSlowPeer(t, ctx, remote, pb.MsgType_MSG_TYPE_MEASURE_REPLY, &pb.MsgMeasureReply{})
+
+	reqCtx, reqCancel := context.WithCancel(ctx)
+	defer reqCancel()
+	_, err = waitCanceled(t, reqCancel, func() (MeasureResult, error) {
+		return MeasureConn(reqCtx, local, 3, 0)
+	})
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	expectRequestCanceled(t, peerErr)
+}
@@ -0,0 +1,101 @@
+package room
+
+import (
+	"context"
+	"net"
+	"net/netip"
+	"time"
+
+	"friendnet.org/protocol"
+)
+
+// networkCheckInterval is how often the network is checked for changes that the server connection should follow.
+const networkCheckInterval = 5 * time.Second
+
+// migrateTimeout is how long migrating the server connection to a new network path may take.
+const migrateTimeout = 10 * time.Second
+
+// maxMigrations is how many times the server connection is migrated before it is reconnected instead.
+// A connection keeps the socket of every path it migrated away from open until it closes, since closing any of them
+// would close the connection too. Reconnecting releases them, and takes little longer than migrating thanks to 0-RTT.
+const maxMigrations = 3
+
+// routeLocalAddr returns the local IP address that packets to the specified address are currently sent from.
+// It does not send anything.
+func routeLocalAddr(remote net.Addr) (netip.Addr, bool) {
+	if remote == nil {
+		return netip.Addr{}, false
+	}
+
+	udpConn, err := net.Dial("udp", remote.String())
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	defer func() {
+		_ = udpConn.Close()
+	}()
+
+	addrPort, err := netip.ParseAddrPort(udpConn.LocalAddr().String())
+	if err != nil {
+		return netip.Addr{}, false
+	}
+	return addrPort.Addr().Unmap(), true
+}
+
+// followNetworkLoop migrates the server connection to a new network path whenever the local address used to reach the
+// server changes, such as when switching from Wi-Fi to LTE, so that transfers continue instead of waiting for the old
+// path to time out.
+// After maxMigrations, the connection is closed so that it is reconnected instead.
+// Runs until the connection closes.
+func (c *Conn) followNetworkLoop() {
+	lastAddr, _ := routeLocalAddr(c.serverConn.RemoteAddr())
+	var migrations int
+
+	ticker := time.NewTicker(networkCheckInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-c.Context.Done():
+			return
+		case <-ticker.C:
+		}
+
+		addr, ok := routeLocalAddr(c.serverConn.RemoteAddr())
+		if !ok || addr == lastAddr {
+			continue
+		}
+
+		if migrations >= maxMigrations {
+			c.logger.Info("network changed, reconnecting to release sockets of earlier paths",
+				"service", "room.Conn",
+				"room", c.RoomName.String(),
+				"migrations", migrations,
+			)
+			_ = c.serverConn.CloseWithCode(protocol.CloseCodeNormal, "network changed")
+			return
+		}
+
+		c.logger.Info("network changed, migrating server connection",
+			"service", "room.Conn",
+			"room", c.RoomName.String(),
+			"old_addr", lastAddr,
+			"new_addr", addr,
+		)
+
+		ctx, cancel := context.WithTimeout(c.Context, migrateTimeout)
+		err := protocol.Migrate(ctx, c.serverConn)
+		cancel()
+		if err != nil {
+			// The server may still follow the connection from the old socket, so this is not fatal.
+			c.logger.Warn("failed to migrate server connection",
+				"service", "room.Conn",
+				"room", c.RoomName.String(),
+				"err", err,
+			)
+		} else {
+			migrations++
+		}
+
+		lastAddr = addr
+	}
+}
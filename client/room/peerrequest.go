@@ -0,0 +1,154 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"net"
+	"time"
+
+	"friendnet.org/client/direct"
+	"friendnet.org/protocol"
+	pb "friendnet.org/protocol/pb/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrPeerRequestTimeout is returned when a peer does not reply to a request within the request timeout.
+var ErrPeerRequestTimeout = errors.New("peer did not reply in time")
+
+// isRetryableRequestErr returns whether a failed attempt of an idempotent request is worth retrying.
+// Only timeouts and network errors are retried; errors returned by the peer itself would just happen again.
+func isRetryableRequestErr(err error) bool {
+	if errors.Is(err, ErrPeerRequestTimeout) {
+		return true
+	}
+
+	_, isNetErr := errors.AsType[net.Error](err)
+	return isNetErr
+}
+
+// withRequestPolicy calls fn with a context that expires after the policy's timeout, retrying with backoff while
+// the attempts fail with retryable errors.
+// fn must be idempotent, and it must not use its context after returning.
+// If ctx is done, returns its error without retrying.
+func withRequestPolicy[T any](ctx context.Context, policy direct.RequestPolicy, fn func(ctx context.Context) (T, error)) (T, error) {
+	backoff := policy.RetryBackoff
+
+	for attempt := 0; ; attempt++ {
+		res, err := requestAttempt(ctx, policy.Timeout, fn)
+		if err == nil || attempt >= policy.MaxRetries || ctx.Err() != nil || !isRetryableRequestErr(err) {
+			return res, err
+		}
+
+		select {
+		case <-ctx.Done():
+			var empty T
+			return empty, ctx.Err()
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+}
+
+func requestAttempt[T any](ctx context.Context, timeout time.Duration, fn func(ctx context.Context) (T, error)) (T, error) {
+	if timeout <= 0 {
+		return fn(ctx)
+	}
+
+	attemptCtx, cancel := context.WithTimeoutCause(ctx, timeout, ErrPeerRequestTimeout)
+	defer cancel()
+
+	return fn(attemptCtx)
+}
+
+// readContext calls read, cancelling bidi if ctx is done before read returns.
+// If ctx was done, returns the cause of ctx instead of the result of read, since reads on a cancelled bidi can
+// look like a normal end of stream.
+func readContext[T any](ctx context.Context, bidi protocol.ProtoBidi, read func() (T, error)) (T, error) {
+	stopCancel := context.AfterFunc(ctx, func() {
+		bidi.Cancel(protocol.RequestCanceledStreamErrorCode)
+	})
+
+	res, err := read()
+	if !stopCancel() {
+		var empty T
+		return empty, context.Cause(ctx)
+	}
+
+	return res, err
+}
+
+// openBidiContext is like OpenBidiWithMsg, but returns the cause of ctx if it is done before the bidi opens.
+// Opening can take a while because it may have to establish a direct connection first.
+// A bidi that opens after ctx is done is closed in the background.
+func (c VirtualC2cConn) openBidiContext(ctx context.Context, typ pb.MsgType, msg proto.Message) (protocol.ProtoBidi, error) {
+	type result struct {
+		bidi protocol.ProtoBidi
+		err  error
+	}
+
+	opened := make(chan result, 1)
+	go func() {
+		bidi, err := c.OpenBidiWithMsg(typ, msg)
+		opened <- result{bidi: bidi, err: err}
+	}()
+
+	select {
+	case res := <-opened:
+		return res.bidi, res.err
+	case <-ctx.Done():
+		go func() {
+			if res := <-opened; res.err == nil {
+				res.bidi.Cancel(protocol.RequestCanceledStreamErrorCode)
+				_ = res.bidi.Close()
+			}
+		}()
+		return protocol.ProtoBidi{}, context.Cause(ctx)
+	}
+}
+
+// peerMsgStream is a stream of messages from a peer that enforces the request timeout on every read, and that is
+// aborted when its context is done.
+type peerMsgStream[T proto.Message] struct {
+	ctx     context.Context
+	timeout time.Duration
+
+	bidi   protocol.ProtoBidi
+	stream protocol.TypedMsgStream[T]
+
+	// The result of the first read, which is made while opening the stream so that it is covered by retries.
+	hasFirst bool
+	first    *protocol.TypedProtoMsg[T]
+	firstErr error
+}
+
+// newPeerMsgStream creates a new peerMsgStream that reads stream from bidi.
+// If timeout is 0, reads do not time out.
+func newPeerMsgStream[T proto.Message](ctx context.Context, timeout time.Duration, bidi protocol.ProtoBidi, stream protocol.TypedMsgStream[T]) *peerMsgStream[T] {
+	return &peerMsgStream[T]{
+		ctx:     ctx,
+		timeout: timeout,
+
+		bidi:   bidi,
+		stream: stream,
+	}
+}
+
+func (s *peerMsgStream[T]) ReadNext() (*protocol.TypedProtoMsg[T], error) {
+	if s.hasFirst {
+		s.hasFirst = false
+		return s.first, s.firstErr
+	}
+
+	ctx := s.ctx
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeoutCause(ctx, s.timeout, ErrPeerRequestTimeout)
+		defer cancel()
+	}
+
+	return readContext(ctx, s.bidi, s.stream.ReadNext)
+}
+
+func (s *peerMsgStream[T]) Close() error {
+	return s.stream.Close()
+}
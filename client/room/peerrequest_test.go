@@ -0,0 +1,188 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"friendnet.org/client/direct"
+	"friendnet.org/protocol"
+	pb "friendnet.org/protocol/pb/v1"
+	"github.com/quic-go/quic-go"
+	"google.golang.org/protobuf/proto"
+)
+
+// serveSlowPeer accepts a single bidi on conn, reads the request, replies with the specified messages, and then stops
+// replying. The error it gets from waiting for the next message is sent on the returned channel once the requester gives
+// up.
+func serveSlowPeer(t *testing.T, ctx context.Context, conn protocol.ProtoConn, typ pb.MsgType, replies ...proto.Message) <-chan error {
+	t.Helper()
+
+	done := make(chan error, 1)
+	go func() {
+		bidi, err := conn.WaitForBidi(ctx)
+		if err != nil {
+			done <- err
+			return
+		}
+		if _, err = bidi.Read(); err != nil {
+			done <- err
+			return
+		}
+		for _, reply := range replies {
+			if err = bidi.Write(typ, reply); err != nil {
+				done <- err
+				return
+			}
+		}
+
+		// Wait for the requester to give up.
+		for {
+			if _, err = bidi.Read(); err != nil {
+				done <- err
+				return
+			}
+		}
+	}()
+	return done
+}
+
+// expectRequestCanceled fails the test unless a slow peer saw its stream get canceled with
+// protocol.RequestCanceledStreamErrorCode in a timely manner.
+func expectRequestCanceled(t *testing.T, peerErr <-chan error) {
+	t.Helper()
+
+	select {
+	case err := <-peerErr:
+		streamErr, ok := errors.AsType[*quic.StreamError](err)
+		if !ok || streamErr.ErrorCode != protocol.RequestCanceledStreamErrorCode {
+			t.Fatalf("expected the peer's stream to be canceled, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the peer's stream to be canceled")
+	}
+}
+
+// waitCanceled returns the result of fn, failing the test if it does not return shortly after ctx is canceled.
+func waitCanceled[T any](t *testing.T, cancel context.CancelFunc, fn func() (T, error)) (T, error) {
+	t.Helper()
+
+	type result struct {
+		val T
+		err error
+	}
+	res := make(chan result, 1)
+	go func() {
+		val, err := fn()
+		res <- result{val: val, err: err}
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case r := <-res:
+		return r.val, r.err
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for the request to be canceled")
+		panic("unreachable")
+	}
+}
+
+func TestWithRequestPolicy_RetriesTimeouts(t *testing.T) {
+	t.Parallel()
+
+	policy := direct.RequestPolicy{
+		Timeout:      10 * time.Millisecond,
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+	}
+
+	attempts := 0
+	res, err := withRequestPolicy(context.Background(), policy, func(ctx context.Context) (string, error) {
+		attempts++
+		if attempts < 3 {
+			<-ctx.Done()
+			return "", context.Cause(ctx)
+		}
+		return "ok", nil
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if res != "ok" || attempts != 3 {
+		t.Fatalf("expected ok after 3 attempts, got %q after %d", res, attempts)
+	}
+
+	attempts = 0
+	_, err = withRequestPolicy(context.Background(), policy, func(ctx context.Context) (string, error) {
+		attempts++
+		<-ctx.Done()
+		return "", context.Cause(ctx)
+	})
+	if !errors.Is(err, ErrPeerRequestTimeout) {
+		t.Fatalf("expected ErrPeerRequestTimeout, got %v", err)
+	}
+	if attempts != 3 {
+		t.Fatalf("expected 3 attempts, got %d", attempts)
+	}
+}
+
+func TestWithRequestPolicy_DoesNotRetryPeerErrors(t *testing.T) {
+	t.Parallel()
+
+	policy := direct.RequestPolicy{
+		Timeout:      time.Second,
+		MaxRetries:   2,
+		RetryBackoff: time.Millisecond,
+	}
+
+	attempts := 0
+	_, err := withRequestPolicy(context.Background(), policy, func(ctx context.Context) (string, error) {
+		attempts++
+		return "", protocol.NewProtoMsgError(&pb.MsgError{Type: pb.ErrType_ERR_TYPE_FILE_NOT_EXIST})
+	})
+	if _, ok := errors.AsType[protocol.ProtoMsgError](err); !ok {
+		t.Fatalf("expected ProtoMsgError, got %v", err)
+	}
+	if attempts != 1 {
+		t.Fatalf("expected 1 attempt, got %d", attempts)
+	}
+}
+
+func TestPeerMsgStream_CancelAbortsSlowPeer(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	local, remote, err := protocol.NewMemNetwork().ConnPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	peerErr := serveSlowPeer(t, ctx, remote, pb.MsgType_MSG_TYPE_SEARCH_RESULT, &pb.MsgSearchResult{DirectoryPath: "/"})
+
+	bidi, err := local.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_SEARCH, &pb.MsgSearch{Query: "song"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = bidi.Close()
+	}()
+
+	reqCtx, reqCancel := context.WithCancel(ctx)
+	defer reqCancel()
+	stream := newPeerMsgStream(reqCtx, 0, bidi, protocol.NewTypedMsgStream[*pb.MsgSearchResult](bidi, pb.MsgType_MSG_TYPE_SEARCH_RESULT))
+
+	// The peer replies once, then takes forever.
+	if _, err = stream.ReadNext(); err != nil {
+		t.Fatal(err)
+	}
+	_, err = waitCanceled(t, reqCancel, stream.ReadNext)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	expectRequestCanceled(t, peerErr)
+}
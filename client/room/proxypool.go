@@ -0,0 +1,202 @@
+package room
+
+import (
+	"sync"
+	"time"
+
+	"friendnet.org/common"
+	"friendnet.org/protocol"
+)
+
+// Opening a proxied stream to a peer takes a trip through the server, which opens a stream to the peer in turn.
+// Browsing tends to send bursts of requests to the same peer, such as listing a directory and then fetching the metadata
+// of each file in it, so after a request is proxied to a peer, a few streams are opened ahead of time and kept warm for
+// the next requests to use.
+//
+// Warm streams are idle until a request is written on them, so the server and the peer just wait for it.
+// Direct connections are not pooled, since opening a stream on an existing QUIC connection does not take a round trip.
+
+// proxyPoolPeerSize is the number of warm proxied streams kept open to a peer that was recently requested.
+const proxyPoolPeerSize = 2
+
+// proxyPoolMaxWarm is the maximum number of warm proxied streams kept open to all peers together.
+const proxyPoolMaxWarm = 4
+
+// proxyPoolIdleTimeout is how long warm proxied streams to a peer are kept open after its last request.
+const proxyPoolIdleTimeout = 20 * time.Second
+
+// peerProxyPool holds the warm proxied streams to a single peer.
+type peerProxyPool struct {
+	warm []protocol.ProtoBidi
+
+	// Whether streams are currently being opened for the pool.
+	refilling bool
+
+	// Closes the pool once it has not been used for proxyPoolIdleTimeout.
+	idleTimer *time.Timer
+}
+
+// proxyPool keeps warm proxied streams to recently requested peers.
+// It is safe for concurrent use.
+type proxyPool struct {
+	mu       sync.Mutex
+	isClosed bool
+
+	// Opens a new proxied stream to a peer.
+	open func(username common.NormalizedUsername) (protocol.ProtoBidi, error)
+
+	// Returns the maximum number of warm streams to keep open to all peers together.
+	// It is called whenever the pool is refilled, so it can change over time.
+	maxWarm func() int
+
+	peers map[common.NormalizedUsername]*peerProxyPool
+
+	// The number of warm streams in all peer pools, including ones being opened.
+	warmCount int
+}
+
+// newProxyPool creates a new, empty proxyPool that opens streams with open.
+func newProxyPool(
+	open func(username common.NormalizedUsername) (protocol.ProtoBidi, error),
+	maxWarm func() int,
+) *proxyPool {
+	return &proxyPool{
+		open:    open,
+		maxWarm: maxWarm,
+		peers:   make(map[common.NormalizedUsername]*peerProxyPool),
+	}
+}
+
+// isLive returns whether a warm stream can still be used.
+// The server cancels proxied streams it could not set up, and the peer cancels them if it goes away, which is noticed
+// here without having to read from the stream.
+func isLive(bidi protocol.ProtoBidi) bool {
+	return bidi.Stream.Context().Err() == nil
+}
+
+// Get returns a proxied stream to a peer, taking a warm one if there is one and opening a new one otherwise.
+// Either way, the peer's pool is refilled in the background, so that the next requests to it can use warm streams.
+func (p *proxyPool) Get(username common.NormalizedUsername) (protocol.ProtoBidi, error) {
+	p.mu.Lock()
+	if p.isClosed {
+		p.mu.Unlock()
+		return p.open(username)
+	}
+
+	peer, has := p.peers[username]
+	if !has {
+		peer = &peerProxyPool{}
+		peer.idleTimer = time.AfterFunc(proxyPoolIdleTimeout, func() {
+			p.expire(username, peer)
+		})
+		p.peers[username] = peer
+	} else {
+		peer.idleTimer.Reset(proxyPoolIdleTimeout)
+	}
+
+	var bidi protocol.ProtoBidi
+	var found bool
+	var dead []protocol.ProtoBidi
+	for len(peer.warm) > 0 {
+		next := peer.warm[0]
+		peer.warm = peer.warm[1:]
+		p.warmCount--
+		if isLive(next) {
+			bidi = next
+			found = true
+			break
+		}
+		dead = append(dead, next)
+	}
+
+	startRefill := !peer.refilling
+	peer.refilling = true
+	p.mu.Unlock()
+
+	for _, d := range dead {
+		_ = d.Close()
+	}
+
+	if startRefill {
+		go p.refill(username, peer)
+	}
+
+	if found {
+		return bidi, nil
+	}
+	return p.open(username)
+}
+
+// refill opens warm streams for a peer's pool until it is full, the pool is closed or expires, or opening one fails.
+func (p *proxyPool) refill(username common.NormalizedUsername, peer *peerProxyPool) {
+	for {
+		p.mu.Lock()
+		if p.isClosed || p.peers[username] != peer ||
+			len(peer.warm) >= proxyPoolPeerSize || p.warmCount >= p.maxWarm() {
+			peer.refilling = false
+			p.mu.Unlock()
+			return
+		}
+		// Reserve the slot while the stream is being opened.
+		p.warmCount++
+		p.mu.Unlock()
+
+		bidi, err := p.open(username)
+
+		p.mu.Lock()
+		if err != nil || p.isClosed || p.peers[username] != peer {
+			p.warmCount--
+			peer.refilling = false
+			p.mu.Unlock()
+			if err == nil {
+				_ = bidi.Close()
+			}
+			return
+		}
+		peer.warm = append(peer.warm, bidi)
+		p.mu.Unlock()
+	}
+}
+
+// expire closes a peer's pool if it is still the current one for the peer.
+func (p *proxyPool) expire(username common.NormalizedUsername, peer *peerProxyPool) {
+	p.mu.Lock()
+	if p.peers[username] != peer {
+		p.mu.Unlock()
+		return
+	}
+	delete(p.peers, username)
+	warm := peer.warm
+	peer.warm = nil
+	p.warmCount -= len(warm)
+	p.mu.Unlock()
+
+	for _, bidi := range warm {
+		_ = bidi.Close()
+	}
+}
+
+// Close closes all warm streams.
+// Later calls to Get open a new stream every time.
+func (p *proxyPool) Close() {
+	p.mu.Lock()
+	if p.isClosed {
+		p.mu.Unlock()
+		return
+	}
+	p.isClosed = true
+
+	var warm []protocol.ProtoBidi
+	for _, peer := range p.peers {
+		peer.idleTimer.Stop()
+		warm = append(warm, peer.warm...)
+		peer.warm = nil
+	}
+	clear(p.peers)
+	p.warmCount = 0
+	p.mu.Unlock()
+
+	for _, bidi := range warm {
+		_ = bidi.Close()
+	}
+}
@@ -0,0 +1,135 @@
+package room
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+	"friendnet.org/protocol"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// waitFor polls cond until it returns true, failing the test if it does not within a few seconds.
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(5 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestProxyPool(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	local, remote, err := protocol.NewMemNetwork().ConnPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+
+	// The remote end stands in for the server, canceling the streams it is told to.
+	remoteBidis := make(chan protocol.ProtoBidi, 16)
+	go func() {
+		for {
+			bidi, err := remote.WaitForBidi(ctx)
+			if err != nil {
+				return
+			}
+			if _, err = bidi.Read(); err != nil {
+				return
+			}
+			remoteBidis <- bidi
+		}
+	}()
+
+	peer := common.UncheckedCreateNormalizedUsername("peer")
+	var opened atomic.Int32
+	var maxWarm atomic.Int32
+	maxWarm.Store(proxyPoolMaxWarm)
+	pool := newProxyPool(func(username common.NormalizedUsername) (protocol.ProtoBidi, error) {
+		opened.Add(1)
+		return local.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_OPEN_OUTBOUND_PROXY, &pb.MsgOpenOutboundProxy{
+			TargetUsername: username.String(),
+		})
+	}, func() int {
+		return int(maxWarm.Load())
+	})
+	defer pool.Close()
+
+	warmCount := func() int {
+		pool.mu.Lock()
+		defer pool.mu.Unlock()
+		if p, has := pool.peers[peer]; has && !p.refilling {
+			return len(p.warm)
+		}
+		return -1
+	}
+
+	// The first request opens a stream, then the pool is filled.
+	first, err := pool.Get(peer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = first.Close()
+	}()
+	waitFor(t, "pool to fill", func() bool { return warmCount() == proxyPoolPeerSize })
+	if n := opened.Load(); n != 1+proxyPoolPeerSize {
+		t.Fatalf("expected %d streams to be opened, got %d", 1+proxyPoolPeerSize, n)
+	}
+
+	// The next request takes a warm stream.
+	second, err := pool.Get(peer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = second.Close()
+	}()
+	waitFor(t, "pool to refill", func() bool { return warmCount() == proxyPoolPeerSize })
+	if n := opened.Load(); n != 2+proxyPoolPeerSize {
+		t.Fatalf("expected the warm stream to be used and replaced, got %d streams opened", n)
+	}
+
+	// Streams the other side canceled are skipped.
+	waitFor(t, "streams to reach the remote end", func() bool { return len(remoteBidis) == int(opened.Load()) })
+	maxWarm.Store(0)
+	for range len(remoteBidis) {
+		bidi := <-remoteBidis
+		bidi.Cancel(protocol.ProxyPeerUnreachableStreamErrorCode)
+	}
+	waitFor(t, "warm streams to be canceled", func() bool {
+		pool.mu.Lock()
+		defer pool.mu.Unlock()
+		for _, bidi := range pool.peers[peer].warm {
+			if isLive(bidi) {
+				return false
+			}
+		}
+		return true
+	})
+	third, err := pool.Get(peer)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = third.Close()
+	}()
+	if !isLive(third) {
+		t.Fatal("expected a live stream")
+	}
+	if n := opened.Load(); n != 3+proxyPoolPeerSize {
+		t.Fatalf("expected a new stream to be opened in place of the canceled ones, got %d streams opened", n)
+	}
+
+	// With no room for warm streams, the pool stays empty.
+	waitFor(t, "refill to stop", func() bool { return warmCount() == 0 })
+}
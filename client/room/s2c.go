@@ -15,6 +15,7 @@ func (c *Conn) s2cLoop() {
 		bidi, waitErr := c.serverConn.WaitForBidi(c.Context)
 		if waitErr != nil {
 			if protocol.IsErrorConnCloseOrCancel(waitErr) {
+				c.noteCloseErr(waitErr)
 				return
 			}
 
@@ -38,6 +39,11 @@ func (c *Conn) s2cLoop() {
 				if errors.Is(err, io.EOF) {
 					return
 				}
+				if unknownErr, ok := errors.AsType[protocol.UnknownMsgTypeError](err); ok {
+					// Probably a message from a newer protocol version.
+					_ = bidi.WriteUnimplementedError(unknownErr.Type)
+					return
+				}
 				if _, ok := errors.AsType[*quic.StreamError](err); ok {
 					return
 				}
@@ -73,13 +79,15 @@ func (c *Conn) s2cLoop() {
 					"room", c.RoomName.String(),
 				)
 				_ = bidi.WriteAck()
-				_ = c.serverConn.CloseWithReason("it was nice knowing you")
+				_ = c.serverConn.CloseWithCode(protocol.CloseCodeNormal, "it was nice knowing you")
 			case pb.MsgType_MSG_TYPE_PING:
 				err = c.logic.OnPing(c.Context, c, bidi, protocol.ToTyped[*pb.MsgPing](rawMsg))
 			case pb.MsgType_MSG_TYPE_CLIENT_ONLINE:
 				err = c.logic.OnClientOnline(c.Context, c, bidi, protocol.ToTyped[*pb.MsgClientOnline](rawMsg))
 			case pb.MsgType_MSG_TYPE_CLIENT_OFFLINE:
 				err = c.logic.OnClientOffline(c.Context, c, bidi, protocol.ToTyped[*pb.MsgClientOffline](rawMsg))
+			case pb.MsgType_MSG_TYPE_SERVER_NOTICE:
+				err = c.logic.OnServerNotice(c.Context, c, bidi, protocol.ToTyped[*pb.MsgServerNotice](rawMsg))
 			case pb.MsgType_MSG_TYPE_SEARCH:
 				err = c.logic.OnSearch(c.Context, c, bidi, protocol.ToTyped[*pb.MsgSearch](rawMsg))
 			default:
@@ -0,0 +1,180 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	"friendnet.org/client/cert"
+	"friendnet.org/common"
+	"friendnet.org/protocol"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// pooledSession is a server session shared by room connections.
+type pooledSession struct {
+	session   *protocol.Session
+	serverVer *pb.ProtoVersion
+	nextScope uint32
+}
+
+// SessionPool shares server connections between room connections to the same server address.
+// The first room connection to an address dials it, and later ones join their rooms over the same connection with
+// MSG_TYPE_JOIN_ROOM.
+// Servers that do not support joining multiple rooms get a dedicated connection per room.
+//
+// It is safe for concurrent use.
+type SessionPool struct {
+	mu sync.Mutex
+
+	// Mapping of server addresses to their current sessions.
+	sessions map[string]*pooledSession
+
+	// Addresses of servers that replied to MSG_TYPE_JOIN_ROOM with ERR_TYPE_UNIMPLEMENTED.
+	unsupported map[string]struct{}
+}
+
+// NewSessionPool creates a new, empty SessionPool.
+func NewSessionPool() *SessionPool {
+	return &SessionPool{
+		sessions:    make(map[string]*pooledSession),
+		unsupported: make(map[string]struct{}),
+	}
+}
+
+// reserveScope returns the live session for the address and a new scope on it.
+// Returns false if there is no live session for the address or the server does not support joining rooms.
+func (p *SessionPool) reserveScope(address string) (*pooledSession, *protocol.ScopedConn, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, has := p.unsupported[address]; has {
+		return nil, nil, false
+	}
+
+	ps, has := p.sessions[address]
+	if !has {
+		return nil, nil, false
+	}
+
+	for {
+		ps.nextScope++
+		if ps.nextScope == 0 {
+			continue
+		}
+
+		scope, err := ps.session.AddScope(ps.nextScope)
+		if err == nil {
+			return ps, scope, true
+		}
+		if errors.Is(err, protocol.ErrScopeInUse) {
+			continue
+		}
+
+		// The session is done.
+		delete(p.sessions, address)
+		return nil, nil, false
+	}
+}
+
+// join joins a room over an existing session.
+// If the server does not support it, the address is marked as unsupported and the returned error wraps
+// protocol.ProtoMsgError of ERR_TYPE_UNIMPLEMENTED.
+func (p *SessionPool) join(ps *pooledSession, scope *protocol.ScopedConn, address string, creds Credentials) (*pb.MsgAuthAccepted, error) {
+	res, err := ps.session.SendAndReceive(pb.MsgType_MSG_TYPE_JOIN_ROOM, &pb.MsgJoinRoom{
+		ScopeId:  scope.ScopeId(),
+		Room:     creds.Room.String(),
+		Username: creds.Username.String(),
+		Password: creds.Password,
+	})
+	if err != nil {
+		if msgErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok && msgErr.Msg.Type == pb.ErrType_ERR_TYPE_UNIMPLEMENTED {
+			p.mu.Lock()
+			p.unsupported[address] = struct{}{}
+			p.mu.Unlock()
+		}
+		return nil, err
+	}
+
+	switch payload := res.Payload.(type) {
+	case *pb.MsgAuthAccepted:
+		return payload, nil
+	case *pb.MsgAuthRejected:
+		return nil, protocol.AuthRejectedError{
+			Reason:  payload.Reason,
+			Message: common.StrPtrOr(payload.Message, ""),
+		}
+	default:
+		return nil, protocol.NewUnexpectedMsgTypeError(pb.MsgType_MSG_TYPE_AUTH_ACCEPTED, res.Type)
+	}
+}
+
+// Connect returns an authenticated connection to the specified room, the server's protocol version, and the server's
+// acceptance message.
+// If there is already a connection to the server at the address, the room is joined over it.
+// Otherwise, a new connection is dialed.
+//
+// If the server rejects the client's protocol version, returns a protocol.VersionRejectedError.
+// If the server rejects the client's credentials, returns a protocol.AuthRejectedError.
+func (p *SessionPool) Connect(
+	ctx context.Context,
+	certStore cert.Store,
+	address string,
+	clientVer *pb.ProtoVersion,
+	creds Credentials,
+) (protocol.ProtoConn, *pb.ProtoVersion, *pb.MsgAuthAccepted, error) {
+	if ps, scope, ok := p.reserveScope(address); ok {
+		accepted, err := p.join(ps, scope, address, creds)
+		if err == nil {
+			return scope, ps.serverVer, accepted, nil
+		}
+		scope.Discard()
+
+		if _, is := errors.AsType[protocol.AuthRejectedError](err); is {
+			return nil, nil, nil, err
+		}
+
+		// The session may have gone away, or the server does not support joining rooms.
+		// Either way, a new connection will do.
+	}
+
+	conn, err := ConnectWithCertStore(ctx, certStore, address)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	conn, serverVer, err := negotiateVersionEarly(ctx, conn, clientVer)
+	if err != nil {
+		_ = conn.CloseWithCode(protocol.CloseCodeNormal, "version negotiation failed")
+		return nil, nil, nil, err
+	}
+	accepted, err := authenticate(conn, creds)
+	if err != nil {
+		_ = conn.CloseWithCode(protocol.CloseCodeNormal, "authentication failed")
+		return nil, nil, nil, err
+	}
+
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if _, has := p.unsupported[address]; has {
+		return conn, serverVer, accepted, nil
+	}
+
+	session := protocol.NewSession(conn, nil)
+
+	// Only replace a session that is gone, so that concurrent connects do not orphan each other's rooms.
+	if ps, has := p.sessions[address]; has {
+		select {
+		case <-ps.session.Done():
+		default:
+			return session.Primary(), serverVer, accepted, nil
+		}
+	}
+	p.sessions[address] = &pooledSession{
+		session:   session,
+		serverVer: serverVer,
+	}
+
+	return session.Primary(), serverVer, accepted, nil
+}
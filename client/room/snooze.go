@@ -0,0 +1,46 @@
+package room
+
+import (
+	"context"
+	"sync/atomic"
+
+	"friendnet.org/common"
+)
+
+// Snooze pauses transfers and optionally hides shares across every server at once.
+// The zero value is not snoozed.
+// It is safe for concurrent use.
+type Snooze struct {
+	// Paused while snoozed.
+	gate common.PauseGate
+
+	hideShares atomic.Bool
+}
+
+// Start snoozes, or updates whether shares are hidden if already snoozed.
+func (s *Snooze) Start(hideShares bool) {
+	s.hideShares.Store(hideShares)
+	s.gate.Pause()
+}
+
+// End ends the snooze, releasing all transfers blocked in Wait.
+func (s *Snooze) End() {
+	s.hideShares.Store(false)
+	s.gate.Resume()
+}
+
+// IsSnoozed returns whether transfers are currently snoozed.
+func (s *Snooze) IsSnoozed() bool {
+	return s.gate.IsPaused()
+}
+
+// SharesHidden returns whether shares should currently be hidden from peers.
+func (s *Snooze) SharesHidden() bool {
+	return s.hideShares.Load()
+}
+
+// Wait blocks while snoozed.
+// Returns the context's error if the context is done before the snooze ends.
+func (s *Snooze) Wait(ctx context.Context) error {
+	return s.gate.Wait(ctx)
+}
@@ -0,0 +1,123 @@
+package room
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"friendnet.org/client/event"
+	"friendnet.org/common"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+	"github.com/google/uuid"
+)
+
+// uploadReportInterval is how often progress of an upload is published.
+const uploadReportInterval = 1 * time.Second
+
+// uploadReporter counts the bytes sent for an upload to a peer and publishes its progress as
+// TYPE_UPLOAD_UPDATE events.
+type uploadReporter struct {
+	publisher *event.Publisher
+
+	// The underlying writer.
+	w io.Writer
+
+	uuid      string
+	peer      common.NormalizedUsername
+	path      common.ProtoPath
+	offset    uint64
+	fileSize  uint64
+	startedTs time.Time
+
+	sent atomic.Uint64
+}
+
+// newUploadReporter creates a new uploadReporter for an upload that writes to w, and publishes that it started.
+func newUploadReporter(
+	publisher *event.Publisher,
+	w io.Writer,
+	peer common.NormalizedUsername,
+	path common.ProtoPath,
+	offset uint64,
+	fileSize uint64,
+) *uploadReporter {
+	uid, err := uuid.NewV7()
+	if err != nil {
+		panic(err)
+	}
+
+	u := &uploadReporter{
+		publisher: publisher,
+		w:         w,
+		uuid:      uid.String(),
+		peer:      peer,
+		path:      path,
+		offset:    offset,
+		fileSize:  fileSize,
+		startedTs: time.Now(),
+	}
+	u.publish(v1.UploadStatus_UPLOAD_STATUS_IN_PROGRESS, 0, nil)
+
+	return u
+}
+
+func (u *uploadReporter) Write(p []byte) (int, error) {
+	n, err := u.w.Write(p)
+	u.sent.Add(uint64(n))
+	return n, err
+}
+
+// run publishes the upload's progress periodically until ctx is done.
+func (u *uploadReporter) run(ctx context.Context) {
+	ticker := time.NewTicker(uploadReportInterval)
+	defer ticker.Stop()
+
+	lastSent := u.sent.Load()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			sent := u.sent.Load()
+			speed := uint64(float64(sent-lastSent) / uploadReportInterval.Seconds())
+			lastSent = sent
+
+			u.publish(v1.UploadStatus_UPLOAD_STATUS_IN_PROGRESS, speed, nil)
+		}
+	}
+}
+
+// finish publishes that the upload ended with the specified status.
+func (u *uploadReporter) finish(status v1.UploadStatus, err error) {
+	var errMsg *string
+	if err != nil {
+		errMsg = new(err.Error())
+	}
+	u.publish(status, 0, errMsg)
+}
+
+func (u *uploadReporter) publish(status v1.UploadStatus, speed uint64, errMsg *string) {
+	info := &v1.UploadInfo{
+		Uuid:         u.uuid,
+		PeerUsername: u.peer.String(),
+		FilePath:     u.path.String(),
+		Status:       status,
+		Offset:       u.offset,
+		BytesSent:    u.sent.Load(),
+		FileSize:     u.fileSize,
+		Speed:        speed,
+		StartedTs:    u.startedTs.Unix(),
+		ErrorMessage: errMsg,
+	}
+	if status != v1.UploadStatus_UPLOAD_STATUS_IN_PROGRESS {
+		info.EndedTs = new(time.Now().Unix())
+	}
+
+	u.publisher.Publish(&v1.Event{
+		Type: v1.Event_TYPE_UPLOAD_UPDATE,
+		UploadUpdate: &v1.Event_UploadUpdate{
+			Upload: info,
+		},
+	})
+}
@@ -3,9 +3,11 @@ package room
 import (
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"net"
 
+	"friendnet.org/client/direct"
 	"friendnet.org/common"
 	"friendnet.org/protocol"
 	pb "friendnet.org/protocol/pb/v1"
@@ -25,6 +27,9 @@ type VirtualC2cConn struct {
 	// Whether to force proxying instead of using a direct connection.
 	// It may still fall back to proxying if no direct connection method is available.
 	ForceProxy bool
+
+	// The timeout and retry policy for idempotent requests like GetFileMeta and GetDirFiles.
+	Policy direct.RequestPolicy
 }
 
 func (c VirtualC2cConn) lockCheck() error {
@@ -41,8 +46,8 @@ func (c VirtualC2cConn) RemoteAddr() net.Addr {
 	return &net.TCPAddr{IP: net.IPv4zero, Port: 0, Zone: ""}
 }
 
-// CloseWithReason is no-op.
-func (c VirtualC2cConn) CloseWithReason(string) error {
+// CloseWithCode is no-op.
+func (c VirtualC2cConn) CloseWithCode(protocol.CloseCode, string) error {
 	return nil
 }
 
@@ -59,7 +64,13 @@ func (c VirtualC2cConn) WaitForBidi(ctx context.Context) (protocol.ProtoBidi, er
 }
 
 func (c VirtualC2cConn) SendAndReceive(typ pb.MsgType, msg proto.Message) (*protocol.UntypedProtoMsg, error) {
-	bidi, err := c.OpenBidiWithMsg(typ, msg)
+	return c.SendAndReceiveContext(context.Background(), typ, msg)
+}
+
+// SendAndReceiveContext is like SendAndReceive, but gives up if ctx is done before the reply arrives.
+// Giving up cancels the bidi, so the peer stops working on the request.
+func (c VirtualC2cConn) SendAndReceiveContext(ctx context.Context, typ pb.MsgType, msg proto.Message) (*protocol.UntypedProtoMsg, error) {
+	bidi, err := c.openBidiContext(ctx, typ, msg)
 	if err != nil {
 		return nil, err
 	}
@@ -67,7 +78,7 @@ func (c VirtualC2cConn) SendAndReceive(typ pb.MsgType, msg proto.Message) (*prot
 		_ = bidi.Close()
 	}()
 
-	return bidi.Read()
+	return readContext(ctx, bidi, bidi.Read)
 }
 
 func (c VirtualC2cConn) SendAndReceiveAck(typ pb.MsgType, msg proto.Message) error {
@@ -89,16 +100,49 @@ func (c VirtualC2cConn) SendAndReceiveAck(typ pb.MsgType, msg proto.Message) err
 var _ protocol.ProtoConn = VirtualC2cConn{}
 
 // GetDirFiles returns a stream of files in the specified directory.
+// See GetDirFilesContext.
 func (c VirtualC2cConn) GetDirFiles(path common.ProtoPath) (protocol.Stream[*pb.MsgDirFiles], error) {
-	bidi, err := c.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_GET_DIR_FILES, &pb.MsgGetDirFiles{
-		Path: path.String(),
+	return c.GetDirFilesContext(context.Background(), path)
+}
+
+// GetDirFilesContext returns a stream of files in the specified directory.
+// Opening the stream is retried according to Policy, and each read from the stream fails with ErrPeerRequestTimeout
+// if the peer does not reply within the policy's timeout.
+// The stream is aborted if ctx is done before it is closed.
+func (c VirtualC2cConn) GetDirFilesContext(ctx context.Context, path common.ProtoPath) (protocol.Stream[*pb.MsgDirFiles], error) {
+	stream, err := withRequestPolicy(ctx, c.Policy, func(attemptCtx context.Context) (*peerMsgStream[*pb.MsgDirFiles], error) {
+		bidi, err := c.openBidiContext(attemptCtx, pb.MsgType_MSG_TYPE_GET_DIR_FILES, &pb.MsgGetDirFiles{
+			Path: path.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		typed := protocol.NewTypedMsgStream[*pb.MsgDirFiles](bidi, pb.MsgType_MSG_TYPE_DIR_FILES)
+		first, err := readContext(attemptCtx, bidi, typed.ReadNext)
+		if err != nil && !errors.Is(err, io.EOF) {
+			_ = bidi.Close()
+			return nil, err
+		}
+
+		return &peerMsgStream[*pb.MsgDirFiles]{
+			ctx:     ctx,
+			timeout: c.Policy.Timeout,
+
+			bidi:   bidi,
+			stream: typed,
+
+			hasFirst: true,
+			first:    first,
+			firstErr: err,
+		}, nil
 	})
 	if err != nil {
 		return nil, err
 	}
 
 	return protocol.NewTransformerStream(
-		protocol.NewTypedMsgStream[*pb.MsgDirFiles](bidi, pb.MsgType_MSG_TYPE_DIR_FILES),
+		stream,
 		func(msg *protocol.TypedProtoMsg[*pb.MsgDirFiles]) *pb.MsgDirFiles {
 			return msg.Payload
 		},
@@ -106,12 +150,73 @@ func (c VirtualC2cConn) GetDirFiles(path common.ProtoPath) (protocol.Stream[*pb.
 }
 
 // GetFileMeta returns the metadata of the specified file.
+// See GetFileMetaContext.
 func (c VirtualC2cConn) GetFileMeta(path common.ProtoPath) (*pb.MsgFileMeta, error) {
+	return c.GetFileMetaContext(context.Background(), path)
+}
+
+// GetFileMetaContext returns the metadata of the specified file.
+// The request is retried according to Policy, and fails with ErrPeerRequestTimeout if no attempt got a reply in time.
+//
+// Concurrent requests for the same file on the same peer are coalesced into a single request to the peer.
+// The shared request is not aborted when ctx is done, only this call stops waiting for it.
+func (c VirtualC2cConn) GetFileMetaContext(ctx context.Context, path common.ProtoPath) (*pb.MsgFileMeta, error) {
+	key := fmt.Sprintf("%s:%t:%s", c.Username.String(), c.ForceProxy, path.String())
+	resChan := c.ServerConn.fileMetaFlight.DoChan(key, func() (any, error) {
+		return c.getFileMeta(context.WithoutCancel(ctx), path)
+	})
+
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case res := <-resChan:
+		if res.Err != nil {
+			return nil, res.Err
+		}
+
+		meta := res.Val.(*pb.MsgFileMeta)
+		if res.Shared {
+			// Callers are free to modify the message, so each needs its own copy.
+			meta = proto.Clone(meta).(*pb.MsgFileMeta)
+		}
+		return meta, nil
+	}
+}
+
+func (c VirtualC2cConn) getFileMeta(ctx context.Context, path common.ProtoPath) (*pb.MsgFileMeta, error) {
+	return withRequestPolicy(ctx, c.Policy, func(attemptCtx context.Context) (*pb.MsgFileMeta, error) {
+		bidi, err := c.openBidiContext(attemptCtx, pb.MsgType_MSG_TYPE_GET_FILE_META, &pb.MsgGetFileMeta{
+			Path: path.String(),
+		})
+		if err != nil {
+			return nil, err
+		}
+		defer func() {
+			_ = bidi.Close()
+		}()
+
+		msg, err := readContext(attemptCtx, bidi, func() (*protocol.TypedProtoMsg[*pb.MsgFileMeta], error) {
+			return protocol.ReadExpect[*pb.MsgFileMeta](bidi.ProtoStreamReader, pb.MsgType_MSG_TYPE_FILE_META)
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		return msg.Payload, nil
+	})
+}
+
+// GetFileMetaWithHash returns the metadata of the specified file, including its SHA-256 hash if it is a file and the
+// peer already knows it.
+// Peers that do not know the hash yet may work it out in the background and set Sha256Pending, in which case asking
+// again later includes it.
+func (c VirtualC2cConn) GetFileMetaWithHash(path common.ProtoPath) (*pb.MsgFileMeta, error) {
 	msg, err := protocol.SendAndReceiveExpect[*pb.MsgFileMeta](
 		c,
 		pb.MsgType_MSG_TYPE_GET_FILE_META,
 		&pb.MsgGetFileMeta{
-			Path: path.String(),
+			Path:          path.String(),
+			IncludeSha256: true,
 		},
 		pb.MsgType_MSG_TYPE_FILE_META,
 	)
@@ -127,30 +232,120 @@ func (c VirtualC2cConn) GetFileMeta(path common.ProtoPath) (*pb.MsgFileMeta, err
 //
 // It is up to the caller to enforce timeouts.
 func (c VirtualC2cConn) GetFile(req *pb.MsgGetFile) (meta *pb.MsgFileMeta, reader io.ReadCloser, err error) {
-	bidi, err := c.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_GET_FILE, req)
+	meta, transfer, err := c.GetFileTransfer(req)
 	if err != nil {
 		return nil, nil, err
 	}
+	return meta, transfer, nil
+}
+
+// GetFileContext is like GetFile, but aborts the transfer if ctx is done before the reader is closed.
+// Aborting resets the bidi with protocol.RequestCanceledStreamErrorCode, which reaches the peer even through a proxy,
+// so the peer stops reading the file instead of sending data nobody will read.
+func (c VirtualC2cConn) GetFileContext(ctx context.Context, req *pb.MsgGetFile) (meta *pb.MsgFileMeta, reader io.ReadCloser, err error) {
+	meta, transfer, err := c.getFileTransfer(ctx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+	return meta, transfer, nil
+}
+
+// FileTransfer is an in-progress file transfer started with MSG_TYPE_GET_FILE.
+// Reading from it reads the file's content.
+type FileTransfer struct {
+	io.ReadCloser
+
+	bidi protocol.ProtoBidi
+}
+
+// Cancel aborts the transfer, telling the peer to stop sending immediately.
+// The transfer must still be closed afterward.
+func (t *FileTransfer) Cancel() {
+	t.bidi.Cancel(protocol.RequestCanceledStreamErrorCode)
+}
+
+// Pause asks the peer to stop sending file content until Resume is called.
+// Peers that do not support pausing will keep sending.
+func (t *FileTransfer) Pause() error {
+	return t.bidi.Write(pb.MsgType_MSG_TYPE_TRANSFER_CONTROL, &pb.MsgTransferControl{
+		Action: pb.TransferControlAction_TRANSFER_CONTROL_ACTION_PAUSE,
+	})
+}
+
+// Resume asks the peer to continue sending file content after Pause.
+func (t *FileTransfer) Resume() error {
+	return t.bidi.Write(pb.MsgType_MSG_TYPE_TRANSFER_CONTROL, &pb.MsgTransferControl{
+		Action: pb.TransferControlAction_TRANSFER_CONTROL_ACTION_RESUME,
+	})
+}
+
+// GetFileTransfer is like GetFile, but returns a FileTransfer that can be paused and resumed.
+// If req.ChecksumChunkSize is set and the peer supports it, reading fails with protocol.ChunkChecksumMismatchError
+// as soon as a chunk of the content does not match its checksum.
+//
+// It is up to the caller to enforce timeouts.
+func (c VirtualC2cConn) GetFileTransfer(req *pb.MsgGetFile) (meta *pb.MsgFileMeta, transfer *FileTransfer, err error) {
+	return c.getFileTransfer(context.Background(), req)
+}
+
+func (c VirtualC2cConn) getFileTransfer(ctx context.Context, req *pb.MsgGetFile) (meta *pb.MsgFileMeta, transfer *FileTransfer, err error) {
+	bidi, err := c.openBidiContext(ctx, pb.MsgType_MSG_TYPE_GET_FILE, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stopCancel := context.AfterFunc(ctx, func() {
+		bidi.Cancel(protocol.RequestCanceledStreamErrorCode)
+	})
 
 	msg, err := protocol.ReadExpect[*pb.MsgFileMeta](
 		bidi.ProtoStreamReader,
 		pb.MsgType_MSG_TYPE_FILE_META,
 	)
 	if err != nil {
+		stopCancel()
+		_ = bidi.Close()
+		if ctxErr := ctx.Err(); ctxErr != nil {
+			return nil, nil, ctxErr
+		}
 		return nil, nil, err
 	}
 
 	// Now that we have the metadata, we can treat the bidi as a binary stream.
-	reader = common.NewLimitReadCloser(
-		protocol.NewReadCloserWithFunc(bidi.Stream, bidi.Close),
-		int64(msg.Payload.Size),
-	)
-	return msg.Payload, reader, nil
+	// If the peer checksums the content in chunks, the checksums are verified and stripped while reading.
+	var content io.Reader = bidi.Stream
+	if chunkSize := msg.Payload.GetChecksumChunkSize(); req.ChecksumChunkSize != 0 && chunkSize != 0 {
+		content = protocol.NewChunkChecksumReader(
+			bidi.Stream,
+			int64(chunkSize),
+			protocol.GetFileContentSize(msg.Payload.Size, req.Offset, req.Limit),
+		)
+	}
+
+	transfer = &FileTransfer{
+		ReadCloser: common.NewLimitReadCloser(
+			protocol.NewReadCloserWithFunc(content, func() error {
+				stopCancel()
+				return bidi.Close()
+			}),
+			int64(msg.Payload.Size),
+		),
+		bidi: bidi,
+	}
+	return msg.Payload, transfer, nil
 }
 
 // Search returns a stream of search results for the specified query.
+// See SearchContext.
 func (c VirtualC2cConn) Search(query string) (protocol.Stream[*pb.MsgSearchResult], error) {
-	bidi, err := c.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_SEARCH, &pb.MsgSearch{
+	return c.SearchContext(context.Background(), query)
+}
+
+// SearchContext returns a stream of search results for the specified query.
+// The stream is aborted if ctx is done before it is closed, which tells the peer to stop searching.
+// Reads are not subject to Policy's timeout, since searching a large share can take a while.
+func (c VirtualC2cConn) SearchContext(ctx context.Context, query string) (protocol.Stream[*pb.MsgSearchResult], error) {
+	bidi, err := c.openBidiContext(ctx, pb.MsgType_MSG_TYPE_SEARCH, &pb.MsgSearch{
 		Query: query,
 	})
 	if err != nil {
@@ -158,7 +353,7 @@ func (c VirtualC2cConn) Search(query string) (protocol.Stream[*pb.MsgSearchResul
 	}
 
 	return protocol.NewTransformerStream(
-		protocol.NewTypedMsgStream[*pb.MsgSearchResult](bidi, pb.MsgType_MSG_TYPE_SEARCH_RESULT),
+		newPeerMsgStream(ctx, 0, bidi, protocol.NewTypedMsgStream[*pb.MsgSearchResult](bidi, pb.MsgType_MSG_TYPE_SEARCH_RESULT)),
 		func(msg *protocol.TypedProtoMsg[*pb.MsgSearchResult]) *pb.MsgSearchResult {
 			return msg.Payload
 		},
@@ -0,0 +1,118 @@
+package room
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+	"friendnet.org/protocol"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+func TestVirtualC2cConn_GetFileMetaCoalesces(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	local, remote, err := protocol.NewMemNetwork().ConnPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+
+	// The remote end stands in for both the server and the peer, holding back its reply until released.
+	var requests atomic.Int32
+	received := make(chan struct{}, 16)
+	release := make(chan struct{})
+	go func() {
+		for {
+			bidi, err := remote.WaitForBidi(ctx)
+			if err != nil {
+				return
+			}
+			go func() {
+				defer func() {
+					_ = bidi.Close()
+				}()
+
+				// The proxy request comes first, then the request meant for the peer.
+				if _, err := bidi.Read(); err != nil {
+					return
+				}
+				if _, err := protocol.ReadExpect[*pb.MsgGetFileMeta](bidi.ProtoStreamReader, pb.MsgType_MSG_TYPE_GET_FILE_META); err != nil {
+					return
+				}
+				requests.Add(1)
+				received <- struct{}{}
+
+				<-release
+				_ = bidi.Write(pb.MsgType_MSG_TYPE_FILE_META, &pb.MsgFileMeta{Name: "song.flac", Size: 1234})
+			}()
+		}
+	}()
+
+	serverConn := &Conn{
+		proxyPool: newProxyPool(func(username common.NormalizedUsername) (protocol.ProtoBidi, error) {
+			return local.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_OPEN_OUTBOUND_PROXY, &pb.MsgOpenOutboundProxy{
+				TargetUsername: username.String(),
+			})
+		}, func() int {
+			return 0
+		}),
+	}
+	defer serverConn.proxyPool.Close()
+
+	peer := VirtualC2cConn{
+		ServerConn: serverConn,
+		Username:   common.UncheckedCreateNormalizedUsername("peer"),
+		ForceProxy: true,
+	}
+	path := common.UncheckedCreateProtoPath("/music/song.flac")
+
+	type result struct {
+		meta *pb.MsgFileMeta
+		err  error
+	}
+	const callers = 4
+	results := make(chan result, callers)
+	for range callers {
+		go func() {
+			meta, err := peer.GetFileMetaContext(ctx, path)
+			results <- result{meta: meta, err: err}
+		}()
+	}
+
+	// Give every caller time to join the lookup before the peer replies.
+	select {
+	case <-received:
+	case <-ctx.Done():
+		t.Fatal("timed out waiting for the request to reach the peer")
+	}
+	time.Sleep(100 * time.Millisecond)
+	close(release)
+
+	metas := make([]*pb.MsgFileMeta, 0, callers)
+	for range callers {
+		res := <-results
+		if res.err != nil {
+			t.Fatalf("failed to get file meta: %v", res.err)
+		}
+		metas = append(metas, res.meta)
+	}
+	if n := requests.Load(); n != 1 {
+		t.Fatalf("expected concurrent callers to share 1 request to the peer, got %d", n)
+	}
+
+	// Each caller gets its own copy, so changing one does not affect the others.
+	metas[0].Name = "changed.flac"
+	for i, meta := range metas[1:] {
+		if meta == metas[0] {
+			t.Fatalf("expected caller %d to get its own copy of the metadata", i+1)
+		}
+		if meta.Name != "song.flac" || meta.Size != 1234 {
+			t.Fatalf("expected caller %d to get song.flac with size 1234, got %s with size %d", i+1, meta.Name, meta.Size)
+		}
+	}
+}
@@ -1,17 +1,23 @@
 package client
 
 import (
+	"bufio"
+	"bytes"
 	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
+	"io/fs"
 	"log/slog"
 	"net/netip"
+	"os"
 	"path/filepath"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"connectrpc.com/connect"
+	"friendnet.org/client/backup"
 	"friendnet.org/client/clog"
 	"friendnet.org/client/direct"
 	"friendnet.org/client/event"
@@ -24,11 +30,13 @@ import (
 	"friendnet.org/protocol/pb/clientrpc/v1/clientrpcv1connect"
 	pb "friendnet.org/protocol/pb/v1"
 	"friendnet.org/updater"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 var errServerNotFound = connect.NewError(connect.CodeNotFound, errors.New("server not found"))
 var errInvalidUsername = connect.NewError(connect.CodeInvalidArgument, errors.New("invalid username"))
 var errInvalidRoomName = connect.NewError(connect.CodeInvalidArgument, errors.New("invalid room name"))
+var errAccountTemplateNotFound = connect.NewError(connect.CodeNotFound, ErrAccountTemplateNotFound)
 var errPathNotDir = connect.NewError(connect.CodeInvalidArgument, errors.New("path is not a directory"))
 var errShareNotFound = connect.NewError(connect.CodeNotFound, errors.New("share not found"))
 var errFileNotFound = connect.NewError(connect.CodeNotFound, errors.New("file not found"))
@@ -38,15 +46,46 @@ var errInvalidUpnpTimeout = connect.NewError(connect.CodeInvalidArgument, errors
 var errIndexingDisabled = connect.NewError(connect.CodeFailedPrecondition, errors.New("share has indexing disabled"))
 var errEmptySearchQuery = connect.NewError(connect.CodeInvalidArgument, errors.New("search query cannot be empty"))
 var errInvalidShareName = connect.NewError(connect.CodeInvalidArgument, share.ErrInvalidShareName)
+var errInvalidConflictRule = connect.NewError(connect.CodeInvalidArgument, errors.New("invalid conflict rule"))
+var errInvalidUnicodeForm = connect.NewError(connect.CodeInvalidArgument, errors.New("invalid Unicode form"))
 var errDownloadHandleNotFound = connect.NewError(connect.CodeNotFound, errors.New("download handle not found"))
 var errDmItemNotFound = connect.NewError(connect.CodeNotFound, errors.New("download manager item not found"))
+var errDownloadHookNotFound = connect.NewError(connect.CodeNotFound, errors.New("download hook not found"))
+var errShareLinkNotFound = connect.NewError(connect.CodeNotFound, ErrShareLinkNotFound)
+var errNoDirectConn = connect.NewError(connect.CodeFailedPrecondition, errors.New("no direct connection to peer"))
+var errFriendNotFound = connect.NewError(connect.CodeNotFound, errors.New("friend not found"))
+var errFriendNicknameTooLong = connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("nickname cannot be longer than %d characters", MaxFriendNicknameLength))
+var errPeerNotBlocked = connect.NewError(connect.CodeNotFound, errors.New("peer is not blocked"))
+var errInterestsUnsupported = connect.NewError(connect.CodeUnimplemented, errors.New("server does not support interests"))
+var errInvalidTrustLevel = connect.NewError(connect.CodeInvalidArgument, errors.New("invalid trust level"))
+var errFriendNoteTooLong = connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("note cannot be longer than %d characters", MaxFriendNoteLength))
+var errZeroSnoozeDuration = connect.NewError(connect.CodeInvalidArgument, errors.New("snooze duration cannot be 0"))
+var errPluginNotFound = connect.NewError(connect.CodeNotFound, errors.New("plugin not found"))
+var errNotPlugin = connect.NewError(connect.CodeFailedPrecondition, errors.New("only plugins can call this method"))
+var errNotHosting = connect.NewError(connect.CodeFailedPrecondition, ErrNotHosting)
+var errAlreadyHosting = connect.NewError(connect.CodeAlreadyExists, ErrAlreadyHosting)
+var errInvalidHostPort = connect.NewError(connect.CodeInvalidArgument, errors.New("port must be between 1 and 65535 (inclusive), or 0 for the default"))
+var errInvalidPluginSearchResult = connect.NewError(connect.CodeInvalidArgument, errors.New("search results must have a file with a name and a valid directory path"))
+
+// MaxFriendNicknameLength is the maximum number of characters in a friend's nickname.
+const MaxFriendNicknameLength = 64
+
+// MaxFriendNoteLength is the maximum number of characters in a note about a friend.
+const MaxFriendNoteLength = 4096
 
 type RpcServer struct {
 	clogHandler     clog.Handler
 	client          *MultiClient
 	eventBus        *event.Bus
 	updateChecker   *updater.UpdateChecker
+	selfUpdater     *SelfUpdater
 	downloadManager *DownloadManager
+	uploadTracker   *UploadTracker
+	uptimeTracker   *UptimeTracker
+	shareGateway    *ShareGateway
+	fileLinks       *FileLinkStore
+	plugins         *PluginManager
+	host            *Host
 	storage         *storage.Storage
 	stopper         func()
 }
@@ -56,7 +95,14 @@ func NewRpcServer(
 	client *MultiClient,
 	eventBus *event.Bus,
 	updateChecker *updater.UpdateChecker,
+	selfUpdater *SelfUpdater,
 	downloadManager *DownloadManager,
+	uploadTracker *UploadTracker,
+	uptimeTracker *UptimeTracker,
+	shareGateway *ShareGateway,
+	fileLinks *FileLinkStore,
+	plugins *PluginManager,
+	host *Host,
 	storage *storage.Storage,
 	stopper func(),
 ) *RpcServer {
@@ -65,7 +111,14 @@ func NewRpcServer(
 		client:          client,
 		eventBus:        eventBus,
 		updateChecker:   updateChecker,
+		selfUpdater:     selfUpdater,
 		downloadManager: downloadManager,
+		uploadTracker:   uploadTracker,
+		uptimeTracker:   uptimeTracker,
+		shareGateway:    shareGateway,
+		fileLinks:       fileLinks,
+		plugins:         plugins,
+		host:            host,
 		storage:         storage,
 		stopper:         stopper,
 	}
@@ -77,11 +130,39 @@ func (s *RpcServer) Close() error {
 
 var _ clientrpcv1connect.ClientRpcServiceHandler = (*RpcServer)(nil)
 
+// rttStatsToPb converts round-trip time statistics to their RPC representation.
+func rttStatsToPb(rtt common.RttStats) *v1.RttStats {
+	res := &v1.RttStats{
+		LastUs:          rtt.Last.Microseconds(),
+		MinUs:           rtt.Min.Microseconds(),
+		AvgUs:           rtt.Avg.Microseconds(),
+		MaxUs:           rtt.Max.Microseconds(),
+		Samples:         uint32(rtt.Samples),
+		Lost:            rtt.Lost,
+		ConsecutiveLost: uint32(rtt.ConsecutiveLost),
+	}
+	if rtt.ClockOffsetKnown {
+		res.ClockOffsetUs = new(rtt.ClockOffset.Microseconds())
+	}
+	return res
+}
+
 func (s *RpcServer) serverToInfo(srv *Server) *v1.ServerInfo {
+	state := &v1.ServerInfo_State{
+		ConnState: srv.ConnNanny.State().ToRpcEnum(),
+	}
+	_ = srv.ConnNanny.TryDo(func(c *room.Conn) error {
+		state.Rtt = rttStatsToPb(c.RttStats())
+		state.Motd = common.StrOrNil(c.Motd())
+		state.Remote = remoteServerInfoToPb(c.ServerInfo())
+		if skew, ok := c.ClockSkew(); ok {
+			state.ClockSkewMs = new(skew.Milliseconds())
+		}
+		return nil
+	})
+
 	return &v1.ServerInfo{
-		State: &v1.ServerInfo_State{
-			ConnState: srv.ConnNanny.State().ToRpcEnum(),
-		},
+		State:     state,
 		Uuid:      srv.Uuid,
 		Name:      srv.Name,
 		Address:   srv.Address(),
@@ -90,21 +171,122 @@ func (s *RpcServer) serverToInfo(srv *Server) *v1.ServerInfo {
 		CreatedTs: srv.CreatedTs.Unix(),
 	}
 }
+func remoteServerInfoToPb(info *pb.MsgServerInfo) *v1.RemoteServerInfo {
+	if info == nil {
+		return nil
+	}
+
+	res := &v1.RemoteServerInfo{
+		Version:  info.Version,
+		Features: info.Features,
+	}
+	if info.ProtocolVersion != nil {
+		res.ProtocolVersion = protocol.FormatProtoVersion(info.ProtocolVersion)
+	}
+	if policies := info.Room; policies != nil {
+		res.MaxClients = policies.MaxClients
+		res.MaxProxyStreamsPerClient = policies.MaxProxyStreamsPerClient
+		res.MaxConcurrentRequests = policies.MaxConcurrentRequests
+		res.DirCacheTtlMs = policies.DirCacheTtlMs
+		res.RelayMaxBytesPerSecond = policies.RelayMaxBytesPerSecond
+	}
+	return res
+}
+
 func (s *RpcServer) metaToInfo(meta *pb.MsgFileMeta) *v1.FileMeta {
 	return &v1.FileMeta{
-		Name:  meta.Name,
-		IsDir: meta.IsDir,
-		Size:  meta.Size,
+		Name:       meta.Name,
+		IsDir:      meta.IsDir,
+		Size:       meta.Size,
+		ModifiedTs: meta.ModifiedTs,
+	}
+}
+func (s *RpcServer) shareRecToInfo(record storage.ShareRecord) *v1.ShareInfo {
+	info := &v1.ShareInfo{
+		Uuid:         record.Uuid,
+		ServerUuid:   record.Server,
+		Name:         record.Name,
+		Path:         record.Path.String(),
+		CreatedTs:    record.CreatedTs.Unix(),
+		FollowLinks:  record.FollowLinks,
+		ConflictRule: conflictRuleToPb(share.ConflictRule(record.ConflictRule)),
+
+		CaseInsensitive: record.CaseInsensitive,
+		UnicodeForm:     unicodeFormToPb(record.UnicodeForm),
+	}
+
+	// Mounts and exclude patterns are only known for shares that are being managed, so shares in the trash have none.
+	if srv, has := s.client.GetByUuid(record.Server); has {
+		if _, mounts, has := srv.ShareMgr.GetMounts(record.Name); has {
+			info.Mounts = make([]*v1.ShareMount, len(mounts))
+			for i, mount := range mounts {
+				info.Mounts[i] = &v1.ShareMount{
+					VirtualPath: mount.VirtualPath.String(),
+					Path:        mount.Dir,
+				}
+			}
+		}
+		info.ExcludePatterns, _ = srv.ShareMgr.GetExcludes(record.Name)
+	}
+
+	return info
+}
+func unicodeFormToPb(form common.UnicodeForm) v1.ShareUnicodeForm {
+	switch form {
+	case common.UnicodeFormNFC:
+		return v1.ShareUnicodeForm_SHARE_UNICODE_FORM_NFC
+	case common.UnicodeFormNFD:
+		return v1.ShareUnicodeForm_SHARE_UNICODE_FORM_NFD
+	default:
+		return v1.ShareUnicodeForm_SHARE_UNICODE_FORM_NONE
+	}
+}
+func healthIssueKindToPb(kind share.HealthIssueKind) v1.ShareHealthIssueKind {
+	switch kind {
+	case share.HealthIssueInvalidName:
+		return v1.ShareHealthIssueKind_SHARE_HEALTH_ISSUE_KIND_INVALID_NAME
+	case share.HealthIssueUnreadable:
+		return v1.ShareHealthIssueKind_SHARE_HEALTH_ISSUE_KIND_UNREADABLE
+	case share.HealthIssueBrokenLink:
+		return v1.ShareHealthIssueKind_SHARE_HEALTH_ISSUE_KIND_BROKEN_LINK
+	case share.HealthIssueWindowsName:
+		return v1.ShareHealthIssueKind_SHARE_HEALTH_ISSUE_KIND_WINDOWS_NAME
+	case share.HealthIssueNameTooLong:
+		return v1.ShareHealthIssueKind_SHARE_HEALTH_ISSUE_KIND_NAME_TOO_LONG
+	default:
+		return v1.ShareHealthIssueKind_SHARE_HEALTH_ISSUE_KIND_UNSPECIFIED
+	}
+}
+func conflictRuleToPb(rule share.ConflictRule) v1.ShareConflictRule {
+	if rule == share.ConflictRuleNewest {
+		return v1.ShareConflictRule_SHARE_CONFLICT_RULE_NEWEST
+	}
+	return v1.ShareConflictRule_SHARE_CONFLICT_RULE_FIRST
+}
+func (s *RpcServer) shareLinkRecToInfo(link storage.ShareLinkRecord, share storage.ShareRecord) *v1.ShareLinkInfo {
+	info := &v1.ShareLinkInfo{
+		Token:      link.Token,
+		ServerUuid: share.Server,
+		ShareName:  share.Name,
+		Path:       link.Path.String(),
+		CreatedTs:  link.CreatedTs.Unix(),
+	}
+	if link.ExpiresTs != nil {
+		info.ExpiresTs = new(link.ExpiresTs.Unix())
+	}
+	if linkUrl, ok := s.shareGateway.LinkUrl(link.Token); ok {
+		info.Url = &linkUrl
 	}
+	return info
 }
-func (s *RpcServer) shareRecToInfo(share storage.ShareRecord) *v1.ShareInfo {
-	return &v1.ShareInfo{
-		Uuid:        share.Uuid,
-		ServerUuid:  share.Server,
-		Name:        share.Name,
-		Path:        share.Path.String(),
-		CreatedTs:   share.CreatedTs.Unix(),
-		FollowLinks: share.FollowLinks,
+
+func (s *RpcServer) downloadHookRecToInfo(hook storage.DownloadHookRecord) *v1.DownloadHookInfo {
+	return &v1.DownloadHookInfo{
+		Uuid:         hook.Uuid,
+		CreatedTs:    hook.CreatedTs.Unix(),
+		Type:         hook.Type,
+		Target:       hook.Target,
+		DownloadUuid: hook.Download,
 	}
 }
 func (s *RpcServer) writeLogMsgPtr(rec clog.MessageRecord, ptr *v1.LogMessage) {
@@ -190,6 +372,22 @@ func (s *RpcServer) StreamEvents(ctx context.Context, _ *v1.StreamEventsRequest,
 	})
 	defer s.eventBus.Unsubscribe(sub)
 
+	// Report recovered downloads to the first stream, since they were recovered before anyone could subscribe.
+	if recovered := s.downloadManager.TakeRecovered(); len(recovered) > 0 {
+		err := conn.Send(&v1.StreamEventsResponse{
+			Event: &v1.Event{
+				Type: v1.Event_TYPE_DOWNLOADS_RECOVERED,
+				DownloadsRecovered: &v1.Event_DownloadsRecovered{
+					Downloads: recovered,
+				},
+			},
+			Context: &v1.EventContext{},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	// Stream new events as they come in.
 	for {
 		select {
@@ -210,20 +408,92 @@ func (s *RpcServer) Stop(_ context.Context, _ *v1.StopRequest) (*v1.StopResponse
 	return &v1.StopResponse{}, nil
 }
 
+// Feature names reported by GetClientInfo.
+const (
+	clientFeatureShareGateway = "share_gateway"
+	clientFeatureSelfUpdate   = "self_update"
+	clientFeatureKeychain     = "keychain"
+)
+
 func (s *RpcServer) GetClientInfo(_ context.Context, _ *v1.GetClientInfoRequest) (*v1.GetClientInfoResponse, error) {
-	return &v1.GetClientInfoResponse{}, nil
+	var features []string
+	if s.shareGateway.IsEnabled() {
+		features = append(features, clientFeatureShareGateway)
+	}
+	if s.selfUpdater.IsEnabled() {
+		features = append(features, clientFeatureSelfUpdate)
+	}
+	if s.storage.UsesSecretStore() {
+		features = append(features, clientFeatureKeychain)
+	}
+
+	startTs := s.uptimeTracker.StartTs()
+	return &v1.GetClientInfoResponse{
+		Version:         s.updateChecker.CurrentUpdate.Version,
+		ProtocolVersion: protocol.FormatProtoVersion(protocol.CurrentProtocolVersion),
+		BuildCommit:     common.BuildCommit(),
+		StartTs:         startTs.Unix(),
+		UptimeSeconds:   int64(time.Since(startTs).Seconds()),
+		Features:        features,
+	}, nil
+}
+
+func (s *RpcServer) GetMemoryUsage(ctx context.Context, _ *v1.GetMemoryUsageRequest) (*v1.GetMemoryUsageResponse, error) {
+	lowMemorySetting, err := s.storage.GetSettingBoolOr(ctx, LowMemorySetting, false)
+	if err != nil {
+		return nil, err
+	}
+
+	budget := s.client.MemoryBudget()
+	usage := ReadMemoryUsage()
+	connLimits := s.client.directMgr.ConnLimits()
+	return &v1.GetMemoryUsageResponse{
+		LowMemory:              budget.LowMemory,
+		LowMemorySetting:       lowMemorySetting,
+		UsedBytes:              usage.Used,
+		HeapBytes:              usage.Heap,
+		SoftLimitBytes:         usage.SoftLimit,
+		ReadAheadBufferedBytes: usage.ReadAheadBuffered,
+		MaxReadAheadBytes:      uint64(max(budget.MaxReadAhead, 0)),
+		MaxDownloadConcurrency: uint32(max(budget.MaxDownloadConcurrency, 0)),
+		MaxIncomingStreams:     uint32(max(connLimits.MaxIncomingStreams, 0)),
+		MaxConcurrentRequests:  uint32(max(connLimits.MaxConcurrentRequests, 0)),
+		MaxIndexFiles:          uint32(max(budget.MaxIndexFiles, 0)),
+	}, nil
 }
 
-func (s *RpcServer) GetServers(_ context.Context, _ *v1.GetServersRequest) (*v1.GetServersResponse, error) {
-	servers := s.client.GetAll()
+func (s *RpcServer) SetLowMemoryMode(ctx context.Context, request *v1.SetLowMemoryModeRequest) (*v1.SetLowMemoryModeResponse, error) {
+	if err := s.storage.PutSettingBool(ctx, LowMemorySetting, request.Enabled); err != nil {
+		return nil, err
+	}
+
+	return &v1.SetLowMemoryModeResponse{}, nil
+}
 
-	infos := make([]*v1.ServerInfo, len(servers))
-	for i, srv := range servers {
-		infos[i] = s.serverToInfo(srv)
+func (s *RpcServer) GetServers(ctx context.Context, request *v1.GetServersRequest) (*v1.GetServersResponse, error) {
+	records, next, err := s.client.storage.GetServersPage(ctx, request.Cursor, common.RpcPageLimit(request.Limit))
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.client.storage.CountServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*v1.ServerInfo, 0, len(records))
+	for _, record := range records {
+		srv, has := s.client.GetByUuid(record.Uuid)
+		if !has {
+			// Created or deleted concurrently.
+			continue
+		}
+		infos = append(infos, s.serverToInfo(srv))
 	}
 
 	return &v1.GetServersResponse{
-		Servers: infos,
+		Servers:    infos,
+		NextCursor: next,
+		Total:      uint32(total),
 	}, nil
 }
 
@@ -232,6 +502,28 @@ func (s *RpcServer) CreateServer(ctx context.Context, request *v1.CreateServerRe
 	if !roomOk {
 		return nil, errInvalidRoomName
 	}
+
+	if request.AccountTemplateUuid != "" {
+		srv, failedShares, err := s.client.CreateFromTemplate(
+			ctx,
+			request.AccountTemplateUuid,
+			request.Name,
+			request.Address,
+			roomName,
+		)
+		if err != nil {
+			if errors.Is(err, ErrAccountTemplateNotFound) {
+				return nil, errAccountTemplateNotFound
+			}
+			return nil, err
+		}
+
+		return &v1.CreateServerResponse{
+			Server:       s.serverToInfo(srv),
+			FailedShares: failedShares,
+		}, nil
+	}
+
 	username, usernameOk := common.NormalizeUsername(request.Username)
 	if !usernameOk {
 		return nil, errInvalidUsername
@@ -254,6 +546,229 @@ func (s *RpcServer) CreateServer(ctx context.Context, request *v1.CreateServerRe
 	}, nil
 }
 
+func (s *RpcServer) ImportInviteBundle(ctx context.Context, request *v1.ImportInviteBundleRequest) (*v1.ImportInviteBundleResponse, error) {
+	bundle, err := protocol.ParseInviteUrl(request.Url)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if _, roomOk := common.NormalizeRoomName(bundle.Room); !roomOk {
+		return nil, errInvalidRoomName
+	}
+
+	var template AccountTemplate
+	var username common.NormalizedUsername
+	password := request.Password
+	if request.AccountTemplateUuid != "" {
+		template, err = s.client.GetAccountTemplate(ctx, request.AccountTemplateUuid)
+		if err != nil {
+			if errors.Is(err, ErrAccountTemplateNotFound) {
+				return nil, errAccountTemplateNotFound
+			}
+			return nil, err
+		}
+		username = template.Username
+		password = template.Password
+	} else {
+		var usernameOk bool
+		username, usernameOk = common.NormalizeUsername(request.Username)
+		if !usernameOk {
+			return nil, errInvalidUsername
+		}
+	}
+
+	srv, err := s.client.ImportInvite(
+		ctx,
+		bundle,
+		request.Name,
+		username,
+		password,
+	)
+	if err != nil {
+		// Certificate and authentication errors are converted by RpcErrorInterceptor.
+		return nil, err
+	}
+
+	return &v1.ImportInviteBundleResponse{
+		Server:       s.serverToInfo(srv),
+		FailedShares: s.client.addTemplateShares(ctx, srv, template),
+	}, nil
+}
+
+// accountTemplateToPb converts an account template to its RPC representation, leaving out the password.
+func accountTemplateToPb(template AccountTemplate) *v1.AccountTemplate {
+	shares := make([]*v1.AccountTemplateShare, len(template.Shares))
+	for i, templateShare := range template.Shares {
+		shares[i] = &v1.AccountTemplateShare{
+			Name:        templateShare.Name,
+			Path:        templateShare.Path,
+			FollowLinks: templateShare.FollowLinks,
+		}
+	}
+
+	return &v1.AccountTemplate{
+		Uuid:      template.Uuid,
+		Name:      template.Name,
+		Username:  template.Username.String(),
+		Shares:    shares,
+		CreatedTs: template.CreatedTs.Unix(),
+	}
+}
+
+func (s *RpcServer) CreateAccountTemplate(ctx context.Context, request *v1.CreateAccountTemplateRequest) (*v1.CreateAccountTemplateResponse, error) {
+	username, usernameOk := common.NormalizeUsername(request.Username)
+	if !usernameOk {
+		return nil, errInvalidUsername
+	}
+
+	shares := make([]storage.AccountTemplateShareRecord, len(request.Shares))
+	for i, templateShare := range request.Shares {
+		if err := share.ValidateShareName(templateShare.Name); err != nil {
+			return nil, errInvalidShareName
+		}
+		shares[i] = storage.AccountTemplateShareRecord{
+			Name:        templateShare.Name,
+			Path:        templateShare.Path,
+			FollowLinks: templateShare.FollowLinks,
+		}
+	}
+
+	uuid, err := s.storage.CreateAccountTemplate(ctx, request.Name, username, request.Password, shares)
+	if err != nil {
+		return nil, err
+	}
+	template, err := s.client.GetAccountTemplate(ctx, uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateAccountTemplateResponse{
+		Template: accountTemplateToPb(template),
+	}, nil
+}
+
+func (s *RpcServer) CreateAccountTemplateFromServer(ctx context.Context, request *v1.CreateAccountTemplateFromServerRequest) (*v1.CreateAccountTemplateFromServerResponse, error) {
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return nil, errServerNotFound
+	}
+
+	template, err := s.client.CreateAccountTemplateFromServer(ctx, srv, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateAccountTemplateFromServerResponse{
+		Template: accountTemplateToPb(template),
+	}, nil
+}
+
+func (s *RpcServer) GetAccountTemplates(ctx context.Context, _ *v1.GetAccountTemplatesRequest) (*v1.GetAccountTemplatesResponse, error) {
+	templates, err := s.client.GetAccountTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	pbTemplates := make([]*v1.AccountTemplate, len(templates))
+	for i, template := range templates {
+		pbTemplates[i] = accountTemplateToPb(template)
+	}
+
+	return &v1.GetAccountTemplatesResponse{
+		Templates: pbTemplates,
+	}, nil
+}
+
+func (s *RpcServer) DeleteAccountTemplate(ctx context.Context, request *v1.DeleteAccountTemplateRequest) (*v1.DeleteAccountTemplateResponse, error) {
+	has, err := s.storage.DeleteAccountTemplate(ctx, request.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errAccountTemplateNotFound
+	}
+
+	return &v1.DeleteAccountTemplateResponse{}, nil
+}
+
+// hostStatusToPb converts the status of a hosted room to its RPC representation.
+func hostStatusToPb(status HostStatus) *v1.HostingInfo {
+	if !status.Hosting {
+		return &v1.HostingInfo{}
+	}
+
+	return &v1.HostingInfo{
+		Hosting:         true,
+		Room:            status.Room.String(),
+		Port:            uint32(status.Port),
+		CertFingerprint: status.CertFingerprint,
+		ServerUuid:      status.ServerUuid,
+		OnlineClients:   uint32(status.OnlineClients),
+		StartTs:         status.StartTs.Unix(),
+	}
+}
+
+func (s *RpcServer) StartHosting(ctx context.Context, request *v1.StartHostingRequest) (*v1.StartHostingResponse, error) {
+	roomName, roomOk := common.NormalizeRoomName(request.Room)
+	if !roomOk {
+		return nil, errInvalidRoomName
+	}
+	username, usernameOk := common.NormalizeUsername(request.Username)
+	if !usernameOk {
+		return nil, errInvalidUsername
+	}
+	if request.Port > 65535 {
+		return nil, errInvalidHostPort
+	}
+
+	status, err := s.host.Start(ctx, roomName, uint16(request.Port), username)
+	if err != nil {
+		if errors.Is(err, ErrAlreadyHosting) {
+			return nil, errAlreadyHosting
+		}
+		return nil, err
+	}
+
+	return &v1.StartHostingResponse{
+		Info: hostStatusToPb(status),
+	}, nil
+}
+
+func (s *RpcServer) StopHosting(ctx context.Context, _ *v1.StopHostingRequest) (*v1.StopHostingResponse, error) {
+	if err := s.host.Stop(ctx); err != nil {
+		if errors.Is(err, ErrNotHosting) {
+			return nil, errNotHosting
+		}
+		return nil, err
+	}
+
+	return &v1.StopHostingResponse{}, nil
+}
+
+func (s *RpcServer) GetHostingInfo(_ context.Context, _ *v1.GetHostingInfoRequest) (*v1.GetHostingInfoResponse, error) {
+	return &v1.GetHostingInfoResponse{
+		Info: hostStatusToPb(s.host.Status()),
+	}, nil
+}
+
+func (s *RpcServer) CreateHostingInvite(ctx context.Context, request *v1.CreateHostingInviteRequest) (*v1.CreateHostingInviteResponse, error) {
+	address := strings.TrimSpace(request.Address)
+	if address == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("address is required"))
+	}
+
+	inviteUrl, err := s.host.CreateInvite(ctx, address)
+	if err != nil {
+		if errors.Is(err, ErrNotHosting) {
+			return nil, errNotHosting
+		}
+		return nil, err
+	}
+
+	return &v1.CreateHostingInviteResponse{
+		Url: inviteUrl,
+	}, nil
+}
+
 func (s *RpcServer) DeleteServer(ctx context.Context, request *v1.DeleteServerRequest) (*v1.DeleteServerResponse, error) {
 	_, has := s.client.GetByUuid(request.Uuid)
 	if !has {
@@ -342,7 +857,11 @@ func (s *RpcServer) GetShares(ctx context.Context, request *v1.GetSharesRequest)
 		return nil, errServerNotFound
 	}
 
-	records, err := s.client.storage.GetSharesByServer(ctx, request.ServerUuid)
+	records, next, err := s.client.storage.GetSharesByServerPage(ctx, request.ServerUuid, request.Cursor, common.RpcPageLimit(request.Limit))
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.client.storage.CountSharesByServer(ctx, request.ServerUuid)
 	if err != nil {
 		return nil, err
 	}
@@ -353,7 +872,9 @@ func (s *RpcServer) GetShares(ctx context.Context, request *v1.GetSharesRequest)
 	}
 
 	return &v1.GetSharesResponse{
-		Shares: infos,
+		Shares:     infos,
+		NextCursor: next,
+		Total:      uint32(total),
 	}, nil
 }
 
@@ -363,11 +884,57 @@ func (s *RpcServer) CreateShare(ctx context.Context, request *v1.CreateShareRequ
 		return nil, errServerNotFound
 	}
 
-	_, err := srv.ShareMgr.Add(ctx, request.Name, request.Path, request.FollowLinks)
+	var rule share.ConflictRule
+	switch request.ConflictRule {
+	case v1.ShareConflictRule_SHARE_CONFLICT_RULE_UNSPECIFIED, v1.ShareConflictRule_SHARE_CONFLICT_RULE_FIRST:
+		rule = share.ConflictRuleFirst
+	case v1.ShareConflictRule_SHARE_CONFLICT_RULE_NEWEST:
+		rule = share.ConflictRuleNewest
+	default:
+		return nil, errInvalidConflictRule
+	}
+
+	var form common.UnicodeForm
+	switch request.UnicodeForm {
+	case v1.ShareUnicodeForm_SHARE_UNICODE_FORM_UNSPECIFIED, v1.ShareUnicodeForm_SHARE_UNICODE_FORM_NONE:
+		form = common.UnicodeFormNone
+	case v1.ShareUnicodeForm_SHARE_UNICODE_FORM_NFC:
+		form = common.UnicodeFormNFC
+	case v1.ShareUnicodeForm_SHARE_UNICODE_FORM_NFD:
+		form = common.UnicodeFormNFD
+	default:
+		return nil, errInvalidUnicodeForm
+	}
+
+	mounts := make([]share.DirMount, len(request.Mounts))
+	for i, mount := range request.Mounts {
+		virtualPath, err := common.NormalizePath(mount.VirtualPath)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf(`%w: %w`, share.ErrInvalidMount, err))
+		}
+		mounts[i] = share.DirMount{
+			VirtualPath: virtualPath,
+			Dir:         mount.Path,
+		}
+	}
+
+	_, err := srv.ShareMgr.AddWithOptions(ctx, request.Name, request.Path, share.ShareOptions{
+		FollowLinks:  request.FollowLinks,
+		Mounts:       mounts,
+		ConflictRule: rule,
+		Excludes:     request.ExcludePatterns,
+		PathMatch: common.PathMatchOptions{
+			CaseInsensitive: request.CaseInsensitive,
+			Form:            form,
+		},
+	})
 	if err != nil {
 		if errors.Is(err, share.ErrInvalidShareName) {
 			return nil, errInvalidShareName
 		}
+		if errors.Is(err, share.ErrInvalidMount) || errors.Is(err, share.ErrInvalidExcludePattern) || errors.Is(err, share.ErrInvalidPathMatch) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
 
 		return nil, err
 	}
@@ -404,44 +971,249 @@ func (s *RpcServer) DeleteShare(ctx context.Context, request *v1.DeleteShareRequ
 
 	return &v1.DeleteShareResponse{}, nil
 }
+func (s *RpcServer) SetShareExcludePatterns(ctx context.Context, request *v1.SetShareExcludePatternsRequest) (*v1.SetShareExcludePatternsResponse, error) {
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return nil, errServerNotFound
+	}
 
-func (s *RpcServer) GetDirFiles(ctx context.Context, request *v1.GetDirFilesRequest, res *connect.ServerStream[v1.GetDirFilesResponse]) error {
-	username, usernameOk := common.NormalizeUsername(request.Username)
-	if !usernameOk {
-		return errInvalidUsername
+	has, err := srv.ShareMgr.SetExcludes(ctx, request.Name, request.ExcludePatterns)
+	if err != nil {
+		if errors.Is(err, share.ErrInvalidExcludePattern) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, err
+	}
+	if !has {
+		return nil, errShareNotFound
 	}
 
-	path, pathErr := common.ValidatePath(request.Path)
-	if pathErr != nil {
-		return connect.NewError(connect.CodeInvalidArgument, pathErr)
+	record, has, err := s.client.storage.GetShareByServerUuidAndName(ctx, request.ServerUuid, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errShareNotFound
 	}
 
+	return &v1.SetShareExcludePatternsResponse{
+		Share: s.shareRecToInfo(record),
+	}, nil
+}
+func (s *RpcServer) CheckShareHealth(ctx context.Context, request *v1.CheckShareHealthRequest) (*v1.CheckShareHealthResponse, error) {
 	srv, has := s.client.GetByUuid(request.ServerUuid)
 	if !has {
-		return errServerNotFound
+		return nil, errServerNotFound
 	}
 
-	return srv.Do(ctx, func(ctx context.Context, c *room.Conn) error {
-		peer := c.GetVirtualC2cConn(username, false)
-		stream, err := peer.GetDirFiles(path)
-		if err != nil {
-			return err
-		}
-		defer func() {
-			_ = stream.Close()
-		}()
-
-		for {
-			var msg *pb.MsgDirFiles
-			msg, err = stream.ReadNext()
-			if err != nil {
-				if errors.Is(err, io.EOF) {
-					break
-				}
+	var maxIssues int
+	if request.MaxIssues != nil {
+		maxIssues = int(min(*request.MaxIssues, share.MaxHealthIssues))
+	}
 
-				if protoMsgErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok {
-					if protoMsgErr.Msg.Type == pb.ErrType_ERR_TYPE_PATH_NOT_DIRECTORY {
-						return errPathNotDir
+	report, has, err := srv.ShareMgr.CheckHealth(ctx, request.Name, maxIssues)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errShareNotFound
+	}
+
+	issues := make([]*v1.ShareHealthIssue, len(report.Issues))
+	for i, issue := range report.Issues {
+		var msg *string
+		if issue.Err != nil {
+			msg = new(issue.Err.Error())
+		}
+		issues[i] = &v1.ShareHealthIssue{
+			Path:    issue.Path,
+			Kind:    healthIssueKindToPb(issue.Kind),
+			Message: msg,
+		}
+	}
+
+	return &v1.CheckShareHealthResponse{
+		Issues:    issues,
+		Truncated: report.Truncated,
+	}, nil
+}
+func (s *RpcServer) ImportShares(ctx context.Context, request *v1.ImportSharesRequest) (*v1.ImportSharesResponse, error) {
+	pbEntries := request.Entries
+	if request.ManifestPath != nil {
+		if len(request.Entries) > 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("entries and manifest path cannot both be set"))
+		}
+
+		data, err := os.ReadFile(*request.ManifestPath)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf(`failed to read share manifest: %w`, err))
+		}
+		var manifest v1.ShareManifest
+		if err = protojson.Unmarshal(data, &manifest); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf(`failed to parse share manifest: %w`, err))
+		}
+		pbEntries = manifest.Shares
+	} else if len(request.Entries) == 0 {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("entries or manifest path must be set"))
+	}
+
+	entries := make([]ShareImportEntry, len(pbEntries))
+	for i, entry := range pbEntries {
+		entries[i] = ShareImportEntry{
+			ServerUuid:  entry.ServerUuid,
+			Name:        entry.Name,
+			Path:        entry.Path,
+			FollowLinks: entry.FollowLinks,
+		}
+	}
+
+	results, imported, err := s.client.ImportShares(ctx, entries, request.DryRun)
+	if err != nil {
+		if errors.Is(err, ErrTooManyShareImportEntries) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, err
+	}
+
+	pbResults := make([]*v1.ShareImportResult, len(results))
+	for i, result := range results {
+		pbResult := &v1.ShareImportResult{
+			Entry: pbEntries[i],
+		}
+		if result.Err != nil {
+			pbResult.Error = new(result.Err.Error())
+		}
+		if result.Created {
+			record, has, recErr := s.storage.GetShareByServerUuidAndName(ctx, entries[i].ServerUuid, entries[i].Name)
+			if recErr != nil {
+				return nil, recErr
+			}
+			if has {
+				pbResult.Share = s.shareRecToInfo(record)
+			}
+		}
+		pbResults[i] = pbResult
+	}
+
+	return &v1.ImportSharesResponse{
+		Results:  pbResults,
+		Imported: imported,
+	}, nil
+}
+
+// getShareRecord returns the record of a share on a server.
+// Returns a connect error if the server or share does not exist.
+func (s *RpcServer) getShareRecord(ctx context.Context, serverUuid string, name string) (storage.ShareRecord, error) {
+	if _, has := s.client.GetByUuid(serverUuid); !has {
+		return storage.ShareRecord{}, errServerNotFound
+	}
+
+	record, has, err := s.storage.GetShareByServerUuidAndName(ctx, serverUuid, name)
+	if err != nil {
+		return record, err
+	}
+	if !has {
+		return record, errShareNotFound
+	}
+	return record, nil
+}
+
+func (s *RpcServer) CreateShareLink(ctx context.Context, request *v1.CreateShareLinkRequest) (*v1.CreateShareLinkResponse, error) {
+	shareRecord, err := s.getShareRecord(ctx, request.ServerUuid, request.ShareName)
+	if err != nil {
+		return nil, err
+	}
+
+	path, err := common.ValidatePath(request.Path)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	var expiresIn time.Duration
+	if request.ExpiresInSeconds != nil {
+		if *request.ExpiresInSeconds == 0 {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("link expiry must be positive if set"))
+		}
+		expiresIn = time.Duration(*request.ExpiresInSeconds) * time.Second
+	}
+
+	link, err := s.shareGateway.CreateLink(ctx, shareRecord, path, expiresIn)
+	if err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return nil, errFileNotFound
+		}
+		return nil, err
+	}
+
+	return &v1.CreateShareLinkResponse{
+		Link: s.shareLinkRecToInfo(link, shareRecord),
+	}, nil
+}
+
+func (s *RpcServer) GetShareLinks(ctx context.Context, request *v1.GetShareLinksRequest) (*v1.GetShareLinksResponse, error) {
+	shareRecord, err := s.getShareRecord(ctx, request.ServerUuid, request.ShareName)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := s.storage.GetShareLinksByShare(ctx, shareRecord.Uuid)
+	if err != nil {
+		return nil, err
+	}
+
+	links := make([]*v1.ShareLinkInfo, len(records))
+	for i, record := range records {
+		links[i] = s.shareLinkRecToInfo(record, shareRecord)
+	}
+
+	return &v1.GetShareLinksResponse{
+		Links: links,
+	}, nil
+}
+
+func (s *RpcServer) DeleteShareLink(ctx context.Context, request *v1.DeleteShareLinkRequest) (*v1.DeleteShareLinkResponse, error) {
+	has, err := s.storage.DeleteShareLink(ctx, request.Token)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errShareLinkNotFound
+	}
+
+	return &v1.DeleteShareLinkResponse{}, nil
+}
+
+func (s *RpcServer) GetDirFiles(ctx context.Context, request *v1.GetDirFilesRequest, res *connect.ServerStream[v1.GetDirFilesResponse]) error {
+	username, usernameOk := common.NormalizeUsername(request.Username)
+	if !usernameOk {
+		return errInvalidUsername
+	}
+
+	path, pathErr := common.ValidatePath(request.Path)
+	if pathErr != nil {
+		return connect.NewError(connect.CodeInvalidArgument, pathErr)
+	}
+
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return errServerNotFound
+	}
+
+	return srv.Do(ctx, func(ctx context.Context, c *room.Conn) error {
+		peer := c.GetVirtualC2cConn(username, false)
+		stream, err := peer.GetDirFilesContext(ctx, path)
+		if err != nil {
+			return err
+		}
+		defer func() {
+			_ = stream.Close()
+		}()
+
+		for msg, err := range protocol.All(ctx, stream) {
+			if err != nil {
+				if protoMsgErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok {
+					if protoMsgErr.Msg.Type == pb.ErrType_ERR_TYPE_PATH_NOT_DIRECTORY {
+						return errPathNotDir
 					}
 					if protoMsgErr.Msg.Type == pb.ErrType_ERR_TYPE_FILE_NOT_EXIST {
 						return errFileNotFound
@@ -468,6 +1240,75 @@ func (s *RpcServer) GetDirFiles(ctx context.Context, request *v1.GetDirFilesRequ
 	})
 }
 
+func (s *RpcServer) StreamDirArchive(ctx context.Context, request *v1.StreamDirArchiveRequest, res *connect.ServerStream[v1.StreamDirArchiveResponse]) error {
+	username, usernameOk := common.NormalizeUsername(request.Username)
+	if !usernameOk {
+		return errInvalidUsername
+	}
+
+	path, pathErr := common.ValidatePath(request.Path)
+	if pathErr != nil {
+		return connect.NewError(connect.CodeInvalidArgument, pathErr)
+	}
+
+	var format ArchiveFormat
+	switch request.Format {
+	case v1.ArchiveFormat_ARCHIVE_FORMAT_ZIP:
+		format = ArchiveFormatZip
+	case v1.ArchiveFormat_ARCHIVE_FORMAT_TAR_GZ:
+		format = ArchiveFormatTarGz
+	default:
+		return connect.NewError(connect.CodeInvalidArgument, errors.New("archive format must be specified"))
+	}
+
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return errServerNotFound
+	}
+
+	return srv.Do(ctx, func(ctx context.Context, c *room.Conn) error {
+		peer := c.GetVirtualC2cConn(username, false)
+
+		meta, err := peer.GetFileMetaContext(ctx, path)
+		if err != nil {
+			if protoMsgErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok {
+				if protoMsgErr.Msg.Type == pb.ErrType_ERR_TYPE_FILE_NOT_EXIST {
+					return errFileNotFound
+				}
+			}
+
+			return err
+		}
+		if !meta.IsDir {
+			return errPathNotDir
+		}
+
+		// Buffer writes so that each message carries a reasonably sized chunk.
+		bw := bufio.NewWriterSize(archiveStreamWriter{res: res}, 64*1024)
+		err = WritePeerDirArchive(ctx, peer, path, format, bw)
+		if err != nil {
+			return err
+		}
+		return bw.Flush()
+	})
+}
+
+// archiveStreamWriter is an io.Writer that sends written data as StreamDirArchive messages.
+type archiveStreamWriter struct {
+	res *connect.ServerStream[v1.StreamDirArchiveResponse]
+}
+
+func (w archiveStreamWriter) Write(p []byte) (int, error) {
+	// The data is copied because the buffer backing p is reused by the caller.
+	err := w.res.Send(&v1.StreamDirArchiveResponse{
+		Data: bytes.Clone(p),
+	})
+	if err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
 func (s *RpcServer) GetFileMeta(ctx context.Context, request *v1.GetFileMetaRequest) (*v1.GetFileMetaResponse, error) {
 	username, usernameOk := common.NormalizeUsername(request.Username)
 	if !usernameOk {
@@ -486,7 +1327,7 @@ func (s *RpcServer) GetFileMeta(ctx context.Context, request *v1.GetFileMetaRequ
 
 	return DoValue(srv.ConnNanny, ctx, func(ctx context.Context, c *room.Conn) (*v1.GetFileMetaResponse, error) {
 		peer := c.GetVirtualC2cConn(username, false)
-		meta, err := peer.GetFileMeta(path)
+		meta, err := peer.GetFileMetaContext(ctx, path)
 		if err != nil {
 			if protoMsgErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok {
 				if protoMsgErr.Msg.Type == pb.ErrType_ERR_TYPE_FILE_NOT_EXIST {
@@ -503,12 +1344,202 @@ func (s *RpcServer) GetFileMeta(ctx context.Context, request *v1.GetFileMetaRequ
 	})
 }
 
+func (s *RpcServer) CreateFileLink(ctx context.Context, request *v1.CreateFileLinkRequest) (*v1.CreateFileLinkResponse, error) {
+	path, pathErr := common.ValidatePath(request.Path)
+	if pathErr != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, pathErr)
+	}
+
+	ttl := DefaultFileLinkTtl
+	if request.ExpiresInSeconds != nil {
+		ttl = time.Duration(*request.ExpiresInSeconds) * time.Second
+		if ttl <= 0 || ttl > MaxFileLinkTtl {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("link expiry must be between 1 and %d seconds", int(MaxFileLinkTtl.Seconds())))
+		}
+	}
+
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return nil, errServerNotFound
+	}
+
+	return DoValue(srv.ConnNanny, ctx, func(ctx context.Context, c *room.Conn) (*v1.CreateFileLinkResponse, error) {
+		username := c.Username
+		if request.Username != nil {
+			var usernameOk bool
+			username, usernameOk = common.NormalizeUsername(*request.Username)
+			if !usernameOk {
+				return nil, errInvalidUsername
+			}
+		}
+
+		meta, err := c.GetVirtualC2cConn(username, false).GetFileMetaContext(ctx, path)
+		if err != nil {
+			if protoMsgErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok {
+				if protoMsgErr.Msg.Type == pb.ErrType_ERR_TYPE_FILE_NOT_EXIST {
+					return nil, errFileNotFound
+				}
+			}
+
+			return nil, err
+		}
+		if meta.IsDir {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("links can only point to files"))
+		}
+
+		token, expiresTs := s.fileLinks.Create(request.ServerUuid, username, path, ttl)
+
+		return &v1.CreateFileLinkResponse{
+			Token:     token,
+			Path:      "/content/" + FileLinkPathToken + "/" + token,
+			ExpiresTs: expiresTs.Unix(),
+		}, nil
+	})
+}
+
+func (s *RpcServer) MeasurePeer(ctx context.Context, request *v1.MeasurePeerRequest) (*v1.MeasurePeerResponse, error) {
+	const defaultPings = 5
+	const maxPings = 100
+	const defaultThroughputBytes = 4 * 1024 * 1024
+	const maxThroughputBytes = 64 * 1024 * 1024
+
+	username, usernameOk := common.NormalizeUsername(request.Username)
+	if !usernameOk {
+		return nil, errInvalidUsername
+	}
+
+	pings := min(int(request.GetPings()), maxPings)
+	if request.Pings == nil {
+		pings = defaultPings
+	}
+	throughputBytes := int64(min(request.GetThroughputBytes(), maxThroughputBytes))
+	if request.ThroughputBytes == nil {
+		throughputBytes = defaultThroughputBytes
+	}
+
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return nil, errServerNotFound
+	}
+
+	return DoValue(srv.ConnNanny, ctx, func(ctx context.Context, c *room.Conn) (*v1.MeasurePeerResponse, error) {
+		var conn protocol.ProtoConn
+		var path v1.PeerPath
+		if request.Path == v1.PeerPath_PEER_PATH_PROXY {
+			conn = c.GetVirtualC2cConn(username, true)
+			path = v1.PeerPath_PEER_PATH_PROXY
+		} else {
+			// Send an echo the normal way first so that a direct connection is established if one is possible.
+			_, err := c.GetVirtualC2cConn(username, false).SendAndReceiveContext(ctx, pb.MsgType_MSG_TYPE_MEASURE, &pb.MsgMeasure{})
+			if err != nil {
+				if errors.Is(err, protocol.ErrPeerUnreachable) {
+					return nil, connect.NewError(connect.CodeUnavailable, err)
+				}
+				return nil, err
+			}
+
+			if directConns := c.GetDirectConns(username); len(directConns) > 0 {
+				conn = directConns[0]
+				path = v1.PeerPath_PEER_PATH_DIRECT
+			} else if request.Path == v1.PeerPath_PEER_PATH_DIRECT {
+				return nil, errNoDirectConn
+			} else {
+				conn = c.GetVirtualC2cConn(username, true)
+				path = v1.PeerPath_PEER_PATH_PROXY
+			}
+		}
+
+		res, err := room.MeasureConn(ctx, conn, pings, throughputBytes)
+		if err != nil {
+			if errors.Is(err, protocol.ErrPeerUnreachable) {
+				return nil, connect.NewError(connect.CodeUnavailable, err)
+			}
+			return nil, err
+		}
+
+		return &v1.MeasurePeerResponse{
+			Path:         path,
+			LatencyMinUs: res.LatencyMin.Microseconds(),
+			LatencyAvgUs: res.LatencyAvg.Microseconds(),
+			LatencyMaxUs: res.LatencyMax.Microseconds(),
+			DownloadBps:  res.DownloadBps,
+			UploadBps:    res.UploadBps,
+		}, nil
+	})
+}
+
+func (s *RpcServer) Diagnose(ctx context.Context, request *v1.DiagnoseRequest) (*v1.DiagnoseResponse, error) {
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return nil, errServerNotFound
+	}
+
+	steps := srv.ConnNanny.Diagnose(ctx)
+
+	results := make([]*v1.DiagnosticResult, len(steps))
+	for i, step := range steps {
+		result := &v1.DiagnosticResult{
+			Step:       diagnosticStepToPb(step.Kind),
+			Status:     diagnosticStatusToPb(step.Status),
+			Detail:     step.Detail,
+			DurationUs: step.Duration.Microseconds(),
+		}
+		if step.Err != nil {
+			result.Error = new(step.Err.Error())
+		}
+		results[i] = result
+	}
+
+	return &v1.DiagnoseResponse{
+		Results: results,
+	}, nil
+}
+
+// diagnosticStepToPb converts a diagnostic step kind to its RPC representation.
+func diagnosticStepToPb(kind room.DiagnosticStepKind) v1.DiagnosticStep {
+	switch kind {
+	case room.DiagnosticStepResolve:
+		return v1.DiagnosticStep_DIAGNOSTIC_STEP_RESOLVE
+	case room.DiagnosticStepUdpProbe:
+		return v1.DiagnosticStep_DIAGNOSTIC_STEP_UDP_PROBE
+	case room.DiagnosticStepQuicHandshake:
+		return v1.DiagnosticStep_DIAGNOSTIC_STEP_QUIC_HANDSHAKE
+	case room.DiagnosticStepVersion:
+		return v1.DiagnosticStep_DIAGNOSTIC_STEP_VERSION_NEGOTIATION
+	case room.DiagnosticStepAuth:
+		return v1.DiagnosticStep_DIAGNOSTIC_STEP_AUTHENTICATION
+	default:
+		return v1.DiagnosticStep_DIAGNOSTIC_STEP_UNSPECIFIED
+	}
+}
+
+// diagnosticStatusToPb converts a diagnostic status to its RPC representation.
+func diagnosticStatusToPb(status room.DiagnosticStatus) v1.DiagnosticStatus {
+	switch status {
+	case room.DiagnosticStatusOk:
+		return v1.DiagnosticStatus_DIAGNOSTIC_STATUS_OK
+	case room.DiagnosticStatusFailed:
+		return v1.DiagnosticStatus_DIAGNOSTIC_STATUS_FAILED
+	case room.DiagnosticStatusInconclusive:
+		return v1.DiagnosticStatus_DIAGNOSTIC_STATUS_INCONCLUSIVE
+	case room.DiagnosticStatusSkipped:
+		return v1.DiagnosticStatus_DIAGNOSTIC_STATUS_SKIPPED
+	default:
+		return v1.DiagnosticStatus_DIAGNOSTIC_STATUS_UNSPECIFIED
+	}
+}
+
 func (s *RpcServer) GetOnlineUsers(ctx context.Context, request *v1.GetOnlineUsersRequest, res *connect.ServerStream[v1.GetOnlineUsersResponse]) error {
 	srv, has := s.client.GetByUuid(request.ServerUuid)
 	if !has {
 		return errServerNotFound
 	}
 
+	friends, err := s.getFriends(ctx, srv.Uuid)
+	if err != nil {
+		return err
+	}
+
 	return srv.Do(ctx, func(ctx context.Context, c *room.Conn) error {
 		stream, err := c.GetOnlineUsers()
 		if err != nil {
@@ -518,21 +1549,21 @@ func (s *RpcServer) GetOnlineUsers(ctx context.Context, request *v1.GetOnlineUse
 			_ = stream.Close()
 		}()
 
-		for {
-			var msg *pb.MsgOnlineUsers
-			msg, err = stream.ReadNext()
+		for msg, err := range protocol.All(ctx, stream) {
 			if err != nil {
-				if errors.Is(err, io.EOF) {
-					break
-				}
-
 				return err
 			}
 
 			users := make([]*v1.OnlineUserInfo, len(msg.Users))
 			for i, user := range msg.Users {
+				username := common.UncheckedCreateNormalizedUsername(user.Username)
 				users[i] = &v1.OnlineUserInfo{
 					Username: user.Username,
+					Friend:   friends[user.Username],
+					Blocked:  srv.BlockList.Has(username),
+				}
+				if rtt, has := c.DirectRttStats(username); has {
+					users[i].DirectRtt = rttStatsToPb(rtt)
 				}
 			}
 			err = res.Send(&v1.GetOnlineUsersResponse{
@@ -735,6 +1766,11 @@ func (s *RpcServer) StreamSearch(ctx context.Context, request *v1.StreamSearchRe
 		return errServerNotFound
 	}
 
+	friends, err := s.getFriends(ctx, srv.Uuid)
+	if err != nil {
+		return err
+	}
+
 	return srv.Do(ctx, func(ctx context.Context, c *room.Conn) error {
 		if request.Username == nil {
 			// Stream from server.
@@ -746,12 +1782,16 @@ func (s *RpcServer) StreamSearch(ctx context.Context, request *v1.StreamSearchRe
 				_ = stream.Close()
 			}()
 
-			for {
-				next, nextErr := stream.ReadNext()
+			for next, nextErr := range protocol.All(ctx, stream) {
 				if nextErr != nil {
 					if protocol.IsErrorConnCloseOrCancel(nextErr) {
 						return nil
 					}
+					return nextErr
+				}
+
+				if srv.BlockList.Has(common.UncheckedCreateNormalizedUsername(next.Username)) {
+					continue
 				}
 
 				err = conn.Send(&v1.StreamSearchResponse{
@@ -759,6 +1799,7 @@ func (s *RpcServer) StreamSearch(ctx context.Context, request *v1.StreamSearchRe
 					DirectoryPath: next.Result.DirectoryPath,
 					File:          s.metaToInfo(next.Result.File),
 					Snippet:       next.Result.Snippet,
+					Friend:        friends[next.Username],
 				})
 				if err != nil {
 					if protocol.IsErrorConnCloseOrCancel(err) {
@@ -767,16 +1808,21 @@ func (s *RpcServer) StreamSearch(ctx context.Context, request *v1.StreamSearchRe
 					return err
 				}
 			}
+
+			return nil
 		} else {
 			// Stream from client.
 			username, usernameOk := common.NormalizeUsername(*request.Username)
 			if !usernameOk {
 				return errInvalidUsername
 			}
+			if srv.BlockList.Has(username) {
+				return nil
+			}
 
 			peer := c.GetVirtualC2cConn(username, false)
 
-			stream, err := peer.Search(request.Query)
+			stream, err := peer.SearchContext(ctx, request.Query)
 			if err != nil {
 				return err
 			}
@@ -784,12 +1830,12 @@ func (s *RpcServer) StreamSearch(ctx context.Context, request *v1.StreamSearchRe
 				_ = stream.Close()
 			}()
 
-			for {
-				next, nextErr := stream.ReadNext()
+			for next, nextErr := range protocol.All(ctx, stream) {
 				if nextErr != nil {
 					if protocol.IsErrorConnCloseOrCancel(nextErr) {
 						return nil
 					}
+					return nextErr
 				}
 
 				err = conn.Send(&v1.StreamSearchResponse{
@@ -797,6 +1843,7 @@ func (s *RpcServer) StreamSearch(ctx context.Context, request *v1.StreamSearchRe
 					DirectoryPath: next.DirectoryPath,
 					File:          s.metaToInfo(next.File),
 					Snippet:       next.Snippet,
+					Friend:        friends[peer.Username.String()],
 				})
 				if err != nil {
 					if protocol.IsErrorConnCloseOrCancel(err) {
@@ -805,6 +1852,8 @@ func (s *RpcServer) StreamSearch(ctx context.Context, request *v1.StreamSearchRe
 					return err
 				}
 			}
+
+			return nil
 		}
 	})
 }
@@ -822,6 +1871,7 @@ func (s *RpcServer) updateToInfo(update *updater.UpdateInfo, updateErr error) *v
 			Version:     update.Version,
 			Description: update.Description,
 			Url:         update.Url,
+			CanApply:    s.selfUpdater.CanApply(*update),
 		}
 	}
 
@@ -830,8 +1880,9 @@ func (s *RpcServer) updateToInfo(update *updater.UpdateInfo, updateErr error) *v
 
 func (s *RpcServer) GetUpdateInfo(_ context.Context, _ *v1.GetUpdateInfoRequest) (*v1.GetUpdateInfoResponse, error) {
 	return &v1.GetUpdateInfoResponse{
-		CurrentInfo: s.updateToInfo(&s.updateChecker.CurrentUpdate, nil),
-		NewInfo:     s.updateToInfo(s.updateChecker.GetNewUpdate()),
+		CurrentInfo:  s.updateToInfo(&s.updateChecker.CurrentUpdate, nil),
+		NewInfo:      s.updateToInfo(s.updateChecker.GetNewUpdate()),
+		UpdateStaged: s.selfUpdater.IsStaged(),
 	}, nil
 }
 
@@ -841,13 +1892,78 @@ func (s *RpcServer) CheckForNewUpdate(_ context.Context, _ *v1.CheckForNewUpdate
 	}, nil
 }
 
-func (s *RpcServer) GetDownloadManagerItems(_ context.Context, _ *v1.GetDownloadManagerItemsRequest) (*v1.GetDownloadManagerItemsResponse, error) {
+func (s *RpcServer) ApplyUpdate(ctx context.Context, _ *v1.ApplyUpdateRequest) (*v1.ApplyUpdateResponse, error) {
+	update, err := s.selfUpdater.Apply(ctx)
+	if err != nil {
+		if errors.Is(err, ErrSelfUpdateDisabled) ||
+			errors.Is(err, ErrNoNewUpdate) ||
+			errors.Is(err, updater.ErrInvalidSignature) ||
+			errors.Is(err, updater.ErrNoAsset) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		if errors.Is(err, updater.ErrChecksumMismatch) {
+			return nil, connect.NewError(connect.CodeDataLoss, err)
+		}
+		return nil, err
+	}
+
+	return &v1.ApplyUpdateResponse{
+		Info: s.updateToInfo(&update, nil),
+	}, nil
+}
+
+func (s *RpcServer) GetUpdateSettings(ctx context.Context, _ *v1.GetUpdateSettingsRequest) (*v1.GetUpdateSettingsResponse, error) {
+	channel, baseUrl, err := getUpdateSettings(ctx, s.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetUpdateSettingsResponse{
+		Settings: &v1.UpdateSettings{
+			Channel: updateChannelToPb(channel),
+			BaseUrl: baseUrl,
+		},
+	}, nil
+}
+
+func (s *RpcServer) UpdateUpdateSettings(ctx context.Context, request *v1.UpdateUpdateSettingsRequest) (*v1.UpdateUpdateSettingsResponse, error) {
+	settings := request.Settings
+
+	channel, ok := updateChannelFromPb(settings.Channel)
+	if !ok {
+		return nil, connect.NewError(connect.CodeInvalidArgument, updater.ErrInvalidChannel)
+	}
+	if settings.BaseUrl != "" {
+		if err := validateUpdateBaseUrl(settings.BaseUrl); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	err := s.storage.PutSetting(ctx, UpdateChannelSetting, string(channel))
+	if err != nil {
+		return nil, err
+	}
+	err = s.storage.PutSetting(ctx, UpdateBaseUrlSetting, settings.BaseUrl)
+	if err != nil {
+		return nil, err
+	}
+
+	checkUrl, err := UpdateCheckUrl(ctx, s.storage)
+	if err != nil {
+		return nil, err
+	}
+	s.updateChecker.SetBaseUrl(checkUrl)
+
+	return &v1.UpdateUpdateSettingsResponse{}, nil
+}
+
+func (s *RpcServer) GetDownloadManagerItems(_ context.Context, _ *v1.GetDownloadManagerItemsRequest) (*v1.GetDownloadManagerItemsResponse, error) {
 	return &v1.GetDownloadManagerItemsResponse{
 		Items: s.downloadManager.SnapshotStates(),
 	}, nil
 }
 
-func (s *RpcServer) QueueFileDownload(_ context.Context, request *v1.QueueFileDownloadRequest) (*v1.QueueFileDownloadResponse, error) {
+func (s *RpcServer) QueueFileDownload(ctx context.Context, request *v1.QueueFileDownloadRequest) (*v1.QueueFileDownloadResponse, error) {
 	srv, has := s.client.GetByUuid(request.ServerUuid)
 	if !has {
 		return nil, errServerNotFound
@@ -861,6 +1977,45 @@ func (s *RpcServer) QueueFileDownload(_ context.Context, request *v1.QueueFileDo
 		return nil, connect.NewError(connect.CodeInvalidArgument, pathErr)
 	}
 
+	resp := &v1.QueueFileDownloadResponse{}
+
+	if request.DuplicateAction != v1.DuplicateAction_DUPLICATE_ACTION_DOWNLOAD {
+		dupe, err := s.downloadManager.FindDuplicate(ctx, srv, username, path)
+		if err != nil {
+			return nil, err
+		}
+
+		if dupe != nil {
+			resp.Duplicate = &v1.DuplicateFile{
+				LocalPath:    dupe.Path,
+				Size:         uint64(dupe.Size),
+				DownloadedTs: dupe.CreatedTs.Unix(),
+			}
+
+			switch request.DuplicateAction {
+			case v1.DuplicateAction_DUPLICATE_ACTION_HARD_LINK, v1.DuplicateAction_DUPLICATE_ACTION_COPY:
+				linkedPath, err := s.downloadManager.LinkDuplicate(
+					ctx,
+					srv,
+					username,
+					path,
+					dupe,
+					request.DuplicateAction == v1.DuplicateAction_DUPLICATE_ACTION_COPY,
+				)
+				if err != nil {
+					if errors.Is(err, ErrDuplicateLinkFailed) || errors.Is(err, fs.ErrExist) {
+						return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+					}
+					return nil, err
+				}
+				resp.LinkedPath = &linkedPath
+			}
+
+			// The user needs to decide what to do, or it was already done.
+			return resp, nil
+		}
+	}
+
 	err := s.downloadManager.Queue(
 		srv,
 		username,
@@ -870,7 +2025,7 @@ func (s *RpcServer) QueueFileDownload(_ context.Context, request *v1.QueueFileDo
 		return nil, err
 	}
 
-	return &v1.QueueFileDownloadResponse{}, nil
+	return resp, nil
 }
 
 func (s *RpcServer) CancelFileDownload(_ context.Context, request *v1.CancelFileDownloadRequest) (*v1.CancelFileDownloadResponse, error) {
@@ -895,6 +2050,21 @@ func (s *RpcServer) RemoveDownloadManagerItem(_ context.Context, request *v1.Rem
 	return &v1.RemoveDownloadManagerItemResponse{}, nil
 }
 
+func (s *RpcServer) PauseFileDownload(_ context.Context, request *v1.PauseFileDownloadRequest) (*v1.PauseFileDownloadResponse, error) {
+	has, err := s.downloadManager.Pause(request.Uuid)
+	if err != nil {
+		if errors.Is(err, ErrDownloadNotPausable) {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+		}
+		return nil, err
+	}
+	if !has {
+		return nil, errDownloadHandleNotFound
+	}
+
+	return &v1.PauseFileDownloadResponse{}, nil
+}
+
 func (s *RpcServer) ResumeFileDownload(_ context.Context, request *v1.ResumeFileDownloadRequest) (*v1.ResumeFileDownloadResponse, error) {
 	has := s.downloadManager.DownloadNow(request.Uuid)
 	if !has {
@@ -923,12 +2093,45 @@ func (s *RpcServer) GetTransferSettings(ctx context.Context, _ *v1.GetTransferSe
 	if completeDir == "" {
 		return nil, errors.New("BUG: expected " + DmDirCompleteSetting + " to be set")
 	}
+	pathTemplate, err := s.storage.GetSettingOr(ctx, DmPathTemplateSetting, DefaultDownloadPathTemplate)
+	if err != nil {
+		return nil, err
+	}
+	serverDirs, err := getServerCompleteDirs(ctx, s.storage)
+	if err != nil {
+		return nil, err
+	}
+	partFilesInIncompleteDir, err := s.storage.GetSettingBoolOr(ctx, DmPartFilesInIncompleteDirSetting, false)
+	if err != nil {
+		return nil, err
+	}
+	shutdownGrace, err := s.storage.GetSettingIntOr(ctx, ShutdownGraceSetting, DefaultShutdownGrace)
+	if err != nil {
+		return nil, err
+	}
+	scanCommand, err := s.storage.GetSettingOr(ctx, DmScanCommandSetting, "")
+	if err != nil {
+		return nil, err
+	}
+	quarantineDir, err := s.storage.GetSettingOr(ctx, DmDirQuarantineSetting, "")
+	if err != nil {
+		return nil, err
+	}
+	if quarantineDir == "" {
+		return nil, errors.New("BUG: expected " + DmDirQuarantineSetting + " to be set")
+	}
 
 	return &v1.GetTransferSettingsResponse{
 		Settings: &v1.TransferSettings{
-			DownloadConcurrency:   uint32(concurrency),
-			IncompleteDownloadDir: incompleteDir,
-			CompleteDownloadDir:   completeDir,
+			DownloadConcurrency:        uint32(concurrency),
+			IncompleteDownloadDir:      incompleteDir,
+			CompleteDownloadDir:        completeDir,
+			DownloadPathTemplate:       pathTemplate,
+			ServerCompleteDownloadDirs: serverDirs,
+			PartFilesInIncompleteDir:   partFilesInIncompleteDir,
+			ShutdownGraceSeconds:       uint32(shutdownGrace),
+			ScanCommand:                scanCommand,
+			QuarantineDir:              quarantineDir,
 		},
 	}, nil
 }
@@ -937,10 +2140,16 @@ func (s *RpcServer) UpdateTransferSettings(ctx context.Context, request *v1.Upda
 	concurrency := request.Settings.DownloadConcurrency
 	incompleteDir := request.Settings.IncompleteDownloadDir
 	completeDir := request.Settings.CompleteDownloadDir
+	pathTemplate := request.Settings.DownloadPathTemplate
+	serverDirs := request.Settings.ServerCompleteDownloadDirs
+	quarantineDir := request.Settings.QuarantineDir
 
 	if concurrency < 1 {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("download concurrency must be at least 1"))
 	}
+	if request.Settings.ShutdownGraceSeconds > MaxShutdownGrace {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("shutdown grace period cannot be longer than %d seconds", MaxShutdownGrace))
+	}
 	if incompleteDir == "" {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("incomplete download directory cannot be empty"))
 	}
@@ -953,8 +2162,39 @@ func (s *RpcServer) UpdateTransferSettings(ctx context.Context, request *v1.Upda
 	if !filepath.IsAbs(completeDir) {
 		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("complete download directory must be an absolute path"))
 	}
+	if quarantineDir == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("quarantine directory cannot be empty"))
+	}
+	if !filepath.IsAbs(quarantineDir) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("quarantine directory must be an absolute path"))
+	}
+	if err := ValidateScanCommand(request.Settings.ScanCommand); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	if pathTemplate == "" {
+		pathTemplate = DefaultDownloadPathTemplate
+	}
+	if err := ValidateDownloadPathTemplate(pathTemplate); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	for _, dir := range serverDirs {
+		if !filepath.IsAbs(dir) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("server complete download directories must be absolute paths"))
+		}
+	}
+	if serverDirs == nil {
+		serverDirs = map[string]string{}
+	}
+	// The UI may still have entries for servers that were deleted since it loaded the settings.
+	if err := dropUnknownServerCompleteDirs(ctx, s.storage, serverDirs); err != nil {
+		return nil, err
+	}
+	serverDirsJson, err := json.Marshal(serverDirs)
+	if err != nil {
+		return nil, err
+	}
 
-	err := s.storage.PutSettingInt(ctx, DmDlConcurrencySetting, int64(concurrency))
+	err = s.storage.PutSettingInt(ctx, DmDlConcurrencySetting, int64(concurrency))
 	if err != nil {
 		return nil, err
 	}
@@ -966,6 +2206,815 @@ func (s *RpcServer) UpdateTransferSettings(ctx context.Context, request *v1.Upda
 	if err != nil {
 		return nil, err
 	}
+	err = s.storage.PutSetting(ctx, DmPathTemplateSetting, pathTemplate)
+	if err != nil {
+		return nil, err
+	}
+	err = s.storage.PutSetting(ctx, DmDirCompleteServersSetting, string(serverDirsJson))
+	if err != nil {
+		return nil, err
+	}
+	err = s.storage.PutSettingBool(ctx, DmPartFilesInIncompleteDirSetting, request.Settings.PartFilesInIncompleteDir)
+	if err != nil {
+		return nil, err
+	}
+	err = s.storage.PutSettingInt(ctx, ShutdownGraceSetting, int64(request.Settings.ShutdownGraceSeconds))
+	if err != nil {
+		return nil, err
+	}
+	err = s.storage.PutSetting(ctx, DmScanCommandSetting, request.Settings.ScanCommand)
+	if err != nil {
+		return nil, err
+	}
+	err = s.storage.PutSetting(ctx, DmDirQuarantineSetting, quarantineDir)
+	if err != nil {
+		return nil, err
+	}
 
 	return &v1.UpdateTransferSettingsResponse{}, nil
 }
+
+func (s *RpcServer) GetNotificationSettings(ctx context.Context, _ *v1.GetNotificationSettingsRequest) (*v1.GetNotificationSettingsResponse, error) {
+	settings, err := getNotificationSettings(ctx, s.storage)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetNotificationSettingsResponse{
+		Settings: settings,
+	}, nil
+}
+
+func (s *RpcServer) UpdateNotificationSettings(ctx context.Context, request *v1.UpdateNotificationSettingsRequest) (*v1.UpdateNotificationSettingsResponse, error) {
+	settings := request.Settings
+
+	if settings.WebhookUrl != "" {
+		if err := validateWebhookUrl(settings.WebhookUrl); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	err := s.storage.PutSettingBool(ctx, NotifyDesktopSetting, settings.Desktop)
+	if err != nil {
+		return nil, err
+	}
+	err = s.storage.PutSetting(ctx, NotifyWebhookUrlSetting, settings.WebhookUrl)
+	if err != nil {
+		return nil, err
+	}
+	err = s.storage.PutSettingBool(ctx, NotifyDownloadCompleteSetting, settings.DownloadComplete)
+	if err != nil {
+		return nil, err
+	}
+	err = s.storage.PutSettingBool(ctx, NotifyFriendOnlineSetting, settings.FriendOnline)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.UpdateNotificationSettingsResponse{}, nil
+}
+func (s *RpcServer) ExportConfig(ctx context.Context, request *v1.ExportConfigRequest) (*v1.ExportConfigResponse, error) {
+	if request.Password == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, backup.ErrEmptyPassword)
+	}
+
+	bundle, err := s.client.ExportConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := backup.Seal(bundle, request.Password)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.ExportConfigResponse{
+		Bundle: data,
+	}, nil
+}
+func (s *RpcServer) ImportConfig(ctx context.Context, request *v1.ImportConfigRequest) (*v1.ImportConfigResponse, error) {
+	bundle, err := backup.Open(request.Bundle, request.Password)
+	if err != nil {
+		if errors.Is(err, backup.ErrInvalidBundle) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if errors.Is(err, backup.ErrDecryptFailed) {
+			return nil, connect.NewError(connect.CodePermissionDenied, err)
+		}
+		return nil, err
+	}
+
+	res, err := s.client.ImportConfig(ctx, bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*v1.ServerInfo, len(res.Servers))
+	for i, srv := range res.Servers {
+		infos[i] = s.serverToInfo(srv)
+	}
+
+	return &v1.ImportConfigResponse{
+		Servers:        infos,
+		SkippedServers: uint32(res.SkippedServers),
+		FailedShares:   res.FailedShares,
+	}, nil
+}
+func (s *RpcServer) BackupDatabase(ctx context.Context, request *v1.BackupDatabaseRequest) (*v1.BackupDatabaseResponse, error) {
+	if request.Path == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("path is required"))
+	}
+
+	if err := s.client.storage.Backup(ctx, request.Path); err != nil {
+		if errors.Is(err, os.ErrExist) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		return nil, err
+	}
+
+	return &v1.BackupDatabaseResponse{}, nil
+}
+func (s *RpcServer) CheckDatabaseIntegrity(ctx context.Context, _ *v1.CheckDatabaseIntegrityRequest) (*v1.CheckDatabaseIntegrityResponse, error) {
+	problems, err := s.client.storage.CheckIntegrity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CheckDatabaseIntegrityResponse{
+		Problems: problems,
+	}, nil
+}
+func (s *RpcServer) GetDownloadHooks(ctx context.Context, _ *v1.GetDownloadHooksRequest) (*v1.GetDownloadHooksResponse, error) {
+	records, err := s.client.storage.GetDownloadHooks(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*v1.DownloadHookInfo, len(records))
+	for i, record := range records {
+		infos[i] = s.downloadHookRecToInfo(record)
+	}
+
+	return &v1.GetDownloadHooksResponse{
+		Hooks: infos,
+	}, nil
+}
+func (s *RpcServer) CreateDownloadHook(ctx context.Context, request *v1.CreateDownloadHookRequest) (*v1.CreateDownloadHookResponse, error) {
+	record, found, err := s.downloadManager.CreateHook(ctx, request.Type, request.Target, request.DownloadUuid)
+	if err != nil {
+		if errors.Is(err, ErrInvalidDownloadHook) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, err
+	}
+	if !found {
+		return nil, errDownloadHandleNotFound
+	}
+
+	return &v1.CreateDownloadHookResponse{
+		Hook: s.downloadHookRecToInfo(record),
+	}, nil
+}
+func (s *RpcServer) DeleteDownloadHook(ctx context.Context, request *v1.DeleteDownloadHookRequest) (*v1.DeleteDownloadHookResponse, error) {
+	has, err := s.client.storage.DeleteDownloadHook(ctx, request.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errDownloadHookNotFound
+	}
+
+	return &v1.DeleteDownloadHookResponse{}, nil
+}
+
+func (s *RpcServer) GetUploads(ctx context.Context, request *v1.GetUploadsRequest) (*v1.GetUploadsResponse, error) {
+	limit := int(request.HistoryLimit)
+	if limit == 0 {
+		limit = 100
+	}
+
+	history, err := s.uploadTracker.History(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.GetUploadsResponse{
+		Active:  s.uploadTracker.Active(),
+		History: history,
+	}, nil
+}
+
+func (s *RpcServer) ClearUploadHistory(ctx context.Context, _ *v1.ClearUploadHistoryRequest) (*v1.ClearUploadHistoryResponse, error) {
+	if err := s.storage.ClearUploadHistory(ctx); err != nil {
+		return nil, err
+	}
+
+	return &v1.ClearUploadHistoryResponse{}, nil
+}
+
+func (s *RpcServer) friendToInfo(record storage.FriendRecord) *v1.FriendInfo {
+	return &v1.FriendInfo{
+		ServerUuid: record.Server,
+		Username:   record.Username.String(),
+		Nickname:   record.Nickname,
+		Note:       record.Note,
+		TrustLevel: record.TrustLevel,
+		CreatedTs:  record.CreatedTs.Unix(),
+		UpdatedTs:  record.UpdatedTs.Unix(),
+	}
+}
+
+// getFriends returns the friend info for users on a server, keyed by username.
+func (s *RpcServer) getFriends(ctx context.Context, serverUuid string) (map[string]*v1.FriendInfo, error) {
+	records, err := s.storage.GetFriends(ctx, serverUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	friends := make(map[string]*v1.FriendInfo, len(records))
+	for _, record := range records {
+		friends[record.Username.String()] = s.friendToInfo(record)
+	}
+	return friends, nil
+}
+
+func (s *RpcServer) GetFriends(ctx context.Context, request *v1.GetFriendsRequest) (*v1.GetFriendsResponse, error) {
+	if _, has := s.client.GetByUuid(request.ServerUuid); !has {
+		return nil, errServerNotFound
+	}
+
+	records, err := s.storage.GetFriends(ctx, request.ServerUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	friends := make([]*v1.FriendInfo, len(records))
+	for i, record := range records {
+		friends[i] = s.friendToInfo(record)
+	}
+
+	return &v1.GetFriendsResponse{
+		Friends: friends,
+	}, nil
+}
+
+func (s *RpcServer) SetFriend(ctx context.Context, request *v1.SetFriendRequest) (*v1.SetFriendResponse, error) {
+	if _, has := s.client.GetByUuid(request.ServerUuid); !has {
+		return nil, errServerNotFound
+	}
+
+	username, usernameOk := common.NormalizeUsername(request.Username)
+	if !usernameOk {
+		return nil, errInvalidUsername
+	}
+	nickname := strings.TrimSpace(request.Nickname)
+	if utf8.RuneCountInString(nickname) > MaxFriendNicknameLength {
+		return nil, errFriendNicknameTooLong
+	}
+	if utf8.RuneCountInString(request.Note) > MaxFriendNoteLength {
+		return nil, errFriendNoteTooLong
+	}
+	if _, known := v1.TrustLevel_name[int32(request.TrustLevel)]; !known {
+		return nil, errInvalidTrustLevel
+	}
+
+	record, err := s.storage.PutFriend(ctx, request.ServerUuid, username, nickname, request.Note, request.TrustLevel)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.SetFriendResponse{
+		Friend: s.friendToInfo(record),
+	}, nil
+}
+
+func (s *RpcServer) DeleteFriend(ctx context.Context, request *v1.DeleteFriendRequest) (*v1.DeleteFriendResponse, error) {
+	if _, has := s.client.GetByUuid(request.ServerUuid); !has {
+		return nil, errServerNotFound
+	}
+
+	username, usernameOk := common.NormalizeUsername(request.Username)
+	if !usernameOk {
+		return nil, errInvalidUsername
+	}
+
+	has, err := s.storage.DeleteFriend(ctx, request.ServerUuid, username)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errFriendNotFound
+	}
+
+	return &v1.DeleteFriendResponse{}, nil
+}
+
+func (s *RpcServer) GetBlockedPeers(ctx context.Context, request *v1.GetBlockedPeersRequest) (*v1.GetBlockedPeersResponse, error) {
+	if _, has := s.client.GetByUuid(request.ServerUuid); !has {
+		return nil, errServerNotFound
+	}
+
+	records, err := s.storage.GetBlockedPeers(ctx, request.ServerUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	peers := make([]*v1.BlockedPeerInfo, len(records))
+	for i, record := range records {
+		peers[i] = &v1.BlockedPeerInfo{
+			Username:  record.Username.String(),
+			CreatedTs: record.CreatedTs.Unix(),
+		}
+	}
+
+	return &v1.GetBlockedPeersResponse{
+		Peers: peers,
+	}, nil
+}
+
+func (s *RpcServer) BlockPeer(ctx context.Context, request *v1.BlockPeerRequest) (*v1.BlockPeerResponse, error) {
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return nil, errServerNotFound
+	}
+
+	username, usernameOk := common.NormalizeUsername(request.Username)
+	if !usernameOk {
+		return nil, errInvalidUsername
+	}
+
+	if err := s.storage.PutBlockedPeer(ctx, srv.Uuid, username); err != nil {
+		return nil, err
+	}
+	srv.BlockList.Add(username)
+
+	return &v1.BlockPeerResponse{}, nil
+}
+
+func (s *RpcServer) UnblockPeer(ctx context.Context, request *v1.UnblockPeerRequest) (*v1.UnblockPeerResponse, error) {
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return nil, errServerNotFound
+	}
+
+	username, usernameOk := common.NormalizeUsername(request.Username)
+	if !usernameOk {
+		return nil, errInvalidUsername
+	}
+
+	has, err := s.storage.DeleteBlockedPeer(ctx, srv.Uuid, username)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errPeerNotBlocked
+	}
+	srv.BlockList.Remove(username)
+
+	return &v1.UnblockPeerResponse{}, nil
+}
+
+func (s *RpcServer) GetInterests(_ context.Context, _ *v1.GetInterestsRequest) (*v1.GetInterestsResponse, error) {
+	return &v1.GetInterestsResponse{
+		Interests: s.client.Interests(),
+	}, nil
+}
+
+func (s *RpcServer) SetInterests(ctx context.Context, request *v1.SetInterestsRequest) (*v1.SetInterestsResponse, error) {
+	interests, err := common.NormalizeInterests(request.Interests)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	interests, err = s.client.SetInterests(ctx, interests)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.SetInterestsResponse{
+		Interests: interests,
+	}, nil
+}
+
+func (s *RpcServer) GetSimilarUsers(ctx context.Context, request *v1.GetSimilarUsersRequest) (*v1.GetSimilarUsersResponse, error) {
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return nil, errServerNotFound
+	}
+
+	var users []*pb.SimilarUser
+	err := srv.Do(ctx, func(ctx context.Context, c *room.Conn) error {
+		var err error
+		users, err = c.GetSimilarUsers(request.GetLimit())
+		if protoErr, ok := errors.AsType[protocol.ProtoMsgError](err); ok && protoErr.Msg.Type == pb.ErrType_ERR_TYPE_UNIMPLEMENTED {
+			return errInterestsUnsupported
+		}
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]*v1.SimilarUserInfo, len(users))
+	for i, user := range users {
+		res[i] = &v1.SimilarUserInfo{
+			Username:        user.Username,
+			SharedInterests: user.SharedInterests,
+			InterestCount:   user.InterestCount,
+		}
+	}
+
+	return &v1.GetSimilarUsersResponse{
+		Users: res,
+	}, nil
+}
+
+func (s *RpcServer) GetServerSchedule(_ context.Context, request *v1.GetServerScheduleRequest) (*v1.GetServerScheduleResponse, error) {
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return nil, errServerNotFound
+	}
+
+	schedule := srv.Schedule()
+	windows := make([]*v1.ConnWindow, len(schedule))
+	for i, w := range schedule {
+		windows[i] = &v1.ConnWindow{
+			Weekdays:    uint32(w.Weekdays),
+			StartMinute: uint32(w.StartMinute),
+			EndMinute:   uint32(w.EndMinute),
+		}
+	}
+
+	return &v1.GetServerScheduleResponse{
+		Windows:    windows,
+		AllowedNow: schedule.Allows(time.Now()),
+	}, nil
+}
+
+func (s *RpcServer) SetServerSchedule(ctx context.Context, request *v1.SetServerScheduleRequest) (*v1.SetServerScheduleResponse, error) {
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return nil, errServerNotFound
+	}
+
+	schedule := make(ConnSchedule, len(request.Windows))
+	for i, w := range request.Windows {
+		if w.Weekdays > common.AllWeekdays {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf(`%w: weekday mask %d has bits past Saturday set`, common.ErrInvalidTimeWindow, w.Weekdays))
+		}
+		schedule[i] = common.TimeWindow{
+			Weekdays:    uint8(w.Weekdays),
+			StartMinute: int(min(w.StartMinute, common.MinutesPerDay)),
+			EndMinute:   int(min(w.EndMinute, common.MinutesPerDay)),
+		}
+	}
+	if err := schedule.Validate(); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	if err := s.storage.SetConnWindows(ctx, srv.Uuid, schedule.toRecords()); err != nil {
+		return nil, err
+	}
+	srv.SetSchedule(schedule)
+
+	return &v1.SetServerScheduleResponse{}, nil
+}
+
+func (s *RpcServer) GetSnooze(_ context.Context, _ *v1.GetSnoozeRequest) (*v1.GetSnoozeResponse, error) {
+	return &v1.GetSnoozeResponse{
+		Snooze: s.client.Snoozer().Info(),
+	}, nil
+}
+
+func (s *RpcServer) Snooze(ctx context.Context, request *v1.SnoozeRequest) (*v1.SnoozeResponse, error) {
+	var duration time.Duration
+	if request.DurationSeconds != nil {
+		if *request.DurationSeconds == 0 {
+			return nil, errZeroSnoozeDuration
+		}
+		duration = time.Duration(*request.DurationSeconds) * time.Second
+	}
+
+	info, err := s.client.Snoozer().Snooze(ctx, duration, request.HideShares)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.SnoozeResponse{
+		Snooze: info,
+	}, nil
+}
+
+func (s *RpcServer) Unsnooze(ctx context.Context, _ *v1.UnsnoozeRequest) (*v1.UnsnoozeResponse, error) {
+	if err := s.client.Snoozer().Unsnooze(ctx); err != nil {
+		return nil, err
+	}
+
+	return &v1.UnsnoozeResponse{}, nil
+}
+
+func (s *RpcServer) GetRunHistory(ctx context.Context, request *v1.GetRunHistoryRequest) (*v1.GetRunHistoryResponse, error) {
+	limit := int(request.Limit)
+	if limit == 0 {
+		limit = 100
+	}
+
+	records, err := s.storage.GetRunSessions(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	runs := make([]*v1.RunSessionInfo, len(records))
+	for i, rec := range records {
+		info := &v1.RunSessionInfo{
+			Uuid:      rec.Uuid,
+			StartedTs: rec.StartedTs.Unix(),
+			Crashed:   rec.Crashed,
+			IsCurrent: rec.Uuid == s.uptimeTracker.RunUuid(),
+		}
+		if rec.StoppedTs != nil {
+			info.StoppedTs = new(rec.StoppedTs.Unix())
+		}
+		runs[i] = info
+	}
+
+	return &v1.GetRunHistoryResponse{
+		Runs: runs,
+	}, nil
+}
+
+func (s *RpcServer) GetConnHistory(ctx context.Context, request *v1.GetConnHistoryRequest) (*v1.GetConnHistoryResponse, error) {
+	if request.ServerUuid != "" {
+		if _, has := s.client.GetByUuid(request.ServerUuid); !has {
+			return nil, errServerNotFound
+		}
+	}
+
+	limit := int(request.Limit)
+	if limit == 0 {
+		limit = 100
+	}
+
+	records, err := s.storage.GetConnSessions(ctx, request.ServerUuid, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	sessions := make([]*v1.ConnSessionInfo, len(records))
+	for i, rec := range records {
+		end := now
+		if rec.DisconnectedTs != nil {
+			end = *rec.DisconnectedTs
+		}
+
+		info := &v1.ConnSessionInfo{
+			Uuid:             rec.Uuid,
+			RunUuid:          rec.Run,
+			ServerUuid:       rec.Server,
+			ConnectedTs:      rec.ConnectedTs.Unix(),
+			DurationSeconds:  int64(max(end.Sub(rec.ConnectedTs), 0) / time.Second),
+			DisconnectReason: rec.DisconnectReason,
+		}
+		if rec.DisconnectedTs != nil {
+			info.DisconnectedTs = new(rec.DisconnectedTs.Unix())
+		}
+		sessions[i] = info
+	}
+
+	return &v1.GetConnHistoryResponse{
+		Sessions: sessions,
+	}, nil
+}
+
+func (s *RpcServer) GetTrash(ctx context.Context, _ *v1.GetTrashRequest) (*v1.GetTrashResponse, error) {
+	retention, err := s.client.TrashRetention(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	serverRecs, err := s.storage.GetTrashedServers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	shareRecs, err := s.storage.GetTrashedShares(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	servers := make([]*v1.TrashedServer, len(serverRecs))
+	for i, rec := range serverRecs {
+		servers[i] = &v1.TrashedServer{
+			Uuid:      rec.Uuid,
+			Name:      rec.Name,
+			Address:   rec.Address,
+			Room:      rec.Room.String(),
+			Username:  rec.Username.String(),
+			CreatedTs: rec.CreatedTs.Unix(),
+			DeletedTs: rec.DeletedTs.Unix(),
+			PurgeTs:   rec.DeletedTs.Add(retention).Unix(),
+		}
+	}
+	shares := make([]*v1.TrashedShare, len(shareRecs))
+	for i, rec := range shareRecs {
+		shares[i] = &v1.TrashedShare{
+			Share:     s.shareRecToInfo(rec),
+			DeletedTs: rec.DeletedTs.Unix(),
+			PurgeTs:   rec.DeletedTs.Add(retention).Unix(),
+		}
+	}
+
+	return &v1.GetTrashResponse{
+		Servers: servers,
+		Shares:  shares,
+	}, nil
+}
+
+func (s *RpcServer) RestoreServer(ctx context.Context, request *v1.RestoreServerRequest) (*v1.RestoreServerResponse, error) {
+	srv, has, err := s.client.RestoreByUuid(ctx, request.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errServerNotFound
+	}
+
+	return &v1.RestoreServerResponse{
+		Server: s.serverToInfo(srv),
+	}, nil
+}
+
+func (s *RpcServer) PurgeServer(ctx context.Context, request *v1.PurgeServerRequest) (*v1.PurgeServerResponse, error) {
+	has, err := s.client.PurgeByUuid(ctx, request.Uuid)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errServerNotFound
+	}
+
+	return &v1.PurgeServerResponse{}, nil
+}
+
+func (s *RpcServer) RestoreShare(ctx context.Context, request *v1.RestoreShareRequest) (*v1.RestoreShareResponse, error) {
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return nil, errServerNotFound
+	}
+
+	has, err := srv.ShareMgr.Restore(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errShareNotFound
+	}
+
+	record, err := s.getShareRecord(ctx, request.ServerUuid, request.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.RestoreShareResponse{
+		Share: s.shareRecToInfo(record),
+	}, nil
+}
+
+func (s *RpcServer) PurgeShare(ctx context.Context, request *v1.PurgeShareRequest) (*v1.PurgeShareResponse, error) {
+	srv, has := s.client.GetByUuid(request.ServerUuid)
+	if !has {
+		return nil, errServerNotFound
+	}
+
+	has, err := srv.ShareMgr.Purge(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errShareNotFound
+	}
+
+	return &v1.PurgeShareResponse{}, nil
+}
+
+func (s *RpcServer) pluginRecToInfo(record storage.PluginRecord) *v1.PluginInfo {
+	return &v1.PluginInfo{
+		Name:        record.Name,
+		CreatedTs:   record.CreatedTs.Unix(),
+		Scopes:      record.Scopes,
+		OpenStreams: uint32(s.plugins.OpenStreams(record.Name)),
+	}
+}
+
+func (s *RpcServer) GetPlugins(ctx context.Context, _ *v1.GetPluginsRequest) (*v1.GetPluginsResponse, error) {
+	records, err := s.storage.GetPlugins(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*v1.PluginInfo, len(records))
+	for i, record := range records {
+		infos[i] = s.pluginRecToInfo(record)
+	}
+
+	return &v1.GetPluginsResponse{
+		Plugins: infos,
+	}, nil
+}
+
+func (s *RpcServer) CreatePlugin(ctx context.Context, request *v1.CreatePluginRequest) (*v1.CreatePluginResponse, error) {
+	record, token, err := s.plugins.Create(ctx, request.Name, request.Scopes)
+	if err != nil {
+		if errors.Is(err, ErrInvalidPluginName) || errors.Is(err, ErrInvalidPluginScope) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if errors.Is(err, ErrPluginExists) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		return nil, err
+	}
+
+	return &v1.CreatePluginResponse{
+		Plugin: s.pluginRecToInfo(record),
+		Token:  token,
+	}, nil
+}
+
+func (s *RpcServer) DeletePlugin(ctx context.Context, request *v1.DeletePluginRequest) (*v1.DeletePluginResponse, error) {
+	has, err := s.plugins.Delete(ctx, request.Name)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errPluginNotFound
+	}
+
+	return &v1.DeletePluginResponse{}, nil
+}
+
+func (s *RpcServer) StreamPluginEvents(ctx context.Context, request *v1.StreamPluginEventsRequest, conn *connect.ServerStream[v1.StreamPluginEventsResponse]) error {
+	plugin, ok := PluginFromContext(ctx)
+	if !ok {
+		return errNotPlugin
+	}
+
+	stream, err := s.plugins.Subscribe(plugin, request.Types)
+	if err != nil {
+		return err
+	}
+	defer s.plugins.Unsubscribe(stream)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-stream.Done:
+			// The plugin was deleted.
+			return nil
+		case evt := <-stream.Events:
+			err = conn.Send(&v1.StreamPluginEventsResponse{
+				Event: evt,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func (s *RpcServer) RespondToSearch(ctx context.Context, request *v1.RespondToSearchRequest) (*v1.RespondToSearchResponse, error) {
+	plugin, ok := PluginFromContext(ctx)
+	if !ok {
+		return nil, errNotPlugin
+	}
+
+	results := make([]pb.MsgSearchResult, len(request.Results))
+	for i, result := range request.Results {
+		if result.File == nil || result.File.Name == "" {
+			return nil, errInvalidPluginSearchResult
+		}
+		if _, err := common.ValidatePath(result.DirectoryPath); err != nil {
+			return nil, errInvalidPluginSearchResult
+		}
+
+		results[i] = pb.MsgSearchResult{
+			DirectoryPath: result.DirectoryPath,
+			File: &pb.MsgFileMeta{
+				Name:       result.File.Name,
+				IsDir:      result.File.IsDir,
+				Size:       result.File.Size,
+				ModifiedTs: result.File.ModifiedTs,
+			},
+			Snippet: result.Snippet,
+		}
+	}
+
+	if err := s.plugins.Respond(plugin.Name, request.SearchId, results); err != nil {
+		if errors.Is(err, ErrPluginSearchNotFound) {
+			return nil, connect.NewError(connect.CodeNotFound, err)
+		}
+		return nil, err
+	}
+
+	return &v1.RespondToSearchResponse{}, nil
+}
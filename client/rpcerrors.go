@@ -0,0 +1,148 @@
+package client
+
+import (
+	"context"
+	"errors"
+
+	"connectrpc.com/connect"
+	"friendnet.org/client/room"
+	"friendnet.org/common"
+	"friendnet.org/protocol"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// RpcErrorInterceptor converts errors returned by RPC handlers that were caused by known protocol errors into connect
+// errors with a matching code and a v1.ErrorInfo detail, so that clients can show an actionable message instead of an
+// internal error.
+// Errors that handlers already converted keep their code, but still get the detail.
+type RpcErrorInterceptor struct{}
+
+var _ connect.Interceptor = RpcErrorInterceptor{}
+
+func (RpcErrorInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		res, err := next(ctx, req)
+		if err != nil {
+			return nil, toRpcError(err)
+		}
+		return res, nil
+	}
+}
+
+func (RpcErrorInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (RpcErrorInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		if err := next(ctx, conn); err != nil {
+			return toRpcError(err)
+		}
+		return nil
+	}
+}
+
+// toRpcError returns err as a connect error with a v1.ErrorInfo detail if it was caused by a known protocol error.
+// Other errors are returned as is.
+func toRpcError(err error) error {
+	code, info, ok := classifyRpcError(err)
+	if !ok {
+		return err
+	}
+
+	connectErr, isConnectErr := errors.AsType[*connect.Error](err)
+	if !isConnectErr {
+		connectErr = connect.NewError(code, err)
+	}
+	if detail, detailErr := connect.NewErrorDetail(info); detailErr == nil {
+		connectErr.AddDetail(detail)
+	}
+	return connectErr
+}
+
+// classifyRpcError returns the connect code and error info for err if it was caused by a known protocol error.
+func classifyRpcError(err error) (connect.Code, *v1.ErrorInfo, bool) {
+	if authErr, ok := errors.AsType[protocol.AuthRejectedError](err); ok {
+		code := connect.CodePermissionDenied
+		var reason v1.ErrorReason
+		switch authErr.Reason {
+		case pb.AuthRejectionReason_AUTH_REJECTION_REASON_INVALID_CREDENTIALS:
+			reason = v1.ErrorReason_ERROR_REASON_AUTH_INVALID_CREDENTIALS
+		case pb.AuthRejectionReason_AUTH_REJECTION_REASON_BANNED:
+			reason = v1.ErrorReason_ERROR_REASON_AUTH_BANNED
+		case pb.AuthRejectionReason_AUTH_REJECTION_REASON_ALREADY_CONNECTED:
+			code = connect.CodeFailedPrecondition
+			reason = v1.ErrorReason_ERROR_REASON_AUTH_ALREADY_CONNECTED
+		case pb.AuthRejectionReason_AUTH_REJECTION_REASON_RATE_LIMITED:
+			code = connect.CodeResourceExhausted
+			reason = v1.ErrorReason_ERROR_REASON_AUTH_RATE_LIMITED
+		case pb.AuthRejectionReason_AUTH_REJECTION_REASON_REGISTRATION_DISABLED:
+			reason = v1.ErrorReason_ERROR_REASON_AUTH_REGISTRATION_DISABLED
+		case pb.AuthRejectionReason_AUTH_REJECTION_REASON_INVALID_INVITE_CODE:
+			reason = v1.ErrorReason_ERROR_REASON_AUTH_INVALID_INVITE_CODE
+		case pb.AuthRejectionReason_AUTH_REJECTION_REASON_USERNAME_TAKEN:
+			code = connect.CodeAlreadyExists
+			reason = v1.ErrorReason_ERROR_REASON_AUTH_USERNAME_TAKEN
+		case pb.AuthRejectionReason_AUTH_REJECTION_REASON_INVALID_PASSWORD:
+			code = connect.CodeInvalidArgument
+			reason = v1.ErrorReason_ERROR_REASON_AUTH_INVALID_PASSWORD
+		default:
+			reason = v1.ErrorReason_ERROR_REASON_AUTH_REJECTED
+		}
+		return code, &v1.ErrorInfo{
+			Reason:  reason,
+			Message: common.StrOrNil(authErr.Message),
+		}, true
+	}
+
+	if verErr, ok := errors.AsType[protocol.VersionRejectedError](err); ok {
+		reason := v1.ErrorReason_ERROR_REASON_VERSION_REJECTED
+		switch verErr.Reason {
+		case pb.VersionRejectionReason_VERSION_REJECTION_REASON_TOO_OLD:
+			reason = v1.ErrorReason_ERROR_REASON_VERSION_TOO_OLD
+		case pb.VersionRejectionReason_VERSION_REJECTION_REASON_TOO_NEW:
+			reason = v1.ErrorReason_ERROR_REASON_VERSION_TOO_NEW
+		}
+		return connect.CodeFailedPrecondition, &v1.ErrorInfo{
+			Reason:  reason,
+			Message: common.StrOrNil(verErr.Message),
+		}, true
+	}
+
+	if certErr, ok := errors.AsType[protocol.CertMismatchError](err); ok {
+		return connect.CodeFailedPrecondition, &v1.ErrorInfo{
+			Reason: v1.ErrorReason_ERROR_REASON_CERT_MISMATCH,
+			Host:   &certErr.Host,
+		}, true
+	}
+	if certErr, ok := errors.AsType[protocol.CertFingerprintMismatchError](err); ok {
+		return connect.CodeFailedPrecondition, &v1.ErrorInfo{
+			Reason: v1.ErrorReason_ERROR_REASON_CERT_FINGERPRINT_MISMATCH,
+			Host:   &certErr.Host,
+		}, true
+	}
+	if errors.Is(err, protocol.ErrServerCertNotValidNow) {
+		return connect.CodeFailedPrecondition, &v1.ErrorInfo{
+			Reason: v1.ErrorReason_ERROR_REASON_CERT_NOT_VALID_NOW,
+		}, true
+	}
+	if errors.Is(err, protocol.ErrNoServerCerts) {
+		return connect.CodeFailedPrecondition, &v1.ErrorInfo{
+			Reason: v1.ErrorReason_ERROR_REASON_NO_SERVER_CERTS,
+		}, true
+	}
+
+	if errors.Is(err, protocol.ErrPeerUnreachable) {
+		return connect.CodeUnavailable, &v1.ErrorInfo{
+			Reason: v1.ErrorReason_ERROR_REASON_PEER_UNREACHABLE,
+		}, true
+	}
+	if errors.Is(err, room.ErrPeerRequestTimeout) {
+		return connect.CodeDeadlineExceeded, &v1.ErrorInfo{
+			Reason: v1.ErrorReason_ERROR_REASON_PEER_TIMEOUT,
+		}, true
+	}
+
+	return 0, nil, false
+}
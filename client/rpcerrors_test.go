@@ -0,0 +1,69 @@
+package client
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"connectrpc.com/connect"
+	"friendnet.org/protocol"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// errorInfoOf returns the v1.ErrorInfo detail of err, if it has one.
+func errorInfoOf(t *testing.T, err error) *v1.ErrorInfo {
+	t.Helper()
+
+	connectErr, ok := errors.AsType[*connect.Error](err)
+	if !ok {
+		t.Fatalf("expected connect error, got %T", err)
+	}
+	for _, detail := range connectErr.Details() {
+		msg, valueErr := detail.Value()
+		if valueErr != nil {
+			t.Fatalf("failed to decode error detail: %v", valueErr)
+		}
+		if info, ok := msg.(*v1.ErrorInfo); ok {
+			return info
+		}
+	}
+	return nil
+}
+
+func TestToRpcError_ProtocolError(t *testing.T) {
+	t.Parallel()
+
+	err := toRpcError(fmt.Errorf("failed to connect: %w", protocol.AuthRejectedError{
+		Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_RATE_LIMITED,
+		Message: "slow down",
+	}))
+	if code := connect.CodeOf(err); code != connect.CodeResourceExhausted {
+		t.Errorf("expected code %s, got %s", connect.CodeResourceExhausted, code)
+	}
+	info := errorInfoOf(t, err)
+	if info.GetReason() != v1.ErrorReason_ERROR_REASON_AUTH_RATE_LIMITED || info.GetMessage() != "slow down" {
+		t.Errorf("unexpected error info: %v", info)
+	}
+}
+
+func TestToRpcError_KeepsCode(t *testing.T) {
+	t.Parallel()
+
+	err := toRpcError(connect.NewError(connect.CodeUnavailable, protocol.CertMismatchError{Host: "example.com"}))
+	if code := connect.CodeOf(err); code != connect.CodeUnavailable {
+		t.Errorf("expected code %s to be kept, got %s", connect.CodeUnavailable, code)
+	}
+	if info := errorInfoOf(t, err); info.GetReason() != v1.ErrorReason_ERROR_REASON_CERT_MISMATCH || info.GetHost() != "example.com" {
+		t.Errorf("unexpected error info: %v", info)
+	}
+}
+
+func TestToRpcError_Unknown(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("something broke")
+	if got := toRpcError(err); got != err {
+		t.Errorf("expected unknown error to be returned as is, got %v", got)
+	}
+}
@@ -0,0 +1,59 @@
+package client
+
+import (
+	"time"
+
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+)
+
+// ConnSchedule is a set of windows during which a server connection is allowed.
+// An empty schedule allows connecting at any time.
+type ConnSchedule []common.TimeWindow
+
+// Allows returns whether the schedule allows a connection at t.
+func (s ConnSchedule) Allows(t time.Time) bool {
+	if len(s) == 0 {
+		return true
+	}
+	for _, w := range s {
+		if w.Contains(t) {
+			return true
+		}
+	}
+	return false
+}
+
+// Validate returns an error wrapping common.ErrInvalidTimeWindow if any of the schedule's windows is invalid.
+func (s ConnSchedule) Validate() error {
+	for _, w := range s {
+		if err := w.Validate(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func connScheduleFromRecords(records []storage.ConnWindowRecord) ConnSchedule {
+	schedule := make(ConnSchedule, len(records))
+	for i, record := range records {
+		schedule[i] = common.TimeWindow{
+			Weekdays:    record.Weekdays,
+			StartMinute: record.StartMinute,
+			EndMinute:   record.EndMinute,
+		}
+	}
+	return schedule
+}
+
+func (s ConnSchedule) toRecords() []storage.ConnWindowRecord {
+	records := make([]storage.ConnWindowRecord, len(s))
+	for i, w := range s {
+		records[i] = storage.ConnWindowRecord{
+			Weekdays:    w.Weekdays,
+			StartMinute: w.StartMinute,
+			EndMinute:   w.EndMinute,
+		}
+	}
+	return records
+}
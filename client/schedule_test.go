@@ -0,0 +1,42 @@
+package client
+
+import (
+	"testing"
+	"time"
+)
+
+func TestConnScheduleAllows(t *testing.T) {
+	// 2026-10-16 is a Friday.
+	at := func(day int, hour int, minute int) time.Time {
+		return time.Date(2026, 10, day, hour, minute, 0, 0, time.Local)
+	}
+
+	overnight := ConnSchedule{{StartMinute: 22 * 60, EndMinute: 8 * 60}}
+	weekdays := ConnSchedule{{Weekdays: 0b0111110, StartMinute: 9 * 60, EndMinute: 17 * 60}}
+	fridayNights := ConnSchedule{{Weekdays: 1 << time.Friday, StartMinute: 20 * 60, EndMinute: 20 * 60}}
+
+	cases := []struct {
+		name     string
+		schedule ConnSchedule
+		t        time.Time
+		want     bool
+	}{
+		{"empty", nil, at(16, 12, 0), true},
+		{"overnight evening", overnight, at(16, 23, 30), true},
+		{"overnight morning", overnight, at(17, 7, 59), true},
+		{"overnight end", overnight, at(17, 8, 0), false},
+		{"overnight afternoon", overnight, at(16, 15, 0), false},
+		{"weekday", weekdays, at(16, 9, 0), true},
+		{"weekday evening", weekdays, at(16, 17, 0), false},
+		{"weekend", weekdays, at(17, 12, 0), false},
+		{"full day start", fridayNights, at(16, 20, 0), true},
+		{"full day next morning", fridayNights, at(17, 19, 59), true},
+		{"full day before", fridayNights, at(16, 19, 59), false},
+		{"full day after", fridayNights, at(17, 20, 0), false},
+	}
+	for _, c := range cases {
+		if got := c.schedule.Allows(c.t); got != c.want {
+			t.Errorf("%s: got %v, want %v", c.name, got, c.want)
+		}
+	}
+}
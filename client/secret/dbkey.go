@@ -0,0 +1,104 @@
+package secret
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"os"
+)
+
+// KeyLen is the length of the key used to encrypt secrets that are kept in the database.
+const KeyLen = 32
+
+// ErrInvalidKey is returned by LoadOrCreateKey if the key file does not contain a key of KeyLen bytes.
+var ErrInvalidKey = errors.New("invalid secret key")
+
+// LoadOrCreateKey reads the key used to encrypt secrets that are kept in the database from the file at path.
+// If the file does not exist, it is created with a new random key, readable only by the current user.
+//
+// The key is kept next to the database rather than in it, so that a copy of the database alone does not reveal
+// secrets. It is the fallback for when the OS keychain cannot be used.
+func LoadOrCreateKey(path string) ([]byte, error) {
+	key, err := os.ReadFile(path)
+	if err == nil {
+		if len(key) != KeyLen {
+			return nil, fmt.Errorf(`%w: %q has %d bytes, expected %d`, ErrInvalidKey, path, len(key), KeyLen)
+		}
+		return key, nil
+	}
+	if !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf(`failed to read secret key: %w`, err)
+	}
+
+	key = make([]byte, KeyLen)
+	_, _ = rand.Read(key)
+
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o600)
+	if err != nil {
+		if errors.Is(err, os.ErrExist) {
+			// Someone else created it in the meantime.
+			return LoadOrCreateKey(path)
+		}
+		return nil, fmt.Errorf(`failed to create secret key: %w`, err)
+	}
+	if _, err = f.Write(key); err != nil {
+		_ = f.Close()
+		_ = os.Remove(path)
+		return nil, fmt.Errorf(`failed to write secret key: %w`, err)
+	}
+	if err = f.Close(); err != nil {
+		_ = os.Remove(path)
+		return nil, fmt.Errorf(`failed to write secret key: %w`, err)
+	}
+
+	return key, nil
+}
+
+func newAead(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// Encrypt encrypts value with a key from LoadOrCreateKey.
+// The result is base64-encoded and includes the nonce, so it can be passed to Decrypt as-is.
+func Encrypt(key []byte, value string) (string, error) {
+	aead, err := newAead(key)
+	if err != nil {
+		return "", err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	_, _ = rand.Read(nonce)
+
+	sealed := aead.Seal(nonce, nonce, []byte(value), nil)
+	return base64.RawStdEncoding.EncodeToString(sealed), nil
+}
+
+// Decrypt decrypts a value encrypted by Encrypt.
+// Returns an error if the value was encrypted with a different key or was tampered with.
+func Decrypt(key []byte, encrypted string) (string, error) {
+	aead, err := newAead(key)
+	if err != nil {
+		return "", err
+	}
+
+	sealed, err := base64.RawStdEncoding.DecodeString(encrypted)
+	if err != nil {
+		return "", fmt.Errorf(`failed to decode encrypted secret: %w`, err)
+	}
+	if len(sealed) < aead.NonceSize() {
+		return "", errors.New("encrypted secret is too short")
+	}
+
+	value, err := aead.Open(nil, sealed[:aead.NonceSize()], sealed[aead.NonceSize():], nil)
+	if err != nil {
+		return "", fmt.Errorf(`failed to decrypt secret: %w`, err)
+	}
+	return string(value), nil
+}
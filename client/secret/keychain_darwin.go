@@ -0,0 +1,85 @@
+//go:build darwin
+
+package secret
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+)
+
+// errSecItemNotFound is the exit code of the security command when there is no such keychain item.
+const errSecItemNotFound = 44
+
+// securityKeychain stores secrets in the login keychain using the security command.
+type securityKeychain struct{}
+
+func newKeychain(_ context.Context) (Store, error) {
+	if _, err := exec.LookPath("security"); err != nil {
+		return nil, ErrUnavailable
+	}
+	return securityKeychain{}, nil
+}
+
+// run runs security with the specified arguments and stdin, and returns its stdout.
+func (securityKeychain) run(ctx context.Context, stdin string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "security", args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	if err := cmd.Run(); err != nil {
+		if isNotFound(err) {
+			return nil, err
+		}
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf(`security failed: %s`, msg)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
+
+// isNotFound returns whether err is the error of a security command that failed because there is no such item.
+func isNotFound(err error) bool {
+	exitErr, ok := errors.AsType[*exec.ExitError](err)
+	return ok && exitErr.ExitCode() == errSecItemNotFound
+}
+
+func (k securityKeychain) Get(ctx context.Context, key string) (string, bool, error) {
+	out, err := k.run(ctx, "", "find-generic-password", "-s", ServiceName, "-a", key, "-w")
+	if err != nil {
+		if isNotFound(err) {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	// Values are stored hex-encoded so that they survive the round trip unchanged; see Put.
+	value, err := hex.DecodeString(strings.TrimSpace(string(out)))
+	if err != nil {
+		return "", false, fmt.Errorf(`keychain item %q is not hex-encoded: %w`, key, err)
+	}
+	return string(value), true, nil
+}
+
+func (k securityKeychain) Put(ctx context.Context, key string, value string) error {
+	// The secret is passed through interactive mode so that it does not show up in the process list.
+	// It is hex-encoded because "security -w" prints non-ASCII values in an ambiguous format.
+	cmd := fmt.Sprintf("add-generic-password -U -s %q -a %q -w %s\n", ServiceName, key, hex.EncodeToString([]byte(value)))
+	_, err := k.run(ctx, cmd, "-i")
+	return err
+}
+
+func (k securityKeychain) Delete(ctx context.Context, key string) error {
+	_, err := k.run(ctx, "", "delete-generic-password", "-s", ServiceName, "-a", key)
+	if isNotFound(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,77 @@
+//go:build linux
+
+package secret
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// secretToolKeychain stores secrets in the Secret Service using the secret-tool command from libsecret.
+type secretToolKeychain struct{}
+
+func newKeychain(ctx context.Context) (Store, error) {
+	if os.Getenv("DBUS_SESSION_BUS_ADDRESS") == "" {
+		return nil, ErrUnavailable
+	}
+	if _, err := exec.LookPath("secret-tool"); err != nil {
+		return nil, ErrUnavailable
+	}
+
+	// Make sure a Secret Service is actually running and unlockable.
+	k := secretToolKeychain{}
+	if _, _, err := k.Get(ctx, "probe"); err != nil {
+		return nil, fmt.Errorf(`%w: %w`, ErrUnavailable, err)
+	}
+
+	return k, nil
+}
+
+// run runs secret-tool with the specified arguments and stdin, and returns its stdout.
+func (secretToolKeychain) run(ctx context.Context, stdin string, args ...string) ([]byte, error) {
+	cmd := exec.CommandContext(ctx, "secret-tool", args...)
+	cmd.Stdin = strings.NewReader(stdin)
+	var stdout, stderr bytes.Buffer
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+
+	err := cmd.Run()
+	if err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf(`secret-tool %s failed: %s`, args[0], msg)
+		}
+		return nil, err
+	}
+	return stdout.Bytes(), nil
+}
+
+func (k secretToolKeychain) Get(ctx context.Context, key string) (string, bool, error) {
+	out, err := k.run(ctx, "", "lookup", "service", ServiceName, "account", key)
+	if err != nil {
+		// secret-tool exits with 1 and prints nothing if there is no such secret.
+		if exitErr, ok := errors.AsType[*exec.ExitError](err); ok && exitErr.ExitCode() == 1 {
+			return "", false, nil
+		}
+		return "", false, err
+	}
+
+	return string(out), true, nil
+}
+
+func (k secretToolKeychain) Put(ctx context.Context, key string, value string) error {
+	_, err := k.run(ctx, value, "store", "--label="+ServiceName+": "+key, "service", ServiceName, "account", key)
+	return err
+}
+
+func (k secretToolKeychain) Delete(ctx context.Context, key string) error {
+	_, err := k.run(ctx, "", "clear", "service", ServiceName, "account", key)
+	if exitErr, ok := errors.AsType[*exec.ExitError](err); ok && exitErr.ExitCode() == 1 {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,9 @@
+//go:build !windows && !linux && !darwin
+
+package secret
+
+import "context"
+
+func newKeychain(_ context.Context) (Store, error) {
+	return nil, ErrUnavailable
+}
@@ -0,0 +1,122 @@
+//go:build windows
+
+package secret
+
+import (
+	"context"
+	"errors"
+	"unsafe"
+
+	"golang.org/x/sys/windows"
+)
+
+const credTypeGeneric = 1
+const credPersistLocalMachine = 2
+
+var advapi32 = windows.NewLazySystemDLL("advapi32.dll")
+var procCredReadW = advapi32.NewProc("CredReadW")
+var procCredWriteW = advapi32.NewProc("CredWriteW")
+var procCredDeleteW = advapi32.NewProc("CredDeleteW")
+var procCredFree = advapi32.NewProc("CredFree")
+
+// credential is the CREDENTIALW struct.
+type credential struct {
+	Flags              uint32
+	Type               uint32
+	TargetName         *uint16
+	Comment            *uint16
+	LastWritten        windows.Filetime
+	CredentialBlobSize uint32
+	CredentialBlob     *byte
+	Persist            uint32
+	AttributeCount     uint32
+	Attributes         uintptr
+	TargetAlias        *uint16
+	UserName           *uint16
+}
+
+// credManagerKeychain stores secrets in the Windows Credential Manager as generic credentials.
+type credManagerKeychain struct{}
+
+func newKeychain(_ context.Context) (Store, error) {
+	if err := procCredReadW.Find(); err != nil {
+		return nil, ErrUnavailable
+	}
+	return credManagerKeychain{}, nil
+}
+
+// targetName returns the credential target name for the key.
+func targetName(key string) (*uint16, error) {
+	return windows.UTF16PtrFromString(ServiceName + ":" + key)
+}
+
+func (credManagerKeychain) Get(_ context.Context, key string) (string, bool, error) {
+	target, err := targetName(key)
+	if err != nil {
+		return "", false, err
+	}
+
+	var cred *credential
+	ret, _, callErr := procCredReadW.Call(
+		uintptr(unsafe.Pointer(target)),
+		uintptr(credTypeGeneric),
+		0,
+		uintptr(unsafe.Pointer(&cred)),
+	)
+	if ret == 0 {
+		if errors.Is(callErr, windows.ERROR_NOT_FOUND) {
+			return "", false, nil
+		}
+		return "", false, callErr
+	}
+	defer func() {
+		_, _, _ = procCredFree.Call(uintptr(unsafe.Pointer(cred)))
+	}()
+
+	if cred.CredentialBlobSize == 0 {
+		return "", true, nil
+	}
+	return string(unsafe.Slice(cred.CredentialBlob, cred.CredentialBlobSize)), true, nil
+}
+
+func (credManagerKeychain) Put(_ context.Context, key string, value string) error {
+	target, err := targetName(key)
+	if err != nil {
+		return err
+	}
+	userName, err := windows.UTF16PtrFromString(key)
+	if err != nil {
+		return err
+	}
+
+	blob := []byte(value)
+	cred := credential{
+		Type:               credTypeGeneric,
+		TargetName:         target,
+		CredentialBlobSize: uint32(len(blob)),
+		Persist:            credPersistLocalMachine,
+		UserName:           userName,
+	}
+	if len(blob) > 0 {
+		cred.CredentialBlob = &blob[0]
+	}
+
+	ret, _, callErr := procCredWriteW.Call(uintptr(unsafe.Pointer(&cred)), 0)
+	if ret == 0 {
+		return callErr
+	}
+	return nil
+}
+
+func (credManagerKeychain) Delete(_ context.Context, key string) error {
+	target, err := targetName(key)
+	if err != nil {
+		return err
+	}
+
+	ret, _, callErr := procCredDeleteW.Call(uintptr(unsafe.Pointer(target)), uintptr(credTypeGeneric), 0)
+	if ret == 0 && !errors.Is(callErr, windows.ERROR_NOT_FOUND) {
+		return callErr
+	}
+	return nil
+}
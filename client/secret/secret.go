@@ -0,0 +1,34 @@
+package secret
+
+import (
+	"context"
+	"errors"
+)
+
+// ServiceName is the service name secrets are stored under in the OS keychain.
+const ServiceName = "FriendNet Client"
+
+// ErrUnavailable is returned by NewKeychain when the OS keychain cannot be used.
+var ErrUnavailable = errors.New("OS keychain is not available")
+
+// Store stores secrets by key.
+type Store interface {
+	// Get returns the secret with the specified key.
+	// Returns false if there is no such secret.
+	Get(ctx context.Context, key string) (value string, has bool, err error)
+
+	// Put stores the secret with the specified key.
+	// Overrides any existing secret with the same key.
+	Put(ctx context.Context, key string, value string) error
+
+	// Delete deletes the secret with the specified key.
+	// If there is no such secret, this is a no-op.
+	Delete(ctx context.Context, key string) error
+}
+
+// NewKeychain returns a Store backed by the OS keychain: Credential Manager on Windows, the login keychain on macOS,
+// and the Secret Service (GNOME Keyring, KWallet, etc.) on Linux.
+// Returns ErrUnavailable if the OS keychain cannot be used, such as in a headless session without a Secret Service.
+func NewKeychain(ctx context.Context) (Store, error) {
+	return newKeychain(ctx)
+}
@@ -0,0 +1,196 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/url"
+	"runtime"
+	"sync"
+
+	"friendnet.org/client/storage"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+	"friendnet.org/updater"
+)
+
+// UpdateChannelSetting is the setting key for the release channel to check for updates on.
+const UpdateChannelSetting = "update_channel"
+
+// UpdateBaseUrlSetting is the setting key for the base URL of the release endpoint, or empty for the official one.
+const UpdateBaseUrlSetting = "update_base_url"
+
+// ErrSelfUpdateDisabled is returned when trying to apply an update while self-updating is disabled.
+var ErrSelfUpdateDisabled = errors.New("self-updating is disabled")
+
+// ErrNoNewUpdate is returned when trying to apply an update while there is no new update.
+var ErrNoNewUpdate = errors.New("no new update is available")
+
+// validateUpdateBaseUrl returns an error if the specified release endpoint URL is not an HTTP or HTTPS URL.
+func validateUpdateBaseUrl(target string) error {
+	u, err := url.Parse(target)
+	if err != nil {
+		return fmt.Errorf(`malformed update URL: %w`, err)
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return errors.New("update URL must be an HTTP or HTTPS URL")
+	}
+	return nil
+}
+
+// getUpdateSettings returns the update settings stored in storage.
+func getUpdateSettings(ctx context.Context, store *storage.Storage) (updater.Channel, string, error) {
+	channelName, err := store.GetSettingOr(ctx, UpdateChannelSetting, string(updater.ChannelStable))
+	if err != nil {
+		return "", "", err
+	}
+	channel, err := updater.ParseChannel(channelName)
+	if err != nil {
+		// Fall back to the stable channel rather than never checking for updates.
+		channel = updater.ChannelStable
+	}
+	baseUrl, err := store.GetSettingOr(ctx, UpdateBaseUrlSetting, "")
+	if err != nil {
+		return "", "", err
+	}
+
+	return channel, baseUrl, nil
+}
+
+// UpdateCheckUrl returns the URL that updates are checked at according to the update settings stored in storage.
+func UpdateCheckUrl(ctx context.Context, store *storage.Storage) (string, error) {
+	channel, baseUrl, err := getUpdateSettings(ctx, store)
+	if err != nil {
+		return "", err
+	}
+	if baseUrl == "" {
+		baseUrl = updater.UpdateCheckerBaseUrl
+	}
+	return updater.ChannelUrl(baseUrl, channel), nil
+}
+
+func updateChannelToPb(channel updater.Channel) v1.UpdateChannel {
+	if channel == updater.ChannelBeta {
+		return v1.UpdateChannel_UPDATE_CHANNEL_BETA
+	}
+	return v1.UpdateChannel_UPDATE_CHANNEL_STABLE
+}
+
+func updateChannelFromPb(channel v1.UpdateChannel) (updater.Channel, bool) {
+	switch channel {
+	case v1.UpdateChannel_UPDATE_CHANNEL_UNSPECIFIED, v1.UpdateChannel_UPDATE_CHANNEL_STABLE:
+		return updater.ChannelStable, true
+	case v1.UpdateChannel_UPDATE_CHANNEL_BETA:
+		return updater.ChannelBeta, true
+	default:
+		return "", false
+	}
+}
+
+// SelfUpdater downloads updates found by an UpdateChecker and replaces the client's executable with them.
+// Updates are staged when applied and installed when the client shuts down, so they take effect on restart.
+type SelfUpdater struct {
+	logger  *slog.Logger
+	checker *updater.UpdateChecker
+
+	// The path of the executable to replace, or empty if self-updating is disabled.
+	exePath string
+
+	// Held while applying an update so that only one is downloaded at a time.
+	applyMu sync.Mutex
+}
+
+// NewSelfUpdater creates a new SelfUpdater that applies updates found by checker to the executable at exePath.
+// If exePath is empty, self-updating is disabled.
+func NewSelfUpdater(logger *slog.Logger, checker *updater.UpdateChecker, exePath string) *SelfUpdater {
+	if exePath != "" {
+		if err := updater.RemoveOldBinary(exePath); err != nil {
+			logger.Warn("failed to remove binary left over from previous update",
+				"service", "client.SelfUpdater",
+				"err", err,
+			)
+		}
+	}
+
+	return &SelfUpdater{
+		logger:  logger,
+		checker: checker,
+		exePath: exePath,
+	}
+}
+
+// IsEnabled returns whether self-updating is enabled.
+func (u *SelfUpdater) IsEnabled() bool {
+	return u.exePath != ""
+}
+
+// CanApply returns whether the specified update can be applied on this platform.
+func (u *SelfUpdater) CanApply(update updater.UpdateInfo) bool {
+	if u.exePath == "" {
+		return false
+	}
+	_, has := update.AssetFor(runtime.GOOS, runtime.GOARCH)
+	return has
+}
+
+// IsStaged returns whether an update was applied and will be installed when the client shuts down.
+func (u *SelfUpdater) IsStaged() bool {
+	return u.exePath != "" && updater.HasStagedUpdate(u.exePath)
+}
+
+// Apply downloads and stages the new update found by the last check.
+// Returns ErrSelfUpdateDisabled if self-updating is disabled.
+// Returns ErrNoNewUpdate if there is no new update.
+// See updater.StageUpdate for other errors.
+func (u *SelfUpdater) Apply(ctx context.Context) (updater.UpdateInfo, error) {
+	if u.exePath == "" {
+		return updater.UpdateInfo{}, ErrSelfUpdateDisabled
+	}
+
+	update, err := u.checker.GetNewUpdate()
+	if err != nil {
+		return updater.UpdateInfo{}, err
+	}
+	if update == nil {
+		return updater.UpdateInfo{}, ErrNoNewUpdate
+	}
+
+	u.applyMu.Lock()
+	defer u.applyMu.Unlock()
+
+	if err = updater.StageUpdate(ctx, *update, u.exePath); err != nil {
+		return updater.UpdateInfo{}, err
+	}
+
+	u.logger.Info("update downloaded, it will be installed when the client restarts",
+		"service", "client.SelfUpdater",
+		"version", update.Version,
+	)
+
+	return *update, nil
+}
+
+// Install installs the staged update, if there is one.
+// It should be called when the client shuts down.
+func (u *SelfUpdater) Install() {
+	if u.exePath == "" {
+		return
+	}
+
+	u.applyMu.Lock()
+	defer u.applyMu.Unlock()
+
+	installed, err := updater.InstallStagedUpdate(u.exePath)
+	if err != nil {
+		u.logger.Error("failed to install update",
+			"service", "client.SelfUpdater",
+			"err", err,
+		)
+		return
+	}
+	if installed {
+		u.logger.Info("update installed, it will take effect when the client starts again",
+			"service", "client.SelfUpdater",
+		)
+	}
+}
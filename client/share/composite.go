@@ -0,0 +1,297 @@
+package share
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"slices"
+	"strings"
+	"syscall"
+
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// ConflictRule decides which file a CompositeShare serves when more than one of its mounts has a file at the same path.
+// Directories never conflict: directories at the same path are merged, and a directory always wins over a file.
+type ConflictRule int
+
+const (
+	// ConflictRuleFirst serves the file from the mount that comes first.
+	ConflictRuleFirst ConflictRule = iota
+
+	// ConflictRuleNewest serves the file that was modified most recently.
+	// Ties are broken by mount order.
+	ConflictRuleNewest
+)
+
+// Mount is a share mounted under a virtual path in a CompositeShare.
+type Mount struct {
+	// The path in the composite share that the mounted share appears at.
+	// Use "/" to merge the mounted share with the root.
+	Path common.ProtoPath
+
+	Share Share
+}
+
+// CompositeShare is an implementation of Share that combines several shares, each mounted under a virtual path.
+//
+// Mounts may have the same path, in which case their contents are merged.
+// The directories leading up to a mount's path exist even if no mounted share has them.
+type CompositeShare struct {
+	name   string
+	rule   ConflictRule
+	mounts []compositeMount
+}
+
+var _ Share = (*CompositeShare)(nil)
+
+type compositeMount struct {
+	Mount
+	segments []string
+}
+
+// candidate is an entry that a mount has at a path in a CompositeShare.
+type candidate struct {
+	meta *pb.MsgFileMeta
+
+	// The mount the entry comes from, and the entry's path in it.
+	// The mount is nil for directories leading up to a mount's path.
+	mount *compositeMount
+	rel   common.ProtoPath
+
+	// For directories leading up to a mount's path, the name of the next directory on the way to it.
+	next string
+}
+
+// NewCompositeShare creates a new CompositeShare from the specified mounts.
+// Earlier mounts take precedence over later ones, depending on the conflict rule.
+func NewCompositeShare(name string, rule ConflictRule, mounts []Mount) *CompositeShare {
+	compositeMounts := make([]compositeMount, len(mounts))
+	for i, mount := range mounts {
+		compositeMounts[i] = compositeMount{
+			Mount:    mount,
+			segments: mount.Path.ToSegments(),
+		}
+	}
+
+	return &CompositeShare{
+		name:   name,
+		rule:   rule,
+		mounts: compositeMounts,
+	}
+}
+
+// Mounts returns the share's mounts, in order.
+func (s *CompositeShare) Mounts() []Mount {
+	mounts := make([]Mount, len(s.mounts))
+	for i, mount := range s.mounts {
+		mounts[i] = mount.Mount
+	}
+	return mounts
+}
+
+// Close closes all mounted shares.
+func (s *CompositeShare) Close() error {
+	var errs []error
+	for _, mount := range s.mounts {
+		if err := mount.Share.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *CompositeShare) Name() string {
+	return s.name
+}
+
+// locate returns where the path with the specified segments is relative to the mount.
+// If the path is the mount's path or under it, returns the path in the mounted share and true.
+// If the path leads up to the mount's path, returns the name of the next directory on the way to it.
+func (m *compositeMount) locate(segments []string) (rel common.ProtoPath, inside bool, next string) {
+	if len(segments) >= len(m.segments) {
+		if !slices.Equal(segments[:len(m.segments)], m.segments) {
+			return common.ZeroProtoPath, false, ""
+		}
+		return common.UncheckedCreateProtoPath("/" + strings.Join(segments[len(m.segments):], "/")), true, ""
+	}
+
+	if !slices.Equal(segments, m.segments[:len(segments)]) {
+		return common.ZeroProtoPath, false, ""
+	}
+	return common.ZeroProtoPath, false, m.segments[len(segments)]
+}
+
+// candidates returns the entries that mounts have at the specified path, in mount order.
+// Returns fs.ErrNotExist if no mount has anything at the path.
+func (s *CompositeShare) candidates(path common.ProtoPath) ([]candidate, error) {
+	segments := path.ToSegments()
+
+	var cands []candidate
+	var firstErr error
+	for i := range s.mounts {
+		mount := &s.mounts[i]
+
+		rel, inside, next := mount.locate(segments)
+		if next != "" {
+			cands = append(cands, candidate{
+				meta: &pb.MsgFileMeta{Name: path.Name(), IsDir: true},
+				next: next,
+			})
+			continue
+		}
+		if !inside {
+			continue
+		}
+
+		meta, err := mount.Share.GetFileMeta(rel)
+		if err != nil {
+			if !errors.Is(err, fs.ErrNotExist) && firstErr == nil {
+				firstErr = err
+			}
+			continue
+		}
+		cands = append(cands, candidate{
+			meta:  meta,
+			mount: mount,
+			rel:   rel,
+		})
+	}
+
+	if len(cands) == 0 {
+		if firstErr != nil {
+			return nil, firstErr
+		}
+		return nil, fs.ErrNotExist
+	}
+
+	return cands, nil
+}
+
+// pick returns the candidate that wins according to the share's conflict rule.
+// cands must not be empty.
+func (s *CompositeShare) pick(cands []candidate) candidate {
+	best := cands[0]
+	for _, cand := range cands[1:] {
+		if best.meta.IsDir {
+			break
+		}
+		if cand.meta.IsDir || (s.rule == ConflictRuleNewest && cand.meta.GetModifiedTs() > best.meta.GetModifiedTs()) {
+			best = cand
+		}
+	}
+	return best
+}
+
+// withName returns the metadata with its name replaced, without modifying the original.
+func withName(meta *pb.MsgFileMeta, name string) *pb.MsgFileMeta {
+	if meta.Name == name {
+		return meta
+	}
+
+	return &pb.MsgFileMeta{
+		Name:       name,
+		IsDir:      meta.IsDir,
+		Size:       meta.Size,
+		ModifiedTs: meta.ModifiedTs,
+		Sha256:     meta.Sha256,
+	}
+}
+
+func (s *CompositeShare) GetFileMeta(path common.ProtoPath) (*pb.MsgFileMeta, error) {
+	if path.IsRoot() {
+		return &pb.MsgFileMeta{
+			Name:  "/",
+			IsDir: true,
+			Size:  0,
+		}, nil
+	}
+
+	cands, err := s.candidates(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return withName(s.pick(cands).meta, path.Name()), nil
+}
+
+func (s *CompositeShare) DirFiles(path common.ProtoPath) ([]*pb.MsgFileMeta, error) {
+	cands, err := s.candidates(path)
+	if err != nil {
+		// The root always exists, even if nothing is mounted there.
+		if !path.IsRoot() || !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+	}
+	if len(cands) > 0 && !s.pick(cands).meta.IsDir {
+		return nil, &fs.PathError{Op: "readdir", Path: path.String(), Err: syscall.ENOTDIR}
+	}
+
+	// Entries are grouped by name, and names are kept in the order they are first seen.
+	var names []string
+	byName := make(map[string][]candidate)
+	add := func(cand candidate) {
+		if _, has := byName[cand.meta.Name]; !has {
+			names = append(names, cand.meta.Name)
+		}
+		byName[cand.meta.Name] = append(byName[cand.meta.Name], cand)
+	}
+
+	for _, cand := range cands {
+		if !cand.meta.IsDir {
+			// A file that is hidden by a directory at the same path.
+			continue
+		}
+
+		if cand.mount == nil {
+			add(candidate{meta: &pb.MsgFileMeta{Name: cand.next, IsDir: true}})
+			continue
+		}
+
+		files, err := cand.mount.Share.DirFiles(cand.rel)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		for _, file := range files {
+			add(candidate{meta: file, mount: cand.mount})
+		}
+	}
+
+	out := make([]*pb.MsgFileMeta, 0, len(names))
+	for _, name := range names {
+		out = append(out, s.pick(byName[name]).meta)
+	}
+
+	return out, nil
+}
+
+func (s *CompositeShare) GetFile(
+	path common.ProtoPath,
+	offset uint64,
+	limit uint64,
+) (*pb.MsgFileMeta, io.ReadCloser, error) {
+	if path.IsRoot() {
+		return &pb.MsgFileMeta{
+			Name:  "/",
+			IsDir: true,
+			Size:  0,
+		}, common.EofReadCloser{}, nil
+	}
+
+	cands, err := s.candidates(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	best := s.pick(cands)
+	if best.meta.IsDir {
+		// Directory; nothing to read.
+		return withName(best.meta, path.Name()), common.EofReadCloser{}, nil
+	}
+
+	return best.mount.Share.GetFile(best.rel, offset, limit)
+}
@@ -0,0 +1,148 @@
+package share
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+)
+
+func writeTestFile(t *testing.T, path string, content string, modTime time.Time) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(path, []byte(content), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chtimes(path, modTime, modTime); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// newTestCompositeShare creates a CompositeShare from pairs of virtual paths and directories.
+func newTestCompositeShare(t *testing.T, rule ConflictRule, mounts ...[2]string) *CompositeShare {
+	compositeMounts := make([]Mount, 0, len(mounts))
+	for _, mount := range mounts {
+		dirShare, err := NewDirShare("test", mount[1], false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		compositeMounts = append(compositeMounts, Mount{
+			Path:  common.UncheckedCreateProtoPath(mount[0]),
+			Share: dirShare,
+		})
+	}
+	return NewCompositeShare("test", rule, compositeMounts)
+}
+
+func readTestFile(t *testing.T, s Share, path string) string {
+	_, rc, err := s.GetFile(common.UncheckedCreateProtoPath(path), 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = rc.Close()
+	}()
+
+	data, err := io.ReadAll(rc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(data)
+}
+
+func TestCompositeShareMerge(t *testing.T) {
+	old := time.Now().Add(-time.Hour)
+	now := time.Now()
+
+	root := t.TempDir()
+	a := t.TempDir()
+	b := t.TempDir()
+	writeTestFile(t, filepath.Join(root, "readme.txt"), "root", now)
+	writeTestFile(t, filepath.Join(a, "song.mp3"), "a", old)
+	writeTestFile(t, filepath.Join(a, "album", "1.mp3"), "a", old)
+	writeTestFile(t, filepath.Join(b, "song.mp3"), "b", now)
+	writeTestFile(t, filepath.Join(b, "album", "2.flac"), "b", now)
+
+	mounts := [][2]string{{"/", root}, {"/media/music", a}, {"/media/music", b}}
+	s := newTestCompositeShare(t, ConflictRuleFirst, mounts...)
+
+	// Directories leading up to a mount exist.
+	files, err := s.DirFiles(common.RootProtoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, file := range files {
+		names = append(names, file.Name)
+	}
+	slices.Sort(names)
+	if !slices.Equal(names, []string{"media", "readme.txt"}) {
+		t.Fatalf("unexpected root files %v", names)
+	}
+	meta, err := s.GetFileMeta(common.UncheckedCreateProtoPath("/media"))
+	if err != nil || !meta.IsDir || meta.Name != "media" {
+		t.Fatalf("expected /media to be a directory, got %v, %v", meta, err)
+	}
+
+	// Directories at the same path are merged.
+	files, err = s.DirFiles(common.UncheckedCreateProtoPath("/media/music/album"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected merged directory to have 2 files, got %d", len(files))
+	}
+	files, err = s.DirFiles(common.UncheckedCreateProtoPath("/media/music"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected conflicting files to be listed once, got %d files", len(files))
+	}
+
+	// The first mount wins conflicts.
+	if content := readTestFile(t, s, "/media/music/song.mp3"); content != "a" {
+		t.Fatalf("expected file from first mount, got %q", content)
+	}
+
+	// The newest file wins conflicts.
+	s = newTestCompositeShare(t, ConflictRuleNewest, mounts...)
+	if content := readTestFile(t, s, "/media/music/song.mp3"); content != "b" {
+		t.Fatalf("expected newest file, got %q", content)
+	}
+
+	if _, err = s.GetFileMeta(common.UncheckedCreateProtoPath("/media/video")); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected fs.ErrNotExist, got %v", err)
+	}
+}
+
+func TestCompositeShareDirectoryWinsOverFile(t *testing.T) {
+	a := t.TempDir()
+	b := t.TempDir()
+	writeTestFile(t, filepath.Join(a, "docs"), "file", time.Now())
+	writeTestFile(t, filepath.Join(b, "docs", "guide.txt"), "guide", time.Now())
+
+	s := newTestCompositeShare(t, ConflictRuleFirst, [2]string{"/", a}, [2]string{"/", b})
+
+	meta, err := s.GetFileMeta(common.UncheckedCreateProtoPath("/docs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !meta.IsDir {
+		t.Fatal("expected directory to win over file")
+	}
+	files, err := s.DirFiles(common.UncheckedCreateProtoPath("/docs"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 || files[0].Name != "guide.txt" {
+		t.Fatalf("unexpected files %v", files)
+	}
+}
@@ -0,0 +1,250 @@
+package share
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"path"
+	"regexp"
+	"runtime"
+	"strings"
+
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// ErrInvalidExcludePattern is returned when trying to use an invalid exclude pattern.
+var ErrInvalidExcludePattern = errors.New("invalid exclude pattern")
+
+// MaxShareExcludes is the maximum number of exclude patterns a share can have.
+const MaxShareExcludes = 256
+
+// MaxExcludePatternLength is the maximum length of an exclude pattern, in bytes.
+const MaxExcludePatternLength = 1024
+
+// excludeRegexPrefix is the prefix of exclude patterns that are regular expressions.
+const excludeRegexPrefix = "re:"
+
+// excludeFoldCase is whether exclude patterns ignore case.
+// Windows and macOS file systems are case-insensitive by default, so "/PRIVATE" names the same directory as "/private"
+// and must be excluded by the same patterns.
+var excludeFoldCase = runtime.GOOS == "windows" || runtime.GOOS == "darwin"
+
+// ExcludeRule is a parsed exclude pattern.
+// Patterns are globs by default:
+//   - A pattern without a slash, such as "*.tmp", matches the name of a file or directory at any depth.
+//   - A pattern with a slash, such as "/photos/private", matches a path from the share's root.
+//   - A pattern that ends with a slash, such as ".git/", only matches directories.
+//
+// Patterns that start with "re:" are regular expressions that match anywhere in the full path, such as "/photos/a.jpg".
+//
+// Excluding a directory excludes everything in it.
+// On Windows and macOS, patterns ignore case.
+type ExcludeRule struct {
+	pattern  string
+	dirOnly  bool
+	anchored bool
+	foldCase bool
+	re       *regexp.Regexp
+}
+
+// ParseExcludeRule parses an exclude pattern.
+// Returns an error wrapping ErrInvalidExcludePattern if it is invalid.
+func ParseExcludeRule(pattern string) (ExcludeRule, error) {
+	return parseExcludeRule(pattern, excludeFoldCase)
+}
+
+// parseExcludeRule is like ParseExcludeRule, but ignores case only if foldCase is true.
+func parseExcludeRule(pattern string, foldCase bool) (ExcludeRule, error) {
+	if pattern == "" || len(pattern) > MaxExcludePatternLength {
+		return ExcludeRule{}, fmt.Errorf(`%w: %q`, ErrInvalidExcludePattern, pattern)
+	}
+
+	if expr, isRegex := strings.CutPrefix(pattern, excludeRegexPrefix); isRegex {
+		if foldCase {
+			expr = "(?i)" + expr
+		}
+		re, err := regexp.Compile(expr)
+		if err != nil {
+			return ExcludeRule{}, fmt.Errorf(`%w: %w`, ErrInvalidExcludePattern, err)
+		}
+		return ExcludeRule{re: re}, nil
+	}
+
+	glob, dirOnly := strings.CutSuffix(pattern, "/")
+	anchored := strings.Contains(glob, "/")
+	glob = strings.TrimPrefix(glob, "/")
+	if glob == "" {
+		return ExcludeRule{}, fmt.Errorf(`%w: %q`, ErrInvalidExcludePattern, pattern)
+	}
+	if _, err := path.Match(glob, ""); err != nil {
+		return ExcludeRule{}, fmt.Errorf(`%w: %w`, ErrInvalidExcludePattern, err)
+	}
+	if foldCase {
+		glob = strings.ToLower(glob)
+	}
+
+	return ExcludeRule{
+		pattern:  glob,
+		dirOnly:  dirOnly,
+		anchored: anchored,
+		foldCase: foldCase,
+	}, nil
+}
+
+// ParseExcludeRules parses exclude patterns.
+// Returns an error wrapping ErrInvalidExcludePattern if any of them is invalid, or if there are more than
+// MaxShareExcludes.
+func ParseExcludeRules(patterns []string) ([]ExcludeRule, error) {
+	if len(patterns) > MaxShareExcludes {
+		return nil, fmt.Errorf(`%w: cannot have more than %d patterns`, ErrInvalidExcludePattern, MaxShareExcludes)
+	}
+
+	rules := make([]ExcludeRule, len(patterns))
+	for i, pattern := range patterns {
+		rule, err := ParseExcludeRule(pattern)
+		if err != nil {
+			return nil, err
+		}
+		rules[i] = rule
+	}
+	return rules, nil
+}
+
+// matches returns whether the rule matches the path with the specified segments.
+func (r ExcludeRule) matches(segments []string, isDir bool) bool {
+	if r.re != nil {
+		return r.re.MatchString("/" + strings.Join(segments, "/"))
+	}
+	if r.dirOnly && !isDir {
+		return false
+	}
+
+	var subject string
+	if r.anchored {
+		subject = strings.Join(segments, "/")
+	} else {
+		subject = segments[len(segments)-1]
+	}
+	if r.foldCase {
+		subject = strings.ToLower(subject)
+	}
+
+	// The pattern was checked when parsing, so there cannot be an error.
+	matched, _ := path.Match(r.pattern, subject)
+	return matched
+}
+
+// ExcludeShare is an implementation of Share that hides paths matching exclude rules from another share.
+// Excluded paths behave as if they do not exist.
+type ExcludeShare struct {
+	share Share
+	rules []ExcludeRule
+}
+
+var _ Share = (*ExcludeShare)(nil)
+
+// NewExcludeShare creates a new ExcludeShare that hides paths in share that match any of the rules.
+func NewExcludeShare(share Share, rules []ExcludeRule) *ExcludeShare {
+	return &ExcludeShare{
+		share: share,
+		rules: rules,
+	}
+}
+
+// Unwrap returns the share that paths are hidden from.
+func (s *ExcludeShare) Unwrap() Share {
+	return s.share
+}
+
+// Close closes the underlying share.
+func (s *ExcludeShare) Close() error {
+	return s.share.Close()
+}
+
+func (s *ExcludeShare) Name() string {
+	return s.share.Name()
+}
+
+// isExcluded returns whether the path with the specified segments matches any rule.
+func (s *ExcludeShare) isExcluded(segments []string, isDir bool) bool {
+	for _, rule := range s.rules {
+		if rule.matches(segments, isDir) {
+			return true
+		}
+	}
+	return false
+}
+
+// isParentExcluded returns whether any of the directories containing the path with the specified segments is excluded.
+func (s *ExcludeShare) isParentExcluded(segments []string) bool {
+	for i := 1; i < len(segments); i++ {
+		if s.isExcluded(segments[:i], true) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *ExcludeShare) GetFileMeta(path common.ProtoPath) (*pb.MsgFileMeta, error) {
+	segments := path.ToSegments()
+	if s.isParentExcluded(segments) {
+		return nil, fs.ErrNotExist
+	}
+
+	meta, err := s.share.GetFileMeta(path)
+	if err != nil {
+		return nil, err
+	}
+	if len(segments) > 0 && s.isExcluded(segments, meta.IsDir) {
+		return nil, fs.ErrNotExist
+	}
+
+	return meta, nil
+}
+
+func (s *ExcludeShare) DirFiles(path common.ProtoPath) ([]*pb.MsgFileMeta, error) {
+	segments := path.ToSegments()
+	if s.isParentExcluded(segments) || (len(segments) > 0 && s.isExcluded(segments, true)) {
+		return nil, fs.ErrNotExist
+	}
+
+	files, err := s.share.DirFiles(path)
+	if err != nil {
+		return nil, err
+	}
+
+	childSegments := append(segments, "")
+	out := files[:0]
+	for _, file := range files {
+		childSegments[len(childSegments)-1] = file.Name
+		if !s.isExcluded(childSegments, file.IsDir) {
+			out = append(out, file)
+		}
+	}
+
+	return out, nil
+}
+
+func (s *ExcludeShare) GetFile(
+	path common.ProtoPath,
+	offset uint64,
+	limit uint64,
+) (*pb.MsgFileMeta, io.ReadCloser, error) {
+	segments := path.ToSegments()
+	if s.isParentExcluded(segments) {
+		return nil, nil, fs.ErrNotExist
+	}
+
+	meta, rc, err := s.share.GetFile(path, offset, limit)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(segments) > 0 && s.isExcluded(segments, meta.IsDir) {
+		_ = rc.Close()
+		return nil, nil, fs.ErrNotExist
+	}
+
+	return meta, rc, nil
+}
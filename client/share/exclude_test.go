@@ -0,0 +1,108 @@
+package share
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+)
+
+func TestExcludeShare(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+	writeTestFile(t, filepath.Join(dir, "song.mp3"), "song", now)
+	writeTestFile(t, filepath.Join(dir, "song.mp3.tmp"), "partial", now)
+	writeTestFile(t, filepath.Join(dir, "notes.bak"), "backup", now)
+	writeTestFile(t, filepath.Join(dir, "project", ".git", "HEAD"), "ref", now)
+	writeTestFile(t, filepath.Join(dir, "project", "main.go"), "code", now)
+	writeTestFile(t, filepath.Join(dir, "private", "diary.txt"), "secret", now)
+	writeTestFile(t, filepath.Join(dir, "music", "private"), "a file, not the private directory", now)
+
+	rules, err := ParseExcludeRules([]string{"*.tmp", ".git/", "/private", `re:\.bak$`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dirShare, err := NewDirShare("test", dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewExcludeShare(dirShare, rules)
+
+	files, err := s.DirFiles(common.RootProtoPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, file := range files {
+		names = append(names, file.Name)
+	}
+	slices.Sort(names)
+	if !slices.Equal(names, []string{"music", "project", "song.mp3"}) {
+		t.Fatalf("unexpected root files %v", names)
+	}
+
+	for _, path := range []string{"/song.mp3.tmp", "/notes.bak", "/project/.git", "/project/.git/HEAD", "/private/diary.txt"} {
+		if _, err = s.GetFileMeta(common.UncheckedCreateProtoPath(path)); !errors.Is(err, fs.ErrNotExist) {
+			t.Fatalf("expected %s to be excluded, got %v", path, err)
+		}
+		if _, _, err = s.GetFile(common.UncheckedCreateProtoPath(path), 0, 0); !errors.Is(err, fs.ErrNotExist) {
+			t.Fatalf("expected %s to be excluded from reads, got %v", path, err)
+		}
+	}
+	if _, err = s.DirFiles(common.UncheckedCreateProtoPath("/project/.git")); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected excluded directory to not be listed, got %v", err)
+	}
+
+	// Anchored patterns only match from the root.
+	if _, err = s.GetFileMeta(common.UncheckedCreateProtoPath("/music/private")); err != nil {
+		t.Fatalf("expected /music/private to not be excluded, got %v", err)
+	}
+	if content := readTestFile(t, s, "/project/main.go"); content != "code" {
+		t.Fatalf("unexpected content %q", content)
+	}
+}
+
+func TestExcludeRuleFoldCase(t *testing.T) {
+	cases := []struct {
+		pattern string
+		path    string
+		isDir   bool
+	}{
+		{"/private", "/PRIVATE", true},
+		{"/photos/private", "/Photos/Private", true},
+		{"*.TMP", "/music/song.tmp", false},
+		{".git/", "/project/.GIT", true},
+		{`re:^/private/`, "/PRIVATE/diary.txt", false},
+	}
+	for _, c := range cases {
+		segments := common.UncheckedCreateProtoPath(c.path).ToSegments()
+
+		rule, err := parseExcludeRule(c.pattern, true)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !rule.matches(segments, c.isDir) {
+			t.Errorf("expected %q to match %s when ignoring case", c.pattern, c.path)
+		}
+
+		rule, err = parseExcludeRule(c.pattern, false)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if rule.matches(segments, c.isDir) {
+			t.Errorf("expected %q not to match %s when case-sensitive", c.pattern, c.path)
+		}
+	}
+}
+
+func TestParseExcludeRuleInvalid(t *testing.T) {
+	for _, pattern := range []string{"", "/", "[", "re:("} {
+		if _, err := ParseExcludeRule(pattern); !errors.Is(err, ErrInvalidExcludePattern) {
+			t.Fatalf("expected ErrInvalidExcludePattern for %q, got %v", pattern, err)
+		}
+	}
+}
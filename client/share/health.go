@@ -0,0 +1,232 @@
+package share
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"unicode/utf8"
+
+	"friendnet.org/client/fsys"
+)
+
+// DefaultMaxHealthIssues is the default maximum number of issues a share health check reports.
+const DefaultMaxHealthIssues = 1_000
+
+// MaxHealthIssues is the maximum number of issues a share health check can report.
+const MaxHealthIssues = 10_000
+
+// maxNameBytes is the longest file name, in bytes, that common filesystems such as NTFS, APFS and ext4 can store.
+const maxNameBytes = 255
+
+// HealthIssueKind is a kind of problem with an entry in a share.
+type HealthIssueKind int
+
+const (
+	// HealthIssueInvalidName is an entry whose name is not valid UTF-8.
+	// It cannot be part of a path, so it is not shared.
+	HealthIssueInvalidName HealthIssueKind = iota + 1
+
+	// HealthIssueUnreadable is an entry that could not be read, such as because of missing permissions.
+	// It is not shared.
+	HealthIssueUnreadable
+
+	// HealthIssueBrokenLink is a symbolic link whose target does not exist.
+	// It is not shared.
+	HealthIssueBrokenLink
+
+	// HealthIssueWindowsName is an entry whose name is reserved on Windows or has characters Windows does not allow,
+	// such as "CON" or "a:b".
+	// It is shared, but peers on Windows save it under a different name.
+	HealthIssueWindowsName
+
+	// HealthIssueNameTooLong is an entry whose name is longer than most filesystems can store.
+	// It is shared, but peers may not be able to save it.
+	HealthIssueNameTooLong
+)
+
+// HealthIssue is a problem with an entry in a share.
+type HealthIssue struct {
+	// The entry's path in the share.
+	// Invalid UTF-8 in names is replaced with U+FFFD.
+	Path string
+
+	Kind HealthIssueKind
+
+	// The error that occurred, for HealthIssueUnreadable.
+	Err error
+}
+
+// HealthReport is the result of a share health check.
+type HealthReport struct {
+	Issues []HealthIssue
+
+	// Whether the check stopped early because it found the maximum number of issues.
+	Truncated bool
+}
+
+// healthCheck scans the directories of a share for entries that cannot be shared, or that peers may have trouble with.
+type healthCheck struct {
+	ctx       context.Context
+	maxIssues int
+
+	// The ExcludeShare the share is wrapped in, or nil if it has no exclude patterns.
+	// Excluded entries are not reported.
+	exclude *ExcludeShare
+
+	report HealthReport
+}
+
+// healthDir is a directory queued to be checked.
+type healthDir struct {
+	osPath   string
+	segments []string
+}
+
+// add reports an issue with the entry at the specified path segments.
+// Returns false if the maximum number of issues was reached.
+func (c *healthCheck) add(segments []string, kind HealthIssueKind, err error) bool {
+	if len(c.report.Issues) >= c.maxIssues {
+		c.report.Truncated = true
+		return false
+	}
+
+	path := "/" + strings.ToValidUTF8(strings.Join(segments, "/"), "�")
+	c.report.Issues = append(c.report.Issues, HealthIssue{
+		Path: path,
+		Kind: kind,
+		Err:  err,
+	})
+	return true
+}
+
+// checkMount checks all entries in a mounted DirShare.
+func (c *healthCheck) checkMount(share *DirShare, mount Mount) error {
+	queue := []healthDir{{osPath: share.dir, segments: mount.Path.ToSegments()}}
+
+	for len(queue) > 0 {
+		if err := c.ctx.Err(); err != nil {
+			return err
+		}
+
+		dir := queue[0]
+		queue = queue[1:]
+
+		entries, err := os.ReadDir(dir.osPath)
+		if err != nil {
+			if errors.Is(err, fs.ErrNotExist) {
+				continue
+			}
+			if !c.add(dir.segments, HealthIssueUnreadable, err) {
+				return nil
+			}
+			continue
+		}
+
+		for _, entry := range entries {
+			name := entry.Name()
+			segments := append(dir.segments[:len(dir.segments):len(dir.segments)], name)
+
+			if !utf8.ValidString(name) {
+				if !c.add(segments, HealthIssueInvalidName, nil) {
+					return nil
+				}
+				continue
+			}
+			if c.exclude != nil && c.exclude.isExcluded(segments, entry.IsDir()) {
+				continue
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				if errors.Is(err, fs.ErrNotExist) {
+					continue
+				}
+				if !c.add(segments, HealthIssueUnreadable, err) {
+					return nil
+				}
+				continue
+			}
+
+			osPath := filepath.Join(dir.osPath, name)
+			isLink := info.Mode()&os.ModeSymlink != 0
+			if isLink {
+				if !share.followLinks {
+					// Links are deliberately not shared.
+					continue
+				}
+
+				info, err = os.Stat(osPath)
+				if err != nil {
+					kind := HealthIssueUnreadable
+					if errors.Is(err, fs.ErrNotExist) {
+						kind = HealthIssueBrokenLink
+						err = nil
+					}
+					if !c.add(segments, kind, err) {
+						return nil
+					}
+					continue
+				}
+			}
+			if !info.Mode().IsRegular() && !info.IsDir() {
+				// Pipes, devices and the like are deliberately not shared.
+				continue
+			}
+
+			if len(name) > maxNameBytes {
+				if !c.add(segments, HealthIssueNameTooLong, nil) {
+					return nil
+				}
+			} else if fsys.StrictReplacer(name) != name {
+				if !c.add(segments, HealthIssueWindowsName, nil) {
+					return nil
+				}
+			}
+
+			// Linked directories are not descended into, since they can link back to their parents.
+			if info.IsDir() && !isLink {
+				queue = append(queue, healthDir{osPath: osPath, segments: segments})
+			}
+		}
+	}
+
+	return nil
+}
+
+// checkShareHealth checks all directories that a share serves files from.
+// Stops after finding maxIssues issues.
+func checkShareHealth(ctx context.Context, share Share, maxIssues int) (HealthReport, error) {
+	c := &healthCheck{
+		ctx:       ctx,
+		maxIssues: maxIssues,
+	}
+
+	for wrapped := share; ; {
+		if exclude, ok := wrapped.(*ExcludeShare); ok {
+			c.exclude = exclude
+		}
+		wrapper, ok := wrapped.(shareWrapper)
+		if !ok {
+			break
+		}
+		wrapped = wrapper.Unwrap()
+	}
+
+	for _, mount := range dirMounts(share) {
+		dirShare, ok := mount.Share.(*DirShare)
+		if !ok {
+			continue
+		}
+		if err := c.checkMount(dirShare, mount); err != nil {
+			return c.report, err
+		}
+		if c.report.Truncated {
+			break
+		}
+	}
+
+	return c.report, nil
+}
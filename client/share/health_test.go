@@ -0,0 +1,91 @@
+package share
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+)
+
+func TestCheckShareHealth(t *testing.T) {
+	if runtime.GOOS == "windows" {
+		t.Skip("names used by this test cannot be created on Windows")
+	}
+
+	dir := t.TempDir()
+	now := time.Now()
+	writeTestFile(t, filepath.Join(dir, "fine.txt"), "fine", now)
+	writeTestFile(t, filepath.Join(dir, "a:b.txt"), "colon", now)
+	writeTestFile(t, filepath.Join(dir, "sub", "CON"), "reserved", now)
+	writeTestFile(t, filepath.Join(dir, "sub", "bad\xff.txt"), "invalid", now)
+	writeTestFile(t, filepath.Join(dir, "skip", "bad\xff.txt"), "excluded", now)
+	if err := os.Symlink(filepath.Join(dir, "missing"), filepath.Join(dir, "broken")); err != nil {
+		t.Fatal(err)
+	}
+
+	rules, err := ParseExcludeRules([]string{"/skip"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	dirShare, err := NewDirShare("test", dir, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := NewExcludeShare(dirShare, rules)
+
+	report, err := checkShareHealth(context.Background(), s, DefaultMaxHealthIssues)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Truncated {
+		t.Fatal("expected report to not be truncated")
+	}
+
+	got := make(map[string]HealthIssueKind)
+	for _, issue := range report.Issues {
+		got[issue.Path] = issue.Kind
+	}
+	expected := map[string]HealthIssueKind{
+		"/a:b.txt":      HealthIssueWindowsName,
+		"/broken":       HealthIssueBrokenLink,
+		"/sub/CON":      HealthIssueWindowsName,
+		"/sub/bad�.txt": HealthIssueInvalidName,
+	}
+	if len(got) != len(expected) {
+		t.Fatalf("expected issues %v, got %v", expected, got)
+	}
+	for path, kind := range expected {
+		if got[path] != kind {
+			t.Fatalf("expected issue %d at %s, got %v", kind, path, got)
+		}
+	}
+
+	// Entries that cannot be shared are left out of listings instead of failing them.
+	files, err := s.DirFiles(common.UncheckedCreateProtoPath("/sub"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, file := range files {
+		names = append(names, file.Name)
+	}
+	if !slices.Equal(names, []string{"CON"}) {
+		t.Fatalf("unexpected files %v", names)
+	}
+	if _, err = s.DirFiles(common.RootProtoPath); err != nil {
+		t.Fatalf("expected root to be listed despite the broken link, got %v", err)
+	}
+
+	report, err = checkShareHealth(context.Background(), s, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Issues) != 1 || !report.Truncated {
+		t.Fatalf("expected 1 issue and a truncated report, got %+v", report)
+	}
+}
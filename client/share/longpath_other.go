@@ -0,0 +1,8 @@
+//go:build !windows
+
+package share
+
+// longPath returns path unchanged, since only Windows has a separate form for long paths.
+func longPath(path string) string {
+	return path
+}
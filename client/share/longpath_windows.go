@@ -0,0 +1,23 @@
+//go:build windows
+
+package share
+
+import "strings"
+
+// longPathThreshold is the length at which paths need the extended-length prefix.
+// Directories are limited to MAX_PATH (260) minus room for an 8.3 file name (12).
+const longPathThreshold = 248
+
+// longPath returns the extended-length form of an absolute path if it is too long for the regular Windows APIs.
+// Go's os package does this by itself, but other code that is handed the share's directory, such as the watcher, may
+// not.
+func longPath(path string) string {
+	if len(path) < longPathThreshold || strings.HasPrefix(path, `\\?\`) || strings.HasPrefix(path, `\\.\`) {
+		return path
+	}
+
+	if unc, isUnc := strings.CutPrefix(path, `\\`); isUnc {
+		return `\\?\UNC\` + unc
+	}
+	return `\\?\` + path
+}
@@ -6,13 +6,16 @@ import (
 	"fmt"
 	"log/slog"
 	"os"
+	"path/filepath"
 	"strings"
 	"sync"
 	"syscall"
 	"time"
 
+	"friendnet.org/client/event"
 	"friendnet.org/client/storage"
 	"friendnet.org/common"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
 	pb "friendnet.org/protocol/pb/v1"
 )
 
@@ -25,16 +28,76 @@ var ErrShareExists = errors.New("share with same name exists")
 // ErrIndexingDisabled is returned when trying to index a share that has indexing disabled.
 var ErrIndexingDisabled = errors.New("indexing disabled for share")
 
+// DefaultMaxIndexFiles is the default maximum number of files indexed per share.
+const DefaultMaxIndexFiles = 1_000_000
+
 // ErrTooManyFiles is returned when trying to index a share that has too many files.
 var ErrTooManyFiles = errors.New("too many files in share, indexing canceled")
 
 // ErrInvalidShareName is returned when trying to create a share with an invalid name.
 var ErrInvalidShareName = errors.New("invalid share name")
 
+// ErrInvalidMount is returned when trying to create a share with an invalid mount or conflict rule.
+var ErrInvalidMount = errors.New("invalid share mount")
+
+// ErrInvalidPathMatch is returned when trying to create a share with invalid path match options.
+var ErrInvalidPathMatch = errors.New("invalid path match options")
+
+// MaxShareMounts is the maximum number of mounts a share can have, in addition to its own path.
+const MaxShareMounts = 64
+
+// DirMount is a directory on disk that is mounted under a virtual path in a share, in addition to the share's own path.
+// Shares with mounts are served by a CompositeShare, with the share's own path mounted at "/" before all other mounts.
+type DirMount struct {
+	// The path in the share that the directory appears at.
+	VirtualPath common.ProtoPath
+
+	// The directory's absolute path on disk.
+	Dir string
+}
+
+// ShareOptions are the settings of a share besides its name and path.
+type ShareOptions struct {
+	// Whether symlinks are followed.
+	FollowLinks bool
+
+	// Directories mounted in the share in addition to its own path.
+	Mounts []DirMount
+
+	// How files that exist at the same path in more than one mounted directory are resolved.
+	ConflictRule ConflictRule
+
+	// Patterns of paths to hide from the share.
+	// See ExcludeRule for their syntax.
+	Excludes []string
+
+	// How paths requested by peers are matched against the names of files in the share.
+	// If not exact, the share is wrapped in a MatchShare.
+	PathMatch common.PathMatchOptions
+}
+
+// shareWrapper is implemented by shares that wrap another share.
+type shareWrapper interface {
+	Unwrap() Share
+}
+
 type shareData struct {
 	share       Share
 	record      storage.ShareRecord
 	lastIndexId int64
+
+	// The share's mounts, in addition to its own path.
+	mounts []DirMount
+
+	// The share's exclude patterns.
+	excludes []string
+
+	// The share's watchers, one for each watched directory.
+	watchers []*dirWatcher
+
+	// The share's revision.
+	// It is incremented every time the watcher detects changes.
+	revision uint64
 }
 
 // Manager manages shares for a server.
@@ -47,12 +110,20 @@ type Manager struct {
 	ctx       context.Context
 	ctxCancel context.CancelFunc
 
-	serverUuid string
-	storage    *storage.Storage
+	serverUuid     string
+	storage        *storage.Storage
+	eventPublisher *event.Publisher
 
 	// A mapping of share names to their underlying Share instances.
 	shareMap map[string]*shareData
 
+	// The revision of all shares together.
+	// It is incremented every time a share is added, deleted or changed.
+	sharesRevision uint64
+
+	// Closed and replaced every time sharesRevision is incremented.
+	sharesRevisionChanged chan struct{}
+
 	indexerInterval         time.Duration
 	indexingShares          map[string]struct{}
 	indexerMaxFiles         int
@@ -61,10 +132,14 @@ type Manager struct {
 
 // NewManager creates a new share manager for the given server.
 // It gets share records for the server and instantiates Share instances for them.
+// Shares are watched for changes, which are published to eventPublisher.
+// Indexing a share with more than maxIndexFiles files is canceled; if 0, DefaultMaxIndexFiles is used.
 func NewManager(
 	logger *slog.Logger,
 	serverUuid string,
 	storage *storage.Storage,
+	eventPublisher *event.Publisher,
+	maxIndexFiles int,
 ) (*Manager, error) {
 	ctx, ctxCancel := context.WithCancel(context.Background())
 
@@ -77,41 +152,349 @@ func NewManager(
 
 	shareMap := make(map[string]*shareData, len(records))
 	for _, record := range records {
+		var mounts []DirMount
+		mounts, err = getDirMounts(ctx, storage, record.Uuid)
+		if err != nil {
+			ctxCancel()
+			return nil, err
+		}
+		var excludes []string
+		excludes, err = storage.GetShareExcludes(ctx, record.Uuid)
+		if err != nil {
+			ctxCancel()
+			return nil, err
+		}
+
 		var share Share
-		share, err = NewDirShare(
-			record.Name,
-			record.Path.String(),
-			record.FollowLinks,
-		)
+		share, err = newShare(record, mounts, excludes)
 		shareMap[record.Name] = &shareData{
-			share:  share,
-			record: record,
+			share:    share,
+			record:   record,
+			mounts:   mounts,
+			excludes: excludes,
 		}
 	}
 
+	if maxIndexFiles <= 0 {
+		maxIndexFiles = DefaultMaxIndexFiles
+	}
+
 	m := &Manager{
 		ctx:       ctx,
 		ctxCancel: ctxCancel,
 
 		logger: logger,
 
-		serverUuid: serverUuid,
-		storage:    storage,
+		serverUuid:     serverUuid,
+		storage:        storage,
+		eventPublisher: eventPublisher,
 
-		shareMap: shareMap,
+		shareMap:              shareMap,
+		sharesRevisionChanged: make(chan struct{}),
 
 		indexerInterval:         1 * time.Hour,
 		indexingShares:          make(map[string]struct{}),
-		indexerMaxFiles:         1_000_000,
+		indexerMaxFiles:         maxIndexFiles,
 		orphanedIndexGcInterval: 10 * time.Minute,
 	}
 
-	go m.indexerDaemon()
-	go m.orphanedIndexGc()
+	for _, data := range shareMap {
+		m.startWatcher(data)
+	}
+
+	common.Supervise(m.ctx, logger, "share.Manager.indexerDaemon", m.indexerDaemon)
+	common.Supervise(m.ctx, logger, "share.Manager.orphanedIndexGc", m.orphanedIndexGc)
 
 	return m, nil
 }
 
+// getDirMounts returns the mounts of the share with the specified UUID from storage.
+func getDirMounts(ctx context.Context, storage *storage.Storage, shareUuid string) ([]DirMount, error) {
+	records, err := storage.GetShareMounts(ctx, shareUuid)
+	if err != nil {
+		return nil, err
+	}
+
+	mounts := make([]DirMount, len(records))
+	for i, record := range records {
+		mounts[i] = DirMount{
+			VirtualPath: record.VirtualPath,
+			Dir:         record.Path,
+		}
+	}
+	return mounts, nil
+}
+
+// newShare creates a share instance for a record.
+// Shares without mounts are a DirShare, and shares with mounts are a CompositeShare.
+// Shares with exclude patterns are wrapped in an ExcludeShare, and shares that do not match paths exactly are wrapped
+// in a MatchShare, so that loosely matched paths are resolved to their real names before they are checked against
+// the exclude patterns.
+func newShare(rec storage.ShareRecord, mounts []DirMount, excludes []string) (Share, error) {
+	share, err := newMountedShare(rec, mounts)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(excludes) > 0 {
+		var rules []ExcludeRule
+		rules, err = ParseExcludeRules(excludes)
+		if err != nil {
+			_ = share.Close()
+			return nil, err
+		}
+		share = NewExcludeShare(share, rules)
+	}
+
+	opts := common.PathMatchOptions{
+		CaseInsensitive: rec.CaseInsensitive,
+		Form:            rec.UnicodeForm,
+	}
+	if !opts.IsExact() {
+		share = NewMatchShare(share, opts)
+	}
+
+	return share, nil
+}
+
+// newMountedShare creates a share instance for a record and its mounts.
+func newMountedShare(rec storage.ShareRecord, mounts []DirMount) (Share, error) {
+	dirShare, err := NewDirShare(
+		rec.Name,
+		rec.Path.String(),
+		rec.FollowLinks,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if len(mounts) == 0 {
+		return dirShare, nil
+	}
+
+	compositeMounts := make([]Mount, 0, len(mounts)+1)
+	compositeMounts = append(compositeMounts, Mount{Path: common.RootProtoPath, Share: dirShare})
+	for _, mount := range mounts {
+		var mounted *DirShare
+		mounted, err = NewDirShare(rec.Name, mount.Dir, rec.FollowLinks)
+		if err != nil {
+			return nil, err
+		}
+		compositeMounts = append(compositeMounts, Mount{Path: mount.VirtualPath, Share: mounted})
+	}
+
+	return NewCompositeShare(rec.Name, ConflictRule(rec.ConflictRule), compositeMounts), nil
+}
+
+// dirMounts returns the mounts that a share serves files from, looking through any shares it is wrapped in.
+// A share that is not mounted in a CompositeShare is returned as mounted at "/".
+func dirMounts(share Share) []Mount {
+	for {
+		wrapper, ok := share.(shareWrapper)
+		if !ok {
+			break
+		}
+		share = wrapper.Unwrap()
+	}
+
+	if composite, ok := share.(*CompositeShare); ok {
+		return composite.Mounts()
+	}
+	return []Mount{{Path: common.RootProtoPath, Share: share}}
+}
+
+// startWatcher starts watching the share's directories for changes.
+// Failures are logged, since the share still works without a watcher.
+func (m *Manager) startWatcher(data *shareData) {
+	m.mu.RLock()
+	share := data.share
+	m.mu.RUnlock()
+
+	// Excluded paths are watched too, since the exclude patterns can change while the watchers keep running.
+	mounts := dirMounts(share)
+
+	name := data.record.Name
+	watchers := make([]*dirWatcher, 0, len(mounts))
+	for _, mount := range mounts {
+		dirShare, ok := mount.Share.(*DirShare)
+		if !ok {
+			continue
+		}
+
+		// Changed paths are relative to the watched directory, so they are moved under the mount's path.
+		mountPath := mount.Path
+		watcher, err := newDirWatcher(m.logger, dirShare.dir, func(paths []common.ProtoPath) {
+			if mountPath.IsRoot() {
+				m.onShareChanged(name, paths)
+				return
+			}
+			sharePaths := make([]common.ProtoPath, len(paths))
+			for i, path := range paths {
+				sharePaths[i] = common.JoinPaths(mountPath, path)
+			}
+			m.onShareChanged(name, sharePaths)
+		})
+		if err != nil {
+			m.logger.Warn("failed to watch share for changes",
+				"service", "share.Manager",
+				"uuid", data.record.Uuid,
+				"name", name,
+				"dir", dirShare.dir,
+				"err", err,
+			)
+			continue
+		}
+		watchers = append(watchers, watcher)
+	}
+
+	m.mu.Lock()
+	data.watchers = watchers
+	m.mu.Unlock()
+}
+
+// onShareChanged is called by a share's watcher when paths in it changed.
+// It updates the search index for the changed paths, bumps the share's revision and publishes a change event.
+func (m *Manager) onShareChanged(name string, paths []common.ProtoPath) {
+	m.mu.Lock()
+	if m.isClosed {
+		m.mu.Unlock()
+		return
+	}
+	data, has := m.shareMap[name]
+	if !has {
+		m.mu.Unlock()
+		return
+	}
+	data.revision++
+	revision := data.revision
+	m.bumpSharesRevisionNoLock()
+	indexId := data.lastIndexId
+	_, isIndexing := m.indexingShares[data.record.Uuid]
+	share := data.share
+	rec := data.record
+	m.mu.Unlock()
+
+	// If a full index is running, it will pick up the changes itself.
+	if rec.EnableIndexing && !isIndexing {
+		for _, path := range paths {
+			err := m.reindexPath(m.ctx, share, rec.Uuid, indexId, path)
+			if errors.Is(err, ErrTooManyFiles) {
+				m.logger.Warn("share has too many files to index, stopped updating index for changed paths",
+					"service", "share.Manager",
+					"uuid", rec.Uuid,
+					"name", rec.Name,
+					"max_files", m.indexerMaxFiles,
+				)
+				break
+			}
+			if err != nil {
+				m.logger.Error("failed to update share index for changed path",
+					"service", "share.Manager",
+					"uuid", rec.Uuid,
+					"name", rec.Name,
+					"path", path.String(),
+					"err", err,
+				)
+			}
+		}
+	}
+
+	strPaths := make([]string, len(paths))
+	for i, path := range paths {
+		strPaths[i] = path.String()
+	}
+
+	if m.eventPublisher != nil {
+		m.eventPublisher.Publish(&v1.Event{
+			Type: v1.Event_TYPE_SHARE_CHANGED,
+			ShareChanged: &v1.Event_ShareChanged{
+				ShareName: name,
+				Revision:  revision,
+				Paths:     strPaths,
+			},
+		})
+	}
+}
+
+// reindexPath replaces the search index entries for a path and everything under it with its current state.
+// The entries left in the rest of the share count towards the indexer's max files, so it returns ErrTooManyFiles if
+// the share as a whole grew past it.
+func (m *Manager) reindexPath(ctx context.Context, share Share, shareUuid string, indexId int64, path common.ProtoPath) error {
+	err := m.storage.ClearShareIndexPath(ctx, shareUuid, path.String())
+	if err != nil {
+		return err
+	}
+
+	if path.IsRoot() {
+		_, err = m.insertIndexTree(ctx, share, shareUuid, indexId, "/", 0)
+		return err
+	}
+
+	count, err := m.storage.CountShareIndex(ctx, shareUuid)
+	if err != nil {
+		return err
+	}
+	if count >= m.indexerMaxFiles {
+		return ErrTooManyFiles
+	}
+
+	meta, err := share.GetFileMeta(path)
+	if err != nil {
+		// The path was removed or is inaccessible; clearing it was all that was needed.
+		if os.IsNotExist(err) || os.IsPermission(err) {
+			return nil
+		}
+		return err
+	}
+
+	err = m.storage.InsertShareIndex(ctx, shareUuid, indexId, path.String(), meta.IsDir, int64(meta.Size))
+	if err != nil {
+		return err
+	}
+
+	if meta.IsDir {
+		_, err = m.insertIndexTree(ctx, share, shareUuid, indexId, path.String(), count+1)
+		return err
+	}
+
+	return nil
+}
+
+// Revision returns the revision of the share with the specified name, and whether the share exists.
+// The revision starts at 0 and is incremented every time changes are detected in the share.
+func (m *Manager) Revision(name string) (uint64, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	data, has := m.shareMap[name]
+	if !has {
+		return 0, false
+	}
+	return data.revision, true
+}
+
+// SharesRevision returns the revision of all shares together, along with a channel that is closed once it changes.
+// The revision starts at 0 and is incremented every time a share is added, deleted or changed.
+func (m *Manager) SharesRevision() (uint64, <-chan struct{}) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	return m.sharesRevision, m.sharesRevisionChanged
+}
+
+// BumpSharesRevision increments the revision of all shares together without any share changing.
+// It is used when what peers can see changes for another reason, such as shares being hidden, so that cached
+// listings are discarded.
+func (m *Manager) BumpSharesRevision() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.bumpSharesRevisionNoLock()
+}
+
+func (m *Manager) bumpSharesRevisionNoLock() {
+	m.sharesRevision++
+	close(m.sharesRevisionChanged)
+	m.sharesRevisionChanged = make(chan struct{})
+}
+
 func (m *Manager) snapshotSharesNoLock() []Share {
 	slice := make([]Share, 0, len(m.shareMap))
 	for _, share := range m.shareMap {
@@ -121,14 +504,6 @@ func (m *Manager) snapshotSharesNoLock() []Share {
 }
 
 func (m *Manager) indexerDaemon() {
-	defer func() {
-		if rec := recover(); rec != nil {
-			m.logger.Error("share indexer daemon panicked",
-				"err", rec,
-			)
-		}
-	}()
-
 	do := func() {
 		m.mu.RLock()
 		recs := make([]storage.ShareRecord, 0, len(m.shareMap))
@@ -216,9 +591,9 @@ func (m *Manager) indexShare(ctx context.Context, name string) (count int, hasSh
 	}
 	curIndexId := time.Now().UnixMilli()
 	val.lastIndexId = curIndexId
+	share := val.share
 	m.mu.Unlock()
 
-	share := val.share
 	rec := val.record
 
 	if !rec.EnableIndexing {
@@ -254,26 +629,42 @@ func (m *Manager) indexShare(ctx context.Context, name string) (count int, hasSh
 		}
 	}()
 
-	dirs := []string{"/"}
+	count, err = m.insertIndexTree(ctx, share, rec.Uuid, curIndexId, "/", 0)
+	if err != nil {
+		if errors.Is(err, ErrTooManyFiles) {
+			shouldClearOld = true
+		}
+		return count, true, err
+	}
+
+	shouldClearOld = true
+	shouldOptimize = true
+
+	return count, true, nil
+}
+
+// insertIndexTree inserts search index entries for everything under the directory at root.
+// count is the number of files already indexed, and the new total is returned.
+// Returns ErrTooManyFiles if the total exceeds the indexer's max files.
+func (m *Manager) insertIndexTree(ctx context.Context, share Share, shareUuid string, indexId int64, root string, count int) (int, error) {
+	dirs := []string{root}
 
 	for len(dirs) > 0 {
 		dir := dirs[0]
 		dirs = dirs[1:]
 
-		var files []*pb.MsgFileMeta
-		files, err = share.DirFiles(common.UncheckedCreateProtoPath(dir))
+		files, err := share.DirFiles(common.UncheckedCreateProtoPath(dir))
 		if err != nil {
 			// Skip files that were removed or we do not have permission to access.
 			if os.IsNotExist(err) || os.IsPermission(err) || errors.Is(err, syscall.ESRCH) {
 				continue
 			}
 
-			return count, true, fmt.Errorf("failed to read directory %q: %w", dir, err)
+			return count, fmt.Errorf("failed to read directory %q: %w", dir, err)
 		}
 		for _, file := range files {
 			if count >= m.indexerMaxFiles {
-				shouldClearOld = true
-				return count, true, ErrTooManyFiles
+				return count, ErrTooManyFiles
 			}
 
 			count++
@@ -290,22 +681,19 @@ func (m *Manager) indexShare(ctx context.Context, name string) (count int, hasSh
 			}
 
 			err = m.storage.InsertShareIndex(ctx,
-				rec.Uuid,
-				curIndexId,
+				shareUuid,
+				indexId,
 				path,
 				file.IsDir,
 				int64(file.Size),
 			)
 			if err != nil {
-				return count, true, fmt.Errorf(`failed to insert share %q index for file %q: %w`, rec.Uuid, path, err)
+				return count, fmt.Errorf(`failed to insert share %q index for file %q: %w`, shareUuid, path, err)
 			}
 		}
 	}
 
-	shouldClearOld = true
-	shouldOptimize = true
-
-	return count, true, nil
+	return count, nil
 }
 
 func (m *Manager) indexShareWithLockAndLogging(rec storage.ShareRecord) {
@@ -426,8 +814,144 @@ func (m *Manager) GetByName(name string) (Share, bool) {
 	return share.share, true
 }
 
+// GetMounts returns the conflict rule and mounts of the share with the specified name, and whether the share exists.
+// Shares without mounts return no mounts.
+func (m *Manager) GetMounts(name string) (ConflictRule, []DirMount, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	data, has := m.shareMap[name]
+	if !has {
+		return ConflictRuleFirst, nil, false
+	}
+	return ConflictRule(data.record.ConflictRule), data.mounts, true
+}
+
+// CheckHealth scans the share with the specified name for entries that cannot be shared, such as names that are not
+// valid UTF-8 or directories that cannot be read, and entries that peers may have trouble saving, such as names that
+// are reserved on Windows. Such entries are left out of listings, so this is how they are found.
+// Stops after finding maxIssues issues, which defaults to DefaultMaxHealthIssues and cannot exceed MaxHealthIssues.
+// Returns false if the share does not exist.
+func (m *Manager) CheckHealth(ctx context.Context, name string, maxIssues int) (HealthReport, bool, error) {
+	m.mu.RLock()
+	if m.isClosed {
+		m.mu.RUnlock()
+		return HealthReport{}, false, ErrServerManagerClosed
+	}
+	data, has := m.shareMap[name]
+	var share Share
+	if has {
+		share = data.share
+	}
+	m.mu.RUnlock()
+	if !has {
+		return HealthReport{}, false, nil
+	}
+
+	if maxIssues <= 0 {
+		maxIssues = DefaultMaxHealthIssues
+	}
+	maxIssues = min(maxIssues, MaxHealthIssues)
+
+	report, err := checkShareHealth(ctx, share, maxIssues)
+	if err != nil {
+		return HealthReport{}, true, fmt.Errorf(`failed to check health of share %q: %w`, name, err)
+	}
+	return report, true, nil
+}
+
+// GetExcludes returns the exclude patterns of the share with the specified name, and whether the share exists.
+func (m *Manager) GetExcludes(name string) ([]string, bool) {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+
+	data, has := m.shareMap[name]
+	if !has {
+		return nil, false
+	}
+	return data.excludes, true
+}
+
+// SetExcludes replaces the exclude patterns of the share with the specified name.
+// Paths that match the patterns are hidden from peers and left out of the search index, which is rebuilt in the
+// background.
+// Returns false if the share does not exist.
+// Returns an error wrapping ErrInvalidExcludePattern if a pattern is invalid.
+func (m *Manager) SetExcludes(ctx context.Context, name string, patterns []string) (bool, error) {
+	if _, err := ParseExcludeRules(patterns); err != nil {
+		return false, err
+	}
+
+	m.mu.RLock()
+	if m.isClosed {
+		m.mu.RUnlock()
+		return false, ErrServerManagerClosed
+	}
+	data, has := m.shareMap[name]
+	var rec storage.ShareRecord
+	var mounts []DirMount
+	if has {
+		rec = data.record
+		mounts = data.mounts
+	}
+	m.mu.RUnlock()
+	if !has {
+		return false, nil
+	}
+
+	if err := m.storage.SetShareExcludes(ctx, rec.Uuid, patterns); err != nil {
+		return false, err
+	}
+
+	share, err := newShare(rec, mounts, patterns)
+	if err != nil {
+		return false, fmt.Errorf(`failed to create share instance for share %q: %w`, name, err)
+	}
+
+	m.mu.Lock()
+	if m.shareMap[name] != data {
+		// The share was deleted in the meantime.
+		m.mu.Unlock()
+		_ = share.Close()
+		return false, nil
+	}
+	old := data.share
+	data.share = share
+	data.excludes = patterns
+	m.bumpSharesRevisionNoLock()
+	m.mu.Unlock()
+
+	_ = old.Close()
+
+	if rec.EnableIndexing {
+		go func() {
+			m.indexShareWithLockAndLogging(rec)
+		}()
+	}
+
+	return true, nil
+}
+
+// ValidateShareName returns ErrInvalidShareName if name cannot be used as a share name.
+func ValidateShareName(name string) error {
+	if name == "" {
+		return ErrInvalidShareName
+	}
+	if strings.ContainsRune(name, '/') {
+		return ErrInvalidShareName
+	}
+
+	// Parse as path.
+	// If it cannot be parsed as a valid path (with "/" prepended), it is not a valid share name.
+	if _, err := common.ValidatePath("/" + name); err != nil {
+		return ErrInvalidShareName
+	}
+
+	return nil
+}
+
 // Add creates a new server share.
-// If a share with the same name exists, returns ErrShareExists.
+// If a share with the same name exists, returns ErrShareExists. A share with the same name in the trash is purged.
 // Triggers an index in the background when the share is created.
 func (m *Manager) Add(
 	ctx context.Context,
@@ -435,19 +959,52 @@ func (m *Manager) Add(
 	path string,
 	followLinks bool,
 ) (Share, error) {
-	// Validate name.
-	if name == "" {
-		return nil, ErrInvalidShareName
+	return m.AddWithOptions(ctx, name, path, ShareOptions{FollowLinks: followLinks})
+}
+
+// validateMounts returns an error wrapping ErrInvalidMount if a share cannot have the specified mounts and rule.
+func validateMounts(rule ConflictRule, mounts []DirMount) error {
+	if rule != ConflictRuleFirst && rule != ConflictRuleNewest {
+		return fmt.Errorf(`%w: unknown conflict rule %d`, ErrInvalidMount, rule)
 	}
-	if strings.ContainsRune(name, '/') {
-		return nil, ErrInvalidShareName
+	if len(mounts) > MaxShareMounts {
+		return fmt.Errorf(`%w: cannot have more than %d mounts`, ErrInvalidMount, MaxShareMounts)
 	}
+	for _, mount := range mounts {
+		if mount.VirtualPath.IsZero() {
+			return fmt.Errorf(`%w: virtual path is blank`, ErrInvalidMount)
+		}
+		if !filepath.IsAbs(mount.Dir) {
+			return fmt.Errorf(`%w: path %q is not absolute`, ErrInvalidMount, mount.Dir)
+		}
+	}
+	return nil
+}
 
-	// Parse as path.
-	// If it cannot be parsed as a valid path (with "/" prepended), it is not a valid share name.
-	_, pathErr := common.ValidatePath("/" + name)
-	if pathErr != nil {
-		return nil, ErrInvalidShareName
+// AddWithOptions creates a new server share with the specified options.
+// If the share has mounts, its own path is mounted at "/" before them.
+// If a share with the same name exists, returns ErrShareExists. A share with the same name in the trash is purged.
+// Returns an error wrapping ErrInvalidMount if the mounts or conflict rule are invalid.
+// Returns an error wrapping ErrInvalidExcludePattern if an exclude pattern is invalid.
+// Returns an error wrapping ErrInvalidPathMatch if the path match options are invalid.
+// Triggers an index in the background when the share is created.
+func (m *Manager) AddWithOptions(
+	ctx context.Context,
+	name string,
+	path string,
+	opts ShareOptions,
+) (Share, error) {
+	if err := ValidateShareName(name); err != nil {
+		return nil, err
+	}
+	if err := validateMounts(opts.ConflictRule, opts.Mounts); err != nil {
+		return nil, err
+	}
+	if _, err := ParseExcludeRules(opts.Excludes); err != nil {
+		return nil, err
+	}
+	if opts.PathMatch.Form < common.UnicodeFormNone || opts.PathMatch.Form > common.UnicodeFormNFD {
+		return nil, fmt.Errorf(`%w: unknown Unicode form %d`, ErrInvalidPathMatch, opts.PathMatch.Form)
 	}
 
 	m.mu.Lock()
@@ -464,8 +1021,13 @@ func (m *Manager) Add(
 		return nil, ErrShareExists
 	}
 
+	// Share names are unique per server, including shares in the trash.
+	if _, err := m.storage.PurgeTrashedShareByServerUuidAndName(ctx, m.serverUuid, name); err != nil {
+		return nil, err
+	}
+
 	// Create in storage.
-	err := m.storage.CreateShare(ctx, m.serverUuid, name, path, followLinks)
+	err := m.storage.CreateShare(ctx, m.serverUuid, name, path, opts.FollowLinks)
 	if err != nil {
 		return nil, fmt.Errorf(`failed to create new share %q: %w`, name, err)
 	}
@@ -476,23 +1038,64 @@ func (m *Manager) Add(
 		return nil, fmt.Errorf(`failed to get share record for newly created share %q: %w`, name, err)
 	}
 
-	// Create instance.
-	share, err := NewDirShare(
-		name,
-		path,
-		followLinks,
-	)
+	if len(opts.Mounts) > 0 || opts.ConflictRule != ConflictRuleFirst {
+		records := make([]storage.ShareMountRecord, len(opts.Mounts))
+		for i, mount := range opts.Mounts {
+			records[i] = storage.ShareMountRecord{
+				VirtualPath: mount.VirtualPath,
+				Path:        mount.Dir,
+			}
+		}
+		if err = m.storage.SetShareMounts(ctx, rec.Uuid, int(opts.ConflictRule), records); err != nil {
+			_ = m.storage.DeleteShareByUuid(ctx, rec.Uuid)
+			return nil, fmt.Errorf(`failed to set mounts of newly created share %q: %w`, name, err)
+		}
+		rec.ConflictRule = int(opts.ConflictRule)
+	}
+	if len(opts.Excludes) > 0 {
+		if err = m.storage.SetShareExcludes(ctx, rec.Uuid, opts.Excludes); err != nil {
+			_ = m.storage.DeleteShareByUuid(ctx, rec.Uuid)
+			return nil, fmt.Errorf(`failed to set exclude patterns of newly created share %q: %w`, name, err)
+		}
+	}
+	if !opts.PathMatch.IsExact() {
+		if err = m.storage.SetSharePathMatching(ctx, rec.Uuid, opts.PathMatch); err != nil {
+			_ = m.storage.DeleteShareByUuid(ctx, rec.Uuid)
+			return nil, fmt.Errorf(`failed to set path matching of newly created share %q: %w`, name, err)
+		}
+		rec.CaseInsensitive = opts.PathMatch.CaseInsensitive
+		rec.UnicodeForm = opts.PathMatch.Form
+	}
+
+	share, err := m.addRecord(rec, opts.Mounts, opts.Excludes)
 	if err != nil {
 		return nil, fmt.Errorf(`failed to create share instance for newly created share %q: %w`, name, err)
 	}
 
-	m.mu.Lock()
-	m.shareMap[name] = &shareData{
-		share:  share,
-		record: rec,
+	return share, nil
+}
+
+// addRecord creates a share instance for a record, its mounts and exclude patterns and starts managing it.
+// Triggers an index in the background if the share has indexing enabled.
+func (m *Manager) addRecord(rec storage.ShareRecord, mounts []DirMount, excludes []string) (Share, error) {
+	share, err := newShare(rec, mounts, excludes)
+	if err != nil {
+		return nil, err
+	}
+
+	data := &shareData{
+		share:    share,
+		record:   rec,
+		mounts:   mounts,
+		excludes: excludes,
 	}
+	m.mu.Lock()
+	m.shareMap[rec.Name] = data
+	m.bumpSharesRevisionNoLock()
 	m.mu.Unlock()
 
+	m.startWatcher(data)
+
 	if rec.EnableIndexing {
 		go func() {
 			m.indexShareWithLockAndLogging(rec)
@@ -502,7 +1105,7 @@ func (m *Manager) Add(
 	return share, nil
 }
 
-// Delete deletes an existing server share.
+// Delete moves an existing server share to the trash, from which it can be restored with Restore.
 // If the share does not exist, this is no-op.
 func (m *Manager) Delete(ctx context.Context, name string) error {
 	m.mu.Lock()
@@ -519,21 +1122,72 @@ func (m *Manager) Delete(ctx context.Context, name string) error {
 		return nil
 	}
 
-	// Remove from storage.
-	err := m.storage.DeleteShareByServerUuidAndName(ctx, m.serverUuid, name)
-	if err != nil {
-		return fmt.Errorf(`failed to remove share with server UUID %q and name %q: %w`, m.serverUuid, name, err)
+	// Move to the trash in storage.
+	if _, err := m.storage.TrashShareByServerUuidAndName(ctx, m.serverUuid, name); err != nil {
+		return err
 	}
 
-	// Close share and remove it from map.
-	_ = share.share.Close()
+	// Remove share from map and close it.
 	m.mu.Lock()
 	delete(m.shareMap, name)
+	m.bumpSharesRevisionNoLock()
+	watchers := share.watchers
+	instance := share.share
 	m.mu.Unlock()
 
+	_ = instance.Close()
+
+	for _, watcher := range watchers {
+		_ = watcher.Close()
+	}
+
 	return nil
 }
 
+// Restore takes a share out of the trash and starts managing it again.
+// Returns false if there is no share with the specified name in the trash.
+func (m *Manager) Restore(ctx context.Context, name string) (bool, error) {
+	m.mu.RLock()
+	isClosed := m.isClosed
+	m.mu.RUnlock()
+	if isClosed {
+		return false, ErrServerManagerClosed
+	}
+
+	has, err := m.storage.RestoreShareByServerUuidAndName(ctx, m.serverUuid, name)
+	if err != nil || !has {
+		return false, err
+	}
+
+	rec, has, err := m.storage.GetShareByServerUuidAndName(ctx, m.serverUuid, name)
+	if err != nil {
+		return false, fmt.Errorf(`failed to get share record for restored share %q: %w`, name, err)
+	}
+	if !has {
+		return false, fmt.Errorf(`restored share record %q not found`, name)
+	}
+	mounts, err := getDirMounts(ctx, m.storage, rec.Uuid)
+	if err != nil {
+		return false, fmt.Errorf(`failed to get mounts of restored share %q: %w`, name, err)
+	}
+	excludes, err := m.storage.GetShareExcludes(ctx, rec.Uuid)
+	if err != nil {
+		return false, fmt.Errorf(`failed to get exclude patterns of restored share %q: %w`, name, err)
+	}
+
+	if _, err = m.addRecord(rec, mounts, excludes); err != nil {
+		return false, fmt.Errorf(`failed to create share instance for restored share %q: %w`, name, err)
+	}
+
+	return true, nil
+}
+
+// Purge permanently deletes a share from the trash.
+// Returns false if there is no share with the specified name in the trash.
+func (m *Manager) Purge(ctx context.Context, name string) (bool, error) {
+	return m.storage.PurgeTrashedShareByServerUuidAndName(ctx, m.serverUuid, name)
+}
+
 // Close closes all shares managed by the manager, then the manager itself.
 func (m *Manager) Close() error {
 	m.mu.Lock()
@@ -545,12 +1199,19 @@ func (m *Manager) Close() error {
 	m.isClosed = true
 
 	shares := m.snapshotSharesNoLock()
+	watchers := make([]*dirWatcher, 0, len(m.shareMap))
+	for _, data := range m.shareMap {
+		watchers = append(watchers, data.watchers...)
+	}
 
 	m.mu.Unlock()
 
 	m.ctxCancel()
 
-	// Close all shares.
+	// Stop watchers and close all shares.
+	for _, watcher := range watchers {
+		_ = watcher.Close()
+	}
 	for _, share := range shares {
 		_ = share.Close()
 	}
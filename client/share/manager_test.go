@@ -0,0 +1,119 @@
+package share
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"friendnet.org/client/event"
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+)
+
+func TestManagerShareChanged(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := storage.NewStorage(filepath.Join(t.TempDir(), "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	serverUuid, err := store.CreateServer(
+		ctx,
+		"test",
+		"127.0.0.1:20038",
+		common.UncheckedCreateNormalizedRoomName("room"),
+		common.UncheckedCreateNormalizedUsername("user"),
+		"password",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const maxFiles = 4
+	mgr, err := NewManager(slog.New(slog.DiscardHandler), serverUuid, store, event.NewBus().CreatePublisher(&v1.EventContext{}), maxFiles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = mgr.Close()
+	}()
+
+	dir := t.TempDir()
+	for _, name := range []string{"a.txt", "b.txt"} {
+		if err = os.WriteFile(filepath.Join(dir, name), []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err = mgr.Add(ctx, "files", dir, false); err != nil {
+		t.Fatal(err)
+	}
+	rec, _, err := store.GetShareByServerUuidAndName(ctx, serverUuid, "files")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	indexCount := func() int {
+		count, err := store.CountShareIndex(ctx, rec.Uuid)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return count
+	}
+	deadline := time.Now().Add(5 * time.Second)
+	for indexCount() != 2 {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for the share to be indexed, got %d entries", indexCount())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+
+	// Changes bump the shares revision, which is what gets announced to the server.
+	revision, changed := mgr.SharesRevision()
+	if err = os.WriteFile(filepath.Join(dir, "c.txt"), []byte("c"), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	mgr.onShareChanged("files", []common.ProtoPath{common.UncheckedCreateProtoPath("/c.txt")})
+	select {
+	case <-changed:
+	default:
+		t.Fatal("expected the shares revision to be announced as changed")
+	}
+	if next, _ := mgr.SharesRevision(); next <= revision {
+		t.Fatalf("expected the shares revision to go up from %d, got %d", revision, next)
+	}
+	if n := indexCount(); n != 3 {
+		t.Fatalf("expected the changed path to be indexed, got %d entries", n)
+	}
+
+	// Files already in the index count towards the cap when reindexing a single path.
+	sub := filepath.Join(dir, "sub")
+	if err = os.Mkdir(sub, 0o755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"d.txt", "e.txt", "f.txt"} {
+		if err = os.WriteFile(filepath.Join(sub, name), []byte(name), 0o644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mgr.mu.RLock()
+	data := mgr.shareMap["files"]
+	share, indexId := data.share, data.lastIndexId
+	mgr.mu.RUnlock()
+
+	err = mgr.reindexPath(ctx, share, rec.Uuid, indexId, common.UncheckedCreateProtoPath("/sub"))
+	if !errors.Is(err, ErrTooManyFiles) {
+		t.Fatalf("expected ErrTooManyFiles, got %v", err)
+	}
+	if n := indexCount(); n > maxFiles {
+		t.Fatalf("expected at most %d index entries, got %d", maxFiles, n)
+	}
+}
@@ -0,0 +1,114 @@
+package share
+
+import (
+	"errors"
+	"io"
+	"io/fs"
+
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// MatchShare is an implementation of Share that looks up paths in another share loosely, such as regardless of case or
+// Unicode normalization, so that peers on platforms with different filesystem conventions can find files.
+//
+// Paths that exist exactly as requested are always used as-is.
+// Otherwise, each segment that does not exist is matched against the entries of its directory, and if more than one
+// entry matches, the first one listed is used.
+type MatchShare struct {
+	share Share
+	opts  common.PathMatchOptions
+}
+
+var _ Share = (*MatchShare)(nil)
+
+// NewMatchShare creates a new MatchShare that looks up paths in share according to opts.
+func NewMatchShare(share Share, opts common.PathMatchOptions) *MatchShare {
+	return &MatchShare{
+		share: share,
+		opts:  opts,
+	}
+}
+
+// Unwrap returns the share that paths are looked up in.
+func (s *MatchShare) Unwrap() Share {
+	return s.share
+}
+
+// Close closes the underlying share.
+func (s *MatchShare) Close() error {
+	return s.share.Close()
+}
+
+func (s *MatchShare) Name() string {
+	return s.share.Name()
+}
+
+// resolve returns the path in the underlying share that a requested path refers to, along with its metadata.
+// Returns fs.ErrNotExist if nothing matches.
+func (s *MatchShare) resolve(path common.ProtoPath) (common.ProtoPath, *pb.MsgFileMeta, error) {
+	meta, err := s.share.GetFileMeta(path)
+	if err == nil || !errors.Is(err, fs.ErrNotExist) || path.IsRoot() {
+		return path, meta, err
+	}
+
+	segments := path.ToSegments()
+	resolved := common.RootProtoPath
+	for _, segment := range segments {
+		next := common.JoinPaths(resolved, common.UncheckedCreateProtoPath("/"+segment))
+		meta, err = s.share.GetFileMeta(next)
+		if err == nil {
+			resolved = next
+			continue
+		}
+		if !errors.Is(err, fs.ErrNotExist) {
+			return path, nil, err
+		}
+
+		var files []*pb.MsgFileMeta
+		files, err = s.share.DirFiles(resolved)
+		if err != nil {
+			return path, nil, err
+		}
+
+		key := s.opts.FoldSegment(segment)
+		meta = nil
+		for _, file := range files {
+			if s.opts.FoldSegment(file.Name) == key {
+				meta = file
+				break
+			}
+		}
+		if meta == nil {
+			return path, nil, fs.ErrNotExist
+		}
+		resolved = common.JoinPaths(resolved, common.UncheckedCreateProtoPath("/"+meta.Name))
+	}
+
+	return resolved, meta, nil
+}
+
+func (s *MatchShare) GetFileMeta(path common.ProtoPath) (*pb.MsgFileMeta, error) {
+	_, meta, err := s.resolve(path)
+	return meta, err
+}
+
+func (s *MatchShare) DirFiles(path common.ProtoPath) ([]*pb.MsgFileMeta, error) {
+	resolved, _, err := s.resolve(path)
+	if err != nil {
+		return nil, err
+	}
+	return s.share.DirFiles(resolved)
+}
+
+func (s *MatchShare) GetFile(
+	path common.ProtoPath,
+	offset uint64,
+	limit uint64,
+) (*pb.MsgFileMeta, io.ReadCloser, error) {
+	resolved, _, err := s.resolve(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return s.share.GetFile(resolved, offset, limit)
+}
@@ -0,0 +1,73 @@
+package share
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+	"golang.org/x/text/unicode/norm"
+)
+
+func TestMatchShareInterop(t *testing.T) {
+	dir := t.TempDir()
+	now := time.Now()
+
+	// Names as a macOS peer would have stored them: decomposed, with mixed case.
+	nfdName := norm.NFD.String("Café.mp3")
+	writeTestFile(t, filepath.Join(dir, "Music", nfdName), "cafe", now)
+	writeTestFile(t, filepath.Join(dir, "Music", "Song.mp3"), "song", now)
+	writeTestFile(t, filepath.Join(dir, "Private", "diary.txt"), "secret", now)
+
+	dirShare, err := NewDirShare("test", dir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// A Windows peer requests names composed and in whatever case it likes.
+	nfcPath := common.UncheckedCreateProtoPath("/music/" + norm.NFC.String("café.MP3"))
+
+	exact := NewMatchShare(dirShare, common.PathMatchOptions{})
+	if _, err = exact.GetFileMeta(nfcPath); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected exact matching to not find %q, got %v", nfcPath.String(), err)
+	}
+
+	caseOnly := NewMatchShare(dirShare, common.PathMatchOptions{CaseInsensitive: true})
+	if content := readTestFile(t, caseOnly, "/MUSIC/song.mp3"); content != "song" {
+		t.Fatalf("unexpected content %q", content)
+	}
+	if _, err = caseOnly.GetFileMeta(nfcPath); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected case-insensitive matching to not normalize %q, got %v", nfcPath.String(), err)
+	}
+
+	loose := NewMatchShare(dirShare, common.PathMatchOptions{CaseInsensitive: true, Form: common.UnicodeFormNFC})
+	meta, err := loose.GetFileMeta(nfcPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if meta.Name != nfdName {
+		t.Fatalf("expected metadata to have the name on disk, got %q", meta.Name)
+	}
+	if content := readTestFile(t, loose, nfcPath.String()); content != "cafe" {
+		t.Fatalf("unexpected content %q", content)
+	}
+	files, err := loose.DirFiles(common.UncheckedCreateProtoPath("/mUsIc"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 2 {
+		t.Fatalf("expected 2 files, got %d", len(files))
+	}
+
+	// Loosely matched paths cannot get around exclude patterns.
+	rules, err := ParseExcludeRules([]string{"/Private"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	excluded := NewMatchShare(NewExcludeShare(dirShare, rules), common.PathMatchOptions{CaseInsensitive: true})
+	if _, err = excluded.GetFileMeta(common.UncheckedCreateProtoPath("/PRIVATE/diary.txt")); !errors.Is(err, fs.ErrNotExist) {
+		t.Fatalf("expected excluded path to not be found, got %v", err)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"unicode/utf8"
 
 	"friendnet.org/common"
 	pb "friendnet.org/protocol/pb/v1"
@@ -79,6 +80,7 @@ func (s *DirShare) Close() error {
 
 // NewDirShare creates a new DirShare backed by the specified directory.
 // If followLinks is false, symlinks will be treated as if they do not exist.
+// On Windows, long directory paths are used in their extended-length form.
 func NewDirShare(
 	name string,
 	dir string,
@@ -88,6 +90,7 @@ func NewDirShare(
 	if err != nil {
 		return nil, err
 	}
+	abs = longPath(abs)
 
 	return &DirShare{
 		name:        name,
@@ -200,14 +203,17 @@ func (s *DirShare) DirFiles(path common.ProtoPath) ([]*pb.MsgFileMeta, error) {
 		return nil, readDirErr
 	}
 
+	// Entries that cannot be shared are left out rather than failing the whole listing.
+	// CheckHealth reports them.
 	out := make([]*pb.MsgFileMeta, 0, len(entries))
 	for _, entry := range entries {
+		if !utf8.ValidString(entry.Name()) {
+			continue
+		}
+
 		info, err := entry.Info()
 		if err != nil {
-			if errors.Is(err, fs.ErrNotExist) {
-				continue
-			}
-			return nil, err
+			continue
 		}
 
 		if s.followLinks && info.Mode()&os.ModeSymlink != 0 {
@@ -219,10 +225,7 @@ func (s *DirShare) DirFiles(path common.ProtoPath) ([]*pb.MsgFileMeta, error) {
 			}
 			info, err = s.stat(common.UncheckedCreateProtoPath(statPath))
 			if err != nil {
-				if errors.Is(err, fs.ErrNotExist) {
-					continue
-				}
-				return nil, err
+				continue
 			}
 		}
 
@@ -323,8 +326,9 @@ func fileInfoToMeta(info fs.FileInfo) *pb.MsgFileMeta {
 	}
 
 	return &pb.MsgFileMeta{
-		Name:  info.Name(),
-		IsDir: isDir,
-		Size:  size,
+		Name:       info.Name(),
+		IsDir:      isDir,
+		Size:       size,
+		ModifiedTs: new(info.ModTime().Unix()),
 	}
 }
@@ -0,0 +1,178 @@
+package share
+
+import (
+	"errors"
+	"io/fs"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"friendnet.org/common"
+	"github.com/fsnotify/fsnotify"
+)
+
+// watcherDebounce is how long a watcher waits after the last filesystem event before reporting changes.
+// Copying a large directory produces a flood of events, so they are batched until things settle down.
+const watcherDebounce = 2 * time.Second
+
+// dirWatcher watches a DirShare's directory tree for added, removed and modified files.
+// fsnotify does not watch recursively, so a watch is added for every directory in the tree, including directories
+// that are created after the watcher starts.
+//
+// Symlinked directories are not watched, even if the share follows links.
+type dirWatcher struct {
+	logger *slog.Logger
+
+	dir      string
+	watcher  *fsnotify.Watcher
+	onChange func(paths []common.ProtoPath)
+
+	closeOnce sync.Once
+	done      chan struct{}
+}
+
+// newDirWatcher creates a watcher for the specified directory and starts watching it.
+// onChange is called with the share paths that changed after events settle down.
+// It is never called concurrently.
+func newDirWatcher(
+	logger *slog.Logger,
+	dir string,
+	onChange func(paths []common.ProtoPath),
+) (*dirWatcher, error) {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return nil, err
+	}
+
+	w := &dirWatcher{
+		logger:   logger,
+		dir:      dir,
+		watcher:  watcher,
+		onChange: onChange,
+		done:     make(chan struct{}),
+	}
+
+	w.addTree(dir)
+
+	go w.loop()
+
+	return w, nil
+}
+
+// addTree adds watches for the specified directory and all directories under it.
+func (w *dirWatcher) addTree(root string) {
+	_ = filepath.WalkDir(root, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			// Skip directories that were removed or that we cannot access.
+			if d != nil && d.IsDir() {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if !d.IsDir() {
+			return nil
+		}
+
+		if addErr := w.watcher.Add(path); addErr != nil {
+			// Usually caused by hitting the OS limit on watches.
+			// The share still works, but changes in this directory will not be picked up until the next index.
+			w.logger.Warn("failed to watch share directory",
+				"service", "share.Manager",
+				"path", path,
+				"err", addErr,
+			)
+			return filepath.SkipDir
+		}
+		return nil
+	})
+}
+
+// toProtoPath converts an OS path under the watched directory to a share path.
+func (w *dirWatcher) toProtoPath(osPath string) (common.ProtoPath, bool) {
+	rel, err := filepath.Rel(w.dir, osPath)
+	if err != nil {
+		return common.ProtoPath{}, false
+	}
+
+	path, err := common.ValidatePath("/" + filepath.ToSlash(rel))
+	if err != nil {
+		return common.ProtoPath{}, false
+	}
+	return path, true
+}
+
+func (w *dirWatcher) loop() {
+	pending := make(map[common.ProtoPath]struct{})
+
+	timer := time.NewTimer(watcherDebounce)
+	timer.Stop()
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-w.done:
+			return
+		case ev, ok := <-w.watcher.Events:
+			if !ok {
+				return
+			}
+			if ev.Op == fsnotify.Chmod {
+				continue
+			}
+
+			// New directories need their own watches.
+			if ev.Has(fsnotify.Create) {
+				if stat, err := os.Lstat(ev.Name); err == nil && stat.IsDir() {
+					w.addTree(ev.Name)
+				}
+			}
+
+			path, ok := w.toProtoPath(ev.Name)
+			if !ok {
+				continue
+			}
+			pending[path] = struct{}{}
+			timer.Reset(watcherDebounce)
+		case err, ok := <-w.watcher.Errors:
+			if !ok {
+				return
+			}
+			if errors.Is(err, fsnotify.ErrEventOverflow) {
+				// Events were dropped, so treat the whole share as changed.
+				pending[common.UncheckedCreateProtoPath("/")] = struct{}{}
+				timer.Reset(watcherDebounce)
+				continue
+			}
+
+			w.logger.Warn("share watcher error",
+				"service", "share.Manager",
+				"dir", w.dir,
+				"err", err,
+			)
+		case <-timer.C:
+			if len(pending) == 0 {
+				continue
+			}
+
+			paths := make([]common.ProtoPath, 0, len(pending))
+			for path := range pending {
+				paths = append(paths, path)
+			}
+			clear(pending)
+
+			w.onChange(paths)
+		}
+	}
+}
+
+// Close stops the watcher.
+func (w *dirWatcher) Close() error {
+	var err error
+	w.closeOnce.Do(func() {
+		close(w.done)
+		err = w.watcher.Close()
+	})
+	return err
+}
@@ -0,0 +1,172 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"friendnet.org/client/share"
+)
+
+// MaxShareImportEntries is the maximum number of shares that can be imported at once.
+const MaxShareImportEntries = 10_000
+
+// ErrTooManyShareImportEntries is returned when importing more than MaxShareImportEntries shares at once.
+var ErrTooManyShareImportEntries = fmt.Errorf("cannot import more than %d shares at once", MaxShareImportEntries)
+
+var errShareImportServerNotFound = errors.New("server not found")
+var errShareImportDuplicate = errors.New("another entry has the same server and share name")
+var errShareImportPathNotAbs = errors.New("path must be absolute")
+var errShareImportPathNotDir = errors.New("path is not a directory")
+var errShareImportAborted = errors.New("not created because another entry could not be created")
+
+// ShareImportEntry is a share to create with MultiClient.ImportShares.
+type ShareImportEntry struct {
+	ServerUuid  string
+	Name        string
+	Path        string
+	FollowLinks bool
+}
+
+// ShareImportResult is the result of importing a ShareImportEntry.
+type ShareImportResult struct {
+	// Why the entry is invalid or its share could not be created, or nil if it is valid.
+	Err error
+
+	// Whether the entry's share was created.
+	// Always false for dry runs, and for all entries if any of them could not be created.
+	Created bool
+}
+
+// validateShareImport validates the entries of a share import, and returns a result for each.
+// Returns true if all entries are valid.
+func (c *MultiClient) validateShareImport(entries []ShareImportEntry) ([]ShareImportResult, bool) {
+	results := make([]ShareImportResult, len(entries))
+	seen := make(map[[2]string]struct{}, len(entries))
+	allValid := true
+
+	validate := func(entry ShareImportEntry) error {
+		srv, has := c.GetByUuid(entry.ServerUuid)
+		if !has {
+			return errShareImportServerNotFound
+		}
+		if err := share.ValidateShareName(entry.Name); err != nil {
+			return err
+		}
+
+		key := [2]string{entry.ServerUuid, entry.Name}
+		if _, has = seen[key]; has {
+			return errShareImportDuplicate
+		}
+		seen[key] = struct{}{}
+
+		if _, has = srv.ShareMgr.GetByName(entry.Name); has {
+			return share.ErrShareExists
+		}
+
+		if !filepath.IsAbs(entry.Path) {
+			return errShareImportPathNotAbs
+		}
+		info, err := os.Stat(entry.Path)
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			return errShareImportPathNotDir
+		}
+
+		return nil
+	}
+
+	for i, entry := range entries {
+		if err := validate(entry); err != nil {
+			results[i].Err = err
+			allValid = false
+		}
+	}
+
+	return results, allValid
+}
+
+// ImportShares creates shares in bulk, such as when migrating a large list of shared folders from another program.
+//
+// All entries are validated before any share is created, and if any entry is invalid, no shares are created.
+// If creating a share fails, the shares that were already created are deleted again, so either all shares are
+// created or none are. If dryRun is true, the entries are only validated.
+//
+// Returns a result for each entry, in the same order, and whether the shares were created.
+// Returns ErrTooManyShareImportEntries if there are more than MaxShareImportEntries entries.
+func (c *MultiClient) ImportShares(ctx context.Context, entries []ShareImportEntry, dryRun bool) ([]ShareImportResult, bool, error) {
+	if len(entries) > MaxShareImportEntries {
+		return nil, false, ErrTooManyShareImportEntries
+	}
+
+	results, allValid := c.validateShareImport(entries)
+	if !allValid || dryRun {
+		return results, false, nil
+	}
+
+	for i, entry := range entries {
+		var err error
+		srv, has := c.GetByUuid(entry.ServerUuid)
+		if !has {
+			// The server was deleted since it was validated.
+			err = errShareImportServerNotFound
+		} else {
+			_, err = srv.ShareMgr.Add(ctx, entry.Name, entry.Path, entry.FollowLinks)
+		}
+		if err == nil {
+			results[i].Created = true
+			continue
+		}
+
+		results[i].Err = err
+		for j := i + 1; j < len(entries); j++ {
+			results[j].Err = errShareImportAborted
+		}
+		if rollbackErr := c.rollBackShareImport(ctx, entries[:i], results[:i]); rollbackErr != nil {
+			return results, false, rollbackErr
+		}
+		return results, false, nil
+	}
+
+	c.logger.Info("imported shares",
+		"service", "client.MultiClient",
+		"count", len(entries),
+	)
+
+	return results, true, nil
+}
+
+// rollBackShareImport deletes the shares that were created by a share import that failed.
+func (c *MultiClient) rollBackShareImport(ctx context.Context, entries []ShareImportEntry, results []ShareImportResult) error {
+	var errs []error
+	for i, entry := range entries {
+		if !results[i].Created {
+			continue
+		}
+
+		srv, has := c.GetByUuid(entry.ServerUuid)
+		if !has {
+			// Deleting the server deleted its shares too.
+			results[i].Created = false
+			continue
+		}
+
+		err := srv.ShareMgr.Delete(ctx, entry.Name)
+		if err == nil {
+			_, err = srv.ShareMgr.Purge(ctx, entry.Name)
+		}
+		if err != nil {
+			errs = append(errs, fmt.Errorf(`failed to delete imported share %q on server %s: %w`, entry.Name, entry.ServerUuid, err))
+			continue
+		}
+
+		results[i].Created = false
+		results[i].Err = errShareImportAborted
+	}
+
+	return errors.Join(errs...)
+}
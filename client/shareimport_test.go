@@ -0,0 +1,113 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	"friendnet.org/client/event"
+	"friendnet.org/client/share"
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+)
+
+func TestImportSharesAllOrNothing(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.DiscardHandler)
+
+	store, err := storage.NewStorage(filepath.Join(t.TempDir(), "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	serverUuid, err := store.CreateServer(
+		ctx,
+		"test",
+		"127.0.0.1:20038",
+		common.UncheckedCreateNormalizedRoomName("room"),
+		common.UncheckedCreateNormalizedUsername("user"),
+		"password",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	shareMgr, err := share.NewManager(logger, serverUuid, store, event.NewBus().CreatePublisher(&v1.EventContext{}), 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = shareMgr.Close()
+	}()
+
+	c := &MultiClient{
+		logger:  logger,
+		storage: store,
+		servers: map[string]*Server{
+			serverUuid: {Uuid: serverUuid, ShareMgr: shareMgr},
+		},
+	}
+
+	music := ShareImportEntry{ServerUuid: serverUuid, Name: "music", Path: t.TempDir()}
+	books := ShareImportEntry{ServerUuid: serverUuid, Name: "books", Path: t.TempDir()}
+
+	// One invalid entry keeps all of them from being created.
+	results, imported, err := c.ImportShares(ctx, []ShareImportEntry{
+		music,
+		books,
+		{ServerUuid: serverUuid, Name: "music", Path: t.TempDir()},
+		{ServerUuid: "missing", Name: "other", Path: t.TempDir()},
+		{ServerUuid: serverUuid, Name: "relative", Path: "Music"},
+	}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if imported {
+		t.Fatal("expected import with invalid entries to fail")
+	}
+	if results[0].Err != nil || results[1].Err != nil {
+		t.Fatalf("expected valid entries to have no errors, got %v, %v", results[0].Err, results[1].Err)
+	}
+	if !errors.Is(results[2].Err, errShareImportDuplicate) {
+		t.Fatalf("expected duplicate error, got %v", results[2].Err)
+	}
+	if !errors.Is(results[3].Err, errShareImportServerNotFound) {
+		t.Fatalf("expected server not found error, got %v", results[3].Err)
+	}
+	if !errors.Is(results[4].Err, errShareImportPathNotAbs) {
+		t.Fatalf("expected relative path error, got %v", results[4].Err)
+	}
+	if shares := shareMgr.GetAll(); len(shares) != 0 {
+		t.Fatalf("expected no shares to be created, got %d", len(shares))
+	}
+
+	// A dry run creates nothing.
+	if _, imported, err = c.ImportShares(ctx, []ShareImportEntry{music, books}, true); err != nil || imported {
+		t.Fatalf("got %t, %v for dry run", imported, err)
+	}
+	if shares := shareMgr.GetAll(); len(shares) != 0 {
+		t.Fatalf("expected dry run to create no shares, got %d", len(shares))
+	}
+
+	results, imported, err = c.ImportShares(ctx, []ShareImportEntry{music, books}, false)
+	if err != nil || !imported {
+		t.Fatalf("got %t, %v importing valid entries", imported, err)
+	}
+	if !results[0].Created || !results[1].Created {
+		t.Fatal("expected both shares to be created")
+	}
+
+	// Existing shares are not replaced.
+	results, _, err = c.ImportShares(ctx, []ShareImportEntry{music}, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !errors.Is(results[0].Err, share.ErrShareExists) {
+		t.Fatalf("expected ErrShareExists, got %v", results[0].Err)
+	}
+}
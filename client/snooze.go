@@ -0,0 +1,223 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"friendnet.org/client/room"
+	"friendnet.org/client/storage"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+)
+
+// SnoozeUntilSetting is the setting key for when the client's snooze ends, as a UNIX timestamp in seconds.
+// 0 means the client is not snoozed, and -1 means it is snoozed until the snooze is ended manually.
+const SnoozeUntilSetting = "snooze_until"
+
+// SnoozeHideSharesSetting is the setting key for whether shares are hidden from peers while the client is snoozed.
+const SnoozeHideSharesSetting = "snooze_hide_shares"
+
+// snoozeUntilResumed is the SnoozeUntilSetting value for a snooze that lasts until it is ended manually.
+const snoozeUntilResumed = -1
+
+// Snoozer pauses all uploads and downloads on every server, and optionally hides shares from peers, for a duration
+// or until it is ended manually.
+// The snooze is stored in settings, so it continues after the client restarts.
+type Snoozer struct {
+	mu sync.Mutex
+
+	logger  *slog.Logger
+	storage *storage.Storage
+
+	state room.Snooze
+
+	// When the snooze ends, or the zero time if it lasts until ended manually.
+	// Only meaningful while snoozed.
+	until time.Time
+
+	// Ends the snooze once until is reached, if any.
+	timer *time.Timer
+
+	// Called when shares are hidden or shown again.
+	onSharesHiddenChange func()
+}
+
+// newSnoozer creates a new Snoozer and restores the snooze stored in settings, if it has not ended yet.
+func newSnoozer(ctx context.Context, logger *slog.Logger, storage *storage.Storage) (*Snoozer, error) {
+	s := &Snoozer{
+		logger:               logger,
+		storage:              storage,
+		onSharesHiddenChange: func() {},
+	}
+
+	untilTs, err := storage.GetSettingIntOr(ctx, SnoozeUntilSetting, 0)
+	if err != nil {
+		return nil, err
+	}
+	if untilTs == 0 {
+		return s, nil
+	}
+	hideShares, err := storage.GetSettingBoolOr(ctx, SnoozeHideSharesSetting, false)
+	if err != nil {
+		return nil, err
+	}
+
+	var until time.Time
+	if untilTs != snoozeUntilResumed {
+		until = time.Unix(untilTs, 0)
+		if !until.After(time.Now()) {
+			// The snooze ended while the client was closed.
+			return s, storage.PutSettingInt(ctx, SnoozeUntilSetting, 0)
+		}
+	}
+
+	s.startNoLock(until, hideShares)
+
+	return s, nil
+}
+
+// State returns the snooze state shared with room logic.
+func (s *Snoozer) State() *room.Snooze {
+	return &s.state
+}
+
+// Info returns the current state of the snooze.
+func (s *Snoozer) Info() *v1.SnoozeInfo {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.infoNoLock()
+}
+
+func (s *Snoozer) infoNoLock() *v1.SnoozeInfo {
+	info := &v1.SnoozeInfo{
+		Active:     s.state.IsSnoozed(),
+		HideShares: s.state.SharesHidden(),
+	}
+	if info.Active && !s.until.IsZero() {
+		info.UntilTs = new(s.until.Unix())
+	}
+	return info
+}
+
+// Snooze starts snoozing, replacing the current snooze if there is one.
+// If duration is 0, the snooze lasts until Unsnooze is called.
+// Returns the new state of the snooze.
+func (s *Snoozer) Snooze(ctx context.Context, duration time.Duration, hideShares bool) (*v1.SnoozeInfo, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var until time.Time
+	untilTs := int64(snoozeUntilResumed)
+	if duration > 0 {
+		until = time.Now().Add(duration)
+		untilTs = until.Unix()
+	}
+
+	if err := s.storage.PutSettingBool(ctx, SnoozeHideSharesSetting, hideShares); err != nil {
+		return nil, err
+	}
+	if err := s.storage.PutSettingInt(ctx, SnoozeUntilSetting, untilTs); err != nil {
+		return nil, err
+	}
+
+	s.startNoLock(until, hideShares)
+
+	return s.infoNoLock(), nil
+}
+
+// Unsnooze ends the snooze.
+// Does nothing if not snoozed.
+func (s *Snoozer) Unsnooze(ctx context.Context) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if err := s.storage.PutSettingInt(ctx, SnoozeUntilSetting, 0); err != nil {
+		return err
+	}
+
+	s.endNoLock()
+
+	return nil
+}
+
+// Close stops the timer that ends the snooze.
+// The snooze is not ended, so it continues the next time the client starts.
+func (s *Snoozer) Close() error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+	return nil
+}
+
+func (s *Snoozer) startNoLock(until time.Time, hideShares bool) {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+
+	wasHidden := s.state.SharesHidden()
+	s.until = until
+	s.state.Start(hideShares)
+	if wasHidden != hideShares {
+		s.onSharesHiddenChange()
+	}
+
+	if !until.IsZero() {
+		s.timer = time.AfterFunc(time.Until(until), func() {
+			s.expire(until)
+		})
+	}
+
+	s.logger.Info("snoozed transfers",
+		"service", "client.Snoozer",
+		"until", until,
+		"hide_shares", hideShares,
+	)
+}
+
+func (s *Snoozer) endNoLock() {
+	if s.timer != nil {
+		s.timer.Stop()
+		s.timer = nil
+	}
+
+	if !s.state.IsSnoozed() {
+		return
+	}
+
+	wasHidden := s.state.SharesHidden()
+	s.until = time.Time{}
+	s.state.End()
+	if wasHidden {
+		s.onSharesHiddenChange()
+	}
+
+	s.logger.Info("ended snooze",
+		"service", "client.Snoozer",
+	)
+}
+
+// expire ends the snooze that was set to end at until, unless it was replaced in the meantime.
+func (s *Snoozer) expire(until time.Time) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if !s.state.IsSnoozed() || !s.until.Equal(until) {
+		return
+	}
+
+	if err := s.storage.PutSettingInt(context.Background(), SnoozeUntilSetting, 0); err != nil {
+		s.logger.Error("failed to clear ended snooze",
+			"service", "client.Snoozer",
+			"err", err,
+		)
+	}
+
+	s.endNoLock()
+}
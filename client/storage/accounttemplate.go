@@ -0,0 +1,164 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+
+	"friendnet.org/common"
+	"github.com/google/uuid"
+)
+
+// Account templates hold a username, password and list of shares that are used on several servers, so adding another
+// server with the same account does not mean entering them all again.
+// Servers created from a template get a copy of its credentials and shares; changing the template afterward does not
+// affect them.
+
+// CreateAccountTemplate creates a new account template with the specified credentials and shares.
+// The Template fields of the share records are ignored.
+// Returns the new template's UUID.
+func (s *Storage) CreateAccountTemplate(
+	ctx context.Context,
+	name string,
+	username common.NormalizedUsername,
+	password string,
+	shares []AccountTemplateShareRecord,
+) (string, error) {
+	uuidRaw, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf(`failed to generate UUIDv7: %w`, err)
+	}
+
+	id := uuidRaw.String()
+
+	dbPassword, inKeychain := s.putPassword(ctx, accountTemplatePasswordKey(id), password)
+	ok := false
+	defer func() {
+		if !ok && inKeychain {
+			_ = s.secrets.Delete(ctx, accountTemplatePasswordKey(id))
+		}
+	}()
+
+	tx, err := s.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	_, err = tx.ExecContext(ctx, `insert into account_template (uuid, name, username, password, password_in_keychain) values (?, ?, ?, ?, ?)`,
+		id,
+		name,
+		username.String(),
+		dbPassword,
+		inKeychain,
+	)
+	if err != nil {
+		return "", fmt.Errorf(`failed to create account template %q: %w`, name, err)
+	}
+	for _, share := range shares {
+		_, err = tx.ExecContext(ctx, `insert into account_template_share (template, name, path, follow_links) values (?, ?, ?, ?)`,
+			id,
+			share.Name,
+			share.Path,
+			share.FollowLinks,
+		)
+		if err != nil {
+			return "", fmt.Errorf(`failed to insert share %q of account template %q: %w`, share.Name, name, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return "", err
+	}
+
+	ok = true
+	return id, nil
+}
+
+// GetAccountTemplates returns all account templates, ordered by creation time.
+func (s *Storage) GetAccountTemplates(ctx context.Context) ([]AccountTemplateRecord, error) {
+	rows, err := s.Query(ctx, `select * from account_template order by uuid`)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query account templates: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]AccountTemplateRecord, 0)
+	for rows.Next() {
+		var record AccountTemplateRecord
+		record, _, err = ScanAccountTemplateRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+	_ = rows.Close()
+
+	// Passwords are loaded after the rows are closed, since the secret store may be slow.
+	for i := range records {
+		s.loadAccountTemplatePassword(ctx, &records[i])
+	}
+
+	return records, nil
+}
+
+// GetAccountTemplateByUuid returns the account template with the specified UUID.
+func (s *Storage) GetAccountTemplateByUuid(ctx context.Context, uuid string) (record AccountTemplateRecord, has bool, err error) {
+	row := s.QueryRow(ctx, `select * from account_template where uuid = ?`, uuid)
+	record, has, err = ScanAccountTemplateRecord(row)
+	if err != nil || !has {
+		return record, has, err
+	}
+
+	s.loadAccountTemplatePassword(ctx, &record)
+	return record, true, nil
+}
+
+// loadAccountTemplatePassword fills in the record's password from the secret store or the database column it was
+// read from.
+func (s *Storage) loadAccountTemplatePassword(ctx context.Context, record *AccountTemplateRecord) {
+	record.Password = s.loadPassword(ctx, record.PasswordInKeychain, accountTemplatePasswordKey(record.Uuid), record.Password)
+}
+
+// GetAccountTemplateShares returns the shares of the account template with the specified UUID, ordered by name.
+func (s *Storage) GetAccountTemplateShares(ctx context.Context, templateUuid string) ([]AccountTemplateShareRecord, error) {
+	rows, err := s.Query(ctx, `select * from account_template_share where template = ? order by name`, templateUuid)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query shares of account template %s: %w`, templateUuid, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]AccountTemplateShareRecord, 0)
+	for rows.Next() {
+		var record AccountTemplateShareRecord
+		record, _, err = ScanAccountTemplateShareRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// DeleteAccountTemplate deletes the account template with the specified UUID, along with its shares.
+// Servers created from it are not affected.
+// Returns false if there was none.
+func (s *Storage) DeleteAccountTemplate(ctx context.Context, uuid string) (bool, error) {
+	has, err := affectedAny(s.Exec(ctx, `delete from account_template where uuid = ?`, uuid))
+	if err != nil {
+		return false, fmt.Errorf(`failed to delete account template %s: %w`, uuid, err)
+	}
+
+	if has && s.secrets != nil {
+		_ = s.secrets.Delete(ctx, accountTemplatePasswordKey(uuid))
+	}
+	return has, nil
+}
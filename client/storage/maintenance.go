@@ -0,0 +1,19 @@
+package storage
+
+import (
+	"context"
+
+	"friendnet.org/common"
+)
+
+// Backup writes a consistent copy of the database to the file at dest while it stays in use.
+// Returns an error wrapping os.ErrExist if dest already exists.
+func (s *Storage) Backup(ctx context.Context, dest string) error {
+	return common.BackupSqliteTo(ctx, s.Db, dest)
+}
+
+// CheckIntegrity checks the database for corruption.
+// Returns the problems found, or nil if the database is intact.
+func (s *Storage) CheckIntegrity(ctx context.Context) ([]string, error) {
+	return common.CheckSqliteIntegrity(ctx, s.Db)
+}
@@ -0,0 +1,44 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddBlockedPeers struct {
+}
+
+var _ common.Migration = (*M20261016AddBlockedPeers)(nil)
+
+func (m *M20261016AddBlockedPeers) Name() string {
+	return "20261016_add_blocked_peers"
+}
+
+func (m *M20261016AddBlockedPeers) Apply(tx *sql.Tx) error {
+	const q = `
+create table blocked_peer
+(
+    server text not null
+		constraint blocked_peer_server_uuid_fk
+        references server
+		on delete cascade,
+	username text not null,
+	created_ts integer default (strftime('%s', 'now')) not null,
+	constraint blocked_peer_pk
+		primary key (server, username)
+);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddBlockedPeers) Revert(tx *sql.Tx) error {
+	const q = `
+drop table blocked_peer;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
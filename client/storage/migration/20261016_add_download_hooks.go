@@ -0,0 +1,49 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddDownloadHooks struct {
+}
+
+var _ common.Migration = (*M20261016AddDownloadHooks)(nil)
+
+func (m *M20261016AddDownloadHooks) Name() string {
+	return "20261016_add_download_hooks"
+}
+
+func (m *M20261016AddDownloadHooks) Apply(tx *sql.Tx) error {
+	const q = `
+create table download_hook
+(
+    uuid text not null
+		constraint download_hook_pk
+			primary key,
+	created_ts integer default (strftime('%s', 'now')) not null,
+	type integer not null,
+	target text not null,
+	download text null
+		constraint download_hook_download_state_uuid_fk
+		references download_state
+		on delete cascade
+);
+
+create index download_hook_download_index
+    on download_hook (download);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddDownloadHooks) Revert(tx *sql.Tx) error {
+	const q = `
+drop table download_hook;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
@@ -0,0 +1,34 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddDownloadPartPaths struct {
+}
+
+var _ common.Migration = (*M20261016AddDownloadPartPaths)(nil)
+
+func (m *M20261016AddDownloadPartPaths) Name() string {
+	return "20261016_add_download_part_paths"
+}
+
+func (m *M20261016AddDownloadPartPaths) Apply(tx *sql.Tx) error {
+	const q = `
+alter table download_state add column part_path text null;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddDownloadPartPaths) Revert(tx *sql.Tx) error {
+	const q = `
+alter table download_state drop column part_path;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
@@ -0,0 +1,34 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddDownloadScanStatus struct {
+}
+
+var _ common.Migration = (*M20261016AddDownloadScanStatus)(nil)
+
+func (m *M20261016AddDownloadScanStatus) Name() string {
+	return "20261016_add_download_scan_status"
+}
+
+func (m *M20261016AddDownloadScanStatus) Apply(tx *sql.Tx) error {
+	const q = `
+alter table download_state add column scan_status integer not null default 0;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddDownloadScanStatus) Revert(tx *sql.Tx) error {
+	const q = `
+alter table download_state drop column scan_status;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
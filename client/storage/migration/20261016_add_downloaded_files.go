@@ -0,0 +1,45 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddDownloadedFiles struct {
+}
+
+var _ common.Migration = (*M20261016AddDownloadedFiles)(nil)
+
+func (m *M20261016AddDownloadedFiles) Name() string {
+	return "20261016_add_downloaded_files"
+}
+
+func (m *M20261016AddDownloadedFiles) Apply(tx *sql.Tx) error {
+	const q = `
+create table downloaded_file
+(
+    path text not null
+		constraint downloaded_file_pk
+			primary key,
+	created_ts integer default (strftime('%s', 'now')) not null,
+	sha256 blob not null,
+	size integer not null
+);
+
+create index downloaded_file_sha256_index
+    on downloaded_file (sha256);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddDownloadedFiles) Revert(tx *sql.Tx) error {
+	const q = `
+drop table downloaded_file;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
@@ -0,0 +1,48 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddFriends struct {
+}
+
+var _ common.Migration = (*M20261016AddFriends)(nil)
+
+func (m *M20261016AddFriends) Name() string {
+	return "20261016_add_friends"
+}
+
+func (m *M20261016AddFriends) Apply(tx *sql.Tx) error {
+	const q = `
+create table friend
+(
+    server text not null
+		constraint friend_server_uuid_fk
+        references server
+		on delete cascade,
+	username text not null,
+	created_ts integer default (strftime('%s', 'now')) not null,
+	updated_ts integer default (strftime('%s', 'now')) not null,
+	nickname text not null default '',
+	note text not null default '',
+	trust_level integer not null default 0,
+	constraint friend_pk
+		primary key (server, username)
+);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddFriends) Revert(tx *sql.Tx) error {
+	const q = `
+drop table friend;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
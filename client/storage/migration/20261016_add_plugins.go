@@ -0,0 +1,44 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddPlugins struct {
+}
+
+var _ common.Migration = (*M20261016AddPlugins)(nil)
+
+func (m *M20261016AddPlugins) Name() string {
+	return "20261016_add_plugins"
+}
+
+func (m *M20261016AddPlugins) Apply(tx *sql.Tx) error {
+	const q = `
+create table plugin
+(
+	name text not null
+		constraint plugin_pk
+			primary key,
+	created_ts integer default (strftime('%s', 'now')) not null,
+	token_sha256 blob not null
+		constraint plugin_token_sha256_uk
+			unique,
+	scopes integer not null
+);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddPlugins) Revert(tx *sql.Tx) error {
+	const q = `
+drop table plugin;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
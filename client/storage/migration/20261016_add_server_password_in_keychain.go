@@ -0,0 +1,36 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddServerPasswordInKeychain struct {
+}
+
+var _ common.Migration = (*M20261016AddServerPasswordInKeychain)(nil)
+
+func (m *M20261016AddServerPasswordInKeychain) Name() string {
+	return "20261016_add_server_password_in_keychain"
+}
+
+func (m *M20261016AddServerPasswordInKeychain) Apply(tx *sql.Tx) error {
+	const q = `
+alter table server
+    add password_in_keychain integer default 0 not null;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddServerPasswordInKeychain) Revert(tx *sql.Tx) error {
+	const q = `
+alter table server
+    drop column password_in_keychain;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
@@ -0,0 +1,46 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddServerSchedules struct {
+}
+
+var _ common.Migration = (*M20261016AddServerSchedules)(nil)
+
+func (m *M20261016AddServerSchedules) Name() string {
+	return "20261016_add_server_schedules"
+}
+
+func (m *M20261016AddServerSchedules) Apply(tx *sql.Tx) error {
+	const q = `
+create table conn_window
+(
+    server text not null
+		constraint conn_window_server_uuid_fk
+        references server
+		on delete cascade,
+	weekdays integer not null default 0,
+	start_minute integer not null,
+	end_minute integer not null
+);
+
+create index conn_window_server_index
+    on conn_window (server);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddServerSchedules) Revert(tx *sql.Tx) error {
+	const q = `
+drop table conn_window;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
@@ -0,0 +1,71 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddSessionHistory struct {
+}
+
+var _ common.Migration = (*M20261016AddSessionHistory)(nil)
+
+func (m *M20261016AddSessionHistory) Name() string {
+	return "20261016_add_session_history"
+}
+
+func (m *M20261016AddSessionHistory) Apply(tx *sql.Tx) error {
+	const q = `
+create table run_session
+(
+    uuid text not null
+		constraint run_session_pk
+			primary key,
+	started_ts integer not null,
+	last_seen_ts integer not null,
+	stopped_ts integer null,
+	crashed integer not null default 0
+);
+
+create index run_session_started_ts_index
+    on run_session (started_ts);
+
+create table conn_session
+(
+    uuid text not null
+		constraint conn_session_pk
+			primary key,
+    run text not null
+		constraint conn_session_run_session_uuid_fk
+        references run_session
+		on delete cascade,
+    server text not null
+		constraint conn_session_server_uuid_fk
+        references server
+		on delete cascade,
+	connected_ts integer not null,
+	disconnected_ts integer null,
+	disconnect_reason text null
+);
+
+create index conn_session_server_connected_ts_index
+    on conn_session (server, connected_ts);
+
+create index conn_session_run_index
+    on conn_session (run);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddSessionHistory) Revert(tx *sql.Tx) error {
+	const q = `
+drop table conn_session;
+drop table run_session;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
@@ -0,0 +1,43 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddShareExcludes struct {
+}
+
+var _ common.Migration = (*M20261016AddShareExcludes)(nil)
+
+func (m *M20261016AddShareExcludes) Name() string {
+	return "20261016_add_share_excludes"
+}
+
+func (m *M20261016AddShareExcludes) Apply(tx *sql.Tx) error {
+	const q = `
+create table share_exclude
+(
+	share text not null
+		constraint share_exclude_share_uuid_fk
+		references share (uuid)
+		on delete cascade,
+	position integer not null,
+	pattern text not null,
+	primary key (share, position)
+);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddShareExcludes) Revert(tx *sql.Tx) error {
+	const q = `
+drop table share_exclude;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
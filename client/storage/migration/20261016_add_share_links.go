@@ -0,0 +1,47 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddShareLinks struct {
+}
+
+var _ common.Migration = (*M20261016AddShareLinks)(nil)
+
+func (m *M20261016AddShareLinks) Name() string {
+	return "20261016_add_share_links"
+}
+
+func (m *M20261016AddShareLinks) Apply(tx *sql.Tx) error {
+	const q = `
+create table share_link
+(
+	token text not null primary key,
+	share text not null
+		constraint share_link_share_uuid_fk
+		references share (uuid)
+		on delete cascade,
+	path text not null,
+	created_ts integer default (strftime('%s', 'now')) not null,
+	expires_ts integer null
+);
+
+create index share_link_share_index
+	on share_link (share);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddShareLinks) Revert(tx *sql.Tx) error {
+	const q = `
+drop table share_link;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
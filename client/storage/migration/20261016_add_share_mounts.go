@@ -0,0 +1,48 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddShareMounts struct {
+}
+
+var _ common.Migration = (*M20261016AddShareMounts)(nil)
+
+func (m *M20261016AddShareMounts) Name() string {
+	return "20261016_add_share_mounts"
+}
+
+func (m *M20261016AddShareMounts) Apply(tx *sql.Tx) error {
+	const q = `
+alter table share add column conflict_rule integer default 0 not null;
+
+create table share_mount
+(
+	share text not null
+		constraint share_mount_share_uuid_fk
+		references share (uuid)
+		on delete cascade,
+	position integer not null,
+	virtual_path text not null,
+	path text not null,
+	primary key (share, position)
+);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddShareMounts) Revert(tx *sql.Tx) error {
+	const q = `
+drop table share_mount;
+
+alter table share drop column conflict_rule;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
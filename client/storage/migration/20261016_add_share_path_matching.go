@@ -0,0 +1,36 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddSharePathMatching struct {
+}
+
+var _ common.Migration = (*M20261016AddSharePathMatching)(nil)
+
+func (m *M20261016AddSharePathMatching) Name() string {
+	return "20261016_add_share_path_matching"
+}
+
+func (m *M20261016AddSharePathMatching) Apply(tx *sql.Tx) error {
+	const q = `
+alter table share add column case_insensitive integer default 0 not null;
+alter table share add column unicode_form integer default 0 not null;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddSharePathMatching) Revert(tx *sql.Tx) error {
+	const q = `
+alter table share drop column unicode_form;
+alter table share drop column case_insensitive;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
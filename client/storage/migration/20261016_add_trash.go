@@ -0,0 +1,45 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddTrash struct {
+}
+
+var _ common.Migration = (*M20261016AddTrash)(nil)
+
+func (m *M20261016AddTrash) Name() string {
+	return "20261016_add_trash"
+}
+
+func (m *M20261016AddTrash) Apply(tx *sql.Tx) error {
+	const q = `
+alter table server add column deleted_ts integer null;
+alter table share add column deleted_ts integer null;
+
+create index server_deleted_ts_index
+	on server (deleted_ts);
+
+create index share_deleted_ts_index
+	on share (deleted_ts);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddTrash) Revert(tx *sql.Tx) error {
+	const q = `
+drop index share_deleted_ts_index;
+drop index server_deleted_ts_index;
+
+alter table share drop column deleted_ts;
+alter table server drop column deleted_ts;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
@@ -0,0 +1,55 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddUploadHistory struct {
+}
+
+var _ common.Migration = (*M20261016AddUploadHistory)(nil)
+
+func (m *M20261016AddUploadHistory) Name() string {
+	return "20261016_add_upload_history"
+}
+
+func (m *M20261016AddUploadHistory) Apply(tx *sql.Tx) error {
+	const q = `
+create table upload_history
+(
+    uuid text not null
+		constraint upload_history_pk
+			primary key,
+    server text not null
+		constraint upload_history_server_uuid_fk
+        references server
+		on delete cascade,
+	peer_username text not null,
+	file_path text not null,
+	status integer not null,
+	file_offset integer not null,
+	bytes_sent integer not null,
+	file_size integer not null,
+	started_ts integer not null,
+	ended_ts integer not null,
+	error text null
+);
+
+create index upload_history_ended_ts_index
+    on upload_history (ended_ts);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddUploadHistory) Revert(tx *sql.Tx) error {
+	const q = `
+drop table upload_history;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
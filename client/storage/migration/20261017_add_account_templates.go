@@ -0,0 +1,55 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261017AddAccountTemplates struct {
+}
+
+var _ common.Migration = (*M20261017AddAccountTemplates)(nil)
+
+func (m *M20261017AddAccountTemplates) Name() string {
+	return "20261017_add_account_templates"
+}
+
+func (m *M20261017AddAccountTemplates) Apply(tx *sql.Tx) error {
+	const q = `
+create table account_template
+(
+	uuid text not null primary key,
+	name text not null,
+	username text not null,
+	password text not null,
+	password_in_keychain integer default 0 not null,
+	created_ts integer default (strftime('%s', 'now')) not null
+);
+
+create table account_template_share
+(
+	template text not null
+		constraint account_template_share_template_uuid_fk
+		references account_template (uuid)
+		on delete cascade,
+	name text not null,
+	path text not null,
+	follow_links integer default 0 not null,
+	primary key (template, name)
+);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261017AddAccountTemplates) Revert(tx *sql.Tx) error {
+	const q = `
+drop table account_template_share;
+drop table account_template;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
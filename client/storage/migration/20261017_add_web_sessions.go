@@ -0,0 +1,38 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261017AddWebSessions struct {
+}
+
+var _ common.Migration = (*M20261017AddWebSessions)(nil)
+
+func (m *M20261017AddWebSessions) Name() string {
+	return "20261017_add_web_sessions"
+}
+
+func (m *M20261017AddWebSessions) Apply(tx *sql.Tx) error {
+	const q = `
+create table web_session
+(
+	id_hash text not null primary key,
+	expires_ts integer not null
+);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261017AddWebSessions) Revert(tx *sql.Tx) error {
+	const q = `
+drop table web_session;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
@@ -3,9 +3,11 @@ package storage
 import (
 	"database/sql"
 	"errors"
+	"slices"
 	"time"
 
 	"friendnet.org/common"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
 	pb "friendnet.org/protocol/pb/v1"
 )
 
@@ -43,6 +45,13 @@ type ServerRecord struct {
 	Username  common.NormalizedUsername
 	Password  string
 	CreatedTs time.Time
+
+	// Whether the password is stored in the storage's secret store instead of the database.
+	// If the secret store could not be read, Password will be empty.
+	PasswordInKeychain bool
+
+	// When the server was moved to the trash, or nil if it is not in the trash.
+	DeletedTs *time.Time
 }
 
 func ScanServerRecord(row common.Scannable) (record ServerRecord, has bool, err error) {
@@ -53,8 +62,10 @@ func ScanServerRecord(row common.Scannable) (record ServerRecord, has bool, err
 	var username string
 	var password string
 	var createdTs int64
+	var passwordInKeychain bool
+	var deletedTs *int64
 
-	err = row.Scan(&uuid, &name, &address, &room, &username, &password, &createdTs)
+	err = row.Scan(&uuid, &name, &address, &room, &username, &password, &createdTs, &passwordInKeychain, &deletedTs)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return record, false, nil
@@ -69,6 +80,10 @@ func ScanServerRecord(row common.Scannable) (record ServerRecord, has bool, err
 	record.Username = common.UncheckedCreateNormalizedUsername(username)
 	record.Password = password
 	record.CreatedTs = time.Unix(createdTs, 0)
+	record.PasswordInKeychain = passwordInKeychain
+	if deletedTs != nil {
+		record.DeletedTs = new(time.Unix(*deletedTs, 0))
+	}
 
 	return record, true, nil
 }
@@ -83,6 +98,19 @@ type ShareRecord struct {
 	EnableDirectories bool
 	IsInternal        bool
 	FollowLinks       bool
+
+	// When the share was moved to the trash, or nil if it is not in the trash.
+	DeletedTs *time.Time
+
+	// How conflicts between the share's mounts are resolved.
+	// The values are defined by the share package.
+	ConflictRule int
+
+	// Whether paths requested by peers are matched regardless of case.
+	CaseInsensitive bool
+
+	// The Unicode normalization form that paths requested by peers are matched in.
+	UnicodeForm common.UnicodeForm
 }
 
 func ScanShareRecord(row common.Scannable) (record ShareRecord, has bool, err error) {
@@ -95,6 +123,10 @@ func ScanShareRecord(row common.Scannable) (record ShareRecord, has bool, err er
 	var enableDirectories bool
 	var isInternal bool
 	var followLinks bool
+	var deletedTs *int64
+	var conflictRule int
+	var caseInsensitive bool
+	var unicodeForm int
 
 	err = row.Scan(
 		&server,
@@ -106,6 +138,10 @@ func ScanShareRecord(row common.Scannable) (record ShareRecord, has bool, err er
 		&enableDirectories,
 		&isInternal,
 		&followLinks,
+		&deletedTs,
+		&conflictRule,
+		&caseInsensitive,
+		&unicodeForm,
 	)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
@@ -123,10 +159,46 @@ func ScanShareRecord(row common.Scannable) (record ShareRecord, has bool, err er
 	record.EnableDirectories = enableDirectories
 	record.IsInternal = isInternal
 	record.FollowLinks = followLinks
+	if deletedTs != nil {
+		record.DeletedTs = new(time.Unix(*deletedTs, 0))
+	}
+	record.ConflictRule = conflictRule
+	record.CaseInsensitive = caseInsensitive
+	record.UnicodeForm = common.UnicodeForm(unicodeForm)
 
 	return record, true, nil
 }
 
+// ShareMountRecord is a directory on disk that is mounted under a virtual path in a share, in addition to the share's
+// own path.
+type ShareMountRecord struct {
+	Share       string
+	Position    int
+	VirtualPath common.ProtoPath
+	Path        string
+}
+
+func ScanShareMountRecord(row common.Scannable) (record ShareMountRecord, has bool, err error) {
+	var share string
+	var position int
+	var virtualPath string
+	var path string
+
+	err = row.Scan(&share, &position, &virtualPath, &path)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Share = share
+	record.Position = position
+	record.VirtualPath = common.UncheckedCreateProtoPath(virtualPath)
+	record.Path = path
+	return record, true, nil
+}
+
 type ShareIndexRecord struct {
 	Share       string
 	IndexId     int64
@@ -205,6 +277,12 @@ type DownloadStateRecord struct {
 	FileTotalSize       int64
 	FileDownloadedBytes int64
 	Error               *string
+
+	// The path of the file the download is written to until it is complete, or nil if it was not started yet.
+	PartPath *string
+
+	// The result of scanning the completed download.
+	ScanStatus v1.ScanStatus
 }
 
 func ScanDownloadStateRecord(row common.Scannable) (record DownloadStateRecord, has bool, err error) {
@@ -218,8 +296,10 @@ func ScanDownloadStateRecord(row common.Scannable) (record DownloadStateRecord,
 	var fileTotalSize int64
 	var fileDownloadedBytes int64
 	var errorStr *string
+	var partPath *string
+	var scanStatus int64
 
-	err = row.Scan(&uuid, &createdTs, &updatedTs, &server, &peerUsername, &status, &filePath, &fileTotalSize, &fileDownloadedBytes, &errorStr)
+	err = row.Scan(&uuid, &createdTs, &updatedTs, &server, &peerUsername, &status, &filePath, &fileTotalSize, &fileDownloadedBytes, &errorStr, &partPath, &scanStatus)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return record, false, nil
@@ -237,5 +317,459 @@ func ScanDownloadStateRecord(row common.Scannable) (record DownloadStateRecord,
 	record.FileTotalSize = fileTotalSize
 	record.FileDownloadedBytes = fileDownloadedBytes
 	record.Error = errorStr
+	record.PartPath = partPath
+	record.ScanStatus = v1.ScanStatus(scanStatus)
+	return record, true, nil
+}
+
+type DownloadHookRecord struct {
+	Uuid      string
+	CreatedTs time.Time
+	Type      v1.DownloadHookType
+
+	// The command path or webhook URL, depending on the hook type.
+	Target string
+
+	// The UUID of the download the hook belongs to, or nil if the hook is global.
+	Download *string
+}
+
+func ScanDownloadHookRecord(row common.Scannable) (record DownloadHookRecord, has bool, err error) {
+	var uuid string
+	var createdTs int64
+	var hookType int64
+	var target string
+	var download *string
+
+	err = row.Scan(&uuid, &createdTs, &hookType, &target, &download)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Uuid = uuid
+	record.CreatedTs = time.Unix(createdTs, 0)
+	record.Type = v1.DownloadHookType(hookType)
+	record.Target = target
+	record.Download = download
+	return record, true, nil
+}
+
+type DownloadedFileRecord struct {
+	// The file's local path.
+	Path      string
+	CreatedTs time.Time
+
+	// The SHA-256 hash of the file's content when it was downloaded.
+	Sha256 []byte
+
+	// The file's size in bytes when it was downloaded.
+	Size int64
+}
+
+func ScanDownloadedFileRecord(row common.Scannable) (record DownloadedFileRecord, has bool, err error) {
+	var path string
+	var createdTs int64
+	var sha256 []byte
+	var size int64
+
+	err = row.Scan(&path, &createdTs, &sha256, &size)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Path = path
+	record.CreatedTs = time.Unix(createdTs, 0)
+	record.Sha256 = sha256
+	record.Size = size
+	return record, true, nil
+}
+
+type UploadHistoryRecord struct {
+	Uuid         string
+	Server       string
+	PeerUsername common.NormalizedUsername
+	FilePath     common.ProtoPath
+	Status       v1.UploadStatus
+
+	// The byte offset the peer requested the file from.
+	Offset    uint64
+	BytesSent uint64
+	FileSize  uint64
+
+	StartedTs time.Time
+	EndedTs   time.Time
+	Error     *string
+}
+
+func ScanUploadHistoryRecord(row common.Scannable) (record UploadHistoryRecord, has bool, err error) {
+	var uuid string
+	var server string
+	var peerUsername string
+	var filePath string
+	var status int64
+	var offset int64
+	var bytesSent int64
+	var fileSize int64
+	var startedTs int64
+	var endedTs int64
+	var errorStr *string
+
+	err = row.Scan(&uuid, &server, &peerUsername, &filePath, &status, &offset, &bytesSent, &fileSize, &startedTs, &endedTs, &errorStr)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Uuid = uuid
+	record.Server = server
+	record.PeerUsername = common.UncheckedCreateNormalizedUsername(peerUsername)
+	record.FilePath = common.UncheckedCreateProtoPath(filePath)
+	record.Status = v1.UploadStatus(status)
+	record.Offset = uint64(offset)
+	record.BytesSent = uint64(bytesSent)
+	record.FileSize = uint64(fileSize)
+	record.StartedTs = time.Unix(startedTs, 0)
+	record.EndedTs = time.Unix(endedTs, 0)
+	record.Error = errorStr
+	return record, true, nil
+}
+
+type FriendRecord struct {
+	Server     string
+	Username   common.NormalizedUsername
+	CreatedTs  time.Time
+	UpdatedTs  time.Time
+	Nickname   string
+	Note       string
+	TrustLevel v1.TrustLevel
+}
+
+func ScanFriendRecord(row common.Scannable) (record FriendRecord, has bool, err error) {
+	var server string
+	var username string
+	var createdTs int64
+	var updatedTs int64
+	var nickname string
+	var note string
+	var trustLevel int64
+
+	err = row.Scan(&server, &username, &createdTs, &updatedTs, &nickname, &note, &trustLevel)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Server = server
+	record.Username = common.UncheckedCreateNormalizedUsername(username)
+	record.CreatedTs = time.Unix(createdTs, 0)
+	record.UpdatedTs = time.Unix(updatedTs, 0)
+	record.Nickname = nickname
+	record.Note = note
+	record.TrustLevel = v1.TrustLevel(trustLevel)
+	return record, true, nil
+}
+
+type ShareLinkRecord struct {
+	Token     string
+	Share     string
+	Path      common.ProtoPath
+	CreatedTs time.Time
+
+	// When the link expires, or nil if it never does.
+	ExpiresTs *time.Time
+}
+
+// IsExpired returns whether the link has expired.
+func (r ShareLinkRecord) IsExpired() bool {
+	return r.ExpiresTs != nil && !time.Now().Before(*r.ExpiresTs)
+}
+
+func ScanShareLinkRecord(row common.Scannable) (record ShareLinkRecord, has bool, err error) {
+	var token string
+	var share string
+	var path string
+	var createdTs int64
+	var expiresTs sql.NullInt64
+
+	err = row.Scan(&token, &share, &path, &createdTs, &expiresTs)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Token = token
+	record.Share = share
+	record.Path = common.UncheckedCreateProtoPath(path)
+	record.CreatedTs = time.Unix(createdTs, 0)
+	if expiresTs.Valid {
+		record.ExpiresTs = new(time.Unix(expiresTs.Int64, 0))
+	}
+	return record, true, nil
+}
+
+type BlockedPeerRecord struct {
+	Server    string
+	Username  common.NormalizedUsername
+	CreatedTs time.Time
+}
+
+func ScanBlockedPeerRecord(row common.Scannable) (record BlockedPeerRecord, has bool, err error) {
+	var server string
+	var username string
+	var createdTs int64
+
+	err = row.Scan(&server, &username, &createdTs)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Server = server
+	record.Username = common.UncheckedCreateNormalizedUsername(username)
+	record.CreatedTs = time.Unix(createdTs, 0)
+	return record, true, nil
+}
+
+type ConnWindowRecord struct {
+	Server      string
+	Weekdays    uint8
+	StartMinute int
+	EndMinute   int
+}
+
+func ScanConnWindowRecord(row common.Scannable) (record ConnWindowRecord, has bool, err error) {
+	var server string
+	var weekdays int64
+	var startMinute int64
+	var endMinute int64
+
+	err = row.Scan(&server, &weekdays, &startMinute, &endMinute)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Server = server
+	record.Weekdays = uint8(weekdays)
+	record.StartMinute = int(startMinute)
+	record.EndMinute = int(endMinute)
+	return record, true, nil
+}
+
+type RunSessionRecord struct {
+	Uuid      string
+	StartedTs time.Time
+
+	// The last time the run was known to be running.
+	LastSeenTs time.Time
+
+	// When the run stopped, or nil if it is still running.
+	StoppedTs *time.Time
+
+	// Whether the run ended without stopping cleanly.
+	Crashed bool
+}
+
+func ScanRunSessionRecord(row common.Scannable) (record RunSessionRecord, has bool, err error) {
+	var uuid string
+	var startedTs int64
+	var lastSeenTs int64
+	var stoppedTs sql.NullInt64
+	var crashed bool
+
+	err = row.Scan(&uuid, &startedTs, &lastSeenTs, &stoppedTs, &crashed)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Uuid = uuid
+	record.StartedTs = time.Unix(startedTs, 0)
+	record.LastSeenTs = time.Unix(lastSeenTs, 0)
+	if stoppedTs.Valid {
+		record.StoppedTs = new(time.Unix(stoppedTs.Int64, 0))
+	}
+	record.Crashed = crashed
+	return record, true, nil
+}
+
+type ConnSessionRecord struct {
+	Uuid        string
+	Run         string
+	Server      string
+	ConnectedTs time.Time
+
+	// When the connection closed, or nil if it is still open.
+	DisconnectedTs *time.Time
+
+	// Why the connection closed, or nil if it is still open.
+	DisconnectReason *string
+}
+
+func ScanConnSessionRecord(row common.Scannable) (record ConnSessionRecord, has bool, err error) {
+	var uuid string
+	var run string
+	var server string
+	var connectedTs int64
+	var disconnectedTs sql.NullInt64
+	var disconnectReason *string
+
+	err = row.Scan(&uuid, &run, &server, &connectedTs, &disconnectedTs, &disconnectReason)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Uuid = uuid
+	record.Run = run
+	record.Server = server
+	record.ConnectedTs = time.Unix(connectedTs, 0)
+	if disconnectedTs.Valid {
+		record.DisconnectedTs = new(time.Unix(disconnectedTs.Int64, 0))
+	}
+	record.DisconnectReason = disconnectReason
+	return record, true, nil
+}
+
+type PluginRecord struct {
+	Name      string
+	CreatedTs time.Time
+
+	// The SHA-256 hash of the plugin's bearer token.
+	// The token itself is not stored.
+	TokenSha256 []byte
+
+	// The scopes granted to the plugin, in ascending order.
+	Scopes []v1.PluginScope
+}
+
+// HasScope returns whether the plugin was granted the specified scope.
+func (r PluginRecord) HasScope(scope v1.PluginScope) bool {
+	return slices.Contains(r.Scopes, scope)
+}
+
+// pluginScopesToMask returns a bitmask of plugin scopes, where bit N is set for the scope with value N.
+func pluginScopesToMask(scopes []v1.PluginScope) int64 {
+	var mask int64
+	for _, scope := range scopes {
+		mask |= 1 << scope
+	}
+	return mask
+}
+
+// pluginScopesFromMask returns the plugin scopes set in a bitmask created by pluginScopesToMask.
+func pluginScopesFromMask(mask int64) []v1.PluginScope {
+	scopes := make([]v1.PluginScope, 0)
+	for scope := range v1.PluginScope(63) {
+		if mask&(1<<scope) != 0 {
+			scopes = append(scopes, scope)
+		}
+	}
+	return scopes
+}
+
+func ScanPluginRecord(row common.Scannable) (record PluginRecord, has bool, err error) {
+	var name string
+	var createdTs int64
+	var tokenSha256 []byte
+	var scopes int64
+
+	err = row.Scan(&name, &createdTs, &tokenSha256, &scopes)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Name = name
+	record.CreatedTs = time.Unix(createdTs, 0)
+	record.TokenSha256 = tokenSha256
+	record.Scopes = pluginScopesFromMask(scopes)
+	return record, true, nil
+}
+
+type AccountTemplateRecord struct {
+	Uuid      string
+	Name      string
+	Username  common.NormalizedUsername
+	Password  string
+	CreatedTs time.Time
+
+	// Whether the password is stored in the storage's secret store instead of the database.
+	// If the secret store could not be read, Password will be empty.
+	PasswordInKeychain bool
+}
+
+func ScanAccountTemplateRecord(row common.Scannable) (record AccountTemplateRecord, has bool, err error) {
+	var uuid string
+	var name string
+	var username string
+	var password string
+	var passwordInKeychain bool
+	var createdTs int64
+
+	err = row.Scan(&uuid, &name, &username, &password, &passwordInKeychain, &createdTs)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Uuid = uuid
+	record.Name = name
+	record.Username = common.UncheckedCreateNormalizedUsername(username)
+	record.Password = password
+	record.PasswordInKeychain = passwordInKeychain
+	record.CreatedTs = time.Unix(createdTs, 0)
+	return record, true, nil
+}
+
+type AccountTemplateShareRecord struct {
+	Template    string
+	Name        string
+	Path        string
+	FollowLinks bool
+}
+
+func ScanAccountTemplateShareRecord(row common.Scannable) (record AccountTemplateShareRecord, has bool, err error) {
+	var template string
+	var name string
+	var path string
+	var followLinks bool
+
+	err = row.Scan(&template, &name, &path, &followLinks)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Template = template
+	record.Name = name
+	record.Path = path
+	record.FollowLinks = followLinks
 	return record, true, nil
 }
@@ -0,0 +1,349 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"friendnet.org/client/secret"
+)
+
+// serverPasswordKey returns the secret store key for the password of the server with the specified UUID.
+func serverPasswordKey(serverUuid string) string {
+	return "server_password:" + serverUuid
+}
+
+// accountTemplatePasswordKey returns the secret store key for the password of the account template with the specified
+// UUID.
+func accountTemplatePasswordKey(templateUuid string) string {
+	return "account_template_password:" + templateUuid
+}
+
+// encryptedPrefix marks database values that were encrypted with the database key.
+// Values without it were written before a key was in use and are read as-is.
+const encryptedPrefix = "enc1:"
+
+// UseDatabaseKey makes the storage encrypt the secrets it keeps in the database with the specified key from
+// secret.LoadOrCreateKey, and encrypts the passwords that are already there.
+// It must be called before the storage is used by anything else, and before UseSecretStore.
+//
+// Secret settings that are already in the database are encrypted the next time they are read.
+func (s *Storage) UseDatabaseKey(ctx context.Context, key []byte) error {
+	s.dbKey = key
+
+	if err := s.sealPasswords(ctx, "server"); err != nil {
+		return err
+	}
+	return s.sealPasswords(ctx, "account_template")
+}
+
+// sealPasswords encrypts the plaintext passwords in the specified table.
+// The table must have uuid, password and password_in_keychain columns.
+func (s *Storage) sealPasswords(ctx context.Context, table string) error {
+	rows, err := s.Query(ctx, `select uuid, password from `+table+` where password_in_keychain = 0 and password != ''`)
+	if err != nil {
+		return fmt.Errorf(`failed to query %s passwords to encrypt: %w`, table, err)
+	}
+
+	type pending struct {
+		uuid     string
+		password string
+	}
+	var toSeal []pending
+	for rows.Next() {
+		var p pending
+		if err = rows.Scan(&p.uuid, &p.password); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		if !strings.HasPrefix(p.password, encryptedPrefix) {
+			toSeal = append(toSeal, p)
+		}
+	}
+	_ = rows.Close()
+
+	for _, p := range toSeal {
+		_, err = s.Exec(ctx, `update `+table+` set password = ? where uuid = ?`, s.sealDb(p.password), p.uuid)
+		if err != nil {
+			return fmt.Errorf(`failed to encrypt password of %s %q: %w`, table, p.uuid, err)
+		}
+	}
+
+	return nil
+}
+
+// sealDb returns the value to store in the database for the specified secret.
+// It is encrypted if a database key is in use.
+func (s *Storage) sealDb(value string) string {
+	if s.dbKey == nil || value == "" {
+		return value
+	}
+
+	sealed, err := secret.Encrypt(s.dbKey, value)
+	if err != nil {
+		// Only happens with an invalid key, which LoadOrCreateKey does not return.
+		panic(err)
+	}
+	return encryptedPrefix + sealed
+}
+
+// openDb returns the secret stored in the database as the specified value.
+// Returns false if it is encrypted and cannot be decrypted, such as when the key file was lost.
+func (s *Storage) openDb(value string) (string, bool) {
+	sealed, ok := strings.CutPrefix(value, encryptedPrefix)
+	if !ok {
+		return value, true
+	}
+	if s.dbKey == nil {
+		return "", false
+	}
+
+	opened, err := secret.Decrypt(s.dbKey, sealed)
+	if err != nil {
+		return "", false
+	}
+	return opened, true
+}
+
+// UseSecretStore makes the storage keep server passwords, account template passwords and secret settings in the
+// specified secret.Store instead of the database, and moves existing passwords into it.
+// It must be called before the storage is used by anything else.
+//
+// If a secret cannot be written to the store, it stays in the database, so secrets are never lost.
+// Returns an error if any existing password could not be moved; the store is used regardless.
+func (s *Storage) UseSecretStore(ctx context.Context, store secret.Store) error {
+	s.secrets = store
+
+	if err := s.movePasswords(ctx, "server", serverPasswordKey); err != nil {
+		return err
+	}
+	return s.movePasswords(ctx, "account_template", accountTemplatePasswordKey)
+}
+
+// movePasswords moves the passwords in the specified table from the database into the secret store.
+// The table must have uuid, password and password_in_keychain columns.
+func (s *Storage) movePasswords(ctx context.Context, table string, keyFunc func(uuid string) string) error {
+	rows, err := s.Query(ctx, `select uuid, password from `+table+` where password_in_keychain = 0`)
+	if err != nil {
+		return fmt.Errorf(`failed to query %s passwords to move: %w`, table, err)
+	}
+
+	type pending struct {
+		uuid     string
+		password string
+	}
+	var toMove []pending
+	for rows.Next() {
+		var p pending
+		if err = rows.Scan(&p.uuid, &p.password); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		toMove = append(toMove, p)
+	}
+	_ = rows.Close()
+
+	for _, p := range toMove {
+		password, ok := s.openDb(p.password)
+		if !ok {
+			// Leave it where it is rather than moving a password nobody can read.
+			continue
+		}
+		if err = s.secrets.Put(ctx, keyFunc(p.uuid), password); err != nil {
+			return fmt.Errorf(`failed to move password of %s %q to secret store: %w`, table, p.uuid, err)
+		}
+		_, err = s.Exec(ctx, `update `+table+` set password = '', password_in_keychain = 1 where uuid = ?`, p.uuid)
+		if err != nil {
+			return fmt.Errorf(`failed to clear password of %s %q from database: %w`, table, p.uuid, err)
+		}
+	}
+
+	return nil
+}
+
+// MoveSecretsToDatabase moves the passwords and the specified secret settings that an earlier UseSecretStore put
+// into the specified store back into the database, and deletes them from the store.
+// It is used when the store is not to be used anymore, such as when the OS keychain is disabled.
+//
+// Secrets that are not in the store are left alone.
+func (s *Storage) MoveSecretsToDatabase(ctx context.Context, from secret.Store, settingKeys ...string) error {
+	if err := s.movePasswordsBack(ctx, from, "server", serverPasswordKey); err != nil {
+		return err
+	}
+	if err := s.movePasswordsBack(ctx, from, "account_template", accountTemplatePasswordKey); err != nil {
+		return err
+	}
+
+	for _, key := range settingKeys {
+		val, has, err := from.Get(ctx, key)
+		if err != nil {
+			return fmt.Errorf(`failed to read setting %q from secret store: %w`, key, err)
+		}
+		if !has {
+			continue
+		}
+		if err = s.PutSetting(ctx, key, s.sealDb(val)); err != nil {
+			return fmt.Errorf(`failed to move setting %q to database: %w`, key, err)
+		}
+		if err = from.Delete(ctx, key); err != nil {
+			return fmt.Errorf(`failed to delete setting %q from secret store: %w`, key, err)
+		}
+	}
+
+	return nil
+}
+
+// movePasswordsBack moves the passwords in the specified table from the secret store back into the database.
+// The table must have uuid, password and password_in_keychain columns.
+func (s *Storage) movePasswordsBack(ctx context.Context, from secret.Store, table string, keyFunc func(uuid string) string) error {
+	rows, err := s.Query(ctx, `select uuid from `+table+` where password_in_keychain = 1`)
+	if err != nil {
+		return fmt.Errorf(`failed to query %s passwords to move: %w`, table, err)
+	}
+
+	var uuids []string
+	for rows.Next() {
+		var id string
+		if err = rows.Scan(&id); err != nil {
+			_ = rows.Close()
+			return err
+		}
+		uuids = append(uuids, id)
+	}
+	_ = rows.Close()
+
+	for _, id := range uuids {
+		password, has, err := from.Get(ctx, keyFunc(id))
+		if err != nil {
+			return fmt.Errorf(`failed to read password of %s %q from secret store: %w`, table, id, err)
+		}
+		if !has {
+			continue
+		}
+		_, err = s.Exec(ctx, `update `+table+` set password = ?, password_in_keychain = 0 where uuid = ?`, s.sealDb(password), id)
+		if err != nil {
+			return fmt.Errorf(`failed to move password of %s %q to database: %w`, table, id, err)
+		}
+		if err = from.Delete(ctx, keyFunc(id)); err != nil {
+			return fmt.Errorf(`failed to delete password of %s %q from secret store: %w`, table, id, err)
+		}
+	}
+
+	return nil
+}
+
+// UsesSecretStore returns whether the storage keeps secrets in a secret.Store.
+// See UseSecretStore.
+func (s *Storage) UsesSecretStore() bool {
+	return s.secrets != nil
+}
+
+// putServerPassword tries to store the server's password in the secret store.
+// Returns the values to store in the password and password_in_keychain columns.
+func (s *Storage) putServerPassword(ctx context.Context, serverUuid string, password string) (dbPassword string, inKeychain bool) {
+	return s.putPassword(ctx, serverPasswordKey(serverUuid), password)
+}
+
+// putPassword tries to store a password in the secret store under the specified key.
+// Returns the values to store in the password and password_in_keychain columns.
+func (s *Storage) putPassword(ctx context.Context, key string, password string) (dbPassword string, inKeychain bool) {
+	if s.secrets == nil {
+		return s.sealDb(password), false
+	}
+
+	if err := s.secrets.Put(ctx, key, password); err != nil {
+		// Fall back to the database.
+		return s.sealDb(password), false
+	}
+	return "", true
+}
+
+// loadServerPassword fills in the record's password from the secret store or the database column it was read from.
+func (s *Storage) loadServerPassword(ctx context.Context, record *ServerRecord) {
+	record.Password = s.loadPassword(ctx, record.PasswordInKeychain, serverPasswordKey(record.Uuid), record.Password)
+}
+
+// loadPassword returns the password stored in the secret store under the specified key if inKeychain is true,
+// otherwise the password stored in the database as dbPassword.
+// Returns an empty string if it cannot be read.
+func (s *Storage) loadPassword(ctx context.Context, inKeychain bool, key string, dbPassword string) string {
+	if !inKeychain {
+		password, _ := s.openDb(dbPassword)
+		return password
+	}
+	if s.secrets == nil {
+		return ""
+	}
+
+	password, has, err := s.secrets.Get(ctx, key)
+	if err != nil || !has {
+		return ""
+	}
+	return password
+}
+
+// getDbSecretSettingOrPut is like GetSettingOrPut, but for a setting whose value is encrypted with the database key.
+// Plaintext values are encrypted when read. A value that cannot be decrypted is replaced with def.
+func (s *Storage) getDbSecretSettingOrPut(ctx context.Context, key string, def string) (string, error) {
+	stored, err := s.GetSettingOrPut(ctx, key, s.sealDb(def))
+	if err != nil {
+		return "", err
+	}
+
+	val, ok := s.openDb(stored)
+	if !ok {
+		return def, s.PutSetting(ctx, key, s.sealDb(def))
+	}
+	if s.dbKey != nil && val != "" && !strings.HasPrefix(stored, encryptedPrefix) {
+		if err = s.PutSetting(ctx, key, s.sealDb(val)); err != nil {
+			return "", err
+		}
+	}
+	return val, nil
+}
+
+// GetSecretSettingOrPut is like GetSettingOrPut, but keeps the setting in the secret store if one is in use.
+// A value already in the database is moved to the secret store.
+// If the secret store cannot be read or written, the database is used.
+func (s *Storage) GetSecretSettingOrPut(ctx context.Context, key string, def string) (string, error) {
+	if s.secrets == nil {
+		return s.getDbSecretSettingOrPut(ctx, key, def)
+	}
+
+	val, has, err := s.secrets.Get(ctx, key)
+	if err != nil {
+		return s.getDbSecretSettingOrPut(ctx, key, def)
+	}
+	if has {
+		return val, nil
+	}
+
+	stored, err := s.GetSettingOr(ctx, key, "")
+	if err != nil {
+		return "", err
+	}
+	val, ok := s.openDb(stored)
+	if !ok || stored == "" {
+		val = def
+	}
+	if err = s.secrets.Put(ctx, key, val); err != nil {
+		return s.getDbSecretSettingOrPut(ctx, key, def)
+	}
+	if err = s.DeleteSetting(ctx, key); err != nil {
+		return "", err
+	}
+
+	return val, nil
+}
+
+// PutSecretSetting is like PutSetting, but keeps the setting in the secret store if one is in use.
+// If the secret store cannot be written, the database is used.
+func (s *Storage) PutSecretSetting(ctx context.Context, key string, value string) error {
+	if s.secrets != nil {
+		if err := s.secrets.Put(ctx, key, value); err == nil {
+			return s.DeleteSetting(ctx, key)
+		}
+	}
+
+	return s.PutSetting(ctx, key, s.sealDb(value))
+}
@@ -0,0 +1,248 @@
+package storage
+
+import (
+	"context"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+
+	"friendnet.org/client/secret"
+	"friendnet.org/common"
+)
+
+// memStore is an in-memory secret.Store.
+type memStore struct {
+	mu     sync.Mutex
+	values map[string]string
+}
+
+var _ secret.Store = (*memStore)(nil)
+
+func newMemStore() *memStore {
+	return &memStore{values: make(map[string]string)}
+}
+
+func (m *memStore) Get(_ context.Context, key string) (string, bool, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	val, has := m.values[key]
+	return val, has, nil
+}
+
+func (m *memStore) Put(_ context.Context, key string, value string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.values[key] = value
+	return nil
+}
+
+func (m *memStore) Delete(_ context.Context, key string) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	delete(m.values, key)
+	return nil
+}
+
+func (m *memStore) Len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return len(m.values)
+}
+
+func newSecretTestStorage(t *testing.T) (*Storage, string) {
+	t.Helper()
+
+	dir := t.TempDir()
+	store, err := NewStorage(filepath.Join(dir, "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = store.Close()
+	})
+
+	key, err := secret.LoadOrCreateKey(filepath.Join(dir, "secret.key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.UseDatabaseKey(context.Background(), key); err != nil {
+		t.Fatal(err)
+	}
+
+	return store, dir
+}
+
+func rawServerPassword(t *testing.T, store *Storage, serverUuid string) (password string, inKeychain bool) {
+	t.Helper()
+	err := store.QueryRow(context.Background(), `select password, password_in_keychain from server where uuid = ?`, serverUuid).
+		Scan(&password, &inKeychain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return password, inKeychain
+}
+
+func TestSecretStoreRoundTrip(t *testing.T) {
+	ctx := context.Background()
+	store, _ := newSecretTestStorage(t)
+
+	serverUuid, err := store.CreateServer(
+		ctx,
+		"test",
+		"127.0.0.1:20038",
+		common.UncheckedCreateNormalizedRoomName("room"),
+		common.UncheckedCreateNormalizedUsername("user"),
+		"password",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.PutSecretSetting(ctx, "token", "hunter2"); err != nil {
+		t.Fatal(err)
+	}
+
+	// Without a secret store, the password is kept in the database, encrypted.
+	if raw, inKeychain := rawServerPassword(t, store, serverUuid); inKeychain || !strings.HasPrefix(raw, encryptedPrefix) {
+		t.Fatalf("expected an encrypted password in the database, got %q (in keychain: %t)", raw, inKeychain)
+	}
+	if raw, _ := store.GetSettingOr(ctx, "token", ""); !strings.HasPrefix(raw, encryptedPrefix) {
+		t.Fatalf("expected an encrypted setting in the database, got %q", raw)
+	}
+	if rec, _, _ := store.GetServerByUuid(ctx, serverUuid); rec.Password != "password" {
+		t.Fatalf("expected the password to be decrypted, got %q", rec.Password)
+	}
+
+	// Using a secret store moves the secrets into it.
+	keychain := newMemStore()
+	if err = store.UseSecretStore(ctx, keychain); err != nil {
+		t.Fatal(err)
+	}
+	if val, err := store.GetSecretSettingOrPut(ctx, "token", "default"); err != nil || val != "hunter2" {
+		t.Fatalf("expected the setting to be kept, got %q (err: %v)", val, err)
+	}
+	if raw, inKeychain := rawServerPassword(t, store, serverUuid); !inKeychain || raw != "" {
+		t.Fatalf("expected the password to be moved to the secret store, got %q (in keychain: %t)", raw, inKeychain)
+	}
+	if raw, _ := store.GetSettingOr(ctx, "token", ""); raw != "" {
+		t.Fatal("expected the setting to be moved to the secret store")
+	}
+	if n := keychain.Len(); n != 2 {
+		t.Fatalf("expected 2 secrets in the secret store, got %d", n)
+	}
+	if rec, _, _ := store.GetServerByUuid(ctx, serverUuid); rec.Password != "password" {
+		t.Fatalf("expected the password to be read from the secret store, got %q", rec.Password)
+	}
+}
+
+func TestMoveSecretsToDatabase(t *testing.T) {
+	ctx := context.Background()
+	store, dir := newSecretTestStorage(t)
+
+	serverUuid, err := store.CreateServer(
+		ctx,
+		"test",
+		"127.0.0.1:20038",
+		common.UncheckedCreateNormalizedRoomName("room"),
+		common.UncheckedCreateNormalizedUsername("user"),
+		"password",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keychain := newMemStore()
+	if err = store.UseSecretStore(ctx, keychain); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.PutSecretSetting(ctx, "token", "hunter2"); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// Reopen without the secret store, like a restart with the keychain disabled.
+	store, err = NewStorage(filepath.Join(dir, "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+	key, err := secret.LoadOrCreateKey(filepath.Join(dir, "secret.key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.UseDatabaseKey(ctx, key); err != nil {
+		t.Fatal(err)
+	}
+	if err = store.MoveSecretsToDatabase(ctx, keychain, "token"); err != nil {
+		t.Fatal(err)
+	}
+
+	if n := keychain.Len(); n != 0 {
+		t.Fatalf("expected the secret store to be emptied, %d secrets are left", n)
+	}
+	if raw, inKeychain := rawServerPassword(t, store, serverUuid); inKeychain || !strings.HasPrefix(raw, encryptedPrefix) {
+		t.Fatalf("expected an encrypted password in the database, got %q (in keychain: %t)", raw, inKeychain)
+	}
+	if rec, _, _ := store.GetServerByUuid(ctx, serverUuid); rec.Password != "password" {
+		t.Fatalf("expected the password to be moved back, got %q", rec.Password)
+	}
+	if val, err := store.GetSecretSettingOrPut(ctx, "token", "default"); err != nil || val != "hunter2" {
+		t.Fatalf("expected the setting to be moved back, got %q (err: %v)", val, err)
+	}
+}
+
+func TestUseDatabaseKeyEncryptsExisting(t *testing.T) {
+	ctx := context.Background()
+	dir := t.TempDir()
+
+	store, err := NewStorage(filepath.Join(dir, "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+	serverUuid, err := store.CreateServer(
+		ctx,
+		"test",
+		"127.0.0.1:20038",
+		common.UncheckedCreateNormalizedRoomName("room"),
+		common.UncheckedCreateNormalizedUsername("user"),
+		"password",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.PutSetting(ctx, "token", "hunter2"); err != nil {
+		t.Fatal(err)
+	}
+	if raw, _ := rawServerPassword(t, store, serverUuid); raw != "password" {
+		t.Fatalf("expected a plaintext password without a key, got %q", raw)
+	}
+
+	key, err := secret.LoadOrCreateKey(filepath.Join(dir, "secret.key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.UseDatabaseKey(ctx, key); err != nil {
+		t.Fatal(err)
+	}
+
+	if raw, _ := rawServerPassword(t, store, serverUuid); !strings.HasPrefix(raw, encryptedPrefix) {
+		t.Fatalf("expected the existing password to be encrypted, got %q", raw)
+	}
+	if rec, _, _ := store.GetServerByUuid(ctx, serverUuid); rec.Password != "password" {
+		t.Fatalf("expected the password to be decrypted, got %q", rec.Password)
+	}
+
+	// Secret settings are encrypted the next time they are read.
+	if val, err := store.GetSecretSettingOrPut(ctx, "token", "default"); err != nil || val != "hunter2" {
+		t.Fatalf("expected the existing setting, got %q (err: %v)", val, err)
+	}
+	if raw, _ := store.GetSettingOr(ctx, "token", ""); !strings.HasPrefix(raw, encryptedPrefix) {
+		t.Fatalf("expected the existing setting to be encrypted, got %q", raw)
+	}
+}
@@ -91,6 +91,13 @@ func (s *Storage) PutSetting(ctx context.Context, key string, value string) erro
 	return err
 }
 
+// DeleteSetting deletes the setting with the specified key.
+// If the setting does not exist, this is a no-op.
+func (s *Storage) DeleteSetting(ctx context.Context, key string) error {
+	_, err := s.Exec(ctx, `delete from setting where key = ?`, key)
+	return err
+}
+
 // GetSettingIntOr returns the integer value of the setting with the specified key.
 // If the setting does not exist, returns the default value.
 // If you want to put a default value while returning one, use GetSettingIntOrPut.
@@ -7,10 +7,13 @@ import (
 	"path"
 	"path/filepath"
 	"strings"
+	"time"
 	"unicode"
 
+	"friendnet.org/client/secret"
 	"friendnet.org/client/storage/migration"
 	"friendnet.org/common"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
 	pb "friendnet.org/protocol/pb/v1"
 	"github.com/google/uuid"
 	_ "modernc.org/sqlite"
@@ -23,6 +26,14 @@ type Storage struct {
 
 	insertShareIndexStmt     *sql.Stmt
 	updateDownloadStatusStmt *sql.Stmt
+
+	// Where secrets are kept instead of the database, or nil to keep them in the database.
+	// See UseSecretStore.
+	secrets secret.Store
+
+	// The key secrets kept in the database are encrypted with, or nil to keep them in plaintext.
+	// See UseDatabaseKey.
+	dbKey []byte
 }
 
 func (s *Storage) Close() error {
@@ -66,6 +77,24 @@ func NewStorage(path string) (*Storage, error) {
 		&migration.M20260225AddSettingKv{},
 		&migration.M20260301AddSearchIndexes{},
 		&migration.M20260311AddDownloadStates{},
+		&migration.M20261016AddDownloadHooks{},
+		&migration.M20261016AddServerPasswordInKeychain{},
+		&migration.M20261016AddDownloadedFiles{},
+		&migration.M20261016AddDownloadPartPaths{},
+		&migration.M20261016AddUploadHistory{},
+		&migration.M20261016AddFriends{},
+		&migration.M20261016AddBlockedPeers{},
+		&migration.M20261016AddServerSchedules{},
+		&migration.M20261016AddShareLinks{},
+		&migration.M20261016AddSessionHistory{},
+		&migration.M20261016AddDownloadScanStatus{},
+		&migration.M20261016AddTrash{},
+		&migration.M20261016AddPlugins{},
+		&migration.M20261016AddShareMounts{},
+		&migration.M20261016AddShareExcludes{},
+		&migration.M20261016AddSharePathMatching{},
+		&migration.M20261017AddAccountTemplates{},
+		&migration.M20261017AddWebSessions{},
 	})
 	if err != nil {
 		return nil, fmt.Errorf(`failed to apply client database migrations: %w`, err)
@@ -144,6 +173,8 @@ func (s *Storage) CreateServer(
 
 	id := uuidRaw.String()
 
+	dbPassword, inKeychain := s.putServerPassword(ctx, id, password)
+
 	_, err = s.Exec(ctx, `
 insert into server
 (
@@ -152,26 +183,31 @@ insert into server
 	address,
 	room,
 	username,
-	password
-) values (?, ?, ?, ?, ?, ?)
+	password,
+	password_in_keychain
+) values (?, ?, ?, ?, ?, ?, ?)
 	`,
 		id,
 		name,
 		address,
 		room.String(),
 		username.String(),
-		password,
+		dbPassword,
+		inKeychain,
 	)
 	if err != nil {
+		if inKeychain {
+			_ = s.secrets.Delete(ctx, serverPasswordKey(id))
+		}
 		return "", fmt.Errorf(`failed to create server: %w`, err)
 	}
 
 	return id, nil
 }
 
-// GetServers returns all server records.
+// GetServers returns all server records that are not in the trash.
 func (s *Storage) GetServers(ctx context.Context) ([]ServerRecord, error) {
-	rows, err := s.Query(ctx, `select * from server`)
+	rows, err := s.Query(ctx, `select * from server where deleted_ts is null`)
 	if err != nil {
 		return nil, fmt.Errorf(`failed to query servers: %w`, err)
 	}
@@ -190,14 +226,100 @@ func (s *Storage) GetServers(ctx context.Context) ([]ServerRecord, error) {
 
 		records = append(records, record)
 	}
+	_ = rows.Close()
+
+	// Passwords are loaded after the rows are closed, since the secret store may be slow.
+	for i := range records {
+		s.loadServerPassword(ctx, &records[i])
+	}
+
+	return records, nil
+}
+
+// GetServersPage returns up to limit server records that are not in the trash, ordered by UUID, starting after the UUID in cursor.
+// Specify an empty cursor to start from the beginning.
+// The returned cursor can be passed to get the next page, and is empty when there are no more records.
+func (s *Storage) GetServersPage(ctx context.Context, cursor string, limit int) ([]ServerRecord, string, error) {
+	if limit <= 0 {
+		panic("limit must be positive")
+	}
+
+	rows, err := s.Query(ctx, `select * from server where deleted_ts is null and uuid > ? order by uuid limit ?`, cursor, limit)
+	if err != nil {
+		return nil, "", fmt.Errorf(`failed to query servers: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]ServerRecord, 0, limit)
+	for rows.Next() {
+		var record ServerRecord
+		record, _, err = ScanServerRecord(rows)
+		if err != nil {
+			return nil, "", err
+		}
+
+		records = append(records, record)
+	}
+	_ = rows.Close()
+
+	for i := range records {
+		s.loadServerPassword(ctx, &records[i])
+	}
+
+	var next string
+	if len(records) == limit {
+		next = records[len(records)-1].Uuid
+	}
+
+	return records, next, nil
+}
+
+// CountServers returns the number of server records that are not in the trash.
+func (s *Storage) CountServers(ctx context.Context) (int, error) {
+	var count int
+	if err := s.QueryRow(ctx, `select count(*) from server where deleted_ts is null`).Scan(&count); err != nil {
+		return 0, fmt.Errorf(`failed to count servers: %w`, err)
+	}
+	return count, nil
+}
+
+// GetServerCerts returns all trusted server certificate records.
+func (s *Storage) GetServerCerts(ctx context.Context) ([]ServerCertRecord, error) {
+	rows, err := s.Query(ctx, `select * from server_cert`)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query server certs: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]ServerCertRecord, 0)
+
+	for rows.Next() {
+		var record ServerCertRecord
+		record, _, err = ScanServerCertRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
 
 	return records, nil
 }
 
-// GetServerByUuid returns the server record with the specified UUID.
+// GetServerByUuid returns the server record with the specified UUID, unless it is in the trash.
 func (s *Storage) GetServerByUuid(ctx context.Context, uuid string) (record ServerRecord, has bool, err error) {
-	row := s.QueryRow(ctx, `select * from server where uuid = ?`, uuid)
-	return ScanServerRecord(row)
+	row := s.QueryRow(ctx, `select * from server where uuid = ? and deleted_ts is null`, uuid)
+	record, has, err = ScanServerRecord(row)
+	if err != nil || !has {
+		return record, has, err
+	}
+
+	s.loadServerPassword(ctx, &record)
+	return record, true, nil
 }
 
 // DeleteServerByUuid will delete the server record with the specified UUID.
@@ -211,6 +333,10 @@ func (s *Storage) DeleteServerByUuid(
 	if err != nil {
 		return fmt.Errorf(`failed to delete server with UUID %q: %w`, uuid, err)
 	}
+
+	if s.secrets != nil {
+		_ = s.secrets.Delete(ctx, serverPasswordKey(uuid))
+	}
 	return nil
 }
 
@@ -238,9 +364,9 @@ func (s *Storage) CreateShare(
 	return err
 }
 
-// GetSharesByServer returns all share records for the server with the specified UUID.
+// GetSharesByServer returns all share records for the server with the specified UUID that are not in the trash.
 func (s *Storage) GetSharesByServer(ctx context.Context, serverUuid string) ([]ShareRecord, error) {
-	rows, err := s.Query(ctx, `select * from share where server = ?`, serverUuid)
+	rows, err := s.Query(ctx, `select * from share where server = ? and deleted_ts is null`, serverUuid)
 	if err != nil {
 		return nil, fmt.Errorf(`failed to query shares for server %q: %w`, serverUuid, err)
 	}
@@ -262,13 +388,58 @@ func (s *Storage) GetSharesByServer(ctx context.Context, serverUuid string) ([]S
 	return records, nil
 }
 
+// GetSharesByServerPage returns up to limit share records for the specified server that are not in the trash, ordered
+// by name, starting after the name in cursor.
+// Specify an empty cursor to start from the beginning.
+// The returned cursor can be passed to get the next page, and is empty when there are no more records.
+func (s *Storage) GetSharesByServerPage(ctx context.Context, serverUuid string, cursor string, limit int) ([]ShareRecord, string, error) {
+	if limit <= 0 {
+		panic("limit must be positive")
+	}
+
+	rows, err := s.Query(ctx, `select * from share where server = ? and deleted_ts is null and name > ? order by name limit ?`, serverUuid, cursor, limit)
+	if err != nil {
+		return nil, "", fmt.Errorf(`failed to query shares for server %q: %w`, serverUuid, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]ShareRecord, 0, limit)
+	for rows.Next() {
+		var record ShareRecord
+		record, _, err = ScanShareRecord(rows)
+		if err != nil {
+			return nil, "", err
+		}
+
+		records = append(records, record)
+	}
+
+	var next string
+	if len(records) == limit {
+		next = records[len(records)-1].Name
+	}
+
+	return records, next, nil
+}
+
+// CountSharesByServer returns the number of shares for the specified server that are not in the trash.
+func (s *Storage) CountSharesByServer(ctx context.Context, serverUuid string) (int, error) {
+	var count int
+	if err := s.QueryRow(ctx, `select count(*) from share where server = ? and deleted_ts is null`, serverUuid).Scan(&count); err != nil {
+		return 0, fmt.Errorf(`failed to count shares for server %q: %w`, serverUuid, err)
+	}
+	return count, nil
+}
+
 func (s *Storage) GetShareByServerUuidAndName(ctx context.Context, serverUuid string, name string) (record ShareRecord, has bool, err error) {
-	row := s.QueryRow(ctx, `select * from share where server = ? and name = ?`, serverUuid, name)
+	row := s.QueryRow(ctx, `select * from share where server = ? and name = ? and deleted_ts is null`, serverUuid, name)
 	return ScanShareRecord(row)
 }
 
 func (s *Storage) GetShareByUuid(ctx context.Context, uuid string) (record ShareRecord, has bool, err error) {
-	row := s.QueryRow(ctx, `select * from share where uuid = ?`, uuid)
+	row := s.QueryRow(ctx, `select * from share where uuid = ? and deleted_ts is null`, uuid)
 	return ScanShareRecord(row)
 }
 
@@ -298,6 +469,126 @@ func (s *Storage) DeleteShareByUuid(
 	return err
 }
 
+// GetShareMounts returns the mounts of the share with the specified UUID, ordered by position.
+func (s *Storage) GetShareMounts(ctx context.Context, shareUuid string) ([]ShareMountRecord, error) {
+	rows, err := s.Query(ctx, `select * from share_mount where share = ? order by position`, shareUuid)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query mounts of share %s: %w`, shareUuid, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]ShareMountRecord, 0)
+	for rows.Next() {
+		var record ShareMountRecord
+		record, _, err = ScanShareMountRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// SetShareMounts replaces the mounts of the share with the specified UUID, and sets how conflicts between them are
+// resolved.
+// The Share and Position fields of the records are ignored; mounts are stored in the order they are passed in.
+func (s *Storage) SetShareMounts(ctx context.Context, shareUuid string, conflictRule int, mounts []ShareMountRecord) error {
+	tx, err := s.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err = tx.ExecContext(ctx, `update share set conflict_rule = ? where uuid = ?`, conflictRule, shareUuid); err != nil {
+		return fmt.Errorf(`failed to set conflict rule of share %s: %w`, shareUuid, err)
+	}
+	if _, err = tx.ExecContext(ctx, `delete from share_mount where share = ?`, shareUuid); err != nil {
+		return fmt.Errorf(`failed to clear mounts of share %s: %w`, shareUuid, err)
+	}
+	for i, mount := range mounts {
+		_, err = tx.ExecContext(ctx, `insert into share_mount (share, position, virtual_path, path) values (?, ?, ?, ?)`,
+			shareUuid,
+			i,
+			mount.VirtualPath.String(),
+			mount.Path,
+		)
+		if err != nil {
+			return fmt.Errorf(`failed to insert mount of share %s: %w`, shareUuid, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// SetSharePathMatching sets how paths requested by peers are matched in the share with the specified UUID.
+func (s *Storage) SetSharePathMatching(ctx context.Context, shareUuid string, opts common.PathMatchOptions) error {
+	_, err := s.Exec(ctx, `update share set case_insensitive = ?, unicode_form = ? where uuid = ?`,
+		opts.CaseInsensitive,
+		int(opts.Form),
+		shareUuid,
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to set path matching of share %s: %w`, shareUuid, err)
+	}
+	return nil
+}
+
+// GetShareExcludes returns the exclude patterns of the share with the specified UUID, in order.
+func (s *Storage) GetShareExcludes(ctx context.Context, shareUuid string) ([]string, error) {
+	rows, err := s.Query(ctx, `select pattern from share_exclude where share = ? order by position`, shareUuid)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query exclude patterns of share %s: %w`, shareUuid, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	patterns := make([]string, 0)
+	for rows.Next() {
+		var pattern string
+		if err = rows.Scan(&pattern); err != nil {
+			return nil, err
+		}
+
+		patterns = append(patterns, pattern)
+	}
+
+	return patterns, nil
+}
+
+// SetShareExcludes replaces the exclude patterns of the share with the specified UUID.
+func (s *Storage) SetShareExcludes(ctx context.Context, shareUuid string, patterns []string) error {
+	tx, err := s.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err = tx.ExecContext(ctx, `delete from share_exclude where share = ?`, shareUuid); err != nil {
+		return fmt.Errorf(`failed to clear exclude patterns of share %s: %w`, shareUuid, err)
+	}
+	for i, pattern := range patterns {
+		_, err = tx.ExecContext(ctx, `insert into share_exclude (share, position, pattern) values (?, ?, ?)`,
+			shareUuid,
+			i,
+			pattern,
+		)
+		if err != nil {
+			return fmt.Errorf(`failed to insert exclude pattern of share %s: %w`, shareUuid, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
 // ClearShareIndex clears the search index for the share with the specified UUID.
 // It excludes all indexes that have an index ID lower than curIndexId.
 func (s *Storage) ClearShareIndex(ctx context.Context, uuid string, curIndexId int64) error {
@@ -308,6 +599,30 @@ func (s *Storage) ClearShareIndex(ctx context.Context, uuid string, curIndexId i
 	return nil
 }
 
+// ClearShareIndexPath removes the search index entries for a path and everything under it in the share with the
+// specified UUID.
+func (s *Storage) ClearShareIndexPath(ctx context.Context, uuid string, path string) error {
+	var err error
+	if path == "/" {
+		_, err = s.Exec(ctx, `delete from share_index_fts where share = ?`, uuid)
+	} else {
+		_, err = s.Exec(ctx, `delete from share_index_fts where share = ? and (path = ? or substr(path, 1, length(?) + 1) = ? || '/')`, uuid, path, path, path)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to clear index for path %q in share %q: %w", path, uuid, err)
+	}
+	return nil
+}
+
+// CountShareIndex returns the number of search index entries for the share with the specified UUID.
+func (s *Storage) CountShareIndex(ctx context.Context, uuid string) (int, error) {
+	var count int
+	if err := s.QueryRow(ctx, `select count(*) from share_index_fts where share = ?`, uuid).Scan(&count); err != nil {
+		return 0, fmt.Errorf(`failed to count index entries for share %q: %w`, uuid, err)
+	}
+	return count, nil
+}
+
 // ClearOrphanedShareIndexes clears share indexes whose share no longer exists.
 // If limit is more than 0, it will only delete up to that many rows.
 // Returns the number of rows deleted.
@@ -515,8 +830,9 @@ func (s *Storage) UpdateServer(
 		vals = append(vals, fields.Username.String())
 	}
 	if fields.Password != nil {
-		fieldStrs = append(fieldStrs, `password = ?`)
-		vals = append(vals, *fields.Password)
+		dbPassword, inKeychain := s.putServerPassword(ctx, uuid, *fields.Password)
+		fieldStrs = append(fieldStrs, `password = ?`, `password_in_keychain = ?`)
+		vals = append(vals, dbPassword, inKeychain)
 	}
 
 	// Nothing to update.
@@ -612,6 +928,25 @@ func (s *Storage) UpdateDownloadState(
 	return nil
 }
 
+// SetDownloadStatePartPath sets the path of the partial file for the download state with the specified UUID.
+// A nil path means that the download has no partial file yet.
+func (s *Storage) SetDownloadStatePartPath(ctx context.Context, uuid string, partPath *string) error {
+	_, err := s.Exec(ctx, `update download_state set part_path = ? where uuid = ?`, partPath, uuid)
+	if err != nil {
+		return fmt.Errorf(`failed to set partial file path for download state with UUID %s: %w`, uuid, err)
+	}
+	return nil
+}
+
+// SetDownloadStateScanStatus sets the result of scanning the completed download with the specified UUID.
+func (s *Storage) SetDownloadStateScanStatus(ctx context.Context, uuid string, status v1.ScanStatus) error {
+	_, err := s.Exec(ctx, `update download_state set scan_status = ? where uuid = ?`, int64(status), uuid)
+	if err != nil {
+		return fmt.Errorf(`failed to set scan status for download state with UUID %s: %w`, uuid, err)
+	}
+	return nil
+}
+
 // DeleteDownloadState deletes the download state with the specified UUID.
 func (s *Storage) DeleteDownloadState(ctx context.Context, uuid string) error {
 	_, err := s.Exec(ctx, `delete from download_state where uuid = ?`, uuid)
@@ -620,3 +955,679 @@ func (s *Storage) DeleteDownloadState(ctx context.Context, uuid string) error {
 	}
 	return nil
 }
+
+// CreateDownloadHook creates a new download hook and returns its record.
+// If downloadUuid is nil, the hook is global and runs for every completed download.
+func (s *Storage) CreateDownloadHook(
+	ctx context.Context,
+	hookType v1.DownloadHookType,
+	target string,
+	downloadUuid *string,
+) (record DownloadHookRecord, err error) {
+	uuidRaw, err := uuid.NewV7()
+	if err != nil {
+		return record, err
+	}
+
+	row := s.QueryRow(ctx, `insert into download_hook (uuid, type, target, download) values (?, ?, ?, ?) returning *`,
+		uuidRaw.String(),
+		hookType,
+		target,
+		downloadUuid,
+	)
+	record, _, err = ScanDownloadHookRecord(row)
+	if err != nil {
+		return record, fmt.Errorf(`failed to create download hook: %w`, err)
+	}
+	return record, nil
+}
+
+func (s *Storage) queryDownloadHooks(ctx context.Context, sqlCode string, args ...any) ([]DownloadHookRecord, error) {
+	rows, err := s.Query(ctx, sqlCode, args...)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query download hooks: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]DownloadHookRecord, 0)
+	for rows.Next() {
+		var record DownloadHookRecord
+		record, _, err = ScanDownloadHookRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// GetDownloadHooks returns all download hooks, both global and per-download.
+func (s *Storage) GetDownloadHooks(ctx context.Context) ([]DownloadHookRecord, error) {
+	return s.queryDownloadHooks(ctx, `select * from download_hook order by created_ts`)
+}
+
+// GetDownloadHooksForDownload returns the hooks that apply to the download with the specified UUID.
+// This includes all global hooks, followed by the download's own hooks.
+func (s *Storage) GetDownloadHooksForDownload(ctx context.Context, downloadUuid string) ([]DownloadHookRecord, error) {
+	return s.queryDownloadHooks(ctx, `select * from download_hook where download is null or download = ? order by download is not null, created_ts`, downloadUuid)
+}
+
+// DeleteDownloadHook deletes the download hook with the specified UUID.
+// Returns true if the hook existed.
+func (s *Storage) DeleteDownloadHook(ctx context.Context, uuid string) (bool, error) {
+	res, err := s.Exec(ctx, `delete from download_hook where uuid = ?`, uuid)
+	if err != nil {
+		return false, fmt.Errorf(`failed to delete download hook with UUID %s: %w`, uuid, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// PutDownloadedFile records that a file with the specified hash and size was downloaded to the specified path.
+// If the path was already recorded, its record is replaced.
+func (s *Storage) PutDownloadedFile(ctx context.Context, path string, sha256 []byte, size int64) error {
+	_, err := s.Exec(ctx, `insert or replace into downloaded_file (path, sha256, size) values (?, ?, ?)`,
+		path,
+		sha256,
+		size,
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to record downloaded file %q: %w`, path, err)
+	}
+	return nil
+}
+
+// HasDownloadedFiles returns whether any downloaded files are recorded.
+func (s *Storage) HasDownloadedFiles(ctx context.Context) (bool, error) {
+	var has bool
+	if err := s.QueryRow(ctx, `select exists(select 1 from downloaded_file)`).Scan(&has); err != nil {
+		return false, fmt.Errorf(`failed to query downloaded files: %w`, err)
+	}
+	return has, nil
+}
+
+// GetDownloadedFilesBySha256 returns the downloaded files with the specified hash, newest first.
+func (s *Storage) GetDownloadedFilesBySha256(ctx context.Context, sha256 []byte) ([]DownloadedFileRecord, error) {
+	rows, err := s.Query(ctx, `select * from downloaded_file where sha256 = ? order by created_ts desc`, sha256)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query downloaded files: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]DownloadedFileRecord, 0)
+	for rows.Next() {
+		var record DownloadedFileRecord
+		record, _, err = ScanDownloadedFileRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// DeleteDownloadedFile deletes the downloaded file record for the specified path.
+// It does not delete anything on disk.
+func (s *Storage) DeleteDownloadedFile(ctx context.Context, path string) error {
+	_, err := s.Exec(ctx, `delete from downloaded_file where path = ?`, path)
+	if err != nil {
+		return fmt.Errorf(`failed to delete downloaded file record for %q: %w`, path, err)
+	}
+	return nil
+}
+
+// PutUploadHistory records a finished upload.
+func (s *Storage) PutUploadHistory(ctx context.Context, record UploadHistoryRecord) error {
+	_, err := s.Exec(ctx, `insert or replace into upload_history (uuid, server, peer_username, file_path, status, file_offset, bytes_sent, file_size, started_ts, ended_ts, error) values (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`,
+		record.Uuid,
+		record.Server,
+		record.PeerUsername.String(),
+		record.FilePath.String(),
+		record.Status,
+		record.Offset,
+		record.BytesSent,
+		record.FileSize,
+		record.StartedTs.Unix(),
+		record.EndedTs.Unix(),
+		record.Error,
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to record upload with UUID %s: %w`, record.Uuid, err)
+	}
+	return nil
+}
+
+// GetUploadHistory returns up to limit finished uploads, newest first.
+func (s *Storage) GetUploadHistory(ctx context.Context, limit int) ([]UploadHistoryRecord, error) {
+	rows, err := s.Query(ctx, `select * from upload_history order by ended_ts desc limit ?`, limit)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query upload history: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]UploadHistoryRecord, 0)
+	for rows.Next() {
+		var record UploadHistoryRecord
+		record, _, err = ScanUploadHistoryRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// PruneUploadHistory deletes all but the newest keep finished uploads.
+func (s *Storage) PruneUploadHistory(ctx context.Context, keep int) error {
+	_, err := s.Exec(ctx, `delete from upload_history where uuid not in (select uuid from upload_history order by ended_ts desc limit ?)`, keep)
+	if err != nil {
+		return fmt.Errorf(`failed to prune upload history: %w`, err)
+	}
+	return nil
+}
+
+// ClearUploadHistory deletes all finished uploads.
+func (s *Storage) ClearUploadHistory(ctx context.Context) error {
+	_, err := s.Exec(ctx, `delete from upload_history`)
+	if err != nil {
+		return fmt.Errorf(`failed to clear upload history: %w`, err)
+	}
+	return nil
+}
+
+// PutFriend creates or replaces the friend info for a user on a server.
+func (s *Storage) PutFriend(
+	ctx context.Context,
+	serverUuid string,
+	username common.NormalizedUsername,
+	nickname string,
+	note string,
+	trustLevel v1.TrustLevel,
+) (record FriendRecord, err error) {
+	row := s.QueryRow(ctx, `insert into friend (server, username, nickname, note, trust_level) values (?, ?, ?, ?, ?)
+on conflict (server, username) do update set
+	nickname = excluded.nickname,
+	note = excluded.note,
+	trust_level = excluded.trust_level,
+	updated_ts = strftime('%s', 'now')
+returning *`,
+		serverUuid,
+		username.String(),
+		nickname,
+		note,
+		trustLevel,
+	)
+	record, _, err = ScanFriendRecord(row)
+	if err != nil {
+		return record, fmt.Errorf(`failed to put friend %q on server %s: %w`, username.String(), serverUuid, err)
+	}
+	return record, nil
+}
+
+// GetFriends returns the friend info for all users on a server that have any, ordered by username.
+func (s *Storage) GetFriends(ctx context.Context, serverUuid string) ([]FriendRecord, error) {
+	rows, err := s.Query(ctx, `select * from friend where server = ? order by username`, serverUuid)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query friends: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]FriendRecord, 0)
+	for rows.Next() {
+		var record FriendRecord
+		record, _, err = ScanFriendRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// DeleteFriend deletes the friend info for a user on a server.
+// Returns false if there was none.
+func (s *Storage) DeleteFriend(ctx context.Context, serverUuid string, username common.NormalizedUsername) (bool, error) {
+	res, err := s.Exec(ctx, `delete from friend where server = ? and username = ?`, serverUuid, username.String())
+	if err != nil {
+		return false, fmt.Errorf(`failed to delete friend %q on server %s: %w`, username.String(), serverUuid, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// CreateShareLink creates a link with the specified token to a path in a share.
+// If expiresTs is nil, the link never expires.
+func (s *Storage) CreateShareLink(
+	ctx context.Context,
+	token string,
+	shareUuid string,
+	path common.ProtoPath,
+	expiresTs *time.Time,
+) (record ShareLinkRecord, err error) {
+	var expires *int64
+	if expiresTs != nil {
+		expires = new(expiresTs.Unix())
+	}
+
+	row := s.QueryRow(ctx, `insert into share_link (token, share, path, expires_ts) values (?, ?, ?, ?) returning *`,
+		token,
+		shareUuid,
+		path.String(),
+		expires,
+	)
+	record, _, err = ScanShareLinkRecord(row)
+	if err != nil {
+		return record, fmt.Errorf(`failed to create link to %q in share %s: %w`, path.String(), shareUuid, err)
+	}
+	return record, nil
+}
+
+// GetShareLink returns the share link with the specified token.
+func (s *Storage) GetShareLink(ctx context.Context, token string) (record ShareLinkRecord, has bool, err error) {
+	row := s.QueryRow(ctx, `select * from share_link where token = ?`, token)
+	return ScanShareLinkRecord(row)
+}
+
+// GetShareLinksByShare returns all links to a share, ordered by creation time.
+func (s *Storage) GetShareLinksByShare(ctx context.Context, shareUuid string) ([]ShareLinkRecord, error) {
+	rows, err := s.Query(ctx, `select * from share_link where share = ? order by created_ts`, shareUuid)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query share links: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]ShareLinkRecord, 0)
+	for rows.Next() {
+		var record ShareLinkRecord
+		record, _, err = ScanShareLinkRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// DeleteShareLink deletes the share link with the specified token.
+// Returns false if there was none.
+func (s *Storage) DeleteShareLink(ctx context.Context, token string) (bool, error) {
+	res, err := s.Exec(ctx, `delete from share_link where token = ?`, token)
+	if err != nil {
+		return false, fmt.Errorf(`failed to delete share link: %w`, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// PutBlockedPeer adds a user on a server to the block list.
+// If the user is already blocked, nothing happens.
+func (s *Storage) PutBlockedPeer(ctx context.Context, serverUuid string, username common.NormalizedUsername) error {
+	_, err := s.Exec(ctx, `insert into blocked_peer (server, username) values (?, ?) on conflict do nothing`,
+		serverUuid,
+		username.String(),
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to block peer %q on server %s: %w`, username.String(), serverUuid, err)
+	}
+	return nil
+}
+
+// GetBlockedPeers returns all blocked users on a server, ordered by username.
+func (s *Storage) GetBlockedPeers(ctx context.Context, serverUuid string) ([]BlockedPeerRecord, error) {
+	rows, err := s.Query(ctx, `select * from blocked_peer where server = ? order by username`, serverUuid)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query blocked peers: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]BlockedPeerRecord, 0)
+	for rows.Next() {
+		var record BlockedPeerRecord
+		record, _, err = ScanBlockedPeerRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// DeleteBlockedPeer removes a user on a server from the block list.
+// Returns false if the user was not blocked.
+func (s *Storage) DeleteBlockedPeer(ctx context.Context, serverUuid string, username common.NormalizedUsername) (bool, error) {
+	res, err := s.Exec(ctx, `delete from blocked_peer where server = ? and username = ?`, serverUuid, username.String())
+	if err != nil {
+		return false, fmt.Errorf(`failed to unblock peer %q on server %s: %w`, username.String(), serverUuid, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// GetConnWindows returns the connection windows of a server.
+func (s *Storage) GetConnWindows(ctx context.Context, serverUuid string) ([]ConnWindowRecord, error) {
+	rows, err := s.Query(ctx, `select * from conn_window where server = ? order by start_minute`, serverUuid)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query connection windows: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]ConnWindowRecord, 0)
+	for rows.Next() {
+		var record ConnWindowRecord
+		record, _, err = ScanConnWindowRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// SetConnWindows replaces the connection windows of a server.
+// The Server field of the records is ignored.
+func (s *Storage) SetConnWindows(ctx context.Context, serverUuid string, windows []ConnWindowRecord) error {
+	tx, err := s.Db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	if _, err = tx.ExecContext(ctx, `delete from conn_window where server = ?`, serverUuid); err != nil {
+		return fmt.Errorf(`failed to clear connection windows of server %s: %w`, serverUuid, err)
+	}
+	for _, window := range windows {
+		_, err = tx.ExecContext(ctx, `insert into conn_window (server, weekdays, start_minute, end_minute) values (?, ?, ?, ?)`,
+			serverUuid,
+			window.Weekdays,
+			window.StartMinute,
+			window.EndMinute,
+		)
+		if err != nil {
+			return fmt.Errorf(`failed to insert connection window of server %s: %w`, serverUuid, err)
+		}
+	}
+
+	return tx.Commit()
+}
+
+// CreateRunSession records that the client started running and returns the new run session's UUID.
+func (s *Storage) CreateRunSession(ctx context.Context) (string, error) {
+	uuidRaw, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf(`failed to generate UUIDv7: %w`, err)
+	}
+
+	id := uuidRaw.String()
+	now := time.Now().Unix()
+
+	_, err = s.Exec(ctx, `insert into run_session (uuid, started_ts, last_seen_ts) values (?, ?, ?)`, id, now, now)
+	if err != nil {
+		return "", fmt.Errorf(`failed to create run session: %w`, err)
+	}
+	return id, nil
+}
+
+// TouchRunSession updates the time the run session with the specified UUID was last known to be running.
+func (s *Storage) TouchRunSession(ctx context.Context, runUuid string) error {
+	_, err := s.Exec(ctx, `update run_session set last_seen_ts = ? where uuid = ?`, time.Now().Unix(), runUuid)
+	if err != nil {
+		return fmt.Errorf(`failed to update run session with UUID %s: %w`, runUuid, err)
+	}
+	return nil
+}
+
+// StopRunSession records that the run session with the specified UUID stopped cleanly.
+// Connection sessions of the run that are still open are ended with the specified reason.
+func (s *Storage) StopRunSession(ctx context.Context, runUuid string, reason string) error {
+	now := time.Now().Unix()
+
+	_, err := s.Exec(ctx, `update conn_session set disconnected_ts = ?, disconnect_reason = ? where run = ? and disconnected_ts is null`,
+		now,
+		reason,
+		runUuid,
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to end connection sessions of run session with UUID %s: %w`, runUuid, err)
+	}
+
+	_, err = s.Exec(ctx, `update run_session set stopped_ts = ?, last_seen_ts = ? where uuid = ?`, now, now, runUuid)
+	if err != nil {
+		return fmt.Errorf(`failed to stop run session with UUID %s: %w`, runUuid, err)
+	}
+	return nil
+}
+
+// MarkCrashedRunSessions marks all run sessions that never stopped as crashed, using the time they were last seen
+// as their stop time.
+// Connection sessions of those runs that are still open are ended at the same time with the specified reason.
+// It must only be called before the current run session is created.
+// Returns the number of run sessions marked as crashed.
+func (s *Storage) MarkCrashedRunSessions(ctx context.Context, reason string) (int64, error) {
+	_, err := s.Exec(ctx, `
+update conn_session set
+	disconnected_ts = (select last_seen_ts from run_session where run_session.uuid = conn_session.run),
+	disconnect_reason = ?
+where disconnected_ts is null
+	`,
+		reason,
+	)
+	if err != nil {
+		return 0, fmt.Errorf(`failed to end connection sessions of crashed run sessions: %w`, err)
+	}
+
+	res, err := s.Exec(ctx, `update run_session set stopped_ts = last_seen_ts, crashed = 1 where stopped_ts is null`)
+	if err != nil {
+		return 0, fmt.Errorf(`failed to mark crashed run sessions: %w`, err)
+	}
+	num, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(`failed to get number of crashed run sessions: %w`, err)
+	}
+	return num, nil
+}
+
+// GetRunSessions returns up to limit run sessions, newest first.
+func (s *Storage) GetRunSessions(ctx context.Context, limit int) ([]RunSessionRecord, error) {
+	rows, err := s.Query(ctx, `select * from run_session order by started_ts desc, uuid desc limit ?`, limit)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query run sessions: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]RunSessionRecord, 0)
+	for rows.Next() {
+		var record RunSessionRecord
+		record, _, err = ScanRunSessionRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// PruneRunSessions deletes all but the newest keep run sessions, along with their connection sessions.
+func (s *Storage) PruneRunSessions(ctx context.Context, keep int) error {
+	_, err := s.Exec(ctx, `delete from run_session where uuid not in (select uuid from run_session order by started_ts desc, uuid desc limit ?)`, keep)
+	if err != nil {
+		return fmt.Errorf(`failed to prune run sessions: %w`, err)
+	}
+	return nil
+}
+
+// CreateConnSession records that a connection to the server with the specified UUID opened during the run session
+// with the specified UUID, and returns the new connection session's UUID.
+func (s *Storage) CreateConnSession(ctx context.Context, runUuid string, serverUuid string) (string, error) {
+	uuidRaw, err := uuid.NewV7()
+	if err != nil {
+		return "", fmt.Errorf(`failed to generate UUIDv7: %w`, err)
+	}
+
+	id := uuidRaw.String()
+
+	_, err = s.Exec(ctx, `insert into conn_session (uuid, run, server, connected_ts) values (?, ?, ?, ?)`,
+		id,
+		runUuid,
+		serverUuid,
+		time.Now().Unix(),
+	)
+	if err != nil {
+		return "", fmt.Errorf(`failed to create connection session for server %s: %w`, serverUuid, err)
+	}
+	return id, nil
+}
+
+// EndConnSession records that the connection session with the specified UUID ended for the specified reason.
+// No-op if it already ended.
+func (s *Storage) EndConnSession(ctx context.Context, connUuid string, reason string) error {
+	_, err := s.Exec(ctx, `update conn_session set disconnected_ts = ?, disconnect_reason = ? where uuid = ? and disconnected_ts is null`,
+		time.Now().Unix(),
+		reason,
+		connUuid,
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to end connection session with UUID %s: %w`, connUuid, err)
+	}
+	return nil
+}
+
+// GetConnSessions returns up to limit connection sessions, newest first.
+// If serverUuid is not empty, only sessions for that server are returned.
+func (s *Storage) GetConnSessions(ctx context.Context, serverUuid string, limit int) ([]ConnSessionRecord, error) {
+	var rows *sql.Rows
+	var err error
+	if serverUuid == "" {
+		rows, err = s.Query(ctx, `select * from conn_session order by connected_ts desc, uuid desc limit ?`, limit)
+	} else {
+		rows, err = s.Query(ctx, `select * from conn_session where server = ? order by connected_ts desc, uuid desc limit ?`, serverUuid, limit)
+	}
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query connection sessions: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]ConnSessionRecord, 0)
+	for rows.Next() {
+		var record ConnSessionRecord
+		record, _, err = ScanConnSessionRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// CreatePlugin creates a new plugin with the specified token hash and scopes, and returns its record.
+func (s *Storage) CreatePlugin(ctx context.Context, name string, tokenSha256 []byte, scopes []v1.PluginScope) (record PluginRecord, err error) {
+	row := s.QueryRow(ctx, `insert into plugin (name, token_sha256, scopes) values (?, ?, ?) returning *`,
+		name,
+		tokenSha256,
+		pluginScopesToMask(scopes),
+	)
+	record, _, err = ScanPluginRecord(row)
+	if err != nil {
+		return record, fmt.Errorf(`failed to create plugin %q: %w`, name, err)
+	}
+	return record, nil
+}
+
+// GetPlugins returns all plugins, ordered by name.
+func (s *Storage) GetPlugins(ctx context.Context) ([]PluginRecord, error) {
+	rows, err := s.Query(ctx, `select * from plugin order by name`)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query plugins: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]PluginRecord, 0)
+	for rows.Next() {
+		var record PluginRecord
+		record, _, err = ScanPluginRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// GetPluginByName returns the plugin with the specified name.
+func (s *Storage) GetPluginByName(ctx context.Context, name string) (record PluginRecord, has bool, err error) {
+	row := s.QueryRow(ctx, `select * from plugin where name = ?`, name)
+	return ScanPluginRecord(row)
+}
+
+// GetPluginByTokenSha256 returns the plugin with the specified token hash.
+func (s *Storage) GetPluginByTokenSha256(ctx context.Context, tokenSha256 []byte) (record PluginRecord, has bool, err error) {
+	row := s.QueryRow(ctx, `select * from plugin where token_sha256 = ?`, tokenSha256)
+	return ScanPluginRecord(row)
+}
+
+// DeletePlugin deletes the plugin with the specified name.
+// Returns true if the plugin existed.
+func (s *Storage) DeletePlugin(ctx context.Context, name string) (bool, error) {
+	res, err := s.Exec(ctx, `delete from plugin where name = ?`, name)
+	if err != nil {
+		return false, fmt.Errorf(`failed to delete plugin %q: %w`, name, err)
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
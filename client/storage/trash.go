@@ -0,0 +1,203 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Deleting a server or share moves it to the trash instead of deleting it right away, so it can be restored.
+// Records in the trash have a deleted_ts, and are left out of everything except the methods in this file.
+// Shares of a server in the trash stay with it, and are restored or purged along with it.
+
+// affectedAny returns whether the result of an Exec call affected any rows.
+func affectedAny(res sql.Result, err error) (bool, error) {
+	if err != nil {
+		return false, err
+	}
+	affected, err := res.RowsAffected()
+	if err != nil {
+		return false, err
+	}
+	return affected > 0, nil
+}
+
+// TrashServerByUuid moves the server with the specified UUID to the trash.
+// Returns false if there is no such server outside the trash.
+func (s *Storage) TrashServerByUuid(ctx context.Context, uuid string) (bool, error) {
+	has, err := affectedAny(s.Exec(ctx, `update server set deleted_ts = strftime('%s', 'now') where uuid = ? and deleted_ts is null`, uuid))
+	if err != nil {
+		return false, fmt.Errorf(`failed to move server with UUID %q to the trash: %w`, uuid, err)
+	}
+	return has, nil
+}
+
+// RestoreServerByUuid takes the server with the specified UUID out of the trash.
+// Returns false if there is no such server in the trash.
+func (s *Storage) RestoreServerByUuid(ctx context.Context, uuid string) (bool, error) {
+	has, err := affectedAny(s.Exec(ctx, `update server set deleted_ts = null where uuid = ? and deleted_ts is not null`, uuid))
+	if err != nil {
+		return false, fmt.Errorf(`failed to restore server with UUID %q from the trash: %w`, uuid, err)
+	}
+	return has, nil
+}
+
+// PurgeTrashedServerByUuid deletes the server with the specified UUID from the trash, along with all records
+// associated with it.
+// Returns false if there is no such server in the trash.
+func (s *Storage) PurgeTrashedServerByUuid(ctx context.Context, uuid string) (bool, error) {
+	var count int
+	if err := s.QueryRow(ctx, `select count(*) from server where uuid = ? and deleted_ts is not null`, uuid).Scan(&count); err != nil {
+		return false, fmt.Errorf(`failed to check whether server with UUID %q is in the trash: %w`, uuid, err)
+	}
+	if count == 0 {
+		return false, nil
+	}
+
+	if err := s.DeleteServerByUuid(ctx, uuid); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// GetAllServerUuids returns the UUIDs of all servers, including those in the trash.
+func (s *Storage) GetAllServerUuids(ctx context.Context) (map[string]struct{}, error) {
+	rows, err := s.Query(ctx, `select uuid from server`)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query server UUIDs: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	uuids := make(map[string]struct{})
+	for rows.Next() {
+		var uuid string
+		if err = rows.Scan(&uuid); err != nil {
+			return nil, err
+		}
+		uuids[uuid] = struct{}{}
+	}
+
+	return uuids, rows.Err()
+}
+
+// GetTrashedServers returns all server records in the trash, most recently deleted first.
+// Passwords are not loaded.
+func (s *Storage) GetTrashedServers(ctx context.Context) ([]ServerRecord, error) {
+	rows, err := s.Query(ctx, `select * from server where deleted_ts is not null order by deleted_ts desc`)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query servers in the trash: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]ServerRecord, 0)
+	for rows.Next() {
+		var record ServerRecord
+		record, _, err = ScanServerRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// TrashShareByServerUuidAndName moves the share with the specified server UUID and name to the trash.
+// Returns false if there is no such share outside the trash.
+func (s *Storage) TrashShareByServerUuidAndName(ctx context.Context, serverUuid string, name string) (bool, error) {
+	has, err := affectedAny(s.Exec(ctx, `update share set deleted_ts = strftime('%s', 'now') where server = ? and name = ? and deleted_ts is null`, serverUuid, name))
+	if err != nil {
+		return false, fmt.Errorf(`failed to move share with server UUID %q and name %q to the trash: %w`, serverUuid, name, err)
+	}
+	return has, nil
+}
+
+// RestoreShareByServerUuidAndName takes the share with the specified server UUID and name out of the trash.
+// Returns false if there is no such share in the trash.
+func (s *Storage) RestoreShareByServerUuidAndName(ctx context.Context, serverUuid string, name string) (bool, error) {
+	has, err := affectedAny(s.Exec(ctx, `update share set deleted_ts = null where server = ? and name = ? and deleted_ts is not null`, serverUuid, name))
+	if err != nil {
+		return false, fmt.Errorf(`failed to restore share with server UUID %q and name %q from the trash: %w`, serverUuid, name, err)
+	}
+	return has, nil
+}
+
+// PurgeTrashedShareByServerUuidAndName deletes the share with the specified server UUID and name from the trash.
+// Returns false if there is no such share in the trash.
+func (s *Storage) PurgeTrashedShareByServerUuidAndName(ctx context.Context, serverUuid string, name string) (bool, error) {
+	has, err := affectedAny(s.Exec(ctx, `delete from share where server = ? and name = ? and deleted_ts is not null`, serverUuid, name))
+	if err != nil {
+		return false, fmt.Errorf(`failed to purge share with server UUID %q and name %q from the trash: %w`, serverUuid, name, err)
+	}
+	return has, nil
+}
+
+// GetTrashedShares returns all share records in the trash, most recently deleted first.
+// Shares of servers in the trash are not included, since they are restored or purged along with their server.
+func (s *Storage) GetTrashedShares(ctx context.Context) ([]ShareRecord, error) {
+	rows, err := s.Query(ctx, `select * from share where deleted_ts is not null and server in (select uuid from server where deleted_ts is null) order by deleted_ts desc`)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query shares in the trash: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]ShareRecord, 0)
+	for rows.Next() {
+		var record ShareRecord
+		record, _, err = ScanShareRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// PurgeExpiredTrash deletes all servers and shares that were moved to the trash before the specified time.
+// Returns the number of servers and shares that were deleted.
+func (s *Storage) PurgeExpiredTrash(ctx context.Context, before time.Time) (int64, error) {
+	rows, err := s.Query(ctx, `select uuid from server where deleted_ts < ?`, before.Unix())
+	if err != nil {
+		return 0, fmt.Errorf(`failed to query expired servers in the trash: %w`, err)
+	}
+	uuids := make([]string, 0)
+	for rows.Next() {
+		var uuid string
+		if err = rows.Scan(&uuid); err != nil {
+			_ = rows.Close()
+			return 0, err
+		}
+		uuids = append(uuids, uuid)
+	}
+	_ = rows.Close()
+
+	// Servers are deleted one at a time so that their passwords are deleted from the secret store.
+	var purged int64
+	for _, uuid := range uuids {
+		if err = s.DeleteServerByUuid(ctx, uuid); err != nil {
+			return purged, err
+		}
+		purged++
+	}
+
+	res, err := s.Exec(ctx, `delete from share where deleted_ts < ?`, before.Unix())
+	if err != nil {
+		return purged, fmt.Errorf(`failed to purge expired shares from the trash: %w`, err)
+	}
+	shares, err := res.RowsAffected()
+	if err != nil {
+		return purged, err
+	}
+
+	return purged + shares, nil
+}
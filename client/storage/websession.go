@@ -0,0 +1,50 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// CreateWebSession creates a web UI session that expires at the specified time.
+// Only a hash of the session ID is stored, so that the database does not hold usable sessions.
+func (s *Storage) CreateWebSession(ctx context.Context, idHash string, expires time.Time) error {
+	_, err := s.Exec(ctx, `insert into web_session (id_hash, expires_ts) values (?, ?)`, idHash, expires.Unix())
+	if err != nil {
+		return fmt.Errorf(`failed to create web session: %w`, err)
+	}
+	return nil
+}
+
+// HasWebSession returns whether a web UI session with the specified ID hash exists and has not expired.
+func (s *Storage) HasWebSession(ctx context.Context, idHash string) (bool, error) {
+	var one int
+	err := s.QueryRow(ctx, `select 1 from web_session where id_hash = ? and expires_ts > ?`, idHash, time.Now().Unix()).Scan(&one)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return false, nil
+		}
+		return false, fmt.Errorf(`failed to query web session: %w`, err)
+	}
+	return true, nil
+}
+
+// DeleteExpiredWebSessions deletes web UI sessions that have expired.
+func (s *Storage) DeleteExpiredWebSessions(ctx context.Context) error {
+	_, err := s.Exec(ctx, `delete from web_session where expires_ts <= ?`, time.Now().Unix())
+	if err != nil {
+		return fmt.Errorf(`failed to delete expired web sessions: %w`, err)
+	}
+	return nil
+}
+
+// DeleteWebSessions deletes all web UI sessions, signing out every browser.
+func (s *Storage) DeleteWebSessions(ctx context.Context) error {
+	_, err := s.Exec(ctx, `delete from web_session`)
+	if err != nil {
+		return fmt.Errorf(`failed to delete web sessions: %w`, err)
+	}
+	return nil
+}
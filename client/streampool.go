@@ -0,0 +1,209 @@
+package client
+
+import (
+	"context"
+	"io"
+	"sync"
+	"time"
+
+	"friendnet.org/client/fsys"
+	"friendnet.org/common"
+)
+
+// Media players request files as a series of range requests, often aborting one and starting the next a little
+// further along when seeking or buffering. Opening a new C2C stream for every one of those requests adds a round trip
+// to the peer each time, so the file server keeps the stream from the last request open for a short while and reuses
+// it if the next request for the same file starts at or shortly after where the stream left off.
+
+// fileStreamIdleTimeout is how long an unused file stream is kept open before it is closed.
+const fileStreamIdleTimeout = 30 * time.Second
+
+// fileStreamMaxSkip is the maximum number of bytes a kept stream will read and discard to reach the offset of a new
+// request. Requests further ahead than this open a new stream, since discarding would take longer than reopening.
+const fileStreamMaxSkip = 4 * 1024 * 1024
+
+// fileStreamMaxPerPeer is the maximum number of streams kept open to a single peer.
+// Each kept stream holds read-ahead buffers and a stream to the peer, so the oldest is closed to make room.
+const fileStreamMaxPerPeer = 4
+
+// fileStreamMaxTotal is the maximum number of streams kept open to all peers together.
+const fileStreamMaxTotal = 32
+
+// pooledFileStream is a read-ahead file stream and its current position in the file.
+type pooledFileStream struct {
+	ra *fsys.ReadAheadReader
+
+	// The offset in the file of the next byte that will be read.
+	pos int64
+
+	// When the stream was put in the pool.
+	keptTs time.Time
+
+	idleTimer *time.Timer
+
+	// Stops closing the stream along with the connection it was opened on.
+	stopConnClose func() bool
+}
+
+// newPooledFileStream wraps a file stream that starts at the specified offset, reading up to readAhead bytes ahead.
+func newPooledFileStream(src io.ReadCloser, offset int64, readAhead int) *pooledFileStream {
+	return &pooledFileStream{
+		ra:  fsys.NewReadAheadReader(src, readAhead),
+		pos: offset,
+	}
+}
+
+// Reader returns a reader for the stream that stops when ctx is done.
+func (s *pooledFileStream) Reader(ctx context.Context) io.Reader {
+	return &pooledFileStreamReader{ctx: ctx, s: s}
+}
+
+func (s *pooledFileStream) Close() error {
+	return s.ra.Close()
+}
+
+type pooledFileStreamReader struct {
+	ctx context.Context
+	s   *pooledFileStream
+}
+
+func (r *pooledFileStreamReader) Read(p []byte) (int, error) {
+	n, err := r.s.ra.ReadContext(r.ctx, p)
+	r.s.pos += int64(n)
+	return n, err
+}
+
+// fileStreamKey identifies the file a pooled stream reads.
+// The ETag and size are included so that a stream is never reused after the file changes.
+type fileStreamKey struct {
+	server   string
+	username common.NormalizedUsername
+	path     common.ProtoPath
+	etag     string
+	size     int64
+}
+
+// fileStreamPool keeps recently used file streams open so they can be reused by following requests.
+// At most one stream is kept per file, fileStreamMaxPerPeer per peer and fileStreamMaxTotal overall.
+type fileStreamPool struct {
+	mu      sync.Mutex
+	streams map[fileStreamKey]*pooledFileStream
+}
+
+func newFileStreamPool() *fileStreamPool {
+	return &fileStreamPool{
+		streams: make(map[fileStreamKey]*pooledFileStream),
+	}
+}
+
+// Take removes and returns the kept stream for the file if it can be positioned at offset, or nil otherwise.
+// The caller owns the returned stream.
+func (p *fileStreamPool) Take(ctx context.Context, key fileStreamKey, offset int64) *pooledFileStream {
+	p.mu.Lock()
+	s, has := p.streams[key]
+	if has {
+		delete(p.streams, key)
+		s.idleTimer.Stop()
+		s.stopConnClose()
+	}
+	p.mu.Unlock()
+
+	if !has {
+		return nil
+	}
+
+	if offset < s.pos || offset-s.pos > fileStreamMaxSkip || s.ra.Failed() {
+		_ = s.Close()
+		return nil
+	}
+
+	if skip := offset - s.pos; skip > 0 {
+		if _, err := io.CopyN(io.Discard, s.Reader(ctx), skip); err != nil {
+			_ = s.Close()
+			return nil
+		}
+	}
+
+	return s
+}
+
+// Put keeps a stream open for reuse, replacing any existing stream for the same file.
+// connCtx is the context of the room connection the stream was opened on, and the stream is closed once it is done.
+// The stream is also closed if it is not taken within fileStreamIdleTimeout, or to make room for newer streams.
+func (p *fileStreamPool) Put(connCtx context.Context, key fileStreamKey, s *pooledFileStream) {
+	p.mu.Lock()
+	// The callbacks only find the stream once it is in the map, so they must be registered under the lock.
+	s.keptTs = time.Now()
+	s.idleTimer = time.AfterFunc(fileStreamIdleTimeout, func() {
+		p.remove(key, s)
+	})
+	s.stopConnClose = context.AfterFunc(connCtx, func() {
+		p.remove(key, s)
+	})
+
+	evicted := make([]*pooledFileStream, 0, 2)
+	if old, has := p.streams[key]; has {
+		delete(p.streams, key)
+		evicted = append(evicted, old)
+	}
+	p.streams[key] = s
+	if oldestKey, has := p.oldestNoLock(func(k fileStreamKey) bool {
+		return k.server == key.server && k.username == key.username
+	}, fileStreamMaxPerPeer); has {
+		evicted = append(evicted, p.streams[oldestKey])
+		delete(p.streams, oldestKey)
+	}
+	if oldestKey, has := p.oldestNoLock(func(fileStreamKey) bool { return true }, fileStreamMaxTotal); has {
+		evicted = append(evicted, p.streams[oldestKey])
+		delete(p.streams, oldestKey)
+	}
+	p.mu.Unlock()
+
+	for _, old := range evicted {
+		old.idleTimer.Stop()
+		old.stopConnClose()
+		_ = old.Close()
+	}
+}
+
+// oldestNoLock returns the key of the oldest kept stream matching match, if more than limit streams match.
+func (p *fileStreamPool) oldestNoLock(match func(key fileStreamKey) bool, limit int) (fileStreamKey, bool) {
+	var oldestKey fileStreamKey
+	var oldest *pooledFileStream
+	count := 0
+	for k, s := range p.streams {
+		if !match(k) {
+			continue
+		}
+		count++
+		if oldest == nil || s.keptTs.Before(oldest.keptTs) {
+			oldestKey = k
+			oldest = s
+		}
+	}
+	return oldestKey, count > limit
+}
+
+// remove closes a kept stream, unless it was taken or replaced in the meantime, in which case it belongs to someone
+// else now.
+func (p *fileStreamPool) remove(key fileStreamKey, s *pooledFileStream) {
+	p.mu.Lock()
+	owned := p.streams[key] == s
+	if owned {
+		delete(p.streams, key)
+	}
+	p.mu.Unlock()
+
+	if owned {
+		s.idleTimer.Stop()
+		s.stopConnClose()
+		_ = s.Close()
+	}
+}
+
+// Len returns the number of kept streams.
+func (p *fileStreamPool) Len() int {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	return len(p.streams)
+}
@@ -0,0 +1,77 @@
+package client
+
+import (
+	"context"
+	"io"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+)
+
+// closeCountingReader is a file stream that counts how many times it was closed.
+type closeCountingReader struct {
+	io.Reader
+	closed *atomic.Int32
+}
+
+func (r closeCountingReader) Close() error {
+	r.closed.Add(1)
+	return nil
+}
+
+func TestFileStreamPoolLimits(t *testing.T) {
+	t.Parallel()
+
+	var closed atomic.Int32
+	newStream := func() *pooledFileStream {
+		return newPooledFileStream(closeCountingReader{Reader: strings.NewReader("data"), closed: &closed}, 0, 16)
+	}
+	key := func(username string, i int) fileStreamKey {
+		return fileStreamKey{
+			server:   "server",
+			username: common.UncheckedCreateNormalizedUsername(username),
+			path:     common.UncheckedCreateProtoPath("/file" + strconv.Itoa(i)),
+			size:     4,
+		}
+	}
+
+	pool := newFileStreamPool()
+	connCtx, connCancel := context.WithCancel(context.Background())
+	defer connCancel()
+
+	// A peer only gets so many kept streams, and the oldest make room for newer ones.
+	for i := range fileStreamMaxPerPeer + 2 {
+		pool.Put(connCtx, key("alice", i), newStream())
+	}
+	if n := pool.Len(); n != fileStreamMaxPerPeer {
+		t.Fatalf("expected %d kept streams, got %d", fileStreamMaxPerPeer, n)
+	}
+	if n := closed.Load(); n != 2 {
+		t.Fatalf("expected the 2 oldest streams to be closed, got %d closed", n)
+	}
+	if s := pool.Take(context.Background(), key("alice", 0), 0); s != nil {
+		t.Fatal("expected the oldest stream to be gone")
+	}
+	if s := pool.Take(context.Background(), key("alice", fileStreamMaxPerPeer+1), 0); s == nil {
+		t.Fatal("expected the newest stream to be kept")
+	} else {
+		_ = s.Close()
+	}
+
+	// Closing the connection the streams were opened on closes them.
+	connCancel()
+	deadline := time.Now().Add(5 * time.Second)
+	for pool.Len() != 0 {
+		if time.Now().After(deadline) {
+			t.Fatalf("expected streams to be closed with their connection, %d are left", pool.Len())
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	if n := closed.Load(); n != fileStreamMaxPerPeer+2 {
+		t.Fatalf("expected every stream to be closed, got %d closed", n)
+	}
+}
@@ -0,0 +1,133 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"time"
+)
+
+// TrashRetentionSetting is the setting key for how many days deleted servers and shares are kept in the trash before
+// they are purged for good.
+// Updates to this will reflect the next time the trash is purged.
+const TrashRetentionSetting = "trash_retention_days"
+
+// DefaultTrashRetention is the default value of TrashRetentionSetting.
+const DefaultTrashRetention = 30
+
+// MaxTrashRetention is the maximum value of TrashRetentionSetting.
+const MaxTrashRetention = 365
+
+// trashPurgeInterval is how often servers and shares that were in the trash for longer than the retention period are
+// purged.
+const trashPurgeInterval = 1 * time.Hour
+
+// TrashRetention returns how long deleted servers and shares are kept in the trash, using the current settings.
+func (c *MultiClient) TrashRetention(ctx context.Context) (time.Duration, error) {
+	days, err := c.storage.GetSettingIntOr(ctx, TrashRetentionSetting, DefaultTrashRetention)
+	if err != nil {
+		return 0, err
+	}
+	days = min(max(days, 0), MaxTrashRetention)
+
+	return time.Duration(days) * 24 * time.Hour, nil
+}
+
+// RestoreByUuid takes the server with the specified UUID out of the trash and starts managing a connection to it.
+// Returns false if there is no such server in the trash.
+func (c *MultiClient) RestoreByUuid(ctx context.Context, uuid string) (*Server, bool, error) {
+	c.mu.RLock()
+	isClosed := c.isClosed
+	c.mu.RUnlock()
+	if isClosed {
+		return nil, false, ErrMultiClientClosed
+	}
+
+	has, err := c.storage.RestoreServerByUuid(ctx, uuid)
+	if err != nil || !has {
+		return nil, false, err
+	}
+
+	record, has, err := c.storage.GetServerByUuid(ctx, uuid)
+	if err != nil {
+		return nil, false, fmt.Errorf(`failed to get server record for restored server %q: %w`, uuid, err)
+	}
+	if !has {
+		return nil, false, fmt.Errorf(`restored server record with UUID %q not found`, uuid)
+	}
+
+	inst, err := c.createServerInstance(record)
+	if err != nil {
+		// Put it back in the trash so that it can be restored again.
+		_, _ = c.storage.TrashServerByUuid(ctx, uuid)
+		return nil, false, fmt.Errorf(`failed to create server instance for restored server %q: %w`, uuid, err)
+	}
+
+	c.mu.Lock()
+	c.servers[uuid] = inst
+	c.mu.Unlock()
+
+	return inst, true, nil
+}
+
+// PurgeByUuid permanently deletes the server with the specified UUID from the trash.
+// Returns false if there is no such server in the trash.
+func (c *MultiClient) PurgeByUuid(ctx context.Context, uuid string) (bool, error) {
+	has, err := c.storage.PurgeTrashedServerByUuid(ctx, uuid)
+	if err != nil || !has {
+		return has, err
+	}
+
+	if err = pruneServerCompleteDirs(ctx, c.storage); err != nil {
+		return true, fmt.Errorf(`failed to remove complete download directory of purged server %q: %w`, uuid, err)
+	}
+	return true, nil
+}
+
+// trashPurger purges servers and shares that were in the trash for longer than the retention period.
+func (c *MultiClient) trashPurger() {
+	do := func() {
+		retention, err := c.TrashRetention(c.ctx)
+		if err != nil {
+			c.logger.Error("failed to get trash retention",
+				"service", "client.MultiClient",
+				"err", err,
+			)
+			return
+		}
+
+		purged, err := c.storage.PurgeExpiredTrash(c.ctx, time.Now().Add(-retention))
+		if err != nil {
+			c.logger.Error("failed to purge expired trash",
+				"service", "client.MultiClient",
+				"err", err,
+			)
+		}
+		if purged > 0 {
+			c.logger.Info("purged expired servers and shares from the trash",
+				"service", "client.MultiClient",
+				"total", purged,
+			)
+
+			if err = pruneServerCompleteDirs(c.ctx, c.storage); err != nil {
+				c.logger.Error("failed to remove complete download directories of purged servers",
+					"service", "client.MultiClient",
+					"err", err,
+				)
+			}
+		}
+	}
+
+	do()
+
+	ticker := time.NewTicker(trashPurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-c.ctx.Done():
+			return
+		case <-ticker.C:
+			do()
+		}
+	}
+}
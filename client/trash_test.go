@@ -0,0 +1,76 @@
+package client
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+)
+
+func TestTrashRestoreAndPurge(t *testing.T) {
+	ctx := context.Background()
+
+	store, err := storage.NewStorage(filepath.Join(t.TempDir(), "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	serverUuid, err := store.CreateServer(
+		ctx,
+		"test",
+		"127.0.0.1:20038",
+		common.UncheckedCreateNormalizedRoomName("room"),
+		common.UncheckedCreateNormalizedUsername("user"),
+		"password",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = store.CreateShare(ctx, serverUuid, "music", t.TempDir(), false); err != nil {
+		t.Fatal(err)
+	}
+
+	// Trashed shares are hidden, and come back with their record intact.
+	if has, err := store.TrashShareByServerUuidAndName(ctx, serverUuid, "music"); err != nil || !has {
+		t.Fatalf("got %t, %v when trashing share", has, err)
+	}
+	if _, has, _ := store.GetShareByServerUuidAndName(ctx, serverUuid, "music"); has {
+		t.Fatal("expected trashed share to be hidden")
+	}
+	if trashed, _ := store.GetTrashedShares(ctx); len(trashed) != 1 || trashed[0].DeletedTs == nil {
+		t.Fatalf("expected one trashed share with a deletion time, got %+v", trashed)
+	}
+	if has, err := store.RestoreShareByServerUuidAndName(ctx, serverUuid, "music"); err != nil || !has {
+		t.Fatalf("got %t, %v when restoring share", has, err)
+	}
+	if rec, has, _ := store.GetShareByServerUuidAndName(ctx, serverUuid, "music"); !has || rec.DeletedTs != nil {
+		t.Fatal("expected restored share to be visible")
+	}
+
+	// A trashed server is kept until it expires.
+	if has, err := store.TrashServerByUuid(ctx, serverUuid); err != nil || !has {
+		t.Fatalf("got %t, %v when trashing server", has, err)
+	}
+	if servers, _ := store.GetServers(ctx); len(servers) != 0 {
+		t.Fatalf("expected trashed server to be hidden, got %d servers", len(servers))
+	}
+	if purged, err := store.PurgeExpiredTrash(ctx, time.Now().Add(-time.Hour)); err != nil || purged != 0 {
+		t.Fatalf("got %d, %v when purging before the server was trashed", purged, err)
+	}
+	if purged, err := store.PurgeExpiredTrash(ctx, time.Now().Add(time.Hour)); err != nil || purged != 1 {
+		t.Fatalf("got %d, %v when purging after the server was trashed", purged, err)
+	}
+	if trashed, _ := store.GetTrashedServers(ctx); len(trashed) != 0 {
+		t.Fatalf("expected trash to be empty, got %d servers", len(trashed))
+	}
+	var shares int
+	if err = store.Db.QueryRow(`select count(*) from share`).Scan(&shares); err != nil || shares != 0 {
+		t.Fatalf("expected shares of purged server to be deleted, got %d, %v", shares, err)
+	}
+}
@@ -0,0 +1,176 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+	"slices"
+	"strings"
+	"sync"
+	"time"
+
+	"friendnet.org/client/event"
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+	v1 "friendnet.org/protocol/pb/clientrpc/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// MaxUploadHistory is the number of finished uploads kept in the upload history.
+// Older uploads are deleted.
+const MaxUploadHistory = 1000
+
+// finishedUploadTtl is how long the UUIDs of finished uploads are remembered, so that progress events delivered after
+// the final event do not bring them back.
+const finishedUploadTtl = 1 * time.Minute
+
+// UploadTracker keeps track of uploads to peers.
+// It listens for upload events on the event bus, keeps the uploads that are in progress, and records finished ones in
+// the upload history.
+type UploadTracker struct {
+	mu sync.Mutex
+
+	logger  *slog.Logger
+	storage *storage.Storage
+
+	bus   *event.Bus
+	subId event.SubscriptionId
+
+	// Uploads that are in progress, keyed by UUID.
+	active map[string]*v1.UploadInfo
+
+	// Uploads that finished recently, mapped to when they finished.
+	finished map[string]time.Time
+}
+
+// NewUploadTracker creates a new UploadTracker that listens for events on the bus.
+// Close must be called to stop listening.
+func NewUploadTracker(logger *slog.Logger, storage *storage.Storage, bus *event.Bus) *UploadTracker {
+	t := &UploadTracker{
+		logger:  logger,
+		storage: storage,
+		bus:     bus,
+
+		active:   make(map[string]*v1.UploadInfo),
+		finished: make(map[string]time.Time),
+	}
+	t.subId = bus.Subscribe(t.onEvent)
+
+	return t
+}
+
+// Close stops listening for events.
+func (t *UploadTracker) Close() error {
+	t.bus.Unsubscribe(t.subId)
+	return nil
+}
+
+func (t *UploadTracker) onEvent(evt *v1.Event, ctx *v1.EventContext) {
+	if evt.Type != v1.Event_TYPE_UPLOAD_UPDATE {
+		return
+	}
+
+	// Other subscribers get the same event, so it must not be modified.
+	info := proto.CloneOf(evt.UploadUpdate.Upload)
+	info.ServerUuid = ctx.ServerUuid
+
+	now := time.Now()
+
+	t.mu.Lock()
+	for uid, ts := range t.finished {
+		if now.Sub(ts) > finishedUploadTtl {
+			delete(t.finished, uid)
+		}
+	}
+	if _, isFinished := t.finished[info.Uuid]; isFinished {
+		t.mu.Unlock()
+		return
+	}
+	if info.Status == v1.UploadStatus_UPLOAD_STATUS_IN_PROGRESS {
+		// Events can arrive out of order, so keep whichever update is furthest along.
+		if cur, has := t.active[info.Uuid]; !has || cur.BytesSent <= info.BytesSent {
+			t.active[info.Uuid] = info
+		}
+		t.mu.Unlock()
+		return
+	}
+	delete(t.active, info.Uuid)
+	t.finished[info.Uuid] = now
+	t.mu.Unlock()
+
+	t.record(info)
+}
+
+// record adds a finished upload to the upload history.
+func (t *UploadTracker) record(info *v1.UploadInfo) {
+	peer := common.UncheckedCreateNormalizedUsername(info.PeerUsername)
+	filePath := common.UncheckedCreateProtoPath(info.FilePath)
+
+	ctx := context.Background()
+	err := t.storage.PutUploadHistory(ctx, storage.UploadHistoryRecord{
+		Uuid:         info.Uuid,
+		Server:       info.ServerUuid,
+		PeerUsername: peer,
+		FilePath:     filePath,
+		Status:       info.Status,
+		Offset:       info.Offset,
+		BytesSent:    info.BytesSent,
+		FileSize:     info.FileSize,
+		StartedTs:    time.Unix(info.StartedTs, 0),
+		EndedTs:      time.Unix(info.GetEndedTs(), 0),
+		Error:        info.ErrorMessage,
+	})
+	if err == nil {
+		err = t.storage.PruneUploadHistory(ctx, MaxUploadHistory)
+	}
+	if err != nil {
+		t.logger.Error("failed to record upload in history",
+			"service", "client.UploadTracker",
+			"uuid", info.Uuid,
+			"err", err,
+		)
+	}
+}
+
+// Active returns the uploads that are in progress, oldest first.
+func (t *UploadTracker) Active() []*v1.UploadInfo {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	uploads := make([]*v1.UploadInfo, 0, len(t.active))
+	for _, info := range t.active {
+		uploads = append(uploads, info)
+	}
+	slices.SortFunc(uploads, func(a, b *v1.UploadInfo) int {
+		// UUIDs are v7, so they sort by creation time.
+		return strings.Compare(a.Uuid, b.Uuid)
+	})
+
+	return uploads
+}
+
+// History returns up to limit finished uploads, newest first.
+func (t *UploadTracker) History(ctx context.Context, limit int) ([]*v1.UploadInfo, error) {
+	records, err := t.storage.GetUploadHistory(ctx, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	uploads := make([]*v1.UploadInfo, len(records))
+	for i, rec := range records {
+		uploads[i] = &v1.UploadInfo{
+			Uuid:         rec.Uuid,
+			ServerUuid:   rec.Server,
+			PeerUsername: rec.PeerUsername.String(),
+			FilePath:     rec.FilePath.String(),
+			Status:       rec.Status,
+			Offset:       rec.Offset,
+			BytesSent:    rec.BytesSent,
+			FileSize:     rec.FileSize,
+			StartedTs:    rec.StartedTs.Unix(),
+			EndedTs:      new(rec.EndedTs.Unix()),
+			ErrorMessage: rec.Error,
+		}
+	}
+
+	return uploads, nil
+}
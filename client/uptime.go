@@ -0,0 +1,202 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"friendnet.org/client/storage"
+)
+
+// MaxRunHistory is the number of run sessions kept in the session history.
+// Older run sessions are deleted along with their connection sessions.
+const MaxRunHistory = 500
+
+// uptimeHeartbeatInterval is how often the current run session is marked as still running.
+// If the client crashes, its stop time is estimated to be the last heartbeat.
+const uptimeHeartbeatInterval = 1 * time.Minute
+
+const (
+	// DisconnectReasonClientStopped is the disconnect reason of connections that were open when the client stopped.
+	DisconnectReasonClientStopped = "client stopped"
+
+	// DisconnectReasonClientCrashed is the disconnect reason of connections that were open when the client crashed.
+	DisconnectReasonClientCrashed = "client crashed"
+)
+
+// UptimeTracker records the history of client runs and of the connections to each server made during them.
+// Runs that never stopped cleanly are marked as crashed the next time the client starts.
+type UptimeTracker struct {
+	mu       sync.Mutex
+	isClosed bool
+
+	logger  *slog.Logger
+	storage *storage.Storage
+
+	ctx       context.Context
+	ctxCancel context.CancelFunc
+
+	// The UUID of the current run session.
+	runUuid string
+
+	// When the current run started.
+	startTs time.Time
+}
+
+// NewUptimeTracker marks previous runs that did not stop cleanly as crashed, then starts recording a new run.
+// Close must be called when the client stops.
+func NewUptimeTracker(logger *slog.Logger, storage *storage.Storage) (*UptimeTracker, error) {
+	ctx, ctxCancel := context.WithCancel(context.Background())
+
+	crashed, err := storage.MarkCrashedRunSessions(ctx, DisconnectReasonClientCrashed)
+	if err != nil {
+		ctxCancel()
+		return nil, err
+	}
+	if crashed > 0 {
+		logger.Warn("client did not stop cleanly last time it ran",
+			"service", "client.UptimeTracker",
+			"runs", crashed,
+		)
+	}
+
+	startTs := time.Now()
+	runUuid, err := storage.CreateRunSession(ctx)
+	if err != nil {
+		ctxCancel()
+		return nil, err
+	}
+	if err = storage.PruneRunSessions(ctx, MaxRunHistory); err != nil {
+		logger.Error("failed to prune run history",
+			"service", "client.UptimeTracker",
+			"err", err,
+		)
+	}
+
+	t := &UptimeTracker{
+		logger:  logger,
+		storage: storage,
+
+		ctx:       ctx,
+		ctxCancel: ctxCancel,
+
+		runUuid: runUuid,
+		startTs: startTs,
+	}
+
+	go t.heartbeat()
+
+	return t, nil
+}
+
+func (t *UptimeTracker) heartbeat() {
+	ticker := time.NewTicker(uptimeHeartbeatInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-t.ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		if err := t.storage.TouchRunSession(t.ctx, t.runUuid); err != nil {
+			t.logger.Error("failed to update run session",
+				"service", "client.UptimeTracker",
+				"err", err,
+			)
+		}
+	}
+}
+
+// RunUuid returns the UUID of the current run session.
+func (t *UptimeTracker) RunUuid() string {
+	return t.runUuid
+}
+
+// StartTs returns when the current run started.
+func (t *UptimeTracker) StartTs() time.Time {
+	return t.startTs
+}
+
+// Close records that the current run stopped cleanly, ending any connection sessions that are still open.
+// Connections that close afterward are not recorded.
+// Subsequent calls are no-op.
+func (t *UptimeTracker) Close() error {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	if t.isClosed {
+		return nil
+	}
+	t.isClosed = true
+
+	t.ctxCancel()
+
+	return t.storage.StopRunSession(context.Background(), t.runUuid, DisconnectReasonClientStopped)
+}
+
+// ForServer returns a ConnHistory that records connections to the server with the specified UUID.
+func (t *UptimeTracker) ForServer(serverUuid string) *ConnHistory {
+	return &ConnHistory{
+		tracker:    t,
+		serverUuid: serverUuid,
+	}
+}
+
+// ConnHistory records the connection sessions of a single server in the current run.
+// A nil *ConnHistory records nothing.
+type ConnHistory struct {
+	tracker    *UptimeTracker
+	serverUuid string
+}
+
+// Opened records that a connection to the server opened.
+// Returns the connection session's UUID to pass to Closed, or empty if it could not be recorded.
+func (h *ConnHistory) Opened() string {
+	if h == nil {
+		return ""
+	}
+
+	t := h.tracker
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.isClosed {
+		return ""
+	}
+
+	connUuid, err := t.storage.CreateConnSession(t.ctx, t.runUuid, h.serverUuid)
+	if err != nil {
+		t.logger.Error("failed to record connection session",
+			"service", "client.UptimeTracker",
+			"server", h.serverUuid,
+			"err", err,
+		)
+		return ""
+	}
+	return connUuid
+}
+
+// Closed records that the connection session with the specified UUID ended for the specified reason.
+// No-op if the UUID is empty.
+func (h *ConnHistory) Closed(connUuid string, reason string) {
+	if h == nil || connUuid == "" {
+		return
+	}
+
+	t := h.tracker
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.isClosed {
+		return
+	}
+
+	if err := t.storage.EndConnSession(t.ctx, connUuid, reason); err != nil {
+		t.logger.Error("failed to end connection session",
+			"service", "client.UptimeTracker",
+			"server", h.serverUuid,
+			"err", err,
+		)
+	}
+}
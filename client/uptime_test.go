@@ -0,0 +1,86 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+	"path/filepath"
+	"testing"
+
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+)
+
+func TestUptimeTrackerMarksUnstoppedRunsCrashed(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.DiscardHandler)
+
+	store, err := storage.NewStorage(filepath.Join(t.TempDir(), "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = store.Close()
+	}()
+
+	serverUuid, err := store.CreateServer(
+		ctx,
+		"test",
+		"127.0.0.1:20038",
+		common.UncheckedCreateNormalizedRoomName("room"),
+		common.UncheckedCreateNormalizedUsername("user"),
+		"password",
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The first run is never closed, as if the client crashed.
+	crashedRun, err := NewUptimeTracker(logger, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	crashedRun.ForServer(serverUuid).Opened()
+	crashedRun.ctxCancel()
+
+	cleanRun, err := NewUptimeTracker(logger, store)
+	if err != nil {
+		t.Fatal(err)
+	}
+	history := cleanRun.ForServer(serverUuid)
+	history.Closed(history.Opened(), "connection lost")
+	history.Opened()
+	if err = cleanRun.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	runs, err := store.GetRunSessions(ctx, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(runs) != 2 {
+		t.Fatalf("expected 2 runs, got %d", len(runs))
+	}
+	for _, run := range runs {
+		if run.StoppedTs == nil {
+			t.Fatalf("expected run %s to be stopped", run.Uuid)
+		}
+		if wantCrashed := run.Uuid == crashedRun.RunUuid(); run.Crashed != wantCrashed {
+			t.Fatalf("expected run %s crashed to be %t, got %t", run.Uuid, wantCrashed, run.Crashed)
+		}
+	}
+
+	sessions, err := store.GetConnSessions(ctx, serverUuid, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reasons := make(map[string]int)
+	for _, session := range sessions {
+		if session.DisconnectReason == nil {
+			t.Fatalf("expected session %s to be ended", session.Uuid)
+		}
+		reasons[*session.DisconnectReason]++
+	}
+	if reasons[DisconnectReasonClientCrashed] != 1 || reasons["connection lost"] != 1 || reasons[DisconnectReasonClientStopped] != 1 {
+		t.Fatalf("unexpected disconnect reasons: %v", reasons)
+	}
+}
@@ -1,6 +1,7 @@
 package client
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -16,14 +17,15 @@ import (
 // If fn returns false, the walk is aborted.
 //
 // If the path does not exist, no items will be crawled, and a nil error will be returned.
-func WalkPeerPath(conn room.VirtualC2cConn, path common.ProtoPath, fn func(path common.ProtoPath, meta *pb.MsgFileMeta) bool) error {
+// If ctx is done, the walk is aborted and the error of ctx is returned.
+func WalkPeerPath(ctx context.Context, conn room.VirtualC2cConn, path common.ProtoPath, fn func(path common.ProtoPath, meta *pb.MsgFileMeta) bool) error {
 	toCrawl := []common.ProtoPath{path}
 	for len(toCrawl) > 0 {
 		dirPath := toCrawl[0]
 		toCrawl = toCrawl[1:]
 
 		err := func() error {
-			stream, nextErr := conn.GetDirFiles(dirPath)
+			stream, nextErr := conn.GetDirFilesContext(ctx, dirPath)
 			if nextErr != nil {
 				if protoErr, ok := errors.AsType[protocol.ProtoMsgError](nextErr); ok {
 					// File might change while we are crawling it.
@@ -40,12 +42,8 @@ func WalkPeerPath(conn room.VirtualC2cConn, path common.ProtoPath, fn func(path
 				_ = stream.Close()
 			}()
 
-			for {
-				next, err := stream.ReadNext()
+			for next, err := range protocol.All(ctx, stream) {
 				if err != nil {
-					if errors.Is(err, io.EOF) {
-						break
-					}
 					return err
 				}
 
@@ -74,3 +72,25 @@ func WalkPeerPath(conn room.VirtualC2cConn, path common.ProtoPath, fn func(path
 
 	return nil
 }
+
+// getPeerDirFiles returns all files in a directory on a peer.
+func getPeerDirFiles(ctx context.Context, conn room.VirtualC2cConn, path common.ProtoPath) ([]*pb.MsgFileMeta, error) {
+	stream, err := conn.GetDirFilesContext(ctx, path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = stream.Close()
+	}()
+
+	files := make([]*pb.MsgFileMeta, 0)
+	for next, err := range protocol.All(ctx, stream) {
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, next.Files...)
+	}
+
+	return files, nil
+}
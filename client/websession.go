@@ -0,0 +1,234 @@
+package client
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"friendnet.org/client/storage"
+	"friendnet.org/common"
+)
+
+// SessionCookieName is the name of the cookie that holds a web UI session.
+// Its value is a random session ID issued by SessionHandler, not the RPC bearer token.
+const SessionCookieName = "friendnet_session"
+
+// SessionPathToken can be used in place of the bearer token in file server paths to authenticate with the session
+// cookie instead.
+const SessionPathToken = "session"
+
+// LaunchCodeTtl is how long a launch code created with NewLaunchCode can be exchanged for a session.
+const LaunchCodeTtl = 2 * time.Minute
+
+// SessionTtl is how long a web UI session lasts before the browser has to sign in again.
+const SessionTtl = 30 * 24 * time.Hour
+
+// sessionIdByteLen is the number of random bytes in a session ID.
+const sessionIdByteLen = 32
+
+// launchCodeMac returns the MAC of a launch code's expiry time.
+func launchCodeMac(token string, expiresStr string) string {
+	mac := hmac.New(sha256.New, []byte(token))
+	mac.Write([]byte("launch:" + expiresStr))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// NewLaunchCode creates a code that can be exchanged once for a web UI session at the session endpoint.
+// It is derived from the RPC bearer token, so any client process that knows the token can create one, such as one
+// started while another instance is already running.
+// The code expires after LaunchCodeTtl, so it is harmless once it ends up in browser history.
+func NewLaunchCode(token string) string {
+	expiresStr := strconv.FormatInt(time.Now().Add(LaunchCodeTtl).Unix(), 10)
+	return expiresStr + "." + launchCodeMac(token, expiresStr)
+}
+
+// SessionHandler is an http.Handler that starts web UI sessions.
+// It exchanges a launch code or the RPC bearer token for a session cookie, then redirects to the web UI.
+// This keeps the bearer token out of URLs and therefore out of browser history.
+//
+// GET requests exchange the "code" query parameter, which must be a launch code created with NewLaunchCode.
+// Each launch code can only be used once. If the code is invalid, the browser is redirected without starting a
+// session.
+//
+// POST requests exchange the "token" form field, which must be the bearer token.
+//
+// Both redirect to the local path in the "next" parameter, or "/" if it is missing.
+//
+// The session cookie holds a random session ID rather than the bearer token. Sessions are kept in storage, so they
+// survive restarts, and expire after SessionTtl.
+type SessionHandler struct {
+	logger  *slog.Logger
+	storage *storage.Storage
+	token   string
+
+	mu sync.Mutex
+	// Launch codes that were already exchanged, mapped to when they expire.
+	usedCodes map[string]time.Time
+}
+
+// NewSessionHandler creates a new SessionHandler for the specified bearer token.
+// Expired sessions are deleted from storage.
+func NewSessionHandler(ctx context.Context, logger *slog.Logger, store *storage.Storage, token string) (*SessionHandler, error) {
+	if err := store.DeleteExpiredWebSessions(ctx); err != nil {
+		return nil, err
+	}
+
+	return &SessionHandler{
+		logger:  logger,
+		storage: store,
+		token:   token,
+
+		usedCodes: make(map[string]time.Time),
+	}, nil
+}
+
+// hashSessionId returns the hash of a session ID that is kept in storage.
+func hashSessionId(id string) string {
+	sum := sha256.Sum256([]byte(id))
+	return base64.RawURLEncoding.EncodeToString(sum[:])
+}
+
+// newSession creates a new session and returns its ID.
+func (h *SessionHandler) newSession(ctx context.Context) (string, error) {
+	id := common.RandomB64UrlStr(sessionIdByteLen)
+	if err := h.storage.CreateWebSession(ctx, hashSessionId(id), time.Now().Add(SessionTtl)); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+// IsValidSession returns whether the session ID belongs to a session that exists and has not expired.
+func (h *SessionHandler) IsValidSession(ctx context.Context, id string) bool {
+	if id == "" {
+		return false
+	}
+
+	has, err := h.storage.HasWebSession(ctx, hashSessionId(id))
+	if err != nil {
+		h.logger.Error("failed to check web UI session",
+			"service", "client.SessionHandler",
+			"err", err,
+		)
+		return false
+	}
+	return has
+}
+
+// IsValidRequest returns whether the request has a session cookie with a valid session.
+func (h *SessionHandler) IsValidRequest(r *http.Request) bool {
+	cookie, err := r.Cookie(SessionCookieName)
+	if err != nil {
+		return false
+	}
+	return h.IsValidSession(r.Context(), cookie.Value)
+}
+
+var _ http.Handler = (*SessionHandler)(nil)
+
+// redeemCode returns whether the launch code is valid and unused, and marks it as used if so.
+func (h *SessionHandler) redeemCode(code string) bool {
+	expiresStr, mac, ok := strings.Cut(code, ".")
+	if !ok {
+		return false
+	}
+	expiresUnix, err := strconv.ParseInt(expiresStr, 10, 64)
+	if err != nil {
+		return false
+	}
+	if !hmac.Equal([]byte(mac), []byte(launchCodeMac(h.token, expiresStr))) {
+		return false
+	}
+
+	now := time.Now()
+	expires := time.Unix(expiresUnix, 0)
+	if now.After(expires) {
+		return false
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	// Forget codes that expired, since they are rejected anyway.
+	for used, usedExpires := range h.usedCodes {
+		if now.After(usedExpires) {
+			delete(h.usedCodes, used)
+		}
+	}
+
+	if _, used := h.usedCodes[code]; used {
+		return false
+	}
+	h.usedCodes[code] = expires
+
+	return true
+}
+
+func (h *SessionHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set("Referrer-Policy", "no-referrer")
+
+	var ok bool
+	switch r.Method {
+	case http.MethodGet:
+		ok = h.redeemCode(r.URL.Query().Get("code"))
+	case http.MethodPost:
+		ok = hmac.Equal([]byte(r.PostFormValue("token")), []byte(h.token))
+		if !ok {
+			http.Error(w, "invalid token", http.StatusForbidden)
+			return
+		}
+	default:
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	if ok {
+		id, err := h.newSession(r.Context())
+		if err != nil {
+			h.logger.Error("failed to create web UI session",
+				"service", "client.SessionHandler",
+				"err", err,
+			)
+			http.Error(w, "failed to create session", http.StatusInternalServerError)
+			return
+		}
+
+		http.SetCookie(w, &http.Cookie{
+			Name:     SessionCookieName,
+			Value:    id,
+			Path:     "/",
+			MaxAge:   int(SessionTtl / time.Second),
+			Secure:   r.TLS != nil,
+			HttpOnly: true,
+			SameSite: http.SameSiteStrictMode,
+		})
+	} else {
+		h.logger.Warn("rejected invalid or expired web UI launch code",
+			"service", "client.SessionHandler",
+		)
+	}
+
+	http.Redirect(w, r, localRedirectPath(r.FormValue("next")), http.StatusSeeOther)
+}
+
+// localRedirectPath returns next if it is a path on the same origin, otherwise "/".
+func localRedirectPath(next string) string {
+	if !strings.HasPrefix(next, "/") || strings.HasPrefix(next, "//") || strings.HasPrefix(next, "/\\") {
+		return "/"
+	}
+
+	u, err := url.Parse(next)
+	if err != nil || u.Scheme != "" || u.Host != "" {
+		return "/"
+	}
+
+	return next
+}
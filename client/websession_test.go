@@ -0,0 +1,158 @@
+package client
+
+import (
+	"context"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"friendnet.org/client/storage"
+)
+
+func newTestSessionHandler(t *testing.T, token string) (*SessionHandler, *storage.Storage) {
+	t.Helper()
+
+	store, err := storage.NewStorage(filepath.Join(t.TempDir(), "client.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		_ = store.Close()
+	})
+
+	h, err := NewSessionHandler(context.Background(), slog.New(slog.DiscardHandler), store, token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return h, store
+}
+
+func TestSessionHandlerRedeemCode(t *testing.T) {
+	t.Parallel()
+
+	const token = "token"
+	h, _ := newTestSessionHandler(t, token)
+
+	code := NewLaunchCode(token)
+	if !h.redeemCode(code) {
+		t.Fatal("expected a new launch code to be accepted")
+	}
+	if h.redeemCode(code) {
+		t.Fatal("expected a launch code to only be accepted once")
+	}
+
+	expiredStr := strconv.FormatInt(time.Now().Add(-time.Minute).Unix(), 10)
+	if h.redeemCode(expiredStr + "." + launchCodeMac(token, expiredStr)) {
+		t.Fatal("expected an expired launch code to be rejected")
+	}
+	if h.redeemCode(NewLaunchCode("other token")) {
+		t.Fatal("expected a launch code for another token to be rejected")
+	}
+
+	expiresStr, _, _ := strings.Cut(NewLaunchCode(token), ".")
+	laterStr := strconv.FormatInt(time.Now().Add(time.Hour).Unix(), 10)
+	if h.redeemCode(laterStr + "." + launchCodeMac(token, expiresStr)) {
+		t.Fatal("expected a launch code with a changed expiry time to be rejected")
+	}
+	for _, bad := range []string{"", "nodot", "abc." + launchCodeMac(token, "abc")} {
+		if h.redeemCode(bad) {
+			t.Fatalf("expected malformed launch code %q to be rejected", bad)
+		}
+	}
+}
+
+func TestLocalRedirectPath(t *testing.T) {
+	t.Parallel()
+
+	tests := map[string]string{
+		"":                          "/",
+		"/":                         "/",
+		"/createserver?invite=abc":  "/createserver?invite=abc",
+		"/servers/123#files":        "/servers/123#files",
+		"servers":                   "/",
+		"//evil.example":            "/",
+		"/\\evil.example":           "/",
+		"https://evil.example/":     "/",
+		"javascript:alert(1)":       "/",
+		"/%zz":                      "/",
+		"/ok?next=https://evil.com": "/ok?next=https://evil.com",
+	}
+	for next, want := range tests {
+		if got := localRedirectPath(next); got != want {
+			t.Errorf("localRedirectPath(%q) = %q, want %q", next, got, want)
+		}
+	}
+}
+
+func TestSessionHandlerIssuesSessions(t *testing.T) {
+	t.Parallel()
+
+	const token = "token"
+	h, store := newTestSessionHandler(t, token)
+
+	// Exchanging the token sets a cookie with a session ID, not the token itself.
+	form := url.Values{"token": {token}, "next": {"/settings"}}
+	req := httptest.NewRequest(http.MethodPost, "/auth/session", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusSeeOther || rec.Header().Get("Location") != "/settings" {
+		t.Fatalf("expected a redirect to /settings, got %d to %q", rec.Code, rec.Header().Get("Location"))
+	}
+	cookies := rec.Result().Cookies()
+	if len(cookies) != 1 || cookies[0].Name != SessionCookieName {
+		t.Fatalf("expected a session cookie, got %v", cookies)
+	}
+	session := cookies[0].Value
+	if session == "" || strings.Contains(session, token) {
+		t.Fatalf("expected the cookie to hold a session ID, got %q", session)
+	}
+	if !h.IsValidSession(context.Background(), session) {
+		t.Fatal("expected the issued session to be valid")
+	}
+
+	withCookie := httptest.NewRequest(http.MethodGet, "/content/session/", nil)
+	withCookie.AddCookie(&http.Cookie{Name: SessionCookieName, Value: session})
+	if !h.IsValidRequest(withCookie) {
+		t.Fatal("expected a request with the session cookie to be valid")
+	}
+
+	// The bearer token itself is not a session.
+	if h.IsValidSession(context.Background(), token) {
+		t.Fatal("expected the bearer token to be rejected as a session")
+	}
+
+	// Expired sessions are rejected.
+	const expired = "expired"
+	if err := store.CreateWebSession(context.Background(), hashSessionId(expired), time.Now().Add(-time.Second)); err != nil {
+		t.Fatal(err)
+	}
+	if h.IsValidSession(context.Background(), expired) {
+		t.Fatal("expected an expired session to be rejected")
+	}
+
+	// A wrong token is rejected without a cookie.
+	form.Set("token", "wrong")
+	req = httptest.NewRequest(http.MethodPost, "/auth/session", strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec = httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != http.StatusForbidden || len(rec.Result().Cookies()) != 0 {
+		t.Fatalf("expected a wrong token to be rejected, got %d with cookies %v", rec.Code, rec.Result().Cookies())
+	}
+
+	// Deleting sessions signs out every browser.
+	if err := store.DeleteWebSessions(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if h.IsValidSession(context.Background(), session) {
+		t.Fatal("expected the session to be gone")
+	}
+}
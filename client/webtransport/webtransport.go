@@ -0,0 +1,392 @@
+// Package webtransport implements enough of the server side of WebTransport over HTTP/3 for browsers to open sessions
+// and bidirectional streams to the client.
+//
+// Datagrams and unidirectional streams opened by browsers are not supported; unidirectional WebTransport streams are
+// rejected.
+package webtransport
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"net/http"
+	"sync"
+
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+	"github.com/quic-go/quic-go/quicvarint"
+)
+
+const (
+	// settingEnableWebTransport is the SETTINGS_ENABLE_WEBTRANSPORT setting from earlier drafts, still required by
+	// some browsers.
+	settingEnableWebTransport = 0x2b603742
+
+	// settingMaxSessions is the SETTINGS_WEBTRANSPORT_MAX_SESSIONS setting.
+	settingMaxSessions = 0xc671706a
+
+	// frameTypeBidiStream is the signal value that starts WebTransport bidirectional streams.
+	frameTypeBidiStream = 0x41
+
+	// streamTypeUniStream is the stream type of WebTransport unidirectional streams.
+	streamTypeUniStream = 0x54
+
+	// errCodeBufferedStreamRejected is WEBTRANSPORT_BUFFERED_STREAM_REJECTED.
+	errCodeBufferedStreamRejected = 0x3994bd84
+
+	// errCodeSessionGone is WEBTRANSPORT_SESSION_GONE.
+	errCodeSessionGone = 0x170d7b68
+)
+
+// MaxSessionsPerConn is the maximum number of WebTransport sessions a single connection can have.
+const MaxSessionsPerConn = 16
+
+// acceptQueueSize is how many streams can wait to be accepted by a session before more are rejected.
+const acceptQueueSize = 32
+
+// ErrNotWebTransport is returned by Upgrade for requests that are not WebTransport extended CONNECT requests.
+var ErrNotWebTransport = errors.New("request is not a WebTransport CONNECT request")
+
+// ErrSessionClosed is returned by Session.AcceptStream once the session is closed.
+var ErrSessionClosed = errors.New("WebTransport session closed")
+
+// connContextKey is the request context key holding the QUIC connection a request arrived on.
+type connContextKey struct{}
+
+// sessionKey identifies a session. Session IDs are the stream IDs of their CONNECT requests, so they are only unique
+// per connection.
+type sessionKey struct {
+	conn *quic.Conn
+	id   quic.StreamID
+}
+
+// Server serves HTTP/3 requests on QUIC connections and routes WebTransport streams to sessions created by Upgrade.
+// Requests that are not upgraded are served by the handler like any other HTTP/3 request.
+// It is safe for concurrent use.
+type Server struct {
+	h3 *http3.Server
+
+	mu       sync.Mutex
+	sessions map[sessionKey]*Session
+	listener *quic.Listener
+	closed   bool
+}
+
+// NewServer creates a new Server that serves requests with handler.
+func NewServer(handler http.Handler) *Server {
+	s := &Server{
+		sessions: make(map[sessionKey]*Session),
+	}
+	s.h3 = &http3.Server{
+		Handler:         handler,
+		EnableDatagrams: true,
+		AdditionalSettings: map[uint64]uint64{
+			settingEnableWebTransport: 1,
+			settingMaxSessions:        MaxSessionsPerConn,
+		},
+		ConnContext: func(ctx context.Context, c *quic.Conn) context.Context {
+			return context.WithValue(ctx, connContextKey{}, c)
+		},
+	}
+	return s
+}
+
+// ListenAndServe listens on the specified UDP address and serves connections until Close is called.
+// tlsCfg must have a certificate; its NextProtos are replaced with HTTP/3's.
+func (s *Server) ListenAndServe(addr string, tlsCfg *tls.Config) error {
+	tlsCfg = http3.ConfigureTLSConfig(tlsCfg)
+	ln, err := quic.ListenAddr(addr, tlsCfg, &quic.Config{
+		EnableDatagrams:                  true,
+		EnableStreamResetPartialDelivery: true,
+	})
+	if err != nil {
+		return err
+	}
+	return s.Serve(ln)
+}
+
+// Serve serves connections accepted by ln until Close is called.
+// ln must have been created with a TLS config from http3.ConfigureTLSConfig and datagrams enabled.
+func (s *Server) Serve(ln *quic.Listener) error {
+	s.mu.Lock()
+	if s.closed {
+		s.mu.Unlock()
+		_ = ln.Close()
+		return nil
+	}
+	s.listener = ln
+	s.mu.Unlock()
+
+	for {
+		conn, acceptErr := ln.Accept(context.Background())
+		if acceptErr != nil {
+			if errors.Is(acceptErr, quic.ErrServerClosed) {
+				return nil
+			}
+			return acceptErr
+		}
+		go s.serveConn(conn)
+	}
+}
+
+// Close stops listening and closes all connections.
+func (s *Server) Close() error {
+	s.mu.Lock()
+	ln := s.listener
+	s.listener = nil
+	s.closed = true
+	s.mu.Unlock()
+
+	if ln == nil {
+		return nil
+	}
+	return ln.Close()
+}
+
+// serveConn serves HTTP/3 and WebTransport streams on conn until it is closed.
+func (s *Server) serveConn(conn *quic.Conn) {
+	raw, err := s.h3.NewRawServerConn(conn)
+	if err != nil {
+		_ = conn.CloseWithError(quic.ApplicationErrorCode(http3.ErrCodeInternalError), "")
+		return
+	}
+
+	context.AfterFunc(conn.Context(), func() {
+		s.removeConnSessions(conn)
+	})
+
+	go func() {
+		for {
+			str, acceptErr := conn.AcceptUniStream(context.Background())
+			if acceptErr != nil {
+				return
+			}
+			go s.handleUniStream(raw, str)
+		}
+	}()
+
+	for {
+		str, acceptErr := conn.AcceptStream(context.Background())
+		if acceptErr != nil {
+			return
+		}
+		go s.handleStream(conn, raw, str)
+	}
+}
+
+// handleStream routes a bidirectional stream to its session if it is a WebTransport stream, and to the HTTP/3
+// server otherwise.
+func (s *Server) handleStream(conn *quic.Conn, raw *http3.RawServerConn, str *quic.Stream) {
+	typ, err := quicvarint.Peek(str)
+	if err != nil {
+		str.CancelRead(quic.StreamErrorCode(http3.ErrCodeRequestIncomplete))
+		str.CancelWrite(quic.StreamErrorCode(http3.ErrCodeRequestIncomplete))
+		return
+	}
+	if typ != frameTypeBidiStream {
+		raw.HandleRequestStream(str)
+		return
+	}
+
+	reader := quicvarint.NewReader(str)
+	var sessionId uint64
+	_, err = quicvarint.Read(reader)
+	if err == nil {
+		sessionId, err = quicvarint.Read(reader)
+	}
+	if err != nil {
+		str.CancelRead(quic.StreamErrorCode(http3.ErrCodeRequestIncomplete))
+		str.CancelWrite(quic.StreamErrorCode(http3.ErrCodeRequestIncomplete))
+		return
+	}
+
+	sess, ok := s.getOrCreateSession(conn, quic.StreamID(sessionId))
+	if !ok {
+		str.CancelRead(errCodeSessionGone)
+		str.CancelWrite(errCodeSessionGone)
+		return
+	}
+
+	select {
+	case sess.streams <- str:
+	default:
+		str.CancelRead(errCodeBufferedStreamRejected)
+		str.CancelWrite(errCodeBufferedStreamRejected)
+	}
+}
+
+// handleUniStream rejects WebTransport unidirectional streams and passes others to the HTTP/3 server.
+func (s *Server) handleUniStream(raw *http3.RawServerConn, str *quic.ReceiveStream) {
+	typ, err := quicvarint.Peek(str)
+	if err != nil {
+		str.CancelRead(quic.StreamErrorCode(http3.ErrCodeStreamCreationError))
+		return
+	}
+	if typ == streamTypeUniStream {
+		str.CancelRead(errCodeBufferedStreamRejected)
+		return
+	}
+	raw.HandleUnidirectionalStream(str)
+}
+
+// getOrCreateSession returns the session with the specified ID on conn.
+// Streams of a session can arrive before its CONNECT request is upgraded, so the session is created by whichever
+// comes first.
+// Returns false if conn already has the maximum number of sessions.
+func (s *Server) getOrCreateSession(conn *quic.Conn, id quic.StreamID) (*Session, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := sessionKey{conn: conn, id: id}
+	if sess, has := s.sessions[key]; has {
+		return sess, true
+	}
+
+	count := 0
+	for k := range s.sessions {
+		if k.conn == conn {
+			count++
+		}
+	}
+	if count >= MaxSessionsPerConn {
+		return nil, false
+	}
+
+	ctx, cancel := context.WithCancel(conn.Context())
+	sess := &Session{
+		ctx:     ctx,
+		cancel:  cancel,
+		streams: make(chan *quic.Stream, acceptQueueSize),
+	}
+	s.sessions[key] = sess
+	return sess, true
+}
+
+// removeSession forgets a session and rejects streams that were never accepted.
+func (s *Server) removeSession(key sessionKey) {
+	s.mu.Lock()
+	sess, has := s.sessions[key]
+	delete(s.sessions, key)
+	s.mu.Unlock()
+
+	if has {
+		sess.close()
+	}
+}
+
+// removeConnSessions forgets all sessions of conn.
+func (s *Server) removeConnSessions(conn *quic.Conn) {
+	s.mu.Lock()
+	var closed []*Session
+	for key, sess := range s.sessions {
+		if key.conn == conn {
+			closed = append(closed, sess)
+			delete(s.sessions, key)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, sess := range closed {
+		sess.close()
+	}
+}
+
+// Upgrade accepts a WebTransport CONNECT request and returns its session.
+// The session stays open after the handler returns, until either side closes it.
+// Returns ErrNotWebTransport if the request is not a WebTransport CONNECT request served by this server.
+func (s *Server) Upgrade(w http.ResponseWriter, r *http.Request) (*Session, error) {
+	if r.Method != http.MethodConnect || r.Proto != "webtransport" {
+		return nil, ErrNotWebTransport
+	}
+	conn, ok := r.Context().Value(connContextKey{}).(*quic.Conn)
+	if !ok {
+		return nil, ErrNotWebTransport
+	}
+	streamer, ok := w.(http3.HTTPStreamer)
+	if !ok {
+		return nil, ErrNotWebTransport
+	}
+
+	w.Header().Set("Sec-Webtransport-Http3-Draft", "draft02")
+	w.WriteHeader(http.StatusOK)
+	if flusher, isFlusher := w.(http.Flusher); isFlusher {
+		flusher.Flush()
+	}
+
+	str := streamer.HTTPStream()
+	key := sessionKey{conn: conn, id: str.StreamID()}
+	sess, ok := s.getOrCreateSession(conn, key.id)
+	if !ok {
+		str.CancelRead(errCodeSessionGone)
+		_ = str.Close()
+		return nil, errors.New("too many WebTransport sessions on connection")
+	}
+
+	// The session lasts as long as its CONNECT stream. Capsules sent on it are not needed, so they are discarded.
+	go func() {
+		buf := make([]byte, 1024)
+		for {
+			if _, err := str.Read(buf); err != nil {
+				break
+			}
+		}
+		s.removeSession(key)
+	}()
+	context.AfterFunc(sess.ctx, func() {
+		s.removeSession(key)
+		str.CancelRead(errCodeSessionGone)
+		_ = str.Close()
+	})
+
+	return sess, nil
+}
+
+// Session is a WebTransport session.
+type Session struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	streams   chan *quic.Stream
+	closeOnce sync.Once
+}
+
+// Context returns a context that is done once the session is closed.
+func (s *Session) Context() context.Context {
+	return s.ctx
+}
+
+// AcceptStream waits for the browser to open a bidirectional stream.
+// Returns ErrSessionClosed once the session is closed.
+func (s *Session) AcceptStream(ctx context.Context) (*quic.Stream, error) {
+	select {
+	case str := <-s.streams:
+		return str, nil
+	case <-s.ctx.Done():
+		return nil, ErrSessionClosed
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	}
+}
+
+// Close closes the session.
+// Streams that are still open are not affected, but no new ones are accepted.
+func (s *Session) Close() error {
+	s.close()
+	return nil
+}
+
+// close cancels the session's context and rejects streams that were never accepted.
+func (s *Session) close() {
+	s.closeOnce.Do(func() {
+		s.cancel()
+		for {
+			select {
+			case str := <-s.streams:
+				str.CancelRead(errCodeSessionGone)
+				str.CancelWrite(errCodeSessionGone)
+			default:
+				return
+			}
+		}
+	})
+}
@@ -0,0 +1,140 @@
+package webtransport
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net/http"
+	"net/url"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+	"github.com/quic-go/quic-go"
+	"github.com/quic-go/quic-go/http3"
+	"github.com/quic-go/quic-go/quicvarint"
+)
+
+// TestSessionEcho tests opening a session and a bidirectional stream on it, alongside a regular request.
+func TestSessionEcho(t *testing.T) {
+	pem, err := common.GenSelfSignedPem("test", false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := tls.X509KeyPair(pem, pem)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var srv *Server
+	mux := http.NewServeMux()
+	mux.HandleFunc("/plain", func(w http.ResponseWriter, r *http.Request) {
+		_, _ = io.WriteString(w, "plain")
+	})
+	mux.HandleFunc("/wt", func(w http.ResponseWriter, r *http.Request) {
+		sess, upgradeErr := srv.Upgrade(w, r)
+		if upgradeErr != nil {
+			http.Error(w, upgradeErr.Error(), http.StatusBadRequest)
+			return
+		}
+		go func() {
+			str, acceptErr := sess.AcceptStream(context.Background())
+			if acceptErr != nil {
+				return
+			}
+			_, _ = io.Copy(str, str)
+			_ = str.Close()
+		}()
+	})
+	srv = NewServer(mux)
+
+	ln, err := quic.ListenAddr("127.0.0.1:0", http3.ConfigureTLSConfig(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+	}), &quic.Config{EnableDatagrams: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		_ = srv.Serve(ln)
+	}()
+	defer func() {
+		_ = srv.Close()
+	}()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	conn, err := quic.DialAddr(ctx, ln.Addr().String(), &tls.Config{
+		InsecureSkipVerify: true,
+		NextProtos:         []string{http3.NextProtoH3},
+	}, &quic.Config{EnableDatagrams: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = conn.CloseWithError(0, "")
+	}()
+
+	tr := &http3.Transport{EnableDatagrams: true}
+	cc := tr.NewClientConn(conn)
+
+	plainReq, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://localhost/plain", nil)
+	plainRes, err := cc.RoundTrip(plainReq)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plainBody, _ := io.ReadAll(plainRes.Body)
+	if string(plainBody) != "plain" {
+		t.Fatalf("expected plain response, got %q", plainBody)
+	}
+
+	select {
+	case <-cc.ReceivedSettings():
+	case <-ctx.Done():
+		t.Fatal(ctx.Err())
+	}
+	if cc.Settings().Other[settingMaxSessions] != MaxSessionsPerConn {
+		t.Fatal("server did not advertise WebTransport support")
+	}
+
+	reqStr, err := cc.OpenRequestStream(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = reqStr.SendRequestHeader(&http.Request{
+		Method: http.MethodConnect,
+		Proto:  "webtransport",
+		Host:   "localhost",
+		URL:    &url.URL{Scheme: "https", Host: "localhost", Path: "/wt"},
+		Header: http.Header{},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, err := reqStr.ReadResponse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.StatusCode != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", res.StatusCode)
+	}
+
+	str, err := conn.OpenStreamSync(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := quicvarint.Append(nil, frameTypeBidiStream)
+	header = quicvarint.Append(header, uint64(reqStr.StreamID()))
+	if _, err = str.Write(append(header, "hello"...)); err != nil {
+		t.Fatal(err)
+	}
+	_ = str.Close()
+
+	echo, err := io.ReadAll(str)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(echo) != "hello" {
+		t.Fatalf("expected echo %q, got %q", "hello", echo)
+	}
+}
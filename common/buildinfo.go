@@ -0,0 +1,28 @@
+package common
+
+import "runtime/debug"
+
+// BuildCommit returns the VCS commit the running program was built from, or empty if it is unknown, such as when it
+// was built outside a repository.
+// The commit ends with "-dirty" if the build had uncommitted changes.
+func BuildCommit() string {
+	info, ok := debug.ReadBuildInfo()
+	if !ok {
+		return ""
+	}
+
+	var revision string
+	var modified bool
+	for _, setting := range info.Settings {
+		switch setting.Key {
+		case "vcs.revision":
+			revision = setting.Value
+		case "vcs.modified":
+			modified = setting.Value == "true"
+		}
+	}
+	if revision != "" && modified {
+		revision += "-dirty"
+	}
+	return revision
+}
@@ -4,16 +4,18 @@ go 1.26.2
 
 require (
 	connectrpc.com/connect v1.19.1
+	connectrpc.com/grpchealth v1.4.0
+	connectrpc.com/grpcreflect v1.3.1
 	friendnet.org/protocol v0.0.0
 	github.com/termermc/go-mcf-password v1.0.0
 	golang.org/x/net v0.50.0
+	golang.org/x/text v0.34.0
+	google.golang.org/protobuf v1.36.11
 )
 
 require (
 	golang.org/x/crypto v0.48.0 // indirect
 	golang.org/x/sys v0.41.0 // indirect
-	golang.org/x/text v0.34.0 // indirect
-	google.golang.org/protobuf v1.36.11 // indirect
 )
 
 replace friendnet.org/protocol => ../protocol
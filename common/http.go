@@ -1,12 +1,282 @@
 package common
 
 import (
+	"cmp"
+	"fmt"
+	"net/http"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 )
 
+// MaxHttpRanges is the maximum number of ranges accepted in a Range header.
+// Requests with more ranges are served in full, which RFC 9110 allows.
+// This prevents clients from requesting thousands of tiny ranges to amplify load.
+const MaxHttpRanges = 32
+
+// HttpRange is a satisfiable byte range within a resource.
+type HttpRange struct {
+	// The offset of the first byte in the range.
+	Offset int64
+
+	// The number of bytes in the range.
+	// Always at least 1.
+	Length int64
+}
+
+// ContentRange returns the Content-Range header value for the range within a resource of the specified size.
+func (r HttpRange) ContentRange(size int64) string {
+	return fmt.Sprintf("bytes %d-%d/%d", r.Offset, r.Offset+r.Length-1, size)
+}
+
+// EvalHttpRange evaluates the Range and If-Range request headers for a resource of the specified size, following
+// RFC 9110 semantics. It returns the HTTP status the response should have and, for 206, the ranges to send.
+//
+//   - http.StatusOK: serve the whole resource. This is the case if there is no Range header, it is malformed, it has
+//     more than MaxHttpRanges ranges, or If-Range does not match.
+//   - http.StatusPartialContent: serve the returned ranges. If there is more than one, they must be sent as a
+//     multipart/byteranges response. Overlapping and adjacent ranges are coalesced, and ranges are sorted.
+//   - http.StatusRequestedRangeNotSatisfiable: none of the ranges overlap the resource. The response should have a
+//     "Content-Range: bytes */<size>" header.
+//
+// etag and lastModified are the resource's current validators, used to evaluate If-Range.
+// Pass an empty string and zero time if the resource has none, in which case any If-Range header causes the whole
+// resource to be served.
+//
+// If size < 0, the function panics.
+func EvalHttpRange(rangeHeader string, ifRangeHeader string, size int64, etag string, lastModified time.Time) (int, []HttpRange) {
+	if size < 0 {
+		panic("BUG: EvalHttpRange: size < 0")
+	}
+
+	if rangeHeader == "" {
+		return http.StatusOK, nil
+	}
+	if ifRangeHeader != "" && !ifRangeMatches(ifRangeHeader, etag, lastModified) {
+		return http.StatusOK, nil
+	}
+
+	unit, specs, ok := strings.Cut(rangeHeader, "=")
+	if !ok || !strings.EqualFold(strings.TrimSpace(unit), "bytes") {
+		return http.StatusOK, nil
+	}
+
+	ranges := make([]HttpRange, 0, 1)
+	specCount := 0
+	for spec := range strings.SplitSeq(specs, ",") {
+		spec = strings.TrimSpace(spec)
+		if spec == "" {
+			// Empty list elements are allowed.
+			continue
+		}
+
+		specCount++
+		if specCount > MaxHttpRanges {
+			return http.StatusOK, nil
+		}
+
+		rng, satisfiable, valid := parseRangeSpec(spec, size)
+		if !valid {
+			return http.StatusOK, nil
+		}
+		if satisfiable {
+			ranges = append(ranges, rng)
+		}
+	}
+
+	if specCount == 0 {
+		return http.StatusOK, nil
+	}
+	if len(ranges) == 0 {
+		return http.StatusRequestedRangeNotSatisfiable, nil
+	}
+
+	return http.StatusPartialContent, coalesceHttpRanges(ranges)
+}
+
+// parseRangeSpec parses a single range spec like "0-99", "100-" or "-500".
+// Returns whether the spec is syntactically valid and whether it overlaps a resource of the specified size.
+// The returned range is clamped to the resource.
+func parseRangeSpec(spec string, size int64) (rng HttpRange, satisfiable bool, valid bool) {
+	startStr, endStr, ok := strings.Cut(spec, "-")
+	if !ok {
+		return rng, false, false
+	}
+	startStr = strings.TrimSpace(startStr)
+	endStr = strings.TrimSpace(endStr)
+
+	if startStr == "" {
+		// Suffix range: last N bytes.
+		suffix, err := strconv.ParseInt(endStr, 10, 64)
+		if err != nil || suffix < 0 {
+			return rng, false, false
+		}
+		if suffix == 0 || size == 0 {
+			return rng, false, true
+		}
+
+		suffix = min(suffix, size)
+		return HttpRange{Offset: size - suffix, Length: suffix}, true, true
+	}
+
+	start, err := strconv.ParseInt(startStr, 10, 64)
+	if err != nil || start < 0 {
+		return rng, false, false
+	}
+
+	end := int64(-1)
+	if endStr != "" {
+		end, err = strconv.ParseInt(endStr, 10, 64)
+		if err != nil || end < start {
+			return rng, false, false
+		}
+	}
+
+	if start >= size {
+		return rng, false, true
+	}
+	if end < 0 || end >= size {
+		end = size - 1
+	}
+
+	return HttpRange{Offset: start, Length: end - start + 1}, true, true
+}
+
+// coalesceHttpRanges sorts ranges and merges the ones that overlap or are adjacent.
+func coalesceHttpRanges(ranges []HttpRange) []HttpRange {
+	slices.SortFunc(ranges, func(a, b HttpRange) int {
+		return cmp.Compare(a.Offset, b.Offset)
+	})
+
+	out := ranges[:1]
+	for _, rng := range ranges[1:] {
+		last := &out[len(out)-1]
+		lastEnd := last.Offset + last.Length
+		if rng.Offset <= lastEnd {
+			last.Length = max(lastEnd, rng.Offset+rng.Length) - last.Offset
+			continue
+		}
+		out = append(out, rng)
+	}
+	return out
+}
+
+// EvalHttpConditional evaluates the conditional request headers If-Match, If-Unmodified-Since, If-None-Match and
+// If-Modified-Since against a resource's current validators, in the order specified by RFC 9110 section 13.2.2.
+// It returns http.StatusOK if the request should proceed normally, http.StatusNotModified if the client's cached copy
+// is current, or http.StatusPreconditionFailed if a precondition failed.
+//
+// etag and lastModified are the resource's current validators.
+// Pass an empty string or zero time for validators the resource does not have.
+func EvalHttpConditional(method string, header http.Header, etag string, lastModified time.Time) int {
+	isGetOrHead := method == http.MethodGet || method == http.MethodHead
+	lastModified = lastModified.Truncate(time.Second)
+
+	if ifMatch := header.Get("If-Match"); ifMatch != "" {
+		if !etagListMatches(ifMatch, etag, true) {
+			return http.StatusPreconditionFailed
+		}
+	} else if ifUnmodifiedSince := header.Get("If-Unmodified-Since"); ifUnmodifiedSince != "" && !lastModified.IsZero() {
+		if date, err := http.ParseTime(ifUnmodifiedSince); err == nil && lastModified.After(date) {
+			return http.StatusPreconditionFailed
+		}
+	}
+
+	if ifNoneMatch := header.Get("If-None-Match"); ifNoneMatch != "" {
+		if etagListMatches(ifNoneMatch, etag, false) {
+			if isGetOrHead {
+				return http.StatusNotModified
+			}
+			return http.StatusPreconditionFailed
+		}
+	} else if ifModifiedSince := header.Get("If-Modified-Since"); ifModifiedSince != "" && isGetOrHead && !lastModified.IsZero() {
+		if date, err := http.ParseTime(ifModifiedSince); err == nil && !lastModified.After(date) {
+			return http.StatusNotModified
+		}
+	}
+
+	return http.StatusOK
+}
+
+// etagListMatches reports whether an If-Match or If-None-Match header value matches etag.
+// A value of "*" matches any current entity tag.
+// If strong is true, weak entity tags never match.
+func etagListMatches(list string, etag string, strong bool) bool {
+	if etag == "" {
+		return false
+	}
+	if strings.TrimSpace(list) == "*" {
+		return true
+	}
+
+	isWeak := strings.HasPrefix(etag, "W/")
+	if strong && isWeak {
+		return false
+	}
+	opaque := strings.TrimPrefix(etag, "W/")
+
+	rest := list
+	for {
+		rest = strings.TrimLeft(rest, " \t,")
+		if rest == "" {
+			return false
+		}
+
+		candidateWeak := false
+		if strings.HasPrefix(rest, "W/") {
+			candidateWeak = true
+			rest = rest[2:]
+		}
+		if !strings.HasPrefix(rest, `"`) {
+			// Malformed; nothing after this can be trusted.
+			return false
+		}
+		end := strings.IndexByte(rest[1:], '"')
+		if end < 0 {
+			return false
+		}
+		candidate := rest[:end+2]
+		rest = rest[end+2:]
+
+		if candidate != opaque {
+			continue
+		}
+		if strong && candidateWeak {
+			continue
+		}
+		return true
+	}
+}
+
+// ifRangeMatches reports whether an If-Range header value matches the resource's validators.
+// Entity tags must match strongly, and dates must match the last modified time exactly.
+func ifRangeMatches(ifRange string, etag string, lastModified time.Time) bool {
+	ifRange = strings.TrimSpace(ifRange)
+
+	if strings.HasPrefix(ifRange, `"`) {
+		// Strong comparison; weak tags never match.
+		return etag != "" && !strings.HasPrefix(etag, "W/") && ifRange == etag
+	}
+	if strings.HasPrefix(ifRange, "W/") {
+		return false
+	}
+
+	if lastModified.IsZero() {
+		return false
+	}
+	date, err := http.ParseTime(ifRange)
+	if err != nil {
+		return false
+	}
+	return date.Equal(lastModified.Truncate(time.Second))
+}
+
 // ParseHttpRange parses an HTTP Range header string.
 // If fileSize < 0, the function panics.
+//
+// Deprecated: It rejects multiple ranges and ranges that end past the file, which RFC 9110 allows.
+// Use EvalHttpRange instead.
 func ParseHttpRange(header string, fileSize int64) (offset int64, limit int64, valid bool) {
 	if fileSize < 0 {
 		panic("BUG: parseRange: fileSize < 0")
@@ -1,7 +1,11 @@
 package common
 
 import (
+	"net/http"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 )
 
 func TestParseHttpRange(t *testing.T) {
@@ -202,3 +206,313 @@ func TestParseHttpRange(t *testing.T) {
 		})
 	}
 }
+
+func TestEvalHttpRange(t *testing.T) {
+	lastModified := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	const etag = `"abc"`
+
+	tests := []struct {
+		name    string
+		header  string
+		ifRange string
+		size    int64
+		status  int
+		ranges  []HttpRange
+	}{
+		{
+			name:   "no header",
+			header: "",
+			size:   1000,
+			status: http.StatusOK,
+		},
+		{
+			name:   "single range",
+			header: "bytes=0-99",
+			size:   1000,
+			status: http.StatusPartialContent,
+			ranges: []HttpRange{{Offset: 0, Length: 100}},
+		},
+		{
+			name:   "open-ended range",
+			header: "bytes=500-",
+			size:   1000,
+			status: http.StatusPartialContent,
+			ranges: []HttpRange{{Offset: 500, Length: 500}},
+		},
+		{
+			name:   "suffix range",
+			header: "bytes=-100",
+			size:   1000,
+			status: http.StatusPartialContent,
+			ranges: []HttpRange{{Offset: 900, Length: 100}},
+		},
+		{
+			name:   "suffix range larger than file",
+			header: "bytes=-2000",
+			size:   1000,
+			status: http.StatusPartialContent,
+			ranges: []HttpRange{{Offset: 0, Length: 1000}},
+		},
+		{
+			name:   "end past file is clamped",
+			header: "bytes=900-5000",
+			size:   1000,
+			status: http.StatusPartialContent,
+			ranges: []HttpRange{{Offset: 900, Length: 100}},
+		},
+		{
+			name:   "multiple ranges",
+			header: "bytes=0-9, 100-109",
+			size:   1000,
+			status: http.StatusPartialContent,
+			ranges: []HttpRange{{Offset: 0, Length: 10}, {Offset: 100, Length: 10}},
+		},
+		{
+			name:   "overlapping ranges are coalesced and sorted",
+			header: "bytes=50-99,0-9,5-59",
+			size:   1000,
+			status: http.StatusPartialContent,
+			ranges: []HttpRange{{Offset: 0, Length: 100}},
+		},
+		{
+			name:   "unsatisfiable ranges are dropped",
+			header: "bytes=0-9,2000-3000",
+			size:   1000,
+			status: http.StatusPartialContent,
+			ranges: []HttpRange{{Offset: 0, Length: 10}},
+		},
+		{
+			name:   "start at file size",
+			header: "bytes=1000-",
+			size:   1000,
+			status: http.StatusRequestedRangeNotSatisfiable,
+		},
+		{
+			name:   "empty file",
+			header: "bytes=0-0",
+			size:   0,
+			status: http.StatusRequestedRangeNotSatisfiable,
+		},
+		{
+			name:   "zero suffix",
+			header: "bytes=-0",
+			size:   1000,
+			status: http.StatusRequestedRangeNotSatisfiable,
+		},
+		{
+			name:   "unknown unit is ignored",
+			header: "words=0-99",
+			size:   1000,
+			status: http.StatusOK,
+		},
+		{
+			name:   "malformed range is ignored",
+			header: "bytes=500-100",
+			size:   1000,
+			status: http.StatusOK,
+		},
+		{
+			name:   "non-numeric range is ignored",
+			header: "bytes=abc-",
+			size:   1000,
+			status: http.StatusOK,
+		},
+		{
+			name:   "too many ranges are ignored",
+			header: "bytes=" + strings.Repeat("0-0,", MaxHttpRanges) + "0-0",
+			size:   1000,
+			status: http.StatusOK,
+		},
+		{
+			name:    "matching etag",
+			header:  "bytes=0-9",
+			ifRange: `"abc"`,
+			size:    1000,
+			status:  http.StatusPartialContent,
+			ranges:  []HttpRange{{Offset: 0, Length: 10}},
+		},
+		{
+			name:    "mismatched etag",
+			header:  "bytes=0-9",
+			ifRange: `"def"`,
+			size:    1000,
+			status:  http.StatusOK,
+		},
+		{
+			name:    "weak etag never matches",
+			header:  "bytes=0-9",
+			ifRange: `W/"abc"`,
+			size:    1000,
+			status:  http.StatusOK,
+		},
+		{
+			name:    "matching date",
+			header:  "bytes=0-9",
+			ifRange: lastModified.Format(http.TimeFormat),
+			size:    1000,
+			status:  http.StatusPartialContent,
+			ranges:  []HttpRange{{Offset: 0, Length: 10}},
+		},
+		{
+			name:    "mismatched date",
+			header:  "bytes=0-9",
+			ifRange: lastModified.Add(time.Hour).Format(http.TimeFormat),
+			size:    1000,
+			status:  http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, ranges := EvalHttpRange(tt.header, tt.ifRange, tt.size, etag, lastModified)
+
+			if status != tt.status {
+				t.Errorf("status = %d, want %d", status, tt.status)
+			}
+			if !slices.Equal(ranges, tt.ranges) {
+				t.Errorf("ranges = %v, want %v", ranges, tt.ranges)
+			}
+		})
+	}
+}
+
+func TestEvalHttpRangeNoValidators(t *testing.T) {
+	status, _ := EvalHttpRange("bytes=0-9", `"abc"`, 1000, "", time.Time{})
+	if status != http.StatusOK {
+		t.Errorf("status = %d, want %d", status, http.StatusOK)
+	}
+}
+
+func TestHttpRangeContentRange(t *testing.T) {
+	got := HttpRange{Offset: 100, Length: 50}.ContentRange(1000)
+	if got != "bytes 100-149/1000" {
+		t.Errorf("got %q", got)
+	}
+}
+
+func TestEvalHttpConditional(t *testing.T) {
+	lastModified := time.Date(2026, 3, 1, 12, 0, 0, 0, time.UTC)
+	before := lastModified.Add(-time.Hour).Format(http.TimeFormat)
+	same := lastModified.Format(http.TimeFormat)
+	const etag = `"abc"`
+
+	tests := []struct {
+		name   string
+		method string
+		header map[string]string
+		etag   string
+		status int
+	}{
+		{
+			name:   "no conditions",
+			method: http.MethodGet,
+			etag:   etag,
+			status: http.StatusOK,
+		},
+		{
+			name:   "if-none-match matches",
+			method: http.MethodGet,
+			header: map[string]string{"If-None-Match": `"xyz", "abc"`},
+			etag:   etag,
+			status: http.StatusNotModified,
+		},
+		{
+			name:   "if-none-match weak comparison",
+			method: http.MethodGet,
+			header: map[string]string{"If-None-Match": `W/"abc"`},
+			etag:   etag,
+			status: http.StatusNotModified,
+		},
+		{
+			name:   "if-none-match star",
+			method: http.MethodHead,
+			header: map[string]string{"If-None-Match": `*`},
+			etag:   etag,
+			status: http.StatusNotModified,
+		},
+		{
+			name:   "if-none-match mismatch",
+			method: http.MethodGet,
+			header: map[string]string{"If-None-Match": `"xyz"`},
+			etag:   etag,
+			status: http.StatusOK,
+		},
+		{
+			name:   "if-none-match takes precedence over if-modified-since",
+			method: http.MethodGet,
+			header: map[string]string{"If-None-Match": `"xyz"`, "If-Modified-Since": same},
+			etag:   etag,
+			status: http.StatusOK,
+		},
+		{
+			name:   "if-modified-since not modified",
+			method: http.MethodGet,
+			header: map[string]string{"If-Modified-Since": same},
+			etag:   etag,
+			status: http.StatusNotModified,
+		},
+		{
+			name:   "if-modified-since modified",
+			method: http.MethodGet,
+			header: map[string]string{"If-Modified-Since": before},
+			etag:   etag,
+			status: http.StatusOK,
+		},
+		{
+			name:   "if-match matches",
+			method: http.MethodGet,
+			header: map[string]string{"If-Match": `"abc"`},
+			etag:   etag,
+			status: http.StatusOK,
+		},
+		{
+			name:   "if-match mismatch",
+			method: http.MethodGet,
+			header: map[string]string{"If-Match": `"xyz"`},
+			etag:   etag,
+			status: http.StatusPreconditionFailed,
+		},
+		{
+			name:   "if-match requires strong comparison",
+			method: http.MethodGet,
+			header: map[string]string{"If-Match": `W/"abc"`},
+			etag:   etag,
+			status: http.StatusPreconditionFailed,
+		},
+		{
+			name:   "if-match without etag",
+			method: http.MethodGet,
+			header: map[string]string{"If-Match": `*`},
+			etag:   "",
+			status: http.StatusPreconditionFailed,
+		},
+		{
+			name:   "if-unmodified-since modified",
+			method: http.MethodGet,
+			header: map[string]string{"If-Unmodified-Since": before},
+			etag:   etag,
+			status: http.StatusPreconditionFailed,
+		},
+		{
+			name:   "if-unmodified-since unmodified",
+			method: http.MethodGet,
+			header: map[string]string{"If-Unmodified-Since": same},
+			etag:   etag,
+			status: http.StatusOK,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			header := make(http.Header)
+			for k, v := range tt.header {
+				header.Set(k, v)
+			}
+
+			status := EvalHttpConditional(tt.method, header, tt.etag, lastModified)
+			if status != tt.status {
+				t.Errorf("status = %d, want %d", status, tt.status)
+			}
+		})
+	}
+}
@@ -0,0 +1,69 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// MaxInterests is the maximum number of interests a user can publish.
+const MaxInterests = 50
+
+// MaxInterestLen is the maximum length of a single interest, in characters.
+const MaxInterestLen = 64
+
+// ErrTooManyInterests is returned by NormalizeInterests when there are more than MaxInterests interests.
+var ErrTooManyInterests = fmt.Errorf("too many interests (max: %d)", MaxInterests)
+
+// NormalizeInterest normalizes an interest so that interests that only differ in case or whitespace match.
+// Rules:
+//   - Lowercase everything
+//   - Trim outer whitespace
+//   - Collapse runs of inner whitespace into a single space
+//
+// Returns an error if the interest is empty, longer than MaxInterestLen, or contains control characters.
+func NormalizeInterest(interest string) (string, error) {
+	if !utf8.ValidString(interest) {
+		return "", errors.New("interest is not valid UTF-8")
+	}
+
+	normalized := strings.ToLower(strings.Join(strings.Fields(interest), " "))
+	if normalized == "" {
+		return "", errors.New("interest cannot be empty")
+	}
+	if utf8.RuneCountInString(normalized) > MaxInterestLen {
+		return "", fmt.Errorf("interest %q is too long (max: %d characters)", normalized, MaxInterestLen)
+	}
+	if strings.IndexFunc(normalized, unicode.IsControl) != -1 {
+		return "", fmt.Errorf("interest %q contains control characters", normalized)
+	}
+
+	return normalized, nil
+}
+
+// NormalizeInterests normalizes each interest with NormalizeInterest and removes duplicates, keeping the order of
+// first occurrences.
+// Returns ErrTooManyInterests if there are more than MaxInterests interests after removing duplicates.
+func NormalizeInterests(interests []string) ([]string, error) {
+	res := make([]string, 0, len(interests))
+	seen := make(map[string]struct{}, len(interests))
+	for _, interest := range interests {
+		normalized, err := NormalizeInterest(interest)
+		if err != nil {
+			return nil, err
+		}
+		if _, has := seen[normalized]; has {
+			continue
+		}
+		seen[normalized] = struct{}{}
+		res = append(res, normalized)
+	}
+
+	if len(res) > MaxInterests {
+		return nil, ErrTooManyInterests
+	}
+
+	return res, nil
+}
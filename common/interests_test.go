@@ -0,0 +1,36 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+)
+
+func TestNormalizeInterests(t *testing.T) {
+	t.Parallel()
+
+	got, err := NormalizeInterests([]string{"  Jazz ", "field   recordings", "JAZZ", "Synth\tPop"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []string{"jazz", "field recordings", "synth pop"}
+	if !slices.Equal(got, want) {
+		t.Fatalf("expected %q, got %q", want, got)
+	}
+
+	for _, bad := range []string{"", "   ", strings.Repeat("a", MaxInterestLen+1), "bad\x00tag", "bad\xff"} {
+		if _, err = NormalizeInterests([]string{bad}); err == nil {
+			t.Fatalf("expected error for %q", bad)
+		}
+	}
+
+	many := make([]string, MaxInterests+1)
+	for i := range many {
+		many[i] = fmt.Sprintf("interest %d", i)
+	}
+	if _, err = NormalizeInterests(many); !errors.Is(err, ErrTooManyInterests) {
+		t.Fatalf("expected ErrTooManyInterests, got %v", err)
+	}
+}
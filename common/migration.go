@@ -1,10 +1,13 @@
 package common
 
 import (
+	"cmp"
+	"context"
 	"database/sql"
 	"errors"
 	"fmt"
 	"slices"
+	"time"
 )
 
 // Migration represents a database migration.
@@ -19,77 +22,273 @@ type Migration interface {
 	Revert(tx *sql.Tx) error
 }
 
-// DoMigrations applies all migrations to a database.
-//
+// MigrationStatus is the state of a migration in a database.
+type MigrationStatus struct {
+	// The migration's name.
+	Name string
+
+	// Whether the migration has been applied.
+	Applied bool
+
+	// When the migration was applied.
+	// Zero if it has not been applied.
+	AppliedTs time.Time
+
+	// Whether the migration is applied to the database but unknown to this version of the application.
+	// This happens when the database was used by a newer version.
+	Unknown bool
+}
+
+// migrationDialect contains the statements a Migrator uses to manage the migration table.
+type migrationDialect struct {
+	createTableSql string
+	tableExistsSql string
+	insertSql      string
+	deleteSql      string
+}
+
 //goland:noinspection SqlNoDataSourceInspection
-func DoMigrations(db *sql.DB, migrations []Migration) error {
-	// Create table if it doesn't exist.
-	_, err := db.Exec(`
+var sqliteMigrationDialect = migrationDialect{
+	createTableSql: `
 		create table if not exists migration (
 			name text not null primary key,
 			created_ts integer not null default (strftime('%s', 'now'))
 		)
-	`)
-	if err != nil {
-		return fmt.Errorf(`failed to create migration table in DoMigrations: %w`, err)
+	`,
+	tableExistsSql: `select exists (select 1 from sqlite_master where type = 'table' and name = 'migration')`,
+	insertSql:      `insert into migration (name) values (?)`,
+	deleteSql:      `delete from migration where name = ?`,
+}
+
+//goland:noinspection SqlNoDataSourceInspection
+var postgresMigrationDialect = migrationDialect{
+	createTableSql: `
+		create table if not exists migration (
+			name text not null primary key,
+			created_ts bigint not null default (extract(epoch from now())::bigint)
+		)
+	`,
+	tableExistsSql: `select to_regclass('migration') is not null`,
+	insertSql:      `insert into migration (name) values ($1)`,
+	deleteSql:      `delete from migration where name = $1`,
+}
+
+// Migrator applies, reverts and inspects a list of migrations on a database.
+// Migrations are applied in list order and reverted in reverse list order.
+type Migrator struct {
+	db         *sql.DB
+	dialect    migrationDialect
+	migrations []Migration
+}
+
+// NewSqliteMigrator creates a new Migrator for a SQLite database.
+func NewSqliteMigrator(db *sql.DB, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		dialect:    sqliteMigrationDialect,
+		migrations: migrations,
+	}
+}
+
+// NewPostgresMigrator creates a new Migrator for a PostgreSQL database.
+func NewPostgresMigrator(db *sql.DB, migrations []Migration) *Migrator {
+	return &Migrator{
+		db:         db,
+		dialect:    postgresMigrationDialect,
+		migrations: migrations,
+	}
+}
+
+// DoMigrations applies all migrations to a SQLite database.
+func DoMigrations(db *sql.DB, migrations []Migration) error {
+	_, err := NewSqliteMigrator(db, migrations).Migrate(context.Background())
+	return err
+}
+
+// DoPostgresMigrations applies all migrations to a PostgreSQL database.
+func DoPostgresMigrations(db *sql.DB, migrations []Migration) error {
+	_, err := NewPostgresMigrator(db, migrations).Migrate(context.Background())
+	return err
+}
+
+// applied returns the names and application times of all applied migrations.
+// It does not create the migration table; if it does not exist, no migrations have been applied.
+func (m *Migrator) applied(ctx context.Context) (map[string]time.Time, error) {
+	var exists bool
+	if err := m.db.QueryRowContext(ctx, m.dialect.tableExistsSql).Scan(&exists); err != nil {
+		return nil, fmt.Errorf(`failed to check for migration table: %w`, err)
+	}
+	if !exists {
+		return map[string]time.Time{}, nil
 	}
 
-	// Get the names of already-applied migrations.
-	var appliedNames []string
-	rows, err := db.Query(`select name from migration`)
+	rows, err := m.db.QueryContext(ctx, `select name, created_ts from migration`)
 	if err != nil {
-		return fmt.Errorf(`failed to query applied migrations in DoMigrations: %w`, err)
+		return nil, fmt.Errorf(`failed to query applied migrations: %w`, err)
 	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	res := make(map[string]time.Time)
 	for rows.Next() {
 		var name string
-		err = rows.Scan(&name)
-		if err != nil {
-			_ = rows.Close()
-			return err
+		var createdTs int64
+		if err = rows.Scan(&name, &createdTs); err != nil {
+			return nil, err
+		}
+		res[name] = time.Unix(createdTs, 0)
+	}
+
+	return res, rows.Err()
+}
+
+// Status returns the state of every known migration in list order, followed by any applied migrations that are
+// unknown to the Migrator.
+// It does not modify the database.
+func (m *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]MigrationStatus, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		name := mig.Name()
+		ts, has := applied[name]
+		res = append(res, MigrationStatus{
+			Name:      name,
+			Applied:   has,
+			AppliedTs: ts,
+		})
+		delete(applied, name)
+	}
+
+	unknown := make([]MigrationStatus, 0, len(applied))
+	for name, ts := range applied {
+		unknown = append(unknown, MigrationStatus{
+			Name:      name,
+			Applied:   true,
+			AppliedTs: ts,
+			Unknown:   true,
+		})
+	}
+	slices.SortFunc(unknown, func(a, b MigrationStatus) int {
+		return cmp.Or(a.AppliedTs.Compare(b.AppliedTs), cmp.Compare(a.Name, b.Name))
+	})
+
+	return append(res, unknown...), nil
+}
+
+// Pending returns the names of the migrations that have not been applied yet, in the order they would be applied.
+// It does not modify the database, so it can be used for a dry run.
+func (m *Migrator) Pending(ctx context.Context) ([]string, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var res []string
+	for _, status := range statuses {
+		if !status.Applied {
+			res = append(res, status.Name)
 		}
+	}
+	return res, nil
+}
+
+// Migrate applies all pending migrations, each in its own transaction.
+// Returns the names of the migrations that were applied.
+// If a migration fails, the migrations before it stay applied.
+func (m *Migrator) Migrate(ctx context.Context) ([]string, error) {
+	// Create table if it doesn't exist.
+	_, err := m.db.ExecContext(ctx, m.dialect.createTableSql)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to create migration table: %w`, err)
+	}
 
-		appliedNames = append(appliedNames, name)
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
 	}
-	_ = rows.Close()
 
-	for _, m := range migrations {
-		if slices.Contains(appliedNames, m.Name()) {
+	var done []string
+	for _, mig := range m.migrations {
+		if _, has := applied[mig.Name()]; has {
 			continue
 		}
 
-		var tx *sql.Tx
-		tx, err = db.Begin()
-		if err != nil {
-			return err
+		if err = m.inTx(ctx, mig.Name(), "apply", mig.Apply, m.dialect.insertSql); err != nil {
+			return done, err
 		}
+		done = append(done, mig.Name())
+	}
+
+	return done, nil
+}
 
-		err = m.Apply(tx)
-		if err != nil {
-			errs := make([]error, 0, 2)
-			errs = append(errs, fmt.Errorf(`failed to apply migration %q: %w`, m.Name(), err))
+// Revert reverts the specified number of most recent applied migrations, each in its own transaction.
+// Migrations are reverted in reverse list order.
+// Returns the names of the migrations that were reverted.
+//
+// Returns an error without reverting anything if the database has applied migrations unknown to the Migrator,
+// since they cannot be reverted by this version of the application.
+func (m *Migrator) Revert(ctx context.Context, count int) ([]string, error) {
+	if count <= 0 {
+		return nil, nil
+	}
 
-			if err = tx.Rollback(); err != nil {
-				errs = append(errs, fmt.Errorf(`failed to roll back transaction after failed migration %q: %w`, m.Name(), err))
-			}
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
 
-			return errors.Join(errs...)
+	var toRevert []Migration
+	for i := len(m.migrations) - 1; i >= 0 && len(toRevert) < count; i-- {
+		if statuses[i].Applied {
+			toRevert = append(toRevert, m.migrations[i])
 		}
+	}
+	if len(statuses) > len(m.migrations) {
+		return nil, fmt.Errorf(`cannot revert migrations: database has unknown migration %q applied`, statuses[len(m.migrations)].Name)
+	}
 
-		_, err = tx.Exec(`insert into migration (name) values (?)`, m.Name())
-		if err != nil {
-			errs := make([]error, 0, 2)
-			errs = append(errs, fmt.Errorf(`failed to record applied migration %q: %w`, m.Name(), err))
-
-			if err = tx.Rollback(); err != nil {
-				errs = append(errs, fmt.Errorf(`failed to roll back transaction after failing to record applied migration %q: %w`, m.Name(), err))
-			}
-			return err
+	var done []string
+	for _, mig := range toRevert {
+		if err = m.inTx(ctx, mig.Name(), "revert", mig.Revert, m.dialect.deleteSql); err != nil {
+			return done, err
 		}
+		done = append(done, mig.Name())
+	}
+
+	return done, nil
+}
 
-		err = tx.Commit()
-		if err != nil {
-			return fmt.Errorf(`failed to commit transaction after successful migration %q: %w`, m.Name(), err)
+// inTx runs fn in a transaction, then runs the bookkeeping statement with the migration name and commits.
+// The verb is used in error messages.
+func (m *Migrator) inTx(ctx context.Context, name string, verb string, fn func(tx *sql.Tx) error, recordSql string) error {
+	tx, err := m.db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	rollback := func(cause error) error {
+		if rbErr := tx.Rollback(); rbErr != nil {
+			return errors.Join(cause, fmt.Errorf(`failed to roll back transaction for migration %q: %w`, name, rbErr))
 		}
+		return cause
+	}
+
+	if err = fn(tx); err != nil {
+		return rollback(fmt.Errorf(`failed to %s migration %q: %w`, verb, name, err))
+	}
+
+	if _, err = tx.ExecContext(ctx, recordSql, name); err != nil {
+		return rollback(fmt.Errorf(`failed to record %s of migration %q: %w`, verb, name, err))
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf(`failed to commit transaction after successful %s of migration %q: %w`, verb, name, err)
 	}
 
 	return nil
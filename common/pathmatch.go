@@ -0,0 +1,83 @@
+package common
+
+import (
+	"strings"
+
+	"golang.org/x/text/cases"
+	"golang.org/x/text/unicode/norm"
+)
+
+// UnicodeForm is a Unicode normalization form that path segments can be compared in.
+type UnicodeForm int
+
+const (
+	// UnicodeFormNone compares path segments without normalizing them.
+	UnicodeFormNone UnicodeForm = iota
+
+	// UnicodeFormNFC compares path segments in Normalization Form C (composed), which Windows and Linux typically use.
+	UnicodeFormNFC
+
+	// UnicodeFormNFD compares path segments in Normalization Form D (decomposed), which macOS typically uses.
+	UnicodeFormNFD
+)
+
+// PathMatchOptions configure how requested paths are matched against the names of files that exist.
+//
+// Paths in the protocol are always case-sensitive and never normalized, but peers on Windows or macOS may request
+// paths that differ from the name on disk only by case or Unicode normalization, since that is how their own
+// filesystems behave. The zero value matches paths exactly.
+type PathMatchOptions struct {
+	// Whether paths are matched regardless of case.
+	CaseInsensitive bool
+
+	// The Unicode normalization form that paths are matched in.
+	Form UnicodeForm
+}
+
+// IsExact returns whether the options match paths exactly.
+func (o PathMatchOptions) IsExact() bool {
+	return !o.CaseInsensitive && o.Form == UnicodeFormNone
+}
+
+// FoldSegment returns the key that a path segment is matched by.
+// Segments match if their keys are equal.
+func (o PathMatchOptions) FoldSegment(segment string) string {
+	var form norm.Form
+	switch o.Form {
+	case UnicodeFormNFC:
+		form = norm.NFC
+	case UnicodeFormNFD:
+		form = norm.NFD
+	default:
+		if o.CaseInsensitive {
+			return cases.Fold().String(segment)
+		}
+		return segment
+	}
+
+	segment = form.String(segment)
+	if o.CaseInsensitive {
+		// Case folding can leave a string denormalized, so it is normalized again afterward.
+		segment = form.String(cases.Fold().String(segment))
+	}
+	return segment
+}
+
+// FoldPath returns the key that a path is matched by.
+// Paths match if their keys are equal.
+func (o PathMatchOptions) FoldPath(path ProtoPath) string {
+	if o.IsExact() || path.IsRoot() {
+		return path.String()
+	}
+
+	segments := path.ToSegments()
+	for i, segment := range segments {
+		segments[i] = o.FoldSegment(segment)
+	}
+	return "/" + strings.Join(segments, "/")
+}
+
+// PathsMatch returns whether two paths match.
+func (o PathMatchOptions) PathsMatch(a ProtoPath, b ProtoPath) bool {
+	return o.FoldPath(a) == o.FoldPath(b)
+}
@@ -0,0 +1,43 @@
+package common
+
+import "testing"
+
+func TestPathMatchOptions(t *testing.T) {
+	t.Parallel()
+
+	// "é" as a single code point (NFC, as on Windows and Linux) and as "e" with a combining accent (NFD, as on macOS).
+	const composed = "/Musique/Café.mp3"
+	const decomposed = "/Musique/Café.mp3"
+
+	tests := []struct {
+		name string
+		opts PathMatchOptions
+		a    string
+		b    string
+		want bool
+	}{
+		{name: "exact_same", opts: PathMatchOptions{}, a: composed, b: composed, want: true},
+		{name: "exact_case", opts: PathMatchOptions{}, a: "/Music/A.mp3", b: "/music/a.mp3", want: false},
+		{name: "exact_normalization", opts: PathMatchOptions{}, a: composed, b: decomposed, want: false},
+		{name: "case_insensitive", opts: PathMatchOptions{CaseInsensitive: true}, a: "/Music/A.mp3", b: "/music/a.MP3", want: true},
+		{name: "case_insensitive_unicode", opts: PathMatchOptions{CaseInsensitive: true}, a: "/STRASSE", b: "/straße", want: true},
+		{name: "case_insensitive_not_normalized", opts: PathMatchOptions{CaseInsensitive: true}, a: composed, b: decomposed, want: false},
+		{name: "nfc", opts: PathMatchOptions{Form: UnicodeFormNFC}, a: composed, b: decomposed, want: true},
+		{name: "nfd", opts: PathMatchOptions{Form: UnicodeFormNFD}, a: composed, b: decomposed, want: true},
+		{name: "nfc_case_sensitive", opts: PathMatchOptions{Form: UnicodeFormNFC}, a: composed, b: "/musique/café.mp3", want: false},
+		{name: "nfd_case_insensitive", opts: PathMatchOptions{CaseInsensitive: true, Form: UnicodeFormNFD}, a: "/MUSIQUE/CAFÉ.MP3", b: decomposed, want: true},
+		{name: "different_names", opts: PathMatchOptions{CaseInsensitive: true, Form: UnicodeFormNFC}, a: "/a/b", b: "/a/c", want: false},
+		{name: "root", opts: PathMatchOptions{CaseInsensitive: true}, a: "/", b: "/", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.opts.PathsMatch(UncheckedCreateProtoPath(tt.a), UncheckedCreateProtoPath(tt.b))
+			if got != tt.want {
+				t.Fatalf("expected PathsMatch(%q, %q) = %t, got %t", tt.a, tt.b, tt.want, got)
+			}
+		})
+	}
+}
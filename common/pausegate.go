@@ -0,0 +1,71 @@
+package common
+
+import (
+	"context"
+	"sync"
+)
+
+// PauseGate lets one side of a transfer block the other while it is paused.
+// The zero value is an unpaused gate.
+// It is safe for concurrent use.
+type PauseGate struct {
+	mu sync.Mutex
+
+	// Closed when the gate is resumed.
+	// Nil while the gate is not paused.
+	resumed chan struct{}
+}
+
+// Pause pauses the gate, causing subsequent calls to Wait to block until Resume is called.
+// Returns false if the gate was already paused.
+func (g *PauseGate) Pause() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.resumed != nil {
+		return false
+	}
+	g.resumed = make(chan struct{})
+	return true
+}
+
+// Resume resumes the gate and releases all callers blocked in Wait.
+// Returns false if the gate was not paused.
+func (g *PauseGate) Resume() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.resumed == nil {
+		return false
+	}
+	close(g.resumed)
+	g.resumed = nil
+	return true
+}
+
+// IsPaused returns whether the gate is currently paused.
+func (g *PauseGate) IsPaused() bool {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	return g.resumed != nil
+}
+
+// Wait blocks while the gate is paused.
+// It returns immediately if the gate is not paused.
+// Returns the context's error if the context is done before the gate is resumed.
+func (g *PauseGate) Wait(ctx context.Context) error {
+	g.mu.Lock()
+	resumed := g.resumed
+	g.mu.Unlock()
+
+	if resumed == nil {
+		return nil
+	}
+
+	select {
+	case <-resumed:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
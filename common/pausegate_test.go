@@ -0,0 +1,73 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestPauseGate_WaitUnpaused(t *testing.T) {
+	t.Parallel()
+
+	var g PauseGate
+	if err := g.Wait(context.Background()); err != nil {
+		t.Fatalf("unexpected error waiting on unpaused gate: %v", err)
+	}
+	if g.IsPaused() {
+		t.Fatal("zero value gate should not be paused")
+	}
+}
+
+func TestPauseGate_PauseResume(t *testing.T) {
+	t.Parallel()
+
+	var g PauseGate
+	if !g.Pause() {
+		t.Fatal("first Pause should return true")
+	}
+	if g.Pause() {
+		t.Fatal("second Pause should return false")
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- g.Wait(context.Background())
+	}()
+
+	select {
+	case <-done:
+		t.Fatal("Wait returned while gate was paused")
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	if !g.Resume() {
+		t.Fatal("first Resume should return true")
+	}
+	if g.Resume() {
+		t.Fatal("second Resume should return false")
+	}
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("unexpected error from Wait: %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Wait did not return after Resume")
+	}
+}
+
+func TestPauseGate_WaitCanceled(t *testing.T) {
+	t.Parallel()
+
+	var g PauseGate
+	g.Pause()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if err := g.Wait(ctx); !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+}
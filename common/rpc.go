@@ -10,10 +10,13 @@ import (
 	"net/http"
 	"net/netip"
 	"runtime/debug"
+	"slices"
 	"strings"
 	"sync"
 
 	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+	"connectrpc.com/grpcreflect"
 	"friendnet.org/common/webserver"
 )
 
@@ -38,10 +41,16 @@ type RpcServerConfig struct {
 	// Windows support for the unix protocol is not supported but may work.
 	Address string `json:"address"`
 
-	// The RPC methods that are allowed to be called on this interface.
+	// The role of callers of this interface, which allows the group of methods the service defines for it.
+	// The standard roles are "viewer", "operator" and "admin"; see RpcRoleViewer, RpcRoleOperator and RpcRoleAdmin.
+	//
+	// If empty, only the methods in AllowedMethods can be called.
+	Role string `json:"role,omitempty"`
+
+	// The RPC methods that are allowed to be called on this interface, in addition to the ones allowed by Role.
 	// Consult the rpc.proto file to see a full list of methods.
 	//
-	// An empty or null list will prevent any methods from being called.
+	// An empty or null list will prevent any methods from being called, unless Role is set.
 	//
 	// To explicitly allow all methods, include a single string with the value "*".
 	//
@@ -56,10 +65,32 @@ type RpcServerConfig struct {
 	// For example, if set to "abc123", the following HTTP header must be set: "Authorization: Bearer abc123".
 	BearerToken string `json:"bearer_token,omitempty"`
 
+	// If not empty, requests that do not have an Authorization header may instead send a session ID in a cookie with
+	// this name, which is accepted if SessionValidator returns true for it.
+	// Not configurable, since only the client's web UI uses it.
+	SessionCookie string `json:"-"`
+
+	// Reports whether a session ID sent in SessionCookie is valid. Required if SessionCookie is set.
+	SessionValidator func(ctx context.Context, session string) bool `json:"-"`
+
+	// If not nil, requests with a bearer token other than BearerToken are passed to it along with the name of the
+	// method being called, instead of being rejected.
+	// It returns the context to handle the request with, or an error to reject the request.
+	// The allowed methods of the interface still apply.
+	// Not configurable, since only the client uses it, for plugin tokens.
+	TokenAuthorizer func(ctx context.Context, token string, method string) (context.Context, error) `json:"-"`
+
 	// If true, sets necessary CORS headers to allow cross-origin requests.
 	// You do not need this unless the RPC interface is accessed by web browsers.
 	CorsAllowAllOrigins bool `json:"cors_allow_all_origins"`
 
+	// Controls how many requests are logged at debug level, along with their method, peer, latency, status and payload
+	// sizes.
+	// If 0 or 1, every request is logged. If greater, only 1 in every N successful requests is logged, which keeps busy
+	// interfaces from flooding the log. Failed requests are always logged.
+	// If negative, requests are not logged.
+	LogSampleEvery int `json:"log_sample_every,omitempty"`
+
 	// If true, the admin UI will be served on the interface.
 	// Only works in the server module, ignored everywhere else.
 	EnableAdminUi bool `json:"enable_admin_ui"`
@@ -71,9 +102,61 @@ type RpcHandlerConstructor[T any] = func(impl T, options ...connect.HandlerOptio
 
 var errMissingBearerToken = connect.NewError(connect.CodeUnauthenticated, errors.New("missing bearer token"))
 var errInvalidBearerToken = connect.NewError(connect.CodePermissionDenied, errors.New("invalid bearer token"))
+var errInvalidSession = connect.NewError(connect.CodeUnauthenticated, errors.New("invalid or expired session"))
 var errIpNotAllowed = connect.NewError(connect.CodePermissionDenied, errors.New("IP not allowed"))
 var errMethodNotAllowed = connect.NewError(connect.CodePermissionDenied, errors.New("method not allowed"))
 
+// The standard RPC roles.
+// Each RPC service defines the methods they allow in its RpcRoles.
+const (
+	// RpcRoleViewer can call methods that read state without revealing secrets.
+	RpcRoleViewer = "viewer"
+
+	// RpcRoleOperator can do everything RpcRoleViewer can, along with the day-to-day management of users and rooms.
+	RpcRoleOperator = "operator"
+
+	// RpcRoleAdmin can call all methods.
+	RpcRoleAdmin = "admin"
+)
+
+// RpcRoles maps the names of roles to the methods of an RPC service they allow to be called.
+// A role with a single "*" method allows all methods.
+type RpcRoles map[string][]string
+
+// UnknownRpcRoleError is returned if an RPC interface has a role that the service does not define.
+type UnknownRpcRoleError struct {
+	// The unknown role.
+	Role string
+}
+
+func (e *UnknownRpcRoleError) Error() string { return "unknown RPC role: " + e.Role }
+
+// AllowedMethods returns the methods allowed to be called on an interface by its role and its allowed methods together.
+// If either allows all methods, returns a single "*".
+//
+// Returns an *UnknownRpcRoleError if the interface's role is not one of the roles.
+func (r RpcRoles) AllowedMethods(cfg RpcServerConfig) ([]string, error) {
+	var roleMethods []string
+	if cfg.Role != "" {
+		var has bool
+		roleMethods, has = r[cfg.Role]
+		if !has {
+			return nil, &UnknownRpcRoleError{Role: cfg.Role}
+		}
+	}
+
+	methods := make([]string, 0, len(roleMethods)+len(cfg.AllowedMethods))
+	for _, method := range slices.Concat(roleMethods, cfg.AllowedMethods) {
+		if method == "*" {
+			return []string{"*"}, nil
+		}
+		if !slices.Contains(methods, method) {
+			methods = append(methods, method)
+		}
+	}
+	return methods, nil
+}
+
 // InvalidRpcProtocolError is returned if an invalid RPC protocol version is specified.
 type InvalidRpcProtocolError struct {
 	// The invalid protocol.
@@ -98,6 +181,13 @@ type RpcServer[T io.Closer] struct {
 
 	impl T
 
+	// The health checker for the RPC service.
+	// Reports the service as serving until the RPC server is closed.
+	health *grpchealth.StaticChecker
+
+	// The fully-qualified name of the RPC service, such as "friendnet.serverrpc.v1.ServerRpcService".
+	serviceName string
+
 	corsAllowAllOrigins bool
 }
 
@@ -105,7 +195,10 @@ type rpcServerInterceptor struct {
 	checkIp    bool
 	allowedIps map[netip.Addr]struct{}
 
-	bearerToken string
+	bearerToken      string
+	sessionCookie    string
+	sessionValidator func(ctx context.Context, session string) bool
+	tokenAuthorizer  func(ctx context.Context, token string, method string) (context.Context, error)
 
 	isAllMethodsAllowed bool
 	// Keys are lowercase.
@@ -114,7 +207,19 @@ type rpcServerInterceptor struct {
 
 var _ connect.Interceptor = rpcServerInterceptor{}
 
-func (i rpcServerInterceptor) logic(peer connect.Peer, spec connect.Spec, reqHeaders http.Header) error {
+// rpcMethodName returns the name of the method called by a procedure, such as "GetRooms" for
+// "/friendnet.serverrpc.v1.ServerRpcService/GetRooms".
+func rpcMethodName(procedure string) string {
+	path := strings.TrimSuffix(procedure, "/")
+	slashIdx := strings.LastIndex(path, "/")
+	if slashIdx == -1 {
+		return path
+	}
+	return path[slashIdx+1:]
+}
+
+// logic checks whether the request is allowed, and returns the context to handle it with.
+func (i rpcServerInterceptor) logic(ctx context.Context, peer connect.Peer, spec connect.Spec, reqHeaders http.Header) (context.Context, error) {
 	// Check IP.
 	if i.checkIp {
 		host, _, _ := net.SplitHostPort(peer.Addr)
@@ -122,7 +227,7 @@ func (i rpcServerInterceptor) logic(peer connect.Peer, spec connect.Spec, reqHea
 		{
 			hostLen := len(host)
 			if hostLen <= 2 {
-				return errIpNotAllowed
+				return ctx, errIpNotAllowed
 			}
 
 			// Remove brackets on IPv6.
@@ -135,52 +240,62 @@ func (i rpcServerInterceptor) logic(peer connect.Peer, spec connect.Spec, reqHea
 		peerIp, err := netip.ParseAddr(host)
 		if err != nil {
 			// Invalid IP string.
-			return errIpNotAllowed
+			return ctx, errIpNotAllowed
 		}
 
 		// Check if IP is allowed.
 		_, has := i.allowedIps[peerIp]
 		if !has {
-			return errIpNotAllowed
+			return ctx, errIpNotAllowed
 		}
 	}
 
+	method := rpcMethodName(spec.Procedure)
+
 	// Check authorization.
 	if i.bearerToken != "" {
 		authz := reqHeaders.Get("Authorization")
+		if authz == "" && i.sessionCookie != "" {
+			cookie, err := (&http.Request{Header: reqHeaders}).Cookie(i.sessionCookie)
+			if err == nil {
+				if !i.sessionValidator(ctx, cookie.Value) {
+					return ctx, errInvalidSession
+				}
+				authz = "Bearer " + i.bearerToken
+			}
+		}
 		if authz == "" {
-			return errMissingBearerToken
+			return ctx, errMissingBearerToken
 		}
 
 		token := strings.TrimPrefix(authz, "Bearer ")
 		if token != i.bearerToken {
-			return errInvalidBearerToken
+			if i.tokenAuthorizer == nil {
+				return ctx, errInvalidBearerToken
+			}
+
+			var err error
+			ctx, err = i.tokenAuthorizer(ctx, token, method)
+			if err != nil {
+				return ctx, err
+			}
 		}
 	}
 
 	// Check method.
 	if !i.isAllMethodsAllowed {
-		path := strings.TrimSuffix(spec.Procedure, "/")
-		var methodLower string
-		{
-			slashIdx := strings.LastIndex(path, "/")
-			if slashIdx == -1 {
-				methodLower = strings.ToLower(path)
-			} else {
-				methodLower = strings.ToLower(path[slashIdx+1:])
-			}
-		}
-		if _, has := i.allowedMethods[methodLower]; !has {
-			return errMethodNotAllowed
+		if _, has := i.allowedMethods[strings.ToLower(method)]; !has {
+			return ctx, errMethodNotAllowed
 		}
 	}
 
-	return nil
+	return ctx, nil
 }
 
 func (i rpcServerInterceptor) WrapUnary(fn connect.UnaryFunc) connect.UnaryFunc {
 	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-		if err := i.logic(req.Peer(), req.Spec(), req.Header()); err != nil {
+		ctx, err := i.logic(ctx, req.Peer(), req.Spec(), req.Header())
+		if err != nil {
 			return nil, err
 		}
 
@@ -195,7 +310,8 @@ func (i rpcServerInterceptor) WrapStreamingClient(fn connect.StreamingClientFunc
 
 func (i rpcServerInterceptor) WrapStreamingHandler(fn connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
 	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
-		if err := i.logic(conn.Peer(), conn.Spec(), conn.RequestHeader()); err != nil {
+		ctx, err := i.logic(ctx, conn.Peer(), conn.Spec(), conn.RequestHeader())
+		if err != nil {
 			return err
 		}
 
@@ -203,22 +319,34 @@ func (i rpcServerInterceptor) WrapStreamingHandler(fn connect.StreamingHandlerFu
 	}
 }
 
+// NewRpcServer creates a new RPC server and mounts it on the web server.
+// The roles are the ones defined by the service, and may be nil if it has none.
+//
+// Alongside the RPC service, it mounts the standard gRPC health checking and server reflection services, so that
+// generic tools such as grpcurl, load balancers and monitoring systems can use the interface. They are subject to
+// the same IP and bearer token checks as the RPC service, but not to its allowed methods.
 func NewRpcServer[T io.Closer](
 	logger *slog.Logger,
 	webServer *webserver.WebServer,
 	cfg RpcServerConfig,
+	roles RpcRoles,
 	impl T,
 	constructor RpcHandlerConstructor[T],
 ) (*RpcServer[T], error) {
+	methods, err := roles.AllowedMethods(cfg)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to resolve allowed methods of RPC interface %q: %w`, cfg.Address, err)
+	}
+
 	var isAllAllowed bool
 	var allowedMethods map[string]struct{}
-	if len(cfg.AllowedMethods) == 1 && cfg.AllowedMethods[0] == "*" {
+	if len(methods) == 1 && methods[0] == "*" {
 		isAllAllowed = true
 		allowedMethods = nil
 	} else {
 		isAllAllowed = false
-		allowedMethods = make(map[string]struct{}, len(cfg.AllowedMethods))
-		for _, method := range cfg.AllowedMethods {
+		allowedMethods = make(map[string]struct{}, len(methods))
+		for _, method := range methods {
 			allowedMethods[strings.ToLower(method)] = struct{}{}
 		}
 	}
@@ -254,19 +382,31 @@ func NewRpcServer[T io.Closer](
 		corsAllowAllOrigins: cfg.CorsAllowAllOrigins,
 	}
 
-	handlerPath, handler := constructor(impl,
-		connect.WithInterceptors(rpcServerInterceptor{
-			checkIp:    checkIp,
-			allowedIps: allowedIps,
+	interceptor := rpcServerInterceptor{
+		checkIp:    checkIp,
+		allowedIps: allowedIps,
 
-			bearerToken: cfg.BearerToken,
+		bearerToken:      cfg.BearerToken,
+		sessionCookie:    cfg.SessionCookie,
+		sessionValidator: cfg.SessionValidator,
+		tokenAuthorizer:  cfg.TokenAuthorizer,
 
-			isAllMethodsAllowed: isAllAllowed,
-			allowedMethods:      allowedMethods,
-		}),
-	)
+		isAllMethodsAllowed: isAllAllowed,
+		allowedMethods:      allowedMethods,
+	}
+
+	// The logging interceptor goes first, so that rejected requests are logged too.
+	logInterceptor := newRpcLogInterceptor(logger, cfg.LogSampleEvery)
+	withInterceptors := func(interceptor connect.Interceptor) connect.HandlerOption {
+		if logInterceptor == nil {
+			return connect.WithInterceptors(interceptor)
+		}
+		return connect.WithInterceptors(logInterceptor, interceptor)
+	}
+
+	handlerPath, handler := constructor(impl, withInterceptors(interceptor))
 
-	err := webServer.Mount(
+	err = webServer.Mount(
 		cfg.Address,
 		handlerPath,
 		http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
@@ -303,6 +443,27 @@ func NewRpcServer[T io.Closer](
 		return nil, fmt.Errorf(`failed to mount RPC handler on %q path %q: %w`, cfg.Address, handlerPath, err)
 	}
 
+	// Mount health checking and reflection.
+	// Their methods are not part of the RPC service, so the allowed methods list does not apply to them.
+	s.serviceName = strings.Trim(handlerPath, "/")
+	s.health = grpchealth.NewStaticChecker(s.serviceName)
+
+	stdInterceptor := interceptor
+	stdInterceptor.isAllMethodsAllowed = true
+	stdOpts := withInterceptors(stdInterceptor)
+
+	reflector := grpcreflect.NewStaticReflector(s.serviceName)
+	for _, mount := range []func() (string, http.Handler){
+		func() (string, http.Handler) { return grpchealth.NewHandler(s.health, stdOpts) },
+		func() (string, http.Handler) { return grpcreflect.NewHandlerV1(reflector, stdOpts) },
+		func() (string, http.Handler) { return grpcreflect.NewHandlerV1Alpha(reflector, stdOpts) },
+	} {
+		path, stdHandler := mount()
+		if err = webServer.Mount(cfg.Address, path, stdHandler); err != nil {
+			return nil, fmt.Errorf(`failed to mount RPC handler on %q path %q: %w`, cfg.Address, path, err)
+		}
+	}
+
 	return s, nil
 }
 
@@ -317,7 +478,25 @@ func (s *RpcServer[T]) Close() error {
 	s.isClosed = true
 	s.mu.Unlock()
 
+	s.health.SetStatus(s.serviceName, grpchealth.StatusNotServing)
+	s.health.SetStatus("", grpchealth.StatusNotServing)
+
 	_ = s.impl.Close()
 
 	return nil
 }
+
+// DefaultRpcPageSize is the number of records returned by paginated RPC methods if the request does not specify a limit.
+const DefaultRpcPageSize = 500
+
+// MaxRpcPageSize is the maximum number of records returned by paginated RPC methods.
+const MaxRpcPageSize = 1000
+
+// RpcPageLimit returns the number of records a paginated RPC method should return for the limit in its request.
+// A limit of 0 means DefaultRpcPageSize, and limits above MaxRpcPageSize are capped to it.
+func RpcPageLimit(limit uint32) int {
+	if limit == 0 {
+		return DefaultRpcPageSize
+	}
+	return int(min(limit, MaxRpcPageSize))
+}
@@ -0,0 +1,131 @@
+package common
+
+import (
+	"context"
+	"errors"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"connectrpc.com/connect"
+	"friendnet.org/common/webserver"
+)
+
+type nopCloser struct{}
+
+func (nopCloser) Close() error { return nil }
+
+func TestRpcServerHealth(t *testing.T) {
+	sockPath := filepath.Join(t.TempDir(), "rpc.sock")
+	logger := slog.New(slog.DiscardHandler)
+
+	ws := webserver.NewWebServer(logger)
+	rpc, err := NewRpcServer(
+		logger,
+		ws,
+		RpcServerConfig{
+			Address:        "unix://" + sockPath,
+			AllowedMethods: []string{"GetRooms"},
+			BearerToken:    "abc123",
+		},
+		nil,
+		nopCloser{},
+		func(_ nopCloser, _ ...connect.HandlerOption) (string, http.Handler) {
+			return "/friendnet.test.v1.TestService/", http.NotFoundHandler()
+		},
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		_ = ws.Serve()
+	}()
+	t.Cleanup(func() {
+		_ = ws.Close()
+	})
+
+	client := &http.Client{
+		Transport: &http.Transport{
+			DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+				var d net.Dialer
+				return d.DialContext(ctx, "unix", sockPath)
+			},
+		},
+	}
+	check := func(token string) (int, string) {
+		req, reqErr := http.NewRequest(http.MethodPost, "http://rpc/grpc.health.v1.Health/Check", strings.NewReader(`{"service":"friendnet.test.v1.TestService"}`))
+		if reqErr != nil {
+			t.Fatal(reqErr)
+		}
+		req.Header.Set("Content-Type", "application/json")
+		if token != "" {
+			req.Header.Set("Authorization", "Bearer "+token)
+		}
+
+		res, reqErr := client.Do(req)
+		if reqErr != nil {
+			t.Fatal(reqErr)
+		}
+		defer func() {
+			_ = res.Body.Close()
+		}()
+		body, _ := io.ReadAll(res.Body)
+		return res.StatusCode, string(body)
+	}
+
+	if status, _ := check(""); status != http.StatusUnauthorized {
+		t.Errorf("expected status %d without a bearer token, got %d", http.StatusUnauthorized, status)
+	}
+
+	// The allowed methods list does not apply to health checks.
+	if status, body := check("abc123"); status != http.StatusOK || !strings.Contains(body, "SERVING") {
+		t.Errorf("expected serving status, got %d %q", status, body)
+	}
+
+	_ = rpc.Close()
+	if _, body := check("abc123"); !strings.Contains(body, "NOT_SERVING") {
+		t.Errorf("expected not serving status after close, got %q", body)
+	}
+}
+
+func TestRpcRolesAllowedMethods(t *testing.T) {
+	roles := RpcRoles{
+		RpcRoleViewer:   {"GetRooms", "GetRoomInfo"},
+		RpcRoleOperator: {"GetRooms", "GetRoomInfo", "KickUser"},
+		RpcRoleAdmin:    {"*"},
+	}
+
+	tests := []struct {
+		cfg  RpcServerConfig
+		want []string
+	}{
+		{RpcServerConfig{}, []string{}},
+		{RpcServerConfig{AllowedMethods: []string{"GetRooms"}}, []string{"GetRooms"}},
+		{RpcServerConfig{Role: RpcRoleViewer}, []string{"GetRooms", "GetRoomInfo"}},
+		{RpcServerConfig{Role: RpcRoleViewer, AllowedMethods: []string{"KickUser", "GetRooms"}}, []string{"GetRooms", "GetRoomInfo", "KickUser"}},
+		{RpcServerConfig{Role: RpcRoleViewer, AllowedMethods: []string{"*"}}, []string{"*"}},
+		{RpcServerConfig{Role: RpcRoleAdmin, AllowedMethods: []string{"GetRooms"}}, []string{"*"}},
+	}
+	for _, test := range tests {
+		got, err := roles.AllowedMethods(test.cfg)
+		if err != nil {
+			t.Fatalf("unexpected error for role %q: %v", test.cfg.Role, err)
+		}
+		if !slices.Equal(got, test.want) {
+			t.Errorf("expected %v for role %q and methods %v, got %v", test.want, test.cfg.Role, test.cfg.AllowedMethods, got)
+		}
+	}
+
+	var unknownErr *UnknownRpcRoleError
+	if _, err := roles.AllowedMethods(RpcServerConfig{Role: "owner"}); !errors.As(err, &unknownErr) || unknownErr.Role != "owner" {
+		t.Fatalf("expected UnknownRpcRoleError, got %v", err)
+	}
+	if _, err := RpcRoles(nil).AllowedMethods(RpcServerConfig{Role: RpcRoleViewer}); !errors.As(err, &unknownErr) {
+		t.Fatalf("expected UnknownRpcRoleError without roles, got %v", err)
+	}
+}
@@ -0,0 +1,137 @@
+package common
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/proto"
+)
+
+// rpcLogInterceptor logs RPC requests at debug level.
+// See RpcServerConfig.LogSampleEvery.
+type rpcLogInterceptor struct {
+	logger *slog.Logger
+
+	// Only 1 in every sampleEvery successful requests is logged.
+	sampleEvery uint64
+
+	// The number of successful requests that were considered for logging.
+	count *atomic.Uint64
+}
+
+var _ connect.Interceptor = rpcLogInterceptor{}
+
+// newRpcLogInterceptor creates an interceptor that logs 1 in every sampleEvery successful requests.
+// Returns nil if sampleEvery is negative.
+func newRpcLogInterceptor(logger *slog.Logger, sampleEvery int) connect.Interceptor {
+	if sampleEvery < 0 {
+		return nil
+	}
+
+	return rpcLogInterceptor{
+		logger:      logger,
+		sampleEvery: uint64(max(sampleEvery, 1)),
+		count:       &atomic.Uint64{},
+	}
+}
+
+// messageSize returns the encoded size of an RPC message, or 0 if it is not a protobuf message.
+func messageSize(msg any) int {
+	if m, ok := msg.(proto.Message); ok {
+		return proto.Size(m)
+	}
+	return 0
+}
+
+func (i rpcLogInterceptor) log(
+	ctx context.Context,
+	spec connect.Spec,
+	peer connect.Peer,
+	start time.Time,
+	err error,
+	requestBytes int,
+	responseBytes int,
+) {
+	if !i.logger.Enabled(ctx, slog.LevelDebug) {
+		return
+	}
+
+	status := "ok"
+	if err != nil {
+		status = connect.CodeOf(err).String()
+	} else if i.sampleEvery > 1 && i.count.Add(1)%i.sampleEvery != 0 {
+		return
+	}
+
+	i.logger.DebugContext(ctx, "handled RPC request",
+		"service", "common.RpcServer",
+		"method", spec.Procedure,
+		"peer", peer.Addr,
+		"latency", time.Since(start),
+		"status", status,
+		"request_bytes", requestBytes,
+		"response_bytes", responseBytes,
+	)
+}
+
+func (i rpcLogInterceptor) WrapUnary(fn connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		start := time.Now()
+
+		res, err := fn(ctx, req)
+
+		var responseBytes int
+		if res != nil {
+			responseBytes = messageSize(res.Any())
+		}
+		i.log(ctx, req.Spec(), req.Peer(), start, err, messageSize(req.Any()), responseBytes)
+
+		return res, err
+	}
+}
+
+func (i rpcLogInterceptor) WrapStreamingClient(fn connect.StreamingClientFunc) connect.StreamingClientFunc {
+	// Not applicable.
+	return fn
+}
+
+func (i rpcLogInterceptor) WrapStreamingHandler(fn connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+
+		counted := &sizeCountingConn{StreamingHandlerConn: conn}
+		err := fn(ctx, counted)
+
+		i.log(ctx, conn.Spec(), conn.Peer(), start, err, counted.receivedBytes, counted.sentBytes)
+
+		return err
+	}
+}
+
+// sizeCountingConn is a connect.StreamingHandlerConn that counts the encoded size of the messages it receives and
+// sends.
+type sizeCountingConn struct {
+	connect.StreamingHandlerConn
+
+	receivedBytes int
+	sentBytes     int
+}
+
+func (c *sizeCountingConn) Receive(msg any) error {
+	err := c.StreamingHandlerConn.Receive(msg)
+	if err == nil {
+		c.receivedBytes += messageSize(msg)
+	}
+	return err
+}
+
+func (c *sizeCountingConn) Send(msg any) error {
+	err := c.StreamingHandlerConn.Send(msg)
+	if err == nil {
+		c.sentBytes += messageSize(msg)
+	}
+	return err
+}
@@ -0,0 +1,133 @@
+package common
+
+import (
+	"sync"
+	"time"
+)
+
+// RttWindow is the number of recent samples RttTracker computes statistics over.
+const RttWindow = 32
+
+// RttStats is a snapshot of round-trip time statistics.
+type RttStats struct {
+	// The most recent round-trip time.
+	// Zero if no samples have been recorded.
+	Last time.Duration
+
+	// The minimum, average and maximum round-trip times over the last RttWindow samples.
+	Min time.Duration
+	Avg time.Duration
+	Max time.Duration
+
+	// The number of samples the minimum, average and maximum were computed from.
+	Samples int
+
+	// The total number of pings that failed.
+	Lost uint64
+
+	// The number of pings that failed in a row since the last successful one.
+	ConsecutiveLost int
+
+	// The estimated offset of the other side's clock from the local clock, positive if the other side's clock is
+	// ahead. It is taken from the sample with the lowest round-trip time in the window, since its estimate is the
+	// least skewed by asymmetric delays.
+	// Only valid if ClockOffsetKnown is true.
+	ClockOffset time.Duration
+
+	// Whether any sample in the window had a clock offset estimate.
+	ClockOffsetKnown bool
+}
+
+// RttSample is the result of a single successful ping.
+type RttSample struct {
+	// The round-trip time.
+	Rtt time.Duration
+
+	// The estimated offset of the other side's clock from the local clock, positive if the other side's clock is
+	// ahead.
+	// Only valid if ClockOffsetKnown is true.
+	ClockOffset time.Duration
+
+	// Whether the other side reported the timestamps needed to estimate ClockOffset.
+	ClockOffsetKnown bool
+}
+
+// RttTracker records ping round-trip times and failures.
+// The zero value is ready to use.
+// It is safe for concurrent use.
+type RttTracker struct {
+	mu sync.Mutex
+
+	// Ring buffer of recent samples.
+	samples [RttWindow]RttSample
+	next    int
+	count   int
+
+	last            time.Duration
+	lost            uint64
+	consecutiveLost int
+}
+
+// Record records a successful ping with the specified round-trip time and no clock offset estimate.
+func (t *RttTracker) Record(rtt time.Duration) {
+	t.RecordSample(RttSample{Rtt: rtt})
+}
+
+// RecordSample records a successful ping.
+func (t *RttTracker) RecordSample(sample RttSample) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.samples[t.next] = sample
+	t.next = (t.next + 1) % RttWindow
+	t.count = min(t.count+1, RttWindow)
+
+	t.last = sample.Rtt
+	t.consecutiveLost = 0
+}
+
+// RecordLost records a failed ping.
+// Returns the number of pings that have failed in a row, including this one.
+func (t *RttTracker) RecordLost() int {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.lost++
+	t.consecutiveLost++
+	return t.consecutiveLost
+}
+
+// Stats returns a snapshot of the current statistics.
+func (t *RttTracker) Stats() RttStats {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	stats := RttStats{
+		Last:            t.last,
+		Samples:         t.count,
+		Lost:            t.lost,
+		ConsecutiveLost: t.consecutiveLost,
+	}
+	if t.count == 0 {
+		return stats
+	}
+
+	var total time.Duration
+	var offsetRtt time.Duration
+	stats.Min = t.samples[0].Rtt
+	for _, sample := range t.samples[:t.count] {
+		rtt := sample.Rtt
+		total += rtt
+		stats.Min = min(stats.Min, rtt)
+		stats.Max = max(stats.Max, rtt)
+
+		if sample.ClockOffsetKnown && (!stats.ClockOffsetKnown || rtt < offsetRtt) {
+			stats.ClockOffset = sample.ClockOffset
+			stats.ClockOffsetKnown = true
+			offsetRtt = rtt
+		}
+	}
+	stats.Avg = total / time.Duration(t.count)
+
+	return stats
+}
@@ -0,0 +1,93 @@
+package common
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRttTracker_Empty(t *testing.T) {
+	t.Parallel()
+
+	var tr RttTracker
+	if stats := tr.Stats(); stats != (RttStats{}) {
+		t.Fatalf("expected empty stats, got %+v", stats)
+	}
+}
+
+func TestRttTracker_Stats(t *testing.T) {
+	t.Parallel()
+
+	var tr RttTracker
+	tr.Record(30 * time.Millisecond)
+	tr.Record(10 * time.Millisecond)
+	tr.Record(20 * time.Millisecond)
+
+	stats := tr.Stats()
+	if stats.Last != 20*time.Millisecond {
+		t.Errorf("expected last 20ms, got %s", stats.Last)
+	}
+	if stats.Min != 10*time.Millisecond || stats.Max != 30*time.Millisecond || stats.Avg != 20*time.Millisecond {
+		t.Errorf("expected min/avg/max 10ms/20ms/30ms, got %s/%s/%s", stats.Min, stats.Avg, stats.Max)
+	}
+	if stats.Samples != 3 {
+		t.Errorf("expected 3 samples, got %d", stats.Samples)
+	}
+}
+
+func TestRttTracker_Window(t *testing.T) {
+	t.Parallel()
+
+	var tr RttTracker
+	tr.Record(time.Hour)
+	for range RttWindow {
+		tr.Record(time.Millisecond)
+	}
+
+	stats := tr.Stats()
+	if stats.Max != time.Millisecond {
+		t.Errorf("expected old sample to fall out of the window, got max %s", stats.Max)
+	}
+	if stats.Samples != RttWindow {
+		t.Errorf("expected %d samples, got %d", RttWindow, stats.Samples)
+	}
+}
+
+func TestRttTracker_Lost(t *testing.T) {
+	t.Parallel()
+
+	var tr RttTracker
+	if n := tr.RecordLost(); n != 1 {
+		t.Errorf("expected 1 consecutive loss, got %d", n)
+	}
+	if n := tr.RecordLost(); n != 2 {
+		t.Errorf("expected 2 consecutive losses, got %d", n)
+	}
+	tr.Record(time.Millisecond)
+
+	stats := tr.Stats()
+	if stats.ConsecutiveLost != 0 {
+		t.Errorf("expected consecutive losses to reset, got %d", stats.ConsecutiveLost)
+	}
+	if stats.Lost != 2 {
+		t.Errorf("expected 2 total losses, got %d", stats.Lost)
+	}
+}
+
+func TestRttTracker_ClockOffset(t *testing.T) {
+	t.Parallel()
+
+	var tr RttTracker
+	tr.Record(time.Millisecond)
+	if stats := tr.Stats(); stats.ClockOffsetKnown {
+		t.Fatalf("expected no clock offset without estimates, got %s", stats.ClockOffset)
+	}
+
+	tr.RecordSample(RttSample{Rtt: 50 * time.Millisecond, ClockOffset: 40 * time.Millisecond, ClockOffsetKnown: true})
+	tr.RecordSample(RttSample{Rtt: 10 * time.Millisecond, ClockOffset: 5 * time.Millisecond, ClockOffsetKnown: true})
+	tr.RecordSample(RttSample{Rtt: 30 * time.Millisecond, ClockOffset: -20 * time.Millisecond, ClockOffsetKnown: true})
+
+	stats := tr.Stats()
+	if !stats.ClockOffsetKnown || stats.ClockOffset != 5*time.Millisecond {
+		t.Errorf("expected clock offset of the fastest sample, 5ms, got %s (known: %t)", stats.ClockOffset, stats.ClockOffsetKnown)
+	}
+}
@@ -0,0 +1,80 @@
+package common
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// SqliteBackupTimeFormat is the time format used in the names of backups created by BackupSqlite.
+const SqliteBackupTimeFormat = "20060102T150405"
+
+// BackupSqlite writes a consistent copy of a SQLite database next to its file at path, and returns the copy's path.
+// The copy is named after the original file and the current time, like "server.db.20261016T150405.bak".
+func BackupSqlite(ctx context.Context, db *sql.DB, path string) (string, error) {
+	dest := path + "." + time.Now().Format(SqliteBackupTimeFormat) + ".bak"
+
+	if err := BackupSqliteTo(ctx, db, dest); err != nil {
+		return "", err
+	}
+
+	return dest, nil
+}
+
+// BackupSqliteTo writes a consistent copy of a SQLite database to dest while it stays usable, using VACUUM INTO.
+// The copy is also defragmented.
+// The parent directory of dest is created if it does not exist.
+// Returns an error if dest already exists.
+//
+//goland:noinspection SqlNoDataSourceInspection
+func BackupSqliteTo(ctx context.Context, db *sql.DB, dest string) error {
+	if dest == "" {
+		return fmt.Errorf(`backup path is required`)
+	}
+
+	if _, err := os.Stat(dest); err == nil {
+		return fmt.Errorf(`failed to back up database to %q: %w`, dest, os.ErrExist)
+	}
+	if err := os.MkdirAll(filepath.Dir(dest), 0o700); err != nil {
+		return fmt.Errorf(`failed to create backup directory for %q: %w`, dest, err)
+	}
+
+	if _, err := db.ExecContext(ctx, `vacuum into ?`, dest); err != nil {
+		return fmt.Errorf(`failed to back up database to %q: %w`, dest, err)
+	}
+
+	return nil
+}
+
+// CheckSqliteIntegrity runs SQLite's integrity check on a database.
+// Returns the problems found, or nil if the database is intact.
+//
+//goland:noinspection SqlNoDataSourceInspection
+func CheckSqliteIntegrity(ctx context.Context, db *sql.DB) ([]string, error) {
+	rows, err := db.QueryContext(ctx, `PRAGMA integrity_check`)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to check database integrity: %w`, err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	var problems []string
+	for rows.Next() {
+		var msg string
+		if err = rows.Scan(&msg); err != nil {
+			return nil, fmt.Errorf(`failed to check database integrity: %w`, err)
+		}
+		if msg != "ok" {
+			problems = append(problems, msg)
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return nil, fmt.Errorf(`failed to check database integrity: %w`, err)
+	}
+
+	return problems, nil
+}
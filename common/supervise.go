@@ -0,0 +1,72 @@
+package common
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+	"time"
+)
+
+// SuperviseMinBackoff is how long Supervise waits before restarting a function that panicked once.
+// The wait doubles with every panic in a row, up to SuperviseMaxBackoff.
+const SuperviseMinBackoff = 1 * time.Second
+
+// SuperviseMaxBackoff is the longest Supervise waits before restarting a function that panicked.
+const SuperviseMaxBackoff = 1 * time.Minute
+
+// superviseStableAfter is how long a function must run without panicking for its backoff to be reset.
+const superviseStableAfter = 1 * time.Minute
+
+// Supervise runs fn in a new goroutine.
+// If fn panics, the panic is logged with its stack and fn is run again after a backoff, so that a bug in one subsystem
+// does not take down the whole process. fn is not run again once it returns normally or ctx is done.
+//
+// fn must be safe to run again after a panic, so it should not rely on state left behind by the previous run.
+func Supervise(ctx context.Context, logger *slog.Logger, name string, fn func()) {
+	go func() {
+		backoff := SuperviseMinBackoff
+		for {
+			startTs := time.Now()
+			if !runRecovered(logger, name, fn) {
+				return
+			}
+			if time.Since(startTs) >= superviseStableAfter {
+				backoff = SuperviseMinBackoff
+			}
+
+			logger.Warn("restarting supervised goroutine after panic",
+				"service", "common.Supervise",
+				"name", name,
+				"backoff", backoff,
+			)
+
+			timer := time.NewTimer(backoff)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return
+			case <-timer.C:
+			}
+
+			backoff = min(backoff*2, SuperviseMaxBackoff)
+		}
+	}()
+}
+
+// runRecovered runs fn and returns whether it panicked.
+func runRecovered(logger *slog.Logger, name string, fn func()) (panicked bool) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			panicked = true
+			logger.Error("panic in supervised goroutine",
+				"service", "common.Supervise",
+				"name", name,
+				"err", rec,
+				"stack", string(debug.Stack()),
+			)
+		}
+	}()
+
+	fn()
+	return false
+}
@@ -0,0 +1,53 @@
+package common
+
+import (
+	"context"
+	"io"
+	"log/slog"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSupervise_RestartsAfterPanic(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+
+	var runs atomic.Int32
+	done := make(chan struct{})
+	Supervise(context.Background(), logger, "test", func() {
+		if runs.Add(1) == 1 {
+			panic("boom")
+		}
+		close(done)
+	})
+
+	select {
+	case <-done:
+	case <-time.After(SuperviseMinBackoff + 5*time.Second):
+		t.Fatal("function was not restarted after panicking")
+	}
+	if n := runs.Load(); n != 2 {
+		t.Fatalf("expected 2 runs, got %d", n)
+	}
+}
+
+func TestSupervise_StopsWhenContextDone(t *testing.T) {
+	t.Parallel()
+
+	logger := slog.New(slog.NewTextHandler(io.Discard, nil))
+	ctx, cancel := context.WithCancel(context.Background())
+
+	var runs atomic.Int32
+	Supervise(ctx, logger, "test", func() {
+		runs.Add(1)
+		cancel()
+		panic("boom")
+	})
+
+	time.Sleep(SuperviseMinBackoff + 500*time.Millisecond)
+	if n := runs.Load(); n != 1 {
+		t.Fatalf("expected 1 run after context was canceled, got %d", n)
+	}
+}
@@ -0,0 +1,105 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+// ErrInvalidTimeWindow is returned when a time window is invalid.
+var ErrInvalidTimeWindow = errors.New("invalid time window")
+
+// MinutesPerDay is the number of minutes in a day.
+const MinutesPerDay = 24 * 60
+
+// AllWeekdays is a weekday mask with every day of the week set.
+const AllWeekdays = 1<<7 - 1
+
+// TimeWindow is a window of time that recurs on some or all days of the week.
+// Times are in the local time zone.
+type TimeWindow struct {
+	// The days of the week the window starts on, as a bit mask where bit 0 is Sunday and bit 6 is Saturday.
+	// 0 means every day.
+	Weekdays uint8
+
+	// The minute of the day the window starts at, from 0 to 1439.
+	StartMinute int
+
+	// The minute of the day the window ends at, from 0 to 1439.
+	// If it is not after StartMinute, the window ends on the next day.
+	EndMinute int
+}
+
+// Validate returns an error wrapping ErrInvalidTimeWindow if the window is invalid.
+func (w TimeWindow) Validate() error {
+	if w.Weekdays > AllWeekdays {
+		return fmt.Errorf(`%w: weekday mask %d has bits past Saturday set`, ErrInvalidTimeWindow, w.Weekdays)
+	}
+	if w.StartMinute < 0 || w.StartMinute >= MinutesPerDay {
+		return fmt.Errorf(`%w: start minute %d is not within a day`, ErrInvalidTimeWindow, w.StartMinute)
+	}
+	if w.EndMinute < 0 || w.EndMinute >= MinutesPerDay {
+		return fmt.Errorf(`%w: end minute %d is not within a day`, ErrInvalidTimeWindow, w.EndMinute)
+	}
+	return nil
+}
+
+// startsOn returns whether the window starts on the specified day of the week.
+func (w TimeWindow) startsOn(day time.Weekday) bool {
+	return w.Weekdays == 0 || w.Weekdays&(1<<day) != 0
+}
+
+// Contains returns whether t is within the window.
+func (w TimeWindow) Contains(t time.Time) bool {
+	minute := t.Hour()*60 + t.Minute()
+	day := t.Weekday()
+
+	if w.StartMinute < w.EndMinute {
+		return w.startsOn(day) && minute >= w.StartMinute && minute < w.EndMinute
+	}
+
+	// The window goes past midnight, so it could have started today or yesterday.
+	if minute >= w.StartMinute && w.startsOn(day) {
+		return true
+	}
+	return minute < w.EndMinute && w.startsOn((day+6)%7)
+}
+
+// weekdayAbbrevs maps three-letter lowercase weekday names to days of the week.
+var weekdayAbbrevs = map[string]time.Weekday{
+	"sun": time.Sunday,
+	"mon": time.Monday,
+	"tue": time.Tuesday,
+	"wed": time.Wednesday,
+	"thu": time.Thursday,
+	"fri": time.Friday,
+	"sat": time.Saturday,
+}
+
+// ParseTimeWindow parses a time window from a list of three-letter weekday names like "mon", and start and end times
+// in "HH:MM" format.
+// An empty list of days means every day.
+func ParseTimeWindow(days []string, start string, end string) (TimeWindow, error) {
+	var w TimeWindow
+	for _, day := range days {
+		wd, ok := weekdayAbbrevs[strings.ToLower(day)]
+		if !ok {
+			return w, fmt.Errorf(`%w: unknown day %q`, ErrInvalidTimeWindow, day)
+		}
+		w.Weekdays |= 1 << wd
+	}
+
+	startTime, err := time.Parse("15:04", start)
+	if err != nil {
+		return w, fmt.Errorf(`%w: start time %q is not in HH:MM format`, ErrInvalidTimeWindow, start)
+	}
+	endTime, err := time.Parse("15:04", end)
+	if err != nil {
+		return w, fmt.Errorf(`%w: end time %q is not in HH:MM format`, ErrInvalidTimeWindow, end)
+	}
+	w.StartMinute = startTime.Hour()*60 + startTime.Minute()
+	w.EndMinute = endTime.Hour()*60 + endTime.Minute()
+
+	return w, nil
+}
@@ -11,6 +11,7 @@ import (
 	"net/url"
 	"os"
 	"path/filepath"
+	"runtime/debug"
 	"strings"
 	"sync"
 )
@@ -32,6 +33,7 @@ func (rw recoverWrapper) ServeHTTP(w http.ResponseWriter, r *http.Request) {
 				"service", "webserver.WebServer",
 				"url", r.URL.String(),
 				"err", rec,
+				"stack", string(debug.Stack()),
 			)
 		}
 	}()
@@ -204,6 +206,8 @@ func (ws *WebServer) Mount(address string, path string, handler http.Handler) er
 		var protos http.Protocols
 		protos.SetHTTP2(true)
 		protos.SetHTTP1(true)
+		// Allows gRPC clients, which require HTTP/2, to connect without TLS, such as over unix sockets.
+		protos.SetUnencryptedHTTP2(true)
 
 		var listener net.Listener
 		var err error
@@ -0,0 +1,208 @@
+package protocol
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+)
+
+// File content sent in reply to MSG_TYPE_GET_FILE can be split into chunks, each followed by its SHA-256 hash, so that
+// the receiver detects corruption as soon as a chunk ends instead of after the whole file, and can resume from the end
+// of the last chunk that matched. See MsgGetFile.checksum_chunk_size.
+
+// MinChecksumChunkSize is the smallest chunk size a peer uses for chunk checksums.
+const MinChecksumChunkSize = 4 * 1024 * 1024
+
+// MaxChecksumChunkSize is the largest chunk size a peer uses for chunk checksums.
+const MaxChecksumChunkSize = 16 * 1024 * 1024
+
+// DefaultChecksumChunkSize is the chunk size requested for chunk checksums by default.
+const DefaultChecksumChunkSize = 8 * 1024 * 1024
+
+// ClampChecksumChunkSize returns the chunk size a peer uses when size is requested.
+func ClampChecksumChunkSize(size uint64) uint64 {
+	return min(max(size, MinChecksumChunkSize), MaxChecksumChunkSize)
+}
+
+// GetFileContentSize returns the number of content bytes sent in reply to a MSG_TYPE_GET_FILE request with the
+// specified offset and limit, for a file of the specified size.
+func GetFileContentSize(size uint64, offset uint64, limit uint64) int64 {
+	if offset >= size {
+		return 0
+	}
+	contentSize := size - offset
+	if limit > 0 {
+		contentSize = min(contentSize, limit)
+	}
+	return int64(contentSize)
+}
+
+// ChunkChecksumMismatchError is returned by ChunkChecksumReader when a chunk does not match its checksum.
+type ChunkChecksumMismatchError struct {
+	// The number of content bytes that were read and verified before the chunk that did not match.
+	Verified int64
+}
+
+func (e ChunkChecksumMismatchError) Error() string {
+	return fmt.Sprintf("file content chunk after %d bytes does not match its checksum", e.Verified)
+}
+
+// ChunkChecksumWriter writes content to a stream, following each chunk with its SHA-256 hash.
+// Flush must be called after the last write so that the last chunk gets its hash.
+type ChunkChecksumWriter struct {
+	w         io.Writer
+	chunkSize int64
+	inChunk   int64
+	hasher    hash.Hash
+}
+
+// NewChunkChecksumWriter returns a ChunkChecksumWriter that writes chunks of chunkSize bytes to w.
+func NewChunkChecksumWriter(w io.Writer, chunkSize int64) *ChunkChecksumWriter {
+	return &ChunkChecksumWriter{
+		w:         w,
+		chunkSize: chunkSize,
+		hasher:    sha256.New(),
+	}
+}
+
+func (c *ChunkChecksumWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := int(min(int64(len(p)), c.chunkSize-c.inChunk))
+		m, err := c.w.Write(p[:n])
+		c.hasher.Write(p[:m])
+		c.inChunk += int64(m)
+		written += m
+		if err != nil {
+			return written, err
+		}
+
+		if c.inChunk == c.chunkSize {
+			if err = c.writeSum(); err != nil {
+				return written, err
+			}
+		}
+		p = p[n:]
+	}
+
+	return written, nil
+}
+
+// Flush writes the hash of the last chunk if it is shorter than the chunk size.
+func (c *ChunkChecksumWriter) Flush() error {
+	if c.inChunk == 0 {
+		return nil
+	}
+	return c.writeSum()
+}
+
+func (c *ChunkChecksumWriter) writeSum() error {
+	_, err := c.w.Write(c.hasher.Sum(nil))
+	c.hasher.Reset()
+	c.inChunk = 0
+	return err
+}
+
+// ChunkChecksumReader reads content written by a ChunkChecksumWriter, verifying each chunk as it ends.
+// Data is returned as soon as it is read, so the data of a chunk that does not match its checksum is returned before
+// the ChunkChecksumMismatchError. Callers that keep the data should discard everything after
+// ChunkChecksumMismatchError.Verified.
+type ChunkChecksumReader struct {
+	r         io.Reader
+	chunkSize int64
+	hasher    hash.Hash
+
+	// The number of content bytes not read yet.
+	remaining int64
+
+	// The length of the current chunk, and the number of its bytes not read yet.
+	chunkLen int64
+	inChunk  int64
+
+	// The number of content bytes that were verified.
+	verified int64
+
+	err error
+}
+
+// NewChunkChecksumReader returns a ChunkChecksumReader that reads size bytes of content in chunks of chunkSize bytes
+// from r.
+func NewChunkChecksumReader(r io.Reader, chunkSize int64, size int64) *ChunkChecksumReader {
+	c := &ChunkChecksumReader{
+		r:         r,
+		chunkSize: chunkSize,
+		hasher:    sha256.New(),
+		remaining: size,
+	}
+	c.startChunk()
+
+	return c
+}
+
+func (c *ChunkChecksumReader) startChunk() {
+	c.chunkLen = min(c.chunkSize, c.remaining)
+	c.inChunk = c.chunkLen
+}
+
+func (c *ChunkChecksumReader) Read(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+	if c.remaining == 0 {
+		return 0, io.EOF
+	}
+
+	if int64(len(p)) > c.inChunk {
+		p = p[:c.inChunk]
+	}
+
+	n, err := c.r.Read(p)
+	c.hasher.Write(p[:n])
+	c.inChunk -= int64(n)
+	c.remaining -= int64(n)
+
+	if c.inChunk == 0 {
+		if verifyErr := c.verifyChunk(); verifyErr != nil {
+			c.err = verifyErr
+			return n, verifyErr
+		}
+		return n, nil
+	}
+
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
+		}
+		c.err = err
+		return n, err
+	}
+
+	return n, nil
+}
+
+// Verified returns the number of content bytes that were read and matched their chunk's checksum.
+func (c *ChunkChecksumReader) Verified() int64 {
+	return c.verified
+}
+
+func (c *ChunkChecksumReader) verifyChunk() error {
+	var sum [sha256.Size]byte
+	if _, err := io.ReadFull(c.r, sum[:]); err != nil {
+		if errors.Is(err, io.EOF) {
+			return io.ErrUnexpectedEOF
+		}
+		return err
+	}
+
+	if !bytes.Equal(sum[:], c.hasher.Sum(nil)) {
+		return ChunkChecksumMismatchError{Verified: c.verified}
+	}
+
+	c.verified += c.chunkLen
+	c.hasher.Reset()
+	c.startChunk()
+	return nil
+}
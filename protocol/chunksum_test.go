@@ -0,0 +1,81 @@
+package protocol
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"testing"
+)
+
+func writeChunked(t *testing.T, content []byte, chunkSize int64) []byte {
+	t.Helper()
+
+	var buf bytes.Buffer
+	w := NewChunkChecksumWriter(&buf, chunkSize)
+	// Write in uneven pieces so that writes straddle chunk boundaries.
+	for i := 0; i < len(content); i += 7 {
+		if _, err := w.Write(content[i:min(i+7, len(content))]); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := w.Flush(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
+
+func TestChunkChecksum_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	stream := writeChunked(t, content, 16)
+
+	// 100 bytes in chunks of 16 is 7 chunks, each followed by a hash.
+	if want := len(content) + 7*32; len(stream) != want {
+		t.Fatalf("expected stream of %d bytes, got %d", want, len(stream))
+	}
+
+	r := NewChunkChecksumReader(bytes.NewReader(stream), 16, int64(len(content)))
+	got, err := io.ReadAll(r)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !bytes.Equal(got, content) {
+		t.Fatalf("expected %q, got %q", content, got)
+	}
+	if r.Verified() != int64(len(content)) {
+		t.Fatalf("expected %d verified bytes, got %d", len(content), r.Verified())
+	}
+}
+
+func TestChunkChecksum_DetectsCorruption(t *testing.T) {
+	t.Parallel()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	stream := writeChunked(t, content, 16)
+
+	// Corrupt the third chunk, which starts after two chunks and their hashes.
+	stream[2*(16+32)+3] ^= 0xff
+
+	r := NewChunkChecksumReader(bytes.NewReader(stream), 16, int64(len(content)))
+	_, err := io.ReadAll(r)
+	mismatchErr, ok := errors.AsType[ChunkChecksumMismatchError](err)
+	if !ok {
+		t.Fatalf("expected ChunkChecksumMismatchError, got %v", err)
+	}
+	if mismatchErr.Verified != 32 {
+		t.Fatalf("expected 32 verified bytes, got %d", mismatchErr.Verified)
+	}
+}
+
+func TestChunkChecksum_TruncatedStream(t *testing.T) {
+	t.Parallel()
+
+	content := bytes.Repeat([]byte("0123456789"), 10)
+	stream := writeChunked(t, content, 16)
+
+	r := NewChunkChecksumReader(bytes.NewReader(stream[:len(stream)-10]), 16, int64(len(content)))
+	if _, err := io.ReadAll(r); !errors.Is(err, io.ErrUnexpectedEOF) {
+		t.Fatalf("expected io.ErrUnexpectedEOF, got %v", err)
+	}
+}
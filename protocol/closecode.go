@@ -0,0 +1,84 @@
+package protocol
+
+import (
+	"errors"
+
+	"github.com/quic-go/quic-go"
+)
+
+// CloseCode is the QUIC application error code sent when closing a connection.
+// It tells the other side why the connection was closed, so it can decide what to do next without parsing the
+// human-readable reason.
+type CloseCode quic.ApplicationErrorCode
+
+const (
+	// CloseCodeNormal means the connection was closed because it is no longer needed.
+	CloseCodeNormal CloseCode = 0
+
+	// CloseCodeAuthFailed means version negotiation or authentication failed.
+	// Reconnecting with the same version and credentials will fail the same way.
+	CloseCodeAuthFailed CloseCode = 1
+
+	// CloseCodeKicked means an administrator removed the client from the room.
+	CloseCodeKicked CloseCode = 2
+
+	// CloseCodeShutdown means the server or room is shutting down.
+	CloseCodeShutdown CloseCode = 3
+
+	// CloseCodeProtocolViolation means the other side sent something it should not have.
+	CloseCodeProtocolViolation CloseCode = 4
+
+	// CloseCodePingTimeout means the other side stopped answering pings.
+	CloseCodePingTimeout CloseCode = 5
+
+	// CloseCodeUnavailable means the server cannot accept the connection right now, for example because the room is
+	// full or the account is already connected.
+	CloseCodeUnavailable CloseCode = 6
+
+	// CloseCodeInternalError means the closing side hit an unexpected error.
+	CloseCodeInternalError CloseCode = 7
+)
+
+func (c CloseCode) String() string {
+	switch c {
+	case CloseCodeNormal:
+		return "normal"
+	case CloseCodeAuthFailed:
+		return "auth failed"
+	case CloseCodeKicked:
+		return "kicked"
+	case CloseCodeShutdown:
+		return "shutdown"
+	case CloseCodeProtocolViolation:
+		return "protocol violation"
+	case CloseCodePingTimeout:
+		return "ping timeout"
+	case CloseCodeUnavailable:
+		return "unavailable"
+	case CloseCodeInternalError:
+		return "internal error"
+	default:
+		return "unknown"
+	}
+}
+
+// Retryable returns whether it makes sense to automatically reconnect after the connection was closed with the code.
+// Unknown codes are assumed to be retryable, so that codes added in newer versions do not strand older clients.
+func (c CloseCode) Retryable() bool {
+	switch c {
+	case CloseCodeAuthFailed, CloseCodeKicked, CloseCodeProtocolViolation:
+		return false
+	default:
+		return true
+	}
+}
+
+// CloseCodeFromError returns the close code and reason if err was caused by the other side closing the connection.
+// Returns false if the connection was not closed by the other side.
+func CloseCodeFromError(err error) (code CloseCode, reason string, ok bool) {
+	appErr, is := errors.AsType[*quic.ApplicationError](err)
+	if !is || !appErr.Remote {
+		return 0, "", false
+	}
+	return CloseCode(appErr.ErrorCode), appErr.ErrorMessage, true
+}
@@ -0,0 +1,239 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"sync"
+	"time"
+
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+	"github.com/quic-go/quic-go"
+	"google.golang.org/protobuf/proto"
+)
+
+// ErrDatagramsUnsupported is returned by Datagrams for connections on which datagrams were not negotiated, or that are
+// not backed by a QUIC connection with datagram support on both sides.
+var ErrDatagramsUnsupported = errors.New("datagrams are not supported on connection")
+
+// SupportedCapabilities returns the capabilities this side supports on conn.
+// Clients send them in MSG_TYPE_VERSION, and servers intersect them with the client's using IntersectCapabilities.
+func SupportedCapabilities(conn ProtoConn) []pb.Capability {
+	var caps []pb.Capability
+	if qConn, ok := quicConnOf(conn); ok && qConn.ConnectionState().SupportsDatagrams.Local {
+		caps = append(caps, pb.Capability_CAPABILITY_DATAGRAMS)
+	}
+	caps = append(caps, pb.Capability_CAPABILITY_PAGE_SIZE)
+	return caps
+}
+
+// IntersectCapabilities returns the capabilities that are in both a and b, in the order of a.
+// Unknown and duplicate capabilities are dropped.
+func IntersectCapabilities(a []pb.Capability, b []pb.Capability) []pb.Capability {
+	var res []pb.Capability
+	for _, c := range a {
+		if c == pb.Capability_CAPABILITY_UNSPECIFIED || slices.Contains(res, c) {
+			continue
+		}
+		if _, known := pb.Capability_name[int32(c)]; !known {
+			continue
+		}
+		if slices.Contains(b, c) {
+			res = append(res, c)
+		}
+	}
+	return res
+}
+
+// SetCapabilities records the capabilities negotiated for conn, so that they can be checked with HasCapability
+// anywhere the connection is used, including sessions scoped on it.
+// If CAPABILITY_DATAGRAMS is negotiated, it starts receiving datagrams on the connection. See PingContext.
+// It does nothing for connections that are not backed by a QUIC connection.
+func SetCapabilities(conn ProtoConn, caps []pb.Capability) {
+	impl, ok := connImplOf(conn)
+	if !ok {
+		return
+	}
+	caps = slices.Clone(caps)
+	impl.capabilities.Store(&caps)
+
+	if datagrams, err := Datagrams(conn); err == nil {
+		pinger := newDatagramPinger(datagrams)
+		if impl.datagramPinger.CompareAndSwap(nil, pinger) {
+			go pinger.run(impl.Inner.Context())
+		}
+	}
+}
+
+// HasCapability returns whether capability was negotiated for conn with SetCapabilities.
+func HasCapability(conn ProtoConn, capability pb.Capability) bool {
+	impl, ok := connImplOf(conn)
+	if !ok {
+		return false
+	}
+	caps := impl.capabilities.Load()
+	return caps != nil && slices.Contains(*caps, capability)
+}
+
+// DatagramChannel sends and receives small unreliable messages as QUIC DATAGRAM frames.
+// Once datagrams are negotiated with SetCapabilities, they are received by the connection itself, which answers
+// pings and hands their pongs to PingContext.
+// Datagrams can be lost, reordered or duplicated, and are not retransmitted, so they are only for messages that are
+// fine to miss and are stale by the time they would be retransmitted, such as presence heartbeats, typing indicators
+// and transfer progress pings. Anything that must arrive still goes over streams.
+// Each datagram holds exactly one message with the same header as on streams, so its payload must fit in a single
+// QUIC packet, which is usually a little over 1 KB.
+// Datagrams are not scoped to a room; they belong to the QUIC connection.
+type DatagramChannel struct {
+	conn *quic.Conn
+}
+
+// Datagrams returns the datagram channel of conn.
+// Returns ErrDatagramsUnsupported if CAPABILITY_DATAGRAMS was not negotiated for the connection, or either side of the
+// QUIC connection did not enable datagrams.
+func Datagrams(conn ProtoConn) (*DatagramChannel, error) {
+	qConn, ok := quicConnOf(conn)
+	if !ok || !HasCapability(conn, pb.Capability_CAPABILITY_DATAGRAMS) {
+		return nil, ErrDatagramsUnsupported
+	}
+
+	support := qConn.ConnectionState().SupportsDatagrams
+	if !support.Local || !support.Remote {
+		return nil, ErrDatagramsUnsupported
+	}
+
+	return &DatagramChannel{conn: qConn}, nil
+}
+
+// Send sends a message as a single datagram.
+// Returns an error wrapping *quic.DatagramTooLargeError if the message does not fit in a datagram.
+// A nil error does not mean the message arrived.
+func (c *DatagramChannel) Send(typ pb.MsgType, msg proto.Message) error {
+	var buf bytes.Buffer
+	if err := NewProtoStreamWriter(&buf).Write(typ, msg); err != nil {
+		return err
+	}
+
+	if err := c.conn.SendDatagram(buf.Bytes()); err != nil {
+		return fmt.Errorf(`failed to send datagram with message type %s: %w`, typ.String(), err)
+	}
+	return nil
+}
+
+// receive waits for the next datagram and returns the message it holds.
+// Only one goroutine may receive on a connection, since each datagram is only returned once.
+// Returns an error if a datagram does not hold exactly one well-formed message; later datagrams can still be received
+// after that.
+func (c *DatagramChannel) receive(ctx context.Context) (*UntypedProtoMsg, error) {
+	data, err := c.conn.ReceiveDatagram(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to receive datagram: %w`, err)
+	}
+
+	reader := bytes.NewReader(data)
+	msg, err := NewProtoStreamReader(reader).ReadRaw()
+	if err != nil {
+		return nil, fmt.Errorf(`failed to read message from datagram: %w`, err)
+	}
+	if reader.Len() > 0 {
+		return nil, fmt.Errorf(`datagram has %d trailing bytes after message of type %s`, reader.Len(), msg.Type.String())
+	}
+
+	return msg, nil
+}
+
+// datagramPingTimeout is how long PingContext waits for the pong to a datagram ping before pinging over a stream.
+const datagramPingTimeout = 2 * time.Second
+
+// datagramPinger receives the datagrams of a connection, answering pings and handing pongs to the pings waiting for
+// them. There is one per QUIC connection, shared by every session scoped on it.
+type datagramPinger struct {
+	datagrams *DatagramChannel
+
+	mu sync.Mutex
+
+	// Channels of pings waiting for their pongs, keyed by the time they were sent.
+	waiting map[int64][]chan *pb.MsgPong
+}
+
+func newDatagramPinger(datagrams *DatagramChannel) *datagramPinger {
+	return &datagramPinger{
+		datagrams: datagrams,
+		waiting:   make(map[int64][]chan *pb.MsgPong),
+	}
+}
+
+// datagramPingerOf returns the datagramPinger of conn, or nil if datagrams were not negotiated on it.
+func datagramPingerOf(conn ProtoConn) *datagramPinger {
+	impl, ok := connImplOf(conn)
+	if !ok {
+		return nil
+	}
+	return impl.datagramPinger.Load()
+}
+
+// run receives datagrams until ctx is done.
+// Datagrams that do not hold a ping or pong are ignored.
+func (p *datagramPinger) run(ctx context.Context) {
+	for {
+		msg, err := p.datagrams.receive(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			continue
+		}
+
+		switch payload := msg.Payload.(type) {
+		case *pb.MsgPing:
+			_ = p.datagrams.Send(pb.MsgType_MSG_TYPE_PONG, NewPong(payload, time.Now()))
+		case *pb.MsgPong:
+			p.mu.Lock()
+			for _, ch := range p.waiting[payload.PingSentTs] {
+				select {
+				case ch <- payload:
+				default:
+				}
+			}
+			p.mu.Unlock()
+		}
+	}
+}
+
+// ping pings the other side with a datagram and measures the round trip.
+// Datagrams can be lost, so it gives up once ctx is done without counting the ping as missed.
+func (p *datagramPinger) ping(ctx context.Context) (common.RttSample, error) {
+	start := time.Now()
+	ping := &pb.MsgPing{
+		SentTs: start.UnixMilli(),
+	}
+
+	ch := make(chan *pb.MsgPong, 1)
+	p.mu.Lock()
+	p.waiting[ping.SentTs] = append(p.waiting[ping.SentTs], ch)
+	p.mu.Unlock()
+	defer func() {
+		p.mu.Lock()
+		defer p.mu.Unlock()
+		p.waiting[ping.SentTs] = slices.DeleteFunc(p.waiting[ping.SentTs], func(waiting chan *pb.MsgPong) bool {
+			return waiting == ch
+		})
+		if len(p.waiting[ping.SentTs]) == 0 {
+			delete(p.waiting, ping.SentTs)
+		}
+	}()
+
+	if err := p.datagrams.Send(pb.MsgType_MSG_TYPE_PING, ping); err != nil {
+		return common.RttSample{}, err
+	}
+
+	select {
+	case pong := <-ch:
+		return measurePong(ping, pong, start, time.Now()), nil
+	case <-ctx.Done():
+		return common.RttSample{}, context.Cause(ctx)
+	}
+}
@@ -0,0 +1,85 @@
+package protocol
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+func TestIntersectCapabilities(t *testing.T) {
+	t.Parallel()
+
+	got := IntersectCapabilities(
+		[]pb.Capability{
+			pb.Capability_CAPABILITY_UNSPECIFIED,
+			pb.Capability_CAPABILITY_DATAGRAMS,
+			pb.Capability(1000),
+			pb.Capability_CAPABILITY_DATAGRAMS,
+		},
+		[]pb.Capability{pb.Capability_CAPABILITY_DATAGRAMS, pb.Capability(1000)},
+	)
+	if want := []pb.Capability{pb.Capability_CAPABILITY_DATAGRAMS}; !slices.Equal(got, want) {
+		t.Fatalf("got %v, want %v", got, want)
+	}
+
+	if got = IntersectCapabilities(nil, []pb.Capability{pb.Capability_CAPABILITY_DATAGRAMS}); len(got) != 0 {
+		t.Fatalf("got %v from a peer without capabilities", got)
+	}
+}
+
+func TestDatagrams_RoundTrip(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	addr := newTestEarlyListener(t, ctx)
+
+	qConn, err := DialEarly(ctx, addr, &tls.Config{
+		InsecureSkipVerify: true,
+		NextProtos:         []string{"test"},
+	}, DefaultConnLimits.QuicConfig())
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer func() {
+		_ = qConn.CloseWithError(0, "")
+	}()
+	conn := ToProtoConn(qConn)
+	pingTestConn(t, conn)
+
+	if _, err = Datagrams(conn); !errors.Is(err, ErrDatagramsUnsupported) {
+		t.Fatalf("got %v before negotiating datagrams, want ErrDatagramsUnsupported", err)
+	}
+
+	if datagramPingerOf(conn) != nil {
+		t.Fatal("expected datagrams not to be received before negotiating them")
+	}
+
+	SetCapabilities(conn, []pb.Capability{pb.Capability_CAPABILITY_DATAGRAMS})
+	pinger := datagramPingerOf(conn)
+	if pinger == nil {
+		t.Fatal("expected datagrams to be received once negotiated")
+	}
+
+	// Datagrams can be lost, so keep pinging until a pong arrives.
+	for {
+		pingCtx, pingCancel := context.WithTimeout(ctx, 200*time.Millisecond)
+		_, err = pinger.ping(pingCtx)
+		pingCancel()
+		if err == nil {
+			break
+		}
+		if ctx.Err() != nil {
+			t.Fatalf("no pong received: %v", err)
+		}
+	}
+
+	// Pings that go over datagrams are still answered.
+	if _, err = PingContext(ctx, conn); err != nil {
+		t.Fatalf("failed to ping: %v", err)
+	}
+}
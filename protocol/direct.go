@@ -141,10 +141,7 @@ func CreateDirectConnection(
 		}
 
 		var qConn *quic.Conn
-		qConn, err = quic.DialAddr(ctx, address, tlsCfg, &quic.Config{
-			KeepAlivePeriod:    DefaultKeepAlivePeriod,
-			MaxIncomingStreams: DefaultMaxIncomingStreams,
-		})
+		qConn, err = quic.DialAddr(ctx, address, tlsCfg, DefaultConnLimits.QuicConfig())
 		if err != nil {
 			return nil, fmt.Errorf(`failed to dial QUIC %q for direct connection: %w`, address, err)
 		}
@@ -162,15 +159,15 @@ func CreateDirectConnection(
 
 			ctxErr := ctx.Err()
 			if errors.Is(ctxErr, context.Canceled) {
-				_ = c.CloseWithReason(canceledMsg)
+				_ = c.CloseWithCode(CloseCodeNormal, canceledMsg)
 				return
 			}
 			if errors.Is(ctxErr, context.DeadlineExceeded) {
-				_ = c.CloseWithReason(timedOutMsg)
+				_ = c.CloseWithCode(CloseCodeNormal, timedOutMsg)
 				return
 			}
 
-			_ = c.CloseWithReason("")
+			_ = c.CloseWithCode(CloseCodeNormal, "")
 		}(conn)
 
 		// Send handshake.
@@ -0,0 +1,102 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	pb "friendnet.org/protocol/pb/v1"
+	"github.com/quic-go/quic-go"
+)
+
+// Connections to servers may use 0-RTT: a client that connected to a server before can send data in its first flight,
+// before the handshake completes, so that reconnecting takes one round trip less.
+// 0-RTT data can be replayed by an attacker who captured it, so servers must only act on messages that are harmless to
+// process more than once until the handshake completes. Replays cannot complete the handshake.
+
+// IsReplaySafeMsgType returns whether messages of the specified type are safe to act on before the handshake completes,
+// since processing them again in a replay does not change anything.
+func IsReplaySafeMsgType(typ pb.MsgType) bool {
+	switch typ {
+	case pb.MsgType_MSG_TYPE_VERSION,
+		pb.MsgType_MSG_TYPE_PING:
+		return true
+	default:
+		return false
+	}
+}
+
+// WaitUntilReplaySafe waits until it is safe to act on a message of the specified type received on conn.
+// For replay-safe message types, it returns immediately.
+// Otherwise, it waits for the connection's handshake to complete, like WaitForHandshake.
+func WaitUntilReplaySafe(ctx context.Context, conn ProtoConn, typ pb.MsgType) error {
+	if IsReplaySafeMsgType(typ) {
+		return nil
+	}
+	return WaitForHandshake(ctx, conn)
+}
+
+// WaitForHandshake waits until the handshake of the QUIC connection underlying conn completes.
+// Returns an error if the handshake failed or ctx is done first.
+// Connections that are not backed by QUIC return immediately.
+func WaitForHandshake(ctx context.Context, conn ProtoConn) error {
+	qConn, ok := quicConnOf(conn)
+	if !ok {
+		return nil
+	}
+
+	select {
+	case <-qConn.HandshakeComplete():
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	// The handshake complete channel is also closed when the handshake fails.
+	if err := context.Cause(qConn.Context()); err != nil {
+		return fmt.Errorf("handshake failed: %w", err)
+	}
+	return nil
+}
+
+// Next0RTTConn returns the connection to use after the server rejected 0-RTT on conn, such as because it restarted
+// since it issued the session ticket.
+// Bidis opened before the rejection fail with quic.Err0RTTRejected and must be opened again on the returned connection.
+func Next0RTTConn(ctx context.Context, conn ProtoConn) (ProtoConn, error) {
+	impl, ok := conn.(*ProtoConnImpl)
+	if !ok {
+		return nil, errors.New("connection does not use 0-RTT")
+	}
+
+	next, err := impl.Inner.NextConnection(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return ToProtoConn(next), nil
+}
+
+// Is0RTTRejected returns whether err means that the server rejected 0-RTT.
+// See Next0RTTConn.
+func Is0RTTRejected(err error) bool {
+	return errors.Is(err, quic.Err0RTTRejected)
+}
+
+// quicConnOf returns the QUIC connection underlying conn, if there is one.
+func quicConnOf(conn ProtoConn) (*quic.Conn, bool) {
+	impl, ok := connImplOf(conn)
+	if !ok {
+		return nil, false
+	}
+	return impl.Inner, true
+}
+
+// connImplOf returns the ProtoConnImpl underlying conn, if there is one.
+func connImplOf(conn ProtoConn) (*ProtoConnImpl, bool) {
+	switch c := conn.(type) {
+	case *ProtoConnImpl:
+		return c, true
+	case *ScopedConn:
+		return connImplOf(c.session.conn)
+	default:
+		return nil, false
+	}
+}
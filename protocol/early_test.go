@@ -0,0 +1,215 @@
+package protocol
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+	"github.com/quic-go/quic-go"
+)
+
+// serveTestPings answers pings on every connection accepted by the listener until ctx is done.
+// Pings are replay-safe, so they are answered without waiting for the handshake.
+func serveTestPings(ctx context.Context, listener ProtoListener) {
+	for {
+		conn, err := listener.Accept(ctx)
+		if err != nil {
+			return
+		}
+		go answerConnPings(ctx, conn)
+		go negotiateTestDatagrams(ctx, conn)
+	}
+}
+
+// negotiateTestDatagrams negotiates datagrams on the connection once its handshake is done, so that pings sent as
+// datagrams are answered.
+func negotiateTestDatagrams(ctx context.Context, conn ProtoConn) {
+	if err := WaitForHandshake(ctx, conn); err != nil {
+		return
+	}
+	SetCapabilities(conn, SupportedCapabilities(conn))
+}
+
+// answerConnPings replies to pings on the connection until it is closed.
+func answerConnPings(ctx context.Context, conn ProtoConn) {
+	for {
+		bidi, err := conn.WaitForBidi(ctx)
+		if err != nil {
+			return
+		}
+		go func() {
+			defer func() {
+				_ = bidi.Close()
+			}()
+			msg, err := bidi.Read()
+			if err != nil || WaitUntilReplaySafe(ctx, conn, msg.Type) != nil {
+				return
+			}
+			_ = bidi.Write(pb.MsgType_MSG_TYPE_PONG, &pb.MsgPong{})
+		}()
+	}
+}
+
+// newTestEarlyListener starts a listener like the server's that answers pings, and returns its address.
+func newTestEarlyListener(t *testing.T, ctx context.Context) string {
+	t.Helper()
+	return newTestListener(t, ctx, NewQuicEarlyProtoListenerFromTransport)
+}
+
+// newTestListener starts a listener created with newListener that answers pings, and returns its address.
+func newTestListener(
+	t *testing.T,
+	ctx context.Context,
+	newListener func(trans *quic.Transport, tlsCfg *tls.Config, limits ConnLimits) (ProtoListener, error),
+) string {
+	t.Helper()
+
+	certPem, err := common.GenSelfSignedPem("test", false)
+	if err != nil {
+		t.Fatalf("failed to generate certificate: %v", err)
+	}
+	cert, err := tls.X509KeyPair(certPem, certPem)
+	if err != nil {
+		t.Fatalf("failed to load certificate: %v", err)
+	}
+
+	udpConn, err := net.ListenUDP("udp4", &net.UDPAddr{IP: net.IPv4(127, 0, 0, 1)})
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	tr := &quic.Transport{Conn: udpConn}
+	t.Cleanup(func() {
+		_ = tr.Close()
+	})
+	listener, err := newListener(tr, &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{"test"},
+	}, DefaultConnLimits)
+	if err != nil {
+		t.Fatalf("failed to create listener: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = listener.Close()
+	})
+	go serveTestPings(ctx, listener)
+
+	return udpConn.LocalAddr().String()
+}
+
+// pingTestConn pings the listener returned by newTestEarlyListener over conn.
+func pingTestConn(t *testing.T, conn ProtoConn) {
+	t.Helper()
+
+	_, err := SendAndReceiveExpect[*pb.MsgPong](conn, pb.MsgType_MSG_TYPE_PING, &pb.MsgPing{}, pb.MsgType_MSG_TYPE_PONG)
+	if err != nil {
+		t.Fatalf("failed to ping: %v", err)
+	}
+}
+
+func TestEarlyListener_ResumesWith0Rtt(t *testing.T) {
+	if !testReconnectUses0Rtt(t, NewQuicEarlyProtoListenerFromTransport) {
+		t.Fatal("reconnect should have used 0-RTT")
+	}
+}
+
+func TestListener_DoesNotAccept0Rtt(t *testing.T) {
+	if testReconnectUses0Rtt(t, NewQuicProtoListenerFromTransport) {
+		t.Fatal("reconnect should not have used 0-RTT")
+	}
+}
+
+// testReconnectUses0Rtt connects to a listener created with newListener, then reconnects, and returns whether the
+// second connection used 0-RTT.
+func testReconnectUses0Rtt(
+	t *testing.T,
+	newListener func(trans *quic.Transport, tlsCfg *tls.Config, limits ConnLimits) (ProtoListener, error),
+) bool {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	addr := newTestListener(t, ctx, newListener)
+
+	clientTlsCfg := &tls.Config{
+		InsecureSkipVerify: true,
+		NextProtos:         []string{"test"},
+		ClientSessionCache: tls.NewLRUClientSessionCache(1),
+	}
+
+	dial := func() *quic.Conn {
+		qConn, err := DialEarly(ctx, addr, clientTlsCfg, DefaultConnLimits.QuicConfig())
+		if err != nil {
+			t.Fatalf("failed to dial: %v", err)
+		}
+		pingTestConn(t, ToProtoConn(qConn))
+		if err = WaitForHandshake(ctx, ToProtoConn(qConn)); err != nil {
+			t.Fatalf("handshake failed: %v", err)
+		}
+		return qConn
+	}
+
+	first := dial()
+	if first.ConnectionState().Used0RTT {
+		t.Fatal("first connection should not have used 0-RTT")
+	}
+	// Give the session ticket, which is sent after the handshake, time to arrive.
+	time.Sleep(100 * time.Millisecond)
+	_ = first.CloseWithError(0, "")
+
+	second := dial()
+	defer func() {
+		_ = second.CloseWithError(0, "")
+	}()
+	return second.ConnectionState().Used0RTT
+}
+
+func TestIsReplaySafeMsgType(t *testing.T) {
+	t.Parallel()
+
+	if !IsReplaySafeMsgType(pb.MsgType_MSG_TYPE_VERSION) {
+		t.Error("version messages should be replay-safe")
+	}
+	for _, typ := range []pb.MsgType{
+		pb.MsgType_MSG_TYPE_AUTHENTICATE,
+		pb.MsgType_MSG_TYPE_REGISTER,
+		pb.MsgType_MSG_TYPE_JOIN_ROOM,
+	} {
+		if IsReplaySafeMsgType(typ) {
+			t.Errorf("%s should not be replay-safe", typ)
+		}
+	}
+}
+
+func TestMigrate_KeepsConnection(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	addr := newTestEarlyListener(t, ctx)
+
+	qConn, err := DialEarly(ctx, addr, &tls.Config{
+		InsecureSkipVerify: true,
+		NextProtos:         []string{"test"},
+	}, DefaultConnLimits.QuicConfig())
+	if err != nil {
+		t.Fatalf("failed to dial: %v", err)
+	}
+	defer func() {
+		_ = qConn.CloseWithError(0, "")
+	}()
+	conn := ToProtoConn(qConn)
+	pingTestConn(t, conn)
+
+	oldAddr := qConn.LocalAddr().String()
+	if err = Migrate(ctx, conn); err != nil {
+		t.Fatalf("failed to migrate: %v", err)
+	}
+	pingTestConn(t, conn)
+	if qConn.LocalAddr().String() == oldAddr {
+		t.Fatal("connection still uses its old socket after migrating")
+	}
+}
@@ -34,6 +34,17 @@ func NewUnexpectedMsgTypeError(expected pb.MsgType, actual pb.MsgType) Unexpecte
 	}
 }
 
+// UnknownMsgTypeError is returned when a message is read with a type that has no known payload.
+// This usually means the remote side speaks a newer protocol version that added the type.
+type UnknownMsgTypeError struct {
+	// The message type received.
+	Type pb.MsgType
+}
+
+func (e UnknownMsgTypeError) Error() string {
+	return fmt.Sprintf("unknown message type %s", e.Type.String())
+}
+
 // ProtoMsgError is an error returned when an error message is read from the protocol.
 type ProtoMsgError struct {
 	Msg *pb.MsgError
@@ -66,6 +77,15 @@ func (e CertMismatchError) Error() string {
 	return fmt.Sprintf("server certificate mismatch for %q", e.Host)
 }
 
+// CertFingerprintMismatchError is returned when a server's certificate does not match the fingerprint it was expected to have.
+type CertFingerprintMismatchError struct {
+	Host string
+}
+
+func (e CertFingerprintMismatchError) Error() string {
+	return fmt.Sprintf("server certificate for %q does not match the expected fingerprint", e.Host)
+}
+
 // VersionRejectedError is returned when the server rejects the client's protocol version.
 type VersionRejectedError struct {
 	Reason  pb.VersionRejectionReason
@@ -0,0 +1,99 @@
+package protocol
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+)
+
+// InviteUrlScheme is the URL scheme of invite bundle URLs.
+const InviteUrlScheme = "friendnet"
+
+// inviteUrlHost is the host part of invite bundle URLs.
+const inviteUrlHost = "invite"
+
+// ErrInvalidInviteUrl is returned when parsing a URL that is not a valid invite bundle URL.
+var ErrInvalidInviteUrl = errors.New("invalid invite URL")
+
+// InviteBundle is everything a client needs to join a room, except for the account's username and password.
+// It is shared as a URL, which can also be shown as a QR code.
+type InviteBundle struct {
+	// The server's address.
+	// It may not have a port, in which case it is resolved like any other server address.
+	Address string
+
+	// The room to join.
+	Room string
+
+	// A single-use invite code for registering an account.
+	// Empty if the bundle is for an existing account.
+	InviteCode string
+
+	// The server certificate's fingerprint, as returned by CertFingerprint.
+	// Empty if the certificate should be trusted on first use as usual.
+	CertFingerprint string
+}
+
+// CertFingerprint returns the fingerprint of a DER-encoded certificate.
+// It is the lowercase hex-encoded SHA-256 hash of the certificate.
+func CertFingerprint(der []byte) string {
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:])
+}
+
+// Url returns the bundle as a URL.
+func (b InviteBundle) Url() string {
+	query := url.Values{}
+	query.Set("address", b.Address)
+	query.Set("room", b.Room)
+	if b.InviteCode != "" {
+		query.Set("code", b.InviteCode)
+	}
+	if b.CertFingerprint != "" {
+		query.Set("fp", b.CertFingerprint)
+	}
+
+	u := url.URL{
+		Scheme:   InviteUrlScheme,
+		Host:     inviteUrlHost,
+		RawQuery: query.Encode(),
+	}
+	return u.String()
+}
+
+// ParseInviteUrl parses an invite bundle URL created by InviteBundle.Url.
+// Returns an error wrapping ErrInvalidInviteUrl if the URL is not a valid invite bundle URL.
+func ParseInviteUrl(rawUrl string) (InviteBundle, error) {
+	u, err := url.Parse(strings.TrimSpace(rawUrl))
+	if err != nil {
+		return InviteBundle{}, fmt.Errorf(`%w: %w`, ErrInvalidInviteUrl, err)
+	}
+	if u.Scheme != InviteUrlScheme || u.Host != inviteUrlHost {
+		return InviteBundle{}, fmt.Errorf(`%w: expected URL starting with %s://%s`, ErrInvalidInviteUrl, InviteUrlScheme, inviteUrlHost)
+	}
+
+	query := u.Query()
+	bundle := InviteBundle{
+		Address:         query.Get("address"),
+		Room:            query.Get("room"),
+		InviteCode:      query.Get("code"),
+		CertFingerprint: strings.ToLower(query.Get("fp")),
+	}
+
+	if bundle.Address == "" {
+		return InviteBundle{}, fmt.Errorf(`%w: missing address`, ErrInvalidInviteUrl)
+	}
+	if bundle.Room == "" {
+		return InviteBundle{}, fmt.Errorf(`%w: missing room`, ErrInvalidInviteUrl)
+	}
+	if bundle.CertFingerprint != "" {
+		if fp, hexErr := hex.DecodeString(bundle.CertFingerprint); hexErr != nil || len(fp) != sha256.Size {
+			return InviteBundle{}, fmt.Errorf(`%w: malformed certificate fingerprint`, ErrInvalidInviteUrl)
+		}
+	}
+
+	return bundle, nil
+}
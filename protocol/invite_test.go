@@ -0,0 +1,44 @@
+package protocol
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestInviteBundle_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	bundle := InviteBundle{
+		Address:         "example.com:20038",
+		Room:            "my room",
+		InviteCode:      "abc&def",
+		CertFingerprint: CertFingerprint([]byte("cert")),
+	}
+
+	parsed, err := ParseInviteUrl(bundle.Url())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if parsed != bundle {
+		t.Fatalf("expected %+v, got %+v", bundle, parsed)
+	}
+}
+
+func TestParseInviteUrl_Invalid(t *testing.T) {
+	t.Parallel()
+
+	urls := []string{
+		"https://invite?address=example.com&room=a",
+		"friendnet://other?address=example.com&room=a",
+		"friendnet://invite?room=a",
+		"friendnet://invite?address=example.com",
+		"friendnet://invite?address=example.com&room=a&fp=" + strings.Repeat("0", 10),
+	}
+
+	for _, u := range urls {
+		if _, err := ParseInviteUrl(u); !errors.Is(err, ErrInvalidInviteUrl) {
+			t.Errorf("%q: expected ErrInvalidInviteUrl, got %v", u, err)
+		}
+	}
+}
@@ -26,6 +26,22 @@ func MsgTypeToEmptyMsg(typ pb.MsgType) proto.Message {
 		return &pb.MsgVersionRejected{}
 	case pb.MsgType_MSG_TYPE_AUTHENTICATE:
 		return &pb.MsgAuthenticate{}
+	case pb.MsgType_MSG_TYPE_REGISTER:
+		return &pb.MsgRegister{}
+	case pb.MsgType_MSG_TYPE_TRANSFER_CONTROL:
+		return &pb.MsgTransferControl{}
+	case pb.MsgType_MSG_TYPE_MEASURE:
+		return &pb.MsgMeasure{}
+	case pb.MsgType_MSG_TYPE_MEASURE_REPLY:
+		return &pb.MsgMeasureReply{}
+	case pb.MsgType_MSG_TYPE_SHARES_REVISION:
+		return &pb.MsgSharesRevision{}
+	case pb.MsgType_MSG_TYPE_ROOM_SCOPE:
+		return &pb.MsgRoomScope{}
+	case pb.MsgType_MSG_TYPE_JOIN_ROOM:
+		return &pb.MsgJoinRoom{}
+	case pb.MsgType_MSG_TYPE_LEAVE_ROOM:
+		return &pb.MsgLeaveRoom{}
 	case pb.MsgType_MSG_TYPE_AUTH_ACCEPTED:
 		return &pb.MsgAuthAccepted{}
 	case pb.MsgType_MSG_TYPE_AUTH_REJECTED:
@@ -86,12 +102,26 @@ func MsgTypeToEmptyMsg(typ pb.MsgType) proto.Message {
 		return &pb.MsgClientOnline{}
 	case pb.MsgType_MSG_TYPE_CLIENT_OFFLINE:
 		return &pb.MsgClientOffline{}
+	case pb.MsgType_MSG_TYPE_SERVER_NOTICE:
+		return &pb.MsgServerNotice{}
+	case pb.MsgType_MSG_TYPE_GET_SERVER_INFO:
+		return &pb.MsgGetServerInfo{}
+	case pb.MsgType_MSG_TYPE_SERVER_INFO:
+		return &pb.MsgServerInfo{}
+	case pb.MsgType_MSG_TYPE_SET_INTERESTS:
+		return &pb.MsgSetInterests{}
+	case pb.MsgType_MSG_TYPE_GET_SIMILAR_USERS:
+		return &pb.MsgGetSimilarUsers{}
+	case pb.MsgType_MSG_TYPE_SIMILAR_USERS:
+		return &pb.MsgSimilarUsers{}
 	case pb.MsgType_MSG_TYPE_SEARCH:
 		return &pb.MsgSearch{}
 	case pb.MsgType_MSG_TYPE_SEARCH_RESULT:
 		return &pb.MsgSearchResult{}
 	case pb.MsgType_MSG_TYPE_SEARCH_ROOM_RESULT:
 		return &pb.MsgSearchRoomResult{}
+	case pb.MsgType_MSG_TYPE_DOWNLOAD_STATUS_UPDATE:
+		return &pb.MsgDownloadStatusUpdate{}
 	default:
 		return nil
 	}
@@ -0,0 +1,390 @@
+package protocol
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+	"github.com/quic-go/quic-go"
+)
+
+// memNetAlpn is the ALPN protocol used by MemNetwork.ConnPair.
+const memNetAlpn = "friendnet-mem"
+
+// memPacketQueueSize is the number of packets each MemNetwork endpoint queues before dropping new ones.
+const memPacketQueueSize = 1024
+
+// MemAddr is the address of an endpoint on a MemNetwork.
+type MemAddr string
+
+func (a MemAddr) Network() string {
+	return "mem"
+}
+
+func (a MemAddr) String() string {
+	return string(a)
+}
+
+// MemNetwork is an in-memory packet network that QUIC connections can run over without any sockets.
+// It is meant for tests, which can use it to set up real protocol connections faster than over loopback UDP, and in
+// environments where binding UDP sockets is not allowed.
+//
+// Packets between endpoints are delivered in order and never lost, except when the receiving endpoint's queue is
+// full, in which case they are dropped like UDP would, and QUIC retransmits them.
+// It is safe for concurrent use.
+type MemNetwork struct {
+	mu        sync.Mutex
+	isClosed  bool
+	endpoints map[MemAddr]*memPacketConn
+	nextPort  int
+}
+
+// NewMemNetwork creates a new, empty MemNetwork.
+func NewMemNetwork() *MemNetwork {
+	return &MemNetwork{
+		endpoints: make(map[MemAddr]*memPacketConn),
+	}
+}
+
+// ListenPacket creates a new endpoint on the network with a unique address.
+// It can be used as the connection of a quic.Transport.
+func (n *MemNetwork) ListenPacket() (net.PacketConn, error) {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.isClosed {
+		return nil, net.ErrClosed
+	}
+
+	n.nextPort++
+	conn := &memPacketConn{
+		network:         n,
+		addr:            MemAddr("mem:" + strconv.Itoa(n.nextPort)),
+		packets:         make(chan memPacket, memPacketQueueSize),
+		closed:          make(chan struct{}),
+		deadlineChanged: make(chan struct{}),
+	}
+	n.endpoints[conn.addr] = conn
+	return conn, nil
+}
+
+// Listen creates a ProtoListener on a new endpoint of the network.
+// Like NewQuicProtoListener, it returns connections once their handshake completes.
+// The listener's address can be read with Addr, and the endpoint is closed along with the listener.
+func (n *MemNetwork) Listen(tlsCfg *tls.Config, limits ConnLimits) (*QuicProtoListener, error) {
+	packetConn, err := n.ListenPacket()
+	if err != nil {
+		return nil, err
+	}
+
+	trans := &quic.Transport{Conn: packetConn}
+	listener, err := NewQuicProtoListenerFromTransport(trans, tlsCfg, limits)
+	if err != nil {
+		_ = packetConn.Close()
+		return nil, err
+	}
+
+	quicListener := listener.(*QuicProtoListener)
+	quicListener.setOwnedTransport(trans, packetConn)
+	return quicListener, nil
+}
+
+// NewDialer creates a ProtoDialer that dials addresses on the network from a new endpoint.
+// The endpoint is closed along with the dialer.
+func (n *MemNetwork) NewDialer(tlsCfg *tls.Config) (ProtoDialer, error) {
+	if tlsCfg == nil {
+		panic("called MemNetwork.NewDialer with nil tlsCfg")
+	}
+
+	packetConn, err := n.ListenPacket()
+	if err != nil {
+		return nil, err
+	}
+
+	return &memProtoDialer{
+		tlsCfg:     tlsCfg,
+		tr:         &quic.Transport{Conn: packetConn},
+		packetConn: packetConn,
+	}, nil
+}
+
+// ConnPair connects two new endpoints of the network with a self-signed certificate and returns both ends.
+// local is the end that dialed and remote is the end that accepted.
+// The connections stay usable until they are closed, the network is closed or ctx is done, at which point both
+// endpoints are closed too. Tests can therefore call NewMemNetwork().ConnPair(ctx) without closing anything else.
+func (n *MemNetwork) ConnPair(ctx context.Context) (local ProtoConn, remote ProtoConn, err error) {
+	certPem, err := common.GenSelfSignedPem("mem", false)
+	if err != nil {
+		return nil, nil, fmt.Errorf(`failed to generate certificate: %w`, err)
+	}
+	cert, err := tls.X509KeyPair(certPem, certPem)
+	if err != nil {
+		return nil, nil, fmt.Errorf(`failed to load certificate: %w`, err)
+	}
+
+	listener, err := n.Listen(&tls.Config{
+		Certificates: []tls.Certificate{cert},
+		NextProtos:   []string{memNetAlpn},
+	}, DefaultConnLimits)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	dialer, err := n.NewDialer(&tls.Config{
+		InsecureSkipVerify: true,
+		NextProtos:         []string{memNetAlpn},
+	})
+	if err != nil {
+		_ = listener.Close()
+		return nil, nil, err
+	}
+
+	// The listener and dialer are not closed here, since that would close the connections made with them.
+	// They are closed along with the network, or once ctx is done.
+	accepted := make(chan ProtoConn, 1)
+	go func() {
+		conn, acceptErr := listener.Accept(ctx)
+		if acceptErr != nil {
+			close(accepted)
+			return
+		}
+		accepted <- conn
+	}()
+
+	local, err = dialer.Dial(ctx, listener.Addr().String())
+	if err != nil {
+		_ = listener.Close()
+		_ = dialer.Close()
+		return nil, nil, err
+	}
+
+	remote, ok := <-accepted
+	if !ok {
+		_ = local.CloseWithCode(CloseCodeNormal, "")
+		_ = listener.Close()
+		_ = dialer.Close()
+		return nil, nil, fmt.Errorf(`failed to accept connection: %w`, ctx.Err())
+	}
+
+	context.AfterFunc(ctx, func() {
+		_ = listener.Close()
+		_ = dialer.Close()
+	})
+
+	return local, remote, nil
+}
+
+// BidiPair is like ConnPair, but also opens a bidi from local to remote and returns both of its ends.
+// The bidi is opened with MSG_TYPE_PING, which remote has already read.
+// Like the connection it runs over, the bidi is closed once ctx is done.
+func (n *MemNetwork) BidiPair(ctx context.Context) (local ProtoBidi, remote ProtoBidi, err error) {
+	localConn, remoteConn, err := n.ConnPair(ctx)
+	if err != nil {
+		return ProtoBidi{}, ProtoBidi{}, err
+	}
+
+	// Streams are only announced once something is sent on them.
+	local, err = localConn.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_PING, &pb.MsgPing{})
+	if err != nil {
+		return ProtoBidi{}, ProtoBidi{}, fmt.Errorf(`failed to open bidi: %w`, err)
+	}
+	remote, err = remoteConn.WaitForBidi(ctx)
+	if err != nil {
+		return ProtoBidi{}, ProtoBidi{}, fmt.Errorf(`failed to accept bidi: %w`, err)
+	}
+	if _, err = remote.Read(); err != nil {
+		return ProtoBidi{}, ProtoBidi{}, fmt.Errorf(`failed to read first message: %w`, err)
+	}
+
+	return local, remote, nil
+}
+
+// Close closes every endpoint on the network, which breaks all connections made over it.
+// Later calls to ListenPacket fail.
+func (n *MemNetwork) Close() error {
+	n.mu.Lock()
+	n.isClosed = true
+	endpoints := make([]*memPacketConn, 0, len(n.endpoints))
+	for _, conn := range n.endpoints {
+		endpoints = append(endpoints, conn)
+	}
+	n.mu.Unlock()
+
+	for _, conn := range endpoints {
+		_ = conn.Close()
+	}
+	return nil
+}
+
+// deliver queues a packet for the endpoint at addr.
+// It drops the packet if there is no such endpoint or its queue is full.
+func (n *MemNetwork) deliver(from MemAddr, to MemAddr, data []byte) {
+	n.mu.Lock()
+	conn, has := n.endpoints[to]
+	n.mu.Unlock()
+	if !has {
+		return
+	}
+
+	select {
+	case conn.packets <- memPacket{from: from, data: data}:
+	default:
+	}
+}
+
+func (n *MemNetwork) remove(addr MemAddr) {
+	n.mu.Lock()
+	delete(n.endpoints, addr)
+	n.mu.Unlock()
+}
+
+// memProtoDialer is a ProtoDialer over a MemNetwork.
+type memProtoDialer struct {
+	tlsCfg     *tls.Config
+	tr         *quic.Transport
+	packetConn net.PacketConn
+}
+
+var _ ProtoDialer = (*memProtoDialer)(nil)
+
+func (d *memProtoDialer) Close() error {
+	err := d.tr.Close()
+	_ = d.packetConn.Close()
+	return err
+}
+
+func (d *memProtoDialer) Dial(ctx context.Context, addr string) (ProtoConn, error) {
+	conn, err := d.tr.Dial(ctx, MemAddr(addr), d.tlsCfg, DefaultConnLimits.QuicConfig())
+	if err != nil {
+		return nil, err
+	}
+	return ToProtoConn(conn), nil
+}
+
+type memPacket struct {
+	from MemAddr
+	data []byte
+}
+
+// memPacketConn is an endpoint on a MemNetwork.
+type memPacketConn struct {
+	network *MemNetwork
+	addr    MemAddr
+
+	packets   chan memPacket
+	closed    chan struct{}
+	closeOnce sync.Once
+
+	mu           sync.Mutex
+	readDeadline time.Time
+
+	// Closed and replaced whenever readDeadline changes, to wake up blocked reads.
+	deadlineChanged chan struct{}
+}
+
+var _ net.PacketConn = (*memPacketConn)(nil)
+
+func (c *memPacketConn) ReadFrom(p []byte) (int, net.Addr, error) {
+	for {
+		select {
+		case <-c.closed:
+			return 0, nil, net.ErrClosed
+		default:
+		}
+
+		c.mu.Lock()
+		deadline := c.readDeadline
+		deadlineChanged := c.deadlineChanged
+		c.mu.Unlock()
+
+		var timer *time.Timer
+		var timeout <-chan time.Time
+		if !deadline.IsZero() {
+			wait := time.Until(deadline)
+			if wait <= 0 {
+				return 0, nil, os.ErrDeadlineExceeded
+			}
+			timer = time.NewTimer(wait)
+			timeout = timer.C
+		}
+
+		select {
+		case packet := <-c.packets:
+			return copy(p, packet.data), packet.from, nil
+		case <-c.closed:
+			return 0, nil, net.ErrClosed
+		case <-timeout:
+			return 0, nil, os.ErrDeadlineExceeded
+		case <-deadlineChanged:
+			if timer != nil {
+				timer.Stop()
+			}
+		}
+	}
+}
+
+func (c *memPacketConn) WriteTo(p []byte, addr net.Addr) (int, error) {
+	select {
+	case <-c.closed:
+		return 0, net.ErrClosed
+	default:
+	}
+
+	to, ok := addr.(MemAddr)
+	if !ok {
+		return 0, fmt.Errorf(`cannot send to non-mem address %q`, addr.String())
+	}
+
+	// The caller may reuse p once this returns.
+	c.network.deliver(c.addr, to, append([]byte(nil), p...))
+	return len(p), nil
+}
+
+func (c *memPacketConn) Close() error {
+	c.closeOnce.Do(func() {
+		close(c.closed)
+		c.network.remove(c.addr)
+	})
+	return nil
+}
+
+func (c *memPacketConn) LocalAddr() net.Addr {
+	return c.addr
+}
+
+func (c *memPacketConn) SetDeadline(t time.Time) error {
+	return c.SetReadDeadline(t)
+}
+
+func (c *memPacketConn) SetReadDeadline(t time.Time) error {
+	c.mu.Lock()
+	c.readDeadline = t
+	close(c.deadlineChanged)
+	c.deadlineChanged = make(chan struct{})
+	c.mu.Unlock()
+	return nil
+}
+
+// SetWriteDeadline is no-op, since writes never block.
+func (c *memPacketConn) SetWriteDeadline(time.Time) error {
+	return nil
+}
+
+// SetReadBuffer is no-op.
+// It keeps quic-go from warning that the connection's buffer sizes cannot be increased.
+func (c *memPacketConn) SetReadBuffer(int) error {
+	return nil
+}
+
+// SetWriteBuffer is no-op.
+// See SetReadBuffer.
+func (c *memPacketConn) SetWriteBuffer(int) error {
+	return nil
+}
@@ -0,0 +1,101 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"net"
+	"os"
+	"testing"
+	"time"
+
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+func TestMemNetwork_ConnPair(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	network := NewMemNetwork()
+	defer func() {
+		_ = network.Close()
+	}()
+
+	local, remote, err := network.ConnPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	if _, ok := remote.RemoteAddr().(MemAddr); !ok {
+		t.Fatalf("expected a MemAddr, got %T", remote.RemoteAddr())
+	}
+
+	// Both sides can open streams.
+	for _, pair := range [][2]ProtoConn{{local, remote}, {remote, local}} {
+		go answerConnPings(ctx, pair[1])
+		if _, err = SendAndReceiveExpect[*pb.MsgPong](pair[0], pb.MsgType_MSG_TYPE_PING, &pb.MsgPing{}, pb.MsgType_MSG_TYPE_PONG); err != nil {
+			t.Fatalf("failed to ping: %v", err)
+		}
+	}
+
+	// Closing the network breaks the connections.
+	_ = network.Close()
+	if _, err = remote.WaitForBidi(ctx); err == nil {
+		t.Fatal("expected the connection to break once the network was closed")
+	}
+	if _, err = network.ListenPacket(); !errors.Is(err, net.ErrClosed) {
+		t.Fatalf("expected net.ErrClosed from a closed network, got %v", err)
+	}
+}
+
+func TestMemNetwork_ReadDeadline(t *testing.T) {
+	t.Parallel()
+
+	network := NewMemNetwork()
+	defer func() {
+		_ = network.Close()
+	}()
+
+	a, err := network.ListenPacket()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, err := network.ListenPacket()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = a.WriteTo([]byte("hello"), b.LocalAddr()); err != nil {
+		t.Fatalf("failed to write: %v", err)
+	}
+	buf := make([]byte, 16)
+	n, from, err := b.ReadFrom(buf)
+	if err != nil {
+		t.Fatalf("failed to read: %v", err)
+	}
+	if string(buf[:n]) != "hello" || from != a.LocalAddr() {
+		t.Fatalf("expected hello from %s, got %q from %s", a.LocalAddr(), buf[:n], from)
+	}
+
+	// A blocked read is woken up by a deadline set after it started.
+	readErr := make(chan error, 1)
+	go func() {
+		_, _, err := b.ReadFrom(buf)
+		readErr <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+	_ = b.SetReadDeadline(time.Now())
+	select {
+	case err = <-readErr:
+		if !errors.Is(err, os.ErrDeadlineExceeded) {
+			t.Fatalf("expected os.ErrDeadlineExceeded, got %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("read was not woken up by the deadline")
+	}
+
+	_ = b.Close()
+	if _, _, err = b.ReadFrom(buf); !errors.Is(err, net.ErrClosed) {
+		t.Fatalf("expected net.ErrClosed after closing, got %v", err)
+	}
+}
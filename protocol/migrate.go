@@ -0,0 +1,107 @@
+package protocol
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net"
+
+	"github.com/quic-go/quic-go"
+)
+
+// migratableConnIdLength is the length of connection IDs used by connections dialed with DialEarly.
+// Connections dialed with quic.DialAddr use zero-length connection IDs, which cannot be migrated.
+const migratableConnIdLength = 8
+
+// ErrCannotMigrate is returned by Migrate for connections that are not backed by a QUIC connection dialed by this side.
+var ErrCannotMigrate = errors.New("connection cannot be migrated")
+
+// Migrate moves the QUIC connection underlying conn to a new local UDP socket, keeping its streams open.
+// It is meant for clients whose network changed, such as from Wi-Fi to LTE, in a way that stopped the old socket from
+// reaching the server. The new path is probed first, and the connection is only switched to it once the server answers
+// on it.
+//
+// Servers follow clients that migrate, or whose address changes because of NAT rebinding, without anything needing to
+// be done on their side.
+//
+// The socket the connection migrated away from stays open until the connection closes, since quic-go closes a
+// connection along with any transport it used. Callers that migrate often should reconnect now and then instead.
+//
+// Returns ErrCannotMigrate if the connection was not dialed by this side with DialEarly or the server disabled migration.
+func Migrate(ctx context.Context, conn ProtoConn) error {
+	qConn, ok := quicConnOf(conn)
+	if !ok {
+		return ErrCannotMigrate
+	}
+
+	tr, err := newMigratableTransport()
+	if err != nil {
+		return fmt.Errorf("failed to open socket to migrate to: %w", err)
+	}
+
+	path, err := qConn.AddPath(tr)
+	if err != nil {
+		_ = tr.Close()
+		return fmt.Errorf("%w: %w", ErrCannotMigrate, err)
+	}
+
+	err = path.Probe(ctx)
+	if err == nil {
+		err = path.Switch()
+	}
+	if err != nil {
+		_ = path.Close()
+		_ = tr.Close()
+		return fmt.Errorf("failed to migrate connection: %w", err)
+	}
+
+	// The transport now carries the connection, so it can only be closed once the connection is.
+	go func() {
+		<-qConn.Context().Done()
+		_ = tr.Close()
+	}()
+
+	return nil
+}
+
+// newMigratableTransport returns a QUIC transport on a new UDP socket that uses connection IDs that can be migrated.
+func newMigratableTransport() (*quic.Transport, error) {
+	udpConn, err := net.ListenUDP("udp", &net.UDPAddr{IP: net.IPv4zero, Port: 0})
+	if err != nil {
+		return nil, err
+	}
+
+	return &quic.Transport{
+		Conn:               udpConn,
+		ConnectionIDLength: migratableConnIdLength,
+	}, nil
+}
+
+// DialEarly is like quic.DialAddrEarly, but the connection can be migrated to another network path with Migrate.
+// Like quic.DialAddrEarly, the connection may be returned before its handshake completes, so it can send 0-RTT data.
+// Its socket is closed once the connection is.
+func DialEarly(ctx context.Context, addr string, tlsCfg *tls.Config, quicCfg *quic.Config) (*quic.Conn, error) {
+	udpAddr, err := net.ResolveUDPAddr("udp", addr)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to resolve address %q: %w`, addr, err)
+	}
+
+	tr, err := newMigratableTransport()
+	if err != nil {
+		return nil, err
+	}
+
+	conn, err := tr.DialEarly(ctx, udpAddr, tlsCfg, quicCfg)
+	if err != nil {
+		_ = tr.Close()
+		return nil, err
+	}
+
+	go func() {
+		<-conn.Context().Done()
+		_ = tr.Close()
+	}()
+
+	return conn, nil
+}
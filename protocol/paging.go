@@ -0,0 +1,84 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+	"time"
+
+	pb "friendnet.org/protocol/pb/v1"
+	"github.com/quic-go/quic-go"
+	"google.golang.org/protobuf/proto"
+)
+
+// DefaultPageSize is the number of items sent per page of a paginated reply, such as MSG_TYPE_DIR_FILES or
+// MSG_TYPE_ONLINE_USERS, when the requester does not ask for a page size.
+const DefaultPageSize = 50
+
+// MaxPageSize is the largest page size a requester can ask for.
+// Larger requests are clamped to it, so that a single page stays a reasonable size to encode and buffer.
+const MaxPageSize = 500
+
+// PageWriteTimeout is how long writing a single page may wait for the receiver to make room for it.
+// A receiver that stops reading for longer has the reply canceled instead of keeping the sender's pages around.
+const PageWriteTimeout = 30 * time.Second
+
+// SlowReceiverStreamErrorCode is the code used to reset a bidi whose receiver stopped reading a paginated reply for
+// longer than PageWriteTimeout.
+const SlowReceiverStreamErrorCode quic.StreamErrorCode = 104
+
+// pageWriteTimeout is PageWriteTimeout, as a variable so tests can shorten it.
+var pageWriteTimeout = PageWriteTimeout
+
+// PageSize returns the page size to use for a requested page size.
+// A requested size of 0 means DefaultPageSize, and sizes above MaxPageSize are clamped to it.
+func PageSize(requested uint32) int {
+	if requested == 0 {
+		return DefaultPageSize
+	}
+	return int(min(requested, MaxPageSize))
+}
+
+// WritePages writes items to bidi in pages of at most pageSize items, wrapping each page with newPage.
+// Nothing is written if items is empty.
+//
+// Pages are written one at a time, and each write only returns once the stream has taken the page, which is bounded
+// by the receiver's flow control window, so a slow receiver slows the sender down rather than having it encode and
+// buffer every page up front.
+// If a page cannot be written within PageWriteTimeout, the stream is canceled with SlowReceiverStreamErrorCode.
+// Writing stops early if ctx is done.
+func WritePages[T any](
+	ctx context.Context,
+	bidi ProtoBidi,
+	typ pb.MsgType,
+	items []T,
+	pageSize int,
+	newPage func(page []T) proto.Message,
+) error {
+	if pageSize <= 0 {
+		pageSize = DefaultPageSize
+	}
+
+	defer func() {
+		_ = bidi.Stream.SetWriteDeadline(time.Time{})
+	}()
+
+	for page := range slices.Chunk(items, pageSize) {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		_ = bidi.Stream.SetWriteDeadline(time.Now().Add(pageWriteTimeout))
+		if err := bidi.Write(typ, newPage(page)); err != nil {
+			if errors.Is(err, os.ErrDeadlineExceeded) {
+				bidi.Cancel(SlowReceiverStreamErrorCode)
+				return fmt.Errorf(`receiver did not make room for page within %s: %w`, pageWriteTimeout, err)
+			}
+			return err
+		}
+	}
+
+	return nil
+}
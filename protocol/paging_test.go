@@ -0,0 +1,146 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"io"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	pb "friendnet.org/protocol/pb/v1"
+	"github.com/quic-go/quic-go"
+	"google.golang.org/protobuf/proto"
+)
+
+func newDirFilesPage(page []*pb.MsgFileMeta) proto.Message {
+	return &pb.MsgDirFiles{Files: page}
+}
+
+func TestPageSize(t *testing.T) {
+	cases := map[uint32]int{
+		0:               DefaultPageSize,
+		1:               1,
+		120:             120,
+		MaxPageSize:     MaxPageSize,
+		MaxPageSize + 1: MaxPageSize,
+		1 << 31:         MaxPageSize,
+	}
+	for requested, want := range cases {
+		if got := PageSize(requested); got != want {
+			t.Errorf("PageSize(%d) = %d, want %d", requested, got, want)
+		}
+	}
+}
+
+func TestWritePages(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// The receiver requests the pages, so the sender is the end that accepted the bidi.
+	receiver, sender, err := NewMemNetwork().BidiPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to open bidi: %v", err)
+	}
+
+	files := make([]*pb.MsgFileMeta, 25)
+	for i := range files {
+		files[i] = &pb.MsgFileMeta{Name: strings.Repeat("a", i+1)}
+	}
+
+	go func() {
+		_ = WritePages(ctx, sender, pb.MsgType_MSG_TYPE_DIR_FILES, files, 10, newDirFilesPage)
+		_ = sender.Close()
+	}()
+
+	var sizes []int
+	var names []string
+	stream := NewTypedMsgStream[*pb.MsgDirFiles](receiver, pb.MsgType_MSG_TYPE_DIR_FILES)
+	for msg, err := range All(ctx, stream) {
+		if err != nil {
+			t.Fatalf("failed to read page: %v", err)
+		}
+		sizes = append(sizes, len(msg.Payload.Files))
+		for _, f := range msg.Payload.Files {
+			names = append(names, f.Name)
+		}
+	}
+
+	if len(sizes) != 3 || sizes[0] != 10 || sizes[1] != 10 || sizes[2] != 5 {
+		t.Fatalf("expected pages of 10, 10 and 5 files, got %v", sizes)
+	}
+	for i, name := range names {
+		if name != files[i].Name {
+			t.Fatalf("expected file %d to be %q, got %q", i, files[i].Name, name)
+		}
+	}
+}
+
+func TestWritePages_SlowReceiver(t *testing.T) {
+	prevTimeout := pageWriteTimeout
+	pageWriteTimeout = 200 * time.Millisecond
+	t.Cleanup(func() {
+		pageWriteTimeout = prevTimeout
+	})
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	receiver, sender, err := NewMemNetwork().BidiPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to open bidi: %v", err)
+	}
+
+	// Far more than fits in the receiver's flow control window, which it never reads from.
+	name := strings.Repeat("a", 1024)
+	files := make([]*pb.MsgFileMeta, 16*1024)
+	for i := range files {
+		files[i] = &pb.MsgFileMeta{Name: name}
+	}
+
+	start := time.Now()
+	err = WritePages(ctx, sender, pb.MsgType_MSG_TYPE_DIR_FILES, files, 100, newDirFilesPage)
+	if !errors.Is(err, os.ErrDeadlineExceeded) {
+		t.Fatalf("expected the write to time out, got %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the write to give up soon after the timeout, took %s", elapsed)
+	}
+
+	// The receiver finds out once it reads what was buffered.
+	for {
+		_, err = receiver.Read()
+		if err != nil {
+			break
+		}
+	}
+	var streamErr *quic.StreamError
+	if !errors.As(err, &streamErr) || streamErr.ErrorCode != SlowReceiverStreamErrorCode {
+		t.Fatalf("expected the stream to be reset with SlowReceiverStreamErrorCode, got %v", err)
+	}
+}
+
+func TestWritePages_Canceled(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	receiver, sender, err := NewMemNetwork().BidiPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to open bidi: %v", err)
+	}
+
+	canceledCtx, cancelWrite := context.WithCancel(ctx)
+	cancelWrite()
+
+	files := []*pb.MsgFileMeta{{Name: "a"}}
+	err = WritePages(canceledCtx, sender, pb.MsgType_MSG_TYPE_DIR_FILES, files, 10, newDirFilesPage)
+	if !errors.Is(err, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", err)
+	}
+
+	_ = sender.Close()
+	if _, err = receiver.Read(); !errors.Is(err, io.EOF) {
+		t.Fatalf("expected no pages to be written, got %v", err)
+	}
+}
@@ -44,12 +44,45 @@ const (
 	// ClientRpcServiceGetClientInfoProcedure is the fully-qualified name of the ClientRpcService's
 	// GetClientInfo RPC.
 	ClientRpcServiceGetClientInfoProcedure = "/pb.clientrpc.v1.ClientRpcService/GetClientInfo"
+	// ClientRpcServiceGetMemoryUsageProcedure is the fully-qualified name of the ClientRpcService's
+	// GetMemoryUsage RPC.
+	ClientRpcServiceGetMemoryUsageProcedure = "/pb.clientrpc.v1.ClientRpcService/GetMemoryUsage"
+	// ClientRpcServiceSetLowMemoryModeProcedure is the fully-qualified name of the ClientRpcService's
+	// SetLowMemoryMode RPC.
+	ClientRpcServiceSetLowMemoryModeProcedure = "/pb.clientrpc.v1.ClientRpcService/SetLowMemoryMode"
 	// ClientRpcServiceGetServersProcedure is the fully-qualified name of the ClientRpcService's
 	// GetServers RPC.
 	ClientRpcServiceGetServersProcedure = "/pb.clientrpc.v1.ClientRpcService/GetServers"
 	// ClientRpcServiceCreateServerProcedure is the fully-qualified name of the ClientRpcService's
 	// CreateServer RPC.
 	ClientRpcServiceCreateServerProcedure = "/pb.clientrpc.v1.ClientRpcService/CreateServer"
+	// ClientRpcServiceImportInviteBundleProcedure is the fully-qualified name of the ClientRpcService's
+	// ImportInviteBundle RPC.
+	ClientRpcServiceImportInviteBundleProcedure = "/pb.clientrpc.v1.ClientRpcService/ImportInviteBundle"
+	// ClientRpcServiceCreateAccountTemplateProcedure is the fully-qualified name of the
+	// ClientRpcService's CreateAccountTemplate RPC.
+	ClientRpcServiceCreateAccountTemplateProcedure = "/pb.clientrpc.v1.ClientRpcService/CreateAccountTemplate"
+	// ClientRpcServiceCreateAccountTemplateFromServerProcedure is the fully-qualified name of the
+	// ClientRpcService's CreateAccountTemplateFromServer RPC.
+	ClientRpcServiceCreateAccountTemplateFromServerProcedure = "/pb.clientrpc.v1.ClientRpcService/CreateAccountTemplateFromServer"
+	// ClientRpcServiceGetAccountTemplatesProcedure is the fully-qualified name of the
+	// ClientRpcService's GetAccountTemplates RPC.
+	ClientRpcServiceGetAccountTemplatesProcedure = "/pb.clientrpc.v1.ClientRpcService/GetAccountTemplates"
+	// ClientRpcServiceDeleteAccountTemplateProcedure is the fully-qualified name of the
+	// ClientRpcService's DeleteAccountTemplate RPC.
+	ClientRpcServiceDeleteAccountTemplateProcedure = "/pb.clientrpc.v1.ClientRpcService/DeleteAccountTemplate"
+	// ClientRpcServiceStartHostingProcedure is the fully-qualified name of the ClientRpcService's
+	// StartHosting RPC.
+	ClientRpcServiceStartHostingProcedure = "/pb.clientrpc.v1.ClientRpcService/StartHosting"
+	// ClientRpcServiceStopHostingProcedure is the fully-qualified name of the ClientRpcService's
+	// StopHosting RPC.
+	ClientRpcServiceStopHostingProcedure = "/pb.clientrpc.v1.ClientRpcService/StopHosting"
+	// ClientRpcServiceGetHostingInfoProcedure is the fully-qualified name of the ClientRpcService's
+	// GetHostingInfo RPC.
+	ClientRpcServiceGetHostingInfoProcedure = "/pb.clientrpc.v1.ClientRpcService/GetHostingInfo"
+	// ClientRpcServiceCreateHostingInviteProcedure is the fully-qualified name of the
+	// ClientRpcService's CreateHostingInvite RPC.
+	ClientRpcServiceCreateHostingInviteProcedure = "/pb.clientrpc.v1.ClientRpcService/CreateHostingInvite"
 	// ClientRpcServiceDeleteServerProcedure is the fully-qualified name of the ClientRpcService's
 	// DeleteServer RPC.
 	ClientRpcServiceDeleteServerProcedure = "/pb.clientrpc.v1.ClientRpcService/DeleteServer"
@@ -71,12 +104,42 @@ const (
 	// ClientRpcServiceDeleteShareProcedure is the fully-qualified name of the ClientRpcService's
 	// DeleteShare RPC.
 	ClientRpcServiceDeleteShareProcedure = "/pb.clientrpc.v1.ClientRpcService/DeleteShare"
+	// ClientRpcServiceSetShareExcludePatternsProcedure is the fully-qualified name of the
+	// ClientRpcService's SetShareExcludePatterns RPC.
+	ClientRpcServiceSetShareExcludePatternsProcedure = "/pb.clientrpc.v1.ClientRpcService/SetShareExcludePatterns"
+	// ClientRpcServiceCheckShareHealthProcedure is the fully-qualified name of the ClientRpcService's
+	// CheckShareHealth RPC.
+	ClientRpcServiceCheckShareHealthProcedure = "/pb.clientrpc.v1.ClientRpcService/CheckShareHealth"
+	// ClientRpcServiceImportSharesProcedure is the fully-qualified name of the ClientRpcService's
+	// ImportShares RPC.
+	ClientRpcServiceImportSharesProcedure = "/pb.clientrpc.v1.ClientRpcService/ImportShares"
+	// ClientRpcServiceCreateShareLinkProcedure is the fully-qualified name of the ClientRpcService's
+	// CreateShareLink RPC.
+	ClientRpcServiceCreateShareLinkProcedure = "/pb.clientrpc.v1.ClientRpcService/CreateShareLink"
+	// ClientRpcServiceGetShareLinksProcedure is the fully-qualified name of the ClientRpcService's
+	// GetShareLinks RPC.
+	ClientRpcServiceGetShareLinksProcedure = "/pb.clientrpc.v1.ClientRpcService/GetShareLinks"
+	// ClientRpcServiceDeleteShareLinkProcedure is the fully-qualified name of the ClientRpcService's
+	// DeleteShareLink RPC.
+	ClientRpcServiceDeleteShareLinkProcedure = "/pb.clientrpc.v1.ClientRpcService/DeleteShareLink"
 	// ClientRpcServiceGetDirFilesProcedure is the fully-qualified name of the ClientRpcService's
 	// GetDirFiles RPC.
 	ClientRpcServiceGetDirFilesProcedure = "/pb.clientrpc.v1.ClientRpcService/GetDirFiles"
+	// ClientRpcServiceStreamDirArchiveProcedure is the fully-qualified name of the ClientRpcService's
+	// StreamDirArchive RPC.
+	ClientRpcServiceStreamDirArchiveProcedure = "/pb.clientrpc.v1.ClientRpcService/StreamDirArchive"
 	// ClientRpcServiceGetFileMetaProcedure is the fully-qualified name of the ClientRpcService's
 	// GetFileMeta RPC.
 	ClientRpcServiceGetFileMetaProcedure = "/pb.clientrpc.v1.ClientRpcService/GetFileMeta"
+	// ClientRpcServiceCreateFileLinkProcedure is the fully-qualified name of the ClientRpcService's
+	// CreateFileLink RPC.
+	ClientRpcServiceCreateFileLinkProcedure = "/pb.clientrpc.v1.ClientRpcService/CreateFileLink"
+	// ClientRpcServiceMeasurePeerProcedure is the fully-qualified name of the ClientRpcService's
+	// MeasurePeer RPC.
+	ClientRpcServiceMeasurePeerProcedure = "/pb.clientrpc.v1.ClientRpcService/MeasurePeer"
+	// ClientRpcServiceDiagnoseProcedure is the fully-qualified name of the ClientRpcService's Diagnose
+	// RPC.
+	ClientRpcServiceDiagnoseProcedure = "/pb.clientrpc.v1.ClientRpcService/Diagnose"
 	// ClientRpcServiceGetOnlineUsersProcedure is the fully-qualified name of the ClientRpcService's
 	// GetOnlineUsers RPC.
 	ClientRpcServiceGetOnlineUsersProcedure = "/pb.clientrpc.v1.ClientRpcService/GetOnlineUsers"
@@ -101,6 +164,24 @@ const (
 	// ClientRpcServiceUpdateTransferSettingsProcedure is the fully-qualified name of the
 	// ClientRpcService's UpdateTransferSettings RPC.
 	ClientRpcServiceUpdateTransferSettingsProcedure = "/pb.clientrpc.v1.ClientRpcService/UpdateTransferSettings"
+	// ClientRpcServiceGetNotificationSettingsProcedure is the fully-qualified name of the
+	// ClientRpcService's GetNotificationSettings RPC.
+	ClientRpcServiceGetNotificationSettingsProcedure = "/pb.clientrpc.v1.ClientRpcService/GetNotificationSettings"
+	// ClientRpcServiceUpdateNotificationSettingsProcedure is the fully-qualified name of the
+	// ClientRpcService's UpdateNotificationSettings RPC.
+	ClientRpcServiceUpdateNotificationSettingsProcedure = "/pb.clientrpc.v1.ClientRpcService/UpdateNotificationSettings"
+	// ClientRpcServiceExportConfigProcedure is the fully-qualified name of the ClientRpcService's
+	// ExportConfig RPC.
+	ClientRpcServiceExportConfigProcedure = "/pb.clientrpc.v1.ClientRpcService/ExportConfig"
+	// ClientRpcServiceImportConfigProcedure is the fully-qualified name of the ClientRpcService's
+	// ImportConfig RPC.
+	ClientRpcServiceImportConfigProcedure = "/pb.clientrpc.v1.ClientRpcService/ImportConfig"
+	// ClientRpcServiceBackupDatabaseProcedure is the fully-qualified name of the ClientRpcService's
+	// BackupDatabase RPC.
+	ClientRpcServiceBackupDatabaseProcedure = "/pb.clientrpc.v1.ClientRpcService/BackupDatabase"
+	// ClientRpcServiceCheckDatabaseIntegrityProcedure is the fully-qualified name of the
+	// ClientRpcService's CheckDatabaseIntegrity RPC.
+	ClientRpcServiceCheckDatabaseIntegrityProcedure = "/pb.clientrpc.v1.ClientRpcService/CheckDatabaseIntegrity"
 	// ClientRpcServiceIndexShareProcedure is the fully-qualified name of the ClientRpcService's
 	// IndexShare RPC.
 	ClientRpcServiceIndexShareProcedure = "/pb.clientrpc.v1.ClientRpcService/IndexShare"
@@ -113,6 +194,15 @@ const (
 	// ClientRpcServiceCheckForNewUpdateProcedure is the fully-qualified name of the ClientRpcService's
 	// CheckForNewUpdate RPC.
 	ClientRpcServiceCheckForNewUpdateProcedure = "/pb.clientrpc.v1.ClientRpcService/CheckForNewUpdate"
+	// ClientRpcServiceApplyUpdateProcedure is the fully-qualified name of the ClientRpcService's
+	// ApplyUpdate RPC.
+	ClientRpcServiceApplyUpdateProcedure = "/pb.clientrpc.v1.ClientRpcService/ApplyUpdate"
+	// ClientRpcServiceGetUpdateSettingsProcedure is the fully-qualified name of the ClientRpcService's
+	// GetUpdateSettings RPC.
+	ClientRpcServiceGetUpdateSettingsProcedure = "/pb.clientrpc.v1.ClientRpcService/GetUpdateSettings"
+	// ClientRpcServiceUpdateUpdateSettingsProcedure is the fully-qualified name of the
+	// ClientRpcService's UpdateUpdateSettings RPC.
+	ClientRpcServiceUpdateUpdateSettingsProcedure = "/pb.clientrpc.v1.ClientRpcService/UpdateUpdateSettings"
 	// ClientRpcServiceGetDownloadManagerItemsProcedure is the fully-qualified name of the
 	// ClientRpcService's GetDownloadManagerItems RPC.
 	ClientRpcServiceGetDownloadManagerItemsProcedure = "/pb.clientrpc.v1.ClientRpcService/GetDownloadManagerItems"
@@ -125,9 +215,104 @@ const (
 	// ClientRpcServiceRemoveDownloadManagerItemProcedure is the fully-qualified name of the
 	// ClientRpcService's RemoveDownloadManagerItem RPC.
 	ClientRpcServiceRemoveDownloadManagerItemProcedure = "/pb.clientrpc.v1.ClientRpcService/RemoveDownloadManagerItem"
+	// ClientRpcServicePauseFileDownloadProcedure is the fully-qualified name of the ClientRpcService's
+	// PauseFileDownload RPC.
+	ClientRpcServicePauseFileDownloadProcedure = "/pb.clientrpc.v1.ClientRpcService/PauseFileDownload"
 	// ClientRpcServiceResumeFileDownloadProcedure is the fully-qualified name of the ClientRpcService's
 	// ResumeFileDownload RPC.
 	ClientRpcServiceResumeFileDownloadProcedure = "/pb.clientrpc.v1.ClientRpcService/ResumeFileDownload"
+	// ClientRpcServiceGetDownloadHooksProcedure is the fully-qualified name of the ClientRpcService's
+	// GetDownloadHooks RPC.
+	ClientRpcServiceGetDownloadHooksProcedure = "/pb.clientrpc.v1.ClientRpcService/GetDownloadHooks"
+	// ClientRpcServiceCreateDownloadHookProcedure is the fully-qualified name of the ClientRpcService's
+	// CreateDownloadHook RPC.
+	ClientRpcServiceCreateDownloadHookProcedure = "/pb.clientrpc.v1.ClientRpcService/CreateDownloadHook"
+	// ClientRpcServiceDeleteDownloadHookProcedure is the fully-qualified name of the ClientRpcService's
+	// DeleteDownloadHook RPC.
+	ClientRpcServiceDeleteDownloadHookProcedure = "/pb.clientrpc.v1.ClientRpcService/DeleteDownloadHook"
+	// ClientRpcServiceGetUploadsProcedure is the fully-qualified name of the ClientRpcService's
+	// GetUploads RPC.
+	ClientRpcServiceGetUploadsProcedure = "/pb.clientrpc.v1.ClientRpcService/GetUploads"
+	// ClientRpcServiceClearUploadHistoryProcedure is the fully-qualified name of the ClientRpcService's
+	// ClearUploadHistory RPC.
+	ClientRpcServiceClearUploadHistoryProcedure = "/pb.clientrpc.v1.ClientRpcService/ClearUploadHistory"
+	// ClientRpcServiceGetFriendsProcedure is the fully-qualified name of the ClientRpcService's
+	// GetFriends RPC.
+	ClientRpcServiceGetFriendsProcedure = "/pb.clientrpc.v1.ClientRpcService/GetFriends"
+	// ClientRpcServiceSetFriendProcedure is the fully-qualified name of the ClientRpcService's
+	// SetFriend RPC.
+	ClientRpcServiceSetFriendProcedure = "/pb.clientrpc.v1.ClientRpcService/SetFriend"
+	// ClientRpcServiceDeleteFriendProcedure is the fully-qualified name of the ClientRpcService's
+	// DeleteFriend RPC.
+	ClientRpcServiceDeleteFriendProcedure = "/pb.clientrpc.v1.ClientRpcService/DeleteFriend"
+	// ClientRpcServiceGetBlockedPeersProcedure is the fully-qualified name of the ClientRpcService's
+	// GetBlockedPeers RPC.
+	ClientRpcServiceGetBlockedPeersProcedure = "/pb.clientrpc.v1.ClientRpcService/GetBlockedPeers"
+	// ClientRpcServiceBlockPeerProcedure is the fully-qualified name of the ClientRpcService's
+	// BlockPeer RPC.
+	ClientRpcServiceBlockPeerProcedure = "/pb.clientrpc.v1.ClientRpcService/BlockPeer"
+	// ClientRpcServiceUnblockPeerProcedure is the fully-qualified name of the ClientRpcService's
+	// UnblockPeer RPC.
+	ClientRpcServiceUnblockPeerProcedure = "/pb.clientrpc.v1.ClientRpcService/UnblockPeer"
+	// ClientRpcServiceGetInterestsProcedure is the fully-qualified name of the ClientRpcService's
+	// GetInterests RPC.
+	ClientRpcServiceGetInterestsProcedure = "/pb.clientrpc.v1.ClientRpcService/GetInterests"
+	// ClientRpcServiceSetInterestsProcedure is the fully-qualified name of the ClientRpcService's
+	// SetInterests RPC.
+	ClientRpcServiceSetInterestsProcedure = "/pb.clientrpc.v1.ClientRpcService/SetInterests"
+	// ClientRpcServiceGetSimilarUsersProcedure is the fully-qualified name of the ClientRpcService's
+	// GetSimilarUsers RPC.
+	ClientRpcServiceGetSimilarUsersProcedure = "/pb.clientrpc.v1.ClientRpcService/GetSimilarUsers"
+	// ClientRpcServiceGetServerScheduleProcedure is the fully-qualified name of the ClientRpcService's
+	// GetServerSchedule RPC.
+	ClientRpcServiceGetServerScheduleProcedure = "/pb.clientrpc.v1.ClientRpcService/GetServerSchedule"
+	// ClientRpcServiceSetServerScheduleProcedure is the fully-qualified name of the ClientRpcService's
+	// SetServerSchedule RPC.
+	ClientRpcServiceSetServerScheduleProcedure = "/pb.clientrpc.v1.ClientRpcService/SetServerSchedule"
+	// ClientRpcServiceGetSnoozeProcedure is the fully-qualified name of the ClientRpcService's
+	// GetSnooze RPC.
+	ClientRpcServiceGetSnoozeProcedure = "/pb.clientrpc.v1.ClientRpcService/GetSnooze"
+	// ClientRpcServiceSnoozeProcedure is the fully-qualified name of the ClientRpcService's Snooze RPC.
+	ClientRpcServiceSnoozeProcedure = "/pb.clientrpc.v1.ClientRpcService/Snooze"
+	// ClientRpcServiceUnsnoozeProcedure is the fully-qualified name of the ClientRpcService's Unsnooze
+	// RPC.
+	ClientRpcServiceUnsnoozeProcedure = "/pb.clientrpc.v1.ClientRpcService/Unsnooze"
+	// ClientRpcServiceGetRunHistoryProcedure is the fully-qualified name of the ClientRpcService's
+	// GetRunHistory RPC.
+	ClientRpcServiceGetRunHistoryProcedure = "/pb.clientrpc.v1.ClientRpcService/GetRunHistory"
+	// ClientRpcServiceGetConnHistoryProcedure is the fully-qualified name of the ClientRpcService's
+	// GetConnHistory RPC.
+	ClientRpcServiceGetConnHistoryProcedure = "/pb.clientrpc.v1.ClientRpcService/GetConnHistory"
+	// ClientRpcServiceGetTrashProcedure is the fully-qualified name of the ClientRpcService's GetTrash
+	// RPC.
+	ClientRpcServiceGetTrashProcedure = "/pb.clientrpc.v1.ClientRpcService/GetTrash"
+	// ClientRpcServiceRestoreServerProcedure is the fully-qualified name of the ClientRpcService's
+	// RestoreServer RPC.
+	ClientRpcServiceRestoreServerProcedure = "/pb.clientrpc.v1.ClientRpcService/RestoreServer"
+	// ClientRpcServicePurgeServerProcedure is the fully-qualified name of the ClientRpcService's
+	// PurgeServer RPC.
+	ClientRpcServicePurgeServerProcedure = "/pb.clientrpc.v1.ClientRpcService/PurgeServer"
+	// ClientRpcServiceRestoreShareProcedure is the fully-qualified name of the ClientRpcService's
+	// RestoreShare RPC.
+	ClientRpcServiceRestoreShareProcedure = "/pb.clientrpc.v1.ClientRpcService/RestoreShare"
+	// ClientRpcServicePurgeShareProcedure is the fully-qualified name of the ClientRpcService's
+	// PurgeShare RPC.
+	ClientRpcServicePurgeShareProcedure = "/pb.clientrpc.v1.ClientRpcService/PurgeShare"
+	// ClientRpcServiceGetPluginsProcedure is the fully-qualified name of the ClientRpcService's
+	// GetPlugins RPC.
+	ClientRpcServiceGetPluginsProcedure = "/pb.clientrpc.v1.ClientRpcService/GetPlugins"
+	// ClientRpcServiceCreatePluginProcedure is the fully-qualified name of the ClientRpcService's
+	// CreatePlugin RPC.
+	ClientRpcServiceCreatePluginProcedure = "/pb.clientrpc.v1.ClientRpcService/CreatePlugin"
+	// ClientRpcServiceDeletePluginProcedure is the fully-qualified name of the ClientRpcService's
+	// DeletePlugin RPC.
+	ClientRpcServiceDeletePluginProcedure = "/pb.clientrpc.v1.ClientRpcService/DeletePlugin"
+	// ClientRpcServiceStreamPluginEventsProcedure is the fully-qualified name of the ClientRpcService's
+	// StreamPluginEvents RPC.
+	ClientRpcServiceStreamPluginEventsProcedure = "/pb.clientrpc.v1.ClientRpcService/StreamPluginEvents"
+	// ClientRpcServiceRespondToSearchProcedure is the fully-qualified name of the ClientRpcService's
+	// RespondToSearch RPC.
+	ClientRpcServiceRespondToSearchProcedure = "/pb.clientrpc.v1.ClientRpcService/RespondToSearch"
 )
 
 // ClientRpcServiceClient is a client for the pb.clientrpc.v1.ClientRpcService service.
@@ -138,13 +323,72 @@ type ClientRpcServiceClient interface {
 	StreamEvents(context.Context, *v1.StreamEventsRequest) (*connect.ServerStreamForClient[v1.StreamEventsResponse], error)
 	// Stop shuts down the client.
 	Stop(context.Context, *v1.StopRequest) (*v1.StopResponse, error)
-	// GetClientInfo returns information about the FriendNet client.
+	// GetClientInfo returns information about the FriendNet client, such as its version and enabled features, so that
+	// UIs and CLIs can display it and only offer features the client supports.
 	GetClientInfo(context.Context, *v1.GetClientInfoRequest) (*v1.GetClientInfoResponse, error)
-	// GetServers returns a list of all servers.
+	// GetMemoryUsage returns how much memory the client is using, and the limits of its memory budget.
+	GetMemoryUsage(context.Context, *v1.GetMemoryUsageRequest) (*v1.GetMemoryUsageResponse, error)
+	// SetLowMemoryMode sets whether the client runs in low-memory mode, which caps cache sizes, read-ahead, concurrent
+	// streams, downloads and buffers for devices with little memory, such as a Raspberry Pi or a NAS.
+	// Changes will not take effect until the client is restarted.
+	SetLowMemoryMode(context.Context, *v1.SetLowMemoryModeRequest) (*v1.SetLowMemoryModeResponse, error)
+	// GetServers returns a page of servers.
+	// Use the returned cursor to get the following pages.
 	GetServers(context.Context, *v1.GetServersRequest) (*v1.GetServersResponse, error)
 	// CreateServer creates a new server and automatically connects to it.
+	// If an account template is specified, its credentials are used and its shares are created on the server.
+	//
+	// Returns NOT_FOUND if the account template does not exist.
 	CreateServer(context.Context, *v1.CreateServerRequest) (*v1.CreateServerResponse, error)
-	// DeleteServer disconnects and deletes a server.
+	// ImportInviteBundle creates a new server from an invite bundle URL and automatically connects to it.
+	// If the bundle has a certificate fingerprint, the server's certificate is checked against it and trusted.
+	// If the bundle has an invite code, a new account is registered with it first.
+	// If an account template is specified, its credentials are used and its shares are created on the server.
+	//
+	// Returns INVALID_ARGUMENT if the URL is not a valid invite bundle URL.
+	// Returns NOT_FOUND if the account template does not exist.
+	// Returns FAILED_PRECONDITION if the server's certificate does not match the bundle's fingerprint.
+	// Returns PERMISSION_DENIED if the server rejected the registration.
+	ImportInviteBundle(context.Context, *v1.ImportInviteBundleRequest) (*v1.ImportInviteBundleResponse, error)
+	// CreateAccountTemplate creates an account template, which holds a username, password and list of shares that can
+	// be reused when adding servers where the same account is used.
+	//
+	// Returns INVALID_ARGUMENT if the username or a share name is invalid.
+	CreateAccountTemplate(context.Context, *v1.CreateAccountTemplateRequest) (*v1.CreateAccountTemplateResponse, error)
+	// CreateAccountTemplateFromServer creates an account template with the credentials and shares of an existing server.
+	// Internal shares are not included.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	CreateAccountTemplateFromServer(context.Context, *v1.CreateAccountTemplateFromServerRequest) (*v1.CreateAccountTemplateFromServerResponse, error)
+	// GetAccountTemplates returns all account templates.
+	// Passwords are not included.
+	GetAccountTemplates(context.Context, *v1.GetAccountTemplatesRequest) (*v1.GetAccountTemplatesResponse, error)
+	// DeleteAccountTemplate deletes an account template.
+	// Servers created from it are not affected.
+	//
+	// Returns NOT_FOUND if no such template exists.
+	DeleteAccountTemplate(context.Context, *v1.DeleteAccountTemplateRequest) (*v1.DeleteAccountTemplateResponse, error)
+	// StartHosting starts an embedded FriendNet server in the client and hosts a room on it, so that friends can join
+	// without anyone deploying a separate server. The client joins the room itself, and hosts it again whenever it
+	// restarts, until StopHosting is called.
+	//
+	// Returns INVALID_ARGUMENT if the room name, username or port is invalid.
+	// Returns ALREADY_EXISTS if the client is already hosting a room.
+	StartHosting(context.Context, *v1.StartHostingRequest) (*v1.StartHostingResponse, error)
+	// StopHosting stops the embedded server, disconnecting everyone in the hosted room.
+	// The room and its accounts are kept, so it can be hosted again later.
+	//
+	// Returns FAILED_PRECONDITION if the client is not hosting a room.
+	StopHosting(context.Context, *v1.StopHostingRequest) (*v1.StopHostingResponse, error)
+	// GetHostingInfo returns the status of the room the client hosts on its embedded server.
+	GetHostingInfo(context.Context, *v1.GetHostingInfoRequest) (*v1.GetHostingInfoResponse, error)
+	// CreateHostingInvite creates an invite link for the hosted room that friends can join with.
+	//
+	// Returns INVALID_ARGUMENT if the address is empty.
+	// Returns FAILED_PRECONDITION if the client is not hosting a room.
+	CreateHostingInvite(context.Context, *v1.CreateHostingInviteRequest) (*v1.CreateHostingInviteResponse, error)
+	// DeleteServer disconnects a server and moves it to the trash, along with its shares.
+	// It can be restored with RestoreServer until it is purged.
 	//
 	// Returns NOT_FOUND if no such server exists.
 	DeleteServer(context.Context, *v1.DeleteServerRequest) (*v1.DeleteServerResponse, error)
@@ -165,21 +409,64 @@ type ClientRpcServiceClient interface {
 	//
 	// Returns NOT_FOUND if no such server exists.
 	UpdateServer(context.Context, *v1.UpdateServerRequest) (*v1.UpdateServerResponse, error)
-	// GetShares returns shares for a server.
+	// GetShares returns a page of shares for a server.
+	// Use the returned cursor to get the following pages.
 	//
 	// Returns NOT_FOUND if no such server exists.
 	GetShares(context.Context, *v1.GetSharesRequest) (*v1.GetSharesResponse, error)
 	// CreateShare creates a new server share.
+	// A deleted share with the same name is purged.
 	//
 	// Returns NOT_FOUND if no such server exists.
-	// Returns INVALID_ARGUMENT if the share name is invalid.
+	// Returns INVALID_ARGUMENT if the share name, a mount, the conflict rule, an exclude pattern or the Unicode form is
+	// invalid.
 	// Returns ALREADY_EXISTS if a share with the same name already exists.
 	CreateShare(context.Context, *v1.CreateShareRequest) (*v1.CreateShareResponse, error)
-	// DeleteShare deletes an existing server share.
+	// DeleteShare stops sharing an existing server share and moves it to the trash.
+	// It can be restored with RestoreShare until it is purged.
 	//
 	// Returns NOT_FOUND if no such server exists.
 	// Returns NOT_FOUND if no such share exists.
 	DeleteShare(context.Context, *v1.DeleteShareRequest) (*v1.DeleteShareResponse, error)
+	// SetShareExcludePatterns replaces the exclude patterns of a share.
+	// The share's search index is rebuilt in the background.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns NOT_FOUND if no such share exists.
+	// Returns INVALID_ARGUMENT if a pattern is invalid.
+	SetShareExcludePatterns(context.Context, *v1.SetShareExcludePatternsRequest) (*v1.SetShareExcludePatternsResponse, error)
+	// CheckShareHealth scans a share for entries that cannot be shared, such as names that are not valid UTF-8 or
+	// directories that cannot be read, and entries that peers may have trouble saving, such as names that are reserved
+	// on Windows.
+	// Entries that cannot be shared are left out of listings, so this is how to find them.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns NOT_FOUND if no such share exists.
+	CheckShareHealth(context.Context, *v1.CheckShareHealthRequest) (*v1.CheckShareHealthResponse, error)
+	// ImportShares creates many shares at once, such as when migrating a large list of shared folders from another
+	// program.
+	// All entries are validated before any share is created. If any entry is invalid or a share cannot be created, no
+	// shares are created, and the results describe the problem with each entry.
+	// Shares in the trash with the same names as imported shares are purged.
+	//
+	// Returns INVALID_ARGUMENT if neither or both of entries and manifest_path are set, the manifest cannot be read or
+	// parsed, or there are more than 10000 entries.
+	ImportShares(context.Context, *v1.ImportSharesRequest) (*v1.ImportSharesResponse, error)
+	// CreateShareLink creates a link that gives read-only access to a path in a share through the public HTTPS
+	// gateway.
+	// Links can be created while the gateway is disabled, but only work once it is enabled.
+	//
+	// Returns NOT_FOUND if no such server, share or path exists.
+	// Returns INVALID_ARGUMENT if the path is invalid.
+	CreateShareLink(context.Context, *v1.CreateShareLinkRequest) (*v1.CreateShareLinkResponse, error)
+	// GetShareLinks returns the links to a share.
+	//
+	// Returns NOT_FOUND if no such server or share exists.
+	GetShareLinks(context.Context, *v1.GetShareLinksRequest) (*v1.GetShareLinksResponse, error)
+	// DeleteShareLink deletes a share link, so that it stops working immediately.
+	//
+	// Returns NOT_FOUND if no such link exists.
+	DeleteShareLink(context.Context, *v1.DeleteShareLinkRequest) (*v1.DeleteShareLinkResponse, error)
 	// GetDirFiles requests the files within a directory shared by an online user.
 	// Each message will contain files within the path.
 	//
@@ -188,12 +475,47 @@ type ClientRpcServiceClient interface {
 	// Returns NOT_FOUND if no such path exists.
 	// Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
 	GetDirFiles(context.Context, *v1.GetDirFilesRequest) (*connect.ServerStreamForClient[v1.GetDirFilesResponse], error)
+	// StreamDirArchive streams a directory shared by an online user as an archive.
+	// The archive is built on the fly, so its size is not known in advance.
+	// Concatenating the data of all messages produces the archive.
+	//
+	// Returns INVALID_ARGUMENT if the format is unspecified.
+	// Returns INVALID_ARGUMENT if the path is not a directory.
+	// Returns NOT_FOUND if no such server exists.
+	// Returns NOT_FOUND if no such path exists.
+	// Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
+	StreamDirArchive(context.Context, *v1.StreamDirArchiveRequest) (*connect.ServerStreamForClient[v1.StreamDirArchiveResponse], error)
 	// GetFileMeta returns metadata about a path shared by an online user.
 	//
 	// Returns NOT_FOUND if no such server exists.
 	// Returns NOT_FOUND if no such path exists.
 	// Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
 	GetFileMeta(context.Context, *v1.GetFileMetaRequest) (*v1.GetFileMetaResponse, error)
+	// CreateFileLink creates a link that downloads a file through the file server without the bearer token, so it can
+	// be given to someone else.
+	// The link can only be downloaded once and expires after a while. Links stop working when the client restarts.
+	// The first client that uses the link can retry until its download finishes, and keep using it for a minute after.
+	// Links are served on the web UI address, so they only work for others if they can reach that address.
+	//
+	// Returns NOT_FOUND if no such server or file exists.
+	// Returns INVALID_ARGUMENT if the path is a directory or the expiry is out of range.
+	// Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
+	CreateFileLink(context.Context, *v1.CreateFileLinkRequest) (*v1.CreateFileLinkResponse, error)
+	// MeasurePeer measures the latency and throughput to an online user.
+	// Measuring generates real traffic, so it should only be used on request.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns FAILED_PRECONDITION if the path is PEER_PATH_DIRECT and no direct connection could be established.
+	// Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
+	MeasurePeer(context.Context, *v1.MeasurePeerRequest) (*v1.MeasurePeerResponse, error)
+	// Diagnose runs the steps of connecting to a server one by one on a separate connection and returns the result of
+	// each, so that connection problems can be narrowed down to DNS, the network, the server's certificate, its
+	// protocol version or the credentials.
+	// Steps after one that failed are skipped.
+	// It works whether or not the server is connected, and does not affect its connection.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	Diagnose(context.Context, *v1.DiagnoseRequest) (*v1.DiagnoseResponse, error)
 	// GetOnlineUsers returns a list of online users in a server.
 	//
 	// Returns NOT_FOUND if no such server exists.
@@ -232,6 +554,33 @@ type ClientRpcServiceClient interface {
 	// Some of the settings take effect immediately, others do not.
 	// All fields must be filled, default values will not be omitted.
 	UpdateTransferSettings(context.Context, *v1.UpdateTransferSettingsRequest) (*v1.UpdateTransferSettingsResponse, error)
+	// GetNotificationSettings returns the client's notification settings.
+	GetNotificationSettings(context.Context, *v1.GetNotificationSettingsRequest) (*v1.GetNotificationSettingsResponse, error)
+	// UpdateNotificationSettings updates the client's notification settings.
+	// The settings take effect immediately.
+	// All fields must be filled, default values will not be omitted.
+	UpdateNotificationSettings(context.Context, *v1.UpdateNotificationSettingsRequest) (*v1.UpdateNotificationSettingsResponse, error)
+	// ExportConfig exports the client's servers, their shares and the trusted server certificates as a bundle
+	// encrypted with the specified password.
+	// The bundle contains server passwords, so it should be treated as sensitive even though it is encrypted.
+	//
+	// Returns INVALID_ARGUMENT if the password is empty.
+	ExportConfig(context.Context, *v1.ExportConfigRequest) (*v1.ExportConfigResponse, error)
+	// ImportConfig imports a bundle created by ExportConfig.
+	// Servers that already exist are skipped, and certificates are only imported for hostnames that do not already
+	// have one.
+	//
+	// Returns INVALID_ARGUMENT if the bundle is not valid.
+	// Returns PERMISSION_DENIED if the password is incorrect or the bundle is corrupted.
+	ImportConfig(context.Context, *v1.ImportConfigRequest) (*v1.ImportConfigResponse, error)
+	// BackupDatabase writes a consistent copy of the client's database to a file while the client keeps running.
+	//
+	// Returns INVALID_ARGUMENT if the path is empty.
+	// Returns ALREADY_EXISTS if the file already exists.
+	BackupDatabase(context.Context, *v1.BackupDatabaseRequest) (*v1.BackupDatabaseResponse, error)
+	// CheckDatabaseIntegrity checks the client's database for corruption.
+	// It may take a while if large shares are indexed.
+	CheckDatabaseIntegrity(context.Context, *v1.CheckDatabaseIntegrityRequest) (*v1.CheckDatabaseIntegrityResponse, error)
 	// IndexShare requests that a share be indexed.
 	// The share will be scheduled to be indexed in the background.
 	//
@@ -255,11 +604,33 @@ type ClientRpcServiceClient interface {
 	// confirmed that there is no new update.
 	// The cache is updated after calling this method.
 	CheckForNewUpdate(context.Context, *v1.CheckForNewUpdateRequest) (*v1.CheckForNewUpdateResponse, error)
+	// ApplyUpdate downloads the new update found by the last check for this platform, verifies it against the checksum
+	// in its signed release manifest, and stages it to replace the client's executable.
+	// The executable is replaced when the client shuts down, so the update takes effect when it is restarted.
+	//
+	// Returns FAILED_PRECONDITION if there is no new update, or if it has no binary for this platform.
+	// Returns FAILED_PRECONDITION if self-updating is disabled, such as when the client was installed by a package
+	// manager.
+	// Returns DATA_LOSS if the downloaded binary does not match its checksum.
+	ApplyUpdate(context.Context, *v1.ApplyUpdateRequest) (*v1.ApplyUpdateResponse, error)
+	// GetUpdateSettings returns the client's update settings.
+	GetUpdateSettings(context.Context, *v1.GetUpdateSettingsRequest) (*v1.GetUpdateSettingsResponse, error)
+	// UpdateUpdateSettings updates the client's update settings.
+	// The settings take effect immediately, and cached update info is cleared.
+	// All fields must be filled, default values will not be omitted.
+	//
+	// Returns INVALID_ARGUMENT if the base URL is not an HTTP or HTTPS URL.
+	UpdateUpdateSettings(context.Context, *v1.UpdateUpdateSettingsRequest) (*v1.UpdateUpdateSettingsResponse, error)
 	// GetDownloadManagerItems returns all download manager items.
 	GetDownloadManagerItems(context.Context, *v1.GetDownloadManagerItemsRequest) (*v1.GetDownloadManagerItemsResponse, error)
 	// QueueFileDownload queues a file download.
+	// If the peer can provide the file's hash and a file with the same hash was already downloaded, the request's
+	// duplicate_action decides what happens. If the peer cannot be reached or does not provide hashes, the download is
+	// queued normally. Peers only provide hashes they already know, so a duplicate may not be found the first time a
+	// file is queued.
 	//
 	// Returns NOT_FOUND if no such server exists.
+	// Returns FAILED_PRECONDITION if the duplicate could not be hard linked, such as when it is on another filesystem.
 	QueueFileDownload(context.Context, *v1.QueueFileDownloadRequest) (*v1.QueueFileDownloadResponse, error)
 	// CancelFileDownload cancels a file download.
 	//
@@ -270,10 +641,158 @@ type ClientRpcServiceClient interface {
 	//
 	// Returns NOT_FOUND if no such item exists.
 	RemoveDownloadManagerItem(context.Context, *v1.RemoveDownloadManagerItemRequest) (*v1.RemoveDownloadManagerItemResponse, error)
+	// PauseFileDownload pauses a file download.
+	// If the download is in progress, its transfer is paused without closing the connection to the peer.
+	// If it is queued, it will not be started until it is resumed.
+	//
+	// Returns NOT_FOUND if no such download exists.
+	// Returns FAILED_PRECONDITION if the download is already done, canceled or failed.
+	PauseFileDownload(context.Context, *v1.PauseFileDownloadRequest) (*v1.PauseFileDownloadResponse, error)
 	// ResumeFileDownload resumes or starts the a file download.
+	// Paused in-progress transfers continue where they stopped.
 	//
 	// Returns NOT_FOUND if no such download exists.
 	ResumeFileDownload(context.Context, *v1.ResumeFileDownloadRequest) (*v1.ResumeFileDownloadResponse, error)
+	// GetDownloadHooks returns all download post-processing hooks.
+	GetDownloadHooks(context.Context, *v1.GetDownloadHooksRequest) (*v1.GetDownloadHooksResponse, error)
+	// CreateDownloadHook creates a hook that runs when a download completes.
+	// Global hooks run before per-download hooks, in the order they were created.
+	//
+	// Returns INVALID_ARGUMENT if the type is unspecified or the target is invalid for the type.
+	// Returns NOT_FOUND if a download UUID was specified but no such download exists.
+	CreateDownloadHook(context.Context, *v1.CreateDownloadHookRequest) (*v1.CreateDownloadHookResponse, error)
+	// DeleteDownloadHook deletes a download hook.
+	//
+	// Returns NOT_FOUND if no such hook exists.
+	DeleteDownloadHook(context.Context, *v1.DeleteDownloadHookRequest) (*v1.DeleteDownloadHookResponse, error)
+	// GetUploads returns uploads to peers that are in progress, and the history of finished uploads.
+	// Live updates are sent as TYPE_UPLOAD_UPDATE events.
+	GetUploads(context.Context, *v1.GetUploadsRequest) (*v1.GetUploadsResponse, error)
+	// ClearUploadHistory deletes the history of finished uploads.
+	ClearUploadHistory(context.Context, *v1.ClearUploadHistoryRequest) (*v1.ClearUploadHistoryResponse, error)
+	// GetFriends returns the friend info for peers on a server.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	GetFriends(context.Context, *v1.GetFriendsRequest) (*v1.GetFriendsResponse, error)
+	// SetFriend creates or replaces the friend info for a peer on a server.
+	// The peer does not need to be online.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns INVALID_ARGUMENT if the username is invalid or the nickname or note is too long.
+	SetFriend(context.Context, *v1.SetFriendRequest) (*v1.SetFriendResponse, error)
+	// DeleteFriend deletes the friend info for a peer on a server.
+	//
+	// Returns NOT_FOUND if no such server or friend info exists.
+	DeleteFriend(context.Context, *v1.DeleteFriendRequest) (*v1.DeleteFriendResponse, error)
+	// GetBlockedPeers returns the peers on the local block list of a server.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	GetBlockedPeers(context.Context, *v1.GetBlockedPeersRequest) (*v1.GetBlockedPeersResponse, error)
+	// BlockPeer adds a peer on a server to the local block list.
+	// Requests from blocked peers are rejected with permission errors, and their results are left out of searches.
+	// Blocking a peer that is already blocked does nothing.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns INVALID_ARGUMENT if the username is invalid.
+	BlockPeer(context.Context, *v1.BlockPeerRequest) (*v1.BlockPeerResponse, error)
+	// UnblockPeer removes a peer on a server from the local block list.
+	//
+	// Returns NOT_FOUND if no such server exists or the peer is not blocked.
+	// Returns INVALID_ARGUMENT if the username is invalid.
+	UnblockPeer(context.Context, *v1.UnblockPeerRequest) (*v1.UnblockPeerResponse, error)
+	// GetInterests returns the interests published to every server.
+	GetInterests(context.Context, *v1.GetInterestsRequest) (*v1.GetInterestsResponse, error)
+	// SetInterests replaces the interests published to every server, so that users with similar interests can find
+	// each other. They are published to connected servers right away, and to other servers when they connect.
+	// There can be at most 50 interests, each at most 64 characters long.
+	//
+	// Returns INVALID_ARGUMENT if an interest is invalid or there are too many.
+	SetInterests(context.Context, *v1.SetInterestsRequest) (*v1.SetInterestsResponse, error)
+	// GetSimilarUsers returns the online users in the server's room whose interests overlap with the client's, most
+	// overlapping first, to help find whose shares to browse.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns UNIMPLEMENTED if the server does not support interests.
+	GetSimilarUsers(context.Context, *v1.GetSimilarUsersRequest) (*v1.GetSimilarUsersResponse, error)
+	// GetServerSchedule returns the windows during which a server connection is allowed.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	GetServerSchedule(context.Context, *v1.GetServerScheduleRequest) (*v1.GetServerScheduleResponse, error)
+	// SetServerSchedule replaces the windows during which a server connection is allowed.
+	// When a window ends, the connection is closed, and when one starts, it is opened again, unless it was
+	// disconnected manually. Connecting manually outside a window keeps the connection open until the next window
+	// starts or ends.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns INVALID_ARGUMENT if a window is invalid.
+	SetServerSchedule(context.Context, *v1.SetServerScheduleRequest) (*v1.SetServerScheduleResponse, error)
+	// GetSnooze returns the state of the client's snooze.
+	GetSnooze(context.Context, *v1.GetSnoozeRequest) (*v1.GetSnoozeResponse, error)
+	// Snooze pauses all uploads and downloads on every server, and optionally hides shares from peers, for a duration
+	// or until Unsnooze is called.
+	// Snoozing while already snoozed replaces the current snooze.
+	// The snooze is kept across client restarts.
+	//
+	// Returns INVALID_ARGUMENT if the duration is 0.
+	Snooze(context.Context, *v1.SnoozeRequest) (*v1.SnoozeResponse, error)
+	// Unsnooze ends the client's snooze, resuming uploads and downloads and showing shares again.
+	// Does nothing if the client is not snoozed.
+	Unsnooze(context.Context, *v1.UnsnoozeRequest) (*v1.UnsnoozeResponse, error)
+	// GetRunHistory returns the history of runs of the client, including when each started and stopped and whether it
+	// crashed.
+	GetRunHistory(context.Context, *v1.GetRunHistoryRequest) (*v1.GetRunHistoryResponse, error)
+	// GetConnHistory returns the history of connections to servers, including how long each was open and why it
+	// closed, such as to audit connection stability.
+	//
+	// Returns NOT_FOUND if a server UUID is specified and no such server exists.
+	GetConnHistory(context.Context, *v1.GetConnHistoryRequest) (*v1.GetConnHistoryResponse, error)
+	// GetTrash returns the deleted servers and shares that can still be restored.
+	// Deleted servers and shares are purged for good once they have been in the trash for longer than the retention
+	// period in the "trash_retention_days" setting.
+	GetTrash(context.Context, *v1.GetTrashRequest) (*v1.GetTrashResponse, error)
+	// RestoreServer takes a deleted server out of the trash, along with its shares, and starts managing a connection
+	// to it again.
+	//
+	// Returns NOT_FOUND if no such server is in the trash.
+	RestoreServer(context.Context, *v1.RestoreServerRequest) (*v1.RestoreServerResponse, error)
+	// PurgeServer permanently deletes a server in the trash, along with its shares.
+	//
+	// Returns NOT_FOUND if no such server is in the trash.
+	PurgeServer(context.Context, *v1.PurgeServerRequest) (*v1.PurgeServerResponse, error)
+	// RestoreShare takes a deleted share out of the trash and shares it again.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns NOT_FOUND if no such share is in the trash.
+	RestoreShare(context.Context, *v1.RestoreShareRequest) (*v1.RestoreShareResponse, error)
+	// PurgeShare permanently deletes a share in the trash.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns NOT_FOUND if no such share is in the trash.
+	PurgeShare(context.Context, *v1.PurgeShareRequest) (*v1.PurgeShareResponse, error)
+	// GetPlugins returns all plugins.
+	GetPlugins(context.Context, *v1.GetPluginsRequest) (*v1.GetPluginsResponse, error)
+	// CreatePlugin creates a plugin with the specified scopes and returns its bearer token.
+	// Plugins use the token in place of the client's own to call the methods their scopes allow.
+	//
+	// Returns INVALID_ARGUMENT if the name is invalid or a scope is unspecified.
+	// Returns ALREADY_EXISTS if a plugin with the same name already exists.
+	CreatePlugin(context.Context, *v1.CreatePluginRequest) (*v1.CreatePluginResponse, error)
+	// DeletePlugin deletes a plugin, revoking its token and closing its event streams.
+	//
+	// Returns NOT_FOUND if no such plugin exists.
+	DeletePlugin(context.Context, *v1.DeletePluginRequest) (*v1.DeletePluginResponse, error)
+	// StreamPluginEvents returns an ongoing stream of the events a plugin registered for.
+	// Only plugins can call it.
+	//
+	// Returns FAILED_PRECONDITION if not called with a plugin's token.
+	// Returns PERMISSION_DENIED if the plugin does not have the scope for one of the requested types.
+	StreamPluginEvents(context.Context, *v1.StreamPluginEventsRequest) (*connect.ServerStreamForClient[v1.StreamPluginEventsResponse], error)
+	// RespondToSearch adds a plugin's results to a search it received as a PLUGIN_EVENT_TYPE_SEARCH event.
+	// Only plugins can call it, once per search.
+	//
+	// Returns FAILED_PRECONDITION if not called with a plugin's token.
+	// Returns NOT_FOUND if no such search is waiting for the plugin's response, such as if its deadline passed.
+	RespondToSearch(context.Context, *v1.RespondToSearchRequest) (*v1.RespondToSearchResponse, error)
 }
 
 // NewClientRpcServiceClient constructs a client for the pb.clientrpc.v1.ClientRpcService service.
@@ -311,6 +830,18 @@ func NewClientRpcServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(clientRpcServiceMethods.ByName("GetClientInfo")),
 			connect.WithClientOptions(opts...),
 		),
+		getMemoryUsage: connect.NewClient[v1.GetMemoryUsageRequest, v1.GetMemoryUsageResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetMemoryUsageProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetMemoryUsage")),
+			connect.WithClientOptions(opts...),
+		),
+		setLowMemoryMode: connect.NewClient[v1.SetLowMemoryModeRequest, v1.SetLowMemoryModeResponse](
+			httpClient,
+			baseURL+ClientRpcServiceSetLowMemoryModeProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("SetLowMemoryMode")),
+			connect.WithClientOptions(opts...),
+		),
 		getServers: connect.NewClient[v1.GetServersRequest, v1.GetServersResponse](
 			httpClient,
 			baseURL+ClientRpcServiceGetServersProcedure,
@@ -323,6 +854,60 @@ func NewClientRpcServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(clientRpcServiceMethods.ByName("CreateServer")),
 			connect.WithClientOptions(opts...),
 		),
+		importInviteBundle: connect.NewClient[v1.ImportInviteBundleRequest, v1.ImportInviteBundleResponse](
+			httpClient,
+			baseURL+ClientRpcServiceImportInviteBundleProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("ImportInviteBundle")),
+			connect.WithClientOptions(opts...),
+		),
+		createAccountTemplate: connect.NewClient[v1.CreateAccountTemplateRequest, v1.CreateAccountTemplateResponse](
+			httpClient,
+			baseURL+ClientRpcServiceCreateAccountTemplateProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("CreateAccountTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		createAccountTemplateFromServer: connect.NewClient[v1.CreateAccountTemplateFromServerRequest, v1.CreateAccountTemplateFromServerResponse](
+			httpClient,
+			baseURL+ClientRpcServiceCreateAccountTemplateFromServerProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("CreateAccountTemplateFromServer")),
+			connect.WithClientOptions(opts...),
+		),
+		getAccountTemplates: connect.NewClient[v1.GetAccountTemplatesRequest, v1.GetAccountTemplatesResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetAccountTemplatesProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetAccountTemplates")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteAccountTemplate: connect.NewClient[v1.DeleteAccountTemplateRequest, v1.DeleteAccountTemplateResponse](
+			httpClient,
+			baseURL+ClientRpcServiceDeleteAccountTemplateProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("DeleteAccountTemplate")),
+			connect.WithClientOptions(opts...),
+		),
+		startHosting: connect.NewClient[v1.StartHostingRequest, v1.StartHostingResponse](
+			httpClient,
+			baseURL+ClientRpcServiceStartHostingProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("StartHosting")),
+			connect.WithClientOptions(opts...),
+		),
+		stopHosting: connect.NewClient[v1.StopHostingRequest, v1.StopHostingResponse](
+			httpClient,
+			baseURL+ClientRpcServiceStopHostingProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("StopHosting")),
+			connect.WithClientOptions(opts...),
+		),
+		getHostingInfo: connect.NewClient[v1.GetHostingInfoRequest, v1.GetHostingInfoResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetHostingInfoProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetHostingInfo")),
+			connect.WithClientOptions(opts...),
+		),
+		createHostingInvite: connect.NewClient[v1.CreateHostingInviteRequest, v1.CreateHostingInviteResponse](
+			httpClient,
+			baseURL+ClientRpcServiceCreateHostingInviteProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("CreateHostingInvite")),
+			connect.WithClientOptions(opts...),
+		),
 		deleteServer: connect.NewClient[v1.DeleteServerRequest, v1.DeleteServerResponse](
 			httpClient,
 			baseURL+ClientRpcServiceDeleteServerProcedure,
@@ -365,18 +950,78 @@ func NewClientRpcServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(clientRpcServiceMethods.ByName("DeleteShare")),
 			connect.WithClientOptions(opts...),
 		),
+		setShareExcludePatterns: connect.NewClient[v1.SetShareExcludePatternsRequest, v1.SetShareExcludePatternsResponse](
+			httpClient,
+			baseURL+ClientRpcServiceSetShareExcludePatternsProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("SetShareExcludePatterns")),
+			connect.WithClientOptions(opts...),
+		),
+		checkShareHealth: connect.NewClient[v1.CheckShareHealthRequest, v1.CheckShareHealthResponse](
+			httpClient,
+			baseURL+ClientRpcServiceCheckShareHealthProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("CheckShareHealth")),
+			connect.WithClientOptions(opts...),
+		),
+		importShares: connect.NewClient[v1.ImportSharesRequest, v1.ImportSharesResponse](
+			httpClient,
+			baseURL+ClientRpcServiceImportSharesProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("ImportShares")),
+			connect.WithClientOptions(opts...),
+		),
+		createShareLink: connect.NewClient[v1.CreateShareLinkRequest, v1.CreateShareLinkResponse](
+			httpClient,
+			baseURL+ClientRpcServiceCreateShareLinkProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("CreateShareLink")),
+			connect.WithClientOptions(opts...),
+		),
+		getShareLinks: connect.NewClient[v1.GetShareLinksRequest, v1.GetShareLinksResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetShareLinksProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetShareLinks")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteShareLink: connect.NewClient[v1.DeleteShareLinkRequest, v1.DeleteShareLinkResponse](
+			httpClient,
+			baseURL+ClientRpcServiceDeleteShareLinkProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("DeleteShareLink")),
+			connect.WithClientOptions(opts...),
+		),
 		getDirFiles: connect.NewClient[v1.GetDirFilesRequest, v1.GetDirFilesResponse](
 			httpClient,
 			baseURL+ClientRpcServiceGetDirFilesProcedure,
 			connect.WithSchema(clientRpcServiceMethods.ByName("GetDirFiles")),
 			connect.WithClientOptions(opts...),
 		),
+		streamDirArchive: connect.NewClient[v1.StreamDirArchiveRequest, v1.StreamDirArchiveResponse](
+			httpClient,
+			baseURL+ClientRpcServiceStreamDirArchiveProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("StreamDirArchive")),
+			connect.WithClientOptions(opts...),
+		),
 		getFileMeta: connect.NewClient[v1.GetFileMetaRequest, v1.GetFileMetaResponse](
 			httpClient,
 			baseURL+ClientRpcServiceGetFileMetaProcedure,
 			connect.WithSchema(clientRpcServiceMethods.ByName("GetFileMeta")),
 			connect.WithClientOptions(opts...),
 		),
+		createFileLink: connect.NewClient[v1.CreateFileLinkRequest, v1.CreateFileLinkResponse](
+			httpClient,
+			baseURL+ClientRpcServiceCreateFileLinkProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("CreateFileLink")),
+			connect.WithClientOptions(opts...),
+		),
+		measurePeer: connect.NewClient[v1.MeasurePeerRequest, v1.MeasurePeerResponse](
+			httpClient,
+			baseURL+ClientRpcServiceMeasurePeerProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("MeasurePeer")),
+			connect.WithClientOptions(opts...),
+		),
+		diagnose: connect.NewClient[v1.DiagnoseRequest, v1.DiagnoseResponse](
+			httpClient,
+			baseURL+ClientRpcServiceDiagnoseProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("Diagnose")),
+			connect.WithClientOptions(opts...),
+		),
 		getOnlineUsers: connect.NewClient[v1.GetOnlineUsersRequest, v1.GetOnlineUsersResponse](
 			httpClient,
 			baseURL+ClientRpcServiceGetOnlineUsersProcedure,
@@ -425,6 +1070,42 @@ func NewClientRpcServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(clientRpcServiceMethods.ByName("UpdateTransferSettings")),
 			connect.WithClientOptions(opts...),
 		),
+		getNotificationSettings: connect.NewClient[v1.GetNotificationSettingsRequest, v1.GetNotificationSettingsResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetNotificationSettingsProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetNotificationSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updateNotificationSettings: connect.NewClient[v1.UpdateNotificationSettingsRequest, v1.UpdateNotificationSettingsResponse](
+			httpClient,
+			baseURL+ClientRpcServiceUpdateNotificationSettingsProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("UpdateNotificationSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		exportConfig: connect.NewClient[v1.ExportConfigRequest, v1.ExportConfigResponse](
+			httpClient,
+			baseURL+ClientRpcServiceExportConfigProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("ExportConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		importConfig: connect.NewClient[v1.ImportConfigRequest, v1.ImportConfigResponse](
+			httpClient,
+			baseURL+ClientRpcServiceImportConfigProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("ImportConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		backupDatabase: connect.NewClient[v1.BackupDatabaseRequest, v1.BackupDatabaseResponse](
+			httpClient,
+			baseURL+ClientRpcServiceBackupDatabaseProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("BackupDatabase")),
+			connect.WithClientOptions(opts...),
+		),
+		checkDatabaseIntegrity: connect.NewClient[v1.CheckDatabaseIntegrityRequest, v1.CheckDatabaseIntegrityResponse](
+			httpClient,
+			baseURL+ClientRpcServiceCheckDatabaseIntegrityProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("CheckDatabaseIntegrity")),
+			connect.WithClientOptions(opts...),
+		),
 		indexShare: connect.NewClient[v1.IndexShareRequest, v1.IndexShareResponse](
 			httpClient,
 			baseURL+ClientRpcServiceIndexShareProcedure,
@@ -449,6 +1130,24 @@ func NewClientRpcServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(clientRpcServiceMethods.ByName("CheckForNewUpdate")),
 			connect.WithClientOptions(opts...),
 		),
+		applyUpdate: connect.NewClient[v1.ApplyUpdateRequest, v1.ApplyUpdateResponse](
+			httpClient,
+			baseURL+ClientRpcServiceApplyUpdateProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("ApplyUpdate")),
+			connect.WithClientOptions(opts...),
+		),
+		getUpdateSettings: connect.NewClient[v1.GetUpdateSettingsRequest, v1.GetUpdateSettingsResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetUpdateSettingsProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetUpdateSettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updateUpdateSettings: connect.NewClient[v1.UpdateUpdateSettingsRequest, v1.UpdateUpdateSettingsResponse](
+			httpClient,
+			baseURL+ClientRpcServiceUpdateUpdateSettingsProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("UpdateUpdateSettings")),
+			connect.WithClientOptions(opts...),
+		),
 		getDownloadManagerItems: connect.NewClient[v1.GetDownloadManagerItemsRequest, v1.GetDownloadManagerItemsResponse](
 			httpClient,
 			baseURL+ClientRpcServiceGetDownloadManagerItemsProcedure,
@@ -473,135 +1172,489 @@ func NewClientRpcServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(clientRpcServiceMethods.ByName("RemoveDownloadManagerItem")),
 			connect.WithClientOptions(opts...),
 		),
+		pauseFileDownload: connect.NewClient[v1.PauseFileDownloadRequest, v1.PauseFileDownloadResponse](
+			httpClient,
+			baseURL+ClientRpcServicePauseFileDownloadProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("PauseFileDownload")),
+			connect.WithClientOptions(opts...),
+		),
 		resumeFileDownload: connect.NewClient[v1.ResumeFileDownloadRequest, v1.ResumeFileDownloadResponse](
 			httpClient,
 			baseURL+ClientRpcServiceResumeFileDownloadProcedure,
 			connect.WithSchema(clientRpcServiceMethods.ByName("ResumeFileDownload")),
 			connect.WithClientOptions(opts...),
 		),
-	}
-}
-
-// clientRpcServiceClient implements ClientRpcServiceClient.
-type clientRpcServiceClient struct {
-	streamLogs                *connect.Client[v1.StreamLogsRequest, v1.StreamLogsResponse]
-	streamEvents              *connect.Client[v1.StreamEventsRequest, v1.StreamEventsResponse]
-	stop                      *connect.Client[v1.StopRequest, v1.StopResponse]
-	getClientInfo             *connect.Client[v1.GetClientInfoRequest, v1.GetClientInfoResponse]
-	getServers                *connect.Client[v1.GetServersRequest, v1.GetServersResponse]
-	createServer              *connect.Client[v1.CreateServerRequest, v1.CreateServerResponse]
-	deleteServer              *connect.Client[v1.DeleteServerRequest, v1.DeleteServerResponse]
-	connectServer             *connect.Client[v1.ConnectServerRequest, v1.ConnectServerResponse]
-	disconnectServer          *connect.Client[v1.DisconnectServerRequest, v1.DisconnectServerResponse]
-	updateServer              *connect.Client[v1.UpdateServerRequest, v1.UpdateServerResponse]
-	getShares                 *connect.Client[v1.GetSharesRequest, v1.GetSharesResponse]
-	createShare               *connect.Client[v1.CreateShareRequest, v1.CreateShareResponse]
-	deleteShare               *connect.Client[v1.DeleteShareRequest, v1.DeleteShareResponse]
-	getDirFiles               *connect.Client[v1.GetDirFilesRequest, v1.GetDirFilesResponse]
-	getFileMeta               *connect.Client[v1.GetFileMetaRequest, v1.GetFileMetaResponse]
-	getOnlineUsers            *connect.Client[v1.GetOnlineUsersRequest, v1.GetOnlineUsersResponse]
-	changeAccountPassword     *connect.Client[v1.ChangeAccountPasswordRequest, v1.ChangeAccountPasswordResponse]
-	serverConnect             *connect.Client[v1.ServerConnectRequest, v1.ServerConnectResponse]
-	serverDisconnect          *connect.Client[v1.ServerDisconnectRequest, v1.ServerDisconnectResponse]
-	getDirectSettings         *connect.Client[v1.GetDirectSettingsRequest, v1.GetDirectSettingsResponse]
-	updateDirectSettings      *connect.Client[v1.UpdateDirectSettingsRequest, v1.UpdateDirectSettingsResponse]
-	getTransferSettings       *connect.Client[v1.GetTransferSettingsRequest, v1.GetTransferSettingsResponse]
-	updateTransferSettings    *connect.Client[v1.UpdateTransferSettingsRequest, v1.UpdateTransferSettingsResponse]
-	indexShare                *connect.Client[v1.IndexShareRequest, v1.IndexShareResponse]
-	streamSearch              *connect.Client[v1.StreamSearchRequest, v1.StreamSearchResponse]
-	getUpdateInfo             *connect.Client[v1.GetUpdateInfoRequest, v1.GetUpdateInfoResponse]
-	checkForNewUpdate         *connect.Client[v1.CheckForNewUpdateRequest, v1.CheckForNewUpdateResponse]
-	getDownloadManagerItems   *connect.Client[v1.GetDownloadManagerItemsRequest, v1.GetDownloadManagerItemsResponse]
-	queueFileDownload         *connect.Client[v1.QueueFileDownloadRequest, v1.QueueFileDownloadResponse]
-	cancelFileDownload        *connect.Client[v1.CancelFileDownloadRequest, v1.CancelFileDownloadResponse]
-	removeDownloadManagerItem *connect.Client[v1.RemoveDownloadManagerItemRequest, v1.RemoveDownloadManagerItemResponse]
-	resumeFileDownload        *connect.Client[v1.ResumeFileDownloadRequest, v1.ResumeFileDownloadResponse]
-}
-
-// StreamLogs calls pb.clientrpc.v1.ClientRpcService.StreamLogs.
-func (c *clientRpcServiceClient) StreamLogs(ctx context.Context, req *v1.StreamLogsRequest) (*connect.ServerStreamForClient[v1.StreamLogsResponse], error) {
-	return c.streamLogs.CallServerStream(ctx, connect.NewRequest(req))
-}
-
-// StreamEvents calls pb.clientrpc.v1.ClientRpcService.StreamEvents.
-func (c *clientRpcServiceClient) StreamEvents(ctx context.Context, req *v1.StreamEventsRequest) (*connect.ServerStreamForClient[v1.StreamEventsResponse], error) {
-	return c.streamEvents.CallServerStream(ctx, connect.NewRequest(req))
-}
-
-// Stop calls pb.clientrpc.v1.ClientRpcService.Stop.
-func (c *clientRpcServiceClient) Stop(ctx context.Context, req *v1.StopRequest) (*v1.StopResponse, error) {
-	response, err := c.stop.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// GetClientInfo calls pb.clientrpc.v1.ClientRpcService.GetClientInfo.
-func (c *clientRpcServiceClient) GetClientInfo(ctx context.Context, req *v1.GetClientInfoRequest) (*v1.GetClientInfoResponse, error) {
-	response, err := c.getClientInfo.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// GetServers calls pb.clientrpc.v1.ClientRpcService.GetServers.
-func (c *clientRpcServiceClient) GetServers(ctx context.Context, req *v1.GetServersRequest) (*v1.GetServersResponse, error) {
-	response, err := c.getServers.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// CreateServer calls pb.clientrpc.v1.ClientRpcService.CreateServer.
-func (c *clientRpcServiceClient) CreateServer(ctx context.Context, req *v1.CreateServerRequest) (*v1.CreateServerResponse, error) {
-	response, err := c.createServer.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// DeleteServer calls pb.clientrpc.v1.ClientRpcService.DeleteServer.
-func (c *clientRpcServiceClient) DeleteServer(ctx context.Context, req *v1.DeleteServerRequest) (*v1.DeleteServerResponse, error) {
-	response, err := c.deleteServer.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// ConnectServer calls pb.clientrpc.v1.ClientRpcService.ConnectServer.
-func (c *clientRpcServiceClient) ConnectServer(ctx context.Context, req *v1.ConnectServerRequest) (*v1.ConnectServerResponse, error) {
-	response, err := c.connectServer.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// DisconnectServer calls pb.clientrpc.v1.ClientRpcService.DisconnectServer.
-func (c *clientRpcServiceClient) DisconnectServer(ctx context.Context, req *v1.DisconnectServerRequest) (*v1.DisconnectServerResponse, error) {
-	response, err := c.disconnectServer.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// UpdateServer calls pb.clientrpc.v1.ClientRpcService.UpdateServer.
-func (c *clientRpcServiceClient) UpdateServer(ctx context.Context, req *v1.UpdateServerRequest) (*v1.UpdateServerResponse, error) {
-	response, err := c.updateServer.CallUnary(ctx, connect.NewRequest(req))
-	if response != nil {
-		return response.Msg, err
-	}
-	return nil, err
-}
-
-// GetShares calls pb.clientrpc.v1.ClientRpcService.GetShares.
-func (c *clientRpcServiceClient) GetShares(ctx context.Context, req *v1.GetSharesRequest) (*v1.GetSharesResponse, error) {
+		getDownloadHooks: connect.NewClient[v1.GetDownloadHooksRequest, v1.GetDownloadHooksResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetDownloadHooksProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetDownloadHooks")),
+			connect.WithClientOptions(opts...),
+		),
+		createDownloadHook: connect.NewClient[v1.CreateDownloadHookRequest, v1.CreateDownloadHookResponse](
+			httpClient,
+			baseURL+ClientRpcServiceCreateDownloadHookProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("CreateDownloadHook")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteDownloadHook: connect.NewClient[v1.DeleteDownloadHookRequest, v1.DeleteDownloadHookResponse](
+			httpClient,
+			baseURL+ClientRpcServiceDeleteDownloadHookProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("DeleteDownloadHook")),
+			connect.WithClientOptions(opts...),
+		),
+		getUploads: connect.NewClient[v1.GetUploadsRequest, v1.GetUploadsResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetUploadsProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetUploads")),
+			connect.WithClientOptions(opts...),
+		),
+		clearUploadHistory: connect.NewClient[v1.ClearUploadHistoryRequest, v1.ClearUploadHistoryResponse](
+			httpClient,
+			baseURL+ClientRpcServiceClearUploadHistoryProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("ClearUploadHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		getFriends: connect.NewClient[v1.GetFriendsRequest, v1.GetFriendsResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetFriendsProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetFriends")),
+			connect.WithClientOptions(opts...),
+		),
+		setFriend: connect.NewClient[v1.SetFriendRequest, v1.SetFriendResponse](
+			httpClient,
+			baseURL+ClientRpcServiceSetFriendProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("SetFriend")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteFriend: connect.NewClient[v1.DeleteFriendRequest, v1.DeleteFriendResponse](
+			httpClient,
+			baseURL+ClientRpcServiceDeleteFriendProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("DeleteFriend")),
+			connect.WithClientOptions(opts...),
+		),
+		getBlockedPeers: connect.NewClient[v1.GetBlockedPeersRequest, v1.GetBlockedPeersResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetBlockedPeersProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetBlockedPeers")),
+			connect.WithClientOptions(opts...),
+		),
+		blockPeer: connect.NewClient[v1.BlockPeerRequest, v1.BlockPeerResponse](
+			httpClient,
+			baseURL+ClientRpcServiceBlockPeerProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("BlockPeer")),
+			connect.WithClientOptions(opts...),
+		),
+		unblockPeer: connect.NewClient[v1.UnblockPeerRequest, v1.UnblockPeerResponse](
+			httpClient,
+			baseURL+ClientRpcServiceUnblockPeerProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("UnblockPeer")),
+			connect.WithClientOptions(opts...),
+		),
+		getInterests: connect.NewClient[v1.GetInterestsRequest, v1.GetInterestsResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetInterestsProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetInterests")),
+			connect.WithClientOptions(opts...),
+		),
+		setInterests: connect.NewClient[v1.SetInterestsRequest, v1.SetInterestsResponse](
+			httpClient,
+			baseURL+ClientRpcServiceSetInterestsProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("SetInterests")),
+			connect.WithClientOptions(opts...),
+		),
+		getSimilarUsers: connect.NewClient[v1.GetSimilarUsersRequest, v1.GetSimilarUsersResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetSimilarUsersProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetSimilarUsers")),
+			connect.WithClientOptions(opts...),
+		),
+		getServerSchedule: connect.NewClient[v1.GetServerScheduleRequest, v1.GetServerScheduleResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetServerScheduleProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetServerSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		setServerSchedule: connect.NewClient[v1.SetServerScheduleRequest, v1.SetServerScheduleResponse](
+			httpClient,
+			baseURL+ClientRpcServiceSetServerScheduleProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("SetServerSchedule")),
+			connect.WithClientOptions(opts...),
+		),
+		getSnooze: connect.NewClient[v1.GetSnoozeRequest, v1.GetSnoozeResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetSnoozeProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetSnooze")),
+			connect.WithClientOptions(opts...),
+		),
+		snooze: connect.NewClient[v1.SnoozeRequest, v1.SnoozeResponse](
+			httpClient,
+			baseURL+ClientRpcServiceSnoozeProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("Snooze")),
+			connect.WithClientOptions(opts...),
+		),
+		unsnooze: connect.NewClient[v1.UnsnoozeRequest, v1.UnsnoozeResponse](
+			httpClient,
+			baseURL+ClientRpcServiceUnsnoozeProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("Unsnooze")),
+			connect.WithClientOptions(opts...),
+		),
+		getRunHistory: connect.NewClient[v1.GetRunHistoryRequest, v1.GetRunHistoryResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetRunHistoryProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetRunHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		getConnHistory: connect.NewClient[v1.GetConnHistoryRequest, v1.GetConnHistoryResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetConnHistoryProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetConnHistory")),
+			connect.WithClientOptions(opts...),
+		),
+		getTrash: connect.NewClient[v1.GetTrashRequest, v1.GetTrashResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetTrashProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetTrash")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreServer: connect.NewClient[v1.RestoreServerRequest, v1.RestoreServerResponse](
+			httpClient,
+			baseURL+ClientRpcServiceRestoreServerProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("RestoreServer")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeServer: connect.NewClient[v1.PurgeServerRequest, v1.PurgeServerResponse](
+			httpClient,
+			baseURL+ClientRpcServicePurgeServerProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("PurgeServer")),
+			connect.WithClientOptions(opts...),
+		),
+		restoreShare: connect.NewClient[v1.RestoreShareRequest, v1.RestoreShareResponse](
+			httpClient,
+			baseURL+ClientRpcServiceRestoreShareProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("RestoreShare")),
+			connect.WithClientOptions(opts...),
+		),
+		purgeShare: connect.NewClient[v1.PurgeShareRequest, v1.PurgeShareResponse](
+			httpClient,
+			baseURL+ClientRpcServicePurgeShareProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("PurgeShare")),
+			connect.WithClientOptions(opts...),
+		),
+		getPlugins: connect.NewClient[v1.GetPluginsRequest, v1.GetPluginsResponse](
+			httpClient,
+			baseURL+ClientRpcServiceGetPluginsProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("GetPlugins")),
+			connect.WithClientOptions(opts...),
+		),
+		createPlugin: connect.NewClient[v1.CreatePluginRequest, v1.CreatePluginResponse](
+			httpClient,
+			baseURL+ClientRpcServiceCreatePluginProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("CreatePlugin")),
+			connect.WithClientOptions(opts...),
+		),
+		deletePlugin: connect.NewClient[v1.DeletePluginRequest, v1.DeletePluginResponse](
+			httpClient,
+			baseURL+ClientRpcServiceDeletePluginProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("DeletePlugin")),
+			connect.WithClientOptions(opts...),
+		),
+		streamPluginEvents: connect.NewClient[v1.StreamPluginEventsRequest, v1.StreamPluginEventsResponse](
+			httpClient,
+			baseURL+ClientRpcServiceStreamPluginEventsProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("StreamPluginEvents")),
+			connect.WithClientOptions(opts...),
+		),
+		respondToSearch: connect.NewClient[v1.RespondToSearchRequest, v1.RespondToSearchResponse](
+			httpClient,
+			baseURL+ClientRpcServiceRespondToSearchProcedure,
+			connect.WithSchema(clientRpcServiceMethods.ByName("RespondToSearch")),
+			connect.WithClientOptions(opts...),
+		),
+	}
+}
+
+// clientRpcServiceClient implements ClientRpcServiceClient.
+type clientRpcServiceClient struct {
+	streamLogs                      *connect.Client[v1.StreamLogsRequest, v1.StreamLogsResponse]
+	streamEvents                    *connect.Client[v1.StreamEventsRequest, v1.StreamEventsResponse]
+	stop                            *connect.Client[v1.StopRequest, v1.StopResponse]
+	getClientInfo                   *connect.Client[v1.GetClientInfoRequest, v1.GetClientInfoResponse]
+	getMemoryUsage                  *connect.Client[v1.GetMemoryUsageRequest, v1.GetMemoryUsageResponse]
+	setLowMemoryMode                *connect.Client[v1.SetLowMemoryModeRequest, v1.SetLowMemoryModeResponse]
+	getServers                      *connect.Client[v1.GetServersRequest, v1.GetServersResponse]
+	createServer                    *connect.Client[v1.CreateServerRequest, v1.CreateServerResponse]
+	importInviteBundle              *connect.Client[v1.ImportInviteBundleRequest, v1.ImportInviteBundleResponse]
+	createAccountTemplate           *connect.Client[v1.CreateAccountTemplateRequest, v1.CreateAccountTemplateResponse]
+	createAccountTemplateFromServer *connect.Client[v1.CreateAccountTemplateFromServerRequest, v1.CreateAccountTemplateFromServerResponse]
+	getAccountTemplates             *connect.Client[v1.GetAccountTemplatesRequest, v1.GetAccountTemplatesResponse]
+	deleteAccountTemplate           *connect.Client[v1.DeleteAccountTemplateRequest, v1.DeleteAccountTemplateResponse]
+	startHosting                    *connect.Client[v1.StartHostingRequest, v1.StartHostingResponse]
+	stopHosting                     *connect.Client[v1.StopHostingRequest, v1.StopHostingResponse]
+	getHostingInfo                  *connect.Client[v1.GetHostingInfoRequest, v1.GetHostingInfoResponse]
+	createHostingInvite             *connect.Client[v1.CreateHostingInviteRequest, v1.CreateHostingInviteResponse]
+	deleteServer                    *connect.Client[v1.DeleteServerRequest, v1.DeleteServerResponse]
+	connectServer                   *connect.Client[v1.ConnectServerRequest, v1.ConnectServerResponse]
+	disconnectServer                *connect.Client[v1.DisconnectServerRequest, v1.DisconnectServerResponse]
+	updateServer                    *connect.Client[v1.UpdateServerRequest, v1.UpdateServerResponse]
+	getShares                       *connect.Client[v1.GetSharesRequest, v1.GetSharesResponse]
+	createShare                     *connect.Client[v1.CreateShareRequest, v1.CreateShareResponse]
+	deleteShare                     *connect.Client[v1.DeleteShareRequest, v1.DeleteShareResponse]
+	setShareExcludePatterns         *connect.Client[v1.SetShareExcludePatternsRequest, v1.SetShareExcludePatternsResponse]
+	checkShareHealth                *connect.Client[v1.CheckShareHealthRequest, v1.CheckShareHealthResponse]
+	importShares                    *connect.Client[v1.ImportSharesRequest, v1.ImportSharesResponse]
+	createShareLink                 *connect.Client[v1.CreateShareLinkRequest, v1.CreateShareLinkResponse]
+	getShareLinks                   *connect.Client[v1.GetShareLinksRequest, v1.GetShareLinksResponse]
+	deleteShareLink                 *connect.Client[v1.DeleteShareLinkRequest, v1.DeleteShareLinkResponse]
+	getDirFiles                     *connect.Client[v1.GetDirFilesRequest, v1.GetDirFilesResponse]
+	streamDirArchive                *connect.Client[v1.StreamDirArchiveRequest, v1.StreamDirArchiveResponse]
+	getFileMeta                     *connect.Client[v1.GetFileMetaRequest, v1.GetFileMetaResponse]
+	createFileLink                  *connect.Client[v1.CreateFileLinkRequest, v1.CreateFileLinkResponse]
+	measurePeer                     *connect.Client[v1.MeasurePeerRequest, v1.MeasurePeerResponse]
+	diagnose                        *connect.Client[v1.DiagnoseRequest, v1.DiagnoseResponse]
+	getOnlineUsers                  *connect.Client[v1.GetOnlineUsersRequest, v1.GetOnlineUsersResponse]
+	changeAccountPassword           *connect.Client[v1.ChangeAccountPasswordRequest, v1.ChangeAccountPasswordResponse]
+	serverConnect                   *connect.Client[v1.ServerConnectRequest, v1.ServerConnectResponse]
+	serverDisconnect                *connect.Client[v1.ServerDisconnectRequest, v1.ServerDisconnectResponse]
+	getDirectSettings               *connect.Client[v1.GetDirectSettingsRequest, v1.GetDirectSettingsResponse]
+	updateDirectSettings            *connect.Client[v1.UpdateDirectSettingsRequest, v1.UpdateDirectSettingsResponse]
+	getTransferSettings             *connect.Client[v1.GetTransferSettingsRequest, v1.GetTransferSettingsResponse]
+	updateTransferSettings          *connect.Client[v1.UpdateTransferSettingsRequest, v1.UpdateTransferSettingsResponse]
+	getNotificationSettings         *connect.Client[v1.GetNotificationSettingsRequest, v1.GetNotificationSettingsResponse]
+	updateNotificationSettings      *connect.Client[v1.UpdateNotificationSettingsRequest, v1.UpdateNotificationSettingsResponse]
+	exportConfig                    *connect.Client[v1.ExportConfigRequest, v1.ExportConfigResponse]
+	importConfig                    *connect.Client[v1.ImportConfigRequest, v1.ImportConfigResponse]
+	backupDatabase                  *connect.Client[v1.BackupDatabaseRequest, v1.BackupDatabaseResponse]
+	checkDatabaseIntegrity          *connect.Client[v1.CheckDatabaseIntegrityRequest, v1.CheckDatabaseIntegrityResponse]
+	indexShare                      *connect.Client[v1.IndexShareRequest, v1.IndexShareResponse]
+	streamSearch                    *connect.Client[v1.StreamSearchRequest, v1.StreamSearchResponse]
+	getUpdateInfo                   *connect.Client[v1.GetUpdateInfoRequest, v1.GetUpdateInfoResponse]
+	checkForNewUpdate               *connect.Client[v1.CheckForNewUpdateRequest, v1.CheckForNewUpdateResponse]
+	applyUpdate                     *connect.Client[v1.ApplyUpdateRequest, v1.ApplyUpdateResponse]
+	getUpdateSettings               *connect.Client[v1.GetUpdateSettingsRequest, v1.GetUpdateSettingsResponse]
+	updateUpdateSettings            *connect.Client[v1.UpdateUpdateSettingsRequest, v1.UpdateUpdateSettingsResponse]
+	getDownloadManagerItems         *connect.Client[v1.GetDownloadManagerItemsRequest, v1.GetDownloadManagerItemsResponse]
+	queueFileDownload               *connect.Client[v1.QueueFileDownloadRequest, v1.QueueFileDownloadResponse]
+	cancelFileDownload              *connect.Client[v1.CancelFileDownloadRequest, v1.CancelFileDownloadResponse]
+	removeDownloadManagerItem       *connect.Client[v1.RemoveDownloadManagerItemRequest, v1.RemoveDownloadManagerItemResponse]
+	pauseFileDownload               *connect.Client[v1.PauseFileDownloadRequest, v1.PauseFileDownloadResponse]
+	resumeFileDownload              *connect.Client[v1.ResumeFileDownloadRequest, v1.ResumeFileDownloadResponse]
+	getDownloadHooks                *connect.Client[v1.GetDownloadHooksRequest, v1.GetDownloadHooksResponse]
+	createDownloadHook              *connect.Client[v1.CreateDownloadHookRequest, v1.CreateDownloadHookResponse]
+	deleteDownloadHook              *connect.Client[v1.DeleteDownloadHookRequest, v1.DeleteDownloadHookResponse]
+	getUploads                      *connect.Client[v1.GetUploadsRequest, v1.GetUploadsResponse]
+	clearUploadHistory              *connect.Client[v1.ClearUploadHistoryRequest, v1.ClearUploadHistoryResponse]
+	getFriends                      *connect.Client[v1.GetFriendsRequest, v1.GetFriendsResponse]
+	setFriend                       *connect.Client[v1.SetFriendRequest, v1.SetFriendResponse]
+	deleteFriend                    *connect.Client[v1.DeleteFriendRequest, v1.DeleteFriendResponse]
+	getBlockedPeers                 *connect.Client[v1.GetBlockedPeersRequest, v1.GetBlockedPeersResponse]
+	blockPeer                       *connect.Client[v1.BlockPeerRequest, v1.BlockPeerResponse]
+	unblockPeer                     *connect.Client[v1.UnblockPeerRequest, v1.UnblockPeerResponse]
+	getInterests                    *connect.Client[v1.GetInterestsRequest, v1.GetInterestsResponse]
+	setInterests                    *connect.Client[v1.SetInterestsRequest, v1.SetInterestsResponse]
+	getSimilarUsers                 *connect.Client[v1.GetSimilarUsersRequest, v1.GetSimilarUsersResponse]
+	getServerSchedule               *connect.Client[v1.GetServerScheduleRequest, v1.GetServerScheduleResponse]
+	setServerSchedule               *connect.Client[v1.SetServerScheduleRequest, v1.SetServerScheduleResponse]
+	getSnooze                       *connect.Client[v1.GetSnoozeRequest, v1.GetSnoozeResponse]
+	snooze                          *connect.Client[v1.SnoozeRequest, v1.SnoozeResponse]
+	unsnooze                        *connect.Client[v1.UnsnoozeRequest, v1.UnsnoozeResponse]
+	getRunHistory                   *connect.Client[v1.GetRunHistoryRequest, v1.GetRunHistoryResponse]
+	getConnHistory                  *connect.Client[v1.GetConnHistoryRequest, v1.GetConnHistoryResponse]
+	getTrash                        *connect.Client[v1.GetTrashRequest, v1.GetTrashResponse]
+	restoreServer                   *connect.Client[v1.RestoreServerRequest, v1.RestoreServerResponse]
+	purgeServer                     *connect.Client[v1.PurgeServerRequest, v1.PurgeServerResponse]
+	restoreShare                    *connect.Client[v1.RestoreShareRequest, v1.RestoreShareResponse]
+	purgeShare                      *connect.Client[v1.PurgeShareRequest, v1.PurgeShareResponse]
+	getPlugins                      *connect.Client[v1.GetPluginsRequest, v1.GetPluginsResponse]
+	createPlugin                    *connect.Client[v1.CreatePluginRequest, v1.CreatePluginResponse]
+	deletePlugin                    *connect.Client[v1.DeletePluginRequest, v1.DeletePluginResponse]
+	streamPluginEvents              *connect.Client[v1.StreamPluginEventsRequest, v1.StreamPluginEventsResponse]
+	respondToSearch                 *connect.Client[v1.RespondToSearchRequest, v1.RespondToSearchResponse]
+}
+
+// StreamLogs calls pb.clientrpc.v1.ClientRpcService.StreamLogs.
+func (c *clientRpcServiceClient) StreamLogs(ctx context.Context, req *v1.StreamLogsRequest) (*connect.ServerStreamForClient[v1.StreamLogsResponse], error) {
+	return c.streamLogs.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// StreamEvents calls pb.clientrpc.v1.ClientRpcService.StreamEvents.
+func (c *clientRpcServiceClient) StreamEvents(ctx context.Context, req *v1.StreamEventsRequest) (*connect.ServerStreamForClient[v1.StreamEventsResponse], error) {
+	return c.streamEvents.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// Stop calls pb.clientrpc.v1.ClientRpcService.Stop.
+func (c *clientRpcServiceClient) Stop(ctx context.Context, req *v1.StopRequest) (*v1.StopResponse, error) {
+	response, err := c.stop.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetClientInfo calls pb.clientrpc.v1.ClientRpcService.GetClientInfo.
+func (c *clientRpcServiceClient) GetClientInfo(ctx context.Context, req *v1.GetClientInfoRequest) (*v1.GetClientInfoResponse, error) {
+	response, err := c.getClientInfo.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetMemoryUsage calls pb.clientrpc.v1.ClientRpcService.GetMemoryUsage.
+func (c *clientRpcServiceClient) GetMemoryUsage(ctx context.Context, req *v1.GetMemoryUsageRequest) (*v1.GetMemoryUsageResponse, error) {
+	response, err := c.getMemoryUsage.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetLowMemoryMode calls pb.clientrpc.v1.ClientRpcService.SetLowMemoryMode.
+func (c *clientRpcServiceClient) SetLowMemoryMode(ctx context.Context, req *v1.SetLowMemoryModeRequest) (*v1.SetLowMemoryModeResponse, error) {
+	response, err := c.setLowMemoryMode.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetServers calls pb.clientrpc.v1.ClientRpcService.GetServers.
+func (c *clientRpcServiceClient) GetServers(ctx context.Context, req *v1.GetServersRequest) (*v1.GetServersResponse, error) {
+	response, err := c.getServers.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateServer calls pb.clientrpc.v1.ClientRpcService.CreateServer.
+func (c *clientRpcServiceClient) CreateServer(ctx context.Context, req *v1.CreateServerRequest) (*v1.CreateServerResponse, error) {
+	response, err := c.createServer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ImportInviteBundle calls pb.clientrpc.v1.ClientRpcService.ImportInviteBundle.
+func (c *clientRpcServiceClient) ImportInviteBundle(ctx context.Context, req *v1.ImportInviteBundleRequest) (*v1.ImportInviteBundleResponse, error) {
+	response, err := c.importInviteBundle.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateAccountTemplate calls pb.clientrpc.v1.ClientRpcService.CreateAccountTemplate.
+func (c *clientRpcServiceClient) CreateAccountTemplate(ctx context.Context, req *v1.CreateAccountTemplateRequest) (*v1.CreateAccountTemplateResponse, error) {
+	response, err := c.createAccountTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateAccountTemplateFromServer calls
+// pb.clientrpc.v1.ClientRpcService.CreateAccountTemplateFromServer.
+func (c *clientRpcServiceClient) CreateAccountTemplateFromServer(ctx context.Context, req *v1.CreateAccountTemplateFromServerRequest) (*v1.CreateAccountTemplateFromServerResponse, error) {
+	response, err := c.createAccountTemplateFromServer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetAccountTemplates calls pb.clientrpc.v1.ClientRpcService.GetAccountTemplates.
+func (c *clientRpcServiceClient) GetAccountTemplates(ctx context.Context, req *v1.GetAccountTemplatesRequest) (*v1.GetAccountTemplatesResponse, error) {
+	response, err := c.getAccountTemplates.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteAccountTemplate calls pb.clientrpc.v1.ClientRpcService.DeleteAccountTemplate.
+func (c *clientRpcServiceClient) DeleteAccountTemplate(ctx context.Context, req *v1.DeleteAccountTemplateRequest) (*v1.DeleteAccountTemplateResponse, error) {
+	response, err := c.deleteAccountTemplate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// StartHosting calls pb.clientrpc.v1.ClientRpcService.StartHosting.
+func (c *clientRpcServiceClient) StartHosting(ctx context.Context, req *v1.StartHostingRequest) (*v1.StartHostingResponse, error) {
+	response, err := c.startHosting.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// StopHosting calls pb.clientrpc.v1.ClientRpcService.StopHosting.
+func (c *clientRpcServiceClient) StopHosting(ctx context.Context, req *v1.StopHostingRequest) (*v1.StopHostingResponse, error) {
+	response, err := c.stopHosting.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetHostingInfo calls pb.clientrpc.v1.ClientRpcService.GetHostingInfo.
+func (c *clientRpcServiceClient) GetHostingInfo(ctx context.Context, req *v1.GetHostingInfoRequest) (*v1.GetHostingInfoResponse, error) {
+	response, err := c.getHostingInfo.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateHostingInvite calls pb.clientrpc.v1.ClientRpcService.CreateHostingInvite.
+func (c *clientRpcServiceClient) CreateHostingInvite(ctx context.Context, req *v1.CreateHostingInviteRequest) (*v1.CreateHostingInviteResponse, error) {
+	response, err := c.createHostingInvite.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteServer calls pb.clientrpc.v1.ClientRpcService.DeleteServer.
+func (c *clientRpcServiceClient) DeleteServer(ctx context.Context, req *v1.DeleteServerRequest) (*v1.DeleteServerResponse, error) {
+	response, err := c.deleteServer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ConnectServer calls pb.clientrpc.v1.ClientRpcService.ConnectServer.
+func (c *clientRpcServiceClient) ConnectServer(ctx context.Context, req *v1.ConnectServerRequest) (*v1.ConnectServerResponse, error) {
+	response, err := c.connectServer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DisconnectServer calls pb.clientrpc.v1.ClientRpcService.DisconnectServer.
+func (c *clientRpcServiceClient) DisconnectServer(ctx context.Context, req *v1.DisconnectServerRequest) (*v1.DisconnectServerResponse, error) {
+	response, err := c.disconnectServer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateServer calls pb.clientrpc.v1.ClientRpcService.UpdateServer.
+func (c *clientRpcServiceClient) UpdateServer(ctx context.Context, req *v1.UpdateServerRequest) (*v1.UpdateServerResponse, error) {
+	response, err := c.updateServer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetShares calls pb.clientrpc.v1.ClientRpcService.GetShares.
+func (c *clientRpcServiceClient) GetShares(ctx context.Context, req *v1.GetSharesRequest) (*v1.GetSharesResponse, error) {
 	response, err := c.getShares.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
@@ -609,177 +1662,628 @@ func (c *clientRpcServiceClient) GetShares(ctx context.Context, req *v1.GetShare
 	return nil, err
 }
 
-// CreateShare calls pb.clientrpc.v1.ClientRpcService.CreateShare.
-func (c *clientRpcServiceClient) CreateShare(ctx context.Context, req *v1.CreateShareRequest) (*v1.CreateShareResponse, error) {
-	response, err := c.createShare.CallUnary(ctx, connect.NewRequest(req))
+// CreateShare calls pb.clientrpc.v1.ClientRpcService.CreateShare.
+func (c *clientRpcServiceClient) CreateShare(ctx context.Context, req *v1.CreateShareRequest) (*v1.CreateShareResponse, error) {
+	response, err := c.createShare.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteShare calls pb.clientrpc.v1.ClientRpcService.DeleteShare.
+func (c *clientRpcServiceClient) DeleteShare(ctx context.Context, req *v1.DeleteShareRequest) (*v1.DeleteShareResponse, error) {
+	response, err := c.deleteShare.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetShareExcludePatterns calls pb.clientrpc.v1.ClientRpcService.SetShareExcludePatterns.
+func (c *clientRpcServiceClient) SetShareExcludePatterns(ctx context.Context, req *v1.SetShareExcludePatternsRequest) (*v1.SetShareExcludePatternsResponse, error) {
+	response, err := c.setShareExcludePatterns.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CheckShareHealth calls pb.clientrpc.v1.ClientRpcService.CheckShareHealth.
+func (c *clientRpcServiceClient) CheckShareHealth(ctx context.Context, req *v1.CheckShareHealthRequest) (*v1.CheckShareHealthResponse, error) {
+	response, err := c.checkShareHealth.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ImportShares calls pb.clientrpc.v1.ClientRpcService.ImportShares.
+func (c *clientRpcServiceClient) ImportShares(ctx context.Context, req *v1.ImportSharesRequest) (*v1.ImportSharesResponse, error) {
+	response, err := c.importShares.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateShareLink calls pb.clientrpc.v1.ClientRpcService.CreateShareLink.
+func (c *clientRpcServiceClient) CreateShareLink(ctx context.Context, req *v1.CreateShareLinkRequest) (*v1.CreateShareLinkResponse, error) {
+	response, err := c.createShareLink.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetShareLinks calls pb.clientrpc.v1.ClientRpcService.GetShareLinks.
+func (c *clientRpcServiceClient) GetShareLinks(ctx context.Context, req *v1.GetShareLinksRequest) (*v1.GetShareLinksResponse, error) {
+	response, err := c.getShareLinks.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteShareLink calls pb.clientrpc.v1.ClientRpcService.DeleteShareLink.
+func (c *clientRpcServiceClient) DeleteShareLink(ctx context.Context, req *v1.DeleteShareLinkRequest) (*v1.DeleteShareLinkResponse, error) {
+	response, err := c.deleteShareLink.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetDirFiles calls pb.clientrpc.v1.ClientRpcService.GetDirFiles.
+func (c *clientRpcServiceClient) GetDirFiles(ctx context.Context, req *v1.GetDirFilesRequest) (*connect.ServerStreamForClient[v1.GetDirFilesResponse], error) {
+	return c.getDirFiles.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// StreamDirArchive calls pb.clientrpc.v1.ClientRpcService.StreamDirArchive.
+func (c *clientRpcServiceClient) StreamDirArchive(ctx context.Context, req *v1.StreamDirArchiveRequest) (*connect.ServerStreamForClient[v1.StreamDirArchiveResponse], error) {
+	return c.streamDirArchive.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// GetFileMeta calls pb.clientrpc.v1.ClientRpcService.GetFileMeta.
+func (c *clientRpcServiceClient) GetFileMeta(ctx context.Context, req *v1.GetFileMetaRequest) (*v1.GetFileMetaResponse, error) {
+	response, err := c.getFileMeta.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateFileLink calls pb.clientrpc.v1.ClientRpcService.CreateFileLink.
+func (c *clientRpcServiceClient) CreateFileLink(ctx context.Context, req *v1.CreateFileLinkRequest) (*v1.CreateFileLinkResponse, error) {
+	response, err := c.createFileLink.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// MeasurePeer calls pb.clientrpc.v1.ClientRpcService.MeasurePeer.
+func (c *clientRpcServiceClient) MeasurePeer(ctx context.Context, req *v1.MeasurePeerRequest) (*v1.MeasurePeerResponse, error) {
+	response, err := c.measurePeer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Diagnose calls pb.clientrpc.v1.ClientRpcService.Diagnose.
+func (c *clientRpcServiceClient) Diagnose(ctx context.Context, req *v1.DiagnoseRequest) (*v1.DiagnoseResponse, error) {
+	response, err := c.diagnose.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetOnlineUsers calls pb.clientrpc.v1.ClientRpcService.GetOnlineUsers.
+func (c *clientRpcServiceClient) GetOnlineUsers(ctx context.Context, req *v1.GetOnlineUsersRequest) (*connect.ServerStreamForClient[v1.GetOnlineUsersResponse], error) {
+	return c.getOnlineUsers.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// ChangeAccountPassword calls pb.clientrpc.v1.ClientRpcService.ChangeAccountPassword.
+func (c *clientRpcServiceClient) ChangeAccountPassword(ctx context.Context, req *v1.ChangeAccountPasswordRequest) (*v1.ChangeAccountPasswordResponse, error) {
+	response, err := c.changeAccountPassword.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ServerConnect calls pb.clientrpc.v1.ClientRpcService.ServerConnect.
+func (c *clientRpcServiceClient) ServerConnect(ctx context.Context, req *v1.ServerConnectRequest) (*v1.ServerConnectResponse, error) {
+	response, err := c.serverConnect.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ServerDisconnect calls pb.clientrpc.v1.ClientRpcService.ServerDisconnect.
+func (c *clientRpcServiceClient) ServerDisconnect(ctx context.Context, req *v1.ServerDisconnectRequest) (*v1.ServerDisconnectResponse, error) {
+	response, err := c.serverDisconnect.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetDirectSettings calls pb.clientrpc.v1.ClientRpcService.GetDirectSettings.
+func (c *clientRpcServiceClient) GetDirectSettings(ctx context.Context, req *v1.GetDirectSettingsRequest) (*v1.GetDirectSettingsResponse, error) {
+	response, err := c.getDirectSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateDirectSettings calls pb.clientrpc.v1.ClientRpcService.UpdateDirectSettings.
+func (c *clientRpcServiceClient) UpdateDirectSettings(ctx context.Context, req *v1.UpdateDirectSettingsRequest) (*v1.UpdateDirectSettingsResponse, error) {
+	response, err := c.updateDirectSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetTransferSettings calls pb.clientrpc.v1.ClientRpcService.GetTransferSettings.
+func (c *clientRpcServiceClient) GetTransferSettings(ctx context.Context, req *v1.GetTransferSettingsRequest) (*v1.GetTransferSettingsResponse, error) {
+	response, err := c.getTransferSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateTransferSettings calls pb.clientrpc.v1.ClientRpcService.UpdateTransferSettings.
+func (c *clientRpcServiceClient) UpdateTransferSettings(ctx context.Context, req *v1.UpdateTransferSettingsRequest) (*v1.UpdateTransferSettingsResponse, error) {
+	response, err := c.updateTransferSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetNotificationSettings calls pb.clientrpc.v1.ClientRpcService.GetNotificationSettings.
+func (c *clientRpcServiceClient) GetNotificationSettings(ctx context.Context, req *v1.GetNotificationSettingsRequest) (*v1.GetNotificationSettingsResponse, error) {
+	response, err := c.getNotificationSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateNotificationSettings calls pb.clientrpc.v1.ClientRpcService.UpdateNotificationSettings.
+func (c *clientRpcServiceClient) UpdateNotificationSettings(ctx context.Context, req *v1.UpdateNotificationSettingsRequest) (*v1.UpdateNotificationSettingsResponse, error) {
+	response, err := c.updateNotificationSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ExportConfig calls pb.clientrpc.v1.ClientRpcService.ExportConfig.
+func (c *clientRpcServiceClient) ExportConfig(ctx context.Context, req *v1.ExportConfigRequest) (*v1.ExportConfigResponse, error) {
+	response, err := c.exportConfig.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ImportConfig calls pb.clientrpc.v1.ClientRpcService.ImportConfig.
+func (c *clientRpcServiceClient) ImportConfig(ctx context.Context, req *v1.ImportConfigRequest) (*v1.ImportConfigResponse, error) {
+	response, err := c.importConfig.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BackupDatabase calls pb.clientrpc.v1.ClientRpcService.BackupDatabase.
+func (c *clientRpcServiceClient) BackupDatabase(ctx context.Context, req *v1.BackupDatabaseRequest) (*v1.BackupDatabaseResponse, error) {
+	response, err := c.backupDatabase.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CheckDatabaseIntegrity calls pb.clientrpc.v1.ClientRpcService.CheckDatabaseIntegrity.
+func (c *clientRpcServiceClient) CheckDatabaseIntegrity(ctx context.Context, req *v1.CheckDatabaseIntegrityRequest) (*v1.CheckDatabaseIntegrityResponse, error) {
+	response, err := c.checkDatabaseIntegrity.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// IndexShare calls pb.clientrpc.v1.ClientRpcService.IndexShare.
+func (c *clientRpcServiceClient) IndexShare(ctx context.Context, req *v1.IndexShareRequest) (*v1.IndexShareResponse, error) {
+	response, err := c.indexShare.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// StreamSearch calls pb.clientrpc.v1.ClientRpcService.StreamSearch.
+func (c *clientRpcServiceClient) StreamSearch(ctx context.Context, req *v1.StreamSearchRequest) (*connect.ServerStreamForClient[v1.StreamSearchResponse], error) {
+	return c.streamSearch.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// GetUpdateInfo calls pb.clientrpc.v1.ClientRpcService.GetUpdateInfo.
+func (c *clientRpcServiceClient) GetUpdateInfo(ctx context.Context, req *v1.GetUpdateInfoRequest) (*v1.GetUpdateInfoResponse, error) {
+	response, err := c.getUpdateInfo.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CheckForNewUpdate calls pb.clientrpc.v1.ClientRpcService.CheckForNewUpdate.
+func (c *clientRpcServiceClient) CheckForNewUpdate(ctx context.Context, req *v1.CheckForNewUpdateRequest) (*v1.CheckForNewUpdateResponse, error) {
+	response, err := c.checkForNewUpdate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ApplyUpdate calls pb.clientrpc.v1.ClientRpcService.ApplyUpdate.
+func (c *clientRpcServiceClient) ApplyUpdate(ctx context.Context, req *v1.ApplyUpdateRequest) (*v1.ApplyUpdateResponse, error) {
+	response, err := c.applyUpdate.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetUpdateSettings calls pb.clientrpc.v1.ClientRpcService.GetUpdateSettings.
+func (c *clientRpcServiceClient) GetUpdateSettings(ctx context.Context, req *v1.GetUpdateSettingsRequest) (*v1.GetUpdateSettingsResponse, error) {
+	response, err := c.getUpdateSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateUpdateSettings calls pb.clientrpc.v1.ClientRpcService.UpdateUpdateSettings.
+func (c *clientRpcServiceClient) UpdateUpdateSettings(ctx context.Context, req *v1.UpdateUpdateSettingsRequest) (*v1.UpdateUpdateSettingsResponse, error) {
+	response, err := c.updateUpdateSettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetDownloadManagerItems calls pb.clientrpc.v1.ClientRpcService.GetDownloadManagerItems.
+func (c *clientRpcServiceClient) GetDownloadManagerItems(ctx context.Context, req *v1.GetDownloadManagerItemsRequest) (*v1.GetDownloadManagerItemsResponse, error) {
+	response, err := c.getDownloadManagerItems.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// DeleteShare calls pb.clientrpc.v1.ClientRpcService.DeleteShare.
-func (c *clientRpcServiceClient) DeleteShare(ctx context.Context, req *v1.DeleteShareRequest) (*v1.DeleteShareResponse, error) {
-	response, err := c.deleteShare.CallUnary(ctx, connect.NewRequest(req))
+// QueueFileDownload calls pb.clientrpc.v1.ClientRpcService.QueueFileDownload.
+func (c *clientRpcServiceClient) QueueFileDownload(ctx context.Context, req *v1.QueueFileDownloadRequest) (*v1.QueueFileDownloadResponse, error) {
+	response, err := c.queueFileDownload.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// GetDirFiles calls pb.clientrpc.v1.ClientRpcService.GetDirFiles.
-func (c *clientRpcServiceClient) GetDirFiles(ctx context.Context, req *v1.GetDirFilesRequest) (*connect.ServerStreamForClient[v1.GetDirFilesResponse], error) {
-	return c.getDirFiles.CallServerStream(ctx, connect.NewRequest(req))
+// CancelFileDownload calls pb.clientrpc.v1.ClientRpcService.CancelFileDownload.
+func (c *clientRpcServiceClient) CancelFileDownload(ctx context.Context, req *v1.CancelFileDownloadRequest) (*v1.CancelFileDownloadResponse, error) {
+	response, err := c.cancelFileDownload.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
 }
 
-// GetFileMeta calls pb.clientrpc.v1.ClientRpcService.GetFileMeta.
-func (c *clientRpcServiceClient) GetFileMeta(ctx context.Context, req *v1.GetFileMetaRequest) (*v1.GetFileMetaResponse, error) {
-	response, err := c.getFileMeta.CallUnary(ctx, connect.NewRequest(req))
+// RemoveDownloadManagerItem calls pb.clientrpc.v1.ClientRpcService.RemoveDownloadManagerItem.
+func (c *clientRpcServiceClient) RemoveDownloadManagerItem(ctx context.Context, req *v1.RemoveDownloadManagerItemRequest) (*v1.RemoveDownloadManagerItemResponse, error) {
+	response, err := c.removeDownloadManagerItem.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// GetOnlineUsers calls pb.clientrpc.v1.ClientRpcService.GetOnlineUsers.
-func (c *clientRpcServiceClient) GetOnlineUsers(ctx context.Context, req *v1.GetOnlineUsersRequest) (*connect.ServerStreamForClient[v1.GetOnlineUsersResponse], error) {
-	return c.getOnlineUsers.CallServerStream(ctx, connect.NewRequest(req))
+// PauseFileDownload calls pb.clientrpc.v1.ClientRpcService.PauseFileDownload.
+func (c *clientRpcServiceClient) PauseFileDownload(ctx context.Context, req *v1.PauseFileDownloadRequest) (*v1.PauseFileDownloadResponse, error) {
+	response, err := c.pauseFileDownload.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
 }
 
-// ChangeAccountPassword calls pb.clientrpc.v1.ClientRpcService.ChangeAccountPassword.
-func (c *clientRpcServiceClient) ChangeAccountPassword(ctx context.Context, req *v1.ChangeAccountPasswordRequest) (*v1.ChangeAccountPasswordResponse, error) {
-	response, err := c.changeAccountPassword.CallUnary(ctx, connect.NewRequest(req))
+// ResumeFileDownload calls pb.clientrpc.v1.ClientRpcService.ResumeFileDownload.
+func (c *clientRpcServiceClient) ResumeFileDownload(ctx context.Context, req *v1.ResumeFileDownloadRequest) (*v1.ResumeFileDownloadResponse, error) {
+	response, err := c.resumeFileDownload.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// ServerConnect calls pb.clientrpc.v1.ClientRpcService.ServerConnect.
-func (c *clientRpcServiceClient) ServerConnect(ctx context.Context, req *v1.ServerConnectRequest) (*v1.ServerConnectResponse, error) {
-	response, err := c.serverConnect.CallUnary(ctx, connect.NewRequest(req))
+// GetDownloadHooks calls pb.clientrpc.v1.ClientRpcService.GetDownloadHooks.
+func (c *clientRpcServiceClient) GetDownloadHooks(ctx context.Context, req *v1.GetDownloadHooksRequest) (*v1.GetDownloadHooksResponse, error) {
+	response, err := c.getDownloadHooks.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// ServerDisconnect calls pb.clientrpc.v1.ClientRpcService.ServerDisconnect.
-func (c *clientRpcServiceClient) ServerDisconnect(ctx context.Context, req *v1.ServerDisconnectRequest) (*v1.ServerDisconnectResponse, error) {
-	response, err := c.serverDisconnect.CallUnary(ctx, connect.NewRequest(req))
+// CreateDownloadHook calls pb.clientrpc.v1.ClientRpcService.CreateDownloadHook.
+func (c *clientRpcServiceClient) CreateDownloadHook(ctx context.Context, req *v1.CreateDownloadHookRequest) (*v1.CreateDownloadHookResponse, error) {
+	response, err := c.createDownloadHook.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// GetDirectSettings calls pb.clientrpc.v1.ClientRpcService.GetDirectSettings.
-func (c *clientRpcServiceClient) GetDirectSettings(ctx context.Context, req *v1.GetDirectSettingsRequest) (*v1.GetDirectSettingsResponse, error) {
-	response, err := c.getDirectSettings.CallUnary(ctx, connect.NewRequest(req))
+// DeleteDownloadHook calls pb.clientrpc.v1.ClientRpcService.DeleteDownloadHook.
+func (c *clientRpcServiceClient) DeleteDownloadHook(ctx context.Context, req *v1.DeleteDownloadHookRequest) (*v1.DeleteDownloadHookResponse, error) {
+	response, err := c.deleteDownloadHook.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// UpdateDirectSettings calls pb.clientrpc.v1.ClientRpcService.UpdateDirectSettings.
-func (c *clientRpcServiceClient) UpdateDirectSettings(ctx context.Context, req *v1.UpdateDirectSettingsRequest) (*v1.UpdateDirectSettingsResponse, error) {
-	response, err := c.updateDirectSettings.CallUnary(ctx, connect.NewRequest(req))
+// GetUploads calls pb.clientrpc.v1.ClientRpcService.GetUploads.
+func (c *clientRpcServiceClient) GetUploads(ctx context.Context, req *v1.GetUploadsRequest) (*v1.GetUploadsResponse, error) {
+	response, err := c.getUploads.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// GetTransferSettings calls pb.clientrpc.v1.ClientRpcService.GetTransferSettings.
-func (c *clientRpcServiceClient) GetTransferSettings(ctx context.Context, req *v1.GetTransferSettingsRequest) (*v1.GetTransferSettingsResponse, error) {
-	response, err := c.getTransferSettings.CallUnary(ctx, connect.NewRequest(req))
+// ClearUploadHistory calls pb.clientrpc.v1.ClientRpcService.ClearUploadHistory.
+func (c *clientRpcServiceClient) ClearUploadHistory(ctx context.Context, req *v1.ClearUploadHistoryRequest) (*v1.ClearUploadHistoryResponse, error) {
+	response, err := c.clearUploadHistory.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// UpdateTransferSettings calls pb.clientrpc.v1.ClientRpcService.UpdateTransferSettings.
-func (c *clientRpcServiceClient) UpdateTransferSettings(ctx context.Context, req *v1.UpdateTransferSettingsRequest) (*v1.UpdateTransferSettingsResponse, error) {
-	response, err := c.updateTransferSettings.CallUnary(ctx, connect.NewRequest(req))
+// GetFriends calls pb.clientrpc.v1.ClientRpcService.GetFriends.
+func (c *clientRpcServiceClient) GetFriends(ctx context.Context, req *v1.GetFriendsRequest) (*v1.GetFriendsResponse, error) {
+	response, err := c.getFriends.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// IndexShare calls pb.clientrpc.v1.ClientRpcService.IndexShare.
-func (c *clientRpcServiceClient) IndexShare(ctx context.Context, req *v1.IndexShareRequest) (*v1.IndexShareResponse, error) {
-	response, err := c.indexShare.CallUnary(ctx, connect.NewRequest(req))
+// SetFriend calls pb.clientrpc.v1.ClientRpcService.SetFriend.
+func (c *clientRpcServiceClient) SetFriend(ctx context.Context, req *v1.SetFriendRequest) (*v1.SetFriendResponse, error) {
+	response, err := c.setFriend.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// StreamSearch calls pb.clientrpc.v1.ClientRpcService.StreamSearch.
-func (c *clientRpcServiceClient) StreamSearch(ctx context.Context, req *v1.StreamSearchRequest) (*connect.ServerStreamForClient[v1.StreamSearchResponse], error) {
-	return c.streamSearch.CallServerStream(ctx, connect.NewRequest(req))
+// DeleteFriend calls pb.clientrpc.v1.ClientRpcService.DeleteFriend.
+func (c *clientRpcServiceClient) DeleteFriend(ctx context.Context, req *v1.DeleteFriendRequest) (*v1.DeleteFriendResponse, error) {
+	response, err := c.deleteFriend.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
 }
 
-// GetUpdateInfo calls pb.clientrpc.v1.ClientRpcService.GetUpdateInfo.
-func (c *clientRpcServiceClient) GetUpdateInfo(ctx context.Context, req *v1.GetUpdateInfoRequest) (*v1.GetUpdateInfoResponse, error) {
-	response, err := c.getUpdateInfo.CallUnary(ctx, connect.NewRequest(req))
+// GetBlockedPeers calls pb.clientrpc.v1.ClientRpcService.GetBlockedPeers.
+func (c *clientRpcServiceClient) GetBlockedPeers(ctx context.Context, req *v1.GetBlockedPeersRequest) (*v1.GetBlockedPeersResponse, error) {
+	response, err := c.getBlockedPeers.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// CheckForNewUpdate calls pb.clientrpc.v1.ClientRpcService.CheckForNewUpdate.
-func (c *clientRpcServiceClient) CheckForNewUpdate(ctx context.Context, req *v1.CheckForNewUpdateRequest) (*v1.CheckForNewUpdateResponse, error) {
-	response, err := c.checkForNewUpdate.CallUnary(ctx, connect.NewRequest(req))
+// BlockPeer calls pb.clientrpc.v1.ClientRpcService.BlockPeer.
+func (c *clientRpcServiceClient) BlockPeer(ctx context.Context, req *v1.BlockPeerRequest) (*v1.BlockPeerResponse, error) {
+	response, err := c.blockPeer.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// GetDownloadManagerItems calls pb.clientrpc.v1.ClientRpcService.GetDownloadManagerItems.
-func (c *clientRpcServiceClient) GetDownloadManagerItems(ctx context.Context, req *v1.GetDownloadManagerItemsRequest) (*v1.GetDownloadManagerItemsResponse, error) {
-	response, err := c.getDownloadManagerItems.CallUnary(ctx, connect.NewRequest(req))
+// UnblockPeer calls pb.clientrpc.v1.ClientRpcService.UnblockPeer.
+func (c *clientRpcServiceClient) UnblockPeer(ctx context.Context, req *v1.UnblockPeerRequest) (*v1.UnblockPeerResponse, error) {
+	response, err := c.unblockPeer.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// QueueFileDownload calls pb.clientrpc.v1.ClientRpcService.QueueFileDownload.
-func (c *clientRpcServiceClient) QueueFileDownload(ctx context.Context, req *v1.QueueFileDownloadRequest) (*v1.QueueFileDownloadResponse, error) {
-	response, err := c.queueFileDownload.CallUnary(ctx, connect.NewRequest(req))
+// GetInterests calls pb.clientrpc.v1.ClientRpcService.GetInterests.
+func (c *clientRpcServiceClient) GetInterests(ctx context.Context, req *v1.GetInterestsRequest) (*v1.GetInterestsResponse, error) {
+	response, err := c.getInterests.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// CancelFileDownload calls pb.clientrpc.v1.ClientRpcService.CancelFileDownload.
-func (c *clientRpcServiceClient) CancelFileDownload(ctx context.Context, req *v1.CancelFileDownloadRequest) (*v1.CancelFileDownloadResponse, error) {
-	response, err := c.cancelFileDownload.CallUnary(ctx, connect.NewRequest(req))
+// SetInterests calls pb.clientrpc.v1.ClientRpcService.SetInterests.
+func (c *clientRpcServiceClient) SetInterests(ctx context.Context, req *v1.SetInterestsRequest) (*v1.SetInterestsResponse, error) {
+	response, err := c.setInterests.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// RemoveDownloadManagerItem calls pb.clientrpc.v1.ClientRpcService.RemoveDownloadManagerItem.
-func (c *clientRpcServiceClient) RemoveDownloadManagerItem(ctx context.Context, req *v1.RemoveDownloadManagerItemRequest) (*v1.RemoveDownloadManagerItemResponse, error) {
-	response, err := c.removeDownloadManagerItem.CallUnary(ctx, connect.NewRequest(req))
+// GetSimilarUsers calls pb.clientrpc.v1.ClientRpcService.GetSimilarUsers.
+func (c *clientRpcServiceClient) GetSimilarUsers(ctx context.Context, req *v1.GetSimilarUsersRequest) (*v1.GetSimilarUsersResponse, error) {
+	response, err := c.getSimilarUsers.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
 	return nil, err
 }
 
-// ResumeFileDownload calls pb.clientrpc.v1.ClientRpcService.ResumeFileDownload.
-func (c *clientRpcServiceClient) ResumeFileDownload(ctx context.Context, req *v1.ResumeFileDownloadRequest) (*v1.ResumeFileDownloadResponse, error) {
-	response, err := c.resumeFileDownload.CallUnary(ctx, connect.NewRequest(req))
+// GetServerSchedule calls pb.clientrpc.v1.ClientRpcService.GetServerSchedule.
+func (c *clientRpcServiceClient) GetServerSchedule(ctx context.Context, req *v1.GetServerScheduleRequest) (*v1.GetServerScheduleResponse, error) {
+	response, err := c.getServerSchedule.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetServerSchedule calls pb.clientrpc.v1.ClientRpcService.SetServerSchedule.
+func (c *clientRpcServiceClient) SetServerSchedule(ctx context.Context, req *v1.SetServerScheduleRequest) (*v1.SetServerScheduleResponse, error) {
+	response, err := c.setServerSchedule.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetSnooze calls pb.clientrpc.v1.ClientRpcService.GetSnooze.
+func (c *clientRpcServiceClient) GetSnooze(ctx context.Context, req *v1.GetSnoozeRequest) (*v1.GetSnoozeResponse, error) {
+	response, err := c.getSnooze.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Snooze calls pb.clientrpc.v1.ClientRpcService.Snooze.
+func (c *clientRpcServiceClient) Snooze(ctx context.Context, req *v1.SnoozeRequest) (*v1.SnoozeResponse, error) {
+	response, err := c.snooze.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Unsnooze calls pb.clientrpc.v1.ClientRpcService.Unsnooze.
+func (c *clientRpcServiceClient) Unsnooze(ctx context.Context, req *v1.UnsnoozeRequest) (*v1.UnsnoozeResponse, error) {
+	response, err := c.unsnooze.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetRunHistory calls pb.clientrpc.v1.ClientRpcService.GetRunHistory.
+func (c *clientRpcServiceClient) GetRunHistory(ctx context.Context, req *v1.GetRunHistoryRequest) (*v1.GetRunHistoryResponse, error) {
+	response, err := c.getRunHistory.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetConnHistory calls pb.clientrpc.v1.ClientRpcService.GetConnHistory.
+func (c *clientRpcServiceClient) GetConnHistory(ctx context.Context, req *v1.GetConnHistoryRequest) (*v1.GetConnHistoryResponse, error) {
+	response, err := c.getConnHistory.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetTrash calls pb.clientrpc.v1.ClientRpcService.GetTrash.
+func (c *clientRpcServiceClient) GetTrash(ctx context.Context, req *v1.GetTrashRequest) (*v1.GetTrashResponse, error) {
+	response, err := c.getTrash.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RestoreServer calls pb.clientrpc.v1.ClientRpcService.RestoreServer.
+func (c *clientRpcServiceClient) RestoreServer(ctx context.Context, req *v1.RestoreServerRequest) (*v1.RestoreServerResponse, error) {
+	response, err := c.restoreServer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PurgeServer calls pb.clientrpc.v1.ClientRpcService.PurgeServer.
+func (c *clientRpcServiceClient) PurgeServer(ctx context.Context, req *v1.PurgeServerRequest) (*v1.PurgeServerResponse, error) {
+	response, err := c.purgeServer.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// RestoreShare calls pb.clientrpc.v1.ClientRpcService.RestoreShare.
+func (c *clientRpcServiceClient) RestoreShare(ctx context.Context, req *v1.RestoreShareRequest) (*v1.RestoreShareResponse, error) {
+	response, err := c.restoreShare.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// PurgeShare calls pb.clientrpc.v1.ClientRpcService.PurgeShare.
+func (c *clientRpcServiceClient) PurgeShare(ctx context.Context, req *v1.PurgeShareRequest) (*v1.PurgeShareResponse, error) {
+	response, err := c.purgeShare.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetPlugins calls pb.clientrpc.v1.ClientRpcService.GetPlugins.
+func (c *clientRpcServiceClient) GetPlugins(ctx context.Context, req *v1.GetPluginsRequest) (*v1.GetPluginsResponse, error) {
+	response, err := c.getPlugins.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreatePlugin calls pb.clientrpc.v1.ClientRpcService.CreatePlugin.
+func (c *clientRpcServiceClient) CreatePlugin(ctx context.Context, req *v1.CreatePluginRequest) (*v1.CreatePluginResponse, error) {
+	response, err := c.createPlugin.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeletePlugin calls pb.clientrpc.v1.ClientRpcService.DeletePlugin.
+func (c *clientRpcServiceClient) DeletePlugin(ctx context.Context, req *v1.DeletePluginRequest) (*v1.DeletePluginResponse, error) {
+	response, err := c.deletePlugin.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// StreamPluginEvents calls pb.clientrpc.v1.ClientRpcService.StreamPluginEvents.
+func (c *clientRpcServiceClient) StreamPluginEvents(ctx context.Context, req *v1.StreamPluginEventsRequest) (*connect.ServerStreamForClient[v1.StreamPluginEventsResponse], error) {
+	return c.streamPluginEvents.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// RespondToSearch calls pb.clientrpc.v1.ClientRpcService.RespondToSearch.
+func (c *clientRpcServiceClient) RespondToSearch(ctx context.Context, req *v1.RespondToSearchRequest) (*v1.RespondToSearchResponse, error) {
+	response, err := c.respondToSearch.CallUnary(ctx, connect.NewRequest(req))
 	if response != nil {
 		return response.Msg, err
 	}
@@ -794,13 +2298,72 @@ type ClientRpcServiceHandler interface {
 	StreamEvents(context.Context, *v1.StreamEventsRequest, *connect.ServerStream[v1.StreamEventsResponse]) error
 	// Stop shuts down the client.
 	Stop(context.Context, *v1.StopRequest) (*v1.StopResponse, error)
-	// GetClientInfo returns information about the FriendNet client.
+	// GetClientInfo returns information about the FriendNet client, such as its version and enabled features, so that
+	// UIs and CLIs can display it and only offer features the client supports.
 	GetClientInfo(context.Context, *v1.GetClientInfoRequest) (*v1.GetClientInfoResponse, error)
-	// GetServers returns a list of all servers.
+	// GetMemoryUsage returns how much memory the client is using, and the limits of its memory budget.
+	GetMemoryUsage(context.Context, *v1.GetMemoryUsageRequest) (*v1.GetMemoryUsageResponse, error)
+	// SetLowMemoryMode sets whether the client runs in low-memory mode, which caps cache sizes, read-ahead, concurrent
+	// streams, downloads and buffers for devices with little memory, such as a Raspberry Pi or a NAS.
+	// Changes will not take effect until the client is restarted.
+	SetLowMemoryMode(context.Context, *v1.SetLowMemoryModeRequest) (*v1.SetLowMemoryModeResponse, error)
+	// GetServers returns a page of servers.
+	// Use the returned cursor to get the following pages.
 	GetServers(context.Context, *v1.GetServersRequest) (*v1.GetServersResponse, error)
 	// CreateServer creates a new server and automatically connects to it.
+	// If an account template is specified, its credentials are used and its shares are created on the server.
+	//
+	// Returns NOT_FOUND if the account template does not exist.
 	CreateServer(context.Context, *v1.CreateServerRequest) (*v1.CreateServerResponse, error)
-	// DeleteServer disconnects and deletes a server.
+	// ImportInviteBundle creates a new server from an invite bundle URL and automatically connects to it.
+	// If the bundle has a certificate fingerprint, the server's certificate is checked against it and trusted.
+	// If the bundle has an invite code, a new account is registered with it first.
+	// If an account template is specified, its credentials are used and its shares are created on the server.
+	//
+	// Returns INVALID_ARGUMENT if the URL is not a valid invite bundle URL.
+	// Returns NOT_FOUND if the account template does not exist.
+	// Returns FAILED_PRECONDITION if the server's certificate does not match the bundle's fingerprint.
+	// Returns PERMISSION_DENIED if the server rejected the registration.
+	ImportInviteBundle(context.Context, *v1.ImportInviteBundleRequest) (*v1.ImportInviteBundleResponse, error)
+	// CreateAccountTemplate creates an account template, which holds a username, password and list of shares that can
+	// be reused when adding servers where the same account is used.
+	//
+	// Returns INVALID_ARGUMENT if the username or a share name is invalid.
+	CreateAccountTemplate(context.Context, *v1.CreateAccountTemplateRequest) (*v1.CreateAccountTemplateResponse, error)
+	// CreateAccountTemplateFromServer creates an account template with the credentials and shares of an existing server.
+	// Internal shares are not included.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	CreateAccountTemplateFromServer(context.Context, *v1.CreateAccountTemplateFromServerRequest) (*v1.CreateAccountTemplateFromServerResponse, error)
+	// GetAccountTemplates returns all account templates.
+	// Passwords are not included.
+	GetAccountTemplates(context.Context, *v1.GetAccountTemplatesRequest) (*v1.GetAccountTemplatesResponse, error)
+	// DeleteAccountTemplate deletes an account template.
+	// Servers created from it are not affected.
+	//
+	// Returns NOT_FOUND if no such template exists.
+	DeleteAccountTemplate(context.Context, *v1.DeleteAccountTemplateRequest) (*v1.DeleteAccountTemplateResponse, error)
+	// StartHosting starts an embedded FriendNet server in the client and hosts a room on it, so that friends can join
+	// without anyone deploying a separate server. The client joins the room itself, and hosts it again whenever it
+	// restarts, until StopHosting is called.
+	//
+	// Returns INVALID_ARGUMENT if the room name, username or port is invalid.
+	// Returns ALREADY_EXISTS if the client is already hosting a room.
+	StartHosting(context.Context, *v1.StartHostingRequest) (*v1.StartHostingResponse, error)
+	// StopHosting stops the embedded server, disconnecting everyone in the hosted room.
+	// The room and its accounts are kept, so it can be hosted again later.
+	//
+	// Returns FAILED_PRECONDITION if the client is not hosting a room.
+	StopHosting(context.Context, *v1.StopHostingRequest) (*v1.StopHostingResponse, error)
+	// GetHostingInfo returns the status of the room the client hosts on its embedded server.
+	GetHostingInfo(context.Context, *v1.GetHostingInfoRequest) (*v1.GetHostingInfoResponse, error)
+	// CreateHostingInvite creates an invite link for the hosted room that friends can join with.
+	//
+	// Returns INVALID_ARGUMENT if the address is empty.
+	// Returns FAILED_PRECONDITION if the client is not hosting a room.
+	CreateHostingInvite(context.Context, *v1.CreateHostingInviteRequest) (*v1.CreateHostingInviteResponse, error)
+	// DeleteServer disconnects a server and moves it to the trash, along with its shares.
+	// It can be restored with RestoreServer until it is purged.
 	//
 	// Returns NOT_FOUND if no such server exists.
 	DeleteServer(context.Context, *v1.DeleteServerRequest) (*v1.DeleteServerResponse, error)
@@ -821,21 +2384,64 @@ type ClientRpcServiceHandler interface {
 	//
 	// Returns NOT_FOUND if no such server exists.
 	UpdateServer(context.Context, *v1.UpdateServerRequest) (*v1.UpdateServerResponse, error)
-	// GetShares returns shares for a server.
+	// GetShares returns a page of shares for a server.
+	// Use the returned cursor to get the following pages.
 	//
 	// Returns NOT_FOUND if no such server exists.
 	GetShares(context.Context, *v1.GetSharesRequest) (*v1.GetSharesResponse, error)
 	// CreateShare creates a new server share.
+	// A deleted share with the same name is purged.
 	//
 	// Returns NOT_FOUND if no such server exists.
-	// Returns INVALID_ARGUMENT if the share name is invalid.
+	// Returns INVALID_ARGUMENT if the share name, a mount, the conflict rule, an exclude pattern or the Unicode form is
+	// invalid.
 	// Returns ALREADY_EXISTS if a share with the same name already exists.
 	CreateShare(context.Context, *v1.CreateShareRequest) (*v1.CreateShareResponse, error)
-	// DeleteShare deletes an existing server share.
+	// DeleteShare stops sharing an existing server share and moves it to the trash.
+	// It can be restored with RestoreShare until it is purged.
 	//
 	// Returns NOT_FOUND if no such server exists.
 	// Returns NOT_FOUND if no such share exists.
 	DeleteShare(context.Context, *v1.DeleteShareRequest) (*v1.DeleteShareResponse, error)
+	// SetShareExcludePatterns replaces the exclude patterns of a share.
+	// The share's search index is rebuilt in the background.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns NOT_FOUND if no such share exists.
+	// Returns INVALID_ARGUMENT if a pattern is invalid.
+	SetShareExcludePatterns(context.Context, *v1.SetShareExcludePatternsRequest) (*v1.SetShareExcludePatternsResponse, error)
+	// CheckShareHealth scans a share for entries that cannot be shared, such as names that are not valid UTF-8 or
+	// directories that cannot be read, and entries that peers may have trouble saving, such as names that are reserved
+	// on Windows.
+	// Entries that cannot be shared are left out of listings, so this is how to find them.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns NOT_FOUND if no such share exists.
+	CheckShareHealth(context.Context, *v1.CheckShareHealthRequest) (*v1.CheckShareHealthResponse, error)
+	// ImportShares creates many shares at once, such as when migrating a large list of shared folders from another
+	// program.
+	// All entries are validated before any share is created. If any entry is invalid or a share cannot be created, no
+	// shares are created, and the results describe the problem with each entry.
+	// Shares in the trash with the same names as imported shares are purged.
+	//
+	// Returns INVALID_ARGUMENT if neither or both of entries and manifest_path are set, the manifest cannot be read or
+	// parsed, or there are more than 10000 entries.
+	ImportShares(context.Context, *v1.ImportSharesRequest) (*v1.ImportSharesResponse, error)
+	// CreateShareLink creates a link that gives read-only access to a path in a share through the public HTTPS
+	// gateway.
+	// Links can be created while the gateway is disabled, but only work once it is enabled.
+	//
+	// Returns NOT_FOUND if no such server, share or path exists.
+	// Returns INVALID_ARGUMENT if the path is invalid.
+	CreateShareLink(context.Context, *v1.CreateShareLinkRequest) (*v1.CreateShareLinkResponse, error)
+	// GetShareLinks returns the links to a share.
+	//
+	// Returns NOT_FOUND if no such server or share exists.
+	GetShareLinks(context.Context, *v1.GetShareLinksRequest) (*v1.GetShareLinksResponse, error)
+	// DeleteShareLink deletes a share link, so that it stops working immediately.
+	//
+	// Returns NOT_FOUND if no such link exists.
+	DeleteShareLink(context.Context, *v1.DeleteShareLinkRequest) (*v1.DeleteShareLinkResponse, error)
 	// GetDirFiles requests the files within a directory shared by an online user.
 	// Each message will contain files within the path.
 	//
@@ -844,12 +2450,47 @@ type ClientRpcServiceHandler interface {
 	// Returns NOT_FOUND if no such path exists.
 	// Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
 	GetDirFiles(context.Context, *v1.GetDirFilesRequest, *connect.ServerStream[v1.GetDirFilesResponse]) error
+	// StreamDirArchive streams a directory shared by an online user as an archive.
+	// The archive is built on the fly, so its size is not known in advance.
+	// Concatenating the data of all messages produces the archive.
+	//
+	// Returns INVALID_ARGUMENT if the format is unspecified.
+	// Returns INVALID_ARGUMENT if the path is not a directory.
+	// Returns NOT_FOUND if no such server exists.
+	// Returns NOT_FOUND if no such path exists.
+	// Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
+	StreamDirArchive(context.Context, *v1.StreamDirArchiveRequest, *connect.ServerStream[v1.StreamDirArchiveResponse]) error
 	// GetFileMeta returns metadata about a path shared by an online user.
 	//
 	// Returns NOT_FOUND if no such server exists.
 	// Returns NOT_FOUND if no such path exists.
 	// Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
 	GetFileMeta(context.Context, *v1.GetFileMetaRequest) (*v1.GetFileMetaResponse, error)
+	// CreateFileLink creates a link that downloads a file through the file server without the bearer token, so it can
+	// be given to someone else.
+	// The link can only be downloaded once and expires after a while. Links stop working when the client restarts.
+	// The first client that uses the link can retry until its download finishes, and keep using it for a minute after.
+	// Links are served on the web UI address, so they only work for others if they can reach that address.
+	//
+	// Returns NOT_FOUND if no such server or file exists.
+	// Returns INVALID_ARGUMENT if the path is a directory or the expiry is out of range.
+	// Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
+	CreateFileLink(context.Context, *v1.CreateFileLinkRequest) (*v1.CreateFileLinkResponse, error)
+	// MeasurePeer measures the latency and throughput to an online user.
+	// Measuring generates real traffic, so it should only be used on request.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns FAILED_PRECONDITION if the path is PEER_PATH_DIRECT and no direct connection could be established.
+	// Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
+	MeasurePeer(context.Context, *v1.MeasurePeerRequest) (*v1.MeasurePeerResponse, error)
+	// Diagnose runs the steps of connecting to a server one by one on a separate connection and returns the result of
+	// each, so that connection problems can be narrowed down to DNS, the network, the server's certificate, its
+	// protocol version or the credentials.
+	// Steps after one that failed are skipped.
+	// It works whether or not the server is connected, and does not affect its connection.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	Diagnose(context.Context, *v1.DiagnoseRequest) (*v1.DiagnoseResponse, error)
 	// GetOnlineUsers returns a list of online users in a server.
 	//
 	// Returns NOT_FOUND if no such server exists.
@@ -888,6 +2529,33 @@ type ClientRpcServiceHandler interface {
 	// Some of the settings take effect immediately, others do not.
 	// All fields must be filled, default values will not be omitted.
 	UpdateTransferSettings(context.Context, *v1.UpdateTransferSettingsRequest) (*v1.UpdateTransferSettingsResponse, error)
+	// GetNotificationSettings returns the client's notification settings.
+	GetNotificationSettings(context.Context, *v1.GetNotificationSettingsRequest) (*v1.GetNotificationSettingsResponse, error)
+	// UpdateNotificationSettings updates the client's notification settings.
+	// The settings take effect immediately.
+	// All fields must be filled, default values will not be omitted.
+	UpdateNotificationSettings(context.Context, *v1.UpdateNotificationSettingsRequest) (*v1.UpdateNotificationSettingsResponse, error)
+	// ExportConfig exports the client's servers, their shares and the trusted server certificates as a bundle
+	// encrypted with the specified password.
+	// The bundle contains server passwords, so it should be treated as sensitive even though it is encrypted.
+	//
+	// Returns INVALID_ARGUMENT if the password is empty.
+	ExportConfig(context.Context, *v1.ExportConfigRequest) (*v1.ExportConfigResponse, error)
+	// ImportConfig imports a bundle created by ExportConfig.
+	// Servers that already exist are skipped, and certificates are only imported for hostnames that do not already
+	// have one.
+	//
+	// Returns INVALID_ARGUMENT if the bundle is not valid.
+	// Returns PERMISSION_DENIED if the password is incorrect or the bundle is corrupted.
+	ImportConfig(context.Context, *v1.ImportConfigRequest) (*v1.ImportConfigResponse, error)
+	// BackupDatabase writes a consistent copy of the client's database to a file while the client keeps running.
+	//
+	// Returns INVALID_ARGUMENT if the path is empty.
+	// Returns ALREADY_EXISTS if the file already exists.
+	BackupDatabase(context.Context, *v1.BackupDatabaseRequest) (*v1.BackupDatabaseResponse, error)
+	// CheckDatabaseIntegrity checks the client's database for corruption.
+	// It may take a while if large shares are indexed.
+	CheckDatabaseIntegrity(context.Context, *v1.CheckDatabaseIntegrityRequest) (*v1.CheckDatabaseIntegrityResponse, error)
 	// IndexShare requests that a share be indexed.
 	// The share will be scheduled to be indexed in the background.
 	//
@@ -911,11 +2579,33 @@ type ClientRpcServiceHandler interface {
 	// confirmed that there is no new update.
 	// The cache is updated after calling this method.
 	CheckForNewUpdate(context.Context, *v1.CheckForNewUpdateRequest) (*v1.CheckForNewUpdateResponse, error)
+	// ApplyUpdate downloads the new update found by the last check for this platform, verifies it against the checksum
+	// in its signed release manifest, and stages it to replace the client's executable.
+	// The executable is replaced when the client shuts down, so the update takes effect when it is restarted.
+	//
+	// Returns FAILED_PRECONDITION if there is no new update, or if it has no binary for this platform.
+	// Returns FAILED_PRECONDITION if self-updating is disabled, such as when the client was installed by a package
+	// manager.
+	// Returns DATA_LOSS if the downloaded binary does not match its checksum.
+	ApplyUpdate(context.Context, *v1.ApplyUpdateRequest) (*v1.ApplyUpdateResponse, error)
+	// GetUpdateSettings returns the client's update settings.
+	GetUpdateSettings(context.Context, *v1.GetUpdateSettingsRequest) (*v1.GetUpdateSettingsResponse, error)
+	// UpdateUpdateSettings updates the client's update settings.
+	// The settings take effect immediately, and cached update info is cleared.
+	// All fields must be filled, default values will not be omitted.
+	//
+	// Returns INVALID_ARGUMENT if the base URL is not an HTTP or HTTPS URL.
+	UpdateUpdateSettings(context.Context, *v1.UpdateUpdateSettingsRequest) (*v1.UpdateUpdateSettingsResponse, error)
 	// GetDownloadManagerItems returns all download manager items.
 	GetDownloadManagerItems(context.Context, *v1.GetDownloadManagerItemsRequest) (*v1.GetDownloadManagerItemsResponse, error)
 	// QueueFileDownload queues a file download.
+	// If the peer can provide the file's hash and a file with the same hash was already downloaded, the request's
+	// duplicate_action decides what happens. If the peer cannot be reached or does not provide hashes, the download is
+	// queued normally. Peers only provide hashes they already know, so a duplicate may not be found the first time a
+	// file is queued.
 	//
 	// Returns NOT_FOUND if no such server exists.
+	// Returns FAILED_PRECONDITION if the duplicate could not be hard linked, such as when it is on another filesystem.
 	QueueFileDownload(context.Context, *v1.QueueFileDownloadRequest) (*v1.QueueFileDownloadResponse, error)
 	// CancelFileDownload cancels a file download.
 	//
@@ -924,12 +2614,160 @@ type ClientRpcServiceHandler interface {
 	// RemoveDownloadManagerItem removes a download manager item.
 	// It does not delete anything on disk.
 	//
-	// Returns NOT_FOUND if no such item exists.
-	RemoveDownloadManagerItem(context.Context, *v1.RemoveDownloadManagerItemRequest) (*v1.RemoveDownloadManagerItemResponse, error)
-	// ResumeFileDownload resumes or starts the a file download.
+	// Returns NOT_FOUND if no such item exists.
+	RemoveDownloadManagerItem(context.Context, *v1.RemoveDownloadManagerItemRequest) (*v1.RemoveDownloadManagerItemResponse, error)
+	// PauseFileDownload pauses a file download.
+	// If the download is in progress, its transfer is paused without closing the connection to the peer.
+	// If it is queued, it will not be started until it is resumed.
+	//
+	// Returns NOT_FOUND if no such download exists.
+	// Returns FAILED_PRECONDITION if the download is already done, canceled or failed.
+	PauseFileDownload(context.Context, *v1.PauseFileDownloadRequest) (*v1.PauseFileDownloadResponse, error)
+	// ResumeFileDownload resumes or starts the a file download.
+	// Paused in-progress transfers continue where they stopped.
+	//
+	// Returns NOT_FOUND if no such download exists.
+	ResumeFileDownload(context.Context, *v1.ResumeFileDownloadRequest) (*v1.ResumeFileDownloadResponse, error)
+	// GetDownloadHooks returns all download post-processing hooks.
+	GetDownloadHooks(context.Context, *v1.GetDownloadHooksRequest) (*v1.GetDownloadHooksResponse, error)
+	// CreateDownloadHook creates a hook that runs when a download completes.
+	// Global hooks run before per-download hooks, in the order they were created.
+	//
+	// Returns INVALID_ARGUMENT if the type is unspecified or the target is invalid for the type.
+	// Returns NOT_FOUND if a download UUID was specified but no such download exists.
+	CreateDownloadHook(context.Context, *v1.CreateDownloadHookRequest) (*v1.CreateDownloadHookResponse, error)
+	// DeleteDownloadHook deletes a download hook.
+	//
+	// Returns NOT_FOUND if no such hook exists.
+	DeleteDownloadHook(context.Context, *v1.DeleteDownloadHookRequest) (*v1.DeleteDownloadHookResponse, error)
+	// GetUploads returns uploads to peers that are in progress, and the history of finished uploads.
+	// Live updates are sent as TYPE_UPLOAD_UPDATE events.
+	GetUploads(context.Context, *v1.GetUploadsRequest) (*v1.GetUploadsResponse, error)
+	// ClearUploadHistory deletes the history of finished uploads.
+	ClearUploadHistory(context.Context, *v1.ClearUploadHistoryRequest) (*v1.ClearUploadHistoryResponse, error)
+	// GetFriends returns the friend info for peers on a server.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	GetFriends(context.Context, *v1.GetFriendsRequest) (*v1.GetFriendsResponse, error)
+	// SetFriend creates or replaces the friend info for a peer on a server.
+	// The peer does not need to be online.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns INVALID_ARGUMENT if the username is invalid or the nickname or note is too long.
+	SetFriend(context.Context, *v1.SetFriendRequest) (*v1.SetFriendResponse, error)
+	// DeleteFriend deletes the friend info for a peer on a server.
+	//
+	// Returns NOT_FOUND if no such server or friend info exists.
+	DeleteFriend(context.Context, *v1.DeleteFriendRequest) (*v1.DeleteFriendResponse, error)
+	// GetBlockedPeers returns the peers on the local block list of a server.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	GetBlockedPeers(context.Context, *v1.GetBlockedPeersRequest) (*v1.GetBlockedPeersResponse, error)
+	// BlockPeer adds a peer on a server to the local block list.
+	// Requests from blocked peers are rejected with permission errors, and their results are left out of searches.
+	// Blocking a peer that is already blocked does nothing.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns INVALID_ARGUMENT if the username is invalid.
+	BlockPeer(context.Context, *v1.BlockPeerRequest) (*v1.BlockPeerResponse, error)
+	// UnblockPeer removes a peer on a server from the local block list.
+	//
+	// Returns NOT_FOUND if no such server exists or the peer is not blocked.
+	// Returns INVALID_ARGUMENT if the username is invalid.
+	UnblockPeer(context.Context, *v1.UnblockPeerRequest) (*v1.UnblockPeerResponse, error)
+	// GetInterests returns the interests published to every server.
+	GetInterests(context.Context, *v1.GetInterestsRequest) (*v1.GetInterestsResponse, error)
+	// SetInterests replaces the interests published to every server, so that users with similar interests can find
+	// each other. They are published to connected servers right away, and to other servers when they connect.
+	// There can be at most 50 interests, each at most 64 characters long.
+	//
+	// Returns INVALID_ARGUMENT if an interest is invalid or there are too many.
+	SetInterests(context.Context, *v1.SetInterestsRequest) (*v1.SetInterestsResponse, error)
+	// GetSimilarUsers returns the online users in the server's room whose interests overlap with the client's, most
+	// overlapping first, to help find whose shares to browse.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns UNIMPLEMENTED if the server does not support interests.
+	GetSimilarUsers(context.Context, *v1.GetSimilarUsersRequest) (*v1.GetSimilarUsersResponse, error)
+	// GetServerSchedule returns the windows during which a server connection is allowed.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	GetServerSchedule(context.Context, *v1.GetServerScheduleRequest) (*v1.GetServerScheduleResponse, error)
+	// SetServerSchedule replaces the windows during which a server connection is allowed.
+	// When a window ends, the connection is closed, and when one starts, it is opened again, unless it was
+	// disconnected manually. Connecting manually outside a window keeps the connection open until the next window
+	// starts or ends.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns INVALID_ARGUMENT if a window is invalid.
+	SetServerSchedule(context.Context, *v1.SetServerScheduleRequest) (*v1.SetServerScheduleResponse, error)
+	// GetSnooze returns the state of the client's snooze.
+	GetSnooze(context.Context, *v1.GetSnoozeRequest) (*v1.GetSnoozeResponse, error)
+	// Snooze pauses all uploads and downloads on every server, and optionally hides shares from peers, for a duration
+	// or until Unsnooze is called.
+	// Snoozing while already snoozed replaces the current snooze.
+	// The snooze is kept across client restarts.
+	//
+	// Returns INVALID_ARGUMENT if the duration is 0.
+	Snooze(context.Context, *v1.SnoozeRequest) (*v1.SnoozeResponse, error)
+	// Unsnooze ends the client's snooze, resuming uploads and downloads and showing shares again.
+	// Does nothing if the client is not snoozed.
+	Unsnooze(context.Context, *v1.UnsnoozeRequest) (*v1.UnsnoozeResponse, error)
+	// GetRunHistory returns the history of runs of the client, including when each started and stopped and whether it
+	// crashed.
+	GetRunHistory(context.Context, *v1.GetRunHistoryRequest) (*v1.GetRunHistoryResponse, error)
+	// GetConnHistory returns the history of connections to servers, including how long each was open and why it
+	// closed, such as to audit connection stability.
+	//
+	// Returns NOT_FOUND if a server UUID is specified and no such server exists.
+	GetConnHistory(context.Context, *v1.GetConnHistoryRequest) (*v1.GetConnHistoryResponse, error)
+	// GetTrash returns the deleted servers and shares that can still be restored.
+	// Deleted servers and shares are purged for good once they have been in the trash for longer than the retention
+	// period in the "trash_retention_days" setting.
+	GetTrash(context.Context, *v1.GetTrashRequest) (*v1.GetTrashResponse, error)
+	// RestoreServer takes a deleted server out of the trash, along with its shares, and starts managing a connection
+	// to it again.
+	//
+	// Returns NOT_FOUND if no such server is in the trash.
+	RestoreServer(context.Context, *v1.RestoreServerRequest) (*v1.RestoreServerResponse, error)
+	// PurgeServer permanently deletes a server in the trash, along with its shares.
+	//
+	// Returns NOT_FOUND if no such server is in the trash.
+	PurgeServer(context.Context, *v1.PurgeServerRequest) (*v1.PurgeServerResponse, error)
+	// RestoreShare takes a deleted share out of the trash and shares it again.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns NOT_FOUND if no such share is in the trash.
+	RestoreShare(context.Context, *v1.RestoreShareRequest) (*v1.RestoreShareResponse, error)
+	// PurgeShare permanently deletes a share in the trash.
+	//
+	// Returns NOT_FOUND if no such server exists.
+	// Returns NOT_FOUND if no such share is in the trash.
+	PurgeShare(context.Context, *v1.PurgeShareRequest) (*v1.PurgeShareResponse, error)
+	// GetPlugins returns all plugins.
+	GetPlugins(context.Context, *v1.GetPluginsRequest) (*v1.GetPluginsResponse, error)
+	// CreatePlugin creates a plugin with the specified scopes and returns its bearer token.
+	// Plugins use the token in place of the client's own to call the methods their scopes allow.
+	//
+	// Returns INVALID_ARGUMENT if the name is invalid or a scope is unspecified.
+	// Returns ALREADY_EXISTS if a plugin with the same name already exists.
+	CreatePlugin(context.Context, *v1.CreatePluginRequest) (*v1.CreatePluginResponse, error)
+	// DeletePlugin deletes a plugin, revoking its token and closing its event streams.
 	//
-	// Returns NOT_FOUND if no such download exists.
-	ResumeFileDownload(context.Context, *v1.ResumeFileDownloadRequest) (*v1.ResumeFileDownloadResponse, error)
+	// Returns NOT_FOUND if no such plugin exists.
+	DeletePlugin(context.Context, *v1.DeletePluginRequest) (*v1.DeletePluginResponse, error)
+	// StreamPluginEvents returns an ongoing stream of the events a plugin registered for.
+	// Only plugins can call it.
+	//
+	// Returns FAILED_PRECONDITION if not called with a plugin's token.
+	// Returns PERMISSION_DENIED if the plugin does not have the scope for one of the requested types.
+	StreamPluginEvents(context.Context, *v1.StreamPluginEventsRequest, *connect.ServerStream[v1.StreamPluginEventsResponse]) error
+	// RespondToSearch adds a plugin's results to a search it received as a PLUGIN_EVENT_TYPE_SEARCH event.
+	// Only plugins can call it, once per search.
+	//
+	// Returns FAILED_PRECONDITION if not called with a plugin's token.
+	// Returns NOT_FOUND if no such search is waiting for the plugin's response, such as if its deadline passed.
+	RespondToSearch(context.Context, *v1.RespondToSearchRequest) (*v1.RespondToSearchResponse, error)
 }
 
 // NewClientRpcServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -963,6 +2801,18 @@ func NewClientRpcServiceHandler(svc ClientRpcServiceHandler, opts ...connect.Han
 		connect.WithSchema(clientRpcServiceMethods.ByName("GetClientInfo")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clientRpcServiceGetMemoryUsageHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetMemoryUsageProcedure,
+		svc.GetMemoryUsage,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetMemoryUsage")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceSetLowMemoryModeHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceSetLowMemoryModeProcedure,
+		svc.SetLowMemoryMode,
+		connect.WithSchema(clientRpcServiceMethods.ByName("SetLowMemoryMode")),
+		connect.WithHandlerOptions(opts...),
+	)
 	clientRpcServiceGetServersHandler := connect.NewUnaryHandlerSimple(
 		ClientRpcServiceGetServersProcedure,
 		svc.GetServers,
@@ -975,6 +2825,60 @@ func NewClientRpcServiceHandler(svc ClientRpcServiceHandler, opts ...connect.Han
 		connect.WithSchema(clientRpcServiceMethods.ByName("CreateServer")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clientRpcServiceImportInviteBundleHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceImportInviteBundleProcedure,
+		svc.ImportInviteBundle,
+		connect.WithSchema(clientRpcServiceMethods.ByName("ImportInviteBundle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceCreateAccountTemplateHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceCreateAccountTemplateProcedure,
+		svc.CreateAccountTemplate,
+		connect.WithSchema(clientRpcServiceMethods.ByName("CreateAccountTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceCreateAccountTemplateFromServerHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceCreateAccountTemplateFromServerProcedure,
+		svc.CreateAccountTemplateFromServer,
+		connect.WithSchema(clientRpcServiceMethods.ByName("CreateAccountTemplateFromServer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetAccountTemplatesHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetAccountTemplatesProcedure,
+		svc.GetAccountTemplates,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetAccountTemplates")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceDeleteAccountTemplateHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceDeleteAccountTemplateProcedure,
+		svc.DeleteAccountTemplate,
+		connect.WithSchema(clientRpcServiceMethods.ByName("DeleteAccountTemplate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceStartHostingHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceStartHostingProcedure,
+		svc.StartHosting,
+		connect.WithSchema(clientRpcServiceMethods.ByName("StartHosting")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceStopHostingHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceStopHostingProcedure,
+		svc.StopHosting,
+		connect.WithSchema(clientRpcServiceMethods.ByName("StopHosting")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetHostingInfoHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetHostingInfoProcedure,
+		svc.GetHostingInfo,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetHostingInfo")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceCreateHostingInviteHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceCreateHostingInviteProcedure,
+		svc.CreateHostingInvite,
+		connect.WithSchema(clientRpcServiceMethods.ByName("CreateHostingInvite")),
+		connect.WithHandlerOptions(opts...),
+	)
 	clientRpcServiceDeleteServerHandler := connect.NewUnaryHandlerSimple(
 		ClientRpcServiceDeleteServerProcedure,
 		svc.DeleteServer,
@@ -1017,18 +2921,78 @@ func NewClientRpcServiceHandler(svc ClientRpcServiceHandler, opts ...connect.Han
 		connect.WithSchema(clientRpcServiceMethods.ByName("DeleteShare")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clientRpcServiceSetShareExcludePatternsHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceSetShareExcludePatternsProcedure,
+		svc.SetShareExcludePatterns,
+		connect.WithSchema(clientRpcServiceMethods.ByName("SetShareExcludePatterns")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceCheckShareHealthHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceCheckShareHealthProcedure,
+		svc.CheckShareHealth,
+		connect.WithSchema(clientRpcServiceMethods.ByName("CheckShareHealth")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceImportSharesHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceImportSharesProcedure,
+		svc.ImportShares,
+		connect.WithSchema(clientRpcServiceMethods.ByName("ImportShares")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceCreateShareLinkHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceCreateShareLinkProcedure,
+		svc.CreateShareLink,
+		connect.WithSchema(clientRpcServiceMethods.ByName("CreateShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetShareLinksHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetShareLinksProcedure,
+		svc.GetShareLinks,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetShareLinks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceDeleteShareLinkHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceDeleteShareLinkProcedure,
+		svc.DeleteShareLink,
+		connect.WithSchema(clientRpcServiceMethods.ByName("DeleteShareLink")),
+		connect.WithHandlerOptions(opts...),
+	)
 	clientRpcServiceGetDirFilesHandler := connect.NewServerStreamHandlerSimple(
 		ClientRpcServiceGetDirFilesProcedure,
 		svc.GetDirFiles,
 		connect.WithSchema(clientRpcServiceMethods.ByName("GetDirFiles")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clientRpcServiceStreamDirArchiveHandler := connect.NewServerStreamHandlerSimple(
+		ClientRpcServiceStreamDirArchiveProcedure,
+		svc.StreamDirArchive,
+		connect.WithSchema(clientRpcServiceMethods.ByName("StreamDirArchive")),
+		connect.WithHandlerOptions(opts...),
+	)
 	clientRpcServiceGetFileMetaHandler := connect.NewUnaryHandlerSimple(
 		ClientRpcServiceGetFileMetaProcedure,
 		svc.GetFileMeta,
 		connect.WithSchema(clientRpcServiceMethods.ByName("GetFileMeta")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clientRpcServiceCreateFileLinkHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceCreateFileLinkProcedure,
+		svc.CreateFileLink,
+		connect.WithSchema(clientRpcServiceMethods.ByName("CreateFileLink")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceMeasurePeerHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceMeasurePeerProcedure,
+		svc.MeasurePeer,
+		connect.WithSchema(clientRpcServiceMethods.ByName("MeasurePeer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceDiagnoseHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceDiagnoseProcedure,
+		svc.Diagnose,
+		connect.WithSchema(clientRpcServiceMethods.ByName("Diagnose")),
+		connect.WithHandlerOptions(opts...),
+	)
 	clientRpcServiceGetOnlineUsersHandler := connect.NewServerStreamHandlerSimple(
 		ClientRpcServiceGetOnlineUsersProcedure,
 		svc.GetOnlineUsers,
@@ -1077,6 +3041,42 @@ func NewClientRpcServiceHandler(svc ClientRpcServiceHandler, opts ...connect.Han
 		connect.WithSchema(clientRpcServiceMethods.ByName("UpdateTransferSettings")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clientRpcServiceGetNotificationSettingsHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetNotificationSettingsProcedure,
+		svc.GetNotificationSettings,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetNotificationSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceUpdateNotificationSettingsHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceUpdateNotificationSettingsProcedure,
+		svc.UpdateNotificationSettings,
+		connect.WithSchema(clientRpcServiceMethods.ByName("UpdateNotificationSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceExportConfigHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceExportConfigProcedure,
+		svc.ExportConfig,
+		connect.WithSchema(clientRpcServiceMethods.ByName("ExportConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceImportConfigHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceImportConfigProcedure,
+		svc.ImportConfig,
+		connect.WithSchema(clientRpcServiceMethods.ByName("ImportConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceBackupDatabaseHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceBackupDatabaseProcedure,
+		svc.BackupDatabase,
+		connect.WithSchema(clientRpcServiceMethods.ByName("BackupDatabase")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceCheckDatabaseIntegrityHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceCheckDatabaseIntegrityProcedure,
+		svc.CheckDatabaseIntegrity,
+		connect.WithSchema(clientRpcServiceMethods.ByName("CheckDatabaseIntegrity")),
+		connect.WithHandlerOptions(opts...),
+	)
 	clientRpcServiceIndexShareHandler := connect.NewUnaryHandlerSimple(
 		ClientRpcServiceIndexShareProcedure,
 		svc.IndexShare,
@@ -1101,6 +3101,24 @@ func NewClientRpcServiceHandler(svc ClientRpcServiceHandler, opts ...connect.Han
 		connect.WithSchema(clientRpcServiceMethods.ByName("CheckForNewUpdate")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clientRpcServiceApplyUpdateHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceApplyUpdateProcedure,
+		svc.ApplyUpdate,
+		connect.WithSchema(clientRpcServiceMethods.ByName("ApplyUpdate")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetUpdateSettingsHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetUpdateSettingsProcedure,
+		svc.GetUpdateSettings,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetUpdateSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceUpdateUpdateSettingsHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceUpdateUpdateSettingsProcedure,
+		svc.UpdateUpdateSettings,
+		connect.WithSchema(clientRpcServiceMethods.ByName("UpdateUpdateSettings")),
+		connect.WithHandlerOptions(opts...),
+	)
 	clientRpcServiceGetDownloadManagerItemsHandler := connect.NewUnaryHandlerSimple(
 		ClientRpcServiceGetDownloadManagerItemsProcedure,
 		svc.GetDownloadManagerItems,
@@ -1125,12 +3143,204 @@ func NewClientRpcServiceHandler(svc ClientRpcServiceHandler, opts ...connect.Han
 		connect.WithSchema(clientRpcServiceMethods.ByName("RemoveDownloadManagerItem")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clientRpcServicePauseFileDownloadHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServicePauseFileDownloadProcedure,
+		svc.PauseFileDownload,
+		connect.WithSchema(clientRpcServiceMethods.ByName("PauseFileDownload")),
+		connect.WithHandlerOptions(opts...),
+	)
 	clientRpcServiceResumeFileDownloadHandler := connect.NewUnaryHandlerSimple(
 		ClientRpcServiceResumeFileDownloadProcedure,
 		svc.ResumeFileDownload,
 		connect.WithSchema(clientRpcServiceMethods.ByName("ResumeFileDownload")),
 		connect.WithHandlerOptions(opts...),
 	)
+	clientRpcServiceGetDownloadHooksHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetDownloadHooksProcedure,
+		svc.GetDownloadHooks,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetDownloadHooks")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceCreateDownloadHookHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceCreateDownloadHookProcedure,
+		svc.CreateDownloadHook,
+		connect.WithSchema(clientRpcServiceMethods.ByName("CreateDownloadHook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceDeleteDownloadHookHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceDeleteDownloadHookProcedure,
+		svc.DeleteDownloadHook,
+		connect.WithSchema(clientRpcServiceMethods.ByName("DeleteDownloadHook")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetUploadsHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetUploadsProcedure,
+		svc.GetUploads,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetUploads")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceClearUploadHistoryHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceClearUploadHistoryProcedure,
+		svc.ClearUploadHistory,
+		connect.WithSchema(clientRpcServiceMethods.ByName("ClearUploadHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetFriendsHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetFriendsProcedure,
+		svc.GetFriends,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetFriends")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceSetFriendHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceSetFriendProcedure,
+		svc.SetFriend,
+		connect.WithSchema(clientRpcServiceMethods.ByName("SetFriend")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceDeleteFriendHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceDeleteFriendProcedure,
+		svc.DeleteFriend,
+		connect.WithSchema(clientRpcServiceMethods.ByName("DeleteFriend")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetBlockedPeersHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetBlockedPeersProcedure,
+		svc.GetBlockedPeers,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetBlockedPeers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceBlockPeerHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceBlockPeerProcedure,
+		svc.BlockPeer,
+		connect.WithSchema(clientRpcServiceMethods.ByName("BlockPeer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceUnblockPeerHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceUnblockPeerProcedure,
+		svc.UnblockPeer,
+		connect.WithSchema(clientRpcServiceMethods.ByName("UnblockPeer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetInterestsHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetInterestsProcedure,
+		svc.GetInterests,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetInterests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceSetInterestsHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceSetInterestsProcedure,
+		svc.SetInterests,
+		connect.WithSchema(clientRpcServiceMethods.ByName("SetInterests")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetSimilarUsersHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetSimilarUsersProcedure,
+		svc.GetSimilarUsers,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetSimilarUsers")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetServerScheduleHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetServerScheduleProcedure,
+		svc.GetServerSchedule,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetServerSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceSetServerScheduleHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceSetServerScheduleProcedure,
+		svc.SetServerSchedule,
+		connect.WithSchema(clientRpcServiceMethods.ByName("SetServerSchedule")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetSnoozeHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetSnoozeProcedure,
+		svc.GetSnooze,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetSnooze")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceSnoozeHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceSnoozeProcedure,
+		svc.Snooze,
+		connect.WithSchema(clientRpcServiceMethods.ByName("Snooze")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceUnsnoozeHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceUnsnoozeProcedure,
+		svc.Unsnooze,
+		connect.WithSchema(clientRpcServiceMethods.ByName("Unsnooze")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetRunHistoryHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetRunHistoryProcedure,
+		svc.GetRunHistory,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetRunHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetConnHistoryHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetConnHistoryProcedure,
+		svc.GetConnHistory,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetConnHistory")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetTrashHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetTrashProcedure,
+		svc.GetTrash,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetTrash")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceRestoreServerHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceRestoreServerProcedure,
+		svc.RestoreServer,
+		connect.WithSchema(clientRpcServiceMethods.ByName("RestoreServer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServicePurgeServerHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServicePurgeServerProcedure,
+		svc.PurgeServer,
+		connect.WithSchema(clientRpcServiceMethods.ByName("PurgeServer")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceRestoreShareHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceRestoreShareProcedure,
+		svc.RestoreShare,
+		connect.WithSchema(clientRpcServiceMethods.ByName("RestoreShare")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServicePurgeShareHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServicePurgeShareProcedure,
+		svc.PurgeShare,
+		connect.WithSchema(clientRpcServiceMethods.ByName("PurgeShare")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceGetPluginsHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceGetPluginsProcedure,
+		svc.GetPlugins,
+		connect.WithSchema(clientRpcServiceMethods.ByName("GetPlugins")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceCreatePluginHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceCreatePluginProcedure,
+		svc.CreatePlugin,
+		connect.WithSchema(clientRpcServiceMethods.ByName("CreatePlugin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceDeletePluginHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceDeletePluginProcedure,
+		svc.DeletePlugin,
+		connect.WithSchema(clientRpcServiceMethods.ByName("DeletePlugin")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceStreamPluginEventsHandler := connect.NewServerStreamHandlerSimple(
+		ClientRpcServiceStreamPluginEventsProcedure,
+		svc.StreamPluginEvents,
+		connect.WithSchema(clientRpcServiceMethods.ByName("StreamPluginEvents")),
+		connect.WithHandlerOptions(opts...),
+	)
+	clientRpcServiceRespondToSearchHandler := connect.NewUnaryHandlerSimple(
+		ClientRpcServiceRespondToSearchProcedure,
+		svc.RespondToSearch,
+		connect.WithSchema(clientRpcServiceMethods.ByName("RespondToSearch")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/pb.clientrpc.v1.ClientRpcService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ClientRpcServiceStreamLogsProcedure:
@@ -1141,10 +3351,32 @@ func NewClientRpcServiceHandler(svc ClientRpcServiceHandler, opts ...connect.Han
 			clientRpcServiceStopHandler.ServeHTTP(w, r)
 		case ClientRpcServiceGetClientInfoProcedure:
 			clientRpcServiceGetClientInfoHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetMemoryUsageProcedure:
+			clientRpcServiceGetMemoryUsageHandler.ServeHTTP(w, r)
+		case ClientRpcServiceSetLowMemoryModeProcedure:
+			clientRpcServiceSetLowMemoryModeHandler.ServeHTTP(w, r)
 		case ClientRpcServiceGetServersProcedure:
 			clientRpcServiceGetServersHandler.ServeHTTP(w, r)
 		case ClientRpcServiceCreateServerProcedure:
 			clientRpcServiceCreateServerHandler.ServeHTTP(w, r)
+		case ClientRpcServiceImportInviteBundleProcedure:
+			clientRpcServiceImportInviteBundleHandler.ServeHTTP(w, r)
+		case ClientRpcServiceCreateAccountTemplateProcedure:
+			clientRpcServiceCreateAccountTemplateHandler.ServeHTTP(w, r)
+		case ClientRpcServiceCreateAccountTemplateFromServerProcedure:
+			clientRpcServiceCreateAccountTemplateFromServerHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetAccountTemplatesProcedure:
+			clientRpcServiceGetAccountTemplatesHandler.ServeHTTP(w, r)
+		case ClientRpcServiceDeleteAccountTemplateProcedure:
+			clientRpcServiceDeleteAccountTemplateHandler.ServeHTTP(w, r)
+		case ClientRpcServiceStartHostingProcedure:
+			clientRpcServiceStartHostingHandler.ServeHTTP(w, r)
+		case ClientRpcServiceStopHostingProcedure:
+			clientRpcServiceStopHostingHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetHostingInfoProcedure:
+			clientRpcServiceGetHostingInfoHandler.ServeHTTP(w, r)
+		case ClientRpcServiceCreateHostingInviteProcedure:
+			clientRpcServiceCreateHostingInviteHandler.ServeHTTP(w, r)
 		case ClientRpcServiceDeleteServerProcedure:
 			clientRpcServiceDeleteServerHandler.ServeHTTP(w, r)
 		case ClientRpcServiceConnectServerProcedure:
@@ -1159,10 +3391,30 @@ func NewClientRpcServiceHandler(svc ClientRpcServiceHandler, opts ...connect.Han
 			clientRpcServiceCreateShareHandler.ServeHTTP(w, r)
 		case ClientRpcServiceDeleteShareProcedure:
 			clientRpcServiceDeleteShareHandler.ServeHTTP(w, r)
+		case ClientRpcServiceSetShareExcludePatternsProcedure:
+			clientRpcServiceSetShareExcludePatternsHandler.ServeHTTP(w, r)
+		case ClientRpcServiceCheckShareHealthProcedure:
+			clientRpcServiceCheckShareHealthHandler.ServeHTTP(w, r)
+		case ClientRpcServiceImportSharesProcedure:
+			clientRpcServiceImportSharesHandler.ServeHTTP(w, r)
+		case ClientRpcServiceCreateShareLinkProcedure:
+			clientRpcServiceCreateShareLinkHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetShareLinksProcedure:
+			clientRpcServiceGetShareLinksHandler.ServeHTTP(w, r)
+		case ClientRpcServiceDeleteShareLinkProcedure:
+			clientRpcServiceDeleteShareLinkHandler.ServeHTTP(w, r)
 		case ClientRpcServiceGetDirFilesProcedure:
 			clientRpcServiceGetDirFilesHandler.ServeHTTP(w, r)
+		case ClientRpcServiceStreamDirArchiveProcedure:
+			clientRpcServiceStreamDirArchiveHandler.ServeHTTP(w, r)
 		case ClientRpcServiceGetFileMetaProcedure:
 			clientRpcServiceGetFileMetaHandler.ServeHTTP(w, r)
+		case ClientRpcServiceCreateFileLinkProcedure:
+			clientRpcServiceCreateFileLinkHandler.ServeHTTP(w, r)
+		case ClientRpcServiceMeasurePeerProcedure:
+			clientRpcServiceMeasurePeerHandler.ServeHTTP(w, r)
+		case ClientRpcServiceDiagnoseProcedure:
+			clientRpcServiceDiagnoseHandler.ServeHTTP(w, r)
 		case ClientRpcServiceGetOnlineUsersProcedure:
 			clientRpcServiceGetOnlineUsersHandler.ServeHTTP(w, r)
 		case ClientRpcServiceChangeAccountPasswordProcedure:
@@ -1179,6 +3431,18 @@ func NewClientRpcServiceHandler(svc ClientRpcServiceHandler, opts ...connect.Han
 			clientRpcServiceGetTransferSettingsHandler.ServeHTTP(w, r)
 		case ClientRpcServiceUpdateTransferSettingsProcedure:
 			clientRpcServiceUpdateTransferSettingsHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetNotificationSettingsProcedure:
+			clientRpcServiceGetNotificationSettingsHandler.ServeHTTP(w, r)
+		case ClientRpcServiceUpdateNotificationSettingsProcedure:
+			clientRpcServiceUpdateNotificationSettingsHandler.ServeHTTP(w, r)
+		case ClientRpcServiceExportConfigProcedure:
+			clientRpcServiceExportConfigHandler.ServeHTTP(w, r)
+		case ClientRpcServiceImportConfigProcedure:
+			clientRpcServiceImportConfigHandler.ServeHTTP(w, r)
+		case ClientRpcServiceBackupDatabaseProcedure:
+			clientRpcServiceBackupDatabaseHandler.ServeHTTP(w, r)
+		case ClientRpcServiceCheckDatabaseIntegrityProcedure:
+			clientRpcServiceCheckDatabaseIntegrityHandler.ServeHTTP(w, r)
 		case ClientRpcServiceIndexShareProcedure:
 			clientRpcServiceIndexShareHandler.ServeHTTP(w, r)
 		case ClientRpcServiceStreamSearchProcedure:
@@ -1187,6 +3451,12 @@ func NewClientRpcServiceHandler(svc ClientRpcServiceHandler, opts ...connect.Han
 			clientRpcServiceGetUpdateInfoHandler.ServeHTTP(w, r)
 		case ClientRpcServiceCheckForNewUpdateProcedure:
 			clientRpcServiceCheckForNewUpdateHandler.ServeHTTP(w, r)
+		case ClientRpcServiceApplyUpdateProcedure:
+			clientRpcServiceApplyUpdateHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetUpdateSettingsProcedure:
+			clientRpcServiceGetUpdateSettingsHandler.ServeHTTP(w, r)
+		case ClientRpcServiceUpdateUpdateSettingsProcedure:
+			clientRpcServiceUpdateUpdateSettingsHandler.ServeHTTP(w, r)
 		case ClientRpcServiceGetDownloadManagerItemsProcedure:
 			clientRpcServiceGetDownloadManagerItemsHandler.ServeHTTP(w, r)
 		case ClientRpcServiceQueueFileDownloadProcedure:
@@ -1195,8 +3465,72 @@ func NewClientRpcServiceHandler(svc ClientRpcServiceHandler, opts ...connect.Han
 			clientRpcServiceCancelFileDownloadHandler.ServeHTTP(w, r)
 		case ClientRpcServiceRemoveDownloadManagerItemProcedure:
 			clientRpcServiceRemoveDownloadManagerItemHandler.ServeHTTP(w, r)
+		case ClientRpcServicePauseFileDownloadProcedure:
+			clientRpcServicePauseFileDownloadHandler.ServeHTTP(w, r)
 		case ClientRpcServiceResumeFileDownloadProcedure:
 			clientRpcServiceResumeFileDownloadHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetDownloadHooksProcedure:
+			clientRpcServiceGetDownloadHooksHandler.ServeHTTP(w, r)
+		case ClientRpcServiceCreateDownloadHookProcedure:
+			clientRpcServiceCreateDownloadHookHandler.ServeHTTP(w, r)
+		case ClientRpcServiceDeleteDownloadHookProcedure:
+			clientRpcServiceDeleteDownloadHookHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetUploadsProcedure:
+			clientRpcServiceGetUploadsHandler.ServeHTTP(w, r)
+		case ClientRpcServiceClearUploadHistoryProcedure:
+			clientRpcServiceClearUploadHistoryHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetFriendsProcedure:
+			clientRpcServiceGetFriendsHandler.ServeHTTP(w, r)
+		case ClientRpcServiceSetFriendProcedure:
+			clientRpcServiceSetFriendHandler.ServeHTTP(w, r)
+		case ClientRpcServiceDeleteFriendProcedure:
+			clientRpcServiceDeleteFriendHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetBlockedPeersProcedure:
+			clientRpcServiceGetBlockedPeersHandler.ServeHTTP(w, r)
+		case ClientRpcServiceBlockPeerProcedure:
+			clientRpcServiceBlockPeerHandler.ServeHTTP(w, r)
+		case ClientRpcServiceUnblockPeerProcedure:
+			clientRpcServiceUnblockPeerHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetInterestsProcedure:
+			clientRpcServiceGetInterestsHandler.ServeHTTP(w, r)
+		case ClientRpcServiceSetInterestsProcedure:
+			clientRpcServiceSetInterestsHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetSimilarUsersProcedure:
+			clientRpcServiceGetSimilarUsersHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetServerScheduleProcedure:
+			clientRpcServiceGetServerScheduleHandler.ServeHTTP(w, r)
+		case ClientRpcServiceSetServerScheduleProcedure:
+			clientRpcServiceSetServerScheduleHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetSnoozeProcedure:
+			clientRpcServiceGetSnoozeHandler.ServeHTTP(w, r)
+		case ClientRpcServiceSnoozeProcedure:
+			clientRpcServiceSnoozeHandler.ServeHTTP(w, r)
+		case ClientRpcServiceUnsnoozeProcedure:
+			clientRpcServiceUnsnoozeHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetRunHistoryProcedure:
+			clientRpcServiceGetRunHistoryHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetConnHistoryProcedure:
+			clientRpcServiceGetConnHistoryHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetTrashProcedure:
+			clientRpcServiceGetTrashHandler.ServeHTTP(w, r)
+		case ClientRpcServiceRestoreServerProcedure:
+			clientRpcServiceRestoreServerHandler.ServeHTTP(w, r)
+		case ClientRpcServicePurgeServerProcedure:
+			clientRpcServicePurgeServerHandler.ServeHTTP(w, r)
+		case ClientRpcServiceRestoreShareProcedure:
+			clientRpcServiceRestoreShareHandler.ServeHTTP(w, r)
+		case ClientRpcServicePurgeShareProcedure:
+			clientRpcServicePurgeShareHandler.ServeHTTP(w, r)
+		case ClientRpcServiceGetPluginsProcedure:
+			clientRpcServiceGetPluginsHandler.ServeHTTP(w, r)
+		case ClientRpcServiceCreatePluginProcedure:
+			clientRpcServiceCreatePluginHandler.ServeHTTP(w, r)
+		case ClientRpcServiceDeletePluginProcedure:
+			clientRpcServiceDeletePluginHandler.ServeHTTP(w, r)
+		case ClientRpcServiceStreamPluginEventsProcedure:
+			clientRpcServiceStreamPluginEventsHandler.ServeHTTP(w, r)
+		case ClientRpcServiceRespondToSearchProcedure:
+			clientRpcServiceRespondToSearchHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -1222,6 +3556,14 @@ func (UnimplementedClientRpcServiceHandler) GetClientInfo(context.Context, *v1.G
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetClientInfo is not implemented"))
 }
 
+func (UnimplementedClientRpcServiceHandler) GetMemoryUsage(context.Context, *v1.GetMemoryUsageRequest) (*v1.GetMemoryUsageResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetMemoryUsage is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) SetLowMemoryMode(context.Context, *v1.SetLowMemoryModeRequest) (*v1.SetLowMemoryModeResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.SetLowMemoryMode is not implemented"))
+}
+
 func (UnimplementedClientRpcServiceHandler) GetServers(context.Context, *v1.GetServersRequest) (*v1.GetServersResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetServers is not implemented"))
 }
@@ -1230,6 +3572,42 @@ func (UnimplementedClientRpcServiceHandler) CreateServer(context.Context, *v1.Cr
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.CreateServer is not implemented"))
 }
 
+func (UnimplementedClientRpcServiceHandler) ImportInviteBundle(context.Context, *v1.ImportInviteBundleRequest) (*v1.ImportInviteBundleResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.ImportInviteBundle is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) CreateAccountTemplate(context.Context, *v1.CreateAccountTemplateRequest) (*v1.CreateAccountTemplateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.CreateAccountTemplate is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) CreateAccountTemplateFromServer(context.Context, *v1.CreateAccountTemplateFromServerRequest) (*v1.CreateAccountTemplateFromServerResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.CreateAccountTemplateFromServer is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetAccountTemplates(context.Context, *v1.GetAccountTemplatesRequest) (*v1.GetAccountTemplatesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetAccountTemplates is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) DeleteAccountTemplate(context.Context, *v1.DeleteAccountTemplateRequest) (*v1.DeleteAccountTemplateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.DeleteAccountTemplate is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) StartHosting(context.Context, *v1.StartHostingRequest) (*v1.StartHostingResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.StartHosting is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) StopHosting(context.Context, *v1.StopHostingRequest) (*v1.StopHostingResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.StopHosting is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetHostingInfo(context.Context, *v1.GetHostingInfoRequest) (*v1.GetHostingInfoResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetHostingInfo is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) CreateHostingInvite(context.Context, *v1.CreateHostingInviteRequest) (*v1.CreateHostingInviteResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.CreateHostingInvite is not implemented"))
+}
+
 func (UnimplementedClientRpcServiceHandler) DeleteServer(context.Context, *v1.DeleteServerRequest) (*v1.DeleteServerResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.DeleteServer is not implemented"))
 }
@@ -1258,14 +3636,54 @@ func (UnimplementedClientRpcServiceHandler) DeleteShare(context.Context, *v1.Del
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.DeleteShare is not implemented"))
 }
 
+func (UnimplementedClientRpcServiceHandler) SetShareExcludePatterns(context.Context, *v1.SetShareExcludePatternsRequest) (*v1.SetShareExcludePatternsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.SetShareExcludePatterns is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) CheckShareHealth(context.Context, *v1.CheckShareHealthRequest) (*v1.CheckShareHealthResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.CheckShareHealth is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) ImportShares(context.Context, *v1.ImportSharesRequest) (*v1.ImportSharesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.ImportShares is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) CreateShareLink(context.Context, *v1.CreateShareLinkRequest) (*v1.CreateShareLinkResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.CreateShareLink is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetShareLinks(context.Context, *v1.GetShareLinksRequest) (*v1.GetShareLinksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetShareLinks is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) DeleteShareLink(context.Context, *v1.DeleteShareLinkRequest) (*v1.DeleteShareLinkResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.DeleteShareLink is not implemented"))
+}
+
 func (UnimplementedClientRpcServiceHandler) GetDirFiles(context.Context, *v1.GetDirFilesRequest, *connect.ServerStream[v1.GetDirFilesResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetDirFiles is not implemented"))
 }
 
+func (UnimplementedClientRpcServiceHandler) StreamDirArchive(context.Context, *v1.StreamDirArchiveRequest, *connect.ServerStream[v1.StreamDirArchiveResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.StreamDirArchive is not implemented"))
+}
+
 func (UnimplementedClientRpcServiceHandler) GetFileMeta(context.Context, *v1.GetFileMetaRequest) (*v1.GetFileMetaResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetFileMeta is not implemented"))
 }
 
+func (UnimplementedClientRpcServiceHandler) CreateFileLink(context.Context, *v1.CreateFileLinkRequest) (*v1.CreateFileLinkResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.CreateFileLink is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) MeasurePeer(context.Context, *v1.MeasurePeerRequest) (*v1.MeasurePeerResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.MeasurePeer is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) Diagnose(context.Context, *v1.DiagnoseRequest) (*v1.DiagnoseResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.Diagnose is not implemented"))
+}
+
 func (UnimplementedClientRpcServiceHandler) GetOnlineUsers(context.Context, *v1.GetOnlineUsersRequest, *connect.ServerStream[v1.GetOnlineUsersResponse]) error {
 	return connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetOnlineUsers is not implemented"))
 }
@@ -1298,6 +3716,30 @@ func (UnimplementedClientRpcServiceHandler) UpdateTransferSettings(context.Conte
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.UpdateTransferSettings is not implemented"))
 }
 
+func (UnimplementedClientRpcServiceHandler) GetNotificationSettings(context.Context, *v1.GetNotificationSettingsRequest) (*v1.GetNotificationSettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetNotificationSettings is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) UpdateNotificationSettings(context.Context, *v1.UpdateNotificationSettingsRequest) (*v1.UpdateNotificationSettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.UpdateNotificationSettings is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) ExportConfig(context.Context, *v1.ExportConfigRequest) (*v1.ExportConfigResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.ExportConfig is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) ImportConfig(context.Context, *v1.ImportConfigRequest) (*v1.ImportConfigResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.ImportConfig is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) BackupDatabase(context.Context, *v1.BackupDatabaseRequest) (*v1.BackupDatabaseResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.BackupDatabase is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) CheckDatabaseIntegrity(context.Context, *v1.CheckDatabaseIntegrityRequest) (*v1.CheckDatabaseIntegrityResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.CheckDatabaseIntegrity is not implemented"))
+}
+
 func (UnimplementedClientRpcServiceHandler) IndexShare(context.Context, *v1.IndexShareRequest) (*v1.IndexShareResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.IndexShare is not implemented"))
 }
@@ -1314,6 +3756,18 @@ func (UnimplementedClientRpcServiceHandler) CheckForNewUpdate(context.Context, *
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.CheckForNewUpdate is not implemented"))
 }
 
+func (UnimplementedClientRpcServiceHandler) ApplyUpdate(context.Context, *v1.ApplyUpdateRequest) (*v1.ApplyUpdateResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.ApplyUpdate is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetUpdateSettings(context.Context, *v1.GetUpdateSettingsRequest) (*v1.GetUpdateSettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetUpdateSettings is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) UpdateUpdateSettings(context.Context, *v1.UpdateUpdateSettingsRequest) (*v1.UpdateUpdateSettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.UpdateUpdateSettings is not implemented"))
+}
+
 func (UnimplementedClientRpcServiceHandler) GetDownloadManagerItems(context.Context, *v1.GetDownloadManagerItemsRequest) (*v1.GetDownloadManagerItemsResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetDownloadManagerItems is not implemented"))
 }
@@ -1330,6 +3784,134 @@ func (UnimplementedClientRpcServiceHandler) RemoveDownloadManagerItem(context.Co
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.RemoveDownloadManagerItem is not implemented"))
 }
 
+func (UnimplementedClientRpcServiceHandler) PauseFileDownload(context.Context, *v1.PauseFileDownloadRequest) (*v1.PauseFileDownloadResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.PauseFileDownload is not implemented"))
+}
+
 func (UnimplementedClientRpcServiceHandler) ResumeFileDownload(context.Context, *v1.ResumeFileDownloadRequest) (*v1.ResumeFileDownloadResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.ResumeFileDownload is not implemented"))
 }
+
+func (UnimplementedClientRpcServiceHandler) GetDownloadHooks(context.Context, *v1.GetDownloadHooksRequest) (*v1.GetDownloadHooksResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetDownloadHooks is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) CreateDownloadHook(context.Context, *v1.CreateDownloadHookRequest) (*v1.CreateDownloadHookResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.CreateDownloadHook is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) DeleteDownloadHook(context.Context, *v1.DeleteDownloadHookRequest) (*v1.DeleteDownloadHookResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.DeleteDownloadHook is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetUploads(context.Context, *v1.GetUploadsRequest) (*v1.GetUploadsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetUploads is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) ClearUploadHistory(context.Context, *v1.ClearUploadHistoryRequest) (*v1.ClearUploadHistoryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.ClearUploadHistory is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetFriends(context.Context, *v1.GetFriendsRequest) (*v1.GetFriendsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetFriends is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) SetFriend(context.Context, *v1.SetFriendRequest) (*v1.SetFriendResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.SetFriend is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) DeleteFriend(context.Context, *v1.DeleteFriendRequest) (*v1.DeleteFriendResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.DeleteFriend is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetBlockedPeers(context.Context, *v1.GetBlockedPeersRequest) (*v1.GetBlockedPeersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetBlockedPeers is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) BlockPeer(context.Context, *v1.BlockPeerRequest) (*v1.BlockPeerResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.BlockPeer is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) UnblockPeer(context.Context, *v1.UnblockPeerRequest) (*v1.UnblockPeerResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.UnblockPeer is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetInterests(context.Context, *v1.GetInterestsRequest) (*v1.GetInterestsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetInterests is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) SetInterests(context.Context, *v1.SetInterestsRequest) (*v1.SetInterestsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.SetInterests is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetSimilarUsers(context.Context, *v1.GetSimilarUsersRequest) (*v1.GetSimilarUsersResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetSimilarUsers is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetServerSchedule(context.Context, *v1.GetServerScheduleRequest) (*v1.GetServerScheduleResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetServerSchedule is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) SetServerSchedule(context.Context, *v1.SetServerScheduleRequest) (*v1.SetServerScheduleResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.SetServerSchedule is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetSnooze(context.Context, *v1.GetSnoozeRequest) (*v1.GetSnoozeResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetSnooze is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) Snooze(context.Context, *v1.SnoozeRequest) (*v1.SnoozeResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.Snooze is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) Unsnooze(context.Context, *v1.UnsnoozeRequest) (*v1.UnsnoozeResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.Unsnooze is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetRunHistory(context.Context, *v1.GetRunHistoryRequest) (*v1.GetRunHistoryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetRunHistory is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetConnHistory(context.Context, *v1.GetConnHistoryRequest) (*v1.GetConnHistoryResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetConnHistory is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetTrash(context.Context, *v1.GetTrashRequest) (*v1.GetTrashResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetTrash is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) RestoreServer(context.Context, *v1.RestoreServerRequest) (*v1.RestoreServerResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.RestoreServer is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) PurgeServer(context.Context, *v1.PurgeServerRequest) (*v1.PurgeServerResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.PurgeServer is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) RestoreShare(context.Context, *v1.RestoreShareRequest) (*v1.RestoreShareResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.RestoreShare is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) PurgeShare(context.Context, *v1.PurgeShareRequest) (*v1.PurgeShareResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.PurgeShare is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) GetPlugins(context.Context, *v1.GetPluginsRequest) (*v1.GetPluginsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.GetPlugins is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) CreatePlugin(context.Context, *v1.CreatePluginRequest) (*v1.CreatePluginResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.CreatePlugin is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) DeletePlugin(context.Context, *v1.DeletePluginRequest) (*v1.DeletePluginResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.DeletePlugin is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) StreamPluginEvents(context.Context, *v1.StreamPluginEventsRequest, *connect.ServerStream[v1.StreamPluginEventsResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.StreamPluginEvents is not implemented"))
+}
+
+func (UnimplementedClientRpcServiceHandler) RespondToSearch(context.Context, *v1.RespondToSearchRequest) (*v1.RespondToSearchResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.clientrpc.v1.ClientRpcService.RespondToSearch is not implemented"))
+}
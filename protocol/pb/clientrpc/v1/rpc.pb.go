@@ -37,6 +37,8 @@ const (
 	DownloadStatus_DOWNLOAD_STATUS_DONE DownloadStatus = 4
 	// Failed to download due to an error.
 	DownloadStatus_DOWNLOAD_STATUS_ERROR DownloadStatus = 5
+	// Paused by the user.
+	DownloadStatus_DOWNLOAD_STATUS_PAUSED DownloadStatus = 6
 )
 
 // Enum value maps for DownloadStatus.
@@ -48,6 +50,7 @@ var (
 		3: "DOWNLOAD_STATUS_CANCELED",
 		4: "DOWNLOAD_STATUS_DONE",
 		5: "DOWNLOAD_STATUS_ERROR",
+		6: "DOWNLOAD_STATUS_PAUSED",
 	}
 	DownloadStatus_value = map[string]int32{
 		"DOWNLOAD_STATUS_UNSPECIFIED": 0,
@@ -56,6 +59,7 @@ var (
 		"DOWNLOAD_STATUS_CANCELED":    3,
 		"DOWNLOAD_STATUS_DONE":        4,
 		"DOWNLOAD_STATUS_ERROR":       5,
+		"DOWNLOAD_STATUS_PAUSED":      6,
 	}
 )
 
@@ -86,6 +90,457 @@ func (DownloadStatus) EnumDescriptor() ([]byte, []int) {
 	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0}
 }
 
+// ScanStatus is the result of scanning a completed download with the configured scan command.
+type ScanStatus int32
+
+const (
+	// The download was not scanned, either because it is not complete or because no scan command is configured.
+	ScanStatus_SCAN_STATUS_UNSPECIFIED ScanStatus = 0
+	// The scan command found nothing.
+	ScanStatus_SCAN_STATUS_CLEAN ScanStatus = 1
+	// The scan command found a threat, and the file was moved to the quarantine directory.
+	ScanStatus_SCAN_STATUS_INFECTED ScanStatus = 2
+	// The scan command failed to scan the file.
+	// The file is kept as a partial download, so that it is scanned again when the download is retried.
+	ScanStatus_SCAN_STATUS_FAILED ScanStatus = 3
+)
+
+// Enum value maps for ScanStatus.
+var (
+	ScanStatus_name = map[int32]string{
+		0: "SCAN_STATUS_UNSPECIFIED",
+		1: "SCAN_STATUS_CLEAN",
+		2: "SCAN_STATUS_INFECTED",
+		3: "SCAN_STATUS_FAILED",
+	}
+	ScanStatus_value = map[string]int32{
+		"SCAN_STATUS_UNSPECIFIED": 0,
+		"SCAN_STATUS_CLEAN":       1,
+		"SCAN_STATUS_INFECTED":    2,
+		"SCAN_STATUS_FAILED":      3,
+	}
+)
+
+func (x ScanStatus) Enum() *ScanStatus {
+	p := new(ScanStatus)
+	*p = x
+	return p
+}
+
+func (x ScanStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ScanStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[1].Descriptor()
+}
+
+func (ScanStatus) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[1]
+}
+
+func (x ScanStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ScanStatus.Descriptor instead.
+func (ScanStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{1}
+}
+
+// UploadStatus is the status of a file upload to a peer.
+type UploadStatus int32
+
+const (
+	// Do not use.
+	UploadStatus_UPLOAD_STATUS_UNSPECIFIED UploadStatus = 0
+	// In progress.
+	UploadStatus_UPLOAD_STATUS_IN_PROGRESS UploadStatus = 1
+	// All requested bytes were sent.
+	UploadStatus_UPLOAD_STATUS_DONE UploadStatus = 2
+	// The peer stopped the transfer, or the connection closed.
+	UploadStatus_UPLOAD_STATUS_CANCELED UploadStatus = 3
+	// Failed due to an error.
+	UploadStatus_UPLOAD_STATUS_ERROR UploadStatus = 4
+)
+
+// Enum value maps for UploadStatus.
+var (
+	UploadStatus_name = map[int32]string{
+		0: "UPLOAD_STATUS_UNSPECIFIED",
+		1: "UPLOAD_STATUS_IN_PROGRESS",
+		2: "UPLOAD_STATUS_DONE",
+		3: "UPLOAD_STATUS_CANCELED",
+		4: "UPLOAD_STATUS_ERROR",
+	}
+	UploadStatus_value = map[string]int32{
+		"UPLOAD_STATUS_UNSPECIFIED": 0,
+		"UPLOAD_STATUS_IN_PROGRESS": 1,
+		"UPLOAD_STATUS_DONE":        2,
+		"UPLOAD_STATUS_CANCELED":    3,
+		"UPLOAD_STATUS_ERROR":       4,
+	}
+)
+
+func (x UploadStatus) Enum() *UploadStatus {
+	p := new(UploadStatus)
+	*p = x
+	return p
+}
+
+func (x UploadStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UploadStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[2].Descriptor()
+}
+
+func (UploadStatus) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[2]
+}
+
+func (x UploadStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UploadStatus.Descriptor instead.
+func (UploadStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{2}
+}
+
+// ArchiveFormat is an archive format that a directory can be streamed as.
+type ArchiveFormat int32
+
+const (
+	// Do not use.
+	ArchiveFormat_ARCHIVE_FORMAT_UNSPECIFIED ArchiveFormat = 0
+	// A zip archive.
+	ArchiveFormat_ARCHIVE_FORMAT_ZIP ArchiveFormat = 1
+	// A gzip-compressed tar archive.
+	ArchiveFormat_ARCHIVE_FORMAT_TAR_GZ ArchiveFormat = 2
+)
+
+// Enum value maps for ArchiveFormat.
+var (
+	ArchiveFormat_name = map[int32]string{
+		0: "ARCHIVE_FORMAT_UNSPECIFIED",
+		1: "ARCHIVE_FORMAT_ZIP",
+		2: "ARCHIVE_FORMAT_TAR_GZ",
+	}
+	ArchiveFormat_value = map[string]int32{
+		"ARCHIVE_FORMAT_UNSPECIFIED": 0,
+		"ARCHIVE_FORMAT_ZIP":         1,
+		"ARCHIVE_FORMAT_TAR_GZ":      2,
+	}
+)
+
+func (x ArchiveFormat) Enum() *ArchiveFormat {
+	p := new(ArchiveFormat)
+	*p = x
+	return p
+}
+
+func (x ArchiveFormat) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ArchiveFormat) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[3].Descriptor()
+}
+
+func (ArchiveFormat) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[3]
+}
+
+func (x ArchiveFormat) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ArchiveFormat.Descriptor instead.
+func (ArchiveFormat) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{3}
+}
+
+// PeerPath is the path that traffic to a peer takes.
+type PeerPath int32
+
+const (
+	// Use whichever path normal traffic to the peer would use.
+	PeerPath_PEER_PATH_UNSPECIFIED PeerPath = 0
+	// Traffic is proxied through the server.
+	PeerPath_PEER_PATH_PROXY PeerPath = 1
+	// Traffic goes over a direct connection to the peer.
+	PeerPath_PEER_PATH_DIRECT PeerPath = 2
+)
+
+// Enum value maps for PeerPath.
+var (
+	PeerPath_name = map[int32]string{
+		0: "PEER_PATH_UNSPECIFIED",
+		1: "PEER_PATH_PROXY",
+		2: "PEER_PATH_DIRECT",
+	}
+	PeerPath_value = map[string]int32{
+		"PEER_PATH_UNSPECIFIED": 0,
+		"PEER_PATH_PROXY":       1,
+		"PEER_PATH_DIRECT":      2,
+	}
+)
+
+func (x PeerPath) Enum() *PeerPath {
+	p := new(PeerPath)
+	*p = x
+	return p
+}
+
+func (x PeerPath) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PeerPath) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[4].Descriptor()
+}
+
+func (PeerPath) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[4]
+}
+
+func (x PeerPath) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PeerPath.Descriptor instead.
+func (PeerPath) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{4}
+}
+
+// DownloadHookType is the type of a download hook.
+type DownloadHookType int32
+
+const (
+	// Do not use.
+	DownloadHookType_DOWNLOAD_HOOK_TYPE_UNSPECIFIED DownloadHookType = 0
+	// Runs an executable with the completed file's path as its only argument.
+	// The executable does not inherit the client's environment; it only receives a minimal set of variables and
+	// FRIENDNET_-prefixed variables describing the download.
+	DownloadHookType_DOWNLOAD_HOOK_TYPE_COMMAND DownloadHookType = 1
+	// Sends an HTTP POST request with a JSON body describing the download.
+	DownloadHookType_DOWNLOAD_HOOK_TYPE_WEBHOOK DownloadHookType = 2
+)
+
+// Enum value maps for DownloadHookType.
+var (
+	DownloadHookType_name = map[int32]string{
+		0: "DOWNLOAD_HOOK_TYPE_UNSPECIFIED",
+		1: "DOWNLOAD_HOOK_TYPE_COMMAND",
+		2: "DOWNLOAD_HOOK_TYPE_WEBHOOK",
+	}
+	DownloadHookType_value = map[string]int32{
+		"DOWNLOAD_HOOK_TYPE_UNSPECIFIED": 0,
+		"DOWNLOAD_HOOK_TYPE_COMMAND":     1,
+		"DOWNLOAD_HOOK_TYPE_WEBHOOK":     2,
+	}
+)
+
+func (x DownloadHookType) Enum() *DownloadHookType {
+	p := new(DownloadHookType)
+	*p = x
+	return p
+}
+
+func (x DownloadHookType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DownloadHookType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[5].Descriptor()
+}
+
+func (DownloadHookType) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[5]
+}
+
+func (x DownloadHookType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DownloadHookType.Descriptor instead.
+func (DownloadHookType) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{5}
+}
+
+// UpdateChannel is a release channel that the client checks for updates on.
+type UpdateChannel int32
+
+const (
+	// Same as UPDATE_CHANNEL_STABLE.
+	UpdateChannel_UPDATE_CHANNEL_UNSPECIFIED UpdateChannel = 0
+	// Regular releases.
+	UpdateChannel_UPDATE_CHANNEL_STABLE UpdateChannel = 1
+	// Pre-releases, which get new features first but may be less reliable.
+	UpdateChannel_UPDATE_CHANNEL_BETA UpdateChannel = 2
+)
+
+// Enum value maps for UpdateChannel.
+var (
+	UpdateChannel_name = map[int32]string{
+		0: "UPDATE_CHANNEL_UNSPECIFIED",
+		1: "UPDATE_CHANNEL_STABLE",
+		2: "UPDATE_CHANNEL_BETA",
+	}
+	UpdateChannel_value = map[string]int32{
+		"UPDATE_CHANNEL_UNSPECIFIED": 0,
+		"UPDATE_CHANNEL_STABLE":      1,
+		"UPDATE_CHANNEL_BETA":        2,
+	}
+)
+
+func (x UpdateChannel) Enum() *UpdateChannel {
+	p := new(UpdateChannel)
+	*p = x
+	return p
+}
+
+func (x UpdateChannel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (UpdateChannel) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[6].Descriptor()
+}
+
+func (UpdateChannel) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[6]
+}
+
+func (x UpdateChannel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use UpdateChannel.Descriptor instead.
+func (UpdateChannel) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{6}
+}
+
+// ErrorReason is the known cause of an RPC error.
+type ErrorReason int32
+
+const (
+	// Do not use.
+	ErrorReason_ERROR_REASON_UNSPECIFIED ErrorReason = 0
+	// The server rejected the credentials.
+	ErrorReason_ERROR_REASON_AUTH_INVALID_CREDENTIALS ErrorReason = 1
+	// The account is banned.
+	ErrorReason_ERROR_REASON_AUTH_BANNED ErrorReason = 2
+	// The account is already connected to the room.
+	ErrorReason_ERROR_REASON_AUTH_ALREADY_CONNECTED ErrorReason = 3
+	// Too many authentication attempts were made.
+	ErrorReason_ERROR_REASON_AUTH_RATE_LIMITED ErrorReason = 4
+	// The server does not allow registration.
+	ErrorReason_ERROR_REASON_AUTH_REGISTRATION_DISABLED ErrorReason = 5
+	// The invite code is invalid or was used up.
+	ErrorReason_ERROR_REASON_AUTH_INVALID_INVITE_CODE ErrorReason = 6
+	// An account with the username already exists.
+	ErrorReason_ERROR_REASON_AUTH_USERNAME_TAKEN ErrorReason = 7
+	// The password does not meet the server's requirements.
+	ErrorReason_ERROR_REASON_AUTH_INVALID_PASSWORD ErrorReason = 8
+	// The server rejected authentication for another reason.
+	ErrorReason_ERROR_REASON_AUTH_REJECTED ErrorReason = 9
+	// The server does not support the client's protocol version because the client is too old.
+	ErrorReason_ERROR_REASON_VERSION_TOO_OLD ErrorReason = 10
+	// The server does not support the client's protocol version because the client is too new.
+	ErrorReason_ERROR_REASON_VERSION_TOO_NEW ErrorReason = 11
+	// The server rejected the client's protocol version for another reason.
+	ErrorReason_ERROR_REASON_VERSION_REJECTED ErrorReason = 12
+	// The server's certificate is different from the one seen on earlier connections.
+	// The server may have been reinstalled, or the connection may be intercepted.
+	ErrorReason_ERROR_REASON_CERT_MISMATCH ErrorReason = 13
+	// The server's certificate does not match the fingerprint in the invite.
+	ErrorReason_ERROR_REASON_CERT_FINGERPRINT_MISMATCH ErrorReason = 14
+	// The server's certificate is expired or not valid yet.
+	ErrorReason_ERROR_REASON_CERT_NOT_VALID_NOW ErrorReason = 15
+	// The server did not present a certificate.
+	ErrorReason_ERROR_REASON_NO_SERVER_CERTS ErrorReason = 16
+	// The peer could not be reached through the server.
+	ErrorReason_ERROR_REASON_PEER_UNREACHABLE ErrorReason = 17
+	// The peer did not reply to the request in time, even after retrying.
+	ErrorReason_ERROR_REASON_PEER_TIMEOUT ErrorReason = 18
+)
+
+// Enum value maps for ErrorReason.
+var (
+	ErrorReason_name = map[int32]string{
+		0:  "ERROR_REASON_UNSPECIFIED",
+		1:  "ERROR_REASON_AUTH_INVALID_CREDENTIALS",
+		2:  "ERROR_REASON_AUTH_BANNED",
+		3:  "ERROR_REASON_AUTH_ALREADY_CONNECTED",
+		4:  "ERROR_REASON_AUTH_RATE_LIMITED",
+		5:  "ERROR_REASON_AUTH_REGISTRATION_DISABLED",
+		6:  "ERROR_REASON_AUTH_INVALID_INVITE_CODE",
+		7:  "ERROR_REASON_AUTH_USERNAME_TAKEN",
+		8:  "ERROR_REASON_AUTH_INVALID_PASSWORD",
+		9:  "ERROR_REASON_AUTH_REJECTED",
+		10: "ERROR_REASON_VERSION_TOO_OLD",
+		11: "ERROR_REASON_VERSION_TOO_NEW",
+		12: "ERROR_REASON_VERSION_REJECTED",
+		13: "ERROR_REASON_CERT_MISMATCH",
+		14: "ERROR_REASON_CERT_FINGERPRINT_MISMATCH",
+		15: "ERROR_REASON_CERT_NOT_VALID_NOW",
+		16: "ERROR_REASON_NO_SERVER_CERTS",
+		17: "ERROR_REASON_PEER_UNREACHABLE",
+		18: "ERROR_REASON_PEER_TIMEOUT",
+	}
+	ErrorReason_value = map[string]int32{
+		"ERROR_REASON_UNSPECIFIED":                0,
+		"ERROR_REASON_AUTH_INVALID_CREDENTIALS":   1,
+		"ERROR_REASON_AUTH_BANNED":                2,
+		"ERROR_REASON_AUTH_ALREADY_CONNECTED":     3,
+		"ERROR_REASON_AUTH_RATE_LIMITED":          4,
+		"ERROR_REASON_AUTH_REGISTRATION_DISABLED": 5,
+		"ERROR_REASON_AUTH_INVALID_INVITE_CODE":   6,
+		"ERROR_REASON_AUTH_USERNAME_TAKEN":        7,
+		"ERROR_REASON_AUTH_INVALID_PASSWORD":      8,
+		"ERROR_REASON_AUTH_REJECTED":              9,
+		"ERROR_REASON_VERSION_TOO_OLD":            10,
+		"ERROR_REASON_VERSION_TOO_NEW":            11,
+		"ERROR_REASON_VERSION_REJECTED":           12,
+		"ERROR_REASON_CERT_MISMATCH":              13,
+		"ERROR_REASON_CERT_FINGERPRINT_MISMATCH":  14,
+		"ERROR_REASON_CERT_NOT_VALID_NOW":         15,
+		"ERROR_REASON_NO_SERVER_CERTS":            16,
+		"ERROR_REASON_PEER_UNREACHABLE":           17,
+		"ERROR_REASON_PEER_TIMEOUT":               18,
+	}
+)
+
+func (x ErrorReason) Enum() *ErrorReason {
+	p := new(ErrorReason)
+	*p = x
+	return p
+}
+
+func (x ErrorReason) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ErrorReason) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[7].Descriptor()
+}
+
+func (ErrorReason) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[7]
+}
+
+func (x ErrorReason) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ErrorReason.Descriptor instead.
+func (ErrorReason) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{7}
+}
+
 // ServerConnState is possible connection states for a server.
 type ServerConnState int32
 
@@ -127,11 +582,11 @@ func (x ServerConnState) String() string {
 }
 
 func (ServerConnState) Descriptor() protoreflect.EnumDescriptor {
-	return file_pb_clientrpc_v1_rpc_proto_enumTypes[1].Descriptor()
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[8].Descriptor()
 }
 
 func (ServerConnState) Type() protoreflect.EnumType {
-	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[1]
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[8]
 }
 
 func (x ServerConnState) Number() protoreflect.EnumNumber {
@@ -140,166 +595,9948 @@ func (x ServerConnState) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ServerConnState.Descriptor instead.
 func (ServerConnState) EnumDescriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{1}
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{8}
 }
 
-type Event_Type int32
+// ShareUnicodeForm is a Unicode normalization form that paths requested by peers are matched in.
+// Peers on macOS typically request names in NFD, while peers on Windows and Linux typically request names in NFC, so
+// matching in either form lets files be found no matter which form their names are stored in.
+type ShareUnicodeForm int32
 
 const (
-	// Do not use.
-	Event_TYPE_UNSPECIFIED Event_Type = 0
-	// The client is stopping.
-	Event_TYPE_STOP Event_Type = 1
-	// A server's connection state changed.
-	Event_TYPE_SERVER_CONN_STATE_CHANGE Event_Type = 2
-	// A client went online.
-	Event_TYPE_CLIENT_ONLINE Event_Type = 3
-	// A client went offline.
-	Event_TYPE_CLIENT_OFFLINE Event_Type = 4
-	// A new update is available or the update checked was invalid.
-	Event_TYPE_NEW_UPDATE Event_Type = 5
-	// Download status updates for files.
-	Event_TYPE_DOWNLOAD_STATUS_UPDATES Event_Type = 6
-	// A new download manager item was added.
-	Event_TYPE_NEW_DM_ITEM Event_Type = 7
-	// A download manager item was removed.
-	Event_TYPE_DM_ITEM_REMOVED Event_Type = 8
+	// Same as SHARE_UNICODE_FORM_NONE.
+	ShareUnicodeForm_SHARE_UNICODE_FORM_UNSPECIFIED ShareUnicodeForm = 0
+	// Match paths without normalizing them.
+	ShareUnicodeForm_SHARE_UNICODE_FORM_NONE ShareUnicodeForm = 1
+	// Match paths in Normalization Form C (composed).
+	ShareUnicodeForm_SHARE_UNICODE_FORM_NFC ShareUnicodeForm = 2
+	// Match paths in Normalization Form D (decomposed).
+	ShareUnicodeForm_SHARE_UNICODE_FORM_NFD ShareUnicodeForm = 3
 )
 
-// Enum value maps for Event_Type.
+// Enum value maps for ShareUnicodeForm.
 var (
-	Event_Type_name = map[int32]string{
-		0: "TYPE_UNSPECIFIED",
-		1: "TYPE_STOP",
-		2: "TYPE_SERVER_CONN_STATE_CHANGE",
-		3: "TYPE_CLIENT_ONLINE",
-		4: "TYPE_CLIENT_OFFLINE",
-		5: "TYPE_NEW_UPDATE",
-		6: "TYPE_DOWNLOAD_STATUS_UPDATES",
-		7: "TYPE_NEW_DM_ITEM",
-		8: "TYPE_DM_ITEM_REMOVED",
+	ShareUnicodeForm_name = map[int32]string{
+		0: "SHARE_UNICODE_FORM_UNSPECIFIED",
+		1: "SHARE_UNICODE_FORM_NONE",
+		2: "SHARE_UNICODE_FORM_NFC",
+		3: "SHARE_UNICODE_FORM_NFD",
 	}
-	Event_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED":              0,
-		"TYPE_STOP":                     1,
-		"TYPE_SERVER_CONN_STATE_CHANGE": 2,
-		"TYPE_CLIENT_ONLINE":            3,
-		"TYPE_CLIENT_OFFLINE":           4,
-		"TYPE_NEW_UPDATE":               5,
-		"TYPE_DOWNLOAD_STATUS_UPDATES":  6,
-		"TYPE_NEW_DM_ITEM":              7,
-		"TYPE_DM_ITEM_REMOVED":          8,
+	ShareUnicodeForm_value = map[string]int32{
+		"SHARE_UNICODE_FORM_UNSPECIFIED": 0,
+		"SHARE_UNICODE_FORM_NONE":        1,
+		"SHARE_UNICODE_FORM_NFC":         2,
+		"SHARE_UNICODE_FORM_NFD":         3,
 	}
 )
 
-func (x Event_Type) Enum() *Event_Type {
-	p := new(Event_Type)
+func (x ShareUnicodeForm) Enum() *ShareUnicodeForm {
+	p := new(ShareUnicodeForm)
 	*p = x
 	return p
 }
 
-func (x Event_Type) String() string {
+func (x ShareUnicodeForm) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (Event_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_pb_clientrpc_v1_rpc_proto_enumTypes[2].Descriptor()
+func (ShareUnicodeForm) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[9].Descriptor()
 }
 
-func (Event_Type) Type() protoreflect.EnumType {
-	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[2]
+func (ShareUnicodeForm) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[9]
 }
 
-func (x Event_Type) Number() protoreflect.EnumNumber {
+func (x ShareUnicodeForm) Number() protoreflect.EnumNumber {
 	return protoreflect.EnumNumber(x)
 }
 
-// Deprecated: Use Event_Type.Descriptor instead.
-func (Event_Type) EnumDescriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 0}
+// Deprecated: Use ShareUnicodeForm.Descriptor instead.
+func (ShareUnicodeForm) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{9}
 }
 
-type DownloadManagerItem_Type int32
+// ShareConflictRule decides which file is served when more than one of a share's mounted directories has a file at the
+// same path.
+// Directories never conflict: directories at the same path are merged, and a directory always wins over a file.
+type ShareConflictRule int32
 
 const (
-	// Do not use.
-	DownloadManagerItem_TYPE_UNSPECIFIED DownloadManagerItem_Type = 0
-	// A download.
-	DownloadManagerItem_TYPE_DOWNLOAD DownloadManagerItem_Type = 1
+	// Same as SHARE_CONFLICT_RULE_FIRST.
+	ShareConflictRule_SHARE_CONFLICT_RULE_UNSPECIFIED ShareConflictRule = 0
+	// Serve the file from the directory that comes first, starting with the share's own path.
+	ShareConflictRule_SHARE_CONFLICT_RULE_FIRST ShareConflictRule = 1
+	// Serve the file that was modified most recently.
+	// Ties are broken by the order of the directories.
+	ShareConflictRule_SHARE_CONFLICT_RULE_NEWEST ShareConflictRule = 2
 )
 
-// Enum value maps for DownloadManagerItem_Type.
+// Enum value maps for ShareConflictRule.
 var (
-	DownloadManagerItem_Type_name = map[int32]string{
-		0: "TYPE_UNSPECIFIED",
-		1: "TYPE_DOWNLOAD",
+	ShareConflictRule_name = map[int32]string{
+		0: "SHARE_CONFLICT_RULE_UNSPECIFIED",
+		1: "SHARE_CONFLICT_RULE_FIRST",
+		2: "SHARE_CONFLICT_RULE_NEWEST",
 	}
-	DownloadManagerItem_Type_value = map[string]int32{
-		"TYPE_UNSPECIFIED": 0,
-		"TYPE_DOWNLOAD":    1,
+	ShareConflictRule_value = map[string]int32{
+		"SHARE_CONFLICT_RULE_UNSPECIFIED": 0,
+		"SHARE_CONFLICT_RULE_FIRST":       1,
+		"SHARE_CONFLICT_RULE_NEWEST":      2,
 	}
 )
 
-func (x DownloadManagerItem_Type) Enum() *DownloadManagerItem_Type {
-	p := new(DownloadManagerItem_Type)
+func (x ShareConflictRule) Enum() *ShareConflictRule {
+	p := new(ShareConflictRule)
 	*p = x
 	return p
 }
 
-func (x DownloadManagerItem_Type) String() string {
+func (x ShareConflictRule) String() string {
 	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
 }
 
-func (DownloadManagerItem_Type) Descriptor() protoreflect.EnumDescriptor {
-	return file_pb_clientrpc_v1_rpc_proto_enumTypes[3].Descriptor()
+func (ShareConflictRule) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[10].Descriptor()
+}
+
+func (ShareConflictRule) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[10]
+}
+
+func (x ShareConflictRule) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareConflictRule.Descriptor instead.
+func (ShareConflictRule) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{10}
+}
+
+// TrustLevel is how much the local user trusts a peer.
+type TrustLevel int32
+
+const (
+	// No trust level was set.
+	TrustLevel_TRUST_LEVEL_UNSPECIFIED TrustLevel = 0
+	// The peer is not trusted.
+	TrustLevel_TRUST_LEVEL_DISTRUSTED TrustLevel = 1
+	// The peer is trusted.
+	TrustLevel_TRUST_LEVEL_TRUSTED TrustLevel = 2
+)
+
+// Enum value maps for TrustLevel.
+var (
+	TrustLevel_name = map[int32]string{
+		0: "TRUST_LEVEL_UNSPECIFIED",
+		1: "TRUST_LEVEL_DISTRUSTED",
+		2: "TRUST_LEVEL_TRUSTED",
+	}
+	TrustLevel_value = map[string]int32{
+		"TRUST_LEVEL_UNSPECIFIED": 0,
+		"TRUST_LEVEL_DISTRUSTED":  1,
+		"TRUST_LEVEL_TRUSTED":     2,
+	}
+)
+
+func (x TrustLevel) Enum() *TrustLevel {
+	p := new(TrustLevel)
+	*p = x
+	return p
+}
+
+func (x TrustLevel) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TrustLevel) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[11].Descriptor()
+}
+
+func (TrustLevel) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[11]
+}
+
+func (x TrustLevel) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TrustLevel.Descriptor instead.
+func (TrustLevel) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{11}
+}
+
+// ShareHealthIssueKind is a kind of problem with an entry in a share.
+type ShareHealthIssueKind int32
+
+const (
+	ShareHealthIssueKind_SHARE_HEALTH_ISSUE_KIND_UNSPECIFIED ShareHealthIssueKind = 0
+	// The entry's name is not valid UTF-8. It is not shared.
+	ShareHealthIssueKind_SHARE_HEALTH_ISSUE_KIND_INVALID_NAME ShareHealthIssueKind = 1
+	// The entry could not be read, such as because of missing permissions. It is not shared.
+	ShareHealthIssueKind_SHARE_HEALTH_ISSUE_KIND_UNREADABLE ShareHealthIssueKind = 2
+	// The entry is a symbolic link whose target does not exist. It is not shared.
+	ShareHealthIssueKind_SHARE_HEALTH_ISSUE_KIND_BROKEN_LINK ShareHealthIssueKind = 3
+	// The entry's name is reserved on Windows or has characters Windows does not allow, such as "CON" or "a:b".
+	// It is shared, but peers on Windows save it under a different name.
+	ShareHealthIssueKind_SHARE_HEALTH_ISSUE_KIND_WINDOWS_NAME ShareHealthIssueKind = 4
+	// The entry's name is longer than most filesystems can store. It is shared, but peers may not be able to save it.
+	ShareHealthIssueKind_SHARE_HEALTH_ISSUE_KIND_NAME_TOO_LONG ShareHealthIssueKind = 5
+)
+
+// Enum value maps for ShareHealthIssueKind.
+var (
+	ShareHealthIssueKind_name = map[int32]string{
+		0: "SHARE_HEALTH_ISSUE_KIND_UNSPECIFIED",
+		1: "SHARE_HEALTH_ISSUE_KIND_INVALID_NAME",
+		2: "SHARE_HEALTH_ISSUE_KIND_UNREADABLE",
+		3: "SHARE_HEALTH_ISSUE_KIND_BROKEN_LINK",
+		4: "SHARE_HEALTH_ISSUE_KIND_WINDOWS_NAME",
+		5: "SHARE_HEALTH_ISSUE_KIND_NAME_TOO_LONG",
+	}
+	ShareHealthIssueKind_value = map[string]int32{
+		"SHARE_HEALTH_ISSUE_KIND_UNSPECIFIED":   0,
+		"SHARE_HEALTH_ISSUE_KIND_INVALID_NAME":  1,
+		"SHARE_HEALTH_ISSUE_KIND_UNREADABLE":    2,
+		"SHARE_HEALTH_ISSUE_KIND_BROKEN_LINK":   3,
+		"SHARE_HEALTH_ISSUE_KIND_WINDOWS_NAME":  4,
+		"SHARE_HEALTH_ISSUE_KIND_NAME_TOO_LONG": 5,
+	}
+)
+
+func (x ShareHealthIssueKind) Enum() *ShareHealthIssueKind {
+	p := new(ShareHealthIssueKind)
+	*p = x
+	return p
+}
+
+func (x ShareHealthIssueKind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShareHealthIssueKind) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[12].Descriptor()
+}
+
+func (ShareHealthIssueKind) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[12]
+}
+
+func (x ShareHealthIssueKind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShareHealthIssueKind.Descriptor instead.
+func (ShareHealthIssueKind) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{12}
+}
+
+// DiagnosticStep is a step of connecting to a server that Diagnose checks.
+type DiagnosticStep int32
+
+const (
+	// Do not use.
+	DiagnosticStep_DIAGNOSTIC_STEP_UNSPECIFIED DiagnosticStep = 0
+	// Resolving the server address to IP addresses, including SRV and well-known lookups.
+	DiagnosticStep_DIAGNOSTIC_STEP_RESOLVE DiagnosticStep = 1
+	// Sending a UDP packet to the server to check whether its host rejects it.
+	DiagnosticStep_DIAGNOSTIC_STEP_UDP_PROBE DiagnosticStep = 2
+	// Connecting to the server over QUIC and verifying its certificate.
+	DiagnosticStep_DIAGNOSTIC_STEP_QUIC_HANDSHAKE DiagnosticStep = 3
+	// Negotiating the protocol version.
+	DiagnosticStep_DIAGNOSTIC_STEP_VERSION_NEGOTIATION DiagnosticStep = 4
+	// Authenticating with the server's credentials.
+	DiagnosticStep_DIAGNOSTIC_STEP_AUTHENTICATION DiagnosticStep = 5
+)
+
+// Enum value maps for DiagnosticStep.
+var (
+	DiagnosticStep_name = map[int32]string{
+		0: "DIAGNOSTIC_STEP_UNSPECIFIED",
+		1: "DIAGNOSTIC_STEP_RESOLVE",
+		2: "DIAGNOSTIC_STEP_UDP_PROBE",
+		3: "DIAGNOSTIC_STEP_QUIC_HANDSHAKE",
+		4: "DIAGNOSTIC_STEP_VERSION_NEGOTIATION",
+		5: "DIAGNOSTIC_STEP_AUTHENTICATION",
+	}
+	DiagnosticStep_value = map[string]int32{
+		"DIAGNOSTIC_STEP_UNSPECIFIED":         0,
+		"DIAGNOSTIC_STEP_RESOLVE":             1,
+		"DIAGNOSTIC_STEP_UDP_PROBE":           2,
+		"DIAGNOSTIC_STEP_QUIC_HANDSHAKE":      3,
+		"DIAGNOSTIC_STEP_VERSION_NEGOTIATION": 4,
+		"DIAGNOSTIC_STEP_AUTHENTICATION":      5,
+	}
+)
+
+func (x DiagnosticStep) Enum() *DiagnosticStep {
+	p := new(DiagnosticStep)
+	*p = x
+	return p
+}
+
+func (x DiagnosticStep) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiagnosticStep) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[13].Descriptor()
+}
+
+func (DiagnosticStep) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[13]
+}
+
+func (x DiagnosticStep) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiagnosticStep.Descriptor instead.
+func (DiagnosticStep) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{13}
+}
+
+// DiagnosticStatus is the outcome of a diagnostic step.
+type DiagnosticStatus int32
+
+const (
+	// Do not use.
+	DiagnosticStatus_DIAGNOSTIC_STATUS_UNSPECIFIED DiagnosticStatus = 0
+	// The step succeeded.
+	DiagnosticStatus_DIAGNOSTIC_STATUS_OK DiagnosticStatus = 1
+	// The step failed.
+	DiagnosticStatus_DIAGNOSTIC_STATUS_FAILED DiagnosticStatus = 2
+	// The step could not tell whether there is a problem.
+	// For example, servers do not reply to UDP probes, so a probe that was not rejected may still have been dropped.
+	DiagnosticStatus_DIAGNOSTIC_STATUS_INCONCLUSIVE DiagnosticStatus = 3
+	// The step was not run because an earlier step failed.
+	DiagnosticStatus_DIAGNOSTIC_STATUS_SKIPPED DiagnosticStatus = 4
+)
+
+// Enum value maps for DiagnosticStatus.
+var (
+	DiagnosticStatus_name = map[int32]string{
+		0: "DIAGNOSTIC_STATUS_UNSPECIFIED",
+		1: "DIAGNOSTIC_STATUS_OK",
+		2: "DIAGNOSTIC_STATUS_FAILED",
+		3: "DIAGNOSTIC_STATUS_INCONCLUSIVE",
+		4: "DIAGNOSTIC_STATUS_SKIPPED",
+	}
+	DiagnosticStatus_value = map[string]int32{
+		"DIAGNOSTIC_STATUS_UNSPECIFIED":  0,
+		"DIAGNOSTIC_STATUS_OK":           1,
+		"DIAGNOSTIC_STATUS_FAILED":       2,
+		"DIAGNOSTIC_STATUS_INCONCLUSIVE": 3,
+		"DIAGNOSTIC_STATUS_SKIPPED":      4,
+	}
+)
+
+func (x DiagnosticStatus) Enum() *DiagnosticStatus {
+	p := new(DiagnosticStatus)
+	*p = x
+	return p
+}
+
+func (x DiagnosticStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiagnosticStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[14].Descriptor()
+}
+
+func (DiagnosticStatus) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[14]
+}
+
+func (x DiagnosticStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiagnosticStatus.Descriptor instead.
+func (DiagnosticStatus) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{14}
+}
+
+// What to do when queueing a download for a file that was already downloaded.
+// Files are matched by their SHA-256 hash, so this only works with peers that can provide hashes.
+type DuplicateAction int32
+
+const (
+	// Do not queue the download, and return the duplicate so that the user can choose what to do.
+	DuplicateAction_DUPLICATE_ACTION_UNSPECIFIED DuplicateAction = 0
+	// Download the file again without checking for duplicates.
+	DuplicateAction_DUPLICATE_ACTION_DOWNLOAD DuplicateAction = 1
+	// Hard link the existing file to where the download would be saved instead of downloading it.
+	// Only works if both are on the same filesystem.
+	DuplicateAction_DUPLICATE_ACTION_HARD_LINK DuplicateAction = 2
+	// Copy the existing file to where the download would be saved instead of downloading it.
+	DuplicateAction_DUPLICATE_ACTION_COPY DuplicateAction = 3
+)
+
+// Enum value maps for DuplicateAction.
+var (
+	DuplicateAction_name = map[int32]string{
+		0: "DUPLICATE_ACTION_UNSPECIFIED",
+		1: "DUPLICATE_ACTION_DOWNLOAD",
+		2: "DUPLICATE_ACTION_HARD_LINK",
+		3: "DUPLICATE_ACTION_COPY",
+	}
+	DuplicateAction_value = map[string]int32{
+		"DUPLICATE_ACTION_UNSPECIFIED": 0,
+		"DUPLICATE_ACTION_DOWNLOAD":    1,
+		"DUPLICATE_ACTION_HARD_LINK":   2,
+		"DUPLICATE_ACTION_COPY":        3,
+	}
+)
+
+func (x DuplicateAction) Enum() *DuplicateAction {
+	p := new(DuplicateAction)
+	*p = x
+	return p
+}
+
+func (x DuplicateAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DuplicateAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[15].Descriptor()
+}
+
+func (DuplicateAction) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[15]
+}
+
+func (x DuplicateAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DuplicateAction.Descriptor instead.
+func (DuplicateAction) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{15}
+}
+
+// PluginScope is a permission that can be granted to a plugin.
+// Plugins authenticate with their own token and can only call the methods their scopes allow.
+type PluginScope int32
+
+const (
+	// Do not use.
+	PluginScope_PLUGIN_SCOPE_UNSPECIFIED PluginScope = 0
+	// Receive client events, such as downloads progressing and peers going online.
+	// Allows StreamEvents, and PLUGIN_EVENT_TYPE_CLIENT_EVENT events on StreamPluginEvents.
+	PluginScope_PLUGIN_SCOPE_EVENTS PluginScope = 1
+	// Receive searches made by peers, and answer them with results of the plugin's own.
+	// Allows RespondToSearch, and PLUGIN_EVENT_TYPE_SEARCH events on StreamPluginEvents.
+	PluginScope_PLUGIN_SCOPE_SEARCH PluginScope = 2
+	// Read servers, shares, online users and peers' files, and search rooms.
+	// Allows GetClientInfo, GetServers, GetShares, GetOnlineUsers, GetDirFiles, GetFileMeta, StreamSearch,
+	// GetDownloadManagerItems, GetUploads and GetFriends.
+	PluginScope_PLUGIN_SCOPE_READ PluginScope = 3
+	// Queue and control downloads.
+	// Allows QueueFileDownload, CancelFileDownload, PauseFileDownload, ResumeFileDownload and
+	// RemoveDownloadManagerItem.
+	PluginScope_PLUGIN_SCOPE_DOWNLOADS PluginScope = 4
+	// Create, index and delete shares, such as to share new folders automatically.
+	// Allows CreateShare, IndexShare, DeleteShare and ImportShares.
+	PluginScope_PLUGIN_SCOPE_SHARES PluginScope = 5
+)
+
+// Enum value maps for PluginScope.
+var (
+	PluginScope_name = map[int32]string{
+		0: "PLUGIN_SCOPE_UNSPECIFIED",
+		1: "PLUGIN_SCOPE_EVENTS",
+		2: "PLUGIN_SCOPE_SEARCH",
+		3: "PLUGIN_SCOPE_READ",
+		4: "PLUGIN_SCOPE_DOWNLOADS",
+		5: "PLUGIN_SCOPE_SHARES",
+	}
+	PluginScope_value = map[string]int32{
+		"PLUGIN_SCOPE_UNSPECIFIED": 0,
+		"PLUGIN_SCOPE_EVENTS":      1,
+		"PLUGIN_SCOPE_SEARCH":      2,
+		"PLUGIN_SCOPE_READ":        3,
+		"PLUGIN_SCOPE_DOWNLOADS":   4,
+		"PLUGIN_SCOPE_SHARES":      5,
+	}
+)
+
+func (x PluginScope) Enum() *PluginScope {
+	p := new(PluginScope)
+	*p = x
+	return p
+}
+
+func (x PluginScope) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PluginScope) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[16].Descriptor()
+}
+
+func (PluginScope) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[16]
+}
+
+func (x PluginScope) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PluginScope.Descriptor instead.
+func (PluginScope) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{16}
+}
+
+// PluginEventType is a type of event sent to plugins.
+type PluginEventType int32
+
+const (
+	// Do not use.
+	PluginEventType_PLUGIN_EVENT_TYPE_UNSPECIFIED PluginEventType = 0
+	// A client event, the same as sent by StreamEvents.
+	// Requires PLUGIN_SCOPE_EVENTS.
+	PluginEventType_PLUGIN_EVENT_TYPE_CLIENT_EVENT PluginEventType = 1
+	// A peer searched the client's shares.
+	// The plugin can add results with RespondToSearch before the search's deadline.
+	// Requires PLUGIN_SCOPE_SEARCH.
+	PluginEventType_PLUGIN_EVENT_TYPE_SEARCH PluginEventType = 2
+)
+
+// Enum value maps for PluginEventType.
+var (
+	PluginEventType_name = map[int32]string{
+		0: "PLUGIN_EVENT_TYPE_UNSPECIFIED",
+		1: "PLUGIN_EVENT_TYPE_CLIENT_EVENT",
+		2: "PLUGIN_EVENT_TYPE_SEARCH",
+	}
+	PluginEventType_value = map[string]int32{
+		"PLUGIN_EVENT_TYPE_UNSPECIFIED":  0,
+		"PLUGIN_EVENT_TYPE_CLIENT_EVENT": 1,
+		"PLUGIN_EVENT_TYPE_SEARCH":       2,
+	}
+)
+
+func (x PluginEventType) Enum() *PluginEventType {
+	p := new(PluginEventType)
+	*p = x
+	return p
+}
+
+func (x PluginEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (PluginEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[17].Descriptor()
+}
+
+func (PluginEventType) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[17]
+}
+
+func (x PluginEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use PluginEventType.Descriptor instead.
+func (PluginEventType) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{17}
+}
+
+// BridgeRequestType is the kind of request sent on a bridge stream.
+type BridgeRequestType int32
+
+const (
+	// Do not use.
+	BridgeRequestType_BRIDGE_REQUEST_TYPE_UNSPECIFIED BridgeRequestType = 0
+	// Gets the metadata of a peer's file or folder.
+	// Answered with one BridgeResponse with meta set.
+	BridgeRequestType_BRIDGE_REQUEST_TYPE_GET_FILE_META BridgeRequestType = 1
+	// Lists the contents of a peer's folder.
+	// Answered with one or more BridgeResponses with files set, then the stream is closed.
+	BridgeRequestType_BRIDGE_REQUEST_TYPE_GET_DIR_FILES BridgeRequestType = 2
+	// Downloads a peer's file.
+	// Answered with one BridgeResponse with meta set, followed by the raw file content, then the stream is closed.
+	BridgeRequestType_BRIDGE_REQUEST_TYPE_GET_FILE BridgeRequestType = 3
+)
+
+// Enum value maps for BridgeRequestType.
+var (
+	BridgeRequestType_name = map[int32]string{
+		0: "BRIDGE_REQUEST_TYPE_UNSPECIFIED",
+		1: "BRIDGE_REQUEST_TYPE_GET_FILE_META",
+		2: "BRIDGE_REQUEST_TYPE_GET_DIR_FILES",
+		3: "BRIDGE_REQUEST_TYPE_GET_FILE",
+	}
+	BridgeRequestType_value = map[string]int32{
+		"BRIDGE_REQUEST_TYPE_UNSPECIFIED":   0,
+		"BRIDGE_REQUEST_TYPE_GET_FILE_META": 1,
+		"BRIDGE_REQUEST_TYPE_GET_DIR_FILES": 2,
+		"BRIDGE_REQUEST_TYPE_GET_FILE":      3,
+	}
+)
+
+func (x BridgeRequestType) Enum() *BridgeRequestType {
+	p := new(BridgeRequestType)
+	*p = x
+	return p
+}
+
+func (x BridgeRequestType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (BridgeRequestType) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[18].Descriptor()
+}
+
+func (BridgeRequestType) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[18]
+}
+
+func (x BridgeRequestType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use BridgeRequestType.Descriptor instead.
+func (BridgeRequestType) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{18}
+}
+
+type Event_Type int32
+
+const (
+	// Do not use.
+	Event_TYPE_UNSPECIFIED Event_Type = 0
+	// The client is stopping.
+	Event_TYPE_STOP Event_Type = 1
+	// A server's connection state changed.
+	Event_TYPE_SERVER_CONN_STATE_CHANGE Event_Type = 2
+	// A client went online.
+	Event_TYPE_CLIENT_ONLINE Event_Type = 3
+	// A client went offline.
+	Event_TYPE_CLIENT_OFFLINE Event_Type = 4
+	// A new update is available or the update checked was invalid.
+	Event_TYPE_NEW_UPDATE Event_Type = 5
+	// Download status updates for files.
+	Event_TYPE_DOWNLOAD_STATUS_UPDATES Event_Type = 6
+	// A new download manager item was added.
+	Event_TYPE_NEW_DM_ITEM Event_Type = 7
+	// A download manager item was removed.
+	Event_TYPE_DM_ITEM_REMOVED Event_Type = 8
+	// Files in a share were added, removed or modified.
+	Event_TYPE_SHARE_CHANGED Event_Type = 9
+	// A server sent a notice from its operators.
+	Event_TYPE_SERVER_NOTICE Event_Type = 10
+	// An upload to a peer started, progressed or ended.
+	Event_TYPE_UPLOAD_UPDATE Event_Type = 11
+	// Downloads that were interrupted when the client last stopped were recovered and queued again.
+	// It is sent once per client run, to the first event stream opened after starting.
+	Event_TYPE_DOWNLOADS_RECOVERED Event_Type = 12
+	// The client is shutting down and waiting for active uploads to finish.
+	// It is sent when waiting starts and whenever the number of active uploads changes.
+	Event_TYPE_SHUTDOWN_DRAIN Event_Type = 13
+	// A server connection opened to a room that has a message of the day.
+	// It is sent every time the connection opens, including reconnects.
+	Event_TYPE_ROOM_MOTD Event_Type = 14
+	// A server connection opened and the local clock differs from the server's by at least the warning threshold.
+	// Clocks that are far off break certificate validation and token expiry.
+	// It is sent every time the connection opens, including reconnects.
+	Event_TYPE_CLOCK_SKEW Event_Type = 15
+)
+
+// Enum value maps for Event_Type.
+var (
+	Event_Type_name = map[int32]string{
+		0:  "TYPE_UNSPECIFIED",
+		1:  "TYPE_STOP",
+		2:  "TYPE_SERVER_CONN_STATE_CHANGE",
+		3:  "TYPE_CLIENT_ONLINE",
+		4:  "TYPE_CLIENT_OFFLINE",
+		5:  "TYPE_NEW_UPDATE",
+		6:  "TYPE_DOWNLOAD_STATUS_UPDATES",
+		7:  "TYPE_NEW_DM_ITEM",
+		8:  "TYPE_DM_ITEM_REMOVED",
+		9:  "TYPE_SHARE_CHANGED",
+		10: "TYPE_SERVER_NOTICE",
+		11: "TYPE_UPLOAD_UPDATE",
+		12: "TYPE_DOWNLOADS_RECOVERED",
+		13: "TYPE_SHUTDOWN_DRAIN",
+		14: "TYPE_ROOM_MOTD",
+		15: "TYPE_CLOCK_SKEW",
+	}
+	Event_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED":              0,
+		"TYPE_STOP":                     1,
+		"TYPE_SERVER_CONN_STATE_CHANGE": 2,
+		"TYPE_CLIENT_ONLINE":            3,
+		"TYPE_CLIENT_OFFLINE":           4,
+		"TYPE_NEW_UPDATE":               5,
+		"TYPE_DOWNLOAD_STATUS_UPDATES":  6,
+		"TYPE_NEW_DM_ITEM":              7,
+		"TYPE_DM_ITEM_REMOVED":          8,
+		"TYPE_SHARE_CHANGED":            9,
+		"TYPE_SERVER_NOTICE":            10,
+		"TYPE_UPLOAD_UPDATE":            11,
+		"TYPE_DOWNLOADS_RECOVERED":      12,
+		"TYPE_SHUTDOWN_DRAIN":           13,
+		"TYPE_ROOM_MOTD":                14,
+		"TYPE_CLOCK_SKEW":               15,
+	}
+)
+
+func (x Event_Type) Enum() *Event_Type {
+	p := new(Event_Type)
+	*p = x
+	return p
+}
+
+func (x Event_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Event_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[19].Descriptor()
+}
+
+func (Event_Type) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[19]
+}
+
+func (x Event_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Event_Type.Descriptor instead.
+func (Event_Type) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 0}
+}
+
+type DownloadManagerItem_Type int32
+
+const (
+	// Do not use.
+	DownloadManagerItem_TYPE_UNSPECIFIED DownloadManagerItem_Type = 0
+	// A download.
+	DownloadManagerItem_TYPE_DOWNLOAD DownloadManagerItem_Type = 1
+)
+
+// Enum value maps for DownloadManagerItem_Type.
+var (
+	DownloadManagerItem_Type_name = map[int32]string{
+		0: "TYPE_UNSPECIFIED",
+		1: "TYPE_DOWNLOAD",
+	}
+	DownloadManagerItem_Type_value = map[string]int32{
+		"TYPE_UNSPECIFIED": 0,
+		"TYPE_DOWNLOAD":    1,
+	}
+)
+
+func (x DownloadManagerItem_Type) Enum() *DownloadManagerItem_Type {
+	p := new(DownloadManagerItem_Type)
+	*p = x
+	return p
+}
+
+func (x DownloadManagerItem_Type) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DownloadManagerItem_Type) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_clientrpc_v1_rpc_proto_enumTypes[20].Descriptor()
+}
+
+func (DownloadManagerItem_Type) Type() protoreflect.EnumType {
+	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[20]
+}
+
+func (x DownloadManagerItem_Type) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DownloadManagerItem_Type.Descriptor instead.
+func (DownloadManagerItem_Type) EnumDescriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{7, 0}
+}
+
+// Event is an event.
+// Events are differentiated by type, and have different data based on that type.
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The event type.
+	// The appropriate field will be filled based on the type.
+	Type                  Event_Type                   `protobuf:"varint,1,opt,name=type,proto3,enum=pb.clientrpc.v1.Event_Type" json:"type,omitempty"`
+	ServerConn            *Event_ServerConnStateChange `protobuf:"bytes,2,opt,name=server_conn,json=serverConn,proto3,oneof" json:"server_conn,omitempty"`
+	ClientOnline          *Event_ClientOnline          `protobuf:"bytes,3,opt,name=client_online,json=clientOnline,proto3,oneof" json:"client_online,omitempty"`
+	ClientOffline         *Event_ClientOffline         `protobuf:"bytes,4,opt,name=client_offline,json=clientOffline,proto3,oneof" json:"client_offline,omitempty"`
+	NewUpdate             *Event_NewUpdate             `protobuf:"bytes,5,opt,name=new_update,json=newUpdate,proto3,oneof" json:"new_update,omitempty"`
+	DownloadStatusUpdates *Event_DownloadStatusUpdates `protobuf:"bytes,6,opt,name=download_status_updates,json=downloadStatusUpdates,proto3,oneof" json:"download_status_updates,omitempty"`
+	NewDmItem             *Event_NewDmItem             `protobuf:"bytes,7,opt,name=new_dm_item,json=newDmItem,proto3,oneof" json:"new_dm_item,omitempty"`
+	DmItemRemoved         *Event_DmItemRemoved         `protobuf:"bytes,8,opt,name=dm_item_removed,json=dmItemRemoved,proto3,oneof" json:"dm_item_removed,omitempty"`
+	ShareChanged          *Event_ShareChanged          `protobuf:"bytes,9,opt,name=share_changed,json=shareChanged,proto3,oneof" json:"share_changed,omitempty"`
+	ServerNotice          *Event_ServerNotice          `protobuf:"bytes,10,opt,name=server_notice,json=serverNotice,proto3,oneof" json:"server_notice,omitempty"`
+	UploadUpdate          *Event_UploadUpdate          `protobuf:"bytes,11,opt,name=upload_update,json=uploadUpdate,proto3,oneof" json:"upload_update,omitempty"`
+	DownloadsRecovered    *Event_DownloadsRecovered    `protobuf:"bytes,12,opt,name=downloads_recovered,json=downloadsRecovered,proto3,oneof" json:"downloads_recovered,omitempty"`
+	ShutdownDrain         *Event_ShutdownDrain         `protobuf:"bytes,13,opt,name=shutdown_drain,json=shutdownDrain,proto3,oneof" json:"shutdown_drain,omitempty"`
+	RoomMotd              *Event_RoomMotd              `protobuf:"bytes,14,opt,name=room_motd,json=roomMotd,proto3,oneof" json:"room_motd,omitempty"`
+	ClockSkew             *Event_ClockSkew             `protobuf:"bytes,15,opt,name=clock_skew,json=clockSkew,proto3,oneof" json:"clock_skew,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Event) GetType() Event_Type {
+	if x != nil {
+		return x.Type
+	}
+	return Event_TYPE_UNSPECIFIED
+}
+
+func (x *Event) GetServerConn() *Event_ServerConnStateChange {
+	if x != nil {
+		return x.ServerConn
+	}
+	return nil
+}
+
+func (x *Event) GetClientOnline() *Event_ClientOnline {
+	if x != nil {
+		return x.ClientOnline
+	}
+	return nil
+}
+
+func (x *Event) GetClientOffline() *Event_ClientOffline {
+	if x != nil {
+		return x.ClientOffline
+	}
+	return nil
+}
+
+func (x *Event) GetNewUpdate() *Event_NewUpdate {
+	if x != nil {
+		return x.NewUpdate
+	}
+	return nil
+}
+
+func (x *Event) GetDownloadStatusUpdates() *Event_DownloadStatusUpdates {
+	if x != nil {
+		return x.DownloadStatusUpdates
+	}
+	return nil
+}
+
+func (x *Event) GetNewDmItem() *Event_NewDmItem {
+	if x != nil {
+		return x.NewDmItem
+	}
+	return nil
+}
+
+func (x *Event) GetDmItemRemoved() *Event_DmItemRemoved {
+	if x != nil {
+		return x.DmItemRemoved
+	}
+	return nil
+}
+
+func (x *Event) GetShareChanged() *Event_ShareChanged {
+	if x != nil {
+		return x.ShareChanged
+	}
+	return nil
+}
+
+func (x *Event) GetServerNotice() *Event_ServerNotice {
+	if x != nil {
+		return x.ServerNotice
+	}
+	return nil
+}
+
+func (x *Event) GetUploadUpdate() *Event_UploadUpdate {
+	if x != nil {
+		return x.UploadUpdate
+	}
+	return nil
+}
+
+func (x *Event) GetDownloadsRecovered() *Event_DownloadsRecovered {
+	if x != nil {
+		return x.DownloadsRecovered
+	}
+	return nil
+}
+
+func (x *Event) GetShutdownDrain() *Event_ShutdownDrain {
+	if x != nil {
+		return x.ShutdownDrain
+	}
+	return nil
+}
+
+func (x *Event) GetRoomMotd() *Event_RoomMotd {
+	if x != nil {
+		return x.RoomMotd
+	}
+	return nil
+}
+
+func (x *Event) GetClockSkew() *Event_ClockSkew {
+	if x != nil {
+		return x.ClockSkew
+	}
+	return nil
+}
+
+// EventContext is the context about where an event was generated.
+type EventContext struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UUID of the server where the event originated from.
+	ServerUuid    string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *EventContext) Reset() {
+	*x = EventContext{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *EventContext) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*EventContext) ProtoMessage() {}
+
+func (x *EventContext) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use EventContext.ProtoReflect.Descriptor instead.
+func (*EventContext) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *EventContext) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+// LogMessageAttr is an attribute within a LogMessage.
+type LogMessageAttr struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The value kind.
+	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	// The key.
+	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	// The string representation of the value.
+	// The value can be interpreted based on the value of `kind`.
+	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogMessageAttr) Reset() {
+	*x = LogMessageAttr{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogMessageAttr) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMessageAttr) ProtoMessage() {}
+
+func (x *LogMessageAttr) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMessageAttr.ProtoReflect.Descriptor instead.
+func (*LogMessageAttr) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *LogMessageAttr) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *LogMessageAttr) GetKey() string {
+	if x != nil {
+		return x.Key
+	}
+	return ""
+}
+
+func (x *LogMessageAttr) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
+// LogMessage is a structured log message.
+type LogMessage struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The message's UID.
+	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
+	// The UNIX millisecond timestamp the log was sent on.
+	CreatedTs int64 `protobuf:"varint,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// The textual message.
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	// The message's attributes.
+	Attrs         []*LogMessageAttr `protobuf:"bytes,4,rep,name=attrs,proto3" json:"attrs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *LogMessage) Reset() {
+	*x = LogMessage{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LogMessage) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LogMessage) ProtoMessage() {}
+
+func (x *LogMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LogMessage.ProtoReflect.Descriptor instead.
+func (*LogMessage) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *LogMessage) GetUid() string {
+	if x != nil {
+		return x.Uid
+	}
+	return ""
+}
+
+func (x *LogMessage) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *LogMessage) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *LogMessage) GetAttrs() []*LogMessageAttr {
+	if x != nil {
+		return x.Attrs
+	}
+	return nil
+}
+
+// DownloadStatusUpdate is a file download status update.
+type DownloadStatusUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The file download's UUID.
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The download status.
+	Status DownloadStatus `protobuf:"varint,2,opt,name=status,proto3,enum=pb.clientrpc.v1.DownloadStatus" json:"status,omitempty"`
+	// The number of bytes downloaded.
+	Downloaded uint64 `protobuf:"varint,3,opt,name=downloaded,proto3" json:"downloaded,omitempty"`
+	// The file's size in bytes, if -1 if not yet known.
+	FileSize int64 `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	// The current download speed, in bytes per second.
+	Speed uint64 `protobuf:"varint,5,opt,name=speed,proto3" json:"speed,omitempty"`
+	// The error message, if applicable.
+	ErrorMessage *string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	// The result of scanning the download once it completed.
+	ScanStatus    ScanStatus `protobuf:"varint,7,opt,name=scan_status,json=scanStatus,proto3,enum=pb.clientrpc.v1.ScanStatus" json:"scan_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadStatusUpdate) Reset() {
+	*x = DownloadStatusUpdate{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadStatusUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadStatusUpdate) ProtoMessage() {}
+
+func (x *DownloadStatusUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadStatusUpdate.ProtoReflect.Descriptor instead.
+func (*DownloadStatusUpdate) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DownloadStatusUpdate) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *DownloadStatusUpdate) GetStatus() DownloadStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DownloadStatus_DOWNLOAD_STATUS_UNSPECIFIED
+}
+
+func (x *DownloadStatusUpdate) GetDownloaded() uint64 {
+	if x != nil {
+		return x.Downloaded
+	}
+	return 0
+}
+
+func (x *DownloadStatusUpdate) GetFileSize() int64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *DownloadStatusUpdate) GetSpeed() uint64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *DownloadStatusUpdate) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+func (x *DownloadStatusUpdate) GetScanStatus() ScanStatus {
+	if x != nil {
+		return x.ScanStatus
+	}
+	return ScanStatus_SCAN_STATUS_UNSPECIFIED
+}
+
+// RecoveredDownload is a download that was interrupted when the client last stopped, and was queued again.
+type RecoveredDownload struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The download manager item's UUID.
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The number of bytes in the partial file that the download continues from.
+	Downloaded uint64 `protobuf:"varint,2,opt,name=downloaded,proto3" json:"downloaded,omitempty"`
+	// The number of bytes that were recorded as downloaded but were missing from the partial file, and will be
+	// downloaded again.
+	Discarded     uint64 `protobuf:"varint,3,opt,name=discarded,proto3" json:"discarded,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecoveredDownload) Reset() {
+	*x = RecoveredDownload{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecoveredDownload) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecoveredDownload) ProtoMessage() {}
+
+func (x *RecoveredDownload) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecoveredDownload.ProtoReflect.Descriptor instead.
+func (*RecoveredDownload) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RecoveredDownload) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *RecoveredDownload) GetDownloaded() uint64 {
+	if x != nil {
+		return x.Downloaded
+	}
+	return 0
+}
+
+func (x *RecoveredDownload) GetDiscarded() uint64 {
+	if x != nil {
+		return x.Discarded
+	}
+	return 0
+}
+
+// UploadInfo is information about a file upload to a peer.
+type UploadInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The upload's UUID.
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The UUID of the server the peer is on.
+	ServerUuid string `protobuf:"bytes,2,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The username of the peer the file is being uploaded to.
+	PeerUsername string `protobuf:"bytes,3,opt,name=peer_username,json=peerUsername,proto3" json:"peer_username,omitempty"`
+	// The file's path within the shares.
+	FilePath string `protobuf:"bytes,4,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	// The upload status.
+	Status UploadStatus `protobuf:"varint,5,opt,name=status,proto3,enum=pb.clientrpc.v1.UploadStatus" json:"status,omitempty"`
+	// The byte offset the peer requested the file from.
+	Offset uint64 `protobuf:"varint,6,opt,name=offset,proto3" json:"offset,omitempty"`
+	// The number of bytes sent so far.
+	BytesSent uint64 `protobuf:"varint,7,opt,name=bytes_sent,json=bytesSent,proto3" json:"bytes_sent,omitempty"`
+	// The file's size in bytes.
+	FileSize uint64 `protobuf:"varint,8,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
+	// The current upload speed, in bytes per second.
+	Speed uint64 `protobuf:"varint,9,opt,name=speed,proto3" json:"speed,omitempty"`
+	// The UNIX timestamp when the upload started.
+	StartedTs int64 `protobuf:"varint,10,opt,name=started_ts,json=startedTs,proto3" json:"started_ts,omitempty"`
+	// The UNIX timestamp when the upload ended, if it has.
+	EndedTs *int64 `protobuf:"varint,11,opt,name=ended_ts,json=endedTs,proto3,oneof" json:"ended_ts,omitempty"`
+	// The error message, if applicable.
+	ErrorMessage  *string `protobuf:"bytes,12,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UploadInfo) Reset() {
+	*x = UploadInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UploadInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UploadInfo) ProtoMessage() {}
+
+func (x *UploadInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UploadInfo.ProtoReflect.Descriptor instead.
+func (*UploadInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *UploadInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UploadInfo) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *UploadInfo) GetPeerUsername() string {
+	if x != nil {
+		return x.PeerUsername
+	}
+	return ""
+}
+
+func (x *UploadInfo) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *UploadInfo) GetStatus() UploadStatus {
+	if x != nil {
+		return x.Status
+	}
+	return UploadStatus_UPLOAD_STATUS_UNSPECIFIED
+}
+
+func (x *UploadInfo) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *UploadInfo) GetBytesSent() uint64 {
+	if x != nil {
+		return x.BytesSent
+	}
+	return 0
+}
+
+func (x *UploadInfo) GetFileSize() uint64 {
+	if x != nil {
+		return x.FileSize
+	}
+	return 0
+}
+
+func (x *UploadInfo) GetSpeed() uint64 {
+	if x != nil {
+		return x.Speed
+	}
+	return 0
+}
+
+func (x *UploadInfo) GetStartedTs() int64 {
+	if x != nil {
+		return x.StartedTs
+	}
+	return 0
+}
+
+func (x *UploadInfo) GetEndedTs() int64 {
+	if x != nil && x.EndedTs != nil {
+		return *x.EndedTs
+	}
+	return 0
+}
+
+func (x *UploadInfo) GetErrorMessage() string {
+	if x != nil && x.ErrorMessage != nil {
+		return *x.ErrorMessage
+	}
+	return ""
+}
+
+// DownloadManagerItem is an item in the download manager.
+type DownloadManagerItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The item's type.
+	Type DownloadManagerItem_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.clientrpc.v1.DownloadManagerItem_Type" json:"type,omitempty"`
+	// The item's UUID.
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The associated server's UUID.
+	ServerUuid string `protobuf:"bytes,3,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The associated peer's username.
+	PeerUsername string `protobuf:"bytes,4,opt,name=peer_username,json=peerUsername,proto3" json:"peer_username,omitempty"`
+	// The file's path.
+	FilePath string `protobuf:"bytes,5,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	// A download item.
+	Download      *DownloadManagerItem_Download `protobuf:"bytes,6,opt,name=download,proto3,oneof" json:"download,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadManagerItem) Reset() {
+	*x = DownloadManagerItem{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadManagerItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadManagerItem) ProtoMessage() {}
+
+func (x *DownloadManagerItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadManagerItem.ProtoReflect.Descriptor instead.
+func (*DownloadManagerItem) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *DownloadManagerItem) GetType() DownloadManagerItem_Type {
+	if x != nil {
+		return x.Type
+	}
+	return DownloadManagerItem_TYPE_UNSPECIFIED
+}
+
+func (x *DownloadManagerItem) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *DownloadManagerItem) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *DownloadManagerItem) GetPeerUsername() string {
+	if x != nil {
+		return x.PeerUsername
+	}
+	return ""
+}
+
+func (x *DownloadManagerItem) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *DownloadManagerItem) GetDownload() *DownloadManagerItem_Download {
+	if x != nil {
+		return x.Download
+	}
+	return nil
+}
+
+// DownloadHookInfo is information about a download post-processing hook.
+type DownloadHookInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The hook's UUID.
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The UNIX timestamp when the hook was created.
+	CreatedTs int64 `protobuf:"varint,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// The hook's type.
+	Type DownloadHookType `protobuf:"varint,3,opt,name=type,proto3,enum=pb.clientrpc.v1.DownloadHookType" json:"type,omitempty"`
+	// The hook's target.
+	// For command hooks, it is the absolute path of the executable to run.
+	// For webhooks, it is the HTTP or HTTPS URL to send the request to.
+	Target string `protobuf:"bytes,4,opt,name=target,proto3" json:"target,omitempty"`
+	// The UUID of the download the hook applies to, or omitted if the hook applies to all downloads.
+	DownloadUuid  *string `protobuf:"bytes,5,opt,name=download_uuid,json=downloadUuid,proto3,oneof" json:"download_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DownloadHookInfo) Reset() {
+	*x = DownloadHookInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DownloadHookInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DownloadHookInfo) ProtoMessage() {}
+
+func (x *DownloadHookInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DownloadHookInfo.ProtoReflect.Descriptor instead.
+func (*DownloadHookInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *DownloadHookInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *DownloadHookInfo) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *DownloadHookInfo) GetType() DownloadHookType {
+	if x != nil {
+		return x.Type
+	}
+	return DownloadHookType_DOWNLOAD_HOOK_TYPE_UNSPECIFIED
+}
+
+func (x *DownloadHookInfo) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+func (x *DownloadHookInfo) GetDownloadUuid() string {
+	if x != nil && x.DownloadUuid != nil {
+		return *x.DownloadUuid
+	}
+	return ""
+}
+
+// Information about an update.
+type UpdateInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the checked update was valid.
+	// If false, no other fields will be filled.
+	// An invalid update is caused by an invalid signature on an update,
+	// which is indicative of a larger problem.
+	IsValid bool `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
+	// The release timestamp.
+	CreatedTs int64 `protobuf:"varint,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// The version string.
+	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
+	// The description.
+	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	// The URL to get the update.
+	// It is not a URL to a binary, it is a URL to a page to get the binary.
+	Url string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+	// Whether the update has a binary for this platform that ApplyUpdate can install.
+	// If false, the update must be installed manually from url.
+	CanApply      bool `protobuf:"varint,6,opt,name=can_apply,json=canApply,proto3" json:"can_apply,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateInfo) Reset() {
+	*x = UpdateInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateInfo) ProtoMessage() {}
+
+func (x *UpdateInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateInfo.ProtoReflect.Descriptor instead.
+func (*UpdateInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *UpdateInfo) GetIsValid() bool {
+	if x != nil {
+		return x.IsValid
+	}
+	return false
+}
+
+func (x *UpdateInfo) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *UpdateInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *UpdateInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateInfo) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *UpdateInfo) GetCanApply() bool {
+	if x != nil {
+		return x.CanApply
+	}
+	return false
+}
+
+// UpdateSettings are the client's update settings.
+type UpdateSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The release channel to check for updates on.
+	Channel UpdateChannel `protobuf:"varint,1,opt,name=channel,proto3,enum=pb.clientrpc.v1.UpdateChannel" json:"channel,omitempty"`
+	// The base URL of the release endpoint to check for updates at, or empty for the official one.
+	// Must be an HTTP or HTTPS URL. Releases must still be signed with the official key.
+	BaseUrl       string `protobuf:"bytes,2,opt,name=base_url,json=baseUrl,proto3" json:"base_url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateSettings) Reset() {
+	*x = UpdateSettings{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateSettings) ProtoMessage() {}
+
+func (x *UpdateSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateSettings.ProtoReflect.Descriptor instead.
+func (*UpdateSettings) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *UpdateSettings) GetChannel() UpdateChannel {
+	if x != nil {
+		return x.Channel
+	}
+	return UpdateChannel_UPDATE_CHANNEL_UNSPECIFIED
+}
+
+func (x *UpdateSettings) GetBaseUrl() string {
+	if x != nil {
+		return x.BaseUrl
+	}
+	return ""
+}
+
+// ErrorInfo is attached as a detail to RPC errors with a known cause, so that clients can show an actionable message.
+type ErrorInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The cause of the error.
+	Reason ErrorReason `protobuf:"varint,1,opt,name=reason,proto3,enum=pb.clientrpc.v1.ErrorReason" json:"reason,omitempty"`
+	// The message the server sent with the rejection, if any.
+	Message *string `protobuf:"bytes,2,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	// The hostname the error is about, for certificate errors.
+	Host          *string `protobuf:"bytes,3,opt,name=host,proto3,oneof" json:"host,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ErrorInfo) Reset() {
+	*x = ErrorInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ErrorInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ErrorInfo) ProtoMessage() {}
+
+func (x *ErrorInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ErrorInfo.ProtoReflect.Descriptor instead.
+func (*ErrorInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ErrorInfo) GetReason() ErrorReason {
+	if x != nil {
+		return x.Reason
+	}
+	return ErrorReason_ERROR_REASON_UNSPECIFIED
+}
+
+func (x *ErrorInfo) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+func (x *ErrorInfo) GetHost() string {
+	if x != nil && x.Host != nil {
+		return *x.Host
+	}
+	return ""
+}
+
+// Information about a server.
+// RttStats is round-trip time statistics for pings sent over a connection.
+type RttStats struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The most recent round-trip time, in microseconds.
+	// 0 if no ping has succeeded yet.
+	LastUs int64 `protobuf:"varint,1,opt,name=last_us,json=lastUs,proto3" json:"last_us,omitempty"`
+	// The minimum round-trip time over recent pings, in microseconds.
+	MinUs int64 `protobuf:"varint,2,opt,name=min_us,json=minUs,proto3" json:"min_us,omitempty"`
+	// The average round-trip time over recent pings, in microseconds.
+	AvgUs int64 `protobuf:"varint,3,opt,name=avg_us,json=avgUs,proto3" json:"avg_us,omitempty"`
+	// The maximum round-trip time over recent pings, in microseconds.
+	MaxUs int64 `protobuf:"varint,4,opt,name=max_us,json=maxUs,proto3" json:"max_us,omitempty"`
+	// The number of recent pings the minimum, average and maximum were computed from.
+	Samples uint32 `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+	// The total number of pings that failed.
+	Lost uint64 `protobuf:"varint,6,opt,name=lost,proto3" json:"lost,omitempty"`
+	// The number of pings that failed in a row since the last successful one.
+	ConsecutiveLost uint32 `protobuf:"varint,7,opt,name=consecutive_lost,json=consecutiveLost,proto3" json:"consecutive_lost,omitempty"`
+	// The estimated offset of the other side's clock from the local clock, in microseconds.
+	// Positive if the other side's clock is ahead.
+	// Only set if the other side reported when it received and answered pings.
+	ClockOffsetUs *int64 `protobuf:"varint,8,opt,name=clock_offset_us,json=clockOffsetUs,proto3,oneof" json:"clock_offset_us,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RttStats) Reset() {
+	*x = RttStats{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RttStats) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RttStats) ProtoMessage() {}
+
+func (x *RttStats) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RttStats.ProtoReflect.Descriptor instead.
+func (*RttStats) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RttStats) GetLastUs() int64 {
+	if x != nil {
+		return x.LastUs
+	}
+	return 0
+}
+
+func (x *RttStats) GetMinUs() int64 {
+	if x != nil {
+		return x.MinUs
+	}
+	return 0
+}
+
+func (x *RttStats) GetAvgUs() int64 {
+	if x != nil {
+		return x.AvgUs
+	}
+	return 0
+}
+
+func (x *RttStats) GetMaxUs() int64 {
+	if x != nil {
+		return x.MaxUs
+	}
+	return 0
+}
+
+func (x *RttStats) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *RttStats) GetLost() uint64 {
+	if x != nil {
+		return x.Lost
+	}
+	return 0
+}
+
+func (x *RttStats) GetConsecutiveLost() uint32 {
+	if x != nil {
+		return x.ConsecutiveLost
+	}
+	return 0
+}
+
+func (x *RttStats) GetClockOffsetUs() int64 {
+	if x != nil && x.ClockOffsetUs != nil {
+		return *x.ClockOffsetUs
+	}
+	return 0
+}
+
+type ServerInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's current state.
+	State *ServerInfo_State `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	// The server's UUID.
+	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The name given to the server.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The server's address.
+	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
+	// The room to connect to.
+	Room string `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
+	// The username to use for authentication.
+	Username string `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
+	// The UNIX timestamp when the server was created.
+	CreatedTs     int64 `protobuf:"varint,7,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerInfo) Reset() {
+	*x = ServerInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerInfo) ProtoMessage() {}
+
+func (x *ServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
+func (*ServerInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ServerInfo) GetState() *ServerInfo_State {
+	if x != nil {
+		return x.State
+	}
+	return nil
+}
+
+func (x *ServerInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ServerInfo) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+// Information a connected server reported about its software and the policies of the room the client is in.
+type RemoteServerInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server software's version.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The protocol version the server speaks, in "MAJOR.MINOR.PATCH" format.
+	ProtocolVersion string `protobuf:"bytes,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// The optional features that are enabled on the server, such as "registration".
+	Features []string `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
+	// The maximum number of online clients in the room, or 0 if unlimited.
+	MaxClients uint32 `protobuf:"varint,4,opt,name=max_clients,json=maxClients,proto3" json:"max_clients,omitempty"`
+	// The maximum number of concurrent proxied streams each client can open, or 0 if unlimited.
+	MaxProxyStreamsPerClient uint32 `protobuf:"varint,5,opt,name=max_proxy_streams_per_client,json=maxProxyStreamsPerClient,proto3" json:"max_proxy_streams_per_client,omitempty"`
+	// The maximum number of requests each client can have in flight at once, or 0 if unlimited.
+	MaxConcurrentRequests uint32 `protobuf:"varint,6,opt,name=max_concurrent_requests,json=maxConcurrentRequests,proto3" json:"max_concurrent_requests,omitempty"`
+	// How long the server caches directory listings it proxies, in milliseconds, or 0 if it does not cache them.
+	DirCacheTtlMs uint32 `protobuf:"varint,7,opt,name=dir_cache_ttl_ms,json=dirCacheTtlMs,proto3" json:"dir_cache_ttl_ms,omitempty"`
+	// The maximum number of bytes per second relayed through the server for all clients combined, as of when the
+	// client connected, or 0 if unlimited.
+	RelayMaxBytesPerSecond int64 `protobuf:"varint,8,opt,name=relay_max_bytes_per_second,json=relayMaxBytesPerSecond,proto3" json:"relay_max_bytes_per_second,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RemoteServerInfo) Reset() {
+	*x = RemoteServerInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoteServerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoteServerInfo) ProtoMessage() {}
+
+func (x *RemoteServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoteServerInfo.ProtoReflect.Descriptor instead.
+func (*RemoteServerInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *RemoteServerInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *RemoteServerInfo) GetProtocolVersion() string {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return ""
+}
+
+func (x *RemoteServerInfo) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *RemoteServerInfo) GetMaxClients() uint32 {
+	if x != nil {
+		return x.MaxClients
+	}
+	return 0
+}
+
+func (x *RemoteServerInfo) GetMaxProxyStreamsPerClient() uint32 {
+	if x != nil {
+		return x.MaxProxyStreamsPerClient
+	}
+	return 0
+}
+
+func (x *RemoteServerInfo) GetMaxConcurrentRequests() uint32 {
+	if x != nil {
+		return x.MaxConcurrentRequests
+	}
+	return 0
+}
+
+func (x *RemoteServerInfo) GetDirCacheTtlMs() uint32 {
+	if x != nil {
+		return x.DirCacheTtlMs
+	}
+	return 0
+}
+
+func (x *RemoteServerInfo) GetRelayMaxBytesPerSecond() int64 {
+	if x != nil {
+		return x.RelayMaxBytesPerSecond
+	}
+	return 0
+}
+
+// Information about a server share.
+type ShareInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The share's UUID.
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The UUID of the associated server.
+	ServerUuid string `protobuf:"bytes,2,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The share's name.
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	// The share's path on disk.
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// Whether links should be followed.
+	// Links are symbolic links or the OS equivalent.
+	FollowLinks bool `protobuf:"varint,5,opt,name=follow_links,json=followLinks,proto3" json:"follow_links,omitempty"`
+	// The UNIX timestamp when the share was created.
+	CreatedTs int64 `protobuf:"varint,6,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// Directories mounted under virtual paths in the share, in addition to its own path, which is mounted at "/".
+	Mounts []*ShareMount `protobuf:"bytes,7,rep,name=mounts,proto3" json:"mounts,omitempty"`
+	// How files that exist at the same path in more than one mounted directory are resolved.
+	ConflictRule ShareConflictRule `protobuf:"varint,8,opt,name=conflict_rule,json=conflictRule,proto3,enum=pb.clientrpc.v1.ShareConflictRule" json:"conflict_rule,omitempty"`
+	// Patterns of paths that are hidden from the share and left out of its search index.
+	// See CreateShareRequest.exclude_patterns for their syntax.
+	ExcludePatterns []string `protobuf:"bytes,9,rep,name=exclude_patterns,json=excludePatterns,proto3" json:"exclude_patterns,omitempty"`
+	// Whether paths requested by peers are matched regardless of case.
+	CaseInsensitive bool `protobuf:"varint,10,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	// The Unicode normalization form that paths requested by peers are matched in.
+	UnicodeForm   ShareUnicodeForm `protobuf:"varint,11,opt,name=unicode_form,json=unicodeForm,proto3,enum=pb.clientrpc.v1.ShareUnicodeForm" json:"unicode_form,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareInfo) Reset() {
+	*x = ShareInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareInfo) ProtoMessage() {}
+
+func (x *ShareInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareInfo.ProtoReflect.Descriptor instead.
+func (*ShareInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *ShareInfo) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *ShareInfo) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *ShareInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShareInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ShareInfo) GetFollowLinks() bool {
+	if x != nil {
+		return x.FollowLinks
+	}
+	return false
+}
+
+func (x *ShareInfo) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *ShareInfo) GetMounts() []*ShareMount {
+	if x != nil {
+		return x.Mounts
+	}
+	return nil
+}
+
+func (x *ShareInfo) GetConflictRule() ShareConflictRule {
+	if x != nil {
+		return x.ConflictRule
+	}
+	return ShareConflictRule_SHARE_CONFLICT_RULE_UNSPECIFIED
+}
+
+func (x *ShareInfo) GetExcludePatterns() []string {
+	if x != nil {
+		return x.ExcludePatterns
+	}
+	return nil
+}
+
+func (x *ShareInfo) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+func (x *ShareInfo) GetUnicodeForm() ShareUnicodeForm {
+	if x != nil {
+		return x.UnicodeForm
+	}
+	return ShareUnicodeForm_SHARE_UNICODE_FORM_UNSPECIFIED
+}
+
+// ShareMount is a directory on disk that is mounted under a virtual path in a share.
+// Directories mounted at the same path are merged, and directories leading up to the virtual path always exist.
+type ShareMount struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The path in the share that the directory appears at, such as "/music".
+	// Use "/" to merge the directory with the share's own path.
+	VirtualPath string `protobuf:"bytes,1,opt,name=virtual_path,json=virtualPath,proto3" json:"virtual_path,omitempty"`
+	// The directory's absolute path on disk.
+	Path          string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareMount) Reset() {
+	*x = ShareMount{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareMount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareMount) ProtoMessage() {}
+
+func (x *ShareMount) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareMount.ProtoReflect.Descriptor instead.
+func (*ShareMount) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ShareMount) GetVirtualPath() string {
+	if x != nil {
+		return x.VirtualPath
+	}
+	return ""
+}
+
+func (x *ShareMount) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+// ShareLinkInfo is a link that gives read-only access to a path in a share through the public HTTPS gateway, to people
+// who do not use FriendNet.
+type ShareLinkInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The link's token.
+	// Anyone who knows it can read the files the link points to.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The UUID of the share's server.
+	ServerUuid string `protobuf:"bytes,2,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The share's name.
+	ShareName string `protobuf:"bytes,3,opt,name=share_name,json=shareName,proto3" json:"share_name,omitempty"`
+	// The path within the share that the link points to.
+	// A link to a directory gives access to everything in it.
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// The UNIX timestamp when the link was created.
+	CreatedTs int64 `protobuf:"varint,5,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// The UNIX timestamp when the link expires, if it does.
+	ExpiresTs *int64 `protobuf:"varint,6,opt,name=expires_ts,json=expiresTs,proto3,oneof" json:"expires_ts,omitempty"`
+	// The link's URL.
+	// Only set if the client has the public gateway enabled.
+	Url           *string `protobuf:"bytes,7,opt,name=url,proto3,oneof" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareLinkInfo) Reset() {
+	*x = ShareLinkInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareLinkInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareLinkInfo) ProtoMessage() {}
+
+func (x *ShareLinkInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareLinkInfo.ProtoReflect.Descriptor instead.
+func (*ShareLinkInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ShareLinkInfo) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *ShareLinkInfo) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *ShareLinkInfo) GetShareName() string {
+	if x != nil {
+		return x.ShareName
+	}
+	return ""
+}
+
+func (x *ShareLinkInfo) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ShareLinkInfo) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *ShareLinkInfo) GetExpiresTs() int64 {
+	if x != nil && x.ExpiresTs != nil {
+		return *x.ExpiresTs
+	}
+	return 0
+}
+
+func (x *ShareLinkInfo) GetUrl() string {
+	if x != nil && x.Url != nil {
+		return *x.Url
+	}
+	return ""
+}
+
+// OnlineUserInfo is information about an online user.
+type OnlineUserInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user's username.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The local friend info for the user, if there is any.
+	Friend *FriendInfo `protobuf:"bytes,2,opt,name=friend,proto3,oneof" json:"friend,omitempty"`
+	// Whether the user is on the local block list.
+	Blocked bool `protobuf:"varint,3,opt,name=blocked,proto3" json:"blocked,omitempty"`
+	// Round-trip time statistics for pings sent to the user over direct connections.
+	// Only set while there is a direct connection to the user.
+	DirectRtt     *RttStats `protobuf:"bytes,4,opt,name=direct_rtt,json=directRtt,proto3,oneof" json:"direct_rtt,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OnlineUserInfo) Reset() {
+	*x = OnlineUserInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OnlineUserInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OnlineUserInfo) ProtoMessage() {}
+
+func (x *OnlineUserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OnlineUserInfo.ProtoReflect.Descriptor instead.
+func (*OnlineUserInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *OnlineUserInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *OnlineUserInfo) GetFriend() *FriendInfo {
+	if x != nil {
+		return x.Friend
+	}
+	return nil
+}
+
+func (x *OnlineUserInfo) GetBlocked() bool {
+	if x != nil {
+		return x.Blocked
+	}
+	return false
+}
+
+func (x *OnlineUserInfo) GetDirectRtt() *RttStats {
+	if x != nil {
+		return x.DirectRtt
+	}
+	return nil
+}
+
+// FriendInfo is local information the user attached to a peer on a server.
+// It is never shared with the server or other peers.
+type FriendInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UUID of the server the peer is on.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The peer's username.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The nickname to show for the peer, or empty to show the username.
+	Nickname string `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// A free-form note about the peer.
+	Note string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	// How much the peer is trusted.
+	TrustLevel TrustLevel `protobuf:"varint,5,opt,name=trust_level,json=trustLevel,proto3,enum=pb.clientrpc.v1.TrustLevel" json:"trust_level,omitempty"`
+	// The UNIX timestamp when the friend info was created.
+	CreatedTs int64 `protobuf:"varint,6,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// The UNIX timestamp when the friend info was last updated.
+	UpdatedTs     int64 `protobuf:"varint,7,opt,name=updated_ts,json=updatedTs,proto3" json:"updated_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FriendInfo) Reset() {
+	*x = FriendInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FriendInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FriendInfo) ProtoMessage() {}
+
+func (x *FriendInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FriendInfo.ProtoReflect.Descriptor instead.
+func (*FriendInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *FriendInfo) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *FriendInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *FriendInfo) GetNickname() string {
+	if x != nil {
+		return x.Nickname
+	}
+	return ""
+}
+
+func (x *FriendInfo) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *FriendInfo) GetTrustLevel() TrustLevel {
+	if x != nil {
+		return x.TrustLevel
+	}
+	return TrustLevel_TRUST_LEVEL_UNSPECIFIED
+}
+
+func (x *FriendInfo) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *FriendInfo) GetUpdatedTs() int64 {
+	if x != nil {
+		return x.UpdatedTs
+	}
+	return 0
+}
+
+// FileMeta is metadata about a file/folder.
+type FileMeta struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The file's name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the file is a directory.
+	IsDir bool `protobuf:"varint,2,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
+	// The file's size, in bytes.
+	// Always zero if the file is a folder.
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The UNIX timestamp when the file was last modified, if known.
+	ModifiedTs    *int64 `protobuf:"varint,4,opt,name=modified_ts,json=modifiedTs,proto3,oneof" json:"modified_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *FileMeta) Reset() {
+	*x = FileMeta{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *FileMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FileMeta) ProtoMessage() {}
+
+func (x *FileMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FileMeta.ProtoReflect.Descriptor instead.
+func (*FileMeta) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *FileMeta) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *FileMeta) GetIsDir() bool {
+	if x != nil {
+		return x.IsDir
+	}
+	return false
+}
+
+func (x *FileMeta) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *FileMeta) GetModifiedTs() int64 {
+	if x != nil && x.ModifiedTs != nil {
+		return *x.ModifiedTs
+	}
+	return 0
+}
+
+// DirectSettings is direct connection settings for the client.
+type DirectSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to disable direct connections entirely.
+	// If true, all other fields will be ignored.
+	Disable bool `protobuf:"varint,1,opt,name=disable,proto3" json:"disable,omitempty"`
+	// The initial addresses to listen on.
+	// Each address must be in the format `IPv4:PORT`, `[IPv6]:PORT`, `IP` (IPv6 without port does not need brackets).
+	// Must specify at least one.
+	// Can use addresses like `0.0.0.0` and `[::]` (with or without port) to listen on all interfaces.
+	// Any addresses without a port will have a port assigned to them.
+	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
+	// The default port to use for addresses that do not have a specified port.
+	// It will also be the port opened by UPnP.
+	//
+	// If 0, a random port will be used.
+	// Using a random port is not recommended because it will cause port churn across reconnects.
+	// Keeping the port consistent across reconnects is useful because external clients will be able to more reliably reach the client.
+	//
+	// A port >= 1024 is recommended to avoid permission denied errors from the OS.
+	DefaultPort uint32 `protobuf:"varint,3,opt,name=default_port,json=defaultPort,proto3" json:"default_port,omitempty"`
+	// Whether to disable probing the machine for IPs to advertise.
+	// It does not advertise private IPs unless advertise_private_ips is true.
+	DisableProbeIpsToAdvertise bool `protobuf:"varint,4,opt,name=disable_probe_ips_to_advertise,json=disableProbeIpsToAdvertise,proto3" json:"disable_probe_ips_to_advertise,omitempty"`
+	// Whether to advertise private IPs (like 192.168.0.0/16, 172.16.0.0/12, 10.0.0.0/8).
+	// Has no effect if probe_ips_to_advertise is false.
+	// This only makes sense when multiple clients are on the same LAN or VPN.
+	AdvertisePrivateIps bool `protobuf:"varint,5,opt,name=advertise_private_ips,json=advertisePrivateIps,proto3" json:"advertise_private_ips,omitempty"`
+	// Whether to disable public IP discovery via the server.
+	// By default, the client will try to discover its public IP by asking the server for it.
+	DisablePublicIpDiscovery bool `protobuf:"varint,6,opt,name=disable_public_ip_discovery,json=disablePublicIpDiscovery,proto3" json:"disable_public_ip_discovery,omitempty"`
+	// Whether to disable UPnP.
+	DisableUpnp bool `protobuf:"varint,7,opt,name=disable_upnp,json=disableUpnp,proto3" json:"disable_upnp,omitempty"`
+	// The timeout for using UPnP.
+	// Defaults to 10 seconds.
+	// Has no effect if disable_upnp is true.
+	UpnpTimeoutMs uint32 `protobuf:"varint,8,opt,name=upnp_timeout_ms,json=upnpTimeoutMs,proto3" json:"upnp_timeout_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DirectSettings) Reset() {
+	*x = DirectSettings{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DirectSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DirectSettings) ProtoMessage() {}
+
+func (x *DirectSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DirectSettings.ProtoReflect.Descriptor instead.
+func (*DirectSettings) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DirectSettings) GetDisable() bool {
+	if x != nil {
+		return x.Disable
+	}
+	return false
+}
+
+func (x *DirectSettings) GetAddresses() []string {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+func (x *DirectSettings) GetDefaultPort() uint32 {
+	if x != nil {
+		return x.DefaultPort
+	}
+	return 0
+}
+
+func (x *DirectSettings) GetDisableProbeIpsToAdvertise() bool {
+	if x != nil {
+		return x.DisableProbeIpsToAdvertise
+	}
+	return false
+}
+
+func (x *DirectSettings) GetAdvertisePrivateIps() bool {
+	if x != nil {
+		return x.AdvertisePrivateIps
+	}
+	return false
+}
+
+func (x *DirectSettings) GetDisablePublicIpDiscovery() bool {
+	if x != nil {
+		return x.DisablePublicIpDiscovery
+	}
+	return false
+}
+
+func (x *DirectSettings) GetDisableUpnp() bool {
+	if x != nil {
+		return x.DisableUpnp
+	}
+	return false
+}
+
+func (x *DirectSettings) GetUpnpTimeoutMs() uint32 {
+	if x != nil {
+		return x.UpnpTimeoutMs
+	}
+	return 0
+}
+
+// TransferSettings are transfer (download and upload) settings for the client.
+type TransferSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of concurrent downloads to perform.
+	DownloadConcurrency uint32 `protobuf:"varint,1,opt,name=download_concurrency,json=downloadConcurrency,proto3" json:"download_concurrency,omitempty"`
+	// The directory to store incomplete downloads.
+	// Must be an absolute path.
+	IncompleteDownloadDir string `protobuf:"bytes,2,opt,name=incomplete_download_dir,json=incompleteDownloadDir,proto3" json:"incomplete_download_dir,omitempty"`
+	// The directory to store complete downloads.
+	// Must be an absolute path.
+	CompleteDownloadDir string `protobuf:"bytes,3,opt,name=complete_download_dir,json=completeDownloadDir,proto3" json:"complete_download_dir,omitempty"`
+	// The template for paths of complete downloads, relative to the complete download directory.
+	// Placeholders are {peer}, {server}, {server_uuid}, {room}, {path}, {dir} and {name}.
+	// Must contain {path} or {name}.
+	// If empty, the default "{peer}-{server_uuid}/{path}" is used.
+	DownloadPathTemplate string `protobuf:"bytes,4,opt,name=download_path_template,json=downloadPathTemplate,proto3" json:"download_path_template,omitempty"`
+	// Directories to store complete downloads from specific servers in, instead of complete_download_dir.
+	// Keys are server UUIDs, and values must be absolute paths.
+	ServerCompleteDownloadDirs map[string]string `protobuf:"bytes,5,rep,name=server_complete_download_dirs,json=serverCompleteDownloadDirs,proto3" json:"server_complete_download_dirs,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	// Whether to keep partial downloads in incomplete_download_dir.
+	// Otherwise, they are kept next to where they are saved once complete, with a ".part" suffix.
+	PartFilesInIncompleteDir bool `protobuf:"varint,6,opt,name=part_files_in_incomplete_dir,json=partFilesInIncompleteDir,proto3" json:"part_files_in_incomplete_dir,omitempty"`
+	// How long active uploads are given to finish when the client shuts down, in seconds.
+	// New uploads are rejected while waiting. If 0, the client shuts down without waiting.
+	ShutdownGraceSeconds uint32 `protobuf:"varint,7,opt,name=shutdown_grace_seconds,json=shutdownGraceSeconds,proto3" json:"shutdown_grace_seconds,omitempty"`
+	// The absolute path of a command, such as clamdscan, that scans completed downloads before they are moved to
+	// complete_download_dir. It gets the file's path as its only argument, and must exit with 0 if nothing was found,
+	// or 1 if a threat was found. If empty, downloads are not scanned.
+	ScanCommand string `protobuf:"bytes,8,opt,name=scan_command,json=scanCommand,proto3" json:"scan_command,omitempty"`
+	// The directory that downloads with threats are moved to.
+	// Must be an absolute path. Changes take effect after the client restarts.
+	QuarantineDir string `protobuf:"bytes,9,opt,name=quarantine_dir,json=quarantineDir,proto3" json:"quarantine_dir,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferSettings) Reset() {
+	*x = TransferSettings{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferSettings) ProtoMessage() {}
+
+func (x *TransferSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferSettings.ProtoReflect.Descriptor instead.
+func (*TransferSettings) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *TransferSettings) GetDownloadConcurrency() uint32 {
+	if x != nil {
+		return x.DownloadConcurrency
+	}
+	return 0
+}
+
+func (x *TransferSettings) GetIncompleteDownloadDir() string {
+	if x != nil {
+		return x.IncompleteDownloadDir
+	}
+	return ""
+}
+
+func (x *TransferSettings) GetCompleteDownloadDir() string {
+	if x != nil {
+		return x.CompleteDownloadDir
+	}
+	return ""
+}
+
+func (x *TransferSettings) GetDownloadPathTemplate() string {
+	if x != nil {
+		return x.DownloadPathTemplate
+	}
+	return ""
+}
+
+func (x *TransferSettings) GetServerCompleteDownloadDirs() map[string]string {
+	if x != nil {
+		return x.ServerCompleteDownloadDirs
+	}
+	return nil
+}
+
+func (x *TransferSettings) GetPartFilesInIncompleteDir() bool {
+	if x != nil {
+		return x.PartFilesInIncompleteDir
+	}
+	return false
+}
+
+func (x *TransferSettings) GetShutdownGraceSeconds() uint32 {
+	if x != nil {
+		return x.ShutdownGraceSeconds
+	}
+	return 0
+}
+
+func (x *TransferSettings) GetScanCommand() string {
+	if x != nil {
+		return x.ScanCommand
+	}
+	return ""
+}
+
+func (x *TransferSettings) GetQuarantineDir() string {
+	if x != nil {
+		return x.QuarantineDir
+	}
+	return ""
+}
+
+// NotificationSettings are settings for notifying the user about client events.
+type NotificationSettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to show desktop notifications.
+	Desktop bool `protobuf:"varint,1,opt,name=desktop,proto3" json:"desktop,omitempty"`
+	// The URL to send notifications to as JSON in POST requests, in addition to desktop notifications.
+	// Must be an HTTP or HTTPS URL. If empty, no webhook is used.
+	WebhookUrl string `protobuf:"bytes,2,opt,name=webhook_url,json=webhookUrl,proto3" json:"webhook_url,omitempty"`
+	// Whether to notify when a download completes.
+	DownloadComplete bool `protobuf:"varint,3,opt,name=download_complete,json=downloadComplete,proto3" json:"download_complete,omitempty"`
+	// Whether to notify when a friend comes online.
+	FriendOnline  bool `protobuf:"varint,4,opt,name=friend_online,json=friendOnline,proto3" json:"friend_online,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *NotificationSettings) Reset() {
+	*x = NotificationSettings{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *NotificationSettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*NotificationSettings) ProtoMessage() {}
+
+func (x *NotificationSettings) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use NotificationSettings.ProtoReflect.Descriptor instead.
+func (*NotificationSettings) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *NotificationSettings) GetDesktop() bool {
+	if x != nil {
+		return x.Desktop
+	}
+	return false
+}
+
+func (x *NotificationSettings) GetWebhookUrl() string {
+	if x != nil {
+		return x.WebhookUrl
+	}
+	return ""
+}
+
+func (x *NotificationSettings) GetDownloadComplete() bool {
+	if x != nil {
+		return x.DownloadComplete
+	}
+	return false
+}
+
+func (x *NotificationSettings) GetFriendOnline() bool {
+	if x != nil {
+		return x.FriendOnline
+	}
+	return false
+}
+
+type StreamEventsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsRequest) Reset() {
+	*x = StreamEventsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsRequest) ProtoMessage() {}
+
+func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{24}
+}
+
+type StreamEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The event.
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// The context about where the event was generated.
+	Context       *EventContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamEventsResponse) Reset() {
+	*x = StreamEventsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamEventsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamEventsResponse) ProtoMessage() {}
+
+func (x *StreamEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamEventsResponse.ProtoReflect.Descriptor instead.
+func (*StreamEventsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *StreamEventsResponse) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *StreamEventsResponse) GetContext() *EventContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type StreamLogsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Optionally, send existing logs after this timestamp before streaming live logs.
+	// The timestamp is a UNIX millisecond timestamp.
+	SendLogsAfterTs *int64 `protobuf:"varint,1,opt,name=send_logs_after_ts,json=sendLogsAfterTs,proto3,oneof" json:"send_logs_after_ts,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *StreamLogsRequest) Reset() {
+	*x = StreamLogsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsRequest) ProtoMessage() {}
+
+func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
+func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *StreamLogsRequest) GetSendLogsAfterTs() int64 {
+	if x != nil && x.SendLogsAfterTs != nil {
+		return *x.SendLogsAfterTs
+	}
+	return 0
+}
+
+type StreamLogsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The logs.
+	// This field usually has only one log message, but in the case of
+	// sending back existing logs, it may have many.
+	// The logs will be ordered by timestamp, ascending.
+	Logs          []*LogMessage `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamLogsResponse) Reset() {
+	*x = StreamLogsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamLogsResponse) ProtoMessage() {}
+
+func (x *StreamLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamLogsResponse.ProtoReflect.Descriptor instead.
+func (*StreamLogsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *StreamLogsResponse) GetLogs() []*LogMessage {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+type StopRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopRequest) Reset() {
+	*x = StopRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopRequest) ProtoMessage() {}
+
+func (x *StopRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
+func (*StopRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{28}
+}
+
+type StopResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopResponse) Reset() {
+	*x = StopResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopResponse) ProtoMessage() {}
+
+func (x *StopResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
+func (*StopResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{29}
+}
+
+type GetClientInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClientInfoRequest) Reset() {
+	*x = GetClientInfoRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientInfoRequest) ProtoMessage() {}
+
+func (x *GetClientInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetClientInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{30}
+}
+
+type GetClientInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The client's version.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The protocol version the client speaks, such as "1.0.1".
+	ProtocolVersion string `protobuf:"bytes,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// The commit the client was built from, or empty if unknown.
+	// Ends with "-dirty" if the build had uncommitted changes.
+	BuildCommit string `protobuf:"bytes,3,opt,name=build_commit,json=buildCommit,proto3" json:"build_commit,omitempty"`
+	// The UNIX timestamp when the client started.
+	StartTs int64 `protobuf:"varint,4,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	// How long the client has been running, in seconds.
+	UptimeSeconds int64 `protobuf:"varint,5,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	// The optional features that are enabled on the client.
+	// Unknown features must be ignored.
+	//
+	// Possible values:
+	//  - "share_gateway": The public share link gateway is enabled, so share links have URLs.
+	//  - "self_update": The client can install updates with ApplyUpdate.
+	//  - "keychain": Secrets are stored in the OS keychain.
+	Features      []string `protobuf:"bytes,6,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetClientInfoResponse) Reset() {
+	*x = GetClientInfoResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetClientInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetClientInfoResponse) ProtoMessage() {}
+
+func (x *GetClientInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetClientInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetClientInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetClientInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetClientInfoResponse) GetProtocolVersion() string {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return ""
+}
+
+func (x *GetClientInfoResponse) GetBuildCommit() string {
+	if x != nil {
+		return x.BuildCommit
+	}
+	return ""
+}
+
+func (x *GetClientInfoResponse) GetStartTs() int64 {
+	if x != nil {
+		return x.StartTs
+	}
+	return 0
+}
+
+func (x *GetClientInfoResponse) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *GetClientInfoResponse) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type GetMemoryUsageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoryUsageRequest) Reset() {
+	*x = GetMemoryUsageRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoryUsageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoryUsageRequest) ProtoMessage() {}
+
+func (x *GetMemoryUsageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoryUsageRequest.ProtoReflect.Descriptor instead.
+func (*GetMemoryUsageRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{32}
+}
+
+type GetMemoryUsageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the client is running in low-memory mode.
+	LowMemory bool `protobuf:"varint,1,opt,name=low_memory,json=lowMemory,proto3" json:"low_memory,omitempty"`
+	// Whether the client will run in low-memory mode after it restarts, as set by SetLowMemoryMode.
+	// The client also runs in low-memory mode when started with the -lowmem flag, regardless of this setting.
+	LowMemorySetting bool `protobuf:"varint,2,opt,name=low_memory_setting,json=lowMemorySetting,proto3" json:"low_memory_setting,omitempty"`
+	// The memory the client has obtained from the OS and not returned, in bytes.
+	// This is what soft_limit_bytes applies to.
+	UsedBytes uint64 `protobuf:"varint,3,opt,name=used_bytes,json=usedBytes,proto3" json:"used_bytes,omitempty"`
+	// The memory used by live and not yet collected objects, in bytes.
+	HeapBytes uint64 `protobuf:"varint,4,opt,name=heap_bytes,json=heapBytes,proto3" json:"heap_bytes,omitempty"`
+	// The soft memory limit, in bytes, or 0 if there is none.
+	// The client collects garbage more often as it gets close to the limit, but may exceed it.
+	SoftLimitBytes uint64 `protobuf:"varint,5,opt,name=soft_limit_bytes,json=softLimitBytes,proto3" json:"soft_limit_bytes,omitempty"`
+	// The number of bytes currently held in read-ahead buffers of files being streamed.
+	ReadAheadBufferedBytes uint64 `protobuf:"varint,6,opt,name=read_ahead_buffered_bytes,json=readAheadBufferedBytes,proto3" json:"read_ahead_buffered_bytes,omitempty"`
+	// The maximum number of bytes read ahead from each file being streamed.
+	MaxReadAheadBytes uint64 `protobuf:"varint,7,opt,name=max_read_ahead_bytes,json=maxReadAheadBytes,proto3" json:"max_read_ahead_bytes,omitempty"`
+	// The maximum number of concurrent downloads, or 0 if only the download concurrency setting applies.
+	MaxDownloadConcurrency uint32 `protobuf:"varint,8,opt,name=max_download_concurrency,json=maxDownloadConcurrency,proto3" json:"max_download_concurrency,omitempty"`
+	// The maximum number of streams a peer may have open at once on a direct connection.
+	MaxIncomingStreams uint32 `protobuf:"varint,9,opt,name=max_incoming_streams,json=maxIncomingStreams,proto3" json:"max_incoming_streams,omitempty"`
+	// The maximum number of requests handled for a peer at once, or 0 for no limit.
+	MaxConcurrentRequests uint32 `protobuf:"varint,10,opt,name=max_concurrent_requests,json=maxConcurrentRequests,proto3" json:"max_concurrent_requests,omitempty"`
+	// The maximum number of files indexed per share.
+	MaxIndexFiles uint32 `protobuf:"varint,11,opt,name=max_index_files,json=maxIndexFiles,proto3" json:"max_index_files,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMemoryUsageResponse) Reset() {
+	*x = GetMemoryUsageResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMemoryUsageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMemoryUsageResponse) ProtoMessage() {}
+
+func (x *GetMemoryUsageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMemoryUsageResponse.ProtoReflect.Descriptor instead.
+func (*GetMemoryUsageResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetMemoryUsageResponse) GetLowMemory() bool {
+	if x != nil {
+		return x.LowMemory
+	}
+	return false
+}
+
+func (x *GetMemoryUsageResponse) GetLowMemorySetting() bool {
+	if x != nil {
+		return x.LowMemorySetting
+	}
+	return false
+}
+
+func (x *GetMemoryUsageResponse) GetUsedBytes() uint64 {
+	if x != nil {
+		return x.UsedBytes
+	}
+	return 0
+}
+
+func (x *GetMemoryUsageResponse) GetHeapBytes() uint64 {
+	if x != nil {
+		return x.HeapBytes
+	}
+	return 0
+}
+
+func (x *GetMemoryUsageResponse) GetSoftLimitBytes() uint64 {
+	if x != nil {
+		return x.SoftLimitBytes
+	}
+	return 0
+}
+
+func (x *GetMemoryUsageResponse) GetReadAheadBufferedBytes() uint64 {
+	if x != nil {
+		return x.ReadAheadBufferedBytes
+	}
+	return 0
+}
+
+func (x *GetMemoryUsageResponse) GetMaxReadAheadBytes() uint64 {
+	if x != nil {
+		return x.MaxReadAheadBytes
+	}
+	return 0
+}
+
+func (x *GetMemoryUsageResponse) GetMaxDownloadConcurrency() uint32 {
+	if x != nil {
+		return x.MaxDownloadConcurrency
+	}
+	return 0
+}
+
+func (x *GetMemoryUsageResponse) GetMaxIncomingStreams() uint32 {
+	if x != nil {
+		return x.MaxIncomingStreams
+	}
+	return 0
+}
+
+func (x *GetMemoryUsageResponse) GetMaxConcurrentRequests() uint32 {
+	if x != nil {
+		return x.MaxConcurrentRequests
+	}
+	return 0
+}
+
+func (x *GetMemoryUsageResponse) GetMaxIndexFiles() uint32 {
+	if x != nil {
+		return x.MaxIndexFiles
+	}
+	return 0
+}
+
+type SetLowMemoryModeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to run in low-memory mode.
+	Enabled       bool `protobuf:"varint,1,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLowMemoryModeRequest) Reset() {
+	*x = SetLowMemoryModeRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLowMemoryModeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLowMemoryModeRequest) ProtoMessage() {}
+
+func (x *SetLowMemoryModeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLowMemoryModeRequest.ProtoReflect.Descriptor instead.
+func (*SetLowMemoryModeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *SetLowMemoryModeRequest) GetEnabled() bool {
+	if x != nil {
+		return x.Enabled
+	}
+	return false
+}
+
+type SetLowMemoryModeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetLowMemoryModeResponse) Reset() {
+	*x = SetLowMemoryModeResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetLowMemoryModeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetLowMemoryModeResponse) ProtoMessage() {}
+
+func (x *SetLowMemoryModeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetLowMemoryModeResponse.ProtoReflect.Descriptor instead.
+func (*SetLowMemoryModeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{35}
+}
+
+type GetServersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of servers to return.
+	// If 0, defaults to 500. Values above 1000 are treated as 1000.
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The cursor returned by a previous call, to get the next page.
+	// Empty to start from the beginning.
+	Cursor        string `protobuf:"bytes,2,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServersRequest) Reset() {
+	*x = GetServersRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServersRequest) ProtoMessage() {}
+
+func (x *GetServersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServersRequest.ProtoReflect.Descriptor instead.
+func (*GetServersRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *GetServersRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetServersRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetServersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A page of server records, ordered by UUID.
+	Servers []*ServerInfo `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// The cursor to pass to get the next page, or empty if this is the last page.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// The total number of servers.
+	Total         uint32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServersResponse) Reset() {
+	*x = GetServersResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServersResponse) ProtoMessage() {}
+
+func (x *GetServersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServersResponse.ProtoReflect.Descriptor instead.
+func (*GetServersResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *GetServersResponse) GetServers() []*ServerInfo {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *GetServersResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetServersResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CreateServerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name given to the server record.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The server's address.
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The room to connect to.
+	Room string `protobuf:"bytes,3,opt,name=room,proto3" json:"room,omitempty"`
+	// The username to use.
+	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
+	// The password to use.
+	Password string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// The UUID of an account template to use.
+	// If set, the template's username and password are used instead of the ones above, and its shares are created on
+	// the server.
+	// Optional.
+	AccountTemplateUuid string `protobuf:"bytes,6,opt,name=account_template_uuid,json=accountTemplateUuid,proto3" json:"account_template_uuid,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateServerRequest) Reset() {
+	*x = CreateServerRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServerRequest) ProtoMessage() {}
+
+func (x *CreateServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServerRequest.ProtoReflect.Descriptor instead.
+func (*CreateServerRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateServerRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateServerRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *CreateServerRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateServerRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateServerRequest) GetAccountTemplateUuid() string {
+	if x != nil {
+		return x.AccountTemplateUuid
+	}
+	return ""
+}
+
+type CreateServerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The newly created server record.
+	Server *ServerInfo `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// Descriptions of the account template's shares that could not be created, such as because their path no longer
+	// exists.
+	FailedShares  []string `protobuf:"bytes,2,rep,name=failed_shares,json=failedShares,proto3" json:"failed_shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateServerResponse) Reset() {
+	*x = CreateServerResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateServerResponse) ProtoMessage() {}
+
+func (x *CreateServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateServerResponse.ProtoReflect.Descriptor instead.
+func (*CreateServerResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *CreateServerResponse) GetServer() *ServerInfo {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *CreateServerResponse) GetFailedShares() []string {
+	if x != nil {
+		return x.FailedShares
+	}
+	return nil
+}
+
+type ImportInviteBundleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The invite bundle URL, starting with friendnet://invite.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The name given to the server record.
+	// If empty, the bundle's address is used.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The username to use.
+	// If the bundle has an invite code, an account with this username is registered.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// The password to use.
+	Password string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	// The UUID of an account template to use.
+	// If set, the template's username and password are used instead of the ones above, and its shares are created on
+	// the server.
+	// Optional.
+	AccountTemplateUuid string `protobuf:"bytes,5,opt,name=account_template_uuid,json=accountTemplateUuid,proto3" json:"account_template_uuid,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *ImportInviteBundleRequest) Reset() {
+	*x = ImportInviteBundleRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportInviteBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportInviteBundleRequest) ProtoMessage() {}
+
+func (x *ImportInviteBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportInviteBundleRequest.ProtoReflect.Descriptor instead.
+func (*ImportInviteBundleRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ImportInviteBundleRequest) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *ImportInviteBundleRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ImportInviteBundleRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *ImportInviteBundleRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *ImportInviteBundleRequest) GetAccountTemplateUuid() string {
+	if x != nil {
+		return x.AccountTemplateUuid
+	}
+	return ""
+}
+
+type ImportInviteBundleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The newly created server record.
+	Server *ServerInfo `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// Descriptions of the account template's shares that could not be created, such as because their path no longer
+	// exists.
+	FailedShares  []string `protobuf:"bytes,2,rep,name=failed_shares,json=failedShares,proto3" json:"failed_shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportInviteBundleResponse) Reset() {
+	*x = ImportInviteBundleResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportInviteBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportInviteBundleResponse) ProtoMessage() {}
+
+func (x *ImportInviteBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportInviteBundleResponse.ProtoReflect.Descriptor instead.
+func (*ImportInviteBundleResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ImportInviteBundleResponse) GetServer() *ServerInfo {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+func (x *ImportInviteBundleResponse) GetFailedShares() []string {
+	if x != nil {
+		return x.FailedShares
+	}
+	return nil
+}
+
+// A share in an account template.
+type AccountTemplateShare struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The share's name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The share's path on disk.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// Whether to follow links.
+	FollowLinks   bool `protobuf:"varint,3,opt,name=follow_links,json=followLinks,proto3" json:"follow_links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountTemplateShare) Reset() {
+	*x = AccountTemplateShare{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountTemplateShare) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountTemplateShare) ProtoMessage() {}
+
+func (x *AccountTemplateShare) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountTemplateShare.ProtoReflect.Descriptor instead.
+func (*AccountTemplateShare) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *AccountTemplateShare) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccountTemplateShare) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *AccountTemplateShare) GetFollowLinks() bool {
+	if x != nil {
+		return x.FollowLinks
+	}
+	return false
+}
+
+// AccountTemplate is a username, password and list of shares that can be reused when adding servers where the same
+// account is used.
+type AccountTemplate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The template's UUID.
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The name given to the template.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The username servers created from the template use.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// The shares created on servers created from the template.
+	Shares []*AccountTemplateShare `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares,omitempty"`
+	// The UNIX timestamp when the template was created.
+	CreatedTs     int64 `protobuf:"varint,5,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountTemplate) Reset() {
+	*x = AccountTemplate{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountTemplate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountTemplate) ProtoMessage() {}
+
+func (x *AccountTemplate) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountTemplate.ProtoReflect.Descriptor instead.
+func (*AccountTemplate) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *AccountTemplate) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *AccountTemplate) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *AccountTemplate) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *AccountTemplate) GetShares() []*AccountTemplateShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *AccountTemplate) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+type CreateAccountTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name given to the template.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The username to use.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The password to use.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// The shares to create on servers created from the template.
+	Shares        []*AccountTemplateShare `protobuf:"bytes,4,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountTemplateRequest) Reset() {
+	*x = CreateAccountTemplateRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountTemplateRequest) ProtoMessage() {}
+
+func (x *CreateAccountTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountTemplateRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateAccountTemplateRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateAccountTemplateRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateAccountTemplateRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateAccountTemplateRequest) GetShares() []*AccountTemplateShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type CreateAccountTemplateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The newly created template.
+	Template      *AccountTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountTemplateResponse) Reset() {
+	*x = CreateAccountTemplateResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountTemplateResponse) ProtoMessage() {}
+
+func (x *CreateAccountTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountTemplateResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *CreateAccountTemplateResponse) GetTemplate() *AccountTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type CreateAccountTemplateFromServerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UUID of the server to copy the credentials and shares of.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The name given to the template.
+	// If empty, the server's name is used.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountTemplateFromServerRequest) Reset() {
+	*x = CreateAccountTemplateFromServerRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountTemplateFromServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountTemplateFromServerRequest) ProtoMessage() {}
+
+func (x *CreateAccountTemplateFromServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountTemplateFromServerRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountTemplateFromServerRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *CreateAccountTemplateFromServerRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *CreateAccountTemplateFromServerRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CreateAccountTemplateFromServerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The newly created template.
+	Template      *AccountTemplate `protobuf:"bytes,1,opt,name=template,proto3" json:"template,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountTemplateFromServerResponse) Reset() {
+	*x = CreateAccountTemplateFromServerResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountTemplateFromServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountTemplateFromServerResponse) ProtoMessage() {}
+
+func (x *CreateAccountTemplateFromServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountTemplateFromServerResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountTemplateFromServerResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *CreateAccountTemplateFromServerResponse) GetTemplate() *AccountTemplate {
+	if x != nil {
+		return x.Template
+	}
+	return nil
+}
+
+type GetAccountTemplatesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountTemplatesRequest) Reset() {
+	*x = GetAccountTemplatesRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountTemplatesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountTemplatesRequest) ProtoMessage() {}
+
+func (x *GetAccountTemplatesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountTemplatesRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountTemplatesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{48}
+}
+
+type GetAccountTemplatesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All account templates, ordered by creation time.
+	Templates     []*AccountTemplate `protobuf:"bytes,1,rep,name=templates,proto3" json:"templates,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountTemplatesResponse) Reset() {
+	*x = GetAccountTemplatesResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountTemplatesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountTemplatesResponse) ProtoMessage() {}
+
+func (x *GetAccountTemplatesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountTemplatesResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountTemplatesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *GetAccountTemplatesResponse) GetTemplates() []*AccountTemplate {
+	if x != nil {
+		return x.Templates
+	}
+	return nil
+}
+
+type DeleteAccountTemplateRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UUID of the template to delete.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountTemplateRequest) Reset() {
+	*x = DeleteAccountTemplateRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountTemplateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountTemplateRequest) ProtoMessage() {}
+
+func (x *DeleteAccountTemplateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountTemplateRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountTemplateRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *DeleteAccountTemplateRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type DeleteAccountTemplateResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountTemplateResponse) Reset() {
+	*x = DeleteAccountTemplateResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountTemplateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountTemplateResponse) ProtoMessage() {}
+
+func (x *DeleteAccountTemplateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountTemplateResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountTemplateResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{51}
+}
+
+// HostingInfo is the status of the room the client hosts on its embedded server.
+type HostingInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether the client is hosting a room.
+	// If false, the other fields are empty.
+	Hosting bool `protobuf:"varint,1,opt,name=hosting,proto3" json:"hosting,omitempty"`
+	// The name of the hosted room.
+	Room string `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	// The UDP port the embedded server listens on.
+	// Friends must be able to reach it, so it may need to be forwarded on the host's router.
+	Port uint32 `protobuf:"varint,3,opt,name=port,proto3" json:"port,omitempty"`
+	// The fingerprint of the embedded server's certificate.
+	CertFingerprint string `protobuf:"bytes,4,opt,name=cert_fingerprint,json=certFingerprint,proto3" json:"cert_fingerprint,omitempty"`
+	// The UUID of the server the host joined the room with, or empty if it was deleted.
+	ServerUuid string `protobuf:"bytes,5,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The number of clients online in the room, including the host.
+	OnlineClients uint32 `protobuf:"varint,6,opt,name=online_clients,json=onlineClients,proto3" json:"online_clients,omitempty"`
+	// The UNIX timestamp when the embedded server started.
+	StartTs       int64 `protobuf:"varint,7,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *HostingInfo) Reset() {
+	*x = HostingInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *HostingInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HostingInfo) ProtoMessage() {}
+
+func (x *HostingInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HostingInfo.ProtoReflect.Descriptor instead.
+func (*HostingInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *HostingInfo) GetHosting() bool {
+	if x != nil {
+		return x.Hosting
+	}
+	return false
+}
+
+func (x *HostingInfo) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *HostingInfo) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *HostingInfo) GetCertFingerprint() string {
+	if x != nil {
+		return x.CertFingerprint
+	}
+	return ""
+}
+
+func (x *HostingInfo) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *HostingInfo) GetOnlineClients() uint32 {
+	if x != nil {
+		return x.OnlineClients
+	}
+	return 0
+}
+
+func (x *HostingInfo) GetStartTs() int64 {
+	if x != nil {
+		return x.StartTs
+	}
+	return 0
+}
+
+type StartHostingRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The name of the room to host.
+	// It is created if it does not exist yet.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The UDP port to listen on.
+	// If 0, defaults to 20038.
+	Port uint32 `protobuf:"varint,2,opt,name=port,proto3" json:"port,omitempty"`
+	// The username of the host's account in the room.
+	// Only used the first time the room is hosted, when the account and a server to join the room with are created.
+	Username      string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartHostingRequest) Reset() {
+	*x = StartHostingRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartHostingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartHostingRequest) ProtoMessage() {}
+
+func (x *StartHostingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartHostingRequest.ProtoReflect.Descriptor instead.
+func (*StartHostingRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *StartHostingRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *StartHostingRequest) GetPort() uint32 {
+	if x != nil {
+		return x.Port
+	}
+	return 0
+}
+
+func (x *StartHostingRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type StartHostingResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The status of the hosted room.
+	Info          *HostingInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StartHostingResponse) Reset() {
+	*x = StartHostingResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StartHostingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StartHostingResponse) ProtoMessage() {}
+
+func (x *StartHostingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StartHostingResponse.ProtoReflect.Descriptor instead.
+func (*StartHostingResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *StartHostingResponse) GetInfo() *HostingInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type StopHostingRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopHostingRequest) Reset() {
+	*x = StopHostingRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopHostingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopHostingRequest) ProtoMessage() {}
+
+func (x *StopHostingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopHostingRequest.ProtoReflect.Descriptor instead.
+func (*StopHostingRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{55}
+}
+
+type StopHostingResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StopHostingResponse) Reset() {
+	*x = StopHostingResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StopHostingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StopHostingResponse) ProtoMessage() {}
+
+func (x *StopHostingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StopHostingResponse.ProtoReflect.Descriptor instead.
+func (*StopHostingResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{56}
+}
+
+type GetHostingInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHostingInfoRequest) Reset() {
+	*x = GetHostingInfoRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHostingInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostingInfoRequest) ProtoMessage() {}
+
+func (x *GetHostingInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostingInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetHostingInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{57}
+}
+
+type GetHostingInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The status of the hosted room.
+	Info          *HostingInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetHostingInfoResponse) Reset() {
+	*x = GetHostingInfoResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetHostingInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetHostingInfoResponse) ProtoMessage() {}
+
+func (x *GetHostingInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetHostingInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetHostingInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetHostingInfoResponse) GetInfo() *HostingInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type CreateHostingInviteRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The address friends connect to, such as the host's public IP address.
+	// If it does not have a port, the embedded server's port is added.
+	Address       string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHostingInviteRequest) Reset() {
+	*x = CreateHostingInviteRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHostingInviteRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHostingInviteRequest) ProtoMessage() {}
+
+func (x *CreateHostingInviteRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHostingInviteRequest.ProtoReflect.Descriptor instead.
+func (*CreateHostingInviteRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *CreateHostingInviteRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+type CreateHostingInviteResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The invite link, with a new single-use invite code friends can register with.
+	Url           string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateHostingInviteResponse) Reset() {
+	*x = CreateHostingInviteResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateHostingInviteResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateHostingInviteResponse) ProtoMessage() {}
+
+func (x *CreateHostingInviteResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateHostingInviteResponse.ProtoReflect.Descriptor instead.
+func (*CreateHostingInviteResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *CreateHostingInviteResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+type DeleteServerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServerRequest) Reset() {
+	*x = DeleteServerRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServerRequest) ProtoMessage() {}
+
+func (x *DeleteServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServerRequest.ProtoReflect.Descriptor instead.
+func (*DeleteServerRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *DeleteServerRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type DeleteServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteServerResponse) Reset() {
+	*x = DeleteServerResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteServerResponse) ProtoMessage() {}
+
+func (x *DeleteServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteServerResponse.ProtoReflect.Descriptor instead.
+func (*DeleteServerResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{62}
+}
+
+type ConnectServerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectServerRequest) Reset() {
+	*x = ConnectServerRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectServerRequest) ProtoMessage() {}
+
+func (x *ConnectServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectServerRequest.ProtoReflect.Descriptor instead.
+func (*ConnectServerRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *ConnectServerRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type ConnectServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ConnectServerResponse) Reset() {
+	*x = ConnectServerResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ConnectServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ConnectServerResponse) ProtoMessage() {}
+
+func (x *ConnectServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ConnectServerResponse.ProtoReflect.Descriptor instead.
+func (*ConnectServerResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{64}
+}
+
+type DisconnectServerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectServerRequest) Reset() {
+	*x = DisconnectServerRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectServerRequest) ProtoMessage() {}
+
+func (x *DisconnectServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectServerRequest.ProtoReflect.Descriptor instead.
+func (*DisconnectServerRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *DisconnectServerRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type DisconnectServerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DisconnectServerResponse) Reset() {
+	*x = DisconnectServerResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DisconnectServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DisconnectServerResponse) ProtoMessage() {}
+
+func (x *DisconnectServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DisconnectServerResponse.ProtoReflect.Descriptor instead.
+func (*DisconnectServerResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{66}
+}
+
+type UpdateServerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The new name, if any.
+	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
+	// The new address, if any.
+	Address *string `protobuf:"bytes,3,opt,name=address,proto3,oneof" json:"address,omitempty"`
+	// The new room, if any.
+	Room *string `protobuf:"bytes,4,opt,name=room,proto3,oneof" json:"room,omitempty"`
+	// The new username, if any.
+	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// The new password, if any.
+	Password      *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateServerRequest) Reset() {
+	*x = UpdateServerRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServerRequest) ProtoMessage() {}
+
+func (x *UpdateServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServerRequest.ProtoReflect.Descriptor instead.
+func (*UpdateServerRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateServerRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetAddress() string {
+	if x != nil && x.Address != nil {
+		return *x.Address
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetRoom() string {
+	if x != nil && x.Room != nil {
+		return *x.Room
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *UpdateServerRequest) GetPassword() string {
+	if x != nil && x.Password != nil {
+		return *x.Password
+	}
+	return ""
+}
+
+type UpdateServerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server after update.
+	Server        *ServerInfo `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateServerResponse) Reset() {
+	*x = UpdateServerResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateServerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateServerResponse) ProtoMessage() {}
+
+func (x *UpdateServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateServerResponse.ProtoReflect.Descriptor instead.
+func (*UpdateServerResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *UpdateServerResponse) GetServer() *ServerInfo {
+	if x != nil {
+		return x.Server
+	}
+	return nil
+}
+
+type GetSharesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UUID of the server to get shares for.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The maximum number of shares to return.
+	// If 0, defaults to 500. Values above 1000 are treated as 1000.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The cursor returned by a previous call, to get the next page.
+	// Empty to start from the beginning.
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharesRequest) Reset() {
+	*x = GetSharesRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharesRequest) ProtoMessage() {}
+
+func (x *GetSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharesRequest.ProtoReflect.Descriptor instead.
+func (*GetSharesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *GetSharesRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *GetSharesRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetSharesRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetSharesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A page of shares, ordered by name.
+	Shares []*ShareInfo `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	// The cursor to pass to get the next page, or empty if this is the last page.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// The total number of shares for the server.
+	Total         uint32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetSharesResponse) Reset() {
+	*x = GetSharesResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetSharesResponse) ProtoMessage() {}
+
+func (x *GetSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetSharesResponse.ProtoReflect.Descriptor instead.
+func (*GetSharesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *GetSharesResponse) GetShares() []*ShareInfo {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+func (x *GetSharesResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetSharesResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CreateShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UUID of the associated server.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The share's name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The share's path on disk.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// Whether to follow links.
+	FollowLinks bool `protobuf:"varint,4,opt,name=follow_links,json=followLinks,proto3" json:"follow_links,omitempty"`
+	// Directories to mount under virtual paths in the share, in addition to its own path, which is mounted at "/".
+	// Optional.
+	Mounts []*ShareMount `protobuf:"bytes,5,rep,name=mounts,proto3" json:"mounts,omitempty"`
+	// How files that exist at the same path in more than one mounted directory are resolved.
+	ConflictRule ShareConflictRule `protobuf:"varint,6,opt,name=conflict_rule,json=conflictRule,proto3,enum=pb.clientrpc.v1.ShareConflictRule" json:"conflict_rule,omitempty"`
+	// Patterns of paths to hide from the share and leave out of its search index.
+	// Optional.
+	//
+	// Patterns are globs by default. A pattern without a slash, such as "*.tmp", matches names at any depth. A pattern
+	// with a slash, such as "/photos/private", matches a path from the share's root. A pattern that ends with a slash,
+	// such as ".git/", only matches directories. Patterns that start with "re:" are regular expressions that match
+	// anywhere in the full path. Excluding a directory excludes everything in it.
+	// On Windows and macOS, where file systems ignore case, patterns ignore case too.
+	ExcludePatterns []string `protobuf:"bytes,7,rep,name=exclude_patterns,json=excludePatterns,proto3" json:"exclude_patterns,omitempty"`
+	// Whether to match paths requested by peers regardless of case, for peers on platforms with case-insensitive
+	// filesystems, such as Windows and macOS.
+	// Paths that exist exactly as requested are always used as-is.
+	CaseInsensitive bool `protobuf:"varint,8,opt,name=case_insensitive,json=caseInsensitive,proto3" json:"case_insensitive,omitempty"`
+	// The Unicode normalization form to match paths requested by peers in.
+	UnicodeForm   ShareUnicodeForm `protobuf:"varint,9,opt,name=unicode_form,json=unicodeForm,proto3,enum=pb.clientrpc.v1.ShareUnicodeForm" json:"unicode_form,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShareRequest) Reset() {
+	*x = CreateShareRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareRequest) ProtoMessage() {}
+
+func (x *CreateShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareRequest.ProtoReflect.Descriptor instead.
+func (*CreateShareRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *CreateShareRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *CreateShareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateShareRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CreateShareRequest) GetFollowLinks() bool {
+	if x != nil {
+		return x.FollowLinks
+	}
+	return false
+}
+
+func (x *CreateShareRequest) GetMounts() []*ShareMount {
+	if x != nil {
+		return x.Mounts
+	}
+	return nil
+}
+
+func (x *CreateShareRequest) GetConflictRule() ShareConflictRule {
+	if x != nil {
+		return x.ConflictRule
+	}
+	return ShareConflictRule_SHARE_CONFLICT_RULE_UNSPECIFIED
+}
+
+func (x *CreateShareRequest) GetExcludePatterns() []string {
+	if x != nil {
+		return x.ExcludePatterns
+	}
+	return nil
+}
+
+func (x *CreateShareRequest) GetCaseInsensitive() bool {
+	if x != nil {
+		return x.CaseInsensitive
+	}
+	return false
+}
+
+func (x *CreateShareRequest) GetUnicodeForm() ShareUnicodeForm {
+	if x != nil {
+		return x.UnicodeForm
+	}
+	return ShareUnicodeForm_SHARE_UNICODE_FORM_UNSPECIFIED
+}
+
+type CreateShareResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The newly created share.
+	Share         *ShareInfo `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShareResponse) Reset() {
+	*x = CreateShareResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareResponse) ProtoMessage() {}
+
+func (x *CreateShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareResponse.ProtoReflect.Descriptor instead.
+func (*CreateShareResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateShareResponse) GetShare() *ShareInfo {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+type DeleteShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The associated server UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The share's name.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShareRequest) Reset() {
+	*x = DeleteShareRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShareRequest) ProtoMessage() {}
+
+func (x *DeleteShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShareRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShareRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *DeleteShareRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *DeleteShareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShareResponse) Reset() {
+	*x = DeleteShareResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShareResponse) ProtoMessage() {}
+
+func (x *DeleteShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShareResponse.ProtoReflect.Descriptor instead.
+func (*DeleteShareResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{74}
+}
+
+type SetShareExcludePatternsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The associated server UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The share's name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The new exclude patterns, replacing the existing ones.
+	// See CreateShareRequest.exclude_patterns for their syntax.
+	ExcludePatterns []string `protobuf:"bytes,3,rep,name=exclude_patterns,json=excludePatterns,proto3" json:"exclude_patterns,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SetShareExcludePatternsRequest) Reset() {
+	*x = SetShareExcludePatternsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetShareExcludePatternsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetShareExcludePatternsRequest) ProtoMessage() {}
+
+func (x *SetShareExcludePatternsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetShareExcludePatternsRequest.ProtoReflect.Descriptor instead.
+func (*SetShareExcludePatternsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *SetShareExcludePatternsRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *SetShareExcludePatternsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetShareExcludePatternsRequest) GetExcludePatterns() []string {
+	if x != nil {
+		return x.ExcludePatterns
+	}
+	return nil
+}
+
+type SetShareExcludePatternsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated share.
+	Share         *ShareInfo `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetShareExcludePatternsResponse) Reset() {
+	*x = SetShareExcludePatternsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetShareExcludePatternsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetShareExcludePatternsResponse) ProtoMessage() {}
+
+func (x *SetShareExcludePatternsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetShareExcludePatternsResponse.ProtoReflect.Descriptor instead.
+func (*SetShareExcludePatternsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *SetShareExcludePatternsResponse) GetShare() *ShareInfo {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+// ShareHealthIssue is a problem with an entry in a share.
+type ShareHealthIssue struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The entry's path in the share.
+	// Invalid UTF-8 in names is replaced with U+FFFD.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The kind of problem.
+	Kind ShareHealthIssueKind `protobuf:"varint,2,opt,name=kind,proto3,enum=pb.clientrpc.v1.ShareHealthIssueKind" json:"kind,omitempty"`
+	// The error that occurred, if any.
+	Message       *string `protobuf:"bytes,3,opt,name=message,proto3,oneof" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareHealthIssue) Reset() {
+	*x = ShareHealthIssue{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareHealthIssue) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareHealthIssue) ProtoMessage() {}
+
+func (x *ShareHealthIssue) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareHealthIssue.ProtoReflect.Descriptor instead.
+func (*ShareHealthIssue) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ShareHealthIssue) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ShareHealthIssue) GetKind() ShareHealthIssueKind {
+	if x != nil {
+		return x.Kind
+	}
+	return ShareHealthIssueKind_SHARE_HEALTH_ISSUE_KIND_UNSPECIFIED
+}
+
+func (x *ShareHealthIssue) GetMessage() string {
+	if x != nil && x.Message != nil {
+		return *x.Message
+	}
+	return ""
+}
+
+type CheckShareHealthRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The associated server UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The share's name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The maximum number of issues to report.
+	// Optional, defaults to 1000. Cannot exceed 10000.
+	MaxIssues     *uint32 `protobuf:"varint,3,opt,name=max_issues,json=maxIssues,proto3,oneof" json:"max_issues,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckShareHealthRequest) Reset() {
+	*x = CheckShareHealthRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckShareHealthRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckShareHealthRequest) ProtoMessage() {}
+
+func (x *CheckShareHealthRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckShareHealthRequest.ProtoReflect.Descriptor instead.
+func (*CheckShareHealthRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *CheckShareHealthRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *CheckShareHealthRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CheckShareHealthRequest) GetMaxIssues() uint32 {
+	if x != nil && x.MaxIssues != nil {
+		return *x.MaxIssues
+	}
+	return 0
+}
+
+type CheckShareHealthResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The issues that were found.
+	Issues []*ShareHealthIssue `protobuf:"bytes,1,rep,name=issues,proto3" json:"issues,omitempty"`
+	// Whether the check stopped early because it found the maximum number of issues.
+	Truncated     bool `protobuf:"varint,2,opt,name=truncated,proto3" json:"truncated,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckShareHealthResponse) Reset() {
+	*x = CheckShareHealthResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckShareHealthResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckShareHealthResponse) ProtoMessage() {}
+
+func (x *CheckShareHealthResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckShareHealthResponse.ProtoReflect.Descriptor instead.
+func (*CheckShareHealthResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *CheckShareHealthResponse) GetIssues() []*ShareHealthIssue {
+	if x != nil {
+		return x.Issues
+	}
+	return nil
+}
+
+func (x *CheckShareHealthResponse) GetTruncated() bool {
+	if x != nil {
+		return x.Truncated
+	}
+	return false
+}
+
+// ShareImportEntry is a share to create with ImportShares.
+type ShareImportEntry struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UUID of the server to create the share on.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The share's name.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The share's absolute path on disk.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// Whether to follow links.
+	FollowLinks   bool `protobuf:"varint,4,opt,name=follow_links,json=followLinks,proto3" json:"follow_links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareImportEntry) Reset() {
+	*x = ShareImportEntry{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareImportEntry) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareImportEntry) ProtoMessage() {}
+
+func (x *ShareImportEntry) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareImportEntry.ProtoReflect.Descriptor instead.
+func (*ShareImportEntry) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ShareImportEntry) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *ShareImportEntry) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ShareImportEntry) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *ShareImportEntry) GetFollowLinks() bool {
+	if x != nil {
+		return x.FollowLinks
+	}
+	return false
+}
+
+// ShareManifest is the format of share manifest files read by ImportShares.
+// Manifest files are in the protobuf JSON format, for example:
+// {"shares": [{"server_uuid": "...", "name": "music", "path": "/home/me/Music"}]}
+type ShareManifest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The shares to create.
+	Shares        []*ShareImportEntry `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareManifest) Reset() {
+	*x = ShareManifest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareManifest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareManifest) ProtoMessage() {}
+
+func (x *ShareManifest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareManifest.ProtoReflect.Descriptor instead.
+func (*ShareManifest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ShareManifest) GetShares() []*ShareImportEntry {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+// ShareImportResult is the result of importing a single share.
+type ShareImportResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The entry the result is for.
+	Entry *ShareImportEntry `protobuf:"bytes,1,opt,name=entry,proto3" json:"entry,omitempty"`
+	// Why the entry is invalid or its share could not be created.
+	// Omitted if the entry is valid and, unless it was a dry run, its share was created.
+	Error *string `protobuf:"bytes,2,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// The created share.
+	// Omitted if the share was not created.
+	Share         *ShareInfo `protobuf:"bytes,3,opt,name=share,proto3,oneof" json:"share,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ShareImportResult) Reset() {
+	*x = ShareImportResult{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ShareImportResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ShareImportResult) ProtoMessage() {}
+
+func (x *ShareImportResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ShareImportResult.ProtoReflect.Descriptor instead.
+func (*ShareImportResult) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *ShareImportResult) GetEntry() *ShareImportEntry {
+	if x != nil {
+		return x.Entry
+	}
+	return nil
+}
+
+func (x *ShareImportResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *ShareImportResult) GetShare() *ShareInfo {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+type ImportSharesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The shares to create.
+	// Must be empty if manifest_path is set.
+	Entries []*ShareImportEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	// The path of a manifest file on the client's machine to read the shares to create from, in the ShareManifest
+	// format.
+	ManifestPath *string `protobuf:"bytes,2,opt,name=manifest_path,json=manifestPath,proto3,oneof" json:"manifest_path,omitempty"`
+	// If true, the shares are only validated and none are created.
+	DryRun        bool `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSharesRequest) Reset() {
+	*x = ImportSharesRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSharesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSharesRequest) ProtoMessage() {}
+
+func (x *ImportSharesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSharesRequest.ProtoReflect.Descriptor instead.
+func (*ImportSharesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ImportSharesRequest) GetEntries() []*ShareImportEntry {
+	if x != nil {
+		return x.Entries
+	}
+	return nil
+}
+
+func (x *ImportSharesRequest) GetManifestPath() string {
+	if x != nil && x.ManifestPath != nil {
+		return *x.ManifestPath
+	}
+	return ""
+}
+
+func (x *ImportSharesRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+type ImportSharesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The result of each share, in the same order as the entries.
+	Results []*ShareImportResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// Whether the shares were created.
+	// If false, no shares were created.
+	Imported      bool `protobuf:"varint,2,opt,name=imported,proto3" json:"imported,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportSharesResponse) Reset() {
+	*x = ImportSharesResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportSharesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportSharesResponse) ProtoMessage() {}
+
+func (x *ImportSharesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportSharesResponse.ProtoReflect.Descriptor instead.
+func (*ImportSharesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ImportSharesResponse) GetResults() []*ShareImportResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportSharesResponse) GetImported() bool {
+	if x != nil {
+		return x.Imported
+	}
+	return false
+}
+
+type CreateShareLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The associated server UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The share's name.
+	ShareName string `protobuf:"bytes,2,opt,name=share_name,json=shareName,proto3" json:"share_name,omitempty"`
+	// The path within the share to link to.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// How long the link is valid for, in seconds.
+	// If not set, the link never expires.
+	ExpiresInSeconds *uint32 `protobuf:"varint,4,opt,name=expires_in_seconds,json=expiresInSeconds,proto3,oneof" json:"expires_in_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateShareLinkRequest) Reset() {
+	*x = CreateShareLinkRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareLinkRequest) ProtoMessage() {}
+
+func (x *CreateShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *CreateShareLinkRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *CreateShareLinkRequest) GetShareName() string {
+	if x != nil {
+		return x.ShareName
+	}
+	return ""
+}
+
+func (x *CreateShareLinkRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CreateShareLinkRequest) GetExpiresInSeconds() uint32 {
+	if x != nil && x.ExpiresInSeconds != nil {
+		return *x.ExpiresInSeconds
+	}
+	return 0
+}
+
+type CreateShareLinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new link.
+	Link          *ShareLinkInfo `protobuf:"bytes,1,opt,name=link,proto3" json:"link,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateShareLinkResponse) Reset() {
+	*x = CreateShareLinkResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateShareLinkResponse) ProtoMessage() {}
+
+func (x *CreateShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *CreateShareLinkResponse) GetLink() *ShareLinkInfo {
+	if x != nil {
+		return x.Link
+	}
+	return nil
+}
+
+type GetShareLinksRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The associated server UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The share's name.
+	ShareName     string `protobuf:"bytes,2,opt,name=share_name,json=shareName,proto3" json:"share_name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShareLinksRequest) Reset() {
+	*x = GetShareLinksRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareLinksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareLinksRequest) ProtoMessage() {}
+
+func (x *GetShareLinksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareLinksRequest.ProtoReflect.Descriptor instead.
+func (*GetShareLinksRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *GetShareLinksRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *GetShareLinksRequest) GetShareName() string {
+	if x != nil {
+		return x.ShareName
+	}
+	return ""
+}
+
+type GetShareLinksResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The share's links, including expired ones, ordered by creation time.
+	Links         []*ShareLinkInfo `protobuf:"bytes,1,rep,name=links,proto3" json:"links,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetShareLinksResponse) Reset() {
+	*x = GetShareLinksResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetShareLinksResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetShareLinksResponse) ProtoMessage() {}
+
+func (x *GetShareLinksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetShareLinksResponse.ProtoReflect.Descriptor instead.
+func (*GetShareLinksResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *GetShareLinksResponse) GetLinks() []*ShareLinkInfo {
+	if x != nil {
+		return x.Links
+	}
+	return nil
+}
+
+type DeleteShareLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The link's token.
+	Token         string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShareLinkRequest) Reset() {
+	*x = DeleteShareLinkRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShareLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShareLinkRequest) ProtoMessage() {}
+
+func (x *DeleteShareLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShareLinkRequest.ProtoReflect.Descriptor instead.
+func (*DeleteShareLinkRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *DeleteShareLinkRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type DeleteShareLinkResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteShareLinkResponse) Reset() {
+	*x = DeleteShareLinkResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[90]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteShareLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteShareLinkResponse) ProtoMessage() {}
+
+func (x *DeleteShareLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[90]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteShareLinkResponse.ProtoReflect.Descriptor instead.
+func (*DeleteShareLinkResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{90}
+}
+
+type GetDirFilesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The online user's username.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The path to get the contents of.
+	Path          string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDirFilesRequest) Reset() {
+	*x = GetDirFilesRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[91]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDirFilesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDirFilesRequest) ProtoMessage() {}
+
+func (x *GetDirFilesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[91]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDirFilesRequest.ProtoReflect.Descriptor instead.
+func (*GetDirFilesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{91}
+}
+
+func (x *GetDirFilesRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *GetDirFilesRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetDirFilesRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetDirFilesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The directory's files.
+	Content       []*FileMeta `protobuf:"bytes,2,rep,name=content,proto3" json:"content,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDirFilesResponse) Reset() {
+	*x = GetDirFilesResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[92]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDirFilesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDirFilesResponse) ProtoMessage() {}
+
+func (x *GetDirFilesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[92]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDirFilesResponse.ProtoReflect.Descriptor instead.
+func (*GetDirFilesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{92}
+}
+
+func (x *GetDirFilesResponse) GetContent() []*FileMeta {
+	if x != nil {
+		return x.Content
+	}
+	return nil
+}
+
+type StreamDirArchiveRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The online user's username.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The path of the directory to archive.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// The archive format.
+	Format        ArchiveFormat `protobuf:"varint,4,opt,name=format,proto3,enum=pb.clientrpc.v1.ArchiveFormat" json:"format,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDirArchiveRequest) Reset() {
+	*x = StreamDirArchiveRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[93]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDirArchiveRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDirArchiveRequest) ProtoMessage() {}
+
+func (x *StreamDirArchiveRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[93]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDirArchiveRequest.ProtoReflect.Descriptor instead.
+func (*StreamDirArchiveRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{93}
+}
+
+func (x *StreamDirArchiveRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *StreamDirArchiveRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *StreamDirArchiveRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *StreamDirArchiveRequest) GetFormat() ArchiveFormat {
+	if x != nil {
+		return x.Format
+	}
+	return ArchiveFormat_ARCHIVE_FORMAT_UNSPECIFIED
+}
+
+type StreamDirArchiveResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The next chunk of archive data.
+	Data          []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamDirArchiveResponse) Reset() {
+	*x = StreamDirArchiveResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[94]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamDirArchiveResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamDirArchiveResponse) ProtoMessage() {}
+
+func (x *StreamDirArchiveResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[94]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamDirArchiveResponse.ProtoReflect.Descriptor instead.
+func (*StreamDirArchiveResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{94}
+}
+
+func (x *StreamDirArchiveResponse) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+type GetFileMetaRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The online user's username.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The path to get the contents of.
+	Path          string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileMetaRequest) Reset() {
+	*x = GetFileMetaRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[95]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileMetaRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileMetaRequest) ProtoMessage() {}
+
+func (x *GetFileMetaRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[95]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileMetaRequest.ProtoReflect.Descriptor instead.
+func (*GetFileMetaRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{95}
+}
+
+func (x *GetFileMetaRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *GetFileMetaRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *GetFileMetaRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type GetFileMetaResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The file's metadata.
+	Meta          *FileMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetFileMetaResponse) Reset() {
+	*x = GetFileMetaResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[96]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetFileMetaResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetFileMetaResponse) ProtoMessage() {}
+
+func (x *GetFileMetaResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[96]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetFileMetaResponse.ProtoReflect.Descriptor instead.
+func (*GetFileMetaResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{96}
+}
+
+func (x *GetFileMetaResponse) GetMeta() *FileMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+type CreateFileLinkRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The username of the user who shares the file.
+	// If not set, the file is one of the local user's own shared files.
+	Username *string `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// The file's path.
+	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// How long the link is valid for, in seconds.
+	// Defaults to 1 hour, and can be at most 7 days.
+	ExpiresInSeconds *uint32 `protobuf:"varint,4,opt,name=expires_in_seconds,json=expiresInSeconds,proto3,oneof" json:"expires_in_seconds,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateFileLinkRequest) Reset() {
+	*x = CreateFileLinkRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[97]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFileLinkRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFileLinkRequest) ProtoMessage() {}
+
+func (x *CreateFileLinkRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[97]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFileLinkRequest.ProtoReflect.Descriptor instead.
+func (*CreateFileLinkRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{97}
+}
+
+func (x *CreateFileLinkRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *CreateFileLinkRequest) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *CreateFileLinkRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CreateFileLinkRequest) GetExpiresInSeconds() uint32 {
+	if x != nil && x.ExpiresInSeconds != nil {
+		return *x.ExpiresInSeconds
+	}
+	return 0
+}
+
+type CreateFileLinkResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The link's token.
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// The link's path on the client's web server, like /content/link/TOKEN.
+	Path string `protobuf:"bytes,2,opt,name=path,proto3" json:"path,omitempty"`
+	// The UNIX timestamp when the link expires.
+	ExpiresTs     int64 `protobuf:"varint,3,opt,name=expires_ts,json=expiresTs,proto3" json:"expires_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateFileLinkResponse) Reset() {
+	*x = CreateFileLinkResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[98]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateFileLinkResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFileLinkResponse) ProtoMessage() {}
+
+func (x *CreateFileLinkResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[98]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFileLinkResponse.ProtoReflect.Descriptor instead.
+func (*CreateFileLinkResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{98}
+}
+
+func (x *CreateFileLinkResponse) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+func (x *CreateFileLinkResponse) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *CreateFileLinkResponse) GetExpiresTs() int64 {
+	if x != nil {
+		return x.ExpiresTs
+	}
+	return 0
+}
+
+// DiagnosticResult is the result of a diagnostic step.
+type DiagnosticResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The step.
+	Step DiagnosticStep `protobuf:"varint,1,opt,name=step,proto3,enum=pb.clientrpc.v1.DiagnosticStep" json:"step,omitempty"`
+	// The outcome of the step.
+	Status DiagnosticStatus `protobuf:"varint,2,opt,name=status,proto3,enum=pb.clientrpc.v1.DiagnosticStatus" json:"status,omitempty"`
+	// A human-readable description of what the step found.
+	Detail string `protobuf:"bytes,3,opt,name=detail,proto3" json:"detail,omitempty"`
+	// The error the step failed with, if any.
+	Error *string `protobuf:"bytes,4,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// How long the step took, in microseconds.
+	DurationUs    int64 `protobuf:"varint,5,opt,name=duration_us,json=durationUs,proto3" json:"duration_us,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnosticResult) Reset() {
+	*x = DiagnosticResult{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[99]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnosticResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnosticResult) ProtoMessage() {}
+
+func (x *DiagnosticResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[99]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnosticResult.ProtoReflect.Descriptor instead.
+func (*DiagnosticResult) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{99}
+}
+
+func (x *DiagnosticResult) GetStep() DiagnosticStep {
+	if x != nil {
+		return x.Step
+	}
+	return DiagnosticStep_DIAGNOSTIC_STEP_UNSPECIFIED
+}
+
+func (x *DiagnosticResult) GetStatus() DiagnosticStatus {
+	if x != nil {
+		return x.Status
+	}
+	return DiagnosticStatus_DIAGNOSTIC_STATUS_UNSPECIFIED
+}
+
+func (x *DiagnosticResult) GetDetail() string {
+	if x != nil {
+		return x.Detail
+	}
+	return ""
+}
+
+func (x *DiagnosticResult) GetError() string {
+	if x != nil && x.Error != nil {
+		return *x.Error
+	}
+	return ""
+}
+
+func (x *DiagnosticResult) GetDurationUs() int64 {
+	if x != nil {
+		return x.DurationUs
+	}
+	return 0
+}
+
+type DiagnoseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	ServerUuid    string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnoseRequest) Reset() {
+	*x = DiagnoseRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[100]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnoseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseRequest) ProtoMessage() {}
+
+func (x *DiagnoseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[100]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseRequest.ProtoReflect.Descriptor instead.
+func (*DiagnoseRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{100}
+}
+
+func (x *DiagnoseRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+type DiagnoseResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The result of each step, in the order they were run.
+	Results       []*DiagnosticResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DiagnoseResponse) Reset() {
+	*x = DiagnoseResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[101]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DiagnoseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiagnoseResponse) ProtoMessage() {}
+
+func (x *DiagnoseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[101]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DiagnoseResponse.ProtoReflect.Descriptor instead.
+func (*DiagnoseResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{101}
+}
+
+func (x *DiagnoseResponse) GetResults() []*DiagnosticResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+type MeasurePeerRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The online user's username.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The path to measure.
+	// If unspecified, the path that normal traffic to the peer would use is measured.
+	Path PeerPath `protobuf:"varint,3,opt,name=path,proto3,enum=pb.clientrpc.v1.PeerPath" json:"path,omitempty"`
+	// The number of echoes to measure latency with.
+	// Defaults to 5 if unspecified. Capped at 100.
+	Pings *uint32 `protobuf:"varint,4,opt,name=pings,proto3,oneof" json:"pings,omitempty"`
+	// The approximate number of bytes to transfer in each direction to measure throughput with.
+	// Defaults to 4 MiB if unspecified. Capped at 64 MiB.
+	// If 0, throughput is not measured.
+	ThroughputBytes *uint64 `protobuf:"varint,5,opt,name=throughput_bytes,json=throughputBytes,proto3,oneof" json:"throughput_bytes,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *MeasurePeerRequest) Reset() {
+	*x = MeasurePeerRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[102]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeasurePeerRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeasurePeerRequest) ProtoMessage() {}
+
+func (x *MeasurePeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[102]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeasurePeerRequest.ProtoReflect.Descriptor instead.
+func (*MeasurePeerRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{102}
+}
+
+func (x *MeasurePeerRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *MeasurePeerRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MeasurePeerRequest) GetPath() PeerPath {
+	if x != nil {
+		return x.Path
+	}
+	return PeerPath_PEER_PATH_UNSPECIFIED
+}
+
+func (x *MeasurePeerRequest) GetPings() uint32 {
+	if x != nil && x.Pings != nil {
+		return *x.Pings
+	}
+	return 0
+}
+
+func (x *MeasurePeerRequest) GetThroughputBytes() uint64 {
+	if x != nil && x.ThroughputBytes != nil {
+		return *x.ThroughputBytes
+	}
+	return 0
+}
+
+type MeasurePeerResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The path that was measured.
+	Path PeerPath `protobuf:"varint,1,opt,name=path,proto3,enum=pb.clientrpc.v1.PeerPath" json:"path,omitempty"`
+	// The minimum echo round trip time, in microseconds.
+	LatencyMinUs int64 `protobuf:"varint,2,opt,name=latency_min_us,json=latencyMinUs,proto3" json:"latency_min_us,omitempty"`
+	// The average echo round trip time, in microseconds.
+	LatencyAvgUs int64 `protobuf:"varint,3,opt,name=latency_avg_us,json=latencyAvgUs,proto3" json:"latency_avg_us,omitempty"`
+	// The maximum echo round trip time, in microseconds.
+	LatencyMaxUs int64 `protobuf:"varint,4,opt,name=latency_max_us,json=latencyMaxUs,proto3" json:"latency_max_us,omitempty"`
+	// The rate at which the peer sent us data, in bytes per second.
+	DownloadBps float64 `protobuf:"fixed64,5,opt,name=download_bps,json=downloadBps,proto3" json:"download_bps,omitempty"`
+	// The rate at which we sent the peer data, in bytes per second.
+	UploadBps     float64 `protobuf:"fixed64,6,opt,name=upload_bps,json=uploadBps,proto3" json:"upload_bps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MeasurePeerResponse) Reset() {
+	*x = MeasurePeerResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[103]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MeasurePeerResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MeasurePeerResponse) ProtoMessage() {}
+
+func (x *MeasurePeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[103]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MeasurePeerResponse.ProtoReflect.Descriptor instead.
+func (*MeasurePeerResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{103}
+}
+
+func (x *MeasurePeerResponse) GetPath() PeerPath {
+	if x != nil {
+		return x.Path
+	}
+	return PeerPath_PEER_PATH_UNSPECIFIED
+}
+
+func (x *MeasurePeerResponse) GetLatencyMinUs() int64 {
+	if x != nil {
+		return x.LatencyMinUs
+	}
+	return 0
+}
+
+func (x *MeasurePeerResponse) GetLatencyAvgUs() int64 {
+	if x != nil {
+		return x.LatencyAvgUs
+	}
+	return 0
+}
+
+func (x *MeasurePeerResponse) GetLatencyMaxUs() int64 {
+	if x != nil {
+		return x.LatencyMaxUs
+	}
+	return 0
+}
+
+func (x *MeasurePeerResponse) GetDownloadBps() float64 {
+	if x != nil {
+		return x.DownloadBps
+	}
+	return 0
+}
+
+func (x *MeasurePeerResponse) GetUploadBps() float64 {
+	if x != nil {
+		return x.UploadBps
+	}
+	return 0
+}
+
+type GetOnlineUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	ServerUuid    string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnlineUsersRequest) Reset() {
+	*x = GetOnlineUsersRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[104]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnlineUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnlineUsersRequest) ProtoMessage() {}
+
+func (x *GetOnlineUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[104]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnlineUsersRequest.ProtoReflect.Descriptor instead.
+func (*GetOnlineUsersRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{104}
+}
+
+func (x *GetOnlineUsersRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+type GetOnlineUsersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The users.
+	Users         []*OnlineUserInfo `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnlineUsersResponse) Reset() {
+	*x = GetOnlineUsersResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[105]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnlineUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnlineUsersResponse) ProtoMessage() {}
+
+func (x *GetOnlineUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[105]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnlineUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetOnlineUsersResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{105}
+}
+
+func (x *GetOnlineUsersResponse) GetUsers() []*OnlineUserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type ChangeAccountPasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The account's current password.
+	CurrentPassword string `protobuf:"bytes,2,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	// The account's new password.
+	NewPassword   string `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeAccountPasswordRequest) Reset() {
+	*x = ChangeAccountPasswordRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[106]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeAccountPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAccountPasswordRequest) ProtoMessage() {}
+
+func (x *ChangeAccountPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[106]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAccountPasswordRequest.ProtoReflect.Descriptor instead.
+func (*ChangeAccountPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{106}
+}
+
+func (x *ChangeAccountPasswordRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *ChangeAccountPasswordRequest) GetCurrentPassword() string {
+	if x != nil {
+		return x.CurrentPassword
+	}
+	return ""
+}
+
+func (x *ChangeAccountPasswordRequest) GetNewPassword() string {
+	if x != nil {
+		return x.NewPassword
+	}
+	return ""
+}
+
+type ChangeAccountPasswordResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeAccountPasswordResponse) Reset() {
+	*x = ChangeAccountPasswordResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[107]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeAccountPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeAccountPasswordResponse) ProtoMessage() {}
+
+func (x *ChangeAccountPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[107]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeAccountPasswordResponse.ProtoReflect.Descriptor instead.
+func (*ChangeAccountPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{107}
+}
+
+type ServerConnectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerConnectRequest) Reset() {
+	*x = ServerConnectRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[108]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerConnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConnectRequest) ProtoMessage() {}
+
+func (x *ServerConnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[108]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConnectRequest.ProtoReflect.Descriptor instead.
+func (*ServerConnectRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{108}
+}
+
+func (x *ServerConnectRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type ServerConnectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerConnectResponse) Reset() {
+	*x = ServerConnectResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[109]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerConnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerConnectResponse) ProtoMessage() {}
+
+func (x *ServerConnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[109]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerConnectResponse.ProtoReflect.Descriptor instead.
+func (*ServerConnectResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{109}
+}
+
+type ServerDisconnectRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerDisconnectRequest) Reset() {
+	*x = ServerDisconnectRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[110]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerDisconnectRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerDisconnectRequest) ProtoMessage() {}
+
+func (x *ServerDisconnectRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[110]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerDisconnectRequest.ProtoReflect.Descriptor instead.
+func (*ServerDisconnectRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{110}
+}
+
+func (x *ServerDisconnectRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type ServerDisconnectResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ServerDisconnectResponse) Reset() {
+	*x = ServerDisconnectResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[111]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ServerDisconnectResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ServerDisconnectResponse) ProtoMessage() {}
+
+func (x *ServerDisconnectResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[111]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ServerDisconnectResponse.ProtoReflect.Descriptor instead.
+func (*ServerDisconnectResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{111}
+}
+
+type GetDirectSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDirectSettingsRequest) Reset() {
+	*x = GetDirectSettingsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[112]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDirectSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDirectSettingsRequest) ProtoMessage() {}
+
+func (x *GetDirectSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[112]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDirectSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetDirectSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{112}
+}
+
+type GetDirectSettingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The client's direct connection settings.
+	Settings      *DirectSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDirectSettingsResponse) Reset() {
+	*x = GetDirectSettingsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[113]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDirectSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDirectSettingsResponse) ProtoMessage() {}
+
+func (x *GetDirectSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[113]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDirectSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetDirectSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{113}
+}
+
+func (x *GetDirectSettingsResponse) GetSettings() *DirectSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateDirectSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The settings to update.
+	// All fields must be filled.
+	Settings      *DirectSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDirectSettingsRequest) Reset() {
+	*x = UpdateDirectSettingsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[114]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDirectSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDirectSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateDirectSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[114]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDirectSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDirectSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{114}
+}
+
+func (x *UpdateDirectSettingsRequest) GetSettings() *DirectSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateDirectSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDirectSettingsResponse) Reset() {
+	*x = UpdateDirectSettingsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[115]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDirectSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDirectSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateDirectSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[115]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDirectSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDirectSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{115}
+}
+
+type GetTransferSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferSettingsRequest) Reset() {
+	*x = GetTransferSettingsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[116]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferSettingsRequest) ProtoMessage() {}
+
+func (x *GetTransferSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[116]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetTransferSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{116}
+}
+
+type GetTransferSettingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The client's transfer settings.
+	Settings      *TransferSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferSettingsResponse) Reset() {
+	*x = GetTransferSettingsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[117]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferSettingsResponse) ProtoMessage() {}
+
+func (x *GetTransferSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[117]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetTransferSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{117}
+}
+
+func (x *GetTransferSettingsResponse) GetSettings() *TransferSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateTransferSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The settings to update.
+	// All fields must be filled.
+	Settings      *TransferSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransferSettingsRequest) Reset() {
+	*x = UpdateTransferSettingsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[118]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransferSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransferSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateTransferSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[118]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransferSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTransferSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{118}
+}
+
+func (x *UpdateTransferSettingsRequest) GetSettings() *TransferSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateTransferSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTransferSettingsResponse) Reset() {
+	*x = UpdateTransferSettingsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[119]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTransferSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTransferSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateTransferSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[119]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTransferSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateTransferSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{119}
+}
+
+type GetNotificationSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationSettingsRequest) Reset() {
+	*x = GetNotificationSettingsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[120]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationSettingsRequest) ProtoMessage() {}
+
+func (x *GetNotificationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[120]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetNotificationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{120}
+}
+
+type GetNotificationSettingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The notification settings.
+	Settings      *NotificationSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetNotificationSettingsResponse) Reset() {
+	*x = GetNotificationSettingsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[121]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetNotificationSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetNotificationSettingsResponse) ProtoMessage() {}
+
+func (x *GetNotificationSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[121]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetNotificationSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetNotificationSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{121}
+}
+
+func (x *GetNotificationSettingsResponse) GetSettings() *NotificationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateNotificationSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new notification settings.
+	// All fields must be filled.
+	Settings      *NotificationSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationSettingsRequest) Reset() {
+	*x = UpdateNotificationSettingsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[122]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateNotificationSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[122]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{122}
+}
+
+func (x *UpdateNotificationSettingsRequest) GetSettings() *NotificationSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateNotificationSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateNotificationSettingsResponse) Reset() {
+	*x = UpdateNotificationSettingsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[123]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateNotificationSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateNotificationSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateNotificationSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[123]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateNotificationSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateNotificationSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{123}
+}
+
+type ExportConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The password to encrypt the bundle with.
+	// Must not be empty.
+	Password      string `protobuf:"bytes,1,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportConfigRequest) Reset() {
+	*x = ExportConfigRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[124]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConfigRequest) ProtoMessage() {}
+
+func (x *ExportConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[124]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConfigRequest.ProtoReflect.Descriptor instead.
+func (*ExportConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{124}
+}
+
+func (x *ExportConfigRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ExportConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The encrypted configuration bundle.
+	Bundle        []byte `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportConfigResponse) Reset() {
+	*x = ExportConfigResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[125]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportConfigResponse) ProtoMessage() {}
+
+func (x *ExportConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[125]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportConfigResponse.ProtoReflect.Descriptor instead.
+func (*ExportConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{125}
+}
+
+func (x *ExportConfigResponse) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+type ImportConfigRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The encrypted configuration bundle, as returned by ExportConfig.
+	Bundle []byte `protobuf:"bytes,1,opt,name=bundle,proto3" json:"bundle,omitempty"`
+	// The password the bundle was encrypted with.
+	Password      string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportConfigRequest) Reset() {
+	*x = ImportConfigRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[126]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportConfigRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigRequest) ProtoMessage() {}
+
+func (x *ImportConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[126]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigRequest.ProtoReflect.Descriptor instead.
+func (*ImportConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{126}
+}
+
+func (x *ImportConfigRequest) GetBundle() []byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *ImportConfigRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type ImportConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The servers that were created.
+	Servers []*ServerInfo `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// The number of servers that were skipped because a server with the same address, room and username already exists.
+	SkippedServers uint32 `protobuf:"varint,2,opt,name=skipped_servers,json=skippedServers,proto3" json:"skipped_servers,omitempty"`
+	// Descriptions of the shares that could not be created, such as because their path does not exist on this machine.
+	// The rest of the import still succeeds.
+	FailedShares  []string `protobuf:"bytes,3,rep,name=failed_shares,json=failedShares,proto3" json:"failed_shares,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportConfigResponse) Reset() {
+	*x = ImportConfigResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[127]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportConfigResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportConfigResponse) ProtoMessage() {}
+
+func (x *ImportConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[127]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportConfigResponse.ProtoReflect.Descriptor instead.
+func (*ImportConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{127}
+}
+
+func (x *ImportConfigResponse) GetServers() []*ServerInfo {
+	if x != nil {
+		return x.Servers
+	}
+	return nil
+}
+
+func (x *ImportConfigResponse) GetSkippedServers() uint32 {
+	if x != nil {
+		return x.SkippedServers
+	}
+	return 0
+}
+
+func (x *ImportConfigResponse) GetFailedShares() []string {
+	if x != nil {
+		return x.FailedShares
+	}
+	return nil
+}
+
+type BackupDatabaseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The path of the file to write the backup to.
+	// Relative paths are resolved against the client's working directory.
+	// The file must not already exist.
+	Path          string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupDatabaseRequest) Reset() {
+	*x = BackupDatabaseRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[128]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupDatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupDatabaseRequest) ProtoMessage() {}
+
+func (x *BackupDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[128]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{128}
+}
+
+func (x *BackupDatabaseRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type BackupDatabaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupDatabaseResponse) Reset() {
+	*x = BackupDatabaseResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[129]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupDatabaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupDatabaseResponse) ProtoMessage() {}
+
+func (x *BackupDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[129]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{129}
+}
+
+type CheckDatabaseIntegrityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDatabaseIntegrityRequest) Reset() {
+	*x = CheckDatabaseIntegrityRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[130]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDatabaseIntegrityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDatabaseIntegrityRequest) ProtoMessage() {}
+
+func (x *CheckDatabaseIntegrityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[130]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDatabaseIntegrityRequest.ProtoReflect.Descriptor instead.
+func (*CheckDatabaseIntegrityRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{130}
+}
+
+type CheckDatabaseIntegrityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The problems found, or empty if the database is intact.
+	Problems      []string `protobuf:"bytes,1,rep,name=problems,proto3" json:"problems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDatabaseIntegrityResponse) Reset() {
+	*x = CheckDatabaseIntegrityResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[131]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDatabaseIntegrityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDatabaseIntegrityResponse) ProtoMessage() {}
+
+func (x *CheckDatabaseIntegrityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[131]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDatabaseIntegrityResponse.ProtoReflect.Descriptor instead.
+func (*CheckDatabaseIntegrityResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{131}
+}
+
+func (x *CheckDatabaseIntegrityResponse) GetProblems() []string {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+type IndexShareRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The associated server UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The share's name.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndexShareRequest) Reset() {
+	*x = IndexShareRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[132]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexShareRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexShareRequest) ProtoMessage() {}
+
+func (x *IndexShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[132]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexShareRequest.ProtoReflect.Descriptor instead.
+func (*IndexShareRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{132}
+}
+
+func (x *IndexShareRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *IndexShareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type IndexShareResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *IndexShareResponse) Reset() {
+	*x = IndexShareResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[133]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *IndexShareResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*IndexShareResponse) ProtoMessage() {}
+
+func (x *IndexShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[133]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use IndexShareResponse.ProtoReflect.Descriptor instead.
+func (*IndexShareResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{133}
+}
+
+type StreamSearchRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The associated server's UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The username of the client to search, or omit to search all clients.
+	Username *string `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
+	// The search query.
+	Query         string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSearchRequest) Reset() {
+	*x = StreamSearchRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[134]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSearchRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSearchRequest) ProtoMessage() {}
+
+func (x *StreamSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[134]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSearchRequest.ProtoReflect.Descriptor instead.
+func (*StreamSearchRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{134}
+}
+
+func (x *StreamSearchRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *StreamSearchRequest) GetUsername() string {
+	if x != nil && x.Username != nil {
+		return *x.Username
+	}
+	return ""
+}
+
+func (x *StreamSearchRequest) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+type StreamSearchResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The username of the client the result came from.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The file's containing directory path.
+	DirectoryPath string `protobuf:"bytes,2,opt,name=directory_path,json=directoryPath,proto3" json:"directory_path,omitempty"`
+	// The file that was found.
+	File *FileMeta `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
+	// A snippet of text highlighting matched terms.
+	Snippet string `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// The local friend info for the client the result came from, if there is any.
+	Friend        *FriendInfo `protobuf:"bytes,5,opt,name=friend,proto3,oneof" json:"friend,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StreamSearchResponse) Reset() {
+	*x = StreamSearchResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[135]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StreamSearchResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StreamSearchResponse) ProtoMessage() {}
+
+func (x *StreamSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[135]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StreamSearchResponse.ProtoReflect.Descriptor instead.
+func (*StreamSearchResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{135}
+}
+
+func (x *StreamSearchResponse) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *StreamSearchResponse) GetDirectoryPath() string {
+	if x != nil {
+		return x.DirectoryPath
+	}
+	return ""
+}
+
+func (x *StreamSearchResponse) GetFile() *FileMeta {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *StreamSearchResponse) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+func (x *StreamSearchResponse) GetFriend() *FriendInfo {
+	if x != nil {
+		return x.Friend
+	}
+	return nil
+}
+
+type GetUpdateInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpdateInfoRequest) Reset() {
+	*x = GetUpdateInfoRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[136]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpdateInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpdateInfoRequest) ProtoMessage() {}
+
+func (x *GetUpdateInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[136]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpdateInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetUpdateInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{136}
+}
+
+type GetUpdateInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The current update the client is running.
+	CurrentInfo *UpdateInfo `protobuf:"bytes,1,opt,name=current_info,json=currentInfo,proto3" json:"current_info,omitempty"`
+	// The new update's info, or no new update.
+	// This is cached info.
+	NewInfo *UpdateInfo `protobuf:"bytes,2,opt,name=new_info,json=newInfo,proto3,oneof" json:"new_info,omitempty"`
+	// Whether an update was downloaded with ApplyUpdate and will be installed when the client restarts.
+	UpdateStaged  bool `protobuf:"varint,3,opt,name=update_staged,json=updateStaged,proto3" json:"update_staged,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpdateInfoResponse) Reset() {
+	*x = GetUpdateInfoResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[137]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpdateInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpdateInfoResponse) ProtoMessage() {}
+
+func (x *GetUpdateInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[137]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpdateInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetUpdateInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{137}
+}
+
+func (x *GetUpdateInfoResponse) GetCurrentInfo() *UpdateInfo {
+	if x != nil {
+		return x.CurrentInfo
+	}
+	return nil
+}
+
+func (x *GetUpdateInfoResponse) GetNewInfo() *UpdateInfo {
+	if x != nil {
+		return x.NewInfo
+	}
+	return nil
+}
+
+func (x *GetUpdateInfoResponse) GetUpdateStaged() bool {
+	if x != nil {
+		return x.UpdateStaged
+	}
+	return false
+}
+
+type CheckForNewUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckForNewUpdateRequest) Reset() {
+	*x = CheckForNewUpdateRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[138]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckForNewUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckForNewUpdateRequest) ProtoMessage() {}
+
+func (x *CheckForNewUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[138]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckForNewUpdateRequest.ProtoReflect.Descriptor instead.
+func (*CheckForNewUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{138}
+}
+
+type CheckForNewUpdateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new update's info, or no new update.
+	NewInfo       *UpdateInfo `protobuf:"bytes,1,opt,name=new_info,json=newInfo,proto3,oneof" json:"new_info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckForNewUpdateResponse) Reset() {
+	*x = CheckForNewUpdateResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[139]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckForNewUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckForNewUpdateResponse) ProtoMessage() {}
+
+func (x *CheckForNewUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[139]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckForNewUpdateResponse.ProtoReflect.Descriptor instead.
+func (*CheckForNewUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{139}
+}
+
+func (x *CheckForNewUpdateResponse) GetNewInfo() *UpdateInfo {
+	if x != nil {
+		return x.NewInfo
+	}
+	return nil
+}
+
+type ApplyUpdateRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyUpdateRequest) Reset() {
+	*x = ApplyUpdateRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[140]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyUpdateRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyUpdateRequest) ProtoMessage() {}
+
+func (x *ApplyUpdateRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[140]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyUpdateRequest.ProtoReflect.Descriptor instead.
+func (*ApplyUpdateRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{140}
+}
+
+type ApplyUpdateResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The update that was downloaded.
+	// It will be installed when the client restarts.
+	Info          *UpdateInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ApplyUpdateResponse) Reset() {
+	*x = ApplyUpdateResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[141]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ApplyUpdateResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyUpdateResponse) ProtoMessage() {}
+
+func (x *ApplyUpdateResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[141]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyUpdateResponse.ProtoReflect.Descriptor instead.
+func (*ApplyUpdateResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{141}
+}
+
+func (x *ApplyUpdateResponse) GetInfo() *UpdateInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type GetUpdateSettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpdateSettingsRequest) Reset() {
+	*x = GetUpdateSettingsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[142]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpdateSettingsRequest) ProtoMessage() {}
+
+func (x *GetUpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[142]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetUpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{142}
+}
+
+type GetUpdateSettingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The update settings.
+	Settings      *UpdateSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUpdateSettingsResponse) Reset() {
+	*x = GetUpdateSettingsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[143]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUpdateSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUpdateSettingsResponse) ProtoMessage() {}
+
+func (x *GetUpdateSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[143]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUpdateSettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetUpdateSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{143}
+}
+
+func (x *GetUpdateSettingsResponse) GetSettings() *UpdateSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateUpdateSettingsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new update settings.
+	// All fields must be filled.
+	Settings      *UpdateSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUpdateSettingsRequest) Reset() {
+	*x = UpdateUpdateSettingsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[144]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUpdateSettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUpdateSettingsRequest) ProtoMessage() {}
+
+func (x *UpdateUpdateSettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[144]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUpdateSettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateUpdateSettingsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{144}
+}
+
+func (x *UpdateUpdateSettingsRequest) GetSettings() *UpdateSettings {
+	if x != nil {
+		return x.Settings
+	}
+	return nil
+}
+
+type UpdateUpdateSettingsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateUpdateSettingsResponse) Reset() {
+	*x = UpdateUpdateSettingsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[145]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateUpdateSettingsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateUpdateSettingsResponse) ProtoMessage() {}
+
+func (x *UpdateUpdateSettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[145]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateUpdateSettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateUpdateSettingsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{145}
+}
+
+type GetDownloadManagerItemsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDownloadManagerItemsRequest) Reset() {
+	*x = GetDownloadManagerItemsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[146]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadManagerItemsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadManagerItemsRequest) ProtoMessage() {}
+
+func (x *GetDownloadManagerItemsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[146]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadManagerItemsRequest.ProtoReflect.Descriptor instead.
+func (*GetDownloadManagerItemsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{146}
+}
+
+type GetDownloadManagerItemsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The download manager items.
+	Items         []*DownloadManagerItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDownloadManagerItemsResponse) Reset() {
+	*x = GetDownloadManagerItemsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[147]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadManagerItemsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadManagerItemsResponse) ProtoMessage() {}
+
+func (x *GetDownloadManagerItemsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[147]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDownloadManagerItemsResponse.ProtoReflect.Descriptor instead.
+func (*GetDownloadManagerItemsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{147}
+}
+
+func (x *GetDownloadManagerItemsResponse) GetItems() []*DownloadManagerItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type QueueFileDownloadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UUID of the server the peer exists on.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The peer's username.
+	PeerUsername string `protobuf:"bytes,2,opt,name=peer_username,json=peerUsername,proto3" json:"peer_username,omitempty"`
+	// The path of the file within the peer.
+	FilePath string `protobuf:"bytes,3,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	// What to do if the file was already downloaded before.
+	DuplicateAction DuplicateAction `protobuf:"varint,4,opt,name=duplicate_action,json=duplicateAction,proto3,enum=pb.clientrpc.v1.DuplicateAction" json:"duplicate_action,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *QueueFileDownloadRequest) Reset() {
+	*x = QueueFileDownloadRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[148]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueFileDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueFileDownloadRequest) ProtoMessage() {}
+
+func (x *QueueFileDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[148]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueFileDownloadRequest.ProtoReflect.Descriptor instead.
+func (*QueueFileDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{148}
+}
+
+func (x *QueueFileDownloadRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *QueueFileDownloadRequest) GetPeerUsername() string {
+	if x != nil {
+		return x.PeerUsername
+	}
+	return ""
+}
+
+func (x *QueueFileDownloadRequest) GetFilePath() string {
+	if x != nil {
+		return x.FilePath
+	}
+	return ""
+}
+
+func (x *QueueFileDownloadRequest) GetDuplicateAction() DuplicateAction {
+	if x != nil {
+		return x.DuplicateAction
+	}
+	return DuplicateAction_DUPLICATE_ACTION_UNSPECIFIED
+}
+
+type QueueFileDownloadResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The file that was already downloaded with the same content, if any.
+	// If duplicate_action was DUPLICATE_ACTION_UNSPECIFIED and this is set, the download was not queued.
+	Duplicate *DuplicateFile `protobuf:"bytes,1,opt,name=duplicate,proto3,oneof" json:"duplicate,omitempty"`
+	// The path the duplicate was hard linked or copied to, if duplicate_action was DUPLICATE_ACTION_HARD_LINK or
+	// DUPLICATE_ACTION_COPY and a duplicate was found.
+	LinkedPath    *string `protobuf:"bytes,2,opt,name=linked_path,json=linkedPath,proto3,oneof" json:"linked_path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *QueueFileDownloadResponse) Reset() {
+	*x = QueueFileDownloadResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[149]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *QueueFileDownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*QueueFileDownloadResponse) ProtoMessage() {}
+
+func (x *QueueFileDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[149]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use QueueFileDownloadResponse.ProtoReflect.Descriptor instead.
+func (*QueueFileDownloadResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{149}
+}
+
+func (x *QueueFileDownloadResponse) GetDuplicate() *DuplicateFile {
+	if x != nil {
+		return x.Duplicate
+	}
+	return nil
+}
+
+func (x *QueueFileDownloadResponse) GetLinkedPath() string {
+	if x != nil && x.LinkedPath != nil {
+		return *x.LinkedPath
+	}
+	return ""
+}
+
+// A file that was already downloaded.
+type DuplicateFile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The file's local path.
+	LocalPath string `protobuf:"bytes,1,opt,name=local_path,json=localPath,proto3" json:"local_path,omitempty"`
+	// The file's size, in bytes.
+	Size uint64 `protobuf:"varint,2,opt,name=size,proto3" json:"size,omitempty"`
+	// The UNIX timestamp when the file was downloaded.
+	DownloadedTs  int64 `protobuf:"varint,3,opt,name=downloaded_ts,json=downloadedTs,proto3" json:"downloaded_ts,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (DownloadManagerItem_Type) Type() protoreflect.EnumType {
-	return &file_pb_clientrpc_v1_rpc_proto_enumTypes[3]
+func (x *DuplicateFile) Reset() {
+	*x = DuplicateFile{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[150]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x DownloadManagerItem_Type) Number() protoreflect.EnumNumber {
-	return protoreflect.EnumNumber(x)
+func (x *DuplicateFile) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-// Deprecated: Use DownloadManagerItem_Type.Descriptor instead.
-func (DownloadManagerItem_Type) EnumDescriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{5, 0}
+func (*DuplicateFile) ProtoMessage() {}
+
+func (x *DuplicateFile) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[150]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
 }
 
-// Event is an event.
-// Events are differentiated by type, and have different data based on that type.
-type Event struct {
+// Deprecated: Use DuplicateFile.ProtoReflect.Descriptor instead.
+func (*DuplicateFile) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{150}
+}
+
+func (x *DuplicateFile) GetLocalPath() string {
+	if x != nil {
+		return x.LocalPath
+	}
+	return ""
+}
+
+func (x *DuplicateFile) GetSize() uint64 {
+	if x != nil {
+		return x.Size
+	}
+	return 0
+}
+
+func (x *DuplicateFile) GetDownloadedTs() int64 {
+	if x != nil {
+		return x.DownloadedTs
+	}
+	return 0
+}
+
+type CancelFileDownloadRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The event type.
-	// The appropriate field will be filled based on the type.
-	Type                  Event_Type                   `protobuf:"varint,1,opt,name=type,proto3,enum=pb.clientrpc.v1.Event_Type" json:"type,omitempty"`
-	ServerConn            *Event_ServerConnStateChange `protobuf:"bytes,2,opt,name=server_conn,json=serverConn,proto3,oneof" json:"server_conn,omitempty"`
-	ClientOnline          *Event_ClientOnline          `protobuf:"bytes,3,opt,name=client_online,json=clientOnline,proto3,oneof" json:"client_online,omitempty"`
-	ClientOffline         *Event_ClientOffline         `protobuf:"bytes,4,opt,name=client_offline,json=clientOffline,proto3,oneof" json:"client_offline,omitempty"`
-	NewUpdate             *Event_NewUpdate             `protobuf:"bytes,5,opt,name=new_update,json=newUpdate,proto3,oneof" json:"new_update,omitempty"`
-	DownloadStatusUpdates *Event_DownloadStatusUpdates `protobuf:"bytes,6,opt,name=download_status_updates,json=downloadStatusUpdates,proto3,oneof" json:"download_status_updates,omitempty"`
-	NewDmItem             *Event_NewDmItem             `protobuf:"bytes,7,opt,name=new_dm_item,json=newDmItem,proto3,oneof" json:"new_dm_item,omitempty"`
-	DmItemRemoved         *Event_DmItemRemoved         `protobuf:"bytes,8,opt,name=dm_item_removed,json=dmItemRemoved,proto3,oneof" json:"dm_item_removed,omitempty"`
-	unknownFields         protoimpl.UnknownFields
-	sizeCache             protoimpl.SizeCache
+	// The file download's UUID.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFileDownloadRequest) Reset() {
+	*x = CancelFileDownloadRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[151]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFileDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFileDownloadRequest) ProtoMessage() {}
+
+func (x *CancelFileDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[151]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFileDownloadRequest.ProtoReflect.Descriptor instead.
+func (*CancelFileDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{151}
+}
+
+func (x *CancelFileDownloadRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type CancelFileDownloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelFileDownloadResponse) Reset() {
+	*x = CancelFileDownloadResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[152]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelFileDownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelFileDownloadResponse) ProtoMessage() {}
+
+func (x *CancelFileDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[152]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelFileDownloadResponse.ProtoReflect.Descriptor instead.
+func (*CancelFileDownloadResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{152}
+}
+
+type RemoveDownloadManagerItemRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The item's UUID.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDownloadManagerItemRequest) Reset() {
+	*x = RemoveDownloadManagerItemRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[153]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDownloadManagerItemRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDownloadManagerItemRequest) ProtoMessage() {}
+
+func (x *RemoveDownloadManagerItemRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[153]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDownloadManagerItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDownloadManagerItemRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{153}
+}
+
+func (x *RemoveDownloadManagerItemRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type RemoveDownloadManagerItemResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RemoveDownloadManagerItemResponse) Reset() {
+	*x = RemoveDownloadManagerItemResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[154]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveDownloadManagerItemResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDownloadManagerItemResponse) ProtoMessage() {}
+
+func (x *RemoveDownloadManagerItemResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[154]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDownloadManagerItemResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDownloadManagerItemResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{154}
+}
+
+type PauseFileDownloadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The file download's UUID.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseFileDownloadRequest) Reset() {
+	*x = PauseFileDownloadRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[155]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseFileDownloadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseFileDownloadRequest) ProtoMessage() {}
+
+func (x *PauseFileDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[155]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseFileDownloadRequest.ProtoReflect.Descriptor instead.
+func (*PauseFileDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{155}
+}
+
+func (x *PauseFileDownloadRequest) GetUuid() string {
+	if x != nil {
+		return x.Uuid
+	}
+	return ""
+}
+
+type PauseFileDownloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PauseFileDownloadResponse) Reset() {
+	*x = PauseFileDownloadResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[156]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PauseFileDownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PauseFileDownloadResponse) ProtoMessage() {}
+
+func (x *PauseFileDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[156]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PauseFileDownloadResponse.ProtoReflect.Descriptor instead.
+func (*PauseFileDownloadResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{156}
 }
 
-func (x *Event) Reset() {
-	*x = Event{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[0]
+type ResumeFileDownloadRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The item's UUID.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ResumeFileDownloadRequest) Reset() {
+	*x = ResumeFileDownloadRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[157]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event) String() string {
+func (x *ResumeFileDownloadRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event) ProtoMessage() {}
+func (*ResumeFileDownloadRequest) ProtoMessage() {}
 
-func (x *Event) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[0]
+func (x *ResumeFileDownloadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[157]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -310,91 +10547,113 @@ func (x *Event) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event.ProtoReflect.Descriptor instead.
-func (*Event) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use ResumeFileDownloadRequest.ProtoReflect.Descriptor instead.
+func (*ResumeFileDownloadRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{157}
 }
 
-func (x *Event) GetType() Event_Type {
+func (x *ResumeFileDownloadRequest) GetUuid() string {
 	if x != nil {
-		return x.Type
+		return x.Uuid
 	}
-	return Event_TYPE_UNSPECIFIED
+	return ""
 }
 
-func (x *Event) GetServerConn() *Event_ServerConnStateChange {
-	if x != nil {
-		return x.ServerConn
-	}
-	return nil
+type ResumeFileDownloadResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event) GetClientOnline() *Event_ClientOnline {
-	if x != nil {
-		return x.ClientOnline
-	}
-	return nil
+func (x *ResumeFileDownloadResponse) Reset() {
+	*x = ResumeFileDownloadResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[158]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event) GetClientOffline() *Event_ClientOffline {
-	if x != nil {
-		return x.ClientOffline
-	}
-	return nil
+func (x *ResumeFileDownloadResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Event) GetNewUpdate() *Event_NewUpdate {
+func (*ResumeFileDownloadResponse) ProtoMessage() {}
+
+func (x *ResumeFileDownloadResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[158]
 	if x != nil {
-		return x.NewUpdate
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Event) GetDownloadStatusUpdates() *Event_DownloadStatusUpdates {
-	if x != nil {
-		return x.DownloadStatusUpdates
-	}
-	return nil
+// Deprecated: Use ResumeFileDownloadResponse.ProtoReflect.Descriptor instead.
+func (*ResumeFileDownloadResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{158}
 }
 
-func (x *Event) GetNewDmItem() *Event_NewDmItem {
-	if x != nil {
-		return x.NewDmItem
-	}
-	return nil
+type GetDownloadHooksRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event) GetDmItemRemoved() *Event_DmItemRemoved {
+func (x *GetDownloadHooksRequest) Reset() {
+	*x = GetDownloadHooksRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[159]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDownloadHooksRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDownloadHooksRequest) ProtoMessage() {}
+
+func (x *GetDownloadHooksRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[159]
 	if x != nil {
-		return x.DmItemRemoved
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-// EventContext is the context about where an event was generated.
-type EventContext struct {
+// Deprecated: Use GetDownloadHooksRequest.ProtoReflect.Descriptor instead.
+func (*GetDownloadHooksRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{159}
+}
+
+type GetDownloadHooksResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The UUID of the server where the event originated from.
-	ServerUuid    string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// All download hooks, both global and per-download.
+	Hooks         []*DownloadHookInfo `protobuf:"bytes,1,rep,name=hooks,proto3" json:"hooks,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *EventContext) Reset() {
-	*x = EventContext{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[1]
+func (x *GetDownloadHooksResponse) Reset() {
+	*x = GetDownloadHooksResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[160]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *EventContext) String() string {
+func (x *GetDownloadHooksResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EventContext) ProtoMessage() {}
+func (*GetDownloadHooksResponse) ProtoMessage() {}
 
-func (x *EventContext) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[1]
+func (x *GetDownloadHooksResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[160]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -405,47 +10664,46 @@ func (x *EventContext) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EventContext.ProtoReflect.Descriptor instead.
-func (*EventContext) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetDownloadHooksResponse.ProtoReflect.Descriptor instead.
+func (*GetDownloadHooksResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{160}
 }
 
-func (x *EventContext) GetServerUuid() string {
+func (x *GetDownloadHooksResponse) GetHooks() []*DownloadHookInfo {
 	if x != nil {
-		return x.ServerUuid
+		return x.Hooks
 	}
-	return ""
+	return nil
 }
 
-// LogMessageAttr is an attribute within a LogMessage.
-type LogMessageAttr struct {
+type CreateDownloadHookRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The value kind.
-	Kind string `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
-	// The key.
-	Key string `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
-	// The string representation of the value.
-	// The value can be interpreted based on the value of `kind`.
-	Value         string `protobuf:"bytes,3,opt,name=value,proto3" json:"value,omitempty"`
+	// The hook's type.
+	Type DownloadHookType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.clientrpc.v1.DownloadHookType" json:"type,omitempty"`
+	// The hook's target.
+	// See DownloadHookInfo.target.
+	Target string `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
+	// The UUID of the download the hook applies to, or omit to apply it to all downloads.
+	DownloadUuid  *string `protobuf:"bytes,3,opt,name=download_uuid,json=downloadUuid,proto3,oneof" json:"download_uuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogMessageAttr) Reset() {
-	*x = LogMessageAttr{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[2]
+func (x *CreateDownloadHookRequest) Reset() {
+	*x = CreateDownloadHookRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[161]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogMessageAttr) String() string {
+func (x *CreateDownloadHookRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogMessageAttr) ProtoMessage() {}
+func (*CreateDownloadHookRequest) ProtoMessage() {}
 
-func (x *LogMessageAttr) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[2]
+func (x *CreateDownloadHookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[161]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -456,62 +10714,55 @@ func (x *LogMessageAttr) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogMessageAttr.ProtoReflect.Descriptor instead.
-func (*LogMessageAttr) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{2}
+// Deprecated: Use CreateDownloadHookRequest.ProtoReflect.Descriptor instead.
+func (*CreateDownloadHookRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{161}
 }
 
-func (x *LogMessageAttr) GetKind() string {
+func (x *CreateDownloadHookRequest) GetType() DownloadHookType {
 	if x != nil {
-		return x.Kind
+		return x.Type
 	}
-	return ""
+	return DownloadHookType_DOWNLOAD_HOOK_TYPE_UNSPECIFIED
 }
 
-func (x *LogMessageAttr) GetKey() string {
+func (x *CreateDownloadHookRequest) GetTarget() string {
 	if x != nil {
-		return x.Key
+		return x.Target
 	}
 	return ""
 }
 
-func (x *LogMessageAttr) GetValue() string {
-	if x != nil {
-		return x.Value
+func (x *CreateDownloadHookRequest) GetDownloadUuid() string {
+	if x != nil && x.DownloadUuid != nil {
+		return *x.DownloadUuid
 	}
 	return ""
 }
 
-// LogMessage is a structured log message.
-type LogMessage struct {
+type CreateDownloadHookResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The message's UID.
-	Uid string `protobuf:"bytes,1,opt,name=uid,proto3" json:"uid,omitempty"`
-	// The UNIX millisecond timestamp the log was sent on.
-	CreatedTs int64 `protobuf:"varint,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
-	// The textual message.
-	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	// The message's attributes.
-	Attrs         []*LogMessageAttr `protobuf:"bytes,4,rep,name=attrs,proto3" json:"attrs,omitempty"`
+	// The new hook.
+	Hook          *DownloadHookInfo `protobuf:"bytes,1,opt,name=hook,proto3" json:"hook,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogMessage) Reset() {
-	*x = LogMessage{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[3]
+func (x *CreateDownloadHookResponse) Reset() {
+	*x = CreateDownloadHookResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[162]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *LogMessage) String() string {
+func (x *CreateDownloadHookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LogMessage) ProtoMessage() {}
+func (*CreateDownloadHookResponse) ProtoMessage() {}
 
-func (x *LogMessage) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[3]
+func (x *CreateDownloadHookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[162]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -522,73 +10773,84 @@ func (x *LogMessage) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LogMessage.ProtoReflect.Descriptor instead.
-func (*LogMessage) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{3}
+// Deprecated: Use CreateDownloadHookResponse.ProtoReflect.Descriptor instead.
+func (*CreateDownloadHookResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{162}
 }
 
-func (x *LogMessage) GetUid() string {
+func (x *CreateDownloadHookResponse) GetHook() *DownloadHookInfo {
 	if x != nil {
-		return x.Uid
+		return x.Hook
 	}
-	return ""
+	return nil
 }
 
-func (x *LogMessage) GetCreatedTs() int64 {
-	if x != nil {
-		return x.CreatedTs
-	}
-	return 0
+type DeleteDownloadHookRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The hook's UUID.
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *LogMessage) GetMessage() string {
+func (x *DeleteDownloadHookRequest) Reset() {
+	*x = DeleteDownloadHookRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[163]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDownloadHookRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDownloadHookRequest) ProtoMessage() {}
+
+func (x *DeleteDownloadHookRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[163]
 	if x != nil {
-		return x.Message
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *LogMessage) GetAttrs() []*LogMessageAttr {
+// Deprecated: Use DeleteDownloadHookRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDownloadHookRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{163}
+}
+
+func (x *DeleteDownloadHookRequest) GetUuid() string {
 	if x != nil {
-		return x.Attrs
+		return x.Uuid
 	}
-	return nil
+	return ""
 }
 
-// DownloadStatusUpdate is a file download status update.
-type DownloadStatusUpdate struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The file download's UUID.
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	// The download status.
-	Status DownloadStatus `protobuf:"varint,2,opt,name=status,proto3,enum=pb.clientrpc.v1.DownloadStatus" json:"status,omitempty"`
-	// The number of bytes downloaded.
-	Downloaded uint64 `protobuf:"varint,3,opt,name=downloaded,proto3" json:"downloaded,omitempty"`
-	// The file's size in bytes, if -1 if not yet known.
-	FileSize int64 `protobuf:"varint,4,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
-	// The current download speed, in bytes per second.
-	Speed uint64 `protobuf:"varint,5,opt,name=speed,proto3" json:"speed,omitempty"`
-	// The error message, if applicable.
-	ErrorMessage  *string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+type DeleteDownloadHookResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadStatusUpdate) Reset() {
-	*x = DownloadStatusUpdate{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[4]
+func (x *DeleteDownloadHookResponse) Reset() {
+	*x = DeleteDownloadHookResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[164]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadStatusUpdate) String() string {
+func (x *DeleteDownloadHookResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadStatusUpdate) ProtoMessage() {}
+func (*DeleteDownloadHookResponse) ProtoMessage() {}
 
-func (x *DownloadStatusUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[4]
+func (x *DeleteDownloadHookResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[164]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -599,87 +10861,82 @@ func (x *DownloadStatusUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadStatusUpdate.ProtoReflect.Descriptor instead.
-func (*DownloadStatusUpdate) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{4}
+// Deprecated: Use DeleteDownloadHookResponse.ProtoReflect.Descriptor instead.
+func (*DeleteDownloadHookResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{164}
 }
 
-func (x *DownloadStatusUpdate) GetUuid() string {
-	if x != nil {
-		return x.Uuid
-	}
-	return ""
+type GetUploadsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of finished uploads to return.
+	// 0 means 100.
+	HistoryLimit  uint32 `protobuf:"varint,1,opt,name=history_limit,json=historyLimit,proto3" json:"history_limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadStatusUpdate) GetStatus() DownloadStatus {
-	if x != nil {
-		return x.Status
-	}
-	return DownloadStatus_DOWNLOAD_STATUS_UNSPECIFIED
+func (x *GetUploadsRequest) Reset() {
+	*x = GetUploadsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[165]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadStatusUpdate) GetDownloaded() uint64 {
+func (x *GetUploadsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUploadsRequest) ProtoMessage() {}
+
+func (x *GetUploadsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[165]
 	if x != nil {
-		return x.Downloaded
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *DownloadStatusUpdate) GetFileSize() int64 {
-	if x != nil {
-		return x.FileSize
-	}
-	return 0
+// Deprecated: Use GetUploadsRequest.ProtoReflect.Descriptor instead.
+func (*GetUploadsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{165}
 }
 
-func (x *DownloadStatusUpdate) GetSpeed() uint64 {
+func (x *GetUploadsRequest) GetHistoryLimit() uint32 {
 	if x != nil {
-		return x.Speed
+		return x.HistoryLimit
 	}
 	return 0
 }
 
-func (x *DownloadStatusUpdate) GetErrorMessage() string {
-	if x != nil && x.ErrorMessage != nil {
-		return *x.ErrorMessage
-	}
-	return ""
-}
-
-// DownloadManagerItem is an item in the download manager.
-type DownloadManagerItem struct {
+type GetUploadsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The item's type.
-	Type DownloadManagerItem_Type `protobuf:"varint,1,opt,name=type,proto3,enum=pb.clientrpc.v1.DownloadManagerItem_Type" json:"type,omitempty"`
-	// The item's UUID.
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	// The associated server's UUID.
-	ServerUuid string `protobuf:"bytes,3,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
-	// The associated peer's username.
-	PeerUsername string `protobuf:"bytes,4,opt,name=peer_username,json=peerUsername,proto3" json:"peer_username,omitempty"`
-	// The file's path.
-	FilePath string `protobuf:"bytes,5,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
-	// A download item.
-	Download      *DownloadManagerItem_Download `protobuf:"bytes,6,opt,name=download,proto3,oneof" json:"download,omitempty"`
+	// Uploads that are in progress.
+	Active []*UploadInfo `protobuf:"bytes,1,rep,name=active,proto3" json:"active,omitempty"`
+	// Finished uploads, newest first.
+	History       []*UploadInfo `protobuf:"bytes,2,rep,name=history,proto3" json:"history,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadManagerItem) Reset() {
-	*x = DownloadManagerItem{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[5]
+func (x *GetUploadsResponse) Reset() {
+	*x = GetUploadsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[166]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadManagerItem) String() string {
+func (x *GetUploadsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DownloadManagerItem) ProtoMessage() {}
+func (*GetUploadsResponse) ProtoMessage() {}
 
-func (x *DownloadManagerItem) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[5]
+func (x *GetUploadsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[166]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -690,89 +10947,82 @@ func (x *DownloadManagerItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DownloadManagerItem.ProtoReflect.Descriptor instead.
-func (*DownloadManagerItem) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use GetUploadsResponse.ProtoReflect.Descriptor instead.
+func (*GetUploadsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{166}
 }
 
-func (x *DownloadManagerItem) GetType() DownloadManagerItem_Type {
+func (x *GetUploadsResponse) GetActive() []*UploadInfo {
 	if x != nil {
-		return x.Type
+		return x.Active
 	}
-	return DownloadManagerItem_TYPE_UNSPECIFIED
+	return nil
 }
 
-func (x *DownloadManagerItem) GetUuid() string {
+func (x *GetUploadsResponse) GetHistory() []*UploadInfo {
 	if x != nil {
-		return x.Uuid
+		return x.History
 	}
-	return ""
+	return nil
 }
 
-func (x *DownloadManagerItem) GetServerUuid() string {
-	if x != nil {
-		return x.ServerUuid
-	}
-	return ""
+type ClearUploadHistoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DownloadManagerItem) GetPeerUsername() string {
-	if x != nil {
-		return x.PeerUsername
-	}
-	return ""
+func (x *ClearUploadHistoryRequest) Reset() {
+	*x = ClearUploadHistoryRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[167]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DownloadManagerItem) GetFilePath() string {
-	if x != nil {
-		return x.FilePath
-	}
-	return ""
+func (x *ClearUploadHistoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DownloadManagerItem) GetDownload() *DownloadManagerItem_Download {
+func (*ClearUploadHistoryRequest) ProtoMessage() {}
+
+func (x *ClearUploadHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[167]
 	if x != nil {
-		return x.Download
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-// Information about an update.
-type UpdateInfo struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether the checked update was valid.
-	// If false, no other fields will be filled.
-	// An invalid update is caused by an invalid signature on an update,
-	// which is indicative of a larger problem.
-	IsValid bool `protobuf:"varint,1,opt,name=is_valid,json=isValid,proto3" json:"is_valid,omitempty"`
-	// The release timestamp.
-	CreatedTs int64 `protobuf:"varint,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
-	// The version string.
-	Version string `protobuf:"bytes,3,opt,name=version,proto3" json:"version,omitempty"`
-	// The description.
-	Description string `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
-	// The URL to get the update.
-	// It is not a URL to a binary, it is a URL to a page to get the binary.
-	Url           string `protobuf:"bytes,5,opt,name=url,proto3" json:"url,omitempty"`
+// Deprecated: Use ClearUploadHistoryRequest.ProtoReflect.Descriptor instead.
+func (*ClearUploadHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{167}
+}
+
+type ClearUploadHistoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateInfo) Reset() {
-	*x = UpdateInfo{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[6]
+func (x *ClearUploadHistoryResponse) Reset() {
+	*x = ClearUploadHistoryResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[168]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateInfo) String() string {
+func (x *ClearUploadHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateInfo) ProtoMessage() {}
+func (*ClearUploadHistoryResponse) ProtoMessage() {}
 
-func (x *UpdateInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[6]
+func (x *ClearUploadHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[168]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -783,82 +11033,79 @@ func (x *UpdateInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateInfo.ProtoReflect.Descriptor instead.
-func (*UpdateInfo) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use ClearUploadHistoryResponse.ProtoReflect.Descriptor instead.
+func (*ClearUploadHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{168}
 }
 
-func (x *UpdateInfo) GetIsValid() bool {
-	if x != nil {
-		return x.IsValid
-	}
-	return false
+type GetFriendsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	ServerUuid    string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateInfo) GetCreatedTs() int64 {
-	if x != nil {
-		return x.CreatedTs
-	}
-	return 0
+func (x *GetFriendsRequest) Reset() {
+	*x = GetFriendsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[169]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateInfo) GetVersion() string {
-	if x != nil {
-		return x.Version
-	}
-	return ""
+func (x *GetFriendsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateInfo) GetDescription() string {
+func (*GetFriendsRequest) ProtoMessage() {}
+
+func (x *GetFriendsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[169]
 	if x != nil {
-		return x.Description
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateInfo) GetUrl() string {
+// Deprecated: Use GetFriendsRequest.ProtoReflect.Descriptor instead.
+func (*GetFriendsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{169}
+}
+
+func (x *GetFriendsRequest) GetServerUuid() string {
 	if x != nil {
-		return x.Url
+		return x.ServerUuid
 	}
 	return ""
 }
 
-// Information about a server.
-type ServerInfo struct {
+type GetFriendsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The server's current state.
-	State *ServerInfo_State `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
-	// The server's UUID.
-	Uuid string `protobuf:"bytes,2,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	// The name given to the server.
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// The server's address.
-	Address string `protobuf:"bytes,4,opt,name=address,proto3" json:"address,omitempty"`
-	// The room to connect to.
-	Room string `protobuf:"bytes,5,opt,name=room,proto3" json:"room,omitempty"`
-	// The username to use for authentication.
-	Username string `protobuf:"bytes,6,opt,name=username,proto3" json:"username,omitempty"`
-	// The UNIX timestamp when the server was created.
-	CreatedTs     int64 `protobuf:"varint,7,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// The friend info for all peers on the server that have any.
+	Friends       []*FriendInfo `protobuf:"bytes,1,rep,name=friends,proto3" json:"friends,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServerInfo) Reset() {
-	*x = ServerInfo{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[7]
+func (x *GetFriendsResponse) Reset() {
+	*x = GetFriendsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[170]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServerInfo) String() string {
+func (x *GetFriendsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerInfo) ProtoMessage() {}
+func (*GetFriendsResponse) ProtoMessage() {}
 
-func (x *ServerInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[7]
+func (x *GetFriendsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[170]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -869,95 +11116,124 @@ func (x *ServerInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerInfo.ProtoReflect.Descriptor instead.
-func (*ServerInfo) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetFriendsResponse.ProtoReflect.Descriptor instead.
+func (*GetFriendsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{170}
 }
 
-func (x *ServerInfo) GetState() *ServerInfo_State {
+func (x *GetFriendsResponse) GetFriends() []*FriendInfo {
 	if x != nil {
-		return x.State
+		return x.Friends
 	}
 	return nil
 }
 
-func (x *ServerInfo) GetUuid() string {
+type SetFriendRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The peer's username.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// See FriendInfo.nickname.
+	// At most 64 characters.
+	Nickname string `protobuf:"bytes,3,opt,name=nickname,proto3" json:"nickname,omitempty"`
+	// See FriendInfo.note.
+	// At most 4096 characters.
+	Note string `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	// See FriendInfo.trust_level.
+	TrustLevel    TrustLevel `protobuf:"varint,5,opt,name=trust_level,json=trustLevel,proto3,enum=pb.clientrpc.v1.TrustLevel" json:"trust_level,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetFriendRequest) Reset() {
+	*x = SetFriendRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[171]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetFriendRequest) ProtoMessage() {}
+
+func (x *SetFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[171]
 	if x != nil {
-		return x.Uuid
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ServerInfo) GetName() string {
+// Deprecated: Use SetFriendRequest.ProtoReflect.Descriptor instead.
+func (*SetFriendRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{171}
+}
+
+func (x *SetFriendRequest) GetServerUuid() string {
 	if x != nil {
-		return x.Name
+		return x.ServerUuid
 	}
 	return ""
 }
 
-func (x *ServerInfo) GetAddress() string {
+func (x *SetFriendRequest) GetUsername() string {
 	if x != nil {
-		return x.Address
+		return x.Username
 	}
 	return ""
 }
 
-func (x *ServerInfo) GetRoom() string {
+func (x *SetFriendRequest) GetNickname() string {
 	if x != nil {
-		return x.Room
+		return x.Nickname
 	}
 	return ""
 }
 
-func (x *ServerInfo) GetUsername() string {
+func (x *SetFriendRequest) GetNote() string {
 	if x != nil {
-		return x.Username
+		return x.Note
 	}
 	return ""
 }
 
-func (x *ServerInfo) GetCreatedTs() int64 {
+func (x *SetFriendRequest) GetTrustLevel() TrustLevel {
 	if x != nil {
-		return x.CreatedTs
+		return x.TrustLevel
 	}
-	return 0
+	return TrustLevel_TRUST_LEVEL_UNSPECIFIED
 }
 
-// Information about a server share.
-type ShareInfo struct {
+type SetFriendResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The share's UUID.
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	// The UUID of the associated server.
-	ServerUuid string `protobuf:"bytes,2,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
-	// The share's name.
-	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	// The share's path on disk.
-	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
-	// Whether links should be followed.
-	// Links are symbolic links or the OS equivalent.
-	FollowLinks bool `protobuf:"varint,5,opt,name=follow_links,json=followLinks,proto3" json:"follow_links,omitempty"`
-	// The UNIX timestamp when the share was created.
-	CreatedTs     int64 `protobuf:"varint,6,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// The friend info as stored.
+	Friend        *FriendInfo `protobuf:"bytes,1,opt,name=friend,proto3" json:"friend,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ShareInfo) Reset() {
-	*x = ShareInfo{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[8]
+func (x *SetFriendResponse) Reset() {
+	*x = SetFriendResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[172]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShareInfo) String() string {
+func (x *SetFriendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ShareInfo) ProtoMessage() {}
+func (*SetFriendResponse) ProtoMessage() {}
 
-func (x *ShareInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[8]
+func (x *SetFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[172]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -968,77 +11244,93 @@ func (x *ShareInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ShareInfo.ProtoReflect.Descriptor instead.
-func (*ShareInfo) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use SetFriendResponse.ProtoReflect.Descriptor instead.
+func (*SetFriendResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{172}
 }
 
-func (x *ShareInfo) GetUuid() string {
+func (x *SetFriendResponse) GetFriend() *FriendInfo {
 	if x != nil {
-		return x.Uuid
+		return x.Friend
 	}
-	return ""
+	return nil
+}
+
+type DeleteFriendRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The peer's username.
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteFriendRequest) Reset() {
+	*x = DeleteFriendRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[173]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *ShareInfo) GetServerUuid() string {
-	if x != nil {
-		return x.ServerUuid
-	}
-	return ""
+func (x *DeleteFriendRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *ShareInfo) GetName() string {
+func (*DeleteFriendRequest) ProtoMessage() {}
+
+func (x *DeleteFriendRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[173]
 	if x != nil {
-		return x.Name
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *ShareInfo) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use DeleteFriendRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFriendRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{173}
 }
 
-func (x *ShareInfo) GetFollowLinks() bool {
+func (x *DeleteFriendRequest) GetServerUuid() string {
 	if x != nil {
-		return x.FollowLinks
+		return x.ServerUuid
 	}
-	return false
+	return ""
 }
 
-func (x *ShareInfo) GetCreatedTs() int64 {
+func (x *DeleteFriendRequest) GetUsername() string {
 	if x != nil {
-		return x.CreatedTs
+		return x.Username
 	}
-	return 0
+	return ""
 }
 
-// OnlineUserInfo is information about an online user.
-type OnlineUserInfo struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The user's username.
-	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+type DeleteFriendResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OnlineUserInfo) Reset() {
-	*x = OnlineUserInfo{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[9]
+func (x *DeleteFriendResponse) Reset() {
+	*x = DeleteFriendResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[174]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OnlineUserInfo) String() string {
+func (x *DeleteFriendResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OnlineUserInfo) ProtoMessage() {}
+func (*DeleteFriendResponse) ProtoMessage() {}
 
-func (x *OnlineUserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[9]
+func (x *DeleteFriendResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[174]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1049,47 +11341,37 @@ func (x *OnlineUserInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OnlineUserInfo.ProtoReflect.Descriptor instead.
-func (*OnlineUserInfo) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{9}
-}
-
-func (x *OnlineUserInfo) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
+// Deprecated: Use DeleteFriendResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFriendResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{174}
 }
 
-// FileMeta is metadata about a file/folder.
-type FileMeta struct {
+// BlockedPeerInfo is a peer on the local block list.
+type BlockedPeerInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The file's name.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Whether the file is a directory.
-	IsDir bool `protobuf:"varint,2,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
-	// The file's size, in bytes.
-	// Always zero if the file is a folder.
-	Size          uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The peer's username.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The UNIX timestamp when the peer was blocked.
+	CreatedTs     int64 `protobuf:"varint,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *FileMeta) Reset() {
-	*x = FileMeta{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[10]
+func (x *BlockedPeerInfo) Reset() {
+	*x = BlockedPeerInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[175]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *FileMeta) String() string {
+func (x *BlockedPeerInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FileMeta) ProtoMessage() {}
+func (*BlockedPeerInfo) ProtoMessage() {}
 
-func (x *FileMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[10]
+func (x *BlockedPeerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[175]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1100,88 +11382,48 @@ func (x *FileMeta) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FileMeta.ProtoReflect.Descriptor instead.
-func (*FileMeta) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{10}
+// Deprecated: Use BlockedPeerInfo.ProtoReflect.Descriptor instead.
+func (*BlockedPeerInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{175}
 }
 
-func (x *FileMeta) GetName() string {
+func (x *BlockedPeerInfo) GetUsername() string {
 	if x != nil {
-		return x.Name
+		return x.Username
 	}
 	return ""
 }
 
-func (x *FileMeta) GetIsDir() bool {
-	if x != nil {
-		return x.IsDir
-	}
-	return false
-}
-
-func (x *FileMeta) GetSize() uint64 {
+func (x *BlockedPeerInfo) GetCreatedTs() int64 {
 	if x != nil {
-		return x.Size
+		return x.CreatedTs
 	}
 	return 0
 }
 
-// DirectSettings is direct connection settings for the client.
-type DirectSettings struct {
+type GetBlockedPeersRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Whether to disable direct connections entirely.
-	// If true, all other fields will be ignored.
-	Disable bool `protobuf:"varint,1,opt,name=disable,proto3" json:"disable,omitempty"`
-	// The initial addresses to listen on.
-	// Each address must be in the format `IPv4:PORT`, `[IPv6]:PORT`, `IP` (IPv6 without port does not need brackets).
-	// Must specify at least one.
-	// Can use addresses like `0.0.0.0` and `[::]` (with or without port) to listen on all interfaces.
-	// Any addresses without a port will have a port assigned to them.
-	Addresses []string `protobuf:"bytes,2,rep,name=addresses,proto3" json:"addresses,omitempty"`
-	// The default port to use for addresses that do not have a specified port.
-	// It will also be the port opened by UPnP.
-	//
-	// If 0, a random port will be used.
-	// Using a random port is not recommended because it will cause port churn across reconnects.
-	// Keeping the port consistent across reconnects is useful because external clients will be able to more reliably reach the client.
-	//
-	// A port >= 1024 is recommended to avoid permission denied errors from the OS.
-	DefaultPort uint32 `protobuf:"varint,3,opt,name=default_port,json=defaultPort,proto3" json:"default_port,omitempty"`
-	// Whether to disable probing the machine for IPs to advertise.
-	// It does not advertise private IPs unless advertise_private_ips is true.
-	DisableProbeIpsToAdvertise bool `protobuf:"varint,4,opt,name=disable_probe_ips_to_advertise,json=disableProbeIpsToAdvertise,proto3" json:"disable_probe_ips_to_advertise,omitempty"`
-	// Whether to advertise private IPs (like 192.168.0.0/16, 172.16.0.0/12, 10.0.0.0/8).
-	// Has no effect if probe_ips_to_advertise is false.
-	// This only makes sense when multiple clients are on the same LAN or VPN.
-	AdvertisePrivateIps bool `protobuf:"varint,5,opt,name=advertise_private_ips,json=advertisePrivateIps,proto3" json:"advertise_private_ips,omitempty"`
-	// Whether to disable public IP discovery via the server.
-	// By default, the client will try to discover its public IP by asking the server for it.
-	DisablePublicIpDiscovery bool `protobuf:"varint,6,opt,name=disable_public_ip_discovery,json=disablePublicIpDiscovery,proto3" json:"disable_public_ip_discovery,omitempty"`
-	// Whether to disable UPnP.
-	DisableUpnp bool `protobuf:"varint,7,opt,name=disable_upnp,json=disableUpnp,proto3" json:"disable_upnp,omitempty"`
-	// The timeout for using UPnP.
-	// Defaults to 10 seconds.
-	// Has no effect if disable_upnp is true.
-	UpnpTimeoutMs uint32 `protobuf:"varint,8,opt,name=upnp_timeout_ms,json=upnpTimeoutMs,proto3" json:"upnp_timeout_ms,omitempty"`
+	// The server's UUID.
+	ServerUuid    string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DirectSettings) Reset() {
-	*x = DirectSettings{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[11]
+func (x *GetBlockedPeersRequest) Reset() {
+	*x = GetBlockedPeersRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[176]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DirectSettings) String() string {
+func (x *GetBlockedPeersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DirectSettings) ProtoMessage() {}
+func (*GetBlockedPeersRequest) ProtoMessage() {}
 
-func (x *DirectSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[11]
+func (x *GetBlockedPeersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[176]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1192,97 +11434,88 @@ func (x *DirectSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DirectSettings.ProtoReflect.Descriptor instead.
-func (*DirectSettings) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetBlockedPeersRequest.ProtoReflect.Descriptor instead.
+func (*GetBlockedPeersRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{176}
 }
 
-func (x *DirectSettings) GetDisable() bool {
+func (x *GetBlockedPeersRequest) GetServerUuid() string {
 	if x != nil {
-		return x.Disable
+		return x.ServerUuid
 	}
-	return false
+	return ""
 }
 
-func (x *DirectSettings) GetAddresses() []string {
-	if x != nil {
-		return x.Addresses
-	}
-	return nil
+type GetBlockedPeersResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The blocked peers on the server, ordered by username.
+	Peers         []*BlockedPeerInfo `protobuf:"bytes,1,rep,name=peers,proto3" json:"peers,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DirectSettings) GetDefaultPort() uint32 {
-	if x != nil {
-		return x.DefaultPort
-	}
-	return 0
+func (x *GetBlockedPeersResponse) Reset() {
+	*x = GetBlockedPeersResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[177]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *DirectSettings) GetDisableProbeIpsToAdvertise() bool {
-	if x != nil {
-		return x.DisableProbeIpsToAdvertise
-	}
-	return false
+func (x *GetBlockedPeersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *DirectSettings) GetAdvertisePrivateIps() bool {
-	if x != nil {
-		return x.AdvertisePrivateIps
-	}
-	return false
-}
+func (*GetBlockedPeersResponse) ProtoMessage() {}
 
-func (x *DirectSettings) GetDisablePublicIpDiscovery() bool {
+func (x *GetBlockedPeersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[177]
 	if x != nil {
-		return x.DisablePublicIpDiscovery
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return false
+	return mi.MessageOf(x)
 }
 
-func (x *DirectSettings) GetDisableUpnp() bool {
-	if x != nil {
-		return x.DisableUpnp
-	}
-	return false
+// Deprecated: Use GetBlockedPeersResponse.ProtoReflect.Descriptor instead.
+func (*GetBlockedPeersResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{177}
 }
 
-func (x *DirectSettings) GetUpnpTimeoutMs() uint32 {
+func (x *GetBlockedPeersResponse) GetPeers() []*BlockedPeerInfo {
 	if x != nil {
-		return x.UpnpTimeoutMs
+		return x.Peers
 	}
-	return 0
+	return nil
 }
 
-// TransferSettings are transfer (download and upload) settings for the client.
-type TransferSettings struct {
+type BlockPeerRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The number of concurrent downloads to perform.
-	DownloadConcurrency uint32 `protobuf:"varint,1,opt,name=download_concurrency,json=downloadConcurrency,proto3" json:"download_concurrency,omitempty"`
-	// The directory to store incomplete downloads.
-	// Must be an absolute path.
-	IncompleteDownloadDir string `protobuf:"bytes,2,opt,name=incomplete_download_dir,json=incompleteDownloadDir,proto3" json:"incomplete_download_dir,omitempty"`
-	// The directory to store complete downloads.
-	// Must be an absolute path.
-	CompleteDownloadDir string `protobuf:"bytes,3,opt,name=complete_download_dir,json=completeDownloadDir,proto3" json:"complete_download_dir,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// The server's UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The peer's username.
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *TransferSettings) Reset() {
-	*x = TransferSettings{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[12]
+func (x *BlockPeerRequest) Reset() {
+	*x = BlockPeerRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[178]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *TransferSettings) String() string {
+func (x *BlockPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TransferSettings) ProtoMessage() {}
+func (*BlockPeerRequest) ProtoMessage() {}
 
-func (x *TransferSettings) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[12]
+func (x *BlockPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[178]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1293,53 +11526,46 @@ func (x *TransferSettings) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TransferSettings.ProtoReflect.Descriptor instead.
-func (*TransferSettings) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{12}
-}
-
-func (x *TransferSettings) GetDownloadConcurrency() uint32 {
-	if x != nil {
-		return x.DownloadConcurrency
-	}
-	return 0
+// Deprecated: Use BlockPeerRequest.ProtoReflect.Descriptor instead.
+func (*BlockPeerRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{178}
 }
 
-func (x *TransferSettings) GetIncompleteDownloadDir() string {
+func (x *BlockPeerRequest) GetServerUuid() string {
 	if x != nil {
-		return x.IncompleteDownloadDir
+		return x.ServerUuid
 	}
 	return ""
 }
 
-func (x *TransferSettings) GetCompleteDownloadDir() string {
+func (x *BlockPeerRequest) GetUsername() string {
 	if x != nil {
-		return x.CompleteDownloadDir
+		return x.Username
 	}
 	return ""
 }
 
-type StreamEventsRequest struct {
+type BlockPeerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamEventsRequest) Reset() {
-	*x = StreamEventsRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[13]
+func (x *BlockPeerResponse) Reset() {
+	*x = BlockPeerResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[179]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamEventsRequest) String() string {
+func (x *BlockPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamEventsRequest) ProtoMessage() {}
+func (*BlockPeerResponse) ProtoMessage() {}
 
-func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[13]
+func (x *BlockPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[179]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1350,36 +11576,36 @@ func (x *StreamEventsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamEventsRequest.ProtoReflect.Descriptor instead.
-func (*StreamEventsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use BlockPeerResponse.ProtoReflect.Descriptor instead.
+func (*BlockPeerResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{179}
 }
 
-type StreamEventsResponse struct {
+type UnblockPeerRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The event.
-	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
-	// The context about where the event was generated.
-	Context       *EventContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	// The server's UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The peer's username.
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamEventsResponse) Reset() {
-	*x = StreamEventsResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[14]
+func (x *UnblockPeerRequest) Reset() {
+	*x = UnblockPeerRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[180]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamEventsResponse) String() string {
+func (x *UnblockPeerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamEventsResponse) ProtoMessage() {}
+func (*UnblockPeerRequest) ProtoMessage() {}
 
-func (x *StreamEventsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[14]
+func (x *UnblockPeerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[180]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1390,49 +11616,46 @@ func (x *StreamEventsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamEventsResponse.ProtoReflect.Descriptor instead.
-func (*StreamEventsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *StreamEventsResponse) GetEvent() *Event {
+// Deprecated: Use UnblockPeerRequest.ProtoReflect.Descriptor instead.
+func (*UnblockPeerRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{180}
+}
+
+func (x *UnblockPeerRequest) GetServerUuid() string {
 	if x != nil {
-		return x.Event
+		return x.ServerUuid
 	}
-	return nil
+	return ""
 }
 
-func (x *StreamEventsResponse) GetContext() *EventContext {
+func (x *UnblockPeerRequest) GetUsername() string {
 	if x != nil {
-		return x.Context
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-type StreamLogsRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// Optionally, send existing logs after this timestamp before streaming live logs.
-	// The timestamp is a UNIX millisecond timestamp.
-	SendLogsAfterTs *int64 `protobuf:"varint,1,opt,name=send_logs_after_ts,json=sendLogsAfterTs,proto3,oneof" json:"send_logs_after_ts,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+type UnblockPeerResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamLogsRequest) Reset() {
-	*x = StreamLogsRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[15]
+func (x *UnblockPeerResponse) Reset() {
+	*x = UnblockPeerResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[181]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamLogsRequest) String() string {
+func (x *UnblockPeerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamLogsRequest) ProtoMessage() {}
+func (*UnblockPeerResponse) ProtoMessage() {}
 
-func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[15]
+func (x *UnblockPeerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[181]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1443,44 +11666,32 @@ func (x *StreamLogsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamLogsRequest.ProtoReflect.Descriptor instead.
-func (*StreamLogsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{15}
-}
-
-func (x *StreamLogsRequest) GetSendLogsAfterTs() int64 {
-	if x != nil && x.SendLogsAfterTs != nil {
-		return *x.SendLogsAfterTs
-	}
-	return 0
+// Deprecated: Use UnblockPeerResponse.ProtoReflect.Descriptor instead.
+func (*UnblockPeerResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{181}
 }
 
-type StreamLogsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The logs.
-	// This field usually has only one log message, but in the case of
-	// sending back existing logs, it may have many.
-	// The logs will be ordered by timestamp, ascending.
-	Logs          []*LogMessage `protobuf:"bytes,1,rep,name=logs,proto3" json:"logs,omitempty"`
+type GetInterestsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamLogsResponse) Reset() {
-	*x = StreamLogsResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[16]
+func (x *GetInterestsRequest) Reset() {
+	*x = GetInterestsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[182]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamLogsResponse) String() string {
+func (x *GetInterestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamLogsResponse) ProtoMessage() {}
+func (*GetInterestsRequest) ProtoMessage() {}
 
-func (x *StreamLogsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[16]
+func (x *GetInterestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[182]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1491,39 +11702,34 @@ func (x *StreamLogsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamLogsResponse.ProtoReflect.Descriptor instead.
-func (*StreamLogsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{16}
-}
-
-func (x *StreamLogsResponse) GetLogs() []*LogMessage {
-	if x != nil {
-		return x.Logs
-	}
-	return nil
+// Deprecated: Use GetInterestsRequest.ProtoReflect.Descriptor instead.
+func (*GetInterestsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{182}
 }
 
-type StopRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type GetInterestsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The interests published to every server, normalized.
+	Interests     []string `protobuf:"bytes,1,rep,name=interests,proto3" json:"interests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopRequest) Reset() {
-	*x = StopRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[17]
+func (x *GetInterestsResponse) Reset() {
+	*x = GetInterestsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[183]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopRequest) String() string {
+func (x *GetInterestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopRequest) ProtoMessage() {}
+func (*GetInterestsResponse) ProtoMessage() {}
 
-func (x *StopRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[17]
+func (x *GetInterestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[183]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1534,32 +11740,43 @@ func (x *StopRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopRequest.ProtoReflect.Descriptor instead.
-func (*StopRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use GetInterestsResponse.ProtoReflect.Descriptor instead.
+func (*GetInterestsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{183}
 }
 
-type StopResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *GetInterestsResponse) GetInterests() []string {
+	if x != nil {
+		return x.Interests
+	}
+	return nil
+}
+
+type SetInterestsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The interests to publish to every server, such as "jazz" or "field recordings".
+	// They are normalized by lowercasing them and collapsing whitespace, and duplicates are removed.
+	// Empty to clear them.
+	Interests     []string `protobuf:"bytes,1,rep,name=interests,proto3" json:"interests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StopResponse) Reset() {
-	*x = StopResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[18]
+func (x *SetInterestsRequest) Reset() {
+	*x = SetInterestsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[184]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StopResponse) String() string {
+func (x *SetInterestsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StopResponse) ProtoMessage() {}
+func (*SetInterestsRequest) ProtoMessage() {}
 
-func (x *StopResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[18]
+func (x *SetInterestsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[184]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1570,32 +11787,41 @@ func (x *StopResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StopResponse.ProtoReflect.Descriptor instead.
-func (*StopResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use SetInterestsRequest.ProtoReflect.Descriptor instead.
+func (*SetInterestsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{184}
 }
 
-type GetClientInfoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *SetInterestsRequest) GetInterests() []string {
+	if x != nil {
+		return x.Interests
+	}
+	return nil
+}
+
+type SetInterestsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The interests that were stored, normalized.
+	Interests     []string `protobuf:"bytes,1,rep,name=interests,proto3" json:"interests,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClientInfoRequest) Reset() {
-	*x = GetClientInfoRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[19]
+func (x *SetInterestsResponse) Reset() {
+	*x = SetInterestsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[185]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClientInfoRequest) String() string {
+func (x *SetInterestsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClientInfoRequest) ProtoMessage() {}
+func (*SetInterestsResponse) ProtoMessage() {}
 
-func (x *GetClientInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[19]
+func (x *SetInterestsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[185]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1606,32 +11832,46 @@ func (x *GetClientInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClientInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetClientInfoRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use SetInterestsResponse.ProtoReflect.Descriptor instead.
+func (*SetInterestsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{185}
 }
 
-type GetClientInfoResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *SetInterestsResponse) GetInterests() []string {
+	if x != nil {
+		return x.Interests
+	}
+	return nil
+}
+
+// SimilarUserInfo is an online user whose interests overlap with the client's.
+type SimilarUserInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user's username.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The interests the user shares with the client.
+	SharedInterests []string `protobuf:"bytes,2,rep,name=shared_interests,json=sharedInterests,proto3" json:"shared_interests,omitempty"`
+	// The total number of interests the user published.
+	InterestCount uint32 `protobuf:"varint,3,opt,name=interest_count,json=interestCount,proto3" json:"interest_count,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetClientInfoResponse) Reset() {
-	*x = GetClientInfoResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[20]
+func (x *SimilarUserInfo) Reset() {
+	*x = SimilarUserInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[186]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetClientInfoResponse) String() string {
+func (x *SimilarUserInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetClientInfoResponse) ProtoMessage() {}
+func (*SimilarUserInfo) ProtoMessage() {}
 
-func (x *GetClientInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[20]
+func (x *SimilarUserInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[186]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1642,32 +11882,58 @@ func (x *GetClientInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetClientInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetClientInfoResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{20}
+// Deprecated: Use SimilarUserInfo.ProtoReflect.Descriptor instead.
+func (*SimilarUserInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{186}
 }
 
-type GetServersRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *SimilarUserInfo) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SimilarUserInfo) GetSharedInterests() []string {
+	if x != nil {
+		return x.SharedInterests
+	}
+	return nil
+}
+
+func (x *SimilarUserInfo) GetInterestCount() uint32 {
+	if x != nil {
+		return x.InterestCount
+	}
+	return 0
+}
+
+type GetSimilarUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The maximum number of users to return.
+	// If unspecified or 0, the server picks a default.
+	Limit         *uint32 `protobuf:"varint,2,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetServersRequest) Reset() {
-	*x = GetServersRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[21]
+func (x *GetSimilarUsersRequest) Reset() {
+	*x = GetSimilarUsersRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[187]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetServersRequest) String() string {
+func (x *GetSimilarUsersRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServersRequest) ProtoMessage() {}
+func (*GetSimilarUsersRequest) ProtoMessage() {}
 
-func (x *GetServersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[21]
+func (x *GetSimilarUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[187]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1678,34 +11944,48 @@ func (x *GetServersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServersRequest.ProtoReflect.Descriptor instead.
-func (*GetServersRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{21}
+// Deprecated: Use GetSimilarUsersRequest.ProtoReflect.Descriptor instead.
+func (*GetSimilarUsersRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{187}
 }
 
-type GetServersResponse struct {
+func (x *GetSimilarUsersRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *GetSimilarUsersRequest) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+type GetSimilarUsersResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The server records.
-	Servers       []*ServerInfo `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// The users, ordered by the number of shared interests, most first.
+	Users         []*SimilarUserInfo `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetServersResponse) Reset() {
-	*x = GetServersResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[22]
+func (x *GetSimilarUsersResponse) Reset() {
+	*x = GetSimilarUsersResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[188]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetServersResponse) String() string {
+func (x *GetSimilarUsersResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServersResponse) ProtoMessage() {}
+func (*GetSimilarUsersResponse) ProtoMessage() {}
 
-func (x *GetServersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[22]
+func (x *GetSimilarUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[188]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1716,49 +11996,49 @@ func (x *GetServersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServersResponse.ProtoReflect.Descriptor instead.
-func (*GetServersResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use GetSimilarUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetSimilarUsersResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{188}
 }
 
-func (x *GetServersResponse) GetServers() []*ServerInfo {
+func (x *GetSimilarUsersResponse) GetUsers() []*SimilarUserInfo {
 	if x != nil {
-		return x.Servers
+		return x.Users
 	}
 	return nil
 }
 
-type CreateServerRequest struct {
+// ConnWindow is a time window during which a server connection is allowed.
+// Times are in the client's local time zone.
+type ConnWindow struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The name given to the server record.
-	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// The server's address.
-	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
-	// The room to connect to.
-	Room string `protobuf:"bytes,3,opt,name=room,proto3" json:"room,omitempty"`
-	// The username to use.
-	Username string `protobuf:"bytes,4,opt,name=username,proto3" json:"username,omitempty"`
-	// The password to use.
-	Password      string `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	// The days of the week the window starts on, as a bit mask where bit 0 is Sunday and bit 6 is Saturday.
+	// 0 means every day.
+	Weekdays uint32 `protobuf:"varint,1,opt,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// The minute of the day the window starts at, from 0 to 1439.
+	StartMinute uint32 `protobuf:"varint,2,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	// The minute of the day the window ends at, from 0 to 1439.
+	// If it is not after start_minute, the window ends on the next day.
+	EndMinute     uint32 `protobuf:"varint,3,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateServerRequest) Reset() {
-	*x = CreateServerRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[23]
+func (x *ConnWindow) Reset() {
+	*x = ConnWindow{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[189]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateServerRequest) String() string {
+func (x *ConnWindow) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateServerRequest) ProtoMessage() {}
+func (*ConnWindow) ProtoMessage() {}
 
-func (x *CreateServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[23]
+func (x *ConnWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[189]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1769,69 +12049,103 @@ func (x *CreateServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateServerRequest.ProtoReflect.Descriptor instead.
-func (*CreateServerRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use ConnWindow.ProtoReflect.Descriptor instead.
+func (*ConnWindow) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{189}
 }
 
-func (x *CreateServerRequest) GetName() string {
+func (x *ConnWindow) GetWeekdays() uint32 {
 	if x != nil {
-		return x.Name
+		return x.Weekdays
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateServerRequest) GetAddress() string {
+func (x *ConnWindow) GetStartMinute() uint32 {
 	if x != nil {
-		return x.Address
+		return x.StartMinute
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateServerRequest) GetRoom() string {
+func (x *ConnWindow) GetEndMinute() uint32 {
 	if x != nil {
-		return x.Room
+		return x.EndMinute
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateServerRequest) GetUsername() string {
+type GetServerScheduleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's UUID.
+	ServerUuid    string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerScheduleRequest) Reset() {
+	*x = GetServerScheduleRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[190]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerScheduleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerScheduleRequest) ProtoMessage() {}
+
+func (x *GetServerScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[190]
 	if x != nil {
-		return x.Username
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *CreateServerRequest) GetPassword() string {
+// Deprecated: Use GetServerScheduleRequest.ProtoReflect.Descriptor instead.
+func (*GetServerScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{190}
+}
+
+func (x *GetServerScheduleRequest) GetServerUuid() string {
 	if x != nil {
-		return x.Password
+		return x.ServerUuid
 	}
 	return ""
 }
 
-type CreateServerResponse struct {
+type GetServerScheduleResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The newly created server record.
-	Server        *ServerInfo `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+	// The windows during which the connection is allowed.
+	// If empty, connecting is allowed at any time.
+	Windows []*ConnWindow `protobuf:"bytes,1,rep,name=windows,proto3" json:"windows,omitempty"`
+	// Whether the schedule allows connecting right now.
+	AllowedNow    bool `protobuf:"varint,2,opt,name=allowed_now,json=allowedNow,proto3" json:"allowed_now,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateServerResponse) Reset() {
-	*x = CreateServerResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[24]
+func (x *GetServerScheduleResponse) Reset() {
+	*x = GetServerScheduleResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[191]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateServerResponse) String() string {
+func (x *GetServerScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateServerResponse) ProtoMessage() {}
+func (*GetServerScheduleResponse) ProtoMessage() {}
 
-func (x *CreateServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[24]
+func (x *GetServerScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[191]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1842,41 +12156,51 @@ func (x *CreateServerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateServerResponse.ProtoReflect.Descriptor instead.
-func (*CreateServerResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use GetServerScheduleResponse.ProtoReflect.Descriptor instead.
+func (*GetServerScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{191}
 }
 
-func (x *CreateServerResponse) GetServer() *ServerInfo {
+func (x *GetServerScheduleResponse) GetWindows() []*ConnWindow {
 	if x != nil {
-		return x.Server
+		return x.Windows
 	}
 	return nil
 }
 
-type DeleteServerRequest struct {
+func (x *GetServerScheduleResponse) GetAllowedNow() bool {
+	if x != nil {
+		return x.AllowedNow
+	}
+	return false
+}
+
+type SetServerScheduleRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The server's UUID.
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The windows during which the connection is allowed.
+	// If empty, connecting is allowed at any time.
+	Windows       []*ConnWindow `protobuf:"bytes,2,rep,name=windows,proto3" json:"windows,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteServerRequest) Reset() {
-	*x = DeleteServerRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[25]
+func (x *SetServerScheduleRequest) Reset() {
+	*x = SetServerScheduleRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[192]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteServerRequest) String() string {
+func (x *SetServerScheduleRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteServerRequest) ProtoMessage() {}
+func (*SetServerScheduleRequest) ProtoMessage() {}
 
-func (x *DeleteServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[25]
+func (x *SetServerScheduleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[192]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1887,39 +12211,46 @@ func (x *DeleteServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteServerRequest.ProtoReflect.Descriptor instead.
-func (*DeleteServerRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use SetServerScheduleRequest.ProtoReflect.Descriptor instead.
+func (*SetServerScheduleRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{192}
 }
 
-func (x *DeleteServerRequest) GetUuid() string {
+func (x *SetServerScheduleRequest) GetServerUuid() string {
 	if x != nil {
-		return x.Uuid
+		return x.ServerUuid
 	}
 	return ""
 }
 
-type DeleteServerResponse struct {
+func (x *SetServerScheduleRequest) GetWindows() []*ConnWindow {
+	if x != nil {
+		return x.Windows
+	}
+	return nil
+}
+
+type SetServerScheduleResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteServerResponse) Reset() {
-	*x = DeleteServerResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[26]
+func (x *SetServerScheduleResponse) Reset() {
+	*x = SetServerScheduleResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[193]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteServerResponse) String() string {
+func (x *SetServerScheduleResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteServerResponse) ProtoMessage() {}
+func (*SetServerScheduleResponse) ProtoMessage() {}
 
-func (x *DeleteServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[26]
+func (x *SetServerScheduleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[193]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1930,34 +12261,42 @@ func (x *DeleteServerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteServerResponse.ProtoReflect.Descriptor instead.
-func (*DeleteServerResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use SetServerScheduleResponse.ProtoReflect.Descriptor instead.
+func (*SetServerScheduleResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{193}
 }
 
-type ConnectServerRequest struct {
+// SnoozeInfo is the state of the client's snooze.
+// While snoozed, all uploads and downloads on every server are paused.
+type SnoozeInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The server's UUID.
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// Whether the client is snoozed.
+	Active bool `protobuf:"varint,1,opt,name=active,proto3" json:"active,omitempty"`
+	// The UNIX timestamp, in seconds, at which the snooze ends.
+	// If unset while active, the snooze lasts until it is ended with Unsnooze.
+	UntilTs *int64 `protobuf:"varint,2,opt,name=until_ts,json=untilTs,proto3,oneof" json:"until_ts,omitempty"`
+	// Whether shares are hidden from peers while snoozed.
+	// Peers see no shares and get no search results.
+	HideShares    bool `protobuf:"varint,3,opt,name=hide_shares,json=hideShares,proto3" json:"hide_shares,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectServerRequest) Reset() {
-	*x = ConnectServerRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[27]
+func (x *SnoozeInfo) Reset() {
+	*x = SnoozeInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[194]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectServerRequest) String() string {
+func (x *SnoozeInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectServerRequest) ProtoMessage() {}
+func (*SnoozeInfo) ProtoMessage() {}
 
-func (x *ConnectServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[27]
+func (x *SnoozeInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[194]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1968,39 +12307,53 @@ func (x *ConnectServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectServerRequest.ProtoReflect.Descriptor instead.
-func (*ConnectServerRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use SnoozeInfo.ProtoReflect.Descriptor instead.
+func (*SnoozeInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{194}
 }
 
-func (x *ConnectServerRequest) GetUuid() string {
+func (x *SnoozeInfo) GetActive() bool {
 	if x != nil {
-		return x.Uuid
+		return x.Active
 	}
-	return ""
+	return false
 }
 
-type ConnectServerResponse struct {
+func (x *SnoozeInfo) GetUntilTs() int64 {
+	if x != nil && x.UntilTs != nil {
+		return *x.UntilTs
+	}
+	return 0
+}
+
+func (x *SnoozeInfo) GetHideShares() bool {
+	if x != nil {
+		return x.HideShares
+	}
+	return false
+}
+
+type GetSnoozeRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ConnectServerResponse) Reset() {
-	*x = ConnectServerResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[28]
+func (x *GetSnoozeRequest) Reset() {
+	*x = GetSnoozeRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[195]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ConnectServerResponse) String() string {
+func (x *GetSnoozeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ConnectServerResponse) ProtoMessage() {}
+func (*GetSnoozeRequest) ProtoMessage() {}
 
-func (x *ConnectServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[28]
+func (x *GetSnoozeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[195]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2011,34 +12364,33 @@ func (x *ConnectServerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ConnectServerResponse.ProtoReflect.Descriptor instead.
-func (*ConnectServerResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use GetSnoozeRequest.ProtoReflect.Descriptor instead.
+func (*GetSnoozeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{195}
 }
 
-type DisconnectServerRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The server's UUID.
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+type GetSnoozeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snooze        *SnoozeInfo            `protobuf:"bytes,1,opt,name=snooze,proto3" json:"snooze,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisconnectServerRequest) Reset() {
-	*x = DisconnectServerRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[29]
+func (x *GetSnoozeResponse) Reset() {
+	*x = GetSnoozeResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[196]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisconnectServerRequest) String() string {
+func (x *GetSnoozeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectServerRequest) ProtoMessage() {}
+func (*GetSnoozeResponse) ProtoMessage() {}
 
-func (x *DisconnectServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[29]
+func (x *GetSnoozeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[196]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2049,39 +12401,44 @@ func (x *DisconnectServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectServerRequest.ProtoReflect.Descriptor instead.
-func (*DisconnectServerRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use GetSnoozeResponse.ProtoReflect.Descriptor instead.
+func (*GetSnoozeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{196}
 }
 
-func (x *DisconnectServerRequest) GetUuid() string {
+func (x *GetSnoozeResponse) GetSnooze() *SnoozeInfo {
 	if x != nil {
-		return x.Uuid
+		return x.Snooze
 	}
-	return ""
+	return nil
 }
 
-type DisconnectServerResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type SnoozeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How long to snooze for, in seconds.
+	// If unset, the snooze lasts until it is ended with Unsnooze.
+	DurationSeconds *uint32 `protobuf:"varint,1,opt,name=duration_seconds,json=durationSeconds,proto3,oneof" json:"duration_seconds,omitempty"`
+	// Whether to hide shares from peers while snoozed.
+	HideShares    bool `protobuf:"varint,2,opt,name=hide_shares,json=hideShares,proto3" json:"hide_shares,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DisconnectServerResponse) Reset() {
-	*x = DisconnectServerResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[30]
+func (x *SnoozeRequest) Reset() {
+	*x = SnoozeRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[197]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DisconnectServerResponse) String() string {
+func (x *SnoozeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DisconnectServerResponse) ProtoMessage() {}
+func (*SnoozeRequest) ProtoMessage() {}
 
-func (x *DisconnectServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[30]
+func (x *SnoozeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[197]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2092,44 +12449,47 @@ func (x *DisconnectServerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DisconnectServerResponse.ProtoReflect.Descriptor instead.
-func (*DisconnectServerResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{30}
+// Deprecated: Use SnoozeRequest.ProtoReflect.Descriptor instead.
+func (*SnoozeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{197}
 }
 
-type UpdateServerRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The server's UUID.
-	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
-	// The new name, if any.
-	Name *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
-	// The new address, if any.
-	Address *string `protobuf:"bytes,3,opt,name=address,proto3,oneof" json:"address,omitempty"`
-	// The new room, if any.
-	Room *string `protobuf:"bytes,4,opt,name=room,proto3,oneof" json:"room,omitempty"`
-	// The new username, if any.
-	Username *string `protobuf:"bytes,5,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// The new password, if any.
-	Password      *string `protobuf:"bytes,6,opt,name=password,proto3,oneof" json:"password,omitempty"`
+func (x *SnoozeRequest) GetDurationSeconds() uint32 {
+	if x != nil && x.DurationSeconds != nil {
+		return *x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *SnoozeRequest) GetHideShares() bool {
+	if x != nil {
+		return x.HideShares
+	}
+	return false
+}
+
+type SnoozeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Snooze        *SnoozeInfo            `protobuf:"bytes,1,opt,name=snooze,proto3" json:"snooze,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateServerRequest) Reset() {
-	*x = UpdateServerRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[31]
+func (x *SnoozeResponse) Reset() {
+	*x = SnoozeResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[198]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateServerRequest) String() string {
+func (x *SnoozeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateServerRequest) ProtoMessage() {}
+func (*SnoozeResponse) ProtoMessage() {}
 
-func (x *UpdateServerRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[31]
+func (x *SnoozeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[198]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2140,76 +12500,75 @@ func (x *UpdateServerRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateServerRequest.ProtoReflect.Descriptor instead.
-func (*UpdateServerRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use SnoozeResponse.ProtoReflect.Descriptor instead.
+func (*SnoozeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{198}
 }
 
-func (x *UpdateServerRequest) GetUuid() string {
+func (x *SnoozeResponse) GetSnooze() *SnoozeInfo {
 	if x != nil {
-		return x.Uuid
+		return x.Snooze
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateServerRequest) GetName() string {
-	if x != nil && x.Name != nil {
-		return *x.Name
-	}
-	return ""
+type UnsnoozeRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateServerRequest) GetAddress() string {
-	if x != nil && x.Address != nil {
-		return *x.Address
-	}
-	return ""
+func (x *UnsnoozeRequest) Reset() {
+	*x = UnsnoozeRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[199]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateServerRequest) GetRoom() string {
-	if x != nil && x.Room != nil {
-		return *x.Room
-	}
-	return ""
+func (x *UnsnoozeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *UpdateServerRequest) GetUsername() string {
-	if x != nil && x.Username != nil {
-		return *x.Username
+func (*UnsnoozeRequest) ProtoMessage() {}
+
+func (x *UnsnoozeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[199]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *UpdateServerRequest) GetPassword() string {
-	if x != nil && x.Password != nil {
-		return *x.Password
-	}
-	return ""
+// Deprecated: Use UnsnoozeRequest.ProtoReflect.Descriptor instead.
+func (*UnsnoozeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{199}
 }
 
-type UpdateServerResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The server after update.
-	Server        *ServerInfo `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
+type UnsnoozeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateServerResponse) Reset() {
-	*x = UpdateServerResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[32]
+func (x *UnsnoozeResponse) Reset() {
+	*x = UnsnoozeResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[200]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateServerResponse) String() string {
+func (x *UnsnoozeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateServerResponse) ProtoMessage() {}
+func (*UnsnoozeResponse) ProtoMessage() {}
 
-func (x *UpdateServerResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[32]
+func (x *UnsnoozeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[200]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2220,41 +12579,44 @@ func (x *UpdateServerResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateServerResponse.ProtoReflect.Descriptor instead.
-func (*UpdateServerResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{32}
-}
-
-func (x *UpdateServerResponse) GetServer() *ServerInfo {
-	if x != nil {
-		return x.Server
-	}
-	return nil
+// Deprecated: Use UnsnoozeResponse.ProtoReflect.Descriptor instead.
+func (*UnsnoozeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{200}
 }
 
-type GetSharesRequest struct {
+// RunSessionInfo is information about a run of the client, from when it started to when it stopped.
+type RunSessionInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The UUID of the server to get shares for.
-	ServerUuid    string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The run's UUID.
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The UNIX timestamp when the client started.
+	StartedTs int64 `protobuf:"varint,2,opt,name=started_ts,json=startedTs,proto3" json:"started_ts,omitempty"`
+	// The UNIX timestamp when the client stopped, if it has.
+	// If the client crashed, this is the last time it was known to be running.
+	StoppedTs *int64 `protobuf:"varint,3,opt,name=stopped_ts,json=stoppedTs,proto3,oneof" json:"stopped_ts,omitempty"`
+	// Whether the client stopped without shutting down cleanly, such as when it crashed or the computer lost power.
+	Crashed bool `protobuf:"varint,4,opt,name=crashed,proto3" json:"crashed,omitempty"`
+	// Whether this is the current run.
+	IsCurrent     bool `protobuf:"varint,5,opt,name=is_current,json=isCurrent,proto3" json:"is_current,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetSharesRequest) Reset() {
-	*x = GetSharesRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[33]
+func (x *RunSessionInfo) Reset() {
+	*x = RunSessionInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[201]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSharesRequest) String() string {
+func (x *RunSessionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSharesRequest) ProtoMessage() {}
+func (*RunSessionInfo) ProtoMessage() {}
 
-func (x *GetSharesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[33]
+func (x *RunSessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[201]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2265,41 +12627,83 @@ func (x *GetSharesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSharesRequest.ProtoReflect.Descriptor instead.
-func (*GetSharesRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use RunSessionInfo.ProtoReflect.Descriptor instead.
+func (*RunSessionInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{201}
 }
 
-func (x *GetSharesRequest) GetServerUuid() string {
+func (x *RunSessionInfo) GetUuid() string {
 	if x != nil {
-		return x.ServerUuid
+		return x.Uuid
 	}
 	return ""
 }
 
-type GetSharesResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The shares.
-	Shares        []*ShareInfo `protobuf:"bytes,1,rep,name=shares,proto3" json:"shares,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *RunSessionInfo) GetStartedTs() int64 {
+	if x != nil {
+		return x.StartedTs
+	}
+	return 0
 }
 
-func (x *GetSharesResponse) Reset() {
-	*x = GetSharesResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[34]
+func (x *RunSessionInfo) GetStoppedTs() int64 {
+	if x != nil && x.StoppedTs != nil {
+		return *x.StoppedTs
+	}
+	return 0
+}
+
+func (x *RunSessionInfo) GetCrashed() bool {
+	if x != nil {
+		return x.Crashed
+	}
+	return false
+}
+
+func (x *RunSessionInfo) GetIsCurrent() bool {
+	if x != nil {
+		return x.IsCurrent
+	}
+	return false
+}
+
+// ConnSessionInfo is information about a connection to a server, from when it opened to when it closed.
+type ConnSessionInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The connection session's UUID.
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The UUID of the run the connection was made in.
+	RunUuid string `protobuf:"bytes,2,opt,name=run_uuid,json=runUuid,proto3" json:"run_uuid,omitempty"`
+	// The UUID of the server.
+	ServerUuid string `protobuf:"bytes,3,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The UNIX timestamp when the connection opened.
+	ConnectedTs int64 `protobuf:"varint,4,opt,name=connected_ts,json=connectedTs,proto3" json:"connected_ts,omitempty"`
+	// The UNIX timestamp when the connection closed, if it has.
+	DisconnectedTs *int64 `protobuf:"varint,5,opt,name=disconnected_ts,json=disconnectedTs,proto3,oneof" json:"disconnected_ts,omitempty"`
+	// How long the connection was open, in seconds.
+	// For connections that are still open, this is how long they have been open so far.
+	DurationSeconds int64 `protobuf:"varint,6,opt,name=duration_seconds,json=durationSeconds,proto3" json:"duration_seconds,omitempty"`
+	// Why the connection closed, if it has, such as "connection lost" or "closed by server: ...".
+	DisconnectReason *string `protobuf:"bytes,7,opt,name=disconnect_reason,json=disconnectReason,proto3,oneof" json:"disconnect_reason,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ConnSessionInfo) Reset() {
+	*x = ConnSessionInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[202]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetSharesResponse) String() string {
+func (x *ConnSessionInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetSharesResponse) ProtoMessage() {}
+func (*ConnSessionInfo) ProtoMessage() {}
 
-func (x *GetSharesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[34]
+func (x *ConnSessionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[202]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2310,47 +12714,84 @@ func (x *GetSharesResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetSharesResponse.ProtoReflect.Descriptor instead.
-func (*GetSharesResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use ConnSessionInfo.ProtoReflect.Descriptor instead.
+func (*ConnSessionInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{202}
 }
 
-func (x *GetSharesResponse) GetShares() []*ShareInfo {
+func (x *ConnSessionInfo) GetUuid() string {
 	if x != nil {
-		return x.Shares
+		return x.Uuid
 	}
-	return nil
+	return ""
 }
 
-type CreateShareRequest struct {
+func (x *ConnSessionInfo) GetRunUuid() string {
+	if x != nil {
+		return x.RunUuid
+	}
+	return ""
+}
+
+func (x *ConnSessionInfo) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *ConnSessionInfo) GetConnectedTs() int64 {
+	if x != nil {
+		return x.ConnectedTs
+	}
+	return 0
+}
+
+func (x *ConnSessionInfo) GetDisconnectedTs() int64 {
+	if x != nil && x.DisconnectedTs != nil {
+		return *x.DisconnectedTs
+	}
+	return 0
+}
+
+func (x *ConnSessionInfo) GetDurationSeconds() int64 {
+	if x != nil {
+		return x.DurationSeconds
+	}
+	return 0
+}
+
+func (x *ConnSessionInfo) GetDisconnectReason() string {
+	if x != nil && x.DisconnectReason != nil {
+		return *x.DisconnectReason
+	}
+	return ""
+}
+
+type GetRunHistoryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The UUID of the associated server.
-	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
-	// The share's name.
-	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// The share's path on disk.
-	Path string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	// Whether to follow links.
-	FollowLinks   bool `protobuf:"varint,4,opt,name=follow_links,json=followLinks,proto3" json:"follow_links,omitempty"`
+	// The maximum number of runs to return.
+	// 0 means 100.
+	Limit         uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateShareRequest) Reset() {
-	*x = CreateShareRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[35]
+func (x *GetRunHistoryRequest) Reset() {
+	*x = GetRunHistoryRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[203]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateShareRequest) String() string {
+func (x *GetRunHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateShareRequest) ProtoMessage() {}
+func (*GetRunHistoryRequest) ProtoMessage() {}
 
-func (x *CreateShareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[35]
+func (x *GetRunHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[203]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2361,62 +12802,41 @@ func (x *CreateShareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateShareRequest.ProtoReflect.Descriptor instead.
-func (*CreateShareRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{35}
-}
-
-func (x *CreateShareRequest) GetServerUuid() string {
-	if x != nil {
-		return x.ServerUuid
-	}
-	return ""
-}
-
-func (x *CreateShareRequest) GetName() string {
-	if x != nil {
-		return x.Name
-	}
-	return ""
-}
-
-func (x *CreateShareRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use GetRunHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetRunHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{203}
 }
 
-func (x *CreateShareRequest) GetFollowLinks() bool {
+func (x *GetRunHistoryRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.FollowLinks
+		return x.Limit
 	}
-	return false
+	return 0
 }
 
-type CreateShareResponse struct {
+type GetRunHistoryResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The newly created share.
-	Share         *ShareInfo `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	// Runs of the client, newest first, including the current one.
+	Runs          []*RunSessionInfo `protobuf:"bytes,1,rep,name=runs,proto3" json:"runs,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateShareResponse) Reset() {
-	*x = CreateShareResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[36]
+func (x *GetRunHistoryResponse) Reset() {
+	*x = GetRunHistoryResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[204]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateShareResponse) String() string {
+func (x *GetRunHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateShareResponse) ProtoMessage() {}
+func (*GetRunHistoryResponse) ProtoMessage() {}
 
-func (x *CreateShareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[36]
+func (x *GetRunHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[204]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2427,43 +12847,45 @@ func (x *CreateShareResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateShareResponse.ProtoReflect.Descriptor instead.
-func (*CreateShareResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use GetRunHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetRunHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{204}
 }
 
-func (x *CreateShareResponse) GetShare() *ShareInfo {
+func (x *GetRunHistoryResponse) GetRuns() []*RunSessionInfo {
 	if x != nil {
-		return x.Share
+		return x.Runs
 	}
 	return nil
 }
 
-type DeleteShareRequest struct {
+type GetConnHistoryRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The associated server UUID.
+	// The UUID of the server to return connections for.
+	// Empty to return connections for all servers.
 	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
-	// The share's name.
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The maximum number of connections to return.
+	// 0 means 100.
+	Limit         uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteShareRequest) Reset() {
-	*x = DeleteShareRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[37]
+func (x *GetConnHistoryRequest) Reset() {
+	*x = GetConnHistoryRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[205]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteShareRequest) String() string {
+func (x *GetConnHistoryRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteShareRequest) ProtoMessage() {}
+func (*GetConnHistoryRequest) ProtoMessage() {}
 
-func (x *DeleteShareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[37]
+func (x *GetConnHistoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[205]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2474,46 +12896,48 @@ func (x *DeleteShareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteShareRequest.ProtoReflect.Descriptor instead.
-func (*DeleteShareRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use GetConnHistoryRequest.ProtoReflect.Descriptor instead.
+func (*GetConnHistoryRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{205}
 }
 
-func (x *DeleteShareRequest) GetServerUuid() string {
+func (x *GetConnHistoryRequest) GetServerUuid() string {
 	if x != nil {
 		return x.ServerUuid
 	}
 	return ""
 }
 
-func (x *DeleteShareRequest) GetName() string {
+func (x *GetConnHistoryRequest) GetLimit() uint32 {
 	if x != nil {
-		return x.Name
+		return x.Limit
 	}
-	return ""
+	return 0
 }
 
-type DeleteShareResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type GetConnHistoryResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Connections to servers, newest first.
+	Sessions      []*ConnSessionInfo `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteShareResponse) Reset() {
-	*x = DeleteShareResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[38]
+func (x *GetConnHistoryResponse) Reset() {
+	*x = GetConnHistoryResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[206]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteShareResponse) String() string {
+func (x *GetConnHistoryResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteShareResponse) ProtoMessage() {}
+func (*GetConnHistoryResponse) ProtoMessage() {}
 
-func (x *DeleteShareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[38]
+func (x *GetConnHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[206]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2524,38 +12948,56 @@ func (x *DeleteShareResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteShareResponse.ProtoReflect.Descriptor instead.
-func (*DeleteShareResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use GetConnHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetConnHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{206}
 }
 
-type GetDirFilesRequest struct {
+func (x *GetConnHistoryResponse) GetSessions() []*ConnSessionInfo {
+	if x != nil {
+		return x.Sessions
+	}
+	return nil
+}
+
+// TrashedServer is a deleted server that can still be restored.
+type TrashedServer struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The server's UUID.
-	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
-	// The online user's username.
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	// The path to get the contents of.
-	Path          string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	Uuid string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The name given to the server.
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The server's address.
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	// The room to connect to.
+	Room string `protobuf:"bytes,4,opt,name=room,proto3" json:"room,omitempty"`
+	// The username to use for authentication.
+	Username string `protobuf:"bytes,5,opt,name=username,proto3" json:"username,omitempty"`
+	// The UNIX timestamp when the server was created.
+	CreatedTs int64 `protobuf:"varint,6,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// The UNIX timestamp when the server was deleted.
+	DeletedTs int64 `protobuf:"varint,7,opt,name=deleted_ts,json=deletedTs,proto3" json:"deleted_ts,omitempty"`
+	// The UNIX timestamp after which the server is purged for good.
+	PurgeTs       int64 `protobuf:"varint,8,opt,name=purge_ts,json=purgeTs,proto3" json:"purge_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDirFilesRequest) Reset() {
-	*x = GetDirFilesRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[39]
+func (x *TrashedServer) Reset() {
+	*x = TrashedServer{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[207]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDirFilesRequest) String() string {
+func (x *TrashedServer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDirFilesRequest) ProtoMessage() {}
+func (*TrashedServer) ProtoMessage() {}
 
-func (x *GetDirFilesRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[39]
+func (x *TrashedServer) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[207]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2566,104 +13008,95 @@ func (x *GetDirFilesRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDirFilesRequest.ProtoReflect.Descriptor instead.
-func (*GetDirFilesRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use TrashedServer.ProtoReflect.Descriptor instead.
+func (*TrashedServer) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{207}
 }
 
-func (x *GetDirFilesRequest) GetServerUuid() string {
+func (x *TrashedServer) GetUuid() string {
 	if x != nil {
-		return x.ServerUuid
+		return x.Uuid
 	}
 	return ""
 }
 
-func (x *GetDirFilesRequest) GetUsername() string {
+func (x *TrashedServer) GetName() string {
 	if x != nil {
-		return x.Username
+		return x.Name
 	}
 	return ""
 }
 
-func (x *GetDirFilesRequest) GetPath() string {
+func (x *TrashedServer) GetAddress() string {
 	if x != nil {
-		return x.Path
+		return x.Address
 	}
 	return ""
 }
 
-type GetDirFilesResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The directory's files.
-	Content       []*FileMeta `protobuf:"bytes,2,rep,name=content,proto3" json:"content,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetDirFilesResponse) Reset() {
-	*x = GetDirFilesResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[40]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *TrashedServer) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
 }
 
-func (x *GetDirFilesResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *TrashedServer) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
 }
 
-func (*GetDirFilesResponse) ProtoMessage() {}
-
-func (x *GetDirFilesResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[40]
+func (x *TrashedServer) GetCreatedTs() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedTs
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetDirFilesResponse.ProtoReflect.Descriptor instead.
-func (*GetDirFilesResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{40}
+func (x *TrashedServer) GetDeletedTs() int64 {
+	if x != nil {
+		return x.DeletedTs
+	}
+	return 0
 }
 
-func (x *GetDirFilesResponse) GetContent() []*FileMeta {
+func (x *TrashedServer) GetPurgeTs() int64 {
 	if x != nil {
-		return x.Content
+		return x.PurgeTs
 	}
-	return nil
+	return 0
 }
 
-type GetFileMetaRequest struct {
+// TrashedShare is a deleted share that can still be restored.
+type TrashedShare struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The server's UUID.
-	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
-	// The online user's username.
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	// The path to get the contents of.
-	Path          string `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	// The share's info.
+	Share *ShareInfo `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
+	// The UNIX timestamp when the share was deleted.
+	DeletedTs int64 `protobuf:"varint,2,opt,name=deleted_ts,json=deletedTs,proto3" json:"deleted_ts,omitempty"`
+	// The UNIX timestamp after which the share is purged for good.
+	PurgeTs       int64 `protobuf:"varint,3,opt,name=purge_ts,json=purgeTs,proto3" json:"purge_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFileMetaRequest) Reset() {
-	*x = GetFileMetaRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[41]
+func (x *TrashedShare) Reset() {
+	*x = TrashedShare{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[208]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFileMetaRequest) String() string {
+func (x *TrashedShare) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileMetaRequest) ProtoMessage() {}
+func (*TrashedShare) ProtoMessage() {}
 
-func (x *GetFileMetaRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[41]
+func (x *TrashedShare) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[208]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2674,55 +13107,94 @@ func (x *GetFileMetaRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileMetaRequest.ProtoReflect.Descriptor instead.
-func (*GetFileMetaRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use TrashedShare.ProtoReflect.Descriptor instead.
+func (*TrashedShare) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{208}
 }
 
-func (x *GetFileMetaRequest) GetServerUuid() string {
+func (x *TrashedShare) GetShare() *ShareInfo {
 	if x != nil {
-		return x.ServerUuid
+		return x.Share
 	}
-	return ""
+	return nil
+}
+
+func (x *TrashedShare) GetDeletedTs() int64 {
+	if x != nil {
+		return x.DeletedTs
+	}
+	return 0
+}
+
+func (x *TrashedShare) GetPurgeTs() int64 {
+	if x != nil {
+		return x.PurgeTs
+	}
+	return 0
+}
+
+type GetTrashRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTrashRequest) Reset() {
+	*x = GetTrashRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[209]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTrashRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *GetFileMetaRequest) GetUsername() string {
+func (*GetTrashRequest) ProtoMessage() {}
+
+func (x *GetTrashRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[209]
 	if x != nil {
-		return x.Username
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *GetFileMetaRequest) GetPath() string {
-	if x != nil {
-		return x.Path
-	}
-	return ""
+// Deprecated: Use GetTrashRequest.ProtoReflect.Descriptor instead.
+func (*GetTrashRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{209}
 }
 
-type GetFileMetaResponse struct {
+type GetTrashResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The file's metadata.
-	Meta          *FileMeta `protobuf:"bytes,1,opt,name=meta,proto3" json:"meta,omitempty"`
+	// Deleted servers, most recently deleted first.
+	Servers []*TrashedServer `protobuf:"bytes,1,rep,name=servers,proto3" json:"servers,omitempty"`
+	// Deleted shares, most recently deleted first.
+	// Shares of deleted servers are not included, since they are restored or purged along with their server.
+	Shares        []*TrashedShare `protobuf:"bytes,2,rep,name=shares,proto3" json:"shares,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetFileMetaResponse) Reset() {
-	*x = GetFileMetaResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[42]
+func (x *GetTrashResponse) Reset() {
+	*x = GetTrashResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[210]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetFileMetaResponse) String() string {
+func (x *GetTrashResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetFileMetaResponse) ProtoMessage() {}
+func (*GetTrashResponse) ProtoMessage() {}
 
-func (x *GetFileMetaResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[42]
+func (x *GetTrashResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[210]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2733,41 +13205,48 @@ func (x *GetFileMetaResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetFileMetaResponse.ProtoReflect.Descriptor instead.
-func (*GetFileMetaResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use GetTrashResponse.ProtoReflect.Descriptor instead.
+func (*GetTrashResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{210}
 }
 
-func (x *GetFileMetaResponse) GetMeta() *FileMeta {
+func (x *GetTrashResponse) GetServers() []*TrashedServer {
 	if x != nil {
-		return x.Meta
+		return x.Servers
 	}
 	return nil
 }
 
-type GetOnlineUsersRequest struct {
+func (x *GetTrashResponse) GetShares() []*TrashedShare {
+	if x != nil {
+		return x.Shares
+	}
+	return nil
+}
+
+type RestoreServerRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The server's UUID.
-	ServerUuid    string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOnlineUsersRequest) Reset() {
-	*x = GetOnlineUsersRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[43]
+func (x *RestoreServerRequest) Reset() {
+	*x = RestoreServerRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[211]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOnlineUsersRequest) String() string {
+func (x *RestoreServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOnlineUsersRequest) ProtoMessage() {}
+func (*RestoreServerRequest) ProtoMessage() {}
 
-func (x *GetOnlineUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[43]
+func (x *RestoreServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[211]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2778,41 +13257,41 @@ func (x *GetOnlineUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOnlineUsersRequest.ProtoReflect.Descriptor instead.
-func (*GetOnlineUsersRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use RestoreServerRequest.ProtoReflect.Descriptor instead.
+func (*RestoreServerRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{211}
 }
 
-func (x *GetOnlineUsersRequest) GetServerUuid() string {
+func (x *RestoreServerRequest) GetUuid() string {
 	if x != nil {
-		return x.ServerUuid
+		return x.Uuid
 	}
 	return ""
 }
 
-type GetOnlineUsersResponse struct {
+type RestoreServerResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The users.
-	Users         []*OnlineUserInfo `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	// The restored server.
+	Server        *ServerInfo `protobuf:"bytes,1,opt,name=server,proto3" json:"server,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOnlineUsersResponse) Reset() {
-	*x = GetOnlineUsersResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[44]
+func (x *RestoreServerResponse) Reset() {
+	*x = RestoreServerResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[212]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOnlineUsersResponse) String() string {
+func (x *RestoreServerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOnlineUsersResponse) ProtoMessage() {}
+func (*RestoreServerResponse) ProtoMessage() {}
 
-func (x *GetOnlineUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[44]
+func (x *RestoreServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[212]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2823,45 +13302,41 @@ func (x *GetOnlineUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOnlineUsersResponse.ProtoReflect.Descriptor instead.
-func (*GetOnlineUsersResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{44}
+// Deprecated: Use RestoreServerResponse.ProtoReflect.Descriptor instead.
+func (*RestoreServerResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{212}
 }
 
-func (x *GetOnlineUsersResponse) GetUsers() []*OnlineUserInfo {
+func (x *RestoreServerResponse) GetServer() *ServerInfo {
 	if x != nil {
-		return x.Users
+		return x.Server
 	}
 	return nil
 }
 
-type ChangeAccountPasswordRequest struct {
+type PurgeServerRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The server's UUID.
-	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
-	// The account's current password.
-	CurrentPassword string `protobuf:"bytes,2,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
-	// The account's new password.
-	NewPassword   string `protobuf:"bytes,3,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeAccountPasswordRequest) Reset() {
-	*x = ChangeAccountPasswordRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[45]
+func (x *PurgeServerRequest) Reset() {
+	*x = PurgeServerRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[213]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeAccountPasswordRequest) String() string {
+func (x *PurgeServerRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAccountPasswordRequest) ProtoMessage() {}
+func (*PurgeServerRequest) ProtoMessage() {}
 
-func (x *ChangeAccountPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[45]
+func (x *PurgeServerRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[213]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2872,53 +13347,39 @@ func (x *ChangeAccountPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAccountPasswordRequest.ProtoReflect.Descriptor instead.
-func (*ChangeAccountPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *ChangeAccountPasswordRequest) GetServerUuid() string {
-	if x != nil {
-		return x.ServerUuid
-	}
-	return ""
-}
-
-func (x *ChangeAccountPasswordRequest) GetCurrentPassword() string {
-	if x != nil {
-		return x.CurrentPassword
-	}
-	return ""
+// Deprecated: Use PurgeServerRequest.ProtoReflect.Descriptor instead.
+func (*PurgeServerRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{213}
 }
 
-func (x *ChangeAccountPasswordRequest) GetNewPassword() string {
+func (x *PurgeServerRequest) GetUuid() string {
 	if x != nil {
-		return x.NewPassword
+		return x.Uuid
 	}
 	return ""
 }
 
-type ChangeAccountPasswordResponse struct {
+type PurgeServerResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ChangeAccountPasswordResponse) Reset() {
-	*x = ChangeAccountPasswordResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[46]
+func (x *PurgeServerResponse) Reset() {
+	*x = PurgeServerResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[214]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ChangeAccountPasswordResponse) String() string {
+func (x *PurgeServerResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChangeAccountPasswordResponse) ProtoMessage() {}
+func (*PurgeServerResponse) ProtoMessage() {}
 
-func (x *ChangeAccountPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[46]
+func (x *PurgeServerResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[214]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2929,34 +13390,36 @@ func (x *ChangeAccountPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChangeAccountPasswordResponse.ProtoReflect.Descriptor instead.
-func (*ChangeAccountPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use PurgeServerResponse.ProtoReflect.Descriptor instead.
+func (*PurgeServerResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{214}
 }
 
-type ServerConnectRequest struct {
+type RestoreShareRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The server's UUID.
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The associated server UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The share's name.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServerConnectRequest) Reset() {
-	*x = ServerConnectRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[47]
+func (x *RestoreShareRequest) Reset() {
+	*x = RestoreShareRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[215]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServerConnectRequest) String() string {
+func (x *RestoreShareRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerConnectRequest) ProtoMessage() {}
+func (*RestoreShareRequest) ProtoMessage() {}
 
-func (x *ServerConnectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[47]
+func (x *RestoreShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[215]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2967,39 +13430,48 @@ func (x *ServerConnectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerConnectRequest.ProtoReflect.Descriptor instead.
-func (*ServerConnectRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use RestoreShareRequest.ProtoReflect.Descriptor instead.
+func (*RestoreShareRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{215}
 }
 
-func (x *ServerConnectRequest) GetUuid() string {
+func (x *RestoreShareRequest) GetServerUuid() string {
 	if x != nil {
-		return x.Uuid
+		return x.ServerUuid
 	}
 	return ""
 }
 
-type ServerConnectResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *RestoreShareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type RestoreShareResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The restored share.
+	Share         *ShareInfo `protobuf:"bytes,1,opt,name=share,proto3" json:"share,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServerConnectResponse) Reset() {
-	*x = ServerConnectResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[48]
+func (x *RestoreShareResponse) Reset() {
+	*x = RestoreShareResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[216]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServerConnectResponse) String() string {
+func (x *RestoreShareResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerConnectResponse) ProtoMessage() {}
+func (*RestoreShareResponse) ProtoMessage() {}
 
-func (x *ServerConnectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[48]
+func (x *RestoreShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[216]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3010,34 +13482,43 @@ func (x *ServerConnectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerConnectResponse.ProtoReflect.Descriptor instead.
-func (*ServerConnectResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{48}
+// Deprecated: Use RestoreShareResponse.ProtoReflect.Descriptor instead.
+func (*RestoreShareResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{216}
 }
 
-type ServerDisconnectRequest struct {
+func (x *RestoreShareResponse) GetShare() *ShareInfo {
+	if x != nil {
+		return x.Share
+	}
+	return nil
+}
+
+type PurgeShareRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The server's UUID.
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The associated server UUID.
+	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The share's name.
+	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServerDisconnectRequest) Reset() {
-	*x = ServerDisconnectRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[49]
+func (x *PurgeShareRequest) Reset() {
+	*x = PurgeShareRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[217]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServerDisconnectRequest) String() string {
+func (x *PurgeShareRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerDisconnectRequest) ProtoMessage() {}
+func (*PurgeShareRequest) ProtoMessage() {}
 
-func (x *ServerDisconnectRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[49]
+func (x *PurgeShareRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[217]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3048,39 +13529,46 @@ func (x *ServerDisconnectRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerDisconnectRequest.ProtoReflect.Descriptor instead.
-func (*ServerDisconnectRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use PurgeShareRequest.ProtoReflect.Descriptor instead.
+func (*PurgeShareRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{217}
 }
 
-func (x *ServerDisconnectRequest) GetUuid() string {
+func (x *PurgeShareRequest) GetServerUuid() string {
 	if x != nil {
-		return x.Uuid
+		return x.ServerUuid
 	}
 	return ""
 }
 
-type ServerDisconnectResponse struct {
+func (x *PurgeShareRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type PurgeShareResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ServerDisconnectResponse) Reset() {
-	*x = ServerDisconnectResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[50]
+func (x *PurgeShareResponse) Reset() {
+	*x = PurgeShareResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[218]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ServerDisconnectResponse) String() string {
+func (x *PurgeShareResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ServerDisconnectResponse) ProtoMessage() {}
+func (*PurgeShareResponse) ProtoMessage() {}
 
-func (x *ServerDisconnectResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[50]
+func (x *PurgeShareResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[218]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3091,32 +13579,41 @@ func (x *ServerDisconnectResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ServerDisconnectResponse.ProtoReflect.Descriptor instead.
-func (*ServerDisconnectResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use PurgeShareResponse.ProtoReflect.Descriptor instead.
+func (*PurgeShareResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{218}
 }
 
-type GetDirectSettingsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+// PluginInfo is information about a plugin that is allowed to use the RPC interface.
+type PluginInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The plugin's unique name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The UNIX timestamp when the plugin was created.
+	CreatedTs int64 `protobuf:"varint,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// The scopes granted to the plugin.
+	Scopes []PluginScope `protobuf:"varint,3,rep,packed,name=scopes,proto3,enum=pb.clientrpc.v1.PluginScope" json:"scopes,omitempty"`
+	// The number of event streams the plugin currently has open.
+	OpenStreams   uint32 `protobuf:"varint,4,opt,name=open_streams,json=openStreams,proto3" json:"open_streams,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDirectSettingsRequest) Reset() {
-	*x = GetDirectSettingsRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[51]
+func (x *PluginInfo) Reset() {
+	*x = PluginInfo{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[219]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDirectSettingsRequest) String() string {
+func (x *PluginInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDirectSettingsRequest) ProtoMessage() {}
+func (*PluginInfo) ProtoMessage() {}
 
-func (x *GetDirectSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[51]
+func (x *PluginInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[219]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3127,80 +13624,66 @@ func (x *GetDirectSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDirectSettingsRequest.ProtoReflect.Descriptor instead.
-func (*GetDirectSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{51}
-}
-
-type GetDirectSettingsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The client's direct connection settings.
-	Settings      *DirectSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetDirectSettingsResponse) Reset() {
-	*x = GetDirectSettingsResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[52]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+// Deprecated: Use PluginInfo.ProtoReflect.Descriptor instead.
+func (*PluginInfo) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{219}
 }
 
-func (x *GetDirectSettingsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *PluginInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
 }
 
-func (*GetDirectSettingsResponse) ProtoMessage() {}
-
-func (x *GetDirectSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[52]
+func (x *PluginInfo) GetCreatedTs() int64 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.CreatedTs
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use GetDirectSettingsResponse.ProtoReflect.Descriptor instead.
-func (*GetDirectSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{52}
+func (x *PluginInfo) GetScopes() []PluginScope {
+	if x != nil {
+		return x.Scopes
+	}
+	return nil
 }
 
-func (x *GetDirectSettingsResponse) GetSettings() *DirectSettings {
+func (x *PluginInfo) GetOpenStreams() uint32 {
 	if x != nil {
-		return x.Settings
+		return x.OpenStreams
 	}
-	return nil
+	return 0
 }
 
-type UpdateDirectSettingsRequest struct {
+// PluginEvent is an event sent to a plugin.
+type PluginEvent struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The settings to update.
-	// All fields must be filled.
-	Settings      *DirectSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	// The event type.
+	// The appropriate field will be filled based on the type.
+	Type          PluginEventType          `protobuf:"varint,1,opt,name=type,proto3,enum=pb.clientrpc.v1.PluginEventType" json:"type,omitempty"`
+	ClientEvent   *PluginEvent_ClientEvent `protobuf:"bytes,2,opt,name=client_event,json=clientEvent,proto3,oneof" json:"client_event,omitempty"`
+	Search        *PluginEvent_Search      `protobuf:"bytes,3,opt,name=search,proto3,oneof" json:"search,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDirectSettingsRequest) Reset() {
-	*x = UpdateDirectSettingsRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[53]
+func (x *PluginEvent) Reset() {
+	*x = PluginEvent{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[220]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDirectSettingsRequest) String() string {
+func (x *PluginEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDirectSettingsRequest) ProtoMessage() {}
+func (*PluginEvent) ProtoMessage() {}
 
-func (x *UpdateDirectSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[53]
+func (x *PluginEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[220]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3211,39 +13694,61 @@ func (x *UpdateDirectSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDirectSettingsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDirectSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use PluginEvent.ProtoReflect.Descriptor instead.
+func (*PluginEvent) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{220}
 }
 
-func (x *UpdateDirectSettingsRequest) GetSettings() *DirectSettings {
+func (x *PluginEvent) GetType() PluginEventType {
 	if x != nil {
-		return x.Settings
+		return x.Type
+	}
+	return PluginEventType_PLUGIN_EVENT_TYPE_UNSPECIFIED
+}
+
+func (x *PluginEvent) GetClientEvent() *PluginEvent_ClientEvent {
+	if x != nil {
+		return x.ClientEvent
 	}
 	return nil
 }
 
-type UpdateDirectSettingsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *PluginEvent) GetSearch() *PluginEvent_Search {
+	if x != nil {
+		return x.Search
+	}
+	return nil
+}
+
+// PluginSearchResult is a search result added by a plugin.
+type PluginSearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The path of the folder containing the file, starting with the name of the share it is in.
+	// Peers download results from the client's shares, so results outside them cannot be downloaded.
+	DirectoryPath string `protobuf:"bytes,1,opt,name=directory_path,json=directoryPath,proto3" json:"directory_path,omitempty"`
+	// The file's metadata.
+	File *FileMeta `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	// A snippet of text highlighting matched terms, or empty.
+	Snippet       string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateDirectSettingsResponse) Reset() {
-	*x = UpdateDirectSettingsResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[54]
+func (x *PluginSearchResult) Reset() {
+	*x = PluginSearchResult{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[221]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateDirectSettingsResponse) String() string {
+func (x *PluginSearchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDirectSettingsResponse) ProtoMessage() {}
+func (*PluginSearchResult) ProtoMessage() {}
 
-func (x *UpdateDirectSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[54]
+func (x *PluginSearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[221]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3254,32 +13759,53 @@ func (x *UpdateDirectSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDirectSettingsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDirectSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use PluginSearchResult.ProtoReflect.Descriptor instead.
+func (*PluginSearchResult) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{221}
 }
 
-type GetTransferSettingsRequest struct {
+func (x *PluginSearchResult) GetDirectoryPath() string {
+	if x != nil {
+		return x.DirectoryPath
+	}
+	return ""
+}
+
+func (x *PluginSearchResult) GetFile() *FileMeta {
+	if x != nil {
+		return x.File
+	}
+	return nil
+}
+
+func (x *PluginSearchResult) GetSnippet() string {
+	if x != nil {
+		return x.Snippet
+	}
+	return ""
+}
+
+type GetPluginsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransferSettingsRequest) Reset() {
-	*x = GetTransferSettingsRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[55]
+func (x *GetPluginsRequest) Reset() {
+	*x = GetPluginsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[222]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransferSettingsRequest) String() string {
+func (x *GetPluginsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransferSettingsRequest) ProtoMessage() {}
+func (*GetPluginsRequest) ProtoMessage() {}
 
-func (x *GetTransferSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[55]
+func (x *GetPluginsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[222]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3290,34 +13816,34 @@ func (x *GetTransferSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransferSettingsRequest.ProtoReflect.Descriptor instead.
-func (*GetTransferSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use GetPluginsRequest.ProtoReflect.Descriptor instead.
+func (*GetPluginsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{222}
 }
 
-type GetTransferSettingsResponse struct {
+type GetPluginsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The client's transfer settings.
-	Settings      *TransferSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	// All plugins, ordered by name.
+	Plugins       []*PluginInfo `protobuf:"bytes,1,rep,name=plugins,proto3" json:"plugins,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetTransferSettingsResponse) Reset() {
-	*x = GetTransferSettingsResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[56]
+func (x *GetPluginsResponse) Reset() {
+	*x = GetPluginsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[223]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetTransferSettingsResponse) String() string {
+func (x *GetPluginsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTransferSettingsResponse) ProtoMessage() {}
+func (*GetPluginsResponse) ProtoMessage() {}
 
-func (x *GetTransferSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[56]
+func (x *GetPluginsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[223]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3328,42 +13854,44 @@ func (x *GetTransferSettingsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTransferSettingsResponse.ProtoReflect.Descriptor instead.
-func (*GetTransferSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use GetPluginsResponse.ProtoReflect.Descriptor instead.
+func (*GetPluginsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{223}
 }
 
-func (x *GetTransferSettingsResponse) GetSettings() *TransferSettings {
+func (x *GetPluginsResponse) GetPlugins() []*PluginInfo {
 	if x != nil {
-		return x.Settings
+		return x.Plugins
 	}
 	return nil
 }
 
-type UpdateTransferSettingsRequest struct {
+type CreatePluginRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The settings to update.
-	// All fields must be filled.
-	Settings      *TransferSettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	// The plugin's name.
+	// Must be between 1 and 64 characters long and unique.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The scopes to grant to the plugin.
+	Scopes        []PluginScope `protobuf:"varint,2,rep,packed,name=scopes,proto3,enum=pb.clientrpc.v1.PluginScope" json:"scopes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateTransferSettingsRequest) Reset() {
-	*x = UpdateTransferSettingsRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[57]
+func (x *CreatePluginRequest) Reset() {
+	*x = CreatePluginRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[224]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateTransferSettingsRequest) String() string {
+func (x *CreatePluginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateTransferSettingsRequest) ProtoMessage() {}
+func (*CreatePluginRequest) ProtoMessage() {}
 
-func (x *UpdateTransferSettingsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[57]
+func (x *CreatePluginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[224]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3374,79 +13902,51 @@ func (x *UpdateTransferSettingsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateTransferSettingsRequest.ProtoReflect.Descriptor instead.
-func (*UpdateTransferSettingsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{57}
+// Deprecated: Use CreatePluginRequest.ProtoReflect.Descriptor instead.
+func (*CreatePluginRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{224}
 }
 
-func (x *UpdateTransferSettingsRequest) GetSettings() *TransferSettings {
+func (x *CreatePluginRequest) GetName() string {
 	if x != nil {
-		return x.Settings
+		return x.Name
 	}
-	return nil
-}
-
-type UpdateTransferSettingsResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *UpdateTransferSettingsResponse) Reset() {
-	*x = UpdateTransferSettingsResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[58]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *UpdateTransferSettingsResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+	return ""
 }
 
-func (*UpdateTransferSettingsResponse) ProtoMessage() {}
-
-func (x *UpdateTransferSettingsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[58]
+func (x *CreatePluginRequest) GetScopes() []PluginScope {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.Scopes
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UpdateTransferSettingsResponse.ProtoReflect.Descriptor instead.
-func (*UpdateTransferSettingsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{58}
+	return nil
 }
 
-type IndexShareRequest struct {
+type CreatePluginResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The associated server UUID.
-	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
-	// The share's name.
-	Name          string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The new plugin.
+	Plugin *PluginInfo `protobuf:"bytes,1,opt,name=plugin,proto3" json:"plugin,omitempty"`
+	// The plugin's bearer token.
+	// It is only returned once; the client does not keep it.
+	Token         string `protobuf:"bytes,2,opt,name=token,proto3" json:"token,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IndexShareRequest) Reset() {
-	*x = IndexShareRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[59]
+func (x *CreatePluginResponse) Reset() {
+	*x = CreatePluginResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[225]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IndexShareRequest) String() string {
+func (x *CreatePluginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IndexShareRequest) ProtoMessage() {}
+func (*CreatePluginResponse) ProtoMessage() {}
 
-func (x *IndexShareRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[59]
+func (x *CreatePluginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[225]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3457,46 +13957,48 @@ func (x *IndexShareRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IndexShareRequest.ProtoReflect.Descriptor instead.
-func (*IndexShareRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{59}
+// Deprecated: Use CreatePluginResponse.ProtoReflect.Descriptor instead.
+func (*CreatePluginResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{225}
 }
 
-func (x *IndexShareRequest) GetServerUuid() string {
+func (x *CreatePluginResponse) GetPlugin() *PluginInfo {
 	if x != nil {
-		return x.ServerUuid
+		return x.Plugin
 	}
-	return ""
+	return nil
 }
 
-func (x *IndexShareRequest) GetName() string {
+func (x *CreatePluginResponse) GetToken() string {
 	if x != nil {
-		return x.Name
+		return x.Token
 	}
 	return ""
 }
 
-type IndexShareResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type DeletePluginRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The plugin's name.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *IndexShareResponse) Reset() {
-	*x = IndexShareResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[60]
+func (x *DeletePluginRequest) Reset() {
+	*x = DeletePluginRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[226]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *IndexShareResponse) String() string {
+func (x *DeletePluginRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IndexShareResponse) ProtoMessage() {}
+func (*DeletePluginRequest) ProtoMessage() {}
 
-func (x *IndexShareResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[60]
+func (x *DeletePluginRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[226]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3507,38 +14009,39 @@ func (x *IndexShareResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IndexShareResponse.ProtoReflect.Descriptor instead.
-func (*IndexShareResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{60}
+// Deprecated: Use DeletePluginRequest.ProtoReflect.Descriptor instead.
+func (*DeletePluginRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{226}
 }
 
-type StreamSearchRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The associated server's UUID.
-	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
-	// The username of the client to search, or omit to search all clients.
-	Username *string `protobuf:"bytes,2,opt,name=username,proto3,oneof" json:"username,omitempty"`
-	// The search query.
-	Query         string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+func (x *DeletePluginRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeletePluginResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamSearchRequest) Reset() {
-	*x = StreamSearchRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[61]
+func (x *DeletePluginResponse) Reset() {
+	*x = DeletePluginResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[227]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamSearchRequest) String() string {
+func (x *DeletePluginResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamSearchRequest) ProtoMessage() {}
+func (*DeletePluginResponse) ProtoMessage() {}
 
-func (x *StreamSearchRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[61]
+func (x *DeletePluginResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[227]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3549,61 +14052,36 @@ func (x *StreamSearchRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StreamSearchRequest.ProtoReflect.Descriptor instead.
-func (*StreamSearchRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{61}
-}
-
-func (x *StreamSearchRequest) GetServerUuid() string {
-	if x != nil {
-		return x.ServerUuid
-	}
-	return ""
-}
-
-func (x *StreamSearchRequest) GetUsername() string {
-	if x != nil && x.Username != nil {
-		return *x.Username
-	}
-	return ""
-}
-
-func (x *StreamSearchRequest) GetQuery() string {
-	if x != nil {
-		return x.Query
-	}
-	return ""
+// Deprecated: Use DeletePluginResponse.ProtoReflect.Descriptor instead.
+func (*DeletePluginResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{227}
 }
 
-type StreamSearchResponse struct {
+type StreamPluginEventsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The username of the client the result came from.
-	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	// The file's containing directory path.
-	DirectoryPath string `protobuf:"bytes,2,opt,name=directory_path,json=directoryPath,proto3" json:"directory_path,omitempty"`
-	// The file that was found.
-	File *FileMeta `protobuf:"bytes,3,opt,name=file,proto3" json:"file,omitempty"`
-	// A snippet of text highlighting matched terms.
-	Snippet       string `protobuf:"bytes,4,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	// The types of events to receive.
+	// The plugin must have the scope each type requires.
+	// If empty, all types the plugin's scopes allow are received.
+	Types         []PluginEventType `protobuf:"varint,1,rep,packed,name=types,proto3,enum=pb.clientrpc.v1.PluginEventType" json:"types,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StreamSearchResponse) Reset() {
-	*x = StreamSearchResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[62]
+func (x *StreamPluginEventsRequest) Reset() {
+	*x = StreamPluginEventsRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[228]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StreamSearchResponse) String() string {
+func (x *StreamPluginEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StreamSearchResponse) ProtoMessage() {}
+func (*StreamPluginEventsRequest) ProtoMessage() {}
 
-func (x *StreamSearchResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[62]
+func (x *StreamPluginEventsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[228]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3611,63 +14089,44 @@ func (x *StreamSearchResponse) ProtoReflect() protoreflect.Message {
 		}
 		return ms
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use StreamSearchResponse.ProtoReflect.Descriptor instead.
-func (*StreamSearchResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{62}
-}
-
-func (x *StreamSearchResponse) GetUsername() string {
-	if x != nil {
-		return x.Username
-	}
-	return ""
-}
-
-func (x *StreamSearchResponse) GetDirectoryPath() string {
-	if x != nil {
-		return x.DirectoryPath
-	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *StreamSearchResponse) GetFile() *FileMeta {
-	if x != nil {
-		return x.File
-	}
-	return nil
+// Deprecated: Use StreamPluginEventsRequest.ProtoReflect.Descriptor instead.
+func (*StreamPluginEventsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{228}
 }
 
-func (x *StreamSearchResponse) GetSnippet() string {
+func (x *StreamPluginEventsRequest) GetTypes() []PluginEventType {
 	if x != nil {
-		return x.Snippet
+		return x.Types
 	}
-	return ""
+	return nil
 }
 
-type GetUpdateInfoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type StreamPluginEventsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The event.
+	Event         *PluginEvent `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpdateInfoRequest) Reset() {
-	*x = GetUpdateInfoRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[63]
+func (x *StreamPluginEventsResponse) Reset() {
+	*x = StreamPluginEventsResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[229]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpdateInfoRequest) String() string {
+func (x *StreamPluginEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpdateInfoRequest) ProtoMessage() {}
+func (*StreamPluginEventsResponse) ProtoMessage() {}
 
-func (x *GetUpdateInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[63]
+func (x *StreamPluginEventsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[229]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3678,37 +14137,44 @@ func (x *GetUpdateInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpdateInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetUpdateInfoRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{63}
+// Deprecated: Use StreamPluginEventsResponse.ProtoReflect.Descriptor instead.
+func (*StreamPluginEventsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{229}
 }
 
-type GetUpdateInfoResponse struct {
+func (x *StreamPluginEventsResponse) GetEvent() *PluginEvent {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+type RespondToSearchRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The current update the client is running.
-	CurrentInfo *UpdateInfo `protobuf:"bytes,1,opt,name=current_info,json=currentInfo,proto3" json:"current_info,omitempty"`
-	// The new update's info, or no new update.
-	// This is cached info.
-	NewInfo       *UpdateInfo `protobuf:"bytes,2,opt,name=new_info,json=newInfo,proto3,oneof" json:"new_info,omitempty"`
+	// The search's ID.
+	SearchId string `protobuf:"bytes,1,opt,name=search_id,json=searchId,proto3" json:"search_id,omitempty"`
+	// The results to add to the search.
+	// Results past the search's max_results are ignored.
+	Results       []*PluginSearchResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetUpdateInfoResponse) Reset() {
-	*x = GetUpdateInfoResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[64]
+func (x *RespondToSearchRequest) Reset() {
+	*x = RespondToSearchRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[230]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetUpdateInfoResponse) String() string {
+func (x *RespondToSearchRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetUpdateInfoResponse) ProtoMessage() {}
+func (*RespondToSearchRequest) ProtoMessage() {}
 
-func (x *GetUpdateInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[64]
+func (x *RespondToSearchRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[230]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3719,46 +14185,46 @@ func (x *GetUpdateInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetUpdateInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetUpdateInfoResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{64}
+// Deprecated: Use RespondToSearchRequest.ProtoReflect.Descriptor instead.
+func (*RespondToSearchRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{230}
 }
 
-func (x *GetUpdateInfoResponse) GetCurrentInfo() *UpdateInfo {
+func (x *RespondToSearchRequest) GetSearchId() string {
 	if x != nil {
-		return x.CurrentInfo
+		return x.SearchId
 	}
-	return nil
+	return ""
 }
 
-func (x *GetUpdateInfoResponse) GetNewInfo() *UpdateInfo {
+func (x *RespondToSearchRequest) GetResults() []*PluginSearchResult {
 	if x != nil {
-		return x.NewInfo
+		return x.Results
 	}
 	return nil
 }
 
-type CheckForNewUpdateRequest struct {
+type RespondToSearchResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckForNewUpdateRequest) Reset() {
-	*x = CheckForNewUpdateRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[65]
+func (x *RespondToSearchResponse) Reset() {
+	*x = RespondToSearchResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[231]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckForNewUpdateRequest) String() string {
+func (x *RespondToSearchResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckForNewUpdateRequest) ProtoMessage() {}
+func (*RespondToSearchResponse) ProtoMessage() {}
 
-func (x *CheckForNewUpdateRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[65]
+func (x *RespondToSearchResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[231]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3769,34 +14235,47 @@ func (x *CheckForNewUpdateRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckForNewUpdateRequest.ProtoReflect.Descriptor instead.
-func (*CheckForNewUpdateRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{65}
+// Deprecated: Use RespondToSearchResponse.ProtoReflect.Descriptor instead.
+func (*RespondToSearchResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{231}
 }
 
-type CheckForNewUpdateResponse struct {
+// BridgeRequest is the first message a browser sends on a bridge stream.
+// Bridge messages are length-delimited with a varint prefix, like protodelim in Go or sizeDelimitedEncode in
+// protobuf-es.
+type BridgeRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The new update's info, or no new update.
-	NewInfo       *UpdateInfo `protobuf:"bytes,1,opt,name=new_info,json=newInfo,proto3,oneof" json:"new_info,omitempty"`
+	// The kind of request.
+	Type BridgeRequestType `protobuf:"varint,1,opt,name=type,proto3,enum=pb.clientrpc.v1.BridgeRequestType" json:"type,omitempty"`
+	// The UUID of the server the peer is on.
+	ServerUuid string `protobuf:"bytes,2,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The peer's username.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// The path of the file or folder.
+	Path string `protobuf:"bytes,4,opt,name=path,proto3" json:"path,omitempty"`
+	// For GET_FILE, the offset to start reading the file at.
+	Offset uint64 `protobuf:"varint,5,opt,name=offset,proto3" json:"offset,omitempty"`
+	// For GET_FILE, the maximum number of bytes to read, or 0 to read until the end of the file.
+	Limit         uint64 `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CheckForNewUpdateResponse) Reset() {
-	*x = CheckForNewUpdateResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[66]
+func (x *BridgeRequest) Reset() {
+	*x = BridgeRequest{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[232]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CheckForNewUpdateResponse) String() string {
+func (x *BridgeRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CheckForNewUpdateResponse) ProtoMessage() {}
+func (*BridgeRequest) ProtoMessage() {}
 
-func (x *CheckForNewUpdateResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[66]
+func (x *BridgeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[232]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3807,39 +14286,81 @@ func (x *CheckForNewUpdateResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CheckForNewUpdateResponse.ProtoReflect.Descriptor instead.
-func (*CheckForNewUpdateResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{66}
+// Deprecated: Use BridgeRequest.ProtoReflect.Descriptor instead.
+func (*BridgeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{232}
 }
 
-func (x *CheckForNewUpdateResponse) GetNewInfo() *UpdateInfo {
+func (x *BridgeRequest) GetType() BridgeRequestType {
 	if x != nil {
-		return x.NewInfo
+		return x.Type
 	}
-	return nil
+	return BridgeRequestType_BRIDGE_REQUEST_TYPE_UNSPECIFIED
 }
 
-type GetDownloadManagerItemsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *BridgeRequest) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *BridgeRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *BridgeRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+func (x *BridgeRequest) GetOffset() uint64 {
+	if x != nil {
+		return x.Offset
+	}
+	return 0
+}
+
+func (x *BridgeRequest) GetLimit() uint64 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+// BridgeError is an error that a bridge request failed with.
+type BridgeError struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The Connect error code the equivalent RPC would have failed with, such as "not_found".
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// A human-readable description of the error.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Details about the error, if it has a known cause.
+	Info          *ErrorInfo `protobuf:"bytes,3,opt,name=info,proto3,oneof" json:"info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDownloadManagerItemsRequest) Reset() {
-	*x = GetDownloadManagerItemsRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[67]
+func (x *BridgeError) Reset() {
+	*x = BridgeError{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[233]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDownloadManagerItemsRequest) String() string {
+func (x *BridgeError) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDownloadManagerItemsRequest) ProtoMessage() {}
+func (*BridgeError) ProtoMessage() {}
 
-func (x *GetDownloadManagerItemsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[67]
+func (x *BridgeError) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[233]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3850,34 +14371,60 @@ func (x *GetDownloadManagerItemsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDownloadManagerItemsRequest.ProtoReflect.Descriptor instead.
-func (*GetDownloadManagerItemsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{67}
+// Deprecated: Use BridgeError.ProtoReflect.Descriptor instead.
+func (*BridgeError) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{233}
 }
 
-type GetDownloadManagerItemsResponse struct {
+func (x *BridgeError) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *BridgeError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *BridgeError) GetInfo() *ErrorInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+// BridgeResponse is sent by the client in answer to a BridgeRequest.
+type BridgeResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The download manager items.
-	Items         []*DownloadManagerItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	// Set if the request failed. No more messages are sent on the stream afterward.
+	Error *BridgeError `protobuf:"bytes,1,opt,name=error,proto3,oneof" json:"error,omitempty"`
+	// For GET_FILE_META and GET_FILE, the metadata of the file.
+	Meta *FileMeta `protobuf:"bytes,2,opt,name=meta,proto3,oneof" json:"meta,omitempty"`
+	// For GET_DIR_FILES, a batch of the folder's contents.
+	Files         []*FileMeta `protobuf:"bytes,3,rep,name=files,proto3" json:"files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDownloadManagerItemsResponse) Reset() {
-	*x = GetDownloadManagerItemsResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[68]
+func (x *BridgeResponse) Reset() {
+	*x = BridgeResponse{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[234]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDownloadManagerItemsResponse) String() string {
+func (x *BridgeResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDownloadManagerItemsResponse) ProtoMessage() {}
+func (*BridgeResponse) ProtoMessage() {}
 
-func (x *GetDownloadManagerItemsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[68]
+func (x *BridgeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[234]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3888,45 +14435,55 @@ func (x *GetDownloadManagerItemsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDownloadManagerItemsResponse.ProtoReflect.Descriptor instead.
-func (*GetDownloadManagerItemsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{68}
+// Deprecated: Use BridgeResponse.ProtoReflect.Descriptor instead.
+func (*BridgeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{234}
 }
 
-func (x *GetDownloadManagerItemsResponse) GetItems() []*DownloadManagerItem {
+func (x *BridgeResponse) GetError() *BridgeError {
 	if x != nil {
-		return x.Items
+		return x.Error
 	}
 	return nil
 }
 
-type QueueFileDownloadRequest struct {
+func (x *BridgeResponse) GetMeta() *FileMeta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *BridgeResponse) GetFiles() []*FileMeta {
+	if x != nil {
+		return x.Files
+	}
+	return nil
+}
+
+type Event_ServerConnStateChange struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The UUID of the server the peer exists on.
-	ServerUuid string `protobuf:"bytes,1,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
-	// The peer's username.
-	PeerUsername string `protobuf:"bytes,2,opt,name=peer_username,json=peerUsername,proto3" json:"peer_username,omitempty"`
-	// The path of the file within the peer.
-	FilePath      string `protobuf:"bytes,3,opt,name=file_path,json=filePath,proto3" json:"file_path,omitempty"`
+	// The server's new connection state.
+	State         ServerConnState `protobuf:"varint,2,opt,name=state,proto3,enum=pb.clientrpc.v1.ServerConnState" json:"state,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueueFileDownloadRequest) Reset() {
-	*x = QueueFileDownloadRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[69]
+func (x *Event_ServerConnStateChange) Reset() {
+	*x = Event_ServerConnStateChange{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[235]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueueFileDownloadRequest) String() string {
+func (x *Event_ServerConnStateChange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueueFileDownloadRequest) ProtoMessage() {}
+func (*Event_ServerConnStateChange) ProtoMessage() {}
 
-func (x *QueueFileDownloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[69]
+func (x *Event_ServerConnStateChange) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[235]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3937,53 +14494,41 @@ func (x *QueueFileDownloadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueueFileDownloadRequest.ProtoReflect.Descriptor instead.
-func (*QueueFileDownloadRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{69}
-}
-
-func (x *QueueFileDownloadRequest) GetServerUuid() string {
-	if x != nil {
-		return x.ServerUuid
-	}
-	return ""
-}
-
-func (x *QueueFileDownloadRequest) GetPeerUsername() string {
-	if x != nil {
-		return x.PeerUsername
-	}
-	return ""
+// Deprecated: Use Event_ServerConnStateChange.ProtoReflect.Descriptor instead.
+func (*Event_ServerConnStateChange) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 0}
 }
 
-func (x *QueueFileDownloadRequest) GetFilePath() string {
+func (x *Event_ServerConnStateChange) GetState() ServerConnState {
 	if x != nil {
-		return x.FilePath
+		return x.State
 	}
-	return ""
+	return ServerConnState_SERVER_CONN_STATE_UNSPECIFIED
 }
 
-type QueueFileDownloadResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type Event_ClientOnline struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The online user's info.
+	Info          *OnlineUserInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *QueueFileDownloadResponse) Reset() {
-	*x = QueueFileDownloadResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[70]
+func (x *Event_ClientOnline) Reset() {
+	*x = Event_ClientOnline{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[236]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *QueueFileDownloadResponse) String() string {
+func (x *Event_ClientOnline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*QueueFileDownloadResponse) ProtoMessage() {}
+func (*Event_ClientOnline) ProtoMessage() {}
 
-func (x *QueueFileDownloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[70]
+func (x *Event_ClientOnline) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[236]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3994,34 +14539,41 @@ func (x *QueueFileDownloadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use QueueFileDownloadResponse.ProtoReflect.Descriptor instead.
-func (*QueueFileDownloadResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{70}
+// Deprecated: Use Event_ClientOnline.ProtoReflect.Descriptor instead.
+func (*Event_ClientOnline) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 1}
 }
 
-type CancelFileDownloadRequest struct {
+func (x *Event_ClientOnline) GetInfo() *OnlineUserInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type Event_ClientOffline struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The file download's UUID.
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The client's username.
+	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelFileDownloadRequest) Reset() {
-	*x = CancelFileDownloadRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[71]
+func (x *Event_ClientOffline) Reset() {
+	*x = Event_ClientOffline{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[237]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelFileDownloadRequest) String() string {
+func (x *Event_ClientOffline) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelFileDownloadRequest) ProtoMessage() {}
+func (*Event_ClientOffline) ProtoMessage() {}
 
-func (x *CancelFileDownloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[71]
+func (x *Event_ClientOffline) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[237]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4032,39 +14584,41 @@ func (x *CancelFileDownloadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelFileDownloadRequest.ProtoReflect.Descriptor instead.
-func (*CancelFileDownloadRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{71}
+// Deprecated: Use Event_ClientOffline.ProtoReflect.Descriptor instead.
+func (*Event_ClientOffline) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 2}
 }
 
-func (x *CancelFileDownloadRequest) GetUuid() string {
+func (x *Event_ClientOffline) GetUsername() string {
 	if x != nil {
-		return x.Uuid
+		return x.Username
 	}
 	return ""
 }
 
-type CancelFileDownloadResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type Event_NewUpdate struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new update's info.
+	Info          *UpdateInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CancelFileDownloadResponse) Reset() {
-	*x = CancelFileDownloadResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[72]
+func (x *Event_NewUpdate) Reset() {
+	*x = Event_NewUpdate{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[238]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CancelFileDownloadResponse) String() string {
+func (x *Event_NewUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CancelFileDownloadResponse) ProtoMessage() {}
+func (*Event_NewUpdate) ProtoMessage() {}
 
-func (x *CancelFileDownloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[72]
+func (x *Event_NewUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[238]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4075,34 +14629,41 @@ func (x *CancelFileDownloadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CancelFileDownloadResponse.ProtoReflect.Descriptor instead.
-func (*CancelFileDownloadResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{72}
+// Deprecated: Use Event_NewUpdate.ProtoReflect.Descriptor instead.
+func (*Event_NewUpdate) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 3}
 }
 
-type RemoveDownloadManagerItemRequest struct {
+func (x *Event_NewUpdate) GetInfo() *UpdateInfo {
+	if x != nil {
+		return x.Info
+	}
+	return nil
+}
+
+type Event_DownloadStatusUpdates struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The item's UUID.
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// The download progress info for files.
+	Files         []*DownloadStatusUpdate `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveDownloadManagerItemRequest) Reset() {
-	*x = RemoveDownloadManagerItemRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[73]
+func (x *Event_DownloadStatusUpdates) Reset() {
+	*x = Event_DownloadStatusUpdates{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[239]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveDownloadManagerItemRequest) String() string {
+func (x *Event_DownloadStatusUpdates) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDownloadManagerItemRequest) ProtoMessage() {}
+func (*Event_DownloadStatusUpdates) ProtoMessage() {}
 
-func (x *RemoveDownloadManagerItemRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[73]
+func (x *Event_DownloadStatusUpdates) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[239]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4113,39 +14674,41 @@ func (x *RemoveDownloadManagerItemRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDownloadManagerItemRequest.ProtoReflect.Descriptor instead.
-func (*RemoveDownloadManagerItemRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{73}
+// Deprecated: Use Event_DownloadStatusUpdates.ProtoReflect.Descriptor instead.
+func (*Event_DownloadStatusUpdates) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 4}
 }
 
-func (x *RemoveDownloadManagerItemRequest) GetUuid() string {
+func (x *Event_DownloadStatusUpdates) GetFiles() []*DownloadStatusUpdate {
 	if x != nil {
-		return x.Uuid
+		return x.Files
 	}
-	return ""
+	return nil
 }
 
-type RemoveDownloadManagerItemResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type Event_NewDmItem struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The download manager item.
+	Item          *DownloadManagerItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *RemoveDownloadManagerItemResponse) Reset() {
-	*x = RemoveDownloadManagerItemResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[74]
+func (x *Event_NewDmItem) Reset() {
+	*x = Event_NewDmItem{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[240]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *RemoveDownloadManagerItemResponse) String() string {
+func (x *Event_NewDmItem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDownloadManagerItemResponse) ProtoMessage() {}
+func (*Event_NewDmItem) ProtoMessage() {}
 
-func (x *RemoveDownloadManagerItemResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[74]
+func (x *Event_NewDmItem) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[240]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4156,12 +14719,19 @@ func (x *RemoveDownloadManagerItemResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDownloadManagerItemResponse.ProtoReflect.Descriptor instead.
-func (*RemoveDownloadManagerItemResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{74}
+// Deprecated: Use Event_NewDmItem.ProtoReflect.Descriptor instead.
+func (*Event_NewDmItem) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 5}
 }
 
-type ResumeFileDownloadRequest struct {
+func (x *Event_NewDmItem) GetItem() *DownloadManagerItem {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+type Event_DmItemRemoved struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The item's UUID.
 	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
@@ -4169,21 +14739,21 @@ type ResumeFileDownloadRequest struct {
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResumeFileDownloadRequest) Reset() {
-	*x = ResumeFileDownloadRequest{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[75]
+func (x *Event_DmItemRemoved) Reset() {
+	*x = Event_DmItemRemoved{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[241]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResumeFileDownloadRequest) String() string {
+func (x *Event_DmItemRemoved) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResumeFileDownloadRequest) ProtoMessage() {}
+func (*Event_DmItemRemoved) ProtoMessage() {}
 
-func (x *ResumeFileDownloadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[75]
+func (x *Event_DmItemRemoved) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[241]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4194,39 +14764,47 @@ func (x *ResumeFileDownloadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResumeFileDownloadRequest.ProtoReflect.Descriptor instead.
-func (*ResumeFileDownloadRequest) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{75}
+// Deprecated: Use Event_DmItemRemoved.ProtoReflect.Descriptor instead.
+func (*Event_DmItemRemoved) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 6}
 }
 
-func (x *ResumeFileDownloadRequest) GetUuid() string {
+func (x *Event_DmItemRemoved) GetUuid() string {
 	if x != nil {
 		return x.Uuid
 	}
 	return ""
 }
 
-type ResumeFileDownloadResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type Event_ShareChanged struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The share's name.
+	ShareName string `protobuf:"bytes,1,opt,name=share_name,json=shareName,proto3" json:"share_name,omitempty"`
+	// The share's new revision.
+	// It starts at 0 when the client starts and increases by 1 for every batch of changes.
+	Revision uint64 `protobuf:"varint,2,opt,name=revision,proto3" json:"revision,omitempty"`
+	// The paths within the share that changed.
+	// A path of "/" means the entire share should be considered changed.
+	Paths         []string `protobuf:"bytes,3,rep,name=paths,proto3" json:"paths,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *ResumeFileDownloadResponse) Reset() {
-	*x = ResumeFileDownloadResponse{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[76]
+func (x *Event_ShareChanged) Reset() {
+	*x = Event_ShareChanged{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[242]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *ResumeFileDownloadResponse) String() string {
+func (x *Event_ShareChanged) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ResumeFileDownloadResponse) ProtoMessage() {}
+func (*Event_ShareChanged) ProtoMessage() {}
 
-func (x *ResumeFileDownloadResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[76]
+func (x *Event_ShareChanged) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[242]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4237,79 +14815,55 @@ func (x *ResumeFileDownloadResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ResumeFileDownloadResponse.ProtoReflect.Descriptor instead.
-func (*ResumeFileDownloadResponse) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{76}
-}
-
-type Event_ServerConnStateChange struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The server's new connection state.
-	State         ServerConnState `protobuf:"varint,2,opt,name=state,proto3,enum=pb.clientrpc.v1.ServerConnState" json:"state,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *Event_ServerConnStateChange) Reset() {
-	*x = Event_ServerConnStateChange{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[77]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
-}
-
-func (x *Event_ServerConnStateChange) String() string {
-	return protoimpl.X.MessageStringOf(x)
+// Deprecated: Use Event_ShareChanged.ProtoReflect.Descriptor instead.
+func (*Event_ShareChanged) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 7}
 }
 
-func (*Event_ServerConnStateChange) ProtoMessage() {}
-
-func (x *Event_ServerConnStateChange) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[77]
+func (x *Event_ShareChanged) GetShareName() string {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.ShareName
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use Event_ServerConnStateChange.ProtoReflect.Descriptor instead.
-func (*Event_ServerConnStateChange) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 0}
+func (x *Event_ShareChanged) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
 }
 
-func (x *Event_ServerConnStateChange) GetState() ServerConnState {
+func (x *Event_ShareChanged) GetPaths() []string {
 	if x != nil {
-		return x.State
+		return x.Paths
 	}
-	return ServerConnState_SERVER_CONN_STATE_UNSPECIFIED
+	return nil
 }
 
-type Event_ClientOnline struct {
+type Event_ServerNotice struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The online user's info.
-	Info          *OnlineUserInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// The notice's text.
+	Text          string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event_ClientOnline) Reset() {
-	*x = Event_ClientOnline{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[78]
+func (x *Event_ServerNotice) Reset() {
+	*x = Event_ServerNotice{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[243]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event_ClientOnline) String() string {
+func (x *Event_ServerNotice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_ClientOnline) ProtoMessage() {}
+func (*Event_ServerNotice) ProtoMessage() {}
 
-func (x *Event_ClientOnline) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[78]
+func (x *Event_ServerNotice) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[243]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4320,41 +14874,41 @@ func (x *Event_ClientOnline) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_ClientOnline.ProtoReflect.Descriptor instead.
-func (*Event_ClientOnline) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 1}
+// Deprecated: Use Event_ServerNotice.ProtoReflect.Descriptor instead.
+func (*Event_ServerNotice) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 8}
 }
 
-func (x *Event_ClientOnline) GetInfo() *OnlineUserInfo {
+func (x *Event_ServerNotice) GetText() string {
 	if x != nil {
-		return x.Info
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-type Event_ClientOffline struct {
+type Event_UploadUpdate struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The client's username.
-	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The upload's current state.
+	Upload        *UploadInfo `protobuf:"bytes,1,opt,name=upload,proto3" json:"upload,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event_ClientOffline) Reset() {
-	*x = Event_ClientOffline{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[79]
+func (x *Event_UploadUpdate) Reset() {
+	*x = Event_UploadUpdate{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[244]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event_ClientOffline) String() string {
+func (x *Event_UploadUpdate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_ClientOffline) ProtoMessage() {}
+func (*Event_UploadUpdate) ProtoMessage() {}
 
-func (x *Event_ClientOffline) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[79]
+func (x *Event_UploadUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[244]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4365,41 +14919,41 @@ func (x *Event_ClientOffline) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_ClientOffline.ProtoReflect.Descriptor instead.
-func (*Event_ClientOffline) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 2}
+// Deprecated: Use Event_UploadUpdate.ProtoReflect.Descriptor instead.
+func (*Event_UploadUpdate) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 9}
 }
 
-func (x *Event_ClientOffline) GetUsername() string {
+func (x *Event_UploadUpdate) GetUpload() *UploadInfo {
 	if x != nil {
-		return x.Username
+		return x.Upload
 	}
-	return ""
+	return nil
 }
 
-type Event_NewUpdate struct {
+type Event_DownloadsRecovered struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The new update's info.
-	Info          *UpdateInfo `protobuf:"bytes,1,opt,name=info,proto3" json:"info,omitempty"`
+	// The downloads that were recovered.
+	Downloads     []*RecoveredDownload `protobuf:"bytes,1,rep,name=downloads,proto3" json:"downloads,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event_NewUpdate) Reset() {
-	*x = Event_NewUpdate{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[80]
+func (x *Event_DownloadsRecovered) Reset() {
+	*x = Event_DownloadsRecovered{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[245]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event_NewUpdate) String() string {
+func (x *Event_DownloadsRecovered) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_NewUpdate) ProtoMessage() {}
+func (*Event_DownloadsRecovered) ProtoMessage() {}
 
-func (x *Event_NewUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[80]
+func (x *Event_DownloadsRecovered) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[245]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4410,41 +14964,44 @@ func (x *Event_NewUpdate) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_NewUpdate.ProtoReflect.Descriptor instead.
-func (*Event_NewUpdate) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 3}
+// Deprecated: Use Event_DownloadsRecovered.ProtoReflect.Descriptor instead.
+func (*Event_DownloadsRecovered) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 10}
 }
 
-func (x *Event_NewUpdate) GetInfo() *UpdateInfo {
+func (x *Event_DownloadsRecovered) GetDownloads() []*RecoveredDownload {
 	if x != nil {
-		return x.Info
+		return x.Downloads
 	}
 	return nil
 }
 
-type Event_DownloadStatusUpdates struct {
+type Event_ShutdownDrain struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The download progress info for files.
-	Files         []*DownloadStatusUpdate `protobuf:"bytes,1,rep,name=files,proto3" json:"files,omitempty"`
+	// The number of uploads that are still active.
+	// Once it is 0, the client continues shutting down.
+	ActiveUploads uint32 `protobuf:"varint,1,opt,name=active_uploads,json=activeUploads,proto3" json:"active_uploads,omitempty"`
+	// The UNIX timestamp, in seconds, after which the client shuts down even if uploads are still active.
+	DeadlineTs    int64 `protobuf:"varint,2,opt,name=deadline_ts,json=deadlineTs,proto3" json:"deadline_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event_DownloadStatusUpdates) Reset() {
-	*x = Event_DownloadStatusUpdates{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[81]
+func (x *Event_ShutdownDrain) Reset() {
+	*x = Event_ShutdownDrain{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[246]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event_DownloadStatusUpdates) String() string {
+func (x *Event_ShutdownDrain) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_DownloadStatusUpdates) ProtoMessage() {}
+func (*Event_ShutdownDrain) ProtoMessage() {}
 
-func (x *Event_DownloadStatusUpdates) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[81]
+func (x *Event_ShutdownDrain) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[246]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4455,41 +15012,48 @@ func (x *Event_DownloadStatusUpdates) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_DownloadStatusUpdates.ProtoReflect.Descriptor instead.
-func (*Event_DownloadStatusUpdates) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 4}
+// Deprecated: Use Event_ShutdownDrain.ProtoReflect.Descriptor instead.
+func (*Event_ShutdownDrain) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 11}
 }
 
-func (x *Event_DownloadStatusUpdates) GetFiles() []*DownloadStatusUpdate {
+func (x *Event_ShutdownDrain) GetActiveUploads() uint32 {
 	if x != nil {
-		return x.Files
+		return x.ActiveUploads
 	}
-	return nil
+	return 0
+}
+
+func (x *Event_ShutdownDrain) GetDeadlineTs() int64 {
+	if x != nil {
+		return x.DeadlineTs
+	}
+	return 0
 }
 
-type Event_NewDmItem struct {
+type Event_RoomMotd struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The download manager item.
-	Item          *DownloadManagerItem `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// The message of the day's text.
+	Text          string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event_NewDmItem) Reset() {
-	*x = Event_NewDmItem{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[82]
+func (x *Event_RoomMotd) Reset() {
+	*x = Event_RoomMotd{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[247]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event_NewDmItem) String() string {
+func (x *Event_RoomMotd) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_NewDmItem) ProtoMessage() {}
+func (*Event_RoomMotd) ProtoMessage() {}
 
-func (x *Event_NewDmItem) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[82]
+func (x *Event_RoomMotd) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[247]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4500,41 +15064,42 @@ func (x *Event_NewDmItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_NewDmItem.ProtoReflect.Descriptor instead.
-func (*Event_NewDmItem) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 5}
+// Deprecated: Use Event_RoomMotd.ProtoReflect.Descriptor instead.
+func (*Event_RoomMotd) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 12}
 }
 
-func (x *Event_NewDmItem) GetItem() *DownloadManagerItem {
+func (x *Event_RoomMotd) GetText() string {
 	if x != nil {
-		return x.Item
+		return x.Text
 	}
-	return nil
+	return ""
 }
 
-type Event_DmItemRemoved struct {
+type Event_ClockSkew struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The item's UUID.
-	Uuid          string `protobuf:"bytes,1,opt,name=uuid,proto3" json:"uuid,omitempty"`
+	// How far the server's clock is ahead of the local clock, in milliseconds.
+	// Negative if the server's clock is behind.
+	SkewMs        int64 `protobuf:"varint,1,opt,name=skew_ms,json=skewMs,proto3" json:"skew_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Event_DmItemRemoved) Reset() {
-	*x = Event_DmItemRemoved{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[83]
+func (x *Event_ClockSkew) Reset() {
+	*x = Event_ClockSkew{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[248]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Event_DmItemRemoved) String() string {
+func (x *Event_ClockSkew) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Event_DmItemRemoved) ProtoMessage() {}
+func (*Event_ClockSkew) ProtoMessage() {}
 
-func (x *Event_DmItemRemoved) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[83]
+func (x *Event_ClockSkew) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[248]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4545,16 +15110,16 @@ func (x *Event_DmItemRemoved) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Event_DmItemRemoved.ProtoReflect.Descriptor instead.
-func (*Event_DmItemRemoved) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 6}
+// Deprecated: Use Event_ClockSkew.ProtoReflect.Descriptor instead.
+func (*Event_ClockSkew) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{0, 13}
 }
 
-func (x *Event_DmItemRemoved) GetUuid() string {
+func (x *Event_ClockSkew) GetSkewMs() int64 {
 	if x != nil {
-		return x.Uuid
+		return x.SkewMs
 	}
-	return ""
+	return 0
 }
 
 type DownloadManagerItem_Download struct {
@@ -4566,14 +15131,16 @@ type DownloadManagerItem_Download struct {
 	// The file's size in bytes, or -1 if not yet known.
 	FileSize int64 `protobuf:"varint,3,opt,name=file_size,json=fileSize,proto3" json:"file_size,omitempty"`
 	// The error message, if applicable.
-	ErrorMessage  *string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	ErrorMessage *string `protobuf:"bytes,6,opt,name=error_message,json=errorMessage,proto3,oneof" json:"error_message,omitempty"`
+	// The result of scanning the download once it completed.
+	ScanStatus    ScanStatus `protobuf:"varint,7,opt,name=scan_status,json=scanStatus,proto3,enum=pb.clientrpc.v1.ScanStatus" json:"scan_status,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *DownloadManagerItem_Download) Reset() {
 	*x = DownloadManagerItem_Download{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[84]
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[249]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4585,7 +15152,7 @@ func (x *DownloadManagerItem_Download) String() string {
 func (*DownloadManagerItem_Download) ProtoMessage() {}
 
 func (x *DownloadManagerItem_Download) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[84]
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[249]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4598,7 +15165,7 @@ func (x *DownloadManagerItem_Download) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DownloadManagerItem_Download.ProtoReflect.Descriptor instead.
 func (*DownloadManagerItem_Download) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{5, 0}
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{7, 0}
 }
 
 func (x *DownloadManagerItem_Download) GetStatus() DownloadStatus {
@@ -4629,17 +15196,37 @@ func (x *DownloadManagerItem_Download) GetErrorMessage() string {
 	return ""
 }
 
+func (x *DownloadManagerItem_Download) GetScanStatus() ScanStatus {
+	if x != nil {
+		return x.ScanStatus
+	}
+	return ScanStatus_SCAN_STATUS_UNSPECIFIED
+}
+
 type ServerInfo_State struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The current connection state.
-	ConnState     ServerConnState `protobuf:"varint,1,opt,name=conn_state,json=connState,proto3,enum=pb.clientrpc.v1.ServerConnState" json:"conn_state,omitempty"`
+	ConnState ServerConnState `protobuf:"varint,1,opt,name=conn_state,json=connState,proto3,enum=pb.clientrpc.v1.ServerConnState" json:"conn_state,omitempty"`
+	// Round-trip time statistics for pings sent to the server.
+	// Only set while the connection is open.
+	Rtt *RttStats `protobuf:"bytes,2,opt,name=rtt,proto3" json:"rtt,omitempty"`
+	// The room's message of the day, as of when the connection opened.
+	// Only set while the connection is open and the room has one.
+	Motd *string `protobuf:"bytes,3,opt,name=motd,proto3,oneof" json:"motd,omitempty"`
+	// Information the server reported about its software and the room's policies.
+	// Only set while the connection is open and the server supports reporting it.
+	Remote *RemoteServerInfo `protobuf:"bytes,4,opt,name=remote,proto3" json:"remote,omitempty"`
+	// How far the server's clock was ahead of the local clock when the connection opened, in milliseconds.
+	// Negative if the server's clock was behind.
+	// Only set while the connection is open and the server reported its time.
+	ClockSkewMs   *int64 `protobuf:"varint,5,opt,name=clock_skew_ms,json=clockSkewMs,proto3,oneof" json:"clock_skew_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ServerInfo_State) Reset() {
 	*x = ServerInfo_State{}
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[85]
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[250]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -4651,7 +15238,7 @@ func (x *ServerInfo_State) String() string {
 func (*ServerInfo_State) ProtoMessage() {}
 
 func (x *ServerInfo_State) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[85]
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[250]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -4664,7 +15251,7 @@ func (x *ServerInfo_State) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ServerInfo_State.ProtoReflect.Descriptor instead.
 func (*ServerInfo_State) Descriptor() ([]byte, []int) {
-	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{7, 0}
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{13, 0}
 }
 
 func (x *ServerInfo_State) GetConnState() ServerConnState {
@@ -4674,11 +15261,174 @@ func (x *ServerInfo_State) GetConnState() ServerConnState {
 	return ServerConnState_SERVER_CONN_STATE_UNSPECIFIED
 }
 
+func (x *ServerInfo_State) GetRtt() *RttStats {
+	if x != nil {
+		return x.Rtt
+	}
+	return nil
+}
+
+func (x *ServerInfo_State) GetMotd() string {
+	if x != nil && x.Motd != nil {
+		return *x.Motd
+	}
+	return ""
+}
+
+func (x *ServerInfo_State) GetRemote() *RemoteServerInfo {
+	if x != nil {
+		return x.Remote
+	}
+	return nil
+}
+
+func (x *ServerInfo_State) GetClockSkewMs() int64 {
+	if x != nil && x.ClockSkewMs != nil {
+		return *x.ClockSkewMs
+	}
+	return 0
+}
+
+type PluginEvent_ClientEvent struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The event.
+	Event *Event `protobuf:"bytes,1,opt,name=event,proto3" json:"event,omitempty"`
+	// The event's context.
+	Context       *EventContext `protobuf:"bytes,2,opt,name=context,proto3" json:"context,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginEvent_ClientEvent) Reset() {
+	*x = PluginEvent_ClientEvent{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[252]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginEvent_ClientEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginEvent_ClientEvent) ProtoMessage() {}
+
+func (x *PluginEvent_ClientEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[252]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginEvent_ClientEvent.ProtoReflect.Descriptor instead.
+func (*PluginEvent_ClientEvent) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{220, 0}
+}
+
+func (x *PluginEvent_ClientEvent) GetEvent() *Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *PluginEvent_ClientEvent) GetContext() *EventContext {
+	if x != nil {
+		return x.Context
+	}
+	return nil
+}
+
+type PluginEvent_Search struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The search's ID, to pass to RespondToSearch.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The UUID of the server the search came from.
+	ServerUuid string `protobuf:"bytes,2,opt,name=server_uuid,json=serverUuid,proto3" json:"server_uuid,omitempty"`
+	// The search query.
+	Query string `protobuf:"bytes,3,opt,name=query,proto3" json:"query,omitempty"`
+	// The maximum number of results the plugin can add.
+	MaxResults uint32 `protobuf:"varint,4,opt,name=max_results,json=maxResults,proto3" json:"max_results,omitempty"`
+	// The UNIX timestamp, in milliseconds, after which responses are no longer accepted.
+	DeadlineTsMs  int64 `protobuf:"varint,5,opt,name=deadline_ts_ms,json=deadlineTsMs,proto3" json:"deadline_ts_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PluginEvent_Search) Reset() {
+	*x = PluginEvent_Search{}
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[253]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PluginEvent_Search) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PluginEvent_Search) ProtoMessage() {}
+
+func (x *PluginEvent_Search) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_clientrpc_v1_rpc_proto_msgTypes[253]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PluginEvent_Search.ProtoReflect.Descriptor instead.
+func (*PluginEvent_Search) Descriptor() ([]byte, []int) {
+	return file_pb_clientrpc_v1_rpc_proto_rawDescGZIP(), []int{220, 1}
+}
+
+func (x *PluginEvent_Search) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *PluginEvent_Search) GetServerUuid() string {
+	if x != nil {
+		return x.ServerUuid
+	}
+	return ""
+}
+
+func (x *PluginEvent_Search) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+func (x *PluginEvent_Search) GetMaxResults() uint32 {
+	if x != nil {
+		return x.MaxResults
+	}
+	return 0
+}
+
+func (x *PluginEvent_Search) GetDeadlineTsMs() int64 {
+	if x != nil {
+		return x.DeadlineTsMs
+	}
+	return 0
+}
+
 var File_pb_clientrpc_v1_rpc_proto protoreflect.FileDescriptor
 
 const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"\n" +
-	"\x19pb/clientrpc/v1/rpc.proto\x12\x0fpb.clientrpc.v1\"\xa8\v\n" +
+	"\x19pb/clientrpc/v1/rpc.proto\x12\x0fpb.clientrpc.v1\"\xb8\x15\n" +
 	"\x05Event\x12/\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x1b.pb.clientrpc.v1.Event.TypeR\x04type\x12R\n" +
 	"\vserver_conn\x18\x02 \x01(\v2,.pb.clientrpc.v1.Event.ServerConnStateChangeH\x00R\n" +
@@ -4689,7 +15439,17 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"new_update\x18\x05 \x01(\v2 .pb.clientrpc.v1.Event.NewUpdateH\x03R\tnewUpdate\x88\x01\x01\x12i\n" +
 	"\x17download_status_updates\x18\x06 \x01(\v2,.pb.clientrpc.v1.Event.DownloadStatusUpdatesH\x04R\x15downloadStatusUpdates\x88\x01\x01\x12E\n" +
 	"\vnew_dm_item\x18\a \x01(\v2 .pb.clientrpc.v1.Event.NewDmItemH\x05R\tnewDmItem\x88\x01\x01\x12Q\n" +
-	"\x0fdm_item_removed\x18\b \x01(\v2$.pb.clientrpc.v1.Event.DmItemRemovedH\x06R\rdmItemRemoved\x88\x01\x01\x1aO\n" +
+	"\x0fdm_item_removed\x18\b \x01(\v2$.pb.clientrpc.v1.Event.DmItemRemovedH\x06R\rdmItemRemoved\x88\x01\x01\x12M\n" +
+	"\rshare_changed\x18\t \x01(\v2#.pb.clientrpc.v1.Event.ShareChangedH\aR\fshareChanged\x88\x01\x01\x12M\n" +
+	"\rserver_notice\x18\n" +
+	" \x01(\v2#.pb.clientrpc.v1.Event.ServerNoticeH\bR\fserverNotice\x88\x01\x01\x12M\n" +
+	"\rupload_update\x18\v \x01(\v2#.pb.clientrpc.v1.Event.UploadUpdateH\tR\fuploadUpdate\x88\x01\x01\x12_\n" +
+	"\x13downloads_recovered\x18\f \x01(\v2).pb.clientrpc.v1.Event.DownloadsRecoveredH\n" +
+	"R\x12downloadsRecovered\x88\x01\x01\x12P\n" +
+	"\x0eshutdown_drain\x18\r \x01(\v2$.pb.clientrpc.v1.Event.ShutdownDrainH\vR\rshutdownDrain\x88\x01\x01\x12A\n" +
+	"\troom_motd\x18\x0e \x01(\v2\x1f.pb.clientrpc.v1.Event.RoomMotdH\fR\broomMotd\x88\x01\x01\x12D\n" +
+	"\n" +
+	"clock_skew\x18\x0f \x01(\v2 .pb.clientrpc.v1.Event.ClockSkewH\rR\tclockSkew\x88\x01\x01\x1aO\n" +
 	"\x15ServerConnStateChange\x126\n" +
 	"\x05state\x18\x02 \x01(\x0e2 .pb.clientrpc.v1.ServerConnStateR\x05state\x1aC\n" +
 	"\fClientOnline\x123\n" +
@@ -4703,7 +15463,26 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"\tNewDmItem\x128\n" +
 	"\x04item\x18\x01 \x01(\v2$.pb.clientrpc.v1.DownloadManagerItemR\x04item\x1a#\n" +
 	"\rDmItemRemoved\x12\x12\n" +
-	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\xe6\x01\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x1a_\n" +
+	"\fShareChanged\x12\x1d\n" +
+	"\n" +
+	"share_name\x18\x01 \x01(\tR\tshareName\x12\x1a\n" +
+	"\brevision\x18\x02 \x01(\x04R\brevision\x12\x14\n" +
+	"\x05paths\x18\x03 \x03(\tR\x05paths\x1a\"\n" +
+	"\fServerNotice\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x1aC\n" +
+	"\fUploadUpdate\x123\n" +
+	"\x06upload\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.UploadInfoR\x06upload\x1aV\n" +
+	"\x12DownloadsRecovered\x12@\n" +
+	"\tdownloads\x18\x01 \x03(\v2\".pb.clientrpc.v1.RecoveredDownloadR\tdownloads\x1aW\n" +
+	"\rShutdownDrain\x12%\n" +
+	"\x0eactive_uploads\x18\x01 \x01(\rR\ractiveUploads\x12\x1f\n" +
+	"\vdeadline_ts\x18\x02 \x01(\x03R\n" +
+	"deadlineTs\x1a\x1e\n" +
+	"\bRoomMotd\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\x1a$\n" +
+	"\tClockSkew\x12\x17\n" +
+	"\askew_ms\x18\x01 \x01(\x03R\x06skewMs\"\x8e\x03\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\r\n" +
 	"\tTYPE_STOP\x10\x01\x12!\n" +
@@ -4713,14 +15492,30 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"\x0fTYPE_NEW_UPDATE\x10\x05\x12 \n" +
 	"\x1cTYPE_DOWNLOAD_STATUS_UPDATES\x10\x06\x12\x14\n" +
 	"\x10TYPE_NEW_DM_ITEM\x10\a\x12\x18\n" +
-	"\x14TYPE_DM_ITEM_REMOVED\x10\bB\x0e\n" +
+	"\x14TYPE_DM_ITEM_REMOVED\x10\b\x12\x16\n" +
+	"\x12TYPE_SHARE_CHANGED\x10\t\x12\x16\n" +
+	"\x12TYPE_SERVER_NOTICE\x10\n" +
+	"\x12\x16\n" +
+	"\x12TYPE_UPLOAD_UPDATE\x10\v\x12\x1c\n" +
+	"\x18TYPE_DOWNLOADS_RECOVERED\x10\f\x12\x17\n" +
+	"\x13TYPE_SHUTDOWN_DRAIN\x10\r\x12\x12\n" +
+	"\x0eTYPE_ROOM_MOTD\x10\x0e\x12\x13\n" +
+	"\x0fTYPE_CLOCK_SKEW\x10\x0fB\x0e\n" +
 	"\f_server_connB\x10\n" +
 	"\x0e_client_onlineB\x11\n" +
 	"\x0f_client_offlineB\r\n" +
 	"\v_new_updateB\x1a\n" +
 	"\x18_download_status_updatesB\x0e\n" +
 	"\f_new_dm_itemB\x12\n" +
-	"\x10_dm_item_removed\"/\n" +
+	"\x10_dm_item_removedB\x10\n" +
+	"\x0e_share_changedB\x10\n" +
+	"\x0e_server_noticeB\x10\n" +
+	"\x0e_upload_updateB\x16\n" +
+	"\x14_downloads_recoveredB\x11\n" +
+	"\x0f_shutdown_drainB\f\n" +
+	"\n" +
+	"_room_motdB\r\n" +
+	"\v_clock_skew\"/\n" +
 	"\fEventContext\x12\x1f\n" +
 	"\vserver_uuid\x18\x01 \x01(\tR\n" +
 	"serverUuid\"L\n" +
@@ -4734,7 +15529,7 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"\n" +
 	"created_ts\x18\x02 \x01(\x03R\tcreatedTs\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\x125\n" +
-	"\x05attrs\x18\x04 \x03(\v2\x1f.pb.clientrpc.v1.LogMessageAttrR\x05attrs\"\xf2\x01\n" +
+	"\x05attrs\x18\x04 \x03(\v2\x1f.pb.clientrpc.v1.LogMessageAttrR\x05attrs\"\xb0\x02\n" +
 	"\x14DownloadStatusUpdate\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x127\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x1f.pb.clientrpc.v1.DownloadStatusR\x06status\x12\x1e\n" +
@@ -4743,8 +15538,36 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"downloaded\x12\x1b\n" +
 	"\tfile_size\x18\x04 \x01(\x03R\bfileSize\x12\x14\n" +
 	"\x05speed\x18\x05 \x01(\x04R\x05speed\x12(\n" +
-	"\rerror_message\x18\x06 \x01(\tH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
-	"\x0e_error_message\"\x98\x04\n" +
+	"\rerror_message\x18\x06 \x01(\tH\x00R\ferrorMessage\x88\x01\x01\x12<\n" +
+	"\vscan_status\x18\a \x01(\x0e2\x1b.pb.clientrpc.v1.ScanStatusR\n" +
+	"scanStatusB\x10\n" +
+	"\x0e_error_message\"e\n" +
+	"\x11RecoveredDownload\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1e\n" +
+	"\n" +
+	"downloaded\x18\x02 \x01(\x04R\n" +
+	"downloaded\x12\x1c\n" +
+	"\tdiscarded\x18\x03 \x01(\x04R\tdiscarded\"\xac\x03\n" +
+	"\n" +
+	"UploadInfo\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1f\n" +
+	"\vserver_uuid\x18\x02 \x01(\tR\n" +
+	"serverUuid\x12#\n" +
+	"\rpeer_username\x18\x03 \x01(\tR\fpeerUsername\x12\x1b\n" +
+	"\tfile_path\x18\x04 \x01(\tR\bfilePath\x125\n" +
+	"\x06status\x18\x05 \x01(\x0e2\x1d.pb.clientrpc.v1.UploadStatusR\x06status\x12\x16\n" +
+	"\x06offset\x18\x06 \x01(\x04R\x06offset\x12\x1d\n" +
+	"\n" +
+	"bytes_sent\x18\a \x01(\x04R\tbytesSent\x12\x1b\n" +
+	"\tfile_size\x18\b \x01(\x04R\bfileSize\x12\x14\n" +
+	"\x05speed\x18\t \x01(\x04R\x05speed\x12\x1d\n" +
+	"\n" +
+	"started_ts\x18\n" +
+	" \x01(\x03R\tstartedTs\x12\x1e\n" +
+	"\bended_ts\x18\v \x01(\x03H\x00R\aendedTs\x88\x01\x01\x12(\n" +
+	"\rerror_message\x18\f \x01(\tH\x01R\ferrorMessage\x88\x01\x01B\v\n" +
+	"\t_ended_tsB\x10\n" +
+	"\x0e_error_message\"\xd6\x04\n" +
 	"\x13DownloadManagerItem\x12=\n" +
 	"\x04type\x18\x01 \x01(\x0e2).pb.clientrpc.v1.DownloadManagerItem.TypeR\x04type\x12\x12\n" +
 	"\x04uuid\x18\x02 \x01(\tR\x04uuid\x12\x1f\n" +
@@ -4752,19 +15575,29 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"serverUuid\x12#\n" +
 	"\rpeer_username\x18\x04 \x01(\tR\fpeerUsername\x12\x1b\n" +
 	"\tfile_path\x18\x05 \x01(\tR\bfilePath\x12N\n" +
-	"\bdownload\x18\x06 \x01(\v2-.pb.clientrpc.v1.DownloadManagerItem.DownloadH\x00R\bdownload\x88\x01\x01\x1a\xbc\x01\n" +
+	"\bdownload\x18\x06 \x01(\v2-.pb.clientrpc.v1.DownloadManagerItem.DownloadH\x00R\bdownload\x88\x01\x01\x1a\xfa\x01\n" +
 	"\bDownload\x127\n" +
 	"\x06status\x18\x01 \x01(\x0e2\x1f.pb.clientrpc.v1.DownloadStatusR\x06status\x12\x1e\n" +
 	"\n" +
 	"downloaded\x18\x02 \x01(\x04R\n" +
 	"downloaded\x12\x1b\n" +
 	"\tfile_size\x18\x03 \x01(\x03R\bfileSize\x12(\n" +
-	"\rerror_message\x18\x06 \x01(\tH\x00R\ferrorMessage\x88\x01\x01B\x10\n" +
+	"\rerror_message\x18\x06 \x01(\tH\x00R\ferrorMessage\x88\x01\x01\x12<\n" +
+	"\vscan_status\x18\a \x01(\x0e2\x1b.pb.clientrpc.v1.ScanStatusR\n" +
+	"scanStatusB\x10\n" +
 	"\x0e_error_message\"/\n" +
 	"\x04Type\x12\x14\n" +
 	"\x10TYPE_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rTYPE_DOWNLOAD\x10\x01B\v\n" +
-	"\t_download\"\x94\x01\n" +
+	"\t_download\"\xd0\x01\n" +
+	"\x10DownloadHookInfo\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x02 \x01(\x03R\tcreatedTs\x125\n" +
+	"\x04type\x18\x03 \x01(\x0e2!.pb.clientrpc.v1.DownloadHookTypeR\x04type\x12\x16\n" +
+	"\x06target\x18\x04 \x01(\tR\x06target\x12(\n" +
+	"\rdownload_uuid\x18\x05 \x01(\tH\x00R\fdownloadUuid\x88\x01\x01B\x10\n" +
+	"\x0e_download_uuid\"\xb1\x01\n" +
 	"\n" +
 	"UpdateInfo\x12\x19\n" +
 	"\bis_valid\x18\x01 \x01(\bR\aisValid\x12\x1d\n" +
@@ -4772,7 +15605,28 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"created_ts\x18\x02 \x01(\x03R\tcreatedTs\x12\x18\n" +
 	"\aversion\x18\x03 \x01(\tR\aversion\x12 \n" +
 	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x10\n" +
-	"\x03url\x18\x05 \x01(\tR\x03url\"\xa0\x02\n" +
+	"\x03url\x18\x05 \x01(\tR\x03url\x12\x1b\n" +
+	"\tcan_apply\x18\x06 \x01(\bR\bcanApply\"e\n" +
+	"\x0eUpdateSettings\x128\n" +
+	"\achannel\x18\x01 \x01(\x0e2\x1e.pb.clientrpc.v1.UpdateChannelR\achannel\x12\x19\n" +
+	"\bbase_url\x18\x02 \x01(\tR\abaseUrl\"\x8e\x01\n" +
+	"\tErrorInfo\x124\n" +
+	"\x06reason\x18\x01 \x01(\x0e2\x1c.pb.clientrpc.v1.ErrorReasonR\x06reason\x12\x1d\n" +
+	"\amessage\x18\x02 \x01(\tH\x00R\amessage\x88\x01\x01\x12\x17\n" +
+	"\x04host\x18\x03 \x01(\tH\x01R\x04host\x88\x01\x01B\n" +
+	"\n" +
+	"\b_messageB\a\n" +
+	"\x05_host\"\x82\x02\n" +
+	"\bRttStats\x12\x17\n" +
+	"\alast_us\x18\x01 \x01(\x03R\x06lastUs\x12\x15\n" +
+	"\x06min_us\x18\x02 \x01(\x03R\x05minUs\x12\x15\n" +
+	"\x06avg_us\x18\x03 \x01(\x03R\x05avgUs\x12\x15\n" +
+	"\x06max_us\x18\x04 \x01(\x03R\x05maxUs\x12\x18\n" +
+	"\asamples\x18\x05 \x01(\rR\asamples\x12\x12\n" +
+	"\x04lost\x18\x06 \x01(\x04R\x04lost\x12)\n" +
+	"\x10consecutive_lost\x18\a \x01(\rR\x0fconsecutiveLost\x12+\n" +
+	"\x0fclock_offset_us\x18\b \x01(\x03H\x00R\rclockOffsetUs\x88\x01\x01B\x12\n" +
+	"\x10_clock_offset_us\"\xe6\x03\n" +
 	"\n" +
 	"ServerInfo\x127\n" +
 	"\x05state\x18\x01 \x01(\v2!.pb.clientrpc.v1.ServerInfo.StateR\x05state\x12\x12\n" +
@@ -4782,10 +15636,26 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"\x04room\x18\x05 \x01(\tR\x04room\x12\x1a\n" +
 	"\busername\x18\x06 \x01(\tR\busername\x12\x1d\n" +
 	"\n" +
-	"created_ts\x18\a \x01(\x03R\tcreatedTs\x1aH\n" +
+	"created_ts\x18\a \x01(\x03R\tcreatedTs\x1a\x8d\x02\n" +
 	"\x05State\x12?\n" +
 	"\n" +
-	"conn_state\x18\x01 \x01(\x0e2 .pb.clientrpc.v1.ServerConnStateR\tconnState\"\xaa\x01\n" +
+	"conn_state\x18\x01 \x01(\x0e2 .pb.clientrpc.v1.ServerConnStateR\tconnState\x12+\n" +
+	"\x03rtt\x18\x02 \x01(\v2\x19.pb.clientrpc.v1.RttStatsR\x03rtt\x12\x17\n" +
+	"\x04motd\x18\x03 \x01(\tH\x00R\x04motd\x88\x01\x01\x129\n" +
+	"\x06remote\x18\x04 \x01(\v2!.pb.clientrpc.v1.RemoteServerInfoR\x06remote\x12'\n" +
+	"\rclock_skew_ms\x18\x05 \x01(\x03H\x01R\vclockSkewMs\x88\x01\x01B\a\n" +
+	"\x05_motdB\x10\n" +
+	"\x0e_clock_skew_ms\"\xf1\x02\n" +
+	"\x10RemoteServerInfo\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12)\n" +
+	"\x10protocol_version\x18\x02 \x01(\tR\x0fprotocolVersion\x12\x1a\n" +
+	"\bfeatures\x18\x03 \x03(\tR\bfeatures\x12\x1f\n" +
+	"\vmax_clients\x18\x04 \x01(\rR\n" +
+	"maxClients\x12>\n" +
+	"\x1cmax_proxy_streams_per_client\x18\x05 \x01(\rR\x18maxProxyStreamsPerClient\x126\n" +
+	"\x17max_concurrent_requests\x18\x06 \x01(\rR\x15maxConcurrentRequests\x12'\n" +
+	"\x10dir_cache_ttl_ms\x18\a \x01(\rR\rdirCacheTtlMs\x12:\n" +
+	"\x1arelay_max_bytes_per_second\x18\b \x01(\x03R\x16relayMaxBytesPerSecond\"\xc4\x03\n" +
 	"\tShareInfo\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1f\n" +
 	"\vserver_uuid\x18\x02 \x01(\tR\n" +
@@ -4794,13 +15664,59 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"\x04path\x18\x04 \x01(\tR\x04path\x12!\n" +
 	"\ffollow_links\x18\x05 \x01(\bR\vfollowLinks\x12\x1d\n" +
 	"\n" +
-	"created_ts\x18\x06 \x01(\x03R\tcreatedTs\",\n" +
+	"created_ts\x18\x06 \x01(\x03R\tcreatedTs\x123\n" +
+	"\x06mounts\x18\a \x03(\v2\x1b.pb.clientrpc.v1.ShareMountR\x06mounts\x12G\n" +
+	"\rconflict_rule\x18\b \x01(\x0e2\".pb.clientrpc.v1.ShareConflictRuleR\fconflictRule\x12)\n" +
+	"\x10exclude_patterns\x18\t \x03(\tR\x0fexcludePatterns\x12)\n" +
+	"\x10case_insensitive\x18\n" +
+	" \x01(\bR\x0fcaseInsensitive\x12D\n" +
+	"\funicode_form\x18\v \x01(\x0e2!.pb.clientrpc.v1.ShareUnicodeFormR\vunicodeForm\"C\n" +
+	"\n" +
+	"ShareMount\x12!\n" +
+	"\fvirtual_path\x18\x01 \x01(\tR\vvirtualPath\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\"\xea\x01\n" +
+	"\rShareLinkInfo\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x1f\n" +
+	"\vserver_uuid\x18\x02 \x01(\tR\n" +
+	"serverUuid\x12\x1d\n" +
+	"\n" +
+	"share_name\x18\x03 \x01(\tR\tshareName\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x05 \x01(\x03R\tcreatedTs\x12\"\n" +
+	"\n" +
+	"expires_ts\x18\x06 \x01(\x03H\x00R\texpiresTs\x88\x01\x01\x12\x15\n" +
+	"\x03url\x18\a \x01(\tH\x01R\x03url\x88\x01\x01B\r\n" +
+	"\v_expires_tsB\x06\n" +
+	"\x04_url\"\xd9\x01\n" +
 	"\x0eOnlineUserInfo\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\"I\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x128\n" +
+	"\x06friend\x18\x02 \x01(\v2\x1b.pb.clientrpc.v1.FriendInfoH\x00R\x06friend\x88\x01\x01\x12\x18\n" +
+	"\ablocked\x18\x03 \x01(\bR\ablocked\x12=\n" +
+	"\n" +
+	"direct_rtt\x18\x04 \x01(\v2\x19.pb.clientrpc.v1.RttStatsH\x01R\tdirectRtt\x88\x01\x01B\t\n" +
+	"\a_friendB\r\n" +
+	"\v_direct_rtt\"\xf5\x01\n" +
+	"\n" +
+	"FriendInfo\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12<\n" +
+	"\vtrust_level\x18\x05 \x01(\x0e2\x1b.pb.clientrpc.v1.TrustLevelR\n" +
+	"trustLevel\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x06 \x01(\x03R\tcreatedTs\x12\x1d\n" +
+	"\n" +
+	"updated_ts\x18\a \x01(\x03R\tupdatedTs\"\x7f\n" +
 	"\bFileMeta\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
 	"\x06is_dir\x18\x02 \x01(\bR\x05isDir\x12\x12\n" +
-	"\x04size\x18\x03 \x01(\x04R\x04size\"\xed\x02\n" +
+	"\x04size\x18\x03 \x01(\x04R\x04size\x12$\n" +
+	"\vmodified_ts\x18\x04 \x01(\x03H\x00R\n" +
+	"modifiedTs\x88\x01\x01B\x0e\n" +
+	"\f_modified_ts\"\xed\x02\n" +
 	"\x0eDirectSettings\x12\x18\n" +
 	"\adisable\x18\x01 \x01(\bR\adisable\x12\x1c\n" +
 	"\taddresses\x18\x02 \x03(\tR\taddresses\x12!\n" +
@@ -4809,11 +15725,26 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"\x15advertise_private_ips\x18\x05 \x01(\bR\x13advertisePrivateIps\x12=\n" +
 	"\x1bdisable_public_ip_discovery\x18\x06 \x01(\bR\x18disablePublicIpDiscovery\x12!\n" +
 	"\fdisable_upnp\x18\a \x01(\bR\vdisableUpnp\x12&\n" +
-	"\x0fupnp_timeout_ms\x18\b \x01(\rR\rupnpTimeoutMs\"\xb1\x01\n" +
+	"\x0fupnp_timeout_ms\x18\b \x01(\rR\rupnpTimeoutMs\"\xfd\x04\n" +
 	"\x10TransferSettings\x121\n" +
 	"\x14download_concurrency\x18\x01 \x01(\rR\x13downloadConcurrency\x126\n" +
 	"\x17incomplete_download_dir\x18\x02 \x01(\tR\x15incompleteDownloadDir\x122\n" +
-	"\x15complete_download_dir\x18\x03 \x01(\tR\x13completeDownloadDir\"\x15\n" +
+	"\x15complete_download_dir\x18\x03 \x01(\tR\x13completeDownloadDir\x124\n" +
+	"\x16download_path_template\x18\x04 \x01(\tR\x14downloadPathTemplate\x12\x84\x01\n" +
+	"\x1dserver_complete_download_dirs\x18\x05 \x03(\v2A.pb.clientrpc.v1.TransferSettings.ServerCompleteDownloadDirsEntryR\x1aserverCompleteDownloadDirs\x12>\n" +
+	"\x1cpart_files_in_incomplete_dir\x18\x06 \x01(\bR\x18partFilesInIncompleteDir\x124\n" +
+	"\x16shutdown_grace_seconds\x18\a \x01(\rR\x14shutdownGraceSeconds\x12!\n" +
+	"\fscan_command\x18\b \x01(\tR\vscanCommand\x12%\n" +
+	"\x0equarantine_dir\x18\t \x01(\tR\rquarantineDir\x1aM\n" +
+	"\x1fServerCompleteDownloadDirsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\tR\x05value:\x028\x01\"\xa3\x01\n" +
+	"\x14NotificationSettings\x12\x18\n" +
+	"\adesktop\x18\x01 \x01(\bR\adesktop\x12\x1f\n" +
+	"\vwebhook_url\x18\x02 \x01(\tR\n" +
+	"webhookUrl\x12+\n" +
+	"\x11download_complete\x18\x03 \x01(\bR\x10downloadComplete\x12#\n" +
+	"\rfriend_online\x18\x04 \x01(\bR\ffriendOnline\"\x15\n" +
 	"\x13StreamEventsRequest\"}\n" +
 	"\x14StreamEventsResponse\x12,\n" +
 	"\x05event\x18\x01 \x01(\v2\x16.pb.clientrpc.v1.EventR\x05event\x127\n" +
@@ -4825,19 +15756,115 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"\x04logs\x18\x01 \x03(\v2\x1b.pb.clientrpc.v1.LogMessageR\x04logs\"\r\n" +
 	"\vStopRequest\"\x0e\n" +
 	"\fStopResponse\"\x16\n" +
-	"\x14GetClientInfoRequest\"\x17\n" +
-	"\x15GetClientInfoResponse\"\x13\n" +
-	"\x11GetServersRequest\"K\n" +
+	"\x14GetClientInfoRequest\"\xdd\x01\n" +
+	"\x15GetClientInfoResponse\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12)\n" +
+	"\x10protocol_version\x18\x02 \x01(\tR\x0fprotocolVersion\x12!\n" +
+	"\fbuild_commit\x18\x03 \x01(\tR\vbuildCommit\x12\x19\n" +
+	"\bstart_ts\x18\x04 \x01(\x03R\astartTs\x12%\n" +
+	"\x0euptime_seconds\x18\x05 \x01(\x03R\ruptimeSeconds\x12\x1a\n" +
+	"\bfeatures\x18\x06 \x03(\tR\bfeatures\"\x17\n" +
+	"\x15GetMemoryUsageRequest\"\x85\x04\n" +
+	"\x16GetMemoryUsageResponse\x12\x1d\n" +
+	"\n" +
+	"low_memory\x18\x01 \x01(\bR\tlowMemory\x12,\n" +
+	"\x12low_memory_setting\x18\x02 \x01(\bR\x10lowMemorySetting\x12\x1d\n" +
+	"\n" +
+	"used_bytes\x18\x03 \x01(\x04R\tusedBytes\x12\x1d\n" +
+	"\n" +
+	"heap_bytes\x18\x04 \x01(\x04R\theapBytes\x12(\n" +
+	"\x10soft_limit_bytes\x18\x05 \x01(\x04R\x0esoftLimitBytes\x129\n" +
+	"\x19read_ahead_buffered_bytes\x18\x06 \x01(\x04R\x16readAheadBufferedBytes\x12/\n" +
+	"\x14max_read_ahead_bytes\x18\a \x01(\x04R\x11maxReadAheadBytes\x128\n" +
+	"\x18max_download_concurrency\x18\b \x01(\rR\x16maxDownloadConcurrency\x120\n" +
+	"\x14max_incoming_streams\x18\t \x01(\rR\x12maxIncomingStreams\x126\n" +
+	"\x17max_concurrent_requests\x18\n" +
+	" \x01(\rR\x15maxConcurrentRequests\x12&\n" +
+	"\x0fmax_index_files\x18\v \x01(\rR\rmaxIndexFiles\"3\n" +
+	"\x17SetLowMemoryModeRequest\x12\x18\n" +
+	"\aenabled\x18\x01 \x01(\bR\aenabled\"\x1a\n" +
+	"\x18SetLowMemoryModeResponse\"A\n" +
+	"\x11GetServersRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\rR\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x02 \x01(\tR\x06cursor\"\x82\x01\n" +
 	"\x12GetServersResponse\x125\n" +
-	"\aservers\x18\x01 \x03(\v2\x1b.pb.clientrpc.v1.ServerInfoR\aservers\"\x8f\x01\n" +
+	"\aservers\x18\x01 \x03(\v2\x1b.pb.clientrpc.v1.ServerInfoR\aservers\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\rR\x05total\"\xc3\x01\n" +
 	"\x13CreateServerRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
 	"\aaddress\x18\x02 \x01(\tR\aaddress\x12\x12\n" +
 	"\x04room\x18\x03 \x01(\tR\x04room\x12\x1a\n" +
 	"\busername\x18\x04 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x05 \x01(\tR\bpassword\"K\n" +
+	"\bpassword\x18\x05 \x01(\tR\bpassword\x122\n" +
+	"\x15account_template_uuid\x18\x06 \x01(\tR\x13accountTemplateUuid\"p\n" +
 	"\x14CreateServerResponse\x123\n" +
-	"\x06server\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.ServerInfoR\x06server\")\n" +
+	"\x06server\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.ServerInfoR\x06server\x12#\n" +
+	"\rfailed_shares\x18\x02 \x03(\tR\ffailedShares\"\xad\x01\n" +
+	"\x19ImportInviteBundleRequest\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\x122\n" +
+	"\x15account_template_uuid\x18\x05 \x01(\tR\x13accountTemplateUuid\"v\n" +
+	"\x1aImportInviteBundleResponse\x123\n" +
+	"\x06server\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.ServerInfoR\x06server\x12#\n" +
+	"\rfailed_shares\x18\x02 \x03(\tR\ffailedShares\"a\n" +
+	"\x14AccountTemplateShare\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12!\n" +
+	"\ffollow_links\x18\x03 \x01(\bR\vfollowLinks\"\xb3\x01\n" +
+	"\x0fAccountTemplate\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12=\n" +
+	"\x06shares\x18\x04 \x03(\v2%.pb.clientrpc.v1.AccountTemplateShareR\x06shares\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x05 \x01(\x03R\tcreatedTs\"\xa9\x01\n" +
+	"\x1cCreateAccountTemplateRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12=\n" +
+	"\x06shares\x18\x04 \x03(\v2%.pb.clientrpc.v1.AccountTemplateShareR\x06shares\"]\n" +
+	"\x1dCreateAccountTemplateResponse\x12<\n" +
+	"\btemplate\x18\x01 \x01(\v2 .pb.clientrpc.v1.AccountTemplateR\btemplate\"]\n" +
+	"&CreateAccountTemplateFromServerRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"g\n" +
+	"'CreateAccountTemplateFromServerResponse\x12<\n" +
+	"\btemplate\x18\x01 \x01(\v2 .pb.clientrpc.v1.AccountTemplateR\btemplate\"\x1c\n" +
+	"\x1aGetAccountTemplatesRequest\"]\n" +
+	"\x1bGetAccountTemplatesResponse\x12>\n" +
+	"\ttemplates\x18\x01 \x03(\v2 .pb.clientrpc.v1.AccountTemplateR\ttemplates\"2\n" +
+	"\x1cDeleteAccountTemplateRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x1f\n" +
+	"\x1dDeleteAccountTemplateResponse\"\xdd\x01\n" +
+	"\vHostingInfo\x12\x18\n" +
+	"\ahosting\x18\x01 \x01(\bR\ahosting\x12\x12\n" +
+	"\x04room\x18\x02 \x01(\tR\x04room\x12\x12\n" +
+	"\x04port\x18\x03 \x01(\rR\x04port\x12)\n" +
+	"\x10cert_fingerprint\x18\x04 \x01(\tR\x0fcertFingerprint\x12\x1f\n" +
+	"\vserver_uuid\x18\x05 \x01(\tR\n" +
+	"serverUuid\x12%\n" +
+	"\x0eonline_clients\x18\x06 \x01(\rR\ronlineClients\x12\x19\n" +
+	"\bstart_ts\x18\a \x01(\x03R\astartTs\"Y\n" +
+	"\x13StartHostingRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12\x12\n" +
+	"\x04port\x18\x02 \x01(\rR\x04port\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\"H\n" +
+	"\x14StartHostingResponse\x120\n" +
+	"\x04info\x18\x01 \x01(\v2\x1c.pb.clientrpc.v1.HostingInfoR\x04info\"\x14\n" +
+	"\x12StopHostingRequest\"\x15\n" +
+	"\x13StopHostingResponse\"\x17\n" +
+	"\x15GetHostingInfoRequest\"J\n" +
+	"\x16GetHostingInfoResponse\x120\n" +
+	"\x04info\x18\x01 \x01(\v2\x1c.pb.clientrpc.v1.HostingInfoR\x04info\"6\n" +
+	"\x1aCreateHostingInviteRequest\x12\x18\n" +
+	"\aaddress\x18\x01 \x01(\tR\aaddress\"/\n" +
+	"\x1bCreateHostingInviteResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\")\n" +
 	"\x13DeleteServerRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x16\n" +
 	"\x14DeleteServerResponse\"*\n" +
@@ -4861,39 +15888,165 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"\t_usernameB\v\n" +
 	"\t_password\"K\n" +
 	"\x14UpdateServerResponse\x123\n" +
-	"\x06server\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.ServerInfoR\x06server\"3\n" +
+	"\x06server\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.ServerInfoR\x06server\"a\n" +
 	"\x10GetSharesRequest\x12\x1f\n" +
 	"\vserver_uuid\x18\x01 \x01(\tR\n" +
-	"serverUuid\"G\n" +
+	"serverUuid\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"~\n" +
 	"\x11GetSharesResponse\x122\n" +
-	"\x06shares\x18\x01 \x03(\v2\x1a.pb.clientrpc.v1.ShareInfoR\x06shares\"\x80\x01\n" +
+	"\x06shares\x18\x01 \x03(\v2\x1a.pb.clientrpc.v1.ShareInfoR\x06shares\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\rR\x05total\"\x9a\x03\n" +
 	"\x12CreateShareRequest\x12\x1f\n" +
 	"\vserver_uuid\x18\x01 \x01(\tR\n" +
 	"serverUuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
 	"\x04path\x18\x03 \x01(\tR\x04path\x12!\n" +
-	"\ffollow_links\x18\x04 \x01(\bR\vfollowLinks\"G\n" +
+	"\ffollow_links\x18\x04 \x01(\bR\vfollowLinks\x123\n" +
+	"\x06mounts\x18\x05 \x03(\v2\x1b.pb.clientrpc.v1.ShareMountR\x06mounts\x12G\n" +
+	"\rconflict_rule\x18\x06 \x01(\x0e2\".pb.clientrpc.v1.ShareConflictRuleR\fconflictRule\x12)\n" +
+	"\x10exclude_patterns\x18\a \x03(\tR\x0fexcludePatterns\x12)\n" +
+	"\x10case_insensitive\x18\b \x01(\bR\x0fcaseInsensitive\x12D\n" +
+	"\funicode_form\x18\t \x01(\x0e2!.pb.clientrpc.v1.ShareUnicodeFormR\vunicodeForm\"G\n" +
 	"\x13CreateShareResponse\x120\n" +
 	"\x05share\x18\x01 \x01(\v2\x1a.pb.clientrpc.v1.ShareInfoR\x05share\"I\n" +
 	"\x12DeleteShareRequest\x12\x1f\n" +
 	"\vserver_uuid\x18\x01 \x01(\tR\n" +
 	"serverUuid\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\"\x15\n" +
-	"\x13DeleteShareResponse\"e\n" +
+	"\x13DeleteShareResponse\"\x80\x01\n" +
+	"\x1eSetShareExcludePatternsRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12)\n" +
+	"\x10exclude_patterns\x18\x03 \x03(\tR\x0fexcludePatterns\"S\n" +
+	"\x1fSetShareExcludePatternsResponse\x120\n" +
+	"\x05share\x18\x01 \x01(\v2\x1a.pb.clientrpc.v1.ShareInfoR\x05share\"\x8c\x01\n" +
+	"\x10ShareHealthIssue\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x129\n" +
+	"\x04kind\x18\x02 \x01(\x0e2%.pb.clientrpc.v1.ShareHealthIssueKindR\x04kind\x12\x1d\n" +
+	"\amessage\x18\x03 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
+	"\n" +
+	"\b_message\"\x81\x01\n" +
+	"\x17CheckShareHealthRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\"\n" +
+	"\n" +
+	"max_issues\x18\x03 \x01(\rH\x00R\tmaxIssues\x88\x01\x01B\r\n" +
+	"\v_max_issues\"s\n" +
+	"\x18CheckShareHealthResponse\x129\n" +
+	"\x06issues\x18\x01 \x03(\v2!.pb.clientrpc.v1.ShareHealthIssueR\x06issues\x12\x1c\n" +
+	"\ttruncated\x18\x02 \x01(\bR\ttruncated\"~\n" +
+	"\x10ShareImportEntry\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x12!\n" +
+	"\ffollow_links\x18\x04 \x01(\bR\vfollowLinks\"J\n" +
+	"\rShareManifest\x129\n" +
+	"\x06shares\x18\x01 \x03(\v2!.pb.clientrpc.v1.ShareImportEntryR\x06shares\"\xb2\x01\n" +
+	"\x11ShareImportResult\x127\n" +
+	"\x05entry\x18\x01 \x01(\v2!.pb.clientrpc.v1.ShareImportEntryR\x05entry\x12\x19\n" +
+	"\x05error\x18\x02 \x01(\tH\x00R\x05error\x88\x01\x01\x125\n" +
+	"\x05share\x18\x03 \x01(\v2\x1a.pb.clientrpc.v1.ShareInfoH\x01R\x05share\x88\x01\x01B\b\n" +
+	"\x06_errorB\b\n" +
+	"\x06_share\"\xa7\x01\n" +
+	"\x13ImportSharesRequest\x12;\n" +
+	"\aentries\x18\x01 \x03(\v2!.pb.clientrpc.v1.ShareImportEntryR\aentries\x12(\n" +
+	"\rmanifest_path\x18\x02 \x01(\tH\x00R\fmanifestPath\x88\x01\x01\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRunB\x10\n" +
+	"\x0e_manifest_path\"p\n" +
+	"\x14ImportSharesResponse\x12<\n" +
+	"\aresults\x18\x01 \x03(\v2\".pb.clientrpc.v1.ShareImportResultR\aresults\x12\x1a\n" +
+	"\bimported\x18\x02 \x01(\bR\bimported\"\xb6\x01\n" +
+	"\x16CreateShareLinkRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x1d\n" +
+	"\n" +
+	"share_name\x18\x02 \x01(\tR\tshareName\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x121\n" +
+	"\x12expires_in_seconds\x18\x04 \x01(\rH\x00R\x10expiresInSeconds\x88\x01\x01B\x15\n" +
+	"\x13_expires_in_seconds\"M\n" +
+	"\x17CreateShareLinkResponse\x122\n" +
+	"\x04link\x18\x01 \x01(\v2\x1e.pb.clientrpc.v1.ShareLinkInfoR\x04link\"V\n" +
+	"\x14GetShareLinksRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x1d\n" +
+	"\n" +
+	"share_name\x18\x02 \x01(\tR\tshareName\"M\n" +
+	"\x15GetShareLinksResponse\x124\n" +
+	"\x05links\x18\x01 \x03(\v2\x1e.pb.clientrpc.v1.ShareLinkInfoR\x05links\".\n" +
+	"\x16DeleteShareLinkRequest\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\"\x19\n" +
+	"\x17DeleteShareLinkResponse\"e\n" +
 	"\x12GetDirFilesRequest\x12\x1f\n" +
 	"\vserver_uuid\x18\x01 \x01(\tR\n" +
 	"serverUuid\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
 	"\x04path\x18\x03 \x01(\tR\x04path\"J\n" +
 	"\x13GetDirFilesResponse\x123\n" +
-	"\acontent\x18\x02 \x03(\v2\x19.pb.clientrpc.v1.FileMetaR\acontent\"e\n" +
+	"\acontent\x18\x02 \x03(\v2\x19.pb.clientrpc.v1.FileMetaR\acontent\"\xa2\x01\n" +
+	"\x17StreamDirArchiveRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x126\n" +
+	"\x06format\x18\x04 \x01(\x0e2\x1e.pb.clientrpc.v1.ArchiveFormatR\x06format\".\n" +
+	"\x18StreamDirArchiveResponse\x12\x12\n" +
+	"\x04data\x18\x01 \x01(\fR\x04data\"e\n" +
 	"\x12GetFileMetaRequest\x12\x1f\n" +
 	"\vserver_uuid\x18\x01 \x01(\tR\n" +
 	"serverUuid\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x12\n" +
 	"\x04path\x18\x03 \x01(\tR\x04path\"D\n" +
 	"\x13GetFileMetaResponse\x12-\n" +
-	"\x04meta\x18\x01 \x01(\v2\x19.pb.clientrpc.v1.FileMetaR\x04meta\"8\n" +
+	"\x04meta\x18\x01 \x01(\v2\x19.pb.clientrpc.v1.FileMetaR\x04meta\"\xc4\x01\n" +
+	"\x15CreateFileLinkRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x1f\n" +
+	"\busername\x18\x02 \x01(\tH\x00R\busername\x88\x01\x01\x12\x12\n" +
+	"\x04path\x18\x03 \x01(\tR\x04path\x121\n" +
+	"\x12expires_in_seconds\x18\x04 \x01(\rH\x01R\x10expiresInSeconds\x88\x01\x01B\v\n" +
+	"\t_usernameB\x15\n" +
+	"\x13_expires_in_seconds\"a\n" +
+	"\x16CreateFileLinkResponse\x12\x14\n" +
+	"\x05token\x18\x01 \x01(\tR\x05token\x12\x12\n" +
+	"\x04path\x18\x02 \x01(\tR\x04path\x12\x1d\n" +
+	"\n" +
+	"expires_ts\x18\x03 \x01(\x03R\texpiresTs\"\xe0\x01\n" +
+	"\x10DiagnosticResult\x123\n" +
+	"\x04step\x18\x01 \x01(\x0e2\x1f.pb.clientrpc.v1.DiagnosticStepR\x04step\x129\n" +
+	"\x06status\x18\x02 \x01(\x0e2!.pb.clientrpc.v1.DiagnosticStatusR\x06status\x12\x16\n" +
+	"\x06detail\x18\x03 \x01(\tR\x06detail\x12\x19\n" +
+	"\x05error\x18\x04 \x01(\tH\x00R\x05error\x88\x01\x01\x12\x1f\n" +
+	"\vduration_us\x18\x05 \x01(\x03R\n" +
+	"durationUsB\b\n" +
+	"\x06_error\"2\n" +
+	"\x0fDiagnoseRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\"O\n" +
+	"\x10DiagnoseResponse\x12;\n" +
+	"\aresults\x18\x01 \x03(\v2!.pb.clientrpc.v1.DiagnosticResultR\aresults\"\xea\x01\n" +
+	"\x12MeasurePeerRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12-\n" +
+	"\x04path\x18\x03 \x01(\x0e2\x19.pb.clientrpc.v1.PeerPathR\x04path\x12\x19\n" +
+	"\x05pings\x18\x04 \x01(\rH\x00R\x05pings\x88\x01\x01\x12.\n" +
+	"\x10throughput_bytes\x18\x05 \x01(\x04H\x01R\x0fthroughputBytes\x88\x01\x01B\b\n" +
+	"\x06_pingsB\x13\n" +
+	"\x11_throughput_bytes\"\xf8\x01\n" +
+	"\x13MeasurePeerResponse\x12-\n" +
+	"\x04path\x18\x01 \x01(\x0e2\x19.pb.clientrpc.v1.PeerPathR\x04path\x12$\n" +
+	"\x0elatency_min_us\x18\x02 \x01(\x03R\flatencyMinUs\x12$\n" +
+	"\x0elatency_avg_us\x18\x03 \x01(\x03R\flatencyAvgUs\x12$\n" +
+	"\x0elatency_max_us\x18\x04 \x01(\x03R\flatencyMaxUs\x12!\n" +
+	"\fdownload_bps\x18\x05 \x01(\x01R\vdownloadBps\x12\x1d\n" +
+	"\n" +
+	"upload_bps\x18\x06 \x01(\x01R\tuploadBps\"8\n" +
 	"\x15GetOnlineUsersRequest\x12\x1f\n" +
 	"\vserver_uuid\x18\x01 \x01(\tR\n" +
 	"serverUuid\"O\n" +
@@ -4922,7 +16075,30 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"\bsettings\x18\x01 \x01(\v2!.pb.clientrpc.v1.TransferSettingsR\bsettings\"^\n" +
 	"\x1dUpdateTransferSettingsRequest\x12=\n" +
 	"\bsettings\x18\x01 \x01(\v2!.pb.clientrpc.v1.TransferSettingsR\bsettings\" \n" +
-	"\x1eUpdateTransferSettingsResponse\"H\n" +
+	"\x1eUpdateTransferSettingsResponse\" \n" +
+	"\x1eGetNotificationSettingsRequest\"d\n" +
+	"\x1fGetNotificationSettingsResponse\x12A\n" +
+	"\bsettings\x18\x01 \x01(\v2%.pb.clientrpc.v1.NotificationSettingsR\bsettings\"f\n" +
+	"!UpdateNotificationSettingsRequest\x12A\n" +
+	"\bsettings\x18\x01 \x01(\v2%.pb.clientrpc.v1.NotificationSettingsR\bsettings\"$\n" +
+	"\"UpdateNotificationSettingsResponse\"1\n" +
+	"\x13ExportConfigRequest\x12\x1a\n" +
+	"\bpassword\x18\x01 \x01(\tR\bpassword\".\n" +
+	"\x14ExportConfigResponse\x12\x16\n" +
+	"\x06bundle\x18\x01 \x01(\fR\x06bundle\"I\n" +
+	"\x13ImportConfigRequest\x12\x16\n" +
+	"\x06bundle\x18\x01 \x01(\fR\x06bundle\x12\x1a\n" +
+	"\bpassword\x18\x02 \x01(\tR\bpassword\"\x9b\x01\n" +
+	"\x14ImportConfigResponse\x125\n" +
+	"\aservers\x18\x01 \x03(\v2\x1b.pb.clientrpc.v1.ServerInfoR\aservers\x12'\n" +
+	"\x0fskipped_servers\x18\x02 \x01(\rR\x0eskippedServers\x12#\n" +
+	"\rfailed_shares\x18\x03 \x03(\tR\ffailedShares\"+\n" +
+	"\x15BackupDatabaseRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\"\x18\n" +
+	"\x16BackupDatabaseResponse\"\x1f\n" +
+	"\x1dCheckDatabaseIntegrityRequest\"<\n" +
+	"\x1eCheckDatabaseIntegrityResponse\x12\x1a\n" +
+	"\bproblems\x18\x01 \x03(\tR\bproblems\"H\n" +
 	"\x11IndexShareRequest\x12\x1f\n" +
 	"\vserver_uuid\x18\x01 \x01(\tR\n" +
 	"serverUuid\x12\x12\n" +
@@ -4933,69 +16109,472 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"serverUuid\x12\x1f\n" +
 	"\busername\x18\x02 \x01(\tH\x00R\busername\x88\x01\x01\x12\x14\n" +
 	"\x05query\x18\x03 \x01(\tR\x05queryB\v\n" +
-	"\t_username\"\xa2\x01\n" +
+	"\t_username\"\xe7\x01\n" +
 	"\x14StreamSearchResponse\x12\x1a\n" +
 	"\busername\x18\x01 \x01(\tR\busername\x12%\n" +
 	"\x0edirectory_path\x18\x02 \x01(\tR\rdirectoryPath\x12-\n" +
 	"\x04file\x18\x03 \x01(\v2\x19.pb.clientrpc.v1.FileMetaR\x04file\x12\x18\n" +
-	"\asnippet\x18\x04 \x01(\tR\asnippet\"\x16\n" +
-	"\x14GetUpdateInfoRequest\"\xa1\x01\n" +
+	"\asnippet\x18\x04 \x01(\tR\asnippet\x128\n" +
+	"\x06friend\x18\x05 \x01(\v2\x1b.pb.clientrpc.v1.FriendInfoH\x00R\x06friend\x88\x01\x01B\t\n" +
+	"\a_friend\"\x16\n" +
+	"\x14GetUpdateInfoRequest\"\xc6\x01\n" +
 	"\x15GetUpdateInfoResponse\x12>\n" +
 	"\fcurrent_info\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.UpdateInfoR\vcurrentInfo\x12;\n" +
-	"\bnew_info\x18\x02 \x01(\v2\x1b.pb.clientrpc.v1.UpdateInfoH\x00R\anewInfo\x88\x01\x01B\v\n" +
+	"\bnew_info\x18\x02 \x01(\v2\x1b.pb.clientrpc.v1.UpdateInfoH\x00R\anewInfo\x88\x01\x01\x12#\n" +
+	"\rupdate_staged\x18\x03 \x01(\bR\fupdateStagedB\v\n" +
 	"\t_new_info\"\x1a\n" +
 	"\x18CheckForNewUpdateRequest\"e\n" +
 	"\x19CheckForNewUpdateResponse\x12;\n" +
 	"\bnew_info\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.UpdateInfoH\x00R\anewInfo\x88\x01\x01B\v\n" +
-	"\t_new_info\" \n" +
+	"\t_new_info\"\x14\n" +
+	"\x12ApplyUpdateRequest\"F\n" +
+	"\x13ApplyUpdateResponse\x12/\n" +
+	"\x04info\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.UpdateInfoR\x04info\"\x1a\n" +
+	"\x18GetUpdateSettingsRequest\"X\n" +
+	"\x19GetUpdateSettingsResponse\x12;\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1f.pb.clientrpc.v1.UpdateSettingsR\bsettings\"Z\n" +
+	"\x1bUpdateUpdateSettingsRequest\x12;\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1f.pb.clientrpc.v1.UpdateSettingsR\bsettings\"\x1e\n" +
+	"\x1cUpdateUpdateSettingsResponse\" \n" +
 	"\x1eGetDownloadManagerItemsRequest\"]\n" +
 	"\x1fGetDownloadManagerItemsResponse\x12:\n" +
-	"\x05items\x18\x01 \x03(\v2$.pb.clientrpc.v1.DownloadManagerItemR\x05items\"}\n" +
+	"\x05items\x18\x01 \x03(\v2$.pb.clientrpc.v1.DownloadManagerItemR\x05items\"\xca\x01\n" +
 	"\x18QueueFileDownloadRequest\x12\x1f\n" +
 	"\vserver_uuid\x18\x01 \x01(\tR\n" +
 	"serverUuid\x12#\n" +
 	"\rpeer_username\x18\x02 \x01(\tR\fpeerUsername\x12\x1b\n" +
-	"\tfile_path\x18\x03 \x01(\tR\bfilePath\"\x1b\n" +
-	"\x19QueueFileDownloadResponse\"/\n" +
+	"\tfile_path\x18\x03 \x01(\tR\bfilePath\x12K\n" +
+	"\x10duplicate_action\x18\x04 \x01(\x0e2 .pb.clientrpc.v1.DuplicateActionR\x0fduplicateAction\"\xa2\x01\n" +
+	"\x19QueueFileDownloadResponse\x12A\n" +
+	"\tduplicate\x18\x01 \x01(\v2\x1e.pb.clientrpc.v1.DuplicateFileH\x00R\tduplicate\x88\x01\x01\x12$\n" +
+	"\vlinked_path\x18\x02 \x01(\tH\x01R\n" +
+	"linkedPath\x88\x01\x01B\f\n" +
+	"\n" +
+	"_duplicateB\x0e\n" +
+	"\f_linked_path\"g\n" +
+	"\rDuplicateFile\x12\x1d\n" +
+	"\n" +
+	"local_path\x18\x01 \x01(\tR\tlocalPath\x12\x12\n" +
+	"\x04size\x18\x02 \x01(\x04R\x04size\x12#\n" +
+	"\rdownloaded_ts\x18\x03 \x01(\x03R\fdownloadedTs\"/\n" +
 	"\x19CancelFileDownloadRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x1c\n" +
 	"\x1aCancelFileDownloadResponse\"6\n" +
 	" RemoveDownloadManagerItemRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"#\n" +
-	"!RemoveDownloadManagerItemResponse\"/\n" +
+	"!RemoveDownloadManagerItemResponse\".\n" +
+	"\x18PauseFileDownloadRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x1b\n" +
+	"\x19PauseFileDownloadResponse\"/\n" +
 	"\x19ResumeFileDownloadRequest\x12\x12\n" +
 	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x1c\n" +
-	"\x1aResumeFileDownloadResponse*\xbd\x01\n" +
+	"\x1aResumeFileDownloadResponse\"\x19\n" +
+	"\x17GetDownloadHooksRequest\"S\n" +
+	"\x18GetDownloadHooksResponse\x127\n" +
+	"\x05hooks\x18\x01 \x03(\v2!.pb.clientrpc.v1.DownloadHookInfoR\x05hooks\"\xa6\x01\n" +
+	"\x19CreateDownloadHookRequest\x125\n" +
+	"\x04type\x18\x01 \x01(\x0e2!.pb.clientrpc.v1.DownloadHookTypeR\x04type\x12\x16\n" +
+	"\x06target\x18\x02 \x01(\tR\x06target\x12(\n" +
+	"\rdownload_uuid\x18\x03 \x01(\tH\x00R\fdownloadUuid\x88\x01\x01B\x10\n" +
+	"\x0e_download_uuid\"S\n" +
+	"\x1aCreateDownloadHookResponse\x125\n" +
+	"\x04hook\x18\x01 \x01(\v2!.pb.clientrpc.v1.DownloadHookInfoR\x04hook\"/\n" +
+	"\x19DeleteDownloadHookRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x1c\n" +
+	"\x1aDeleteDownloadHookResponse\"8\n" +
+	"\x11GetUploadsRequest\x12#\n" +
+	"\rhistory_limit\x18\x01 \x01(\rR\fhistoryLimit\"\x80\x01\n" +
+	"\x12GetUploadsResponse\x123\n" +
+	"\x06active\x18\x01 \x03(\v2\x1b.pb.clientrpc.v1.UploadInfoR\x06active\x125\n" +
+	"\ahistory\x18\x02 \x03(\v2\x1b.pb.clientrpc.v1.UploadInfoR\ahistory\"\x1b\n" +
+	"\x19ClearUploadHistoryRequest\"\x1c\n" +
+	"\x1aClearUploadHistoryResponse\"4\n" +
+	"\x11GetFriendsRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\"K\n" +
+	"\x12GetFriendsResponse\x125\n" +
+	"\afriends\x18\x01 \x03(\v2\x1b.pb.clientrpc.v1.FriendInfoR\afriends\"\xbd\x01\n" +
+	"\x10SetFriendRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bnickname\x18\x03 \x01(\tR\bnickname\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12<\n" +
+	"\vtrust_level\x18\x05 \x01(\x0e2\x1b.pb.clientrpc.v1.TrustLevelR\n" +
+	"trustLevel\"H\n" +
+	"\x11SetFriendResponse\x123\n" +
+	"\x06friend\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.FriendInfoR\x06friend\"R\n" +
+	"\x13DeleteFriendRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\x16\n" +
+	"\x14DeleteFriendResponse\"L\n" +
+	"\x0fBlockedPeerInfo\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x02 \x01(\x03R\tcreatedTs\"9\n" +
+	"\x16GetBlockedPeersRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\"Q\n" +
+	"\x17GetBlockedPeersResponse\x126\n" +
+	"\x05peers\x18\x01 \x03(\v2 .pb.clientrpc.v1.BlockedPeerInfoR\x05peers\"O\n" +
+	"\x10BlockPeerRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\x13\n" +
+	"\x11BlockPeerResponse\"Q\n" +
+	"\x12UnblockPeerRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\x15\n" +
+	"\x13UnblockPeerResponse\"\x15\n" +
+	"\x13GetInterestsRequest\"4\n" +
+	"\x14GetInterestsResponse\x12\x1c\n" +
+	"\tinterests\x18\x01 \x03(\tR\tinterests\"3\n" +
+	"\x13SetInterestsRequest\x12\x1c\n" +
+	"\tinterests\x18\x01 \x03(\tR\tinterests\"4\n" +
+	"\x14SetInterestsResponse\x12\x1c\n" +
+	"\tinterests\x18\x01 \x03(\tR\tinterests\"\x7f\n" +
+	"\x0fSimilarUserInfo\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12)\n" +
+	"\x10shared_interests\x18\x02 \x03(\tR\x0fsharedInterests\x12%\n" +
+	"\x0einterest_count\x18\x03 \x01(\rR\rinterestCount\"^\n" +
+	"\x16GetSimilarUsersRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x19\n" +
+	"\x05limit\x18\x02 \x01(\rH\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"Q\n" +
+	"\x17GetSimilarUsersResponse\x126\n" +
+	"\x05users\x18\x01 \x03(\v2 .pb.clientrpc.v1.SimilarUserInfoR\x05users\"j\n" +
+	"\n" +
+	"ConnWindow\x12\x1a\n" +
+	"\bweekdays\x18\x01 \x01(\rR\bweekdays\x12!\n" +
+	"\fstart_minute\x18\x02 \x01(\rR\vstartMinute\x12\x1d\n" +
+	"\n" +
+	"end_minute\x18\x03 \x01(\rR\tendMinute\";\n" +
+	"\x18GetServerScheduleRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\"s\n" +
+	"\x19GetServerScheduleResponse\x125\n" +
+	"\awindows\x18\x01 \x03(\v2\x1b.pb.clientrpc.v1.ConnWindowR\awindows\x12\x1f\n" +
+	"\vallowed_now\x18\x02 \x01(\bR\n" +
+	"allowedNow\"r\n" +
+	"\x18SetServerScheduleRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x125\n" +
+	"\awindows\x18\x02 \x03(\v2\x1b.pb.clientrpc.v1.ConnWindowR\awindows\"\x1b\n" +
+	"\x19SetServerScheduleResponse\"r\n" +
+	"\n" +
+	"SnoozeInfo\x12\x16\n" +
+	"\x06active\x18\x01 \x01(\bR\x06active\x12\x1e\n" +
+	"\buntil_ts\x18\x02 \x01(\x03H\x00R\auntilTs\x88\x01\x01\x12\x1f\n" +
+	"\vhide_shares\x18\x03 \x01(\bR\n" +
+	"hideSharesB\v\n" +
+	"\t_until_ts\"\x12\n" +
+	"\x10GetSnoozeRequest\"H\n" +
+	"\x11GetSnoozeResponse\x123\n" +
+	"\x06snooze\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.SnoozeInfoR\x06snooze\"u\n" +
+	"\rSnoozeRequest\x12.\n" +
+	"\x10duration_seconds\x18\x01 \x01(\rH\x00R\x0fdurationSeconds\x88\x01\x01\x12\x1f\n" +
+	"\vhide_shares\x18\x02 \x01(\bR\n" +
+	"hideSharesB\x13\n" +
+	"\x11_duration_seconds\"E\n" +
+	"\x0eSnoozeResponse\x123\n" +
+	"\x06snooze\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.SnoozeInfoR\x06snooze\"\x11\n" +
+	"\x0fUnsnoozeRequest\"\x12\n" +
+	"\x10UnsnoozeResponse\"\xaf\x01\n" +
+	"\x0eRunSessionInfo\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x1d\n" +
+	"\n" +
+	"started_ts\x18\x02 \x01(\x03R\tstartedTs\x12\"\n" +
+	"\n" +
+	"stopped_ts\x18\x03 \x01(\x03H\x00R\tstoppedTs\x88\x01\x01\x12\x18\n" +
+	"\acrashed\x18\x04 \x01(\bR\acrashed\x12\x1d\n" +
+	"\n" +
+	"is_current\x18\x05 \x01(\bR\tisCurrentB\r\n" +
+	"\v_stopped_ts\"\xb9\x02\n" +
+	"\x0fConnSessionInfo\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x19\n" +
+	"\brun_uuid\x18\x02 \x01(\tR\arunUuid\x12\x1f\n" +
+	"\vserver_uuid\x18\x03 \x01(\tR\n" +
+	"serverUuid\x12!\n" +
+	"\fconnected_ts\x18\x04 \x01(\x03R\vconnectedTs\x12,\n" +
+	"\x0fdisconnected_ts\x18\x05 \x01(\x03H\x00R\x0edisconnectedTs\x88\x01\x01\x12)\n" +
+	"\x10duration_seconds\x18\x06 \x01(\x03R\x0fdurationSeconds\x120\n" +
+	"\x11disconnect_reason\x18\a \x01(\tH\x01R\x10disconnectReason\x88\x01\x01B\x12\n" +
+	"\x10_disconnected_tsB\x14\n" +
+	"\x12_disconnect_reason\",\n" +
+	"\x14GetRunHistoryRequest\x12\x14\n" +
+	"\x05limit\x18\x01 \x01(\rR\x05limit\"L\n" +
+	"\x15GetRunHistoryResponse\x123\n" +
+	"\x04runs\x18\x01 \x03(\v2\x1f.pb.clientrpc.v1.RunSessionInfoR\x04runs\"N\n" +
+	"\x15GetConnHistoryRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\"V\n" +
+	"\x16GetConnHistoryResponse\x12<\n" +
+	"\bsessions\x18\x01 \x03(\v2 .pb.clientrpc.v1.ConnSessionInfoR\bsessions\"\xda\x01\n" +
+	"\rTrashedServer\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12\x12\n" +
+	"\x04room\x18\x04 \x01(\tR\x04room\x12\x1a\n" +
+	"\busername\x18\x05 \x01(\tR\busername\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x06 \x01(\x03R\tcreatedTs\x12\x1d\n" +
+	"\n" +
+	"deleted_ts\x18\a \x01(\x03R\tdeletedTs\x12\x19\n" +
+	"\bpurge_ts\x18\b \x01(\x03R\apurgeTs\"z\n" +
+	"\fTrashedShare\x120\n" +
+	"\x05share\x18\x01 \x01(\v2\x1a.pb.clientrpc.v1.ShareInfoR\x05share\x12\x1d\n" +
+	"\n" +
+	"deleted_ts\x18\x02 \x01(\x03R\tdeletedTs\x12\x19\n" +
+	"\bpurge_ts\x18\x03 \x01(\x03R\apurgeTs\"\x11\n" +
+	"\x0fGetTrashRequest\"\x83\x01\n" +
+	"\x10GetTrashResponse\x128\n" +
+	"\aservers\x18\x01 \x03(\v2\x1e.pb.clientrpc.v1.TrashedServerR\aservers\x125\n" +
+	"\x06shares\x18\x02 \x03(\v2\x1d.pb.clientrpc.v1.TrashedShareR\x06shares\"*\n" +
+	"\x14RestoreServerRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"L\n" +
+	"\x15RestoreServerResponse\x123\n" +
+	"\x06server\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.ServerInfoR\x06server\"(\n" +
+	"\x12PurgeServerRequest\x12\x12\n" +
+	"\x04uuid\x18\x01 \x01(\tR\x04uuid\"\x15\n" +
+	"\x13PurgeServerResponse\"J\n" +
+	"\x13RestoreShareRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"H\n" +
+	"\x14RestoreShareResponse\x120\n" +
+	"\x05share\x18\x01 \x01(\v2\x1a.pb.clientrpc.v1.ShareInfoR\x05share\"H\n" +
+	"\x11PurgeShareRequest\x12\x1f\n" +
+	"\vserver_uuid\x18\x01 \x01(\tR\n" +
+	"serverUuid\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\"\x14\n" +
+	"\x12PurgeShareResponse\"\x98\x01\n" +
+	"\n" +
+	"PluginInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x02 \x01(\x03R\tcreatedTs\x124\n" +
+	"\x06scopes\x18\x03 \x03(\x0e2\x1c.pb.clientrpc.v1.PluginScopeR\x06scopes\x12!\n" +
+	"\fopen_streams\x18\x04 \x01(\rR\vopenStreams\"\x82\x04\n" +
+	"\vPluginEvent\x124\n" +
+	"\x04type\x18\x01 \x01(\x0e2 .pb.clientrpc.v1.PluginEventTypeR\x04type\x12P\n" +
+	"\fclient_event\x18\x02 \x01(\v2(.pb.clientrpc.v1.PluginEvent.ClientEventH\x00R\vclientEvent\x88\x01\x01\x12@\n" +
+	"\x06search\x18\x03 \x01(\v2#.pb.clientrpc.v1.PluginEvent.SearchH\x01R\x06search\x88\x01\x01\x1at\n" +
+	"\vClientEvent\x12,\n" +
+	"\x05event\x18\x01 \x01(\v2\x16.pb.clientrpc.v1.EventR\x05event\x127\n" +
+	"\acontext\x18\x02 \x01(\v2\x1d.pb.clientrpc.v1.EventContextR\acontext\x1a\x96\x01\n" +
+	"\x06Search\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x1f\n" +
+	"\vserver_uuid\x18\x02 \x01(\tR\n" +
+	"serverUuid\x12\x14\n" +
+	"\x05query\x18\x03 \x01(\tR\x05query\x12\x1f\n" +
+	"\vmax_results\x18\x04 \x01(\rR\n" +
+	"maxResults\x12$\n" +
+	"\x0edeadline_ts_ms\x18\x05 \x01(\x03R\fdeadlineTsMsB\x0f\n" +
+	"\r_client_eventB\t\n" +
+	"\a_search\"\x84\x01\n" +
+	"\x12PluginSearchResult\x12%\n" +
+	"\x0edirectory_path\x18\x01 \x01(\tR\rdirectoryPath\x12-\n" +
+	"\x04file\x18\x02 \x01(\v2\x19.pb.clientrpc.v1.FileMetaR\x04file\x12\x18\n" +
+	"\asnippet\x18\x03 \x01(\tR\asnippet\"\x13\n" +
+	"\x11GetPluginsRequest\"K\n" +
+	"\x12GetPluginsResponse\x125\n" +
+	"\aplugins\x18\x01 \x03(\v2\x1b.pb.clientrpc.v1.PluginInfoR\aplugins\"_\n" +
+	"\x13CreatePluginRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x124\n" +
+	"\x06scopes\x18\x02 \x03(\x0e2\x1c.pb.clientrpc.v1.PluginScopeR\x06scopes\"a\n" +
+	"\x14CreatePluginResponse\x123\n" +
+	"\x06plugin\x18\x01 \x01(\v2\x1b.pb.clientrpc.v1.PluginInfoR\x06plugin\x12\x14\n" +
+	"\x05token\x18\x02 \x01(\tR\x05token\")\n" +
+	"\x13DeletePluginRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"\x16\n" +
+	"\x14DeletePluginResponse\"S\n" +
+	"\x19StreamPluginEventsRequest\x126\n" +
+	"\x05types\x18\x01 \x03(\x0e2 .pb.clientrpc.v1.PluginEventTypeR\x05types\"P\n" +
+	"\x1aStreamPluginEventsResponse\x122\n" +
+	"\x05event\x18\x01 \x01(\v2\x1c.pb.clientrpc.v1.PluginEventR\x05event\"t\n" +
+	"\x16RespondToSearchRequest\x12\x1b\n" +
+	"\tsearch_id\x18\x01 \x01(\tR\bsearchId\x12=\n" +
+	"\aresults\x18\x02 \x03(\v2#.pb.clientrpc.v1.PluginSearchResultR\aresults\"\x19\n" +
+	"\x17RespondToSearchResponse\"\xc6\x01\n" +
+	"\rBridgeRequest\x126\n" +
+	"\x04type\x18\x01 \x01(\x0e2\".pb.clientrpc.v1.BridgeRequestTypeR\x04type\x12\x1f\n" +
+	"\vserver_uuid\x18\x02 \x01(\tR\n" +
+	"serverUuid\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x12\n" +
+	"\x04path\x18\x04 \x01(\tR\x04path\x12\x16\n" +
+	"\x06offset\x18\x05 \x01(\x04R\x06offset\x12\x14\n" +
+	"\x05limit\x18\x06 \x01(\x04R\x05limit\"y\n" +
+	"\vBridgeError\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x123\n" +
+	"\x04info\x18\x03 \x01(\v2\x1a.pb.clientrpc.v1.ErrorInfoH\x00R\x04info\x88\x01\x01B\a\n" +
+	"\x05_info\"\xc1\x01\n" +
+	"\x0eBridgeResponse\x127\n" +
+	"\x05error\x18\x01 \x01(\v2\x1c.pb.clientrpc.v1.BridgeErrorH\x00R\x05error\x88\x01\x01\x122\n" +
+	"\x04meta\x18\x02 \x01(\v2\x19.pb.clientrpc.v1.FileMetaH\x01R\x04meta\x88\x01\x01\x12/\n" +
+	"\x05files\x18\x03 \x03(\v2\x19.pb.clientrpc.v1.FileMetaR\x05filesB\b\n" +
+	"\x06_errorB\a\n" +
+	"\x05_meta*\xd9\x01\n" +
 	"\x0eDownloadStatus\x12\x1f\n" +
 	"\x1bDOWNLOAD_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16DOWNLOAD_STATUS_QUEUED\x10\x01\x12\x1b\n" +
 	"\x17DOWNLOAD_STATUS_PENDING\x10\x02\x12\x1c\n" +
 	"\x18DOWNLOAD_STATUS_CANCELED\x10\x03\x12\x18\n" +
 	"\x14DOWNLOAD_STATUS_DONE\x10\x04\x12\x19\n" +
-	"\x15DOWNLOAD_STATUS_ERROR\x10\x05*\x8d\x01\n" +
+	"\x15DOWNLOAD_STATUS_ERROR\x10\x05\x12\x1a\n" +
+	"\x16DOWNLOAD_STATUS_PAUSED\x10\x06*r\n" +
+	"\n" +
+	"ScanStatus\x12\x1b\n" +
+	"\x17SCAN_STATUS_UNSPECIFIED\x10\x00\x12\x15\n" +
+	"\x11SCAN_STATUS_CLEAN\x10\x01\x12\x18\n" +
+	"\x14SCAN_STATUS_INFECTED\x10\x02\x12\x16\n" +
+	"\x12SCAN_STATUS_FAILED\x10\x03*\x99\x01\n" +
+	"\fUploadStatus\x12\x1d\n" +
+	"\x19UPLOAD_STATUS_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19UPLOAD_STATUS_IN_PROGRESS\x10\x01\x12\x16\n" +
+	"\x12UPLOAD_STATUS_DONE\x10\x02\x12\x1a\n" +
+	"\x16UPLOAD_STATUS_CANCELED\x10\x03\x12\x17\n" +
+	"\x13UPLOAD_STATUS_ERROR\x10\x04*b\n" +
+	"\rArchiveFormat\x12\x1e\n" +
+	"\x1aARCHIVE_FORMAT_UNSPECIFIED\x10\x00\x12\x16\n" +
+	"\x12ARCHIVE_FORMAT_ZIP\x10\x01\x12\x19\n" +
+	"\x15ARCHIVE_FORMAT_TAR_GZ\x10\x02*P\n" +
+	"\bPeerPath\x12\x19\n" +
+	"\x15PEER_PATH_UNSPECIFIED\x10\x00\x12\x13\n" +
+	"\x0fPEER_PATH_PROXY\x10\x01\x12\x14\n" +
+	"\x10PEER_PATH_DIRECT\x10\x02*v\n" +
+	"\x10DownloadHookType\x12\"\n" +
+	"\x1eDOWNLOAD_HOOK_TYPE_UNSPECIFIED\x10\x00\x12\x1e\n" +
+	"\x1aDOWNLOAD_HOOK_TYPE_COMMAND\x10\x01\x12\x1e\n" +
+	"\x1aDOWNLOAD_HOOK_TYPE_WEBHOOK\x10\x02*c\n" +
+	"\rUpdateChannel\x12\x1e\n" +
+	"\x1aUPDATE_CHANNEL_UNSPECIFIED\x10\x00\x12\x19\n" +
+	"\x15UPDATE_CHANNEL_STABLE\x10\x01\x12\x17\n" +
+	"\x13UPDATE_CHANNEL_BETA\x10\x02*\xc3\x05\n" +
+	"\vErrorReason\x12\x1c\n" +
+	"\x18ERROR_REASON_UNSPECIFIED\x10\x00\x12)\n" +
+	"%ERROR_REASON_AUTH_INVALID_CREDENTIALS\x10\x01\x12\x1c\n" +
+	"\x18ERROR_REASON_AUTH_BANNED\x10\x02\x12'\n" +
+	"#ERROR_REASON_AUTH_ALREADY_CONNECTED\x10\x03\x12\"\n" +
+	"\x1eERROR_REASON_AUTH_RATE_LIMITED\x10\x04\x12+\n" +
+	"'ERROR_REASON_AUTH_REGISTRATION_DISABLED\x10\x05\x12)\n" +
+	"%ERROR_REASON_AUTH_INVALID_INVITE_CODE\x10\x06\x12$\n" +
+	" ERROR_REASON_AUTH_USERNAME_TAKEN\x10\a\x12&\n" +
+	"\"ERROR_REASON_AUTH_INVALID_PASSWORD\x10\b\x12\x1e\n" +
+	"\x1aERROR_REASON_AUTH_REJECTED\x10\t\x12 \n" +
+	"\x1cERROR_REASON_VERSION_TOO_OLD\x10\n" +
+	"\x12 \n" +
+	"\x1cERROR_REASON_VERSION_TOO_NEW\x10\v\x12!\n" +
+	"\x1dERROR_REASON_VERSION_REJECTED\x10\f\x12\x1e\n" +
+	"\x1aERROR_REASON_CERT_MISMATCH\x10\r\x12*\n" +
+	"&ERROR_REASON_CERT_FINGERPRINT_MISMATCH\x10\x0e\x12#\n" +
+	"\x1fERROR_REASON_CERT_NOT_VALID_NOW\x10\x0f\x12 \n" +
+	"\x1cERROR_REASON_NO_SERVER_CERTS\x10\x10\x12!\n" +
+	"\x1dERROR_REASON_PEER_UNREACHABLE\x10\x11\x12\x1d\n" +
+	"\x19ERROR_REASON_PEER_TIMEOUT\x10\x12*\x8d\x01\n" +
 	"\x0fServerConnState\x12!\n" +
 	"\x1dSERVER_CONN_STATE_UNSPECIFIED\x10\x00\x12\x1c\n" +
 	"\x18SERVER_CONN_STATE_CLOSED\x10\x01\x12\x1d\n" +
 	"\x19SERVER_CONN_STATE_OPENING\x10\x02\x12\x1a\n" +
-	"\x16SERVER_CONN_STATE_OPEN\x10\x032\xe7\x19\n" +
+	"\x16SERVER_CONN_STATE_OPEN\x10\x03*\x8b\x01\n" +
+	"\x10ShareUnicodeForm\x12\"\n" +
+	"\x1eSHARE_UNICODE_FORM_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17SHARE_UNICODE_FORM_NONE\x10\x01\x12\x1a\n" +
+	"\x16SHARE_UNICODE_FORM_NFC\x10\x02\x12\x1a\n" +
+	"\x16SHARE_UNICODE_FORM_NFD\x10\x03*w\n" +
+	"\x11ShareConflictRule\x12#\n" +
+	"\x1fSHARE_CONFLICT_RULE_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19SHARE_CONFLICT_RULE_FIRST\x10\x01\x12\x1e\n" +
+	"\x1aSHARE_CONFLICT_RULE_NEWEST\x10\x02*^\n" +
+	"\n" +
+	"TrustLevel\x12\x1b\n" +
+	"\x17TRUST_LEVEL_UNSPECIFIED\x10\x00\x12\x1a\n" +
+	"\x16TRUST_LEVEL_DISTRUSTED\x10\x01\x12\x17\n" +
+	"\x13TRUST_LEVEL_TRUSTED\x10\x02*\x8f\x02\n" +
+	"\x14ShareHealthIssueKind\x12'\n" +
+	"#SHARE_HEALTH_ISSUE_KIND_UNSPECIFIED\x10\x00\x12(\n" +
+	"$SHARE_HEALTH_ISSUE_KIND_INVALID_NAME\x10\x01\x12&\n" +
+	"\"SHARE_HEALTH_ISSUE_KIND_UNREADABLE\x10\x02\x12'\n" +
+	"#SHARE_HEALTH_ISSUE_KIND_BROKEN_LINK\x10\x03\x12(\n" +
+	"$SHARE_HEALTH_ISSUE_KIND_WINDOWS_NAME\x10\x04\x12)\n" +
+	"%SHARE_HEALTH_ISSUE_KIND_NAME_TOO_LONG\x10\x05*\xde\x01\n" +
+	"\x0eDiagnosticStep\x12\x1f\n" +
+	"\x1bDIAGNOSTIC_STEP_UNSPECIFIED\x10\x00\x12\x1b\n" +
+	"\x17DIAGNOSTIC_STEP_RESOLVE\x10\x01\x12\x1d\n" +
+	"\x19DIAGNOSTIC_STEP_UDP_PROBE\x10\x02\x12\"\n" +
+	"\x1eDIAGNOSTIC_STEP_QUIC_HANDSHAKE\x10\x03\x12'\n" +
+	"#DIAGNOSTIC_STEP_VERSION_NEGOTIATION\x10\x04\x12\"\n" +
+	"\x1eDIAGNOSTIC_STEP_AUTHENTICATION\x10\x05*\xb0\x01\n" +
+	"\x10DiagnosticStatus\x12!\n" +
+	"\x1dDIAGNOSTIC_STATUS_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14DIAGNOSTIC_STATUS_OK\x10\x01\x12\x1c\n" +
+	"\x18DIAGNOSTIC_STATUS_FAILED\x10\x02\x12\"\n" +
+	"\x1eDIAGNOSTIC_STATUS_INCONCLUSIVE\x10\x03\x12\x1d\n" +
+	"\x19DIAGNOSTIC_STATUS_SKIPPED\x10\x04*\x8d\x01\n" +
+	"\x0fDuplicateAction\x12 \n" +
+	"\x1cDUPLICATE_ACTION_UNSPECIFIED\x10\x00\x12\x1d\n" +
+	"\x19DUPLICATE_ACTION_DOWNLOAD\x10\x01\x12\x1e\n" +
+	"\x1aDUPLICATE_ACTION_HARD_LINK\x10\x02\x12\x19\n" +
+	"\x15DUPLICATE_ACTION_COPY\x10\x03*\xa9\x01\n" +
+	"\vPluginScope\x12\x1c\n" +
+	"\x18PLUGIN_SCOPE_UNSPECIFIED\x10\x00\x12\x17\n" +
+	"\x13PLUGIN_SCOPE_EVENTS\x10\x01\x12\x17\n" +
+	"\x13PLUGIN_SCOPE_SEARCH\x10\x02\x12\x15\n" +
+	"\x11PLUGIN_SCOPE_READ\x10\x03\x12\x1a\n" +
+	"\x16PLUGIN_SCOPE_DOWNLOADS\x10\x04\x12\x17\n" +
+	"\x13PLUGIN_SCOPE_SHARES\x10\x05*v\n" +
+	"\x0fPluginEventType\x12!\n" +
+	"\x1dPLUGIN_EVENT_TYPE_UNSPECIFIED\x10\x00\x12\"\n" +
+	"\x1ePLUGIN_EVENT_TYPE_CLIENT_EVENT\x10\x01\x12\x1c\n" +
+	"\x18PLUGIN_EVENT_TYPE_SEARCH\x10\x02*\xa8\x01\n" +
+	"\x11BridgeRequestType\x12#\n" +
+	"\x1fBRIDGE_REQUEST_TYPE_UNSPECIFIED\x10\x00\x12%\n" +
+	"!BRIDGE_REQUEST_TYPE_GET_FILE_META\x10\x01\x12%\n" +
+	"!BRIDGE_REQUEST_TYPE_GET_DIR_FILES\x10\x02\x12 \n" +
+	"\x1cBRIDGE_REQUEST_TYPE_GET_FILE\x10\x032\xccK\n" +
 	"\x10ClientRpcService\x12Y\n" +
 	"\n" +
 	"StreamLogs\x12\".pb.clientrpc.v1.StreamLogsRequest\x1a#.pb.clientrpc.v1.StreamLogsResponse\"\x000\x01\x12_\n" +
 	"\fStreamEvents\x12$.pb.clientrpc.v1.StreamEventsRequest\x1a%.pb.clientrpc.v1.StreamEventsResponse\"\x000\x01\x12E\n" +
 	"\x04Stop\x12\x1c.pb.clientrpc.v1.StopRequest\x1a\x1d.pb.clientrpc.v1.StopResponse\"\x00\x12`\n" +
-	"\rGetClientInfo\x12%.pb.clientrpc.v1.GetClientInfoRequest\x1a&.pb.clientrpc.v1.GetClientInfoResponse\"\x00\x12W\n" +
+	"\rGetClientInfo\x12%.pb.clientrpc.v1.GetClientInfoRequest\x1a&.pb.clientrpc.v1.GetClientInfoResponse\"\x00\x12c\n" +
+	"\x0eGetMemoryUsage\x12&.pb.clientrpc.v1.GetMemoryUsageRequest\x1a'.pb.clientrpc.v1.GetMemoryUsageResponse\"\x00\x12i\n" +
+	"\x10SetLowMemoryMode\x12(.pb.clientrpc.v1.SetLowMemoryModeRequest\x1a).pb.clientrpc.v1.SetLowMemoryModeResponse\"\x00\x12W\n" +
 	"\n" +
 	"GetServers\x12\".pb.clientrpc.v1.GetServersRequest\x1a#.pb.clientrpc.v1.GetServersResponse\"\x00\x12]\n" +
-	"\fCreateServer\x12$.pb.clientrpc.v1.CreateServerRequest\x1a%.pb.clientrpc.v1.CreateServerResponse\"\x00\x12]\n" +
+	"\fCreateServer\x12$.pb.clientrpc.v1.CreateServerRequest\x1a%.pb.clientrpc.v1.CreateServerResponse\"\x00\x12o\n" +
+	"\x12ImportInviteBundle\x12*.pb.clientrpc.v1.ImportInviteBundleRequest\x1a+.pb.clientrpc.v1.ImportInviteBundleResponse\"\x00\x12x\n" +
+	"\x15CreateAccountTemplate\x12-.pb.clientrpc.v1.CreateAccountTemplateRequest\x1a..pb.clientrpc.v1.CreateAccountTemplateResponse\"\x00\x12\x96\x01\n" +
+	"\x1fCreateAccountTemplateFromServer\x127.pb.clientrpc.v1.CreateAccountTemplateFromServerRequest\x1a8.pb.clientrpc.v1.CreateAccountTemplateFromServerResponse\"\x00\x12r\n" +
+	"\x13GetAccountTemplates\x12+.pb.clientrpc.v1.GetAccountTemplatesRequest\x1a,.pb.clientrpc.v1.GetAccountTemplatesResponse\"\x00\x12x\n" +
+	"\x15DeleteAccountTemplate\x12-.pb.clientrpc.v1.DeleteAccountTemplateRequest\x1a..pb.clientrpc.v1.DeleteAccountTemplateResponse\"\x00\x12]\n" +
+	"\fStartHosting\x12$.pb.clientrpc.v1.StartHostingRequest\x1a%.pb.clientrpc.v1.StartHostingResponse\"\x00\x12Z\n" +
+	"\vStopHosting\x12#.pb.clientrpc.v1.StopHostingRequest\x1a$.pb.clientrpc.v1.StopHostingResponse\"\x00\x12c\n" +
+	"\x0eGetHostingInfo\x12&.pb.clientrpc.v1.GetHostingInfoRequest\x1a'.pb.clientrpc.v1.GetHostingInfoResponse\"\x00\x12r\n" +
+	"\x13CreateHostingInvite\x12+.pb.clientrpc.v1.CreateHostingInviteRequest\x1a,.pb.clientrpc.v1.CreateHostingInviteResponse\"\x00\x12]\n" +
 	"\fDeleteServer\x12$.pb.clientrpc.v1.DeleteServerRequest\x1a%.pb.clientrpc.v1.DeleteServerResponse\"\x00\x12`\n" +
 	"\rConnectServer\x12%.pb.clientrpc.v1.ConnectServerRequest\x1a&.pb.clientrpc.v1.ConnectServerResponse\"\x00\x12i\n" +
 	"\x10DisconnectServer\x12(.pb.clientrpc.v1.DisconnectServerRequest\x1a).pb.clientrpc.v1.DisconnectServerResponse\"\x00\x12]\n" +
 	"\fUpdateServer\x12$.pb.clientrpc.v1.UpdateServerRequest\x1a%.pb.clientrpc.v1.UpdateServerResponse\"\x00\x12T\n" +
 	"\tGetShares\x12!.pb.clientrpc.v1.GetSharesRequest\x1a\".pb.clientrpc.v1.GetSharesResponse\"\x00\x12Z\n" +
 	"\vCreateShare\x12#.pb.clientrpc.v1.CreateShareRequest\x1a$.pb.clientrpc.v1.CreateShareResponse\"\x00\x12Z\n" +
-	"\vDeleteShare\x12#.pb.clientrpc.v1.DeleteShareRequest\x1a$.pb.clientrpc.v1.DeleteShareResponse\"\x00\x12\\\n" +
-	"\vGetDirFiles\x12#.pb.clientrpc.v1.GetDirFilesRequest\x1a$.pb.clientrpc.v1.GetDirFilesResponse\"\x000\x01\x12Z\n" +
-	"\vGetFileMeta\x12#.pb.clientrpc.v1.GetFileMetaRequest\x1a$.pb.clientrpc.v1.GetFileMetaResponse\"\x00\x12e\n" +
+	"\vDeleteShare\x12#.pb.clientrpc.v1.DeleteShareRequest\x1a$.pb.clientrpc.v1.DeleteShareResponse\"\x00\x12~\n" +
+	"\x17SetShareExcludePatterns\x12/.pb.clientrpc.v1.SetShareExcludePatternsRequest\x1a0.pb.clientrpc.v1.SetShareExcludePatternsResponse\"\x00\x12i\n" +
+	"\x10CheckShareHealth\x12(.pb.clientrpc.v1.CheckShareHealthRequest\x1a).pb.clientrpc.v1.CheckShareHealthResponse\"\x00\x12]\n" +
+	"\fImportShares\x12$.pb.clientrpc.v1.ImportSharesRequest\x1a%.pb.clientrpc.v1.ImportSharesResponse\"\x00\x12f\n" +
+	"\x0fCreateShareLink\x12'.pb.clientrpc.v1.CreateShareLinkRequest\x1a(.pb.clientrpc.v1.CreateShareLinkResponse\"\x00\x12`\n" +
+	"\rGetShareLinks\x12%.pb.clientrpc.v1.GetShareLinksRequest\x1a&.pb.clientrpc.v1.GetShareLinksResponse\"\x00\x12f\n" +
+	"\x0fDeleteShareLink\x12'.pb.clientrpc.v1.DeleteShareLinkRequest\x1a(.pb.clientrpc.v1.DeleteShareLinkResponse\"\x00\x12\\\n" +
+	"\vGetDirFiles\x12#.pb.clientrpc.v1.GetDirFilesRequest\x1a$.pb.clientrpc.v1.GetDirFilesResponse\"\x000\x01\x12k\n" +
+	"\x10StreamDirArchive\x12(.pb.clientrpc.v1.StreamDirArchiveRequest\x1a).pb.clientrpc.v1.StreamDirArchiveResponse\"\x000\x01\x12Z\n" +
+	"\vGetFileMeta\x12#.pb.clientrpc.v1.GetFileMetaRequest\x1a$.pb.clientrpc.v1.GetFileMetaResponse\"\x00\x12c\n" +
+	"\x0eCreateFileLink\x12&.pb.clientrpc.v1.CreateFileLinkRequest\x1a'.pb.clientrpc.v1.CreateFileLinkResponse\"\x00\x12Z\n" +
+	"\vMeasurePeer\x12#.pb.clientrpc.v1.MeasurePeerRequest\x1a$.pb.clientrpc.v1.MeasurePeerResponse\"\x00\x12Q\n" +
+	"\bDiagnose\x12 .pb.clientrpc.v1.DiagnoseRequest\x1a!.pb.clientrpc.v1.DiagnoseResponse\"\x00\x12e\n" +
 	"\x0eGetOnlineUsers\x12&.pb.clientrpc.v1.GetOnlineUsersRequest\x1a'.pb.clientrpc.v1.GetOnlineUsersResponse\"\x000\x01\x12x\n" +
 	"\x15ChangeAccountPassword\x12-.pb.clientrpc.v1.ChangeAccountPasswordRequest\x1a..pb.clientrpc.v1.ChangeAccountPasswordResponse\"\x00\x12`\n" +
 	"\rServerConnect\x12%.pb.clientrpc.v1.ServerConnectRequest\x1a&.pb.clientrpc.v1.ServerConnectResponse\"\x00\x12i\n" +
@@ -5003,17 +16582,62 @@ const file_pb_clientrpc_v1_rpc_proto_rawDesc = "" +
 	"\x11GetDirectSettings\x12).pb.clientrpc.v1.GetDirectSettingsRequest\x1a*.pb.clientrpc.v1.GetDirectSettingsResponse\"\x00\x12u\n" +
 	"\x14UpdateDirectSettings\x12,.pb.clientrpc.v1.UpdateDirectSettingsRequest\x1a-.pb.clientrpc.v1.UpdateDirectSettingsResponse\"\x00\x12r\n" +
 	"\x13GetTransferSettings\x12+.pb.clientrpc.v1.GetTransferSettingsRequest\x1a,.pb.clientrpc.v1.GetTransferSettingsResponse\"\x00\x12{\n" +
-	"\x16UpdateTransferSettings\x12..pb.clientrpc.v1.UpdateTransferSettingsRequest\x1a/.pb.clientrpc.v1.UpdateTransferSettingsResponse\"\x00\x12W\n" +
+	"\x16UpdateTransferSettings\x12..pb.clientrpc.v1.UpdateTransferSettingsRequest\x1a/.pb.clientrpc.v1.UpdateTransferSettingsResponse\"\x00\x12~\n" +
+	"\x17GetNotificationSettings\x12/.pb.clientrpc.v1.GetNotificationSettingsRequest\x1a0.pb.clientrpc.v1.GetNotificationSettingsResponse\"\x00\x12\x87\x01\n" +
+	"\x1aUpdateNotificationSettings\x122.pb.clientrpc.v1.UpdateNotificationSettingsRequest\x1a3.pb.clientrpc.v1.UpdateNotificationSettingsResponse\"\x00\x12]\n" +
+	"\fExportConfig\x12$.pb.clientrpc.v1.ExportConfigRequest\x1a%.pb.clientrpc.v1.ExportConfigResponse\"\x00\x12]\n" +
+	"\fImportConfig\x12$.pb.clientrpc.v1.ImportConfigRequest\x1a%.pb.clientrpc.v1.ImportConfigResponse\"\x00\x12c\n" +
+	"\x0eBackupDatabase\x12&.pb.clientrpc.v1.BackupDatabaseRequest\x1a'.pb.clientrpc.v1.BackupDatabaseResponse\"\x00\x12{\n" +
+	"\x16CheckDatabaseIntegrity\x12..pb.clientrpc.v1.CheckDatabaseIntegrityRequest\x1a/.pb.clientrpc.v1.CheckDatabaseIntegrityResponse\"\x00\x12W\n" +
 	"\n" +
 	"IndexShare\x12\".pb.clientrpc.v1.IndexShareRequest\x1a#.pb.clientrpc.v1.IndexShareResponse\"\x00\x12_\n" +
 	"\fStreamSearch\x12$.pb.clientrpc.v1.StreamSearchRequest\x1a%.pb.clientrpc.v1.StreamSearchResponse\"\x000\x01\x12`\n" +
 	"\rGetUpdateInfo\x12%.pb.clientrpc.v1.GetUpdateInfoRequest\x1a&.pb.clientrpc.v1.GetUpdateInfoResponse\"\x00\x12l\n" +
-	"\x11CheckForNewUpdate\x12).pb.clientrpc.v1.CheckForNewUpdateRequest\x1a*.pb.clientrpc.v1.CheckForNewUpdateResponse\"\x00\x12~\n" +
+	"\x11CheckForNewUpdate\x12).pb.clientrpc.v1.CheckForNewUpdateRequest\x1a*.pb.clientrpc.v1.CheckForNewUpdateResponse\"\x00\x12Z\n" +
+	"\vApplyUpdate\x12#.pb.clientrpc.v1.ApplyUpdateRequest\x1a$.pb.clientrpc.v1.ApplyUpdateResponse\"\x00\x12l\n" +
+	"\x11GetUpdateSettings\x12).pb.clientrpc.v1.GetUpdateSettingsRequest\x1a*.pb.clientrpc.v1.GetUpdateSettingsResponse\"\x00\x12u\n" +
+	"\x14UpdateUpdateSettings\x12,.pb.clientrpc.v1.UpdateUpdateSettingsRequest\x1a-.pb.clientrpc.v1.UpdateUpdateSettingsResponse\"\x00\x12~\n" +
 	"\x17GetDownloadManagerItems\x12/.pb.clientrpc.v1.GetDownloadManagerItemsRequest\x1a0.pb.clientrpc.v1.GetDownloadManagerItemsResponse\"\x00\x12l\n" +
 	"\x11QueueFileDownload\x12).pb.clientrpc.v1.QueueFileDownloadRequest\x1a*.pb.clientrpc.v1.QueueFileDownloadResponse\"\x00\x12o\n" +
 	"\x12CancelFileDownload\x12*.pb.clientrpc.v1.CancelFileDownloadRequest\x1a+.pb.clientrpc.v1.CancelFileDownloadResponse\"\x00\x12\x84\x01\n" +
-	"\x19RemoveDownloadManagerItem\x121.pb.clientrpc.v1.RemoveDownloadManagerItemRequest\x1a2.pb.clientrpc.v1.RemoveDownloadManagerItemResponse\"\x00\x12o\n" +
-	"\x12ResumeFileDownload\x12*.pb.clientrpc.v1.ResumeFileDownloadRequest\x1a+.pb.clientrpc.v1.ResumeFileDownloadResponse\"\x00B\xb1\x01\n" +
+	"\x19RemoveDownloadManagerItem\x121.pb.clientrpc.v1.RemoveDownloadManagerItemRequest\x1a2.pb.clientrpc.v1.RemoveDownloadManagerItemResponse\"\x00\x12l\n" +
+	"\x11PauseFileDownload\x12).pb.clientrpc.v1.PauseFileDownloadRequest\x1a*.pb.clientrpc.v1.PauseFileDownloadResponse\"\x00\x12o\n" +
+	"\x12ResumeFileDownload\x12*.pb.clientrpc.v1.ResumeFileDownloadRequest\x1a+.pb.clientrpc.v1.ResumeFileDownloadResponse\"\x00\x12i\n" +
+	"\x10GetDownloadHooks\x12(.pb.clientrpc.v1.GetDownloadHooksRequest\x1a).pb.clientrpc.v1.GetDownloadHooksResponse\"\x00\x12o\n" +
+	"\x12CreateDownloadHook\x12*.pb.clientrpc.v1.CreateDownloadHookRequest\x1a+.pb.clientrpc.v1.CreateDownloadHookResponse\"\x00\x12o\n" +
+	"\x12DeleteDownloadHook\x12*.pb.clientrpc.v1.DeleteDownloadHookRequest\x1a+.pb.clientrpc.v1.DeleteDownloadHookResponse\"\x00\x12W\n" +
+	"\n" +
+	"GetUploads\x12\".pb.clientrpc.v1.GetUploadsRequest\x1a#.pb.clientrpc.v1.GetUploadsResponse\"\x00\x12o\n" +
+	"\x12ClearUploadHistory\x12*.pb.clientrpc.v1.ClearUploadHistoryRequest\x1a+.pb.clientrpc.v1.ClearUploadHistoryResponse\"\x00\x12W\n" +
+	"\n" +
+	"GetFriends\x12\".pb.clientrpc.v1.GetFriendsRequest\x1a#.pb.clientrpc.v1.GetFriendsResponse\"\x00\x12T\n" +
+	"\tSetFriend\x12!.pb.clientrpc.v1.SetFriendRequest\x1a\".pb.clientrpc.v1.SetFriendResponse\"\x00\x12]\n" +
+	"\fDeleteFriend\x12$.pb.clientrpc.v1.DeleteFriendRequest\x1a%.pb.clientrpc.v1.DeleteFriendResponse\"\x00\x12f\n" +
+	"\x0fGetBlockedPeers\x12'.pb.clientrpc.v1.GetBlockedPeersRequest\x1a(.pb.clientrpc.v1.GetBlockedPeersResponse\"\x00\x12T\n" +
+	"\tBlockPeer\x12!.pb.clientrpc.v1.BlockPeerRequest\x1a\".pb.clientrpc.v1.BlockPeerResponse\"\x00\x12Z\n" +
+	"\vUnblockPeer\x12#.pb.clientrpc.v1.UnblockPeerRequest\x1a$.pb.clientrpc.v1.UnblockPeerResponse\"\x00\x12]\n" +
+	"\fGetInterests\x12$.pb.clientrpc.v1.GetInterestsRequest\x1a%.pb.clientrpc.v1.GetInterestsResponse\"\x00\x12]\n" +
+	"\fSetInterests\x12$.pb.clientrpc.v1.SetInterestsRequest\x1a%.pb.clientrpc.v1.SetInterestsResponse\"\x00\x12f\n" +
+	"\x0fGetSimilarUsers\x12'.pb.clientrpc.v1.GetSimilarUsersRequest\x1a(.pb.clientrpc.v1.GetSimilarUsersResponse\"\x00\x12l\n" +
+	"\x11GetServerSchedule\x12).pb.clientrpc.v1.GetServerScheduleRequest\x1a*.pb.clientrpc.v1.GetServerScheduleResponse\"\x00\x12l\n" +
+	"\x11SetServerSchedule\x12).pb.clientrpc.v1.SetServerScheduleRequest\x1a*.pb.clientrpc.v1.SetServerScheduleResponse\"\x00\x12T\n" +
+	"\tGetSnooze\x12!.pb.clientrpc.v1.GetSnoozeRequest\x1a\".pb.clientrpc.v1.GetSnoozeResponse\"\x00\x12K\n" +
+	"\x06Snooze\x12\x1e.pb.clientrpc.v1.SnoozeRequest\x1a\x1f.pb.clientrpc.v1.SnoozeResponse\"\x00\x12Q\n" +
+	"\bUnsnooze\x12 .pb.clientrpc.v1.UnsnoozeRequest\x1a!.pb.clientrpc.v1.UnsnoozeResponse\"\x00\x12`\n" +
+	"\rGetRunHistory\x12%.pb.clientrpc.v1.GetRunHistoryRequest\x1a&.pb.clientrpc.v1.GetRunHistoryResponse\"\x00\x12c\n" +
+	"\x0eGetConnHistory\x12&.pb.clientrpc.v1.GetConnHistoryRequest\x1a'.pb.clientrpc.v1.GetConnHistoryResponse\"\x00\x12Q\n" +
+	"\bGetTrash\x12 .pb.clientrpc.v1.GetTrashRequest\x1a!.pb.clientrpc.v1.GetTrashResponse\"\x00\x12`\n" +
+	"\rRestoreServer\x12%.pb.clientrpc.v1.RestoreServerRequest\x1a&.pb.clientrpc.v1.RestoreServerResponse\"\x00\x12Z\n" +
+	"\vPurgeServer\x12#.pb.clientrpc.v1.PurgeServerRequest\x1a$.pb.clientrpc.v1.PurgeServerResponse\"\x00\x12]\n" +
+	"\fRestoreShare\x12$.pb.clientrpc.v1.RestoreShareRequest\x1a%.pb.clientrpc.v1.RestoreShareResponse\"\x00\x12W\n" +
+	"\n" +
+	"PurgeShare\x12\".pb.clientrpc.v1.PurgeShareRequest\x1a#.pb.clientrpc.v1.PurgeShareResponse\"\x00\x12W\n" +
+	"\n" +
+	"GetPlugins\x12\".pb.clientrpc.v1.GetPluginsRequest\x1a#.pb.clientrpc.v1.GetPluginsResponse\"\x00\x12]\n" +
+	"\fCreatePlugin\x12$.pb.clientrpc.v1.CreatePluginRequest\x1a%.pb.clientrpc.v1.CreatePluginResponse\"\x00\x12]\n" +
+	"\fDeletePlugin\x12$.pb.clientrpc.v1.DeletePluginRequest\x1a%.pb.clientrpc.v1.DeletePluginResponse\"\x00\x12q\n" +
+	"\x12StreamPluginEvents\x12*.pb.clientrpc.v1.StreamPluginEventsRequest\x1a+.pb.clientrpc.v1.StreamPluginEventsResponse\"\x000\x01\x12f\n" +
+	"\x0fRespondToSearch\x12'.pb.clientrpc.v1.RespondToSearchRequest\x1a(.pb.clientrpc.v1.RespondToSearchResponse\"\x00B\xb1\x01\n" +
 	"\x13com.pb.clientrpc.v1B\bRpcProtoP\x01Z2friendnet.org/protocol/pb/clientrpc/v1;clientrpcv1\xa2\x02\x03PCX\xaa\x02\x0fPb.Clientrpc.V1\xca\x02\x0fPb\\Clientrpc\\V1\xe2\x02\x1bPb\\Clientrpc\\V1\\GPBMetadata\xea\x02\x11Pb::Clientrpc::V1b\x06proto3"
 
 var (
@@ -5028,210 +16652,618 @@ func file_pb_clientrpc_v1_rpc_proto_rawDescGZIP() []byte {
 	return file_pb_clientrpc_v1_rpc_proto_rawDescData
 }
 
-var file_pb_clientrpc_v1_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
-var file_pb_clientrpc_v1_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 86)
+var file_pb_clientrpc_v1_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 21)
+var file_pb_clientrpc_v1_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 254)
 var file_pb_clientrpc_v1_rpc_proto_goTypes = []any{
-	(DownloadStatus)(0),                       // 0: pb.clientrpc.v1.DownloadStatus
-	(ServerConnState)(0),                      // 1: pb.clientrpc.v1.ServerConnState
-	(Event_Type)(0),                           // 2: pb.clientrpc.v1.Event.Type
-	(DownloadManagerItem_Type)(0),             // 3: pb.clientrpc.v1.DownloadManagerItem.Type
-	(*Event)(nil),                             // 4: pb.clientrpc.v1.Event
-	(*EventContext)(nil),                      // 5: pb.clientrpc.v1.EventContext
-	(*LogMessageAttr)(nil),                    // 6: pb.clientrpc.v1.LogMessageAttr
-	(*LogMessage)(nil),                        // 7: pb.clientrpc.v1.LogMessage
-	(*DownloadStatusUpdate)(nil),              // 8: pb.clientrpc.v1.DownloadStatusUpdate
-	(*DownloadManagerItem)(nil),               // 9: pb.clientrpc.v1.DownloadManagerItem
-	(*UpdateInfo)(nil),                        // 10: pb.clientrpc.v1.UpdateInfo
-	(*ServerInfo)(nil),                        // 11: pb.clientrpc.v1.ServerInfo
-	(*ShareInfo)(nil),                         // 12: pb.clientrpc.v1.ShareInfo
-	(*OnlineUserInfo)(nil),                    // 13: pb.clientrpc.v1.OnlineUserInfo
-	(*FileMeta)(nil),                          // 14: pb.clientrpc.v1.FileMeta
-	(*DirectSettings)(nil),                    // 15: pb.clientrpc.v1.DirectSettings
-	(*TransferSettings)(nil),                  // 16: pb.clientrpc.v1.TransferSettings
-	(*StreamEventsRequest)(nil),               // 17: pb.clientrpc.v1.StreamEventsRequest
-	(*StreamEventsResponse)(nil),              // 18: pb.clientrpc.v1.StreamEventsResponse
-	(*StreamLogsRequest)(nil),                 // 19: pb.clientrpc.v1.StreamLogsRequest
-	(*StreamLogsResponse)(nil),                // 20: pb.clientrpc.v1.StreamLogsResponse
-	(*StopRequest)(nil),                       // 21: pb.clientrpc.v1.StopRequest
-	(*StopResponse)(nil),                      // 22: pb.clientrpc.v1.StopResponse
-	(*GetClientInfoRequest)(nil),              // 23: pb.clientrpc.v1.GetClientInfoRequest
-	(*GetClientInfoResponse)(nil),             // 24: pb.clientrpc.v1.GetClientInfoResponse
-	(*GetServersRequest)(nil),                 // 25: pb.clientrpc.v1.GetServersRequest
-	(*GetServersResponse)(nil),                // 26: pb.clientrpc.v1.GetServersResponse
-	(*CreateServerRequest)(nil),               // 27: pb.clientrpc.v1.CreateServerRequest
-	(*CreateServerResponse)(nil),              // 28: pb.clientrpc.v1.CreateServerResponse
-	(*DeleteServerRequest)(nil),               // 29: pb.clientrpc.v1.DeleteServerRequest
-	(*DeleteServerResponse)(nil),              // 30: pb.clientrpc.v1.DeleteServerResponse
-	(*ConnectServerRequest)(nil),              // 31: pb.clientrpc.v1.ConnectServerRequest
-	(*ConnectServerResponse)(nil),             // 32: pb.clientrpc.v1.ConnectServerResponse
-	(*DisconnectServerRequest)(nil),           // 33: pb.clientrpc.v1.DisconnectServerRequest
-	(*DisconnectServerResponse)(nil),          // 34: pb.clientrpc.v1.DisconnectServerResponse
-	(*UpdateServerRequest)(nil),               // 35: pb.clientrpc.v1.UpdateServerRequest
-	(*UpdateServerResponse)(nil),              // 36: pb.clientrpc.v1.UpdateServerResponse
-	(*GetSharesRequest)(nil),                  // 37: pb.clientrpc.v1.GetSharesRequest
-	(*GetSharesResponse)(nil),                 // 38: pb.clientrpc.v1.GetSharesResponse
-	(*CreateShareRequest)(nil),                // 39: pb.clientrpc.v1.CreateShareRequest
-	(*CreateShareResponse)(nil),               // 40: pb.clientrpc.v1.CreateShareResponse
-	(*DeleteShareRequest)(nil),                // 41: pb.clientrpc.v1.DeleteShareRequest
-	(*DeleteShareResponse)(nil),               // 42: pb.clientrpc.v1.DeleteShareResponse
-	(*GetDirFilesRequest)(nil),                // 43: pb.clientrpc.v1.GetDirFilesRequest
-	(*GetDirFilesResponse)(nil),               // 44: pb.clientrpc.v1.GetDirFilesResponse
-	(*GetFileMetaRequest)(nil),                // 45: pb.clientrpc.v1.GetFileMetaRequest
-	(*GetFileMetaResponse)(nil),               // 46: pb.clientrpc.v1.GetFileMetaResponse
-	(*GetOnlineUsersRequest)(nil),             // 47: pb.clientrpc.v1.GetOnlineUsersRequest
-	(*GetOnlineUsersResponse)(nil),            // 48: pb.clientrpc.v1.GetOnlineUsersResponse
-	(*ChangeAccountPasswordRequest)(nil),      // 49: pb.clientrpc.v1.ChangeAccountPasswordRequest
-	(*ChangeAccountPasswordResponse)(nil),     // 50: pb.clientrpc.v1.ChangeAccountPasswordResponse
-	(*ServerConnectRequest)(nil),              // 51: pb.clientrpc.v1.ServerConnectRequest
-	(*ServerConnectResponse)(nil),             // 52: pb.clientrpc.v1.ServerConnectResponse
-	(*ServerDisconnectRequest)(nil),           // 53: pb.clientrpc.v1.ServerDisconnectRequest
-	(*ServerDisconnectResponse)(nil),          // 54: pb.clientrpc.v1.ServerDisconnectResponse
-	(*GetDirectSettingsRequest)(nil),          // 55: pb.clientrpc.v1.GetDirectSettingsRequest
-	(*GetDirectSettingsResponse)(nil),         // 56: pb.clientrpc.v1.GetDirectSettingsResponse
-	(*UpdateDirectSettingsRequest)(nil),       // 57: pb.clientrpc.v1.UpdateDirectSettingsRequest
-	(*UpdateDirectSettingsResponse)(nil),      // 58: pb.clientrpc.v1.UpdateDirectSettingsResponse
-	(*GetTransferSettingsRequest)(nil),        // 59: pb.clientrpc.v1.GetTransferSettingsRequest
-	(*GetTransferSettingsResponse)(nil),       // 60: pb.clientrpc.v1.GetTransferSettingsResponse
-	(*UpdateTransferSettingsRequest)(nil),     // 61: pb.clientrpc.v1.UpdateTransferSettingsRequest
-	(*UpdateTransferSettingsResponse)(nil),    // 62: pb.clientrpc.v1.UpdateTransferSettingsResponse
-	(*IndexShareRequest)(nil),                 // 63: pb.clientrpc.v1.IndexShareRequest
-	(*IndexShareResponse)(nil),                // 64: pb.clientrpc.v1.IndexShareResponse
-	(*StreamSearchRequest)(nil),               // 65: pb.clientrpc.v1.StreamSearchRequest
-	(*StreamSearchResponse)(nil),              // 66: pb.clientrpc.v1.StreamSearchResponse
-	(*GetUpdateInfoRequest)(nil),              // 67: pb.clientrpc.v1.GetUpdateInfoRequest
-	(*GetUpdateInfoResponse)(nil),             // 68: pb.clientrpc.v1.GetUpdateInfoResponse
-	(*CheckForNewUpdateRequest)(nil),          // 69: pb.clientrpc.v1.CheckForNewUpdateRequest
-	(*CheckForNewUpdateResponse)(nil),         // 70: pb.clientrpc.v1.CheckForNewUpdateResponse
-	(*GetDownloadManagerItemsRequest)(nil),    // 71: pb.clientrpc.v1.GetDownloadManagerItemsRequest
-	(*GetDownloadManagerItemsResponse)(nil),   // 72: pb.clientrpc.v1.GetDownloadManagerItemsResponse
-	(*QueueFileDownloadRequest)(nil),          // 73: pb.clientrpc.v1.QueueFileDownloadRequest
-	(*QueueFileDownloadResponse)(nil),         // 74: pb.clientrpc.v1.QueueFileDownloadResponse
-	(*CancelFileDownloadRequest)(nil),         // 75: pb.clientrpc.v1.CancelFileDownloadRequest
-	(*CancelFileDownloadResponse)(nil),        // 76: pb.clientrpc.v1.CancelFileDownloadResponse
-	(*RemoveDownloadManagerItemRequest)(nil),  // 77: pb.clientrpc.v1.RemoveDownloadManagerItemRequest
-	(*RemoveDownloadManagerItemResponse)(nil), // 78: pb.clientrpc.v1.RemoveDownloadManagerItemResponse
-	(*ResumeFileDownloadRequest)(nil),         // 79: pb.clientrpc.v1.ResumeFileDownloadRequest
-	(*ResumeFileDownloadResponse)(nil),        // 80: pb.clientrpc.v1.ResumeFileDownloadResponse
-	(*Event_ServerConnStateChange)(nil),       // 81: pb.clientrpc.v1.Event.ServerConnStateChange
-	(*Event_ClientOnline)(nil),                // 82: pb.clientrpc.v1.Event.ClientOnline
-	(*Event_ClientOffline)(nil),               // 83: pb.clientrpc.v1.Event.ClientOffline
-	(*Event_NewUpdate)(nil),                   // 84: pb.clientrpc.v1.Event.NewUpdate
-	(*Event_DownloadStatusUpdates)(nil),       // 85: pb.clientrpc.v1.Event.DownloadStatusUpdates
-	(*Event_NewDmItem)(nil),                   // 86: pb.clientrpc.v1.Event.NewDmItem
-	(*Event_DmItemRemoved)(nil),               // 87: pb.clientrpc.v1.Event.DmItemRemoved
-	(*DownloadManagerItem_Download)(nil),      // 88: pb.clientrpc.v1.DownloadManagerItem.Download
-	(*ServerInfo_State)(nil),                  // 89: pb.clientrpc.v1.ServerInfo.State
+	(DownloadStatus)(0),                             // 0: pb.clientrpc.v1.DownloadStatus
+	(ScanStatus)(0),                                 // 1: pb.clientrpc.v1.ScanStatus
+	(UploadStatus)(0),                               // 2: pb.clientrpc.v1.UploadStatus
+	(ArchiveFormat)(0),                              // 3: pb.clientrpc.v1.ArchiveFormat
+	(PeerPath)(0),                                   // 4: pb.clientrpc.v1.PeerPath
+	(DownloadHookType)(0),                           // 5: pb.clientrpc.v1.DownloadHookType
+	(UpdateChannel)(0),                              // 6: pb.clientrpc.v1.UpdateChannel
+	(ErrorReason)(0),                                // 7: pb.clientrpc.v1.ErrorReason
+	(ServerConnState)(0),                            // 8: pb.clientrpc.v1.ServerConnState
+	(ShareUnicodeForm)(0),                           // 9: pb.clientrpc.v1.ShareUnicodeForm
+	(ShareConflictRule)(0),                          // 10: pb.clientrpc.v1.ShareConflictRule
+	(TrustLevel)(0),                                 // 11: pb.clientrpc.v1.TrustLevel
+	(ShareHealthIssueKind)(0),                       // 12: pb.clientrpc.v1.ShareHealthIssueKind
+	(DiagnosticStep)(0),                             // 13: pb.clientrpc.v1.DiagnosticStep
+	(DiagnosticStatus)(0),                           // 14: pb.clientrpc.v1.DiagnosticStatus
+	(DuplicateAction)(0),                            // 15: pb.clientrpc.v1.DuplicateAction
+	(PluginScope)(0),                                // 16: pb.clientrpc.v1.PluginScope
+	(PluginEventType)(0),                            // 17: pb.clientrpc.v1.PluginEventType
+	(BridgeRequestType)(0),                          // 18: pb.clientrpc.v1.BridgeRequestType
+	(Event_Type)(0),                                 // 19: pb.clientrpc.v1.Event.Type
+	(DownloadManagerItem_Type)(0),                   // 20: pb.clientrpc.v1.DownloadManagerItem.Type
+	(*Event)(nil),                                   // 21: pb.clientrpc.v1.Event
+	(*EventContext)(nil),                            // 22: pb.clientrpc.v1.EventContext
+	(*LogMessageAttr)(nil),                          // 23: pb.clientrpc.v1.LogMessageAttr
+	(*LogMessage)(nil),                              // 24: pb.clientrpc.v1.LogMessage
+	(*DownloadStatusUpdate)(nil),                    // 25: pb.clientrpc.v1.DownloadStatusUpdate
+	(*RecoveredDownload)(nil),                       // 26: pb.clientrpc.v1.RecoveredDownload
+	(*UploadInfo)(nil),                              // 27: pb.clientrpc.v1.UploadInfo
+	(*DownloadManagerItem)(nil),                     // 28: pb.clientrpc.v1.DownloadManagerItem
+	(*DownloadHookInfo)(nil),                        // 29: pb.clientrpc.v1.DownloadHookInfo
+	(*UpdateInfo)(nil),                              // 30: pb.clientrpc.v1.UpdateInfo
+	(*UpdateSettings)(nil),                          // 31: pb.clientrpc.v1.UpdateSettings
+	(*ErrorInfo)(nil),                               // 32: pb.clientrpc.v1.ErrorInfo
+	(*RttStats)(nil),                                // 33: pb.clientrpc.v1.RttStats
+	(*ServerInfo)(nil),                              // 34: pb.clientrpc.v1.ServerInfo
+	(*RemoteServerInfo)(nil),                        // 35: pb.clientrpc.v1.RemoteServerInfo
+	(*ShareInfo)(nil),                               // 36: pb.clientrpc.v1.ShareInfo
+	(*ShareMount)(nil),                              // 37: pb.clientrpc.v1.ShareMount
+	(*ShareLinkInfo)(nil),                           // 38: pb.clientrpc.v1.ShareLinkInfo
+	(*OnlineUserInfo)(nil),                          // 39: pb.clientrpc.v1.OnlineUserInfo
+	(*FriendInfo)(nil),                              // 40: pb.clientrpc.v1.FriendInfo
+	(*FileMeta)(nil),                                // 41: pb.clientrpc.v1.FileMeta
+	(*DirectSettings)(nil),                          // 42: pb.clientrpc.v1.DirectSettings
+	(*TransferSettings)(nil),                        // 43: pb.clientrpc.v1.TransferSettings
+	(*NotificationSettings)(nil),                    // 44: pb.clientrpc.v1.NotificationSettings
+	(*StreamEventsRequest)(nil),                     // 45: pb.clientrpc.v1.StreamEventsRequest
+	(*StreamEventsResponse)(nil),                    // 46: pb.clientrpc.v1.StreamEventsResponse
+	(*StreamLogsRequest)(nil),                       // 47: pb.clientrpc.v1.StreamLogsRequest
+	(*StreamLogsResponse)(nil),                      // 48: pb.clientrpc.v1.StreamLogsResponse
+	(*StopRequest)(nil),                             // 49: pb.clientrpc.v1.StopRequest
+	(*StopResponse)(nil),                            // 50: pb.clientrpc.v1.StopResponse
+	(*GetClientInfoRequest)(nil),                    // 51: pb.clientrpc.v1.GetClientInfoRequest
+	(*GetClientInfoResponse)(nil),                   // 52: pb.clientrpc.v1.GetClientInfoResponse
+	(*GetMemoryUsageRequest)(nil),                   // 53: pb.clientrpc.v1.GetMemoryUsageRequest
+	(*GetMemoryUsageResponse)(nil),                  // 54: pb.clientrpc.v1.GetMemoryUsageResponse
+	(*SetLowMemoryModeRequest)(nil),                 // 55: pb.clientrpc.v1.SetLowMemoryModeRequest
+	(*SetLowMemoryModeResponse)(nil),                // 56: pb.clientrpc.v1.SetLowMemoryModeResponse
+	(*GetServersRequest)(nil),                       // 57: pb.clientrpc.v1.GetServersRequest
+	(*GetServersResponse)(nil),                      // 58: pb.clientrpc.v1.GetServersResponse
+	(*CreateServerRequest)(nil),                     // 59: pb.clientrpc.v1.CreateServerRequest
+	(*CreateServerResponse)(nil),                    // 60: pb.clientrpc.v1.CreateServerResponse
+	(*ImportInviteBundleRequest)(nil),               // 61: pb.clientrpc.v1.ImportInviteBundleRequest
+	(*ImportInviteBundleResponse)(nil),              // 62: pb.clientrpc.v1.ImportInviteBundleResponse
+	(*AccountTemplateShare)(nil),                    // 63: pb.clientrpc.v1.AccountTemplateShare
+	(*AccountTemplate)(nil),                         // 64: pb.clientrpc.v1.AccountTemplate
+	(*CreateAccountTemplateRequest)(nil),            // 65: pb.clientrpc.v1.CreateAccountTemplateRequest
+	(*CreateAccountTemplateResponse)(nil),           // 66: pb.clientrpc.v1.CreateAccountTemplateResponse
+	(*CreateAccountTemplateFromServerRequest)(nil),  // 67: pb.clientrpc.v1.CreateAccountTemplateFromServerRequest
+	(*CreateAccountTemplateFromServerResponse)(nil), // 68: pb.clientrpc.v1.CreateAccountTemplateFromServerResponse
+	(*GetAccountTemplatesRequest)(nil),              // 69: pb.clientrpc.v1.GetAccountTemplatesRequest
+	(*GetAccountTemplatesResponse)(nil),             // 70: pb.clientrpc.v1.GetAccountTemplatesResponse
+	(*DeleteAccountTemplateRequest)(nil),            // 71: pb.clientrpc.v1.DeleteAccountTemplateRequest
+	(*DeleteAccountTemplateResponse)(nil),           // 72: pb.clientrpc.v1.DeleteAccountTemplateResponse
+	(*HostingInfo)(nil),                             // 73: pb.clientrpc.v1.HostingInfo
+	(*StartHostingRequest)(nil),                     // 74: pb.clientrpc.v1.StartHostingRequest
+	(*StartHostingResponse)(nil),                    // 75: pb.clientrpc.v1.StartHostingResponse
+	(*StopHostingRequest)(nil),                      // 76: pb.clientrpc.v1.StopHostingRequest
+	(*StopHostingResponse)(nil),                     // 77: pb.clientrpc.v1.StopHostingResponse
+	(*GetHostingInfoRequest)(nil),                   // 78: pb.clientrpc.v1.GetHostingInfoRequest
+	(*GetHostingInfoResponse)(nil),                  // 79: pb.clientrpc.v1.GetHostingInfoResponse
+	(*CreateHostingInviteRequest)(nil),              // 80: pb.clientrpc.v1.CreateHostingInviteRequest
+	(*CreateHostingInviteResponse)(nil),             // 81: pb.clientrpc.v1.CreateHostingInviteResponse
+	(*DeleteServerRequest)(nil),                     // 82: pb.clientrpc.v1.DeleteServerRequest
+	(*DeleteServerResponse)(nil),                    // 83: pb.clientrpc.v1.DeleteServerResponse
+	(*ConnectServerRequest)(nil),                    // 84: pb.clientrpc.v1.ConnectServerRequest
+	(*ConnectServerResponse)(nil),                   // 85: pb.clientrpc.v1.ConnectServerResponse
+	(*DisconnectServerRequest)(nil),                 // 86: pb.clientrpc.v1.DisconnectServerRequest
+	(*DisconnectServerResponse)(nil),                // 87: pb.clientrpc.v1.DisconnectServerResponse
+	(*UpdateServerRequest)(nil),                     // 88: pb.clientrpc.v1.UpdateServerRequest
+	(*UpdateServerResponse)(nil),                    // 89: pb.clientrpc.v1.UpdateServerResponse
+	(*GetSharesRequest)(nil),                        // 90: pb.clientrpc.v1.GetSharesRequest
+	(*GetSharesResponse)(nil),                       // 91: pb.clientrpc.v1.GetSharesResponse
+	(*CreateShareRequest)(nil),                      // 92: pb.clientrpc.v1.CreateShareRequest
+	(*CreateShareResponse)(nil),                     // 93: pb.clientrpc.v1.CreateShareResponse
+	(*DeleteShareRequest)(nil),                      // 94: pb.clientrpc.v1.DeleteShareRequest
+	(*DeleteShareResponse)(nil),                     // 95: pb.clientrpc.v1.DeleteShareResponse
+	(*SetShareExcludePatternsRequest)(nil),          // 96: pb.clientrpc.v1.SetShareExcludePatternsRequest
+	(*SetShareExcludePatternsResponse)(nil),         // 97: pb.clientrpc.v1.SetShareExcludePatternsResponse
+	(*ShareHealthIssue)(nil),                        // 98: pb.clientrpc.v1.ShareHealthIssue
+	(*CheckShareHealthRequest)(nil),                 // 99: pb.clientrpc.v1.CheckShareHealthRequest
+	(*CheckShareHealthResponse)(nil),                // 100: pb.clientrpc.v1.CheckShareHealthResponse
+	(*ShareImportEntry)(nil),                        // 101: pb.clientrpc.v1.ShareImportEntry
+	(*ShareManifest)(nil),                           // 102: pb.clientrpc.v1.ShareManifest
+	(*ShareImportResult)(nil),                       // 103: pb.clientrpc.v1.ShareImportResult
+	(*ImportSharesRequest)(nil),                     // 104: pb.clientrpc.v1.ImportSharesRequest
+	(*ImportSharesResponse)(nil),                    // 105: pb.clientrpc.v1.ImportSharesResponse
+	(*CreateShareLinkRequest)(nil),                  // 106: pb.clientrpc.v1.CreateShareLinkRequest
+	(*CreateShareLinkResponse)(nil),                 // 107: pb.clientrpc.v1.CreateShareLinkResponse
+	(*GetShareLinksRequest)(nil),                    // 108: pb.clientrpc.v1.GetShareLinksRequest
+	(*GetShareLinksResponse)(nil),                   // 109: pb.clientrpc.v1.GetShareLinksResponse
+	(*DeleteShareLinkRequest)(nil),                  // 110: pb.clientrpc.v1.DeleteShareLinkRequest
+	(*DeleteShareLinkResponse)(nil),                 // 111: pb.clientrpc.v1.DeleteShareLinkResponse
+	(*GetDirFilesRequest)(nil),                      // 112: pb.clientrpc.v1.GetDirFilesRequest
+	(*GetDirFilesResponse)(nil),                     // 113: pb.clientrpc.v1.GetDirFilesResponse
+	(*StreamDirArchiveRequest)(nil),                 // 114: pb.clientrpc.v1.StreamDirArchiveRequest
+	(*StreamDirArchiveResponse)(nil),                // 115: pb.clientrpc.v1.StreamDirArchiveResponse
+	(*GetFileMetaRequest)(nil),                      // 116: pb.clientrpc.v1.GetFileMetaRequest
+	(*GetFileMetaResponse)(nil),                     // 117: pb.clientrpc.v1.GetFileMetaResponse
+	(*CreateFileLinkRequest)(nil),                   // 118: pb.clientrpc.v1.CreateFileLinkRequest
+	(*CreateFileLinkResponse)(nil),                  // 119: pb.clientrpc.v1.CreateFileLinkResponse
+	(*DiagnosticResult)(nil),                        // 120: pb.clientrpc.v1.DiagnosticResult
+	(*DiagnoseRequest)(nil),                         // 121: pb.clientrpc.v1.DiagnoseRequest
+	(*DiagnoseResponse)(nil),                        // 122: pb.clientrpc.v1.DiagnoseResponse
+	(*MeasurePeerRequest)(nil),                      // 123: pb.clientrpc.v1.MeasurePeerRequest
+	(*MeasurePeerResponse)(nil),                     // 124: pb.clientrpc.v1.MeasurePeerResponse
+	(*GetOnlineUsersRequest)(nil),                   // 125: pb.clientrpc.v1.GetOnlineUsersRequest
+	(*GetOnlineUsersResponse)(nil),                  // 126: pb.clientrpc.v1.GetOnlineUsersResponse
+	(*ChangeAccountPasswordRequest)(nil),            // 127: pb.clientrpc.v1.ChangeAccountPasswordRequest
+	(*ChangeAccountPasswordResponse)(nil),           // 128: pb.clientrpc.v1.ChangeAccountPasswordResponse
+	(*ServerConnectRequest)(nil),                    // 129: pb.clientrpc.v1.ServerConnectRequest
+	(*ServerConnectResponse)(nil),                   // 130: pb.clientrpc.v1.ServerConnectResponse
+	(*ServerDisconnectRequest)(nil),                 // 131: pb.clientrpc.v1.ServerDisconnectRequest
+	(*ServerDisconnectResponse)(nil),                // 132: pb.clientrpc.v1.ServerDisconnectResponse
+	(*GetDirectSettingsRequest)(nil),                // 133: pb.clientrpc.v1.GetDirectSettingsRequest
+	(*GetDirectSettingsResponse)(nil),               // 134: pb.clientrpc.v1.GetDirectSettingsResponse
+	(*UpdateDirectSettingsRequest)(nil),             // 135: pb.clientrpc.v1.UpdateDirectSettingsRequest
+	(*UpdateDirectSettingsResponse)(nil),            // 136: pb.clientrpc.v1.UpdateDirectSettingsResponse
+	(*GetTransferSettingsRequest)(nil),              // 137: pb.clientrpc.v1.GetTransferSettingsRequest
+	(*GetTransferSettingsResponse)(nil),             // 138: pb.clientrpc.v1.GetTransferSettingsResponse
+	(*UpdateTransferSettingsRequest)(nil),           // 139: pb.clientrpc.v1.UpdateTransferSettingsRequest
+	(*UpdateTransferSettingsResponse)(nil),          // 140: pb.clientrpc.v1.UpdateTransferSettingsResponse
+	(*GetNotificationSettingsRequest)(nil),          // 141: pb.clientrpc.v1.GetNotificationSettingsRequest
+	(*GetNotificationSettingsResponse)(nil),         // 142: pb.clientrpc.v1.GetNotificationSettingsResponse
+	(*UpdateNotificationSettingsRequest)(nil),       // 143: pb.clientrpc.v1.UpdateNotificationSettingsRequest
+	(*UpdateNotificationSettingsResponse)(nil),      // 144: pb.clientrpc.v1.UpdateNotificationSettingsResponse
+	(*ExportConfigRequest)(nil),                     // 145: pb.clientrpc.v1.ExportConfigRequest
+	(*ExportConfigResponse)(nil),                    // 146: pb.clientrpc.v1.ExportConfigResponse
+	(*ImportConfigRequest)(nil),                     // 147: pb.clientrpc.v1.ImportConfigRequest
+	(*ImportConfigResponse)(nil),                    // 148: pb.clientrpc.v1.ImportConfigResponse
+	(*BackupDatabaseRequest)(nil),                   // 149: pb.clientrpc.v1.BackupDatabaseRequest
+	(*BackupDatabaseResponse)(nil),                  // 150: pb.clientrpc.v1.BackupDatabaseResponse
+	(*CheckDatabaseIntegrityRequest)(nil),           // 151: pb.clientrpc.v1.CheckDatabaseIntegrityRequest
+	(*CheckDatabaseIntegrityResponse)(nil),          // 152: pb.clientrpc.v1.CheckDatabaseIntegrityResponse
+	(*IndexShareRequest)(nil),                       // 153: pb.clientrpc.v1.IndexShareRequest
+	(*IndexShareResponse)(nil),                      // 154: pb.clientrpc.v1.IndexShareResponse
+	(*StreamSearchRequest)(nil),                     // 155: pb.clientrpc.v1.StreamSearchRequest
+	(*StreamSearchResponse)(nil),                    // 156: pb.clientrpc.v1.StreamSearchResponse
+	(*GetUpdateInfoRequest)(nil),                    // 157: pb.clientrpc.v1.GetUpdateInfoRequest
+	(*GetUpdateInfoResponse)(nil),                   // 158: pb.clientrpc.v1.GetUpdateInfoResponse
+	(*CheckForNewUpdateRequest)(nil),                // 159: pb.clientrpc.v1.CheckForNewUpdateRequest
+	(*CheckForNewUpdateResponse)(nil),               // 160: pb.clientrpc.v1.CheckForNewUpdateResponse
+	(*ApplyUpdateRequest)(nil),                      // 161: pb.clientrpc.v1.ApplyUpdateRequest
+	(*ApplyUpdateResponse)(nil),                     // 162: pb.clientrpc.v1.ApplyUpdateResponse
+	(*GetUpdateSettingsRequest)(nil),                // 163: pb.clientrpc.v1.GetUpdateSettingsRequest
+	(*GetUpdateSettingsResponse)(nil),               // 164: pb.clientrpc.v1.GetUpdateSettingsResponse
+	(*UpdateUpdateSettingsRequest)(nil),             // 165: pb.clientrpc.v1.UpdateUpdateSettingsRequest
+	(*UpdateUpdateSettingsResponse)(nil),            // 166: pb.clientrpc.v1.UpdateUpdateSettingsResponse
+	(*GetDownloadManagerItemsRequest)(nil),          // 167: pb.clientrpc.v1.GetDownloadManagerItemsRequest
+	(*GetDownloadManagerItemsResponse)(nil),         // 168: pb.clientrpc.v1.GetDownloadManagerItemsResponse
+	(*QueueFileDownloadRequest)(nil),                // 169: pb.clientrpc.v1.QueueFileDownloadRequest
+	(*QueueFileDownloadResponse)(nil),               // 170: pb.clientrpc.v1.QueueFileDownloadResponse
+	(*DuplicateFile)(nil),                           // 171: pb.clientrpc.v1.DuplicateFile
+	(*CancelFileDownloadRequest)(nil),               // 172: pb.clientrpc.v1.CancelFileDownloadRequest
+	(*CancelFileDownloadResponse)(nil),              // 173: pb.clientrpc.v1.CancelFileDownloadResponse
+	(*RemoveDownloadManagerItemRequest)(nil),        // 174: pb.clientrpc.v1.RemoveDownloadManagerItemRequest
+	(*RemoveDownloadManagerItemResponse)(nil),       // 175: pb.clientrpc.v1.RemoveDownloadManagerItemResponse
+	(*PauseFileDownloadRequest)(nil),                // 176: pb.clientrpc.v1.PauseFileDownloadRequest
+	(*PauseFileDownloadResponse)(nil),               // 177: pb.clientrpc.v1.PauseFileDownloadResponse
+	(*ResumeFileDownloadRequest)(nil),               // 178: pb.clientrpc.v1.ResumeFileDownloadRequest
+	(*ResumeFileDownloadResponse)(nil),              // 179: pb.clientrpc.v1.ResumeFileDownloadResponse
+	(*GetDownloadHooksRequest)(nil),                 // 180: pb.clientrpc.v1.GetDownloadHooksRequest
+	(*GetDownloadHooksResponse)(nil),                // 181: pb.clientrpc.v1.GetDownloadHooksResponse
+	(*CreateDownloadHookRequest)(nil),               // 182: pb.clientrpc.v1.CreateDownloadHookRequest
+	(*CreateDownloadHookResponse)(nil),              // 183: pb.clientrpc.v1.CreateDownloadHookResponse
+	(*DeleteDownloadHookRequest)(nil),               // 184: pb.clientrpc.v1.DeleteDownloadHookRequest
+	(*DeleteDownloadHookResponse)(nil),              // 185: pb.clientrpc.v1.DeleteDownloadHookResponse
+	(*GetUploadsRequest)(nil),                       // 186: pb.clientrpc.v1.GetUploadsRequest
+	(*GetUploadsResponse)(nil),                      // 187: pb.clientrpc.v1.GetUploadsResponse
+	(*ClearUploadHistoryRequest)(nil),               // 188: pb.clientrpc.v1.ClearUploadHistoryRequest
+	(*ClearUploadHistoryResponse)(nil),              // 189: pb.clientrpc.v1.ClearUploadHistoryResponse
+	(*GetFriendsRequest)(nil),                       // 190: pb.clientrpc.v1.GetFriendsRequest
+	(*GetFriendsResponse)(nil),                      // 191: pb.clientrpc.v1.GetFriendsResponse
+	(*SetFriendRequest)(nil),                        // 192: pb.clientrpc.v1.SetFriendRequest
+	(*SetFriendResponse)(nil),                       // 193: pb.clientrpc.v1.SetFriendResponse
+	(*DeleteFriendRequest)(nil),                     // 194: pb.clientrpc.v1.DeleteFriendRequest
+	(*DeleteFriendResponse)(nil),                    // 195: pb.clientrpc.v1.DeleteFriendResponse
+	(*BlockedPeerInfo)(nil),                         // 196: pb.clientrpc.v1.BlockedPeerInfo
+	(*GetBlockedPeersRequest)(nil),                  // 197: pb.clientrpc.v1.GetBlockedPeersRequest
+	(*GetBlockedPeersResponse)(nil),                 // 198: pb.clientrpc.v1.GetBlockedPeersResponse
+	(*BlockPeerRequest)(nil),                        // 199: pb.clientrpc.v1.BlockPeerRequest
+	(*BlockPeerResponse)(nil),                       // 200: pb.clientrpc.v1.BlockPeerResponse
+	(*UnblockPeerRequest)(nil),                      // 201: pb.clientrpc.v1.UnblockPeerRequest
+	(*UnblockPeerResponse)(nil),                     // 202: pb.clientrpc.v1.UnblockPeerResponse
+	(*GetInterestsRequest)(nil),                     // 203: pb.clientrpc.v1.GetInterestsRequest
+	(*GetInterestsResponse)(nil),                    // 204: pb.clientrpc.v1.GetInterestsResponse
+	(*SetInterestsRequest)(nil),                     // 205: pb.clientrpc.v1.SetInterestsRequest
+	(*SetInterestsResponse)(nil),                    // 206: pb.clientrpc.v1.SetInterestsResponse
+	(*SimilarUserInfo)(nil),                         // 207: pb.clientrpc.v1.SimilarUserInfo
+	(*GetSimilarUsersRequest)(nil),                  // 208: pb.clientrpc.v1.GetSimilarUsersRequest
+	(*GetSimilarUsersResponse)(nil),                 // 209: pb.clientrpc.v1.GetSimilarUsersResponse
+	(*ConnWindow)(nil),                              // 210: pb.clientrpc.v1.ConnWindow
+	(*GetServerScheduleRequest)(nil),                // 211: pb.clientrpc.v1.GetServerScheduleRequest
+	(*GetServerScheduleResponse)(nil),               // 212: pb.clientrpc.v1.GetServerScheduleResponse
+	(*SetServerScheduleRequest)(nil),                // 213: pb.clientrpc.v1.SetServerScheduleRequest
+	(*SetServerScheduleResponse)(nil),               // 214: pb.clientrpc.v1.SetServerScheduleResponse
+	(*SnoozeInfo)(nil),                              // 215: pb.clientrpc.v1.SnoozeInfo
+	(*GetSnoozeRequest)(nil),                        // 216: pb.clientrpc.v1.GetSnoozeRequest
+	(*GetSnoozeResponse)(nil),                       // 217: pb.clientrpc.v1.GetSnoozeResponse
+	(*SnoozeRequest)(nil),                           // 218: pb.clientrpc.v1.SnoozeRequest
+	(*SnoozeResponse)(nil),                          // 219: pb.clientrpc.v1.SnoozeResponse
+	(*UnsnoozeRequest)(nil),                         // 220: pb.clientrpc.v1.UnsnoozeRequest
+	(*UnsnoozeResponse)(nil),                        // 221: pb.clientrpc.v1.UnsnoozeResponse
+	(*RunSessionInfo)(nil),                          // 222: pb.clientrpc.v1.RunSessionInfo
+	(*ConnSessionInfo)(nil),                         // 223: pb.clientrpc.v1.ConnSessionInfo
+	(*GetRunHistoryRequest)(nil),                    // 224: pb.clientrpc.v1.GetRunHistoryRequest
+	(*GetRunHistoryResponse)(nil),                   // 225: pb.clientrpc.v1.GetRunHistoryResponse
+	(*GetConnHistoryRequest)(nil),                   // 226: pb.clientrpc.v1.GetConnHistoryRequest
+	(*GetConnHistoryResponse)(nil),                  // 227: pb.clientrpc.v1.GetConnHistoryResponse
+	(*TrashedServer)(nil),                           // 228: pb.clientrpc.v1.TrashedServer
+	(*TrashedShare)(nil),                            // 229: pb.clientrpc.v1.TrashedShare
+	(*GetTrashRequest)(nil),                         // 230: pb.clientrpc.v1.GetTrashRequest
+	(*GetTrashResponse)(nil),                        // 231: pb.clientrpc.v1.GetTrashResponse
+	(*RestoreServerRequest)(nil),                    // 232: pb.clientrpc.v1.RestoreServerRequest
+	(*RestoreServerResponse)(nil),                   // 233: pb.clientrpc.v1.RestoreServerResponse
+	(*PurgeServerRequest)(nil),                      // 234: pb.clientrpc.v1.PurgeServerRequest
+	(*PurgeServerResponse)(nil),                     // 235: pb.clientrpc.v1.PurgeServerResponse
+	(*RestoreShareRequest)(nil),                     // 236: pb.clientrpc.v1.RestoreShareRequest
+	(*RestoreShareResponse)(nil),                    // 237: pb.clientrpc.v1.RestoreShareResponse
+	(*PurgeShareRequest)(nil),                       // 238: pb.clientrpc.v1.PurgeShareRequest
+	(*PurgeShareResponse)(nil),                      // 239: pb.clientrpc.v1.PurgeShareResponse
+	(*PluginInfo)(nil),                              // 240: pb.clientrpc.v1.PluginInfo
+	(*PluginEvent)(nil),                             // 241: pb.clientrpc.v1.PluginEvent
+	(*PluginSearchResult)(nil),                      // 242: pb.clientrpc.v1.PluginSearchResult
+	(*GetPluginsRequest)(nil),                       // 243: pb.clientrpc.v1.GetPluginsRequest
+	(*GetPluginsResponse)(nil),                      // 244: pb.clientrpc.v1.GetPluginsResponse
+	(*CreatePluginRequest)(nil),                     // 245: pb.clientrpc.v1.CreatePluginRequest
+	(*CreatePluginResponse)(nil),                    // 246: pb.clientrpc.v1.CreatePluginResponse
+	(*DeletePluginRequest)(nil),                     // 247: pb.clientrpc.v1.DeletePluginRequest
+	(*DeletePluginResponse)(nil),                    // 248: pb.clientrpc.v1.DeletePluginResponse
+	(*StreamPluginEventsRequest)(nil),               // 249: pb.clientrpc.v1.StreamPluginEventsRequest
+	(*StreamPluginEventsResponse)(nil),              // 250: pb.clientrpc.v1.StreamPluginEventsResponse
+	(*RespondToSearchRequest)(nil),                  // 251: pb.clientrpc.v1.RespondToSearchRequest
+	(*RespondToSearchResponse)(nil),                 // 252: pb.clientrpc.v1.RespondToSearchResponse
+	(*BridgeRequest)(nil),                           // 253: pb.clientrpc.v1.BridgeRequest
+	(*BridgeError)(nil),                             // 254: pb.clientrpc.v1.BridgeError
+	(*BridgeResponse)(nil),                          // 255: pb.clientrpc.v1.BridgeResponse
+	(*Event_ServerConnStateChange)(nil),             // 256: pb.clientrpc.v1.Event.ServerConnStateChange
+	(*Event_ClientOnline)(nil),                      // 257: pb.clientrpc.v1.Event.ClientOnline
+	(*Event_ClientOffline)(nil),                     // 258: pb.clientrpc.v1.Event.ClientOffline
+	(*Event_NewUpdate)(nil),                         // 259: pb.clientrpc.v1.Event.NewUpdate
+	(*Event_DownloadStatusUpdates)(nil),             // 260: pb.clientrpc.v1.Event.DownloadStatusUpdates
+	(*Event_NewDmItem)(nil),                         // 261: pb.clientrpc.v1.Event.NewDmItem
+	(*Event_DmItemRemoved)(nil),                     // 262: pb.clientrpc.v1.Event.DmItemRemoved
+	(*Event_ShareChanged)(nil),                      // 263: pb.clientrpc.v1.Event.ShareChanged
+	(*Event_ServerNotice)(nil),                      // 264: pb.clientrpc.v1.Event.ServerNotice
+	(*Event_UploadUpdate)(nil),                      // 265: pb.clientrpc.v1.Event.UploadUpdate
+	(*Event_DownloadsRecovered)(nil),                // 266: pb.clientrpc.v1.Event.DownloadsRecovered
+	(*Event_ShutdownDrain)(nil),                     // 267: pb.clientrpc.v1.Event.ShutdownDrain
+	(*Event_RoomMotd)(nil),                          // 268: pb.clientrpc.v1.Event.RoomMotd
+	(*Event_ClockSkew)(nil),                         // 269: pb.clientrpc.v1.Event.ClockSkew
+	(*DownloadManagerItem_Download)(nil),            // 270: pb.clientrpc.v1.DownloadManagerItem.Download
+	(*ServerInfo_State)(nil),                        // 271: pb.clientrpc.v1.ServerInfo.State
+	nil,                                             // 272: pb.clientrpc.v1.TransferSettings.ServerCompleteDownloadDirsEntry
+	(*PluginEvent_ClientEvent)(nil),                 // 273: pb.clientrpc.v1.PluginEvent.ClientEvent
+	(*PluginEvent_Search)(nil),                      // 274: pb.clientrpc.v1.PluginEvent.Search
 }
 var file_pb_clientrpc_v1_rpc_proto_depIdxs = []int32{
-	2,  // 0: pb.clientrpc.v1.Event.type:type_name -> pb.clientrpc.v1.Event.Type
-	81, // 1: pb.clientrpc.v1.Event.server_conn:type_name -> pb.clientrpc.v1.Event.ServerConnStateChange
-	82, // 2: pb.clientrpc.v1.Event.client_online:type_name -> pb.clientrpc.v1.Event.ClientOnline
-	83, // 3: pb.clientrpc.v1.Event.client_offline:type_name -> pb.clientrpc.v1.Event.ClientOffline
-	84, // 4: pb.clientrpc.v1.Event.new_update:type_name -> pb.clientrpc.v1.Event.NewUpdate
-	85, // 5: pb.clientrpc.v1.Event.download_status_updates:type_name -> pb.clientrpc.v1.Event.DownloadStatusUpdates
-	86, // 6: pb.clientrpc.v1.Event.new_dm_item:type_name -> pb.clientrpc.v1.Event.NewDmItem
-	87, // 7: pb.clientrpc.v1.Event.dm_item_removed:type_name -> pb.clientrpc.v1.Event.DmItemRemoved
-	6,  // 8: pb.clientrpc.v1.LogMessage.attrs:type_name -> pb.clientrpc.v1.LogMessageAttr
-	0,  // 9: pb.clientrpc.v1.DownloadStatusUpdate.status:type_name -> pb.clientrpc.v1.DownloadStatus
-	3,  // 10: pb.clientrpc.v1.DownloadManagerItem.type:type_name -> pb.clientrpc.v1.DownloadManagerItem.Type
-	88, // 11: pb.clientrpc.v1.DownloadManagerItem.download:type_name -> pb.clientrpc.v1.DownloadManagerItem.Download
-	89, // 12: pb.clientrpc.v1.ServerInfo.state:type_name -> pb.clientrpc.v1.ServerInfo.State
-	4,  // 13: pb.clientrpc.v1.StreamEventsResponse.event:type_name -> pb.clientrpc.v1.Event
-	5,  // 14: pb.clientrpc.v1.StreamEventsResponse.context:type_name -> pb.clientrpc.v1.EventContext
-	7,  // 15: pb.clientrpc.v1.StreamLogsResponse.logs:type_name -> pb.clientrpc.v1.LogMessage
-	11, // 16: pb.clientrpc.v1.GetServersResponse.servers:type_name -> pb.clientrpc.v1.ServerInfo
-	11, // 17: pb.clientrpc.v1.CreateServerResponse.server:type_name -> pb.clientrpc.v1.ServerInfo
-	11, // 18: pb.clientrpc.v1.UpdateServerResponse.server:type_name -> pb.clientrpc.v1.ServerInfo
-	12, // 19: pb.clientrpc.v1.GetSharesResponse.shares:type_name -> pb.clientrpc.v1.ShareInfo
-	12, // 20: pb.clientrpc.v1.CreateShareResponse.share:type_name -> pb.clientrpc.v1.ShareInfo
-	14, // 21: pb.clientrpc.v1.GetDirFilesResponse.content:type_name -> pb.clientrpc.v1.FileMeta
-	14, // 22: pb.clientrpc.v1.GetFileMetaResponse.meta:type_name -> pb.clientrpc.v1.FileMeta
-	13, // 23: pb.clientrpc.v1.GetOnlineUsersResponse.users:type_name -> pb.clientrpc.v1.OnlineUserInfo
-	15, // 24: pb.clientrpc.v1.GetDirectSettingsResponse.settings:type_name -> pb.clientrpc.v1.DirectSettings
-	15, // 25: pb.clientrpc.v1.UpdateDirectSettingsRequest.settings:type_name -> pb.clientrpc.v1.DirectSettings
-	16, // 26: pb.clientrpc.v1.GetTransferSettingsResponse.settings:type_name -> pb.clientrpc.v1.TransferSettings
-	16, // 27: pb.clientrpc.v1.UpdateTransferSettingsRequest.settings:type_name -> pb.clientrpc.v1.TransferSettings
-	14, // 28: pb.clientrpc.v1.StreamSearchResponse.file:type_name -> pb.clientrpc.v1.FileMeta
-	10, // 29: pb.clientrpc.v1.GetUpdateInfoResponse.current_info:type_name -> pb.clientrpc.v1.UpdateInfo
-	10, // 30: pb.clientrpc.v1.GetUpdateInfoResponse.new_info:type_name -> pb.clientrpc.v1.UpdateInfo
-	10, // 31: pb.clientrpc.v1.CheckForNewUpdateResponse.new_info:type_name -> pb.clientrpc.v1.UpdateInfo
-	9,  // 32: pb.clientrpc.v1.GetDownloadManagerItemsResponse.items:type_name -> pb.clientrpc.v1.DownloadManagerItem
-	1,  // 33: pb.clientrpc.v1.Event.ServerConnStateChange.state:type_name -> pb.clientrpc.v1.ServerConnState
-	13, // 34: pb.clientrpc.v1.Event.ClientOnline.info:type_name -> pb.clientrpc.v1.OnlineUserInfo
-	10, // 35: pb.clientrpc.v1.Event.NewUpdate.info:type_name -> pb.clientrpc.v1.UpdateInfo
-	8,  // 36: pb.clientrpc.v1.Event.DownloadStatusUpdates.files:type_name -> pb.clientrpc.v1.DownloadStatusUpdate
-	9,  // 37: pb.clientrpc.v1.Event.NewDmItem.item:type_name -> pb.clientrpc.v1.DownloadManagerItem
-	0,  // 38: pb.clientrpc.v1.DownloadManagerItem.Download.status:type_name -> pb.clientrpc.v1.DownloadStatus
-	1,  // 39: pb.clientrpc.v1.ServerInfo.State.conn_state:type_name -> pb.clientrpc.v1.ServerConnState
-	19, // 40: pb.clientrpc.v1.ClientRpcService.StreamLogs:input_type -> pb.clientrpc.v1.StreamLogsRequest
-	17, // 41: pb.clientrpc.v1.ClientRpcService.StreamEvents:input_type -> pb.clientrpc.v1.StreamEventsRequest
-	21, // 42: pb.clientrpc.v1.ClientRpcService.Stop:input_type -> pb.clientrpc.v1.StopRequest
-	23, // 43: pb.clientrpc.v1.ClientRpcService.GetClientInfo:input_type -> pb.clientrpc.v1.GetClientInfoRequest
-	25, // 44: pb.clientrpc.v1.ClientRpcService.GetServers:input_type -> pb.clientrpc.v1.GetServersRequest
-	27, // 45: pb.clientrpc.v1.ClientRpcService.CreateServer:input_type -> pb.clientrpc.v1.CreateServerRequest
-	29, // 46: pb.clientrpc.v1.ClientRpcService.DeleteServer:input_type -> pb.clientrpc.v1.DeleteServerRequest
-	31, // 47: pb.clientrpc.v1.ClientRpcService.ConnectServer:input_type -> pb.clientrpc.v1.ConnectServerRequest
-	33, // 48: pb.clientrpc.v1.ClientRpcService.DisconnectServer:input_type -> pb.clientrpc.v1.DisconnectServerRequest
-	35, // 49: pb.clientrpc.v1.ClientRpcService.UpdateServer:input_type -> pb.clientrpc.v1.UpdateServerRequest
-	37, // 50: pb.clientrpc.v1.ClientRpcService.GetShares:input_type -> pb.clientrpc.v1.GetSharesRequest
-	39, // 51: pb.clientrpc.v1.ClientRpcService.CreateShare:input_type -> pb.clientrpc.v1.CreateShareRequest
-	41, // 52: pb.clientrpc.v1.ClientRpcService.DeleteShare:input_type -> pb.clientrpc.v1.DeleteShareRequest
-	43, // 53: pb.clientrpc.v1.ClientRpcService.GetDirFiles:input_type -> pb.clientrpc.v1.GetDirFilesRequest
-	45, // 54: pb.clientrpc.v1.ClientRpcService.GetFileMeta:input_type -> pb.clientrpc.v1.GetFileMetaRequest
-	47, // 55: pb.clientrpc.v1.ClientRpcService.GetOnlineUsers:input_type -> pb.clientrpc.v1.GetOnlineUsersRequest
-	49, // 56: pb.clientrpc.v1.ClientRpcService.ChangeAccountPassword:input_type -> pb.clientrpc.v1.ChangeAccountPasswordRequest
-	51, // 57: pb.clientrpc.v1.ClientRpcService.ServerConnect:input_type -> pb.clientrpc.v1.ServerConnectRequest
-	53, // 58: pb.clientrpc.v1.ClientRpcService.ServerDisconnect:input_type -> pb.clientrpc.v1.ServerDisconnectRequest
-	55, // 59: pb.clientrpc.v1.ClientRpcService.GetDirectSettings:input_type -> pb.clientrpc.v1.GetDirectSettingsRequest
-	57, // 60: pb.clientrpc.v1.ClientRpcService.UpdateDirectSettings:input_type -> pb.clientrpc.v1.UpdateDirectSettingsRequest
-	59, // 61: pb.clientrpc.v1.ClientRpcService.GetTransferSettings:input_type -> pb.clientrpc.v1.GetTransferSettingsRequest
-	61, // 62: pb.clientrpc.v1.ClientRpcService.UpdateTransferSettings:input_type -> pb.clientrpc.v1.UpdateTransferSettingsRequest
-	63, // 63: pb.clientrpc.v1.ClientRpcService.IndexShare:input_type -> pb.clientrpc.v1.IndexShareRequest
-	65, // 64: pb.clientrpc.v1.ClientRpcService.StreamSearch:input_type -> pb.clientrpc.v1.StreamSearchRequest
-	67, // 65: pb.clientrpc.v1.ClientRpcService.GetUpdateInfo:input_type -> pb.clientrpc.v1.GetUpdateInfoRequest
-	69, // 66: pb.clientrpc.v1.ClientRpcService.CheckForNewUpdate:input_type -> pb.clientrpc.v1.CheckForNewUpdateRequest
-	71, // 67: pb.clientrpc.v1.ClientRpcService.GetDownloadManagerItems:input_type -> pb.clientrpc.v1.GetDownloadManagerItemsRequest
-	73, // 68: pb.clientrpc.v1.ClientRpcService.QueueFileDownload:input_type -> pb.clientrpc.v1.QueueFileDownloadRequest
-	75, // 69: pb.clientrpc.v1.ClientRpcService.CancelFileDownload:input_type -> pb.clientrpc.v1.CancelFileDownloadRequest
-	77, // 70: pb.clientrpc.v1.ClientRpcService.RemoveDownloadManagerItem:input_type -> pb.clientrpc.v1.RemoveDownloadManagerItemRequest
-	79, // 71: pb.clientrpc.v1.ClientRpcService.ResumeFileDownload:input_type -> pb.clientrpc.v1.ResumeFileDownloadRequest
-	20, // 72: pb.clientrpc.v1.ClientRpcService.StreamLogs:output_type -> pb.clientrpc.v1.StreamLogsResponse
-	18, // 73: pb.clientrpc.v1.ClientRpcService.StreamEvents:output_type -> pb.clientrpc.v1.StreamEventsResponse
-	22, // 74: pb.clientrpc.v1.ClientRpcService.Stop:output_type -> pb.clientrpc.v1.StopResponse
-	24, // 75: pb.clientrpc.v1.ClientRpcService.GetClientInfo:output_type -> pb.clientrpc.v1.GetClientInfoResponse
-	26, // 76: pb.clientrpc.v1.ClientRpcService.GetServers:output_type -> pb.clientrpc.v1.GetServersResponse
-	28, // 77: pb.clientrpc.v1.ClientRpcService.CreateServer:output_type -> pb.clientrpc.v1.CreateServerResponse
-	30, // 78: pb.clientrpc.v1.ClientRpcService.DeleteServer:output_type -> pb.clientrpc.v1.DeleteServerResponse
-	32, // 79: pb.clientrpc.v1.ClientRpcService.ConnectServer:output_type -> pb.clientrpc.v1.ConnectServerResponse
-	34, // 80: pb.clientrpc.v1.ClientRpcService.DisconnectServer:output_type -> pb.clientrpc.v1.DisconnectServerResponse
-	36, // 81: pb.clientrpc.v1.ClientRpcService.UpdateServer:output_type -> pb.clientrpc.v1.UpdateServerResponse
-	38, // 82: pb.clientrpc.v1.ClientRpcService.GetShares:output_type -> pb.clientrpc.v1.GetSharesResponse
-	40, // 83: pb.clientrpc.v1.ClientRpcService.CreateShare:output_type -> pb.clientrpc.v1.CreateShareResponse
-	42, // 84: pb.clientrpc.v1.ClientRpcService.DeleteShare:output_type -> pb.clientrpc.v1.DeleteShareResponse
-	44, // 85: pb.clientrpc.v1.ClientRpcService.GetDirFiles:output_type -> pb.clientrpc.v1.GetDirFilesResponse
-	46, // 86: pb.clientrpc.v1.ClientRpcService.GetFileMeta:output_type -> pb.clientrpc.v1.GetFileMetaResponse
-	48, // 87: pb.clientrpc.v1.ClientRpcService.GetOnlineUsers:output_type -> pb.clientrpc.v1.GetOnlineUsersResponse
-	50, // 88: pb.clientrpc.v1.ClientRpcService.ChangeAccountPassword:output_type -> pb.clientrpc.v1.ChangeAccountPasswordResponse
-	52, // 89: pb.clientrpc.v1.ClientRpcService.ServerConnect:output_type -> pb.clientrpc.v1.ServerConnectResponse
-	54, // 90: pb.clientrpc.v1.ClientRpcService.ServerDisconnect:output_type -> pb.clientrpc.v1.ServerDisconnectResponse
-	56, // 91: pb.clientrpc.v1.ClientRpcService.GetDirectSettings:output_type -> pb.clientrpc.v1.GetDirectSettingsResponse
-	58, // 92: pb.clientrpc.v1.ClientRpcService.UpdateDirectSettings:output_type -> pb.clientrpc.v1.UpdateDirectSettingsResponse
-	60, // 93: pb.clientrpc.v1.ClientRpcService.GetTransferSettings:output_type -> pb.clientrpc.v1.GetTransferSettingsResponse
-	62, // 94: pb.clientrpc.v1.ClientRpcService.UpdateTransferSettings:output_type -> pb.clientrpc.v1.UpdateTransferSettingsResponse
-	64, // 95: pb.clientrpc.v1.ClientRpcService.IndexShare:output_type -> pb.clientrpc.v1.IndexShareResponse
-	66, // 96: pb.clientrpc.v1.ClientRpcService.StreamSearch:output_type -> pb.clientrpc.v1.StreamSearchResponse
-	68, // 97: pb.clientrpc.v1.ClientRpcService.GetUpdateInfo:output_type -> pb.clientrpc.v1.GetUpdateInfoResponse
-	70, // 98: pb.clientrpc.v1.ClientRpcService.CheckForNewUpdate:output_type -> pb.clientrpc.v1.CheckForNewUpdateResponse
-	72, // 99: pb.clientrpc.v1.ClientRpcService.GetDownloadManagerItems:output_type -> pb.clientrpc.v1.GetDownloadManagerItemsResponse
-	74, // 100: pb.clientrpc.v1.ClientRpcService.QueueFileDownload:output_type -> pb.clientrpc.v1.QueueFileDownloadResponse
-	76, // 101: pb.clientrpc.v1.ClientRpcService.CancelFileDownload:output_type -> pb.clientrpc.v1.CancelFileDownloadResponse
-	78, // 102: pb.clientrpc.v1.ClientRpcService.RemoveDownloadManagerItem:output_type -> pb.clientrpc.v1.RemoveDownloadManagerItemResponse
-	80, // 103: pb.clientrpc.v1.ClientRpcService.ResumeFileDownload:output_type -> pb.clientrpc.v1.ResumeFileDownloadResponse
-	72, // [72:104] is the sub-list for method output_type
-	40, // [40:72] is the sub-list for method input_type
-	40, // [40:40] is the sub-list for extension type_name
-	40, // [40:40] is the sub-list for extension extendee
-	0,  // [0:40] is the sub-list for field type_name
+	19,  // 0: pb.clientrpc.v1.Event.type:type_name -> pb.clientrpc.v1.Event.Type
+	256, // 1: pb.clientrpc.v1.Event.server_conn:type_name -> pb.clientrpc.v1.Event.ServerConnStateChange
+	257, // 2: pb.clientrpc.v1.Event.client_online:type_name -> pb.clientrpc.v1.Event.ClientOnline
+	258, // 3: pb.clientrpc.v1.Event.client_offline:type_name -> pb.clientrpc.v1.Event.ClientOffline
+	259, // 4: pb.clientrpc.v1.Event.new_update:type_name -> pb.clientrpc.v1.Event.NewUpdate
+	260, // 5: pb.clientrpc.v1.Event.download_status_updates:type_name -> pb.clientrpc.v1.Event.DownloadStatusUpdates
+	261, // 6: pb.clientrpc.v1.Event.new_dm_item:type_name -> pb.clientrpc.v1.Event.NewDmItem
+	262, // 7: pb.clientrpc.v1.Event.dm_item_removed:type_name -> pb.clientrpc.v1.Event.DmItemRemoved
+	263, // 8: pb.clientrpc.v1.Event.share_changed:type_name -> pb.clientrpc.v1.Event.ShareChanged
+	264, // 9: pb.clientrpc.v1.Event.server_notice:type_name -> pb.clientrpc.v1.Event.ServerNotice
+	265, // 10: pb.clientrpc.v1.Event.upload_update:type_name -> pb.clientrpc.v1.Event.UploadUpdate
+	266, // 11: pb.clientrpc.v1.Event.downloads_recovered:type_name -> pb.clientrpc.v1.Event.DownloadsRecovered
+	267, // 12: pb.clientrpc.v1.Event.shutdown_drain:type_name -> pb.clientrpc.v1.Event.ShutdownDrain
+	268, // 13: pb.clientrpc.v1.Event.room_motd:type_name -> pb.clientrpc.v1.Event.RoomMotd
+	269, // 14: pb.clientrpc.v1.Event.clock_skew:type_name -> pb.clientrpc.v1.Event.ClockSkew
+	23,  // 15: pb.clientrpc.v1.LogMessage.attrs:type_name -> pb.clientrpc.v1.LogMessageAttr
+	0,   // 16: pb.clientrpc.v1.DownloadStatusUpdate.status:type_name -> pb.clientrpc.v1.DownloadStatus
+	1,   // 17: pb.clientrpc.v1.DownloadStatusUpdate.scan_status:type_name -> pb.clientrpc.v1.ScanStatus
+	2,   // 18: pb.clientrpc.v1.UploadInfo.status:type_name -> pb.clientrpc.v1.UploadStatus
+	20,  // 19: pb.clientrpc.v1.DownloadManagerItem.type:type_name -> pb.clientrpc.v1.DownloadManagerItem.Type
+	270, // 20: pb.clientrpc.v1.DownloadManagerItem.download:type_name -> pb.clientrpc.v1.DownloadManagerItem.Download
+	5,   // 21: pb.clientrpc.v1.DownloadHookInfo.type:type_name -> pb.clientrpc.v1.DownloadHookType
+	6,   // 22: pb.clientrpc.v1.UpdateSettings.channel:type_name -> pb.clientrpc.v1.UpdateChannel
+	7,   // 23: pb.clientrpc.v1.ErrorInfo.reason:type_name -> pb.clientrpc.v1.ErrorReason
+	271, // 24: pb.clientrpc.v1.ServerInfo.state:type_name -> pb.clientrpc.v1.ServerInfo.State
+	37,  // 25: pb.clientrpc.v1.ShareInfo.mounts:type_name -> pb.clientrpc.v1.ShareMount
+	10,  // 26: pb.clientrpc.v1.ShareInfo.conflict_rule:type_name -> pb.clientrpc.v1.ShareConflictRule
+	9,   // 27: pb.clientrpc.v1.ShareInfo.unicode_form:type_name -> pb.clientrpc.v1.ShareUnicodeForm
+	40,  // 28: pb.clientrpc.v1.OnlineUserInfo.friend:type_name -> pb.clientrpc.v1.FriendInfo
+	33,  // 29: pb.clientrpc.v1.OnlineUserInfo.direct_rtt:type_name -> pb.clientrpc.v1.RttStats
+	11,  // 30: pb.clientrpc.v1.FriendInfo.trust_level:type_name -> pb.clientrpc.v1.TrustLevel
+	272, // 31: pb.clientrpc.v1.TransferSettings.server_complete_download_dirs:type_name -> pb.clientrpc.v1.TransferSettings.ServerCompleteDownloadDirsEntry
+	21,  // 32: pb.clientrpc.v1.StreamEventsResponse.event:type_name -> pb.clientrpc.v1.Event
+	22,  // 33: pb.clientrpc.v1.StreamEventsResponse.context:type_name -> pb.clientrpc.v1.EventContext
+	24,  // 34: pb.clientrpc.v1.StreamLogsResponse.logs:type_name -> pb.clientrpc.v1.LogMessage
+	34,  // 35: pb.clientrpc.v1.GetServersResponse.servers:type_name -> pb.clientrpc.v1.ServerInfo
+	34,  // 36: pb.clientrpc.v1.CreateServerResponse.server:type_name -> pb.clientrpc.v1.ServerInfo
+	34,  // 37: pb.clientrpc.v1.ImportInviteBundleResponse.server:type_name -> pb.clientrpc.v1.ServerInfo
+	63,  // 38: pb.clientrpc.v1.AccountTemplate.shares:type_name -> pb.clientrpc.v1.AccountTemplateShare
+	63,  // 39: pb.clientrpc.v1.CreateAccountTemplateRequest.shares:type_name -> pb.clientrpc.v1.AccountTemplateShare
+	64,  // 40: pb.clientrpc.v1.CreateAccountTemplateResponse.template:type_name -> pb.clientrpc.v1.AccountTemplate
+	64,  // 41: pb.clientrpc.v1.CreateAccountTemplateFromServerResponse.template:type_name -> pb.clientrpc.v1.AccountTemplate
+	64,  // 42: pb.clientrpc.v1.GetAccountTemplatesResponse.templates:type_name -> pb.clientrpc.v1.AccountTemplate
+	73,  // 43: pb.clientrpc.v1.StartHostingResponse.info:type_name -> pb.clientrpc.v1.HostingInfo
+	73,  // 44: pb.clientrpc.v1.GetHostingInfoResponse.info:type_name -> pb.clientrpc.v1.HostingInfo
+	34,  // 45: pb.clientrpc.v1.UpdateServerResponse.server:type_name -> pb.clientrpc.v1.ServerInfo
+	36,  // 46: pb.clientrpc.v1.GetSharesResponse.shares:type_name -> pb.clientrpc.v1.ShareInfo
+	37,  // 47: pb.clientrpc.v1.CreateShareRequest.mounts:type_name -> pb.clientrpc.v1.ShareMount
+	10,  // 48: pb.clientrpc.v1.CreateShareRequest.conflict_rule:type_name -> pb.clientrpc.v1.ShareConflictRule
+	9,   // 49: pb.clientrpc.v1.CreateShareRequest.unicode_form:type_name -> pb.clientrpc.v1.ShareUnicodeForm
+	36,  // 50: pb.clientrpc.v1.CreateShareResponse.share:type_name -> pb.clientrpc.v1.ShareInfo
+	36,  // 51: pb.clientrpc.v1.SetShareExcludePatternsResponse.share:type_name -> pb.clientrpc.v1.ShareInfo
+	12,  // 52: pb.clientrpc.v1.ShareHealthIssue.kind:type_name -> pb.clientrpc.v1.ShareHealthIssueKind
+	98,  // 53: pb.clientrpc.v1.CheckShareHealthResponse.issues:type_name -> pb.clientrpc.v1.ShareHealthIssue
+	101, // 54: pb.clientrpc.v1.ShareManifest.shares:type_name -> pb.clientrpc.v1.ShareImportEntry
+	101, // 55: pb.clientrpc.v1.ShareImportResult.entry:type_name -> pb.clientrpc.v1.ShareImportEntry
+	36,  // 56: pb.clientrpc.v1.ShareImportResult.share:type_name -> pb.clientrpc.v1.ShareInfo
+	101, // 57: pb.clientrpc.v1.ImportSharesRequest.entries:type_name -> pb.clientrpc.v1.ShareImportEntry
+	103, // 58: pb.clientrpc.v1.ImportSharesResponse.results:type_name -> pb.clientrpc.v1.ShareImportResult
+	38,  // 59: pb.clientrpc.v1.CreateShareLinkResponse.link:type_name -> pb.clientrpc.v1.ShareLinkInfo
+	38,  // 60: pb.clientrpc.v1.GetShareLinksResponse.links:type_name -> pb.clientrpc.v1.ShareLinkInfo
+	41,  // 61: pb.clientrpc.v1.GetDirFilesResponse.content:type_name -> pb.clientrpc.v1.FileMeta
+	3,   // 62: pb.clientrpc.v1.StreamDirArchiveRequest.format:type_name -> pb.clientrpc.v1.ArchiveFormat
+	41,  // 63: pb.clientrpc.v1.GetFileMetaResponse.meta:type_name -> pb.clientrpc.v1.FileMeta
+	13,  // 64: pb.clientrpc.v1.DiagnosticResult.step:type_name -> pb.clientrpc.v1.DiagnosticStep
+	14,  // 65: pb.clientrpc.v1.DiagnosticResult.status:type_name -> pb.clientrpc.v1.DiagnosticStatus
+	120, // 66: pb.clientrpc.v1.DiagnoseResponse.results:type_name -> pb.clientrpc.v1.DiagnosticResult
+	4,   // 67: pb.clientrpc.v1.MeasurePeerRequest.path:type_name -> pb.clientrpc.v1.PeerPath
+	4,   // 68: pb.clientrpc.v1.MeasurePeerResponse.path:type_name -> pb.clientrpc.v1.PeerPath
+	39,  // 69: pb.clientrpc.v1.GetOnlineUsersResponse.users:type_name -> pb.clientrpc.v1.OnlineUserInfo
+	42,  // 70: pb.clientrpc.v1.GetDirectSettingsResponse.settings:type_name -> pb.clientrpc.v1.DirectSettings
+	42,  // 71: pb.clientrpc.v1.UpdateDirectSettingsRequest.settings:type_name -> pb.clientrpc.v1.DirectSettings
+	43,  // 72: pb.clientrpc.v1.GetTransferSettingsResponse.settings:type_name -> pb.clientrpc.v1.TransferSettings
+	43,  // 73: pb.clientrpc.v1.UpdateTransferSettingsRequest.settings:type_name -> pb.clientrpc.v1.TransferSettings
+	44,  // 74: pb.clientrpc.v1.GetNotificationSettingsResponse.settings:type_name -> pb.clientrpc.v1.NotificationSettings
+	44,  // 75: pb.clientrpc.v1.UpdateNotificationSettingsRequest.settings:type_name -> pb.clientrpc.v1.NotificationSettings
+	34,  // 76: pb.clientrpc.v1.ImportConfigResponse.servers:type_name -> pb.clientrpc.v1.ServerInfo
+	41,  // 77: pb.clientrpc.v1.StreamSearchResponse.file:type_name -> pb.clientrpc.v1.FileMeta
+	40,  // 78: pb.clientrpc.v1.StreamSearchResponse.friend:type_name -> pb.clientrpc.v1.FriendInfo
+	30,  // 79: pb.clientrpc.v1.GetUpdateInfoResponse.current_info:type_name -> pb.clientrpc.v1.UpdateInfo
+	30,  // 80: pb.clientrpc.v1.GetUpdateInfoResponse.new_info:type_name -> pb.clientrpc.v1.UpdateInfo
+	30,  // 81: pb.clientrpc.v1.CheckForNewUpdateResponse.new_info:type_name -> pb.clientrpc.v1.UpdateInfo
+	30,  // 82: pb.clientrpc.v1.ApplyUpdateResponse.info:type_name -> pb.clientrpc.v1.UpdateInfo
+	31,  // 83: pb.clientrpc.v1.GetUpdateSettingsResponse.settings:type_name -> pb.clientrpc.v1.UpdateSettings
+	31,  // 84: pb.clientrpc.v1.UpdateUpdateSettingsRequest.settings:type_name -> pb.clientrpc.v1.UpdateSettings
+	28,  // 85: pb.clientrpc.v1.GetDownloadManagerItemsResponse.items:type_name -> pb.clientrpc.v1.DownloadManagerItem
+	15,  // 86: pb.clientrpc.v1.QueueFileDownloadRequest.duplicate_action:type_name -> pb.clientrpc.v1.DuplicateAction
+	171, // 87: pb.clientrpc.v1.QueueFileDownloadResponse.duplicate:type_name -> pb.clientrpc.v1.DuplicateFile
+	29,  // 88: pb.clientrpc.v1.GetDownloadHooksResponse.hooks:type_name -> pb.clientrpc.v1.DownloadHookInfo
+	5,   // 89: pb.clientrpc.v1.CreateDownloadHookRequest.type:type_name -> pb.clientrpc.v1.DownloadHookType
+	29,  // 90: pb.clientrpc.v1.CreateDownloadHookResponse.hook:type_name -> pb.clientrpc.v1.DownloadHookInfo
+	27,  // 91: pb.clientrpc.v1.GetUploadsResponse.active:type_name -> pb.clientrpc.v1.UploadInfo
+	27,  // 92: pb.clientrpc.v1.GetUploadsResponse.history:type_name -> pb.clientrpc.v1.UploadInfo
+	40,  // 93: pb.clientrpc.v1.GetFriendsResponse.friends:type_name -> pb.clientrpc.v1.FriendInfo
+	11,  // 94: pb.clientrpc.v1.SetFriendRequest.trust_level:type_name -> pb.clientrpc.v1.TrustLevel
+	40,  // 95: pb.clientrpc.v1.SetFriendResponse.friend:type_name -> pb.clientrpc.v1.FriendInfo
+	196, // 96: pb.clientrpc.v1.GetBlockedPeersResponse.peers:type_name -> pb.clientrpc.v1.BlockedPeerInfo
+	207, // 97: pb.clientrpc.v1.GetSimilarUsersResponse.users:type_name -> pb.clientrpc.v1.SimilarUserInfo
+	210, // 98: pb.clientrpc.v1.GetServerScheduleResponse.windows:type_name -> pb.clientrpc.v1.ConnWindow
+	210, // 99: pb.clientrpc.v1.SetServerScheduleRequest.windows:type_name -> pb.clientrpc.v1.ConnWindow
+	215, // 100: pb.clientrpc.v1.GetSnoozeResponse.snooze:type_name -> pb.clientrpc.v1.SnoozeInfo
+	215, // 101: pb.clientrpc.v1.SnoozeResponse.snooze:type_name -> pb.clientrpc.v1.SnoozeInfo
+	222, // 102: pb.clientrpc.v1.GetRunHistoryResponse.runs:type_name -> pb.clientrpc.v1.RunSessionInfo
+	223, // 103: pb.clientrpc.v1.GetConnHistoryResponse.sessions:type_name -> pb.clientrpc.v1.ConnSessionInfo
+	36,  // 104: pb.clientrpc.v1.TrashedShare.share:type_name -> pb.clientrpc.v1.ShareInfo
+	228, // 105: pb.clientrpc.v1.GetTrashResponse.servers:type_name -> pb.clientrpc.v1.TrashedServer
+	229, // 106: pb.clientrpc.v1.GetTrashResponse.shares:type_name -> pb.clientrpc.v1.TrashedShare
+	34,  // 107: pb.clientrpc.v1.RestoreServerResponse.server:type_name -> pb.clientrpc.v1.ServerInfo
+	36,  // 108: pb.clientrpc.v1.RestoreShareResponse.share:type_name -> pb.clientrpc.v1.ShareInfo
+	16,  // 109: pb.clientrpc.v1.PluginInfo.scopes:type_name -> pb.clientrpc.v1.PluginScope
+	17,  // 110: pb.clientrpc.v1.PluginEvent.type:type_name -> pb.clientrpc.v1.PluginEventType
+	273, // 111: pb.clientrpc.v1.PluginEvent.client_event:type_name -> pb.clientrpc.v1.PluginEvent.ClientEvent
+	274, // 112: pb.clientrpc.v1.PluginEvent.search:type_name -> pb.clientrpc.v1.PluginEvent.Search
+	41,  // 113: pb.clientrpc.v1.PluginSearchResult.file:type_name -> pb.clientrpc.v1.FileMeta
+	240, // 114: pb.clientrpc.v1.GetPluginsResponse.plugins:type_name -> pb.clientrpc.v1.PluginInfo
+	16,  // 115: pb.clientrpc.v1.CreatePluginRequest.scopes:type_name -> pb.clientrpc.v1.PluginScope
+	240, // 116: pb.clientrpc.v1.CreatePluginResponse.plugin:type_name -> pb.clientrpc.v1.PluginInfo
+	17,  // 117: pb.clientrpc.v1.StreamPluginEventsRequest.types:type_name -> pb.clientrpc.v1.PluginEventType
+	241, // 118: pb.clientrpc.v1.StreamPluginEventsResponse.event:type_name -> pb.clientrpc.v1.PluginEvent
+	242, // 119: pb.clientrpc.v1.RespondToSearchRequest.results:type_name -> pb.clientrpc.v1.PluginSearchResult
+	18,  // 120: pb.clientrpc.v1.BridgeRequest.type:type_name -> pb.clientrpc.v1.BridgeRequestType
+	32,  // 121: pb.clientrpc.v1.BridgeError.info:type_name -> pb.clientrpc.v1.ErrorInfo
+	254, // 122: pb.clientrpc.v1.BridgeResponse.error:type_name -> pb.clientrpc.v1.BridgeError
+	41,  // 123: pb.clientrpc.v1.BridgeResponse.meta:type_name -> pb.clientrpc.v1.FileMeta
+	41,  // 124: pb.clientrpc.v1.BridgeResponse.files:type_name -> pb.clientrpc.v1.FileMeta
+	8,   // 125: pb.clientrpc.v1.Event.ServerConnStateChange.state:type_name -> pb.clientrpc.v1.ServerConnState
+	39,  // 126: pb.clientrpc.v1.Event.ClientOnline.info:type_name -> pb.clientrpc.v1.OnlineUserInfo
+	30,  // 127: pb.clientrpc.v1.Event.NewUpdate.info:type_name -> pb.clientrpc.v1.UpdateInfo
+	25,  // 128: pb.clientrpc.v1.Event.DownloadStatusUpdates.files:type_name -> pb.clientrpc.v1.DownloadStatusUpdate
+	28,  // 129: pb.clientrpc.v1.Event.NewDmItem.item:type_name -> pb.clientrpc.v1.DownloadManagerItem
+	27,  // 130: pb.clientrpc.v1.Event.UploadUpdate.upload:type_name -> pb.clientrpc.v1.UploadInfo
+	26,  // 131: pb.clientrpc.v1.Event.DownloadsRecovered.downloads:type_name -> pb.clientrpc.v1.RecoveredDownload
+	0,   // 132: pb.clientrpc.v1.DownloadManagerItem.Download.status:type_name -> pb.clientrpc.v1.DownloadStatus
+	1,   // 133: pb.clientrpc.v1.DownloadManagerItem.Download.scan_status:type_name -> pb.clientrpc.v1.ScanStatus
+	8,   // 134: pb.clientrpc.v1.ServerInfo.State.conn_state:type_name -> pb.clientrpc.v1.ServerConnState
+	33,  // 135: pb.clientrpc.v1.ServerInfo.State.rtt:type_name -> pb.clientrpc.v1.RttStats
+	35,  // 136: pb.clientrpc.v1.ServerInfo.State.remote:type_name -> pb.clientrpc.v1.RemoteServerInfo
+	21,  // 137: pb.clientrpc.v1.PluginEvent.ClientEvent.event:type_name -> pb.clientrpc.v1.Event
+	22,  // 138: pb.clientrpc.v1.PluginEvent.ClientEvent.context:type_name -> pb.clientrpc.v1.EventContext
+	47,  // 139: pb.clientrpc.v1.ClientRpcService.StreamLogs:input_type -> pb.clientrpc.v1.StreamLogsRequest
+	45,  // 140: pb.clientrpc.v1.ClientRpcService.StreamEvents:input_type -> pb.clientrpc.v1.StreamEventsRequest
+	49,  // 141: pb.clientrpc.v1.ClientRpcService.Stop:input_type -> pb.clientrpc.v1.StopRequest
+	51,  // 142: pb.clientrpc.v1.ClientRpcService.GetClientInfo:input_type -> pb.clientrpc.v1.GetClientInfoRequest
+	53,  // 143: pb.clientrpc.v1.ClientRpcService.GetMemoryUsage:input_type -> pb.clientrpc.v1.GetMemoryUsageRequest
+	55,  // 144: pb.clientrpc.v1.ClientRpcService.SetLowMemoryMode:input_type -> pb.clientrpc.v1.SetLowMemoryModeRequest
+	57,  // 145: pb.clientrpc.v1.ClientRpcService.GetServers:input_type -> pb.clientrpc.v1.GetServersRequest
+	59,  // 146: pb.clientrpc.v1.ClientRpcService.CreateServer:input_type -> pb.clientrpc.v1.CreateServerRequest
+	61,  // 147: pb.clientrpc.v1.ClientRpcService.ImportInviteBundle:input_type -> pb.clientrpc.v1.ImportInviteBundleRequest
+	65,  // 148: pb.clientrpc.v1.ClientRpcService.CreateAccountTemplate:input_type -> pb.clientrpc.v1.CreateAccountTemplateRequest
+	67,  // 149: pb.clientrpc.v1.ClientRpcService.CreateAccountTemplateFromServer:input_type -> pb.clientrpc.v1.CreateAccountTemplateFromServerRequest
+	69,  // 150: pb.clientrpc.v1.ClientRpcService.GetAccountTemplates:input_type -> pb.clientrpc.v1.GetAccountTemplatesRequest
+	71,  // 151: pb.clientrpc.v1.ClientRpcService.DeleteAccountTemplate:input_type -> pb.clientrpc.v1.DeleteAccountTemplateRequest
+	74,  // 152: pb.clientrpc.v1.ClientRpcService.StartHosting:input_type -> pb.clientrpc.v1.StartHostingRequest
+	76,  // 153: pb.clientrpc.v1.ClientRpcService.StopHosting:input_type -> pb.clientrpc.v1.StopHostingRequest
+	78,  // 154: pb.clientrpc.v1.ClientRpcService.GetHostingInfo:input_type -> pb.clientrpc.v1.GetHostingInfoRequest
+	80,  // 155: pb.clientrpc.v1.ClientRpcService.CreateHostingInvite:input_type -> pb.clientrpc.v1.CreateHostingInviteRequest
+	82,  // 156: pb.clientrpc.v1.ClientRpcService.DeleteServer:input_type -> pb.clientrpc.v1.DeleteServerRequest
+	84,  // 157: pb.clientrpc.v1.ClientRpcService.ConnectServer:input_type -> pb.clientrpc.v1.ConnectServerRequest
+	86,  // 158: pb.clientrpc.v1.ClientRpcService.DisconnectServer:input_type -> pb.clientrpc.v1.DisconnectServerRequest
+	88,  // 159: pb.clientrpc.v1.ClientRpcService.UpdateServer:input_type -> pb.clientrpc.v1.UpdateServerRequest
+	90,  // 160: pb.clientrpc.v1.ClientRpcService.GetShares:input_type -> pb.clientrpc.v1.GetSharesRequest
+	92,  // 161: pb.clientrpc.v1.ClientRpcService.CreateShare:input_type -> pb.clientrpc.v1.CreateShareRequest
+	94,  // 162: pb.clientrpc.v1.ClientRpcService.DeleteShare:input_type -> pb.clientrpc.v1.DeleteShareRequest
+	96,  // 163: pb.clientrpc.v1.ClientRpcService.SetShareExcludePatterns:input_type -> pb.clientrpc.v1.SetShareExcludePatternsRequest
+	99,  // 164: pb.clientrpc.v1.ClientRpcService.CheckShareHealth:input_type -> pb.clientrpc.v1.CheckShareHealthRequest
+	104, // 165: pb.clientrpc.v1.ClientRpcService.ImportShares:input_type -> pb.clientrpc.v1.ImportSharesRequest
+	106, // 166: pb.clientrpc.v1.ClientRpcService.CreateShareLink:input_type -> pb.clientrpc.v1.CreateShareLinkRequest
+	108, // 167: pb.clientrpc.v1.ClientRpcService.GetShareLinks:input_type -> pb.clientrpc.v1.GetShareLinksRequest
+	110, // 168: pb.clientrpc.v1.ClientRpcService.DeleteShareLink:input_type -> pb.clientrpc.v1.DeleteShareLinkRequest
+	112, // 169: pb.clientrpc.v1.ClientRpcService.GetDirFiles:input_type -> pb.clientrpc.v1.GetDirFilesRequest
+	114, // 170: pb.clientrpc.v1.ClientRpcService.StreamDirArchive:input_type -> pb.clientrpc.v1.StreamDirArchiveRequest
+	116, // 171: pb.clientrpc.v1.ClientRpcService.GetFileMeta:input_type -> pb.clientrpc.v1.GetFileMetaRequest
+	118, // 172: pb.clientrpc.v1.ClientRpcService.CreateFileLink:input_type -> pb.clientrpc.v1.CreateFileLinkRequest
+	123, // 173: pb.clientrpc.v1.ClientRpcService.MeasurePeer:input_type -> pb.clientrpc.v1.MeasurePeerRequest
+	121, // 174: pb.clientrpc.v1.ClientRpcService.Diagnose:input_type -> pb.clientrpc.v1.DiagnoseRequest
+	125, // 175: pb.clientrpc.v1.ClientRpcService.GetOnlineUsers:input_type -> pb.clientrpc.v1.GetOnlineUsersRequest
+	127, // 176: pb.clientrpc.v1.ClientRpcService.ChangeAccountPassword:input_type -> pb.clientrpc.v1.ChangeAccountPasswordRequest
+	129, // 177: pb.clientrpc.v1.ClientRpcService.ServerConnect:input_type -> pb.clientrpc.v1.ServerConnectRequest
+	131, // 178: pb.clientrpc.v1.ClientRpcService.ServerDisconnect:input_type -> pb.clientrpc.v1.ServerDisconnectRequest
+	133, // 179: pb.clientrpc.v1.ClientRpcService.GetDirectSettings:input_type -> pb.clientrpc.v1.GetDirectSettingsRequest
+	135, // 180: pb.clientrpc.v1.ClientRpcService.UpdateDirectSettings:input_type -> pb.clientrpc.v1.UpdateDirectSettingsRequest
+	137, // 181: pb.clientrpc.v1.ClientRpcService.GetTransferSettings:input_type -> pb.clientrpc.v1.GetTransferSettingsRequest
+	139, // 182: pb.clientrpc.v1.ClientRpcService.UpdateTransferSettings:input_type -> pb.clientrpc.v1.UpdateTransferSettingsRequest
+	141, // 183: pb.clientrpc.v1.ClientRpcService.GetNotificationSettings:input_type -> pb.clientrpc.v1.GetNotificationSettingsRequest
+	143, // 184: pb.clientrpc.v1.ClientRpcService.UpdateNotificationSettings:input_type -> pb.clientrpc.v1.UpdateNotificationSettingsRequest
+	145, // 185: pb.clientrpc.v1.ClientRpcService.ExportConfig:input_type -> pb.clientrpc.v1.ExportConfigRequest
+	147, // 186: pb.clientrpc.v1.ClientRpcService.ImportConfig:input_type -> pb.clientrpc.v1.ImportConfigRequest
+	149, // 187: pb.clientrpc.v1.ClientRpcService.BackupDatabase:input_type -> pb.clientrpc.v1.BackupDatabaseRequest
+	151, // 188: pb.clientrpc.v1.ClientRpcService.CheckDatabaseIntegrity:input_type -> pb.clientrpc.v1.CheckDatabaseIntegrityRequest
+	153, // 189: pb.clientrpc.v1.ClientRpcService.IndexShare:input_type -> pb.clientrpc.v1.IndexShareRequest
+	155, // 190: pb.clientrpc.v1.ClientRpcService.StreamSearch:input_type -> pb.clientrpc.v1.StreamSearchRequest
+	157, // 191: pb.clientrpc.v1.ClientRpcService.GetUpdateInfo:input_type -> pb.clientrpc.v1.GetUpdateInfoRequest
+	159, // 192: pb.clientrpc.v1.ClientRpcService.CheckForNewUpdate:input_type -> pb.clientrpc.v1.CheckForNewUpdateRequest
+	161, // 193: pb.clientrpc.v1.ClientRpcService.ApplyUpdate:input_type -> pb.clientrpc.v1.ApplyUpdateRequest
+	163, // 194: pb.clientrpc.v1.ClientRpcService.GetUpdateSettings:input_type -> pb.clientrpc.v1.GetUpdateSettingsRequest
+	165, // 195: pb.clientrpc.v1.ClientRpcService.UpdateUpdateSettings:input_type -> pb.clientrpc.v1.UpdateUpdateSettingsRequest
+	167, // 196: pb.clientrpc.v1.ClientRpcService.GetDownloadManagerItems:input_type -> pb.clientrpc.v1.GetDownloadManagerItemsRequest
+	169, // 197: pb.clientrpc.v1.ClientRpcService.QueueFileDownload:input_type -> pb.clientrpc.v1.QueueFileDownloadRequest
+	172, // 198: pb.clientrpc.v1.ClientRpcService.CancelFileDownload:input_type -> pb.clientrpc.v1.CancelFileDownloadRequest
+	174, // 199: pb.clientrpc.v1.ClientRpcService.RemoveDownloadManagerItem:input_type -> pb.clientrpc.v1.RemoveDownloadManagerItemRequest
+	176, // 200: pb.clientrpc.v1.ClientRpcService.PauseFileDownload:input_type -> pb.clientrpc.v1.PauseFileDownloadRequest
+	178, // 201: pb.clientrpc.v1.ClientRpcService.ResumeFileDownload:input_type -> pb.clientrpc.v1.ResumeFileDownloadRequest
+	180, // 202: pb.clientrpc.v1.ClientRpcService.GetDownloadHooks:input_type -> pb.clientrpc.v1.GetDownloadHooksRequest
+	182, // 203: pb.clientrpc.v1.ClientRpcService.CreateDownloadHook:input_type -> pb.clientrpc.v1.CreateDownloadHookRequest
+	184, // 204: pb.clientrpc.v1.ClientRpcService.DeleteDownloadHook:input_type -> pb.clientrpc.v1.DeleteDownloadHookRequest
+	186, // 205: pb.clientrpc.v1.ClientRpcService.GetUploads:input_type -> pb.clientrpc.v1.GetUploadsRequest
+	188, // 206: pb.clientrpc.v1.ClientRpcService.ClearUploadHistory:input_type -> pb.clientrpc.v1.ClearUploadHistoryRequest
+	190, // 207: pb.clientrpc.v1.ClientRpcService.GetFriends:input_type -> pb.clientrpc.v1.GetFriendsRequest
+	192, // 208: pb.clientrpc.v1.ClientRpcService.SetFriend:input_type -> pb.clientrpc.v1.SetFriendRequest
+	194, // 209: pb.clientrpc.v1.ClientRpcService.DeleteFriend:input_type -> pb.clientrpc.v1.DeleteFriendRequest
+	197, // 210: pb.clientrpc.v1.ClientRpcService.GetBlockedPeers:input_type -> pb.clientrpc.v1.GetBlockedPeersRequest
+	199, // 211: pb.clientrpc.v1.ClientRpcService.BlockPeer:input_type -> pb.clientrpc.v1.BlockPeerRequest
+	201, // 212: pb.clientrpc.v1.ClientRpcService.UnblockPeer:input_type -> pb.clientrpc.v1.UnblockPeerRequest
+	203, // 213: pb.clientrpc.v1.ClientRpcService.GetInterests:input_type -> pb.clientrpc.v1.GetInterestsRequest
+	205, // 214: pb.clientrpc.v1.ClientRpcService.SetInterests:input_type -> pb.clientrpc.v1.SetInterestsRequest
+	208, // 215: pb.clientrpc.v1.ClientRpcService.GetSimilarUsers:input_type -> pb.clientrpc.v1.GetSimilarUsersRequest
+	211, // 216: pb.clientrpc.v1.ClientRpcService.GetServerSchedule:input_type -> pb.clientrpc.v1.GetServerScheduleRequest
+	213, // 217: pb.clientrpc.v1.ClientRpcService.SetServerSchedule:input_type -> pb.clientrpc.v1.SetServerScheduleRequest
+	216, // 218: pb.clientrpc.v1.ClientRpcService.GetSnooze:input_type -> pb.clientrpc.v1.GetSnoozeRequest
+	218, // 219: pb.clientrpc.v1.ClientRpcService.Snooze:input_type -> pb.clientrpc.v1.SnoozeRequest
+	220, // 220: pb.clientrpc.v1.ClientRpcService.Unsnooze:input_type -> pb.clientrpc.v1.UnsnoozeRequest
+	224, // 221: pb.clientrpc.v1.ClientRpcService.GetRunHistory:input_type -> pb.clientrpc.v1.GetRunHistoryRequest
+	226, // 222: pb.clientrpc.v1.ClientRpcService.GetConnHistory:input_type -> pb.clientrpc.v1.GetConnHistoryRequest
+	230, // 223: pb.clientrpc.v1.ClientRpcService.GetTrash:input_type -> pb.clientrpc.v1.GetTrashRequest
+	232, // 224: pb.clientrpc.v1.ClientRpcService.RestoreServer:input_type -> pb.clientrpc.v1.RestoreServerRequest
+	234, // 225: pb.clientrpc.v1.ClientRpcService.PurgeServer:input_type -> pb.clientrpc.v1.PurgeServerRequest
+	236, // 226: pb.clientrpc.v1.ClientRpcService.RestoreShare:input_type -> pb.clientrpc.v1.RestoreShareRequest
+	238, // 227: pb.clientrpc.v1.ClientRpcService.PurgeShare:input_type -> pb.clientrpc.v1.PurgeShareRequest
+	243, // 228: pb.clientrpc.v1.ClientRpcService.GetPlugins:input_type -> pb.clientrpc.v1.GetPluginsRequest
+	245, // 229: pb.clientrpc.v1.ClientRpcService.CreatePlugin:input_type -> pb.clientrpc.v1.CreatePluginRequest
+	247, // 230: pb.clientrpc.v1.ClientRpcService.DeletePlugin:input_type -> pb.clientrpc.v1.DeletePluginRequest
+	249, // 231: pb.clientrpc.v1.ClientRpcService.StreamPluginEvents:input_type -> pb.clientrpc.v1.StreamPluginEventsRequest
+	251, // 232: pb.clientrpc.v1.ClientRpcService.RespondToSearch:input_type -> pb.clientrpc.v1.RespondToSearchRequest
+	48,  // 233: pb.clientrpc.v1.ClientRpcService.StreamLogs:output_type -> pb.clientrpc.v1.StreamLogsResponse
+	46,  // 234: pb.clientrpc.v1.ClientRpcService.StreamEvents:output_type -> pb.clientrpc.v1.StreamEventsResponse
+	50,  // 235: pb.clientrpc.v1.ClientRpcService.Stop:output_type -> pb.clientrpc.v1.StopResponse
+	52,  // 236: pb.clientrpc.v1.ClientRpcService.GetClientInfo:output_type -> pb.clientrpc.v1.GetClientInfoResponse
+	54,  // 237: pb.clientrpc.v1.ClientRpcService.GetMemoryUsage:output_type -> pb.clientrpc.v1.GetMemoryUsageResponse
+	56,  // 238: pb.clientrpc.v1.ClientRpcService.SetLowMemoryMode:output_type -> pb.clientrpc.v1.SetLowMemoryModeResponse
+	58,  // 239: pb.clientrpc.v1.ClientRpcService.GetServers:output_type -> pb.clientrpc.v1.GetServersResponse
+	60,  // 240: pb.clientrpc.v1.ClientRpcService.CreateServer:output_type -> pb.clientrpc.v1.CreateServerResponse
+	62,  // 241: pb.clientrpc.v1.ClientRpcService.ImportInviteBundle:output_type -> pb.clientrpc.v1.ImportInviteBundleResponse
+	66,  // 242: pb.clientrpc.v1.ClientRpcService.CreateAccountTemplate:output_type -> pb.clientrpc.v1.CreateAccountTemplateResponse
+	68,  // 243: pb.clientrpc.v1.ClientRpcService.CreateAccountTemplateFromServer:output_type -> pb.clientrpc.v1.CreateAccountTemplateFromServerResponse
+	70,  // 244: pb.clientrpc.v1.ClientRpcService.GetAccountTemplates:output_type -> pb.clientrpc.v1.GetAccountTemplatesResponse
+	72,  // 245: pb.clientrpc.v1.ClientRpcService.DeleteAccountTemplate:output_type -> pb.clientrpc.v1.DeleteAccountTemplateResponse
+	75,  // 246: pb.clientrpc.v1.ClientRpcService.StartHosting:output_type -> pb.clientrpc.v1.StartHostingResponse
+	77,  // 247: pb.clientrpc.v1.ClientRpcService.StopHosting:output_type -> pb.clientrpc.v1.StopHostingResponse
+	79,  // 248: pb.clientrpc.v1.ClientRpcService.GetHostingInfo:output_type -> pb.clientrpc.v1.GetHostingInfoResponse
+	81,  // 249: pb.clientrpc.v1.ClientRpcService.CreateHostingInvite:output_type -> pb.clientrpc.v1.CreateHostingInviteResponse
+	83,  // 250: pb.clientrpc.v1.ClientRpcService.DeleteServer:output_type -> pb.clientrpc.v1.DeleteServerResponse
+	85,  // 251: pb.clientrpc.v1.ClientRpcService.ConnectServer:output_type -> pb.clientrpc.v1.ConnectServerResponse
+	87,  // 252: pb.clientrpc.v1.ClientRpcService.DisconnectServer:output_type -> pb.clientrpc.v1.DisconnectServerResponse
+	89,  // 253: pb.clientrpc.v1.ClientRpcService.UpdateServer:output_type -> pb.clientrpc.v1.UpdateServerResponse
+	91,  // 254: pb.clientrpc.v1.ClientRpcService.GetShares:output_type -> pb.clientrpc.v1.GetSharesResponse
+	93,  // 255: pb.clientrpc.v1.ClientRpcService.CreateShare:output_type -> pb.clientrpc.v1.CreateShareResponse
+	95,  // 256: pb.clientrpc.v1.ClientRpcService.DeleteShare:output_type -> pb.clientrpc.v1.DeleteShareResponse
+	97,  // 257: pb.clientrpc.v1.ClientRpcService.SetShareExcludePatterns:output_type -> pb.clientrpc.v1.SetShareExcludePatternsResponse
+	100, // 258: pb.clientrpc.v1.ClientRpcService.CheckShareHealth:output_type -> pb.clientrpc.v1.CheckShareHealthResponse
+	105, // 259: pb.clientrpc.v1.ClientRpcService.ImportShares:output_type -> pb.clientrpc.v1.ImportSharesResponse
+	107, // 260: pb.clientrpc.v1.ClientRpcService.CreateShareLink:output_type -> pb.clientrpc.v1.CreateShareLinkResponse
+	109, // 261: pb.clientrpc.v1.ClientRpcService.GetShareLinks:output_type -> pb.clientrpc.v1.GetShareLinksResponse
+	111, // 262: pb.clientrpc.v1.ClientRpcService.DeleteShareLink:output_type -> pb.clientrpc.v1.DeleteShareLinkResponse
+	113, // 263: pb.clientrpc.v1.ClientRpcService.GetDirFiles:output_type -> pb.clientrpc.v1.GetDirFilesResponse
+	115, // 264: pb.clientrpc.v1.ClientRpcService.StreamDirArchive:output_type -> pb.clientrpc.v1.StreamDirArchiveResponse
+	117, // 265: pb.clientrpc.v1.ClientRpcService.GetFileMeta:output_type -> pb.clientrpc.v1.GetFileMetaResponse
+	119, // 266: pb.clientrpc.v1.ClientRpcService.CreateFileLink:output_type -> pb.clientrpc.v1.CreateFileLinkResponse
+	124, // 267: pb.clientrpc.v1.ClientRpcService.MeasurePeer:output_type -> pb.clientrpc.v1.MeasurePeerResponse
+	122, // 268: pb.clientrpc.v1.ClientRpcService.Diagnose:output_type -> pb.clientrpc.v1.DiagnoseResponse
+	126, // 269: pb.clientrpc.v1.ClientRpcService.GetOnlineUsers:output_type -> pb.clientrpc.v1.GetOnlineUsersResponse
+	128, // 270: pb.clientrpc.v1.ClientRpcService.ChangeAccountPassword:output_type -> pb.clientrpc.v1.ChangeAccountPasswordResponse
+	130, // 271: pb.clientrpc.v1.ClientRpcService.ServerConnect:output_type -> pb.clientrpc.v1.ServerConnectResponse
+	132, // 272: pb.clientrpc.v1.ClientRpcService.ServerDisconnect:output_type -> pb.clientrpc.v1.ServerDisconnectResponse
+	134, // 273: pb.clientrpc.v1.ClientRpcService.GetDirectSettings:output_type -> pb.clientrpc.v1.GetDirectSettingsResponse
+	136, // 274: pb.clientrpc.v1.ClientRpcService.UpdateDirectSettings:output_type -> pb.clientrpc.v1.UpdateDirectSettingsResponse
+	138, // 275: pb.clientrpc.v1.ClientRpcService.GetTransferSettings:output_type -> pb.clientrpc.v1.GetTransferSettingsResponse
+	140, // 276: pb.clientrpc.v1.ClientRpcService.UpdateTransferSettings:output_type -> pb.clientrpc.v1.UpdateTransferSettingsResponse
+	142, // 277: pb.clientrpc.v1.ClientRpcService.GetNotificationSettings:output_type -> pb.clientrpc.v1.GetNotificationSettingsResponse
+	144, // 278: pb.clientrpc.v1.ClientRpcService.UpdateNotificationSettings:output_type -> pb.clientrpc.v1.UpdateNotificationSettingsResponse
+	146, // 279: pb.clientrpc.v1.ClientRpcService.ExportConfig:output_type -> pb.clientrpc.v1.ExportConfigResponse
+	148, // 280: pb.clientrpc.v1.ClientRpcService.ImportConfig:output_type -> pb.clientrpc.v1.ImportConfigResponse
+	150, // 281: pb.clientrpc.v1.ClientRpcService.BackupDatabase:output_type -> pb.clientrpc.v1.BackupDatabaseResponse
+	152, // 282: pb.clientrpc.v1.ClientRpcService.CheckDatabaseIntegrity:output_type -> pb.clientrpc.v1.CheckDatabaseIntegrityResponse
+	154, // 283: pb.clientrpc.v1.ClientRpcService.IndexShare:output_type -> pb.clientrpc.v1.IndexShareResponse
+	156, // 284: pb.clientrpc.v1.ClientRpcService.StreamSearch:output_type -> pb.clientrpc.v1.StreamSearchResponse
+	158, // 285: pb.clientrpc.v1.ClientRpcService.GetUpdateInfo:output_type -> pb.clientrpc.v1.GetUpdateInfoResponse
+	160, // 286: pb.clientrpc.v1.ClientRpcService.CheckForNewUpdate:output_type -> pb.clientrpc.v1.CheckForNewUpdateResponse
+	162, // 287: pb.clientrpc.v1.ClientRpcService.ApplyUpdate:output_type -> pb.clientrpc.v1.ApplyUpdateResponse
+	164, // 288: pb.clientrpc.v1.ClientRpcService.GetUpdateSettings:output_type -> pb.clientrpc.v1.GetUpdateSettingsResponse
+	166, // 289: pb.clientrpc.v1.ClientRpcService.UpdateUpdateSettings:output_type -> pb.clientrpc.v1.UpdateUpdateSettingsResponse
+	168, // 290: pb.clientrpc.v1.ClientRpcService.GetDownloadManagerItems:output_type -> pb.clientrpc.v1.GetDownloadManagerItemsResponse
+	170, // 291: pb.clientrpc.v1.ClientRpcService.QueueFileDownload:output_type -> pb.clientrpc.v1.QueueFileDownloadResponse
+	173, // 292: pb.clientrpc.v1.ClientRpcService.CancelFileDownload:output_type -> pb.clientrpc.v1.CancelFileDownloadResponse
+	175, // 293: pb.clientrpc.v1.ClientRpcService.RemoveDownloadManagerItem:output_type -> pb.clientrpc.v1.RemoveDownloadManagerItemResponse
+	177, // 294: pb.clientrpc.v1.ClientRpcService.PauseFileDownload:output_type -> pb.clientrpc.v1.PauseFileDownloadResponse
+	179, // 295: pb.clientrpc.v1.ClientRpcService.ResumeFileDownload:output_type -> pb.clientrpc.v1.ResumeFileDownloadResponse
+	181, // 296: pb.clientrpc.v1.ClientRpcService.GetDownloadHooks:output_type -> pb.clientrpc.v1.GetDownloadHooksResponse
+	183, // 297: pb.clientrpc.v1.ClientRpcService.CreateDownloadHook:output_type -> pb.clientrpc.v1.CreateDownloadHookResponse
+	185, // 298: pb.clientrpc.v1.ClientRpcService.DeleteDownloadHook:output_type -> pb.clientrpc.v1.DeleteDownloadHookResponse
+	187, // 299: pb.clientrpc.v1.ClientRpcService.GetUploads:output_type -> pb.clientrpc.v1.GetUploadsResponse
+	189, // 300: pb.clientrpc.v1.ClientRpcService.ClearUploadHistory:output_type -> pb.clientrpc.v1.ClearUploadHistoryResponse
+	191, // 301: pb.clientrpc.v1.ClientRpcService.GetFriends:output_type -> pb.clientrpc.v1.GetFriendsResponse
+	193, // 302: pb.clientrpc.v1.ClientRpcService.SetFriend:output_type -> pb.clientrpc.v1.SetFriendResponse
+	195, // 303: pb.clientrpc.v1.ClientRpcService.DeleteFriend:output_type -> pb.clientrpc.v1.DeleteFriendResponse
+	198, // 304: pb.clientrpc.v1.ClientRpcService.GetBlockedPeers:output_type -> pb.clientrpc.v1.GetBlockedPeersResponse
+	200, // 305: pb.clientrpc.v1.ClientRpcService.BlockPeer:output_type -> pb.clientrpc.v1.BlockPeerResponse
+	202, // 306: pb.clientrpc.v1.ClientRpcService.UnblockPeer:output_type -> pb.clientrpc.v1.UnblockPeerResponse
+	204, // 307: pb.clientrpc.v1.ClientRpcService.GetInterests:output_type -> pb.clientrpc.v1.GetInterestsResponse
+	206, // 308: pb.clientrpc.v1.ClientRpcService.SetInterests:output_type -> pb.clientrpc.v1.SetInterestsResponse
+	209, // 309: pb.clientrpc.v1.ClientRpcService.GetSimilarUsers:output_type -> pb.clientrpc.v1.GetSimilarUsersResponse
+	212, // 310: pb.clientrpc.v1.ClientRpcService.GetServerSchedule:output_type -> pb.clientrpc.v1.GetServerScheduleResponse
+	214, // 311: pb.clientrpc.v1.ClientRpcService.SetServerSchedule:output_type -> pb.clientrpc.v1.SetServerScheduleResponse
+	217, // 312: pb.clientrpc.v1.ClientRpcService.GetSnooze:output_type -> pb.clientrpc.v1.GetSnoozeResponse
+	219, // 313: pb.clientrpc.v1.ClientRpcService.Snooze:output_type -> pb.clientrpc.v1.SnoozeResponse
+	221, // 314: pb.clientrpc.v1.ClientRpcService.Unsnooze:output_type -> pb.clientrpc.v1.UnsnoozeResponse
+	225, // 315: pb.clientrpc.v1.ClientRpcService.GetRunHistory:output_type -> pb.clientrpc.v1.GetRunHistoryResponse
+	227, // 316: pb.clientrpc.v1.ClientRpcService.GetConnHistory:output_type -> pb.clientrpc.v1.GetConnHistoryResponse
+	231, // 317: pb.clientrpc.v1.ClientRpcService.GetTrash:output_type -> pb.clientrpc.v1.GetTrashResponse
+	233, // 318: pb.clientrpc.v1.ClientRpcService.RestoreServer:output_type -> pb.clientrpc.v1.RestoreServerResponse
+	235, // 319: pb.clientrpc.v1.ClientRpcService.PurgeServer:output_type -> pb.clientrpc.v1.PurgeServerResponse
+	237, // 320: pb.clientrpc.v1.ClientRpcService.RestoreShare:output_type -> pb.clientrpc.v1.RestoreShareResponse
+	239, // 321: pb.clientrpc.v1.ClientRpcService.PurgeShare:output_type -> pb.clientrpc.v1.PurgeShareResponse
+	244, // 322: pb.clientrpc.v1.ClientRpcService.GetPlugins:output_type -> pb.clientrpc.v1.GetPluginsResponse
+	246, // 323: pb.clientrpc.v1.ClientRpcService.CreatePlugin:output_type -> pb.clientrpc.v1.CreatePluginResponse
+	248, // 324: pb.clientrpc.v1.ClientRpcService.DeletePlugin:output_type -> pb.clientrpc.v1.DeletePluginResponse
+	250, // 325: pb.clientrpc.v1.ClientRpcService.StreamPluginEvents:output_type -> pb.clientrpc.v1.StreamPluginEventsResponse
+	252, // 326: pb.clientrpc.v1.ClientRpcService.RespondToSearch:output_type -> pb.clientrpc.v1.RespondToSearchResponse
+	233, // [233:327] is the sub-list for method output_type
+	139, // [139:233] is the sub-list for method input_type
+	139, // [139:139] is the sub-list for extension type_name
+	139, // [139:139] is the sub-list for extension extendee
+	0,   // [0:139] is the sub-list for field type_name
 }
 
 func init() { file_pb_clientrpc_v1_rpc_proto_init() }
@@ -5241,20 +17273,47 @@ func file_pb_clientrpc_v1_rpc_proto_init() {
 	}
 	file_pb_clientrpc_v1_rpc_proto_msgTypes[0].OneofWrappers = []any{}
 	file_pb_clientrpc_v1_rpc_proto_msgTypes[4].OneofWrappers = []any{}
-	file_pb_clientrpc_v1_rpc_proto_msgTypes[5].OneofWrappers = []any{}
-	file_pb_clientrpc_v1_rpc_proto_msgTypes[15].OneofWrappers = []any{}
-	file_pb_clientrpc_v1_rpc_proto_msgTypes[31].OneofWrappers = []any{}
-	file_pb_clientrpc_v1_rpc_proto_msgTypes[61].OneofWrappers = []any{}
-	file_pb_clientrpc_v1_rpc_proto_msgTypes[64].OneofWrappers = []any{}
-	file_pb_clientrpc_v1_rpc_proto_msgTypes[66].OneofWrappers = []any{}
-	file_pb_clientrpc_v1_rpc_proto_msgTypes[84].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[6].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[7].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[8].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[11].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[12].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[17].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[18].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[20].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[26].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[67].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[77].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[78].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[82].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[83].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[85].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[97].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[99].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[102].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[134].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[135].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[137].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[139].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[149].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[161].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[187].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[194].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[197].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[201].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[202].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[220].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[233].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[234].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[249].OneofWrappers = []any{}
+	file_pb_clientrpc_v1_rpc_proto_msgTypes[250].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pb_clientrpc_v1_rpc_proto_rawDesc), len(file_pb_clientrpc_v1_rpc_proto_rawDesc)),
-			NumEnums:      4,
-			NumMessages:   86,
+			NumEnums:      21,
+			NumMessages:   254,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -28,8 +28,23 @@ type RoomInfo struct {
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	// The number of online users in the room.
 	OnlineUserCount uint32 `protobuf:"varint,2,opt,name=online_user_count,json=onlineUserCount,proto3" json:"online_user_count,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	// The maximum number of online users allowed in the room, or 0 if unlimited.
+	MaxClients uint32 `protobuf:"varint,3,opt,name=max_clients,json=maxClients,proto3" json:"max_clients,omitempty"`
+	// The maximum number of concurrent proxied streams each client in the room can open, or 0 if unlimited.
+	MaxProxyStreamsPerClient uint32 `protobuf:"varint,4,opt,name=max_proxy_streams_per_client,json=maxProxyStreamsPerClient,proto3" json:"max_proxy_streams_per_client,omitempty"`
+	// How long directory listings proxied in the room are cached, in milliseconds, or 0 if caching is disabled.
+	DirCacheTtlMs uint32 `protobuf:"varint,5,opt,name=dir_cache_ttl_ms,json=dirCacheTtlMs,proto3" json:"dir_cache_ttl_ms,omitempty"`
+	// When the room was created, as a UNIX timestamp in seconds.
+	CreatedTs int64 `protobuf:"varint,6,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
+	// A description of the room, shown to people looking for rooms to join.
+	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	// Whether the room is included in GetRooms results by default.
+	Listed bool `protobuf:"varint,8,opt,name=listed,proto3" json:"listed,omitempty"`
+	// The message of the day sent to clients when they join the room.
+	// Empty if the room has none.
+	Motd          string `protobuf:"bytes,9,opt,name=motd,proto3" json:"motd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *RoomInfo) Reset() {
@@ -76,13 +91,68 @@ func (x *RoomInfo) GetOnlineUserCount() uint32 {
 	return 0
 }
 
+func (x *RoomInfo) GetMaxClients() uint32 {
+	if x != nil {
+		return x.MaxClients
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetMaxProxyStreamsPerClient() uint32 {
+	if x != nil {
+		return x.MaxProxyStreamsPerClient
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetDirCacheTtlMs() uint32 {
+	if x != nil {
+		return x.DirCacheTtlMs
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+func (x *RoomInfo) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *RoomInfo) GetListed() bool {
+	if x != nil {
+		return x.Listed
+	}
+	return false
+}
+
+func (x *RoomInfo) GetMotd() string {
+	if x != nil {
+		return x.Motd
+	}
+	return ""
+}
+
 // OnlineUserInfo is information about an online user.
 type OnlineUserInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The user's username.
-	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Round-trip time statistics for pings sent to the user.
+	Rtt *RttStats `protobuf:"bytes,2,opt,name=rtt,proto3" json:"rtt,omitempty"`
+	// The total number of bytes relayed through the server for proxied streams the user opened.
+	RelayedBytes int64 `protobuf:"varint,3,opt,name=relayed_bytes,json=relayedBytes,proto3" json:"relayed_bytes,omitempty"`
+	// The recent rate of bytes relayed through the server for proxied streams the user opened, in bytes per second.
+	RelayBytesPerSecond int64 `protobuf:"varint,4,opt,name=relay_bytes_per_second,json=relayBytesPerSecond,proto3" json:"relay_bytes_per_second,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
 }
 
 func (x *OnlineUserInfo) Reset() {
@@ -122,29 +192,67 @@ func (x *OnlineUserInfo) GetUsername() string {
 	return ""
 }
 
-// AccountInfo is information about an account.
-type AccountInfo struct {
+func (x *OnlineUserInfo) GetRtt() *RttStats {
+	if x != nil {
+		return x.Rtt
+	}
+	return nil
+}
+
+func (x *OnlineUserInfo) GetRelayedBytes() int64 {
+	if x != nil {
+		return x.RelayedBytes
+	}
+	return 0
+}
+
+func (x *OnlineUserInfo) GetRelayBytesPerSecond() int64 {
+	if x != nil {
+		return x.RelayBytesPerSecond
+	}
+	return 0
+}
+
+// RttStats is round-trip time statistics for pings sent over a connection.
+type RttStats struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The account's username.
-	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The most recent round-trip time, in microseconds.
+	// 0 if no ping has succeeded yet.
+	LastUs int64 `protobuf:"varint,1,opt,name=last_us,json=lastUs,proto3" json:"last_us,omitempty"`
+	// The minimum round-trip time over recent pings, in microseconds.
+	MinUs int64 `protobuf:"varint,2,opt,name=min_us,json=minUs,proto3" json:"min_us,omitempty"`
+	// The average round-trip time over recent pings, in microseconds.
+	AvgUs int64 `protobuf:"varint,3,opt,name=avg_us,json=avgUs,proto3" json:"avg_us,omitempty"`
+	// The maximum round-trip time over recent pings, in microseconds.
+	MaxUs int64 `protobuf:"varint,4,opt,name=max_us,json=maxUs,proto3" json:"max_us,omitempty"`
+	// The number of recent pings the minimum, average and maximum were computed from.
+	Samples uint32 `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+	// The total number of pings that failed.
+	Lost uint64 `protobuf:"varint,6,opt,name=lost,proto3" json:"lost,omitempty"`
+	// The number of pings that failed in a row since the last successful one.
+	ConsecutiveLost uint32 `protobuf:"varint,7,opt,name=consecutive_lost,json=consecutiveLost,proto3" json:"consecutive_lost,omitempty"`
+	// The estimated offset of the other side's clock from the local clock, in microseconds.
+	// Positive if the other side's clock is ahead.
+	// Only set if the other side reported when it received and answered pings.
+	ClockOffsetUs *int64 `protobuf:"varint,8,opt,name=clock_offset_us,json=clockOffsetUs,proto3,oneof" json:"clock_offset_us,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *AccountInfo) Reset() {
-	*x = AccountInfo{}
+func (x *RttStats) Reset() {
+	*x = RttStats{}
 	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[2]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *AccountInfo) String() string {
+func (x *RttStats) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AccountInfo) ProtoMessage() {}
+func (*RttStats) ProtoMessage() {}
 
-func (x *AccountInfo) ProtoReflect() protoreflect.Message {
+func (x *RttStats) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[2]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -156,38 +264,92 @@ func (x *AccountInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AccountInfo.ProtoReflect.Descriptor instead.
-func (*AccountInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use RttStats.ProtoReflect.Descriptor instead.
+func (*RttStats) Descriptor() ([]byte, []int) {
 	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *AccountInfo) GetUsername() string {
+func (x *RttStats) GetLastUs() int64 {
 	if x != nil {
-		return x.Username
+		return x.LastUs
 	}
-	return ""
+	return 0
 }
 
-type GetServerInfoRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *RttStats) GetMinUs() int64 {
+	if x != nil {
+		return x.MinUs
+	}
+	return 0
+}
+
+func (x *RttStats) GetAvgUs() int64 {
+	if x != nil {
+		return x.AvgUs
+	}
+	return 0
+}
+
+func (x *RttStats) GetMaxUs() int64 {
+	if x != nil {
+		return x.MaxUs
+	}
+	return 0
+}
+
+func (x *RttStats) GetSamples() uint32 {
+	if x != nil {
+		return x.Samples
+	}
+	return 0
+}
+
+func (x *RttStats) GetLost() uint64 {
+	if x != nil {
+		return x.Lost
+	}
+	return 0
+}
+
+func (x *RttStats) GetConsecutiveLost() uint32 {
+	if x != nil {
+		return x.ConsecutiveLost
+	}
+	return 0
+}
+
+func (x *RttStats) GetClockOffsetUs() int64 {
+	if x != nil && x.ClockOffsetUs != nil {
+		return *x.ClockOffsetUs
+	}
+	return 0
+}
+
+// InviteCodeInfo is information about an unused invite code.
+type InviteCodeInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The invite code.
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// The UNIX timestamp, in seconds, when the invite code was created.
+	CreatedTs     int64 `protobuf:"varint,2,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetServerInfoRequest) Reset() {
-	*x = GetServerInfoRequest{}
+func (x *InviteCodeInfo) Reset() {
+	*x = InviteCodeInfo{}
 	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[3]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetServerInfoRequest) String() string {
+func (x *InviteCodeInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServerInfoRequest) ProtoMessage() {}
+func (*InviteCodeInfo) ProtoMessage() {}
 
-func (x *GetServerInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *InviteCodeInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[3]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -199,35 +361,60 @@ func (x *GetServerInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServerInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetServerInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use InviteCodeInfo.ProtoReflect.Descriptor instead.
+func (*InviteCodeInfo) Descriptor() ([]byte, []int) {
 	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{3}
 }
 
-type GetServerInfoResponse struct {
+func (x *InviteCodeInfo) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+func (x *InviteCodeInfo) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+// StreamInfo is information about an open proxied stream between two clients.
+type StreamInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The server's version.
-	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
-	// Information about the RPC interface being accessed.
-	Rpc           *GetServerInfoResponse_Rpc `protobuf:"bytes,2,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	// The stream's ID, unique within its room.
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// The room the stream is in.
+	Room string `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	// The username of the client that opened the stream.
+	OriginUsername string `protobuf:"bytes,3,opt,name=origin_username,json=originUsername,proto3" json:"origin_username,omitempty"`
+	// The username of the client the stream is connected to.
+	TargetUsername string `protobuf:"bytes,4,opt,name=target_username,json=targetUsername,proto3" json:"target_username,omitempty"`
+	// The number of bytes sent from the origin to the target so far.
+	BytesToTarget int64 `protobuf:"varint,5,opt,name=bytes_to_target,json=bytesToTarget,proto3" json:"bytes_to_target,omitempty"`
+	// The number of bytes sent from the target to the origin so far.
+	BytesToOrigin int64 `protobuf:"varint,6,opt,name=bytes_to_origin,json=bytesToOrigin,proto3" json:"bytes_to_origin,omitempty"`
+	// The UNIX timestamp, in seconds, when the stream was opened.
+	CreatedTs     int64 `protobuf:"varint,7,opt,name=created_ts,json=createdTs,proto3" json:"created_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetServerInfoResponse) Reset() {
-	*x = GetServerInfoResponse{}
+func (x *StreamInfo) Reset() {
+	*x = StreamInfo{}
 	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[4]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetServerInfoResponse) String() string {
+func (x *StreamInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetServerInfoResponse) ProtoMessage() {}
+func (*StreamInfo) ProtoMessage() {}
 
-func (x *GetServerInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *StreamInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[4]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -239,45 +426,87 @@ func (x *GetServerInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetServerInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetServerInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use StreamInfo.ProtoReflect.Descriptor instead.
+func (*StreamInfo) Descriptor() ([]byte, []int) {
 	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *GetServerInfoResponse) GetVersion() string {
+func (x *StreamInfo) GetId() string {
 	if x != nil {
-		return x.Version
+		return x.Id
 	}
 	return ""
 }
 
-func (x *GetServerInfoResponse) GetRpc() *GetServerInfoResponse_Rpc {
+func (x *StreamInfo) GetRoom() string {
 	if x != nil {
-		return x.Rpc
+		return x.Room
 	}
-	return nil
+	return ""
 }
 
-type GetRoomsRequest struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+func (x *StreamInfo) GetOriginUsername() string {
+	if x != nil {
+		return x.OriginUsername
+	}
+	return ""
+}
+
+func (x *StreamInfo) GetTargetUsername() string {
+	if x != nil {
+		return x.TargetUsername
+	}
+	return ""
+}
+
+func (x *StreamInfo) GetBytesToTarget() int64 {
+	if x != nil {
+		return x.BytesToTarget
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetBytesToOrigin() int64 {
+	if x != nil {
+		return x.BytesToOrigin
+	}
+	return 0
+}
+
+func (x *StreamInfo) GetCreatedTs() int64 {
+	if x != nil {
+		return x.CreatedTs
+	}
+	return 0
+}
+
+// RoomStat is a snapshot of a room's usage.
+type RoomStat struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The UNIX timestamp, in seconds, when the snapshot was taken.
+	Ts int64 `protobuf:"varint,1,opt,name=ts,proto3" json:"ts,omitempty"`
+	// The number of clients that were online in the room.
+	OnlineClients uint32 `protobuf:"varint,2,opt,name=online_clients,json=onlineClients,proto3" json:"online_clients,omitempty"`
+	// The number of bytes relayed through the server for proxied streams in the room since the previous snapshot.
+	RelayedBytes  int64 `protobuf:"varint,3,opt,name=relayed_bytes,json=relayedBytes,proto3" json:"relayed_bytes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoomsRequest) Reset() {
-	*x = GetRoomsRequest{}
+func (x *RoomStat) Reset() {
+	*x = RoomStat{}
 	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoomsRequest) String() string {
+func (x *RoomStat) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoomsRequest) ProtoMessage() {}
+func (*RoomStat) ProtoMessage() {}
 
-func (x *GetRoomsRequest) ProtoReflect() protoreflect.Message {
+func (x *RoomStat) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -289,33 +518,57 @@ func (x *GetRoomsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoomsRequest.ProtoReflect.Descriptor instead.
-func (*GetRoomsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RoomStat.ProtoReflect.Descriptor instead.
+func (*RoomStat) Descriptor() ([]byte, []int) {
 	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{5}
 }
 
-type GetRoomsResponse struct {
+func (x *RoomStat) GetTs() int64 {
+	if x != nil {
+		return x.Ts
+	}
+	return 0
+}
+
+func (x *RoomStat) GetOnlineClients() uint32 {
+	if x != nil {
+		return x.OnlineClients
+	}
+	return 0
+}
+
+func (x *RoomStat) GetRelayedBytes() int64 {
+	if x != nil {
+		return x.RelayedBytes
+	}
+	return 0
+}
+
+// AccountInfo is information about an account.
+type AccountInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// All the rooms in the server.
-	Rooms         []*RoomInfo `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	// The account's username.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Whether the account is a guest account.
+	IsGuest       bool `protobuf:"varint,2,opt,name=is_guest,json=isGuest,proto3" json:"is_guest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoomsResponse) Reset() {
-	*x = GetRoomsResponse{}
+func (x *AccountInfo) Reset() {
+	*x = AccountInfo{}
 	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoomsResponse) String() string {
+func (x *AccountInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoomsResponse) ProtoMessage() {}
+func (*AccountInfo) ProtoMessage() {}
 
-func (x *GetRoomsResponse) ProtoReflect() protoreflect.Message {
+func (x *AccountInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -327,41 +580,3293 @@ func (x *GetRoomsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoomsResponse.ProtoReflect.Descriptor instead.
-func (*GetRoomsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use AccountInfo.ProtoReflect.Descriptor instead.
+func (*AccountInfo) Descriptor() ([]byte, []int) {
 	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *GetRoomsResponse) GetRooms() []*RoomInfo {
+func (x *AccountInfo) GetUsername() string {
 	if x != nil {
-		return x.Rooms
+		return x.Username
 	}
-	return nil
+	return ""
 }
 
-type GetRoomInfoRequest struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The room's name.
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+func (x *AccountInfo) GetIsGuest() bool {
+	if x != nil {
+		return x.IsGuest
+	}
+	return false
+}
+
+type GetServerInfoRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetRoomInfoRequest) Reset() {
-	*x = GetRoomInfoRequest{}
+func (x *GetServerInfoRequest) Reset() {
+	*x = GetServerInfoRequest{}
 	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[7]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoomInfoRequest) String() string {
+func (x *GetServerInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerInfoRequest) ProtoMessage() {}
+
+func (x *GetServerInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetServerInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{7}
+}
+
+type GetServerInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server's version.
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Information about the RPC interface being accessed.
+	Rpc *GetServerInfoResponse_Rpc `protobuf:"bytes,2,opt,name=rpc,proto3" json:"rpc,omitempty"`
+	// Whether the server is draining.
+	// See Drain.
+	Draining bool `protobuf:"varint,3,opt,name=draining,proto3" json:"draining,omitempty"`
+	// The protocol version the server speaks, such as "1.0.1".
+	ProtocolVersion string `protobuf:"bytes,4,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// The commit the server was built from, or empty if unknown.
+	// Ends with "-dirty" if the build had uncommitted changes.
+	BuildCommit string `protobuf:"bytes,5,opt,name=build_commit,json=buildCommit,proto3" json:"build_commit,omitempty"`
+	// The UNIX timestamp when the server started.
+	StartTs int64 `protobuf:"varint,6,opt,name=start_ts,json=startTs,proto3" json:"start_ts,omitempty"`
+	// How long the server has been running, in seconds.
+	UptimeSeconds int64 `protobuf:"varint,7,opt,name=uptime_seconds,json=uptimeSeconds,proto3" json:"uptime_seconds,omitempty"`
+	// The optional features that are enabled on the server.
+	// Unknown features must be ignored.
+	//
+	// Possible values:
+	//  - "registration": Clients can register their own accounts.
+	//  - "invite_codes": Registering requires an invite code.
+	//  - "auth_rate_limit": Failed authentication attempts are rate-limited.
+	//  - "admin_ui": The admin web UI is served on the RPC interface being accessed.
+	Features      []string `protobuf:"bytes,8,rep,name=features,proto3" json:"features,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetServerInfoResponse) Reset() {
+	*x = GetServerInfoResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetServerInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetServerInfoResponse) ProtoMessage() {}
+
+func (x *GetServerInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetServerInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetServerInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetServerInfoResponse) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *GetServerInfoResponse) GetRpc() *GetServerInfoResponse_Rpc {
+	if x != nil {
+		return x.Rpc
+	}
+	return nil
+}
+
+func (x *GetServerInfoResponse) GetDraining() bool {
+	if x != nil {
+		return x.Draining
+	}
+	return false
+}
+
+func (x *GetServerInfoResponse) GetProtocolVersion() string {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return ""
+}
+
+func (x *GetServerInfoResponse) GetBuildCommit() string {
+	if x != nil {
+		return x.BuildCommit
+	}
+	return ""
+}
+
+func (x *GetServerInfoResponse) GetStartTs() int64 {
+	if x != nil {
+		return x.StartTs
+	}
+	return 0
+}
+
+func (x *GetServerInfoResponse) GetUptimeSeconds() int64 {
+	if x != nil {
+		return x.UptimeSeconds
+	}
+	return 0
+}
+
+func (x *GetServerInfoResponse) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+type GetRoomsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Whether to include unlisted rooms.
+	IncludeUnlisted bool `protobuf:"varint,1,opt,name=include_unlisted,json=includeUnlisted,proto3" json:"include_unlisted,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *GetRoomsRequest) Reset() {
+	*x = GetRoomsRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomsRequest) ProtoMessage() {}
+
+func (x *GetRoomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomsRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *GetRoomsRequest) GetIncludeUnlisted() bool {
+	if x != nil {
+		return x.IncludeUnlisted
+	}
+	return false
+}
+
+type GetRoomsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// All the rooms in the server.
+	Rooms         []*RoomInfo `protobuf:"bytes,1,rep,name=rooms,proto3" json:"rooms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomsResponse) Reset() {
+	*x = GetRoomsResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomsResponse) ProtoMessage() {}
+
+func (x *GetRoomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomsResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *GetRoomsResponse) GetRooms() []*RoomInfo {
+	if x != nil {
+		return x.Rooms
+	}
+	return nil
+}
+
+type GetRoomInfoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomInfoRequest) Reset() {
+	*x = GetRoomInfoRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomInfoRequest) ProtoMessage() {}
+
+func (x *GetRoomInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *GetRoomInfoRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type GetRoomInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Information about the room..
+	Room          *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRoomInfoResponse) Reset() {
+	*x = GetRoomInfoResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRoomInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRoomInfoResponse) ProtoMessage() {}
+
+func (x *GetRoomInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRoomInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetRoomInfoResponse) GetRoom() *RoomInfo {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type GetOnlineUsersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room to query.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The maximum number of users to send per response message.
+	// If 0, the default page size is used.
+	// Values above the maximum page size are clamped to it.
+	PageSize      uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnlineUsersRequest) Reset() {
+	*x = GetOnlineUsersRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnlineUsersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnlineUsersRequest) ProtoMessage() {}
+
+func (x *GetOnlineUsersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnlineUsersRequest.ProtoReflect.Descriptor instead.
+func (*GetOnlineUsersRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetOnlineUsersRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *GetOnlineUsersRequest) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type GetOnlineUsersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Users         []*OnlineUserInfo      `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnlineUsersResponse) Reset() {
+	*x = GetOnlineUsersResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnlineUsersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnlineUsersResponse) ProtoMessage() {}
+
+func (x *GetOnlineUsersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnlineUsersResponse.ProtoReflect.Descriptor instead.
+func (*GetOnlineUsersResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *GetOnlineUsersResponse) GetUsers() []*OnlineUserInfo {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+type GetOnlineUserInfoRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The user's username.
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnlineUserInfoRequest) Reset() {
+	*x = GetOnlineUserInfoRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnlineUserInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnlineUserInfoRequest) ProtoMessage() {}
+
+func (x *GetOnlineUserInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnlineUserInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetOnlineUserInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *GetOnlineUserInfoRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *GetOnlineUserInfoRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type GetOnlineUserInfoResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Information about the online user.
+	User          *OnlineUserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOnlineUserInfoResponse) Reset() {
+	*x = GetOnlineUserInfoResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOnlineUserInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOnlineUserInfoResponse) ProtoMessage() {}
+
+func (x *GetOnlineUserInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOnlineUserInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetOnlineUserInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetOnlineUserInfoResponse) GetUser() *OnlineUserInfo {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type GetAccountsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room to query.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The maximum number of accounts to return.
+	// If 0, defaults to 500. Values above 1000 are treated as 1000.
+	Limit uint32 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// The cursor returned by a previous call, to get the next page.
+	// Empty to start from the beginning.
+	Cursor        string `protobuf:"bytes,3,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountsRequest) Reset() {
+	*x = GetAccountsRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsRequest) ProtoMessage() {}
+
+func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountsRequest.ProtoReflect.Descriptor instead.
+func (*GetAccountsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetAccountsRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *GetAccountsRequest) GetLimit() uint32 {
+	if x != nil {
+		return x.Limit
+	}
+	return 0
+}
+
+func (x *GetAccountsRequest) GetCursor() string {
+	if x != nil {
+		return x.Cursor
+	}
+	return ""
+}
+
+type GetAccountsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// A page of accounts in the room, ordered by username.
+	Accounts []*AccountInfo `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// The cursor to pass to get the next page, or empty if this is the last page.
+	NextCursor string `protobuf:"bytes,2,opt,name=next_cursor,json=nextCursor,proto3" json:"next_cursor,omitempty"`
+	// The total number of accounts in the room.
+	Total         uint32 `protobuf:"varint,3,opt,name=total,proto3" json:"total,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAccountsResponse) Reset() {
+	*x = GetAccountsResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAccountsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAccountsResponse) ProtoMessage() {}
+
+func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAccountsResponse.ProtoReflect.Descriptor instead.
+func (*GetAccountsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetAccountsResponse) GetAccounts() []*AccountInfo {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *GetAccountsResponse) GetNextCursor() string {
+	if x != nil {
+		return x.NextCursor
+	}
+	return ""
+}
+
+func (x *GetAccountsResponse) GetTotal() uint32 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+type CreateRoomRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The new room's name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// A description of the room.
+	// At most 500 characters.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Whether the room should be included in GetRooms results by default.
+	// Defaults to true.
+	Listed        *bool `protobuf:"varint,3,opt,name=listed,proto3,oneof" json:"listed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomRequest) Reset() {
+	*x = CreateRoomRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomRequest) ProtoMessage() {}
+
+func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
+func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *CreateRoomRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateRoomRequest) GetListed() bool {
+	if x != nil && x.Listed != nil {
+		return *x.Listed
+	}
+	return false
+}
+
+type CreateRoomResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Information about the newly created room.
+	Room          *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRoomResponse) Reset() {
+	*x = CreateRoomResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRoomResponse) ProtoMessage() {}
+
+func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
+func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *CreateRoomResponse) GetRoom() *RoomInfo {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type DeleteRoomRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomRequest) Reset() {
+	*x = DeleteRoomRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomRequest) ProtoMessage() {}
+
+func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *DeleteRoomRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type DeleteRoomResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRoomResponse) Reset() {
+	*x = DeleteRoomResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRoomResponse) ProtoMessage() {}
+
+func (x *DeleteRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRoomResponse.ProtoReflect.Descriptor instead.
+func (*DeleteRoomResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{22}
+}
+
+type SetRoomLimitsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The maximum number of online users allowed in the room, or 0 for unlimited.
+	MaxClients uint32 `protobuf:"varint,2,opt,name=max_clients,json=maxClients,proto3" json:"max_clients,omitempty"`
+	// The maximum number of concurrent proxied streams each client in the room can open, or 0 for unlimited.
+	MaxProxyStreamsPerClient uint32 `protobuf:"varint,3,opt,name=max_proxy_streams_per_client,json=maxProxyStreamsPerClient,proto3" json:"max_proxy_streams_per_client,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *SetRoomLimitsRequest) Reset() {
+	*x = SetRoomLimitsRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoomLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoomLimitsRequest) ProtoMessage() {}
+
+func (x *SetRoomLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoomLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetRoomLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SetRoomLimitsRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetRoomLimitsRequest) GetMaxClients() uint32 {
+	if x != nil {
+		return x.MaxClients
+	}
+	return 0
+}
+
+func (x *SetRoomLimitsRequest) GetMaxProxyStreamsPerClient() uint32 {
+	if x != nil {
+		return x.MaxProxyStreamsPerClient
+	}
+	return 0
+}
+
+type SetRoomLimitsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated room.
+	Room          *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRoomLimitsResponse) Reset() {
+	*x = SetRoomLimitsResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoomLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoomLimitsResponse) ProtoMessage() {}
+
+func (x *SetRoomLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoomLimitsResponse.ProtoReflect.Descriptor instead.
+func (*SetRoomLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *SetRoomLimitsResponse) GetRoom() *RoomInfo {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type SetRoomDirCacheTtlRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// How long directory listings proxied in the room are cached, in milliseconds, or 0 to disable caching.
+	TtlMs         uint32 `protobuf:"varint,2,opt,name=ttl_ms,json=ttlMs,proto3" json:"ttl_ms,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRoomDirCacheTtlRequest) Reset() {
+	*x = SetRoomDirCacheTtlRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoomDirCacheTtlRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoomDirCacheTtlRequest) ProtoMessage() {}
+
+func (x *SetRoomDirCacheTtlRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoomDirCacheTtlRequest.ProtoReflect.Descriptor instead.
+func (*SetRoomDirCacheTtlRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SetRoomDirCacheTtlRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetRoomDirCacheTtlRequest) GetTtlMs() uint32 {
+	if x != nil {
+		return x.TtlMs
+	}
+	return 0
+}
+
+type SetRoomDirCacheTtlResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated room.
+	Room          *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRoomDirCacheTtlResponse) Reset() {
+	*x = SetRoomDirCacheTtlResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoomDirCacheTtlResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoomDirCacheTtlResponse) ProtoMessage() {}
+
+func (x *SetRoomDirCacheTtlResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoomDirCacheTtlResponse.ProtoReflect.Descriptor instead.
+func (*SetRoomDirCacheTtlResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *SetRoomDirCacheTtlResponse) GetRoom() *RoomInfo {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type SetRoomMetadataRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The room's new description.
+	// At most 500 characters.
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	// Whether the room should be included in GetRooms results by default.
+	Listed        bool `protobuf:"varint,3,opt,name=listed,proto3" json:"listed,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRoomMetadataRequest) Reset() {
+	*x = SetRoomMetadataRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoomMetadataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoomMetadataRequest) ProtoMessage() {}
+
+func (x *SetRoomMetadataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoomMetadataRequest.ProtoReflect.Descriptor instead.
+func (*SetRoomMetadataRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *SetRoomMetadataRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetRoomMetadataRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *SetRoomMetadataRequest) GetListed() bool {
+	if x != nil {
+		return x.Listed
+	}
+	return false
+}
+
+type SetRoomMetadataResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated room.
+	Room          *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRoomMetadataResponse) Reset() {
+	*x = SetRoomMetadataResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoomMetadataResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoomMetadataResponse) ProtoMessage() {}
+
+func (x *SetRoomMetadataResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoomMetadataResponse.ProtoReflect.Descriptor instead.
+func (*SetRoomMetadataResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *SetRoomMetadataResponse) GetRoom() *RoomInfo {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type SetRoomMotdRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The room's new message of the day.
+	// At most 2000 characters.
+	// Empty to remove it.
+	Motd          string `protobuf:"bytes,2,opt,name=motd,proto3" json:"motd,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRoomMotdRequest) Reset() {
+	*x = SetRoomMotdRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoomMotdRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoomMotdRequest) ProtoMessage() {}
+
+func (x *SetRoomMotdRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoomMotdRequest.ProtoReflect.Descriptor instead.
+func (*SetRoomMotdRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *SetRoomMotdRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *SetRoomMotdRequest) GetMotd() string {
+	if x != nil {
+		return x.Motd
+	}
+	return ""
+}
+
+type SetRoomMotdResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The updated room.
+	Room          *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRoomMotdResponse) Reset() {
+	*x = SetRoomMotdResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRoomMotdResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRoomMotdResponse) ProtoMessage() {}
+
+func (x *SetRoomMotdResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRoomMotdResponse.ProtoReflect.Descriptor instead.
+func (*SetRoomMotdResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *SetRoomMotdResponse) GetRoom() *RoomInfo {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+type CloseRoomRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CloseRoomRequest) Reset() {
+	*x = CloseRoomRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseRoomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseRoomRequest) ProtoMessage() {}
+
+func (x *CloseRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseRoomRequest.ProtoReflect.Descriptor instead.
+func (*CloseRoomRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *CloseRoomRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+type CloseRoomResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of users that were disconnected.
+	DisconnectedUserCount uint32 `protobuf:"varint,1,opt,name=disconnected_user_count,json=disconnectedUserCount,proto3" json:"disconnected_user_count,omitempty"`
+	unknownFields         protoimpl.UnknownFields
+	sizeCache             protoimpl.SizeCache
+}
+
+func (x *CloseRoomResponse) Reset() {
+	*x = CloseRoomResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CloseRoomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CloseRoomResponse) ProtoMessage() {}
+
+func (x *CloseRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CloseRoomResponse.ProtoReflect.Descriptor instead.
+func (*CloseRoomResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *CloseRoomResponse) GetDisconnectedUserCount() uint32 {
+	if x != nil {
+		return x.DisconnectedUserCount
+	}
+	return 0
+}
+
+type KickUserRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The online user's username.
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickUserRequest) Reset() {
+	*x = KickUserRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserRequest) ProtoMessage() {}
+
+func (x *KickUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserRequest.ProtoReflect.Descriptor instead.
+func (*KickUserRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *KickUserRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *KickUserRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type KickUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *KickUserResponse) Reset() {
+	*x = KickUserResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *KickUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*KickUserResponse) ProtoMessage() {}
+
+func (x *KickUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use KickUserResponse.ProtoReflect.Descriptor instead.
+func (*KickUserResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{34}
+}
+
+type BroadcastMessageRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The message's text.
+	Text          string `protobuf:"bytes,2,opt,name=text,proto3" json:"text,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BroadcastMessageRequest) Reset() {
+	*x = BroadcastMessageRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastMessageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastMessageRequest) ProtoMessage() {}
+
+func (x *BroadcastMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastMessageRequest.ProtoReflect.Descriptor instead.
+func (*BroadcastMessageRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *BroadcastMessageRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *BroadcastMessageRequest) GetText() string {
+	if x != nil {
+		return x.Text
+	}
+	return ""
+}
+
+type BroadcastMessageResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The number of online users the message was sent to.
+	RecipientCount uint32 `protobuf:"varint,1,opt,name=recipient_count,json=recipientCount,proto3" json:"recipient_count,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *BroadcastMessageResponse) Reset() {
+	*x = BroadcastMessageResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BroadcastMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BroadcastMessageResponse) ProtoMessage() {}
+
+func (x *BroadcastMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BroadcastMessageResponse.ProtoReflect.Descriptor instead.
+func (*BroadcastMessageResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *BroadcastMessageResponse) GetRecipientCount() uint32 {
+	if x != nil {
+		return x.RecipientCount
+	}
+	return 0
+}
+
+type CreateAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The new account's username.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The new account's password, or empty to generate one.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// Whether the new account is a guest account.
+	// Guests can browse and download, but cannot share files or change their password.
+	IsGuest       bool `protobuf:"varint,4,opt,name=is_guest,json=isGuest,proto3" json:"is_guest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateAccountRequest) Reset() {
+	*x = CreateAccountRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountRequest) ProtoMessage() {}
+
+func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
+func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *CreateAccountRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *CreateAccountRequest) GetIsGuest() bool {
+	if x != nil {
+		return x.IsGuest
+	}
+	return false
+}
+
+type CreateAccountResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The newly created account.
+	Account *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	// The generated password, if applicable.
+	GeneratedPassword *string `protobuf:"bytes,2,opt,name=generated_password,json=generatedPassword,proto3,oneof" json:"generated_password,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CreateAccountResponse) Reset() {
+	*x = CreateAccountResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAccountResponse) ProtoMessage() {}
+
+func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
+func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *CreateAccountResponse) GetAccount() *AccountInfo {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *CreateAccountResponse) GetGeneratedPassword() string {
+	if x != nil && x.GeneratedPassword != nil {
+		return *x.GeneratedPassword
+	}
+	return ""
+}
+
+type DeleteAccountRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The account's username.
+	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountRequest) Reset() {
+	*x = DeleteAccountRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountRequest) ProtoMessage() {}
+
+func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *DeleteAccountRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *DeleteAccountRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+type DeleteAccountResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteAccountResponse) Reset() {
+	*x = DeleteAccountResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteAccountResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAccountResponse) ProtoMessage() {}
+
+func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
+func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{40}
+}
+
+type UpdateAccountPasswordRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The account's username.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The account's new password, or empty to generate one.
+	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateAccountPasswordRequest) Reset() {
+	*x = UpdateAccountPasswordRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountPasswordRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountPasswordRequest) ProtoMessage() {}
+
+func (x *UpdateAccountPasswordRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountPasswordRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAccountPasswordRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *UpdateAccountPasswordRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *UpdateAccountPasswordRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *UpdateAccountPasswordRequest) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+type UpdateAccountPasswordResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The generated password, if applicable.
+	GeneratedPassword *string `protobuf:"bytes,1,opt,name=generated_password,json=generatedPassword,proto3,oneof" json:"generated_password,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *UpdateAccountPasswordResponse) Reset() {
+	*x = UpdateAccountPasswordResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateAccountPasswordResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAccountPasswordResponse) ProtoMessage() {}
+
+func (x *UpdateAccountPasswordResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAccountPasswordResponse.ProtoReflect.Descriptor instead.
+func (*UpdateAccountPasswordResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *UpdateAccountPasswordResponse) GetGeneratedPassword() string {
+	if x != nil && x.GeneratedPassword != nil {
+		return *x.GeneratedPassword
+	}
+	return ""
+}
+
+type CreateInviteCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Room          string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteCodeRequest) Reset() {
+	*x = CreateInviteCodeRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteCodeRequest) ProtoMessage() {}
+
+func (x *CreateInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *CreateInviteCodeRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+type CreateInviteCodeResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The newly created invite code.
+	InviteCode    *InviteCodeInfo `protobuf:"bytes,1,opt,name=invite_code,json=inviteCode,proto3" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteCodeResponse) Reset() {
+	*x = CreateInviteCodeResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteCodeResponse) ProtoMessage() {}
+
+func (x *CreateInviteCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteCodeResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *CreateInviteCodeResponse) GetInviteCode() *InviteCodeInfo {
+	if x != nil {
+		return x.InviteCode
+	}
+	return nil
+}
+
+type GetInviteCodesRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Room          string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInviteCodesRequest) Reset() {
+	*x = GetInviteCodesRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInviteCodesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInviteCodesRequest) ProtoMessage() {}
+
+func (x *GetInviteCodesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInviteCodesRequest.ProtoReflect.Descriptor instead.
+func (*GetInviteCodesRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *GetInviteCodesRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+type GetInviteCodesResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's unused invite codes.
+	InviteCodes   []*InviteCodeInfo `protobuf:"bytes,1,rep,name=invite_codes,json=inviteCodes,proto3" json:"invite_codes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetInviteCodesResponse) Reset() {
+	*x = GetInviteCodesResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetInviteCodesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInviteCodesResponse) ProtoMessage() {}
+
+func (x *GetInviteCodesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInviteCodesResponse.ProtoReflect.Descriptor instead.
+func (*GetInviteCodesResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *GetInviteCodesResponse) GetInviteCodes() []*InviteCodeInfo {
+	if x != nil {
+		return x.InviteCodes
+	}
+	return nil
+}
+
+type DeleteInviteCodeRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The invite code to delete.
+	Code          string `protobuf:"bytes,2,opt,name=code,proto3" json:"code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInviteCodeRequest) Reset() {
+	*x = DeleteInviteCodeRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInviteCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInviteCodeRequest) ProtoMessage() {}
+
+func (x *DeleteInviteCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInviteCodeRequest.ProtoReflect.Descriptor instead.
+func (*DeleteInviteCodeRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *DeleteInviteCodeRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *DeleteInviteCodeRequest) GetCode() string {
+	if x != nil {
+		return x.Code
+	}
+	return ""
+}
+
+type DeleteInviteCodeResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteInviteCodeResponse) Reset() {
+	*x = DeleteInviteCodeResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteInviteCodeResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteInviteCodeResponse) ProtoMessage() {}
+
+func (x *DeleteInviteCodeResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteInviteCodeResponse.ProtoReflect.Descriptor instead.
+func (*DeleteInviteCodeResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{48}
+}
+
+type CreateInviteBundleRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The address clients should use to reach the server, like "example.com" or "example.com:20038".
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// Whether to create a new invite code and include it in the bundle, so the recipient can register an account.
+	CreateInviteCode bool `protobuf:"varint,3,opt,name=create_invite_code,json=createInviteCode,proto3" json:"create_invite_code,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CreateInviteBundleRequest) Reset() {
+	*x = CreateInviteBundleRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteBundleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteBundleRequest) ProtoMessage() {}
+
+func (x *CreateInviteBundleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteBundleRequest.ProtoReflect.Descriptor instead.
+func (*CreateInviteBundleRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *CreateInviteBundleRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *CreateInviteBundleRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateInviteBundleRequest) GetCreateInviteCode() bool {
+	if x != nil {
+		return x.CreateInviteCode
+	}
+	return false
+}
+
+type CreateInviteBundleResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The invite bundle URL, starting with friendnet://invite.
+	Url string `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	// The invite code included in the bundle, if one was created.
+	InviteCode    *InviteCodeInfo `protobuf:"bytes,2,opt,name=invite_code,json=inviteCode,proto3,oneof" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateInviteBundleResponse) Reset() {
+	*x = CreateInviteBundleResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateInviteBundleResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInviteBundleResponse) ProtoMessage() {}
+
+func (x *CreateInviteBundleResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInviteBundleResponse.ProtoReflect.Descriptor instead.
+func (*CreateInviteBundleResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *CreateInviteBundleResponse) GetUrl() string {
+	if x != nil {
+		return x.Url
+	}
+	return ""
+}
+
+func (x *CreateInviteBundleResponse) GetInviteCode() *InviteCodeInfo {
+	if x != nil {
+		return x.InviteCode
+	}
+	return nil
+}
+
+type SetAccountGuestRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The account's username.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// Whether the account should be a guest account.
+	IsGuest       bool `protobuf:"varint,3,opt,name=is_guest,json=isGuest,proto3" json:"is_guest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountGuestRequest) Reset() {
+	*x = SetAccountGuestRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountGuestRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountGuestRequest) ProtoMessage() {}
+
+func (x *SetAccountGuestRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountGuestRequest.ProtoReflect.Descriptor instead.
+func (*SetAccountGuestRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SetAccountGuestRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *SetAccountGuestRequest) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SetAccountGuestRequest) GetIsGuest() bool {
+	if x != nil {
+		return x.IsGuest
+	}
+	return false
+}
+
+type SetAccountGuestResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetAccountGuestResponse) Reset() {
+	*x = SetAccountGuestResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetAccountGuestResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetAccountGuestResponse) ProtoMessage() {}
+
+func (x *SetAccountGuestResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetAccountGuestResponse.ProtoReflect.Descriptor instead.
+func (*SetAccountGuestResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{52}
+}
+
+type ListStreamsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name, or empty to list streams in all rooms.
+	Room          string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStreamsRequest) Reset() {
+	*x = ListStreamsRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStreamsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStreamsRequest) ProtoMessage() {}
+
+func (x *ListStreamsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStreamsRequest.ProtoReflect.Descriptor instead.
+func (*ListStreamsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *ListStreamsRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+type ListStreamsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The open streams.
+	Streams       []*StreamInfo `protobuf:"bytes,1,rep,name=streams,proto3" json:"streams,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStreamsResponse) Reset() {
+	*x = ListStreamsResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStreamsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStreamsResponse) ProtoMessage() {}
+
+func (x *ListStreamsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStreamsResponse.ProtoReflect.Descriptor instead.
+func (*ListStreamsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *ListStreamsResponse) GetStreams() []*StreamInfo {
+	if x != nil {
+		return x.Streams
+	}
+	return nil
+}
+
+type CancelStreamRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's name.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The stream's ID.
+	Id            string `protobuf:"bytes,2,opt,name=id,proto3" json:"id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelStreamRequest) Reset() {
+	*x = CancelStreamRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelStreamRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelStreamRequest) ProtoMessage() {}
+
+func (x *CancelStreamRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelStreamRequest.ProtoReflect.Descriptor instead.
+func (*CancelStreamRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *CancelStreamRequest) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *CancelStreamRequest) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+type CancelStreamResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CancelStreamResponse) Reset() {
+	*x = CancelStreamResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CancelStreamResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CancelStreamResponse) ProtoMessage() {}
+
+func (x *CancelStreamResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CancelStreamResponse.ProtoReflect.Descriptor instead.
+func (*CancelStreamResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{56}
+}
+
+// MigrationInfo is the state of a database schema migration.
+type MigrationInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The migration's name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// Whether the migration has been applied.
+	Applied bool `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	// When the migration was applied, as a UNIX timestamp in seconds.
+	// 0 if it has not been applied.
+	AppliedTs int64 `protobuf:"varint,3,opt,name=applied_ts,json=appliedTs,proto3" json:"applied_ts,omitempty"`
+	// Whether the migration is applied to the database but unknown to this server version.
+	// This happens when the database was used by a newer server version.
+	Unknown       bool `protobuf:"varint,4,opt,name=unknown,proto3" json:"unknown,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MigrationInfo) Reset() {
+	*x = MigrationInfo{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MigrationInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MigrationInfo) ProtoMessage() {}
+
+func (x *MigrationInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MigrationInfo.ProtoReflect.Descriptor instead.
+func (*MigrationInfo) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MigrationInfo) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MigrationInfo) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *MigrationInfo) GetAppliedTs() int64 {
+	if x != nil {
+		return x.AppliedTs
+	}
+	return 0
+}
+
+func (x *MigrationInfo) GetUnknown() bool {
+	if x != nil {
+		return x.Unknown
+	}
+	return false
+}
+
+type GetMigrationStatusRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMigrationStatusRequest) Reset() {
+	*x = GetMigrationStatusRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMigrationStatusRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMigrationStatusRequest) ProtoMessage() {}
+
+func (x *GetMigrationStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMigrationStatusRequest.ProtoReflect.Descriptor instead.
+func (*GetMigrationStatusRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{58}
+}
+
+type GetMigrationStatusResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The state of each migration, in the order they are applied, followed by any unknown migrations.
+	Migrations    []*MigrationInfo `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetMigrationStatusResponse) Reset() {
+	*x = GetMigrationStatusResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetMigrationStatusResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetMigrationStatusResponse) ProtoMessage() {}
+
+func (x *GetMigrationStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetMigrationStatusResponse.ProtoReflect.Descriptor instead.
+func (*GetMigrationStatusResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetMigrationStatusResponse) GetMigrations() []*MigrationInfo {
+	if x != nil {
+		return x.Migrations
+	}
+	return nil
+}
+
+type BackupDatabaseRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The path of the file on the server to write the backup to.
+	// Relative paths are resolved against the server's working directory.
+	// The file must not already exist.
+	Path          string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupDatabaseRequest) Reset() {
+	*x = BackupDatabaseRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupDatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupDatabaseRequest) ProtoMessage() {}
+
+func (x *BackupDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *BackupDatabaseRequest) GetPath() string {
+	if x != nil {
+		return x.Path
+	}
+	return ""
+}
+
+type BackupDatabaseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BackupDatabaseResponse) Reset() {
+	*x = BackupDatabaseResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BackupDatabaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupDatabaseResponse) ProtoMessage() {}
+
+func (x *BackupDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{61}
+}
+
+type CheckDatabaseIntegrityRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDatabaseIntegrityRequest) Reset() {
+	*x = CheckDatabaseIntegrityRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDatabaseIntegrityRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDatabaseIntegrityRequest) ProtoMessage() {}
+
+func (x *CheckDatabaseIntegrityRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDatabaseIntegrityRequest.ProtoReflect.Descriptor instead.
+func (*CheckDatabaseIntegrityRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{62}
+}
+
+type CheckDatabaseIntegrityResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The problems found, or empty if the database is intact.
+	Problems      []string `protobuf:"bytes,1,rep,name=problems,proto3" json:"problems,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CheckDatabaseIntegrityResponse) Reset() {
+	*x = CheckDatabaseIntegrityResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CheckDatabaseIntegrityResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CheckDatabaseIntegrityResponse) ProtoMessage() {}
+
+func (x *CheckDatabaseIntegrityResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CheckDatabaseIntegrityResponse.ProtoReflect.Descriptor instead.
+func (*CheckDatabaseIntegrityResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CheckDatabaseIntegrityResponse) GetProblems() []string {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+// RelayLimitWindow is a time window during which relay bandwidth has a different limit.
+// Times are in the server's local time zone.
+type RelayLimitWindow struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The days of the week the window starts on, as a bit mask where bit 0 is Sunday and bit 6 is Saturday.
+	// 0 means every day.
+	Weekdays uint32 `protobuf:"varint,1,opt,name=weekdays,proto3" json:"weekdays,omitempty"`
+	// The minute of the day the window starts at, from 0 to 1439.
+	StartMinute uint32 `protobuf:"varint,2,opt,name=start_minute,json=startMinute,proto3" json:"start_minute,omitempty"`
+	// The minute of the day the window ends at, from 0 to 1439.
+	// If it is not after start_minute, the window ends on the next day.
+	EndMinute uint32 `protobuf:"varint,3,opt,name=end_minute,json=endMinute,proto3" json:"end_minute,omitempty"`
+	// The maximum number of bytes per second relayed during the window, or 0 for unlimited.
+	MaxBytesPerSecond uint64 `protobuf:"varint,4,opt,name=max_bytes_per_second,json=maxBytesPerSecond,proto3" json:"max_bytes_per_second,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *RelayLimitWindow) Reset() {
+	*x = RelayLimitWindow{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RelayLimitWindow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RelayLimitWindow) ProtoMessage() {}
+
+func (x *RelayLimitWindow) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RelayLimitWindow.ProtoReflect.Descriptor instead.
+func (*RelayLimitWindow) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *RelayLimitWindow) GetWeekdays() uint32 {
+	if x != nil {
+		return x.Weekdays
+	}
+	return 0
+}
+
+func (x *RelayLimitWindow) GetStartMinute() uint32 {
+	if x != nil {
+		return x.StartMinute
+	}
+	return 0
+}
+
+func (x *RelayLimitWindow) GetEndMinute() uint32 {
+	if x != nil {
+		return x.EndMinute
+	}
+	return 0
+}
+
+func (x *RelayLimitWindow) GetMaxBytesPerSecond() uint64 {
+	if x != nil {
+		return x.MaxBytesPerSecond
+	}
+	return 0
+}
+
+type GetRelayLimitsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRelayLimitsRequest) Reset() {
+	*x = GetRelayLimitsRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelayLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelayLimitsRequest) ProtoMessage() {}
+
+func (x *GetRelayLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelayLimitsRequest.ProtoReflect.Descriptor instead.
+func (*GetRelayLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{65}
+}
+
+type GetRelayLimitsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of bytes per second relayed outside of scheduled windows, or 0 for unlimited.
+	MaxBytesPerSecond uint64 `protobuf:"varint,1,opt,name=max_bytes_per_second,json=maxBytesPerSecond,proto3" json:"max_bytes_per_second,omitempty"`
+	// Windows with a different limit.
+	// If windows overlap, the first one applies.
+	Schedule []*RelayLimitWindow `protobuf:"bytes,2,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	// The limit that applies right now, or 0 for unlimited.
+	CurrentMaxBytesPerSecond uint64 `protobuf:"varint,3,opt,name=current_max_bytes_per_second,json=currentMaxBytesPerSecond,proto3" json:"current_max_bytes_per_second,omitempty"`
+	unknownFields            protoimpl.UnknownFields
+	sizeCache                protoimpl.SizeCache
+}
+
+func (x *GetRelayLimitsResponse) Reset() {
+	*x = GetRelayLimitsResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRelayLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRelayLimitsResponse) ProtoMessage() {}
+
+func (x *GetRelayLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRelayLimitsResponse.ProtoReflect.Descriptor instead.
+func (*GetRelayLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetRelayLimitsResponse) GetMaxBytesPerSecond() uint64 {
+	if x != nil {
+		return x.MaxBytesPerSecond
+	}
+	return 0
+}
+
+func (x *GetRelayLimitsResponse) GetSchedule() []*RelayLimitWindow {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+func (x *GetRelayLimitsResponse) GetCurrentMaxBytesPerSecond() uint64 {
+	if x != nil {
+		return x.CurrentMaxBytesPerSecond
+	}
+	return 0
+}
+
+type SetRelayLimitsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of bytes per second relayed outside of scheduled windows, or 0 for unlimited.
+	MaxBytesPerSecond uint64 `protobuf:"varint,1,opt,name=max_bytes_per_second,json=maxBytesPerSecond,proto3" json:"max_bytes_per_second,omitempty"`
+	// Windows with a different limit.
+	// If windows overlap, the first one applies.
+	Schedule      []*RelayLimitWindow `protobuf:"bytes,2,rep,name=schedule,proto3" json:"schedule,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRelayLimitsRequest) Reset() {
+	*x = SetRelayLimitsRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRelayLimitsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRelayLimitsRequest) ProtoMessage() {}
+
+func (x *SetRelayLimitsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRelayLimitsRequest.ProtoReflect.Descriptor instead.
+func (*SetRelayLimitsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *SetRelayLimitsRequest) GetMaxBytesPerSecond() uint64 {
+	if x != nil {
+		return x.MaxBytesPerSecond
+	}
+	return 0
+}
+
+func (x *SetRelayLimitsRequest) GetSchedule() []*RelayLimitWindow {
+	if x != nil {
+		return x.Schedule
+	}
+	return nil
+}
+
+type SetRelayLimitsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SetRelayLimitsResponse) Reset() {
+	*x = SetRelayLimitsResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SetRelayLimitsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetRelayLimitsResponse) ProtoMessage() {}
+
+func (x *SetRelayLimitsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetRelayLimitsResponse.ProtoReflect.Descriptor instead.
+func (*SetRelayLimitsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{68}
+}
+
+// LobbySettings are the settings for the lobby, where new connections negotiate versions and authenticate.
+type LobbySettings struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// How long a connection can stay in the lobby until it is disconnected, in seconds.
+	TimeoutSeconds uint32 `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3" json:"timeout_seconds,omitempty"`
+	// The oldest protocol version accepted from clients, inclusive, in "MAJOR.MINOR.PATCH" format.
+	// Only the major and minor parts are compared.
+	MinProtocolVersion string `protobuf:"bytes,2,opt,name=min_protocol_version,json=minProtocolVersion,proto3" json:"min_protocol_version,omitempty"`
+	// The newest protocol version accepted from clients, inclusive, in "MAJOR.MINOR.PATCH" format.
+	// Only the major and minor parts are compared.
+	MaxProtocolVersion string `protobuf:"bytes,3,opt,name=max_protocol_version,json=maxProtocolVersion,proto3" json:"max_protocol_version,omitempty"`
+	// The maximum number of connections that can be in the lobby at once, or 0 for unlimited.
+	MaxConcurrent uint32 `protobuf:"varint,4,opt,name=max_concurrent,json=maxConcurrent,proto3" json:"max_concurrent,omitempty"`
+	// The maximum number of new connections a single IP address can make per minute, or 0 for unlimited.
+	MaxConnsPerIpPerMinute uint32 `protobuf:"varint,5,opt,name=max_conns_per_ip_per_minute,json=maxConnsPerIpPerMinute,proto3" json:"max_conns_per_ip_per_minute,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *LobbySettings) Reset() {
+	*x = LobbySettings{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *LobbySettings) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LobbySettings) ProtoMessage() {}
+
+func (x *LobbySettings) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LobbySettings.ProtoReflect.Descriptor instead.
+func (*LobbySettings) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *LobbySettings) GetTimeoutSeconds() uint32 {
+	if x != nil {
+		return x.TimeoutSeconds
+	}
+	return 0
+}
+
+func (x *LobbySettings) GetMinProtocolVersion() string {
+	if x != nil {
+		return x.MinProtocolVersion
+	}
+	return ""
+}
+
+func (x *LobbySettings) GetMaxProtocolVersion() string {
+	if x != nil {
+		return x.MaxProtocolVersion
+	}
+	return ""
+}
+
+func (x *LobbySettings) GetMaxConcurrent() uint32 {
+	if x != nil {
+		return x.MaxConcurrent
+	}
+	return 0
+}
+
+func (x *LobbySettings) GetMaxConnsPerIpPerMinute() uint32 {
+	if x != nil {
+		return x.MaxConnsPerIpPerMinute
+	}
+	return 0
+}
+
+type GetLobbySettingsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLobbySettingsRequest) Reset() {
+	*x = GetLobbySettingsRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLobbySettingsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetLobbySettingsRequest) ProtoMessage() {}
+
+func (x *GetLobbySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetLobbySettingsRequest.ProtoReflect.Descriptor instead.
+func (*GetLobbySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{70}
+}
+
+type GetLobbySettingsResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The current settings.
+	Settings      *LobbySettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetLobbySettingsResponse) Reset() {
+	*x = GetLobbySettingsResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetLobbySettingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoomInfoRequest) ProtoMessage() {}
+func (*GetLobbySettingsResponse) ProtoMessage() {}
 
-func (x *GetRoomInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[7]
+func (x *GetLobbySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[71]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -372,41 +3877,54 @@ func (x *GetRoomInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoomInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetRoomInfoRequest) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use GetLobbySettingsResponse.ProtoReflect.Descriptor instead.
+func (*GetLobbySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{71}
 }
 
-func (x *GetRoomInfoRequest) GetName() string {
+func (x *GetLobbySettingsResponse) GetSettings() *LobbySettings {
 	if x != nil {
-		return x.Name
+		return x.Settings
 	}
-	return ""
+	return nil
 }
 
-type GetRoomInfoResponse struct {
+type UpdateLobbySettingsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Information about the room..
-	Room          *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
-}
-
-func (x *GetRoomInfoResponse) Reset() {
-	*x = GetRoomInfoResponse{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[8]
+	// The new timeout in seconds.
+	// If omitted, it is not changed.
+	TimeoutSeconds *uint32 `protobuf:"varint,1,opt,name=timeout_seconds,json=timeoutSeconds,proto3,oneof" json:"timeout_seconds,omitempty"`
+	// The new oldest accepted protocol version, in "MAJOR.MINOR" or "MAJOR.MINOR.PATCH" format.
+	// If omitted, it is not changed.
+	MinProtocolVersion *string `protobuf:"bytes,2,opt,name=min_protocol_version,json=minProtocolVersion,proto3,oneof" json:"min_protocol_version,omitempty"`
+	// The new newest accepted protocol version, in "MAJOR.MINOR" or "MAJOR.MINOR.PATCH" format.
+	// If omitted, it is not changed.
+	MaxProtocolVersion *string `protobuf:"bytes,3,opt,name=max_protocol_version,json=maxProtocolVersion,proto3,oneof" json:"max_protocol_version,omitempty"`
+	// The new maximum number of connections in the lobby at once, or 0 for unlimited.
+	// If omitted, it is not changed.
+	MaxConcurrent *uint32 `protobuf:"varint,4,opt,name=max_concurrent,json=maxConcurrent,proto3,oneof" json:"max_concurrent,omitempty"`
+	// The new maximum number of new connections per IP address per minute, or 0 for unlimited.
+	// If omitted, it is not changed.
+	MaxConnsPerIpPerMinute *uint32 `protobuf:"varint,5,opt,name=max_conns_per_ip_per_minute,json=maxConnsPerIpPerMinute,proto3,oneof" json:"max_conns_per_ip_per_minute,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *UpdateLobbySettingsRequest) Reset() {
+	*x = UpdateLobbySettingsRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[72]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetRoomInfoResponse) String() string {
+func (x *UpdateLobbySettingsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetRoomInfoResponse) ProtoMessage() {}
+func (*UpdateLobbySettingsRequest) ProtoMessage() {}
 
-func (x *GetRoomInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[8]
+func (x *UpdateLobbySettingsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[72]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -417,41 +3935,69 @@ func (x *GetRoomInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetRoomInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetRoomInfoResponse) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{8}
+// Deprecated: Use UpdateLobbySettingsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateLobbySettingsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{72}
 }
 
-func (x *GetRoomInfoResponse) GetRoom() *RoomInfo {
-	if x != nil {
-		return x.Room
+func (x *UpdateLobbySettingsRequest) GetTimeoutSeconds() uint32 {
+	if x != nil && x.TimeoutSeconds != nil {
+		return *x.TimeoutSeconds
 	}
-	return nil
+	return 0
 }
 
-type GetOnlineUsersRequest struct {
+func (x *UpdateLobbySettingsRequest) GetMinProtocolVersion() string {
+	if x != nil && x.MinProtocolVersion != nil {
+		return *x.MinProtocolVersion
+	}
+	return ""
+}
+
+func (x *UpdateLobbySettingsRequest) GetMaxProtocolVersion() string {
+	if x != nil && x.MaxProtocolVersion != nil {
+		return *x.MaxProtocolVersion
+	}
+	return ""
+}
+
+func (x *UpdateLobbySettingsRequest) GetMaxConcurrent() uint32 {
+	if x != nil && x.MaxConcurrent != nil {
+		return *x.MaxConcurrent
+	}
+	return 0
+}
+
+func (x *UpdateLobbySettingsRequest) GetMaxConnsPerIpPerMinute() uint32 {
+	if x != nil && x.MaxConnsPerIpPerMinute != nil {
+		return *x.MaxConnsPerIpPerMinute
+	}
+	return 0
+}
+
+type UpdateLobbySettingsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The room to query.
-	Room          string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The settings after the update.
+	Settings      *LobbySettings `protobuf:"bytes,1,opt,name=settings,proto3" json:"settings,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOnlineUsersRequest) Reset() {
-	*x = GetOnlineUsersRequest{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[9]
+func (x *UpdateLobbySettingsResponse) Reset() {
+	*x = UpdateLobbySettingsResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[73]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOnlineUsersRequest) String() string {
+func (x *UpdateLobbySettingsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOnlineUsersRequest) ProtoMessage() {}
+func (*UpdateLobbySettingsResponse) ProtoMessage() {}
 
-func (x *GetOnlineUsersRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[9]
+func (x *UpdateLobbySettingsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[73]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -462,40 +4008,39 @@ func (x *GetOnlineUsersRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOnlineUsersRequest.ProtoReflect.Descriptor instead.
-func (*GetOnlineUsersRequest) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{9}
+// Deprecated: Use UpdateLobbySettingsResponse.ProtoReflect.Descriptor instead.
+func (*UpdateLobbySettingsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{73}
 }
 
-func (x *GetOnlineUsersRequest) GetRoom() string {
+func (x *UpdateLobbySettingsResponse) GetSettings() *LobbySettings {
 	if x != nil {
-		return x.Room
+		return x.Settings
 	}
-	return ""
+	return nil
 }
 
-type GetOnlineUsersResponse struct {
+type GetLobbyStatsRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Users         []*OnlineUserInfo      `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOnlineUsersResponse) Reset() {
-	*x = GetOnlineUsersResponse{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[10]
+func (x *GetLobbyStatsRequest) Reset() {
+	*x = GetLobbyStatsRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[74]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOnlineUsersResponse) String() string {
+func (x *GetLobbyStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOnlineUsersResponse) ProtoMessage() {}
+func (*GetLobbyStatsRequest) ProtoMessage() {}
 
-func (x *GetOnlineUsersResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[10]
+func (x *GetLobbyStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[74]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -506,43 +4051,42 @@ func (x *GetOnlineUsersResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOnlineUsersResponse.ProtoReflect.Descriptor instead.
-func (*GetOnlineUsersResponse) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{10}
-}
-
-func (x *GetOnlineUsersResponse) GetUsers() []*OnlineUserInfo {
-	if x != nil {
-		return x.Users
-	}
-	return nil
+// Deprecated: Use GetLobbyStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetLobbyStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{74}
 }
 
-type GetOnlineUserInfoRequest struct {
+type GetLobbyStatsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The room's name.
-	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
-	// The user's username.
-	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The number of connections that entered the lobby since the server started.
+	Accepted uint64 `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	// The number of connections rejected because the server was draining.
+	RejectedDraining uint64 `protobuf:"varint,2,opt,name=rejected_draining,json=rejectedDraining,proto3" json:"rejected_draining,omitempty"`
+	// The number of connections rejected because their IP address made too many connections.
+	RejectedRateLimited uint64 `protobuf:"varint,3,opt,name=rejected_rate_limited,json=rejectedRateLimited,proto3" json:"rejected_rate_limited,omitempty"`
+	// The number of connections rejected because too many connections were already in the lobby.
+	RejectedBusy uint64 `protobuf:"varint,4,opt,name=rejected_busy,json=rejectedBusy,proto3" json:"rejected_busy,omitempty"`
+	// The number of connections currently in the lobby.
+	Onboarding    uint32 `protobuf:"varint,5,opt,name=onboarding,proto3" json:"onboarding,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOnlineUserInfoRequest) Reset() {
-	*x = GetOnlineUserInfoRequest{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[11]
+func (x *GetLobbyStatsResponse) Reset() {
+	*x = GetLobbyStatsResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[75]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOnlineUserInfoRequest) String() string {
+func (x *GetLobbyStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOnlineUserInfoRequest) ProtoMessage() {}
+func (*GetLobbyStatsResponse) ProtoMessage() {}
 
-func (x *GetOnlineUserInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[11]
+func (x *GetLobbyStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[75]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -553,48 +4097,75 @@ func (x *GetOnlineUserInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOnlineUserInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetOnlineUserInfoRequest) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{11}
+// Deprecated: Use GetLobbyStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetLobbyStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{75}
 }
 
-func (x *GetOnlineUserInfoRequest) GetRoom() string {
+func (x *GetLobbyStatsResponse) GetAccepted() uint64 {
 	if x != nil {
-		return x.Room
+		return x.Accepted
 	}
-	return ""
+	return 0
 }
 
-func (x *GetOnlineUserInfoRequest) GetUsername() string {
+func (x *GetLobbyStatsResponse) GetRejectedDraining() uint64 {
 	if x != nil {
-		return x.Username
+		return x.RejectedDraining
 	}
-	return ""
+	return 0
 }
 
-type GetOnlineUserInfoResponse struct {
+func (x *GetLobbyStatsResponse) GetRejectedRateLimited() uint64 {
+	if x != nil {
+		return x.RejectedRateLimited
+	}
+	return 0
+}
+
+func (x *GetLobbyStatsResponse) GetRejectedBusy() uint64 {
+	if x != nil {
+		return x.RejectedBusy
+	}
+	return 0
+}
+
+func (x *GetLobbyStatsResponse) GetOnboarding() uint32 {
+	if x != nil {
+		return x.Onboarding
+	}
+	return 0
+}
+
+type GetRoomStatsRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Information about the online user.
-	User          *OnlineUserInfo `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	// The room's name.
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The UNIX timestamp, in seconds, of the start of the range, inclusive.
+	// Specify 0 to start from the oldest snapshot.
+	FromTs int64 `protobuf:"varint,2,opt,name=from_ts,json=fromTs,proto3" json:"from_ts,omitempty"`
+	// The UNIX timestamp, in seconds, of the end of the range, exclusive.
+	// Specify 0 to end at the current time.
+	ToTs          int64 `protobuf:"varint,3,opt,name=to_ts,json=toTs,proto3" json:"to_ts,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOnlineUserInfoResponse) Reset() {
-	*x = GetOnlineUserInfoResponse{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[12]
+func (x *GetRoomStatsRequest) Reset() {
+	*x = GetRoomStatsRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[76]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOnlineUserInfoResponse) String() string {
+func (x *GetRoomStatsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOnlineUserInfoResponse) ProtoMessage() {}
+func (*GetRoomStatsRequest) ProtoMessage() {}
 
-func (x *GetOnlineUserInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[12]
+func (x *GetRoomStatsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[76]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -605,41 +4176,55 @@ func (x *GetOnlineUserInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOnlineUserInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetOnlineUserInfoResponse) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use GetRoomStatsRequest.ProtoReflect.Descriptor instead.
+func (*GetRoomStatsRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{76}
 }
 
-func (x *GetOnlineUserInfoResponse) GetUser() *OnlineUserInfo {
+func (x *GetRoomStatsRequest) GetName() string {
 	if x != nil {
-		return x.User
+		return x.Name
 	}
-	return nil
+	return ""
 }
 
-type GetAccountsRequest struct {
+func (x *GetRoomStatsRequest) GetFromTs() int64 {
+	if x != nil {
+		return x.FromTs
+	}
+	return 0
+}
+
+func (x *GetRoomStatsRequest) GetToTs() int64 {
+	if x != nil {
+		return x.ToTs
+	}
+	return 0
+}
+
+type GetRoomStatsResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The room to query.
-	Room          string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The room's usage snapshots in the range, oldest first.
+	Stats         []*RoomStat `protobuf:"bytes,1,rep,name=stats,proto3" json:"stats,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAccountsRequest) Reset() {
-	*x = GetAccountsRequest{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[13]
+func (x *GetRoomStatsResponse) Reset() {
+	*x = GetRoomStatsResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[77]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAccountsRequest) String() string {
+func (x *GetRoomStatsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAccountsRequest) ProtoMessage() {}
+func (*GetRoomStatsResponse) ProtoMessage() {}
 
-func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[13]
+func (x *GetRoomStatsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[77]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -650,41 +4235,39 @@ func (x *GetAccountsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAccountsRequest.ProtoReflect.Descriptor instead.
-func (*GetAccountsRequest) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use GetRoomStatsResponse.ProtoReflect.Descriptor instead.
+func (*GetRoomStatsResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{77}
 }
 
-func (x *GetAccountsRequest) GetRoom() string {
+func (x *GetRoomStatsResponse) GetStats() []*RoomStat {
 	if x != nil {
-		return x.Room
+		return x.Stats
 	}
-	return ""
+	return nil
 }
 
-type GetAccountsResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// All accounts in the room.
-	Accounts      []*AccountInfo `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+type DrainRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetAccountsResponse) Reset() {
-	*x = GetAccountsResponse{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[14]
+func (x *DrainRequest) Reset() {
+	*x = DrainRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[78]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetAccountsResponse) String() string {
+func (x *DrainRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetAccountsResponse) ProtoMessage() {}
+func (*DrainRequest) ProtoMessage() {}
 
-func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[14]
+func (x *DrainRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[78]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -695,41 +4278,37 @@ func (x *GetAccountsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetAccountsResponse.ProtoReflect.Descriptor instead.
-func (*GetAccountsResponse) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{14}
-}
-
-func (x *GetAccountsResponse) GetAccounts() []*AccountInfo {
-	if x != nil {
-		return x.Accounts
-	}
-	return nil
+// Deprecated: Use DrainRequest.ProtoReflect.Descriptor instead.
+func (*DrainRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{78}
 }
 
-type CreateRoomRequest struct {
+type DrainResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The new room's name.
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The number of proxied streams that are still carrying data.
+	// Idle streams that clients opened ahead of time for their next requests are not counted.
+	ActiveStreams uint32 `protobuf:"varint,1,opt,name=active_streams,json=activeStreams,proto3" json:"active_streams,omitempty"`
+	// The number of clients still connected, across all rooms.
+	OnlineClients uint32 `protobuf:"varint,2,opt,name=online_clients,json=onlineClients,proto3" json:"online_clients,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRoomRequest) Reset() {
-	*x = CreateRoomRequest{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[15]
+func (x *DrainResponse) Reset() {
+	*x = DrainResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[79]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoomRequest) String() string {
+func (x *DrainResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoomRequest) ProtoMessage() {}
+func (*DrainResponse) ProtoMessage() {}
 
-func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[15]
+func (x *DrainResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[79]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -740,41 +4319,54 @@ func (x *CreateRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoomRequest.ProtoReflect.Descriptor instead.
-func (*CreateRoomRequest) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{15}
+// Deprecated: Use DrainResponse.ProtoReflect.Descriptor instead.
+func (*DrainResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{79}
 }
 
-func (x *CreateRoomRequest) GetName() string {
+func (x *DrainResponse) GetActiveStreams() uint32 {
 	if x != nil {
-		return x.Name
+		return x.ActiveStreams
 	}
-	return ""
+	return 0
 }
 
-type CreateRoomResponse struct {
+func (x *DrainResponse) GetOnlineClients() uint32 {
+	if x != nil {
+		return x.OnlineClients
+	}
+	return 0
+}
+
+// ConfigProblem is a problem found in a server config.
+type ConfigProblem struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// Information about the newly created room.
-	Room          *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The field the problem is in, such as "rpc.interfaces[1].address".
+	// Empty if the problem is with the config as a whole.
+	Field string `protobuf:"bytes,1,opt,name=field,proto3" json:"field,omitempty"`
+	// A description of the problem.
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	// Whether the config can still be used despite the problem, such as an unknown field that is ignored.
+	Warning       bool `protobuf:"varint,3,opt,name=warning,proto3" json:"warning,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateRoomResponse) Reset() {
-	*x = CreateRoomResponse{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[16]
+func (x *ConfigProblem) Reset() {
+	*x = ConfigProblem{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[80]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateRoomResponse) String() string {
+func (x *ConfigProblem) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateRoomResponse) ProtoMessage() {}
+func (*ConfigProblem) ProtoMessage() {}
 
-func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[16]
+func (x *ConfigProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[80]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -785,41 +4377,57 @@ func (x *CreateRoomResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateRoomResponse.ProtoReflect.Descriptor instead.
-func (*CreateRoomResponse) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{16}
+// Deprecated: Use ConfigProblem.ProtoReflect.Descriptor instead.
+func (*ConfigProblem) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{80}
 }
 
-func (x *CreateRoomResponse) GetRoom() *RoomInfo {
+func (x *ConfigProblem) GetField() string {
 	if x != nil {
-		return x.Room
+		return x.Field
 	}
-	return nil
+	return ""
 }
 
-type DeleteRoomRequest struct {
+func (x *ConfigProblem) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+func (x *ConfigProblem) GetWarning() bool {
+	if x != nil {
+		return x.Warning
+	}
+	return false
+}
+
+type ValidateConfigRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The room's name.
-	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// The config JSON to validate.
+	// If empty, the config file the server was started with is read again and validated, such as to check changes to
+	// it before restarting the server.
+	ConfigJson    string `protobuf:"bytes,1,opt,name=config_json,json=configJson,proto3" json:"config_json,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRoomRequest) Reset() {
-	*x = DeleteRoomRequest{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[17]
+func (x *ValidateConfigRequest) Reset() {
+	*x = ValidateConfigRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[81]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRoomRequest) String() string {
+func (x *ValidateConfigRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRoomRequest) ProtoMessage() {}
+func (*ValidateConfigRequest) ProtoMessage() {}
 
-func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[17]
+func (x *ValidateConfigRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[81]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -830,39 +4438,41 @@ func (x *DeleteRoomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRoomRequest.ProtoReflect.Descriptor instead.
-func (*DeleteRoomRequest) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use ValidateConfigRequest.ProtoReflect.Descriptor instead.
+func (*ValidateConfigRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{81}
 }
 
-func (x *DeleteRoomRequest) GetName() string {
+func (x *ValidateConfigRequest) GetConfigJson() string {
 	if x != nil {
-		return x.Name
+		return x.ConfigJson
 	}
 	return ""
 }
 
-type DeleteRoomResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type ValidateConfigResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Every problem found in the config, or empty if it has none.
+	Problems      []*ConfigProblem `protobuf:"bytes,1,rep,name=problems,proto3" json:"problems,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteRoomResponse) Reset() {
-	*x = DeleteRoomResponse{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[18]
+func (x *ValidateConfigResponse) Reset() {
+	*x = ValidateConfigResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[82]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteRoomResponse) String() string {
+func (x *ValidateConfigResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteRoomResponse) ProtoMessage() {}
+func (*ValidateConfigResponse) ProtoMessage() {}
 
-func (x *DeleteRoomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[18]
+func (x *ValidateConfigResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[82]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -873,38 +4483,62 @@ func (x *DeleteRoomResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteRoomResponse.ProtoReflect.Descriptor instead.
-func (*DeleteRoomResponse) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use ValidateConfigResponse.ProtoReflect.Descriptor instead.
+func (*ValidateConfigResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{82}
 }
 
-type CreateAccountRequest struct {
+func (x *ValidateConfigResponse) GetProblems() []*ConfigProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+// RoomArchive is a portable copy of a room's settings, accounts and unused invite codes, used to move a room to another
+// server without its users registering again.
+type RoomArchive struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
+	// The version of the archive format.
+	// See RoomArchiveVersion in the server for the current version.
+	Version uint32 `protobuf:"varint,1,opt,name=version,proto3" json:"version,omitempty"`
 	// The room's name.
-	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
-	// The new account's username.
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	// The new account's password, or empty to generate one.
-	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// The room's description.
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	// Whether the room is listed.
+	Listed bool `protobuf:"varint,4,opt,name=listed,proto3" json:"listed,omitempty"`
+	// The room's message of the day, or empty if there is none.
+	Motd string `protobuf:"bytes,5,opt,name=motd,proto3" json:"motd,omitempty"`
+	// The maximum number of clients that can be in the room at once, or 0 for unlimited.
+	MaxClients uint32 `protobuf:"varint,6,opt,name=max_clients,json=maxClients,proto3" json:"max_clients,omitempty"`
+	// The maximum number of proxied streams each client in the room can have open at once, or 0 for unlimited.
+	MaxProxyStreamsPerClient uint32 `protobuf:"varint,7,opt,name=max_proxy_streams_per_client,json=maxProxyStreamsPerClient,proto3" json:"max_proxy_streams_per_client,omitempty"`
+	// How long proxied directory listings are cached, in milliseconds, or 0 if caching is disabled.
+	DirCacheTtlMs uint32 `protobuf:"varint,8,opt,name=dir_cache_ttl_ms,json=dirCacheTtlMs,proto3" json:"dir_cache_ttl_ms,omitempty"`
+	// The room's accounts.
+	Accounts []*RoomArchive_Account `protobuf:"bytes,9,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	// The room's unused invite codes.
+	InviteCodes   []string `protobuf:"bytes,10,rep,name=invite_codes,json=inviteCodes,proto3" json:"invite_codes,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *CreateAccountRequest) Reset() {
-	*x = CreateAccountRequest{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[19]
+func (x *RoomArchive) Reset() {
+	*x = RoomArchive{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[83]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *CreateAccountRequest) String() string {
+func (x *RoomArchive) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateAccountRequest) ProtoMessage() {}
+func (*RoomArchive) ProtoMessage() {}
 
-func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[19]
+func (x *RoomArchive) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[83]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -915,111 +4549,104 @@ func (x *CreateAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateAccountRequest.ProtoReflect.Descriptor instead.
-func (*CreateAccountRequest) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{19}
+// Deprecated: Use RoomArchive.ProtoReflect.Descriptor instead.
+func (*RoomArchive) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{83}
 }
 
-func (x *CreateAccountRequest) GetRoom() string {
+func (x *RoomArchive) GetVersion() uint32 {
 	if x != nil {
-		return x.Room
+		return x.Version
 	}
-	return ""
+	return 0
 }
 
-func (x *CreateAccountRequest) GetUsername() string {
+func (x *RoomArchive) GetName() string {
 	if x != nil {
-		return x.Username
+		return x.Name
 	}
 	return ""
 }
 
-func (x *CreateAccountRequest) GetPassword() string {
+func (x *RoomArchive) GetDescription() string {
 	if x != nil {
-		return x.Password
+		return x.Description
 	}
 	return ""
 }
 
-type CreateAccountResponse struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The newly created account.
-	Account *AccountInfo `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
-	// The generated password, if applicable.
-	GeneratedPassword *string `protobuf:"bytes,2,opt,name=generated_password,json=generatedPassword,proto3,oneof" json:"generated_password,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+func (x *RoomArchive) GetListed() bool {
+	if x != nil {
+		return x.Listed
+	}
+	return false
 }
 
-func (x *CreateAccountResponse) Reset() {
-	*x = CreateAccountResponse{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[20]
-	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-	ms.StoreMessageInfo(mi)
+func (x *RoomArchive) GetMotd() string {
+	if x != nil {
+		return x.Motd
+	}
+	return ""
 }
 
-func (x *CreateAccountResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *RoomArchive) GetMaxClients() uint32 {
+	if x != nil {
+		return x.MaxClients
+	}
+	return 0
 }
 
-func (*CreateAccountResponse) ProtoMessage() {}
-
-func (x *CreateAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[20]
+func (x *RoomArchive) GetMaxProxyStreamsPerClient() uint32 {
 	if x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+		return x.MaxProxyStreamsPerClient
 	}
-	return mi.MessageOf(x)
+	return 0
 }
 
-// Deprecated: Use CreateAccountResponse.ProtoReflect.Descriptor instead.
-func (*CreateAccountResponse) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{20}
+func (x *RoomArchive) GetDirCacheTtlMs() uint32 {
+	if x != nil {
+		return x.DirCacheTtlMs
+	}
+	return 0
 }
 
-func (x *CreateAccountResponse) GetAccount() *AccountInfo {
+func (x *RoomArchive) GetAccounts() []*RoomArchive_Account {
 	if x != nil {
-		return x.Account
+		return x.Accounts
 	}
 	return nil
 }
 
-func (x *CreateAccountResponse) GetGeneratedPassword() string {
-	if x != nil && x.GeneratedPassword != nil {
-		return *x.GeneratedPassword
+func (x *RoomArchive) GetInviteCodes() []string {
+	if x != nil {
+		return x.InviteCodes
 	}
-	return ""
+	return nil
 }
 
-type DeleteAccountRequest struct {
+type ExportRoomRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The room's name.
-	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
-	// The account's username.
-	Username      string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The name of the room to export.
+	Name          string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAccountRequest) Reset() {
-	*x = DeleteAccountRequest{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[21]
+func (x *ExportRoomRequest) Reset() {
+	*x = ExportRoomRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[84]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAccountRequest) String() string {
+func (x *ExportRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountRequest) ProtoMessage() {}
+func (*ExportRoomRequest) ProtoMessage() {}
 
-func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[21]
+func (x *ExportRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[84]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1030,46 +4657,41 @@ func (x *DeleteAccountRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountRequest.ProtoReflect.Descriptor instead.
-func (*DeleteAccountRequest) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{21}
-}
-
-func (x *DeleteAccountRequest) GetRoom() string {
-	if x != nil {
-		return x.Room
-	}
-	return ""
+// Deprecated: Use ExportRoomRequest.ProtoReflect.Descriptor instead.
+func (*ExportRoomRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{84}
 }
 
-func (x *DeleteAccountRequest) GetUsername() string {
+func (x *ExportRoomRequest) GetName() string {
 	if x != nil {
-		return x.Username
+		return x.Name
 	}
 	return ""
 }
 
-type DeleteAccountResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+type ExportRoomResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's archive.
+	Archive       *RoomArchive `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *DeleteAccountResponse) Reset() {
-	*x = DeleteAccountResponse{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[22]
+func (x *ExportRoomResponse) Reset() {
+	*x = ExportRoomResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[85]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *DeleteAccountResponse) String() string {
+func (x *ExportRoomResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteAccountResponse) ProtoMessage() {}
+func (*ExportRoomResponse) ProtoMessage() {}
 
-func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[22]
+func (x *ExportRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[85]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1080,38 +4702,44 @@ func (x *DeleteAccountResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteAccountResponse.ProtoReflect.Descriptor instead.
-func (*DeleteAccountResponse) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{22}
+// Deprecated: Use ExportRoomResponse.ProtoReflect.Descriptor instead.
+func (*ExportRoomResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{85}
 }
 
-type UpdateAccountPasswordRequest struct {
+func (x *ExportRoomResponse) GetArchive() *RoomArchive {
+	if x != nil {
+		return x.Archive
+	}
+	return nil
+}
+
+type ImportRoomRequest struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The room's name.
-	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
-	// The account's username.
-	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
-	// The account's new password, or empty to generate one.
-	Password      string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// The archive to import.
+	Archive *RoomArchive `protobuf:"bytes,1,opt,name=archive,proto3" json:"archive,omitempty"`
+	// The name to give the imported room.
+	// If omitted, the name in the archive is used.
+	Name          *string `protobuf:"bytes,2,opt,name=name,proto3,oneof" json:"name,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAccountPasswordRequest) Reset() {
-	*x = UpdateAccountPasswordRequest{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[23]
+func (x *ImportRoomRequest) Reset() {
+	*x = ImportRoomRequest{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[86]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAccountPasswordRequest) String() string {
+func (x *ImportRoomRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAccountPasswordRequest) ProtoMessage() {}
+func (*ImportRoomRequest) ProtoMessage() {}
 
-func (x *UpdateAccountPasswordRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[23]
+func (x *ImportRoomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[86]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1122,55 +4750,48 @@ func (x *UpdateAccountPasswordRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAccountPasswordRequest.ProtoReflect.Descriptor instead.
-func (*UpdateAccountPasswordRequest) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{23}
-}
-
-func (x *UpdateAccountPasswordRequest) GetRoom() string {
-	if x != nil {
-		return x.Room
-	}
-	return ""
+// Deprecated: Use ImportRoomRequest.ProtoReflect.Descriptor instead.
+func (*ImportRoomRequest) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{86}
 }
 
-func (x *UpdateAccountPasswordRequest) GetUsername() string {
+func (x *ImportRoomRequest) GetArchive() *RoomArchive {
 	if x != nil {
-		return x.Username
+		return x.Archive
 	}
-	return ""
+	return nil
 }
 
-func (x *UpdateAccountPasswordRequest) GetPassword() string {
-	if x != nil {
-		return x.Password
+func (x *ImportRoomRequest) GetName() string {
+	if x != nil && x.Name != nil {
+		return *x.Name
 	}
 	return ""
 }
 
-type UpdateAccountPasswordResponse struct {
+type ImportRoomResponse struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The generated password, if applicable.
-	GeneratedPassword *string `protobuf:"bytes,1,opt,name=generated_password,json=generatedPassword,proto3,oneof" json:"generated_password,omitempty"`
-	unknownFields     protoimpl.UnknownFields
-	sizeCache         protoimpl.SizeCache
+	// The imported room.
+	Room          *RoomInfo `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *UpdateAccountPasswordResponse) Reset() {
-	*x = UpdateAccountPasswordResponse{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[24]
+func (x *ImportRoomResponse) Reset() {
+	*x = ImportRoomResponse{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[87]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *UpdateAccountPasswordResponse) String() string {
+func (x *ImportRoomResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateAccountPasswordResponse) ProtoMessage() {}
+func (*ImportRoomResponse) ProtoMessage() {}
 
-func (x *UpdateAccountPasswordResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[24]
+func (x *ImportRoomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[87]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1181,32 +4802,34 @@ func (x *UpdateAccountPasswordResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateAccountPasswordResponse.ProtoReflect.Descriptor instead.
-func (*UpdateAccountPasswordResponse) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use ImportRoomResponse.ProtoReflect.Descriptor instead.
+func (*ImportRoomResponse) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{87}
 }
 
-func (x *UpdateAccountPasswordResponse) GetGeneratedPassword() string {
-	if x != nil && x.GeneratedPassword != nil {
-		return *x.GeneratedPassword
+func (x *ImportRoomResponse) GetRoom() *RoomInfo {
+	if x != nil {
+		return x.Room
 	}
-	return ""
+	return nil
 }
 
 type GetServerInfoResponse_Rpc struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// A list of all allowed methods on the RPC interface.
+	// A list of all allowed methods on the RPC interface, including the ones allowed by its role.
 	// If all permissions are allowed, it will contain a single "*".
 	AllowedMethods []string `protobuf:"bytes,1,rep,name=allowed_methods,json=allowedMethods,proto3" json:"allowed_methods,omitempty"`
 	// Whether the RPC interface requires a bearer token.
 	RequiresBearerToken bool `protobuf:"varint,2,opt,name=requires_bearer_token,json=requiresBearerToken,proto3" json:"requires_bearer_token,omitempty"`
-	unknownFields       protoimpl.UnknownFields
-	sizeCache           protoimpl.SizeCache
+	// The role of the RPC interface, such as "viewer", "operator" or "admin", or empty if it has none.
+	Role          string `protobuf:"bytes,3,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
 func (x *GetServerInfoResponse_Rpc) Reset() {
 	*x = GetServerInfoResponse_Rpc{}
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[25]
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[88]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1218,7 +4841,7 @@ func (x *GetServerInfoResponse_Rpc) String() string {
 func (*GetServerInfoResponse_Rpc) ProtoMessage() {}
 
 func (x *GetServerInfoResponse_Rpc) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[25]
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[88]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1231,7 +4854,7 @@ func (x *GetServerInfoResponse_Rpc) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetServerInfoResponse_Rpc.ProtoReflect.Descriptor instead.
 func (*GetServerInfoResponse_Rpc) Descriptor() ([]byte, []int) {
-	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{4, 0}
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{8, 0}
 }
 
 func (x *GetServerInfoResponse_Rpc) GetAllowedMethods() []string {
@@ -1248,56 +4871,223 @@ func (x *GetServerInfoResponse_Rpc) GetRequiresBearerToken() bool {
 	return false
 }
 
+func (x *GetServerInfoResponse_Rpc) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
+// An account in the room.
+type RoomArchive_Account struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The account's username.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The account's password hash.
+	// Users keep their passwords when the room is imported.
+	PasswordHash string `protobuf:"bytes,2,opt,name=password_hash,json=passwordHash,proto3" json:"password_hash,omitempty"`
+	// Whether the account is a guest account.
+	IsGuest       bool `protobuf:"varint,3,opt,name=is_guest,json=isGuest,proto3" json:"is_guest,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoomArchive_Account) Reset() {
+	*x = RoomArchive_Account{}
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[89]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomArchive_Account) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomArchive_Account) ProtoMessage() {}
+
+func (x *RoomArchive_Account) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_serverrpc_v1_rpc_proto_msgTypes[89]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomArchive_Account.ProtoReflect.Descriptor instead.
+func (*RoomArchive_Account) Descriptor() ([]byte, []int) {
+	return file_pb_serverrpc_v1_rpc_proto_rawDescGZIP(), []int{83, 0}
+}
+
+func (x *RoomArchive_Account) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *RoomArchive_Account) GetPasswordHash() string {
+	if x != nil {
+		return x.PasswordHash
+	}
+	return ""
+}
+
+func (x *RoomArchive_Account) GetIsGuest() bool {
+	if x != nil {
+		return x.IsGuest
+	}
+	return false
+}
+
 var File_pb_serverrpc_v1_rpc_proto protoreflect.FileDescriptor
 
 const file_pb_serverrpc_v1_rpc_proto_rawDesc = "" +
 	"\n" +
-	"\x19pb/serverrpc/v1/rpc.proto\x12\x0fpb.serverrpc.v1\"J\n" +
+	"\x19pb/serverrpc/v1/rpc.proto\x12\x0fpb.serverrpc.v1\"\xc1\x02\n" +
 	"\bRoomInfo\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12*\n" +
-	"\x11online_user_count\x18\x02 \x01(\rR\x0fonlineUserCount\",\n" +
+	"\x11online_user_count\x18\x02 \x01(\rR\x0fonlineUserCount\x12\x1f\n" +
+	"\vmax_clients\x18\x03 \x01(\rR\n" +
+	"maxClients\x12>\n" +
+	"\x1cmax_proxy_streams_per_client\x18\x04 \x01(\rR\x18maxProxyStreamsPerClient\x12'\n" +
+	"\x10dir_cache_ttl_ms\x18\x05 \x01(\rR\rdirCacheTtlMs\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x06 \x01(\x03R\tcreatedTs\x12 \n" +
+	"\vdescription\x18\a \x01(\tR\vdescription\x12\x16\n" +
+	"\x06listed\x18\b \x01(\bR\x06listed\x12\x12\n" +
+	"\x04motd\x18\t \x01(\tR\x04motd\"\xb3\x01\n" +
 	"\x0eOnlineUserInfo\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\")\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12+\n" +
+	"\x03rtt\x18\x02 \x01(\v2\x19.pb.serverrpc.v1.RttStatsR\x03rtt\x12#\n" +
+	"\rrelayed_bytes\x18\x03 \x01(\x03R\frelayedBytes\x123\n" +
+	"\x16relay_bytes_per_second\x18\x04 \x01(\x03R\x13relayBytesPerSecond\"\x82\x02\n" +
+	"\bRttStats\x12\x17\n" +
+	"\alast_us\x18\x01 \x01(\x03R\x06lastUs\x12\x15\n" +
+	"\x06min_us\x18\x02 \x01(\x03R\x05minUs\x12\x15\n" +
+	"\x06avg_us\x18\x03 \x01(\x03R\x05avgUs\x12\x15\n" +
+	"\x06max_us\x18\x04 \x01(\x03R\x05maxUs\x12\x18\n" +
+	"\asamples\x18\x05 \x01(\rR\asamples\x12\x12\n" +
+	"\x04lost\x18\x06 \x01(\x04R\x04lost\x12)\n" +
+	"\x10consecutive_lost\x18\a \x01(\rR\x0fconsecutiveLost\x12+\n" +
+	"\x0fclock_offset_us\x18\b \x01(\x03H\x00R\rclockOffsetUs\x88\x01\x01B\x12\n" +
+	"\x10_clock_offset_us\"C\n" +
+	"\x0eInviteCodeInfo\x12\x12\n" +
+	"\x04code\x18\x01 \x01(\tR\x04code\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\x02 \x01(\x03R\tcreatedTs\"\xf1\x01\n" +
+	"\n" +
+	"StreamInfo\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\tR\x02id\x12\x12\n" +
+	"\x04room\x18\x02 \x01(\tR\x04room\x12'\n" +
+	"\x0forigin_username\x18\x03 \x01(\tR\x0eoriginUsername\x12'\n" +
+	"\x0ftarget_username\x18\x04 \x01(\tR\x0etargetUsername\x12&\n" +
+	"\x0fbytes_to_target\x18\x05 \x01(\x03R\rbytesToTarget\x12&\n" +
+	"\x0fbytes_to_origin\x18\x06 \x01(\x03R\rbytesToOrigin\x12\x1d\n" +
+	"\n" +
+	"created_ts\x18\a \x01(\x03R\tcreatedTs\"f\n" +
+	"\bRoomStat\x12\x0e\n" +
+	"\x02ts\x18\x01 \x01(\x03R\x02ts\x12%\n" +
+	"\x0eonline_clients\x18\x02 \x01(\rR\ronlineClients\x12#\n" +
+	"\rrelayed_bytes\x18\x03 \x01(\x03R\frelayedBytes\"D\n" +
 	"\vAccountInfo\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\"\x16\n" +
-	"\x14GetServerInfoRequest\"\xd3\x01\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x19\n" +
+	"\bis_guest\x18\x02 \x01(\bR\aisGuest\"\x16\n" +
+	"\x14GetServerInfoRequest\"\xaf\x03\n" +
 	"\x15GetServerInfoResponse\x12\x18\n" +
 	"\aversion\x18\x01 \x01(\tR\aversion\x12<\n" +
-	"\x03rpc\x18\x02 \x01(\v2*.pb.serverrpc.v1.GetServerInfoResponse.RpcR\x03rpc\x1ab\n" +
+	"\x03rpc\x18\x02 \x01(\v2*.pb.serverrpc.v1.GetServerInfoResponse.RpcR\x03rpc\x12\x1a\n" +
+	"\bdraining\x18\x03 \x01(\bR\bdraining\x12)\n" +
+	"\x10protocol_version\x18\x04 \x01(\tR\x0fprotocolVersion\x12!\n" +
+	"\fbuild_commit\x18\x05 \x01(\tR\vbuildCommit\x12\x19\n" +
+	"\bstart_ts\x18\x06 \x01(\x03R\astartTs\x12%\n" +
+	"\x0euptime_seconds\x18\a \x01(\x03R\ruptimeSeconds\x12\x1a\n" +
+	"\bfeatures\x18\b \x03(\tR\bfeatures\x1av\n" +
 	"\x03Rpc\x12'\n" +
 	"\x0fallowed_methods\x18\x01 \x03(\tR\x0eallowedMethods\x122\n" +
-	"\x15requires_bearer_token\x18\x02 \x01(\bR\x13requiresBearerToken\"\x11\n" +
-	"\x0fGetRoomsRequest\"C\n" +
+	"\x15requires_bearer_token\x18\x02 \x01(\bR\x13requiresBearerToken\x12\x12\n" +
+	"\x04role\x18\x03 \x01(\tR\x04role\"<\n" +
+	"\x0fGetRoomsRequest\x12)\n" +
+	"\x10include_unlisted\x18\x01 \x01(\bR\x0fincludeUnlisted\"C\n" +
 	"\x10GetRoomsResponse\x12/\n" +
 	"\x05rooms\x18\x01 \x03(\v2\x19.pb.serverrpc.v1.RoomInfoR\x05rooms\"(\n" +
 	"\x12GetRoomInfoRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"D\n" +
 	"\x13GetRoomInfoResponse\x12-\n" +
-	"\x04room\x18\x01 \x01(\v2\x19.pb.serverrpc.v1.RoomInfoR\x04room\"+\n" +
+	"\x04room\x18\x01 \x01(\v2\x19.pb.serverrpc.v1.RoomInfoR\x04room\"H\n" +
 	"\x15GetOnlineUsersRequest\x12\x12\n" +
-	"\x04room\x18\x01 \x01(\tR\x04room\"O\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\"O\n" +
 	"\x16GetOnlineUsersResponse\x125\n" +
 	"\x05users\x18\x01 \x03(\v2\x1f.pb.serverrpc.v1.OnlineUserInfoR\x05users\"J\n" +
 	"\x18GetOnlineUserInfoRequest\x12\x12\n" +
 	"\x04room\x18\x01 \x01(\tR\x04room\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\"P\n" +
 	"\x19GetOnlineUserInfoResponse\x123\n" +
-	"\x04user\x18\x01 \x01(\v2\x1f.pb.serverrpc.v1.OnlineUserInfoR\x04user\"(\n" +
+	"\x04user\x18\x01 \x01(\v2\x1f.pb.serverrpc.v1.OnlineUserInfoR\x04user\"V\n" +
 	"\x12GetAccountsRequest\x12\x12\n" +
-	"\x04room\x18\x01 \x01(\tR\x04room\"O\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12\x14\n" +
+	"\x05limit\x18\x02 \x01(\rR\x05limit\x12\x16\n" +
+	"\x06cursor\x18\x03 \x01(\tR\x06cursor\"\x86\x01\n" +
 	"\x13GetAccountsResponse\x128\n" +
-	"\baccounts\x18\x01 \x03(\v2\x1c.pb.serverrpc.v1.AccountInfoR\baccounts\"'\n" +
+	"\baccounts\x18\x01 \x03(\v2\x1c.pb.serverrpc.v1.AccountInfoR\baccounts\x12\x1f\n" +
+	"\vnext_cursor\x18\x02 \x01(\tR\n" +
+	"nextCursor\x12\x14\n" +
+	"\x05total\x18\x03 \x01(\rR\x05total\"q\n" +
 	"\x11CreateRoomRequest\x12\x12\n" +
-	"\x04name\x18\x01 \x01(\tR\x04name\"C\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x1b\n" +
+	"\x06listed\x18\x03 \x01(\bH\x00R\x06listed\x88\x01\x01B\t\n" +
+	"\a_listed\"C\n" +
 	"\x12CreateRoomResponse\x12-\n" +
 	"\x04room\x18\x01 \x01(\v2\x19.pb.serverrpc.v1.RoomInfoR\x04room\"'\n" +
 	"\x11DeleteRoomRequest\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\"\x14\n" +
-	"\x12DeleteRoomResponse\"b\n" +
+	"\x12DeleteRoomResponse\"\x8b\x01\n" +
+	"\x14SetRoomLimitsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x1f\n" +
+	"\vmax_clients\x18\x02 \x01(\rR\n" +
+	"maxClients\x12>\n" +
+	"\x1cmax_proxy_streams_per_client\x18\x03 \x01(\rR\x18maxProxyStreamsPerClient\"F\n" +
+	"\x15SetRoomLimitsResponse\x12-\n" +
+	"\x04room\x18\x01 \x01(\v2\x19.pb.serverrpc.v1.RoomInfoR\x04room\"F\n" +
+	"\x19SetRoomDirCacheTtlRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
+	"\x06ttl_ms\x18\x02 \x01(\rR\x05ttlMs\"K\n" +
+	"\x1aSetRoomDirCacheTtlResponse\x12-\n" +
+	"\x04room\x18\x01 \x01(\v2\x19.pb.serverrpc.v1.RoomInfoR\x04room\"f\n" +
+	"\x16SetRoomMetadataRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x02 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06listed\x18\x03 \x01(\bR\x06listed\"H\n" +
+	"\x17SetRoomMetadataResponse\x12-\n" +
+	"\x04room\x18\x01 \x01(\v2\x19.pb.serverrpc.v1.RoomInfoR\x04room\"<\n" +
+	"\x12SetRoomMotdRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x12\n" +
+	"\x04motd\x18\x02 \x01(\tR\x04motd\"D\n" +
+	"\x13SetRoomMotdResponse\x12-\n" +
+	"\x04room\x18\x01 \x01(\v2\x19.pb.serverrpc.v1.RoomInfoR\x04room\"&\n" +
+	"\x10CloseRoomRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"K\n" +
+	"\x11CloseRoomResponse\x126\n" +
+	"\x17disconnected_user_count\x18\x01 \x01(\rR\x15disconnectedUserCount\"A\n" +
+	"\x0fKickUserRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\"\x12\n" +
+	"\x10KickUserResponse\"A\n" +
+	"\x17BroadcastMessageRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12\x12\n" +
+	"\x04text\x18\x02 \x01(\tR\x04text\"C\n" +
+	"\x18BroadcastMessageResponse\x12'\n" +
+	"\x0frecipient_count\x18\x01 \x01(\rR\x0erecipientCount\"}\n" +
 	"\x14CreateAccountRequest\x12\x12\n" +
 	"\x04room\x18\x01 \x01(\tR\x04room\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"\x9a\x01\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12\x19\n" +
+	"\bis_guest\x18\x04 \x01(\bR\aisGuest\"\x9a\x01\n" +
 	"\x15CreateAccountResponse\x126\n" +
 	"\aaccount\x18\x01 \x01(\v2\x1c.pb.serverrpc.v1.AccountInfoR\aaccount\x122\n" +
 	"\x12generated_password\x18\x02 \x01(\tH\x00R\x11generatedPassword\x88\x01\x01B\x15\n" +
@@ -1312,7 +5102,151 @@ const file_pb_serverrpc_v1_rpc_proto_rawDesc = "" +
 	"\bpassword\x18\x03 \x01(\tR\bpassword\"j\n" +
 	"\x1dUpdateAccountPasswordResponse\x122\n" +
 	"\x12generated_password\x18\x01 \x01(\tH\x00R\x11generatedPassword\x88\x01\x01B\x15\n" +
-	"\x13_generated_password2\xc4\b\n" +
+	"\x13_generated_password\"-\n" +
+	"\x17CreateInviteCodeRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\"\\\n" +
+	"\x18CreateInviteCodeResponse\x12@\n" +
+	"\vinvite_code\x18\x01 \x01(\v2\x1f.pb.serverrpc.v1.InviteCodeInfoR\n" +
+	"inviteCode\"+\n" +
+	"\x15GetInviteCodesRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\"\\\n" +
+	"\x16GetInviteCodesResponse\x12B\n" +
+	"\finvite_codes\x18\x01 \x03(\v2\x1f.pb.serverrpc.v1.InviteCodeInfoR\vinviteCodes\"A\n" +
+	"\x17DeleteInviteCodeRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12\x12\n" +
+	"\x04code\x18\x02 \x01(\tR\x04code\"\x1a\n" +
+	"\x18DeleteInviteCodeResponse\"w\n" +
+	"\x19CreateInviteBundleRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12,\n" +
+	"\x12create_invite_code\x18\x03 \x01(\bR\x10createInviteCode\"\x85\x01\n" +
+	"\x1aCreateInviteBundleResponse\x12\x10\n" +
+	"\x03url\x18\x01 \x01(\tR\x03url\x12E\n" +
+	"\vinvite_code\x18\x02 \x01(\v2\x1f.pb.serverrpc.v1.InviteCodeInfoH\x00R\n" +
+	"inviteCode\x88\x01\x01B\x0e\n" +
+	"\f_invite_code\"c\n" +
+	"\x16SetAccountGuestRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x19\n" +
+	"\bis_guest\x18\x03 \x01(\bR\aisGuest\"\x19\n" +
+	"\x17SetAccountGuestResponse\"(\n" +
+	"\x12ListStreamsRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\"L\n" +
+	"\x13ListStreamsResponse\x125\n" +
+	"\astreams\x18\x01 \x03(\v2\x1b.pb.serverrpc.v1.StreamInfoR\astreams\"9\n" +
+	"\x13CancelStreamRequest\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12\x0e\n" +
+	"\x02id\x18\x02 \x01(\tR\x02id\"\x16\n" +
+	"\x14CancelStreamResponse\"v\n" +
+	"\rMigrationInfo\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aapplied\x18\x02 \x01(\bR\aapplied\x12\x1d\n" +
+	"\n" +
+	"applied_ts\x18\x03 \x01(\x03R\tappliedTs\x12\x18\n" +
+	"\aunknown\x18\x04 \x01(\bR\aunknown\"\x1b\n" +
+	"\x19GetMigrationStatusRequest\"\\\n" +
+	"\x1aGetMigrationStatusResponse\x12>\n" +
+	"\n" +
+	"migrations\x18\x01 \x03(\v2\x1e.pb.serverrpc.v1.MigrationInfoR\n" +
+	"migrations\"+\n" +
+	"\x15BackupDatabaseRequest\x12\x12\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\"\x18\n" +
+	"\x16BackupDatabaseResponse\"\x1f\n" +
+	"\x1dCheckDatabaseIntegrityRequest\"<\n" +
+	"\x1eCheckDatabaseIntegrityResponse\x12\x1a\n" +
+	"\bproblems\x18\x01 \x03(\tR\bproblems\"\xa1\x01\n" +
+	"\x10RelayLimitWindow\x12\x1a\n" +
+	"\bweekdays\x18\x01 \x01(\rR\bweekdays\x12!\n" +
+	"\fstart_minute\x18\x02 \x01(\rR\vstartMinute\x12\x1d\n" +
+	"\n" +
+	"end_minute\x18\x03 \x01(\rR\tendMinute\x12/\n" +
+	"\x14max_bytes_per_second\x18\x04 \x01(\x04R\x11maxBytesPerSecond\"\x17\n" +
+	"\x15GetRelayLimitsRequest\"\xc8\x01\n" +
+	"\x16GetRelayLimitsResponse\x12/\n" +
+	"\x14max_bytes_per_second\x18\x01 \x01(\x04R\x11maxBytesPerSecond\x12=\n" +
+	"\bschedule\x18\x02 \x03(\v2!.pb.serverrpc.v1.RelayLimitWindowR\bschedule\x12>\n" +
+	"\x1ccurrent_max_bytes_per_second\x18\x03 \x01(\x04R\x18currentMaxBytesPerSecond\"\x87\x01\n" +
+	"\x15SetRelayLimitsRequest\x12/\n" +
+	"\x14max_bytes_per_second\x18\x01 \x01(\x04R\x11maxBytesPerSecond\x12=\n" +
+	"\bschedule\x18\x02 \x03(\v2!.pb.serverrpc.v1.RelayLimitWindowR\bschedule\"\x18\n" +
+	"\x16SetRelayLimitsResponse\"\x80\x02\n" +
+	"\rLobbySettings\x12'\n" +
+	"\x0ftimeout_seconds\x18\x01 \x01(\rR\x0etimeoutSeconds\x120\n" +
+	"\x14min_protocol_version\x18\x02 \x01(\tR\x12minProtocolVersion\x120\n" +
+	"\x14max_protocol_version\x18\x03 \x01(\tR\x12maxProtocolVersion\x12%\n" +
+	"\x0emax_concurrent\x18\x04 \x01(\rR\rmaxConcurrent\x12;\n" +
+	"\x1bmax_conns_per_ip_per_minute\x18\x05 \x01(\rR\x16maxConnsPerIpPerMinute\"\x19\n" +
+	"\x17GetLobbySettingsRequest\"V\n" +
+	"\x18GetLobbySettingsResponse\x12:\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1e.pb.serverrpc.v1.LobbySettingsR\bsettings\"\x9f\x03\n" +
+	"\x1aUpdateLobbySettingsRequest\x12,\n" +
+	"\x0ftimeout_seconds\x18\x01 \x01(\rH\x00R\x0etimeoutSeconds\x88\x01\x01\x125\n" +
+	"\x14min_protocol_version\x18\x02 \x01(\tH\x01R\x12minProtocolVersion\x88\x01\x01\x125\n" +
+	"\x14max_protocol_version\x18\x03 \x01(\tH\x02R\x12maxProtocolVersion\x88\x01\x01\x12*\n" +
+	"\x0emax_concurrent\x18\x04 \x01(\rH\x03R\rmaxConcurrent\x88\x01\x01\x12@\n" +
+	"\x1bmax_conns_per_ip_per_minute\x18\x05 \x01(\rH\x04R\x16maxConnsPerIpPerMinute\x88\x01\x01B\x12\n" +
+	"\x10_timeout_secondsB\x17\n" +
+	"\x15_min_protocol_versionB\x17\n" +
+	"\x15_max_protocol_versionB\x11\n" +
+	"\x0f_max_concurrentB\x1e\n" +
+	"\x1c_max_conns_per_ip_per_minute\"Y\n" +
+	"\x1bUpdateLobbySettingsResponse\x12:\n" +
+	"\bsettings\x18\x01 \x01(\v2\x1e.pb.serverrpc.v1.LobbySettingsR\bsettings\"\x16\n" +
+	"\x14GetLobbyStatsRequest\"\xd9\x01\n" +
+	"\x15GetLobbyStatsResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\x04R\baccepted\x12+\n" +
+	"\x11rejected_draining\x18\x02 \x01(\x04R\x10rejectedDraining\x122\n" +
+	"\x15rejected_rate_limited\x18\x03 \x01(\x04R\x13rejectedRateLimited\x12#\n" +
+	"\rrejected_busy\x18\x04 \x01(\x04R\frejectedBusy\x12\x1e\n" +
+	"\n" +
+	"onboarding\x18\x05 \x01(\rR\n" +
+	"onboarding\"W\n" +
+	"\x13GetRoomStatsRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x17\n" +
+	"\afrom_ts\x18\x02 \x01(\x03R\x06fromTs\x12\x13\n" +
+	"\x05to_ts\x18\x03 \x01(\x03R\x04toTs\"G\n" +
+	"\x14GetRoomStatsResponse\x12/\n" +
+	"\x05stats\x18\x01 \x03(\v2\x19.pb.serverrpc.v1.RoomStatR\x05stats\"\x0e\n" +
+	"\fDrainRequest\"]\n" +
+	"\rDrainResponse\x12%\n" +
+	"\x0eactive_streams\x18\x01 \x01(\rR\ractiveStreams\x12%\n" +
+	"\x0eonline_clients\x18\x02 \x01(\rR\ronlineClients\"Y\n" +
+	"\rConfigProblem\x12\x14\n" +
+	"\x05field\x18\x01 \x01(\tR\x05field\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\x12\x18\n" +
+	"\awarning\x18\x03 \x01(\bR\awarning\"8\n" +
+	"\x15ValidateConfigRequest\x12\x1f\n" +
+	"\vconfig_json\x18\x01 \x01(\tR\n" +
+	"configJson\"T\n" +
+	"\x16ValidateConfigResponse\x12:\n" +
+	"\bproblems\x18\x01 \x03(\v2\x1e.pb.serverrpc.v1.ConfigProblemR\bproblems\"\xdf\x03\n" +
+	"\vRoomArchive\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\rR\aversion\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x16\n" +
+	"\x06listed\x18\x04 \x01(\bR\x06listed\x12\x12\n" +
+	"\x04motd\x18\x05 \x01(\tR\x04motd\x12\x1f\n" +
+	"\vmax_clients\x18\x06 \x01(\rR\n" +
+	"maxClients\x12>\n" +
+	"\x1cmax_proxy_streams_per_client\x18\a \x01(\rR\x18maxProxyStreamsPerClient\x12'\n" +
+	"\x10dir_cache_ttl_ms\x18\b \x01(\rR\rdirCacheTtlMs\x12@\n" +
+	"\baccounts\x18\t \x03(\v2$.pb.serverrpc.v1.RoomArchive.AccountR\baccounts\x12!\n" +
+	"\finvite_codes\x18\n" +
+	" \x03(\tR\vinviteCodes\x1ae\n" +
+	"\aAccount\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12#\n" +
+	"\rpassword_hash\x18\x02 \x01(\tR\fpasswordHash\x12\x19\n" +
+	"\bis_guest\x18\x03 \x01(\bR\aisGuest\"'\n" +
+	"\x11ExportRoomRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\"L\n" +
+	"\x12ExportRoomResponse\x126\n" +
+	"\aarchive\x18\x01 \x01(\v2\x1c.pb.serverrpc.v1.RoomArchiveR\aarchive\"m\n" +
+	"\x11ImportRoomRequest\x126\n" +
+	"\aarchive\x18\x01 \x01(\v2\x1c.pb.serverrpc.v1.RoomArchiveR\aarchive\x12\x17\n" +
+	"\x04name\x18\x02 \x01(\tH\x00R\x04name\x88\x01\x01B\a\n" +
+	"\x05_name\"C\n" +
+	"\x12ImportRoomResponse\x12-\n" +
+	"\x04room\x18\x01 \x01(\v2\x19.pb.serverrpc.v1.RoomInfoR\x04room2\xde\x1d\n" +
 	"\x10ServerRpcService\x12`\n" +
 	"\rGetServerInfo\x12%.pb.serverrpc.v1.GetServerInfoRequest\x1a&.pb.serverrpc.v1.GetServerInfoResponse\"\x00\x12Q\n" +
 	"\bGetRooms\x12 .pb.serverrpc.v1.GetRoomsRequest\x1a!.pb.serverrpc.v1.GetRoomsResponse\"\x00\x12Z\n" +
@@ -1324,9 +5258,38 @@ const file_pb_serverrpc_v1_rpc_proto_rawDesc = "" +
 	"CreateRoom\x12\".pb.serverrpc.v1.CreateRoomRequest\x1a#.pb.serverrpc.v1.CreateRoomResponse\"\x00\x12W\n" +
 	"\n" +
 	"DeleteRoom\x12\".pb.serverrpc.v1.DeleteRoomRequest\x1a#.pb.serverrpc.v1.DeleteRoomResponse\"\x00\x12`\n" +
+	"\rSetRoomLimits\x12%.pb.serverrpc.v1.SetRoomLimitsRequest\x1a&.pb.serverrpc.v1.SetRoomLimitsResponse\"\x00\x12o\n" +
+	"\x12SetRoomDirCacheTtl\x12*.pb.serverrpc.v1.SetRoomDirCacheTtlRequest\x1a+.pb.serverrpc.v1.SetRoomDirCacheTtlResponse\"\x00\x12f\n" +
+	"\x0fSetRoomMetadata\x12'.pb.serverrpc.v1.SetRoomMetadataRequest\x1a(.pb.serverrpc.v1.SetRoomMetadataResponse\"\x00\x12Z\n" +
+	"\vSetRoomMotd\x12#.pb.serverrpc.v1.SetRoomMotdRequest\x1a$.pb.serverrpc.v1.SetRoomMotdResponse\"\x00\x12T\n" +
+	"\tCloseRoom\x12!.pb.serverrpc.v1.CloseRoomRequest\x1a\".pb.serverrpc.v1.CloseRoomResponse\"\x00\x12Q\n" +
+	"\bKickUser\x12 .pb.serverrpc.v1.KickUserRequest\x1a!.pb.serverrpc.v1.KickUserResponse\"\x00\x12i\n" +
+	"\x10BroadcastMessage\x12(.pb.serverrpc.v1.BroadcastMessageRequest\x1a).pb.serverrpc.v1.BroadcastMessageResponse\"\x00\x12`\n" +
 	"\rCreateAccount\x12%.pb.serverrpc.v1.CreateAccountRequest\x1a&.pb.serverrpc.v1.CreateAccountResponse\"\x00\x12`\n" +
 	"\rDeleteAccount\x12%.pb.serverrpc.v1.DeleteAccountRequest\x1a&.pb.serverrpc.v1.DeleteAccountResponse\"\x00\x12x\n" +
-	"\x15UpdateAccountPassword\x12-.pb.serverrpc.v1.UpdateAccountPasswordRequest\x1a..pb.serverrpc.v1.UpdateAccountPasswordResponse\"\x00B\xb1\x01\n" +
+	"\x15UpdateAccountPassword\x12-.pb.serverrpc.v1.UpdateAccountPasswordRequest\x1a..pb.serverrpc.v1.UpdateAccountPasswordResponse\"\x00\x12f\n" +
+	"\x0fSetAccountGuest\x12'.pb.serverrpc.v1.SetAccountGuestRequest\x1a(.pb.serverrpc.v1.SetAccountGuestResponse\"\x00\x12i\n" +
+	"\x10CreateInviteCode\x12(.pb.serverrpc.v1.CreateInviteCodeRequest\x1a).pb.serverrpc.v1.CreateInviteCodeResponse\"\x00\x12c\n" +
+	"\x0eGetInviteCodes\x12&.pb.serverrpc.v1.GetInviteCodesRequest\x1a'.pb.serverrpc.v1.GetInviteCodesResponse\"\x00\x12i\n" +
+	"\x10DeleteInviteCode\x12(.pb.serverrpc.v1.DeleteInviteCodeRequest\x1a).pb.serverrpc.v1.DeleteInviteCodeResponse\"\x00\x12o\n" +
+	"\x12CreateInviteBundle\x12*.pb.serverrpc.v1.CreateInviteBundleRequest\x1a+.pb.serverrpc.v1.CreateInviteBundleResponse\"\x00\x12Z\n" +
+	"\vListStreams\x12#.pb.serverrpc.v1.ListStreamsRequest\x1a$.pb.serverrpc.v1.ListStreamsResponse\"\x00\x12]\n" +
+	"\fCancelStream\x12$.pb.serverrpc.v1.CancelStreamRequest\x1a%.pb.serverrpc.v1.CancelStreamResponse\"\x00\x12o\n" +
+	"\x12GetMigrationStatus\x12*.pb.serverrpc.v1.GetMigrationStatusRequest\x1a+.pb.serverrpc.v1.GetMigrationStatusResponse\"\x00\x12c\n" +
+	"\x0eBackupDatabase\x12&.pb.serverrpc.v1.BackupDatabaseRequest\x1a'.pb.serverrpc.v1.BackupDatabaseResponse\"\x00\x12{\n" +
+	"\x16CheckDatabaseIntegrity\x12..pb.serverrpc.v1.CheckDatabaseIntegrityRequest\x1a/.pb.serverrpc.v1.CheckDatabaseIntegrityResponse\"\x00\x12c\n" +
+	"\x0eGetRelayLimits\x12&.pb.serverrpc.v1.GetRelayLimitsRequest\x1a'.pb.serverrpc.v1.GetRelayLimitsResponse\"\x00\x12c\n" +
+	"\x0eSetRelayLimits\x12&.pb.serverrpc.v1.SetRelayLimitsRequest\x1a'.pb.serverrpc.v1.SetRelayLimitsResponse\"\x00\x12i\n" +
+	"\x10GetLobbySettings\x12(.pb.serverrpc.v1.GetLobbySettingsRequest\x1a).pb.serverrpc.v1.GetLobbySettingsResponse\"\x00\x12r\n" +
+	"\x13UpdateLobbySettings\x12+.pb.serverrpc.v1.UpdateLobbySettingsRequest\x1a,.pb.serverrpc.v1.UpdateLobbySettingsResponse\"\x00\x12`\n" +
+	"\rGetLobbyStats\x12%.pb.serverrpc.v1.GetLobbyStatsRequest\x1a&.pb.serverrpc.v1.GetLobbyStatsResponse\"\x00\x12]\n" +
+	"\fGetRoomStats\x12$.pb.serverrpc.v1.GetRoomStatsRequest\x1a%.pb.serverrpc.v1.GetRoomStatsResponse\"\x00\x12J\n" +
+	"\x05Drain\x12\x1d.pb.serverrpc.v1.DrainRequest\x1a\x1e.pb.serverrpc.v1.DrainResponse\"\x000\x01\x12c\n" +
+	"\x0eValidateConfig\x12&.pb.serverrpc.v1.ValidateConfigRequest\x1a'.pb.serverrpc.v1.ValidateConfigResponse\"\x00\x12W\n" +
+	"\n" +
+	"ExportRoom\x12\".pb.serverrpc.v1.ExportRoomRequest\x1a#.pb.serverrpc.v1.ExportRoomResponse\"\x00\x12W\n" +
+	"\n" +
+	"ImportRoom\x12\".pb.serverrpc.v1.ImportRoomRequest\x1a#.pb.serverrpc.v1.ImportRoomResponse\"\x00B\xb1\x01\n" +
 	"\x13com.pb.serverrpc.v1B\bRpcProtoP\x01Z2friendnet.org/protocol/pb/serverrpc/v1;serverrpcv1\xa2\x02\x03PSX\xaa\x02\x0fPb.Serverrpc.V1\xca\x02\x0fPb\\Serverrpc\\V1\xe2\x02\x1bPb\\Serverrpc\\V1\\GPBMetadata\xea\x02\x11Pb::Serverrpc::V1b\x06proto3"
 
 var (
@@ -1341,71 +5304,209 @@ func file_pb_serverrpc_v1_rpc_proto_rawDescGZIP() []byte {
 	return file_pb_serverrpc_v1_rpc_proto_rawDescData
 }
 
-var file_pb_serverrpc_v1_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 26)
+var file_pb_serverrpc_v1_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 90)
 var file_pb_serverrpc_v1_rpc_proto_goTypes = []any{
-	(*RoomInfo)(nil),                      // 0: pb.serverrpc.v1.RoomInfo
-	(*OnlineUserInfo)(nil),                // 1: pb.serverrpc.v1.OnlineUserInfo
-	(*AccountInfo)(nil),                   // 2: pb.serverrpc.v1.AccountInfo
-	(*GetServerInfoRequest)(nil),          // 3: pb.serverrpc.v1.GetServerInfoRequest
-	(*GetServerInfoResponse)(nil),         // 4: pb.serverrpc.v1.GetServerInfoResponse
-	(*GetRoomsRequest)(nil),               // 5: pb.serverrpc.v1.GetRoomsRequest
-	(*GetRoomsResponse)(nil),              // 6: pb.serverrpc.v1.GetRoomsResponse
-	(*GetRoomInfoRequest)(nil),            // 7: pb.serverrpc.v1.GetRoomInfoRequest
-	(*GetRoomInfoResponse)(nil),           // 8: pb.serverrpc.v1.GetRoomInfoResponse
-	(*GetOnlineUsersRequest)(nil),         // 9: pb.serverrpc.v1.GetOnlineUsersRequest
-	(*GetOnlineUsersResponse)(nil),        // 10: pb.serverrpc.v1.GetOnlineUsersResponse
-	(*GetOnlineUserInfoRequest)(nil),      // 11: pb.serverrpc.v1.GetOnlineUserInfoRequest
-	(*GetOnlineUserInfoResponse)(nil),     // 12: pb.serverrpc.v1.GetOnlineUserInfoResponse
-	(*GetAccountsRequest)(nil),            // 13: pb.serverrpc.v1.GetAccountsRequest
-	(*GetAccountsResponse)(nil),           // 14: pb.serverrpc.v1.GetAccountsResponse
-	(*CreateRoomRequest)(nil),             // 15: pb.serverrpc.v1.CreateRoomRequest
-	(*CreateRoomResponse)(nil),            // 16: pb.serverrpc.v1.CreateRoomResponse
-	(*DeleteRoomRequest)(nil),             // 17: pb.serverrpc.v1.DeleteRoomRequest
-	(*DeleteRoomResponse)(nil),            // 18: pb.serverrpc.v1.DeleteRoomResponse
-	(*CreateAccountRequest)(nil),          // 19: pb.serverrpc.v1.CreateAccountRequest
-	(*CreateAccountResponse)(nil),         // 20: pb.serverrpc.v1.CreateAccountResponse
-	(*DeleteAccountRequest)(nil),          // 21: pb.serverrpc.v1.DeleteAccountRequest
-	(*DeleteAccountResponse)(nil),         // 22: pb.serverrpc.v1.DeleteAccountResponse
-	(*UpdateAccountPasswordRequest)(nil),  // 23: pb.serverrpc.v1.UpdateAccountPasswordRequest
-	(*UpdateAccountPasswordResponse)(nil), // 24: pb.serverrpc.v1.UpdateAccountPasswordResponse
-	(*GetServerInfoResponse_Rpc)(nil),     // 25: pb.serverrpc.v1.GetServerInfoResponse.Rpc
+	(*RoomInfo)(nil),                       // 0: pb.serverrpc.v1.RoomInfo
+	(*OnlineUserInfo)(nil),                 // 1: pb.serverrpc.v1.OnlineUserInfo
+	(*RttStats)(nil),                       // 2: pb.serverrpc.v1.RttStats
+	(*InviteCodeInfo)(nil),                 // 3: pb.serverrpc.v1.InviteCodeInfo
+	(*StreamInfo)(nil),                     // 4: pb.serverrpc.v1.StreamInfo
+	(*RoomStat)(nil),                       // 5: pb.serverrpc.v1.RoomStat
+	(*AccountInfo)(nil),                    // 6: pb.serverrpc.v1.AccountInfo
+	(*GetServerInfoRequest)(nil),           // 7: pb.serverrpc.v1.GetServerInfoRequest
+	(*GetServerInfoResponse)(nil),          // 8: pb.serverrpc.v1.GetServerInfoResponse
+	(*GetRoomsRequest)(nil),                // 9: pb.serverrpc.v1.GetRoomsRequest
+	(*GetRoomsResponse)(nil),               // 10: pb.serverrpc.v1.GetRoomsResponse
+	(*GetRoomInfoRequest)(nil),             // 11: pb.serverrpc.v1.GetRoomInfoRequest
+	(*GetRoomInfoResponse)(nil),            // 12: pb.serverrpc.v1.GetRoomInfoResponse
+	(*GetOnlineUsersRequest)(nil),          // 13: pb.serverrpc.v1.GetOnlineUsersRequest
+	(*GetOnlineUsersResponse)(nil),         // 14: pb.serverrpc.v1.GetOnlineUsersResponse
+	(*GetOnlineUserInfoRequest)(nil),       // 15: pb.serverrpc.v1.GetOnlineUserInfoRequest
+	(*GetOnlineUserInfoResponse)(nil),      // 16: pb.serverrpc.v1.GetOnlineUserInfoResponse
+	(*GetAccountsRequest)(nil),             // 17: pb.serverrpc.v1.GetAccountsRequest
+	(*GetAccountsResponse)(nil),            // 18: pb.serverrpc.v1.GetAccountsResponse
+	(*CreateRoomRequest)(nil),              // 19: pb.serverrpc.v1.CreateRoomRequest
+	(*CreateRoomResponse)(nil),             // 20: pb.serverrpc.v1.CreateRoomResponse
+	(*DeleteRoomRequest)(nil),              // 21: pb.serverrpc.v1.DeleteRoomRequest
+	(*DeleteRoomResponse)(nil),             // 22: pb.serverrpc.v1.DeleteRoomResponse
+	(*SetRoomLimitsRequest)(nil),           // 23: pb.serverrpc.v1.SetRoomLimitsRequest
+	(*SetRoomLimitsResponse)(nil),          // 24: pb.serverrpc.v1.SetRoomLimitsResponse
+	(*SetRoomDirCacheTtlRequest)(nil),      // 25: pb.serverrpc.v1.SetRoomDirCacheTtlRequest
+	(*SetRoomDirCacheTtlResponse)(nil),     // 26: pb.serverrpc.v1.SetRoomDirCacheTtlResponse
+	(*SetRoomMetadataRequest)(nil),         // 27: pb.serverrpc.v1.SetRoomMetadataRequest
+	(*SetRoomMetadataResponse)(nil),        // 28: pb.serverrpc.v1.SetRoomMetadataResponse
+	(*SetRoomMotdRequest)(nil),             // 29: pb.serverrpc.v1.SetRoomMotdRequest
+	(*SetRoomMotdResponse)(nil),            // 30: pb.serverrpc.v1.SetRoomMotdResponse
+	(*CloseRoomRequest)(nil),               // 31: pb.serverrpc.v1.CloseRoomRequest
+	(*CloseRoomResponse)(nil),              // 32: pb.serverrpc.v1.CloseRoomResponse
+	(*KickUserRequest)(nil),                // 33: pb.serverrpc.v1.KickUserRequest
+	(*KickUserResponse)(nil),               // 34: pb.serverrpc.v1.KickUserResponse
+	(*BroadcastMessageRequest)(nil),        // 35: pb.serverrpc.v1.BroadcastMessageRequest
+	(*BroadcastMessageResponse)(nil),       // 36: pb.serverrpc.v1.BroadcastMessageResponse
+	(*CreateAccountRequest)(nil),           // 37: pb.serverrpc.v1.CreateAccountRequest
+	(*CreateAccountResponse)(nil),          // 38: pb.serverrpc.v1.CreateAccountResponse
+	(*DeleteAccountRequest)(nil),           // 39: pb.serverrpc.v1.DeleteAccountRequest
+	(*DeleteAccountResponse)(nil),          // 40: pb.serverrpc.v1.DeleteAccountResponse
+	(*UpdateAccountPasswordRequest)(nil),   // 41: pb.serverrpc.v1.UpdateAccountPasswordRequest
+	(*UpdateAccountPasswordResponse)(nil),  // 42: pb.serverrpc.v1.UpdateAccountPasswordResponse
+	(*CreateInviteCodeRequest)(nil),        // 43: pb.serverrpc.v1.CreateInviteCodeRequest
+	(*CreateInviteCodeResponse)(nil),       // 44: pb.serverrpc.v1.CreateInviteCodeResponse
+	(*GetInviteCodesRequest)(nil),          // 45: pb.serverrpc.v1.GetInviteCodesRequest
+	(*GetInviteCodesResponse)(nil),         // 46: pb.serverrpc.v1.GetInviteCodesResponse
+	(*DeleteInviteCodeRequest)(nil),        // 47: pb.serverrpc.v1.DeleteInviteCodeRequest
+	(*DeleteInviteCodeResponse)(nil),       // 48: pb.serverrpc.v1.DeleteInviteCodeResponse
+	(*CreateInviteBundleRequest)(nil),      // 49: pb.serverrpc.v1.CreateInviteBundleRequest
+	(*CreateInviteBundleResponse)(nil),     // 50: pb.serverrpc.v1.CreateInviteBundleResponse
+	(*SetAccountGuestRequest)(nil),         // 51: pb.serverrpc.v1.SetAccountGuestRequest
+	(*SetAccountGuestResponse)(nil),        // 52: pb.serverrpc.v1.SetAccountGuestResponse
+	(*ListStreamsRequest)(nil),             // 53: pb.serverrpc.v1.ListStreamsRequest
+	(*ListStreamsResponse)(nil),            // 54: pb.serverrpc.v1.ListStreamsResponse
+	(*CancelStreamRequest)(nil),            // 55: pb.serverrpc.v1.CancelStreamRequest
+	(*CancelStreamResponse)(nil),           // 56: pb.serverrpc.v1.CancelStreamResponse
+	(*MigrationInfo)(nil),                  // 57: pb.serverrpc.v1.MigrationInfo
+	(*GetMigrationStatusRequest)(nil),      // 58: pb.serverrpc.v1.GetMigrationStatusRequest
+	(*GetMigrationStatusResponse)(nil),     // 59: pb.serverrpc.v1.GetMigrationStatusResponse
+	(*BackupDatabaseRequest)(nil),          // 60: pb.serverrpc.v1.BackupDatabaseRequest
+	(*BackupDatabaseResponse)(nil),         // 61: pb.serverrpc.v1.BackupDatabaseResponse
+	(*CheckDatabaseIntegrityRequest)(nil),  // 62: pb.serverrpc.v1.CheckDatabaseIntegrityRequest
+	(*CheckDatabaseIntegrityResponse)(nil), // 63: pb.serverrpc.v1.CheckDatabaseIntegrityResponse
+	(*RelayLimitWindow)(nil),               // 64: pb.serverrpc.v1.RelayLimitWindow
+	(*GetRelayLimitsRequest)(nil),          // 65: pb.serverrpc.v1.GetRelayLimitsRequest
+	(*GetRelayLimitsResponse)(nil),         // 66: pb.serverrpc.v1.GetRelayLimitsResponse
+	(*SetRelayLimitsRequest)(nil),          // 67: pb.serverrpc.v1.SetRelayLimitsRequest
+	(*SetRelayLimitsResponse)(nil),         // 68: pb.serverrpc.v1.SetRelayLimitsResponse
+	(*LobbySettings)(nil),                  // 69: pb.serverrpc.v1.LobbySettings
+	(*GetLobbySettingsRequest)(nil),        // 70: pb.serverrpc.v1.GetLobbySettingsRequest
+	(*GetLobbySettingsResponse)(nil),       // 71: pb.serverrpc.v1.GetLobbySettingsResponse
+	(*UpdateLobbySettingsRequest)(nil),     // 72: pb.serverrpc.v1.UpdateLobbySettingsRequest
+	(*UpdateLobbySettingsResponse)(nil),    // 73: pb.serverrpc.v1.UpdateLobbySettingsResponse
+	(*GetLobbyStatsRequest)(nil),           // 74: pb.serverrpc.v1.GetLobbyStatsRequest
+	(*GetLobbyStatsResponse)(nil),          // 75: pb.serverrpc.v1.GetLobbyStatsResponse
+	(*GetRoomStatsRequest)(nil),            // 76: pb.serverrpc.v1.GetRoomStatsRequest
+	(*GetRoomStatsResponse)(nil),           // 77: pb.serverrpc.v1.GetRoomStatsResponse
+	(*DrainRequest)(nil),                   // 78: pb.serverrpc.v1.DrainRequest
+	(*DrainResponse)(nil),                  // 79: pb.serverrpc.v1.DrainResponse
+	(*ConfigProblem)(nil),                  // 80: pb.serverrpc.v1.ConfigProblem
+	(*ValidateConfigRequest)(nil),          // 81: pb.serverrpc.v1.ValidateConfigRequest
+	(*ValidateConfigResponse)(nil),         // 82: pb.serverrpc.v1.ValidateConfigResponse
+	(*RoomArchive)(nil),                    // 83: pb.serverrpc.v1.RoomArchive
+	(*ExportRoomRequest)(nil),              // 84: pb.serverrpc.v1.ExportRoomRequest
+	(*ExportRoomResponse)(nil),             // 85: pb.serverrpc.v1.ExportRoomResponse
+	(*ImportRoomRequest)(nil),              // 86: pb.serverrpc.v1.ImportRoomRequest
+	(*ImportRoomResponse)(nil),             // 87: pb.serverrpc.v1.ImportRoomResponse
+	(*GetServerInfoResponse_Rpc)(nil),      // 88: pb.serverrpc.v1.GetServerInfoResponse.Rpc
+	(*RoomArchive_Account)(nil),            // 89: pb.serverrpc.v1.RoomArchive.Account
 }
 var file_pb_serverrpc_v1_rpc_proto_depIdxs = []int32{
-	25, // 0: pb.serverrpc.v1.GetServerInfoResponse.rpc:type_name -> pb.serverrpc.v1.GetServerInfoResponse.Rpc
-	0,  // 1: pb.serverrpc.v1.GetRoomsResponse.rooms:type_name -> pb.serverrpc.v1.RoomInfo
-	0,  // 2: pb.serverrpc.v1.GetRoomInfoResponse.room:type_name -> pb.serverrpc.v1.RoomInfo
-	1,  // 3: pb.serverrpc.v1.GetOnlineUsersResponse.users:type_name -> pb.serverrpc.v1.OnlineUserInfo
-	1,  // 4: pb.serverrpc.v1.GetOnlineUserInfoResponse.user:type_name -> pb.serverrpc.v1.OnlineUserInfo
-	2,  // 5: pb.serverrpc.v1.GetAccountsResponse.accounts:type_name -> pb.serverrpc.v1.AccountInfo
-	0,  // 6: pb.serverrpc.v1.CreateRoomResponse.room:type_name -> pb.serverrpc.v1.RoomInfo
-	2,  // 7: pb.serverrpc.v1.CreateAccountResponse.account:type_name -> pb.serverrpc.v1.AccountInfo
-	3,  // 8: pb.serverrpc.v1.ServerRpcService.GetServerInfo:input_type -> pb.serverrpc.v1.GetServerInfoRequest
-	5,  // 9: pb.serverrpc.v1.ServerRpcService.GetRooms:input_type -> pb.serverrpc.v1.GetRoomsRequest
-	7,  // 10: pb.serverrpc.v1.ServerRpcService.GetRoomInfo:input_type -> pb.serverrpc.v1.GetRoomInfoRequest
-	9,  // 11: pb.serverrpc.v1.ServerRpcService.GetOnlineUsers:input_type -> pb.serverrpc.v1.GetOnlineUsersRequest
-	11, // 12: pb.serverrpc.v1.ServerRpcService.GetOnlineUserInfo:input_type -> pb.serverrpc.v1.GetOnlineUserInfoRequest
-	13, // 13: pb.serverrpc.v1.ServerRpcService.GetAccounts:input_type -> pb.serverrpc.v1.GetAccountsRequest
-	15, // 14: pb.serverrpc.v1.ServerRpcService.CreateRoom:input_type -> pb.serverrpc.v1.CreateRoomRequest
-	17, // 15: pb.serverrpc.v1.ServerRpcService.DeleteRoom:input_type -> pb.serverrpc.v1.DeleteRoomRequest
-	19, // 16: pb.serverrpc.v1.ServerRpcService.CreateAccount:input_type -> pb.serverrpc.v1.CreateAccountRequest
-	21, // 17: pb.serverrpc.v1.ServerRpcService.DeleteAccount:input_type -> pb.serverrpc.v1.DeleteAccountRequest
-	23, // 18: pb.serverrpc.v1.ServerRpcService.UpdateAccountPassword:input_type -> pb.serverrpc.v1.UpdateAccountPasswordRequest
-	4,  // 19: pb.serverrpc.v1.ServerRpcService.GetServerInfo:output_type -> pb.serverrpc.v1.GetServerInfoResponse
-	6,  // 20: pb.serverrpc.v1.ServerRpcService.GetRooms:output_type -> pb.serverrpc.v1.GetRoomsResponse
-	8,  // 21: pb.serverrpc.v1.ServerRpcService.GetRoomInfo:output_type -> pb.serverrpc.v1.GetRoomInfoResponse
-	10, // 22: pb.serverrpc.v1.ServerRpcService.GetOnlineUsers:output_type -> pb.serverrpc.v1.GetOnlineUsersResponse
-	12, // 23: pb.serverrpc.v1.ServerRpcService.GetOnlineUserInfo:output_type -> pb.serverrpc.v1.GetOnlineUserInfoResponse
-	14, // 24: pb.serverrpc.v1.ServerRpcService.GetAccounts:output_type -> pb.serverrpc.v1.GetAccountsResponse
-	16, // 25: pb.serverrpc.v1.ServerRpcService.CreateRoom:output_type -> pb.serverrpc.v1.CreateRoomResponse
-	18, // 26: pb.serverrpc.v1.ServerRpcService.DeleteRoom:output_type -> pb.serverrpc.v1.DeleteRoomResponse
-	20, // 27: pb.serverrpc.v1.ServerRpcService.CreateAccount:output_type -> pb.serverrpc.v1.CreateAccountResponse
-	22, // 28: pb.serverrpc.v1.ServerRpcService.DeleteAccount:output_type -> pb.serverrpc.v1.DeleteAccountResponse
-	24, // 29: pb.serverrpc.v1.ServerRpcService.UpdateAccountPassword:output_type -> pb.serverrpc.v1.UpdateAccountPasswordResponse
-	19, // [19:30] is the sub-list for method output_type
-	8,  // [8:19] is the sub-list for method input_type
-	8,  // [8:8] is the sub-list for extension type_name
-	8,  // [8:8] is the sub-list for extension extendee
-	0,  // [0:8] is the sub-list for field type_name
+	2,  // 0: pb.serverrpc.v1.OnlineUserInfo.rtt:type_name -> pb.serverrpc.v1.RttStats
+	88, // 1: pb.serverrpc.v1.GetServerInfoResponse.rpc:type_name -> pb.serverrpc.v1.GetServerInfoResponse.Rpc
+	0,  // 2: pb.serverrpc.v1.GetRoomsResponse.rooms:type_name -> pb.serverrpc.v1.RoomInfo
+	0,  // 3: pb.serverrpc.v1.GetRoomInfoResponse.room:type_name -> pb.serverrpc.v1.RoomInfo
+	1,  // 4: pb.serverrpc.v1.GetOnlineUsersResponse.users:type_name -> pb.serverrpc.v1.OnlineUserInfo
+	1,  // 5: pb.serverrpc.v1.GetOnlineUserInfoResponse.user:type_name -> pb.serverrpc.v1.OnlineUserInfo
+	6,  // 6: pb.serverrpc.v1.GetAccountsResponse.accounts:type_name -> pb.serverrpc.v1.AccountInfo
+	0,  // 7: pb.serverrpc.v1.CreateRoomResponse.room:type_name -> pb.serverrpc.v1.RoomInfo
+	0,  // 8: pb.serverrpc.v1.SetRoomLimitsResponse.room:type_name -> pb.serverrpc.v1.RoomInfo
+	0,  // 9: pb.serverrpc.v1.SetRoomDirCacheTtlResponse.room:type_name -> pb.serverrpc.v1.RoomInfo
+	0,  // 10: pb.serverrpc.v1.SetRoomMetadataResponse.room:type_name -> pb.serverrpc.v1.RoomInfo
+	0,  // 11: pb.serverrpc.v1.SetRoomMotdResponse.room:type_name -> pb.serverrpc.v1.RoomInfo
+	6,  // 12: pb.serverrpc.v1.CreateAccountResponse.account:type_name -> pb.serverrpc.v1.AccountInfo
+	3,  // 13: pb.serverrpc.v1.CreateInviteCodeResponse.invite_code:type_name -> pb.serverrpc.v1.InviteCodeInfo
+	3,  // 14: pb.serverrpc.v1.GetInviteCodesResponse.invite_codes:type_name -> pb.serverrpc.v1.InviteCodeInfo
+	3,  // 15: pb.serverrpc.v1.CreateInviteBundleResponse.invite_code:type_name -> pb.serverrpc.v1.InviteCodeInfo
+	4,  // 16: pb.serverrpc.v1.ListStreamsResponse.streams:type_name -> pb.serverrpc.v1.StreamInfo
+	57, // 17: pb.serverrpc.v1.GetMigrationStatusResponse.migrations:type_name -> pb.serverrpc.v1.MigrationInfo
+	64, // 18: pb.serverrpc.v1.GetRelayLimitsResponse.schedule:type_name -> pb.serverrpc.v1.RelayLimitWindow
+	64, // 19: pb.serverrpc.v1.SetRelayLimitsRequest.schedule:type_name -> pb.serverrpc.v1.RelayLimitWindow
+	69, // 20: pb.serverrpc.v1.GetLobbySettingsResponse.settings:type_name -> pb.serverrpc.v1.LobbySettings
+	69, // 21: pb.serverrpc.v1.UpdateLobbySettingsResponse.settings:type_name -> pb.serverrpc.v1.LobbySettings
+	5,  // 22: pb.serverrpc.v1.GetRoomStatsResponse.stats:type_name -> pb.serverrpc.v1.RoomStat
+	80, // 23: pb.serverrpc.v1.ValidateConfigResponse.problems:type_name -> pb.serverrpc.v1.ConfigProblem
+	89, // 24: pb.serverrpc.v1.RoomArchive.accounts:type_name -> pb.serverrpc.v1.RoomArchive.Account
+	83, // 25: pb.serverrpc.v1.ExportRoomResponse.archive:type_name -> pb.serverrpc.v1.RoomArchive
+	83, // 26: pb.serverrpc.v1.ImportRoomRequest.archive:type_name -> pb.serverrpc.v1.RoomArchive
+	0,  // 27: pb.serverrpc.v1.ImportRoomResponse.room:type_name -> pb.serverrpc.v1.RoomInfo
+	7,  // 28: pb.serverrpc.v1.ServerRpcService.GetServerInfo:input_type -> pb.serverrpc.v1.GetServerInfoRequest
+	9,  // 29: pb.serverrpc.v1.ServerRpcService.GetRooms:input_type -> pb.serverrpc.v1.GetRoomsRequest
+	11, // 30: pb.serverrpc.v1.ServerRpcService.GetRoomInfo:input_type -> pb.serverrpc.v1.GetRoomInfoRequest
+	13, // 31: pb.serverrpc.v1.ServerRpcService.GetOnlineUsers:input_type -> pb.serverrpc.v1.GetOnlineUsersRequest
+	15, // 32: pb.serverrpc.v1.ServerRpcService.GetOnlineUserInfo:input_type -> pb.serverrpc.v1.GetOnlineUserInfoRequest
+	17, // 33: pb.serverrpc.v1.ServerRpcService.GetAccounts:input_type -> pb.serverrpc.v1.GetAccountsRequest
+	19, // 34: pb.serverrpc.v1.ServerRpcService.CreateRoom:input_type -> pb.serverrpc.v1.CreateRoomRequest
+	21, // 35: pb.serverrpc.v1.ServerRpcService.DeleteRoom:input_type -> pb.serverrpc.v1.DeleteRoomRequest
+	23, // 36: pb.serverrpc.v1.ServerRpcService.SetRoomLimits:input_type -> pb.serverrpc.v1.SetRoomLimitsRequest
+	25, // 37: pb.serverrpc.v1.ServerRpcService.SetRoomDirCacheTtl:input_type -> pb.serverrpc.v1.SetRoomDirCacheTtlRequest
+	27, // 38: pb.serverrpc.v1.ServerRpcService.SetRoomMetadata:input_type -> pb.serverrpc.v1.SetRoomMetadataRequest
+	29, // 39: pb.serverrpc.v1.ServerRpcService.SetRoomMotd:input_type -> pb.serverrpc.v1.SetRoomMotdRequest
+	31, // 40: pb.serverrpc.v1.ServerRpcService.CloseRoom:input_type -> pb.serverrpc.v1.CloseRoomRequest
+	33, // 41: pb.serverrpc.v1.ServerRpcService.KickUser:input_type -> pb.serverrpc.v1.KickUserRequest
+	35, // 42: pb.serverrpc.v1.ServerRpcService.BroadcastMessage:input_type -> pb.serverrpc.v1.BroadcastMessageRequest
+	37, // 43: pb.serverrpc.v1.ServerRpcService.CreateAccount:input_type -> pb.serverrpc.v1.CreateAccountRequest
+	39, // 44: pb.serverrpc.v1.ServerRpcService.DeleteAccount:input_type -> pb.serverrpc.v1.DeleteAccountRequest
+	41, // 45: pb.serverrpc.v1.ServerRpcService.UpdateAccountPassword:input_type -> pb.serverrpc.v1.UpdateAccountPasswordRequest
+	51, // 46: pb.serverrpc.v1.ServerRpcService.SetAccountGuest:input_type -> pb.serverrpc.v1.SetAccountGuestRequest
+	43, // 47: pb.serverrpc.v1.ServerRpcService.CreateInviteCode:input_type -> pb.serverrpc.v1.CreateInviteCodeRequest
+	45, // 48: pb.serverrpc.v1.ServerRpcService.GetInviteCodes:input_type -> pb.serverrpc.v1.GetInviteCodesRequest
+	47, // 49: pb.serverrpc.v1.ServerRpcService.DeleteInviteCode:input_type -> pb.serverrpc.v1.DeleteInviteCodeRequest
+	49, // 50: pb.serverrpc.v1.ServerRpcService.CreateInviteBundle:input_type -> pb.serverrpc.v1.CreateInviteBundleRequest
+	53, // 51: pb.serverrpc.v1.ServerRpcService.ListStreams:input_type -> pb.serverrpc.v1.ListStreamsRequest
+	55, // 52: pb.serverrpc.v1.ServerRpcService.CancelStream:input_type -> pb.serverrpc.v1.CancelStreamRequest
+	58, // 53: pb.serverrpc.v1.ServerRpcService.GetMigrationStatus:input_type -> pb.serverrpc.v1.GetMigrationStatusRequest
+	60, // 54: pb.serverrpc.v1.ServerRpcService.BackupDatabase:input_type -> pb.serverrpc.v1.BackupDatabaseRequest
+	62, // 55: pb.serverrpc.v1.ServerRpcService.CheckDatabaseIntegrity:input_type -> pb.serverrpc.v1.CheckDatabaseIntegrityRequest
+	65, // 56: pb.serverrpc.v1.ServerRpcService.GetRelayLimits:input_type -> pb.serverrpc.v1.GetRelayLimitsRequest
+	67, // 57: pb.serverrpc.v1.ServerRpcService.SetRelayLimits:input_type -> pb.serverrpc.v1.SetRelayLimitsRequest
+	70, // 58: pb.serverrpc.v1.ServerRpcService.GetLobbySettings:input_type -> pb.serverrpc.v1.GetLobbySettingsRequest
+	72, // 59: pb.serverrpc.v1.ServerRpcService.UpdateLobbySettings:input_type -> pb.serverrpc.v1.UpdateLobbySettingsRequest
+	74, // 60: pb.serverrpc.v1.ServerRpcService.GetLobbyStats:input_type -> pb.serverrpc.v1.GetLobbyStatsRequest
+	76, // 61: pb.serverrpc.v1.ServerRpcService.GetRoomStats:input_type -> pb.serverrpc.v1.GetRoomStatsRequest
+	78, // 62: pb.serverrpc.v1.ServerRpcService.Drain:input_type -> pb.serverrpc.v1.DrainRequest
+	81, // 63: pb.serverrpc.v1.ServerRpcService.ValidateConfig:input_type -> pb.serverrpc.v1.ValidateConfigRequest
+	84, // 64: pb.serverrpc.v1.ServerRpcService.ExportRoom:input_type -> pb.serverrpc.v1.ExportRoomRequest
+	86, // 65: pb.serverrpc.v1.ServerRpcService.ImportRoom:input_type -> pb.serverrpc.v1.ImportRoomRequest
+	8,  // 66: pb.serverrpc.v1.ServerRpcService.GetServerInfo:output_type -> pb.serverrpc.v1.GetServerInfoResponse
+	10, // 67: pb.serverrpc.v1.ServerRpcService.GetRooms:output_type -> pb.serverrpc.v1.GetRoomsResponse
+	12, // 68: pb.serverrpc.v1.ServerRpcService.GetRoomInfo:output_type -> pb.serverrpc.v1.GetRoomInfoResponse
+	14, // 69: pb.serverrpc.v1.ServerRpcService.GetOnlineUsers:output_type -> pb.serverrpc.v1.GetOnlineUsersResponse
+	16, // 70: pb.serverrpc.v1.ServerRpcService.GetOnlineUserInfo:output_type -> pb.serverrpc.v1.GetOnlineUserInfoResponse
+	18, // 71: pb.serverrpc.v1.ServerRpcService.GetAccounts:output_type -> pb.serverrpc.v1.GetAccountsResponse
+	20, // 72: pb.serverrpc.v1.ServerRpcService.CreateRoom:output_type -> pb.serverrpc.v1.CreateRoomResponse
+	22, // 73: pb.serverrpc.v1.ServerRpcService.DeleteRoom:output_type -> pb.serverrpc.v1.DeleteRoomResponse
+	24, // 74: pb.serverrpc.v1.ServerRpcService.SetRoomLimits:output_type -> pb.serverrpc.v1.SetRoomLimitsResponse
+	26, // 75: pb.serverrpc.v1.ServerRpcService.SetRoomDirCacheTtl:output_type -> pb.serverrpc.v1.SetRoomDirCacheTtlResponse
+	28, // 76: pb.serverrpc.v1.ServerRpcService.SetRoomMetadata:output_type -> pb.serverrpc.v1.SetRoomMetadataResponse
+	30, // 77: pb.serverrpc.v1.ServerRpcService.SetRoomMotd:output_type -> pb.serverrpc.v1.SetRoomMotdResponse
+	32, // 78: pb.serverrpc.v1.ServerRpcService.CloseRoom:output_type -> pb.serverrpc.v1.CloseRoomResponse
+	34, // 79: pb.serverrpc.v1.ServerRpcService.KickUser:output_type -> pb.serverrpc.v1.KickUserResponse
+	36, // 80: pb.serverrpc.v1.ServerRpcService.BroadcastMessage:output_type -> pb.serverrpc.v1.BroadcastMessageResponse
+	38, // 81: pb.serverrpc.v1.ServerRpcService.CreateAccount:output_type -> pb.serverrpc.v1.CreateAccountResponse
+	40, // 82: pb.serverrpc.v1.ServerRpcService.DeleteAccount:output_type -> pb.serverrpc.v1.DeleteAccountResponse
+	42, // 83: pb.serverrpc.v1.ServerRpcService.UpdateAccountPassword:output_type -> pb.serverrpc.v1.UpdateAccountPasswordResponse
+	52, // 84: pb.serverrpc.v1.ServerRpcService.SetAccountGuest:output_type -> pb.serverrpc.v1.SetAccountGuestResponse
+	44, // 85: pb.serverrpc.v1.ServerRpcService.CreateInviteCode:output_type -> pb.serverrpc.v1.CreateInviteCodeResponse
+	46, // 86: pb.serverrpc.v1.ServerRpcService.GetInviteCodes:output_type -> pb.serverrpc.v1.GetInviteCodesResponse
+	48, // 87: pb.serverrpc.v1.ServerRpcService.DeleteInviteCode:output_type -> pb.serverrpc.v1.DeleteInviteCodeResponse
+	50, // 88: pb.serverrpc.v1.ServerRpcService.CreateInviteBundle:output_type -> pb.serverrpc.v1.CreateInviteBundleResponse
+	54, // 89: pb.serverrpc.v1.ServerRpcService.ListStreams:output_type -> pb.serverrpc.v1.ListStreamsResponse
+	56, // 90: pb.serverrpc.v1.ServerRpcService.CancelStream:output_type -> pb.serverrpc.v1.CancelStreamResponse
+	59, // 91: pb.serverrpc.v1.ServerRpcService.GetMigrationStatus:output_type -> pb.serverrpc.v1.GetMigrationStatusResponse
+	61, // 92: pb.serverrpc.v1.ServerRpcService.BackupDatabase:output_type -> pb.serverrpc.v1.BackupDatabaseResponse
+	63, // 93: pb.serverrpc.v1.ServerRpcService.CheckDatabaseIntegrity:output_type -> pb.serverrpc.v1.CheckDatabaseIntegrityResponse
+	66, // 94: pb.serverrpc.v1.ServerRpcService.GetRelayLimits:output_type -> pb.serverrpc.v1.GetRelayLimitsResponse
+	68, // 95: pb.serverrpc.v1.ServerRpcService.SetRelayLimits:output_type -> pb.serverrpc.v1.SetRelayLimitsResponse
+	71, // 96: pb.serverrpc.v1.ServerRpcService.GetLobbySettings:output_type -> pb.serverrpc.v1.GetLobbySettingsResponse
+	73, // 97: pb.serverrpc.v1.ServerRpcService.UpdateLobbySettings:output_type -> pb.serverrpc.v1.UpdateLobbySettingsResponse
+	75, // 98: pb.serverrpc.v1.ServerRpcService.GetLobbyStats:output_type -> pb.serverrpc.v1.GetLobbyStatsResponse
+	77, // 99: pb.serverrpc.v1.ServerRpcService.GetRoomStats:output_type -> pb.serverrpc.v1.GetRoomStatsResponse
+	79, // 100: pb.serverrpc.v1.ServerRpcService.Drain:output_type -> pb.serverrpc.v1.DrainResponse
+	82, // 101: pb.serverrpc.v1.ServerRpcService.ValidateConfig:output_type -> pb.serverrpc.v1.ValidateConfigResponse
+	85, // 102: pb.serverrpc.v1.ServerRpcService.ExportRoom:output_type -> pb.serverrpc.v1.ExportRoomResponse
+	87, // 103: pb.serverrpc.v1.ServerRpcService.ImportRoom:output_type -> pb.serverrpc.v1.ImportRoomResponse
+	66, // [66:104] is the sub-list for method output_type
+	28, // [28:66] is the sub-list for method input_type
+	28, // [28:28] is the sub-list for extension type_name
+	28, // [28:28] is the sub-list for extension extendee
+	0,  // [0:28] is the sub-list for field type_name
 }
 
 func init() { file_pb_serverrpc_v1_rpc_proto_init() }
@@ -1413,15 +5514,20 @@ func file_pb_serverrpc_v1_rpc_proto_init() {
 	if File_pb_serverrpc_v1_rpc_proto != nil {
 		return
 	}
-	file_pb_serverrpc_v1_rpc_proto_msgTypes[20].OneofWrappers = []any{}
-	file_pb_serverrpc_v1_rpc_proto_msgTypes[24].OneofWrappers = []any{}
+	file_pb_serverrpc_v1_rpc_proto_msgTypes[2].OneofWrappers = []any{}
+	file_pb_serverrpc_v1_rpc_proto_msgTypes[19].OneofWrappers = []any{}
+	file_pb_serverrpc_v1_rpc_proto_msgTypes[38].OneofWrappers = []any{}
+	file_pb_serverrpc_v1_rpc_proto_msgTypes[42].OneofWrappers = []any{}
+	file_pb_serverrpc_v1_rpc_proto_msgTypes[50].OneofWrappers = []any{}
+	file_pb_serverrpc_v1_rpc_proto_msgTypes[72].OneofWrappers = []any{}
+	file_pb_serverrpc_v1_rpc_proto_msgTypes[86].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pb_serverrpc_v1_rpc_proto_rawDesc), len(file_pb_serverrpc_v1_rpc_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   26,
+			NumMessages:   90,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
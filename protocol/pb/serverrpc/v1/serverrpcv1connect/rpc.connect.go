@@ -57,6 +57,27 @@ const (
 	// ServerRpcServiceDeleteRoomProcedure is the fully-qualified name of the ServerRpcService's
 	// DeleteRoom RPC.
 	ServerRpcServiceDeleteRoomProcedure = "/pb.serverrpc.v1.ServerRpcService/DeleteRoom"
+	// ServerRpcServiceSetRoomLimitsProcedure is the fully-qualified name of the ServerRpcService's
+	// SetRoomLimits RPC.
+	ServerRpcServiceSetRoomLimitsProcedure = "/pb.serverrpc.v1.ServerRpcService/SetRoomLimits"
+	// ServerRpcServiceSetRoomDirCacheTtlProcedure is the fully-qualified name of the ServerRpcService's
+	// SetRoomDirCacheTtl RPC.
+	ServerRpcServiceSetRoomDirCacheTtlProcedure = "/pb.serverrpc.v1.ServerRpcService/SetRoomDirCacheTtl"
+	// ServerRpcServiceSetRoomMetadataProcedure is the fully-qualified name of the ServerRpcService's
+	// SetRoomMetadata RPC.
+	ServerRpcServiceSetRoomMetadataProcedure = "/pb.serverrpc.v1.ServerRpcService/SetRoomMetadata"
+	// ServerRpcServiceSetRoomMotdProcedure is the fully-qualified name of the ServerRpcService's
+	// SetRoomMotd RPC.
+	ServerRpcServiceSetRoomMotdProcedure = "/pb.serverrpc.v1.ServerRpcService/SetRoomMotd"
+	// ServerRpcServiceCloseRoomProcedure is the fully-qualified name of the ServerRpcService's
+	// CloseRoom RPC.
+	ServerRpcServiceCloseRoomProcedure = "/pb.serverrpc.v1.ServerRpcService/CloseRoom"
+	// ServerRpcServiceKickUserProcedure is the fully-qualified name of the ServerRpcService's KickUser
+	// RPC.
+	ServerRpcServiceKickUserProcedure = "/pb.serverrpc.v1.ServerRpcService/KickUser"
+	// ServerRpcServiceBroadcastMessageProcedure is the fully-qualified name of the ServerRpcService's
+	// BroadcastMessage RPC.
+	ServerRpcServiceBroadcastMessageProcedure = "/pb.serverrpc.v1.ServerRpcService/BroadcastMessage"
 	// ServerRpcServiceCreateAccountProcedure is the fully-qualified name of the ServerRpcService's
 	// CreateAccount RPC.
 	ServerRpcServiceCreateAccountProcedure = "/pb.serverrpc.v1.ServerRpcService/CreateAccount"
@@ -66,14 +87,75 @@ const (
 	// ServerRpcServiceUpdateAccountPasswordProcedure is the fully-qualified name of the
 	// ServerRpcService's UpdateAccountPassword RPC.
 	ServerRpcServiceUpdateAccountPasswordProcedure = "/pb.serverrpc.v1.ServerRpcService/UpdateAccountPassword"
+	// ServerRpcServiceSetAccountGuestProcedure is the fully-qualified name of the ServerRpcService's
+	// SetAccountGuest RPC.
+	ServerRpcServiceSetAccountGuestProcedure = "/pb.serverrpc.v1.ServerRpcService/SetAccountGuest"
+	// ServerRpcServiceCreateInviteCodeProcedure is the fully-qualified name of the ServerRpcService's
+	// CreateInviteCode RPC.
+	ServerRpcServiceCreateInviteCodeProcedure = "/pb.serverrpc.v1.ServerRpcService/CreateInviteCode"
+	// ServerRpcServiceGetInviteCodesProcedure is the fully-qualified name of the ServerRpcService's
+	// GetInviteCodes RPC.
+	ServerRpcServiceGetInviteCodesProcedure = "/pb.serverrpc.v1.ServerRpcService/GetInviteCodes"
+	// ServerRpcServiceDeleteInviteCodeProcedure is the fully-qualified name of the ServerRpcService's
+	// DeleteInviteCode RPC.
+	ServerRpcServiceDeleteInviteCodeProcedure = "/pb.serverrpc.v1.ServerRpcService/DeleteInviteCode"
+	// ServerRpcServiceCreateInviteBundleProcedure is the fully-qualified name of the ServerRpcService's
+	// CreateInviteBundle RPC.
+	ServerRpcServiceCreateInviteBundleProcedure = "/pb.serverrpc.v1.ServerRpcService/CreateInviteBundle"
+	// ServerRpcServiceListStreamsProcedure is the fully-qualified name of the ServerRpcService's
+	// ListStreams RPC.
+	ServerRpcServiceListStreamsProcedure = "/pb.serverrpc.v1.ServerRpcService/ListStreams"
+	// ServerRpcServiceCancelStreamProcedure is the fully-qualified name of the ServerRpcService's
+	// CancelStream RPC.
+	ServerRpcServiceCancelStreamProcedure = "/pb.serverrpc.v1.ServerRpcService/CancelStream"
+	// ServerRpcServiceGetMigrationStatusProcedure is the fully-qualified name of the ServerRpcService's
+	// GetMigrationStatus RPC.
+	ServerRpcServiceGetMigrationStatusProcedure = "/pb.serverrpc.v1.ServerRpcService/GetMigrationStatus"
+	// ServerRpcServiceBackupDatabaseProcedure is the fully-qualified name of the ServerRpcService's
+	// BackupDatabase RPC.
+	ServerRpcServiceBackupDatabaseProcedure = "/pb.serverrpc.v1.ServerRpcService/BackupDatabase"
+	// ServerRpcServiceCheckDatabaseIntegrityProcedure is the fully-qualified name of the
+	// ServerRpcService's CheckDatabaseIntegrity RPC.
+	ServerRpcServiceCheckDatabaseIntegrityProcedure = "/pb.serverrpc.v1.ServerRpcService/CheckDatabaseIntegrity"
+	// ServerRpcServiceGetRelayLimitsProcedure is the fully-qualified name of the ServerRpcService's
+	// GetRelayLimits RPC.
+	ServerRpcServiceGetRelayLimitsProcedure = "/pb.serverrpc.v1.ServerRpcService/GetRelayLimits"
+	// ServerRpcServiceSetRelayLimitsProcedure is the fully-qualified name of the ServerRpcService's
+	// SetRelayLimits RPC.
+	ServerRpcServiceSetRelayLimitsProcedure = "/pb.serverrpc.v1.ServerRpcService/SetRelayLimits"
+	// ServerRpcServiceGetLobbySettingsProcedure is the fully-qualified name of the ServerRpcService's
+	// GetLobbySettings RPC.
+	ServerRpcServiceGetLobbySettingsProcedure = "/pb.serverrpc.v1.ServerRpcService/GetLobbySettings"
+	// ServerRpcServiceUpdateLobbySettingsProcedure is the fully-qualified name of the
+	// ServerRpcService's UpdateLobbySettings RPC.
+	ServerRpcServiceUpdateLobbySettingsProcedure = "/pb.serverrpc.v1.ServerRpcService/UpdateLobbySettings"
+	// ServerRpcServiceGetLobbyStatsProcedure is the fully-qualified name of the ServerRpcService's
+	// GetLobbyStats RPC.
+	ServerRpcServiceGetLobbyStatsProcedure = "/pb.serverrpc.v1.ServerRpcService/GetLobbyStats"
+	// ServerRpcServiceGetRoomStatsProcedure is the fully-qualified name of the ServerRpcService's
+	// GetRoomStats RPC.
+	ServerRpcServiceGetRoomStatsProcedure = "/pb.serverrpc.v1.ServerRpcService/GetRoomStats"
+	// ServerRpcServiceDrainProcedure is the fully-qualified name of the ServerRpcService's Drain RPC.
+	ServerRpcServiceDrainProcedure = "/pb.serverrpc.v1.ServerRpcService/Drain"
+	// ServerRpcServiceValidateConfigProcedure is the fully-qualified name of the ServerRpcService's
+	// ValidateConfig RPC.
+	ServerRpcServiceValidateConfigProcedure = "/pb.serverrpc.v1.ServerRpcService/ValidateConfig"
+	// ServerRpcServiceExportRoomProcedure is the fully-qualified name of the ServerRpcService's
+	// ExportRoom RPC.
+	ServerRpcServiceExportRoomProcedure = "/pb.serverrpc.v1.ServerRpcService/ExportRoom"
+	// ServerRpcServiceImportRoomProcedure is the fully-qualified name of the ServerRpcService's
+	// ImportRoom RPC.
+	ServerRpcServiceImportRoomProcedure = "/pb.serverrpc.v1.ServerRpcService/ImportRoom"
 )
 
 // ServerRpcServiceClient is a client for the pb.serverrpc.v1.ServerRpcService service.
 type ServerRpcServiceClient interface {
-	// GetServerInfo returns information about the server.
+	// GetServerInfo returns information about the server, such as its version and enabled features, so that UIs and
+	// CLIs can display it and only offer features the server supports.
 	// It also returns information about the RPC interface used to call the method.
 	GetServerInfo(context.Context, *v1.GetServerInfoRequest) (*v1.GetServerInfoResponse, error)
-	// GetRooms returns a list of all rooms in the server.
+	// GetRooms returns a list of the rooms in the server.
+	// Unlisted rooms are only included if requested.
 	GetRooms(context.Context, *v1.GetRoomsRequest) (*v1.GetRoomsResponse, error)
 	// GetRoomInfo returns information about a room.
 	// Returns status code NOT_FOUND if no such room exists.
@@ -84,16 +166,48 @@ type ServerRpcServiceClient interface {
 	// GetOnlineUserInfo returns information about an online user.
 	// Returns status code NOT_FOUND if the user is not online or does not exist.
 	GetOnlineUserInfo(context.Context, *v1.GetOnlineUserInfoRequest) (*v1.GetOnlineUserInfoResponse, error)
-	// GetAccounts returns all accounts in a room.
+	// GetAccounts returns a page of accounts in a room.
+	// Use the returned cursor to get the following pages.
 	// Returns status code NOT_FOUND if no such room exists.
 	GetAccounts(context.Context, *v1.GetAccountsRequest) (*v1.GetAccountsResponse, error)
 	// CreateRoom creates a new room.
 	// Returns status code ALREADY_EXISTS if a room with the same name already exists.
+	// Returns status code INVALID_ARGUMENT if the description is too long.
 	CreateRoom(context.Context, *v1.CreateRoomRequest) (*v1.CreateRoomResponse, error)
 	// DeleteRoom deletes an existing room.
 	// Any connected users are disconnected before deletion.
 	// Returns status code NOT_FOUND if no such room exists.
 	DeleteRoom(context.Context, *v1.DeleteRoomRequest) (*v1.DeleteRoomResponse, error)
+	// SetRoomLimits sets a room's capacity and concurrency limits.
+	// Lowering the limits does not disconnect online users or close open streams; they only apply to new ones.
+	// Returns status code NOT_FOUND if no such room exists.
+	SetRoomLimits(context.Context, *v1.SetRoomLimitsRequest) (*v1.SetRoomLimitsResponse, error)
+	// SetRoomDirCacheTtl sets how long directory listings proxied in a room are cached.
+	// Cached listings are only served while the sharing user's files are unchanged, so a short TTL mostly just
+	// bounds memory use.
+	// Returns status code NOT_FOUND if no such room exists.
+	SetRoomDirCacheTtl(context.Context, *v1.SetRoomDirCacheTtlRequest) (*v1.SetRoomDirCacheTtlResponse, error)
+	// SetRoomMetadata sets a room's description and whether it is listed.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code INVALID_ARGUMENT if the description is too long.
+	SetRoomMetadata(context.Context, *v1.SetRoomMetadataRequest) (*v1.SetRoomMetadataResponse, error)
+	// SetRoomMotd sets a room's message of the day, which is sent to clients when they join the room.
+	// Clients that are already in the room receive it the next time they join.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code INVALID_ARGUMENT if the message is too long.
+	SetRoomMotd(context.Context, *v1.SetRoomMotdRequest) (*v1.SetRoomMotdResponse, error)
+	// CloseRoom disconnects all users in a room and cancels its open streams, without deleting the room.
+	// The room keeps its accounts and settings, and users may reconnect afterward.
+	// Returns status code NOT_FOUND if no such room exists.
+	CloseRoom(context.Context, *v1.CloseRoomRequest) (*v1.CloseRoomResponse, error)
+	// KickUser disconnects an online user from a room.
+	// It does not delete or otherwise change their account, so they may reconnect afterward.
+	// Returns status code NOT_FOUND if no such room exists or the user is not online.
+	KickUser(context.Context, *v1.KickUserRequest) (*v1.KickUserResponse, error)
+	// BroadcastMessage sends a notice to every online user in a room.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code INVALID_ARGUMENT if the text is empty or too long.
+	BroadcastMessage(context.Context, *v1.BroadcastMessageRequest) (*v1.BroadcastMessageResponse, error)
 	// CreateAccount creates a new account in a room.
 	// It can generate a password if none is given.
 	// Returns status code NOT_FOUND if no such room exists.
@@ -109,6 +223,94 @@ type ServerRpcServiceClient interface {
 	// Returns status code NOT_FOUND if no such room exists.
 	// Returns status code NOT_FOUND if no such account exists.
 	UpdateAccountPassword(context.Context, *v1.UpdateAccountPasswordRequest) (*v1.UpdateAccountPasswordResponse, error)
+	// SetAccountGuest sets whether an account is a guest account.
+	// Guests can browse and download, but cannot share files or change their password.
+	// If the user is online, the change applies immediately.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code NOT_FOUND if no such account exists.
+	SetAccountGuest(context.Context, *v1.SetAccountGuestRequest) (*v1.SetAccountGuestResponse, error)
+	// CreateInviteCode creates a new single-use invite code for registering an account in a room.
+	// Returns status code NOT_FOUND if no such room exists.
+	CreateInviteCode(context.Context, *v1.CreateInviteCodeRequest) (*v1.CreateInviteCodeResponse, error)
+	// GetInviteCodes returns all unused invite codes for a room.
+	// Returns status code NOT_FOUND if no such room exists.
+	GetInviteCodes(context.Context, *v1.GetInviteCodesRequest) (*v1.GetInviteCodesResponse, error)
+	// DeleteInviteCode deletes an unused invite code.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code NOT_FOUND if no such invite code exists.
+	DeleteInviteCode(context.Context, *v1.DeleteInviteCodeRequest) (*v1.DeleteInviteCodeResponse, error)
+	// CreateInviteBundle creates an invite bundle URL for a room, including the server's certificate fingerprint.
+	// Clients can import it to add the server without entering its details or trusting its certificate blindly.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code INVALID_ARGUMENT if the address is empty.
+	CreateInviteBundle(context.Context, *v1.CreateInviteBundleRequest) (*v1.CreateInviteBundleResponse, error)
+	// ListStreams returns all open proxied streams between clients.
+	// Returns status code NOT_FOUND if a room is specified and no such room exists.
+	ListStreams(context.Context, *v1.ListStreamsRequest) (*v1.ListStreamsResponse, error)
+	// CancelStream closes an open proxied stream, interrupting whatever transfer is using it.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code NOT_FOUND if no such stream exists.
+	CancelStream(context.Context, *v1.CancelStreamRequest) (*v1.CancelStreamResponse, error)
+	// GetMigrationStatus returns the state of the server database's schema migrations.
+	// Operators can use it to check the schema before upgrading or downgrading the server.
+	GetMigrationStatus(context.Context, *v1.GetMigrationStatusRequest) (*v1.GetMigrationStatusResponse, error)
+	// BackupDatabase writes a consistent copy of the server's database to a file on the server while it keeps running.
+	// Returns status code INVALID_ARGUMENT if the path is empty.
+	// Returns status code ALREADY_EXISTS if the file already exists.
+	// Returns status code UNIMPLEMENTED if the database is not SQLite.
+	BackupDatabase(context.Context, *v1.BackupDatabaseRequest) (*v1.BackupDatabaseResponse, error)
+	// CheckDatabaseIntegrity checks the server's database for corruption.
+	// It may take a while for large databases.
+	// Returns status code UNIMPLEMENTED if the database is not SQLite.
+	CheckDatabaseIntegrity(context.Context, *v1.CheckDatabaseIntegrityRequest) (*v1.CheckDatabaseIntegrityResponse, error)
+	// GetRelayLimits returns the bandwidth limits for proxied streams.
+	GetRelayLimits(context.Context, *v1.GetRelayLimitsRequest) (*v1.GetRelayLimitsResponse, error)
+	// SetRelayLimits replaces the bandwidth limits for proxied streams.
+	// They apply immediately, without clients reconnecting.
+	// Changes are not saved to the config file, so they last until the server restarts.
+	// Returns status code INVALID_ARGUMENT if a window is invalid.
+	SetRelayLimits(context.Context, *v1.SetRelayLimitsRequest) (*v1.SetRelayLimitsResponse, error)
+	// GetLobbySettings returns the lobby timeout, accepted protocol versions and concurrency limit.
+	GetLobbySettings(context.Context, *v1.GetLobbySettingsRequest) (*v1.GetLobbySettingsResponse, error)
+	// UpdateLobbySettings changes the lobby timeout, accepted protocol versions and concurrency limit.
+	// Only the fields that are set are changed. Changes apply to new connections, without restarting the server.
+	// Changes are not saved to the config file, so they last until the server restarts.
+	// Returns status code INVALID_ARGUMENT if the resulting settings are invalid.
+	UpdateLobbySettings(context.Context, *v1.UpdateLobbySettingsRequest) (*v1.UpdateLobbySettingsResponse, error)
+	// GetLobbyStats returns counters of connections that were accepted into or rejected from the lobby since the server
+	// started, such as to monitor for connection floods.
+	GetLobbyStats(context.Context, *v1.GetLobbyStatsRequest) (*v1.GetLobbyStatsResponse, error)
+	// GetRoomStats returns the periodic snapshots of a room's online client count and relayed bytes taken within a
+	// time range, such as to graph its usage over time.
+	// Snapshots older than the server's configured retention are not available.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code INVALID_ARGUMENT if the range ends before it starts.
+	GetRoomStats(context.Context, *v1.GetRoomStatsRequest) (*v1.GetRoomStatsResponse, error)
+	// Drain starts draining the server, such as before stopping it for an upgrade while another server takes over on
+	// a different address. New connections are closed with an unavailable close code, and new proxied streams are
+	// refused as if the target were offline, so clients try again later. Existing connections and proxied streams are
+	// left to finish.
+	// Draining lasts until the server restarts. Calling Drain while already draining only reports progress.
+	//
+	// Progress is streamed every second until no proxied streams are open, after which the server can be stopped
+	// without interrupting transfers.
+	Drain(context.Context, *v1.DrainRequest) (*connect.ServerStreamForClient[v1.DrainResponse], error)
+	// ValidateConfig checks a server config for problems, such as invalid or overlapping addresses, unknown RPC methods
+	// and unknown fields, and reports all of them at once.
+	// Returns status code FAILED_PRECONDITION if no config JSON is specified and the server was not started with a
+	// config file.
+	ValidateConfig(context.Context, *v1.ValidateConfigRequest) (*v1.ValidateConfigResponse, error)
+	// ExportRoom returns a portable archive of a room's settings, accounts with their password hashes and unused invite
+	// codes, which can be imported into another server with ImportRoom.
+	// The archive contains password hashes, so it should be kept as secret as the database.
+	// Returns status code NOT_FOUND if no such room exists.
+	ExportRoom(context.Context, *v1.ExportRoomRequest) (*v1.ExportRoomResponse, error)
+	// ImportRoom creates a new room from an archive made by ExportRoom, including its accounts, so users can log in
+	// with the same passwords. The room is either imported in full or not at all.
+	// Invite codes that are already used by another room are left out.
+	// Returns status code ALREADY_EXISTS if a room with the same name already exists.
+	// Returns status code INVALID_ARGUMENT if the archive or name is invalid, or the archive version is not supported.
+	ImportRoom(context.Context, *v1.ImportRoomRequest) (*v1.ImportRoomResponse, error)
 }
 
 // NewServerRpcServiceClient constructs a client for the pb.serverrpc.v1.ServerRpcService service.
@@ -170,6 +372,48 @@ func NewServerRpcServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(serverRpcServiceMethods.ByName("DeleteRoom")),
 			connect.WithClientOptions(opts...),
 		),
+		setRoomLimits: connect.NewClient[v1.SetRoomLimitsRequest, v1.SetRoomLimitsResponse](
+			httpClient,
+			baseURL+ServerRpcServiceSetRoomLimitsProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("SetRoomLimits")),
+			connect.WithClientOptions(opts...),
+		),
+		setRoomDirCacheTtl: connect.NewClient[v1.SetRoomDirCacheTtlRequest, v1.SetRoomDirCacheTtlResponse](
+			httpClient,
+			baseURL+ServerRpcServiceSetRoomDirCacheTtlProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("SetRoomDirCacheTtl")),
+			connect.WithClientOptions(opts...),
+		),
+		setRoomMetadata: connect.NewClient[v1.SetRoomMetadataRequest, v1.SetRoomMetadataResponse](
+			httpClient,
+			baseURL+ServerRpcServiceSetRoomMetadataProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("SetRoomMetadata")),
+			connect.WithClientOptions(opts...),
+		),
+		setRoomMotd: connect.NewClient[v1.SetRoomMotdRequest, v1.SetRoomMotdResponse](
+			httpClient,
+			baseURL+ServerRpcServiceSetRoomMotdProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("SetRoomMotd")),
+			connect.WithClientOptions(opts...),
+		),
+		closeRoom: connect.NewClient[v1.CloseRoomRequest, v1.CloseRoomResponse](
+			httpClient,
+			baseURL+ServerRpcServiceCloseRoomProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("CloseRoom")),
+			connect.WithClientOptions(opts...),
+		),
+		kickUser: connect.NewClient[v1.KickUserRequest, v1.KickUserResponse](
+			httpClient,
+			baseURL+ServerRpcServiceKickUserProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("KickUser")),
+			connect.WithClientOptions(opts...),
+		),
+		broadcastMessage: connect.NewClient[v1.BroadcastMessageRequest, v1.BroadcastMessageResponse](
+			httpClient,
+			baseURL+ServerRpcServiceBroadcastMessageProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("BroadcastMessage")),
+			connect.WithClientOptions(opts...),
+		),
 		createAccount: connect.NewClient[v1.CreateAccountRequest, v1.CreateAccountResponse](
 			httpClient,
 			baseURL+ServerRpcServiceCreateAccountProcedure,
@@ -188,22 +432,169 @@ func NewServerRpcServiceClient(httpClient connect.HTTPClient, baseURL string, op
 			connect.WithSchema(serverRpcServiceMethods.ByName("UpdateAccountPassword")),
 			connect.WithClientOptions(opts...),
 		),
+		setAccountGuest: connect.NewClient[v1.SetAccountGuestRequest, v1.SetAccountGuestResponse](
+			httpClient,
+			baseURL+ServerRpcServiceSetAccountGuestProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("SetAccountGuest")),
+			connect.WithClientOptions(opts...),
+		),
+		createInviteCode: connect.NewClient[v1.CreateInviteCodeRequest, v1.CreateInviteCodeResponse](
+			httpClient,
+			baseURL+ServerRpcServiceCreateInviteCodeProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("CreateInviteCode")),
+			connect.WithClientOptions(opts...),
+		),
+		getInviteCodes: connect.NewClient[v1.GetInviteCodesRequest, v1.GetInviteCodesResponse](
+			httpClient,
+			baseURL+ServerRpcServiceGetInviteCodesProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("GetInviteCodes")),
+			connect.WithClientOptions(opts...),
+		),
+		deleteInviteCode: connect.NewClient[v1.DeleteInviteCodeRequest, v1.DeleteInviteCodeResponse](
+			httpClient,
+			baseURL+ServerRpcServiceDeleteInviteCodeProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("DeleteInviteCode")),
+			connect.WithClientOptions(opts...),
+		),
+		createInviteBundle: connect.NewClient[v1.CreateInviteBundleRequest, v1.CreateInviteBundleResponse](
+			httpClient,
+			baseURL+ServerRpcServiceCreateInviteBundleProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("CreateInviteBundle")),
+			connect.WithClientOptions(opts...),
+		),
+		listStreams: connect.NewClient[v1.ListStreamsRequest, v1.ListStreamsResponse](
+			httpClient,
+			baseURL+ServerRpcServiceListStreamsProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("ListStreams")),
+			connect.WithClientOptions(opts...),
+		),
+		cancelStream: connect.NewClient[v1.CancelStreamRequest, v1.CancelStreamResponse](
+			httpClient,
+			baseURL+ServerRpcServiceCancelStreamProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("CancelStream")),
+			connect.WithClientOptions(opts...),
+		),
+		getMigrationStatus: connect.NewClient[v1.GetMigrationStatusRequest, v1.GetMigrationStatusResponse](
+			httpClient,
+			baseURL+ServerRpcServiceGetMigrationStatusProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("GetMigrationStatus")),
+			connect.WithClientOptions(opts...),
+		),
+		backupDatabase: connect.NewClient[v1.BackupDatabaseRequest, v1.BackupDatabaseResponse](
+			httpClient,
+			baseURL+ServerRpcServiceBackupDatabaseProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("BackupDatabase")),
+			connect.WithClientOptions(opts...),
+		),
+		checkDatabaseIntegrity: connect.NewClient[v1.CheckDatabaseIntegrityRequest, v1.CheckDatabaseIntegrityResponse](
+			httpClient,
+			baseURL+ServerRpcServiceCheckDatabaseIntegrityProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("CheckDatabaseIntegrity")),
+			connect.WithClientOptions(opts...),
+		),
+		getRelayLimits: connect.NewClient[v1.GetRelayLimitsRequest, v1.GetRelayLimitsResponse](
+			httpClient,
+			baseURL+ServerRpcServiceGetRelayLimitsProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("GetRelayLimits")),
+			connect.WithClientOptions(opts...),
+		),
+		setRelayLimits: connect.NewClient[v1.SetRelayLimitsRequest, v1.SetRelayLimitsResponse](
+			httpClient,
+			baseURL+ServerRpcServiceSetRelayLimitsProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("SetRelayLimits")),
+			connect.WithClientOptions(opts...),
+		),
+		getLobbySettings: connect.NewClient[v1.GetLobbySettingsRequest, v1.GetLobbySettingsResponse](
+			httpClient,
+			baseURL+ServerRpcServiceGetLobbySettingsProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("GetLobbySettings")),
+			connect.WithClientOptions(opts...),
+		),
+		updateLobbySettings: connect.NewClient[v1.UpdateLobbySettingsRequest, v1.UpdateLobbySettingsResponse](
+			httpClient,
+			baseURL+ServerRpcServiceUpdateLobbySettingsProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("UpdateLobbySettings")),
+			connect.WithClientOptions(opts...),
+		),
+		getLobbyStats: connect.NewClient[v1.GetLobbyStatsRequest, v1.GetLobbyStatsResponse](
+			httpClient,
+			baseURL+ServerRpcServiceGetLobbyStatsProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("GetLobbyStats")),
+			connect.WithClientOptions(opts...),
+		),
+		getRoomStats: connect.NewClient[v1.GetRoomStatsRequest, v1.GetRoomStatsResponse](
+			httpClient,
+			baseURL+ServerRpcServiceGetRoomStatsProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("GetRoomStats")),
+			connect.WithClientOptions(opts...),
+		),
+		drain: connect.NewClient[v1.DrainRequest, v1.DrainResponse](
+			httpClient,
+			baseURL+ServerRpcServiceDrainProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("Drain")),
+			connect.WithClientOptions(opts...),
+		),
+		validateConfig: connect.NewClient[v1.ValidateConfigRequest, v1.ValidateConfigResponse](
+			httpClient,
+			baseURL+ServerRpcServiceValidateConfigProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("ValidateConfig")),
+			connect.WithClientOptions(opts...),
+		),
+		exportRoom: connect.NewClient[v1.ExportRoomRequest, v1.ExportRoomResponse](
+			httpClient,
+			baseURL+ServerRpcServiceExportRoomProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("ExportRoom")),
+			connect.WithClientOptions(opts...),
+		),
+		importRoom: connect.NewClient[v1.ImportRoomRequest, v1.ImportRoomResponse](
+			httpClient,
+			baseURL+ServerRpcServiceImportRoomProcedure,
+			connect.WithSchema(serverRpcServiceMethods.ByName("ImportRoom")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // serverRpcServiceClient implements ServerRpcServiceClient.
 type serverRpcServiceClient struct {
-	getServerInfo         *connect.Client[v1.GetServerInfoRequest, v1.GetServerInfoResponse]
-	getRooms              *connect.Client[v1.GetRoomsRequest, v1.GetRoomsResponse]
-	getRoomInfo           *connect.Client[v1.GetRoomInfoRequest, v1.GetRoomInfoResponse]
-	getOnlineUsers        *connect.Client[v1.GetOnlineUsersRequest, v1.GetOnlineUsersResponse]
-	getOnlineUserInfo     *connect.Client[v1.GetOnlineUserInfoRequest, v1.GetOnlineUserInfoResponse]
-	getAccounts           *connect.Client[v1.GetAccountsRequest, v1.GetAccountsResponse]
-	createRoom            *connect.Client[v1.CreateRoomRequest, v1.CreateRoomResponse]
-	deleteRoom            *connect.Client[v1.DeleteRoomRequest, v1.DeleteRoomResponse]
-	createAccount         *connect.Client[v1.CreateAccountRequest, v1.CreateAccountResponse]
-	deleteAccount         *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
-	updateAccountPassword *connect.Client[v1.UpdateAccountPasswordRequest, v1.UpdateAccountPasswordResponse]
+	getServerInfo          *connect.Client[v1.GetServerInfoRequest, v1.GetServerInfoResponse]
+	getRooms               *connect.Client[v1.GetRoomsRequest, v1.GetRoomsResponse]
+	getRoomInfo            *connect.Client[v1.GetRoomInfoRequest, v1.GetRoomInfoResponse]
+	getOnlineUsers         *connect.Client[v1.GetOnlineUsersRequest, v1.GetOnlineUsersResponse]
+	getOnlineUserInfo      *connect.Client[v1.GetOnlineUserInfoRequest, v1.GetOnlineUserInfoResponse]
+	getAccounts            *connect.Client[v1.GetAccountsRequest, v1.GetAccountsResponse]
+	createRoom             *connect.Client[v1.CreateRoomRequest, v1.CreateRoomResponse]
+	deleteRoom             *connect.Client[v1.DeleteRoomRequest, v1.DeleteRoomResponse]
+	setRoomLimits          *connect.Client[v1.SetRoomLimitsRequest, v1.SetRoomLimitsResponse]
+	setRoomDirCacheTtl     *connect.Client[v1.SetRoomDirCacheTtlRequest, v1.SetRoomDirCacheTtlResponse]
+	setRoomMetadata        *connect.Client[v1.SetRoomMetadataRequest, v1.SetRoomMetadataResponse]
+	setRoomMotd            *connect.Client[v1.SetRoomMotdRequest, v1.SetRoomMotdResponse]
+	closeRoom              *connect.Client[v1.CloseRoomRequest, v1.CloseRoomResponse]
+	kickUser               *connect.Client[v1.KickUserRequest, v1.KickUserResponse]
+	broadcastMessage       *connect.Client[v1.BroadcastMessageRequest, v1.BroadcastMessageResponse]
+	createAccount          *connect.Client[v1.CreateAccountRequest, v1.CreateAccountResponse]
+	deleteAccount          *connect.Client[v1.DeleteAccountRequest, v1.DeleteAccountResponse]
+	updateAccountPassword  *connect.Client[v1.UpdateAccountPasswordRequest, v1.UpdateAccountPasswordResponse]
+	setAccountGuest        *connect.Client[v1.SetAccountGuestRequest, v1.SetAccountGuestResponse]
+	createInviteCode       *connect.Client[v1.CreateInviteCodeRequest, v1.CreateInviteCodeResponse]
+	getInviteCodes         *connect.Client[v1.GetInviteCodesRequest, v1.GetInviteCodesResponse]
+	deleteInviteCode       *connect.Client[v1.DeleteInviteCodeRequest, v1.DeleteInviteCodeResponse]
+	createInviteBundle     *connect.Client[v1.CreateInviteBundleRequest, v1.CreateInviteBundleResponse]
+	listStreams            *connect.Client[v1.ListStreamsRequest, v1.ListStreamsResponse]
+	cancelStream           *connect.Client[v1.CancelStreamRequest, v1.CancelStreamResponse]
+	getMigrationStatus     *connect.Client[v1.GetMigrationStatusRequest, v1.GetMigrationStatusResponse]
+	backupDatabase         *connect.Client[v1.BackupDatabaseRequest, v1.BackupDatabaseResponse]
+	checkDatabaseIntegrity *connect.Client[v1.CheckDatabaseIntegrityRequest, v1.CheckDatabaseIntegrityResponse]
+	getRelayLimits         *connect.Client[v1.GetRelayLimitsRequest, v1.GetRelayLimitsResponse]
+	setRelayLimits         *connect.Client[v1.SetRelayLimitsRequest, v1.SetRelayLimitsResponse]
+	getLobbySettings       *connect.Client[v1.GetLobbySettingsRequest, v1.GetLobbySettingsResponse]
+	updateLobbySettings    *connect.Client[v1.UpdateLobbySettingsRequest, v1.UpdateLobbySettingsResponse]
+	getLobbyStats          *connect.Client[v1.GetLobbyStatsRequest, v1.GetLobbyStatsResponse]
+	getRoomStats           *connect.Client[v1.GetRoomStatsRequest, v1.GetRoomStatsResponse]
+	drain                  *connect.Client[v1.DrainRequest, v1.DrainResponse]
+	validateConfig         *connect.Client[v1.ValidateConfigRequest, v1.ValidateConfigResponse]
+	exportRoom             *connect.Client[v1.ExportRoomRequest, v1.ExportRoomResponse]
+	importRoom             *connect.Client[v1.ImportRoomRequest, v1.ImportRoomResponse]
 }
 
 // GetServerInfo calls pb.serverrpc.v1.ServerRpcService.GetServerInfo.
@@ -274,6 +665,69 @@ func (c *serverRpcServiceClient) DeleteRoom(ctx context.Context, req *v1.DeleteR
 	return nil, err
 }
 
+// SetRoomLimits calls pb.serverrpc.v1.ServerRpcService.SetRoomLimits.
+func (c *serverRpcServiceClient) SetRoomLimits(ctx context.Context, req *v1.SetRoomLimitsRequest) (*v1.SetRoomLimitsResponse, error) {
+	response, err := c.setRoomLimits.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetRoomDirCacheTtl calls pb.serverrpc.v1.ServerRpcService.SetRoomDirCacheTtl.
+func (c *serverRpcServiceClient) SetRoomDirCacheTtl(ctx context.Context, req *v1.SetRoomDirCacheTtlRequest) (*v1.SetRoomDirCacheTtlResponse, error) {
+	response, err := c.setRoomDirCacheTtl.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetRoomMetadata calls pb.serverrpc.v1.ServerRpcService.SetRoomMetadata.
+func (c *serverRpcServiceClient) SetRoomMetadata(ctx context.Context, req *v1.SetRoomMetadataRequest) (*v1.SetRoomMetadataResponse, error) {
+	response, err := c.setRoomMetadata.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetRoomMotd calls pb.serverrpc.v1.ServerRpcService.SetRoomMotd.
+func (c *serverRpcServiceClient) SetRoomMotd(ctx context.Context, req *v1.SetRoomMotdRequest) (*v1.SetRoomMotdResponse, error) {
+	response, err := c.setRoomMotd.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CloseRoom calls pb.serverrpc.v1.ServerRpcService.CloseRoom.
+func (c *serverRpcServiceClient) CloseRoom(ctx context.Context, req *v1.CloseRoomRequest) (*v1.CloseRoomResponse, error) {
+	response, err := c.closeRoom.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// KickUser calls pb.serverrpc.v1.ServerRpcService.KickUser.
+func (c *serverRpcServiceClient) KickUser(ctx context.Context, req *v1.KickUserRequest) (*v1.KickUserResponse, error) {
+	response, err := c.kickUser.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BroadcastMessage calls pb.serverrpc.v1.ServerRpcService.BroadcastMessage.
+func (c *serverRpcServiceClient) BroadcastMessage(ctx context.Context, req *v1.BroadcastMessageRequest) (*v1.BroadcastMessageResponse, error) {
+	response, err := c.broadcastMessage.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // CreateAccount calls pb.serverrpc.v1.ServerRpcService.CreateAccount.
 func (c *serverRpcServiceClient) CreateAccount(ctx context.Context, req *v1.CreateAccountRequest) (*v1.CreateAccountResponse, error) {
 	response, err := c.createAccount.CallUnary(ctx, connect.NewRequest(req))
@@ -301,12 +755,190 @@ func (c *serverRpcServiceClient) UpdateAccountPassword(ctx context.Context, req
 	return nil, err
 }
 
+// SetAccountGuest calls pb.serverrpc.v1.ServerRpcService.SetAccountGuest.
+func (c *serverRpcServiceClient) SetAccountGuest(ctx context.Context, req *v1.SetAccountGuestRequest) (*v1.SetAccountGuestResponse, error) {
+	response, err := c.setAccountGuest.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateInviteCode calls pb.serverrpc.v1.ServerRpcService.CreateInviteCode.
+func (c *serverRpcServiceClient) CreateInviteCode(ctx context.Context, req *v1.CreateInviteCodeRequest) (*v1.CreateInviteCodeResponse, error) {
+	response, err := c.createInviteCode.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetInviteCodes calls pb.serverrpc.v1.ServerRpcService.GetInviteCodes.
+func (c *serverRpcServiceClient) GetInviteCodes(ctx context.Context, req *v1.GetInviteCodesRequest) (*v1.GetInviteCodesResponse, error) {
+	response, err := c.getInviteCodes.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// DeleteInviteCode calls pb.serverrpc.v1.ServerRpcService.DeleteInviteCode.
+func (c *serverRpcServiceClient) DeleteInviteCode(ctx context.Context, req *v1.DeleteInviteCodeRequest) (*v1.DeleteInviteCodeResponse, error) {
+	response, err := c.deleteInviteCode.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CreateInviteBundle calls pb.serverrpc.v1.ServerRpcService.CreateInviteBundle.
+func (c *serverRpcServiceClient) CreateInviteBundle(ctx context.Context, req *v1.CreateInviteBundleRequest) (*v1.CreateInviteBundleResponse, error) {
+	response, err := c.createInviteBundle.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ListStreams calls pb.serverrpc.v1.ServerRpcService.ListStreams.
+func (c *serverRpcServiceClient) ListStreams(ctx context.Context, req *v1.ListStreamsRequest) (*v1.ListStreamsResponse, error) {
+	response, err := c.listStreams.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CancelStream calls pb.serverrpc.v1.ServerRpcService.CancelStream.
+func (c *serverRpcServiceClient) CancelStream(ctx context.Context, req *v1.CancelStreamRequest) (*v1.CancelStreamResponse, error) {
+	response, err := c.cancelStream.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetMigrationStatus calls pb.serverrpc.v1.ServerRpcService.GetMigrationStatus.
+func (c *serverRpcServiceClient) GetMigrationStatus(ctx context.Context, req *v1.GetMigrationStatusRequest) (*v1.GetMigrationStatusResponse, error) {
+	response, err := c.getMigrationStatus.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// BackupDatabase calls pb.serverrpc.v1.ServerRpcService.BackupDatabase.
+func (c *serverRpcServiceClient) BackupDatabase(ctx context.Context, req *v1.BackupDatabaseRequest) (*v1.BackupDatabaseResponse, error) {
+	response, err := c.backupDatabase.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// CheckDatabaseIntegrity calls pb.serverrpc.v1.ServerRpcService.CheckDatabaseIntegrity.
+func (c *serverRpcServiceClient) CheckDatabaseIntegrity(ctx context.Context, req *v1.CheckDatabaseIntegrityRequest) (*v1.CheckDatabaseIntegrityResponse, error) {
+	response, err := c.checkDatabaseIntegrity.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetRelayLimits calls pb.serverrpc.v1.ServerRpcService.GetRelayLimits.
+func (c *serverRpcServiceClient) GetRelayLimits(ctx context.Context, req *v1.GetRelayLimitsRequest) (*v1.GetRelayLimitsResponse, error) {
+	response, err := c.getRelayLimits.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// SetRelayLimits calls pb.serverrpc.v1.ServerRpcService.SetRelayLimits.
+func (c *serverRpcServiceClient) SetRelayLimits(ctx context.Context, req *v1.SetRelayLimitsRequest) (*v1.SetRelayLimitsResponse, error) {
+	response, err := c.setRelayLimits.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetLobbySettings calls pb.serverrpc.v1.ServerRpcService.GetLobbySettings.
+func (c *serverRpcServiceClient) GetLobbySettings(ctx context.Context, req *v1.GetLobbySettingsRequest) (*v1.GetLobbySettingsResponse, error) {
+	response, err := c.getLobbySettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// UpdateLobbySettings calls pb.serverrpc.v1.ServerRpcService.UpdateLobbySettings.
+func (c *serverRpcServiceClient) UpdateLobbySettings(ctx context.Context, req *v1.UpdateLobbySettingsRequest) (*v1.UpdateLobbySettingsResponse, error) {
+	response, err := c.updateLobbySettings.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetLobbyStats calls pb.serverrpc.v1.ServerRpcService.GetLobbyStats.
+func (c *serverRpcServiceClient) GetLobbyStats(ctx context.Context, req *v1.GetLobbyStatsRequest) (*v1.GetLobbyStatsResponse, error) {
+	response, err := c.getLobbyStats.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// GetRoomStats calls pb.serverrpc.v1.ServerRpcService.GetRoomStats.
+func (c *serverRpcServiceClient) GetRoomStats(ctx context.Context, req *v1.GetRoomStatsRequest) (*v1.GetRoomStatsResponse, error) {
+	response, err := c.getRoomStats.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// Drain calls pb.serverrpc.v1.ServerRpcService.Drain.
+func (c *serverRpcServiceClient) Drain(ctx context.Context, req *v1.DrainRequest) (*connect.ServerStreamForClient[v1.DrainResponse], error) {
+	return c.drain.CallServerStream(ctx, connect.NewRequest(req))
+}
+
+// ValidateConfig calls pb.serverrpc.v1.ServerRpcService.ValidateConfig.
+func (c *serverRpcServiceClient) ValidateConfig(ctx context.Context, req *v1.ValidateConfigRequest) (*v1.ValidateConfigResponse, error) {
+	response, err := c.validateConfig.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ExportRoom calls pb.serverrpc.v1.ServerRpcService.ExportRoom.
+func (c *serverRpcServiceClient) ExportRoom(ctx context.Context, req *v1.ExportRoomRequest) (*v1.ExportRoomResponse, error) {
+	response, err := c.exportRoom.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
+// ImportRoom calls pb.serverrpc.v1.ServerRpcService.ImportRoom.
+func (c *serverRpcServiceClient) ImportRoom(ctx context.Context, req *v1.ImportRoomRequest) (*v1.ImportRoomResponse, error) {
+	response, err := c.importRoom.CallUnary(ctx, connect.NewRequest(req))
+	if response != nil {
+		return response.Msg, err
+	}
+	return nil, err
+}
+
 // ServerRpcServiceHandler is an implementation of the pb.serverrpc.v1.ServerRpcService service.
 type ServerRpcServiceHandler interface {
-	// GetServerInfo returns information about the server.
+	// GetServerInfo returns information about the server, such as its version and enabled features, so that UIs and
+	// CLIs can display it and only offer features the server supports.
 	// It also returns information about the RPC interface used to call the method.
 	GetServerInfo(context.Context, *v1.GetServerInfoRequest) (*v1.GetServerInfoResponse, error)
-	// GetRooms returns a list of all rooms in the server.
+	// GetRooms returns a list of the rooms in the server.
+	// Unlisted rooms are only included if requested.
 	GetRooms(context.Context, *v1.GetRoomsRequest) (*v1.GetRoomsResponse, error)
 	// GetRoomInfo returns information about a room.
 	// Returns status code NOT_FOUND if no such room exists.
@@ -317,16 +949,48 @@ type ServerRpcServiceHandler interface {
 	// GetOnlineUserInfo returns information about an online user.
 	// Returns status code NOT_FOUND if the user is not online or does not exist.
 	GetOnlineUserInfo(context.Context, *v1.GetOnlineUserInfoRequest) (*v1.GetOnlineUserInfoResponse, error)
-	// GetAccounts returns all accounts in a room.
+	// GetAccounts returns a page of accounts in a room.
+	// Use the returned cursor to get the following pages.
 	// Returns status code NOT_FOUND if no such room exists.
 	GetAccounts(context.Context, *v1.GetAccountsRequest) (*v1.GetAccountsResponse, error)
 	// CreateRoom creates a new room.
 	// Returns status code ALREADY_EXISTS if a room with the same name already exists.
+	// Returns status code INVALID_ARGUMENT if the description is too long.
 	CreateRoom(context.Context, *v1.CreateRoomRequest) (*v1.CreateRoomResponse, error)
 	// DeleteRoom deletes an existing room.
 	// Any connected users are disconnected before deletion.
 	// Returns status code NOT_FOUND if no such room exists.
 	DeleteRoom(context.Context, *v1.DeleteRoomRequest) (*v1.DeleteRoomResponse, error)
+	// SetRoomLimits sets a room's capacity and concurrency limits.
+	// Lowering the limits does not disconnect online users or close open streams; they only apply to new ones.
+	// Returns status code NOT_FOUND if no such room exists.
+	SetRoomLimits(context.Context, *v1.SetRoomLimitsRequest) (*v1.SetRoomLimitsResponse, error)
+	// SetRoomDirCacheTtl sets how long directory listings proxied in a room are cached.
+	// Cached listings are only served while the sharing user's files are unchanged, so a short TTL mostly just
+	// bounds memory use.
+	// Returns status code NOT_FOUND if no such room exists.
+	SetRoomDirCacheTtl(context.Context, *v1.SetRoomDirCacheTtlRequest) (*v1.SetRoomDirCacheTtlResponse, error)
+	// SetRoomMetadata sets a room's description and whether it is listed.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code INVALID_ARGUMENT if the description is too long.
+	SetRoomMetadata(context.Context, *v1.SetRoomMetadataRequest) (*v1.SetRoomMetadataResponse, error)
+	// SetRoomMotd sets a room's message of the day, which is sent to clients when they join the room.
+	// Clients that are already in the room receive it the next time they join.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code INVALID_ARGUMENT if the message is too long.
+	SetRoomMotd(context.Context, *v1.SetRoomMotdRequest) (*v1.SetRoomMotdResponse, error)
+	// CloseRoom disconnects all users in a room and cancels its open streams, without deleting the room.
+	// The room keeps its accounts and settings, and users may reconnect afterward.
+	// Returns status code NOT_FOUND if no such room exists.
+	CloseRoom(context.Context, *v1.CloseRoomRequest) (*v1.CloseRoomResponse, error)
+	// KickUser disconnects an online user from a room.
+	// It does not delete or otherwise change their account, so they may reconnect afterward.
+	// Returns status code NOT_FOUND if no such room exists or the user is not online.
+	KickUser(context.Context, *v1.KickUserRequest) (*v1.KickUserResponse, error)
+	// BroadcastMessage sends a notice to every online user in a room.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code INVALID_ARGUMENT if the text is empty or too long.
+	BroadcastMessage(context.Context, *v1.BroadcastMessageRequest) (*v1.BroadcastMessageResponse, error)
 	// CreateAccount creates a new account in a room.
 	// It can generate a password if none is given.
 	// Returns status code NOT_FOUND if no such room exists.
@@ -342,6 +1006,94 @@ type ServerRpcServiceHandler interface {
 	// Returns status code NOT_FOUND if no such room exists.
 	// Returns status code NOT_FOUND if no such account exists.
 	UpdateAccountPassword(context.Context, *v1.UpdateAccountPasswordRequest) (*v1.UpdateAccountPasswordResponse, error)
+	// SetAccountGuest sets whether an account is a guest account.
+	// Guests can browse and download, but cannot share files or change their password.
+	// If the user is online, the change applies immediately.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code NOT_FOUND if no such account exists.
+	SetAccountGuest(context.Context, *v1.SetAccountGuestRequest) (*v1.SetAccountGuestResponse, error)
+	// CreateInviteCode creates a new single-use invite code for registering an account in a room.
+	// Returns status code NOT_FOUND if no such room exists.
+	CreateInviteCode(context.Context, *v1.CreateInviteCodeRequest) (*v1.CreateInviteCodeResponse, error)
+	// GetInviteCodes returns all unused invite codes for a room.
+	// Returns status code NOT_FOUND if no such room exists.
+	GetInviteCodes(context.Context, *v1.GetInviteCodesRequest) (*v1.GetInviteCodesResponse, error)
+	// DeleteInviteCode deletes an unused invite code.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code NOT_FOUND if no such invite code exists.
+	DeleteInviteCode(context.Context, *v1.DeleteInviteCodeRequest) (*v1.DeleteInviteCodeResponse, error)
+	// CreateInviteBundle creates an invite bundle URL for a room, including the server's certificate fingerprint.
+	// Clients can import it to add the server without entering its details or trusting its certificate blindly.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code INVALID_ARGUMENT if the address is empty.
+	CreateInviteBundle(context.Context, *v1.CreateInviteBundleRequest) (*v1.CreateInviteBundleResponse, error)
+	// ListStreams returns all open proxied streams between clients.
+	// Returns status code NOT_FOUND if a room is specified and no such room exists.
+	ListStreams(context.Context, *v1.ListStreamsRequest) (*v1.ListStreamsResponse, error)
+	// CancelStream closes an open proxied stream, interrupting whatever transfer is using it.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code NOT_FOUND if no such stream exists.
+	CancelStream(context.Context, *v1.CancelStreamRequest) (*v1.CancelStreamResponse, error)
+	// GetMigrationStatus returns the state of the server database's schema migrations.
+	// Operators can use it to check the schema before upgrading or downgrading the server.
+	GetMigrationStatus(context.Context, *v1.GetMigrationStatusRequest) (*v1.GetMigrationStatusResponse, error)
+	// BackupDatabase writes a consistent copy of the server's database to a file on the server while it keeps running.
+	// Returns status code INVALID_ARGUMENT if the path is empty.
+	// Returns status code ALREADY_EXISTS if the file already exists.
+	// Returns status code UNIMPLEMENTED if the database is not SQLite.
+	BackupDatabase(context.Context, *v1.BackupDatabaseRequest) (*v1.BackupDatabaseResponse, error)
+	// CheckDatabaseIntegrity checks the server's database for corruption.
+	// It may take a while for large databases.
+	// Returns status code UNIMPLEMENTED if the database is not SQLite.
+	CheckDatabaseIntegrity(context.Context, *v1.CheckDatabaseIntegrityRequest) (*v1.CheckDatabaseIntegrityResponse, error)
+	// GetRelayLimits returns the bandwidth limits for proxied streams.
+	GetRelayLimits(context.Context, *v1.GetRelayLimitsRequest) (*v1.GetRelayLimitsResponse, error)
+	// SetRelayLimits replaces the bandwidth limits for proxied streams.
+	// They apply immediately, without clients reconnecting.
+	// Changes are not saved to the config file, so they last until the server restarts.
+	// Returns status code INVALID_ARGUMENT if a window is invalid.
+	SetRelayLimits(context.Context, *v1.SetRelayLimitsRequest) (*v1.SetRelayLimitsResponse, error)
+	// GetLobbySettings returns the lobby timeout, accepted protocol versions and concurrency limit.
+	GetLobbySettings(context.Context, *v1.GetLobbySettingsRequest) (*v1.GetLobbySettingsResponse, error)
+	// UpdateLobbySettings changes the lobby timeout, accepted protocol versions and concurrency limit.
+	// Only the fields that are set are changed. Changes apply to new connections, without restarting the server.
+	// Changes are not saved to the config file, so they last until the server restarts.
+	// Returns status code INVALID_ARGUMENT if the resulting settings are invalid.
+	UpdateLobbySettings(context.Context, *v1.UpdateLobbySettingsRequest) (*v1.UpdateLobbySettingsResponse, error)
+	// GetLobbyStats returns counters of connections that were accepted into or rejected from the lobby since the server
+	// started, such as to monitor for connection floods.
+	GetLobbyStats(context.Context, *v1.GetLobbyStatsRequest) (*v1.GetLobbyStatsResponse, error)
+	// GetRoomStats returns the periodic snapshots of a room's online client count and relayed bytes taken within a
+	// time range, such as to graph its usage over time.
+	// Snapshots older than the server's configured retention are not available.
+	// Returns status code NOT_FOUND if no such room exists.
+	// Returns status code INVALID_ARGUMENT if the range ends before it starts.
+	GetRoomStats(context.Context, *v1.GetRoomStatsRequest) (*v1.GetRoomStatsResponse, error)
+	// Drain starts draining the server, such as before stopping it for an upgrade while another server takes over on
+	// a different address. New connections are closed with an unavailable close code, and new proxied streams are
+	// refused as if the target were offline, so clients try again later. Existing connections and proxied streams are
+	// left to finish.
+	// Draining lasts until the server restarts. Calling Drain while already draining only reports progress.
+	//
+	// Progress is streamed every second until no proxied streams are open, after which the server can be stopped
+	// without interrupting transfers.
+	Drain(context.Context, *v1.DrainRequest, *connect.ServerStream[v1.DrainResponse]) error
+	// ValidateConfig checks a server config for problems, such as invalid or overlapping addresses, unknown RPC methods
+	// and unknown fields, and reports all of them at once.
+	// Returns status code FAILED_PRECONDITION if no config JSON is specified and the server was not started with a
+	// config file.
+	ValidateConfig(context.Context, *v1.ValidateConfigRequest) (*v1.ValidateConfigResponse, error)
+	// ExportRoom returns a portable archive of a room's settings, accounts with their password hashes and unused invite
+	// codes, which can be imported into another server with ImportRoom.
+	// The archive contains password hashes, so it should be kept as secret as the database.
+	// Returns status code NOT_FOUND if no such room exists.
+	ExportRoom(context.Context, *v1.ExportRoomRequest) (*v1.ExportRoomResponse, error)
+	// ImportRoom creates a new room from an archive made by ExportRoom, including its accounts, so users can log in
+	// with the same passwords. The room is either imported in full or not at all.
+	// Invite codes that are already used by another room are left out.
+	// Returns status code ALREADY_EXISTS if a room with the same name already exists.
+	// Returns status code INVALID_ARGUMENT if the archive or name is invalid, or the archive version is not supported.
+	ImportRoom(context.Context, *v1.ImportRoomRequest) (*v1.ImportRoomResponse, error)
 }
 
 // NewServerRpcServiceHandler builds an HTTP handler from the service implementation. It returns the
@@ -399,6 +1151,48 @@ func NewServerRpcServiceHandler(svc ServerRpcServiceHandler, opts ...connect.Han
 		connect.WithSchema(serverRpcServiceMethods.ByName("DeleteRoom")),
 		connect.WithHandlerOptions(opts...),
 	)
+	serverRpcServiceSetRoomLimitsHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceSetRoomLimitsProcedure,
+		svc.SetRoomLimits,
+		connect.WithSchema(serverRpcServiceMethods.ByName("SetRoomLimits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceSetRoomDirCacheTtlHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceSetRoomDirCacheTtlProcedure,
+		svc.SetRoomDirCacheTtl,
+		connect.WithSchema(serverRpcServiceMethods.ByName("SetRoomDirCacheTtl")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceSetRoomMetadataHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceSetRoomMetadataProcedure,
+		svc.SetRoomMetadata,
+		connect.WithSchema(serverRpcServiceMethods.ByName("SetRoomMetadata")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceSetRoomMotdHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceSetRoomMotdProcedure,
+		svc.SetRoomMotd,
+		connect.WithSchema(serverRpcServiceMethods.ByName("SetRoomMotd")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceCloseRoomHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceCloseRoomProcedure,
+		svc.CloseRoom,
+		connect.WithSchema(serverRpcServiceMethods.ByName("CloseRoom")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceKickUserHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceKickUserProcedure,
+		svc.KickUser,
+		connect.WithSchema(serverRpcServiceMethods.ByName("KickUser")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceBroadcastMessageHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceBroadcastMessageProcedure,
+		svc.BroadcastMessage,
+		connect.WithSchema(serverRpcServiceMethods.ByName("BroadcastMessage")),
+		connect.WithHandlerOptions(opts...),
+	)
 	serverRpcServiceCreateAccountHandler := connect.NewUnaryHandlerSimple(
 		ServerRpcServiceCreateAccountProcedure,
 		svc.CreateAccount,
@@ -417,6 +1211,126 @@ func NewServerRpcServiceHandler(svc ServerRpcServiceHandler, opts ...connect.Han
 		connect.WithSchema(serverRpcServiceMethods.ByName("UpdateAccountPassword")),
 		connect.WithHandlerOptions(opts...),
 	)
+	serverRpcServiceSetAccountGuestHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceSetAccountGuestProcedure,
+		svc.SetAccountGuest,
+		connect.WithSchema(serverRpcServiceMethods.ByName("SetAccountGuest")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceCreateInviteCodeHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceCreateInviteCodeProcedure,
+		svc.CreateInviteCode,
+		connect.WithSchema(serverRpcServiceMethods.ByName("CreateInviteCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceGetInviteCodesHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceGetInviteCodesProcedure,
+		svc.GetInviteCodes,
+		connect.WithSchema(serverRpcServiceMethods.ByName("GetInviteCodes")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceDeleteInviteCodeHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceDeleteInviteCodeProcedure,
+		svc.DeleteInviteCode,
+		connect.WithSchema(serverRpcServiceMethods.ByName("DeleteInviteCode")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceCreateInviteBundleHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceCreateInviteBundleProcedure,
+		svc.CreateInviteBundle,
+		connect.WithSchema(serverRpcServiceMethods.ByName("CreateInviteBundle")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceListStreamsHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceListStreamsProcedure,
+		svc.ListStreams,
+		connect.WithSchema(serverRpcServiceMethods.ByName("ListStreams")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceCancelStreamHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceCancelStreamProcedure,
+		svc.CancelStream,
+		connect.WithSchema(serverRpcServiceMethods.ByName("CancelStream")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceGetMigrationStatusHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceGetMigrationStatusProcedure,
+		svc.GetMigrationStatus,
+		connect.WithSchema(serverRpcServiceMethods.ByName("GetMigrationStatus")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceBackupDatabaseHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceBackupDatabaseProcedure,
+		svc.BackupDatabase,
+		connect.WithSchema(serverRpcServiceMethods.ByName("BackupDatabase")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceCheckDatabaseIntegrityHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceCheckDatabaseIntegrityProcedure,
+		svc.CheckDatabaseIntegrity,
+		connect.WithSchema(serverRpcServiceMethods.ByName("CheckDatabaseIntegrity")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceGetRelayLimitsHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceGetRelayLimitsProcedure,
+		svc.GetRelayLimits,
+		connect.WithSchema(serverRpcServiceMethods.ByName("GetRelayLimits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceSetRelayLimitsHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceSetRelayLimitsProcedure,
+		svc.SetRelayLimits,
+		connect.WithSchema(serverRpcServiceMethods.ByName("SetRelayLimits")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceGetLobbySettingsHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceGetLobbySettingsProcedure,
+		svc.GetLobbySettings,
+		connect.WithSchema(serverRpcServiceMethods.ByName("GetLobbySettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceUpdateLobbySettingsHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceUpdateLobbySettingsProcedure,
+		svc.UpdateLobbySettings,
+		connect.WithSchema(serverRpcServiceMethods.ByName("UpdateLobbySettings")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceGetLobbyStatsHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceGetLobbyStatsProcedure,
+		svc.GetLobbyStats,
+		connect.WithSchema(serverRpcServiceMethods.ByName("GetLobbyStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceGetRoomStatsHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceGetRoomStatsProcedure,
+		svc.GetRoomStats,
+		connect.WithSchema(serverRpcServiceMethods.ByName("GetRoomStats")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceDrainHandler := connect.NewServerStreamHandlerSimple(
+		ServerRpcServiceDrainProcedure,
+		svc.Drain,
+		connect.WithSchema(serverRpcServiceMethods.ByName("Drain")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceValidateConfigHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceValidateConfigProcedure,
+		svc.ValidateConfig,
+		connect.WithSchema(serverRpcServiceMethods.ByName("ValidateConfig")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceExportRoomHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceExportRoomProcedure,
+		svc.ExportRoom,
+		connect.WithSchema(serverRpcServiceMethods.ByName("ExportRoom")),
+		connect.WithHandlerOptions(opts...),
+	)
+	serverRpcServiceImportRoomHandler := connect.NewUnaryHandlerSimple(
+		ServerRpcServiceImportRoomProcedure,
+		svc.ImportRoom,
+		connect.WithSchema(serverRpcServiceMethods.ByName("ImportRoom")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/pb.serverrpc.v1.ServerRpcService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case ServerRpcServiceGetServerInfoProcedure:
@@ -435,12 +1349,66 @@ func NewServerRpcServiceHandler(svc ServerRpcServiceHandler, opts ...connect.Han
 			serverRpcServiceCreateRoomHandler.ServeHTTP(w, r)
 		case ServerRpcServiceDeleteRoomProcedure:
 			serverRpcServiceDeleteRoomHandler.ServeHTTP(w, r)
+		case ServerRpcServiceSetRoomLimitsProcedure:
+			serverRpcServiceSetRoomLimitsHandler.ServeHTTP(w, r)
+		case ServerRpcServiceSetRoomDirCacheTtlProcedure:
+			serverRpcServiceSetRoomDirCacheTtlHandler.ServeHTTP(w, r)
+		case ServerRpcServiceSetRoomMetadataProcedure:
+			serverRpcServiceSetRoomMetadataHandler.ServeHTTP(w, r)
+		case ServerRpcServiceSetRoomMotdProcedure:
+			serverRpcServiceSetRoomMotdHandler.ServeHTTP(w, r)
+		case ServerRpcServiceCloseRoomProcedure:
+			serverRpcServiceCloseRoomHandler.ServeHTTP(w, r)
+		case ServerRpcServiceKickUserProcedure:
+			serverRpcServiceKickUserHandler.ServeHTTP(w, r)
+		case ServerRpcServiceBroadcastMessageProcedure:
+			serverRpcServiceBroadcastMessageHandler.ServeHTTP(w, r)
 		case ServerRpcServiceCreateAccountProcedure:
 			serverRpcServiceCreateAccountHandler.ServeHTTP(w, r)
 		case ServerRpcServiceDeleteAccountProcedure:
 			serverRpcServiceDeleteAccountHandler.ServeHTTP(w, r)
 		case ServerRpcServiceUpdateAccountPasswordProcedure:
 			serverRpcServiceUpdateAccountPasswordHandler.ServeHTTP(w, r)
+		case ServerRpcServiceSetAccountGuestProcedure:
+			serverRpcServiceSetAccountGuestHandler.ServeHTTP(w, r)
+		case ServerRpcServiceCreateInviteCodeProcedure:
+			serverRpcServiceCreateInviteCodeHandler.ServeHTTP(w, r)
+		case ServerRpcServiceGetInviteCodesProcedure:
+			serverRpcServiceGetInviteCodesHandler.ServeHTTP(w, r)
+		case ServerRpcServiceDeleteInviteCodeProcedure:
+			serverRpcServiceDeleteInviteCodeHandler.ServeHTTP(w, r)
+		case ServerRpcServiceCreateInviteBundleProcedure:
+			serverRpcServiceCreateInviteBundleHandler.ServeHTTP(w, r)
+		case ServerRpcServiceListStreamsProcedure:
+			serverRpcServiceListStreamsHandler.ServeHTTP(w, r)
+		case ServerRpcServiceCancelStreamProcedure:
+			serverRpcServiceCancelStreamHandler.ServeHTTP(w, r)
+		case ServerRpcServiceGetMigrationStatusProcedure:
+			serverRpcServiceGetMigrationStatusHandler.ServeHTTP(w, r)
+		case ServerRpcServiceBackupDatabaseProcedure:
+			serverRpcServiceBackupDatabaseHandler.ServeHTTP(w, r)
+		case ServerRpcServiceCheckDatabaseIntegrityProcedure:
+			serverRpcServiceCheckDatabaseIntegrityHandler.ServeHTTP(w, r)
+		case ServerRpcServiceGetRelayLimitsProcedure:
+			serverRpcServiceGetRelayLimitsHandler.ServeHTTP(w, r)
+		case ServerRpcServiceSetRelayLimitsProcedure:
+			serverRpcServiceSetRelayLimitsHandler.ServeHTTP(w, r)
+		case ServerRpcServiceGetLobbySettingsProcedure:
+			serverRpcServiceGetLobbySettingsHandler.ServeHTTP(w, r)
+		case ServerRpcServiceUpdateLobbySettingsProcedure:
+			serverRpcServiceUpdateLobbySettingsHandler.ServeHTTP(w, r)
+		case ServerRpcServiceGetLobbyStatsProcedure:
+			serverRpcServiceGetLobbyStatsHandler.ServeHTTP(w, r)
+		case ServerRpcServiceGetRoomStatsProcedure:
+			serverRpcServiceGetRoomStatsHandler.ServeHTTP(w, r)
+		case ServerRpcServiceDrainProcedure:
+			serverRpcServiceDrainHandler.ServeHTTP(w, r)
+		case ServerRpcServiceValidateConfigProcedure:
+			serverRpcServiceValidateConfigHandler.ServeHTTP(w, r)
+		case ServerRpcServiceExportRoomProcedure:
+			serverRpcServiceExportRoomHandler.ServeHTTP(w, r)
+		case ServerRpcServiceImportRoomProcedure:
+			serverRpcServiceImportRoomHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -482,6 +1450,34 @@ func (UnimplementedServerRpcServiceHandler) DeleteRoom(context.Context, *v1.Dele
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.DeleteRoom is not implemented"))
 }
 
+func (UnimplementedServerRpcServiceHandler) SetRoomLimits(context.Context, *v1.SetRoomLimitsRequest) (*v1.SetRoomLimitsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.SetRoomLimits is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) SetRoomDirCacheTtl(context.Context, *v1.SetRoomDirCacheTtlRequest) (*v1.SetRoomDirCacheTtlResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.SetRoomDirCacheTtl is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) SetRoomMetadata(context.Context, *v1.SetRoomMetadataRequest) (*v1.SetRoomMetadataResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.SetRoomMetadata is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) SetRoomMotd(context.Context, *v1.SetRoomMotdRequest) (*v1.SetRoomMotdResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.SetRoomMotd is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) CloseRoom(context.Context, *v1.CloseRoomRequest) (*v1.CloseRoomResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.CloseRoom is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) KickUser(context.Context, *v1.KickUserRequest) (*v1.KickUserResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.KickUser is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) BroadcastMessage(context.Context, *v1.BroadcastMessageRequest) (*v1.BroadcastMessageResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.BroadcastMessage is not implemented"))
+}
+
 func (UnimplementedServerRpcServiceHandler) CreateAccount(context.Context, *v1.CreateAccountRequest) (*v1.CreateAccountResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.CreateAccount is not implemented"))
 }
@@ -493,3 +1489,83 @@ func (UnimplementedServerRpcServiceHandler) DeleteAccount(context.Context, *v1.D
 func (UnimplementedServerRpcServiceHandler) UpdateAccountPassword(context.Context, *v1.UpdateAccountPasswordRequest) (*v1.UpdateAccountPasswordResponse, error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.UpdateAccountPassword is not implemented"))
 }
+
+func (UnimplementedServerRpcServiceHandler) SetAccountGuest(context.Context, *v1.SetAccountGuestRequest) (*v1.SetAccountGuestResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.SetAccountGuest is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) CreateInviteCode(context.Context, *v1.CreateInviteCodeRequest) (*v1.CreateInviteCodeResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.CreateInviteCode is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) GetInviteCodes(context.Context, *v1.GetInviteCodesRequest) (*v1.GetInviteCodesResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.GetInviteCodes is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) DeleteInviteCode(context.Context, *v1.DeleteInviteCodeRequest) (*v1.DeleteInviteCodeResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.DeleteInviteCode is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) CreateInviteBundle(context.Context, *v1.CreateInviteBundleRequest) (*v1.CreateInviteBundleResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.CreateInviteBundle is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) ListStreams(context.Context, *v1.ListStreamsRequest) (*v1.ListStreamsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.ListStreams is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) CancelStream(context.Context, *v1.CancelStreamRequest) (*v1.CancelStreamResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.CancelStream is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) GetMigrationStatus(context.Context, *v1.GetMigrationStatusRequest) (*v1.GetMigrationStatusResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.GetMigrationStatus is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) BackupDatabase(context.Context, *v1.BackupDatabaseRequest) (*v1.BackupDatabaseResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.BackupDatabase is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) CheckDatabaseIntegrity(context.Context, *v1.CheckDatabaseIntegrityRequest) (*v1.CheckDatabaseIntegrityResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.CheckDatabaseIntegrity is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) GetRelayLimits(context.Context, *v1.GetRelayLimitsRequest) (*v1.GetRelayLimitsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.GetRelayLimits is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) SetRelayLimits(context.Context, *v1.SetRelayLimitsRequest) (*v1.SetRelayLimitsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.SetRelayLimits is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) GetLobbySettings(context.Context, *v1.GetLobbySettingsRequest) (*v1.GetLobbySettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.GetLobbySettings is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) UpdateLobbySettings(context.Context, *v1.UpdateLobbySettingsRequest) (*v1.UpdateLobbySettingsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.UpdateLobbySettings is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) GetLobbyStats(context.Context, *v1.GetLobbyStatsRequest) (*v1.GetLobbyStatsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.GetLobbyStats is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) GetRoomStats(context.Context, *v1.GetRoomStatsRequest) (*v1.GetRoomStatsResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.GetRoomStats is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) Drain(context.Context, *v1.DrainRequest, *connect.ServerStream[v1.DrainResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.Drain is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) ValidateConfig(context.Context, *v1.ValidateConfigRequest) (*v1.ValidateConfigResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.ValidateConfig is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) ExportRoom(context.Context, *v1.ExportRoomRequest) (*v1.ExportRoomResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.ExportRoom is not implemented"))
+}
+
+func (UnimplementedServerRpcServiceHandler) ImportRoom(context.Context, *v1.ImportRoomRequest) (*v1.ImportRoomResponse, error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pb.serverrpc.v1.ServerRpcService.ImportRoom is not implemented"))
+}
@@ -56,6 +56,9 @@ const (
 	// [C2S] Request to open an outbound proxy to a connected peer.
 	// The server will cancel the stream with an error if the target peer could not be contacted.
 	// If successful, the stream will be converted to a proxied stream to the target peer.
+	// If the target peer is a guest, the server replies with MSG_TYPE_ERROR of ERR_TYPE_PERMISSION_DENIED, since guests do not share files.
+	// If the client already has the maximum number of concurrent proxied streams allowed by the room, the server replies
+	// with MSG_TYPE_ERROR of ERR_TYPE_RATE_LIMITED.
 	MsgType_MSG_TYPE_OPEN_OUTBOUND_PROXY MsgType = 11
 	// [S2C] Notification of a new inbound proxy stream from another peer.
 	// The client can choose to cancel the stream or send data on it.
@@ -79,7 +82,9 @@ const (
 	// Expected: Either:
 	//   - Message MSG_TYPE_FILE_META then the file's requested binary content until the stream is closed by receiver.
 	//     If the file is a directory, the size will be zero and no content will be sent.
+	//     If MSG_TYPE_FILE_META has checksum_chunk_size set, the content is interleaved with chunk checksums.
 	//   - Message MSG_TYPE_ERROR of ERR_TYPE_FILE_NOT_EXIST.
+	// After receiving MSG_TYPE_FILE_META, the requester may send MSG_TYPE_TRANSFER_CONTROL messages on the same bidi.
 	MsgType_MSG_TYPE_GET_FILE MsgType = 17
 	// [C2S] Request to get a list of online users in the room.
 	// Expected: Repeated message MSG_TYPE_ONLINE_USERS until stream is closed by receiver.
@@ -92,7 +97,9 @@ const (
 	MsgType_MSG_TYPE_BYE MsgType = 20
 	// [C2S] Advertises a connection method for clients to direct connect to the sender.
 	// The server may return CONN_RESULT_DID_NOT_TRY for IP addresses it refuses to connect to, such as LAN addresses.
-	// Expected: Message MSG_TYPE_ADVERTISE_CONN_METHOD_RESULT.
+	// Expected: Either:
+	//   - Message MSG_TYPE_ADVERTISE_CONN_METHOD_RESULT.
+	//   - Message MSG_TYPE_ERROR of ERR_TYPE_PERMISSION_DENIED if the client is a guest.
 	MsgType_MSG_TYPE_ADVERTISE_CONN_METHOD MsgType = 21
 	// [S2C] The result of the server attempting to direct connect to a client.
 	MsgType_MSG_TYPE_ADVERTISE_CONN_METHOD_RESULT MsgType = 22
@@ -141,7 +148,7 @@ const (
 	// [C2S] Requests changing the client's account password.
 	// Expected: Either:
 	//   - Message MSG_TYPE_ACKNOWLEDGED if successful.
-	//   - Message MSG_TYPE_ERROR of ERR_TYPE_PERMISSION_DENIED if the specified current password is incorrect.
+	//   - Message MSG_TYPE_ERROR of ERR_TYPE_PERMISSION_DENIED if the specified current password is incorrect or the client is a guest.
 	//   - Message MSG_TYPE_ERROR of ERR_TYPE_INVALID_FIELDS if the new password is empty or otherwise does not follow the server's password requirements.
 	MsgType_MSG_TYPE_CHANGE_ACCOUNT_PASSWORD MsgType = 36
 	// [S2C] Notification that a client went online.
@@ -164,27 +171,96 @@ const (
 	// Multiple messages of this type can be sent in the same bidi until the sender closes it.
 	// The receiver may close the bidi at any time.
 	MsgType_MSG_TYPE_DOWNLOAD_STATUS_UPDATE MsgType = 42
-	// [C2S, S2C] Used in the initiation of the NAT hole punching process.
-	// When C2S, it requests a hole punch to the specified user.
-	// The server will then send S2C to the target user and relay the response.
+	// [C2S] Requests a list of STUN servers the client can use to discover its public IP and port.
+	// Expected: MSG_TYPE_STUN_SERVERS
+	MsgType_MSG_TYPE_GET_STUN_SERVERS MsgType = 43
+	// [S2C] A list of STUN servers a client can use to discover its public IP and port.
+	MsgType_MSG_TYPE_STUN_SERVERS MsgType = 44
+	// [C2C] Sent by a client to a peer to initiate NAT hole punching.
+	// It includes the initiator's public IP address and port.
 	// Expected: Either:
-	//   - If C2S: Message MSG_TYPE_PUNCH_ENDPOINT
-	//   - If C2S: Message MSG_TYPE_ERROR of ERR_TYPE_CLIENT_NOT_ONLINE if the target user is not online.
-	//   - If S2C: Message MSG_TYPE_PUNCH_ACCEPT if the client accepted the hole punch request.
-	//   - If S2C: Message MSG_TYPE_PUNCH_REJECT if the client rejected the hole punch request.
-	MsgType_MSG_TYPE_START_PUNCH MsgType = 43
-	// [C2S] Used to confirm a NAT hole punching attempt.
-	MsgType_MSG_TYPE_PUNCH_ACCEPT MsgType = 44
+	//   - Message MSG_TYPE_PUNCH_ACCEPT if the client accepted the hole punch request.
+	//   - Message MSG_TYPE_PUNCH_REJECT if the client rejected the hole punch request.
+	MsgType_MSG_TYPE_PUNCH_OFFER MsgType = 45
+	// [C2C] Used to confirm a NAT hole punching attempt.
+	// It includes the peer's IP and port. The IP must be in the same family (IPv4 or IPv6) as the IP
+	// in the MSG_TYPE_PUNCH_OFFER that it is replying to.
+	MsgType_MSG_TYPE_PUNCH_ACCEPT MsgType = 46
 	// [C2S, S2C] When C2S, used to reject a NAT hole punching attempt.
 	// When S2C, it is the  forwarded rejection reason from the target client.
 	// If S2C, the stream will be closed after being sent.
-	MsgType_MSG_TYPE_PUNCH_REJECT MsgType = 45
-	// [S2C] Sent by the server and used by clients to validate with its discovery endpoint.
-	// This is sent to both clients after the target accepted the attempt.
-	MsgType_MSG_TYPE_PUNCH_TOKEN MsgType = 46
-	// [S2C] Sent by the server to each client in a NAT hole punch session once both sides have reached
-	// out to the discovery address. It contains the other side's IP address and port.
-	MsgType_MSG_TYPE_PUNCH_ADDRESS MsgType = 47
+	MsgType_MSG_TYPE_PUNCH_REJECT MsgType = 47
+	// [C2S] Request to create a new account and authenticate with it in one step.
+	// Sent in place of MSG_TYPE_AUTHENTICATE, and only accepted if the server has registration enabled.
+	// Expected: Either:
+	//   - Message MSG_TYPE_AUTH_ACCEPTED if the account was created and the client is now authenticated.
+	//   - Message MSG_TYPE_AUTH_REJECTED if registration failed.
+	MsgType_MSG_TYPE_REGISTER MsgType = 48
+	// [C2C] Pauses or resumes an in-progress file transfer started with MSG_TYPE_GET_FILE.
+	// Sent by the requester on the same bidi, after receiving MSG_TYPE_FILE_META.
+	// While paused, the sender stops writing file content. When resumed, it continues from where it stopped.
+	// No reply is sent. Senders that do not support this message ignore it.
+	MsgType_MSG_TYPE_TRANSFER_CONTROL MsgType = 49
+	// [C2C] A timed echo used to measure latency and throughput between clients.
+	// The requester may send more MSG_TYPE_MEASURE messages on the same bidi after the first, without waiting for
+	// replies. The receiver replies to each one in order until the requester closes the bidi.
+	// Expected: Message MSG_TYPE_MEASURE_REPLY for each MSG_TYPE_MEASURE sent.
+	MsgType_MSG_TYPE_MEASURE MsgType = 50
+	// [C2C] Reply to MSG_TYPE_MEASURE.
+	MsgType_MSG_TYPE_MEASURE_REPLY MsgType = 51
+	// [C2S] Announces the client's shares revision, a number that changes every time the client's shared files change.
+	// Sent after authenticating and again whenever the revision changes.
+	// The server may use it to cache directory listings that it proxies to the client.
+	// Expected: Message MSG_TYPE_ACKNOWLEDGED.
+	MsgType_MSG_TYPE_SHARES_REVISION MsgType = 52
+	// [C2S, S2C] Directs a bidi at an additional room joined with MSG_TYPE_JOIN_ROOM.
+	// Sent as the first message on the bidi, followed by the bidi's actual first message.
+	// Bidis that do not start with it belong to the room the connection authenticated with.
+	// Expected: Whatever the following message expects, or MSG_TYPE_ERROR of ERR_TYPE_INVALID_FIELDS if the scope is
+	// not known.
+	MsgType_MSG_TYPE_ROOM_SCOPE MsgType = 53
+	// [C2S] Joins an additional room over an already authenticated connection.
+	// The client chooses the new room's scope ID, which it then uses in MSG_TYPE_ROOM_SCOPE.
+	// Expected:
+	//   - Message MSG_TYPE_AUTH_ACCEPTED if the room was joined.
+	//   - Message MSG_TYPE_AUTH_REJECTED if joining failed.
+	//   - Message MSG_TYPE_ERROR of ERR_TYPE_INVALID_FIELDS if the scope ID is zero or already in use.
+	//   - Message MSG_TYPE_ERROR of ERR_TYPE_UNIMPLEMENTED if the server does not support joining multiple rooms over
+	//     one connection. The client should open a separate connection instead.
+	MsgType_MSG_TYPE_JOIN_ROOM MsgType = 54
+	// [C2S, S2C] Leaves the room the bidi is scoped to, without closing the connection.
+	// Either side sends it when it would otherwise close the connection while other rooms are still joined over it.
+	// If no rooms are left, the connection is closed instead.
+	// Expected: Message MSG_TYPE_ACKNOWLEDGED.
+	MsgType_MSG_TYPE_LEAVE_ROOM MsgType = 55
+	// [S2C] A notice from the server's operators, such as an announcement of upcoming maintenance.
+	// Sent to every client in a room.
+	MsgType_MSG_TYPE_SERVER_NOTICE MsgType = 56
+	// [C2S] Requests information about the server's software and the policies of the room the bidi is scoped to, so
+	// that the client can adapt its behavior to them.
+	// Expected: Either:
+	//   - Message MSG_TYPE_SERVER_INFO.
+	//   - Message MSG_TYPE_ERROR of ERR_TYPE_UNIMPLEMENTED if the server does not support it.
+	MsgType_MSG_TYPE_GET_SERVER_INFO MsgType = 57
+	// [S2C] Reply to MSG_TYPE_GET_SERVER_INFO.
+	MsgType_MSG_TYPE_SERVER_INFO MsgType = 58
+	// [C2S] Publishes the client's interests to the room, replacing any it published before.
+	// Interests are short tags, such as "jazz" or "field recordings", that let other users find people whose shares
+	// they might want to browse. They are kept until the client disconnects, so clients publish them after every
+	// connect.
+	// Guests cannot publish interests.
+	// Expected: Either:
+	//   - Message MSG_TYPE_ACKNOWLEDGED.
+	//   - Message MSG_TYPE_ERROR of ERR_TYPE_INVALID_FIELDS if an interest is invalid or there are too many.
+	//   - Message MSG_TYPE_ERROR of ERR_TYPE_UNIMPLEMENTED if the server does not support interests.
+	MsgType_MSG_TYPE_SET_INTERESTS MsgType = 59
+	// [C2S] Requests the online users in the room whose interests overlap with the client's, most overlapping first.
+	// Expected: Either:
+	//   - Message MSG_TYPE_SIMILAR_USERS.
+	//   - Message MSG_TYPE_ERROR of ERR_TYPE_UNIMPLEMENTED if the server does not support interests.
+	MsgType_MSG_TYPE_GET_SIMILAR_USERS MsgType = 60
+	// [S2C] Reply to MSG_TYPE_GET_SIMILAR_USERS.
+	MsgType_MSG_TYPE_SIMILAR_USERS MsgType = 61
 )
 
 // Enum value maps for MsgType.
@@ -233,11 +309,25 @@ var (
 		40: "MSG_TYPE_SEARCH_RESULT",
 		41: "MSG_TYPE_SEARCH_ROOM_RESULT",
 		42: "MSG_TYPE_DOWNLOAD_STATUS_UPDATE",
-		43: "MSG_TYPE_START_PUNCH",
-		44: "MSG_TYPE_PUNCH_ACCEPT",
-		45: "MSG_TYPE_PUNCH_REJECT",
-		46: "MSG_TYPE_PUNCH_TOKEN",
-		47: "MSG_TYPE_PUNCH_ADDRESS",
+		43: "MSG_TYPE_GET_STUN_SERVERS",
+		44: "MSG_TYPE_STUN_SERVERS",
+		45: "MSG_TYPE_PUNCH_OFFER",
+		46: "MSG_TYPE_PUNCH_ACCEPT",
+		47: "MSG_TYPE_PUNCH_REJECT",
+		48: "MSG_TYPE_REGISTER",
+		49: "MSG_TYPE_TRANSFER_CONTROL",
+		50: "MSG_TYPE_MEASURE",
+		51: "MSG_TYPE_MEASURE_REPLY",
+		52: "MSG_TYPE_SHARES_REVISION",
+		53: "MSG_TYPE_ROOM_SCOPE",
+		54: "MSG_TYPE_JOIN_ROOM",
+		55: "MSG_TYPE_LEAVE_ROOM",
+		56: "MSG_TYPE_SERVER_NOTICE",
+		57: "MSG_TYPE_GET_SERVER_INFO",
+		58: "MSG_TYPE_SERVER_INFO",
+		59: "MSG_TYPE_SET_INTERESTS",
+		60: "MSG_TYPE_GET_SIMILAR_USERS",
+		61: "MSG_TYPE_SIMILAR_USERS",
 	}
 	MsgType_value = map[string]int32{
 		"MSG_TYPE_UNSPECIFIED":                        0,
@@ -283,11 +373,25 @@ var (
 		"MSG_TYPE_SEARCH_RESULT":                      40,
 		"MSG_TYPE_SEARCH_ROOM_RESULT":                 41,
 		"MSG_TYPE_DOWNLOAD_STATUS_UPDATE":             42,
-		"MSG_TYPE_START_PUNCH":                        43,
-		"MSG_TYPE_PUNCH_ACCEPT":                       44,
-		"MSG_TYPE_PUNCH_REJECT":                       45,
-		"MSG_TYPE_PUNCH_TOKEN":                        46,
-		"MSG_TYPE_PUNCH_ADDRESS":                      47,
+		"MSG_TYPE_GET_STUN_SERVERS":                   43,
+		"MSG_TYPE_STUN_SERVERS":                       44,
+		"MSG_TYPE_PUNCH_OFFER":                        45,
+		"MSG_TYPE_PUNCH_ACCEPT":                       46,
+		"MSG_TYPE_PUNCH_REJECT":                       47,
+		"MSG_TYPE_REGISTER":                           48,
+		"MSG_TYPE_TRANSFER_CONTROL":                   49,
+		"MSG_TYPE_MEASURE":                            50,
+		"MSG_TYPE_MEASURE_REPLY":                      51,
+		"MSG_TYPE_SHARES_REVISION":                    52,
+		"MSG_TYPE_ROOM_SCOPE":                         53,
+		"MSG_TYPE_JOIN_ROOM":                          54,
+		"MSG_TYPE_LEAVE_ROOM":                         55,
+		"MSG_TYPE_SERVER_NOTICE":                      56,
+		"MSG_TYPE_GET_SERVER_INFO":                    57,
+		"MSG_TYPE_SERVER_INFO":                        58,
+		"MSG_TYPE_SET_INTERESTS":                      59,
+		"MSG_TYPE_GET_SIMILAR_USERS":                  60,
+		"MSG_TYPE_SIMILAR_USERS":                      61,
 	}
 )
 
@@ -411,6 +515,63 @@ func (ErrType) EnumDescriptor() ([]byte, []int) {
 	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{1}
 }
 
+// Optional protocol features that are negotiated during version negotiation, independently of the protocol version.
+type Capability int32
+
+const (
+	// Do not use.
+	Capability_CAPABILITY_UNSPECIFIED Capability = 0
+	// Small unreliable messages can be sent as QUIC DATAGRAM frames, for things that are fine to lose and would be
+	// stale if retransmitted, such as presence heartbeats or progress updates.
+	// Each datagram holds exactly one message with the same layout as on streams, and is not scoped to a room.
+	// Reliable data must still use streams.
+	Capability_CAPABILITY_DATAGRAMS Capability = 1
+	// The server honors the page_size of MSG_TYPE_GET_ONLINE_USERS.
+	// Without it, replies use the server's default page size.
+	Capability_CAPABILITY_PAGE_SIZE Capability = 2
+)
+
+// Enum value maps for Capability.
+var (
+	Capability_name = map[int32]string{
+		0: "CAPABILITY_UNSPECIFIED",
+		1: "CAPABILITY_DATAGRAMS",
+		2: "CAPABILITY_PAGE_SIZE",
+	}
+	Capability_value = map[string]int32{
+		"CAPABILITY_UNSPECIFIED": 0,
+		"CAPABILITY_DATAGRAMS":   1,
+		"CAPABILITY_PAGE_SIZE":   2,
+	}
+)
+
+func (x Capability) Enum() *Capability {
+	p := new(Capability)
+	*p = x
+	return p
+}
+
+func (x Capability) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (Capability) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_v1_protocol_proto_enumTypes[2].Descriptor()
+}
+
+func (Capability) Type() protoreflect.EnumType {
+	return &file_pb_v1_protocol_proto_enumTypes[2]
+}
+
+func (x Capability) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use Capability.Descriptor instead.
+func (Capability) EnumDescriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{2}
+}
+
 // Reasons for a client's version being rejected
 type VersionRejectionReason int32
 
@@ -449,11 +610,11 @@ func (x VersionRejectionReason) String() string {
 }
 
 func (VersionRejectionReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_pb_v1_protocol_proto_enumTypes[2].Descriptor()
+	return file_pb_v1_protocol_proto_enumTypes[3].Descriptor()
 }
 
 func (VersionRejectionReason) Type() protoreflect.EnumType {
-	return &file_pb_v1_protocol_proto_enumTypes[2]
+	return &file_pb_v1_protocol_proto_enumTypes[3]
 }
 
 func (x VersionRejectionReason) Number() protoreflect.EnumNumber {
@@ -462,7 +623,7 @@ func (x VersionRejectionReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use VersionRejectionReason.Descriptor instead.
 func (VersionRejectionReason) EnumDescriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{2}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{3}
 }
 
 // Reasons for a client's authentication request being rejected.
@@ -478,21 +639,47 @@ const (
 	AuthRejectionReason_AUTH_REJECTION_REASON_BANNED AuthRejectionReason = 3
 	// A client with the same username is already connected.
 	AuthRejectionReason_AUTH_REJECTION_REASON_ALREADY_CONNECTED AuthRejectionReason = 4
+	// Too many failed authentication attempts were made from the client's address or for the account.
+	// The client should wait before trying again.
+	AuthRejectionReason_AUTH_REJECTION_REASON_RATE_LIMITED AuthRejectionReason = 5
+	// The server does not accept registrations.
+	AuthRejectionReason_AUTH_REJECTION_REASON_REGISTRATION_DISABLED AuthRejectionReason = 6
+	// The invite code was missing, invalid or already used.
+	AuthRejectionReason_AUTH_REJECTION_REASON_INVALID_INVITE_CODE AuthRejectionReason = 7
+	// An account with the requested username already exists.
+	AuthRejectionReason_AUTH_REJECTION_REASON_USERNAME_TAKEN AuthRejectionReason = 8
+	// The requested password does not meet the server's password requirements.
+	// More details will be in the rejection message.
+	AuthRejectionReason_AUTH_REJECTION_REASON_INVALID_PASSWORD AuthRejectionReason = 9
+	// The room already has the maximum number of online clients it allows.
+	AuthRejectionReason_AUTH_REJECTION_REASON_ROOM_FULL AuthRejectionReason = 10
 )
 
 // Enum value maps for AuthRejectionReason.
 var (
 	AuthRejectionReason_name = map[int32]string{
-		0: "AUTH_REJECTION_REASON_UNSPECIFIED",
-		2: "AUTH_REJECTION_REASON_INVALID_CREDENTIALS",
-		3: "AUTH_REJECTION_REASON_BANNED",
-		4: "AUTH_REJECTION_REASON_ALREADY_CONNECTED",
+		0:  "AUTH_REJECTION_REASON_UNSPECIFIED",
+		2:  "AUTH_REJECTION_REASON_INVALID_CREDENTIALS",
+		3:  "AUTH_REJECTION_REASON_BANNED",
+		4:  "AUTH_REJECTION_REASON_ALREADY_CONNECTED",
+		5:  "AUTH_REJECTION_REASON_RATE_LIMITED",
+		6:  "AUTH_REJECTION_REASON_REGISTRATION_DISABLED",
+		7:  "AUTH_REJECTION_REASON_INVALID_INVITE_CODE",
+		8:  "AUTH_REJECTION_REASON_USERNAME_TAKEN",
+		9:  "AUTH_REJECTION_REASON_INVALID_PASSWORD",
+		10: "AUTH_REJECTION_REASON_ROOM_FULL",
 	}
 	AuthRejectionReason_value = map[string]int32{
-		"AUTH_REJECTION_REASON_UNSPECIFIED":         0,
-		"AUTH_REJECTION_REASON_INVALID_CREDENTIALS": 2,
-		"AUTH_REJECTION_REASON_BANNED":              3,
-		"AUTH_REJECTION_REASON_ALREADY_CONNECTED":   4,
+		"AUTH_REJECTION_REASON_UNSPECIFIED":           0,
+		"AUTH_REJECTION_REASON_INVALID_CREDENTIALS":   2,
+		"AUTH_REJECTION_REASON_BANNED":                3,
+		"AUTH_REJECTION_REASON_ALREADY_CONNECTED":     4,
+		"AUTH_REJECTION_REASON_RATE_LIMITED":          5,
+		"AUTH_REJECTION_REASON_REGISTRATION_DISABLED": 6,
+		"AUTH_REJECTION_REASON_INVALID_INVITE_CODE":   7,
+		"AUTH_REJECTION_REASON_USERNAME_TAKEN":        8,
+		"AUTH_REJECTION_REASON_INVALID_PASSWORD":      9,
+		"AUTH_REJECTION_REASON_ROOM_FULL":             10,
 	}
 )
 
@@ -507,11 +694,11 @@ func (x AuthRejectionReason) String() string {
 }
 
 func (AuthRejectionReason) Descriptor() protoreflect.EnumDescriptor {
-	return file_pb_v1_protocol_proto_enumTypes[3].Descriptor()
+	return file_pb_v1_protocol_proto_enumTypes[4].Descriptor()
 }
 
 func (AuthRejectionReason) Type() protoreflect.EnumType {
-	return &file_pb_v1_protocol_proto_enumTypes[3]
+	return &file_pb_v1_protocol_proto_enumTypes[4]
 }
 
 func (x AuthRejectionReason) Number() protoreflect.EnumNumber {
@@ -520,7 +707,60 @@ func (x AuthRejectionReason) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use AuthRejectionReason.Descriptor instead.
 func (AuthRejectionReason) EnumDescriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{3}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{4}
+}
+
+// Actions for controlling an in-progress file transfer.
+type TransferControlAction int32
+
+const (
+	// Do not use.
+	TransferControlAction_TRANSFER_CONTROL_ACTION_UNSPECIFIED TransferControlAction = 0
+	// Stop sending file content until resumed.
+	TransferControlAction_TRANSFER_CONTROL_ACTION_PAUSE TransferControlAction = 1
+	// Continue sending file content.
+	TransferControlAction_TRANSFER_CONTROL_ACTION_RESUME TransferControlAction = 2
+)
+
+// Enum value maps for TransferControlAction.
+var (
+	TransferControlAction_name = map[int32]string{
+		0: "TRANSFER_CONTROL_ACTION_UNSPECIFIED",
+		1: "TRANSFER_CONTROL_ACTION_PAUSE",
+		2: "TRANSFER_CONTROL_ACTION_RESUME",
+	}
+	TransferControlAction_value = map[string]int32{
+		"TRANSFER_CONTROL_ACTION_UNSPECIFIED": 0,
+		"TRANSFER_CONTROL_ACTION_PAUSE":       1,
+		"TRANSFER_CONTROL_ACTION_RESUME":      2,
+	}
+)
+
+func (x TransferControlAction) Enum() *TransferControlAction {
+	p := new(TransferControlAction)
+	*p = x
+	return p
+}
+
+func (x TransferControlAction) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (TransferControlAction) Descriptor() protoreflect.EnumDescriptor {
+	return file_pb_v1_protocol_proto_enumTypes[5].Descriptor()
+}
+
+func (TransferControlAction) Type() protoreflect.EnumType {
+	return &file_pb_v1_protocol_proto_enumTypes[5]
+}
+
+func (x TransferControlAction) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use TransferControlAction.Descriptor instead.
+func (TransferControlAction) EnumDescriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{5}
 }
 
 // ConnMethodType is an enum of possible connection method types.
@@ -568,11 +808,11 @@ func (x ConnMethodType) String() string {
 }
 
 func (ConnMethodType) Descriptor() protoreflect.EnumDescriptor {
-	return file_pb_v1_protocol_proto_enumTypes[4].Descriptor()
+	return file_pb_v1_protocol_proto_enumTypes[6].Descriptor()
 }
 
 func (ConnMethodType) Type() protoreflect.EnumType {
-	return &file_pb_v1_protocol_proto_enumTypes[4]
+	return &file_pb_v1_protocol_proto_enumTypes[6]
 }
 
 func (x ConnMethodType) Number() protoreflect.EnumNumber {
@@ -581,7 +821,7 @@ func (x ConnMethodType) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConnMethodType.Descriptor instead.
 func (ConnMethodType) EnumDescriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{4}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{6}
 }
 
 // ConnResult is an enum of possible results of a direct connection attempt.
@@ -642,11 +882,11 @@ func (x ConnResult) String() string {
 }
 
 func (ConnResult) Descriptor() protoreflect.EnumDescriptor {
-	return file_pb_v1_protocol_proto_enumTypes[5].Descriptor()
+	return file_pb_v1_protocol_proto_enumTypes[7].Descriptor()
 }
 
 func (ConnResult) Type() protoreflect.EnumType {
-	return &file_pb_v1_protocol_proto_enumTypes[5]
+	return &file_pb_v1_protocol_proto_enumTypes[7]
 }
 
 func (x ConnResult) Number() protoreflect.EnumNumber {
@@ -655,7 +895,7 @@ func (x ConnResult) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use ConnResult.Descriptor instead.
 func (ConnResult) EnumDescriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{5}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{7}
 }
 
 type DirectConnHandshakeResult int32
@@ -702,11 +942,11 @@ func (x DirectConnHandshakeResult) String() string {
 }
 
 func (DirectConnHandshakeResult) Descriptor() protoreflect.EnumDescriptor {
-	return file_pb_v1_protocol_proto_enumTypes[6].Descriptor()
+	return file_pb_v1_protocol_proto_enumTypes[8].Descriptor()
 }
 
 func (DirectConnHandshakeResult) Type() protoreflect.EnumType {
-	return &file_pb_v1_protocol_proto_enumTypes[6]
+	return &file_pb_v1_protocol_proto_enumTypes[8]
 }
 
 func (x DirectConnHandshakeResult) Number() protoreflect.EnumNumber {
@@ -715,7 +955,7 @@ func (x DirectConnHandshakeResult) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DirectConnHandshakeResult.Descriptor instead.
 func (DirectConnHandshakeResult) EnumDescriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{6}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{8}
 }
 
 // DownloadStatus is the status of a file download.
@@ -734,6 +974,8 @@ const (
 	DownloadStatus_DOWNLOAD_STATUS_DONE DownloadStatus = 4
 	// Failed to download due to an error.
 	DownloadStatus_DOWNLOAD_STATUS_ERROR DownloadStatus = 5
+	// Paused by the user.
+	DownloadStatus_DOWNLOAD_STATUS_PAUSED DownloadStatus = 6
 )
 
 // Enum value maps for DownloadStatus.
@@ -745,6 +987,7 @@ var (
 		3: "DOWNLOAD_STATUS_CANCELED",
 		4: "DOWNLOAD_STATUS_DONE",
 		5: "DOWNLOAD_STATUS_ERROR",
+		6: "DOWNLOAD_STATUS_PAUSED",
 	}
 	DownloadStatus_value = map[string]int32{
 		"DOWNLOAD_STATUS_UNSPECIFIED": 0,
@@ -753,6 +996,7 @@ var (
 		"DOWNLOAD_STATUS_CANCELED":    3,
 		"DOWNLOAD_STATUS_DONE":        4,
 		"DOWNLOAD_STATUS_ERROR":       5,
+		"DOWNLOAD_STATUS_PAUSED":      6,
 	}
 )
 
@@ -767,11 +1011,11 @@ func (x DownloadStatus) String() string {
 }
 
 func (DownloadStatus) Descriptor() protoreflect.EnumDescriptor {
-	return file_pb_v1_protocol_proto_enumTypes[7].Descriptor()
+	return file_pb_v1_protocol_proto_enumTypes[9].Descriptor()
 }
 
 func (DownloadStatus) Type() protoreflect.EnumType {
-	return &file_pb_v1_protocol_proto_enumTypes[7]
+	return &file_pb_v1_protocol_proto_enumTypes[9]
 }
 
 func (x DownloadStatus) Number() protoreflect.EnumNumber {
@@ -780,7 +1024,7 @@ func (x DownloadStatus) Number() protoreflect.EnumNumber {
 
 // Deprecated: Use DownloadStatus.Descriptor instead.
 func (DownloadStatus) EnumDescriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{7}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{9}
 }
 
 // Ping message.
@@ -831,13 +1075,19 @@ func (x *MsgPing) GetSentTs() int64 {
 }
 
 // Pong message.
+// The timestamps let the pinger estimate the offset between its clock and the responder's, like NTP does.
+// Responders that leave them at 0 only allow measuring the round-trip time.
 type MsgPong struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The epoch millisecond timestamp when the message was sent.
 	// Used to measure latency.
-	SentTs        int64 `protobuf:"varint,1,opt,name=sent_ts,json=sentTs,proto3" json:"sent_ts,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	SentTs int64 `protobuf:"varint,1,opt,name=sent_ts,json=sentTs,proto3" json:"sent_ts,omitempty"`
+	// The sent_ts of the ping this message answers, copied as is.
+	PingSentTs int64 `protobuf:"varint,2,opt,name=ping_sent_ts,json=pingSentTs,proto3" json:"ping_sent_ts,omitempty"`
+	// The epoch millisecond timestamp when the ping this message answers was received.
+	PingReceivedTs int64 `protobuf:"varint,3,opt,name=ping_received_ts,json=pingReceivedTs,proto3" json:"ping_received_ts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
 }
 
 func (x *MsgPong) Reset() {
@@ -877,6 +1127,20 @@ func (x *MsgPong) GetSentTs() int64 {
 	return 0
 }
 
+func (x *MsgPong) GetPingSentTs() int64 {
+	if x != nil {
+		return x.PingSentTs
+	}
+	return 0
+}
+
+func (x *MsgPong) GetPingReceivedTs() int64 {
+	if x != nil {
+		return x.PingReceivedTs
+	}
+	return 0
+}
+
 // Acknowledgement message.
 // Empty.
 type MsgAcknowledged struct {
@@ -1041,7 +1305,9 @@ func (x *ProtoVersion) GetPatch() uint32 {
 type MsgVersion struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The client's protocol version.
-	Version       *ProtoVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Version *ProtoVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The optional capabilities the client supports.
+	Capabilities  []Capability `protobuf:"varint,2,rep,packed,name=capabilities,proto3,enum=pb.v1.Capability" json:"capabilities,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1083,12 +1349,22 @@ func (x *MsgVersion) GetVersion() *ProtoVersion {
 	return nil
 }
 
+func (x *MsgVersion) GetCapabilities() []Capability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
 // Message sent by the server as a reply to PROTO_VERSION.
 // If a client receives this message, it may continue the handshake process.
 type MsgVersionAccepted struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The server's protocol version.
-	Version       *ProtoVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	Version *ProtoVersion `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The optional capabilities that both the client and server support, which may be used on the connection.
+	// Servers that do not know about capabilities leave it empty.
+	Capabilities  []Capability `protobuf:"varint,2,rep,packed,name=capabilities,proto3,enum=pb.v1.Capability" json:"capabilities,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -1130,6 +1406,13 @@ func (x *MsgVersionAccepted) GetVersion() *ProtoVersion {
 	return nil
 }
 
+func (x *MsgVersionAccepted) GetCapabilities() []Capability {
+	if x != nil {
+		return x.Capabilities
+	}
+	return nil
+}
+
 // Message sent by the server as a reply to PROTO_VERSION.
 // If a client receives this message, it will be disconnected and must connect with a suitable version.
 type MsgVersionRejected struct {
@@ -1261,17 +1544,99 @@ func (x *MsgAuthenticate) GetPassword() string {
 	return ""
 }
 
+// See MSG_TYPE_REGISTER.
+type MsgRegister struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room to create the account in.
+	Room string `protobuf:"bytes,1,opt,name=room,proto3" json:"room,omitempty"`
+	// The desired username.
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	// The desired password.
+	Password string `protobuf:"bytes,3,opt,name=password,proto3" json:"password,omitempty"`
+	// The single-use invite code to redeem.
+	// Required if the server requires invite codes for registration.
+	InviteCode    *string `protobuf:"bytes,4,opt,name=invite_code,json=inviteCode,proto3,oneof" json:"invite_code,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgRegister) Reset() {
+	*x = MsgRegister{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgRegister) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRegister) ProtoMessage() {}
+
+func (x *MsgRegister) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgRegister.ProtoReflect.Descriptor instead.
+func (*MsgRegister) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *MsgRegister) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *MsgRegister) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MsgRegister) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+func (x *MsgRegister) GetInviteCode() string {
+	if x != nil && x.InviteCode != nil {
+		return *x.InviteCode
+	}
+	return ""
+}
+
 // Message sent by the server as a reply to PROTO_AUTHENTICATE.
 // If a client receives this message, it is considered to be authenticated and connected, and a session has been established.
 type MsgAuthAccepted struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The room's message of the day, to show to the user.
+	// Empty if the room has none.
+	Motd string `protobuf:"bytes,1,opt,name=motd,proto3" json:"motd,omitempty"`
+	// The server's current time as a UNIX millisecond timestamp, when it accepted the client.
+	// Clients can compare it with their own clock to warn users whose clocks are far off, since that breaks certificate
+	// validation and token expiry.
+	// 0 if the server did not report it.
+	ServerTsMs    int64 `protobuf:"varint,2,opt,name=server_ts_ms,json=serverTsMs,proto3" json:"server_ts_ms,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MsgAuthAccepted) Reset() {
 	*x = MsgAuthAccepted{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[9]
+	mi := &file_pb_v1_protocol_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1283,7 +1648,7 @@ func (x *MsgAuthAccepted) String() string {
 func (*MsgAuthAccepted) ProtoMessage() {}
 
 func (x *MsgAuthAccepted) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[9]
+	mi := &file_pb_v1_protocol_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1296,7 +1661,21 @@ func (x *MsgAuthAccepted) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgAuthAccepted.ProtoReflect.Descriptor instead.
 func (*MsgAuthAccepted) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{9}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MsgAuthAccepted) GetMotd() string {
+	if x != nil {
+		return x.Motd
+	}
+	return ""
+}
+
+func (x *MsgAuthAccepted) GetServerTsMs() int64 {
+	if x != nil {
+		return x.ServerTsMs
+	}
+	return 0
 }
 
 // Message sent by the server as a reply to PROTO_AUTHENTICATE.
@@ -1313,7 +1692,7 @@ type MsgAuthRejected struct {
 
 func (x *MsgAuthRejected) Reset() {
 	*x = MsgAuthRejected{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[10]
+	mi := &file_pb_v1_protocol_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1325,7 +1704,7 @@ func (x *MsgAuthRejected) String() string {
 func (*MsgAuthRejected) ProtoMessage() {}
 
 func (x *MsgAuthRejected) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[10]
+	mi := &file_pb_v1_protocol_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1338,7 +1717,7 @@ func (x *MsgAuthRejected) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgAuthRejected.ProtoReflect.Descriptor instead.
 func (*MsgAuthRejected) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{10}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *MsgAuthRejected) GetReason() AuthRejectionReason {
@@ -1366,7 +1745,7 @@ type MsgOpenOutboundProxy struct {
 
 func (x *MsgOpenOutboundProxy) Reset() {
 	*x = MsgOpenOutboundProxy{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[11]
+	mi := &file_pb_v1_protocol_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1378,7 +1757,7 @@ func (x *MsgOpenOutboundProxy) String() string {
 func (*MsgOpenOutboundProxy) ProtoMessage() {}
 
 func (x *MsgOpenOutboundProxy) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[11]
+	mi := &file_pb_v1_protocol_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1391,7 +1770,7 @@ func (x *MsgOpenOutboundProxy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgOpenOutboundProxy.ProtoReflect.Descriptor instead.
 func (*MsgOpenOutboundProxy) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{11}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *MsgOpenOutboundProxy) GetTargetUsername() string {
@@ -1412,7 +1791,7 @@ type MsgInboundProxy struct {
 
 func (x *MsgInboundProxy) Reset() {
 	*x = MsgInboundProxy{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[12]
+	mi := &file_pb_v1_protocol_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1424,7 +1803,7 @@ func (x *MsgInboundProxy) String() string {
 func (*MsgInboundProxy) ProtoMessage() {}
 
 func (x *MsgInboundProxy) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[12]
+	mi := &file_pb_v1_protocol_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1437,7 +1816,7 @@ func (x *MsgInboundProxy) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgInboundProxy.ProtoReflect.Descriptor instead.
 func (*MsgInboundProxy) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{12}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *MsgInboundProxy) GetOriginUsername() string {
@@ -1452,14 +1831,18 @@ type MsgGetDirFiles struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The path of the directory within the share.
 	// The path must begin with a `/`.
-	Path          string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The maximum number of files to send per MSG_TYPE_DIR_FILES message.
+	// If 0, the peer picks the page size.
+	// Peers clamp it to their maximum page size, and older peers ignore it.
+	PageSize      uint32 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MsgGetDirFiles) Reset() {
 	*x = MsgGetDirFiles{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[13]
+	mi := &file_pb_v1_protocol_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1471,7 +1854,7 @@ func (x *MsgGetDirFiles) String() string {
 func (*MsgGetDirFiles) ProtoMessage() {}
 
 func (x *MsgGetDirFiles) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[13]
+	mi := &file_pb_v1_protocol_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1484,7 +1867,7 @@ func (x *MsgGetDirFiles) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgGetDirFiles.ProtoReflect.Descriptor instead.
 func (*MsgGetDirFiles) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{13}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *MsgGetDirFiles) GetPath() string {
@@ -1494,6 +1877,13 @@ func (x *MsgGetDirFiles) GetPath() string {
 	return ""
 }
 
+func (x *MsgGetDirFiles) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
 // See MSG_TYPE_DIR_FILES.
 type MsgDirFiles struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1505,7 +1895,7 @@ type MsgDirFiles struct {
 
 func (x *MsgDirFiles) Reset() {
 	*x = MsgDirFiles{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[14]
+	mi := &file_pb_v1_protocol_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1517,7 +1907,7 @@ func (x *MsgDirFiles) String() string {
 func (*MsgDirFiles) ProtoMessage() {}
 
 func (x *MsgDirFiles) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[14]
+	mi := &file_pb_v1_protocol_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1530,7 +1920,7 @@ func (x *MsgDirFiles) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgDirFiles.ProtoReflect.Descriptor instead.
 func (*MsgDirFiles) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{14}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *MsgDirFiles) GetFiles() []*MsgFileMeta {
@@ -1544,14 +1934,19 @@ func (x *MsgDirFiles) GetFiles() []*MsgFileMeta {
 type MsgGetFileMeta struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The path to the file.
-	Path          string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// Whether to include the file's SHA-256 hash in the reply.
+	// The peer only includes a hash it already knows, so that the reply is not held up by reading the whole file. If it
+	// does not know the hash yet, it may work it out in the background and set sha256_pending.
+	// Has no effect on directories.
+	IncludeSha256 bool `protobuf:"varint,2,opt,name=include_sha256,json=includeSha256,proto3" json:"include_sha256,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MsgGetFileMeta) Reset() {
 	*x = MsgGetFileMeta{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[15]
+	mi := &file_pb_v1_protocol_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1563,7 +1958,7 @@ func (x *MsgGetFileMeta) String() string {
 func (*MsgGetFileMeta) ProtoMessage() {}
 
 func (x *MsgGetFileMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[15]
+	mi := &file_pb_v1_protocol_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1576,7 +1971,7 @@ func (x *MsgGetFileMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgGetFileMeta.ProtoReflect.Descriptor instead.
 func (*MsgGetFileMeta) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{15}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *MsgGetFileMeta) GetPath() string {
@@ -1586,6 +1981,13 @@ func (x *MsgGetFileMeta) GetPath() string {
 	return ""
 }
 
+func (x *MsgGetFileMeta) GetIncludeSha256() bool {
+	if x != nil {
+		return x.IncludeSha256
+	}
+	return false
+}
+
 // See MSG_TYPE_FILE_META.
 type MsgFileMeta struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1595,14 +1997,26 @@ type MsgFileMeta struct {
 	IsDir bool `protobuf:"varint,2,opt,name=is_dir,json=isDir,proto3" json:"is_dir,omitempty"`
 	// The file's size, in bytes.
 	// Always zero if the file is a folder.
-	Size          uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	Size uint64 `protobuf:"varint,3,opt,name=size,proto3" json:"size,omitempty"`
+	// The UNIX timestamp when the file was last modified, if known.
+	ModifiedTs *int64 `protobuf:"varint,4,opt,name=modified_ts,json=modifiedTs,proto3,oneof" json:"modified_ts,omitempty"`
+	// The SHA-256 hash of the file's content.
+	// Only set in replies to MSG_TYPE_GET_FILE_META requests with include_sha256 set, and only if the peer supports it.
+	Sha256 []byte `protobuf:"bytes,5,opt,name=sha256,proto3,oneof" json:"sha256,omitempty"`
+	// The size of the chunks the file content is checksummed in.
+	// Only set in replies to MSG_TYPE_GET_FILE requests with checksum_chunk_size set, and only if the peer supports it.
+	// If set, the SHA-256 hash of each chunk of the content follows the chunk. See MsgGetFile.checksum_chunk_size.
+	ChecksumChunkSize *uint64 `protobuf:"varint,6,opt,name=checksum_chunk_size,json=checksumChunkSize,proto3,oneof" json:"checksum_chunk_size,omitempty"`
+	// Whether the peer is working out the file's SHA-256 hash, so asking again later will include it.
+	// Only set in replies to MSG_TYPE_GET_FILE_META requests with include_sha256 set, when sha256 is not set.
+	Sha256Pending bool `protobuf:"varint,7,opt,name=sha256_pending,json=sha256Pending,proto3" json:"sha256_pending,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MsgFileMeta) Reset() {
 	*x = MsgFileMeta{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[16]
+	mi := &file_pb_v1_protocol_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1614,7 +2028,7 @@ func (x *MsgFileMeta) String() string {
 func (*MsgFileMeta) ProtoMessage() {}
 
 func (x *MsgFileMeta) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[16]
+	mi := &file_pb_v1_protocol_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1627,7 +2041,7 @@ func (x *MsgFileMeta) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgFileMeta.ProtoReflect.Descriptor instead.
 func (*MsgFileMeta) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{16}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *MsgFileMeta) GetName() string {
@@ -1651,24 +2065,58 @@ func (x *MsgFileMeta) GetSize() uint64 {
 	return 0
 }
 
-// See MSG_TYPE_GET_FILE.
-type MsgGetFile struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The path to the file.
-	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
-	// The offset into the file to read, in bytes.
-	// Values above the file size will just result in no data being returned.
-	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
+func (x *MsgFileMeta) GetModifiedTs() int64 {
+	if x != nil && x.ModifiedTs != nil {
+		return *x.ModifiedTs
+	}
+	return 0
+}
+
+func (x *MsgFileMeta) GetSha256() []byte {
+	if x != nil {
+		return x.Sha256
+	}
+	return nil
+}
+
+func (x *MsgFileMeta) GetChecksumChunkSize() uint64 {
+	if x != nil && x.ChecksumChunkSize != nil {
+		return *x.ChecksumChunkSize
+	}
+	return 0
+}
+
+func (x *MsgFileMeta) GetSha256Pending() bool {
+	if x != nil {
+		return x.Sha256Pending
+	}
+	return false
+}
+
+// See MSG_TYPE_GET_FILE.
+type MsgGetFile struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The path to the file.
+	Path string `protobuf:"bytes,1,opt,name=path,proto3" json:"path,omitempty"`
+	// The offset into the file to read, in bytes.
+	// Values above the file size will just result in no data being returned.
+	Offset uint64 `protobuf:"varint,2,opt,name=offset,proto3" json:"offset,omitempty"`
 	// The limit of the file to read, in bytes.
 	// Specify 0 for no limit.
-	Limit         uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+	Limit uint64 `protobuf:"varint,3,opt,name=limit,proto3" json:"limit,omitempty"`
+	// If not 0, asks the peer to send the 32-byte SHA-256 hash of every chunk of this many bytes of content right after
+	// the chunk, so that corruption is detected as early as possible.
+	// Chunks are counted from offset, and the last chunk may be shorter.
+	// The peer clamps the value between 4 MiB and 16 MiB, and reports the size it uses in
+	// MsgFileMeta.checksum_chunk_size. Peers that do not report it send the content as is.
+	ChecksumChunkSize uint64 `protobuf:"varint,4,opt,name=checksum_chunk_size,json=checksumChunkSize,proto3" json:"checksum_chunk_size,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
 }
 
 func (x *MsgGetFile) Reset() {
 	*x = MsgGetFile{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[17]
+	mi := &file_pb_v1_protocol_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1680,7 +2128,7 @@ func (x *MsgGetFile) String() string {
 func (*MsgGetFile) ProtoMessage() {}
 
 func (x *MsgGetFile) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[17]
+	mi := &file_pb_v1_protocol_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1693,7 +2141,7 @@ func (x *MsgGetFile) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgGetFile.ProtoReflect.Descriptor instead.
 func (*MsgGetFile) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{17}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *MsgGetFile) GetPath() string {
@@ -1717,16 +2165,73 @@ func (x *MsgGetFile) GetLimit() uint64 {
 	return 0
 }
 
+func (x *MsgGetFile) GetChecksumChunkSize() uint64 {
+	if x != nil {
+		return x.ChecksumChunkSize
+	}
+	return 0
+}
+
+// See MSG_TYPE_TRANSFER_CONTROL.
+type MsgTransferControl struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The action to perform.
+	Action        TransferControlAction `protobuf:"varint,1,opt,name=action,proto3,enum=pb.v1.TransferControlAction" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgTransferControl) Reset() {
+	*x = MsgTransferControl{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgTransferControl) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgTransferControl) ProtoMessage() {}
+
+func (x *MsgTransferControl) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgTransferControl.ProtoReflect.Descriptor instead.
+func (*MsgTransferControl) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *MsgTransferControl) GetAction() TransferControlAction {
+	if x != nil {
+		return x.Action
+	}
+	return TransferControlAction_TRANSFER_CONTROL_ACTION_UNSPECIFIED
+}
+
 // See MSG_TYPE_GET_ONLINE_USERS.
 type MsgGetOnlineUsers struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of users to send per MSG_TYPE_ONLINE_USERS message.
+	// If 0, the server picks the page size.
+	// Only honored if CAPABILITY_PAGE_SIZE was negotiated, and clamped to the server's maximum page size.
+	PageSize      uint32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *MsgGetOnlineUsers) Reset() {
 	*x = MsgGetOnlineUsers{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[18]
+	mi := &file_pb_v1_protocol_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1738,7 +2243,7 @@ func (x *MsgGetOnlineUsers) String() string {
 func (*MsgGetOnlineUsers) ProtoMessage() {}
 
 func (x *MsgGetOnlineUsers) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[18]
+	mi := &file_pb_v1_protocol_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1751,21 +2256,31 @@ func (x *MsgGetOnlineUsers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgGetOnlineUsers.ProtoReflect.Descriptor instead.
 func (*MsgGetOnlineUsers) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{18}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *MsgGetOnlineUsers) GetPageSize() uint32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
 }
 
 // OnlineUserInfo is information about an online user.
 type OnlineUserInfo struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
 	// The user's username.
-	Username      string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// Whether the user is a guest.
+	// Guests can browse and download, but do not share files and are not included in searches.
+	IsGuest       bool `protobuf:"varint,2,opt,name=is_guest,json=isGuest,proto3" json:"is_guest,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *OnlineUserInfo) Reset() {
 	*x = OnlineUserInfo{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[19]
+	mi := &file_pb_v1_protocol_proto_msgTypes[21]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1777,7 +2292,7 @@ func (x *OnlineUserInfo) String() string {
 func (*OnlineUserInfo) ProtoMessage() {}
 
 func (x *OnlineUserInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[19]
+	mi := &file_pb_v1_protocol_proto_msgTypes[21]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1790,7 +2305,7 @@ func (x *OnlineUserInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use OnlineUserInfo.ProtoReflect.Descriptor instead.
 func (*OnlineUserInfo) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{19}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{21}
 }
 
 func (x *OnlineUserInfo) GetUsername() string {
@@ -1800,6 +2315,13 @@ func (x *OnlineUserInfo) GetUsername() string {
 	return ""
 }
 
+func (x *OnlineUserInfo) GetIsGuest() bool {
+	if x != nil {
+		return x.IsGuest
+	}
+	return false
+}
+
 // See MSG_TYPE_ONLINE_USERS.
 type MsgOnlineUsers struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
@@ -1811,7 +2333,7 @@ type MsgOnlineUsers struct {
 
 func (x *MsgOnlineUsers) Reset() {
 	*x = MsgOnlineUsers{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[20]
+	mi := &file_pb_v1_protocol_proto_msgTypes[22]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1823,7 +2345,7 @@ func (x *MsgOnlineUsers) String() string {
 func (*MsgOnlineUsers) ProtoMessage() {}
 
 func (x *MsgOnlineUsers) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[20]
+	mi := &file_pb_v1_protocol_proto_msgTypes[22]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1836,7 +2358,7 @@ func (x *MsgOnlineUsers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgOnlineUsers.ProtoReflect.Descriptor instead.
 func (*MsgOnlineUsers) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{20}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{22}
 }
 
 func (x *MsgOnlineUsers) GetUsers() []*OnlineUserInfo {
@@ -1855,7 +2377,7 @@ type MsgBye struct {
 
 func (x *MsgBye) Reset() {
 	*x = MsgBye{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[21]
+	mi := &file_pb_v1_protocol_proto_msgTypes[23]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1867,7 +2389,7 @@ func (x *MsgBye) String() string {
 func (*MsgBye) ProtoMessage() {}
 
 func (x *MsgBye) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[21]
+	mi := &file_pb_v1_protocol_proto_msgTypes[23]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1880,7 +2402,7 @@ func (x *MsgBye) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgBye.ProtoReflect.Descriptor instead.
 func (*MsgBye) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{21}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{23}
 }
 
 // See MSG_TYPE_ADVERTISE_CONN_METHOD.
@@ -1904,7 +2426,7 @@ type MsgAdvertiseConnMethod struct {
 
 func (x *MsgAdvertiseConnMethod) Reset() {
 	*x = MsgAdvertiseConnMethod{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[22]
+	mi := &file_pb_v1_protocol_proto_msgTypes[24]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1916,7 +2438,7 @@ func (x *MsgAdvertiseConnMethod) String() string {
 func (*MsgAdvertiseConnMethod) ProtoMessage() {}
 
 func (x *MsgAdvertiseConnMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[22]
+	mi := &file_pb_v1_protocol_proto_msgTypes[24]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1929,7 +2451,7 @@ func (x *MsgAdvertiseConnMethod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgAdvertiseConnMethod.ProtoReflect.Descriptor instead.
 func (*MsgAdvertiseConnMethod) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{22}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{24}
 }
 
 func (x *MsgAdvertiseConnMethod) GetId() string {
@@ -1974,7 +2496,7 @@ type MsgAdvertiseConnMethodResult struct {
 
 func (x *MsgAdvertiseConnMethodResult) Reset() {
 	*x = MsgAdvertiseConnMethodResult{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[23]
+	mi := &file_pb_v1_protocol_proto_msgTypes[25]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1986,7 +2508,7 @@ func (x *MsgAdvertiseConnMethodResult) String() string {
 func (*MsgAdvertiseConnMethodResult) ProtoMessage() {}
 
 func (x *MsgAdvertiseConnMethodResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[23]
+	mi := &file_pb_v1_protocol_proto_msgTypes[25]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1999,7 +2521,7 @@ func (x *MsgAdvertiseConnMethodResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgAdvertiseConnMethodResult.ProtoReflect.Descriptor instead.
 func (*MsgAdvertiseConnMethodResult) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{23}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{25}
 }
 
 func (x *MsgAdvertiseConnMethodResult) GetAlreadyExists() bool {
@@ -2027,7 +2549,7 @@ type MsgRemoveConnMethod struct {
 
 func (x *MsgRemoveConnMethod) Reset() {
 	*x = MsgRemoveConnMethod{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[24]
+	mi := &file_pb_v1_protocol_proto_msgTypes[26]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2039,7 +2561,7 @@ func (x *MsgRemoveConnMethod) String() string {
 func (*MsgRemoveConnMethod) ProtoMessage() {}
 
 func (x *MsgRemoveConnMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[24]
+	mi := &file_pb_v1_protocol_proto_msgTypes[26]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2052,7 +2574,7 @@ func (x *MsgRemoveConnMethod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgRemoveConnMethod.ProtoReflect.Descriptor instead.
 func (*MsgRemoveConnMethod) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{24}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{26}
 }
 
 func (x *MsgRemoveConnMethod) GetId() string {
@@ -2071,7 +2593,7 @@ type MsgConnectToMe struct {
 
 func (x *MsgConnectToMe) Reset() {
 	*x = MsgConnectToMe{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[25]
+	mi := &file_pb_v1_protocol_proto_msgTypes[27]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2083,7 +2605,7 @@ func (x *MsgConnectToMe) String() string {
 func (*MsgConnectToMe) ProtoMessage() {}
 
 func (x *MsgConnectToMe) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[25]
+	mi := &file_pb_v1_protocol_proto_msgTypes[27]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2096,7 +2618,7 @@ func (x *MsgConnectToMe) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgConnectToMe.ProtoReflect.Descriptor instead.
 func (*MsgConnectToMe) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{25}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{27}
 }
 
 // See MSG_TYPE_DIRECT_CONN_RESULT.
@@ -2110,7 +2632,7 @@ type MsgDirectConnResult struct {
 
 func (x *MsgDirectConnResult) Reset() {
 	*x = MsgDirectConnResult{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[26]
+	mi := &file_pb_v1_protocol_proto_msgTypes[28]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2122,7 +2644,7 @@ func (x *MsgDirectConnResult) String() string {
 func (*MsgDirectConnResult) ProtoMessage() {}
 
 func (x *MsgDirectConnResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[26]
+	mi := &file_pb_v1_protocol_proto_msgTypes[28]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2135,7 +2657,7 @@ func (x *MsgDirectConnResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgDirectConnResult.ProtoReflect.Descriptor instead.
 func (*MsgDirectConnResult) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{26}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{28}
 }
 
 func (x *MsgDirectConnResult) GetResult() ConnResult {
@@ -2154,7 +2676,7 @@ type MsgGetPublicIp struct {
 
 func (x *MsgGetPublicIp) Reset() {
 	*x = MsgGetPublicIp{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[27]
+	mi := &file_pb_v1_protocol_proto_msgTypes[29]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2166,7 +2688,7 @@ func (x *MsgGetPublicIp) String() string {
 func (*MsgGetPublicIp) ProtoMessage() {}
 
 func (x *MsgGetPublicIp) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[27]
+	mi := &file_pb_v1_protocol_proto_msgTypes[29]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2179,7 +2701,7 @@ func (x *MsgGetPublicIp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgGetPublicIp.ProtoReflect.Descriptor instead.
 func (*MsgGetPublicIp) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{27}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{29}
 }
 
 // See MSG_TYPE_PUBLIC_IP.
@@ -2193,7 +2715,7 @@ type MsgPublicIp struct {
 
 func (x *MsgPublicIp) Reset() {
 	*x = MsgPublicIp{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[28]
+	mi := &file_pb_v1_protocol_proto_msgTypes[30]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2205,7 +2727,7 @@ func (x *MsgPublicIp) String() string {
 func (*MsgPublicIp) ProtoMessage() {}
 
 func (x *MsgPublicIp) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[28]
+	mi := &file_pb_v1_protocol_proto_msgTypes[30]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2218,7 +2740,7 @@ func (x *MsgPublicIp) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgPublicIp.ProtoReflect.Descriptor instead.
 func (*MsgPublicIp) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{28}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{30}
 }
 
 func (x *MsgPublicIp) GetPublicIp() string {
@@ -2239,7 +2761,7 @@ type MsgGetClientConnMethods struct {
 
 func (x *MsgGetClientConnMethods) Reset() {
 	*x = MsgGetClientConnMethods{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[29]
+	mi := &file_pb_v1_protocol_proto_msgTypes[31]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2251,7 +2773,7 @@ func (x *MsgGetClientConnMethods) String() string {
 func (*MsgGetClientConnMethods) ProtoMessage() {}
 
 func (x *MsgGetClientConnMethods) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[29]
+	mi := &file_pb_v1_protocol_proto_msgTypes[31]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2264,7 +2786,7 @@ func (x *MsgGetClientConnMethods) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgGetClientConnMethods.ProtoReflect.Descriptor instead.
 func (*MsgGetClientConnMethods) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{29}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{31}
 }
 
 func (x *MsgGetClientConnMethods) GetUsername() string {
@@ -2296,7 +2818,7 @@ type ConnMethod struct {
 
 func (x *ConnMethod) Reset() {
 	*x = ConnMethod{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[30]
+	mi := &file_pb_v1_protocol_proto_msgTypes[32]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2308,7 +2830,7 @@ func (x *ConnMethod) String() string {
 func (*ConnMethod) ProtoMessage() {}
 
 func (x *ConnMethod) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[30]
+	mi := &file_pb_v1_protocol_proto_msgTypes[32]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2321,7 +2843,7 @@ func (x *ConnMethod) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ConnMethod.ProtoReflect.Descriptor instead.
 func (*ConnMethod) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{30}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{32}
 }
 
 func (x *ConnMethod) GetId() string {
@@ -2370,7 +2892,7 @@ type MsgClientConnMethods struct {
 
 func (x *MsgClientConnMethods) Reset() {
 	*x = MsgClientConnMethods{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[31]
+	mi := &file_pb_v1_protocol_proto_msgTypes[33]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2382,7 +2904,7 @@ func (x *MsgClientConnMethods) String() string {
 func (*MsgClientConnMethods) ProtoMessage() {}
 
 func (x *MsgClientConnMethods) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[31]
+	mi := &file_pb_v1_protocol_proto_msgTypes[33]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2395,7 +2917,7 @@ func (x *MsgClientConnMethods) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgClientConnMethods.ProtoReflect.Descriptor instead.
 func (*MsgClientConnMethods) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{31}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{33}
 }
 
 func (x *MsgClientConnMethods) GetMethods() []*ConnMethod {
@@ -2416,7 +2938,7 @@ type MsgGetDirectConnHandshakeToken struct {
 
 func (x *MsgGetDirectConnHandshakeToken) Reset() {
 	*x = MsgGetDirectConnHandshakeToken{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[32]
+	mi := &file_pb_v1_protocol_proto_msgTypes[34]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2428,7 +2950,7 @@ func (x *MsgGetDirectConnHandshakeToken) String() string {
 func (*MsgGetDirectConnHandshakeToken) ProtoMessage() {}
 
 func (x *MsgGetDirectConnHandshakeToken) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[32]
+	mi := &file_pb_v1_protocol_proto_msgTypes[34]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2441,7 +2963,7 @@ func (x *MsgGetDirectConnHandshakeToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgGetDirectConnHandshakeToken.ProtoReflect.Descriptor instead.
 func (*MsgGetDirectConnHandshakeToken) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{32}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{34}
 }
 
 func (x *MsgGetDirectConnHandshakeToken) GetUsername() string {
@@ -2462,7 +2984,7 @@ type MsgDirectConnHandshakeToken struct {
 
 func (x *MsgDirectConnHandshakeToken) Reset() {
 	*x = MsgDirectConnHandshakeToken{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[33]
+	mi := &file_pb_v1_protocol_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2474,7 +2996,7 @@ func (x *MsgDirectConnHandshakeToken) String() string {
 func (*MsgDirectConnHandshakeToken) ProtoMessage() {}
 
 func (x *MsgDirectConnHandshakeToken) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[33]
+	mi := &file_pb_v1_protocol_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2487,7 +3009,7 @@ func (x *MsgDirectConnHandshakeToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgDirectConnHandshakeToken.ProtoReflect.Descriptor instead.
 func (*MsgDirectConnHandshakeToken) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{33}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *MsgDirectConnHandshakeToken) GetToken() string {
@@ -2508,7 +3030,7 @@ type MsgRedeemConnHandshakeToken struct {
 
 func (x *MsgRedeemConnHandshakeToken) Reset() {
 	*x = MsgRedeemConnHandshakeToken{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[34]
+	mi := &file_pb_v1_protocol_proto_msgTypes[36]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2520,7 +3042,7 @@ func (x *MsgRedeemConnHandshakeToken) String() string {
 func (*MsgRedeemConnHandshakeToken) ProtoMessage() {}
 
 func (x *MsgRedeemConnHandshakeToken) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[34]
+	mi := &file_pb_v1_protocol_proto_msgTypes[36]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2533,7 +3055,7 @@ func (x *MsgRedeemConnHandshakeToken) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgRedeemConnHandshakeToken.ProtoReflect.Descriptor instead.
 func (*MsgRedeemConnHandshakeToken) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{34}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{36}
 }
 
 func (x *MsgRedeemConnHandshakeToken) GetToken() string {
@@ -2563,7 +3085,7 @@ type MsgRedeemConnHandshakeTokenResult struct {
 
 func (x *MsgRedeemConnHandshakeTokenResult) Reset() {
 	*x = MsgRedeemConnHandshakeTokenResult{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[35]
+	mi := &file_pb_v1_protocol_proto_msgTypes[37]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2575,7 +3097,7 @@ func (x *MsgRedeemConnHandshakeTokenResult) String() string {
 func (*MsgRedeemConnHandshakeTokenResult) ProtoMessage() {}
 
 func (x *MsgRedeemConnHandshakeTokenResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[35]
+	mi := &file_pb_v1_protocol_proto_msgTypes[37]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2588,7 +3110,7 @@ func (x *MsgRedeemConnHandshakeTokenResult) ProtoReflect() protoreflect.Message
 
 // Deprecated: Use MsgRedeemConnHandshakeTokenResult.ProtoReflect.Descriptor instead.
 func (*MsgRedeemConnHandshakeTokenResult) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{35}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{37}
 }
 
 func (x *MsgRedeemConnHandshakeTokenResult) GetIsValid() bool {
@@ -2634,7 +3156,7 @@ type MsgDirectConnHandshake struct {
 
 func (x *MsgDirectConnHandshake) Reset() {
 	*x = MsgDirectConnHandshake{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[36]
+	mi := &file_pb_v1_protocol_proto_msgTypes[38]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2646,7 +3168,7 @@ func (x *MsgDirectConnHandshake) String() string {
 func (*MsgDirectConnHandshake) ProtoMessage() {}
 
 func (x *MsgDirectConnHandshake) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[36]
+	mi := &file_pb_v1_protocol_proto_msgTypes[38]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2659,7 +3181,7 @@ func (x *MsgDirectConnHandshake) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgDirectConnHandshake.ProtoReflect.Descriptor instead.
 func (*MsgDirectConnHandshake) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{36}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{38}
 }
 
 func (x *MsgDirectConnHandshake) GetMethodId() string {
@@ -2688,7 +3210,7 @@ type MsgDirectConnHandshakeResult struct {
 
 func (x *MsgDirectConnHandshakeResult) Reset() {
 	*x = MsgDirectConnHandshakeResult{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[37]
+	mi := &file_pb_v1_protocol_proto_msgTypes[39]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2700,7 +3222,7 @@ func (x *MsgDirectConnHandshakeResult) String() string {
 func (*MsgDirectConnHandshakeResult) ProtoMessage() {}
 
 func (x *MsgDirectConnHandshakeResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[37]
+	mi := &file_pb_v1_protocol_proto_msgTypes[39]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2713,7 +3235,7 @@ func (x *MsgDirectConnHandshakeResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgDirectConnHandshakeResult.ProtoReflect.Descriptor instead.
 func (*MsgDirectConnHandshakeResult) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{37}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{39}
 }
 
 func (x *MsgDirectConnHandshakeResult) GetResult() DirectConnHandshakeResult {
@@ -2737,7 +3259,7 @@ type MsgChangeAccountPassword struct {
 
 func (x *MsgChangeAccountPassword) Reset() {
 	*x = MsgChangeAccountPassword{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[38]
+	mi := &file_pb_v1_protocol_proto_msgTypes[40]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2749,7 +3271,7 @@ func (x *MsgChangeAccountPassword) String() string {
 func (*MsgChangeAccountPassword) ProtoMessage() {}
 
 func (x *MsgChangeAccountPassword) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[38]
+	mi := &file_pb_v1_protocol_proto_msgTypes[40]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2762,7 +3284,7 @@ func (x *MsgChangeAccountPassword) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgChangeAccountPassword.ProtoReflect.Descriptor instead.
 func (*MsgChangeAccountPassword) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{38}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{40}
 }
 
 func (x *MsgChangeAccountPassword) GetCurrentPassword() string {
@@ -2790,7 +3312,7 @@ type MsgClientOnline struct {
 
 func (x *MsgClientOnline) Reset() {
 	*x = MsgClientOnline{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[39]
+	mi := &file_pb_v1_protocol_proto_msgTypes[41]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2802,7 +3324,7 @@ func (x *MsgClientOnline) String() string {
 func (*MsgClientOnline) ProtoMessage() {}
 
 func (x *MsgClientOnline) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[39]
+	mi := &file_pb_v1_protocol_proto_msgTypes[41]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2815,7 +3337,7 @@ func (x *MsgClientOnline) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgClientOnline.ProtoReflect.Descriptor instead.
 func (*MsgClientOnline) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{39}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{41}
 }
 
 func (x *MsgClientOnline) GetInfo() *OnlineUserInfo {
@@ -2836,7 +3358,7 @@ type MsgClientOffline struct {
 
 func (x *MsgClientOffline) Reset() {
 	*x = MsgClientOffline{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[40]
+	mi := &file_pb_v1_protocol_proto_msgTypes[42]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2848,7 +3370,7 @@ func (x *MsgClientOffline) String() string {
 func (*MsgClientOffline) ProtoMessage() {}
 
 func (x *MsgClientOffline) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[40]
+	mi := &file_pb_v1_protocol_proto_msgTypes[42]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2861,7 +3383,7 @@ func (x *MsgClientOffline) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgClientOffline.ProtoReflect.Descriptor instead.
 func (*MsgClientOffline) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{40}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{42}
 }
 
 func (x *MsgClientOffline) GetUsername() string {
@@ -2871,30 +3393,30 @@ func (x *MsgClientOffline) GetUsername() string {
 	return ""
 }
 
-// See MSG_TYPE_SEARCH.
-type MsgSearch struct {
+// See MSG_TYPE_SERVER_NOTICE.
+type MsgServerNotice struct {
 	state protoimpl.MessageState `protogen:"open.v1"`
-	// The query.
-	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	// The notice's text.
+	Text          string `protobuf:"bytes,1,opt,name=text,proto3" json:"text,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MsgSearch) Reset() {
-	*x = MsgSearch{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[41]
+func (x *MsgServerNotice) Reset() {
+	*x = MsgServerNotice{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[43]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *MsgSearch) String() string {
+func (x *MsgServerNotice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MsgSearch) ProtoMessage() {}
+func (*MsgServerNotice) ProtoMessage() {}
 
-func (x *MsgSearch) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[41]
+func (x *MsgServerNotice) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[43]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2905,34 +3427,489 @@ func (x *MsgSearch) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MsgSearch.ProtoReflect.Descriptor instead.
-func (*MsgSearch) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use MsgServerNotice.ProtoReflect.Descriptor instead.
+func (*MsgServerNotice) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *MsgSearch) GetQuery() string {
+func (x *MsgServerNotice) GetText() string {
 	if x != nil {
-		return x.Query
+		return x.Text
 	}
 	return ""
 }
 
-// See MSG_TYPE_SEARCH_RESULT.
-type MsgSearchResult struct {
-	state protoimpl.MessageState `protogen:"open.v1"`
-	// The file's containing directory path.
-	DirectoryPath string `protobuf:"bytes,1,opt,name=directory_path,json=directoryPath,proto3" json:"directory_path,omitempty"`
-	// The file that was found.
-	File *MsgFileMeta `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
-	// A snippet of text highlighting matched terms.
-	Snippet       string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+// See MSG_TYPE_GET_SERVER_INFO.
+type MsgGetServerInfo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *MsgSearchResult) Reset() {
-	*x = MsgSearchResult{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[42]
+func (x *MsgGetServerInfo) Reset() {
+	*x = MsgGetServerInfo{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgGetServerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgGetServerInfo) ProtoMessage() {}
+
+func (x *MsgGetServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgGetServerInfo.ProtoReflect.Descriptor instead.
+func (*MsgGetServerInfo) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{44}
+}
+
+// See MSG_TYPE_SET_INTERESTS.
+type MsgSetInterests struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The client's interests.
+	// Servers normalize them by lowercasing them and collapsing whitespace, and remove duplicates.
+	// Each must be at most 64 characters long, and there can be at most 50.
+	// Empty to clear the client's interests.
+	Interests     []string `protobuf:"bytes,1,rep,name=interests,proto3" json:"interests,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgSetInterests) Reset() {
+	*x = MsgSetInterests{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgSetInterests) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSetInterests) ProtoMessage() {}
+
+func (x *MsgSetInterests) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgSetInterests.ProtoReflect.Descriptor instead.
+func (*MsgSetInterests) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *MsgSetInterests) GetInterests() []string {
+	if x != nil {
+		return x.Interests
+	}
+	return nil
+}
+
+// See MSG_TYPE_GET_SIMILAR_USERS.
+type MsgGetSimilarUsers struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of users to return.
+	// If unspecified or 0, the server picks a default.
+	// The server may return fewer users than requested.
+	Limit         *uint32 `protobuf:"varint,1,opt,name=limit,proto3,oneof" json:"limit,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgGetSimilarUsers) Reset() {
+	*x = MsgGetSimilarUsers{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgGetSimilarUsers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgGetSimilarUsers) ProtoMessage() {}
+
+func (x *MsgGetSimilarUsers) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgGetSimilarUsers.ProtoReflect.Descriptor instead.
+func (*MsgGetSimilarUsers) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *MsgGetSimilarUsers) GetLimit() uint32 {
+	if x != nil && x.Limit != nil {
+		return *x.Limit
+	}
+	return 0
+}
+
+// A user whose interests overlap with the requesting client's.
+type SimilarUser struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The user's username.
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	// The interests the user shares with the requesting client, normalized.
+	SharedInterests []string `protobuf:"bytes,2,rep,name=shared_interests,json=sharedInterests,proto3" json:"shared_interests,omitempty"`
+	// The total number of interests the user published.
+	InterestCount uint32 `protobuf:"varint,3,opt,name=interest_count,json=interestCount,proto3" json:"interest_count,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SimilarUser) Reset() {
+	*x = SimilarUser{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SimilarUser) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SimilarUser) ProtoMessage() {}
+
+func (x *SimilarUser) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SimilarUser.ProtoReflect.Descriptor instead.
+func (*SimilarUser) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SimilarUser) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *SimilarUser) GetSharedInterests() []string {
+	if x != nil {
+		return x.SharedInterests
+	}
+	return nil
+}
+
+func (x *SimilarUser) GetInterestCount() uint32 {
+	if x != nil {
+		return x.InterestCount
+	}
+	return 0
+}
+
+// See MSG_TYPE_SIMILAR_USERS.
+type MsgSimilarUsers struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The users, ordered by the number of shared interests, most first.
+	// Users with no shared interests are not included.
+	Users         []*SimilarUser `protobuf:"bytes,1,rep,name=users,proto3" json:"users,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgSimilarUsers) Reset() {
+	*x = MsgSimilarUsers{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgSimilarUsers) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSimilarUsers) ProtoMessage() {}
+
+func (x *MsgSimilarUsers) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgSimilarUsers.ProtoReflect.Descriptor instead.
+func (*MsgSimilarUsers) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *MsgSimilarUsers) GetUsers() []*SimilarUser {
+	if x != nil {
+		return x.Users
+	}
+	return nil
+}
+
+// RoomPolicies are the limits a room places on its clients.
+// Files are transferred between clients, so there are no limits on file sizes or storage.
+type RoomPolicies struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The maximum number of online clients, or 0 if unlimited.
+	MaxClients uint32 `protobuf:"varint,1,opt,name=max_clients,json=maxClients,proto3" json:"max_clients,omitempty"`
+	// The maximum number of concurrent proxied streams each client can open, or 0 if unlimited.
+	MaxProxyStreamsPerClient uint32 `protobuf:"varint,2,opt,name=max_proxy_streams_per_client,json=maxProxyStreamsPerClient,proto3" json:"max_proxy_streams_per_client,omitempty"`
+	// The maximum number of requests each client can have in flight at once, or 0 if unlimited.
+	// Requests beyond it are answered with MSG_TYPE_ERROR of ERR_TYPE_RATE_LIMITED.
+	MaxConcurrentRequests uint32 `protobuf:"varint,3,opt,name=max_concurrent_requests,json=maxConcurrentRequests,proto3" json:"max_concurrent_requests,omitempty"`
+	// How long the server caches directory listings it proxies, in milliseconds, or 0 if it does not cache them.
+	DirCacheTtlMs uint32 `protobuf:"varint,4,opt,name=dir_cache_ttl_ms,json=dirCacheTtlMs,proto3" json:"dir_cache_ttl_ms,omitempty"`
+	// The maximum number of bytes per second currently relayed through the server for all clients combined, or 0 if
+	// unlimited. It may change over time according to the server's schedule.
+	RelayMaxBytesPerSecond int64 `protobuf:"varint,5,opt,name=relay_max_bytes_per_second,json=relayMaxBytesPerSecond,proto3" json:"relay_max_bytes_per_second,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *RoomPolicies) Reset() {
+	*x = RoomPolicies{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoomPolicies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoomPolicies) ProtoMessage() {}
+
+func (x *RoomPolicies) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoomPolicies.ProtoReflect.Descriptor instead.
+func (*RoomPolicies) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *RoomPolicies) GetMaxClients() uint32 {
+	if x != nil {
+		return x.MaxClients
+	}
+	return 0
+}
+
+func (x *RoomPolicies) GetMaxProxyStreamsPerClient() uint32 {
+	if x != nil {
+		return x.MaxProxyStreamsPerClient
+	}
+	return 0
+}
+
+func (x *RoomPolicies) GetMaxConcurrentRequests() uint32 {
+	if x != nil {
+		return x.MaxConcurrentRequests
+	}
+	return 0
+}
+
+func (x *RoomPolicies) GetDirCacheTtlMs() uint32 {
+	if x != nil {
+		return x.DirCacheTtlMs
+	}
+	return 0
+}
+
+func (x *RoomPolicies) GetRelayMaxBytesPerSecond() int64 {
+	if x != nil {
+		return x.RelayMaxBytesPerSecond
+	}
+	return 0
+}
+
+// See MSG_TYPE_SERVER_INFO.
+type MsgServerInfo struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The server software's version, such as "1.1.3".
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// The protocol version the server speaks.
+	ProtocolVersion *ProtoVersion `protobuf:"bytes,2,opt,name=protocol_version,json=protocolVersion,proto3" json:"protocol_version,omitempty"`
+	// The optional features that are enabled on the server.
+	// Unknown features must be ignored.
+	//
+	// Possible values:
+	//   - "registration": Clients can register their own accounts.
+	//   - "invite_codes": Registering requires an invite code.
+	//   - "auth_rate_limit": Failed authentication attempts are rate-limited.
+	Features []string `protobuf:"bytes,3,rep,name=features,proto3" json:"features,omitempty"`
+	// The policies of the room the request was scoped to.
+	Room          *RoomPolicies `protobuf:"bytes,4,opt,name=room,proto3" json:"room,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgServerInfo) Reset() {
+	*x = MsgServerInfo{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgServerInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgServerInfo) ProtoMessage() {}
+
+func (x *MsgServerInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgServerInfo.ProtoReflect.Descriptor instead.
+func (*MsgServerInfo) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *MsgServerInfo) GetVersion() string {
+	if x != nil {
+		return x.Version
+	}
+	return ""
+}
+
+func (x *MsgServerInfo) GetProtocolVersion() *ProtoVersion {
+	if x != nil {
+		return x.ProtocolVersion
+	}
+	return nil
+}
+
+func (x *MsgServerInfo) GetFeatures() []string {
+	if x != nil {
+		return x.Features
+	}
+	return nil
+}
+
+func (x *MsgServerInfo) GetRoom() *RoomPolicies {
+	if x != nil {
+		return x.Room
+	}
+	return nil
+}
+
+// See MSG_TYPE_SEARCH.
+type MsgSearch struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The query.
+	Query         string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgSearch) Reset() {
+	*x = MsgSearch{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgSearch) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSearch) ProtoMessage() {}
+
+func (x *MsgSearch) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgSearch.ProtoReflect.Descriptor instead.
+func (*MsgSearch) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *MsgSearch) GetQuery() string {
+	if x != nil {
+		return x.Query
+	}
+	return ""
+}
+
+// See MSG_TYPE_SEARCH_RESULT.
+type MsgSearchResult struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The file's containing directory path.
+	DirectoryPath string `protobuf:"bytes,1,opt,name=directory_path,json=directoryPath,proto3" json:"directory_path,omitempty"`
+	// The file that was found.
+	File *MsgFileMeta `protobuf:"bytes,2,opt,name=file,proto3" json:"file,omitempty"`
+	// A snippet of text highlighting matched terms.
+	Snippet       string `protobuf:"bytes,3,opt,name=snippet,proto3" json:"snippet,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgSearchResult) Reset() {
+	*x = MsgSearchResult{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[52]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -2944,7 +3921,7 @@ func (x *MsgSearchResult) String() string {
 func (*MsgSearchResult) ProtoMessage() {}
 
 func (x *MsgSearchResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[42]
+	mi := &file_pb_v1_protocol_proto_msgTypes[52]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2957,7 +3934,7 @@ func (x *MsgSearchResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgSearchResult.ProtoReflect.Descriptor instead.
 func (*MsgSearchResult) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{42}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{52}
 }
 
 func (x *MsgSearchResult) GetDirectoryPath() string {
@@ -2994,7 +3971,7 @@ type MsgSearchRoomResult struct {
 
 func (x *MsgSearchRoomResult) Reset() {
 	*x = MsgSearchRoomResult{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[43]
+	mi := &file_pb_v1_protocol_proto_msgTypes[53]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3006,7 +3983,7 @@ func (x *MsgSearchRoomResult) String() string {
 func (*MsgSearchRoomResult) ProtoMessage() {}
 
 func (x *MsgSearchRoomResult) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[43]
+	mi := &file_pb_v1_protocol_proto_msgTypes[53]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3019,7 +3996,7 @@ func (x *MsgSearchRoomResult) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgSearchRoomResult.ProtoReflect.Descriptor instead.
 func (*MsgSearchRoomResult) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{43}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{53}
 }
 
 func (x *MsgSearchRoomResult) GetUsername() string {
@@ -3052,7 +4029,7 @@ type MsgDownloadStatusUpdate struct {
 
 func (x *MsgDownloadStatusUpdate) Reset() {
 	*x = MsgDownloadStatusUpdate{}
-	mi := &file_pb_v1_protocol_proto_msgTypes[44]
+	mi := &file_pb_v1_protocol_proto_msgTypes[54]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -3064,7 +4041,7 @@ func (x *MsgDownloadStatusUpdate) String() string {
 func (*MsgDownloadStatusUpdate) ProtoMessage() {}
 
 func (x *MsgDownloadStatusUpdate) ProtoReflect() protoreflect.Message {
-	mi := &file_pb_v1_protocol_proto_msgTypes[44]
+	mi := &file_pb_v1_protocol_proto_msgTypes[54]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3077,7 +4054,7 @@ func (x *MsgDownloadStatusUpdate) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use MsgDownloadStatusUpdate.ProtoReflect.Descriptor instead.
 func (*MsgDownloadStatusUpdate) Descriptor() ([]byte, []int) {
-	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{44}
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{54}
 }
 
 func (x *MsgDownloadStatusUpdate) GetPath() string {
@@ -3101,15 +4078,343 @@ func (x *MsgDownloadStatusUpdate) GetBytesDownloaded() uint64 {
 	return 0
 }
 
+// See MSG_TYPE_MEASURE.
+type MsgMeasure struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Arbitrary data, used to measure upload throughput.
+	// The receiver ignores it.
+	Payload []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	// The number of bytes of padding the receiver should put in its reply, used to measure download throughput.
+	// Receivers cap it at 262144 bytes.
+	ReplySize     uint32 `protobuf:"varint,2,opt,name=reply_size,json=replySize,proto3" json:"reply_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgMeasure) Reset() {
+	*x = MsgMeasure{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgMeasure) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgMeasure) ProtoMessage() {}
+
+func (x *MsgMeasure) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgMeasure.ProtoReflect.Descriptor instead.
+func (*MsgMeasure) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *MsgMeasure) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+func (x *MsgMeasure) GetReplySize() uint32 {
+	if x != nil {
+		return x.ReplySize
+	}
+	return 0
+}
+
+// See MSG_TYPE_MEASURE_REPLY.
+type MsgMeasureReply struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Padding of the size requested in MSG_TYPE_MEASURE.
+	Payload       []byte `protobuf:"bytes,1,opt,name=payload,proto3" json:"payload,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgMeasureReply) Reset() {
+	*x = MsgMeasureReply{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgMeasureReply) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgMeasureReply) ProtoMessage() {}
+
+func (x *MsgMeasureReply) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgMeasureReply.ProtoReflect.Descriptor instead.
+func (*MsgMeasureReply) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *MsgMeasureReply) GetPayload() []byte {
+	if x != nil {
+		return x.Payload
+	}
+	return nil
+}
+
+// See MSG_TYPE_ROOM_SCOPE.
+type MsgRoomScope struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The scope ID chosen in MSG_TYPE_JOIN_ROOM.
+	ScopeId       uint32 `protobuf:"varint,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgRoomScope) Reset() {
+	*x = MsgRoomScope{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgRoomScope) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgRoomScope) ProtoMessage() {}
+
+func (x *MsgRoomScope) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgRoomScope.ProtoReflect.Descriptor instead.
+func (*MsgRoomScope) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *MsgRoomScope) GetScopeId() uint32 {
+	if x != nil {
+		return x.ScopeId
+	}
+	return 0
+}
+
+// See MSG_TYPE_JOIN_ROOM.
+type MsgJoinRoom struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The scope ID to use for the room.
+	// Must be non-zero and not already in use on the connection.
+	ScopeId uint32 `protobuf:"varint,1,opt,name=scope_id,json=scopeId,proto3" json:"scope_id,omitempty"`
+	// The room to join.
+	Room string `protobuf:"bytes,2,opt,name=room,proto3" json:"room,omitempty"`
+	// The user's username in the room.
+	Username string `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	// The user's password in the room.
+	Password      string `protobuf:"bytes,4,opt,name=password,proto3" json:"password,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgJoinRoom) Reset() {
+	*x = MsgJoinRoom{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgJoinRoom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgJoinRoom) ProtoMessage() {}
+
+func (x *MsgJoinRoom) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgJoinRoom.ProtoReflect.Descriptor instead.
+func (*MsgJoinRoom) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *MsgJoinRoom) GetScopeId() uint32 {
+	if x != nil {
+		return x.ScopeId
+	}
+	return 0
+}
+
+func (x *MsgJoinRoom) GetRoom() string {
+	if x != nil {
+		return x.Room
+	}
+	return ""
+}
+
+func (x *MsgJoinRoom) GetUsername() string {
+	if x != nil {
+		return x.Username
+	}
+	return ""
+}
+
+func (x *MsgJoinRoom) GetPassword() string {
+	if x != nil {
+		return x.Password
+	}
+	return ""
+}
+
+// See MSG_TYPE_LEAVE_ROOM.
+type MsgLeaveRoom struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The close code, with the same meaning as the QUIC application error code used when closing a connection.
+	CloseCode uint32 `protobuf:"varint,1,opt,name=close_code,json=closeCode,proto3" json:"close_code,omitempty"`
+	// A human-readable reason.
+	Reason        *string `protobuf:"bytes,2,opt,name=reason,proto3,oneof" json:"reason,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgLeaveRoom) Reset() {
+	*x = MsgLeaveRoom{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgLeaveRoom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgLeaveRoom) ProtoMessage() {}
+
+func (x *MsgLeaveRoom) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgLeaveRoom.ProtoReflect.Descriptor instead.
+func (*MsgLeaveRoom) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *MsgLeaveRoom) GetCloseCode() uint32 {
+	if x != nil {
+		return x.CloseCode
+	}
+	return 0
+}
+
+func (x *MsgLeaveRoom) GetReason() string {
+	if x != nil && x.Reason != nil {
+		return *x.Reason
+	}
+	return ""
+}
+
+// See MSG_TYPE_SHARES_REVISION.
+type MsgSharesRevision struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// The shares revision.
+	// Only meaningful for comparing with other revisions announced on the same connection.
+	Revision      uint64 `protobuf:"varint,1,opt,name=revision,proto3" json:"revision,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MsgSharesRevision) Reset() {
+	*x = MsgSharesRevision{}
+	mi := &file_pb_v1_protocol_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MsgSharesRevision) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MsgSharesRevision) ProtoMessage() {}
+
+func (x *MsgSharesRevision) ProtoReflect() protoreflect.Message {
+	mi := &file_pb_v1_protocol_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MsgSharesRevision.ProtoReflect.Descriptor instead.
+func (*MsgSharesRevision) Descriptor() ([]byte, []int) {
+	return file_pb_v1_protocol_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *MsgSharesRevision) GetRevision() uint64 {
+	if x != nil {
+		return x.Revision
+	}
+	return 0
+}
+
 var File_pb_v1_protocol_proto protoreflect.FileDescriptor
 
 const file_pb_v1_protocol_proto_rawDesc = "" +
 	"\n" +
 	"\x14pb/v1/protocol.proto\x12\x05pb.v1\"\"\n" +
 	"\aMsgPing\x12\x17\n" +
-	"\asent_ts\x18\x01 \x01(\x03R\x06sentTs\"\"\n" +
+	"\asent_ts\x18\x01 \x01(\x03R\x06sentTs\"n\n" +
 	"\aMsgPong\x12\x17\n" +
-	"\asent_ts\x18\x01 \x01(\x03R\x06sentTs\"\x11\n" +
+	"\asent_ts\x18\x01 \x01(\x03R\x06sentTs\x12 \n" +
+	"\fping_sent_ts\x18\x02 \x01(\x03R\n" +
+	"pingSentTs\x12(\n" +
+	"\x10ping_received_ts\x18\x03 \x01(\x03R\x0epingReceivedTs\"\x11\n" +
 	"\x0fMsgAcknowledged\"Y\n" +
 	"\bMsgError\x12\"\n" +
 	"\x04type\x18\x01 \x01(\x0e2\x0e.pb.v1.ErrTypeR\x04type\x12\x1d\n" +
@@ -3119,12 +4424,14 @@ const file_pb_v1_protocol_proto_rawDesc = "" +
 	"\fProtoVersion\x12\x14\n" +
 	"\x05major\x18\x01 \x01(\rR\x05major\x12\x14\n" +
 	"\x05minor\x18\x02 \x01(\rR\x05minor\x12\x14\n" +
-	"\x05patch\x18\x03 \x01(\rR\x05patch\";\n" +
+	"\x05patch\x18\x03 \x01(\rR\x05patch\"r\n" +
 	"\n" +
 	"MsgVersion\x12-\n" +
-	"\aversion\x18\x01 \x01(\v2\x13.pb.v1.ProtoVersionR\aversion\"C\n" +
+	"\aversion\x18\x01 \x01(\v2\x13.pb.v1.ProtoVersionR\aversion\x125\n" +
+	"\fcapabilities\x18\x02 \x03(\x0e2\x11.pb.v1.CapabilityR\fcapabilities\"z\n" +
 	"\x12MsgVersionAccepted\x12-\n" +
-	"\aversion\x18\x01 \x01(\v2\x13.pb.v1.ProtoVersionR\aversion\"\xa5\x01\n" +
+	"\aversion\x18\x01 \x01(\v2\x13.pb.v1.ProtoVersionR\aversion\x125\n" +
+	"\fcapabilities\x18\x02 \x03(\x0e2\x11.pb.v1.CapabilityR\fcapabilities\"\xa5\x01\n" +
 	"\x12MsgVersionRejected\x12-\n" +
 	"\aversion\x18\x01 \x01(\v2\x13.pb.v1.ProtoVersionR\aversion\x125\n" +
 	"\x06reason\x18\x02 \x01(\x0e2\x1d.pb.v1.VersionRejectionReasonR\x06reason\x12\x1d\n" +
@@ -3134,8 +4441,18 @@ const file_pb_v1_protocol_proto_rawDesc = "" +
 	"\x0fMsgAuthenticate\x12\x12\n" +
 	"\x04room\x18\x01 \x01(\tR\x04room\x12\x1a\n" +
 	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
-	"\bpassword\x18\x03 \x01(\tR\bpassword\"\x11\n" +
-	"\x0fMsgAuthAccepted\"p\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\"\x8f\x01\n" +
+	"\vMsgRegister\x12\x12\n" +
+	"\x04room\x18\x01 \x01(\tR\x04room\x12\x1a\n" +
+	"\busername\x18\x02 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x03 \x01(\tR\bpassword\x12$\n" +
+	"\vinvite_code\x18\x04 \x01(\tH\x00R\n" +
+	"inviteCode\x88\x01\x01B\x0e\n" +
+	"\f_invite_code\"G\n" +
+	"\x0fMsgAuthAccepted\x12\x12\n" +
+	"\x04motd\x18\x01 \x01(\tR\x04motd\x12 \n" +
+	"\fserver_ts_ms\x18\x02 \x01(\x03R\n" +
+	"serverTsMs\"p\n" +
 	"\x0fMsgAuthRejected\x122\n" +
 	"\x06reason\x18\x01 \x01(\x0e2\x1a.pb.v1.AuthRejectionReasonR\x06reason\x12\x1d\n" +
 	"\amessage\x18\x02 \x01(\tH\x00R\amessage\x88\x01\x01B\n" +
@@ -3144,25 +4461,40 @@ const file_pb_v1_protocol_proto_rawDesc = "" +
 	"\x14MsgOpenOutboundProxy\x12'\n" +
 	"\x0ftarget_username\x18\x01 \x01(\tR\x0etargetUsername\":\n" +
 	"\x0fMsgInboundProxy\x12'\n" +
-	"\x0forigin_username\x18\x01 \x01(\tR\x0eoriginUsername\"$\n" +
+	"\x0forigin_username\x18\x01 \x01(\tR\x0eoriginUsername\"A\n" +
 	"\x0eMsgGetDirFiles\x12\x12\n" +
-	"\x04path\x18\x01 \x01(\tR\x04path\"7\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12\x1b\n" +
+	"\tpage_size\x18\x02 \x01(\rR\bpageSize\"7\n" +
 	"\vMsgDirFiles\x12(\n" +
-	"\x05files\x18\x01 \x03(\v2\x12.pb.v1.MsgFileMetaR\x05files\"$\n" +
+	"\x05files\x18\x01 \x03(\v2\x12.pb.v1.MsgFileMetaR\x05files\"K\n" +
 	"\x0eMsgGetFileMeta\x12\x12\n" +
-	"\x04path\x18\x01 \x01(\tR\x04path\"L\n" +
+	"\x04path\x18\x01 \x01(\tR\x04path\x12%\n" +
+	"\x0einclude_sha256\x18\x02 \x01(\bR\rincludeSha256\"\x9e\x02\n" +
 	"\vMsgFileMeta\x12\x12\n" +
 	"\x04name\x18\x01 \x01(\tR\x04name\x12\x15\n" +
 	"\x06is_dir\x18\x02 \x01(\bR\x05isDir\x12\x12\n" +
-	"\x04size\x18\x03 \x01(\x04R\x04size\"N\n" +
+	"\x04size\x18\x03 \x01(\x04R\x04size\x12$\n" +
+	"\vmodified_ts\x18\x04 \x01(\x03H\x00R\n" +
+	"modifiedTs\x88\x01\x01\x12\x1b\n" +
+	"\x06sha256\x18\x05 \x01(\fH\x01R\x06sha256\x88\x01\x01\x123\n" +
+	"\x13checksum_chunk_size\x18\x06 \x01(\x04H\x02R\x11checksumChunkSize\x88\x01\x01\x12%\n" +
+	"\x0esha256_pending\x18\a \x01(\bR\rsha256PendingB\x0e\n" +
+	"\f_modified_tsB\t\n" +
+	"\a_sha256B\x16\n" +
+	"\x14_checksum_chunk_size\"~\n" +
 	"\n" +
 	"MsgGetFile\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x12\x16\n" +
 	"\x06offset\x18\x02 \x01(\x04R\x06offset\x12\x14\n" +
-	"\x05limit\x18\x03 \x01(\x04R\x05limit\"\x13\n" +
-	"\x11MsgGetOnlineUsers\",\n" +
+	"\x05limit\x18\x03 \x01(\x04R\x05limit\x12.\n" +
+	"\x13checksum_chunk_size\x18\x04 \x01(\x04R\x11checksumChunkSize\"J\n" +
+	"\x12MsgTransferControl\x124\n" +
+	"\x06action\x18\x01 \x01(\x0e2\x1c.pb.v1.TransferControlActionR\x06action\"0\n" +
+	"\x11MsgGetOnlineUsers\x12\x1b\n" +
+	"\tpage_size\x18\x01 \x01(\rR\bpageSize\"G\n" +
 	"\x0eOnlineUserInfo\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\"=\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12\x19\n" +
+	"\bis_guest\x18\x02 \x01(\bR\aisGuest\"=\n" +
 	"\x0eMsgOnlineUsers\x12+\n" +
 	"\x05users\x18\x01 \x03(\v2\x15.pb.v1.OnlineUserInfoR\x05users\"\b\n" +
 	"\x06MsgBye\"\x89\x01\n" +
@@ -3216,7 +4548,33 @@ const file_pb_v1_protocol_proto_rawDesc = "" +
 	"\x0fMsgClientOnline\x12)\n" +
 	"\x04info\x18\x01 \x01(\v2\x15.pb.v1.OnlineUserInfoR\x04info\".\n" +
 	"\x10MsgClientOffline\x12\x1a\n" +
-	"\busername\x18\x01 \x01(\tR\busername\"!\n" +
+	"\busername\x18\x01 \x01(\tR\busername\"%\n" +
+	"\x0fMsgServerNotice\x12\x12\n" +
+	"\x04text\x18\x01 \x01(\tR\x04text\"\x12\n" +
+	"\x10MsgGetServerInfo\"/\n" +
+	"\x0fMsgSetInterests\x12\x1c\n" +
+	"\tinterests\x18\x01 \x03(\tR\tinterests\"9\n" +
+	"\x12MsgGetSimilarUsers\x12\x19\n" +
+	"\x05limit\x18\x01 \x01(\rH\x00R\x05limit\x88\x01\x01B\b\n" +
+	"\x06_limit\"{\n" +
+	"\vSimilarUser\x12\x1a\n" +
+	"\busername\x18\x01 \x01(\tR\busername\x12)\n" +
+	"\x10shared_interests\x18\x02 \x03(\tR\x0fsharedInterests\x12%\n" +
+	"\x0einterest_count\x18\x03 \x01(\rR\rinterestCount\";\n" +
+	"\x0fMsgSimilarUsers\x12(\n" +
+	"\x05users\x18\x01 \x03(\v2\x12.pb.v1.SimilarUserR\x05users\"\x8c\x02\n" +
+	"\fRoomPolicies\x12\x1f\n" +
+	"\vmax_clients\x18\x01 \x01(\rR\n" +
+	"maxClients\x12>\n" +
+	"\x1cmax_proxy_streams_per_client\x18\x02 \x01(\rR\x18maxProxyStreamsPerClient\x126\n" +
+	"\x17max_concurrent_requests\x18\x03 \x01(\rR\x15maxConcurrentRequests\x12'\n" +
+	"\x10dir_cache_ttl_ms\x18\x04 \x01(\rR\rdirCacheTtlMs\x12:\n" +
+	"\x1arelay_max_bytes_per_second\x18\x05 \x01(\x03R\x16relayMaxBytesPerSecond\"\xae\x01\n" +
+	"\rMsgServerInfo\x12\x18\n" +
+	"\aversion\x18\x01 \x01(\tR\aversion\x12>\n" +
+	"\x10protocol_version\x18\x02 \x01(\v2\x13.pb.v1.ProtoVersionR\x0fprotocolVersion\x12\x1a\n" +
+	"\bfeatures\x18\x03 \x03(\tR\bfeatures\x12'\n" +
+	"\x04room\x18\x04 \x01(\v2\x13.pb.v1.RoomPoliciesR\x04room\"!\n" +
 	"\tMsgSearch\x12\x14\n" +
 	"\x05query\x18\x01 \x01(\tR\x05query\"z\n" +
 	"\x0fMsgSearchResult\x12%\n" +
@@ -3229,7 +4587,28 @@ const file_pb_v1_protocol_proto_rawDesc = "" +
 	"\x17MsgDownloadStatusUpdate\x12\x12\n" +
 	"\x04path\x18\x01 \x01(\tR\x04path\x12-\n" +
 	"\x06status\x18\x02 \x01(\x0e2\x15.pb.v1.DownloadStatusR\x06status\x12)\n" +
-	"\x10bytes_downloaded\x18\x03 \x01(\x04R\x0fbytesDownloaded*\xaf\v\n" +
+	"\x10bytes_downloaded\x18\x03 \x01(\x04R\x0fbytesDownloaded\"E\n" +
+	"\n" +
+	"MsgMeasure\x12\x18\n" +
+	"\apayload\x18\x01 \x01(\fR\apayload\x12\x1d\n" +
+	"\n" +
+	"reply_size\x18\x02 \x01(\rR\treplySize\"+\n" +
+	"\x0fMsgMeasureReply\x12\x18\n" +
+	"\apayload\x18\x01 \x01(\fR\apayload\")\n" +
+	"\fMsgRoomScope\x12\x19\n" +
+	"\bscope_id\x18\x01 \x01(\rR\ascopeId\"t\n" +
+	"\vMsgJoinRoom\x12\x19\n" +
+	"\bscope_id\x18\x01 \x01(\rR\ascopeId\x12\x12\n" +
+	"\x04room\x18\x02 \x01(\tR\x04room\x12\x1a\n" +
+	"\busername\x18\x03 \x01(\tR\busername\x12\x1a\n" +
+	"\bpassword\x18\x04 \x01(\tR\bpassword\"U\n" +
+	"\fMsgLeaveRoom\x12\x1d\n" +
+	"\n" +
+	"close_code\x18\x01 \x01(\rR\tcloseCode\x12\x1b\n" +
+	"\x06reason\x18\x02 \x01(\tH\x00R\x06reason\x88\x01\x01B\t\n" +
+	"\a_reason\"/\n" +
+	"\x11MsgSharesRevision\x12\x1a\n" +
+	"\brevision\x18\x01 \x01(\x04R\brevision*\xaf\x0e\n" +
 	"\aMsgType\x12\x18\n" +
 	"\x14MSG_TYPE_UNSPECIFIED\x10\x00\x12\x11\n" +
 	"\rMSG_TYPE_PING\x10\x01\x12\x11\n" +
@@ -3274,12 +4653,26 @@ const file_pb_v1_protocol_proto_rawDesc = "" +
 	"\x0fMSG_TYPE_SEARCH\x10'\x12\x1a\n" +
 	"\x16MSG_TYPE_SEARCH_RESULT\x10(\x12\x1f\n" +
 	"\x1bMSG_TYPE_SEARCH_ROOM_RESULT\x10)\x12#\n" +
-	"\x1fMSG_TYPE_DOWNLOAD_STATUS_UPDATE\x10*\x12\x18\n" +
-	"\x14MSG_TYPE_START_PUNCH\x10+\x12\x19\n" +
-	"\x15MSG_TYPE_PUNCH_ACCEPT\x10,\x12\x19\n" +
-	"\x15MSG_TYPE_PUNCH_REJECT\x10-\x12\x18\n" +
-	"\x14MSG_TYPE_PUNCH_TOKEN\x10.\x12\x1a\n" +
-	"\x16MSG_TYPE_PUNCH_ADDRESS\x10/*\x8b\x03\n" +
+	"\x1fMSG_TYPE_DOWNLOAD_STATUS_UPDATE\x10*\x12\x1d\n" +
+	"\x19MSG_TYPE_GET_STUN_SERVERS\x10+\x12\x19\n" +
+	"\x15MSG_TYPE_STUN_SERVERS\x10,\x12\x18\n" +
+	"\x14MSG_TYPE_PUNCH_OFFER\x10-\x12\x19\n" +
+	"\x15MSG_TYPE_PUNCH_ACCEPT\x10.\x12\x19\n" +
+	"\x15MSG_TYPE_PUNCH_REJECT\x10/\x12\x15\n" +
+	"\x11MSG_TYPE_REGISTER\x100\x12\x1d\n" +
+	"\x19MSG_TYPE_TRANSFER_CONTROL\x101\x12\x14\n" +
+	"\x10MSG_TYPE_MEASURE\x102\x12\x1a\n" +
+	"\x16MSG_TYPE_MEASURE_REPLY\x103\x12\x1c\n" +
+	"\x18MSG_TYPE_SHARES_REVISION\x104\x12\x17\n" +
+	"\x13MSG_TYPE_ROOM_SCOPE\x105\x12\x16\n" +
+	"\x12MSG_TYPE_JOIN_ROOM\x106\x12\x17\n" +
+	"\x13MSG_TYPE_LEAVE_ROOM\x107\x12\x1a\n" +
+	"\x16MSG_TYPE_SERVER_NOTICE\x108\x12\x1c\n" +
+	"\x18MSG_TYPE_GET_SERVER_INFO\x109\x12\x18\n" +
+	"\x14MSG_TYPE_SERVER_INFO\x10:\x12\x1a\n" +
+	"\x16MSG_TYPE_SET_INTERESTS\x10;\x12\x1e\n" +
+	"\x1aMSG_TYPE_GET_SIMILAR_USERS\x10<\x12\x1a\n" +
+	"\x16MSG_TYPE_SIMILAR_USERS\x10=*\x8b\x03\n" +
 	"\aErrType\x12\x18\n" +
 	"\x14ERR_TYPE_UNSPECIFIED\x10\x00\x12\x15\n" +
 	"\x11ERR_TYPE_INTERNAL\x10\x01\x12\x1e\n" +
@@ -3294,16 +4687,32 @@ const file_pb_v1_protocol_proto_rawDesc = "" +
 	"\x1aERR_TYPE_PERMISSION_DENIED\x10\n" +
 	"\x12\x1f\n" +
 	"\x1bERR_TYPE_PATH_NOT_DIRECTORY\x10\v\x12\x1e\n" +
-	"\x1aERR_TYPE_CLIENT_NOT_ONLINE\x10\f*\x8e\x01\n" +
+	"\x1aERR_TYPE_CLIENT_NOT_ONLINE\x10\f*\\\n" +
+	"\n" +
+	"Capability\x12\x1a\n" +
+	"\x16CAPABILITY_UNSPECIFIED\x10\x00\x12\x18\n" +
+	"\x14CAPABILITY_DATAGRAMS\x10\x01\x12\x18\n" +
+	"\x14CAPABILITY_PAGE_SIZE\x10\x02*\x8e\x01\n" +
 	"\x16VersionRejectionReason\x12(\n" +
 	"$VERSION_REJECTION_REASON_UNSPECIFIED\x10\x00\x12$\n" +
 	" VERSION_REJECTION_REASON_TOO_OLD\x10\x02\x12$\n" +
-	" VERSION_REJECTION_REASON_TOO_NEW\x10\x03*\xba\x01\n" +
+	" VERSION_REJECTION_REASON_TOO_NEW\x10\x03*\xbd\x03\n" +
 	"\x13AuthRejectionReason\x12%\n" +
 	"!AUTH_REJECTION_REASON_UNSPECIFIED\x10\x00\x12-\n" +
 	")AUTH_REJECTION_REASON_INVALID_CREDENTIALS\x10\x02\x12 \n" +
 	"\x1cAUTH_REJECTION_REASON_BANNED\x10\x03\x12+\n" +
-	"'AUTH_REJECTION_REASON_ALREADY_CONNECTED\x10\x04*\x8f\x01\n" +
+	"'AUTH_REJECTION_REASON_ALREADY_CONNECTED\x10\x04\x12&\n" +
+	"\"AUTH_REJECTION_REASON_RATE_LIMITED\x10\x05\x12/\n" +
+	"+AUTH_REJECTION_REASON_REGISTRATION_DISABLED\x10\x06\x12-\n" +
+	")AUTH_REJECTION_REASON_INVALID_INVITE_CODE\x10\a\x12(\n" +
+	"$AUTH_REJECTION_REASON_USERNAME_TAKEN\x10\b\x12*\n" +
+	"&AUTH_REJECTION_REASON_INVALID_PASSWORD\x10\t\x12#\n" +
+	"\x1fAUTH_REJECTION_REASON_ROOM_FULL\x10\n" +
+	"*\x87\x01\n" +
+	"\x15TransferControlAction\x12'\n" +
+	"#TRANSFER_CONTROL_ACTION_UNSPECIFIED\x10\x00\x12!\n" +
+	"\x1dTRANSFER_CONTROL_ACTION_PAUSE\x10\x01\x12\"\n" +
+	"\x1eTRANSFER_CONTROL_ACTION_RESUME\x10\x02*\x8f\x01\n" +
 	"\x0eConnMethodType\x12 \n" +
 	"\x1cCONN_METHOD_TYPE_UNSPECIFIED\x10\x00\x12\x17\n" +
 	"\x13CONN_METHOD_TYPE_IP\x10\x01\x12\x1e\n" +
@@ -3324,14 +4733,15 @@ const file_pb_v1_protocol_proto_rawDesc = "" +
 	"\x1fDIRECT_CONN_HANDSHAKE_RESULT_OK\x10\x01\x12.\n" +
 	"*DIRECT_CONN_HANDSHAKE_RESULT_TOKEN_INVALID\x10\x02\x12/\n" +
 	"+DIRECT_CONN_HANDSHAKE_RESULT_INTERNAL_ERROR\x10\x03\x12(\n" +
-	"$DIRECT_CONN_HANDSHAKE_RESULT_KTHXBYE\x10\x04*\xbd\x01\n" +
+	"$DIRECT_CONN_HANDSHAKE_RESULT_KTHXBYE\x10\x04*\xd9\x01\n" +
 	"\x0eDownloadStatus\x12\x1f\n" +
 	"\x1bDOWNLOAD_STATUS_UNSPECIFIED\x10\x00\x12\x1a\n" +
 	"\x16DOWNLOAD_STATUS_QUEUED\x10\x01\x12\x1b\n" +
 	"\x17DOWNLOAD_STATUS_PENDING\x10\x02\x12\x1c\n" +
 	"\x18DOWNLOAD_STATUS_CANCELED\x10\x03\x12\x18\n" +
 	"\x14DOWNLOAD_STATUS_DONE\x10\x04\x12\x19\n" +
-	"\x15DOWNLOAD_STATUS_ERROR\x10\x05Br\n" +
+	"\x15DOWNLOAD_STATUS_ERROR\x10\x05\x12\x1a\n" +
+	"\x16DOWNLOAD_STATUS_PAUSED\x10\x06Br\n" +
 	"\tcom.pb.v1B\rProtocolProtoP\x01Z!friendnet.org/protocol/pb/v1;pbv1\xa2\x02\x03PXX\xaa\x02\x05Pb.V1\xca\x02\x05Pb\\V1\xe2\x02\x11Pb\\V1\\GPBMetadata\xea\x02\x06Pb::V1b\x06proto3"
 
 var (
@@ -3346,87 +4756,111 @@ func file_pb_v1_protocol_proto_rawDescGZIP() []byte {
 	return file_pb_v1_protocol_proto_rawDescData
 }
 
-var file_pb_v1_protocol_proto_enumTypes = make([]protoimpl.EnumInfo, 8)
-var file_pb_v1_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 45)
+var file_pb_v1_protocol_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_pb_v1_protocol_proto_msgTypes = make([]protoimpl.MessageInfo, 61)
 var file_pb_v1_protocol_proto_goTypes = []any{
 	(MsgType)(0),                              // 0: pb.v1.MsgType
 	(ErrType)(0),                              // 1: pb.v1.ErrType
-	(VersionRejectionReason)(0),               // 2: pb.v1.VersionRejectionReason
-	(AuthRejectionReason)(0),                  // 3: pb.v1.AuthRejectionReason
-	(ConnMethodType)(0),                       // 4: pb.v1.ConnMethodType
-	(ConnResult)(0),                           // 5: pb.v1.ConnResult
-	(DirectConnHandshakeResult)(0),            // 6: pb.v1.DirectConnHandshakeResult
-	(DownloadStatus)(0),                       // 7: pb.v1.DownloadStatus
-	(*MsgPing)(nil),                           // 8: pb.v1.MsgPing
-	(*MsgPong)(nil),                           // 9: pb.v1.MsgPong
-	(*MsgAcknowledged)(nil),                   // 10: pb.v1.MsgAcknowledged
-	(*MsgError)(nil),                          // 11: pb.v1.MsgError
-	(*ProtoVersion)(nil),                      // 12: pb.v1.ProtoVersion
-	(*MsgVersion)(nil),                        // 13: pb.v1.MsgVersion
-	(*MsgVersionAccepted)(nil),                // 14: pb.v1.MsgVersionAccepted
-	(*MsgVersionRejected)(nil),                // 15: pb.v1.MsgVersionRejected
-	(*MsgAuthenticate)(nil),                   // 16: pb.v1.MsgAuthenticate
-	(*MsgAuthAccepted)(nil),                   // 17: pb.v1.MsgAuthAccepted
-	(*MsgAuthRejected)(nil),                   // 18: pb.v1.MsgAuthRejected
-	(*MsgOpenOutboundProxy)(nil),              // 19: pb.v1.MsgOpenOutboundProxy
-	(*MsgInboundProxy)(nil),                   // 20: pb.v1.MsgInboundProxy
-	(*MsgGetDirFiles)(nil),                    // 21: pb.v1.MsgGetDirFiles
-	(*MsgDirFiles)(nil),                       // 22: pb.v1.MsgDirFiles
-	(*MsgGetFileMeta)(nil),                    // 23: pb.v1.MsgGetFileMeta
-	(*MsgFileMeta)(nil),                       // 24: pb.v1.MsgFileMeta
-	(*MsgGetFile)(nil),                        // 25: pb.v1.MsgGetFile
-	(*MsgGetOnlineUsers)(nil),                 // 26: pb.v1.MsgGetOnlineUsers
-	(*OnlineUserInfo)(nil),                    // 27: pb.v1.OnlineUserInfo
-	(*MsgOnlineUsers)(nil),                    // 28: pb.v1.MsgOnlineUsers
-	(*MsgBye)(nil),                            // 29: pb.v1.MsgBye
-	(*MsgAdvertiseConnMethod)(nil),            // 30: pb.v1.MsgAdvertiseConnMethod
-	(*MsgAdvertiseConnMethodResult)(nil),      // 31: pb.v1.MsgAdvertiseConnMethodResult
-	(*MsgRemoveConnMethod)(nil),               // 32: pb.v1.MsgRemoveConnMethod
-	(*MsgConnectToMe)(nil),                    // 33: pb.v1.MsgConnectToMe
-	(*MsgDirectConnResult)(nil),               // 34: pb.v1.MsgDirectConnResult
-	(*MsgGetPublicIp)(nil),                    // 35: pb.v1.MsgGetPublicIp
-	(*MsgPublicIp)(nil),                       // 36: pb.v1.MsgPublicIp
-	(*MsgGetClientConnMethods)(nil),           // 37: pb.v1.MsgGetClientConnMethods
-	(*ConnMethod)(nil),                        // 38: pb.v1.ConnMethod
-	(*MsgClientConnMethods)(nil),              // 39: pb.v1.MsgClientConnMethods
-	(*MsgGetDirectConnHandshakeToken)(nil),    // 40: pb.v1.MsgGetDirectConnHandshakeToken
-	(*MsgDirectConnHandshakeToken)(nil),       // 41: pb.v1.MsgDirectConnHandshakeToken
-	(*MsgRedeemConnHandshakeToken)(nil),       // 42: pb.v1.MsgRedeemConnHandshakeToken
-	(*MsgRedeemConnHandshakeTokenResult)(nil), // 43: pb.v1.MsgRedeemConnHandshakeTokenResult
-	(*MsgDirectConnHandshake)(nil),            // 44: pb.v1.MsgDirectConnHandshake
-	(*MsgDirectConnHandshakeResult)(nil),      // 45: pb.v1.MsgDirectConnHandshakeResult
-	(*MsgChangeAccountPassword)(nil),          // 46: pb.v1.MsgChangeAccountPassword
-	(*MsgClientOnline)(nil),                   // 47: pb.v1.MsgClientOnline
-	(*MsgClientOffline)(nil),                  // 48: pb.v1.MsgClientOffline
-	(*MsgSearch)(nil),                         // 49: pb.v1.MsgSearch
-	(*MsgSearchResult)(nil),                   // 50: pb.v1.MsgSearchResult
-	(*MsgSearchRoomResult)(nil),               // 51: pb.v1.MsgSearchRoomResult
-	(*MsgDownloadStatusUpdate)(nil),           // 52: pb.v1.MsgDownloadStatusUpdate
+	(Capability)(0),                           // 2: pb.v1.Capability
+	(VersionRejectionReason)(0),               // 3: pb.v1.VersionRejectionReason
+	(AuthRejectionReason)(0),                  // 4: pb.v1.AuthRejectionReason
+	(TransferControlAction)(0),                // 5: pb.v1.TransferControlAction
+	(ConnMethodType)(0),                       // 6: pb.v1.ConnMethodType
+	(ConnResult)(0),                           // 7: pb.v1.ConnResult
+	(DirectConnHandshakeResult)(0),            // 8: pb.v1.DirectConnHandshakeResult
+	(DownloadStatus)(0),                       // 9: pb.v1.DownloadStatus
+	(*MsgPing)(nil),                           // 10: pb.v1.MsgPing
+	(*MsgPong)(nil),                           // 11: pb.v1.MsgPong
+	(*MsgAcknowledged)(nil),                   // 12: pb.v1.MsgAcknowledged
+	(*MsgError)(nil),                          // 13: pb.v1.MsgError
+	(*ProtoVersion)(nil),                      // 14: pb.v1.ProtoVersion
+	(*MsgVersion)(nil),                        // 15: pb.v1.MsgVersion
+	(*MsgVersionAccepted)(nil),                // 16: pb.v1.MsgVersionAccepted
+	(*MsgVersionRejected)(nil),                // 17: pb.v1.MsgVersionRejected
+	(*MsgAuthenticate)(nil),                   // 18: pb.v1.MsgAuthenticate
+	(*MsgRegister)(nil),                       // 19: pb.v1.MsgRegister
+	(*MsgAuthAccepted)(nil),                   // 20: pb.v1.MsgAuthAccepted
+	(*MsgAuthRejected)(nil),                   // 21: pb.v1.MsgAuthRejected
+	(*MsgOpenOutboundProxy)(nil),              // 22: pb.v1.MsgOpenOutboundProxy
+	(*MsgInboundProxy)(nil),                   // 23: pb.v1.MsgInboundProxy
+	(*MsgGetDirFiles)(nil),                    // 24: pb.v1.MsgGetDirFiles
+	(*MsgDirFiles)(nil),                       // 25: pb.v1.MsgDirFiles
+	(*MsgGetFileMeta)(nil),                    // 26: pb.v1.MsgGetFileMeta
+	(*MsgFileMeta)(nil),                       // 27: pb.v1.MsgFileMeta
+	(*MsgGetFile)(nil),                        // 28: pb.v1.MsgGetFile
+	(*MsgTransferControl)(nil),                // 29: pb.v1.MsgTransferControl
+	(*MsgGetOnlineUsers)(nil),                 // 30: pb.v1.MsgGetOnlineUsers
+	(*OnlineUserInfo)(nil),                    // 31: pb.v1.OnlineUserInfo
+	(*MsgOnlineUsers)(nil),                    // 32: pb.v1.MsgOnlineUsers
+	(*MsgBye)(nil),                            // 33: pb.v1.MsgBye
+	(*MsgAdvertiseConnMethod)(nil),            // 34: pb.v1.MsgAdvertiseConnMethod
+	(*MsgAdvertiseConnMethodResult)(nil),      // 35: pb.v1.MsgAdvertiseConnMethodResult
+	(*MsgRemoveConnMethod)(nil),               // 36: pb.v1.MsgRemoveConnMethod
+	(*MsgConnectToMe)(nil),                    // 37: pb.v1.MsgConnectToMe
+	(*MsgDirectConnResult)(nil),               // 38: pb.v1.MsgDirectConnResult
+	(*MsgGetPublicIp)(nil),                    // 39: pb.v1.MsgGetPublicIp
+	(*MsgPublicIp)(nil),                       // 40: pb.v1.MsgPublicIp
+	(*MsgGetClientConnMethods)(nil),           // 41: pb.v1.MsgGetClientConnMethods
+	(*ConnMethod)(nil),                        // 42: pb.v1.ConnMethod
+	(*MsgClientConnMethods)(nil),              // 43: pb.v1.MsgClientConnMethods
+	(*MsgGetDirectConnHandshakeToken)(nil),    // 44: pb.v1.MsgGetDirectConnHandshakeToken
+	(*MsgDirectConnHandshakeToken)(nil),       // 45: pb.v1.MsgDirectConnHandshakeToken
+	(*MsgRedeemConnHandshakeToken)(nil),       // 46: pb.v1.MsgRedeemConnHandshakeToken
+	(*MsgRedeemConnHandshakeTokenResult)(nil), // 47: pb.v1.MsgRedeemConnHandshakeTokenResult
+	(*MsgDirectConnHandshake)(nil),            // 48: pb.v1.MsgDirectConnHandshake
+	(*MsgDirectConnHandshakeResult)(nil),      // 49: pb.v1.MsgDirectConnHandshakeResult
+	(*MsgChangeAccountPassword)(nil),          // 50: pb.v1.MsgChangeAccountPassword
+	(*MsgClientOnline)(nil),                   // 51: pb.v1.MsgClientOnline
+	(*MsgClientOffline)(nil),                  // 52: pb.v1.MsgClientOffline
+	(*MsgServerNotice)(nil),                   // 53: pb.v1.MsgServerNotice
+	(*MsgGetServerInfo)(nil),                  // 54: pb.v1.MsgGetServerInfo
+	(*MsgSetInterests)(nil),                   // 55: pb.v1.MsgSetInterests
+	(*MsgGetSimilarUsers)(nil),                // 56: pb.v1.MsgGetSimilarUsers
+	(*SimilarUser)(nil),                       // 57: pb.v1.SimilarUser
+	(*MsgSimilarUsers)(nil),                   // 58: pb.v1.MsgSimilarUsers
+	(*RoomPolicies)(nil),                      // 59: pb.v1.RoomPolicies
+	(*MsgServerInfo)(nil),                     // 60: pb.v1.MsgServerInfo
+	(*MsgSearch)(nil),                         // 61: pb.v1.MsgSearch
+	(*MsgSearchResult)(nil),                   // 62: pb.v1.MsgSearchResult
+	(*MsgSearchRoomResult)(nil),               // 63: pb.v1.MsgSearchRoomResult
+	(*MsgDownloadStatusUpdate)(nil),           // 64: pb.v1.MsgDownloadStatusUpdate
+	(*MsgMeasure)(nil),                        // 65: pb.v1.MsgMeasure
+	(*MsgMeasureReply)(nil),                   // 66: pb.v1.MsgMeasureReply
+	(*MsgRoomScope)(nil),                      // 67: pb.v1.MsgRoomScope
+	(*MsgJoinRoom)(nil),                       // 68: pb.v1.MsgJoinRoom
+	(*MsgLeaveRoom)(nil),                      // 69: pb.v1.MsgLeaveRoom
+	(*MsgSharesRevision)(nil),                 // 70: pb.v1.MsgSharesRevision
 }
 var file_pb_v1_protocol_proto_depIdxs = []int32{
 	1,  // 0: pb.v1.MsgError.type:type_name -> pb.v1.ErrType
-	12, // 1: pb.v1.MsgVersion.version:type_name -> pb.v1.ProtoVersion
-	12, // 2: pb.v1.MsgVersionAccepted.version:type_name -> pb.v1.ProtoVersion
-	12, // 3: pb.v1.MsgVersionRejected.version:type_name -> pb.v1.ProtoVersion
-	2,  // 4: pb.v1.MsgVersionRejected.reason:type_name -> pb.v1.VersionRejectionReason
-	3,  // 5: pb.v1.MsgAuthRejected.reason:type_name -> pb.v1.AuthRejectionReason
-	24, // 6: pb.v1.MsgDirFiles.files:type_name -> pb.v1.MsgFileMeta
-	27, // 7: pb.v1.MsgOnlineUsers.users:type_name -> pb.v1.OnlineUserInfo
-	4,  // 8: pb.v1.MsgAdvertiseConnMethod.type:type_name -> pb.v1.ConnMethodType
-	5,  // 9: pb.v1.MsgAdvertiseConnMethodResult.test_result:type_name -> pb.v1.ConnResult
-	5,  // 10: pb.v1.MsgDirectConnResult.result:type_name -> pb.v1.ConnResult
-	4,  // 11: pb.v1.ConnMethod.type:type_name -> pb.v1.ConnMethodType
-	38, // 12: pb.v1.MsgClientConnMethods.methods:type_name -> pb.v1.ConnMethod
-	6,  // 13: pb.v1.MsgDirectConnHandshakeResult.result:type_name -> pb.v1.DirectConnHandshakeResult
-	27, // 14: pb.v1.MsgClientOnline.info:type_name -> pb.v1.OnlineUserInfo
-	24, // 15: pb.v1.MsgSearchResult.file:type_name -> pb.v1.MsgFileMeta
-	50, // 16: pb.v1.MsgSearchRoomResult.result:type_name -> pb.v1.MsgSearchResult
-	7,  // 17: pb.v1.MsgDownloadStatusUpdate.status:type_name -> pb.v1.DownloadStatus
-	18, // [18:18] is the sub-list for method output_type
-	18, // [18:18] is the sub-list for method input_type
-	18, // [18:18] is the sub-list for extension type_name
-	18, // [18:18] is the sub-list for extension extendee
-	0,  // [0:18] is the sub-list for field type_name
+	14, // 1: pb.v1.MsgVersion.version:type_name -> pb.v1.ProtoVersion
+	2,  // 2: pb.v1.MsgVersion.capabilities:type_name -> pb.v1.Capability
+	14, // 3: pb.v1.MsgVersionAccepted.version:type_name -> pb.v1.ProtoVersion
+	2,  // 4: pb.v1.MsgVersionAccepted.capabilities:type_name -> pb.v1.Capability
+	14, // 5: pb.v1.MsgVersionRejected.version:type_name -> pb.v1.ProtoVersion
+	3,  // 6: pb.v1.MsgVersionRejected.reason:type_name -> pb.v1.VersionRejectionReason
+	4,  // 7: pb.v1.MsgAuthRejected.reason:type_name -> pb.v1.AuthRejectionReason
+	27, // 8: pb.v1.MsgDirFiles.files:type_name -> pb.v1.MsgFileMeta
+	5,  // 9: pb.v1.MsgTransferControl.action:type_name -> pb.v1.TransferControlAction
+	31, // 10: pb.v1.MsgOnlineUsers.users:type_name -> pb.v1.OnlineUserInfo
+	6,  // 11: pb.v1.MsgAdvertiseConnMethod.type:type_name -> pb.v1.ConnMethodType
+	7,  // 12: pb.v1.MsgAdvertiseConnMethodResult.test_result:type_name -> pb.v1.ConnResult
+	7,  // 13: pb.v1.MsgDirectConnResult.result:type_name -> pb.v1.ConnResult
+	6,  // 14: pb.v1.ConnMethod.type:type_name -> pb.v1.ConnMethodType
+	42, // 15: pb.v1.MsgClientConnMethods.methods:type_name -> pb.v1.ConnMethod
+	8,  // 16: pb.v1.MsgDirectConnHandshakeResult.result:type_name -> pb.v1.DirectConnHandshakeResult
+	31, // 17: pb.v1.MsgClientOnline.info:type_name -> pb.v1.OnlineUserInfo
+	57, // 18: pb.v1.MsgSimilarUsers.users:type_name -> pb.v1.SimilarUser
+	14, // 19: pb.v1.MsgServerInfo.protocol_version:type_name -> pb.v1.ProtoVersion
+	59, // 20: pb.v1.MsgServerInfo.room:type_name -> pb.v1.RoomPolicies
+	27, // 21: pb.v1.MsgSearchResult.file:type_name -> pb.v1.MsgFileMeta
+	62, // 22: pb.v1.MsgSearchRoomResult.result:type_name -> pb.v1.MsgSearchResult
+	9,  // 23: pb.v1.MsgDownloadStatusUpdate.status:type_name -> pb.v1.DownloadStatus
+	24, // [24:24] is the sub-list for method output_type
+	24, // [24:24] is the sub-list for method input_type
+	24, // [24:24] is the sub-list for extension type_name
+	24, // [24:24] is the sub-list for extension extendee
+	0,  // [0:24] is the sub-list for field type_name
 }
 
 func init() { file_pb_v1_protocol_proto_init() }
@@ -3436,14 +4870,18 @@ func file_pb_v1_protocol_proto_init() {
 	}
 	file_pb_v1_protocol_proto_msgTypes[3].OneofWrappers = []any{}
 	file_pb_v1_protocol_proto_msgTypes[7].OneofWrappers = []any{}
-	file_pb_v1_protocol_proto_msgTypes[10].OneofWrappers = []any{}
+	file_pb_v1_protocol_proto_msgTypes[9].OneofWrappers = []any{}
+	file_pb_v1_protocol_proto_msgTypes[11].OneofWrappers = []any{}
+	file_pb_v1_protocol_proto_msgTypes[17].OneofWrappers = []any{}
+	file_pb_v1_protocol_proto_msgTypes[46].OneofWrappers = []any{}
+	file_pb_v1_protocol_proto_msgTypes[59].OneofWrappers = []any{}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_pb_v1_protocol_proto_rawDesc), len(file_pb_v1_protocol_proto_rawDesc)),
-			NumEnums:      8,
-			NumMessages:   45,
+			NumEnums:      10,
+			NumMessages:   61,
 			NumExtensions: 0,
 			NumServices:   0,
 		},
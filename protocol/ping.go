@@ -0,0 +1,104 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// NewPong returns the reply to ping, which was received at receivedTs.
+// It carries the timestamps the pinger needs to estimate the clock offset between both sides.
+func NewPong(ping *pb.MsgPing, receivedTs time.Time) *pb.MsgPong {
+	return &pb.MsgPong{
+		SentTs:         time.Now().UnixMilli(),
+		PingSentTs:     ping.SentTs,
+		PingReceivedTs: receivedTs.UnixMilli(),
+	}
+}
+
+// ErrPingTimeout is the cause of the context used by ping loops when the other side does not answer a ping in time.
+// Unlike context.DeadlineExceeded, it is not reported as a closed connection by IsErrorConnCloseOrCancel, so the ping
+// counts as missed.
+var ErrPingTimeout = errors.New("ping timed out")
+
+// Ping pings the other side of conn and measures the round trip.
+// The clock offset is only estimated if the other side answered with the timestamps set by NewPong.
+// See PingContext.
+func Ping(conn ProtoConn) (common.RttSample, error) {
+	return PingContext(context.Background(), conn)
+}
+
+// PingContext is like Ping, but gives up once ctx is done, canceling the ping's bidi.
+// The returned error wraps the cause of ctx, so a peer that keeps the connection alive without answering pings does
+// not block the caller forever.
+//
+// If datagrams were negotiated on conn, the ping is sent as a datagram first, which does not need a stream. Datagrams
+// can be lost, so if no pong arrives within datagramPingTimeout, the ping is sent again over a stream.
+func PingContext(ctx context.Context, conn ProtoConn) (common.RttSample, error) {
+	if pinger := datagramPingerOf(conn); pinger != nil {
+		datagramCtx, cancel := context.WithTimeout(ctx, datagramPingTimeout)
+		sample, err := pinger.ping(datagramCtx)
+		cancel()
+		if err == nil {
+			return sample, nil
+		}
+	}
+
+	start := time.Now()
+	ping := &pb.MsgPing{
+		SentTs: start.UnixMilli(),
+	}
+	bidi, err := conn.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_PING, ping)
+	if err != nil {
+		return common.RttSample{}, fmt.Errorf("failed to ping: %w", err)
+	}
+	defer func() {
+		_ = bidi.Close()
+	}()
+
+	stop := context.AfterFunc(ctx, func() {
+		bidi.Cancel(RequestCanceledStreamErrorCode)
+	})
+	defer stop()
+
+	pong, err := ReadExpect[*pb.MsgPong](bidi.ProtoStreamReader, pb.MsgType_MSG_TYPE_PONG)
+	if err != nil {
+		if cause := context.Cause(ctx); cause != nil {
+			err = cause
+		}
+		return common.RttSample{}, fmt.Errorf("failed to ping: %w", err)
+	}
+	end := time.Now()
+
+	return measurePong(ping, pong.Payload, start, end), nil
+}
+
+// measurePong measures the round trip of ping, which was sent at start and answered with pong at end.
+func measurePong(ping *pb.MsgPing, pong *pb.MsgPong, start time.Time, end time.Time) common.RttSample {
+	sample := common.RttSample{
+		Rtt: end.Sub(start),
+	}
+
+	if pong.PingSentTs != ping.SentTs || pong.PingReceivedTs == 0 || pong.SentTs < pong.PingReceivedTs {
+		return sample
+	}
+
+	// The same calculation NTP uses, where t0 and t3 are local and t1 and t2 are remote.
+	t0 := ping.SentTs
+	t1 := pong.PingReceivedTs
+	t2 := pong.SentTs
+	t3 := end.UnixMilli()
+
+	// Time the other side spent answering is not network delay.
+	if processing := time.Duration(t2-t1) * time.Millisecond; processing < sample.Rtt {
+		sample.Rtt -= processing
+	}
+	sample.ClockOffset = time.Duration((t1-t0)+(t2-t3)) * time.Millisecond / 2
+	sample.ClockOffsetKnown = true
+
+	return sample
+}
@@ -0,0 +1,84 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+func TestMeasurePong_ClockOffset(t *testing.T) {
+	t.Parallel()
+
+	start := time.UnixMilli(1_000_000)
+	end := start.Add(100 * time.Millisecond)
+	ping := &pb.MsgPing{SentTs: start.UnixMilli()}
+
+	// The other side's clock is 5 seconds ahead, the trip takes 40ms each way, and answering takes 20ms.
+	pong := &pb.MsgPong{
+		PingSentTs:     ping.SentTs,
+		PingReceivedTs: start.UnixMilli() + 5040,
+		SentTs:         start.UnixMilli() + 5060,
+	}
+
+	sample := measurePong(ping, pong, start, end)
+	if !sample.ClockOffsetKnown || sample.ClockOffset != 5*time.Second {
+		t.Errorf("expected clock offset 5s, got %s (known: %t)", sample.ClockOffset, sample.ClockOffsetKnown)
+	}
+	if sample.Rtt != 80*time.Millisecond {
+		t.Errorf("expected round-trip time 80ms without processing time, got %s", sample.Rtt)
+	}
+}
+
+func TestMeasurePong_WithoutTimestamps(t *testing.T) {
+	t.Parallel()
+
+	start := time.UnixMilli(1_000_000)
+	sample := measurePong(&pb.MsgPing{SentTs: start.UnixMilli()}, &pb.MsgPong{}, start, start.Add(30*time.Millisecond))
+	if sample.ClockOffsetKnown {
+		t.Error("expected no clock offset from a pong without timestamps")
+	}
+	if sample.Rtt != 30*time.Millisecond {
+		t.Errorf("expected round-trip time 30ms, got %s", sample.Rtt)
+	}
+}
+
+func TestPingContext_PeerNeverAnswers(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	local, remote, err := NewMemNetwork().ConnPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+
+	// The peer keeps the connection alive, but only reads pings.
+	go func() {
+		for {
+			bidi, err := remote.WaitForBidi(ctx)
+			if err != nil {
+				return
+			}
+			_, _ = bidi.Read()
+		}
+	}()
+
+	pingCtx, pingCancel := context.WithTimeoutCause(ctx, 200*time.Millisecond, ErrPingTimeout)
+	defer pingCancel()
+
+	start := time.Now()
+	_, err = PingContext(pingCtx, local)
+	if !errors.Is(err, ErrPingTimeout) {
+		t.Fatalf("expected ErrPingTimeout, got %v", err)
+	}
+	if IsErrorConnCloseOrCancel(err) {
+		t.Fatal("expected a timed out ping not to look like a closed connection")
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the ping to give up soon after the timeout, took %s", elapsed)
+	}
+}
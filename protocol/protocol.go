@@ -8,8 +8,10 @@ import (
 	"fmt"
 	"io"
 	"net"
-	"net/netip"
 	"reflect"
+	"strconv"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	"friendnet.org/common"
@@ -28,6 +30,11 @@ const MaxPayloadSize = 512 * 1024
 // ProxyPeerUnreachableStreamErrorCode is the code inside a quic.StreamError returned to a proxy initiator when the destination peer is unreachable.
 const ProxyPeerUnreachableStreamErrorCode quic.StreamErrorCode = 101
 
+// RequestCanceledStreamErrorCode is the code a requester uses to reset a bidi when it no longer wants the response,
+// for example when the HTTP client that asked for a file went away.
+// Proxies pass it on to the other side, so the peer serving the request stops as soon as possible.
+const RequestCanceledStreamErrorCode quic.StreamErrorCode = 102
+
 // ErrPeerUnreachable is returned when a peer is unreachable.
 var ErrPeerUnreachable = errors.New("peer unreachable")
 
@@ -40,12 +47,54 @@ var CurrentProtocolVersion = &pb.ProtoVersion{
 	Patch: 1,
 }
 
+// DefaultServerPort is the port servers listen on by default, and the port clients use when an address has none and
+// none could be discovered.
+const DefaultServerPort = 20038
+
 // DefaultKeepAlivePeriod is the default keepalive period for QUIC connections.
 const DefaultKeepAlivePeriod = 10 * time.Second
 
 // DefaultMaxIncomingStreams is the default maximum of incoming streams to allow for QUIC connections.
 const DefaultMaxIncomingStreams = 100
 
+// DefaultMaxConcurrentRequests is the default maximum number of requests handled at once for a single peer.
+// It is lower than DefaultMaxIncomingStreams so that excess requests are rejected instead of waiting on QUIC flow
+// control, and so that pings and other housekeeping streams always have room.
+const DefaultMaxConcurrentRequests = 64
+
+// ConnLimits limits how much of a connection a single peer can use at once.
+type ConnLimits struct {
+	// The maximum number of streams the peer may have open at once.
+	// This is enforced by QUIC; opening more streams blocks until others close.
+	MaxIncomingStreams int64
+
+	// The maximum number of requests handled at once.
+	// Requests beyond this are rejected with ERR_TYPE_RATE_LIMITED.
+	// It should be lower than MaxIncomingStreams, otherwise QUIC blocks excess requests before they can be rejected.
+	// 0 means unlimited.
+	MaxConcurrentRequests int
+}
+
+// DefaultConnLimits is the default connection limits.
+var DefaultConnLimits = ConnLimits{
+	MaxIncomingStreams:    DefaultMaxIncomingStreams,
+	MaxConcurrentRequests: DefaultMaxConcurrentRequests,
+}
+
+// QuicConfig returns a QUIC config that enforces the limits.
+func (l ConnLimits) QuicConfig() *quic.Config {
+	maxStreams := l.MaxIncomingStreams
+	if maxStreams <= 0 {
+		maxStreams = DefaultMaxIncomingStreams
+	}
+
+	return &quic.Config{
+		KeepAlivePeriod:    DefaultKeepAlivePeriod,
+		MaxIncomingStreams: maxStreams,
+		EnableDatagrams:    true,
+	}
+}
+
 // UntypedProtoMsg is a protocol message with an unknown payload type.
 // It can be converted to a TypedProtoMsg with ToTyped.
 // See documentation on ToTyped for details.
@@ -121,10 +170,7 @@ func (d *QuicProtoDialer) Dial(ctx context.Context, addr string) (ProtoConn, err
 		return nil, fmt.Errorf(`failed to resolve address %q: %w`, addr, err)
 	}
 
-	conn, err := d.tr.Dial(ctx, udpAddr, d.tlsCfg, &quic.Config{
-		KeepAlivePeriod:    DefaultKeepAlivePeriod,
-		MaxIncomingStreams: DefaultMaxIncomingStreams,
-	})
+	conn, err := d.tr.Dial(ctx, udpAddr, d.tlsCfg, DefaultConnLimits.QuicConfig())
 	if err != nil {
 		return nil, err
 	}
@@ -162,9 +208,9 @@ type ProtoConn interface {
 	// RemoteAddr returns the remote address of the connection.
 	RemoteAddr() net.Addr
 
-	// CloseWithReason closes the connection with the specified reason.
+	// CloseWithCode closes the connection with the specified code and human-readable reason.
 	// It will try to send a close message to the other side, but delivery is not guaranteed.
-	CloseWithReason(string) error
+	CloseWithCode(code CloseCode, reason string) error
 
 	// OpenBidiWithMsg opens a new bidirectional stream and sends the specified protocol message on it.
 	// It is the responsibility of the caller to close the bidi after it is opened successfully.
@@ -187,6 +233,14 @@ type ProtoConn interface {
 type ProtoConnImpl struct {
 	// The underlying QUIC connection.
 	Inner *quic.Conn
+
+	// The capabilities negotiated for the connection.
+	// See SetCapabilities.
+	capabilities atomic.Pointer[[]pb.Capability]
+
+	// Receives datagrams once they are negotiated.
+	// Nil until then.
+	datagramPinger atomic.Pointer[datagramPinger]
 }
 
 var _ ProtoConn = &ProtoConnImpl{}
@@ -202,8 +256,8 @@ func (conn *ProtoConnImpl) RemoteAddr() net.Addr {
 	return conn.Inner.RemoteAddr()
 }
 
-func (conn *ProtoConnImpl) CloseWithReason(reason string) error {
-	return conn.Inner.CloseWithError(0, reason)
+func (conn *ProtoConnImpl) CloseWithCode(code CloseCode, reason string) error {
+	return conn.Inner.CloseWithError(quic.ApplicationErrorCode(code), reason)
 }
 
 func (conn *ProtoConnImpl) OpenBidiWithMsg(typ pb.MsgType, msg proto.Message) (bidi ProtoBidi, err error) {
@@ -318,34 +372,24 @@ func NewProtoStreamReader(stream io.Reader) *ProtoStreamReader {
 // ReadRaw tries to read a protocol message from the stream.
 // It does not do any special handling for error types.
 // If the bidi was closed because a remote peer was unreachable, returns ErrPeerUnreachable.
+//
+// If the stream ends cleanly before a new message starts, the returned error wraps io.EOF.
+// If it ends partway through a message, the error wraps io.ErrUnexpectedEOF instead.
+// If the message type is unknown, its payload is consumed and an UnknownMsgTypeError is returned, so the stream
+// can still be read afterward.
 func (r *ProtoStreamReader) ReadRaw() (*UntypedProtoMsg, error) {
-	var (
-		n   int
-		err error
-	)
-
 	// Read header.
 	// A tiny read like this is fine because QUIC streams are buffered.
 	var header [msgHeaderSize]byte
-	headerRead := 0
-	for headerRead < len(header) {
-		n, err = r.stream.Read(header[headerRead:])
-		if n > 0 {
-			headerRead += n
-		}
-		if err != nil {
-			var streamErr *quic.StreamError
-			if errors.As(err, &streamErr) {
-				if streamErr.ErrorCode == ProxyPeerUnreachableStreamErrorCode {
-					return nil, ErrPeerUnreachable
-				}
-			}
-
-			if errors.Is(err, io.EOF) && headerRead == len(header) {
-				break
+	if _, err := io.ReadFull(r.stream, header[:]); err != nil {
+		var streamErr *quic.StreamError
+		if errors.As(err, &streamErr) {
+			if streamErr.ErrorCode == ProxyPeerUnreachableStreamErrorCode {
+				return nil, ErrPeerUnreachable
 			}
-			return nil, fmt.Errorf(`failed to read protocol message header: %w`, err)
 		}
+
+		return nil, fmt.Errorf(`failed to read protocol message header: %w`, err)
 	}
 
 	typ := pb.MsgType(binary.LittleEndian.Uint32(header[:4]))
@@ -356,33 +400,27 @@ func (r *ProtoStreamReader) ReadRaw() (*UntypedProtoMsg, error) {
 	}
 
 	// Read payload.
-	readSize := 0
 	payload := make([]byte, payloadLen)
-	for readSize < len(payload) {
-		n, err = r.stream.Read(payload[readSize:])
-		if n > 0 {
-			readSize += n
-		}
-		if err != nil {
-			if err == io.EOF && readSize == len(payload) {
-				break
-			}
-			return nil, fmt.Errorf(`got protocol message header with type %s and length %d, but failed reading payload at %d bytes: %w`,
-				typ.String(),
-				payloadLen,
-				readSize,
-				err,
-			)
+	if readSize, err := io.ReadFull(r.stream, payload); err != nil {
+		// The header promised a payload, so even a clean end of stream is a truncated message.
+		if errors.Is(err, io.EOF) {
+			err = io.ErrUnexpectedEOF
 		}
+		return nil, fmt.Errorf(`got protocol message header with type %s and length %d, but failed reading payload at %d bytes: %w`,
+			typ.String(),
+			payloadLen,
+			readSize,
+			err,
+		)
 	}
 
 	// Decode message.
 	msg := MsgTypeToEmptyMsg(typ)
 	if msg == nil {
-		return nil, fmt.Errorf(`BUG: got message type %s but there was no message mapping for it`, typ.String())
+		return nil, UnknownMsgTypeError{Type: typ}
 	}
 
-	err = proto.Unmarshal(payload, msg)
+	err := proto.Unmarshal(payload, msg)
 	if err != nil {
 		return nil, fmt.Errorf(`failed to decode protocol message payload with supposed type %s and length %d: %w`,
 			typ.String(),
@@ -522,6 +560,13 @@ func (bidi ProtoBidi) Close() error {
 	return nil
 }
 
+// Cancel abruptly aborts both sides of the stream with the specified code.
+// Unlike Close, the other side is told to stop sending immediately and any unsent data is discarded.
+func (bidi ProtoBidi) Cancel(code quic.StreamErrorCode) {
+	bidi.Stream.CancelRead(code)
+	bidi.Stream.CancelWrite(code)
+}
+
 func wrapBidi(stream *quic.Stream) ProtoBidi {
 	return ProtoBidi{
 		Stream:            stream,
@@ -625,6 +670,35 @@ func CompareProtoVersions(a *pb.ProtoVersion, b *pb.ProtoVersion) int {
 	return 0
 }
 
+// ParseProtoVersion parses a protocol version in "MAJOR.MINOR" or "MAJOR.MINOR.PATCH" format.
+// If the patch part is omitted, it is 0.
+func ParseProtoVersion(str string) (*pb.ProtoVersion, error) {
+	parts := strings.Split(str, ".")
+	if len(parts) < 2 || len(parts) > 3 {
+		return nil, fmt.Errorf(`protocol version %q must be in "MAJOR.MINOR" or "MAJOR.MINOR.PATCH" format`, str)
+	}
+
+	nums := make([]uint32, 3)
+	for i, part := range parts {
+		num, err := strconv.ParseUint(part, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf(`protocol version %q has an invalid part %q`, str, part)
+		}
+		nums[i] = uint32(num)
+	}
+
+	return &pb.ProtoVersion{
+		Major: nums[0],
+		Minor: nums[1],
+		Patch: nums[2],
+	}, nil
+}
+
+// FormatProtoVersion formats a protocol version in "MAJOR.MINOR.PATCH" format.
+func FormatProtoVersion(ver *pb.ProtoVersion) string {
+	return fmt.Sprintf("%d.%d.%d", ver.GetMajor(), ver.GetMinor(), ver.GetPatch())
+}
+
 // ProtoListener represents a listener that can accept protocol connections.
 type ProtoListener interface {
 	io.Closer
@@ -636,10 +710,14 @@ type ProtoListener interface {
 // QuicProtoListener implements ProtoListener using QUIC.
 type QuicProtoListener struct {
 	*quic.Listener
+
+	owned ownedTransport
 }
 
 func (l *QuicProtoListener) Close() error {
-	return l.Listener.Close()
+	err := l.Listener.Close()
+	l.owned.close()
+	return err
 }
 
 func (l *QuicProtoListener) Accept(ctx context.Context) (ProtoConn, error) {
@@ -658,45 +736,125 @@ func ToProtoListener(listener *quic.Listener) ProtoListener {
 	}
 }
 
-// NewQuicProtoListener creates a ProtoListener on the specified transport and TLS config.
-func NewQuicProtoListenerFromTransport(trans *quic.Transport, tlsCfg *tls.Config) (ProtoListener, error) {
-	listener, err := trans.Listen(tlsCfg, &quic.Config{
-		KeepAlivePeriod:    DefaultKeepAlivePeriod,
-		MaxIncomingStreams: DefaultMaxIncomingStreams,
-	})
+// QuicEarlyProtoListener implements ProtoListener using QUIC, accepting connections before their handshake completes.
+// Clients that connected before may send 0-RTT data on them, so only replay-safe messages may be acted on until the
+// handshake completes. See WaitUntilReplaySafe.
+type QuicEarlyProtoListener struct {
+	*quic.EarlyListener
+
+	owned ownedTransport
+}
+
+func (l *QuicEarlyProtoListener) Close() error {
+	err := l.EarlyListener.Close()
+	l.owned.close()
+	return err
+}
+
+func (l *QuicEarlyProtoListener) Accept(ctx context.Context) (ProtoConn, error) {
+	conn, err := l.EarlyListener.Accept(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	return ToProtoListener(listener), nil
+	return ToProtoConn(conn), nil
+}
+
+// ownedTransport is the transport and packet connection a listener was created on, if it owns them.
+// They are closed along with the listener.
+type ownedTransport struct {
+	trans      *quic.Transport
+	packetConn net.PacketConn
+}
+
+func (o ownedTransport) close() {
+	if o.trans != nil {
+		_ = o.trans.Close()
+		_ = o.packetConn.Close()
+	}
+}
+
+// ownsTransport is implemented by listeners that can own the transport they were created on.
+type ownsTransport interface {
+	setOwnedTransport(trans *quic.Transport, packetConn net.PacketConn)
+}
+
+func (l *QuicProtoListener) setOwnedTransport(trans *quic.Transport, packetConn net.PacketConn) {
+	l.owned = ownedTransport{trans: trans, packetConn: packetConn}
+}
+
+func (l *QuicEarlyProtoListener) setOwnedTransport(trans *quic.Transport, packetConn net.PacketConn) {
+	l.owned = ownedTransport{trans: trans, packetConn: packetConn}
 }
 
-// NewQuicProtoListener creates a ProtoListener on the specified address and TLS config.
-func NewQuicProtoListener(listenAddr string, tlsCfg *tls.Config) (ProtoListener, error) {
-	addrPort, err := netip.ParseAddrPort(listenAddr)
+// NewQuicProtoListenerFromTransport creates a ProtoListener on the specified transport, TLS config and limits.
+// Connections are returned once their handshake completes.
+func NewQuicProtoListenerFromTransport(trans *quic.Transport, tlsCfg *tls.Config, limits ConnLimits) (ProtoListener, error) {
+	listener, err := trans.Listen(tlsCfg, limits.QuicConfig())
 	if err != nil {
-		return nil, fmt.Errorf(`failed to parse listen address %q: %w`, listenAddr, err)
+		return nil, err
 	}
 
-	var udpConn *net.UDPConn
-	addr := addrPort.Addr()
-	if addr.Is6() {
-		udpConn, err = net.ListenUDP("udp6", &net.UDPAddr{
-			IP:   addr.AsSlice(),
-			Port: int(addrPort.Port()),
-		})
-	} else {
-		udpConn, err = net.ListenUDP("udp4", &net.UDPAddr{
-			IP:   addr.AsSlice(),
-			Port: int(addrPort.Port()),
-		})
+	return ToProtoListener(listener), nil
+}
+
+// NewQuicEarlyProtoListenerFromTransport is like NewQuicProtoListenerFromTransport, but accepts 0-RTT connections,
+// so it returns them before their handshake completes.
+// Only handlers that call WaitUntilReplaySafe before acting on messages may serve its connections.
+// See QuicEarlyProtoListener.
+func NewQuicEarlyProtoListenerFromTransport(trans *quic.Transport, tlsCfg *tls.Config, limits ConnLimits) (ProtoListener, error) {
+	quicCfg := limits.QuicConfig()
+	quicCfg.Allow0RTT = true
+
+	listener, err := trans.ListenEarly(tlsCfg, quicCfg)
+	if err != nil {
+		return nil, err
 	}
+
+	return &QuicEarlyProtoListener{
+		EarlyListener: listener,
+	}, nil
+}
+
+// NewQuicProtoListener creates a ProtoListener on the specified address, TLS config and limits.
+// Like NewQuicProtoListenerFromTransport, it returns connections once their handshake completes.
+func NewQuicProtoListener(listenAddr string, tlsCfg *tls.Config, limits ConnLimits) (ProtoListener, error) {
+	return newQuicProtoListener(listenAddr, false, false, tlsCfg, limits)
+}
+
+// NewQuicEarlyProtoListener is like NewQuicProtoListener, but accepts 0-RTT connections.
+// See NewQuicEarlyProtoListenerFromTransport.
+func NewQuicEarlyProtoListener(listenAddr string, tlsCfg *tls.Config, limits ConnLimits) (ProtoListener, error) {
+	return newQuicProtoListener(listenAddr, false, true, tlsCfg, limits)
+}
+
+// NewQuicEarlyProtoListenerReusePort is like NewQuicEarlyProtoListener, but binds with port reuse so that another
+// process can listen on the same address at the same time.
+// See ListenUDP.
+func NewQuicEarlyProtoListenerReusePort(listenAddr string, tlsCfg *tls.Config, limits ConnLimits) (ProtoListener, error) {
+	return newQuicProtoListener(listenAddr, true, true, tlsCfg, limits)
+}
+
+func newQuicProtoListener(listenAddr string, reusePort bool, early bool, tlsCfg *tls.Config, limits ConnLimits) (ProtoListener, error) {
+	udpConn, err := ListenUDP(listenAddr, reusePort)
 	if err != nil {
 		return nil, err
 	}
 
 	trans := &quic.Transport{Conn: udpConn}
-	return NewQuicProtoListenerFromTransport(trans, tlsCfg)
+	newListener := NewQuicProtoListenerFromTransport
+	if early {
+		newListener = NewQuicEarlyProtoListenerFromTransport
+	}
+	listener, err := newListener(trans, tlsCfg, limits)
+	if err != nil {
+		_ = udpConn.Close()
+		return nil, err
+	}
+
+	// The transport and connection were created for the listener, so they are closed along with it.
+	listener.(ownsTransport).setOwnedTransport(trans, udpConn)
+	return listener, nil
 }
 
 // IsErrorConnCloseOrCancel returns whether the specified error can broadly be considered a connection close or cancel error.
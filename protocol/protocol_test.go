@@ -0,0 +1,193 @@
+package protocol
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"io"
+	"testing"
+
+	pb "friendnet.org/protocol/pb/v1"
+	"google.golang.org/protobuf/proto"
+)
+
+// encodeMsg encodes a message the same way ProtoStreamWriter does.
+func encodeMsg(t testing.TB, typ pb.MsgType, msg proto.Message) []byte {
+	var buf bytes.Buffer
+	if err := NewProtoStreamWriter(&buf).Write(typ, msg); err != nil {
+		t.Fatalf("failed to encode message: %v", err)
+	}
+	return buf.Bytes()
+}
+
+// rawMsg encodes a message header with an arbitrary type and length, followed by payload.
+func rawMsg(typ uint32, length uint32, payload []byte) []byte {
+	buf := make([]byte, msgHeaderSize, msgHeaderSize+len(payload))
+	binary.LittleEndian.PutUint32(buf[:4], typ)
+	binary.LittleEndian.PutUint32(buf[4:], length)
+	return append(buf, payload...)
+}
+
+func TestReadRaw_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	data := encodeMsg(t, pb.MsgType_MSG_TYPE_PING, &pb.MsgPing{SentTs: 1234})
+	msg, err := NewProtoStreamReader(bytes.NewReader(data)).ReadRaw()
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if msg.Type != pb.MsgType_MSG_TYPE_PING || msg.Payload.(*pb.MsgPing).SentTs != 1234 {
+		t.Fatalf("unexpected message: %v", msg)
+	}
+}
+
+func TestReadRaw_Malformed(t *testing.T) {
+	t.Parallel()
+
+	ping := encodeMsg(t, pb.MsgType_MSG_TYPE_PING, &pb.MsgPing{SentTs: 1234})
+
+	tests := []struct {
+		name string
+		data []byte
+		is   error
+	}{
+		{"empty", nil, io.EOF},
+		{"truncated header", ping[:3], io.ErrUnexpectedEOF},
+		{"truncated payload", ping[:len(ping)-1], io.ErrUnexpectedEOF},
+		{"missing payload", rawMsg(uint32(pb.MsgType_MSG_TYPE_PING), 10, nil), io.ErrUnexpectedEOF},
+		{"absurd length", rawMsg(uint32(pb.MsgType_MSG_TYPE_PING), 0xFFFFFFFF, nil), nil},
+		{"unknown type", rawMsg(0xFFFFFF, 0, nil), nil},
+		{"unspecified type", rawMsg(0, 0, nil), nil},
+		{"garbage payload", rawMsg(uint32(pb.MsgType_MSG_TYPE_PING), 3, []byte{0xFF, 0xFF, 0xFF}), nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			msg, err := NewProtoStreamReader(bytes.NewReader(tt.data)).ReadRaw()
+			if err == nil {
+				t.Fatalf("expected error, got message %v", msg)
+			}
+			if tt.is != nil && !errors.Is(err, tt.is) {
+				t.Fatalf("expected error wrapping %v, got %v", tt.is, err)
+			}
+		})
+	}
+}
+
+func TestReadRaw_UnknownTypeKeepsFraming(t *testing.T) {
+	t.Parallel()
+
+	data := rawMsg(0xFFFFFF, 3, []byte{1, 2, 3})
+	data = append(data, encodeMsg(t, pb.MsgType_MSG_TYPE_PONG, &pb.MsgPong{})...)
+
+	r := NewProtoStreamReader(bytes.NewReader(data))
+	if _, err := r.ReadRaw(); !errors.As(err, new(UnknownMsgTypeError)) {
+		t.Fatalf("expected UnknownMsgTypeError, got %v", err)
+	}
+
+	msg, err := r.ReadRaw()
+	if err != nil {
+		t.Fatalf("unexpected error reading message after unknown type: %v", err)
+	}
+	if msg.Type != pb.MsgType_MSG_TYPE_PONG {
+		t.Fatalf("expected PONG, got %s", msg.Type.String())
+	}
+}
+
+func FuzzReadRaw(f *testing.F) {
+	f.Add(encodeMsg(f, pb.MsgType_MSG_TYPE_PING, &pb.MsgPing{SentTs: 1234}))
+	f.Add(encodeMsg(f, pb.MsgType_MSG_TYPE_ERROR, &pb.MsgError{Message: new("oops")}))
+	f.Add(encodeMsg(f, pb.MsgType_MSG_TYPE_DIR_FILES, &pb.MsgDirFiles{
+		Files: []*pb.MsgFileMeta{{Name: "a", Size: 1}},
+	}))
+	f.Add(rawMsg(uint32(pb.MsgType_MSG_TYPE_PING), 0xFFFFFFFF, nil))
+	f.Add(rawMsg(0xFFFFFF, 2, []byte{1, 2}))
+	f.Add([]byte{1, 2, 3})
+
+	f.Fuzz(func(t *testing.T, data []byte) {
+		r := NewProtoStreamReader(bytes.NewReader(data))
+
+		// Read until the input is exhausted or the framing breaks.
+		for range 64 {
+			msg, err := r.Read()
+			if err != nil {
+				if errors.Is(err, io.EOF) {
+					return
+				}
+				if errors.As(err, new(UnknownMsgTypeError)) || errors.As(err, new(ProtoMsgError)) {
+					continue
+				}
+				return
+			}
+			if msg.Payload == nil {
+				t.Fatalf("message of type %s has a nil payload", msg.Type.String())
+			}
+		}
+	})
+}
+
+func FuzzMsgTypeToEmptyMsg(f *testing.F) {
+	for typ := range pb.MsgType_name {
+		f.Add(uint32(typ), []byte{})
+	}
+	f.Add(uint32(0xFFFFFFFF), []byte{0x08, 0x01})
+
+	f.Fuzz(func(t *testing.T, typ uint32, payload []byte) {
+		msg := MsgTypeToEmptyMsg(pb.MsgType(typ))
+		if msg == nil {
+			return
+		}
+		_ = proto.Unmarshal(payload, msg)
+	})
+}
+
+func TestMsgTypeToEmptyMsg_KnownTypes(t *testing.T) {
+	t.Parallel()
+
+	// Types that are reserved in the protocol definition but have no payload message yet.
+	unmapped := map[pb.MsgType]struct{}{
+		pb.MsgType_MSG_TYPE_UNSPECIFIED:      {},
+		pb.MsgType_MSG_TYPE_GET_STUN_SERVERS: {},
+		pb.MsgType_MSG_TYPE_STUN_SERVERS:     {},
+		pb.MsgType_MSG_TYPE_PUNCH_OFFER:      {},
+		pb.MsgType_MSG_TYPE_PUNCH_ACCEPT:     {},
+		pb.MsgType_MSG_TYPE_PUNCH_REJECT:     {},
+	}
+
+	for num, name := range pb.MsgType_name {
+		typ := pb.MsgType(num)
+		if _, skip := unmapped[typ]; skip {
+			continue
+		}
+		if MsgTypeToEmptyMsg(typ) == nil {
+			t.Errorf("message type %s has no mapping", name)
+		}
+	}
+}
+
+func TestParseProtoVersion(t *testing.T) {
+	t.Parallel()
+
+	for str, want := range map[string]*pb.ProtoVersion{
+		"1.0":    {Major: 1, Minor: 0, Patch: 0},
+		"1.2.3":  {Major: 1, Minor: 2, Patch: 3},
+		"0.10.0": {Major: 0, Minor: 10, Patch: 0},
+	} {
+		got, err := ParseProtoVersion(str)
+		if err != nil {
+			t.Errorf("failed to parse %q: %v", str, err)
+			continue
+		}
+		if CompareProtoVersions(got, want) != 0 {
+			t.Errorf("parsed %q as %s, want %s", str, FormatProtoVersion(got), FormatProtoVersion(want))
+		}
+	}
+
+	for _, str := range []string{"", "1", "1.2.3.4", "a.b", "1.-1", "1..2"} {
+		if _, err := ParseProtoVersion(str); err == nil {
+			t.Errorf("expected %q to be rejected", str)
+		}
+	}
+}
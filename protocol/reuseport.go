@@ -0,0 +1,64 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+)
+
+// With SO_REUSEPORT, two server processes can listen on the same UDP port at the same time, so a server binary can be
+// upgraded without refusing connections while neither process is listening. The handoff works like this:
+//
+//  1. The new process starts listening on the same address with port reuse enabled.
+//     The old process must have been started with port reuse enabled too, or the new one fails to bind.
+//  2. The old process is told to drain. It refuses new connections and proxied streams, and lets existing ones finish.
+//  3. Once the old process has no active proxied streams, it is stopped. Its clients are disconnected and reconnect,
+//     which now always reaches the new process.
+//
+// The kernel picks the socket for each packet by hashing its source and destination addresses over all sockets bound
+// to the port, so while both processes are listening, new connections reach either of them. Clients refused by the
+// draining process try again later, like they would when connecting to any draining server.
+//
+// When the new process binds, the kernel spreads the hash over one more socket, so packets of some connections that
+// were already established with the old process start arriving at the new one instead. The new process does not know
+// those connections and drops their packets without a stateless reset, so the affected clients time out and reconnect.
+// Only that share of clients is disconnected early; the rest of the room stays online on the old process until it stops.
+//
+// Port reuse is only supported on Linux, where it balances packets between sockets as described above.
+// Other systems either do not support it for UDP or deliver all packets to a single socket.
+
+// ErrReusePortUnsupported is returned when port reuse is requested on a system that does not support it.
+var ErrReusePortUnsupported = errors.New("port reuse is not supported on this system")
+
+// ListenUDP listens for UDP packets on the specified address.
+// The address must be in HOST:PORT format, with IPv6 addresses enclosed in square brackets.
+//
+// If reusePort is true, the socket is bound with SO_REUSEPORT so that other processes can listen on the same address.
+// Returns ErrReusePortUnsupported if reusePort is true and ReusePortSupported is false.
+func ListenUDP(listenAddr string, reusePort bool) (*net.UDPConn, error) {
+	addrPort, err := netip.ParseAddrPort(listenAddr)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to parse listen address %q: %w`, listenAddr, err)
+	}
+
+	var lc net.ListenConfig
+	if reusePort {
+		if !ReusePortSupported {
+			return nil, ErrReusePortUnsupported
+		}
+		lc.Control = reusePortControl
+	}
+
+	network := "udp4"
+	if addrPort.Addr().Is6() {
+		network = "udp6"
+	}
+
+	conn, err := lc.ListenPacket(context.Background(), network, addrPort.String())
+	if err != nil {
+		return nil, err
+	}
+	return conn.(*net.UDPConn), nil
+}
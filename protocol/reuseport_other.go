@@ -0,0 +1,12 @@
+//go:build !linux
+
+package protocol
+
+import "syscall"
+
+// ReusePortSupported is whether ListenUDP supports port reuse on this system.
+const ReusePortSupported = false
+
+func reusePortControl(_ string, _ string, _ syscall.RawConn) error {
+	return ErrReusePortUnsupported
+}
@@ -0,0 +1,42 @@
+package protocol
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestListenUDP_ReusePort(t *testing.T) {
+	t.Parallel()
+
+	if !ReusePortSupported {
+		if _, err := ListenUDP("127.0.0.1:0", true); !errors.Is(err, ErrReusePortUnsupported) {
+			t.Fatalf("expected ErrReusePortUnsupported, got %v", err)
+		}
+		return
+	}
+
+	first, err := ListenUDP("127.0.0.1:0", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = first.Close()
+	}()
+	addr := first.LocalAddr().String()
+
+	// A second socket can bind to the same port when both reuse it.
+	second, err := ListenUDP(addr, true)
+	if err != nil {
+		t.Fatalf("expected second listener with port reuse to bind to %s, got %v", addr, err)
+	}
+	defer func() {
+		_ = second.Close()
+	}()
+
+	// But not when it does not.
+	third, err := ListenUDP(addr, false)
+	if err == nil {
+		_ = third.Close()
+		t.Fatalf("expected listener without port reuse to fail to bind to %s", addr)
+	}
+}
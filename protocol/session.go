@@ -0,0 +1,462 @@
+package protocol
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"sync"
+	"time"
+
+	pb "friendnet.org/protocol/pb/v1"
+	"github.com/quic-go/quic-go"
+	"google.golang.org/protobuf/proto"
+)
+
+// ScopeClosedStreamErrorCode is the code used to reset bidis that were still open when the room they were scoped to
+// was left.
+const ScopeClosedStreamErrorCode quic.StreamErrorCode = 103
+
+// leaveAckTimeout is how long to wait for the other side to acknowledge MSG_TYPE_LEAVE_ROOM.
+const leaveAckTimeout = 5 * time.Second
+
+// ErrScopeInUse is returned by Session.AddScope when the scope ID is zero or already in use.
+var ErrScopeInUse = errors.New("room scope ID is zero or already in use")
+
+// JoinHandler handles a MSG_TYPE_JOIN_ROOM request received on a Session.
+// It must write the reply to the bidi, but must not close it.
+type JoinHandler func(session *Session, bidi ProtoBidi, msg *pb.MsgJoinRoom)
+
+// Session multiplexes multiple rooms over a single connection.
+// Each room is a scope with its own ScopedConn, which behaves like a dedicated connection to that room.
+// Scope 0 is the room the connection authenticated with, and its bidis are not prefixed, so a Session is compatible
+// with peers that only ever use one room per connection.
+// Additional scopes are joined with MSG_TYPE_JOIN_ROOM, and their bidis start with MSG_TYPE_ROOM_SCOPE.
+//
+// The underlying connection is closed once no scopes are left.
+type Session struct {
+	conn   ProtoConn
+	onJoin JoinHandler
+
+	primary *ScopedConn
+
+	mu     sync.Mutex
+	scopes map[uint32]*ScopedConn
+
+	done chan struct{}
+}
+
+// NewSession creates a Session on top of an authenticated connection and starts routing its incoming bidis.
+// The Session takes ownership of the connection; it must not be used directly afterward.
+// If onJoin is nil, MSG_TYPE_JOIN_ROOM requests are answered with ERR_TYPE_UNIMPLEMENTED.
+func NewSession(conn ProtoConn, onJoin JoinHandler) *Session {
+	s := &Session{
+		conn:   conn,
+		onJoin: onJoin,
+
+		scopes: make(map[uint32]*ScopedConn),
+
+		done: make(chan struct{}),
+	}
+
+	s.primary = newScopedConn(s, 0)
+	s.scopes[0] = s.primary
+
+	go s.acceptLoop()
+
+	return s
+}
+
+// Primary returns the scope of the room the connection authenticated with.
+func (s *Session) Primary() *ScopedConn {
+	return s.primary
+}
+
+// AddScope registers a new scope with the specified ID and returns it.
+// Returns ErrScopeInUse if the ID is zero or already in use.
+// Returns an error wrapping the connection's close error if the Session is done.
+func (s *Session) AddScope(id uint32) (*ScopedConn, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	select {
+	case <-s.done:
+		return nil, fmt.Errorf(`failed to add room scope %d: %w`, id, net.ErrClosed)
+	default:
+	}
+
+	if _, has := s.scopes[id]; has || id == 0 {
+		return nil, ErrScopeInUse
+	}
+
+	scope := newScopedConn(s, id)
+	s.scopes[id] = scope
+	return scope, nil
+}
+
+// Done returns a channel that is closed once the underlying connection is closed.
+func (s *Session) Done() <-chan struct{} {
+	return s.done
+}
+
+// RemoteAddr returns the remote address of the underlying connection.
+func (s *Session) RemoteAddr() net.Addr {
+	return s.conn.RemoteAddr()
+}
+
+// SendAndReceive opens an unscoped bidi, sends the specified message, and receives a reply.
+// It is meant for messages that concern the whole Session, such as MSG_TYPE_JOIN_ROOM.
+func (s *Session) SendAndReceive(typ pb.MsgType, msg proto.Message) (*UntypedProtoMsg, error) {
+	return s.conn.SendAndReceive(typ, msg)
+}
+
+func (s *Session) scope(id uint32) (*ScopedConn, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	scope, has := s.scopes[id]
+	return scope, has
+}
+
+// removeScope removes the scope with the specified ID and returns the number of scopes left.
+func (s *Session) removeScope(id uint32) int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	delete(s.scopes, id)
+	return len(s.scopes)
+}
+
+// openBidi opens a bidi in the specified scope and writes the message to it.
+func (s *Session) openBidi(id uint32, typ pb.MsgType, msg proto.Message) (ProtoBidi, error) {
+	if id == 0 {
+		return s.conn.OpenBidiWithMsg(typ, msg)
+	}
+
+	bidi, err := s.conn.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_ROOM_SCOPE, &pb.MsgRoomScope{
+		ScopeId: id,
+	})
+	if err != nil {
+		return ProtoBidi{}, err
+	}
+
+	err = bidi.Write(typ, msg)
+	if err != nil {
+		_ = bidi.Close()
+		return ProtoBidi{}, err
+	}
+
+	return bidi, nil
+}
+
+// sendLeave tells the other side that the scope was left.
+// It is best-effort; failures are ignored.
+func (s *Session) sendLeave(id uint32, code CloseCode, reason string) {
+	bidi, err := s.openBidi(id, pb.MsgType_MSG_TYPE_LEAVE_ROOM, &pb.MsgLeaveRoom{
+		CloseCode: uint32(code),
+		Reason:    &reason,
+	})
+	if err != nil {
+		return
+	}
+	defer func() {
+		_ = bidi.Close()
+	}()
+
+	_ = bidi.Stream.SetReadDeadline(time.Now().Add(leaveAckTimeout))
+	_, _ = bidi.Read()
+}
+
+func (s *Session) acceptLoop() {
+	for {
+		bidi, err := s.conn.WaitForBidi(context.Background())
+		if err != nil {
+			s.mu.Lock()
+			scopes := make([]*ScopedConn, 0, len(s.scopes))
+			for _, scope := range s.scopes {
+				scopes = append(scopes, scope)
+			}
+			clear(s.scopes)
+			close(s.done)
+			s.mu.Unlock()
+
+			for _, scope := range scopes {
+				scope.markClosed(err)
+			}
+			return
+		}
+
+		go s.route(bidi)
+	}
+}
+
+// peekMsg reads the next message from the bidi and returns it along with its raw bytes, so that it can be replayed.
+func peekMsg(bidi ProtoBidi) (*UntypedProtoMsg, []byte, error) {
+	var raw bytes.Buffer
+	msg, err := NewProtoStreamReader(io.TeeReader(bidi.Stream, &raw)).ReadRaw()
+	return msg, raw.Bytes(), err
+}
+
+// route reads a new bidi's first message and passes the bidi to the scope it belongs to.
+func (s *Session) route(bidi ProtoBidi) {
+	var id uint32
+	msg, raw, err := peekMsg(bidi)
+	if err == nil && msg.Type == pb.MsgType_MSG_TYPE_ROOM_SCOPE {
+		id = msg.Payload.(*pb.MsgRoomScope).ScopeId
+		msg, raw, err = peekMsg(bidi)
+	}
+	if err != nil {
+		if _, ok := errors.AsType[UnknownMsgTypeError](err); ok {
+			// Probably a message from a newer protocol version.
+			// Let the scope's reader reply to it.
+			s.deliver(id, bidi, raw)
+			return
+		}
+
+		_ = bidi.Close()
+		return
+	}
+
+	switch msg.Type {
+	case pb.MsgType_MSG_TYPE_JOIN_ROOM:
+		defer func() {
+			_ = bidi.Close()
+		}()
+
+		if s.onJoin == nil {
+			_ = bidi.WriteUnimplementedError(msg.Type)
+			return
+		}
+		s.onJoin(s, bidi, msg.Payload.(*pb.MsgJoinRoom))
+	case pb.MsgType_MSG_TYPE_LEAVE_ROOM:
+		defer func() {
+			_ = bidi.Close()
+		}()
+
+		scope, has := s.scope(id)
+		if !has {
+			_ = bidi.WriteError(pb.ErrType_ERR_TYPE_INVALID_FIELDS, fmt.Sprintf("unknown room scope %d", id))
+			return
+		}
+
+		leaveMsg := msg.Payload.(*pb.MsgLeaveRoom)
+		_ = bidi.WriteAck()
+		scope.closeRemote(CloseCode(leaveMsg.CloseCode), leaveMsg.GetReason())
+	default:
+		s.deliver(id, bidi, raw)
+	}
+}
+
+// deliver passes a bidi to the scope with the specified ID.
+// The raw bytes are replayed before the rest of the stream, so the scope sees the bidi's first message.
+func (s *Session) deliver(id uint32, bidi ProtoBidi, raw []byte) {
+	scope, has := s.scope(id)
+	if !has {
+		_ = bidi.WriteError(pb.ErrType_ERR_TYPE_INVALID_FIELDS, fmt.Sprintf("unknown room scope %d", id))
+		_ = bidi.Close()
+		return
+	}
+
+	bidi.ProtoStreamReader = NewProtoStreamReader(io.MultiReader(bytes.NewReader(raw), bidi.Stream))
+	scope.push(bidi)
+}
+
+// ScopedConn is a ProtoConn for a single room of a Session.
+type ScopedConn struct {
+	session *Session
+	id      uint32
+
+	incoming chan ProtoBidi
+	closed   chan struct{}
+
+	mu       sync.Mutex
+	closeErr error
+	streams  map[*quic.Stream]struct{}
+}
+
+var _ ProtoConn = &ScopedConn{}
+
+func newScopedConn(session *Session, id uint32) *ScopedConn {
+	return &ScopedConn{
+		session: session,
+		id:      id,
+
+		incoming: make(chan ProtoBidi),
+		closed:   make(chan struct{}),
+
+		streams: make(map[*quic.Stream]struct{}),
+	}
+}
+
+// ScopeId returns the scope's ID.
+func (c *ScopedConn) ScopeId() uint32 {
+	return c.id
+}
+
+// err returns the error the scope was closed with, or nil if it is still open.
+func (c *ScopedConn) err() error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.closeErr
+}
+
+// track registers a stream so that it is reset when the scope is closed.
+// If the scope is already closed, the stream is reset immediately.
+func (c *ScopedConn) track(stream *quic.Stream) {
+	c.mu.Lock()
+	if c.closeErr != nil {
+		c.mu.Unlock()
+		stream.CancelRead(ScopeClosedStreamErrorCode)
+		stream.CancelWrite(ScopeClosedStreamErrorCode)
+		return
+	}
+	c.streams[stream] = struct{}{}
+	c.mu.Unlock()
+
+	// Once the write side is done, there is nothing left to reset.
+	context.AfterFunc(stream.Context(), func() {
+		c.mu.Lock()
+		delete(c.streams, stream)
+		c.mu.Unlock()
+	})
+}
+
+func (c *ScopedConn) push(bidi ProtoBidi) {
+	c.track(bidi.Stream)
+
+	select {
+	case c.incoming <- bidi:
+	case <-c.closed:
+		bidi.Cancel(ScopeClosedStreamErrorCode)
+	}
+}
+
+// markClosed marks the scope as closed with the specified error and resets its open streams.
+// Returns false if the scope was already closed.
+func (c *ScopedConn) markClosed(err error) bool {
+	c.mu.Lock()
+	if c.closeErr != nil {
+		c.mu.Unlock()
+		return false
+	}
+	c.closeErr = err
+	streams := c.streams
+	c.streams = nil
+	close(c.closed)
+	c.mu.Unlock()
+
+	for stream := range streams {
+		stream.CancelRead(ScopeClosedStreamErrorCode)
+		stream.CancelWrite(ScopeClosedStreamErrorCode)
+	}
+
+	return true
+}
+
+// closeRemote closes the scope after the other side left it.
+func (c *ScopedConn) closeRemote(code CloseCode, reason string) {
+	if !c.markClosed(&quic.ApplicationError{
+		Remote:       true,
+		ErrorCode:    quic.ApplicationErrorCode(code),
+		ErrorMessage: reason,
+	}) {
+		return
+	}
+
+	if c.session.removeScope(c.id) == 0 {
+		_ = c.session.conn.CloseWithCode(CloseCodeNormal, "no rooms left")
+	}
+}
+
+// Discard closes the scope without telling the other side.
+// It is meant for scopes that were added but never successfully joined.
+func (c *ScopedConn) Discard() {
+	if !c.markClosed(&quic.ApplicationError{
+		ErrorCode:    quic.ApplicationErrorCode(CloseCodeNormal),
+		ErrorMessage: "room scope discarded",
+	}) {
+		return
+	}
+
+	if c.session.removeScope(c.id) == 0 {
+		_ = c.session.conn.CloseWithCode(CloseCodeNormal, "no rooms left")
+	}
+}
+
+func (c *ScopedConn) RemoteAddr() net.Addr {
+	return c.session.RemoteAddr()
+}
+
+// CloseWithCode leaves the scope's room.
+// If it was the last scope of the Session, the underlying connection is closed with the code and reason.
+// Otherwise, the other side is sent MSG_TYPE_LEAVE_ROOM in the background.
+func (c *ScopedConn) CloseWithCode(code CloseCode, reason string) error {
+	if !c.markClosed(&quic.ApplicationError{
+		ErrorCode:    quic.ApplicationErrorCode(code),
+		ErrorMessage: reason,
+	}) {
+		return nil
+	}
+
+	if c.session.removeScope(c.id) == 0 {
+		return c.session.conn.CloseWithCode(code, reason)
+	}
+
+	go c.session.sendLeave(c.id, code, reason)
+	return nil
+}
+
+func (c *ScopedConn) OpenBidiWithMsg(typ pb.MsgType, msg proto.Message) (ProtoBidi, error) {
+	if err := c.err(); err != nil {
+		return ProtoBidi{}, fmt.Errorf(`failed to open bidi before writing message of type %s: %w`, typ.String(), err)
+	}
+
+	bidi, err := c.session.openBidi(c.id, typ, msg)
+	if err != nil {
+		return ProtoBidi{}, err
+	}
+
+	c.track(bidi.Stream)
+	return bidi, nil
+}
+
+func (c *ScopedConn) WaitForBidi(ctx context.Context) (ProtoBidi, error) {
+	select {
+	case bidi := <-c.incoming:
+		return bidi, nil
+	case <-c.closed:
+		return ProtoBidi{}, fmt.Errorf(`failed to accept stream in WaitForBidi: %w`, c.err())
+	case <-ctx.Done():
+		return ProtoBidi{}, fmt.Errorf(`failed to accept stream in WaitForBidi: %w`, ctx.Err())
+	}
+}
+
+func (c *ScopedConn) SendAndReceive(typ pb.MsgType, msg proto.Message) (*UntypedProtoMsg, error) {
+	bidi, err := c.OpenBidiWithMsg(typ, msg)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = bidi.Close()
+	}()
+
+	return bidi.Read()
+}
+
+func (c *ScopedConn) SendAndReceiveAck(typ pb.MsgType, msg proto.Message) error {
+	reply, err := c.SendAndReceive(typ, msg)
+	if err != nil {
+		return err
+	}
+
+	if reply.Type != pb.MsgType_MSG_TYPE_ACKNOWLEDGED {
+		return UnexpectedMsgTypeError{
+			Expected: pb.MsgType_MSG_TYPE_ACKNOWLEDGED,
+			Actual:   reply.Type,
+		}
+	}
+
+	return nil
+}
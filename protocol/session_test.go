@@ -0,0 +1,120 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// answerPings replies to pings on the scope until it is closed.
+func answerPings(scope *ScopedConn) {
+	for {
+		bidi, err := scope.WaitForBidi(context.Background())
+		if err != nil {
+			return
+		}
+		go func() {
+			defer func() {
+				_ = bidi.Close()
+			}()
+			if _, err := bidi.Read(); err == nil {
+				_ = bidi.Write(pb.MsgType_MSG_TYPE_PONG, &pb.MsgPong{})
+			}
+		}()
+	}
+}
+
+func TestSession_JoinAndLeave(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	clientConn, serverConn, err := NewMemNetwork().ConnPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+
+	joined := make(chan *ScopedConn, 1)
+	server := NewSession(serverConn, func(session *Session, bidi ProtoBidi, msg *pb.MsgJoinRoom) {
+		scope, err := session.AddScope(msg.ScopeId)
+		if err != nil {
+			_ = bidi.WriteError(pb.ErrType_ERR_TYPE_INVALID_FIELDS, err.Error())
+			return
+		}
+		joined <- scope
+		_ = bidi.Write(pb.MsgType_MSG_TYPE_AUTH_ACCEPTED, &pb.MsgAuthAccepted{})
+	})
+	go answerPings(server.Primary())
+
+	client := NewSession(clientConn, nil)
+
+	scope, err := client.AddScope(1)
+	if err != nil {
+		t.Fatalf("failed to add scope: %v", err)
+	}
+	if _, err = client.SendAndReceive(pb.MsgType_MSG_TYPE_JOIN_ROOM, &pb.MsgJoinRoom{ScopeId: 1}); err != nil {
+		t.Fatalf("failed to join room: %v", err)
+	}
+	serverScope := <-joined
+
+	// Bidis opened on the new scope must only reach the new scope.
+	go func() {
+		_, _ = scope.SendAndReceive(pb.MsgType_MSG_TYPE_PING, &pb.MsgPing{})
+	}()
+	bidi, err := serverScope.WaitForBidi(ctx)
+	if err != nil {
+		t.Fatalf("failed to accept bidi on joined scope: %v", err)
+	}
+	msg, err := bidi.Read()
+	if err != nil {
+		t.Fatalf("failed to read first message: %v", err)
+	}
+	if msg.Type != pb.MsgType_MSG_TYPE_PING {
+		t.Fatalf("expected PING, got %s", msg.Type.String())
+	}
+	_ = bidi.Close()
+
+	// Leaving the joined room must not affect the primary one.
+	if err = scope.CloseWithCode(CloseCodeKicked, "bye"); err != nil {
+		t.Fatalf("failed to leave room: %v", err)
+	}
+	_, err = serverScope.WaitForBidi(ctx)
+	code, reason, ok := CloseCodeFromError(err)
+	if !ok || code != CloseCodeKicked || reason != "bye" {
+		t.Fatalf("expected remote close with code %s, got %v", CloseCodeKicked, err)
+	}
+
+	if _, err = client.Primary().SendAndReceive(pb.MsgType_MSG_TYPE_PING, &pb.MsgPing{}); err != nil {
+		t.Fatalf("primary scope stopped working after leaving another room: %v", err)
+	}
+}
+
+func TestSession_UnknownScope(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	clientConn, serverConn, err := NewMemNetwork().ConnPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	NewSession(serverConn, nil)
+
+	bidi, err := clientConn.OpenBidiWithMsg(pb.MsgType_MSG_TYPE_ROOM_SCOPE, &pb.MsgRoomScope{ScopeId: 7})
+	if err != nil {
+		t.Fatalf("failed to open bidi: %v", err)
+	}
+	defer func() {
+		_ = bidi.Close()
+	}()
+	if err = bidi.Write(pb.MsgType_MSG_TYPE_PING, &pb.MsgPing{}); err != nil {
+		t.Fatalf("failed to write message: %v", err)
+	}
+
+	_, err = bidi.Read()
+	msgErr, ok := errors.AsType[ProtoMsgError](err)
+	if !ok || msgErr.Msg.Type != pb.ErrType_ERR_TYPE_INVALID_FIELDS {
+		t.Fatalf("expected ERR_TYPE_INVALID_FIELDS, got %v", err)
+	}
+}
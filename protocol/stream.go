@@ -1,8 +1,10 @@
 package protocol
 
 import (
+	"context"
 	"errors"
 	"io"
+	"iter"
 
 	pb "friendnet.org/protocol/pb/v1"
 	"github.com/quic-go/quic-go"
@@ -10,6 +12,7 @@ import (
 )
 
 // Stream is an interface that defines a pull-based stream for any type of value.
+// Prefer ranging over All to calling ReadNext in a loop, since it handles the end of the stream and cancellation.
 type Stream[T any] interface {
 	// ReadNext reads the next value from the stream.
 	// Returns io.EOF when the stream has ended.
@@ -20,6 +23,42 @@ type Stream[T any] interface {
 	Close() error
 }
 
+// All returns an iterator over the values read from a stream, for use with range-over-func.
+//
+// Iteration ends without an error once the stream has ended.
+// If reading fails, the error is yielded with the zero value, and iteration ends.
+// If ctx is done before the stream ends, the stream is closed to unblock the pending read, and the context's error is
+// yielded.
+//
+// The stream is not closed when iteration ends otherwise, so callers must still close it.
+func All[T any](ctx context.Context, stream Stream[T]) iter.Seq2[T, error] {
+	return func(yield func(T, error) bool) {
+		stop := context.AfterFunc(ctx, func() {
+			_ = stream.Close()
+		})
+		defer stop()
+
+		for {
+			val, err := stream.ReadNext()
+			if err != nil {
+				if ctxErr := ctx.Err(); ctxErr != nil {
+					err = ctxErr
+				} else if errors.Is(err, io.EOF) {
+					return
+				}
+
+				var empty T
+				yield(empty, err)
+				return
+			}
+
+			if !yield(val, nil) {
+				return
+			}
+		}
+	}
+}
+
 // TypedMsgStream is a stream that reads protocol messages of a specific type.
 type TypedMsgStream[T proto.Message] struct {
 	typ  pb.MsgType
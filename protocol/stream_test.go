@@ -0,0 +1,98 @@
+package protocol
+
+import (
+	"context"
+	"errors"
+	"io"
+	"slices"
+	"testing"
+)
+
+// sliceStream is a Stream that reads values from a slice, then returns its error.
+type sliceStream struct {
+	vals   []int
+	err    error
+	closed chan struct{}
+	block  bool
+}
+
+func newSliceStream(vals []int, err error) *sliceStream {
+	return &sliceStream{
+		vals:   vals,
+		err:    err,
+		closed: make(chan struct{}),
+	}
+}
+
+func (s *sliceStream) ReadNext() (int, error) {
+	if len(s.vals) == 0 {
+		if s.block {
+			<-s.closed
+			return 0, io.EOF
+		}
+		return 0, s.err
+	}
+	val := s.vals[0]
+	s.vals = s.vals[1:]
+	return val, nil
+}
+
+func (s *sliceStream) Close() error {
+	select {
+	case <-s.closed:
+	default:
+		close(s.closed)
+	}
+	return nil
+}
+
+func TestAll(t *testing.T) {
+	t.Parallel()
+
+	var got []int
+	for val, err := range All[int](context.Background(), newSliceStream([]int{1, 2, 3}, io.EOF)) {
+		if err != nil {
+			t.Fatal(err)
+		}
+		got = append(got, val)
+	}
+	if !slices.Equal(got, []int{1, 2, 3}) {
+		t.Fatalf("unexpected values %v", got)
+	}
+
+	readErr := errors.New("read failed")
+	var gotErr error
+	got = nil
+	for val, err := range All[int](context.Background(), newSliceStream([]int{1}, readErr)) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		got = append(got, val)
+	}
+	if !errors.Is(gotErr, readErr) || !slices.Equal(got, []int{1}) {
+		t.Fatalf("expected 1 value then the read error, got %v, %v", got, gotErr)
+	}
+}
+
+func TestAll_Cancel(t *testing.T) {
+	t.Parallel()
+
+	stream := newSliceStream([]int{1}, nil)
+	stream.block = true
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	var gotErr error
+	for _, err := range All[int](ctx, stream) {
+		if err != nil {
+			gotErr = err
+			break
+		}
+		// The next read blocks until the stream is closed by the cancellation.
+		cancel()
+	}
+	if !errors.Is(gotErr, context.Canceled) {
+		t.Fatalf("expected context.Canceled, got %v", gotErr)
+	}
+}
@@ -4,15 +4,19 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"iter"
 	"net/http"
 	"os"
 	"slices"
+	"strconv"
 	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	v1 "friendnet.org/protocol/pb/serverrpc/v1"
 	"friendnet.org/protocol/pb/serverrpc/v1/serverrpcv1connect"
 	"github.com/chzyer/readline"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 // Opt is a function that configures a CLI.
@@ -112,6 +116,27 @@ func NewCli(client serverrpcv1connect.ServerRpcServiceClient, opts ...Opt) *Cli
 				return cli.cmdGetOnlineUserInfo(ctx, args)
 			},
 		},
+		{
+			Name:  "kick",
+			Usage: "kick <room> <username>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdKick(ctx, args)
+			},
+		},
+		{
+			Name:  "broadcast",
+			Usage: "broadcast <room> <message>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdBroadcast(ctx, args)
+			},
+		},
+		{
+			Name:  "closeroom",
+			Usage: "closeroom <room>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdCloseRoom(ctx, args)
+			},
+		},
 		{
 			Name:  "getaccounts",
 			Usage: "getaccounts <room>",
@@ -133,6 +158,34 @@ func NewCli(client serverrpcv1connect.ServerRpcServiceClient, opts ...Opt) *Cli
 				return cli.cmdDeleteRoom(ctx, args)
 			},
 		},
+		{
+			Name:  "setroomlimits",
+			Usage: "setroomlimits <room> <max clients> <max proxy streams per client>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdSetRoomLimits(ctx, args)
+			},
+		},
+		{
+			Name:  "setroomdircachettl",
+			Usage: "setroomdircachettl <room> <ttl ms>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdSetRoomDirCacheTtl(ctx, args)
+			},
+		},
+		{
+			Name:  "setroommetadata",
+			Usage: "setroommetadata <room> <listed true|false> [description]",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdSetRoomMetadata(ctx, args)
+			},
+		},
+		{
+			Name:  "setroommotd",
+			Usage: "setroommotd <room> [message]",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdSetRoomMotd(ctx, args)
+			},
+		},
 		{
 			Name:  "createaccount",
 			Usage: "createaccount <room> <username> [password]",
@@ -154,6 +207,146 @@ func NewCli(client serverrpcv1connect.ServerRpcServiceClient, opts ...Opt) *Cli
 				return cli.cmdUpdateAccountPassword(ctx, args)
 			},
 		},
+		{
+			Name:  "setaccountguest",
+			Usage: "setaccountguest <room> <username> <true|false>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdSetAccountGuest(ctx, args)
+			},
+		},
+		{
+			Name:  "createinvitecode",
+			Usage: "createinvitecode <room>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdCreateInviteCode(ctx, args)
+			},
+		},
+		{
+			Name:  "getinvitecodes",
+			Usage: "getinvitecodes <room>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdGetInviteCodes(ctx, args)
+			},
+		},
+		{
+			Name:  "deleteinvitecode",
+			Usage: "deleteinvitecode <room> <code>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdDeleteInviteCode(ctx, args)
+			},
+		},
+		{
+			Name:  "createinvitebundle",
+			Usage: "createinvitebundle <room> <address> [withcode]",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdCreateInviteBundle(ctx, args)
+			},
+		},
+		{
+			Name:  "liststreams",
+			Usage: "liststreams [room]",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdListStreams(ctx, args)
+			},
+		},
+		{
+			Name:  "cancelstream",
+			Usage: "cancelstream <room> <id>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdCancelStream(ctx, args)
+			},
+		},
+		{
+			Name:  "migrations",
+			Usage: "migrations",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdMigrations(ctx, args)
+			},
+		},
+		{
+			Name:  "backupdb",
+			Usage: "backupdb <path>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdBackupDb(ctx, args)
+			},
+		},
+		{
+			Name:  "checkdb",
+			Usage: "checkdb",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdCheckDb(ctx, args)
+			},
+		},
+		{
+			Name:  "getrelaylimits",
+			Usage: "getrelaylimits",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdGetRelayLimits(ctx, args)
+			},
+		},
+		{
+			Name:  "setrelaylimit",
+			Usage: "setrelaylimit <max bytes per second>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdSetRelayLimit(ctx, args)
+			},
+		},
+		{
+			Name:  "getlobbysettings",
+			Usage: "getlobbysettings",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdGetLobbySettings(ctx, args)
+			},
+		},
+		{
+			Name:  "setlobbysetting",
+			Usage: "setlobbysetting <timeout|minversion|maxversion|maxconcurrent|maxconnsperip> <value>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdSetLobbySetting(ctx, args)
+			},
+		},
+		{
+			Name:  "getlobbystats",
+			Usage: "getlobbystats",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdGetLobbyStats(ctx, args)
+			},
+		},
+		{
+			Name:  "getroomstats",
+			Usage: "getroomstats <room> [hours]",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdGetRoomStats(ctx, args)
+			},
+		},
+		{
+			Name:  "drain",
+			Usage: "drain",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdDrain(ctx, args)
+			},
+		},
+		{
+			Name:  "checkconfig",
+			Usage: "checkconfig [local config path]",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdCheckConfig(ctx, args)
+			},
+		},
+		{
+			Name:  "exportroom",
+			Usage: "exportroom <room> <local archive path>",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdExportRoom(ctx, args)
+			},
+		},
+		{
+			Name:  "importroom",
+			Usage: "importroom <local archive path> [new room name]",
+			Handler: func(ctx context.Context, cli *Cli, args []string) error {
+				return cli.cmdImportRoom(ctx, args)
+			},
+		},
 	}
 	return cli
 }
@@ -230,7 +423,11 @@ func (c *Cli) cmdGetServerInfo(ctx context.Context, _ []string) error {
 	}
 
 	fmt.Printf("Server version: %s\n", resp.GetVersion())
+	fmt.Printf("Draining: %t\n", resp.GetDraining())
 	fmt.Printf("RPC requires bearer token authentication: %t\n", resp.GetRpc().GetRequiresBearerToken())
+	if role := resp.GetRpc().GetRole(); role != "" {
+		fmt.Printf("Role of this RPC interface: %s\n", role)
+	}
 	allowedMethods := resp.GetRpc().GetAllowedMethods()
 	if slices.Contains(allowedMethods, "*") {
 		fmt.Printf("Allowed methods for this RPC interface: all\n")
@@ -249,7 +446,9 @@ func (c *Cli) cmdGetRooms(ctx context.Context, args []string) error {
 		return err
 	}
 
-	resp, err := c.client.GetRooms(ctx, &v1.GetRoomsRequest{})
+	resp, err := c.client.GetRooms(ctx, &v1.GetRoomsRequest{
+		IncludeUnlisted: true,
+	})
 	if err != nil {
 		return err
 	}
@@ -263,7 +462,11 @@ func (c *Cli) cmdGetRooms(ctx context.Context, args []string) error {
 		if room == nil {
 			continue
 		}
-		fmt.Printf("%s (online users: %d)\n", room.GetName(), room.GetOnlineUserCount())
+		if room.GetListed() {
+			fmt.Printf("%s (online users: %d)\n", room.GetName(), room.GetOnlineUserCount())
+		} else {
+			fmt.Printf("%s (online users: %d, unlisted)\n", room.GetName(), room.GetOnlineUserCount())
+		}
 	}
 	return nil
 }
@@ -286,6 +489,21 @@ func (c *Cli) cmdGetRoomInfo(ctx context.Context, args []string) error {
 		return nil
 	}
 	fmt.Printf("%s (online users: %d)\n", room.GetName(), room.GetOnlineUserCount())
+	if desc := room.GetDescription(); desc != "" {
+		fmt.Printf("Description: %s\n", desc)
+	}
+	if motd := room.GetMotd(); motd != "" {
+		fmt.Printf("Message of the day: %s\n", motd)
+	}
+	fmt.Printf("Created: %s\n", time.Unix(room.GetCreatedTs(), 0).Format(time.DateTime))
+	fmt.Printf("Listed: %t\n", room.GetListed())
+	fmt.Printf("Max clients: %s\n", fmtLimit(room.GetMaxClients()))
+	fmt.Printf("Max proxy streams per client: %s\n", fmtLimit(room.GetMaxProxyStreamsPerClient()))
+	if ttl := room.GetDirCacheTtlMs(); ttl > 0 {
+		fmt.Printf("Directory cache TTL: %dms\n", ttl)
+	} else {
+		fmt.Println("Directory cache TTL: disabled")
+	}
 	return nil
 }
 
@@ -302,8 +520,10 @@ func (c *Cli) cmdGetOnlineUsers(ctx context.Context, args []string) error {
 	}
 
 	var count int
-	for stream.Receive() {
-		msg := stream.Msg()
+	for msg, err := range receiveAll(stream) {
+		if err != nil {
+			return err
+		}
 		for _, user := range msg.GetUsers() {
 			if user == nil {
 				continue
@@ -312,9 +532,6 @@ func (c *Cli) cmdGetOnlineUsers(ctx context.Context, args []string) error {
 			count++
 		}
 	}
-	if err := stream.Err(); err != nil {
-		return err
-	}
 	if count == 0 {
 		fmt.Println("No online users.")
 	}
@@ -340,33 +557,63 @@ func (c *Cli) cmdGetOnlineUserInfo(ctx context.Context, args []string) error {
 		return nil
 	}
 	fmt.Println(user.GetUsername())
+	if rtt := user.GetRtt(); rtt.GetSamples() > 0 {
+		fmt.Printf("Latency: %s (min %s, avg %s, max %s, lost %d)\n",
+			fmtMicros(rtt.GetLastUs()),
+			fmtMicros(rtt.GetMinUs()),
+			fmtMicros(rtt.GetAvgUs()),
+			fmtMicros(rtt.GetMaxUs()),
+			rtt.GetLost(),
+		)
+		if rtt.ClockOffsetUs != nil {
+			fmt.Printf("Clock offset: %s\n", fmtMicros(rtt.GetClockOffsetUs()))
+		}
+	}
+	fmt.Printf("Relayed: %d bytes (%d bytes/s)\n", user.GetRelayedBytes(), user.GetRelayBytesPerSecond())
 	return nil
 }
 
+// fmtMicros formats a duration in microseconds.
+func fmtMicros(us int64) string {
+	return (time.Duration(us) * time.Microsecond).String()
+}
+
 func (c *Cli) cmdGetAccounts(ctx context.Context, args []string) error {
 	if err := validateArgCount(args, 1, 1, "getaccounts <room>"); err != nil {
 		return err
 	}
 
-	resp, err := c.client.GetAccounts(ctx, &v1.GetAccountsRequest{
-		Room: args[0],
-	})
-	if err != nil {
-		return err
-	}
+	cursor := ""
+	for {
+		resp, err := c.client.GetAccounts(ctx, &v1.GetAccountsRequest{
+			Room:   args[0],
+			Cursor: cursor,
+		})
+		if err != nil {
+			return err
+		}
 
-	accounts := resp.GetAccounts()
-	if len(accounts) == 0 {
-		fmt.Println("No accounts.")
-		return nil
-	}
-	for _, account := range accounts {
-		if account == nil {
-			continue
+		if cursor == "" && resp.GetTotal() == 0 {
+			fmt.Println("No accounts.")
+			return nil
+		}
+		for _, account := range resp.GetAccounts() {
+			if account == nil {
+				continue
+			}
+			if account.GetIsGuest() {
+				fmt.Printf("%s (guest)\n", account.GetUsername())
+			} else {
+				fmt.Println(account.GetUsername())
+			}
+		}
+
+		cursor = resp.GetNextCursor()
+		if cursor == "" {
+			fmt.Printf("Total: %d\n", resp.GetTotal())
+			return nil
 		}
-		fmt.Println(account.GetUsername())
 	}
-	return nil
 }
 
 func (c *Cli) cmdCreateRoom(ctx context.Context, args []string) error {
@@ -406,6 +653,163 @@ func (c *Cli) cmdDeleteRoom(ctx context.Context, args []string) error {
 	return nil
 }
 
+func (c *Cli) cmdCloseRoom(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 1, 1, "closeroom <room>"); err != nil {
+		return err
+	}
+
+	resp, err := c.client.CloseRoom(ctx, &v1.CloseRoomRequest{
+		Name: args[0],
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Closed room %q, disconnecting %d user(s).\n", args[0], resp.GetDisconnectedUserCount())
+	return nil
+}
+
+func (c *Cli) cmdKick(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 2, 2, "kick <room> <username>"); err != nil {
+		return err
+	}
+
+	_, err := c.client.KickUser(ctx, &v1.KickUserRequest{
+		Room:     args[0],
+		Username: args[1],
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Kicked %q from room %q.\n", args[1], args[0])
+	return nil
+}
+
+func (c *Cli) cmdBroadcast(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 2, -1, "broadcast <room> <message>"); err != nil {
+		return err
+	}
+
+	resp, err := c.client.BroadcastMessage(ctx, &v1.BroadcastMessageRequest{
+		Room: args[0],
+		Text: strings.Join(args[1:], " "),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Sent message to %d user(s) in room %q.\n", resp.GetRecipientCount(), args[0])
+	return nil
+}
+
+func (c *Cli) cmdSetRoomLimits(ctx context.Context, args []string) error {
+	const usage = "setroomlimits <room> <max clients> <max proxy streams per client>"
+	if err := validateArgCount(args, 3, 3, usage); err != nil {
+		return err
+	}
+
+	maxClients, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return fmt.Errorf("usage: %s", usage)
+	}
+	maxStreams, err := strconv.ParseUint(args[2], 10, 32)
+	if err != nil {
+		return fmt.Errorf("usage: %s", usage)
+	}
+
+	resp, err := c.client.SetRoomLimits(ctx, &v1.SetRoomLimitsRequest{
+		Name:                     args[0],
+		MaxClients:               uint32(maxClients),
+		MaxProxyStreamsPerClient: uint32(maxStreams),
+	})
+	if err != nil {
+		return err
+	}
+
+	room := resp.GetRoom()
+	fmt.Printf("Updated limits for room %q (max clients: %s, max proxy streams per client: %s).\n",
+		args[0],
+		fmtLimit(room.GetMaxClients()),
+		fmtLimit(room.GetMaxProxyStreamsPerClient()),
+	)
+	return nil
+}
+
+func (c *Cli) cmdSetRoomDirCacheTtl(ctx context.Context, args []string) error {
+	const usage = "setroomdircachettl <room> <ttl ms>"
+	if err := validateArgCount(args, 2, 2, usage); err != nil {
+		return err
+	}
+
+	ttl, err := strconv.ParseUint(args[1], 10, 32)
+	if err != nil {
+		return fmt.Errorf("usage: %s", usage)
+	}
+
+	_, err = c.client.SetRoomDirCacheTtl(ctx, &v1.SetRoomDirCacheTtlRequest{
+		Name:  args[0],
+		TtlMs: uint32(ttl),
+	})
+	if err != nil {
+		return err
+	}
+
+	if ttl == 0 {
+		fmt.Printf("Disabled directory caching for room %q.\n", args[0])
+	} else {
+		fmt.Printf("Set directory cache TTL for room %q to %dms.\n", args[0], ttl)
+	}
+	return nil
+}
+
+func (c *Cli) cmdSetRoomMetadata(ctx context.Context, args []string) error {
+	const usage = "setroommetadata <room> <listed true|false> [description]"
+	if err := validateArgCount(args, 2, -1, usage); err != nil {
+		return err
+	}
+
+	listed, err := strconv.ParseBool(args[1])
+	if err != nil {
+		return fmt.Errorf("usage: %s", usage)
+	}
+	description := strings.Join(args[2:], " ")
+
+	_, err = c.client.SetRoomMetadata(ctx, &v1.SetRoomMetadataRequest{
+		Name:        args[0],
+		Description: description,
+		Listed:      listed,
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Updated metadata for room %q.\n", args[0])
+	return nil
+}
+
+func (c *Cli) cmdSetRoomMotd(ctx context.Context, args []string) error {
+	if len(args) < 1 {
+		return fmt.Errorf("usage: setroommotd <room> [message]")
+	}
+
+	motd := strings.Join(args[1:], " ")
+	_, err := c.client.SetRoomMotd(ctx, &v1.SetRoomMotdRequest{
+		Name: args[0],
+		Motd: motd,
+	})
+	if err != nil {
+		return err
+	}
+
+	if motd == "" {
+		fmt.Printf("Removed message of the day for room %q.\n", args[0])
+	} else {
+		fmt.Printf("Updated message of the day for room %q.\n", args[0])
+	}
+	return nil
+}
+
 func (c *Cli) cmdCreateAccount(ctx context.Context, args []string) error {
 	if err := validateArgCount(args, 2, 3, "createaccount <room> <username> [password]"); err != nil {
 		return err
@@ -481,20 +885,475 @@ func (c *Cli) cmdUpdateAccountPassword(ctx context.Context, args []string) error
 	return nil
 }
 
-func validateArgCount(args []string, min int, max int, usage string) error {
-	if len(args) < min {
-		return fmt.Errorf("usage: %s", usage)
-	}
-	if max >= 0 && len(args) > max {
-		return fmt.Errorf("usage: %s", usage)
+func (c *Cli) cmdSetAccountGuest(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 3, 3, "setaccountguest <room> <username> <true|false>"); err != nil {
+		return err
 	}
-	return nil
-}
 
-const defaultWelcomeMsg = "Welcome to the FriendNet server RPC CLI."
+	isGuest, err := strconv.ParseBool(args[2])
+	if err != nil {
+		return fmt.Errorf("usage: setaccountguest <room> <username> <true|false>")
+	}
 
-// Run runs the CLI.
-// It returns when the client presses CTRL+D.
+	_, err = c.client.SetAccountGuest(ctx, &v1.SetAccountGuestRequest{
+		Room:     args[0],
+		Username: args[1],
+		IsGuest:  isGuest,
+	})
+	if err != nil {
+		return err
+	}
+
+	if isGuest {
+		fmt.Printf("Account %q in room %q is now a guest.\n", args[1], args[0])
+	} else {
+		fmt.Printf("Account %q in room %q is no longer a guest.\n", args[1], args[0])
+	}
+	return nil
+}
+
+func (c *Cli) cmdCreateInviteCode(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 1, 1, "createinvitecode <room>"); err != nil {
+		return err
+	}
+
+	resp, err := c.client.CreateInviteCode(ctx, &v1.CreateInviteCodeRequest{
+		Room: args[0],
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Invite code: %s\n", resp.GetInviteCode().GetCode())
+	return nil
+}
+
+func (c *Cli) cmdCreateInviteBundle(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 2, 3, "createinvitebundle <room> <address> [withcode]"); err != nil {
+		return err
+	}
+
+	createCode := false
+	if len(args) > 2 {
+		if args[2] != "withcode" {
+			return fmt.Errorf(`expected "withcode" or nothing, got %q`, args[2])
+		}
+		createCode = true
+	}
+
+	resp, err := c.client.CreateInviteBundle(ctx, &v1.CreateInviteBundleRequest{
+		Room:             args[0],
+		Address:          args[1],
+		CreateInviteCode: createCode,
+	})
+	if err != nil {
+		return err
+	}
+
+	if code := resp.GetInviteCode(); code != nil {
+		fmt.Printf("Invite code: %s\n", code.GetCode())
+	}
+	fmt.Printf("Invite URL: %s\n", resp.GetUrl())
+	return nil
+}
+
+func (c *Cli) cmdGetInviteCodes(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 1, 1, "getinvitecodes <room>"); err != nil {
+		return err
+	}
+
+	resp, err := c.client.GetInviteCodes(ctx, &v1.GetInviteCodesRequest{
+		Room: args[0],
+	})
+	if err != nil {
+		return err
+	}
+
+	codes := resp.GetInviteCodes()
+	if len(codes) == 0 {
+		fmt.Println("No invite codes.")
+		return nil
+	}
+	for _, code := range codes {
+		if code == nil {
+			continue
+		}
+		fmt.Printf("%s (created %s)\n", code.GetCode(), time.Unix(code.GetCreatedTs(), 0).Format(time.DateTime))
+	}
+	return nil
+}
+
+func (c *Cli) cmdDeleteInviteCode(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 2, 2, "deleteinvitecode <room> <code>"); err != nil {
+		return err
+	}
+
+	_, err := c.client.DeleteInviteCode(ctx, &v1.DeleteInviteCodeRequest{
+		Room: args[0],
+		Code: args[1],
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Deleted invite code %q in room %q.\n", args[1], args[0])
+	return nil
+}
+
+func (c *Cli) cmdListStreams(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 0, 1, "liststreams [room]"); err != nil {
+		return err
+	}
+
+	room := ""
+	if len(args) == 1 {
+		room = args[0]
+	}
+
+	resp, err := c.client.ListStreams(ctx, &v1.ListStreamsRequest{
+		Room: room,
+	})
+	if err != nil {
+		return err
+	}
+
+	streams := resp.GetStreams()
+	if len(streams) == 0 {
+		fmt.Println("No open streams.")
+		return nil
+	}
+	for _, stream := range streams {
+		if stream == nil {
+			continue
+		}
+		age := time.Since(time.Unix(stream.GetCreatedTs(), 0)).Round(time.Second)
+		fmt.Printf("%s [%s] %s -> %s (sent: %d bytes, received: %d bytes, age: %s)\n",
+			stream.GetId(),
+			stream.GetRoom(),
+			stream.GetOriginUsername(),
+			stream.GetTargetUsername(),
+			stream.GetBytesToTarget(),
+			stream.GetBytesToOrigin(),
+			age,
+		)
+	}
+	return nil
+}
+
+func (c *Cli) cmdCancelStream(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 2, 2, "cancelstream <room> <id>"); err != nil {
+		return err
+	}
+
+	_, err := c.client.CancelStream(ctx, &v1.CancelStreamRequest{
+		Room: args[0],
+		Id:   args[1],
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Canceled stream %q in room %q.\n", args[1], args[0])
+	return nil
+}
+
+func (c *Cli) cmdMigrations(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 0, 0, "migrations"); err != nil {
+		return err
+	}
+
+	resp, err := c.client.GetMigrationStatus(ctx, &v1.GetMigrationStatusRequest{})
+	if err != nil {
+		return err
+	}
+
+	var pending int
+	for _, m := range resp.GetMigrations() {
+		if m == nil {
+			continue
+		}
+		appliedAt := time.Unix(m.GetAppliedTs(), 0).Format(time.RFC3339)
+		switch {
+		case m.GetUnknown():
+			fmt.Printf("unknown  %s (applied %s by a newer version)\n", m.GetName(), appliedAt)
+		case m.GetApplied():
+			fmt.Printf("applied  %s (%s)\n", m.GetName(), appliedAt)
+		default:
+			pending++
+			fmt.Printf("pending  %s\n", m.GetName())
+		}
+	}
+	if pending == 0 {
+		fmt.Println("Database schema is up to date.")
+	}
+	return nil
+}
+
+func (c *Cli) cmdBackupDb(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 1, 1, "backupdb <path>"); err != nil {
+		return err
+	}
+
+	_, err := c.client.BackupDatabase(ctx, &v1.BackupDatabaseRequest{
+		Path: args[0],
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Backed up database to %q on the server.\n", args[0])
+	return nil
+}
+
+func (c *Cli) cmdCheckDb(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 0, 0, "checkdb"); err != nil {
+		return err
+	}
+
+	resp, err := c.client.CheckDatabaseIntegrity(ctx, &v1.CheckDatabaseIntegrityRequest{})
+	if err != nil {
+		return err
+	}
+
+	problems := resp.GetProblems()
+	if len(problems) == 0 {
+		fmt.Println("Database is intact.")
+		return nil
+	}
+	fmt.Println("Database integrity check found problems:")
+	for _, problem := range problems {
+		fmt.Printf("  %s\n", problem)
+	}
+	return nil
+}
+
+func (c *Cli) cmdGetRelayLimits(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 0, 0, "getrelaylimits"); err != nil {
+		return err
+	}
+
+	resp, err := c.client.GetRelayLimits(ctx, &v1.GetRelayLimitsRequest{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Current limit: %s\n", fmtByteRate(resp.GetCurrentMaxBytesPerSecond()))
+	fmt.Printf("Default limit: %s\n", fmtByteRate(resp.GetMaxBytesPerSecond()))
+	for _, w := range resp.GetSchedule() {
+		days := "every day"
+		if w.GetWeekdays() != 0 {
+			names := make([]string, 0, 7)
+			for day := time.Sunday; day <= time.Saturday; day++ {
+				if w.GetWeekdays()&(1<<day) != 0 {
+					names = append(names, day.String()[:3])
+				}
+			}
+			days = strings.Join(names, ",")
+		}
+		fmt.Printf("  %02d:%02d-%02d:%02d %s: %s\n",
+			w.GetStartMinute()/60, w.GetStartMinute()%60,
+			w.GetEndMinute()/60, w.GetEndMinute()%60,
+			days,
+			fmtByteRate(w.GetMaxBytesPerSecond()),
+		)
+	}
+	return nil
+}
+
+func (c *Cli) cmdSetRelayLimit(ctx context.Context, args []string) error {
+	const usage = "setrelaylimit <max bytes per second>"
+	if err := validateArgCount(args, 1, 1, usage); err != nil {
+		return err
+	}
+
+	limit, err := strconv.ParseUint(args[0], 10, 64)
+	if err != nil {
+		return fmt.Errorf("usage: %s", usage)
+	}
+
+	// Keep the schedule, which is easier to edit in the config file.
+	cur, err := c.client.GetRelayLimits(ctx, &v1.GetRelayLimitsRequest{})
+	if err != nil {
+		return err
+	}
+	_, err = c.client.SetRelayLimits(ctx, &v1.SetRelayLimitsRequest{
+		MaxBytesPerSecond: limit,
+		Schedule:          cur.GetSchedule(),
+	})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Set default relay limit to %s.\n", fmtByteRate(limit))
+	return nil
+}
+
+func (c *Cli) cmdGetLobbySettings(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 0, 0, "getlobbysettings"); err != nil {
+		return err
+	}
+
+	resp, err := c.client.GetLobbySettings(ctx, &v1.GetLobbySettingsRequest{})
+	if err != nil {
+		return err
+	}
+
+	printLobbySettings(resp.GetSettings())
+	return nil
+}
+
+func (c *Cli) cmdSetLobbySetting(ctx context.Context, args []string) error {
+	const usage = "setlobbysetting <timeout|minversion|maxversion|maxconcurrent|maxconnsperip> <value>"
+	if err := validateArgCount(args, 2, 2, usage); err != nil {
+		return err
+	}
+
+	req := &v1.UpdateLobbySettingsRequest{}
+	switch args[0] {
+	case "timeout":
+		secs, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return fmt.Errorf("usage: %s", usage)
+		}
+		req.TimeoutSeconds = new(uint32(secs))
+	case "minversion":
+		req.MinProtocolVersion = &args[1]
+	case "maxversion":
+		req.MaxProtocolVersion = &args[1]
+	case "maxconcurrent":
+		limit, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return fmt.Errorf("usage: %s", usage)
+		}
+		req.MaxConcurrent = new(uint32(limit))
+	case "maxconnsperip":
+		limit, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return fmt.Errorf("usage: %s", usage)
+		}
+		req.MaxConnsPerIpPerMinute = new(uint32(limit))
+	default:
+		return fmt.Errorf("usage: %s", usage)
+	}
+
+	resp, err := c.client.UpdateLobbySettings(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	printLobbySettings(resp.GetSettings())
+	return nil
+}
+
+func printLobbySettings(settings *v1.LobbySettings) {
+	fmt.Printf("Timeout: %ds\n", settings.GetTimeoutSeconds())
+	fmt.Printf("Accepted protocol versions: %s to %s\n", settings.GetMinProtocolVersion(), settings.GetMaxProtocolVersion())
+	fmt.Printf("Max concurrent connections: %s\n", fmtLimit(settings.GetMaxConcurrent()))
+	fmt.Printf("Max connections per IP per minute: %s\n", fmtLimit(settings.GetMaxConnsPerIpPerMinute()))
+}
+
+func (c *Cli) cmdGetLobbyStats(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 0, 0, "getlobbystats"); err != nil {
+		return err
+	}
+
+	resp, err := c.client.GetLobbyStats(ctx, &v1.GetLobbyStatsRequest{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("In lobby: %d\n", resp.GetOnboarding())
+	fmt.Printf("Accepted: %d\n", resp.GetAccepted())
+	fmt.Printf("Rejected (rate limited): %d\n", resp.GetRejectedRateLimited())
+	fmt.Printf("Rejected (busy): %d\n", resp.GetRejectedBusy())
+	fmt.Printf("Rejected (draining): %d\n", resp.GetRejectedDraining())
+	return nil
+}
+
+func (c *Cli) cmdGetRoomStats(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 1, 2, "getroomstats <room> [hours]"); err != nil {
+		return err
+	}
+
+	hours := 24
+	if len(args) > 1 {
+		var err error
+		hours, err = strconv.Atoi(args[1])
+		if err != nil || hours <= 0 {
+			return fmt.Errorf("hours must be a positive integer")
+		}
+	}
+
+	resp, err := c.client.GetRoomStats(ctx, &v1.GetRoomStatsRequest{
+		Name:   args[0],
+		FromTs: time.Now().Add(-time.Duration(hours) * time.Hour).Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, stat := range resp.GetStats() {
+		fmt.Printf("%s  online: %d  relayed: %d B\n",
+			time.Unix(stat.GetTs(), 0).Format(time.DateTime),
+			stat.GetOnlineClients(),
+			stat.GetRelayedBytes(),
+		)
+	}
+	fmt.Printf("%d snapshots\n", len(resp.GetStats()))
+	return nil
+}
+
+// fmtByteRate formats a bytes per second limit where 0 means unlimited.
+func fmtByteRate(limit uint64) string {
+	if limit == 0 {
+		return "unlimited"
+	}
+	return strconv.FormatUint(limit, 10) + " B/s"
+}
+
+// fmtLimit formats a limit value where 0 means unlimited.
+func fmtLimit(limit uint32) string {
+	if limit == 0 {
+		return "unlimited"
+	}
+	return strconv.FormatUint(uint64(limit), 10)
+}
+
+// receiveAll returns an iterator over the messages received from a server stream, for use with range-over-func.
+// If the stream fails, the error is yielded with a nil message, and iteration ends.
+// The stream is closed once iteration ends, including when the loop breaks early.
+// Requests made with a context that is done end with the context's error.
+func receiveAll[T any](stream *connect.ServerStreamForClient[T]) iter.Seq2[*T, error] {
+	return func(yield func(*T, error) bool) {
+		defer func() {
+			_ = stream.Close()
+		}()
+
+		for stream.Receive() {
+			if !yield(stream.Msg(), nil) {
+				return
+			}
+		}
+		if err := stream.Err(); err != nil {
+			yield(nil, err)
+		}
+	}
+}
+
+func validateArgCount(args []string, min int, max int, usage string) error {
+	if len(args) < min {
+		return fmt.Errorf("usage: %s", usage)
+	}
+	if max >= 0 && len(args) > max {
+		return fmt.Errorf("usage: %s", usage)
+	}
+	return nil
+}
+
+const defaultWelcomeMsg = "Welcome to the FriendNet server RPC CLI."
+
+// Run runs the CLI.
+// It returns when the client presses CTRL+D.
 func (c *Cli) Run() {
 	var msg string
 	if c.welcomeMsg == "" {
@@ -550,3 +1409,132 @@ func (c *Cli) completer() readline.AutoCompleter {
 	}
 	return readline.NewPrefixCompleter(items...)
 }
+
+func (c *Cli) cmdDrain(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 0, 0, "drain"); err != nil {
+		return err
+	}
+
+	stream, err := c.client.Drain(ctx, &v1.DrainRequest{})
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Draining. New connections and proxied streams are refused until the server restarts.")
+	for msg, err := range receiveAll(stream) {
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Active proxied streams: %d, online clients: %d\n", msg.GetActiveStreams(), msg.GetOnlineClients())
+	}
+
+	fmt.Println("No proxied streams are open, so the server can be stopped.")
+	return nil
+}
+
+func (c *Cli) cmdCheckConfig(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 0, 1, "checkconfig [local config path]"); err != nil {
+		return err
+	}
+
+	// Without a path, the server checks the config file it was started with.
+	var configJson string
+	if len(args) == 1 {
+		data, err := os.ReadFile(args[0])
+		if err != nil {
+			return err
+		}
+		configJson = string(data)
+	}
+
+	resp, err := c.client.ValidateConfig(ctx, &v1.ValidateConfigRequest{
+		ConfigJson: configJson,
+	})
+	if err != nil {
+		return err
+	}
+
+	problems := resp.GetProblems()
+	if len(problems) == 0 {
+		fmt.Println("Config has no problems.")
+		return nil
+	}
+	fmt.Println("Config has problems:")
+	for _, problem := range problems {
+		kind := "error"
+		if problem.GetWarning() {
+			kind = "warning"
+		}
+		if problem.GetField() == "" {
+			fmt.Printf("  %-8s %s\n", kind, problem.GetMessage())
+		} else {
+			fmt.Printf("  %-8s %s: %s\n", kind, problem.GetField(), problem.GetMessage())
+		}
+	}
+	return nil
+}
+
+func (c *Cli) cmdExportRoom(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 2, 2, "exportroom <room> <local archive path>"); err != nil {
+		return err
+	}
+
+	resp, err := c.client.ExportRoom(ctx, &v1.ExportRoomRequest{
+		Name: args[0],
+	})
+	if err != nil {
+		return err
+	}
+
+	data, err := protojson.MarshalOptions{Multiline: true}.Marshal(resp.GetArchive())
+	if err != nil {
+		return err
+	}
+
+	// The archive has password hashes, so only the current user can read it.
+	f, err := os.OpenFile(args[1], os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	_, err = f.Write(data)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Exported room %s with %d account(s) to %s.\n", resp.GetArchive().GetName(), len(resp.GetArchive().GetAccounts()), args[1])
+	fmt.Println("The archive contains password hashes, so keep it secret.")
+	return nil
+}
+
+func (c *Cli) cmdImportRoom(ctx context.Context, args []string) error {
+	if err := validateArgCount(args, 1, 2, "importroom <local archive path> [new room name]"); err != nil {
+		return err
+	}
+
+	data, err := os.ReadFile(args[0])
+	if err != nil {
+		return err
+	}
+	var archive v1.RoomArchive
+	if err = protojson.Unmarshal(data, &archive); err != nil {
+		return fmt.Errorf("invalid room archive: %w", err)
+	}
+
+	req := &v1.ImportRoomRequest{
+		Archive: &archive,
+	}
+	if len(args) == 2 {
+		req.Name = &args[1]
+	}
+
+	resp, err := c.client.ImportRoom(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Imported room %s with %d account(s).\n", resp.GetRoom().GetName(), len(archive.GetAccounts()))
+	return nil
+}
@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file pb/serverrpc/v1/rpc.proto.
  */
 export const file_pb_serverrpc_v1_rpc: GenFile = /*@__PURE__*/
-  fileDesc("ChlwYi9zZXJ2ZXJycGMvdjEvcnBjLnByb3RvEg9wYi5zZXJ2ZXJycGMudjEiMwoIUm9vbUluZm8SDAoEbmFtZRgBIAEoCRIZChFvbmxpbmVfdXNlcl9jb3VudBgCIAEoDSIiCg5PbmxpbmVVc2VySW5mbxIQCgh1c2VybmFtZRgBIAEoCSIfCgtBY2NvdW50SW5mbxIQCgh1c2VybmFtZRgBIAEoCSIWChRHZXRTZXJ2ZXJJbmZvUmVxdWVzdCKgAQoVR2V0U2VydmVySW5mb1Jlc3BvbnNlEg8KB3ZlcnNpb24YASABKAkSNwoDcnBjGAIgASgLMioucGIuc2VydmVycnBjLnYxLkdldFNlcnZlckluZm9SZXNwb25zZS5ScGMaPQoDUnBjEhcKD2FsbG93ZWRfbWV0aG9kcxgBIAMoCRIdChVyZXF1aXJlc19iZWFyZXJfdG9rZW4YAiABKAgiEQoPR2V0Um9vbXNSZXF1ZXN0IjwKEEdldFJvb21zUmVzcG9uc2USKAoFcm9vbXMYASADKAsyGS5wYi5zZXJ2ZXJycGMudjEuUm9vbUluZm8iIgoSR2V0Um9vbUluZm9SZXF1ZXN0EgwKBG5hbWUYASABKAkiPgoTR2V0Um9vbUluZm9SZXNwb25zZRInCgRyb29tGAEgASgLMhkucGIuc2VydmVycnBjLnYxLlJvb21JbmZvIiUKFUdldE9ubGluZVVzZXJzUmVxdWVzdBIMCgRyb29tGAEgASgJIkgKFkdldE9ubGluZVVzZXJzUmVzcG9uc2USLgoFdXNlcnMYASADKAsyHy5wYi5zZXJ2ZXJycGMudjEuT25saW5lVXNlckluZm8iOgoYR2V0T25saW5lVXNlckluZm9SZXF1ZXN0EgwKBHJvb20YASABKAkSEAoIdXNlcm5hbWUYAiABKAkiSgoZR2V0T25saW5lVXNlckluZm9SZXNwb25zZRItCgR1c2VyGAEgASgLMh8ucGIuc2VydmVycnBjLnYxLk9ubGluZVVzZXJJbmZvIiIKEkdldEFjY291bnRzUmVxdWVzdBIMCgRyb29tGAEgASgJIkUKE0dldEFjY291bnRzUmVzcG9uc2USLgoIYWNjb3VudHMYASADKAsyHC5wYi5zZXJ2ZXJycGMudjEuQWNjb3VudEluZm8iIQoRQ3JlYXRlUm9vbVJlcXVlc3QSDAoEbmFtZRgBIAEoCSI9ChJDcmVhdGVSb29tUmVzcG9uc2USJwoEcm9vbRgBIAEoCzIZLnBiLnNlcnZlcnJwYy52MS5Sb29tSW5mbyIhChFEZWxldGVSb29tUmVxdWVzdBIMCgRuYW1lGAEgASgJIhQKEkRlbGV0ZVJvb21SZXNwb25zZSJIChRDcmVhdGVBY2NvdW50UmVxdWVzdBIMCgRyb29tGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEhAKCHBhc3N3b3JkGAMgASgJIn4KFUNyZWF0ZUFjY291bnRSZXNwb25zZRItCgdhY2NvdW50GAEgASgLMhwucGIuc2VydmVycnBjLnYxLkFjY291bnRJbmZvEh8KEmdlbmVyYXRlZF9wYXNzd29yZBgCIAEoCUgAiAEBQhUKE19nZW5lcmF0ZWRfcGFzc3dvcmQiNgoURGVsZXRlQWNjb3VudFJlcXVlc3QSDAoEcm9vbRgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCSIXChVEZWxldGVBY2NvdW50UmVzcG9uc2UiUAocVXBkYXRlQWNjb3VudFBhc3N3b3JkUmVxdWVzdBIMCgRyb29tGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEhAKCHBhc3N3b3JkGAMgASgJIlcKHVVwZGF0ZUFjY291bnRQYXNzd29yZFJlc3BvbnNlEh8KEmdlbmVyYXRlZF9wYXNzd29yZBgBIAEoCUgAiAEBQhUKE19nZW5lcmF0ZWRfcGFzc3dvcmQyxAgKEFNlcnZlclJwY1NlcnZpY2USYAoNR2V0U2VydmVySW5mbxIlLnBiLnNlcnZlcnJwYy52MS5HZXRTZXJ2ZXJJbmZvUmVxdWVzdBomLnBiLnNlcnZlcnJwYy52MS5HZXRTZXJ2ZXJJbmZvUmVzcG9uc2UiABJRCghHZXRSb29tcxIgLnBiLnNlcnZlcnJwYy52MS5HZXRSb29tc1JlcXVlc3QaIS5wYi5zZXJ2ZXJycGMudjEuR2V0Um9vbXNSZXNwb25zZSIAEloKC0dldFJvb21JbmZvEiMucGIuc2VydmVycnBjLnYxLkdldFJvb21JbmZvUmVxdWVzdBokLnBiLnNlcnZlcnJwYy52MS5HZXRSb29tSW5mb1Jlc3BvbnNlIgASZQoOR2V0T25saW5lVXNlcnMSJi5wYi5zZXJ2ZXJycGMudjEuR2V0T25saW5lVXNlcnNSZXF1ZXN0GicucGIuc2VydmVycnBjLnYxLkdldE9ubGluZVVzZXJzUmVzcG9uc2UiADABEmwKEUdldE9ubGluZVVzZXJJbmZvEikucGIuc2VydmVycnBjLnYxLkdldE9ubGluZVVzZXJJbmZvUmVxdWVzdBoqLnBiLnNlcnZlcnJwYy52MS5HZXRPbmxpbmVVc2VySW5mb1Jlc3BvbnNlIgASWgoLR2V0QWNjb3VudHMSIy5wYi5zZXJ2ZXJycGMudjEuR2V0QWNjb3VudHNSZXF1ZXN0GiQucGIuc2VydmVycnBjLnYxLkdldEFjY291bnRzUmVzcG9uc2UiABJXCgpDcmVhdGVSb29tEiIucGIuc2VydmVycnBjLnYxLkNyZWF0ZVJvb21SZXF1ZXN0GiMucGIuc2VydmVycnBjLnYxLkNyZWF0ZVJvb21SZXNwb25zZSIAElcKCkRlbGV0ZVJvb20SIi5wYi5zZXJ2ZXJycGMudjEuRGVsZXRlUm9vbVJlcXVlc3QaIy5wYi5zZXJ2ZXJycGMudjEuRGVsZXRlUm9vbVJlc3BvbnNlIgASYAoNQ3JlYXRlQWNjb3VudBIlLnBiLnNlcnZlcnJwYy52MS5DcmVhdGVBY2NvdW50UmVxdWVzdBomLnBiLnNlcnZlcnJwYy52MS5DcmVhdGVBY2NvdW50UmVzcG9uc2UiABJgCg1EZWxldGVBY2NvdW50EiUucGIuc2VydmVycnBjLnYxLkRlbGV0ZUFjY291bnRSZXF1ZXN0GiYucGIuc2VydmVycnBjLnYxLkRlbGV0ZUFjY291bnRSZXNwb25zZSIAEngKFVVwZGF0ZUFjY291bnRQYXNzd29yZBItLnBiLnNlcnZlcnJwYy52MS5VcGRhdGVBY2NvdW50UGFzc3dvcmRSZXF1ZXN0Gi4ucGIuc2VydmVycnBjLnYxLlVwZGF0ZUFjY291bnRQYXNzd29yZFJlc3BvbnNlIgBCIlogZnJpZW5kbmV0Lm9yZy9wcm90b2NvbC9zZXJ2ZXJycGNiBnByb3RvMw");
+  fileDesc("ChlwYi9zZXJ2ZXJycGMvdjEvcnBjLnByb3RvEg9wYi5zZXJ2ZXJycGMudjEizwEKCFJvb21JbmZvEgwKBG5hbWUYASABKAkSGQoRb25saW5lX3VzZXJfY291bnQYAiABKA0SEwoLbWF4X2NsaWVudHMYAyABKA0SJAocbWF4X3Byb3h5X3N0cmVhbXNfcGVyX2NsaWVudBgEIAEoDRIYChBkaXJfY2FjaGVfdHRsX21zGAUgASgNEhIKCmNyZWF0ZWRfdHMYBiABKAMSEwoLZGVzY3JpcHRpb24YByABKAkSDgoGbGlzdGVkGAggASgIEgwKBG1vdGQYCSABKAkigQEKDk9ubGluZVVzZXJJbmZvEhAKCHVzZXJuYW1lGAEgASgJEiYKA3J0dBgCIAEoCzIZLnBiLnNlcnZlcnJwYy52MS5SdHRTdGF0cxIVCg1yZWxheWVkX2J5dGVzGAMgASgDEh4KFnJlbGF5X2J5dGVzX3Blcl9zZWNvbmQYBCABKAMitgEKCFJ0dFN0YXRzEg8KB2xhc3RfdXMYASABKAMSDgoGbWluX3VzGAIgASgDEg4KBmF2Z191cxgDIAEoAxIOCgZtYXhfdXMYBCABKAMSDwoHc2FtcGxlcxgFIAEoDRIMCgRsb3N0GAYgASgEEhgKEGNvbnNlY3V0aXZlX2xvc3QYByABKA0SHAoPY2xvY2tfb2Zmc2V0X3VzGAggASgDSACIAQFCEgoQX2Nsb2NrX29mZnNldF91cyIyCg5JbnZpdGVDb2RlSW5mbxIMCgRjb2RlGAEgASgJEhIKCmNyZWF0ZWRfdHMYAiABKAMingEKClN0cmVhbUluZm8SCgoCaWQYASABKAkSDAoEcm9vbRgCIAEoCRIXCg9vcmlnaW5fdXNlcm5hbWUYAyABKAkSFwoPdGFyZ2V0X3VzZXJuYW1lGAQgASgJEhcKD2J5dGVzX3RvX3RhcmdldBgFIAEoAxIXCg9ieXRlc190b19vcmlnaW4YBiABKAMSEgoKY3JlYXRlZF90cxgHIAEoAyJFCghSb29tU3RhdBIKCgJ0cxgBIAEoAxIWCg5vbmxpbmVfY2xpZW50cxgCIAEoDRIVCg1yZWxheWVkX2J5dGVzGAMgASgDIjEKC0FjY291bnRJbmZvEhAKCHVzZXJuYW1lGAEgASgJEhAKCGlzX2d1ZXN0GAIgASgIIhYKFEdldFNlcnZlckluZm9SZXF1ZXN0IqwCChVHZXRTZXJ2ZXJJbmZvUmVzcG9uc2USDwoHdmVyc2lvbhgBIAEoCRI3CgNycGMYAiABKAsyKi5wYi5zZXJ2ZXJycGMudjEuR2V0U2VydmVySW5mb1Jlc3BvbnNlLlJwYxIQCghkcmFpbmluZxgDIAEoCBIYChBwcm90b2NvbF92ZXJzaW9uGAQgASgJEhQKDGJ1aWxkX2NvbW1pdBgFIAEoCRIQCghzdGFydF90cxgGIAEoAxIWCg51cHRpbWVfc2Vjb25kcxgHIAEoAxIQCghmZWF0dXJlcxgIIAMoCRpLCgNScGMSFwoPYWxsb3dlZF9tZXRob2RzGAEgAygJEh0KFXJlcXVpcmVzX2JlYXJlcl90b2tlbhgCIAEoCBIMCgRyb2xlGAMgASgJIisKD0dldFJvb21zUmVxdWVzdBIYChBpbmNsdWRlX3VubGlzdGVkGAEgASgIIjwKEEdldFJvb21zUmVzcG9uc2USKAoFcm9vbXMYASADKAsyGS5wYi5zZXJ2ZXJycGMudjEuUm9vbUluZm8iIgoSR2V0Um9vbUluZm9SZXF1ZXN0EgwKBG5hbWUYASABKAkiPgoTR2V0Um9vbUluZm9SZXNwb25zZRInCgRyb29tGAEgASgLMhkucGIuc2VydmVycnBjLnYxLlJvb21JbmZvIjgKFUdldE9ubGluZVVzZXJzUmVxdWVzdBIMCgRyb29tGAEgASgJEhEKCXBhZ2Vfc2l6ZRgCIAEoDSJIChZHZXRPbmxpbmVVc2Vyc1Jlc3BvbnNlEi4KBXVzZXJzGAEgAygLMh8ucGIuc2VydmVycnBjLnYxLk9ubGluZVVzZXJJbmZvIjoKGEdldE9ubGluZVVzZXJJbmZvUmVxdWVzdBIMCgRyb29tGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJIkoKGUdldE9ubGluZVVzZXJJbmZvUmVzcG9uc2USLQoEdXNlchgBIAEoCzIfLnBiLnNlcnZlcnJwYy52MS5PbmxpbmVVc2VySW5mbyJBChJHZXRBY2NvdW50c1JlcXVlc3QSDAoEcm9vbRgBIAEoCRINCgVsaW1pdBgCIAEoDRIOCgZjdXJzb3IYAyABKAkiaQoTR2V0QWNjb3VudHNSZXNwb25zZRIuCghhY2NvdW50cxgBIAMoCzIcLnBiLnNlcnZlcnJwYy52MS5BY2NvdW50SW5mbxITCgtuZXh0X2N1cnNvchgCIAEoCRINCgV0b3RhbBgDIAEoDSJWChFDcmVhdGVSb29tUmVxdWVzdBIMCgRuYW1lGAEgASgJEhMKC2Rlc2NyaXB0aW9uGAIgASgJEhMKBmxpc3RlZBgDIAEoCEgAiAEBQgkKB19saXN0ZWQiPQoSQ3JlYXRlUm9vbVJlc3BvbnNlEicKBHJvb20YASABKAsyGS5wYi5zZXJ2ZXJycGMudjEuUm9vbUluZm8iIQoRRGVsZXRlUm9vbVJlcXVlc3QSDAoEbmFtZRgBIAEoCSIUChJEZWxldGVSb29tUmVzcG9uc2UiXwoUU2V0Um9vbUxpbWl0c1JlcXVlc3QSDAoEbmFtZRgBIAEoCRITCgttYXhfY2xpZW50cxgCIAEoDRIkChxtYXhfcHJveHlfc3RyZWFtc19wZXJfY2xpZW50GAMgASgNIkAKFVNldFJvb21MaW1pdHNSZXNwb25zZRInCgRyb29tGAEgASgLMhkucGIuc2VydmVycnBjLnYxLlJvb21JbmZvIjkKGVNldFJvb21EaXJDYWNoZVR0bFJlcXVlc3QSDAoEbmFtZRgBIAEoCRIOCgZ0dGxfbXMYAiABKA0iRQoaU2V0Um9vbURpckNhY2hlVHRsUmVzcG9uc2USJwoEcm9vbRgBIAEoCzIZLnBiLnNlcnZlcnJwYy52MS5Sb29tSW5mbyJLChZTZXRSb29tTWV0YWRhdGFSZXF1ZXN0EgwKBG5hbWUYASABKAkSEwoLZGVzY3JpcHRpb24YAiABKAkSDgoGbGlzdGVkGAMgASgIIkIKF1NldFJvb21NZXRhZGF0YVJlc3BvbnNlEicKBHJvb20YASABKAsyGS5wYi5zZXJ2ZXJycGMudjEuUm9vbUluZm8iMAoSU2V0Um9vbU1vdGRSZXF1ZXN0EgwKBG5hbWUYASABKAkSDAoEbW90ZBgCIAEoCSI+ChNTZXRSb29tTW90ZFJlc3BvbnNlEicKBHJvb20YASABKAsyGS5wYi5zZXJ2ZXJycGMudjEuUm9vbUluZm8iIAoQQ2xvc2VSb29tUmVxdWVzdBIMCgRuYW1lGAEgASgJIjQKEUNsb3NlUm9vbVJlc3BvbnNlEh8KF2Rpc2Nvbm5lY3RlZF91c2VyX2NvdW50GAEgASgNIjEKD0tpY2tVc2VyUmVxdWVzdBIMCgRyb29tGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJIhIKEEtpY2tVc2VyUmVzcG9uc2UiNQoXQnJvYWRjYXN0TWVzc2FnZVJlcXVlc3QSDAoEcm9vbRgBIAEoCRIMCgR0ZXh0GAIgASgJIjMKGEJyb2FkY2FzdE1lc3NhZ2VSZXNwb25zZRIXCg9yZWNpcGllbnRfY291bnQYASABKA0iWgoUQ3JlYXRlQWNjb3VudFJlcXVlc3QSDAoEcm9vbRgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRIQCghwYXNzd29yZBgDIAEoCRIQCghpc19ndWVzdBgEIAEoCCJ+ChVDcmVhdGVBY2NvdW50UmVzcG9uc2USLQoHYWNjb3VudBgBIAEoCzIcLnBiLnNlcnZlcnJwYy52MS5BY2NvdW50SW5mbxIfChJnZW5lcmF0ZWRfcGFzc3dvcmQYAiABKAlIAIgBAUIVChNfZ2VuZXJhdGVkX3Bhc3N3b3JkIjYKFERlbGV0ZUFjY291bnRSZXF1ZXN0EgwKBHJvb20YASABKAkSEAoIdXNlcm5hbWUYAiABKAkiFwoVRGVsZXRlQWNjb3VudFJlc3BvbnNlIlAKHFVwZGF0ZUFjY291bnRQYXNzd29yZFJlcXVlc3QSDAoEcm9vbRgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRIQCghwYXNzd29yZBgDIAEoCSJXCh1VcGRhdGVBY2NvdW50UGFzc3dvcmRSZXNwb25zZRIfChJnZW5lcmF0ZWRfcGFzc3dvcmQYASABKAlIAIgBAUIVChNfZ2VuZXJhdGVkX3Bhc3N3b3JkIicKF0NyZWF0ZUludml0ZUNvZGVSZXF1ZXN0EgwKBHJvb20YASABKAkiUAoYQ3JlYXRlSW52aXRlQ29kZVJlc3BvbnNlEjQKC2ludml0ZV9jb2RlGAEgASgLMh8ucGIuc2VydmVycnBjLnYxLkludml0ZUNvZGVJbmZvIiUKFUdldEludml0ZUNvZGVzUmVxdWVzdBIMCgRyb29tGAEgASgJIk8KFkdldEludml0ZUNvZGVzUmVzcG9uc2USNQoMaW52aXRlX2NvZGVzGAEgAygLMh8ucGIuc2VydmVycnBjLnYxLkludml0ZUNvZGVJbmZvIjUKF0RlbGV0ZUludml0ZUNvZGVSZXF1ZXN0EgwKBHJvb20YASABKAkSDAoEY29kZRgCIAEoCSIaChhEZWxldGVJbnZpdGVDb2RlUmVzcG9uc2UiVgoZQ3JlYXRlSW52aXRlQnVuZGxlUmVxdWVzdBIMCgRyb29tGAEgASgJEg8KB2FkZHJlc3MYAiABKAkSGgoSY3JlYXRlX2ludml0ZV9jb2RlGAMgASgIInQKGkNyZWF0ZUludml0ZUJ1bmRsZVJlc3BvbnNlEgsKA3VybBgBIAEoCRI5CgtpbnZpdGVfY29kZRgCIAEoCzIfLnBiLnNlcnZlcnJwYy52MS5JbnZpdGVDb2RlSW5mb0gAiAEBQg4KDF9pbnZpdGVfY29kZSJKChZTZXRBY2NvdW50R3Vlc3RSZXF1ZXN0EgwKBHJvb20YASABKAkSEAoIdXNlcm5hbWUYAiABKAkSEAoIaXNfZ3Vlc3QYAyABKAgiGQoXU2V0QWNjb3VudEd1ZXN0UmVzcG9uc2UiIgoSTGlzdFN0cmVhbXNSZXF1ZXN0EgwKBHJvb20YASABKAkiQwoTTGlzdFN0cmVhbXNSZXNwb25zZRIsCgdzdHJlYW1zGAEgAygLMhsucGIuc2VydmVycnBjLnYxLlN0cmVhbUluZm8iLwoTQ2FuY2VsU3RyZWFtUmVxdWVzdBIMCgRyb29tGAEgASgJEgoKAmlkGAIgASgJIhYKFENhbmNlbFN0cmVhbVJlc3BvbnNlIlMKDU1pZ3JhdGlvbkluZm8SDAoEbmFtZRgBIAEoCRIPCgdhcHBsaWVkGAIgASgIEhIKCmFwcGxpZWRfdHMYAyABKAMSDwoHdW5rbm93bhgEIAEoCCIbChlHZXRNaWdyYXRpb25TdGF0dXNSZXF1ZXN0IlAKGkdldE1pZ3JhdGlvblN0YXR1c1Jlc3BvbnNlEjIKCm1pZ3JhdGlvbnMYASADKAsyHi5wYi5zZXJ2ZXJycGMudjEuTWlncmF0aW9uSW5mbyIlChVCYWNrdXBEYXRhYmFzZVJlcXVlc3QSDAoEcGF0aBgBIAEoCSIYChZCYWNrdXBEYXRhYmFzZVJlc3BvbnNlIh8KHUNoZWNrRGF0YWJhc2VJbnRlZ3JpdHlSZXF1ZXN0IjIKHkNoZWNrRGF0YWJhc2VJbnRlZ3JpdHlSZXNwb25zZRIQCghwcm9ibGVtcxgBIAMoCSJsChBSZWxheUxpbWl0V2luZG93EhAKCHdlZWtkYXlzGAEgASgNEhQKDHN0YXJ0X21pbnV0ZRgCIAEoDRISCgplbmRfbWludXRlGAMgASgNEhwKFG1heF9ieXRlc19wZXJfc2Vjb25kGAQgASgEIhcKFUdldFJlbGF5TGltaXRzUmVxdWVzdCKRAQoWR2V0UmVsYXlMaW1pdHNSZXNwb25zZRIcChRtYXhfYnl0ZXNfcGVyX3NlY29uZBgBIAEoBBIzCghzY2hlZHVsZRgCIAMoCzIhLnBiLnNlcnZlcnJwYy52MS5SZWxheUxpbWl0V2luZG93EiQKHGN1cnJlbnRfbWF4X2J5dGVzX3Blcl9zZWNvbmQYAyABKAQiagoVU2V0UmVsYXlMaW1pdHNSZXF1ZXN0EhwKFG1heF9ieXRlc19wZXJfc2Vjb25kGAEgASgEEjMKCHNjaGVkdWxlGAIgAygLMiEucGIuc2VydmVycnBjLnYxLlJlbGF5TGltaXRXaW5kb3ciGAoWU2V0UmVsYXlMaW1pdHNSZXNwb25zZSKhAQoNTG9iYnlTZXR0aW5ncxIXCg90aW1lb3V0X3NlY29uZHMYASABKA0SHAoUbWluX3Byb3RvY29sX3ZlcnNpb24YAiABKAkSHAoUbWF4X3Byb3RvY29sX3ZlcnNpb24YAyABKAkSFgoObWF4X2NvbmN1cnJlbnQYBCABKA0SIwobbWF4X2Nvbm5zX3Blcl9pcF9wZXJfbWludXRlGAUgASgNIhkKF0dldExvYmJ5U2V0dGluZ3NSZXF1ZXN0IkwKGEdldExvYmJ5U2V0dGluZ3NSZXNwb25zZRIwCghzZXR0aW5ncxgBIAEoCzIeLnBiLnNlcnZlcnJwYy52MS5Mb2JieVNldHRpbmdzIsACChpVcGRhdGVMb2JieVNldHRpbmdzUmVxdWVzdBIcCg90aW1lb3V0X3NlY29uZHMYASABKA1IAIgBARIhChRtaW5fcHJvdG9jb2xfdmVyc2lvbhgCIAEoCUgBiAEBEiEKFG1heF9wcm90b2NvbF92ZXJzaW9uGAMgASgJSAKIAQESGwoObWF4X2NvbmN1cnJlbnQYBCABKA1IA4gBARIoChttYXhfY29ubnNfcGVyX2lwX3Blcl9taW51dGUYBSABKA1IBIgBAUISChBfdGltZW91dF9zZWNvbmRzQhcKFV9taW5fcHJvdG9jb2xfdmVyc2lvbkIXChVfbWF4X3Byb3RvY29sX3ZlcnNpb25CEQoPX21heF9jb25jdXJyZW50Qh4KHF9tYXhfY29ubnNfcGVyX2lwX3Blcl9taW51dGUiTwobVXBkYXRlTG9iYnlTZXR0aW5nc1Jlc3BvbnNlEjAKCHNldHRpbmdzGAEgASgLMh4ucGIuc2VydmVycnBjLnYxLkxvYmJ5U2V0dGluZ3MiFgoUR2V0TG9iYnlTdGF0c1JlcXVlc3QijgEKFUdldExvYmJ5U3RhdHNSZXNwb25zZRIQCghhY2NlcHRlZBgBIAEoBBIZChFyZWplY3RlZF9kcmFpbmluZxgCIAEoBBIdChVyZWplY3RlZF9yYXRlX2xpbWl0ZWQYAyABKAQSFQoNcmVqZWN0ZWRfYnVzeRgEIAEoBBISCgpvbmJvYXJkaW5nGAUgASgNIkMKE0dldFJvb21TdGF0c1JlcXVlc3QSDAoEbmFtZRgBIAEoCRIPCgdmcm9tX3RzGAIgASgDEg0KBXRvX3RzGAMgASgDIkAKFEdldFJvb21TdGF0c1Jlc3BvbnNlEigKBXN0YXRzGAEgAygLMhkucGIuc2VydmVycnBjLnYxLlJvb21TdGF0Ig4KDERyYWluUmVxdWVzdCI/Cg1EcmFpblJlc3BvbnNlEhYKDmFjdGl2ZV9zdHJlYW1zGAEgASgNEhYKDm9ubGluZV9jbGllbnRzGAIgASgNIkAKDUNvbmZpZ1Byb2JsZW0SDQoFZmllbGQYASABKAkSDwoHbWVzc2FnZRgCIAEoCRIPCgd3YXJuaW5nGAMgASgIIiwKFVZhbGlkYXRlQ29uZmlnUmVxdWVzdBITCgtjb25maWdfanNvbhgBIAEoCSJKChZWYWxpZGF0ZUNvbmZpZ1Jlc3BvbnNlEjAKCHByb2JsZW1zGAEgAygLMh4ucGIuc2VydmVycnBjLnYxLkNvbmZpZ1Byb2JsZW0iyAIKC1Jvb21BcmNoaXZlEg8KB3ZlcnNpb24YASABKA0SDAoEbmFtZRgCIAEoCRITCgtkZXNjcmlwdGlvbhgDIAEoCRIOCgZsaXN0ZWQYBCABKAgSDAoEbW90ZBgFIAEoCRITCgttYXhfY2xpZW50cxgGIAEoDRIkChxtYXhfcHJveHlfc3RyZWFtc19wZXJfY2xpZW50GAcgASgNEhgKEGRpcl9jYWNoZV90dGxfbXMYCCABKA0SNgoIYWNjb3VudHMYCSADKAsyJC5wYi5zZXJ2ZXJycGMudjEuUm9vbUFyY2hpdmUuQWNjb3VudBIUCgxpbnZpdGVfY29kZXMYCiADKAkaRAoHQWNjb3VudBIQCgh1c2VybmFtZRgBIAEoCRIVCg1wYXNzd29yZF9oYXNoGAIgASgJEhAKCGlzX2d1ZXN0GAMgASgIIiEKEUV4cG9ydFJvb21SZXF1ZXN0EgwKBG5hbWUYASABKAkiQwoSRXhwb3J0Um9vbVJlc3BvbnNlEi0KB2FyY2hpdmUYASABKAsyHC5wYi5zZXJ2ZXJycGMudjEuUm9vbUFyY2hpdmUiXgoRSW1wb3J0Um9vbVJlcXVlc3QSLQoHYXJjaGl2ZRgBIAEoCzIcLnBiLnNlcnZlcnJwYy52MS5Sb29tQXJjaGl2ZRIRCgRuYW1lGAIgASgJSACIAQFCBwoFX25hbWUiPQoSSW1wb3J0Um9vbVJlc3BvbnNlEicKBHJvb20YASABKAsyGS5wYi5zZXJ2ZXJycGMudjEuUm9vbUluZm8y3h0KEFNlcnZlclJwY1NlcnZpY2USYAoNR2V0U2VydmVySW5mbxIlLnBiLnNlcnZlcnJwYy52MS5HZXRTZXJ2ZXJJbmZvUmVxdWVzdBomLnBiLnNlcnZlcnJwYy52MS5HZXRTZXJ2ZXJJbmZvUmVzcG9uc2UiABJRCghHZXRSb29tcxIgLnBiLnNlcnZlcnJwYy52MS5HZXRSb29tc1JlcXVlc3QaIS5wYi5zZXJ2ZXJycGMudjEuR2V0Um9vbXNSZXNwb25zZSIAEloKC0dldFJvb21JbmZvEiMucGIuc2VydmVycnBjLnYxLkdldFJvb21JbmZvUmVxdWVzdBokLnBiLnNlcnZlcnJwYy52MS5HZXRSb29tSW5mb1Jlc3BvbnNlIgASZQoOR2V0T25saW5lVXNlcnMSJi5wYi5zZXJ2ZXJycGMudjEuR2V0T25saW5lVXNlcnNSZXF1ZXN0GicucGIuc2VydmVycnBjLnYxLkdldE9ubGluZVVzZXJzUmVzcG9uc2UiADABEmwKEUdldE9ubGluZVVzZXJJbmZvEikucGIuc2VydmVycnBjLnYxLkdldE9ubGluZVVzZXJJbmZvUmVxdWVzdBoqLnBiLnNlcnZlcnJwYy52MS5HZXRPbmxpbmVVc2VySW5mb1Jlc3BvbnNlIgASWgoLR2V0QWNjb3VudHMSIy5wYi5zZXJ2ZXJycGMudjEuR2V0QWNjb3VudHNSZXF1ZXN0GiQucGIuc2VydmVycnBjLnYxLkdldEFjY291bnRzUmVzcG9uc2UiABJXCgpDcmVhdGVSb29tEiIucGIuc2VydmVycnBjLnYxLkNyZWF0ZVJvb21SZXF1ZXN0GiMucGIuc2VydmVycnBjLnYxLkNyZWF0ZVJvb21SZXNwb25zZSIAElcKCkRlbGV0ZVJvb20SIi5wYi5zZXJ2ZXJycGMudjEuRGVsZXRlUm9vbVJlcXVlc3QaIy5wYi5zZXJ2ZXJycGMudjEuRGVsZXRlUm9vbVJlc3BvbnNlIgASYAoNU2V0Um9vbUxpbWl0cxIlLnBiLnNlcnZlcnJwYy52MS5TZXRSb29tTGltaXRzUmVxdWVzdBomLnBiLnNlcnZlcnJwYy52MS5TZXRSb29tTGltaXRzUmVzcG9uc2UiABJvChJTZXRSb29tRGlyQ2FjaGVUdGwSKi5wYi5zZXJ2ZXJycGMudjEuU2V0Um9vbURpckNhY2hlVHRsUmVxdWVzdBorLnBiLnNlcnZlcnJwYy52MS5TZXRSb29tRGlyQ2FjaGVUdGxSZXNwb25zZSIAEmYKD1NldFJvb21NZXRhZGF0YRInLnBiLnNlcnZlcnJwYy52MS5TZXRSb29tTWV0YWRhdGFSZXF1ZXN0GigucGIuc2VydmVycnBjLnYxLlNldFJvb21NZXRhZGF0YVJlc3BvbnNlIgASWgoLU2V0Um9vbU1vdGQSIy5wYi5zZXJ2ZXJycGMudjEuU2V0Um9vbU1vdGRSZXF1ZXN0GiQucGIuc2VydmVycnBjLnYxLlNldFJvb21Nb3RkUmVzcG9uc2UiABJUCglDbG9zZVJvb20SIS5wYi5zZXJ2ZXJycGMudjEuQ2xvc2VSb29tUmVxdWVzdBoiLnBiLnNlcnZlcnJwYy52MS5DbG9zZVJvb21SZXNwb25zZSIAElEKCEtpY2tVc2VyEiAucGIuc2VydmVycnBjLnYxLktpY2tVc2VyUmVxdWVzdBohLnBiLnNlcnZlcnJwYy52MS5LaWNrVXNlclJlc3BvbnNlIgASaQoQQnJvYWRjYXN0TWVzc2FnZRIoLnBiLnNlcnZlcnJwYy52MS5Ccm9hZGNhc3RNZXNzYWdlUmVxdWVzdBopLnBiLnNlcnZlcnJwYy52MS5Ccm9hZGNhc3RNZXNzYWdlUmVzcG9uc2UiABJgCg1DcmVhdGVBY2NvdW50EiUucGIuc2VydmVycnBjLnYxLkNyZWF0ZUFjY291bnRSZXF1ZXN0GiYucGIuc2VydmVycnBjLnYxLkNyZWF0ZUFjY291bnRSZXNwb25zZSIAEmAKDURlbGV0ZUFjY291bnQSJS5wYi5zZXJ2ZXJycGMudjEuRGVsZXRlQWNjb3VudFJlcXVlc3QaJi5wYi5zZXJ2ZXJycGMudjEuRGVsZXRlQWNjb3VudFJlc3BvbnNlIgASeAoVVXBkYXRlQWNjb3VudFBhc3N3b3JkEi0ucGIuc2VydmVycnBjLnYxLlVwZGF0ZUFjY291bnRQYXNzd29yZFJlcXVlc3QaLi5wYi5zZXJ2ZXJycGMudjEuVXBkYXRlQWNjb3VudFBhc3N3b3JkUmVzcG9uc2UiABJmCg9TZXRBY2NvdW50R3Vlc3QSJy5wYi5zZXJ2ZXJycGMudjEuU2V0QWNjb3VudEd1ZXN0UmVxdWVzdBooLnBiLnNlcnZlcnJwYy52MS5TZXRBY2NvdW50R3Vlc3RSZXNwb25zZSIAEmkKEENyZWF0ZUludml0ZUNvZGUSKC5wYi5zZXJ2ZXJycGMudjEuQ3JlYXRlSW52aXRlQ29kZVJlcXVlc3QaKS5wYi5zZXJ2ZXJycGMudjEuQ3JlYXRlSW52aXRlQ29kZVJlc3BvbnNlIgASYwoOR2V0SW52aXRlQ29kZXMSJi5wYi5zZXJ2ZXJycGMudjEuR2V0SW52aXRlQ29kZXNSZXF1ZXN0GicucGIuc2VydmVycnBjLnYxLkdldEludml0ZUNvZGVzUmVzcG9uc2UiABJpChBEZWxldGVJbnZpdGVDb2RlEigucGIuc2VydmVycnBjLnYxLkRlbGV0ZUludml0ZUNvZGVSZXF1ZXN0GikucGIuc2VydmVycnBjLnYxLkRlbGV0ZUludml0ZUNvZGVSZXNwb25zZSIAEm8KEkNyZWF0ZUludml0ZUJ1bmRsZRIqLnBiLnNlcnZlcnJwYy52MS5DcmVhdGVJbnZpdGVCdW5kbGVSZXF1ZXN0GisucGIuc2VydmVycnBjLnYxLkNyZWF0ZUludml0ZUJ1bmRsZVJlc3BvbnNlIgASWgoLTGlzdFN0cmVhbXMSIy5wYi5zZXJ2ZXJycGMudjEuTGlzdFN0cmVhbXNSZXF1ZXN0GiQucGIuc2VydmVycnBjLnYxLkxpc3RTdHJlYW1zUmVzcG9uc2UiABJdCgxDYW5jZWxTdHJlYW0SJC5wYi5zZXJ2ZXJycGMudjEuQ2FuY2VsU3RyZWFtUmVxdWVzdBolLnBiLnNlcnZlcnJwYy52MS5DYW5jZWxTdHJlYW1SZXNwb25zZSIAEm8KEkdldE1pZ3JhdGlvblN0YXR1cxIqLnBiLnNlcnZlcnJwYy52MS5HZXRNaWdyYXRpb25TdGF0dXNSZXF1ZXN0GisucGIuc2VydmVycnBjLnYxLkdldE1pZ3JhdGlvblN0YXR1c1Jlc3BvbnNlIgASYwoOQmFja3VwRGF0YWJhc2USJi5wYi5zZXJ2ZXJycGMudjEuQmFja3VwRGF0YWJhc2VSZXF1ZXN0GicucGIuc2VydmVycnBjLnYxLkJhY2t1cERhdGFiYXNlUmVzcG9uc2UiABJ7ChZDaGVja0RhdGFiYXNlSW50ZWdyaXR5Ei4ucGIuc2VydmVycnBjLnYxLkNoZWNrRGF0YWJhc2VJbnRlZ3JpdHlSZXF1ZXN0Gi8ucGIuc2VydmVycnBjLnYxLkNoZWNrRGF0YWJhc2VJbnRlZ3JpdHlSZXNwb25zZSIAEmMKDkdldFJlbGF5TGltaXRzEiYucGIuc2VydmVycnBjLnYxLkdldFJlbGF5TGltaXRzUmVxdWVzdBonLnBiLnNlcnZlcnJwYy52MS5HZXRSZWxheUxpbWl0c1Jlc3BvbnNlIgASYwoOU2V0UmVsYXlMaW1pdHMSJi5wYi5zZXJ2ZXJycGMudjEuU2V0UmVsYXlMaW1pdHNSZXF1ZXN0GicucGIuc2VydmVycnBjLnYxLlNldFJlbGF5TGltaXRzUmVzcG9uc2UiABJpChBHZXRMb2JieVNldHRpbmdzEigucGIuc2VydmVycnBjLnYxLkdldExvYmJ5U2V0dGluZ3NSZXF1ZXN0GikucGIuc2VydmVycnBjLnYxLkdldExvYmJ5U2V0dGluZ3NSZXNwb25zZSIAEnIKE1VwZGF0ZUxvYmJ5U2V0dGluZ3MSKy5wYi5zZXJ2ZXJycGMudjEuVXBkYXRlTG9iYnlTZXR0aW5nc1JlcXVlc3QaLC5wYi5zZXJ2ZXJycGMudjEuVXBkYXRlTG9iYnlTZXR0aW5nc1Jlc3BvbnNlIgASYAoNR2V0TG9iYnlTdGF0cxIlLnBiLnNlcnZlcnJwYy52MS5HZXRMb2JieVN0YXRzUmVxdWVzdBomLnBiLnNlcnZlcnJwYy52MS5HZXRMb2JieVN0YXRzUmVzcG9uc2UiABJdCgxHZXRSb29tU3RhdHMSJC5wYi5zZXJ2ZXJycGMudjEuR2V0Um9vbVN0YXRzUmVxdWVzdBolLnBiLnNlcnZlcnJwYy52MS5HZXRSb29tU3RhdHNSZXNwb25zZSIAEkoKBURyYWluEh0ucGIuc2VydmVycnBjLnYxLkRyYWluUmVxdWVzdBoeLnBiLnNlcnZlcnJwYy52MS5EcmFpblJlc3BvbnNlIgAwARJjCg5WYWxpZGF0ZUNvbmZpZxImLnBiLnNlcnZlcnJwYy52MS5WYWxpZGF0ZUNvbmZpZ1JlcXVlc3QaJy5wYi5zZXJ2ZXJycGMudjEuVmFsaWRhdGVDb25maWdSZXNwb25zZSIAElcKCkV4cG9ydFJvb20SIi5wYi5zZXJ2ZXJycGMudjEuRXhwb3J0Um9vbVJlcXVlc3QaIy5wYi5zZXJ2ZXJycGMudjEuRXhwb3J0Um9vbVJlc3BvbnNlIgASVwoKSW1wb3J0Um9vbRIiLnBiLnNlcnZlcnJwYy52MS5JbXBvcnRSb29tUmVxdWVzdBojLnBiLnNlcnZlcnJwYy52MS5JbXBvcnRSb29tUmVzcG9uc2UiAEIiWiBmcmllbmRuZXQub3JnL3Byb3RvY29sL3NlcnZlcnJwY2IGcHJvdG8z");
 
 /**
  * RoomInfo is information about a room.
@@ -31,6 +31,56 @@ export type RoomInfo = Message<"pb.serverrpc.v1.RoomInfo"> & {
    * @generated from field: uint32 online_user_count = 2;
    */
   onlineUserCount: number;
+
+  /**
+   * The maximum number of online users allowed in the room, or 0 if unlimited.
+   *
+   * @generated from field: uint32 max_clients = 3;
+   */
+  maxClients: number;
+
+  /**
+   * The maximum number of concurrent proxied streams each client in the room can open, or 0 if unlimited.
+   *
+   * @generated from field: uint32 max_proxy_streams_per_client = 4;
+   */
+  maxProxyStreamsPerClient: number;
+
+  /**
+   * How long directory listings proxied in the room are cached, in milliseconds, or 0 if caching is disabled.
+   *
+   * @generated from field: uint32 dir_cache_ttl_ms = 5;
+   */
+  dirCacheTtlMs: number;
+
+  /**
+   * When the room was created, as a UNIX timestamp in seconds.
+   *
+   * @generated from field: int64 created_ts = 6;
+   */
+  createdTs: bigint;
+
+  /**
+   * A description of the room, shown to people looking for rooms to join.
+   *
+   * @generated from field: string description = 7;
+   */
+  description: string;
+
+  /**
+   * Whether the room is included in GetRooms results by default.
+   *
+   * @generated from field: bool listed = 8;
+   */
+  listed: boolean;
+
+  /**
+   * The message of the day sent to clients when they join the room.
+   * Empty if the room has none.
+   *
+   * @generated from field: string motd = 9;
+   */
+  motd: string;
 };
 
 /**
@@ -52,6 +102,27 @@ export type OnlineUserInfo = Message<"pb.serverrpc.v1.OnlineUserInfo"> & {
    * @generated from field: string username = 1;
    */
   username: string;
+
+  /**
+   * Round-trip time statistics for pings sent to the user.
+   *
+   * @generated from field: pb.serverrpc.v1.RttStats rtt = 2;
+   */
+  rtt?: RttStats;
+
+  /**
+   * The total number of bytes relayed through the server for proxied streams the user opened.
+   *
+   * @generated from field: int64 relayed_bytes = 3;
+   */
+  relayedBytes: bigint;
+
+  /**
+   * The recent rate of bytes relayed through the server for proxied streams the user opened, in bytes per second.
+   *
+   * @generated from field: int64 relay_bytes_per_second = 4;
+   */
+  relayBytesPerSecond: bigint;
 };
 
 /**
@@ -61,6 +132,205 @@ export type OnlineUserInfo = Message<"pb.serverrpc.v1.OnlineUserInfo"> & {
 export const OnlineUserInfoSchema: GenMessage<OnlineUserInfo> = /*@__PURE__*/
   messageDesc(file_pb_serverrpc_v1_rpc, 1);
 
+/**
+ * RttStats is round-trip time statistics for pings sent over a connection.
+ *
+ * @generated from message pb.serverrpc.v1.RttStats
+ */
+export type RttStats = Message<"pb.serverrpc.v1.RttStats"> & {
+  /**
+   * The most recent round-trip time, in microseconds.
+   * 0 if no ping has succeeded yet.
+   *
+   * @generated from field: int64 last_us = 1;
+   */
+  lastUs: bigint;
+
+  /**
+   * The minimum round-trip time over recent pings, in microseconds.
+   *
+   * @generated from field: int64 min_us = 2;
+   */
+  minUs: bigint;
+
+  /**
+   * The average round-trip time over recent pings, in microseconds.
+   *
+   * @generated from field: int64 avg_us = 3;
+   */
+  avgUs: bigint;
+
+  /**
+   * The maximum round-trip time over recent pings, in microseconds.
+   *
+   * @generated from field: int64 max_us = 4;
+   */
+  maxUs: bigint;
+
+  /**
+   * The number of recent pings the minimum, average and maximum were computed from.
+   *
+   * @generated from field: uint32 samples = 5;
+   */
+  samples: number;
+
+  /**
+   * The total number of pings that failed.
+   *
+   * @generated from field: uint64 lost = 6;
+   */
+  lost: bigint;
+
+  /**
+   * The number of pings that failed in a row since the last successful one.
+   *
+   * @generated from field: uint32 consecutive_lost = 7;
+   */
+  consecutiveLost: number;
+
+  /**
+   * The estimated offset of the other side's clock from the local clock, in microseconds.
+   * Positive if the other side's clock is ahead.
+   * Only set if the other side reported when it received and answered pings.
+   *
+   * @generated from field: optional int64 clock_offset_us = 8;
+   */
+  clockOffsetUs?: bigint;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.RttStats.
+ * Use `create(RttStatsSchema)` to create a new message.
+ */
+export const RttStatsSchema: GenMessage<RttStats> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 2);
+
+/**
+ * InviteCodeInfo is information about an unused invite code.
+ *
+ * @generated from message pb.serverrpc.v1.InviteCodeInfo
+ */
+export type InviteCodeInfo = Message<"pb.serverrpc.v1.InviteCodeInfo"> & {
+  /**
+   * The invite code.
+   *
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * The UNIX timestamp, in seconds, when the invite code was created.
+   *
+   * @generated from field: int64 created_ts = 2;
+   */
+  createdTs: bigint;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.InviteCodeInfo.
+ * Use `create(InviteCodeInfoSchema)` to create a new message.
+ */
+export const InviteCodeInfoSchema: GenMessage<InviteCodeInfo> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 3);
+
+/**
+ * StreamInfo is information about an open proxied stream between two clients.
+ *
+ * @generated from message pb.serverrpc.v1.StreamInfo
+ */
+export type StreamInfo = Message<"pb.serverrpc.v1.StreamInfo"> & {
+  /**
+   * The stream's ID, unique within its room.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The room the stream is in.
+   *
+   * @generated from field: string room = 2;
+   */
+  room: string;
+
+  /**
+   * The username of the client that opened the stream.
+   *
+   * @generated from field: string origin_username = 3;
+   */
+  originUsername: string;
+
+  /**
+   * The username of the client the stream is connected to.
+   *
+   * @generated from field: string target_username = 4;
+   */
+  targetUsername: string;
+
+  /**
+   * The number of bytes sent from the origin to the target so far.
+   *
+   * @generated from field: int64 bytes_to_target = 5;
+   */
+  bytesToTarget: bigint;
+
+  /**
+   * The number of bytes sent from the target to the origin so far.
+   *
+   * @generated from field: int64 bytes_to_origin = 6;
+   */
+  bytesToOrigin: bigint;
+
+  /**
+   * The UNIX timestamp, in seconds, when the stream was opened.
+   *
+   * @generated from field: int64 created_ts = 7;
+   */
+  createdTs: bigint;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.StreamInfo.
+ * Use `create(StreamInfoSchema)` to create a new message.
+ */
+export const StreamInfoSchema: GenMessage<StreamInfo> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 4);
+
+/**
+ * RoomStat is a snapshot of a room's usage.
+ *
+ * @generated from message pb.serverrpc.v1.RoomStat
+ */
+export type RoomStat = Message<"pb.serverrpc.v1.RoomStat"> & {
+  /**
+   * The UNIX timestamp, in seconds, when the snapshot was taken.
+   *
+   * @generated from field: int64 ts = 1;
+   */
+  ts: bigint;
+
+  /**
+   * The number of clients that were online in the room.
+   *
+   * @generated from field: uint32 online_clients = 2;
+   */
+  onlineClients: number;
+
+  /**
+   * The number of bytes relayed through the server for proxied streams in the room since the previous snapshot.
+   *
+   * @generated from field: int64 relayed_bytes = 3;
+   */
+  relayedBytes: bigint;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.RoomStat.
+ * Use `create(RoomStatSchema)` to create a new message.
+ */
+export const RoomStatSchema: GenMessage<RoomStat> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 5);
+
 /**
  * AccountInfo is information about an account.
  *
@@ -73,6 +343,13 @@ export type AccountInfo = Message<"pb.serverrpc.v1.AccountInfo"> & {
    * @generated from field: string username = 1;
    */
   username: string;
+
+  /**
+   * Whether the account is a guest account.
+   *
+   * @generated from field: bool is_guest = 2;
+   */
+  isGuest: boolean;
 };
 
 /**
@@ -80,7 +357,7 @@ export type AccountInfo = Message<"pb.serverrpc.v1.AccountInfo"> & {
  * Use `create(AccountInfoSchema)` to create a new message.
  */
 export const AccountInfoSchema: GenMessage<AccountInfo> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 2);
+  messageDesc(file_pb_serverrpc_v1_rpc, 6);
 
 /**
  * @generated from message pb.serverrpc.v1.GetServerInfoRequest
@@ -93,7 +370,7 @@ export type GetServerInfoRequest = Message<"pb.serverrpc.v1.GetServerInfoRequest
  * Use `create(GetServerInfoRequestSchema)` to create a new message.
  */
 export const GetServerInfoRequestSchema: GenMessage<GetServerInfoRequest> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 3);
+  messageDesc(file_pb_serverrpc_v1_rpc, 7);
 
 /**
  * @generated from message pb.serverrpc.v1.GetServerInfoResponse
@@ -112,6 +389,57 @@ export type GetServerInfoResponse = Message<"pb.serverrpc.v1.GetServerInfoRespon
    * @generated from field: pb.serverrpc.v1.GetServerInfoResponse.Rpc rpc = 2;
    */
   rpc?: GetServerInfoResponse_Rpc;
+
+  /**
+   * Whether the server is draining.
+   * See Drain.
+   *
+   * @generated from field: bool draining = 3;
+   */
+  draining: boolean;
+
+  /**
+   * The protocol version the server speaks, such as "1.0.1".
+   *
+   * @generated from field: string protocol_version = 4;
+   */
+  protocolVersion: string;
+
+  /**
+   * The commit the server was built from, or empty if unknown.
+   * Ends with "-dirty" if the build had uncommitted changes.
+   *
+   * @generated from field: string build_commit = 5;
+   */
+  buildCommit: string;
+
+  /**
+   * The UNIX timestamp when the server started.
+   *
+   * @generated from field: int64 start_ts = 6;
+   */
+  startTs: bigint;
+
+  /**
+   * How long the server has been running, in seconds.
+   *
+   * @generated from field: int64 uptime_seconds = 7;
+   */
+  uptimeSeconds: bigint;
+
+  /**
+   * The optional features that are enabled on the server.
+   * Unknown features must be ignored.
+   *
+   * Possible values:
+   *  - "registration": Clients can register their own accounts.
+   *  - "invite_codes": Registering requires an invite code.
+   *  - "auth_rate_limit": Failed authentication attempts are rate-limited.
+   *  - "admin_ui": The admin web UI is served on the RPC interface being accessed.
+   *
+   * @generated from field: repeated string features = 8;
+   */
+  features: string[];
 };
 
 /**
@@ -119,14 +447,14 @@ export type GetServerInfoResponse = Message<"pb.serverrpc.v1.GetServerInfoRespon
  * Use `create(GetServerInfoResponseSchema)` to create a new message.
  */
 export const GetServerInfoResponseSchema: GenMessage<GetServerInfoResponse> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 4);
+  messageDesc(file_pb_serverrpc_v1_rpc, 8);
 
 /**
  * @generated from message pb.serverrpc.v1.GetServerInfoResponse.Rpc
  */
 export type GetServerInfoResponse_Rpc = Message<"pb.serverrpc.v1.GetServerInfoResponse.Rpc"> & {
   /**
-   * A list of all allowed methods on the RPC interface.
+   * A list of all allowed methods on the RPC interface, including the ones allowed by its role.
    * If all permissions are allowed, it will contain a single "*".
    *
    * @generated from field: repeated string allowed_methods = 1;
@@ -139,6 +467,13 @@ export type GetServerInfoResponse_Rpc = Message<"pb.serverrpc.v1.GetServerInfoRe
    * @generated from field: bool requires_bearer_token = 2;
    */
   requiresBearerToken: boolean;
+
+  /**
+   * The role of the RPC interface, such as "viewer", "operator" or "admin", or empty if it has none.
+   *
+   * @generated from field: string role = 3;
+   */
+  role: string;
 };
 
 /**
@@ -146,12 +481,18 @@ export type GetServerInfoResponse_Rpc = Message<"pb.serverrpc.v1.GetServerInfoRe
  * Use `create(GetServerInfoResponse_RpcSchema)` to create a new message.
  */
 export const GetServerInfoResponse_RpcSchema: GenMessage<GetServerInfoResponse_Rpc> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 4, 0);
+  messageDesc(file_pb_serverrpc_v1_rpc, 8, 0);
 
 /**
  * @generated from message pb.serverrpc.v1.GetRoomsRequest
  */
 export type GetRoomsRequest = Message<"pb.serverrpc.v1.GetRoomsRequest"> & {
+  /**
+   * Whether to include unlisted rooms.
+   *
+   * @generated from field: bool include_unlisted = 1;
+   */
+  includeUnlisted: boolean;
 };
 
 /**
@@ -159,7 +500,7 @@ export type GetRoomsRequest = Message<"pb.serverrpc.v1.GetRoomsRequest"> & {
  * Use `create(GetRoomsRequestSchema)` to create a new message.
  */
 export const GetRoomsRequestSchema: GenMessage<GetRoomsRequest> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 5);
+  messageDesc(file_pb_serverrpc_v1_rpc, 9);
 
 /**
  * @generated from message pb.serverrpc.v1.GetRoomsResponse
@@ -178,7 +519,7 @@ export type GetRoomsResponse = Message<"pb.serverrpc.v1.GetRoomsResponse"> & {
  * Use `create(GetRoomsResponseSchema)` to create a new message.
  */
 export const GetRoomsResponseSchema: GenMessage<GetRoomsResponse> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 6);
+  messageDesc(file_pb_serverrpc_v1_rpc, 10);
 
 /**
  * @generated from message pb.serverrpc.v1.GetRoomInfoRequest
@@ -197,7 +538,7 @@ export type GetRoomInfoRequest = Message<"pb.serverrpc.v1.GetRoomInfoRequest"> &
  * Use `create(GetRoomInfoRequestSchema)` to create a new message.
  */
 export const GetRoomInfoRequestSchema: GenMessage<GetRoomInfoRequest> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 7);
+  messageDesc(file_pb_serverrpc_v1_rpc, 11);
 
 /**
  * @generated from message pb.serverrpc.v1.GetRoomInfoResponse
@@ -216,7 +557,7 @@ export type GetRoomInfoResponse = Message<"pb.serverrpc.v1.GetRoomInfoResponse">
  * Use `create(GetRoomInfoResponseSchema)` to create a new message.
  */
 export const GetRoomInfoResponseSchema: GenMessage<GetRoomInfoResponse> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 8);
+  messageDesc(file_pb_serverrpc_v1_rpc, 12);
 
 /**
  * @generated from message pb.serverrpc.v1.GetOnlineUsersRequest
@@ -228,6 +569,15 @@ export type GetOnlineUsersRequest = Message<"pb.serverrpc.v1.GetOnlineUsersReque
    * @generated from field: string room = 1;
    */
   room: string;
+
+  /**
+   * The maximum number of users to send per response message.
+   * If 0, the default page size is used.
+   * Values above the maximum page size are clamped to it.
+   *
+   * @generated from field: uint32 page_size = 2;
+   */
+  pageSize: number;
 };
 
 /**
@@ -235,7 +585,7 @@ export type GetOnlineUsersRequest = Message<"pb.serverrpc.v1.GetOnlineUsersReque
  * Use `create(GetOnlineUsersRequestSchema)` to create a new message.
  */
 export const GetOnlineUsersRequestSchema: GenMessage<GetOnlineUsersRequest> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 9);
+  messageDesc(file_pb_serverrpc_v1_rpc, 13);
 
 /**
  * @generated from message pb.serverrpc.v1.GetOnlineUsersResponse
@@ -252,7 +602,7 @@ export type GetOnlineUsersResponse = Message<"pb.serverrpc.v1.GetOnlineUsersResp
  * Use `create(GetOnlineUsersResponseSchema)` to create a new message.
  */
 export const GetOnlineUsersResponseSchema: GenMessage<GetOnlineUsersResponse> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 10);
+  messageDesc(file_pb_serverrpc_v1_rpc, 14);
 
 /**
  * @generated from message pb.serverrpc.v1.GetOnlineUserInfoRequest
@@ -278,7 +628,7 @@ export type GetOnlineUserInfoRequest = Message<"pb.serverrpc.v1.GetOnlineUserInf
  * Use `create(GetOnlineUserInfoRequestSchema)` to create a new message.
  */
 export const GetOnlineUserInfoRequestSchema: GenMessage<GetOnlineUserInfoRequest> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 11);
+  messageDesc(file_pb_serverrpc_v1_rpc, 15);
 
 /**
  * @generated from message pb.serverrpc.v1.GetOnlineUserInfoResponse
@@ -297,7 +647,7 @@ export type GetOnlineUserInfoResponse = Message<"pb.serverrpc.v1.GetOnlineUserIn
  * Use `create(GetOnlineUserInfoResponseSchema)` to create a new message.
  */
 export const GetOnlineUserInfoResponseSchema: GenMessage<GetOnlineUserInfoResponse> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 12);
+  messageDesc(file_pb_serverrpc_v1_rpc, 16);
 
 /**
  * @generated from message pb.serverrpc.v1.GetAccountsRequest
@@ -309,6 +659,22 @@ export type GetAccountsRequest = Message<"pb.serverrpc.v1.GetAccountsRequest"> &
    * @generated from field: string room = 1;
    */
   room: string;
+
+  /**
+   * The maximum number of accounts to return.
+   * If 0, defaults to 500. Values above 1000 are treated as 1000.
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit: number;
+
+  /**
+   * The cursor returned by a previous call, to get the next page.
+   * Empty to start from the beginning.
+   *
+   * @generated from field: string cursor = 3;
+   */
+  cursor: string;
 };
 
 /**
@@ -316,18 +682,32 @@ export type GetAccountsRequest = Message<"pb.serverrpc.v1.GetAccountsRequest"> &
  * Use `create(GetAccountsRequestSchema)` to create a new message.
  */
 export const GetAccountsRequestSchema: GenMessage<GetAccountsRequest> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 13);
+  messageDesc(file_pb_serverrpc_v1_rpc, 17);
 
 /**
  * @generated from message pb.serverrpc.v1.GetAccountsResponse
  */
 export type GetAccountsResponse = Message<"pb.serverrpc.v1.GetAccountsResponse"> & {
   /**
-   * All accounts in the room.
+   * A page of accounts in the room, ordered by username.
    *
    * @generated from field: repeated pb.serverrpc.v1.AccountInfo accounts = 1;
    */
   accounts: AccountInfo[];
+
+  /**
+   * The cursor to pass to get the next page, or empty if this is the last page.
+   *
+   * @generated from field: string next_cursor = 2;
+   */
+  nextCursor: string;
+
+  /**
+   * The total number of accounts in the room.
+   *
+   * @generated from field: uint32 total = 3;
+   */
+  total: number;
 };
 
 /**
@@ -335,7 +715,7 @@ export type GetAccountsResponse = Message<"pb.serverrpc.v1.GetAccountsResponse">
  * Use `create(GetAccountsResponseSchema)` to create a new message.
  */
 export const GetAccountsResponseSchema: GenMessage<GetAccountsResponse> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 14);
+  messageDesc(file_pb_serverrpc_v1_rpc, 18);
 
 /**
  * @generated from message pb.serverrpc.v1.CreateRoomRequest
@@ -347,6 +727,22 @@ export type CreateRoomRequest = Message<"pb.serverrpc.v1.CreateRoomRequest"> & {
    * @generated from field: string name = 1;
    */
   name: string;
+
+  /**
+   * A description of the room.
+   * At most 500 characters.
+   *
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * Whether the room should be included in GetRooms results by default.
+   * Defaults to true.
+   *
+   * @generated from field: optional bool listed = 3;
+   */
+  listed?: boolean;
 };
 
 /**
@@ -354,7 +750,7 @@ export type CreateRoomRequest = Message<"pb.serverrpc.v1.CreateRoomRequest"> & {
  * Use `create(CreateRoomRequestSchema)` to create a new message.
  */
 export const CreateRoomRequestSchema: GenMessage<CreateRoomRequest> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 15);
+  messageDesc(file_pb_serverrpc_v1_rpc, 19);
 
 /**
  * @generated from message pb.serverrpc.v1.CreateRoomResponse
@@ -373,7 +769,7 @@ export type CreateRoomResponse = Message<"pb.serverrpc.v1.CreateRoomResponse"> &
  * Use `create(CreateRoomResponseSchema)` to create a new message.
  */
 export const CreateRoomResponseSchema: GenMessage<CreateRoomResponse> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 16);
+  messageDesc(file_pb_serverrpc_v1_rpc, 20);
 
 /**
  * @generated from message pb.serverrpc.v1.DeleteRoomRequest
@@ -392,7 +788,7 @@ export type DeleteRoomRequest = Message<"pb.serverrpc.v1.DeleteRoomRequest"> & {
  * Use `create(DeleteRoomRequestSchema)` to create a new message.
  */
 export const DeleteRoomRequestSchema: GenMessage<DeleteRoomRequest> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 17);
+  messageDesc(file_pb_serverrpc_v1_rpc, 21);
 
 /**
  * @generated from message pb.serverrpc.v1.DeleteRoomResponse
@@ -405,157 +801,1645 @@ export type DeleteRoomResponse = Message<"pb.serverrpc.v1.DeleteRoomResponse"> &
  * Use `create(DeleteRoomResponseSchema)` to create a new message.
  */
 export const DeleteRoomResponseSchema: GenMessage<DeleteRoomResponse> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 18);
+  messageDesc(file_pb_serverrpc_v1_rpc, 22);
 
 /**
- * @generated from message pb.serverrpc.v1.CreateAccountRequest
+ * @generated from message pb.serverrpc.v1.SetRoomLimitsRequest
  */
-export type CreateAccountRequest = Message<"pb.serverrpc.v1.CreateAccountRequest"> & {
+export type SetRoomLimitsRequest = Message<"pb.serverrpc.v1.SetRoomLimitsRequest"> & {
   /**
    * The room's name.
    *
-   * @generated from field: string room = 1;
+   * @generated from field: string name = 1;
    */
-  room: string;
+  name: string;
+
+  /**
+   * The maximum number of online users allowed in the room, or 0 for unlimited.
+   *
+   * @generated from field: uint32 max_clients = 2;
+   */
+  maxClients: number;
+
+  /**
+   * The maximum number of concurrent proxied streams each client in the room can open, or 0 for unlimited.
+   *
+   * @generated from field: uint32 max_proxy_streams_per_client = 3;
+   */
+  maxProxyStreamsPerClient: number;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.SetRoomLimitsRequest.
+ * Use `create(SetRoomLimitsRequestSchema)` to create a new message.
+ */
+export const SetRoomLimitsRequestSchema: GenMessage<SetRoomLimitsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 23);
+
+/**
+ * @generated from message pb.serverrpc.v1.SetRoomLimitsResponse
+ */
+export type SetRoomLimitsResponse = Message<"pb.serverrpc.v1.SetRoomLimitsResponse"> & {
+  /**
+   * The updated room.
+   *
+   * @generated from field: pb.serverrpc.v1.RoomInfo room = 1;
+   */
+  room?: RoomInfo;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.SetRoomLimitsResponse.
+ * Use `create(SetRoomLimitsResponseSchema)` to create a new message.
+ */
+export const SetRoomLimitsResponseSchema: GenMessage<SetRoomLimitsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 24);
+
+/**
+ * @generated from message pb.serverrpc.v1.SetRoomDirCacheTtlRequest
+ */
+export type SetRoomDirCacheTtlRequest = Message<"pb.serverrpc.v1.SetRoomDirCacheTtlRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * How long directory listings proxied in the room are cached, in milliseconds, or 0 to disable caching.
+   *
+   * @generated from field: uint32 ttl_ms = 2;
+   */
+  ttlMs: number;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.SetRoomDirCacheTtlRequest.
+ * Use `create(SetRoomDirCacheTtlRequestSchema)` to create a new message.
+ */
+export const SetRoomDirCacheTtlRequestSchema: GenMessage<SetRoomDirCacheTtlRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 25);
+
+/**
+ * @generated from message pb.serverrpc.v1.SetRoomDirCacheTtlResponse
+ */
+export type SetRoomDirCacheTtlResponse = Message<"pb.serverrpc.v1.SetRoomDirCacheTtlResponse"> & {
+  /**
+   * The updated room.
+   *
+   * @generated from field: pb.serverrpc.v1.RoomInfo room = 1;
+   */
+  room?: RoomInfo;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.SetRoomDirCacheTtlResponse.
+ * Use `create(SetRoomDirCacheTtlResponseSchema)` to create a new message.
+ */
+export const SetRoomDirCacheTtlResponseSchema: GenMessage<SetRoomDirCacheTtlResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 26);
+
+/**
+ * @generated from message pb.serverrpc.v1.SetRoomMetadataRequest
+ */
+export type SetRoomMetadataRequest = Message<"pb.serverrpc.v1.SetRoomMetadataRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The room's new description.
+   * At most 500 characters.
+   *
+   * @generated from field: string description = 2;
+   */
+  description: string;
+
+  /**
+   * Whether the room should be included in GetRooms results by default.
+   *
+   * @generated from field: bool listed = 3;
+   */
+  listed: boolean;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.SetRoomMetadataRequest.
+ * Use `create(SetRoomMetadataRequestSchema)` to create a new message.
+ */
+export const SetRoomMetadataRequestSchema: GenMessage<SetRoomMetadataRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 27);
+
+/**
+ * @generated from message pb.serverrpc.v1.SetRoomMetadataResponse
+ */
+export type SetRoomMetadataResponse = Message<"pb.serverrpc.v1.SetRoomMetadataResponse"> & {
+  /**
+   * The updated room.
+   *
+   * @generated from field: pb.serverrpc.v1.RoomInfo room = 1;
+   */
+  room?: RoomInfo;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.SetRoomMetadataResponse.
+ * Use `create(SetRoomMetadataResponseSchema)` to create a new message.
+ */
+export const SetRoomMetadataResponseSchema: GenMessage<SetRoomMetadataResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 28);
+
+/**
+ * @generated from message pb.serverrpc.v1.SetRoomMotdRequest
+ */
+export type SetRoomMotdRequest = Message<"pb.serverrpc.v1.SetRoomMotdRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The room's new message of the day.
+   * At most 2000 characters.
+   * Empty to remove it.
+   *
+   * @generated from field: string motd = 2;
+   */
+  motd: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.SetRoomMotdRequest.
+ * Use `create(SetRoomMotdRequestSchema)` to create a new message.
+ */
+export const SetRoomMotdRequestSchema: GenMessage<SetRoomMotdRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 29);
+
+/**
+ * @generated from message pb.serverrpc.v1.SetRoomMotdResponse
+ */
+export type SetRoomMotdResponse = Message<"pb.serverrpc.v1.SetRoomMotdResponse"> & {
+  /**
+   * The updated room.
+   *
+   * @generated from field: pb.serverrpc.v1.RoomInfo room = 1;
+   */
+  room?: RoomInfo;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.SetRoomMotdResponse.
+ * Use `create(SetRoomMotdResponseSchema)` to create a new message.
+ */
+export const SetRoomMotdResponseSchema: GenMessage<SetRoomMotdResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 30);
+
+/**
+ * @generated from message pb.serverrpc.v1.CloseRoomRequest
+ */
+export type CloseRoomRequest = Message<"pb.serverrpc.v1.CloseRoomRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.CloseRoomRequest.
+ * Use `create(CloseRoomRequestSchema)` to create a new message.
+ */
+export const CloseRoomRequestSchema: GenMessage<CloseRoomRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 31);
+
+/**
+ * @generated from message pb.serverrpc.v1.CloseRoomResponse
+ */
+export type CloseRoomResponse = Message<"pb.serverrpc.v1.CloseRoomResponse"> & {
+  /**
+   * The number of users that were disconnected.
+   *
+   * @generated from field: uint32 disconnected_user_count = 1;
+   */
+  disconnectedUserCount: number;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.CloseRoomResponse.
+ * Use `create(CloseRoomResponseSchema)` to create a new message.
+ */
+export const CloseRoomResponseSchema: GenMessage<CloseRoomResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 32);
+
+/**
+ * @generated from message pb.serverrpc.v1.KickUserRequest
+ */
+export type KickUserRequest = Message<"pb.serverrpc.v1.KickUserRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string room = 1;
+   */
+  room: string;
+
+  /**
+   * The online user's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.KickUserRequest.
+ * Use `create(KickUserRequestSchema)` to create a new message.
+ */
+export const KickUserRequestSchema: GenMessage<KickUserRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 33);
+
+/**
+ * @generated from message pb.serverrpc.v1.KickUserResponse
+ */
+export type KickUserResponse = Message<"pb.serverrpc.v1.KickUserResponse"> & {
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.KickUserResponse.
+ * Use `create(KickUserResponseSchema)` to create a new message.
+ */
+export const KickUserResponseSchema: GenMessage<KickUserResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 34);
+
+/**
+ * @generated from message pb.serverrpc.v1.BroadcastMessageRequest
+ */
+export type BroadcastMessageRequest = Message<"pb.serverrpc.v1.BroadcastMessageRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string room = 1;
+   */
+  room: string;
+
+  /**
+   * The message's text.
+   *
+   * @generated from field: string text = 2;
+   */
+  text: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.BroadcastMessageRequest.
+ * Use `create(BroadcastMessageRequestSchema)` to create a new message.
+ */
+export const BroadcastMessageRequestSchema: GenMessage<BroadcastMessageRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 35);
+
+/**
+ * @generated from message pb.serverrpc.v1.BroadcastMessageResponse
+ */
+export type BroadcastMessageResponse = Message<"pb.serverrpc.v1.BroadcastMessageResponse"> & {
+  /**
+   * The number of online users the message was sent to.
+   *
+   * @generated from field: uint32 recipient_count = 1;
+   */
+  recipientCount: number;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.BroadcastMessageResponse.
+ * Use `create(BroadcastMessageResponseSchema)` to create a new message.
+ */
+export const BroadcastMessageResponseSchema: GenMessage<BroadcastMessageResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 36);
+
+/**
+ * @generated from message pb.serverrpc.v1.CreateAccountRequest
+ */
+export type CreateAccountRequest = Message<"pb.serverrpc.v1.CreateAccountRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string room = 1;
+   */
+  room: string;
+
+  /**
+   * The new account's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * The new account's password, or empty to generate one.
+   *
+   * @generated from field: string password = 3;
+   */
+  password: string;
+
+  /**
+   * Whether the new account is a guest account.
+   * Guests can browse and download, but cannot share files or change their password.
+   *
+   * @generated from field: bool is_guest = 4;
+   */
+  isGuest: boolean;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.CreateAccountRequest.
+ * Use `create(CreateAccountRequestSchema)` to create a new message.
+ */
+export const CreateAccountRequestSchema: GenMessage<CreateAccountRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 37);
+
+/**
+ * @generated from message pb.serverrpc.v1.CreateAccountResponse
+ */
+export type CreateAccountResponse = Message<"pb.serverrpc.v1.CreateAccountResponse"> & {
+  /**
+   * The newly created account.
+   *
+   * @generated from field: pb.serverrpc.v1.AccountInfo account = 1;
+   */
+  account?: AccountInfo;
+
+  /**
+   * The generated password, if applicable.
+   *
+   * @generated from field: optional string generated_password = 2;
+   */
+  generatedPassword?: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.CreateAccountResponse.
+ * Use `create(CreateAccountResponseSchema)` to create a new message.
+ */
+export const CreateAccountResponseSchema: GenMessage<CreateAccountResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 38);
+
+/**
+ * @generated from message pb.serverrpc.v1.DeleteAccountRequest
+ */
+export type DeleteAccountRequest = Message<"pb.serverrpc.v1.DeleteAccountRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string room = 1;
+   */
+  room: string;
+
+  /**
+   * The account's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.DeleteAccountRequest.
+ * Use `create(DeleteAccountRequestSchema)` to create a new message.
+ */
+export const DeleteAccountRequestSchema: GenMessage<DeleteAccountRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 39);
+
+/**
+ * @generated from message pb.serverrpc.v1.DeleteAccountResponse
+ */
+export type DeleteAccountResponse = Message<"pb.serverrpc.v1.DeleteAccountResponse"> & {
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.DeleteAccountResponse.
+ * Use `create(DeleteAccountResponseSchema)` to create a new message.
+ */
+export const DeleteAccountResponseSchema: GenMessage<DeleteAccountResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 40);
+
+/**
+ * @generated from message pb.serverrpc.v1.UpdateAccountPasswordRequest
+ */
+export type UpdateAccountPasswordRequest = Message<"pb.serverrpc.v1.UpdateAccountPasswordRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string room = 1;
+   */
+  room: string;
+
+  /**
+   * The account's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * The account's new password, or empty to generate one.
+   *
+   * @generated from field: string password = 3;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.UpdateAccountPasswordRequest.
+ * Use `create(UpdateAccountPasswordRequestSchema)` to create a new message.
+ */
+export const UpdateAccountPasswordRequestSchema: GenMessage<UpdateAccountPasswordRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 41);
+
+/**
+ * @generated from message pb.serverrpc.v1.UpdateAccountPasswordResponse
+ */
+export type UpdateAccountPasswordResponse = Message<"pb.serverrpc.v1.UpdateAccountPasswordResponse"> & {
+  /**
+   * The generated password, if applicable.
+   *
+   * @generated from field: optional string generated_password = 1;
+   */
+  generatedPassword?: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.UpdateAccountPasswordResponse.
+ * Use `create(UpdateAccountPasswordResponseSchema)` to create a new message.
+ */
+export const UpdateAccountPasswordResponseSchema: GenMessage<UpdateAccountPasswordResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 42);
+
+/**
+ * @generated from message pb.serverrpc.v1.CreateInviteCodeRequest
+ */
+export type CreateInviteCodeRequest = Message<"pb.serverrpc.v1.CreateInviteCodeRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string room = 1;
+   */
+  room: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.CreateInviteCodeRequest.
+ * Use `create(CreateInviteCodeRequestSchema)` to create a new message.
+ */
+export const CreateInviteCodeRequestSchema: GenMessage<CreateInviteCodeRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 43);
+
+/**
+ * @generated from message pb.serverrpc.v1.CreateInviteCodeResponse
+ */
+export type CreateInviteCodeResponse = Message<"pb.serverrpc.v1.CreateInviteCodeResponse"> & {
+  /**
+   * The newly created invite code.
+   *
+   * @generated from field: pb.serverrpc.v1.InviteCodeInfo invite_code = 1;
+   */
+  inviteCode?: InviteCodeInfo;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.CreateInviteCodeResponse.
+ * Use `create(CreateInviteCodeResponseSchema)` to create a new message.
+ */
+export const CreateInviteCodeResponseSchema: GenMessage<CreateInviteCodeResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 44);
+
+/**
+ * @generated from message pb.serverrpc.v1.GetInviteCodesRequest
+ */
+export type GetInviteCodesRequest = Message<"pb.serverrpc.v1.GetInviteCodesRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string room = 1;
+   */
+  room: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.GetInviteCodesRequest.
+ * Use `create(GetInviteCodesRequestSchema)` to create a new message.
+ */
+export const GetInviteCodesRequestSchema: GenMessage<GetInviteCodesRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 45);
+
+/**
+ * @generated from message pb.serverrpc.v1.GetInviteCodesResponse
+ */
+export type GetInviteCodesResponse = Message<"pb.serverrpc.v1.GetInviteCodesResponse"> & {
+  /**
+   * The room's unused invite codes.
+   *
+   * @generated from field: repeated pb.serverrpc.v1.InviteCodeInfo invite_codes = 1;
+   */
+  inviteCodes: InviteCodeInfo[];
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.GetInviteCodesResponse.
+ * Use `create(GetInviteCodesResponseSchema)` to create a new message.
+ */
+export const GetInviteCodesResponseSchema: GenMessage<GetInviteCodesResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 46);
+
+/**
+ * @generated from message pb.serverrpc.v1.DeleteInviteCodeRequest
+ */
+export type DeleteInviteCodeRequest = Message<"pb.serverrpc.v1.DeleteInviteCodeRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string room = 1;
+   */
+  room: string;
+
+  /**
+   * The invite code to delete.
+   *
+   * @generated from field: string code = 2;
+   */
+  code: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.DeleteInviteCodeRequest.
+ * Use `create(DeleteInviteCodeRequestSchema)` to create a new message.
+ */
+export const DeleteInviteCodeRequestSchema: GenMessage<DeleteInviteCodeRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 47);
+
+/**
+ * @generated from message pb.serverrpc.v1.DeleteInviteCodeResponse
+ */
+export type DeleteInviteCodeResponse = Message<"pb.serverrpc.v1.DeleteInviteCodeResponse"> & {
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.DeleteInviteCodeResponse.
+ * Use `create(DeleteInviteCodeResponseSchema)` to create a new message.
+ */
+export const DeleteInviteCodeResponseSchema: GenMessage<DeleteInviteCodeResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 48);
+
+/**
+ * @generated from message pb.serverrpc.v1.CreateInviteBundleRequest
+ */
+export type CreateInviteBundleRequest = Message<"pb.serverrpc.v1.CreateInviteBundleRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string room = 1;
+   */
+  room: string;
+
+  /**
+   * The address clients should use to reach the server, like "example.com" or "example.com:20038".
+   *
+   * @generated from field: string address = 2;
+   */
+  address: string;
+
+  /**
+   * Whether to create a new invite code and include it in the bundle, so the recipient can register an account.
+   *
+   * @generated from field: bool create_invite_code = 3;
+   */
+  createInviteCode: boolean;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.CreateInviteBundleRequest.
+ * Use `create(CreateInviteBundleRequestSchema)` to create a new message.
+ */
+export const CreateInviteBundleRequestSchema: GenMessage<CreateInviteBundleRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 49);
+
+/**
+ * @generated from message pb.serverrpc.v1.CreateInviteBundleResponse
+ */
+export type CreateInviteBundleResponse = Message<"pb.serverrpc.v1.CreateInviteBundleResponse"> & {
+  /**
+   * The invite bundle URL, starting with friendnet://invite.
+   *
+   * @generated from field: string url = 1;
+   */
+  url: string;
+
+  /**
+   * The invite code included in the bundle, if one was created.
+   *
+   * @generated from field: optional pb.serverrpc.v1.InviteCodeInfo invite_code = 2;
+   */
+  inviteCode?: InviteCodeInfo;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.CreateInviteBundleResponse.
+ * Use `create(CreateInviteBundleResponseSchema)` to create a new message.
+ */
+export const CreateInviteBundleResponseSchema: GenMessage<CreateInviteBundleResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 50);
+
+/**
+ * @generated from message pb.serverrpc.v1.SetAccountGuestRequest
+ */
+export type SetAccountGuestRequest = Message<"pb.serverrpc.v1.SetAccountGuestRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string room = 1;
+   */
+  room: string;
+
+  /**
+   * The account's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * Whether the account should be a guest account.
+   *
+   * @generated from field: bool is_guest = 3;
+   */
+  isGuest: boolean;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.SetAccountGuestRequest.
+ * Use `create(SetAccountGuestRequestSchema)` to create a new message.
+ */
+export const SetAccountGuestRequestSchema: GenMessage<SetAccountGuestRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 51);
+
+/**
+ * @generated from message pb.serverrpc.v1.SetAccountGuestResponse
+ */
+export type SetAccountGuestResponse = Message<"pb.serverrpc.v1.SetAccountGuestResponse"> & {
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.SetAccountGuestResponse.
+ * Use `create(SetAccountGuestResponseSchema)` to create a new message.
+ */
+export const SetAccountGuestResponseSchema: GenMessage<SetAccountGuestResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 52);
+
+/**
+ * @generated from message pb.serverrpc.v1.ListStreamsRequest
+ */
+export type ListStreamsRequest = Message<"pb.serverrpc.v1.ListStreamsRequest"> & {
+  /**
+   * The room's name, or empty to list streams in all rooms.
+   *
+   * @generated from field: string room = 1;
+   */
+  room: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.ListStreamsRequest.
+ * Use `create(ListStreamsRequestSchema)` to create a new message.
+ */
+export const ListStreamsRequestSchema: GenMessage<ListStreamsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 53);
+
+/**
+ * @generated from message pb.serverrpc.v1.ListStreamsResponse
+ */
+export type ListStreamsResponse = Message<"pb.serverrpc.v1.ListStreamsResponse"> & {
+  /**
+   * The open streams.
+   *
+   * @generated from field: repeated pb.serverrpc.v1.StreamInfo streams = 1;
+   */
+  streams: StreamInfo[];
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.ListStreamsResponse.
+ * Use `create(ListStreamsResponseSchema)` to create a new message.
+ */
+export const ListStreamsResponseSchema: GenMessage<ListStreamsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 54);
+
+/**
+ * @generated from message pb.serverrpc.v1.CancelStreamRequest
+ */
+export type CancelStreamRequest = Message<"pb.serverrpc.v1.CancelStreamRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string room = 1;
+   */
+  room: string;
+
+  /**
+   * The stream's ID.
+   *
+   * @generated from field: string id = 2;
+   */
+  id: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.CancelStreamRequest.
+ * Use `create(CancelStreamRequestSchema)` to create a new message.
+ */
+export const CancelStreamRequestSchema: GenMessage<CancelStreamRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 55);
+
+/**
+ * @generated from message pb.serverrpc.v1.CancelStreamResponse
+ */
+export type CancelStreamResponse = Message<"pb.serverrpc.v1.CancelStreamResponse"> & {
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.CancelStreamResponse.
+ * Use `create(CancelStreamResponseSchema)` to create a new message.
+ */
+export const CancelStreamResponseSchema: GenMessage<CancelStreamResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 56);
+
+/**
+ * MigrationInfo is the state of a database schema migration.
+ *
+ * @generated from message pb.serverrpc.v1.MigrationInfo
+ */
+export type MigrationInfo = Message<"pb.serverrpc.v1.MigrationInfo"> & {
+  /**
+   * The migration's name.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Whether the migration has been applied.
+   *
+   * @generated from field: bool applied = 2;
+   */
+  applied: boolean;
+
+  /**
+   * When the migration was applied, as a UNIX timestamp in seconds.
+   * 0 if it has not been applied.
+   *
+   * @generated from field: int64 applied_ts = 3;
+   */
+  appliedTs: bigint;
+
+  /**
+   * Whether the migration is applied to the database but unknown to this server version.
+   * This happens when the database was used by a newer server version.
+   *
+   * @generated from field: bool unknown = 4;
+   */
+  unknown: boolean;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.MigrationInfo.
+ * Use `create(MigrationInfoSchema)` to create a new message.
+ */
+export const MigrationInfoSchema: GenMessage<MigrationInfo> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 57);
+
+/**
+ * @generated from message pb.serverrpc.v1.GetMigrationStatusRequest
+ */
+export type GetMigrationStatusRequest = Message<"pb.serverrpc.v1.GetMigrationStatusRequest"> & {
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.GetMigrationStatusRequest.
+ * Use `create(GetMigrationStatusRequestSchema)` to create a new message.
+ */
+export const GetMigrationStatusRequestSchema: GenMessage<GetMigrationStatusRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 58);
+
+/**
+ * @generated from message pb.serverrpc.v1.GetMigrationStatusResponse
+ */
+export type GetMigrationStatusResponse = Message<"pb.serverrpc.v1.GetMigrationStatusResponse"> & {
+  /**
+   * The state of each migration, in the order they are applied, followed by any unknown migrations.
+   *
+   * @generated from field: repeated pb.serverrpc.v1.MigrationInfo migrations = 1;
+   */
+  migrations: MigrationInfo[];
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.GetMigrationStatusResponse.
+ * Use `create(GetMigrationStatusResponseSchema)` to create a new message.
+ */
+export const GetMigrationStatusResponseSchema: GenMessage<GetMigrationStatusResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 59);
+
+/**
+ * @generated from message pb.serverrpc.v1.BackupDatabaseRequest
+ */
+export type BackupDatabaseRequest = Message<"pb.serverrpc.v1.BackupDatabaseRequest"> & {
+  /**
+   * The path of the file on the server to write the backup to.
+   * Relative paths are resolved against the server's working directory.
+   * The file must not already exist.
+   *
+   * @generated from field: string path = 1;
+   */
+  path: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.BackupDatabaseRequest.
+ * Use `create(BackupDatabaseRequestSchema)` to create a new message.
+ */
+export const BackupDatabaseRequestSchema: GenMessage<BackupDatabaseRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 60);
+
+/**
+ * @generated from message pb.serverrpc.v1.BackupDatabaseResponse
+ */
+export type BackupDatabaseResponse = Message<"pb.serverrpc.v1.BackupDatabaseResponse"> & {
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.BackupDatabaseResponse.
+ * Use `create(BackupDatabaseResponseSchema)` to create a new message.
+ */
+export const BackupDatabaseResponseSchema: GenMessage<BackupDatabaseResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 61);
+
+/**
+ * @generated from message pb.serverrpc.v1.CheckDatabaseIntegrityRequest
+ */
+export type CheckDatabaseIntegrityRequest = Message<"pb.serverrpc.v1.CheckDatabaseIntegrityRequest"> & {
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.CheckDatabaseIntegrityRequest.
+ * Use `create(CheckDatabaseIntegrityRequestSchema)` to create a new message.
+ */
+export const CheckDatabaseIntegrityRequestSchema: GenMessage<CheckDatabaseIntegrityRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 62);
+
+/**
+ * @generated from message pb.serverrpc.v1.CheckDatabaseIntegrityResponse
+ */
+export type CheckDatabaseIntegrityResponse = Message<"pb.serverrpc.v1.CheckDatabaseIntegrityResponse"> & {
+  /**
+   * The problems found, or empty if the database is intact.
+   *
+   * @generated from field: repeated string problems = 1;
+   */
+  problems: string[];
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.CheckDatabaseIntegrityResponse.
+ * Use `create(CheckDatabaseIntegrityResponseSchema)` to create a new message.
+ */
+export const CheckDatabaseIntegrityResponseSchema: GenMessage<CheckDatabaseIntegrityResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 63);
+
+/**
+ * RelayLimitWindow is a time window during which relay bandwidth has a different limit.
+ * Times are in the server's local time zone.
+ *
+ * @generated from message pb.serverrpc.v1.RelayLimitWindow
+ */
+export type RelayLimitWindow = Message<"pb.serverrpc.v1.RelayLimitWindow"> & {
+  /**
+   * The days of the week the window starts on, as a bit mask where bit 0 is Sunday and bit 6 is Saturday.
+   * 0 means every day.
+   *
+   * @generated from field: uint32 weekdays = 1;
+   */
+  weekdays: number;
+
+  /**
+   * The minute of the day the window starts at, from 0 to 1439.
+   *
+   * @generated from field: uint32 start_minute = 2;
+   */
+  startMinute: number;
+
+  /**
+   * The minute of the day the window ends at, from 0 to 1439.
+   * If it is not after start_minute, the window ends on the next day.
+   *
+   * @generated from field: uint32 end_minute = 3;
+   */
+  endMinute: number;
+
+  /**
+   * The maximum number of bytes per second relayed during the window, or 0 for unlimited.
+   *
+   * @generated from field: uint64 max_bytes_per_second = 4;
+   */
+  maxBytesPerSecond: bigint;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.RelayLimitWindow.
+ * Use `create(RelayLimitWindowSchema)` to create a new message.
+ */
+export const RelayLimitWindowSchema: GenMessage<RelayLimitWindow> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 64);
+
+/**
+ * @generated from message pb.serverrpc.v1.GetRelayLimitsRequest
+ */
+export type GetRelayLimitsRequest = Message<"pb.serverrpc.v1.GetRelayLimitsRequest"> & {
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.GetRelayLimitsRequest.
+ * Use `create(GetRelayLimitsRequestSchema)` to create a new message.
+ */
+export const GetRelayLimitsRequestSchema: GenMessage<GetRelayLimitsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 65);
+
+/**
+ * @generated from message pb.serverrpc.v1.GetRelayLimitsResponse
+ */
+export type GetRelayLimitsResponse = Message<"pb.serverrpc.v1.GetRelayLimitsResponse"> & {
+  /**
+   * The maximum number of bytes per second relayed outside of scheduled windows, or 0 for unlimited.
+   *
+   * @generated from field: uint64 max_bytes_per_second = 1;
+   */
+  maxBytesPerSecond: bigint;
+
+  /**
+   * Windows with a different limit.
+   * If windows overlap, the first one applies.
+   *
+   * @generated from field: repeated pb.serverrpc.v1.RelayLimitWindow schedule = 2;
+   */
+  schedule: RelayLimitWindow[];
+
+  /**
+   * The limit that applies right now, or 0 for unlimited.
+   *
+   * @generated from field: uint64 current_max_bytes_per_second = 3;
+   */
+  currentMaxBytesPerSecond: bigint;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.GetRelayLimitsResponse.
+ * Use `create(GetRelayLimitsResponseSchema)` to create a new message.
+ */
+export const GetRelayLimitsResponseSchema: GenMessage<GetRelayLimitsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 66);
+
+/**
+ * @generated from message pb.serverrpc.v1.SetRelayLimitsRequest
+ */
+export type SetRelayLimitsRequest = Message<"pb.serverrpc.v1.SetRelayLimitsRequest"> & {
+  /**
+   * The maximum number of bytes per second relayed outside of scheduled windows, or 0 for unlimited.
+   *
+   * @generated from field: uint64 max_bytes_per_second = 1;
+   */
+  maxBytesPerSecond: bigint;
+
+  /**
+   * Windows with a different limit.
+   * If windows overlap, the first one applies.
+   *
+   * @generated from field: repeated pb.serverrpc.v1.RelayLimitWindow schedule = 2;
+   */
+  schedule: RelayLimitWindow[];
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.SetRelayLimitsRequest.
+ * Use `create(SetRelayLimitsRequestSchema)` to create a new message.
+ */
+export const SetRelayLimitsRequestSchema: GenMessage<SetRelayLimitsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 67);
+
+/**
+ * @generated from message pb.serverrpc.v1.SetRelayLimitsResponse
+ */
+export type SetRelayLimitsResponse = Message<"pb.serverrpc.v1.SetRelayLimitsResponse"> & {
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.SetRelayLimitsResponse.
+ * Use `create(SetRelayLimitsResponseSchema)` to create a new message.
+ */
+export const SetRelayLimitsResponseSchema: GenMessage<SetRelayLimitsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 68);
+
+/**
+ * LobbySettings are the settings for the lobby, where new connections negotiate versions and authenticate.
+ *
+ * @generated from message pb.serverrpc.v1.LobbySettings
+ */
+export type LobbySettings = Message<"pb.serverrpc.v1.LobbySettings"> & {
+  /**
+   * How long a connection can stay in the lobby until it is disconnected, in seconds.
+   *
+   * @generated from field: uint32 timeout_seconds = 1;
+   */
+  timeoutSeconds: number;
+
+  /**
+   * The oldest protocol version accepted from clients, inclusive, in "MAJOR.MINOR.PATCH" format.
+   * Only the major and minor parts are compared.
+   *
+   * @generated from field: string min_protocol_version = 2;
+   */
+  minProtocolVersion: string;
+
+  /**
+   * The newest protocol version accepted from clients, inclusive, in "MAJOR.MINOR.PATCH" format.
+   * Only the major and minor parts are compared.
+   *
+   * @generated from field: string max_protocol_version = 3;
+   */
+  maxProtocolVersion: string;
+
+  /**
+   * The maximum number of connections that can be in the lobby at once, or 0 for unlimited.
+   *
+   * @generated from field: uint32 max_concurrent = 4;
+   */
+  maxConcurrent: number;
+
+  /**
+   * The maximum number of new connections a single IP address can make per minute, or 0 for unlimited.
+   *
+   * @generated from field: uint32 max_conns_per_ip_per_minute = 5;
+   */
+  maxConnsPerIpPerMinute: number;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.LobbySettings.
+ * Use `create(LobbySettingsSchema)` to create a new message.
+ */
+export const LobbySettingsSchema: GenMessage<LobbySettings> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 69);
+
+/**
+ * @generated from message pb.serverrpc.v1.GetLobbySettingsRequest
+ */
+export type GetLobbySettingsRequest = Message<"pb.serverrpc.v1.GetLobbySettingsRequest"> & {
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.GetLobbySettingsRequest.
+ * Use `create(GetLobbySettingsRequestSchema)` to create a new message.
+ */
+export const GetLobbySettingsRequestSchema: GenMessage<GetLobbySettingsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 70);
+
+/**
+ * @generated from message pb.serverrpc.v1.GetLobbySettingsResponse
+ */
+export type GetLobbySettingsResponse = Message<"pb.serverrpc.v1.GetLobbySettingsResponse"> & {
+  /**
+   * The current settings.
+   *
+   * @generated from field: pb.serverrpc.v1.LobbySettings settings = 1;
+   */
+  settings?: LobbySettings;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.GetLobbySettingsResponse.
+ * Use `create(GetLobbySettingsResponseSchema)` to create a new message.
+ */
+export const GetLobbySettingsResponseSchema: GenMessage<GetLobbySettingsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 71);
+
+/**
+ * @generated from message pb.serverrpc.v1.UpdateLobbySettingsRequest
+ */
+export type UpdateLobbySettingsRequest = Message<"pb.serverrpc.v1.UpdateLobbySettingsRequest"> & {
+  /**
+   * The new timeout in seconds.
+   * If omitted, it is not changed.
+   *
+   * @generated from field: optional uint32 timeout_seconds = 1;
+   */
+  timeoutSeconds?: number;
+
+  /**
+   * The new oldest accepted protocol version, in "MAJOR.MINOR" or "MAJOR.MINOR.PATCH" format.
+   * If omitted, it is not changed.
+   *
+   * @generated from field: optional string min_protocol_version = 2;
+   */
+  minProtocolVersion?: string;
+
+  /**
+   * The new newest accepted protocol version, in "MAJOR.MINOR" or "MAJOR.MINOR.PATCH" format.
+   * If omitted, it is not changed.
+   *
+   * @generated from field: optional string max_protocol_version = 3;
+   */
+  maxProtocolVersion?: string;
+
+  /**
+   * The new maximum number of connections in the lobby at once, or 0 for unlimited.
+   * If omitted, it is not changed.
+   *
+   * @generated from field: optional uint32 max_concurrent = 4;
+   */
+  maxConcurrent?: number;
+
+  /**
+   * The new maximum number of new connections per IP address per minute, or 0 for unlimited.
+   * If omitted, it is not changed.
+   *
+   * @generated from field: optional uint32 max_conns_per_ip_per_minute = 5;
+   */
+  maxConnsPerIpPerMinute?: number;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.UpdateLobbySettingsRequest.
+ * Use `create(UpdateLobbySettingsRequestSchema)` to create a new message.
+ */
+export const UpdateLobbySettingsRequestSchema: GenMessage<UpdateLobbySettingsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 72);
+
+/**
+ * @generated from message pb.serverrpc.v1.UpdateLobbySettingsResponse
+ */
+export type UpdateLobbySettingsResponse = Message<"pb.serverrpc.v1.UpdateLobbySettingsResponse"> & {
+  /**
+   * The settings after the update.
+   *
+   * @generated from field: pb.serverrpc.v1.LobbySettings settings = 1;
+   */
+  settings?: LobbySettings;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.UpdateLobbySettingsResponse.
+ * Use `create(UpdateLobbySettingsResponseSchema)` to create a new message.
+ */
+export const UpdateLobbySettingsResponseSchema: GenMessage<UpdateLobbySettingsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 73);
+
+/**
+ * @generated from message pb.serverrpc.v1.GetLobbyStatsRequest
+ */
+export type GetLobbyStatsRequest = Message<"pb.serverrpc.v1.GetLobbyStatsRequest"> & {
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.GetLobbyStatsRequest.
+ * Use `create(GetLobbyStatsRequestSchema)` to create a new message.
+ */
+export const GetLobbyStatsRequestSchema: GenMessage<GetLobbyStatsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 74);
+
+/**
+ * @generated from message pb.serverrpc.v1.GetLobbyStatsResponse
+ */
+export type GetLobbyStatsResponse = Message<"pb.serverrpc.v1.GetLobbyStatsResponse"> & {
+  /**
+   * The number of connections that entered the lobby since the server started.
+   *
+   * @generated from field: uint64 accepted = 1;
+   */
+  accepted: bigint;
+
+  /**
+   * The number of connections rejected because the server was draining.
+   *
+   * @generated from field: uint64 rejected_draining = 2;
+   */
+  rejectedDraining: bigint;
+
+  /**
+   * The number of connections rejected because their IP address made too many connections.
+   *
+   * @generated from field: uint64 rejected_rate_limited = 3;
+   */
+  rejectedRateLimited: bigint;
+
+  /**
+   * The number of connections rejected because too many connections were already in the lobby.
+   *
+   * @generated from field: uint64 rejected_busy = 4;
+   */
+  rejectedBusy: bigint;
+
+  /**
+   * The number of connections currently in the lobby.
+   *
+   * @generated from field: uint32 onboarding = 5;
+   */
+  onboarding: number;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.GetLobbyStatsResponse.
+ * Use `create(GetLobbyStatsResponseSchema)` to create a new message.
+ */
+export const GetLobbyStatsResponseSchema: GenMessage<GetLobbyStatsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 75);
+
+/**
+ * @generated from message pb.serverrpc.v1.GetRoomStatsRequest
+ */
+export type GetRoomStatsRequest = Message<"pb.serverrpc.v1.GetRoomStatsRequest"> & {
+  /**
+   * The room's name.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The UNIX timestamp, in seconds, of the start of the range, inclusive.
+   * Specify 0 to start from the oldest snapshot.
+   *
+   * @generated from field: int64 from_ts = 2;
+   */
+  fromTs: bigint;
+
+  /**
+   * The UNIX timestamp, in seconds, of the end of the range, exclusive.
+   * Specify 0 to end at the current time.
+   *
+   * @generated from field: int64 to_ts = 3;
+   */
+  toTs: bigint;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.GetRoomStatsRequest.
+ * Use `create(GetRoomStatsRequestSchema)` to create a new message.
+ */
+export const GetRoomStatsRequestSchema: GenMessage<GetRoomStatsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 76);
+
+/**
+ * @generated from message pb.serverrpc.v1.GetRoomStatsResponse
+ */
+export type GetRoomStatsResponse = Message<"pb.serverrpc.v1.GetRoomStatsResponse"> & {
+  /**
+   * The room's usage snapshots in the range, oldest first.
+   *
+   * @generated from field: repeated pb.serverrpc.v1.RoomStat stats = 1;
+   */
+  stats: RoomStat[];
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.GetRoomStatsResponse.
+ * Use `create(GetRoomStatsResponseSchema)` to create a new message.
+ */
+export const GetRoomStatsResponseSchema: GenMessage<GetRoomStatsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 77);
+
+/**
+ * @generated from message pb.serverrpc.v1.DrainRequest
+ */
+export type DrainRequest = Message<"pb.serverrpc.v1.DrainRequest"> & {
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.DrainRequest.
+ * Use `create(DrainRequestSchema)` to create a new message.
+ */
+export const DrainRequestSchema: GenMessage<DrainRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 78);
+
+/**
+ * @generated from message pb.serverrpc.v1.DrainResponse
+ */
+export type DrainResponse = Message<"pb.serverrpc.v1.DrainResponse"> & {
+  /**
+   * The number of proxied streams that are still carrying data.
+   * Idle streams that clients opened ahead of time for their next requests are not counted.
+   *
+   * @generated from field: uint32 active_streams = 1;
+   */
+  activeStreams: number;
+
+  /**
+   * The number of clients still connected, across all rooms.
+   *
+   * @generated from field: uint32 online_clients = 2;
+   */
+  onlineClients: number;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.DrainResponse.
+ * Use `create(DrainResponseSchema)` to create a new message.
+ */
+export const DrainResponseSchema: GenMessage<DrainResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 79);
+
+/**
+ * ConfigProblem is a problem found in a server config.
+ *
+ * @generated from message pb.serverrpc.v1.ConfigProblem
+ */
+export type ConfigProblem = Message<"pb.serverrpc.v1.ConfigProblem"> & {
+  /**
+   * The field the problem is in, such as "rpc.interfaces[1].address".
+   * Empty if the problem is with the config as a whole.
+   *
+   * @generated from field: string field = 1;
+   */
+  field: string;
+
+  /**
+   * A description of the problem.
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+
+  /**
+   * Whether the config can still be used despite the problem, such as an unknown field that is ignored.
+   *
+   * @generated from field: bool warning = 3;
+   */
+  warning: boolean;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.ConfigProblem.
+ * Use `create(ConfigProblemSchema)` to create a new message.
+ */
+export const ConfigProblemSchema: GenMessage<ConfigProblem> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 80);
+
+/**
+ * @generated from message pb.serverrpc.v1.ValidateConfigRequest
+ */
+export type ValidateConfigRequest = Message<"pb.serverrpc.v1.ValidateConfigRequest"> & {
+  /**
+   * The config JSON to validate.
+   * If empty, the config file the server was started with is read again and validated, such as to check changes to
+   * it before restarting the server.
+   *
+   * @generated from field: string config_json = 1;
+   */
+  configJson: string;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.ValidateConfigRequest.
+ * Use `create(ValidateConfigRequestSchema)` to create a new message.
+ */
+export const ValidateConfigRequestSchema: GenMessage<ValidateConfigRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 81);
+
+/**
+ * @generated from message pb.serverrpc.v1.ValidateConfigResponse
+ */
+export type ValidateConfigResponse = Message<"pb.serverrpc.v1.ValidateConfigResponse"> & {
+  /**
+   * Every problem found in the config, or empty if it has none.
+   *
+   * @generated from field: repeated pb.serverrpc.v1.ConfigProblem problems = 1;
+   */
+  problems: ConfigProblem[];
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.ValidateConfigResponse.
+ * Use `create(ValidateConfigResponseSchema)` to create a new message.
+ */
+export const ValidateConfigResponseSchema: GenMessage<ValidateConfigResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 82);
+
+/**
+ * RoomArchive is a portable copy of a room's settings, accounts and unused invite codes, used to move a room to another
+ * server without its users registering again.
+ *
+ * @generated from message pb.serverrpc.v1.RoomArchive
+ */
+export type RoomArchive = Message<"pb.serverrpc.v1.RoomArchive"> & {
+  /**
+   * The version of the archive format.
+   * See RoomArchiveVersion in the server for the current version.
+   *
+   * @generated from field: uint32 version = 1;
+   */
+  version: number;
+
+  /**
+   * The room's name.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * The room's description.
+   *
+   * @generated from field: string description = 3;
+   */
+  description: string;
 
   /**
-   * The new account's username.
+   * Whether the room is listed.
    *
-   * @generated from field: string username = 2;
+   * @generated from field: bool listed = 4;
    */
-  username: string;
+  listed: boolean;
 
   /**
-   * The new account's password, or empty to generate one.
+   * The room's message of the day, or empty if there is none.
    *
-   * @generated from field: string password = 3;
+   * @generated from field: string motd = 5;
    */
-  password: string;
-};
+  motd: string;
 
-/**
- * Describes the message pb.serverrpc.v1.CreateAccountRequest.
- * Use `create(CreateAccountRequestSchema)` to create a new message.
- */
-export const CreateAccountRequestSchema: GenMessage<CreateAccountRequest> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 19);
+  /**
+   * The maximum number of clients that can be in the room at once, or 0 for unlimited.
+   *
+   * @generated from field: uint32 max_clients = 6;
+   */
+  maxClients: number;
 
-/**
- * @generated from message pb.serverrpc.v1.CreateAccountResponse
- */
-export type CreateAccountResponse = Message<"pb.serverrpc.v1.CreateAccountResponse"> & {
   /**
-   * The newly created account.
+   * The maximum number of proxied streams each client in the room can have open at once, or 0 for unlimited.
    *
-   * @generated from field: pb.serverrpc.v1.AccountInfo account = 1;
+   * @generated from field: uint32 max_proxy_streams_per_client = 7;
    */
-  account?: AccountInfo;
+  maxProxyStreamsPerClient: number;
 
   /**
-   * The generated password, if applicable.
+   * How long proxied directory listings are cached, in milliseconds, or 0 if caching is disabled.
    *
-   * @generated from field: optional string generated_password = 2;
+   * @generated from field: uint32 dir_cache_ttl_ms = 8;
    */
-  generatedPassword?: string;
+  dirCacheTtlMs: number;
+
+  /**
+   * The room's accounts.
+   *
+   * @generated from field: repeated pb.serverrpc.v1.RoomArchive.Account accounts = 9;
+   */
+  accounts: RoomArchive_Account[];
+
+  /**
+   * The room's unused invite codes.
+   *
+   * @generated from field: repeated string invite_codes = 10;
+   */
+  inviteCodes: string[];
 };
 
 /**
- * Describes the message pb.serverrpc.v1.CreateAccountResponse.
- * Use `create(CreateAccountResponseSchema)` to create a new message.
+ * Describes the message pb.serverrpc.v1.RoomArchive.
+ * Use `create(RoomArchiveSchema)` to create a new message.
  */
-export const CreateAccountResponseSchema: GenMessage<CreateAccountResponse> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 20);
+export const RoomArchiveSchema: GenMessage<RoomArchive> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 83);
 
 /**
- * @generated from message pb.serverrpc.v1.DeleteAccountRequest
+ * An account in the room.
+ *
+ * @generated from message pb.serverrpc.v1.RoomArchive.Account
  */
-export type DeleteAccountRequest = Message<"pb.serverrpc.v1.DeleteAccountRequest"> & {
+export type RoomArchive_Account = Message<"pb.serverrpc.v1.RoomArchive.Account"> & {
   /**
-   * The room's name.
+   * The account's username.
    *
-   * @generated from field: string room = 1;
+   * @generated from field: string username = 1;
    */
-  room: string;
+  username: string;
 
   /**
-   * The account's username.
+   * The account's password hash.
+   * Users keep their passwords when the room is imported.
    *
-   * @generated from field: string username = 2;
+   * @generated from field: string password_hash = 2;
    */
-  username: string;
+  passwordHash: string;
+
+  /**
+   * Whether the account is a guest account.
+   *
+   * @generated from field: bool is_guest = 3;
+   */
+  isGuest: boolean;
 };
 
 /**
- * Describes the message pb.serverrpc.v1.DeleteAccountRequest.
- * Use `create(DeleteAccountRequestSchema)` to create a new message.
+ * Describes the message pb.serverrpc.v1.RoomArchive.Account.
+ * Use `create(RoomArchive_AccountSchema)` to create a new message.
  */
-export const DeleteAccountRequestSchema: GenMessage<DeleteAccountRequest> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 21);
+export const RoomArchive_AccountSchema: GenMessage<RoomArchive_Account> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 83, 0);
 
 /**
- * @generated from message pb.serverrpc.v1.DeleteAccountResponse
+ * @generated from message pb.serverrpc.v1.ExportRoomRequest
  */
-export type DeleteAccountResponse = Message<"pb.serverrpc.v1.DeleteAccountResponse"> & {
+export type ExportRoomRequest = Message<"pb.serverrpc.v1.ExportRoomRequest"> & {
+  /**
+   * The name of the room to export.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
 };
 
 /**
- * Describes the message pb.serverrpc.v1.DeleteAccountResponse.
- * Use `create(DeleteAccountResponseSchema)` to create a new message.
+ * Describes the message pb.serverrpc.v1.ExportRoomRequest.
+ * Use `create(ExportRoomRequestSchema)` to create a new message.
  */
-export const DeleteAccountResponseSchema: GenMessage<DeleteAccountResponse> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 22);
+export const ExportRoomRequestSchema: GenMessage<ExportRoomRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 84);
 
 /**
- * @generated from message pb.serverrpc.v1.UpdateAccountPasswordRequest
+ * @generated from message pb.serverrpc.v1.ExportRoomResponse
  */
-export type UpdateAccountPasswordRequest = Message<"pb.serverrpc.v1.UpdateAccountPasswordRequest"> & {
+export type ExportRoomResponse = Message<"pb.serverrpc.v1.ExportRoomResponse"> & {
   /**
-   * The room's name.
+   * The room's archive.
    *
-   * @generated from field: string room = 1;
+   * @generated from field: pb.serverrpc.v1.RoomArchive archive = 1;
    */
-  room: string;
+  archive?: RoomArchive;
+};
+
+/**
+ * Describes the message pb.serverrpc.v1.ExportRoomResponse.
+ * Use `create(ExportRoomResponseSchema)` to create a new message.
+ */
+export const ExportRoomResponseSchema: GenMessage<ExportRoomResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 85);
 
+/**
+ * @generated from message pb.serverrpc.v1.ImportRoomRequest
+ */
+export type ImportRoomRequest = Message<"pb.serverrpc.v1.ImportRoomRequest"> & {
   /**
-   * The account's username.
+   * The archive to import.
    *
-   * @generated from field: string username = 2;
+   * @generated from field: pb.serverrpc.v1.RoomArchive archive = 1;
    */
-  username: string;
+  archive?: RoomArchive;
 
   /**
-   * The account's new password, or empty to generate one.
+   * The name to give the imported room.
+   * If omitted, the name in the archive is used.
    *
-   * @generated from field: string password = 3;
+   * @generated from field: optional string name = 2;
    */
-  password: string;
+  name?: string;
 };
 
 /**
- * Describes the message pb.serverrpc.v1.UpdateAccountPasswordRequest.
- * Use `create(UpdateAccountPasswordRequestSchema)` to create a new message.
+ * Describes the message pb.serverrpc.v1.ImportRoomRequest.
+ * Use `create(ImportRoomRequestSchema)` to create a new message.
  */
-export const UpdateAccountPasswordRequestSchema: GenMessage<UpdateAccountPasswordRequest> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 23);
+export const ImportRoomRequestSchema: GenMessage<ImportRoomRequest> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 86);
 
 /**
- * @generated from message pb.serverrpc.v1.UpdateAccountPasswordResponse
+ * @generated from message pb.serverrpc.v1.ImportRoomResponse
  */
-export type UpdateAccountPasswordResponse = Message<"pb.serverrpc.v1.UpdateAccountPasswordResponse"> & {
+export type ImportRoomResponse = Message<"pb.serverrpc.v1.ImportRoomResponse"> & {
   /**
-   * The generated password, if applicable.
+   * The imported room.
    *
-   * @generated from field: optional string generated_password = 1;
+   * @generated from field: pb.serverrpc.v1.RoomInfo room = 1;
    */
-  generatedPassword?: string;
+  room?: RoomInfo;
 };
 
 /**
- * Describes the message pb.serverrpc.v1.UpdateAccountPasswordResponse.
- * Use `create(UpdateAccountPasswordResponseSchema)` to create a new message.
+ * Describes the message pb.serverrpc.v1.ImportRoomResponse.
+ * Use `create(ImportRoomResponseSchema)` to create a new message.
  */
-export const UpdateAccountPasswordResponseSchema: GenMessage<UpdateAccountPasswordResponse> = /*@__PURE__*/
-  messageDesc(file_pb_serverrpc_v1_rpc, 24);
+export const ImportRoomResponseSchema: GenMessage<ImportRoomResponse> = /*@__PURE__*/
+  messageDesc(file_pb_serverrpc_v1_rpc, 87);
 
 /**
  * ServerRpcService provides an RPC interface to a running FriendNet server.
@@ -568,7 +2452,8 @@ export const UpdateAccountPasswordResponseSchema: GenMessage<UpdateAccountPasswo
  */
 export const ServerRpcService: GenService<{
   /**
-   * GetServerInfo returns information about the server.
+   * GetServerInfo returns information about the server, such as its version and enabled features, so that UIs and
+   * CLIs can display it and only offer features the server supports.
    * It also returns information about the RPC interface used to call the method.
    *
    * @generated from rpc pb.serverrpc.v1.ServerRpcService.GetServerInfo
@@ -579,7 +2464,8 @@ export const ServerRpcService: GenService<{
     output: typeof GetServerInfoResponseSchema;
   },
   /**
-   * GetRooms returns a list of all rooms in the server.
+   * GetRooms returns a list of the rooms in the server.
+   * Unlisted rooms are only included if requested.
    *
    * @generated from rpc pb.serverrpc.v1.ServerRpcService.GetRooms
    */
@@ -622,7 +2508,8 @@ export const ServerRpcService: GenService<{
     output: typeof GetOnlineUserInfoResponseSchema;
   },
   /**
-   * GetAccounts returns all accounts in a room.
+   * GetAccounts returns a page of accounts in a room.
+   * Use the returned cursor to get the following pages.
    * Returns status code NOT_FOUND if no such room exists.
    *
    * @generated from rpc pb.serverrpc.v1.ServerRpcService.GetAccounts
@@ -635,6 +2522,7 @@ export const ServerRpcService: GenService<{
   /**
    * CreateRoom creates a new room.
    * Returns status code ALREADY_EXISTS if a room with the same name already exists.
+   * Returns status code INVALID_ARGUMENT if the description is too long.
    *
    * @generated from rpc pb.serverrpc.v1.ServerRpcService.CreateRoom
    */
@@ -655,6 +2543,92 @@ export const ServerRpcService: GenService<{
     input: typeof DeleteRoomRequestSchema;
     output: typeof DeleteRoomResponseSchema;
   },
+  /**
+   * SetRoomLimits sets a room's capacity and concurrency limits.
+   * Lowering the limits does not disconnect online users or close open streams; they only apply to new ones.
+   * Returns status code NOT_FOUND if no such room exists.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.SetRoomLimits
+   */
+  setRoomLimits: {
+    methodKind: "unary";
+    input: typeof SetRoomLimitsRequestSchema;
+    output: typeof SetRoomLimitsResponseSchema;
+  },
+  /**
+   * SetRoomDirCacheTtl sets how long directory listings proxied in a room are cached.
+   * Cached listings are only served while the sharing user's files are unchanged, so a short TTL mostly just
+   * bounds memory use.
+   * Returns status code NOT_FOUND if no such room exists.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.SetRoomDirCacheTtl
+   */
+  setRoomDirCacheTtl: {
+    methodKind: "unary";
+    input: typeof SetRoomDirCacheTtlRequestSchema;
+    output: typeof SetRoomDirCacheTtlResponseSchema;
+  },
+  /**
+   * SetRoomMetadata sets a room's description and whether it is listed.
+   * Returns status code NOT_FOUND if no such room exists.
+   * Returns status code INVALID_ARGUMENT if the description is too long.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.SetRoomMetadata
+   */
+  setRoomMetadata: {
+    methodKind: "unary";
+    input: typeof SetRoomMetadataRequestSchema;
+    output: typeof SetRoomMetadataResponseSchema;
+  },
+  /**
+   * SetRoomMotd sets a room's message of the day, which is sent to clients when they join the room.
+   * Clients that are already in the room receive it the next time they join.
+   * Returns status code NOT_FOUND if no such room exists.
+   * Returns status code INVALID_ARGUMENT if the message is too long.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.SetRoomMotd
+   */
+  setRoomMotd: {
+    methodKind: "unary";
+    input: typeof SetRoomMotdRequestSchema;
+    output: typeof SetRoomMotdResponseSchema;
+  },
+  /**
+   * CloseRoom disconnects all users in a room and cancels its open streams, without deleting the room.
+   * The room keeps its accounts and settings, and users may reconnect afterward.
+   * Returns status code NOT_FOUND if no such room exists.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.CloseRoom
+   */
+  closeRoom: {
+    methodKind: "unary";
+    input: typeof CloseRoomRequestSchema;
+    output: typeof CloseRoomResponseSchema;
+  },
+  /**
+   * KickUser disconnects an online user from a room.
+   * It does not delete or otherwise change their account, so they may reconnect afterward.
+   * Returns status code NOT_FOUND if no such room exists or the user is not online.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.KickUser
+   */
+  kickUser: {
+    methodKind: "unary";
+    input: typeof KickUserRequestSchema;
+    output: typeof KickUserResponseSchema;
+  },
+  /**
+   * BroadcastMessage sends a notice to every online user in a room.
+   * Returns status code NOT_FOUND if no such room exists.
+   * Returns status code INVALID_ARGUMENT if the text is empty or too long.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.BroadcastMessage
+   */
+  broadcastMessage: {
+    methodKind: "unary";
+    input: typeof BroadcastMessageRequestSchema;
+    output: typeof BroadcastMessageResponseSchema;
+  },
   /**
    * CreateAccount creates a new account in a room.
    * It can generate a password if none is given.
@@ -694,6 +2668,254 @@ export const ServerRpcService: GenService<{
     input: typeof UpdateAccountPasswordRequestSchema;
     output: typeof UpdateAccountPasswordResponseSchema;
   },
+  /**
+   * SetAccountGuest sets whether an account is a guest account.
+   * Guests can browse and download, but cannot share files or change their password.
+   * If the user is online, the change applies immediately.
+   * Returns status code NOT_FOUND if no such room exists.
+   * Returns status code NOT_FOUND if no such account exists.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.SetAccountGuest
+   */
+  setAccountGuest: {
+    methodKind: "unary";
+    input: typeof SetAccountGuestRequestSchema;
+    output: typeof SetAccountGuestResponseSchema;
+  },
+  /**
+   * CreateInviteCode creates a new single-use invite code for registering an account in a room.
+   * Returns status code NOT_FOUND if no such room exists.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.CreateInviteCode
+   */
+  createInviteCode: {
+    methodKind: "unary";
+    input: typeof CreateInviteCodeRequestSchema;
+    output: typeof CreateInviteCodeResponseSchema;
+  },
+  /**
+   * GetInviteCodes returns all unused invite codes for a room.
+   * Returns status code NOT_FOUND if no such room exists.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.GetInviteCodes
+   */
+  getInviteCodes: {
+    methodKind: "unary";
+    input: typeof GetInviteCodesRequestSchema;
+    output: typeof GetInviteCodesResponseSchema;
+  },
+  /**
+   * DeleteInviteCode deletes an unused invite code.
+   * Returns status code NOT_FOUND if no such room exists.
+   * Returns status code NOT_FOUND if no such invite code exists.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.DeleteInviteCode
+   */
+  deleteInviteCode: {
+    methodKind: "unary";
+    input: typeof DeleteInviteCodeRequestSchema;
+    output: typeof DeleteInviteCodeResponseSchema;
+  },
+  /**
+   * CreateInviteBundle creates an invite bundle URL for a room, including the server's certificate fingerprint.
+   * Clients can import it to add the server without entering its details or trusting its certificate blindly.
+   * Returns status code NOT_FOUND if no such room exists.
+   * Returns status code INVALID_ARGUMENT if the address is empty.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.CreateInviteBundle
+   */
+  createInviteBundle: {
+    methodKind: "unary";
+    input: typeof CreateInviteBundleRequestSchema;
+    output: typeof CreateInviteBundleResponseSchema;
+  },
+  /**
+   * ListStreams returns all open proxied streams between clients.
+   * Returns status code NOT_FOUND if a room is specified and no such room exists.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.ListStreams
+   */
+  listStreams: {
+    methodKind: "unary";
+    input: typeof ListStreamsRequestSchema;
+    output: typeof ListStreamsResponseSchema;
+  },
+  /**
+   * CancelStream closes an open proxied stream, interrupting whatever transfer is using it.
+   * Returns status code NOT_FOUND if no such room exists.
+   * Returns status code NOT_FOUND if no such stream exists.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.CancelStream
+   */
+  cancelStream: {
+    methodKind: "unary";
+    input: typeof CancelStreamRequestSchema;
+    output: typeof CancelStreamResponseSchema;
+  },
+  /**
+   * GetMigrationStatus returns the state of the server database's schema migrations.
+   * Operators can use it to check the schema before upgrading or downgrading the server.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.GetMigrationStatus
+   */
+  getMigrationStatus: {
+    methodKind: "unary";
+    input: typeof GetMigrationStatusRequestSchema;
+    output: typeof GetMigrationStatusResponseSchema;
+  },
+  /**
+   * BackupDatabase writes a consistent copy of the server's database to a file on the server while it keeps running.
+   * Returns status code INVALID_ARGUMENT if the path is empty.
+   * Returns status code ALREADY_EXISTS if the file already exists.
+   * Returns status code UNIMPLEMENTED if the database is not SQLite.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.BackupDatabase
+   */
+  backupDatabase: {
+    methodKind: "unary";
+    input: typeof BackupDatabaseRequestSchema;
+    output: typeof BackupDatabaseResponseSchema;
+  },
+  /**
+   * CheckDatabaseIntegrity checks the server's database for corruption.
+   * It may take a while for large databases.
+   * Returns status code UNIMPLEMENTED if the database is not SQLite.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.CheckDatabaseIntegrity
+   */
+  checkDatabaseIntegrity: {
+    methodKind: "unary";
+    input: typeof CheckDatabaseIntegrityRequestSchema;
+    output: typeof CheckDatabaseIntegrityResponseSchema;
+  },
+  /**
+   * GetRelayLimits returns the bandwidth limits for proxied streams.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.GetRelayLimits
+   */
+  getRelayLimits: {
+    methodKind: "unary";
+    input: typeof GetRelayLimitsRequestSchema;
+    output: typeof GetRelayLimitsResponseSchema;
+  },
+  /**
+   * SetRelayLimits replaces the bandwidth limits for proxied streams.
+   * They apply immediately, without clients reconnecting.
+   * Changes are not saved to the config file, so they last until the server restarts.
+   * Returns status code INVALID_ARGUMENT if a window is invalid.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.SetRelayLimits
+   */
+  setRelayLimits: {
+    methodKind: "unary";
+    input: typeof SetRelayLimitsRequestSchema;
+    output: typeof SetRelayLimitsResponseSchema;
+  },
+  /**
+   * GetLobbySettings returns the lobby timeout, accepted protocol versions and concurrency limit.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.GetLobbySettings
+   */
+  getLobbySettings: {
+    methodKind: "unary";
+    input: typeof GetLobbySettingsRequestSchema;
+    output: typeof GetLobbySettingsResponseSchema;
+  },
+  /**
+   * UpdateLobbySettings changes the lobby timeout, accepted protocol versions and concurrency limit.
+   * Only the fields that are set are changed. Changes apply to new connections, without restarting the server.
+   * Changes are not saved to the config file, so they last until the server restarts.
+   * Returns status code INVALID_ARGUMENT if the resulting settings are invalid.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.UpdateLobbySettings
+   */
+  updateLobbySettings: {
+    methodKind: "unary";
+    input: typeof UpdateLobbySettingsRequestSchema;
+    output: typeof UpdateLobbySettingsResponseSchema;
+  },
+  /**
+   * GetLobbyStats returns counters of connections that were accepted into or rejected from the lobby since the server
+   * started, such as to monitor for connection floods.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.GetLobbyStats
+   */
+  getLobbyStats: {
+    methodKind: "unary";
+    input: typeof GetLobbyStatsRequestSchema;
+    output: typeof GetLobbyStatsResponseSchema;
+  },
+  /**
+   * GetRoomStats returns the periodic snapshots of a room's online client count and relayed bytes taken within a
+   * time range, such as to graph its usage over time.
+   * Snapshots older than the server's configured retention are not available.
+   * Returns status code NOT_FOUND if no such room exists.
+   * Returns status code INVALID_ARGUMENT if the range ends before it starts.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.GetRoomStats
+   */
+  getRoomStats: {
+    methodKind: "unary";
+    input: typeof GetRoomStatsRequestSchema;
+    output: typeof GetRoomStatsResponseSchema;
+  },
+  /**
+   * Drain starts draining the server, such as before stopping it for an upgrade while another server takes over on
+   * a different address. New connections are closed with an unavailable close code, and new proxied streams are
+   * refused as if the target were offline, so clients try again later. Existing connections and proxied streams are
+   * left to finish.
+   * Draining lasts until the server restarts. Calling Drain while already draining only reports progress.
+   *
+   * Progress is streamed every second until no proxied streams are open, after which the server can be stopped
+   * without interrupting transfers.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.Drain
+   */
+  drain: {
+    methodKind: "server_streaming";
+    input: typeof DrainRequestSchema;
+    output: typeof DrainResponseSchema;
+  },
+  /**
+   * ValidateConfig checks a server config for problems, such as invalid or overlapping addresses, unknown RPC methods
+   * and unknown fields, and reports all of them at once.
+   * Returns status code FAILED_PRECONDITION if no config JSON is specified and the server was not started with a
+   * config file.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.ValidateConfig
+   */
+  validateConfig: {
+    methodKind: "unary";
+    input: typeof ValidateConfigRequestSchema;
+    output: typeof ValidateConfigResponseSchema;
+  },
+  /**
+   * ExportRoom returns a portable archive of a room's settings, accounts with their password hashes and unused invite
+   * codes, which can be imported into another server with ImportRoom.
+   * The archive contains password hashes, so it should be kept as secret as the database.
+   * Returns status code NOT_FOUND if no such room exists.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.ExportRoom
+   */
+  exportRoom: {
+    methodKind: "unary";
+    input: typeof ExportRoomRequestSchema;
+    output: typeof ExportRoomResponseSchema;
+  },
+  /**
+   * ImportRoom creates a new room from an archive made by ExportRoom, including its accounts, so users can log in
+   * with the same passwords. The room is either imported in full or not at all.
+   * Invite codes that are already used by another room are left out.
+   * Returns status code ALREADY_EXISTS if a room with the same name already exists.
+   * Returns status code INVALID_ARGUMENT if the archive or name is invalid, or the archive version is not supported.
+   *
+   * @generated from rpc pb.serverrpc.v1.ServerRpcService.ImportRoom
+   */
+  importRoom: {
+    methodKind: "unary";
+    input: typeof ImportRoomRequestSchema;
+    output: typeof ImportRoomResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_pb_serverrpc_v1_rpc, 0);
 
@@ -0,0 +1,40 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"friendnet.org/server/config"
+)
+
+// printConfigProblems prints every problem in the config file at the specified path without starting the server.
+// Returns the status code to exit with, which is 1 if the file could not be read or has errors.
+func printConfigProblems(path string) int {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		_, _ = fmt.Fprintf(os.Stderr, "failed to read config: %v\n", err)
+		return 1
+	}
+
+	_, problems := config.ValidateConfig(data)
+	if len(problems) == 0 {
+		fmt.Printf("%s has no problems.\n", path)
+		return 0
+	}
+
+	var errCount int
+	for _, problem := range problems {
+		if problem.Warning {
+			fmt.Printf("warning  %s\n", problem)
+		} else {
+			errCount++
+			fmt.Printf("error    %s\n", problem)
+		}
+	}
+	fmt.Printf("%d error(s), %d warning(s).\n", errCount, len(problems)-errCount)
+
+	if errCount > 0 {
+		return 1
+	}
+	return 0
+}
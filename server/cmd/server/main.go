@@ -21,7 +21,6 @@ import (
 	"friendnet.org/adminui"
 	"friendnet.org/common"
 	"friendnet.org/common/machine"
-	"friendnet.org/common/password"
 	"friendnet.org/common/webserver"
 	"friendnet.org/protocol"
 	"friendnet.org/protocol/pb/serverrpc/v1/serverrpcv1connect"
@@ -29,11 +28,17 @@ import (
 	"friendnet.org/server"
 	"friendnet.org/server/cert"
 	"friendnet.org/server/config"
+	"friendnet.org/server/lobby"
+	"friendnet.org/server/room"
 	"friendnet.org/server/storage"
 	"friendnet.org/updater"
 	"golang.org/x/term"
 )
 
+// roomStorageCloseInterval is how often the databases of rooms that nobody is online in are closed, when each room has
+// its own database.
+const roomStorageCloseInterval = 5 * time.Minute
+
 func main() {
 	logger := slog.New(slog.NewJSONHandler(os.Stdout, &slog.HandlerOptions{
 		Level: slog.LevelDebug,
@@ -41,27 +46,51 @@ func main() {
 
 	var configPath string
 	var noCli bool
+	var showMigrations bool
+	var revertCount int
+	var checkConfig bool
 	flag.StringVar(&configPath, "config", "server.json", "path to server config JSON")
 	flag.BoolVar(&noCli, "nocli", false, "disable CLI")
+	flag.BoolVar(&showMigrations, "migrations", false, "print database migration status and pending migrations, then exit without changing anything")
+	flag.IntVar(&revertCount, "revert-migrations", 0, "revert the specified number of most recent database migrations, then exit")
+	flag.BoolVar(&checkConfig, "check-config", false, "print every problem in the config file, then exit with status 1 if any of them are errors")
 	flag.Parse()
 
-	cfg, err := config.LoadOrCreate(configPath)
+	if checkConfig {
+		os.Exit(printConfigProblems(configPath))
+	}
+
+	cfg, warnings, err := config.LoadOrCreate(configPath)
 	if err != nil {
 		logger.Error("failed to load config", "err", err)
 		os.Exit(1)
 	}
+	for _, warning := range warnings {
+		logger.Warn("config problem",
+			"field", warning.Field,
+			"problem", warning.Message,
+		)
+	}
+
+	if showMigrations || revertCount > 0 {
+		migrateCtx, cancel := context.WithTimeout(context.Background(), 5*time.Minute)
+		if showMigrations {
+			err = printMigrations(migrateCtx, cfg)
+		} else {
+			err = revertMigrations(migrateCtx, cfg, revertCount)
+		}
+		cancel()
+		if err != nil {
+			logger.Error("failed to run migration command", "err", err)
+			os.Exit(1)
+		}
+		os.Exit(0)
+	}
 
 	// Check for insecure RPC interfaces that have wildcard permissions.
 	for _, iface := range cfg.Rpc.Interfaces {
 		if iface.BearerToken == "" {
-			if iface.EnableAdminUi {
-				logger.Error("RPC interface has admin UI enabled but does not require a bearer token",
-					"address", iface.Address,
-				)
-				os.Exit(1)
-			}
-
-			if slices.Contains(iface.AllowedMethods, "*") {
+			if methods, _ := config.RpcRoles.AllowedMethods(iface); slices.Contains(methods, "*") {
 				addr, _ := url.Parse(iface.Address)
 				if addr.Scheme == "unix" {
 					// UNIX sockets are exempt from warning.
@@ -92,13 +121,24 @@ func main() {
 		os.Exit(1)
 	}
 
+	certFingerprint := protocol.CertFingerprint(serverCert.Certificate[0])
+
 	tlsCfg := &tls.Config{
 		MinVersion:   tls.VersionTLS13,
 		Certificates: []tls.Certificate{serverCert},
 		NextProtos:   []string{protocol.AlpnProtoName},
 	}
 
-	storageInst, err := storage.NewStorage(cfg.DbPath)
+	var storageInst storage.Storage
+	if cfg.DbDriver == config.DbDriverPostgres {
+		connectCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+		storageInst, err = storage.NewPostgresStorage(connectCtx, logger, cfg.DbUrl)
+		cancel()
+	} else if cfg.RoomStorageDir != "" {
+		storageInst, err = storage.NewNamespacedSqliteStorage(logger, cfg.DbPath, cfg.RoomStorageDir)
+	} else {
+		storageInst, err = storage.NewSqliteStorage(logger, cfg.DbPath)
+	}
 	if err != nil {
 		logger.Error("failed to create storage", "err", err)
 		os.Exit(1)
@@ -121,17 +161,92 @@ func main() {
 	}
 
 	// Server-wide password requirements.
-	passReqs := password.NewRequirements(
-		password.WithMinLen(8),
-		password.WithMaxLen(64),
-		password.WithCannotContainUsername(),
-	)
+	if cfg.PasswordPolicy == nil {
+		logger.Warn("missing password_policy in config, using default value; you should add it to your config!",
+			"default", config.DefaultPasswordPolicy,
+		)
+		cfg.PasswordPolicy = &config.DefaultPasswordPolicy
+	}
+	passReqs := cfg.PasswordPolicy.ToRequirements()
+
+	// Authentication brute-force protection.
+	if cfg.AuthRateLimit == nil {
+		logger.Warn("missing auth_rate_limit in config, using default value; you should add it to your config!",
+			"default", config.DefaultAuthRateLimit,
+		)
+		cfg.AuthRateLimit = &config.DefaultAuthRateLimit
+	}
+
+	// Per-connection stream and request limits.
+	if cfg.ConnLimits == nil {
+		logger.Warn("missing conn_limits in config, using default value; you should add it to your config!",
+			"default", config.DefaultConnLimits,
+		)
+		cfg.ConnLimits = &config.DefaultConnLimits
+	}
+
+	// Room usage history is recorded by default, so a missing section just uses the default settings.
+	if cfg.RoomStats == nil {
+		cfg.RoomStats = &config.DefaultRoomStats
+	}
+
+	// Self-registration is opt-in, so a missing section just means it is disabled.
+	var registration lobby.RegistrationConfig
+	if cfg.Registration != nil {
+		registration = lobby.RegistrationConfig{
+			Enabled:           cfg.Registration.Enabled,
+			RequireInviteCode: cfg.Registration.RequireInviteCode,
+		}
+	}
+
+	lobbySettings := lobby.DefaultSettings()
+	if cfg.Lobby != nil {
+		if cfg.Lobby.TimeoutSeconds > 0 {
+			lobbySettings.Timeout = time.Duration(cfg.Lobby.TimeoutSeconds) * time.Second
+		}
+		// Already validated when the config was parsed.
+		lobbySettings.MinVersion, lobbySettings.MaxVersion, _ = cfg.Lobby.ProtocolVersions()
+		lobbySettings.MaxConcurrent = cfg.Lobby.MaxConcurrent
+		lobbySettings.MaxConnsPerIpPerMinute = cfg.Lobby.MaxConnsPerIpPerMinute
+	}
+
+	// Relay bandwidth sharing is opt-in, so a missing section just means relayed bytes are only counted.
+	var relay room.RelayConfig
+	if cfg.Relay != nil {
+		relay = room.RelayConfig{
+			MaxBytesPerSecond: cfg.Relay.MaxBytesPerSecond,
+			DefaultWeight:     cfg.Relay.DefaultWeight,
+			GuestWeight:       cfg.Relay.GuestWeight,
+			UserWeights:       cfg.Relay.NormalizedUserWeights(),
+		}
+		for _, entry := range cfg.Relay.Schedule {
+			// Already validated when the config was parsed.
+			window, _ := entry.ToTimeWindow()
+			relay.Schedule = append(relay.Schedule, room.RelayLimitWindow{
+				TimeWindow:        window,
+				MaxBytesPerSecond: entry.MaxBytesPerSecond,
+			})
+		}
+	}
 
 	srv, err := server.NewServer(
 		logger,
 		storageInst,
 		connMethodSupport,
 		passReqs,
+		&lobby.AuthLimiterConfig{
+			MaxIpFailures:      cfg.AuthRateLimit.MaxIpFailures,
+			MaxAccountFailures: cfg.AuthRateLimit.MaxAccountFailures,
+			Window:             time.Duration(cfg.AuthRateLimit.WindowSeconds) * time.Second,
+			Lockout:            time.Duration(cfg.AuthRateLimit.LockoutSeconds) * time.Second,
+		},
+		registration,
+		lobbySettings,
+		protocol.ConnLimits{
+			MaxIncomingStreams:    cfg.ConnLimits.MaxIncomingStreams,
+			MaxConcurrentRequests: cfg.ConnLimits.MaxConcurrentRequests,
+		},
+		relay,
 	)
 	if err != nil {
 		logger.Error("failed to create server", "err", err)
@@ -155,7 +270,8 @@ func main() {
 			logger,
 			webServer,
 			iface,
-			server.NewRpcServer(srv, iface),
+			config.RpcRoles,
+			server.NewRpcServer(srv, iface, certFingerprint, configPath),
 			func(impl *server.RpcServer, options ...connect.HandlerOption) (string, http.Handler) {
 				return serverrpcv1connect.NewServerRpcServiceHandler(impl, options...)
 			},
@@ -186,6 +302,23 @@ func main() {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	if cfg.Backup != nil {
+		go storage.RunScheduledBackups(ctx, logger, storageInst, storage.ScheduledBackupConfig{
+			Dir:      cfg.Backup.Dir,
+			Interval: time.Duration(cfg.Backup.IntervalSeconds) * time.Second,
+			Keep:     cfg.Backup.Keep,
+		})
+	}
+
+	go room.RunStatsRecorder(ctx, logger, storageInst, srv.RoomManager, room.StatsConfig{
+		Interval:  time.Duration(cfg.RoomStats.IntervalSeconds) * time.Second,
+		Retention: time.Duration(cfg.RoomStats.RetentionDays) * 24 * time.Hour,
+	})
+
+	if closer, ok := storageInst.(storage.RoomCloser); ok {
+		go room.RunRoomStorageCloser(ctx, logger, closer, srv.RoomManager, roomStorageCloseInterval)
+	}
+
 	var updateChecker *updater.UpdateChecker
 	if !cfg.DisableUpdateChecker {
 		// We do not need to listen to the update channel because the updater already logs everything we need.
@@ -211,6 +344,8 @@ func main() {
 					common.RpcServerConfig{
 						AllowedMethods: []string{"*"},
 					},
+					certFingerprint,
+					configPath,
 				),
 			)
 			mux.HandleFunc(path, func(w http.ResponseWriter, r *http.Request) {
@@ -261,7 +396,12 @@ func main() {
 
 	for _, listenAddr := range cfg.Listen {
 		go func() {
-			listenErr := srv.Listen(listenAddr, tlsCfg)
+			var listenErr error
+			if cfg.ReusePort {
+				listenErr = srv.ListenReusePort(listenAddr, tlsCfg)
+			} else {
+				listenErr = srv.Listen(listenAddr, tlsCfg)
+			}
 			if listenErr != nil {
 				logger.Error("failed to listen",
 					"addr", listenAddr,
@@ -272,6 +412,7 @@ func main() {
 		}()
 		logger.Info("server listening",
 			"addr", listenAddr,
+			"reuse_port", cfg.ReusePort,
 		)
 	}
 
@@ -0,0 +1,90 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"friendnet.org/common"
+	"friendnet.org/server/config"
+	"friendnet.org/server/storage"
+)
+
+// openMigrator opens the configured database without applying migrations.
+// The caller must close the returned database.
+func openMigrator(ctx context.Context, cfg *config.ServerConfig) (*common.Migrator, *sql.DB, error) {
+	if cfg.DbDriver == config.DbDriverPostgres {
+		return storage.OpenPostgresMigrator(ctx, cfg.DbUrl)
+	}
+	return storage.OpenSqliteMigrator(cfg.DbPath)
+}
+
+// printMigrations prints the state of the configured database's migrations, including which migrations would be
+// applied on the next start.
+// It does not modify the database.
+func printMigrations(ctx context.Context, cfg *config.ServerConfig) error {
+	migrator, db, err := openMigrator(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return err
+	}
+
+	var pending int
+	for _, status := range statuses {
+		switch {
+		case status.Unknown:
+			fmt.Printf("unknown  %s (applied %s by a newer version)\n", status.Name, status.AppliedTs.Format(time.RFC3339))
+		case status.Applied:
+			fmt.Printf("applied  %s (%s)\n", status.Name, status.AppliedTs.Format(time.RFC3339))
+		default:
+			pending++
+			fmt.Printf("pending  %s\n", status.Name)
+		}
+	}
+
+	if pending == 0 {
+		fmt.Println("Database schema is up to date.")
+	} else {
+		fmt.Printf("%d migration(s) will be applied when the server starts.\n", pending)
+	}
+	return nil
+}
+
+// revertMigrations reverts the specified number of most recent migrations on the configured database.
+// A SQLite database is backed up first.
+func revertMigrations(ctx context.Context, cfg *config.ServerConfig, count int) error {
+	migrator, db, err := openMigrator(ctx, cfg)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = db.Close()
+	}()
+
+	if cfg.DbDriver != config.DbDriverPostgres {
+		backupPath, err := common.BackupSqlite(ctx, db, cfg.DbPath)
+		if err != nil {
+			return err
+		}
+		fmt.Printf("Backed up database to %s\n", backupPath)
+	}
+
+	reverted, err := migrator.Revert(ctx, count)
+	for _, name := range reverted {
+		fmt.Printf("reverted %s\n", name)
+	}
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("Reverted %d migration(s). Start an older server version, or a newer one to apply them again.\n", len(reverted))
+	return nil
+}
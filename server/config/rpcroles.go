@@ -0,0 +1,53 @@
+package config
+
+import (
+	"slices"
+
+	"friendnet.org/common"
+)
+
+// rpcViewerMethods are the server RPC methods that read the state of the server and its rooms without revealing
+// secrets such as invite codes.
+var rpcViewerMethods = []string{
+	"GetServerInfo",
+	"GetRooms",
+	"GetRoomInfo",
+	"GetOnlineUsers",
+	"GetOnlineUserInfo",
+	"GetAccounts",
+	"ListStreams",
+	"GetMigrationStatus",
+	"GetRelayLimits",
+	"GetLobbySettings",
+	"GetLobbyStats",
+	"GetRoomStats",
+}
+
+// rpcOperatorMethods are the server RPC methods for the day-to-day management of users and rooms, on top of
+// rpcViewerMethods.
+var rpcOperatorMethods = []string{
+	"SetRoomMetadata",
+	"SetRoomMotd",
+	"KickUser",
+	"BroadcastMessage",
+	"CreateAccount",
+	"DeleteAccount",
+	"UpdateAccountPassword",
+	"SetAccountGuest",
+	"CreateInviteCode",
+	"GetInviteCodes",
+	"DeleteInviteCode",
+	"CreateInviteBundle",
+	"CancelStream",
+	"CheckDatabaseIntegrity",
+	"ValidateConfig",
+}
+
+// RpcRoles are the roles an interface of the server RPC can have.
+// Methods that change how the server runs, such as creating and deleting rooms, changing limits, backing up the
+// database and draining, are only allowed for common.RpcRoleAdmin.
+var RpcRoles = common.RpcRoles{
+	common.RpcRoleViewer:   rpcViewerMethods,
+	common.RpcRoleOperator: slices.Concat(rpcViewerMethods, rpcOperatorMethods),
+	common.RpcRoleAdmin:    {"*"},
+}
@@ -0,0 +1,41 @@
+package config
+
+import (
+	"slices"
+	"testing"
+
+	v1 "friendnet.org/protocol/pb/serverrpc/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+func TestRpcRolesCoverMethods(t *testing.T) {
+	rpcMethods := v1.File_pb_serverrpc_v1_rpc_proto.Services().ByName("ServerRpcService").Methods()
+
+	for _, method := range slices.Concat(rpcViewerMethods, rpcOperatorMethods) {
+		if rpcMethods.ByName(protoreflect.Name(method)) == nil {
+			t.Errorf("role has unknown method %q", method)
+		}
+	}
+
+	// Every method has to be placed in a role on purpose, or left out on purpose for admins only.
+	adminOnly := []string{
+		"CreateRoom",
+		"DeleteRoom",
+		"SetRoomLimits",
+		"SetRoomDirCacheTtl",
+		"CloseRoom",
+		"BackupDatabase",
+		"SetRelayLimits",
+		"UpdateLobbySettings",
+		"Drain",
+		"ExportRoom",
+		"ImportRoom",
+	}
+	for i := range rpcMethods.Len() {
+		name := string(rpcMethods.Get(i).Name())
+		inRoles := slices.Contains(rpcViewerMethods, name) || slices.Contains(rpcOperatorMethods, name)
+		if inRoles == slices.Contains(adminOnly, name) {
+			t.Errorf("method %q must be either in a role or admin-only", name)
+		}
+	}
+}
@@ -0,0 +1,92 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// Fields that hold secrets or paths can reference environment variables and secret files instead of containing the
+// values themselves, so that config files can be committed without them:
+//   - "${NAME}" is replaced with the value of the environment variable NAME, anywhere in the value. A "$" that is not
+//     followed by "{" is kept as it is.
+//   - A value of "file://PATH" is replaced with the contents of the file at PATH, without trailing newlines. This is
+//     how secrets are usually provided by container orchestrators, such as Docker secrets.
+//
+// The fields are db_path, db_url, room_storage_dir, pem_path, backup.dir, rpc.https_pem_path and
+// rpc.interfaces[].bearer_token.
+
+// secretFilePrefix is the prefix of values that are read from a file.
+const secretFilePrefix = "file://"
+
+// ResolveSecret resolves environment variable and secret file references in a config value.
+// Returns the value unchanged if it has none.
+func ResolveSecret(value string) (string, error) {
+	if path, ok := strings.CutPrefix(value, secretFilePrefix); ok {
+		if path == "" {
+			return "", errors.New("secret file path is empty")
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf(`failed to read secret file: %w`, err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+
+	var res strings.Builder
+	for {
+		start := strings.Index(value, "${")
+		if start < 0 {
+			res.WriteString(value)
+			return res.String(), nil
+		}
+		end := strings.IndexByte(value[start:], '}')
+		if end < 0 {
+			return "", errors.New(`unterminated "${" in value`)
+		}
+		end += start
+
+		name := value[start+2 : end]
+		if name == "" {
+			return "", errors.New(`empty environment variable name in "${}"`)
+		}
+		envVal, ok := os.LookupEnv(name)
+		if !ok {
+			return "", fmt.Errorf(`environment variable %q is not set`, name)
+		}
+
+		res.WriteString(value[:start])
+		res.WriteString(envVal)
+		value = value[end+1:]
+	}
+}
+
+// resolveSecrets resolves environment variable and secret file references in the fields of the config that can have
+// them, in place.
+// Returns a problem for each field that could not be resolved.
+func (c *ServerConfig) resolveSecrets() []Problem {
+	problems := make([]Problem, 0)
+	resolve := func(field string, value *string) {
+		resolved, err := ResolveSecret(*value)
+		if err != nil {
+			problems = append(problems, Problem{Field: field, Message: err.Error()})
+			return
+		}
+		*value = resolved
+	}
+
+	resolve("db_path", &c.DbPath)
+	resolve("db_url", &c.DbUrl)
+	resolve("room_storage_dir", &c.RoomStorageDir)
+	resolve("pem_path", &c.PemPath)
+	if c.Backup != nil {
+		resolve("backup.dir", &c.Backup.Dir)
+	}
+	resolve("rpc.https_pem_path", &c.Rpc.HttpsPemPath)
+	for i := range c.Rpc.Interfaces {
+		resolve(fmt.Sprintf("rpc.interfaces[%d].bearer_token", i), &c.Rpc.Interfaces[i].BearerToken)
+	}
+
+	return problems
+}
@@ -0,0 +1,371 @@
+package config
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+	"net/url"
+	"reflect"
+	"slices"
+	"strings"
+
+	"friendnet.org/common"
+	"friendnet.org/protocol"
+	v1 "friendnet.org/protocol/pb/serverrpc/v1"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// Problem is a problem found in a server config by ValidateConfig.
+type Problem struct {
+	// The field the problem is in, such as "rpc.interfaces[1].address".
+	// Empty if the problem is with the config as a whole.
+	Field string
+
+	// A description of the problem.
+	Message string
+
+	// Whether the config can still be used despite the problem.
+	// Unknown fields are warnings, since they are ignored.
+	Warning bool
+}
+
+func (p Problem) String() string {
+	if p.Field == "" {
+		return p.Message
+	}
+	return p.Field + ": " + p.Message
+}
+
+// ProblemsErr returns an error that joins all problems that are not warnings, or nil if there are none.
+func ProblemsErr(problems []Problem) error {
+	errs := make([]error, 0, len(problems))
+	for _, p := range problems {
+		if !p.Warning {
+			errs = append(errs, errors.New(p.String()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// ValidateConfig parses a server config from JSON and checks it for problems.
+// Unlike failing on the first problem, it reports every problem it finds at once, so they can all be fixed before
+// trying again.
+// Environment variable and secret file references are resolved in the returned config; see ResolveSecret.
+// The returned config is nil if the JSON could not be parsed at all. Otherwise, it is returned even if it has problems,
+// and can only be used if none of them are errors.
+func ValidateConfig(data []byte) (*ServerConfig, []Problem) {
+	problems := make([]Problem, 0)
+	add := func(field string, format string, args ...any) {
+		problems = append(problems, Problem{Field: field, Message: fmt.Sprintf(format, args...)})
+	}
+
+	var cfg ServerConfig
+	if err := json.Unmarshal(data, &cfg); err != nil {
+		// Decoding continues past values of the wrong type, so the rest of the config can still be checked.
+		typeErr, ok := errors.AsType[*json.UnmarshalTypeError](err)
+		if !ok {
+			add("", "invalid JSON: %v", err)
+			return nil, problems
+		}
+		add(typeErr.Field, "expected a value of type %s, got %s", typeErr.Type, typeErr.Value)
+	}
+
+	var raw any
+	if err := json.Unmarshal(data, &raw); err == nil {
+		for _, field := range unknownFields("", raw, reflect.TypeFor[ServerConfig]()) {
+			problems = append(problems, Problem{Field: field, Message: "unknown field, it will be ignored", Warning: true})
+		}
+	}
+
+	problems = append(problems, cfg.resolveSecrets()...)
+
+	switch cfg.DbDriver {
+	case "", DbDriverSqlite:
+		if cfg.DbPath == "" {
+			add("db_path", "is required")
+		}
+	case DbDriverPostgres:
+		if cfg.DbUrl == "" {
+			add("db_url", "is required when db_driver is %q", DbDriverPostgres)
+		}
+	default:
+		add("db_driver", "unsupported driver %q, must be %q or %q", cfg.DbDriver, DbDriverSqlite, DbDriverPostgres)
+	}
+	if cfg.RoomStorageDir != "" && cfg.DbDriver == DbDriverPostgres {
+		add("room_storage_dir", "not supported with the %q db_driver", DbDriverPostgres)
+	}
+	if cfg.PemPath == "" {
+		add("pem_path", "is required")
+	}
+
+	if len(cfg.Listen) == 0 {
+		add("listen", "at least one listen address is required")
+	}
+	listenAddrs := make([]netip.AddrPort, len(cfg.Listen))
+	for i, addr := range cfg.Listen {
+		field := fmt.Sprintf("listen[%d]", i)
+		addrPort, err := netip.ParseAddrPort(addr)
+		if err != nil {
+			add(field, `%q must be IP:PORT, with IPv6 addresses in square brackets (like "[::1]:20038")`, addr)
+			continue
+		}
+		if addrPort.Port() == 0 {
+			add(field, "%q must have a port other than 0", addr)
+			continue
+		}
+		for j := range i {
+			if overlaps(listenAddrs[j], addrPort) {
+				add(field, "%q overlaps with listen[%d] %q", addr, j, cfg.Listen[j])
+				break
+			}
+		}
+		listenAddrs[i] = addrPort
+	}
+	if cfg.ReusePort && !protocol.ReusePortSupported {
+		add("reuse_port", "is only supported on Linux")
+	}
+
+	if cfg.PasswordPolicy != nil {
+		if cfg.PasswordPolicy.MinLength < 0 || cfg.PasswordPolicy.MaxLength < 0 {
+			add("password_policy", "lengths cannot be negative")
+		}
+		if cfg.PasswordPolicy.MaxLength > 0 && cfg.PasswordPolicy.MinLength > cfg.PasswordPolicy.MaxLength {
+			add("password_policy.min_length", "cannot be greater than password_policy.max_length")
+		}
+	}
+	if cfg.AuthRateLimit != nil {
+		if cfg.AuthRateLimit.MaxIpFailures < 0 || cfg.AuthRateLimit.MaxAccountFailures < 0 {
+			add("auth_rate_limit", "failure limits cannot be negative")
+		}
+		if cfg.AuthRateLimit.WindowSeconds <= 0 {
+			add("auth_rate_limit.window_seconds", "must be positive")
+		}
+		if cfg.AuthRateLimit.LockoutSeconds <= 0 {
+			add("auth_rate_limit.lockout_seconds", "must be positive")
+		}
+	}
+
+	if cfg.ConnLimits != nil {
+		if cfg.ConnLimits.MaxIncomingStreams < 0 || cfg.ConnLimits.MaxConcurrentRequests < 0 {
+			add("conn_limits", "values cannot be negative")
+		}
+	}
+	if cfg.Lobby != nil {
+		if cfg.Lobby.TimeoutSeconds < 0 || cfg.Lobby.MaxConcurrent < 0 || cfg.Lobby.MaxConnsPerIpPerMinute < 0 {
+			add("lobby", "values cannot be negative")
+		}
+		if _, _, err := cfg.Lobby.ProtocolVersions(); err != nil {
+			add("lobby", "%v", err)
+		}
+	}
+	if cfg.Relay != nil {
+		if cfg.Relay.MaxBytesPerSecond < 0 {
+			add("relay.max_bytes_per_second", "cannot be negative")
+		}
+		if cfg.Relay.DefaultWeight < 0 || cfg.Relay.GuestWeight < 0 {
+			add("relay", "weights cannot be negative")
+		}
+		for key, weight := range cfg.Relay.UserWeights {
+			field := fmt.Sprintf("relay.user_weights[%q]", key)
+			if _, ok := normalizeUserWeightKey(key); !ok {
+				add(field, `key must be a valid "room/username"`)
+			}
+			if weight <= 0 {
+				add(field, "weight must be positive")
+			}
+		}
+		for i, entry := range cfg.Relay.Schedule {
+			field := fmt.Sprintf("relay.schedule[%d]", i)
+			if _, err := entry.ToTimeWindow(); err != nil {
+				add(field, "%v", err)
+			}
+			if entry.MaxBytesPerSecond < 0 {
+				add(field+".max_bytes_per_second", "cannot be negative")
+			}
+		}
+	}
+	if cfg.Backup != nil {
+		if cfg.DbDriver == DbDriverPostgres {
+			add("backup", "not supported with the %q db_driver; use PostgreSQL's own backup tools", DbDriverPostgres)
+		}
+		if cfg.Backup.Dir == "" {
+			add("backup.dir", "is required")
+		}
+		if cfg.Backup.IntervalSeconds <= 0 {
+			add("backup.interval_seconds", "must be positive")
+		}
+		if cfg.Backup.Keep < 0 {
+			add("backup.keep", "cannot be negative")
+		}
+	}
+
+	if cfg.RoomStats != nil {
+		if cfg.RoomStats.IntervalSeconds <= 0 {
+			add("room_stats.interval_seconds", "must be positive")
+		}
+		if cfg.RoomStats.RetentionDays < 0 {
+			add("room_stats.retention_days", "cannot be negative")
+		}
+	}
+
+	rpcMethods := v1.File_pb_serverrpc_v1_rpc_proto.Services().ByName("ServerRpcService").Methods()
+	rpcAddrs := make([]netip.AddrPort, len(cfg.Rpc.Interfaces))
+	for i, iface := range cfg.Rpc.Interfaces {
+		field := fmt.Sprintf("rpc.interfaces[%d]", i)
+
+		u, err := url.Parse(iface.Address)
+		switch {
+		case err != nil:
+			add(field+".address", "%q is not a valid URL: %v", iface.Address, err)
+		case u.Scheme == "http" || u.Scheme == "https":
+			addrPort, err := netip.ParseAddrPort(u.Host)
+			if err != nil || u.Path != "" {
+				add(field+".address", "%q must be %s://IP:PORT, without a path", iface.Address, u.Scheme)
+				break
+			}
+			for j := range i {
+				if overlaps(rpcAddrs[j], addrPort) {
+					add(field+".address", "%q overlaps with rpc.interfaces[%d] %q", iface.Address, j, cfg.Rpc.Interfaces[j].Address)
+					break
+				}
+			}
+			rpcAddrs[i] = addrPort
+		case u.Scheme == "unix":
+			if u.Host+u.Path == "" {
+				add(field+".address", "%q must include a socket path", iface.Address)
+				break
+			}
+			for j := range i {
+				if cfg.Rpc.Interfaces[j].Address == iface.Address {
+					add(field+".address", "%q is the same as rpc.interfaces[%d]", iface.Address, j)
+					break
+				}
+			}
+		default:
+			add(field+".address", "%q has unsupported protocol %q, must be http, https or unix", iface.Address, u.Scheme)
+		}
+
+		if _, has := RpcRoles[iface.Role]; iface.Role != "" && !has {
+			add(field+".role", "unknown role %q, must be %q, %q or %q", iface.Role,
+				common.RpcRoleViewer, common.RpcRoleOperator, common.RpcRoleAdmin)
+		}
+		for j, method := range iface.AllowedMethods {
+			if method == "*" {
+				if len(iface.AllowedMethods) > 1 {
+					problems = append(problems, Problem{
+						Field:   fmt.Sprintf("%s.allowed_methods[%d]", field, j),
+						Message: `"*" allows all methods, so the others are redundant`,
+						Warning: true,
+					})
+				}
+				continue
+			}
+			if rpcMethods.ByName(protoreflect.Name(method)) == nil {
+				add(fmt.Sprintf("%s.allowed_methods[%d]", field, j), "unknown method %q", method)
+			}
+		}
+		for j, ip := range iface.AllowedIps {
+			if _, err := netip.ParseAddr(ip); err != nil {
+				add(fmt.Sprintf("%s.allowed_ips[%d]", field, j), "%q is not a valid IP address", ip)
+			}
+		}
+		if iface.EnableAdminUi && iface.BearerToken == "" {
+			add(field+".enable_admin_ui", "the admin UI requires bearer_token to be set")
+		}
+	}
+
+	return &cfg, problems
+}
+
+// overlaps returns whether two listen addresses of the same IP family would conflict, either because they are the same
+// or because one of them listens on all addresses.
+// IPv4 and IPv6 addresses are listened on separately, so they never overlap.
+// Invalid addresses never overlap.
+func overlaps(a netip.AddrPort, b netip.AddrPort) bool {
+	if !a.IsValid() || !b.IsValid() || a.Port() != b.Port() {
+		return false
+	}
+	aAddr, bAddr := a.Addr(), b.Addr()
+	if aAddr.Is4() != bAddr.Is4() {
+		return false
+	}
+	return aAddr == bAddr || aAddr.IsUnspecified() || bAddr.IsUnspecified()
+}
+
+// unknownFields returns the paths of the fields in a decoded JSON value that do not exist in typ.
+// Like json.Unmarshal, field names are matched without regard to case.
+func unknownFields(prefix string, val any, typ reflect.Type) []string {
+	for typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	res := make([]string, 0)
+	switch typ.Kind() {
+	case reflect.Struct:
+		obj, ok := val.(map[string]any)
+		if !ok {
+			return res
+		}
+
+		// Sort keys so that problems are reported in the same order every time.
+		keys := make([]string, 0, len(obj))
+		for key := range obj {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		for _, key := range keys {
+			path := key
+			if prefix != "" {
+				path = prefix + "." + key
+			}
+
+			field, ok := jsonField(typ, key)
+			if !ok {
+				res = append(res, path)
+				continue
+			}
+			res = append(res, unknownFields(path, obj[key], field.Type)...)
+		}
+	case reflect.Slice, reflect.Array:
+		arr, ok := val.([]any)
+		if !ok {
+			return res
+		}
+		for i, elem := range arr {
+			res = append(res, unknownFields(fmt.Sprintf("%s[%d]", prefix, i), elem, typ.Elem())...)
+		}
+	case reflect.Map:
+		obj, ok := val.(map[string]any)
+		if !ok {
+			return res
+		}
+		for key, elem := range obj {
+			res = append(res, unknownFields(fmt.Sprintf("%s[%q]", prefix, key), elem, typ.Elem())...)
+		}
+	default:
+	}
+	return res
+}
+
+// jsonField returns the field of struct type typ that a JSON object key decodes into.
+func jsonField(typ reflect.Type, key string) (reflect.StructField, bool) {
+	for field := range typ.Fields() {
+		if !field.IsExported() {
+			continue
+		}
+		tag := field.Tag.Get("json")
+		if tag == "-" {
+			continue
+		}
+		name, _, _ := strings.Cut(tag, ",")
+		if name == "" {
+			name = field.Name
+		}
+		if strings.EqualFold(name, key) {
+			return field, true
+		}
+	}
+	return reflect.StructField{}, false
+}
@@ -0,0 +1,80 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestValidateConfigDefault(t *testing.T) {
+	data, err := json.Marshal(Default)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cfg, problems := ValidateConfig(data)
+	if cfg == nil || len(problems) != 0 {
+		t.Fatalf("expected default config to have no problems, got %v", problems)
+	}
+}
+
+func TestValidateConfigReportsAllProblems(t *testing.T) {
+	data := []byte(`{
+		"listen": ["0.0.0.0:20038", "127.0.0.1:20038", "[::]:20038", "localhost:20038"],
+		"db_path": "server.db",
+		"pem_path": "server.pem",
+		"lobby": {"timeout_seconds": -1, "max_concurent": 5},
+		"rpc": {
+			"interfaces": [
+				{"address": "http://127.0.0.1:8080", "allowed_methods": ["GetRooms", "GetRoomz"]},
+				{"address": "https://0.0.0.0:8080", "allowed_methods": ["*"], "allowed_ips": ["not an ip"]},
+				{"address": "ftp://127.0.0.1:21", "role": "owner"}
+			]
+		},
+		"extra": true
+	}`)
+
+	cfg, problems := ValidateConfig(data)
+	if cfg == nil {
+		t.Fatal("expected config to be parsed")
+	}
+
+	want := map[Problem]bool{
+		{Field: "listen[1]", Message: `"127.0.0.1:20038" overlaps with listen[0] "0.0.0.0:20038"`}:                                      true,
+		{Field: "lobby", Message: "values cannot be negative"}:                                                                          true,
+		{Field: "rpc.interfaces[0].allowed_methods[1]", Message: `unknown method "GetRoomz"`}:                                           true,
+		{Field: "rpc.interfaces[1].address", Message: `"https://0.0.0.0:8080" overlaps with rpc.interfaces[0] "http://127.0.0.1:8080"`}: true,
+		{Field: "rpc.interfaces[1].allowed_ips[0]", Message: `"not an ip" is not a valid IP address`}:                                   true,
+		{Field: "rpc.interfaces[2].role", Message: `unknown role "owner", must be "viewer", "operator" or "admin"`}:                     true,
+		{Field: "lobby.max_concurent", Message: "unknown field, it will be ignored", Warning: true}:                                     true,
+		{Field: "extra", Message: "unknown field, it will be ignored", Warning: true}:                                                   true,
+	}
+	got := make(map[Problem]bool, len(problems))
+	for _, p := range problems {
+		got[p] = true
+	}
+	for p := range want {
+		if !got[p] {
+			t.Errorf("missing problem %q", p)
+		}
+	}
+
+	// The IPv6 wildcard does not overlap with IPv4 addresses, but hostnames are not allowed.
+	var listenProblems, rpcAddrProblems int
+	for _, p := range problems {
+		switch p.Field {
+		case "listen[2]":
+			t.Errorf("unexpected problem %q", p)
+		case "listen[3]":
+			listenProblems++
+		case "rpc.interfaces[2].address":
+			rpcAddrProblems++
+		}
+	}
+	if listenProblems != 1 || rpcAddrProblems != 1 {
+		t.Errorf("expected hostname listen address and unsupported RPC protocol to be reported, got %v", problems)
+	}
+
+	if ProblemsErr(problems) == nil {
+		t.Error("expected problems to be an error")
+	}
+}
@@ -0,0 +1,83 @@
+package lobby
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// connRateLimiterPruneInterval is how often connRateLimiter forgets addresses that are no longer limited.
+const connRateLimiterPruneInterval = 1 * time.Minute
+
+// connBucket is the token bucket of a single IP address.
+type connBucket struct {
+	tokens float64
+	lastTs time.Time
+}
+
+// connRateLimiter limits how many new connections each IP address can make per minute.
+// Unlike AuthLimiter, it is kept in memory, since it needs to be cheap enough to check for every connection in a flood.
+// Each address has a token bucket that holds up to a minute's worth of connections and refills continuously, so
+// bursts up to the limit are allowed.
+// It is safe for concurrent use.
+type connRateLimiter struct {
+	mu sync.Mutex
+
+	buckets map[string]*connBucket
+	pruneTs time.Time
+}
+
+func newConnRateLimiter() *connRateLimiter {
+	return &connRateLimiter{
+		buckets: make(map[string]*connBucket),
+		pruneTs: time.Now(),
+	}
+}
+
+// allow records a new connection from addr and returns whether it is within perMinute.
+// If perMinute is 0, every connection is allowed and nothing is recorded.
+func (l *connRateLimiter) allow(addr net.Addr, perMinute int) bool {
+	if perMinute <= 0 {
+		return true
+	}
+
+	capacity := float64(perMinute)
+	ratePerSec := capacity / 60
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(l.pruneTs) >= connRateLimiterPruneInterval {
+		l.pruneNoLock(now, capacity, ratePerSec)
+	}
+
+	subject := addrToIpSubject(addr)
+	bucket, has := l.buckets[subject]
+	if !has {
+		bucket = &connBucket{
+			tokens: capacity,
+			lastTs: now,
+		}
+		l.buckets[subject] = bucket
+	} else {
+		bucket.tokens = min(capacity, bucket.tokens+now.Sub(bucket.lastTs).Seconds()*ratePerSec)
+		bucket.lastTs = now
+	}
+
+	if bucket.tokens < 1 {
+		return false
+	}
+	bucket.tokens--
+	return true
+}
+
+// pruneNoLock forgets addresses whose buckets have refilled, since they are the same as new ones.
+func (l *connRateLimiter) pruneNoLock(now time.Time, capacity float64, ratePerSec float64) {
+	for subject, bucket := range l.buckets {
+		if bucket.tokens+now.Sub(bucket.lastTs).Seconds()*ratePerSec >= capacity {
+			delete(l.buckets, subject)
+		}
+	}
+	l.pruneTs = now
+}
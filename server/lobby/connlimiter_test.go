@@ -0,0 +1,28 @@
+package lobby
+
+import (
+	"net"
+	"testing"
+)
+
+func TestConnRateLimiterLimitsPerIp(t *testing.T) {
+	l := newConnRateLimiter()
+
+	addrA := &net.UDPAddr{IP: net.ParseIP("192.0.2.1"), Port: 1000}
+	addrB := &net.UDPAddr{IP: net.ParseIP("192.0.2.2"), Port: 1000}
+
+	for i := range 3 {
+		if !l.allow(addrA, 3) {
+			t.Fatalf("connection %d should be allowed", i+1)
+		}
+	}
+	if l.allow(&net.UDPAddr{IP: addrA.IP, Port: 2000}, 3) {
+		t.Fatal("connection past the limit should be rejected, even from another port")
+	}
+	if !l.allow(addrB, 3) {
+		t.Fatal("other IP addresses should not be limited")
+	}
+	if !l.allow(addrA, 0) {
+		t.Fatal("no connections should be rejected without a limit")
+	}
+}
@@ -0,0 +1,185 @@
+package lobby
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/netip"
+	"time"
+
+	"friendnet.org/common"
+	"friendnet.org/server/storage"
+)
+
+// AuthLimiterConfig is the configuration for an AuthLimiter.
+type AuthLimiterConfig struct {
+	// The maximum number of failed attempts from a single IP address within the window.
+	// Specify 0 to disable per-IP limiting.
+	MaxIpFailures int
+
+	// The maximum number of failed attempts for a single account within the window.
+	// Specify 0 to disable per-account limiting.
+	MaxAccountFailures int
+
+	// The window in which failed attempts are counted.
+	Window time.Duration
+
+	// How long an IP address or account is locked out after reaching its limit.
+	Lockout time.Duration
+}
+
+// AuthLimiter provides brute-force protection for authentication.
+// It counts failed authentication attempts per IP address and per account, and locks them out when they exceed
+// their limits.
+// Counters are persisted in storage so that they survive restarts.
+type AuthLimiter struct {
+	logger  *slog.Logger
+	storage storage.Storage
+	cfg     AuthLimiterConfig
+
+	gcInterval time.Duration
+}
+
+// NewAuthLimiter creates a new AuthLimiter.
+// It periodically deletes stale counters from storage until ctx is done.
+func NewAuthLimiter(
+	ctx context.Context,
+	logger *slog.Logger,
+	storage storage.Storage,
+	cfg AuthLimiterConfig,
+) *AuthLimiter {
+	if cfg.Window <= 0 {
+		panic("auth limiter window must be positive")
+	}
+	if cfg.Lockout <= 0 {
+		panic("auth limiter lockout must be positive")
+	}
+
+	l := &AuthLimiter{
+		logger:  logger,
+		storage: storage,
+		cfg:     cfg,
+
+		gcInterval: 10 * time.Minute,
+	}
+
+	go l.gc(ctx)
+
+	return l
+}
+
+func (l *AuthLimiter) gc(ctx context.Context) {
+	ticker := time.NewTicker(l.gcInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			deleted, err := l.storage.DeleteStaleAuthAttempts(ctx, time.Now().Add(-l.cfg.Window))
+			if err != nil {
+				l.logger.Error("failed to delete stale auth attempts",
+					"service", "lobby.AuthLimiter",
+					"err", err,
+				)
+				continue
+			}
+
+			if deleted > 0 {
+				l.logger.Debug("deleted stale auth attempts",
+					"service", "lobby.AuthLimiter",
+					"total", deleted,
+				)
+			}
+		}
+	}
+}
+
+// addrToIpSubject returns the IP address subject for the specified address.
+// If the address does not contain an IP, the full address string is used.
+func addrToIpSubject(addr net.Addr) string {
+	if addrPort, err := netip.ParseAddrPort(addr.String()); err == nil {
+		return addrPort.Addr().Unmap().String()
+	}
+	return addr.String()
+}
+
+func accountSubject(room common.NormalizedRoomName, username common.NormalizedUsername) string {
+	return room.String() + "/" + username.String()
+}
+
+func (l *AuthLimiter) isLocked(ctx context.Context, kind storage.AuthAttemptKind, subject string) (bool, error) {
+	rec, has, err := l.storage.GetAuthAttempt(ctx, kind, subject)
+	if err != nil {
+		return false, fmt.Errorf(`failed to get auth attempt record for %s %q: %w`, kind, subject, err)
+	}
+	if !has {
+		return false, nil
+	}
+
+	return time.Now().Before(rec.LockedUntilTs), nil
+}
+
+// IsIpLocked returns whether the IP address of the specified remote address is locked out.
+func (l *AuthLimiter) IsIpLocked(ctx context.Context, addr net.Addr) (bool, error) {
+	if l.cfg.MaxIpFailures == 0 {
+		return false, nil
+	}
+
+	return l.isLocked(ctx, storage.AuthAttemptKindIp, addrToIpSubject(addr))
+}
+
+// IsAccountLocked returns whether the specified account is locked out.
+// The account does not need to exist.
+func (l *AuthLimiter) IsAccountLocked(ctx context.Context, room common.NormalizedRoomName, username common.NormalizedUsername) (bool, error) {
+	if l.cfg.MaxAccountFailures == 0 {
+		return false, nil
+	}
+
+	return l.isLocked(ctx, storage.AuthAttemptKindAccount, accountSubject(room, username))
+}
+
+func (l *AuthLimiter) recordFailure(ctx context.Context, kind storage.AuthAttemptKind, subject string, max int) error {
+	if max == 0 {
+		return nil
+	}
+
+	now := time.Now()
+	failures, err := l.storage.IncrementAuthFailures(ctx, kind, subject, now.Add(-l.cfg.Window))
+	if err != nil {
+		return err
+	}
+
+	if failures < max {
+		return nil
+	}
+
+	l.logger.Warn("too many failed authentication attempts, locking out",
+		"service", "lobby.AuthLimiter",
+		"kind", string(kind),
+		"subject", subject,
+		"failures", failures,
+		"lockout", l.cfg.Lockout.String(),
+	)
+
+	return l.storage.LockAuthSubject(ctx, kind, subject, now.Add(l.cfg.Lockout))
+}
+
+// RecordIpFailure records a failed authentication attempt from the IP address of the specified remote address.
+func (l *AuthLimiter) RecordIpFailure(ctx context.Context, addr net.Addr) error {
+	return l.recordFailure(ctx, storage.AuthAttemptKindIp, addrToIpSubject(addr), l.cfg.MaxIpFailures)
+}
+
+// RecordAccountFailure records a failed authentication attempt for the specified account.
+// The account does not need to exist.
+func (l *AuthLimiter) RecordAccountFailure(ctx context.Context, room common.NormalizedRoomName, username common.NormalizedUsername) error {
+	return l.recordFailure(ctx, storage.AuthAttemptKindAccount, accountSubject(room, username), l.cfg.MaxAccountFailures)
+}
+
+// RecordAccountSuccess clears the failure counter for the specified account.
+// The per-IP counter is left untouched so that a single valid account cannot be used to reset it.
+func (l *AuthLimiter) RecordAccountSuccess(ctx context.Context, room common.NormalizedRoomName, username common.NormalizedUsername) error {
+	return l.storage.DeleteAuthAttempt(ctx, storage.AuthAttemptKindAccount, accountSubject(room, username))
+}
@@ -0,0 +1,161 @@
+package lobby
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+	"friendnet.org/server/storage"
+)
+
+func newTestLimiter(t *testing.T, cfg AuthLimiterConfig) *AuthLimiter {
+	t.Helper()
+
+	st, err := storage.NewSqliteStorage(slog.New(slog.DiscardHandler), filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	t.Cleanup(func() {
+		_ = st.Close()
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	return NewAuthLimiter(ctx, slog.New(slog.DiscardHandler), st, cfg)
+}
+
+func TestAuthLimiterLocksAccount(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLimiter(t, AuthLimiterConfig{
+		MaxAccountFailures: 3,
+		Window:             time.Minute,
+		Lockout:            time.Minute,
+	})
+
+	room := common.UncheckedCreateNormalizedRoomName("room")
+	username := common.UncheckedCreateNormalizedUsername("user")
+
+	for i := 0; i < 2; i++ {
+		if err := l.RecordAccountFailure(ctx, room, username); err != nil {
+			t.Fatalf("failed to record failure: %v", err)
+		}
+		locked, err := l.IsAccountLocked(ctx, room, username)
+		if err != nil {
+			t.Fatalf("failed to check lock: %v", err)
+		}
+		if locked {
+			t.Fatalf("account locked after %d failures", i+1)
+		}
+	}
+
+	if err := l.RecordAccountFailure(ctx, room, username); err != nil {
+		t.Fatalf("failed to record failure: %v", err)
+	}
+	locked, err := l.IsAccountLocked(ctx, room, username)
+	if err != nil {
+		t.Fatalf("failed to check lock: %v", err)
+	}
+	if !locked {
+		t.Fatal("expected account to be locked")
+	}
+
+	// Other accounts must not be affected.
+	locked, err = l.IsAccountLocked(ctx, room, common.UncheckedCreateNormalizedUsername("other"))
+	if err != nil {
+		t.Fatalf("failed to check lock: %v", err)
+	}
+	if locked {
+		t.Fatal("expected other account not to be locked")
+	}
+}
+
+func TestAuthLimiterSuccessResetsAccount(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLimiter(t, AuthLimiterConfig{
+		MaxAccountFailures: 2,
+		Window:             time.Minute,
+		Lockout:            time.Minute,
+	})
+
+	room := common.UncheckedCreateNormalizedRoomName("room")
+	username := common.UncheckedCreateNormalizedUsername("user")
+
+	if err := l.RecordAccountFailure(ctx, room, username); err != nil {
+		t.Fatalf("failed to record failure: %v", err)
+	}
+	if err := l.RecordAccountSuccess(ctx, room, username); err != nil {
+		t.Fatalf("failed to record success: %v", err)
+	}
+	if err := l.RecordAccountFailure(ctx, room, username); err != nil {
+		t.Fatalf("failed to record failure: %v", err)
+	}
+
+	locked, err := l.IsAccountLocked(ctx, room, username)
+	if err != nil {
+		t.Fatalf("failed to check lock: %v", err)
+	}
+	if locked {
+		t.Fatal("expected account not to be locked after successful authentication reset")
+	}
+}
+
+func TestAuthLimiterLocksIp(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLimiter(t, AuthLimiterConfig{
+		MaxIpFailures: 1,
+		Window:        time.Minute,
+		Lockout:       time.Minute,
+	})
+
+	addr := &net.UDPAddr{IP: net.ParseIP("192.0.2.1"), Port: 1234}
+	samePeerOtherPort := &net.UDPAddr{IP: net.ParseIP("192.0.2.1"), Port: 5678}
+	otherAddr := &net.UDPAddr{IP: net.ParseIP("192.0.2.2"), Port: 1234}
+
+	if err := l.RecordIpFailure(ctx, addr); err != nil {
+		t.Fatalf("failed to record failure: %v", err)
+	}
+
+	locked, err := l.IsIpLocked(ctx, samePeerOtherPort)
+	if err != nil {
+		t.Fatalf("failed to check lock: %v", err)
+	}
+	if !locked {
+		t.Fatal("expected IP to be locked regardless of port")
+	}
+
+	locked, err = l.IsIpLocked(ctx, otherAddr)
+	if err != nil {
+		t.Fatalf("failed to check lock: %v", err)
+	}
+	if locked {
+		t.Fatal("expected other IP not to be locked")
+	}
+}
+
+func TestAuthLimiterDisabledLimits(t *testing.T) {
+	ctx := context.Background()
+	l := newTestLimiter(t, AuthLimiterConfig{
+		Window:  time.Minute,
+		Lockout: time.Minute,
+	})
+
+	addr := &net.UDPAddr{IP: net.ParseIP("192.0.2.1"), Port: 1234}
+	for i := 0; i < 10; i++ {
+		if err := l.RecordIpFailure(ctx, addr); err != nil {
+			t.Fatalf("failed to record failure: %v", err)
+		}
+	}
+
+	locked, err := l.IsIpLocked(ctx, addr)
+	if err != nil {
+		t.Fatalf("failed to check lock: %v", err)
+	}
+	if locked {
+		t.Fatal("expected IP not to be locked when limiting is disabled")
+	}
+}
@@ -5,9 +5,11 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	"time"
+	"net"
+	"sync/atomic"
 
 	"friendnet.org/common"
+	"friendnet.org/common/password"
 	"friendnet.org/protocol"
 	pb "friendnet.org/protocol/pb/v1"
 	"friendnet.org/server/room"
@@ -15,8 +17,15 @@ import (
 	mcfpassword "github.com/termermc/go-mcf-password"
 )
 
-// DefaultTimeout is the default timeout for connections in the lobby (unauthenticated).
-const DefaultTimeout = 10 * time.Second
+// RegistrationConfig configures whether and how clients can register their own accounts from the lobby.
+type RegistrationConfig struct {
+	// Whether clients can register new accounts with MSG_TYPE_REGISTER.
+	Enabled bool
+
+	// Whether registration requires a single-use invite code.
+	// If false, an invite code is still redeemed if the client provides one.
+	RequireInviteCode bool
+}
 
 // Lobby is where clients go when they first connect.
 // It accepts new connections and handles authentication.
@@ -24,111 +33,298 @@ const DefaultTimeout = 10 * time.Second
 type Lobby struct {
 	logger *slog.Logger
 
-	storage *storage.Storage
-	roomMgr *room.Manager
+	storage      storage.Storage
+	roomMgr      *room.Manager
+	authLimiter  *AuthLimiter
+	registration RegistrationConfig
+
+	settings atomic.Pointer[Settings]
+
+	connLimiter *connRateLimiter
+
+	// The number of connections currently in the lobby.
+	onboarding atomic.Int64
+
+	accepted            atomic.Uint64
+	rejectedDraining    atomic.Uint64
+	rejectedRateLimited atomic.Uint64
+	rejectedBusy        atomic.Uint64
+}
+
+// Stats are counters of connections that reached the lobby since the server started.
+type Stats struct {
+	// The number of connections that entered the lobby.
+	Accepted uint64
+
+	// The number of connections rejected because the server was draining.
+	RejectedDraining uint64
 
-	timeout   time.Duration
-	serverVer *pb.ProtoVersion
+	// The number of connections rejected because their IP address made too many connections.
+	RejectedRateLimited uint64
+
+	// The number of connections rejected because too many connections were already in the lobby.
+	RejectedBusy uint64
+
+	// The number of connections currently in the lobby.
+	Onboarding int64
 }
 
 // NewLobby creates a new lobby instance.
-// The timeout is how long a connection can stay in the lobby until it is disconnected.
+// The settings can be changed later with SetSettings.
+// If authLimiter is nil, authentication attempts will not be rate-limited.
+// Panics if the settings are invalid.
 func NewLobby(
 	logger *slog.Logger,
 
-	storage *storage.Storage,
+	storage storage.Storage,
 	roomMgr *room.Manager,
+	authLimiter *AuthLimiter,
+	registration RegistrationConfig,
 
-	timeout time.Duration,
-	serverVer *pb.ProtoVersion,
+	settings Settings,
 ) *Lobby {
-	if timeout <= 0 {
-		panic("lobby timeout must be positive")
-	}
-	if serverVer == nil {
-		panic("server version cannot be nil")
+	if err := settings.Validate(); err != nil {
+		panic(err)
 	}
 
-	return &Lobby{
+	l := &Lobby{
 		logger: logger,
 
-		storage: storage,
-		roomMgr: roomMgr,
+		storage:      storage,
+		roomMgr:      roomMgr,
+		authLimiter:  authLimiter,
+		registration: registration,
+
+		connLimiter: newConnRateLimiter(),
+	}
+	l.settings.Store(&settings)
+
+	return l
+}
+
+// Registration returns how clients can register their own accounts.
+func (l *Lobby) Registration() RegistrationConfig {
+	return l.registration
+}
+
+// IsAuthRateLimited returns whether failed authentication attempts are rate-limited.
+func (l *Lobby) IsAuthRateLimited() bool {
+	return l.authLimiter != nil
+}
+
+// Settings returns the lobby's current settings.
+func (l *Lobby) Settings() Settings {
+	return *l.settings.Load()
+}
+
+// SetSettings replaces the lobby's settings.
+// They apply to connections that enter the lobby afterward; connections already in it keep the settings they entered
+// with.
+// Returns an error if the settings are invalid.
+func (l *Lobby) SetSettings(settings Settings) error {
+	if err := settings.Validate(); err != nil {
+		return err
+	}
+
+	l.settings.Store(&settings)
+	return nil
+}
+
+// Stats returns the lobby's connection counters.
+func (l *Lobby) Stats() Stats {
+	return Stats{
+		Accepted:            l.accepted.Load(),
+		RejectedDraining:    l.rejectedDraining.Load(),
+		RejectedRateLimited: l.rejectedRateLimited.Load(),
+		RejectedBusy:        l.rejectedBusy.Load(),
+		Onboarding:          l.onboarding.Load(),
+	}
+}
 
-		timeout:   timeout,
-		serverVer: serverVer,
+// admit decides whether a new connection can enter the lobby.
+// If it can, it is counted as onboarding, and the caller must decrement l.onboarding once it leaves the lobby.
+// Otherwise, the reason to close it with is returned.
+func (l *Lobby) admit(conn protocol.ProtoConn, settings *Settings) (reason string, ok bool) {
+	if l.roomMgr.Drain().IsDraining() {
+		l.rejectedDraining.Add(1)
+		return "server is draining, try again later", false
 	}
+
+	if !l.connLimiter.allow(conn.RemoteAddr(), settings.MaxConnsPerIpPerMinute) {
+		l.rejectedRateLimited.Add(1)
+		return "too many connections, try again later", false
+	}
+
+	onboarding := l.onboarding.Add(1)
+	if settings.MaxConcurrent > 0 && onboarding > int64(settings.MaxConcurrent) {
+		l.onboarding.Add(-1)
+		l.rejectedBusy.Add(1)
+		return "server is busy, try again later", false
+	}
+
+	l.accepted.Add(1)
+	return "", true
 }
 
 // Onboard takes ownership of a connection and performs negotiation and authentication steps.
+// It is meant to be called from the accept loop: connections that cannot enter the lobby, such as those from an IP
+// address that is connecting too often, are closed right away, without doing any other work for them.
 // It returns immediately.
 func (l *Lobby) Onboard(conn protocol.ProtoConn) {
+	settings := l.settings.Load()
+
+	if reason, ok := l.admit(conn, settings); !ok {
+		_ = conn.CloseWithCode(protocol.CloseCodeUnavailable, reason)
+		return
+	}
+
 	// Onboard in its own goroutine so that the method can return immediately.
 	go func() {
-		lobbyCtx, lobbyCancel := context.WithTimeout(context.Background(), l.timeout)
+		defer l.onboarding.Add(-1)
+
+		lobbyCtx, lobbyCancel := context.WithTimeout(context.Background(), settings.Timeout)
 		defer lobbyCancel()
 
-		clientVer, err := l.negotiateClientVersion(lobbyCtx, conn)
+		clientVer, err := l.negotiateClientVersion(lobbyCtx, conn, settings)
 		if err != nil {
-			_ = conn.CloseWithReason(err.Error())
+			_ = conn.CloseWithCode(closeCodeForOnboardErr(err), err.Error())
 			return
 		}
 
-		authBidi, authRoom, authUsername, err := l.authenticateClient(
+		authBidi, authRoom, authUsername, authIsGuest, err := l.authenticateClient(
 			lobbyCtx,
 			conn,
 		)
 		if err != nil {
-			_ = conn.CloseWithReason(err.Error())
+			_ = conn.CloseWithCode(closeCodeForOnboardErr(err), err.Error())
 			return
 		}
 
-		// Get room instance from the manager.
-		roomInst, has := l.roomMgr.GetRoomByName(authRoom)
-		if !has {
-			_ = authBidi.WriteInternalError(nil)
+		// From here on, the connection can carry more than one room.
+		session := protocol.NewSession(conn, l.joinHandler(clientVer))
+
+		code, reason, ok := l.enterRoom(authBidi, session.Primary(), clientVer, authRoom, authUsername, authIsGuest)
+		if !ok {
 			_ = authBidi.Close()
-			_ = conn.CloseWithReason("room not found")
+			_ = session.Primary().CloseWithCode(code, reason)
+		}
+	}()
+}
+
+// enterRoom passes ownership of an authenticated connection to the specified room.
+// The room sends the success message on authBidi if successful.
+// Otherwise, the appropriate error reply is written to authBidi, and the code and reason the connection should be
+// closed with are returned.
+func (l *Lobby) enterRoom(
+	authBidi protocol.ProtoBidi,
+	conn protocol.ProtoConn,
+	clientVer *pb.ProtoVersion,
+	roomName common.NormalizedRoomName,
+	username common.NormalizedUsername,
+	isGuest bool,
+) (code protocol.CloseCode, reason string, ok bool) {
+	// Get room instance from the manager.
+	roomInst, has := l.roomMgr.GetRoomByName(roomName)
+	if !has {
+		_ = authBidi.WriteInternalError(nil)
+		return protocol.CloseCodeUnavailable, "room not found", false
+	}
+
+	err := roomInst.Onboard(authBidi, conn, clientVer, username, isGuest)
+	if err == nil {
+		return 0, "", true
+	}
+
+	if errors.Is(err, room.ErrUsernameAlreadyConnected) {
+		msg := "username already connected"
+		_ = authBidi.Write(pb.MsgType_MSG_TYPE_AUTH_REJECTED, &pb.MsgAuthRejected{
+			Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_ALREADY_CONNECTED,
+			Message: &msg,
+		})
+		return protocol.CloseCodeUnavailable, msg, false
+	}
+	if errors.Is(err, room.ErrRoomFull) {
+		msg := "room is full"
+		_ = authBidi.Write(pb.MsgType_MSG_TYPE_AUTH_REJECTED, &pb.MsgAuthRejected{
+			Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_ROOM_FULL,
+			Message: &msg,
+		})
+		return protocol.CloseCodeUnavailable, msg, false
+	}
+
+	l.logger.Error("failed to onboard client to room",
+		"service", "main.Lobby",
+		"room", roomName.String(),
+		"username", username.String(),
+		"err", err,
+	)
+
+	_ = authBidi.WriteInternalError(err)
+	return protocol.CloseCodeInternalError, "internal error", false
+}
+
+// joinHandler returns the handler for MSG_TYPE_JOIN_ROOM requests on a client's session.
+// Joining a room takes the same credentials as authenticating, and is subject to the same rate limits.
+func (l *Lobby) joinHandler(clientVer *pb.ProtoVersion) protocol.JoinHandler {
+	return func(session *protocol.Session, bidi protocol.ProtoBidi, msg *pb.MsgJoinRoom) {
+		ctx, cancel := context.WithTimeout(context.Background(), l.Settings().Timeout)
+		defer cancel()
+
+		roomName, username, isGuest, err := l.verifyCredentials(
+			ctx,
+			session.RemoteAddr(),
+			msg.Room,
+			msg.Username,
+			msg.Password,
+		)
+		if err != nil {
+			writeAuthErr(bidi, err)
 			return
 		}
 
-		// Pass ownership of connection to the room instance.
-		// The room will send the success message to the client if successful.
-		err = roomInst.Onboard(authBidi, conn, clientVer, authUsername)
+		scope, err := session.AddScope(msg.ScopeId)
 		if err != nil {
-			if errors.Is(err, room.ErrUsernameAlreadyConnected) {
-				msg := "username already connected"
-				_ = authBidi.Write(pb.MsgType_MSG_TYPE_AUTH_REJECTED, &pb.MsgAuthRejected{
-					Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_ALREADY_CONNECTED,
-					Message: &msg,
-				})
-				_ = authBidi.Close()
-				_ = conn.CloseWithReason(msg)
-				return
-			}
+			_ = bidi.WriteError(pb.ErrType_ERR_TYPE_INVALID_FIELDS, err.Error())
+			return
+		}
 
-			l.logger.Error("failed to onboard client to room",
-				"service", "main.Lobby",
-				"room", authRoom.String(),
-				"username", authUsername.String(),
-				"err", err,
-			)
+		if _, _, ok := l.enterRoom(bidi, scope, clientVer, roomName, username, isGuest); !ok {
+			scope.Discard()
+		}
+	}
+}
 
-			_ = authBidi.WriteInternalError(err)
-			_ = authBidi.Close()
-			_ = conn.CloseWithReason("internal error")
-			return
+// closeCodeForOnboardErr returns the close code to use when version negotiation or authentication fails with err.
+func closeCodeForOnboardErr(err error) protocol.CloseCode {
+	if _, is := errors.AsType[protocol.VersionRejectedError](err); is {
+		return protocol.CloseCodeAuthFailed
+	}
+	if rejErr, is := errors.AsType[protocol.AuthRejectedError](err); is {
+		switch rejErr.Reason {
+		case pb.AuthRejectionReason_AUTH_REJECTION_REASON_RATE_LIMITED,
+			pb.AuthRejectionReason_AUTH_REJECTION_REASON_ALREADY_CONNECTED,
+			pb.AuthRejectionReason_AUTH_REJECTION_REASON_ROOM_FULL:
+			// These may succeed if the client tries again later.
+			return protocol.CloseCodeUnavailable
+		default:
+			return protocol.CloseCodeAuthFailed
 		}
-	}()
+	}
+	if _, is := errors.AsType[protocol.UnexpectedMsgTypeError](err); is {
+		return protocol.CloseCodeProtocolViolation
+	}
+	return protocol.CloseCodeInternalError
 }
 
 // negotiateClientVersion performs the version negotiation phase with the provided connection.
-// If the negotiation succeeds, the client's version will be returned.
-// Negotiation will fail with an error if the client and server versions are incompatible.
+// If the negotiation succeeds, the client's version will be returned, and the capabilities both sides support will
+// be recorded on conn with protocol.SetCapabilities.
+// Negotiation will fail with an error if the client's version is outside the range accepted by the settings.
 // This method still takes care of sending the appropriate reply to the client's authentication request, even if there was an error.
 func (l *Lobby) negotiateClientVersion(
 	ctx context.Context,
 	conn protocol.ProtoConn,
+	settings *Settings,
 ) (clientVer *pb.ProtoVersion, finalErr error) {
 	bidi, bidiErr := conn.WaitForBidi(ctx)
 	if bidiErr != nil {
@@ -138,6 +334,9 @@ func (l *Lobby) negotiateClientVersion(
 		_ = bidi.Close()
 	}()
 
+	// The capabilities both sides support, which are recorded on the connection if negotiation succeeds.
+	var caps []pb.Capability
+
 	finalErr = func() error {
 		msg, err := protocol.ReadExpect[*pb.MsgVersion](bidi.ProtoStreamReader, pb.MsgType_MSG_TYPE_VERSION)
 		if err != nil {
@@ -145,6 +344,7 @@ func (l *Lobby) negotiateClientVersion(
 		}
 
 		clientVer = msg.Payload.Version
+		caps = protocol.IntersectCapabilities(msg.Payload.Capabilities, protocol.SupportedCapabilities(conn))
 
 		if clientVer == nil {
 			return &protocol.VersionRejectedError{
@@ -153,17 +353,14 @@ func (l *Lobby) negotiateClientVersion(
 			}
 		}
 
-		// Check if versions are the same, or at least the major and minor parts are the same.
-		cmp := protocol.CompareProtoVersions(clientVer, l.serverVer)
-		if cmp == 0 || (clientVer.Major == l.serverVer.Major && clientVer.Minor == l.serverVer.Minor) {
-			return nil
-		}
-
+		// Check if the version is within the accepted range.
 		var reason pb.VersionRejectionReason
-		if cmp > 0 {
+		if compareMajorMinor(clientVer, settings.MinVersion) < 0 {
+			reason = pb.VersionRejectionReason_VERSION_REJECTION_REASON_TOO_OLD
+		} else if compareMajorMinor(clientVer, settings.MaxVersion) > 0 {
 			reason = pb.VersionRejectionReason_VERSION_REJECTION_REASON_TOO_NEW
 		} else {
-			reason = pb.VersionRejectionReason_VERSION_REJECTION_REASON_TOO_OLD
+			return nil
 		}
 
 		return &protocol.VersionRejectedError{
@@ -188,12 +385,20 @@ func (l *Lobby) negotiateClientVersion(
 		return clientVer, finalErr
 	}
 
-	return clientVer, bidi.Write(pb.MsgType_MSG_TYPE_VERSION_ACCEPTED, &pb.MsgVersionAccepted{})
+	err := bidi.Write(pb.MsgType_MSG_TYPE_VERSION_ACCEPTED, &pb.MsgVersionAccepted{
+		Capabilities: caps,
+	})
+	if err != nil {
+		return nil, err
+	}
+	protocol.SetCapabilities(conn, caps)
+	return clientVer, nil
 }
 
 // authenticateClient performs the authentication phase with the provided connection.
-// If the authentication succeeds, the client's room and username will be returned.
+// If the authentication succeeds, the client's room, username and whether the account is a guest will be returned.
 // Authentication will fail with an error if the client provides invalid credentials.
+// If registration is enabled, the client may send MSG_TYPE_REGISTER instead to create an account and authenticate with it.
 //
 // This method still takes care of sending the appropriate error reply to the client's authentication request, if any.
 // It will NOT send the success message.
@@ -204,12 +409,12 @@ func (l *Lobby) negotiateClientVersion(
 func (l *Lobby) authenticateClient(
 	ctx context.Context,
 	conn protocol.ProtoConn,
-) (authBidi protocol.ProtoBidi, room common.NormalizedRoomName, username common.NormalizedUsername, finalErr error) {
+) (authBidi protocol.ProtoBidi, room common.NormalizedRoomName, username common.NormalizedUsername, isGuest bool, finalErr error) {
 	isSuccess := false
 	var bidiErr error
 	authBidi, bidiErr = conn.WaitForBidi(ctx)
 	if bidiErr != nil {
-		return authBidi, room, username, fmt.Errorf("failed to wait for authentication stream: %w", bidiErr)
+		return authBidi, room, username, isGuest, fmt.Errorf("failed to wait for authentication stream: %w", bidiErr)
 	}
 	defer func() {
 		if !isSuccess {
@@ -218,100 +423,315 @@ func (l *Lobby) authenticateClient(
 	}()
 
 	finalErr = func() error {
-		msg, err := protocol.ReadExpect[*pb.MsgAuthenticate](authBidi.ProtoStreamReader, pb.MsgType_MSG_TYPE_AUTHENTICATE)
+		msg, err := authBidi.Read()
 		if err != nil {
 			return err
 		}
-		authMsg := msg.Payload
 
-		invalidCreds := func() error {
-			return protocol.AuthRejectedError{
-				Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_INVALID_CREDENTIALS,
-				Message: "invalid credentials",
-			}
+		// Authentication and registration change state, so they must not be acted on if they could be 0-RTT replays.
+		err = protocol.WaitUntilReplaySafe(ctx, conn, msg.Type)
+		if err != nil {
+			return err
+		}
+		if msg.Type == pb.MsgType_MSG_TYPE_REGISTER {
+			room, username, err = l.registerClient(ctx, conn, msg.Payload.(*pb.MsgRegister))
+			return err
+		}
+		if msg.Type != pb.MsgType_MSG_TYPE_AUTHENTICATE {
+			return protocol.NewUnexpectedMsgTypeError(pb.MsgType_MSG_TYPE_AUTHENTICATE, msg.Type)
 		}
+		authMsg := msg.Payload.(*pb.MsgAuthenticate)
+
+		room, username, isGuest, err = l.verifyCredentials(
+			ctx,
+			conn.RemoteAddr(),
+			authMsg.Room,
+			authMsg.Username,
+			authMsg.Password,
+		)
+		return err
+	}()
+	if finalErr != nil {
+		// Write appropriate error reply to bidi before closure.
+		writeAuthErr(authBidi, finalErr)
 
-		// Validate room name and username.
-		var isValid bool
-		room, isValid = common.NormalizeRoomName(authMsg.Room)
-		if !isValid {
-			return invalidCreds()
+		room = common.ZeroNormalizedRoomName
+		username = common.ZeroNormalizedUsername
+		isGuest = false
+		return authBidi, room, username, isGuest, finalErr
+	}
+
+	isSuccess = true
+
+	return authBidi, room, username, isGuest, nil
+}
+
+// verifyCredentials checks the specified credentials and returns the normalized room and username, and whether the
+// account is a guest.
+// Returns a protocol.AuthRejectedError if the credentials are invalid or the client is rate-limited.
+// Failures are counted against the specified address and, if it exists, the account.
+func (l *Lobby) verifyCredentials(
+	ctx context.Context,
+	addr net.Addr,
+	roomStr string,
+	usernameStr string,
+	password string,
+) (room common.NormalizedRoomName, username common.NormalizedUsername, isGuest bool, err error) {
+	// Whether the room and username were valid and can be used to count account failures.
+	hasAccountSubject := false
+
+	invalidCreds := func() error {
+		l.recordAuthFailure(ctx, addr, room, username, hasAccountSubject)
+
+		return protocol.AuthRejectedError{
+			Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_INVALID_CREDENTIALS,
+			Message: "invalid credentials",
 		}
-		username, isValid = common.NormalizeUsername(authMsg.Username)
-		if !isValid {
-			return invalidCreds()
+	}
+	rateLimited := func() error {
+		return protocol.AuthRejectedError{
+			Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_RATE_LIMITED,
+			Message: "too many failed authentication attempts, try again later",
 		}
+	}
 
-		// Look up account and verify password.
-		var accountRec storage.AccountRecord
-		var hasAcc bool
-		accountRec, hasAcc, err = l.storage.GetAccountByRoomAndUsername(ctx, room, username)
+	// Check whether the client's address is locked out before doing anything else.
+	if l.authLimiter != nil {
+		locked, err := l.authLimiter.IsIpLocked(ctx, addr)
 		if err != nil {
-			return err
+			return room, username, false, err
 		}
-		if !hasAcc {
-			return invalidCreds()
+		if locked {
+			return room, username, false, rateLimited()
 		}
+	}
 
-		// Check password.
-		var matches bool
-		var needsRehash bool
-		matches, needsRehash, err = mcfpassword.VerifyPassword(authMsg.Password, accountRec.PasswordHash)
+	// Validate room name and username.
+	var isValid bool
+	room, isValid = common.NormalizeRoomName(roomStr)
+	if !isValid {
+		return room, username, false, invalidCreds()
+	}
+	username, isValid = common.NormalizeUsername(usernameStr)
+	if !isValid {
+		return room, username, false, invalidCreds()
+	}
+	hasAccountSubject = true
+
+	if l.authLimiter != nil {
+		locked, err := l.authLimiter.IsAccountLocked(ctx, room, username)
 		if err != nil {
-			return fmt.Errorf(`failed to verify password for account with room %q and username %q: %w`,
+			return room, username, false, err
+		}
+		if locked {
+			return room, username, false, rateLimited()
+		}
+	}
+
+	// Look up account and verify password.
+	accountRec, hasAcc, err := l.storage.GetAccountByRoomAndUsername(ctx, room, username)
+	if err != nil {
+		return room, username, false, err
+	}
+	if !hasAcc {
+		return room, username, false, invalidCreds()
+	}
+	// Check password.
+	matches, needsRehash, err := mcfpassword.VerifyPassword(password, accountRec.PasswordHash)
+	if err != nil {
+		return room, username, false, fmt.Errorf(`failed to verify password for account with room %q and username %q: %w`,
+			room.String(),
+			username.String(),
+			err,
+		)
+	}
+	if !matches {
+		return room, username, false, invalidCreds()
+	}
+
+	// Rehash password if necessary.
+	if needsRehash {
+		newHash, err := mcfpassword.HashPassword(password)
+		if err != nil {
+			return room, username, false, fmt.Errorf(`failed to rehash password for account with room %q and username %q: %w`,
 				room.String(),
 				username.String(),
 				err,
 			)
 		}
-		if !matches {
-			return invalidCreds()
+
+		err = l.storage.UpdateAccountPasswordHash(ctx, room, username, newHash)
+		if err != nil {
+			return room, username, false, fmt.Errorf(`failed to update account with room %q and username %q with rehashed password: %w`,
+				room.String(),
+				username.String(),
+				err,
+			)
+		}
+	}
+
+	if l.authLimiter != nil {
+		err = l.authLimiter.RecordAccountSuccess(ctx, room, username)
+		if err != nil {
+			l.logger.Error("failed to clear auth failures after successful authentication",
+				"service", "main.Lobby",
+				"room", room.String(),
+				"username", username.String(),
+				"err", err,
+			)
+		}
+	}
+
+	return room, username, accountRec.IsGuest, nil
+}
+
+// writeAuthErr writes the reply for a failed authentication or join attempt to the bidi.
+func writeAuthErr(bidi protocol.ProtoBidi, err error) {
+	if rejErr, ok := errors.AsType[protocol.AuthRejectedError](err); ok {
+		_ = bidi.Write(pb.MsgType_MSG_TYPE_AUTH_REJECTED, &pb.MsgAuthRejected{
+			Reason:  rejErr.Reason,
+			Message: &rejErr.Message,
+		})
+	} else if unexpectedErr, ok := errors.AsType[protocol.UnexpectedMsgTypeError](err); ok {
+		_ = bidi.WriteUnexpectedMsgTypeError(unexpectedErr.Expected, unexpectedErr.Actual)
+	} else {
+		_ = bidi.WriteInternalError(err)
+	}
+}
+
+// registerClient handles a MSG_TYPE_REGISTER request by creating the requested account.
+// If registration succeeds, the new account's room and username will be returned and the client is considered authenticated.
+// Failures that could be used to guess invite codes or probe for rooms are counted against the client's address.
+func (l *Lobby) registerClient(
+	ctx context.Context,
+	conn protocol.ProtoConn,
+	regMsg *pb.MsgRegister,
+) (roomName common.NormalizedRoomName, username common.NormalizedUsername, err error) {
+	if !l.registration.Enabled {
+		return roomName, username, protocol.AuthRejectedError{
+			Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_REGISTRATION_DISABLED,
+			Message: "registration is disabled on this server",
 		}
+	}
 
-		// Rehash password if necessary.
-		if needsRehash {
-			var newHash string
-			newHash, err = mcfpassword.HashPassword(authMsg.Password)
-			if err != nil {
-				return fmt.Errorf(`failed to rehash password for account with room %q and username %q: %w`,
-					room.String(),
-					username.String(),
-					err,
-				)
+	if l.authLimiter != nil {
+		var locked bool
+		locked, err = l.authLimiter.IsIpLocked(ctx, conn.RemoteAddr())
+		if err != nil {
+			return roomName, username, err
+		}
+		if locked {
+			return roomName, username, protocol.AuthRejectedError{
+				Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_RATE_LIMITED,
+				Message: "too many failed authentication attempts, try again later",
 			}
+		}
+	}
 
-			err = l.storage.UpdateAccountPasswordHash(ctx, room, username, newHash)
-			if err != nil {
-				return fmt.Errorf(`failed to update account with room %q and username %q with rehashed password: %w`,
-					room.String(),
-					username.String(),
-					err,
-				)
+	var isValid bool
+	roomName, isValid = common.NormalizeRoomName(regMsg.Room)
+	if !isValid {
+		return roomName, username, protocol.AuthRejectedError{
+			Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_UNSPECIFIED,
+			Message: "invalid room name",
+		}
+	}
+	username, isValid = common.NormalizeUsername(regMsg.Username)
+	if !isValid {
+		return roomName, username, protocol.AuthRejectedError{
+			Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_UNSPECIFIED,
+			Message: "invalid username",
+		}
+	}
+
+	roomInst, has := l.roomMgr.GetRoomByName(roomName)
+	if !has {
+		l.recordAuthFailure(ctx, conn.RemoteAddr(), roomName, username, false)
+		return roomName, username, protocol.AuthRejectedError{
+			Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_UNSPECIFIED,
+			Message: "room not found",
+		}
+	}
+
+	inviteCode := regMsg.GetInviteCode()
+	if inviteCode == "" {
+		if l.registration.RequireInviteCode {
+			return roomName, username, protocol.AuthRejectedError{
+				Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_INVALID_INVITE_CODE,
+				Message: "an invite code is required to register",
 			}
 		}
 
-		// Authenticate successful.
-		return nil
-	}()
-	if finalErr != nil {
-		// Write appropriate error reply to bidi before closure.
-		if rejErr, ok := errors.AsType[protocol.AuthRejectedError](finalErr); ok {
-			_ = authBidi.Write(pb.MsgType_MSG_TYPE_AUTH_REJECTED, &pb.MsgAuthRejected{
-				Reason:  rejErr.Reason,
-				Message: &rejErr.Message,
-			})
-		} else if unexpectedErr, ok := errors.AsType[protocol.UnexpectedMsgTypeError](finalErr); ok {
-			_ = authBidi.WriteUnexpectedMsgTypeError(unexpectedErr.Expected, unexpectedErr.Actual)
-		} else {
-			_ = authBidi.WriteInternalError(finalErr)
+		err = roomInst.CreateAccount(ctx, username, regMsg.Password, false)
+	} else {
+		err = roomInst.CreateAccountWithInviteCode(ctx, username, regMsg.Password, inviteCode)
+	}
+	if err != nil {
+		if errors.Is(err, room.ErrInvalidInviteCode) {
+			l.recordAuthFailure(ctx, conn.RemoteAddr(), roomName, username, false)
+			return roomName, username, protocol.AuthRejectedError{
+				Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_INVALID_INVITE_CODE,
+				Message: "invalid invite code",
+			}
+		}
+		if errors.Is(err, room.ErrAccountExists) || errors.Is(err, storage.ErrRecordExists) {
+			return roomName, username, protocol.AuthRejectedError{
+				Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_USERNAME_TAKEN,
+				Message: "username already taken",
+			}
+		}
+		if passErr, ok := errors.AsType[password.Error](err); ok {
+			return roomName, username, protocol.AuthRejectedError{
+				Reason:  pb.AuthRejectionReason_AUTH_REJECTION_REASON_INVALID_PASSWORD,
+				Message: passErr.Error(),
+			}
 		}
 
-		room = common.ZeroNormalizedRoomName
-		username = common.ZeroNormalizedUsername
-		return authBidi, room, username, finalErr
+		return roomName, username, err
 	}
 
-	isSuccess = true
+	l.logger.Info("registered new account",
+		"service", "main.Lobby",
+		"room", roomName.String(),
+		"username", username.String(),
+		"used_invite_code", inviteCode != "",
+	)
+
+	return roomName, username, nil
+}
+
+// recordAuthFailure records a failed authentication attempt with the lobby's AuthLimiter, if any.
+// If hasAccount is false, only the IP address failure is recorded.
+// Errors are logged, not returned.
+func (l *Lobby) recordAuthFailure(
+	ctx context.Context,
+	addr net.Addr,
+	room common.NormalizedRoomName,
+	username common.NormalizedUsername,
+	hasAccount bool,
+) {
+	if l.authLimiter == nil {
+		return
+	}
 
-	return authBidi, room, username, nil
+	if err := l.authLimiter.RecordIpFailure(ctx, addr); err != nil {
+		l.logger.Error("failed to record auth failure for IP",
+			"service", "main.Lobby",
+			"remote_addr", addr.String(),
+			"err", err,
+		)
+	}
+
+	if !hasAccount {
+		return
+	}
+
+	if err := l.authLimiter.RecordAccountFailure(ctx, room, username); err != nil {
+		l.logger.Error("failed to record auth failure for account",
+			"service", "main.Lobby",
+			"room", room.String(),
+			"username", username.String(),
+			"err", err,
+		)
+	}
 }
@@ -0,0 +1,87 @@
+package lobby
+
+import (
+	"errors"
+	"time"
+
+	"friendnet.org/protocol"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// DefaultTimeout is the default timeout for connections in the lobby (unauthenticated).
+const DefaultTimeout = 10 * time.Second
+
+// DefaultMaxConcurrent is the default maximum number of connections that can be in the lobby at once.
+const DefaultMaxConcurrent = 256
+
+// DefaultMaxConnsPerIpPerMinute is the default maximum number of new connections a single IP address can make per
+// minute.
+const DefaultMaxConnsPerIpPerMinute = 30
+
+// Settings are the lobby settings that can be changed while the server is running.
+// Changes apply to connections that enter the lobby afterward.
+type Settings struct {
+	// How long a connection can stay in the lobby until it is disconnected.
+	// Also limits how long joining another room on an existing connection can take.
+	Timeout time.Duration
+
+	// The oldest protocol version accepted from clients, inclusive.
+	// Only the major and minor parts are compared, since patch versions do not include breaking changes.
+	MinVersion *pb.ProtoVersion
+
+	// The newest protocol version accepted from clients, inclusive.
+	// Only the major and minor parts are compared, since patch versions do not include breaking changes.
+	MaxVersion *pb.ProtoVersion
+
+	// The maximum number of connections that can be in the lobby at once.
+	// Connections beyond this are closed with an unavailable close code, so clients try again later.
+	// Specify 0 for no limit.
+	MaxConcurrent int
+
+	// The maximum number of new connections a single IP address can make per minute.
+	// Connections beyond this are closed with an unavailable close code before entering the lobby.
+	// Bursts up to the limit are allowed.
+	// Specify 0 for no limit.
+	MaxConnsPerIpPerMinute int
+}
+
+// DefaultSettings returns the default lobby settings.
+// Only clients with the same major and minor protocol version as the server are accepted.
+func DefaultSettings() Settings {
+	return Settings{
+		Timeout:    DefaultTimeout,
+		MinVersion: protocol.CurrentProtocolVersion,
+		MaxVersion: protocol.CurrentProtocolVersion,
+
+		MaxConcurrent:          DefaultMaxConcurrent,
+		MaxConnsPerIpPerMinute: DefaultMaxConnsPerIpPerMinute,
+	}
+}
+
+// Validate returns an error if the settings are invalid.
+func (s Settings) Validate() error {
+	if s.Timeout <= 0 {
+		return errors.New("lobby timeout must be positive")
+	}
+	if s.MinVersion == nil || s.MaxVersion == nil {
+		return errors.New("lobby protocol version range cannot be open")
+	}
+	if compareMajorMinor(s.MinVersion, s.MaxVersion) > 0 {
+		return errors.New("lobby minimum protocol version cannot be newer than the maximum")
+	}
+	if s.MaxConcurrent < 0 {
+		return errors.New("lobby max concurrent connections cannot be negative")
+	}
+	if s.MaxConnsPerIpPerMinute < 0 {
+		return errors.New("lobby max connections per IP per minute cannot be negative")
+	}
+	return nil
+}
+
+// compareMajorMinor compares two protocol versions like protocol.CompareProtoVersions, ignoring the patch part.
+func compareMajorMinor(a *pb.ProtoVersion, b *pb.ProtoVersion) int {
+	return protocol.CompareProtoVersions(
+		&pb.ProtoVersion{Major: a.Major, Minor: a.Minor},
+		&pb.ProtoVersion{Major: b.Major, Minor: b.Minor},
+	)
+}
@@ -0,0 +1,157 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+	"unicode/utf8"
+
+	"friendnet.org/common"
+	"friendnet.org/server/storage"
+)
+
+// ErrInvalidArchive is returned when importing an archive that has invalid contents.
+var ErrInvalidArchive = errors.New("invalid room archive")
+
+// ArchivedAccount is an account in an Archive.
+type ArchivedAccount struct {
+	Username common.NormalizedUsername
+
+	// The account's password hash, in the same format as it is stored.
+	// Accounts keep their passwords when they are imported, so users do not need to register again.
+	PasswordHash string
+
+	IsGuest bool
+}
+
+// Archive is a portable copy of a room's settings, accounts and unused invite codes, used to move a room to another
+// server.
+type Archive struct {
+	Metadata    Metadata
+	Motd        string
+	Limits      Limits
+	DirCacheTtl time.Duration
+
+	Accounts    []ArchivedAccount
+	InviteCodes []string
+}
+
+// Export returns an archive of the room.
+func (r *Room) Export(ctx context.Context) (Archive, error) {
+	records, err := r.storage.GetAccountsByRoom(ctx, r.Name)
+	if err != nil {
+		return Archive{}, fmt.Errorf(`failed to get accounts of room %q for export: %w`, r.Name.String(), err)
+	}
+	codes, err := r.storage.GetInviteCodesByRoom(ctx, r.Name)
+	if err != nil {
+		return Archive{}, fmt.Errorf(`failed to get invite codes of room %q for export: %w`, r.Name.String(), err)
+	}
+
+	accounts := make([]ArchivedAccount, len(records))
+	for i, record := range records {
+		accounts[i] = ArchivedAccount{
+			Username:     record.Username,
+			PasswordHash: record.PasswordHash,
+			IsGuest:      record.IsGuest,
+		}
+	}
+	inviteCodes := make([]string, len(codes))
+	for i, code := range codes {
+		inviteCodes[i] = code.Code
+	}
+
+	return Archive{
+		Metadata:    r.Metadata(),
+		Motd:        r.Motd(),
+		Limits:      r.Limits(),
+		DirCacheTtl: r.DirCacheTtl(),
+
+		Accounts:    accounts,
+		InviteCodes: inviteCodes,
+	}, nil
+}
+
+// Validate returns an error wrapping ErrInvalidArchive if the archive cannot be imported.
+func (a *Archive) Validate() error {
+	if err := a.Metadata.Validate(); err != nil {
+		return fmt.Errorf(`%w: %w`, ErrInvalidArchive, err)
+	}
+	if utf8.RuneCountInString(a.Motd) > MaxMotdLength {
+		return fmt.Errorf(`%w: %w`, ErrInvalidArchive, ErrMotdTooLong)
+	}
+	if a.Limits.MaxClients < 0 || a.Limits.MaxProxyStreamsPerClient < 0 || a.DirCacheTtl < 0 {
+		return fmt.Errorf(`%w: limits cannot be negative`, ErrInvalidArchive)
+	}
+
+	seen := make(map[string]struct{}, len(a.Accounts))
+	for _, account := range a.Accounts {
+		if account.PasswordHash == "" {
+			return fmt.Errorf(`%w: account %q has no password hash`, ErrInvalidArchive, account.Username.String())
+		}
+		if _, has := seen[account.Username.String()]; has {
+			return fmt.Errorf(`%w: account %q appears more than once`, ErrInvalidArchive, account.Username.String())
+		}
+		seen[account.Username.String()] = struct{}{}
+	}
+	for _, code := range a.InviteCodes {
+		if code == "" {
+			return fmt.Errorf(`%w: invite codes cannot be empty`, ErrInvalidArchive)
+		}
+	}
+
+	return nil
+}
+
+// ImportRoom creates a new room with the specified name from an archive, including its accounts and invite codes.
+// If anything fails, the room is deleted again, so a room is either imported in full or not at all.
+// Returns ErrRoomExists if a room with the same name already exists.
+// Returns an error wrapping ErrInvalidArchive if the archive is invalid.
+func (m *Manager) ImportRoom(ctx context.Context, name common.NormalizedRoomName, archive Archive) (*Room, error) {
+	if err := archive.Validate(); err != nil {
+		return nil, err
+	}
+
+	r, err := m.CreateRoom(ctx, name, archive.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = m.fillImportedRoom(ctx, r, archive); err != nil {
+		if delErr := m.DeleteRoomByName(ctx, name); delErr != nil {
+			return nil, errors.Join(err, fmt.Errorf(`failed to delete partially imported room %q: %w`, name.String(), delErr))
+		}
+		return nil, err
+	}
+
+	return r, nil
+}
+
+// fillImportedRoom applies the settings, accounts and invite codes of an archive to a newly created room.
+func (m *Manager) fillImportedRoom(ctx context.Context, r *Room, archive Archive) error {
+	if err := r.SetMotd(ctx, archive.Motd); err != nil {
+		return err
+	}
+	if err := r.SetLimits(ctx, archive.Limits); err != nil {
+		return err
+	}
+	if err := r.SetDirCacheTtl(ctx, archive.DirCacheTtl); err != nil {
+		return err
+	}
+
+	for _, account := range archive.Accounts {
+		err := m.storage.CreateAccount(ctx, r.Name, account.Username, account.PasswordHash, account.IsGuest)
+		if err != nil {
+			return fmt.Errorf(`failed to import account %q@%q: %w`, account.Username.String(), r.Name.String(), err)
+		}
+	}
+	for _, code := range archive.InviteCodes {
+		// Codes are unique across rooms, so a code that is taken, such as when a room is imported into the server it
+		// was exported from under a new name, is left out.
+		if _, err := m.storage.CreateInviteCode(ctx, r.Name, code); err != nil && !errors.Is(err, storage.ErrRecordExists) {
+			return fmt.Errorf(`failed to import invite code for room %q: %w`, r.Name.String(), err)
+		}
+	}
+
+	return nil
+}
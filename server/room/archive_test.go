@@ -0,0 +1,35 @@
+package room
+
+import (
+	"errors"
+	"testing"
+
+	"friendnet.org/common"
+)
+
+func TestArchiveValidate(t *testing.T) {
+	alice := common.UncheckedCreateNormalizedUsername("alice")
+
+	valid := Archive{
+		Accounts:    []ArchivedAccount{{Username: alice, PasswordHash: "hash"}},
+		InviteCodes: []string{"code"},
+	}
+	if err := valid.Validate(); err != nil {
+		t.Fatalf("expected valid archive, got %v", err)
+	}
+
+	invalid := map[string]Archive{
+		"negative limits": {Limits: Limits{MaxClients: -1}},
+		"empty hash":      {Accounts: []ArchivedAccount{{Username: alice}}},
+		"duplicate account": {Accounts: []ArchivedAccount{
+			{Username: alice, PasswordHash: "hash"},
+			{Username: alice, PasswordHash: "other"},
+		}},
+		"empty invite code": {InviteCodes: []string{""}},
+	}
+	for name, archive := range invalid {
+		if err := archive.Validate(); !errors.Is(err, ErrInvalidArchive) {
+			t.Errorf("%s: expected ErrInvalidArchive, got %v", name, err)
+		}
+	}
+}
@@ -18,6 +18,9 @@ import (
 // ClientPingInterval is the interval between pings sent to clients.
 const ClientPingInterval = 10 * time.Second
 
+// ClientMaxMissedPings is the number of pings in a row a client may fail to answer before it is disconnected.
+const ClientMaxMissedPings = 3
+
 // Client is an authenticated client connected to a room.
 type Client struct {
 	mu sync.RWMutex
@@ -29,10 +32,35 @@ type Client struct {
 	Room     *Room
 	Username common.NormalizedUsername
 
+	// Whether the client is logged in with a guest account.
+	// Guests can browse and download, but cannot share files or change their account.
+	isGuest bool
+
 	logic Logic
 
 	// A mapping of connection method IDs to their corresponding methods.
 	connMethods map[string]*pb.ConnMethod
+
+	// The number of proxied streams the client currently has open.
+	activeProxyStreams int
+
+	// The number of requests the client currently has in flight.
+	activeRequests int
+
+	// The client's latest announced shares revision, if it has announced one.
+	sharesRevision    uint64
+	hasSharesRevision bool
+
+	// The client's published interests, normalized.
+	interests []string
+
+	// Directory listings proxied to the client, cached for other clients to reuse.
+	dirCache dirCache
+
+	rtt common.RttTracker
+
+	// The client's share of the relay, charged for proxied streams the client opens.
+	relay *relayFlow
 }
 
 // NewClient creates a new room client.
@@ -43,6 +71,7 @@ func NewClient(
 	version *pb.ProtoVersion,
 	room *Room,
 	username common.NormalizedUsername,
+	isGuest bool,
 
 	logic Logic,
 ) *Client {
@@ -53,10 +82,150 @@ func NewClient(
 		version:  version,
 		Room:     room,
 		Username: username,
+		isGuest:  isGuest,
 
 		logic: logic,
 
 		connMethods: make(map[string]*pb.ConnMethod),
+
+		relay: newRelayFlow(room.relay.weightFor(room.Name, username, isGuest)),
+	}
+}
+
+// guestDeniedMsgTypes are the message types that guest clients are not allowed to send.
+// Handlers for messages that let a client share files or modify their account belong here.
+var guestDeniedMsgTypes = map[pb.MsgType]struct{}{
+	pb.MsgType_MSG_TYPE_ADVERTISE_CONN_METHOD:   {},
+	pb.MsgType_MSG_TYPE_CHANGE_ACCOUNT_PASSWORD: {},
+	pb.MsgType_MSG_TYPE_SET_INTERESTS:           {},
+}
+
+// IsGuest returns whether the client is logged in with a guest account.
+func (c *Client) IsGuest() bool {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.isGuest
+}
+
+// setGuest updates whether the client is treated as a guest.
+// Connection methods advertised before becoming a guest are dropped.
+func (c *Client) setGuest(isGuest bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.isGuest = isGuest
+	if isGuest {
+		clear(c.connMethods)
+		c.interests = nil
+	}
+	c.relay.setWeight(c.Room.relay.weightFor(c.Room.Name, c.Username, isGuest))
+}
+
+// RelayStats returns the total number of bytes relayed through the server for proxied streams the client opened, and
+// the recent rate in bytes per second.
+func (c *Client) RelayStats() (total int64, bytesPerSecond float64) {
+	return c.relay.stats()
+}
+
+// acquireProxyStream reserves a proxied stream slot for the client.
+// Returns false if the client already has the maximum number of concurrent proxied streams allowed by the room.
+// If it returns true, releaseProxyStream must be called once the stream is finished.
+func (c *Client) acquireProxyStream() bool {
+	limit := c.Room.Limits().MaxProxyStreamsPerClient
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if limit > 0 && c.activeProxyStreams >= limit {
+		return false
+	}
+	c.activeProxyStreams++
+	return true
+}
+
+// releaseProxyStream releases a proxied stream slot reserved by acquireProxyStream.
+func (c *Client) releaseProxyStream() {
+	c.mu.Lock()
+	c.activeProxyStreams--
+	c.mu.Unlock()
+}
+
+// requestLimitExemptMsgTypes are the message types that do not count towards the client's concurrent request limit.
+// Pings and byes must always get through, and proxied streams are limited separately by acquireProxyStream.
+var requestLimitExemptMsgTypes = map[pb.MsgType]struct{}{
+	pb.MsgType_MSG_TYPE_PING:                {},
+	pb.MsgType_MSG_TYPE_BYE:                 {},
+	pb.MsgType_MSG_TYPE_OPEN_OUTBOUND_PROXY: {},
+}
+
+// acquireRequest reserves a request slot for the client.
+// Returns false if the client already has the maximum number of concurrent requests allowed by the room.
+// If it returns true, releaseRequest must be called once the request is finished.
+func (c *Client) acquireRequest() bool {
+	limit := c.Room.maxRequestsPerClient
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if limit > 0 && c.activeRequests >= limit {
+		return false
+	}
+	c.activeRequests++
+	return true
+}
+
+// releaseRequest releases a request slot reserved by acquireRequest.
+func (c *Client) releaseRequest() {
+	c.mu.Lock()
+	c.activeRequests--
+	c.mu.Unlock()
+}
+
+// SharesRevision returns the client's latest announced shares revision.
+// Returns false if the client has not announced one, in which case listings proxied to it are not cached.
+func (c *Client) SharesRevision() (uint64, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.sharesRevision, c.hasSharesRevision
+}
+
+// setSharesRevision records a newly announced shares revision.
+// Listings cached under other revisions are dropped, since they may no longer be accurate.
+func (c *Client) setSharesRevision(revision uint64) {
+	c.mu.Lock()
+	changed := !c.hasSharesRevision || c.sharesRevision != revision
+	c.sharesRevision = revision
+	c.hasSharesRevision = true
+	c.mu.Unlock()
+
+	if changed {
+		c.dirCache.clear()
+	}
+}
+
+// Interests returns the client's published interests.
+// The returned slice must not be modified.
+func (c *Client) Interests() []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.interests
+}
+
+// setInterests replaces the client's published interests.
+// They must already be normalized with common.NormalizeInterests.
+func (c *Client) setInterests(interests []string) {
+	c.mu.Lock()
+	c.interests = interests
+	c.mu.Unlock()
+}
+
+// RttStats returns round-trip time statistics for pings sent to the client.
+func (c *Client) RttStats() common.RttStats {
+	return c.rtt.Stats()
+}
+
+// Info returns the client's online user info.
+func (c *Client) Info() *pb.OnlineUserInfo {
+	return &pb.OnlineUserInfo{
+		Username: c.Username.String(),
+		IsGuest:  c.IsGuest(),
 	}
 }
 
@@ -66,6 +235,10 @@ func NewClient(
 func (c *Client) msgHandler(bidi protocol.ProtoBidi, firstMsg *protocol.UntypedProtoMsg) error {
 	ctx := context.Background()
 
+	if _, denied := guestDeniedMsgTypes[firstMsg.Type]; denied && c.IsGuest() {
+		return bidi.WriteError(pb.ErrType_ERR_TYPE_PERMISSION_DENIED, "not allowed for guest accounts")
+	}
+
 	switch firstMsg.Type {
 	case pb.MsgType_MSG_TYPE_BYE:
 		_ = bidi.WriteAck()
@@ -91,8 +264,16 @@ func (c *Client) msgHandler(bidi protocol.ProtoBidi, firstMsg *protocol.UntypedP
 		return c.logic.OnRedeemConnHandshakeToken(ctx, c, bidi, protocol.ToTyped[*pb.MsgRedeemConnHandshakeToken](firstMsg))
 	case pb.MsgType_MSG_TYPE_CHANGE_ACCOUNT_PASSWORD:
 		return c.logic.OnChangeAccountPassword(ctx, c, bidi, protocol.ToTyped[*pb.MsgChangeAccountPassword](firstMsg))
+	case pb.MsgType_MSG_TYPE_SHARES_REVISION:
+		return c.logic.OnSharesRevision(ctx, c, bidi, protocol.ToTyped[*pb.MsgSharesRevision](firstMsg))
 	case pb.MsgType_MSG_TYPE_SEARCH:
 		return c.logic.OnSearch(ctx, c, bidi, protocol.ToTyped[*pb.MsgSearch](firstMsg))
+	case pb.MsgType_MSG_TYPE_GET_SERVER_INFO:
+		return c.logic.OnGetServerInfo(ctx, c, bidi, protocol.ToTyped[*pb.MsgGetServerInfo](firstMsg))
+	case pb.MsgType_MSG_TYPE_SET_INTERESTS:
+		return c.logic.OnSetInterests(ctx, c, bidi, protocol.ToTyped[*pb.MsgSetInterests](firstMsg))
+	case pb.MsgType_MSG_TYPE_GET_SIMILAR_USERS:
+		return c.logic.OnGetSimilarUsers(ctx, c, bidi, protocol.ToTyped[*pb.MsgGetSimilarUsers](firstMsg))
 
 	default:
 		c.logger.Error("client sent unknown message type",
@@ -117,6 +298,12 @@ func (c *Client) bidiHandler(bidi protocol.ProtoBidi) {
 	// Read first message.
 	firstMsg, firstErr := bidi.Read()
 	if firstErr != nil {
+		if unknownErr, ok := errors.AsType[protocol.UnknownMsgTypeError](firstErr); ok {
+			// Probably a message from a newer protocol version.
+			_ = bidi.WriteUnimplementedError(unknownErr.Type)
+			return
+		}
+
 		c.logger.Error("failed to read first message from bidi",
 			"service", "room.Client",
 			"room", c.Room.Name.String(),
@@ -126,6 +313,14 @@ func (c *Client) bidiHandler(bidi protocol.ProtoBidi) {
 		return
 	}
 
+	if _, exempt := requestLimitExemptMsgTypes[firstMsg.Type]; !exempt {
+		if !c.acquireRequest() {
+			_ = bidi.WriteError(pb.ErrType_ERR_TYPE_RATE_LIMITED, "too many concurrent requests")
+			return
+		}
+		defer c.releaseRequest()
+	}
+
 	// Wrap message logic handler for better error messages.
 	err := c.msgHandler(bidi, firstMsg)
 	if err != nil {
@@ -182,7 +377,8 @@ func (c *Client) ReadLoop(ctx context.Context) error {
 }
 
 // PingLoop runs the client ping loop.
-// Only returns if the context is canceled.
+// Returns if the context is canceled, the connection closes, or the client misses ClientMaxMissedPings pings in a row.
+// In the last case, the connection is closed before returning.
 func (c *Client) PingLoop(ctx context.Context) {
 	ticker := time.NewTicker(ClientPingInterval)
 	defer ticker.Stop()
@@ -192,37 +388,55 @@ func (c *Client) PingLoop(ctx context.Context) {
 		case <-ctx.Done():
 			return
 		case <-ticker.C:
-			if _, err := c.Ping(); err != nil {
-				if protocol.IsErrorConnCloseOrCancel(err) {
-					return
-				}
+			sample, err := c.Ping()
+			if err == nil {
+				c.rtt.RecordSample(sample)
+				continue
+			}
+			if protocol.IsErrorConnCloseOrCancel(err) {
+				return
+			}
 
-				c.logger.Error("failed to ping client",
+			missed := c.rtt.RecordLost()
+			c.logger.Error("failed to ping client",
+				"service", "room.Client",
+				"room", c.Room.Name.String(),
+				"username", c.Username.String(),
+				"missed", missed,
+				"err", err,
+			)
+
+			if missed >= ClientMaxMissedPings {
+				c.logger.Warn("disconnecting client after missed pings",
 					"service", "room.Client",
 					"room", c.Room.Name.String(),
 					"username", c.Username.String(),
-					"err", err,
+					"missed", missed,
 				)
+				_ = c.conn.CloseWithCode(protocol.CloseCodePingTimeout, fmt.Sprintf("missed %d pings", missed))
+				return
 			}
 		}
 	}
 }
 
-// Ping sends a ping request to the client and returns the round-trip time.
-func (c *Client) Ping() (time.Duration, error) {
-	start := time.Now()
-	_, err := c.conn.SendAndReceive(pb.MsgType_MSG_TYPE_PING, &pb.MsgPing{
-		SentTs: start.UnixMilli(),
-	})
+// Ping sends a ping request to the client and returns the round-trip time and, if the client reported it, the
+// offset of its clock.
+// It gives up with protocol.ErrPingTimeout if the client does not answer within ClientPingInterval.
+func (c *Client) Ping() (common.RttSample, error) {
+	ctx, cancel := context.WithTimeoutCause(context.Background(), ClientPingInterval, protocol.ErrPingTimeout)
+	defer cancel()
+
+	sample, err := protocol.PingContext(ctx, c.conn)
 	if err != nil {
-		return 0, fmt.Errorf("failed to send ping to client %q@%q: %w",
+		return common.RttSample{}, fmt.Errorf("failed to send ping to client %q@%q: %w",
 			c.Username.String(),
 			c.Room.Name.String(),
 			err,
 		)
 	}
 
-	return time.Since(start), nil
+	return sample, nil
 }
 
 // GetConnMethods returns a copy of the client's connection methods.
@@ -0,0 +1,160 @@
+package room
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"sync"
+	"time"
+
+	"friendnet.org/protocol"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// dirCacheMaxResponseSize is the maximum size of a directory listing response that will be cached.
+// Larger listings are always proxied.
+const dirCacheMaxResponseSize = 256 * 1024
+
+// dirCacheMaxEntries is the maximum number of directory listings cached per client.
+const dirCacheMaxEntries = 256
+
+type dirCacheKey struct {
+	path     string
+	pageSize uint32
+	revision uint64
+}
+
+type dirCacheEntry struct {
+	response  []byte
+	expiresTs time.Time
+}
+
+// dirCache caches the raw responses to proxied MSG_TYPE_GET_DIR_FILES requests sent to a single client.
+// Entries are keyed by the client's shares revision, so listings are never served after the client announces that its
+// shares changed.
+// The zero value is ready to use.
+// It is safe for concurrent use.
+type dirCache struct {
+	mu      sync.Mutex
+	entries map[dirCacheKey]dirCacheEntry
+}
+
+// get returns the cached response for the key, if it has not expired.
+func (c *dirCache) get(key dirCacheKey) ([]byte, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, has := c.entries[key]
+	if !has {
+		return nil, false
+	}
+	if time.Now().After(entry.expiresTs) {
+		delete(c.entries, key)
+		return nil, false
+	}
+	return entry.response, true
+}
+
+// put caches a response for the key until the TTL passes.
+// Expired entries and entries for other revisions are dropped to make room.
+func (c *dirCache) put(key dirCacheKey, response []byte, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.entries == nil {
+		c.entries = make(map[dirCacheKey]dirCacheEntry)
+	}
+
+	now := time.Now()
+	for k, entry := range c.entries {
+		if k.revision != key.revision || now.After(entry.expiresTs) {
+			delete(c.entries, k)
+		}
+	}
+	if len(c.entries) >= dirCacheMaxEntries {
+		// Evict an arbitrary entry.
+		for k := range c.entries {
+			delete(c.entries, k)
+			break
+		}
+	}
+
+	c.entries[key] = dirCacheEntry{
+		response:  response,
+		expiresTs: now.Add(ttl),
+	}
+}
+
+// clear drops all cached responses.
+func (c *dirCache) clear() {
+	c.mu.Lock()
+	clear(c.entries)
+	c.mu.Unlock()
+}
+
+// readProxiedRequest reads the first message that the origin sends through a proxy.
+// It also returns the message's exact encoded bytes so that they can be forwarded to the target unchanged.
+// The bytes are returned even if reading fails, since they still need to be forwarded.
+func readProxiedRequest(bidi protocol.ProtoBidi) (*protocol.UntypedProtoMsg, []byte, error) {
+	var raw bytes.Buffer
+	msg, err := protocol.NewProtoStreamReader(io.TeeReader(bidi.Stream, &raw)).ReadRaw()
+	return msg, raw.Bytes(), err
+}
+
+// responseRecorder records the data a target sends through a proxy, up to dirCacheMaxResponseSize.
+// It is safe for concurrent use.
+type responseRecorder struct {
+	mu         sync.Mutex
+	buf        []byte
+	overflowed bool
+	finished   bool
+}
+
+func (r *responseRecorder) Write(p []byte) (int, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.overflowed {
+		return len(p), nil
+	}
+	if len(r.buf)+len(p) > dirCacheMaxResponseSize {
+		r.overflowed = true
+		r.buf = nil
+		return len(p), nil
+	}
+	r.buf = append(r.buf, p...)
+	return len(p), nil
+}
+
+// finish marks the response as complete.
+// It must only be called once the target has cleanly finished sending.
+func (r *responseRecorder) finish() {
+	r.mu.Lock()
+	r.finished = true
+	r.mu.Unlock()
+}
+
+// dirFilesResponse returns the recorded response if it is a complete directory listing that can be cached.
+// Errors are never returned as cacheable, since they may be temporary.
+func (r *responseRecorder) dirFilesResponse() ([]byte, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if !r.finished || r.overflowed {
+		return nil, false
+	}
+
+	reader := protocol.NewProtoStreamReader(bytes.NewReader(r.buf))
+	for {
+		msg, err := reader.ReadRaw()
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return r.buf, true
+			}
+			return nil, false
+		}
+		if msg.Type != pb.MsgType_MSG_TYPE_DIR_FILES {
+			return nil, false
+		}
+	}
+}
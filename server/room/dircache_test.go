@@ -0,0 +1,82 @@
+package room
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"friendnet.org/protocol"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+func TestDirCache_Revision(t *testing.T) {
+	t.Parallel()
+
+	var cache dirCache
+	cache.put(dirCacheKey{path: "/a", revision: 1}, []byte("old"), time.Minute)
+	cache.put(dirCacheKey{path: "/b", revision: 2}, []byte("new"), time.Minute)
+
+	if _, hit := cache.get(dirCacheKey{path: "/a", revision: 1}); hit {
+		t.Error("expected entry for old revision to be dropped")
+	}
+	if response, hit := cache.get(dirCacheKey{path: "/b", revision: 2}); !hit || string(response) != "new" {
+		t.Errorf("expected cached response, got %q, %v", response, hit)
+	}
+}
+
+func TestDirCache_Expiry(t *testing.T) {
+	t.Parallel()
+
+	var cache dirCache
+	key := dirCacheKey{path: "/", revision: 0}
+	cache.put(key, []byte("listing"), -time.Second)
+
+	if _, hit := cache.get(key); hit {
+		t.Error("expected expired entry to miss")
+	}
+}
+
+func TestResponseRecorder_DirFilesResponse(t *testing.T) {
+	t.Parallel()
+
+	var listing bytes.Buffer
+	w := protocol.NewProtoStreamWriter(&listing)
+	if err := w.Write(pb.MsgType_MSG_TYPE_DIR_FILES, &pb.MsgDirFiles{Files: []*pb.MsgFileMeta{{Name: "a"}}}); err != nil {
+		t.Fatalf("failed to encode listing: %v", err)
+	}
+	var errResponse bytes.Buffer
+	if err := protocol.NewProtoStreamWriter(&errResponse).Write(pb.MsgType_MSG_TYPE_ERROR, &pb.MsgError{
+		Type: pb.ErrType_ERR_TYPE_INTERNAL,
+	}); err != nil {
+		t.Fatalf("failed to encode error: %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		data      []byte
+		finished  bool
+		cacheable bool
+	}{
+		{"listing", listing.Bytes(), true, true},
+		{"empty listing", nil, true, true},
+		{"unfinished", listing.Bytes(), false, false},
+		{"truncated", listing.Bytes()[:listing.Len()-1], true, false},
+		{"error", errResponse.Bytes(), true, false},
+		{"too large", make([]byte, dirCacheMaxResponseSize+1), true, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var r responseRecorder
+			_, _ = r.Write(tt.data)
+			if tt.finished {
+				r.finish()
+			}
+			if _, ok := r.dirFilesResponse(); ok != tt.cacheable {
+				t.Errorf("expected cacheable %v, got %v", tt.cacheable, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,22 @@
+package room
+
+import "sync/atomic"
+
+// Drain is whether the server is draining, such as before it is stopped for an upgrade.
+// While draining, new connections and proxied streams are refused, and existing ones are left to finish.
+// It is shared by every room.
+// The zero value is not draining.
+type Drain struct {
+	draining atomic.Bool
+}
+
+// Start starts draining.
+// Returns false if already draining.
+func (d *Drain) Start() bool {
+	return d.draining.CompareAndSwap(false, true)
+}
+
+// IsDraining returns whether the server is draining.
+func (d *Drain) IsDraining() bool {
+	return d.draining.Load()
+}
@@ -0,0 +1,66 @@
+package room
+
+import (
+	"log/slog"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+	"friendnet.org/common/machine"
+	"friendnet.org/common/password"
+)
+
+func TestDrain_ActiveProxyCount(t *testing.T) {
+	t.Parallel()
+
+	var drain Drain
+	r := NewRoom(
+		slog.New(slog.DiscardHandler),
+		nil,
+		machine.ConnMethodSupport{},
+		password.Requirements{},
+		common.UncheckedCreateNormalizedRoomName("room"),
+		time.Now(),
+		Metadata{},
+		"",
+		Limits{},
+		0,
+		0,
+		nil,
+		nil,
+		&drain,
+		&ServerInfo{},
+	)
+	defer func() {
+		_ = r.Close()
+	}()
+
+	if !drain.Start() || drain.Start() {
+		t.Fatal("expected only the first call to start draining")
+	}
+
+	// A warm stream that no request was written on yet is not waited for.
+	warm := &ClientProxy{room: r, Id: "warm"}
+	r.registerProxy(warm)
+
+	request := &ClientProxy{room: r, Id: "request"}
+	request.bytesToTarget.Add(64)
+	r.registerProxy(request)
+
+	response := &ClientProxy{room: r, Id: "response"}
+	response.bytesToOrigin.Add(1024)
+	r.registerProxy(response)
+
+	if n := r.ActiveProxyCount(); n != 2 {
+		t.Fatalf("expected 2 active proxied streams, got %d", n)
+	}
+	if n := len(r.GetProxies()); n != 3 {
+		t.Fatalf("expected 3 open proxied streams, got %d", n)
+	}
+
+	// Once a request is written on the warm stream, draining waits for it too.
+	warm.bytesToTarget.Add(64)
+	if n := r.ActiveProxyCount(); n != 3 {
+		t.Fatalf("expected 3 active proxied streams, got %d", n)
+	}
+}
@@ -0,0 +1,66 @@
+package room
+
+import (
+	"cmp"
+	"slices"
+
+	"friendnet.org/common"
+	pb "friendnet.org/protocol/pb/v1"
+)
+
+// DefaultSimilarUsersLimit is the number of similar users returned when the client does not specify a limit.
+const DefaultSimilarUsersLimit = 20
+
+// MaxSimilarUsersLimit is the maximum number of similar users returned for a single request.
+const MaxSimilarUsersLimit = 100
+
+// interestCandidate is a user whose interests are compared against a client's.
+type interestCandidate struct {
+	username  common.NormalizedUsername
+	interests []string
+}
+
+// rankSimilarUsers returns the candidates that share at least one interest with the specified interests, ordered by
+// the number of shared interests, most first, then by username.
+// At most limit users are returned.
+func rankSimilarUsers(interests []string, candidates []interestCandidate, limit int) []*pb.SimilarUser {
+	if len(interests) == 0 {
+		return nil
+	}
+
+	own := make(map[string]struct{}, len(interests))
+	for _, interest := range interests {
+		own[interest] = struct{}{}
+	}
+
+	var users []*pb.SimilarUser
+	for _, candidate := range candidates {
+		var shared []string
+		for _, interest := range candidate.interests {
+			if _, has := own[interest]; has {
+				shared = append(shared, interest)
+			}
+		}
+		if len(shared) == 0 {
+			continue
+		}
+
+		users = append(users, &pb.SimilarUser{
+			Username:        candidate.username.String(),
+			SharedInterests: shared,
+			InterestCount:   uint32(len(candidate.interests)),
+		})
+	}
+
+	slices.SortFunc(users, func(a, b *pb.SimilarUser) int {
+		if c := cmp.Compare(len(b.SharedInterests), len(a.SharedInterests)); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.Username, b.Username)
+	})
+
+	if len(users) > limit {
+		users = users[:limit]
+	}
+	return users
+}
@@ -0,0 +1,38 @@
+package room
+
+import (
+	"slices"
+	"testing"
+
+	"friendnet.org/common"
+)
+
+func TestRankSimilarUsers(t *testing.T) {
+	t.Parallel()
+
+	candidates := []interestCandidate{
+		{username: common.UncheckedCreateNormalizedUsername("carol"), interests: []string{"jazz"}},
+		{username: common.UncheckedCreateNormalizedUsername("bob"), interests: []string{"jazz", "synth pop", "film"}},
+		{username: common.UncheckedCreateNormalizedUsername("dave"), interests: []string{"gardening"}},
+		{username: common.UncheckedCreateNormalizedUsername("alice"), interests: []string{"jazz"}},
+	}
+
+	users := rankSimilarUsers([]string{"jazz", "synth pop"}, candidates, 10)
+	var names []string
+	for _, user := range users {
+		names = append(names, user.Username)
+	}
+	if !slices.Equal(names, []string{"bob", "alice", "carol"}) {
+		t.Fatalf("unexpected order %v", names)
+	}
+	if !slices.Equal(users[0].SharedInterests, []string{"jazz", "synth pop"}) || users[0].InterestCount != 3 {
+		t.Fatalf("unexpected first user %v", users[0])
+	}
+
+	if users = rankSimilarUsers([]string{"jazz"}, candidates, 2); len(users) != 2 {
+		t.Fatalf("expected limit to be applied, got %d users", len(users))
+	}
+	if users = rankSimilarUsers(nil, candidates, 10); len(users) != 0 {
+		t.Fatalf("expected no users without interests, got %d", len(users))
+	}
+}
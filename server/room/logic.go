@@ -12,6 +12,7 @@ import (
 	"friendnet.org/common/password"
 	"friendnet.org/protocol"
 	pb "friendnet.org/protocol/pb/v1"
+	"google.golang.org/protobuf/proto"
 )
 
 // Logic exposes handlers for incoming C2S messages.
@@ -118,6 +119,42 @@ type Logic interface {
 		bidi protocol.ProtoBidi,
 		msg *protocol.TypedProtoMsg[*pb.MsgSearch],
 	) error
+
+	// OnSharesRevision handles an incoming shares revision announcement.
+	// Implementations must follow the documentation on MSG_TYPE_SHARES_REVISION.
+	OnSharesRevision(
+		ctx context.Context,
+		client *Client,
+		bidi protocol.ProtoBidi,
+		msg *protocol.TypedProtoMsg[*pb.MsgSharesRevision],
+	) error
+
+	// OnGetServerInfo handles an incoming server info request.
+	// Implementations must follow the documentation on MSG_TYPE_GET_SERVER_INFO.
+	OnGetServerInfo(
+		ctx context.Context,
+		client *Client,
+		bidi protocol.ProtoBidi,
+		msg *protocol.TypedProtoMsg[*pb.MsgGetServerInfo],
+	) error
+
+	// OnSetInterests handles an incoming request to publish interests.
+	// Implementations must follow the documentation on MSG_TYPE_SET_INTERESTS.
+	OnSetInterests(
+		ctx context.Context,
+		client *Client,
+		bidi protocol.ProtoBidi,
+		msg *protocol.TypedProtoMsg[*pb.MsgSetInterests],
+	) error
+
+	// OnGetSimilarUsers handles an incoming request for users with similar interests.
+	// Implementations must follow the documentation on MSG_TYPE_GET_SIMILAR_USERS.
+	OnGetSimilarUsers(
+		ctx context.Context,
+		client *Client,
+		bidi protocol.ProtoBidi,
+		msg *protocol.TypedProtoMsg[*pb.MsgGetSimilarUsers],
+	) error
 }
 
 type LogicImpl struct {
@@ -138,10 +175,8 @@ func NewLogicImpl(logger *slog.Logger) *LogicImpl {
 	}
 }
 
-func (l LogicImpl) OnPing(_ context.Context, _ *Client, bidi protocol.ProtoBidi, _ *protocol.TypedProtoMsg[*pb.MsgPing]) error {
-	return bidi.Write(pb.MsgType_MSG_TYPE_PONG, &pb.MsgPong{
-		SentTs: time.Now().Unix(),
-	})
+func (l LogicImpl) OnPing(_ context.Context, _ *Client, bidi protocol.ProtoBidi, msg *protocol.TypedProtoMsg[*pb.MsgPing]) error {
+	return bidi.Write(pb.MsgType_MSG_TYPE_PONG, protocol.NewPong(msg.Payload, time.Now()))
 }
 
 func (l LogicImpl) OnOpenOutboundProxy(_ context.Context, client *Client, bidi protocol.ProtoBidi, msg *protocol.TypedProtoMsg[*pb.MsgOpenOutboundProxy]) error {
@@ -152,9 +187,50 @@ func (l LogicImpl) OnOpenOutboundProxy(_ context.Context, client *Client, bidi p
 		return nil
 	}
 
+	// Guests do not share files, so there is nothing to proxy to.
+	if target, has := client.Room.GetClientByUsername(targetUsername); has && target.IsGuest() {
+		return bidi.WriteError(pb.ErrType_ERR_TYPE_PERMISSION_DENIED, "target user is a guest and does not share files")
+	}
+
+	// While draining, peers are treated as offline so that clients try again later, possibly on another server.
+	if client.Room.drain.IsDraining() {
+		return bidi.WriteError(pb.ErrType_ERR_TYPE_CLIENT_NOT_ONLINE, "server is draining, try again later")
+	}
+
+	if !client.acquireProxyStream() {
+		return bidi.WriteError(pb.ErrType_ERR_TYPE_RATE_LIMITED, "too many concurrent proxied streams")
+	}
+	defer client.releaseProxyStream()
+
+	// If the room caches directory listings, look at the request before proxying it.
+	// Targets that never announced a shares revision are not cached, since there is no way to tell when their
+	// listings change.
+	var forward []byte
+	var cacheTarget *Client
+	var cacheKey dirCacheKey
+	ttl := client.Room.DirCacheTtl()
+	if target, has := client.Room.GetClientByUsername(targetUsername); has && ttl > 0 {
+		if revision, known := target.SharesRevision(); known {
+			req, raw, readErr := readProxiedRequest(bidi)
+			forward = raw
+			if readErr == nil && req.Type == pb.MsgType_MSG_TYPE_GET_DIR_FILES {
+				getDirFiles := req.Payload.(*pb.MsgGetDirFiles)
+				cacheKey = dirCacheKey{
+					path:     getDirFiles.Path,
+					pageSize: getDirFiles.PageSize,
+					revision: revision,
+				}
+				if response, hit := target.dirCache.get(cacheKey); hit {
+					_, err := bidi.Stream.Write(response)
+					return err
+				}
+				cacheTarget = target
+			}
+		}
+	}
+
 	proxy, err := NewClientProxy(
-		client.Room,
-		client.Username,
+		client,
 		targetUsername,
 		bidi,
 	)
@@ -169,41 +245,46 @@ func (l LogicImpl) OnOpenOutboundProxy(_ context.Context, client *Client, bidi p
 		_ = proxy.Close()
 	}()
 
-	return proxy.Run()
-}
+	if len(forward) > 0 {
+		if err = proxy.forwardToTarget(forward); err != nil {
+			// The target went away before it got the request.
+			bidi.Cancel(protocol.ProxyPeerUnreachableStreamErrorCode)
+			return nil
+		}
+	}
+	if cacheTarget != nil {
+		proxy.recorder = &responseRecorder{}
+	}
 
-func (l LogicImpl) OnGetOnlineUsers(_ context.Context, client *Client, bidi protocol.ProtoBidi, _ *protocol.TypedProtoMsg[*pb.MsgGetOnlineUsers]) error {
-	const pageSize = 50
+	err = proxy.Run()
 
-	// Snapshot clients and get their statuses.
-	clients := client.Room.GetAllClients()
-	statuses := make([]*pb.OnlineUserInfo, len(clients))
-	for i, c := range clients {
-		statuses[i] = &pb.OnlineUserInfo{
-			Username: c.Username.String(),
+	if cacheTarget != nil {
+		if response, ok := proxy.recorder.dirFilesResponse(); ok {
+			cacheTarget.dirCache.put(cacheKey, response, ttl)
 		}
 	}
 
-	// Send pages of statuses.
-	sent := 0
-	for sent < len(clients) {
-		end := sent + pageSize
-		if end > len(clients) {
-			end = len(clients)
-		}
+	return err
+}
 
-		err := bidi.Write(pb.MsgType_MSG_TYPE_ONLINE_USERS, &pb.MsgOnlineUsers{
-			Users: statuses[sent:end],
-		})
-		if err != nil {
-			return err
-		}
+func (l LogicImpl) OnGetOnlineUsers(ctx context.Context, client *Client, bidi protocol.ProtoBidi, msg *protocol.TypedProtoMsg[*pb.MsgGetOnlineUsers]) error {
+	pageSize := protocol.DefaultPageSize
+	if protocol.HasCapability(client.conn, pb.Capability_CAPABILITY_PAGE_SIZE) {
+		pageSize = protocol.PageSize(msg.Payload.PageSize)
+	}
 
-		// We could have sent less than pageSize, but in that case it would break anyway, so we don't care about being accurate here.
-		sent += pageSize
+	// Snapshot clients and get their statuses.
+	clients := client.Room.GetAllClients()
+	statuses := make([]*pb.OnlineUserInfo, len(clients))
+	for i, c := range clients {
+		statuses[i] = c.Info()
 	}
 
-	return nil
+	return protocol.WritePages(ctx, bidi, pb.MsgType_MSG_TYPE_ONLINE_USERS, statuses, pageSize,
+		func(page []*pb.OnlineUserInfo) proto.Message {
+			return &pb.MsgOnlineUsers{Users: page}
+		},
+	)
 }
 
 func (l LogicImpl) OnAdvertiseConnMethod(ctx context.Context, client *Client, bidi protocol.ProtoBidi, msg *protocol.TypedProtoMsg[*pb.MsgAdvertiseConnMethod]) error {
@@ -234,7 +315,7 @@ func (l LogicImpl) OnAdvertiseConnMethod(ctx context.Context, client *Client, bi
 			Token:    token,
 		})
 		if conn != nil {
-			_ = conn.CloseWithReason("direct connection verified")
+			_ = conn.CloseWithCode(protocol.CloseCodeNormal, "direct connection verified")
 		}
 
 		return result
@@ -391,6 +472,11 @@ func (l LogicImpl) OnSearch(ctx context.Context, client *Client, bidi protocol.P
 	go func() {
 		var wg sync.WaitGroup
 		for _, c := range clients {
+			// Guests do not share files, so they have nothing to search.
+			if c.IsGuest() {
+				continue
+			}
+
 			wg.Go(func() {
 				stream, err := c.Search(msg.Payload)
 				if err != nil {
@@ -410,14 +496,7 @@ func (l LogicImpl) OnSearch(ctx context.Context, client *Client, bidi protocol.P
 					_ = stream.Close()
 				}()
 
-				for {
-					select {
-					case <-timeoutCtx.Done():
-						return
-					default:
-					}
-
-					next, nextErr := stream.ReadNext()
+				for next, nextErr := range protocol.All(timeoutCtx, stream) {
 					if nextErr != nil {
 						if protocol.IsErrorConnCloseOrCancel(nextErr) {
 							return
@@ -472,3 +551,61 @@ recvLoop:
 
 	return nil
 }
+
+func (l LogicImpl) OnSharesRevision(_ context.Context, client *Client, bidi protocol.ProtoBidi, msg *protocol.TypedProtoMsg[*pb.MsgSharesRevision]) error {
+	client.setSharesRevision(msg.Payload.Revision)
+	return bidi.WriteAck()
+}
+
+func (l LogicImpl) OnGetServerInfo(_ context.Context, client *Client, bidi protocol.ProtoBidi, _ *protocol.TypedProtoMsg[*pb.MsgGetServerInfo]) error {
+	software := client.Room.serverInfo.Software()
+	limits := client.Room.Limits()
+	_, _, relayLimit := client.Room.relay.Limits()
+
+	return bidi.Write(pb.MsgType_MSG_TYPE_SERVER_INFO, &pb.MsgServerInfo{
+		Version:         software.Version,
+		ProtocolVersion: protocol.CurrentProtocolVersion,
+		Features:        software.Features,
+		Room: &pb.RoomPolicies{
+			MaxClients:               uint32(limits.MaxClients),
+			MaxProxyStreamsPerClient: uint32(limits.MaxProxyStreamsPerClient),
+			MaxConcurrentRequests:    uint32(client.Room.maxRequestsPerClient),
+			DirCacheTtlMs:            uint32(client.Room.DirCacheTtl().Milliseconds()),
+			RelayMaxBytesPerSecond:   relayLimit,
+		},
+	})
+}
+
+func (l LogicImpl) OnSetInterests(_ context.Context, client *Client, bidi protocol.ProtoBidi, msg *protocol.TypedProtoMsg[*pb.MsgSetInterests]) error {
+	interests, err := common.NormalizeInterests(msg.Payload.Interests)
+	if err != nil {
+		return bidi.WriteError(pb.ErrType_ERR_TYPE_INVALID_FIELDS, err.Error())
+	}
+
+	client.setInterests(interests)
+	return bidi.WriteAck()
+}
+
+func (l LogicImpl) OnGetSimilarUsers(_ context.Context, client *Client, bidi protocol.ProtoBidi, msg *protocol.TypedProtoMsg[*pb.MsgGetSimilarUsers]) error {
+	limit := DefaultSimilarUsersLimit
+	if msg.Payload.Limit != nil && *msg.Payload.Limit > 0 {
+		limit = min(int(*msg.Payload.Limit), MaxSimilarUsersLimit)
+	}
+
+	var candidates []interestCandidate
+	for _, c := range client.Room.GetAllClients() {
+		if c == client {
+			continue
+		}
+		if interests := c.Interests(); len(interests) > 0 {
+			candidates = append(candidates, interestCandidate{
+				username:  c.Username,
+				interests: interests,
+			})
+		}
+	}
+
+	return bidi.Write(pb.MsgType_MSG_TYPE_SIMILAR_USERS, &pb.MsgSimilarUsers{
+		Users: rankSimilarUsers(client.Interests(), candidates, limit),
+	})
+}
@@ -23,25 +23,41 @@ type Manager struct {
 	mu       sync.RWMutex
 	isClosed bool
 
-	storage           *storage.Storage
+	storage           storage.Storage
 	connMethodSupport machine.ConnMethodSupport
 	passReqs          password.Requirements
 
 	logic Logic
 
+	// The maximum number of requests each client can have in flight at once.
+	maxRequestsPerClient int
+
+	// Paces proxied streams between clients in all rooms.
+	relay *RelayScheduler
+
+	// Whether new connections and proxied streams in all rooms are refused.
+	drain Drain
+
+	// The server info reported to clients in all rooms.
+	serverInfo ServerInfo
+
 	// Key is the string value of a common.NormalizedRoomName.
 	rooms map[string]*Room
 }
 
 // NewManager creates a new room manager.
 // It loads all rooms from storage.
+// Each client in each room can have at most maxRequestsPerClient requests in flight at once, or unlimited if 0.
+// Proxied streams in all rooms share the relay scheduler.
 func NewManager(
 	ctx context.Context,
 	logger *slog.Logger,
-	storage *storage.Storage,
+	storage storage.Storage,
 	connMethodSupport machine.ConnMethodSupport,
 	passReqs password.Requirements,
 	logic Logic,
+	maxRequestsPerClient int,
+	relay *RelayScheduler,
 ) (*Manager, error) {
 	m := &Manager{
 		logger: logger,
@@ -52,6 +68,9 @@ func NewManager(
 
 		logic: logic,
 
+		maxRequestsPerClient: maxRequestsPerClient,
+		relay:                relay,
+
 		rooms: make(map[string]*Room),
 	}
 
@@ -67,7 +86,22 @@ func NewManager(
 			connMethodSupport,
 			passReqs,
 			room.Name,
+			room.CreatedTs,
+			Metadata{
+				Description: room.Description,
+				Listed:      room.Listed,
+			},
+			room.Motd,
+			Limits{
+				MaxClients:               room.MaxClients,
+				MaxProxyStreamsPerClient: room.MaxProxyStreamsPerClient,
+			},
+			room.DirCacheTtl,
+			maxRequestsPerClient,
 			logic,
+			relay,
+			&m.drain,
+			&m.serverInfo,
 		)
 	}
 
@@ -111,6 +145,21 @@ func (m *Manager) Close() error {
 	return nil
 }
 
+// Relay returns the scheduler that proxied streams in all rooms share.
+func (m *Manager) Relay() *RelayScheduler {
+	return m.relay
+}
+
+// Drain returns whether the server is draining, shared by all rooms.
+func (m *Manager) Drain() *Drain {
+	return &m.drain
+}
+
+// ServerInfo returns the server info reported to clients, shared by all rooms.
+func (m *Manager) ServerInfo() *ServerInfo {
+	return &m.serverInfo
+}
+
 // GetAll returns all rooms.
 // Returns empty if the manager is closed.
 // Note that this method creates a new slice each time it is called.
@@ -123,9 +172,14 @@ func (m *Manager) GetAll() []*Room {
 	return m.snapshotRoomsNoLock()
 }
 
-// CreateRoom creates a new room and returns it.
+// CreateRoom creates a new room with the specified metadata and returns it.
 // If a room with the same name already exists, returns ErrRoomExists.
-func (m *Manager) CreateRoom(ctx context.Context, name common.NormalizedRoomName) (*Room, error) {
+// Returns ErrDescriptionTooLong if the description is too long.
+func (m *Manager) CreateRoom(ctx context.Context, name common.NormalizedRoomName, metadata Metadata) (*Room, error) {
+	if err := metadata.Validate(); err != nil {
+		return nil, err
+	}
+
 	m.mu.RLock()
 	if m.isClosed {
 		m.mu.RUnlock()
@@ -141,10 +195,17 @@ func (m *Manager) CreateRoom(ctx context.Context, name common.NormalizedRoomName
 	}
 
 	// Create room in storage.
-	err := m.storage.CreateRoom(ctx, name)
+	err := m.storage.CreateRoom(ctx, name, metadata.Description, metadata.Listed)
 	if err != nil {
 		return nil, err
 	}
+	record, has, err := m.storage.GetRoomByName(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, fmt.Errorf(`room %q was not found after creating it`, name.String())
+	}
 
 	// Create room instance and add it to manager.
 	room := NewRoom(
@@ -153,7 +214,16 @@ func (m *Manager) CreateRoom(ctx context.Context, name common.NormalizedRoomName
 		m.connMethodSupport,
 		m.passReqs,
 		name,
+		record.CreatedTs,
+		metadata,
+		"",
+		Limits{},
+		0,
+		m.maxRequestsPerClient,
 		m.logic,
+		m.relay,
+		&m.drain,
+		&m.serverInfo,
 	)
 
 	m.mu.Lock()
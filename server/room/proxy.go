@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"io"
 	"sync"
+	"sync/atomic"
+	"time"
 
 	"friendnet.org/common"
 	"friendnet.org/protocol"
@@ -27,8 +29,44 @@ type ClientProxy struct {
 	ctx       context.Context
 	ctxCancel context.CancelFunc
 
+	room *Room
+
+	// The share of the relay that the proxy is charged to, which is the origin's.
+	relay *relayFlow
+
 	originBidi protocol.ProtoBidi
 	targetBidi protocol.ProtoBidi
+
+	// The proxy's unique ID within its room.
+	Id string
+
+	// The username of the client that opened the proxy.
+	Origin common.NormalizedUsername
+
+	// The username of the client the proxy is connected to.
+	Target common.NormalizedUsername
+
+	// When the proxy was opened.
+	CreatedTs time.Time
+
+	bytesToTarget atomic.Int64
+	bytesToOrigin atomic.Int64
+
+	// If set, records everything the target sends to the origin.
+	// Must be set before calling ClientProxy.Run.
+	recorder *responseRecorder
+}
+
+// countingWriter wraps a writer and adds the number of bytes written to a counter.
+type countingWriter struct {
+	w io.Writer
+	n *atomic.Int64
+}
+
+func (c countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n.Add(int64(n))
+	return n, err
 }
 
 const proxyBufSize = 1024
@@ -39,14 +77,20 @@ const proxyBufSize = 1024
 //
 // If the target client is not online, returns ErrTargetNotOnline.
 //
+// The proxy is tracked by the room until it is closed.
+//
 // Returns after successfully opening a target bidi and connecting the two clients.
 // Call ClientProxy.Run to run the proxy. It can be stopped by calling ClientProxy.Close.
+//
+// Data proxied in both directions is paced by the room's RelayScheduler and counted towards the origin client.
 func NewClientProxy(
-	room *Room,
-	originUsername common.NormalizedUsername,
+	origin *Client,
 	targetUsername common.NormalizedUsername,
 	originBidi protocol.ProtoBidi,
 ) (*ClientProxy, error) {
+	room := origin.Room
+	originUsername := origin.Username
+
 	targetClient, isOnline := room.GetClientByUsername(targetUsername)
 	if !isOnline {
 		return nil, ErrTargetNotOnline
@@ -65,13 +109,49 @@ func NewClientProxy(
 
 	ctx, ctxCancel := context.WithCancel(context.Background())
 
-	return &ClientProxy{
+	proxy := &ClientProxy{
 		ctx:       ctx,
 		ctxCancel: ctxCancel,
 
+		room:  room,
+		relay: origin.relay,
+
 		originBidi: originBidi,
 		targetBidi: proxyBidi,
-	}, nil
+
+		Id:        common.RandomB64UrlStr(9),
+		Origin:    originUsername,
+		Target:    targetUsername,
+		CreatedTs: time.Now(),
+	}
+	room.registerProxy(proxy)
+
+	return proxy, nil
+}
+
+// BytesToTarget returns the number of bytes proxied from the origin to the target so far.
+func (p *ClientProxy) BytesToTarget() int64 {
+	return p.bytesToTarget.Load()
+}
+
+// BytesToOrigin returns the number of bytes proxied from the target to the origin so far.
+func (p *ClientProxy) BytesToOrigin() int64 {
+	return p.bytesToOrigin.Load()
+}
+
+// IsIdle returns whether nothing was proxied in either direction yet.
+// Clients open proxied streams ahead of time and keep them warm for their next requests, and those streams are idle
+// until a request is written on them.
+func (p *ClientProxy) IsIdle() bool {
+	return p.bytesToTarget.Load() == 0 && p.bytesToOrigin.Load() == 0
+}
+
+// forwardToTarget sends data that was already read from the origin to the target.
+// Must be called before ClientProxy.Run.
+func (p *ClientProxy) forwardToTarget(data []byte) error {
+	n, err := p.targetBidi.Stream.Write(data)
+	p.bytesToTarget.Add(int64(n))
+	return err
 }
 
 // Close closes the proxy by closing bidi streams.
@@ -87,6 +167,7 @@ func (p *ClientProxy) Close() error {
 	p.mu.Unlock()
 
 	p.ctxCancel()
+	p.room.unregisterProxy(p)
 
 	errs := make([]error, 0, 2)
 	if err := p.targetBidi.Close(); err != nil {
@@ -101,11 +182,60 @@ func (p *ClientProxy) Close() error {
 	return fmt.Errorf("closing proxy bidi streams failed: %w", errors.Join(errs...))
 }
 
-func (p *ClientProxy) proxyThread(from protocol.ProtoBidi, to protocol.ProtoBidi) error {
-	_, err := io.Copy(to.Stream, from.Stream)
+// errRecordingReader wraps a reader and records the first error other than io.EOF that it returns.
+type errRecordingReader struct {
+	r   io.Reader
+	err error
+}
+
+func (r *errRecordingReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if err != nil && err != io.EOF && r.err == nil {
+		r.err = err
+	}
+	return n, err
+}
+
+// proxyCopy copies data from one bidi to another until either fails.
+// If a side cancels its stream, the cancellation is passed on to the other side with the same code, so that a
+// requester giving up makes the serving peer stop instead of writing into a closed proxy.
+// If tap is not nil, everything written to the destination is also written to it.
+// If relay is not nil, writes to the destination are paced through it.
+func proxyCopy(from protocol.ProtoBidi, to protocol.ProtoBidi, counter *atomic.Int64, tap io.Writer, relay *relayWriter) error {
+	src := &errRecordingReader{r: from.Stream}
+	var dst io.Writer = countingWriter{w: to.Stream, n: counter}
+	if relay != nil {
+		relay.w = dst
+		dst = relay
+	}
+	if tap != nil {
+		dst = io.MultiWriter(dst, tap)
+	}
+	_, err := io.Copy(dst, src)
+
+	if streamErr, ok := errors.AsType[*quic.StreamError](err); ok && streamErr.Remote {
+		if src.err != nil {
+			// The sender reset its side, so the receiver will not get the rest.
+			to.Stream.CancelWrite(streamErr.ErrorCode)
+		} else {
+			// The receiver stopped reading, so the sender should stop sending.
+			from.Stream.CancelRead(streamErr.ErrorCode)
+		}
+	}
+
 	return err
 }
 
+// relayWriter returns a relayWriter that charges the proxy's relay flow.
+// Its destination is set by proxyCopy.
+func (p *ClientProxy) relayWriter() *relayWriter {
+	return &relayWriter{
+		ctx:   p.ctx,
+		sched: p.room.relay,
+		flow:  p.relay,
+	}
+}
+
 // Run runs the proxy until it is closed.
 // Not safe for concurrent use.
 // Returns nil once the proxy is closed, either by calling ClientProxy.Close or by either side closing their stream.
@@ -124,11 +254,21 @@ func (p *ClientProxy) Run() error {
 
 	proxyErr := make(chan error, 1)
 
+	var tap io.Writer
+	if p.recorder != nil {
+		tap = p.recorder
+	}
+
 	go func() {
-		proxyErr <- p.proxyThread(p.originBidi, p.targetBidi)
+		proxyErr <- proxyCopy(p.originBidi, p.targetBidi, &p.bytesToTarget, nil, p.relayWriter())
 	}()
 	go func() {
-		proxyErr <- p.proxyThread(p.targetBidi, p.originBidi)
+		err := proxyCopy(p.targetBidi, p.originBidi, &p.bytesToOrigin, tap, p.relayWriter())
+		if err == nil && p.recorder != nil {
+			// The target finished sending cleanly.
+			p.recorder.finish()
+		}
+		proxyErr <- err
 	}()
 
 	select {
@@ -0,0 +1,66 @@
+package room
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"friendnet.org/protocol"
+	"github.com/quic-go/quic-go"
+)
+
+func TestProxyCopy_PropagatesRequesterCancel(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	origin, proxyOrigin, err := protocol.NewMemNetwork().BidiPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to open bidi: %v", err)
+	}
+	proxyTarget, target, err := protocol.NewMemNetwork().BidiPair(ctx)
+	if err != nil {
+		t.Fatalf("failed to open bidi: %v", err)
+	}
+
+	var toTarget, toOrigin atomic.Int64
+	go func() {
+		_ = proxyCopy(proxyOrigin, proxyTarget, &toTarget, nil, nil)
+	}()
+	go func() {
+		_ = proxyCopy(proxyTarget, proxyOrigin, &toOrigin, nil, nil)
+	}()
+
+	// The target acts like a peer serving a large file.
+	writeErr := make(chan error, 1)
+	go func() {
+		buf := make([]byte, 32*1024)
+		for {
+			if _, err := target.Stream.Write(buf); err != nil {
+				writeErr <- err
+				return
+			}
+		}
+	}()
+
+	// Receive part of the file, then give up on it.
+	if _, err := io.ReadFull(origin.Stream, make([]byte, 64*1024)); err != nil {
+		t.Fatalf("failed to read from proxy: %v", err)
+	}
+	origin.Cancel(protocol.RequestCanceledStreamErrorCode)
+
+	select {
+	case err := <-writeErr:
+		streamErr, ok := errors.AsType[*quic.StreamError](err)
+		if !ok {
+			t.Fatalf("expected stream error, got %v", err)
+		}
+		if streamErr.ErrorCode != protocol.RequestCanceledStreamErrorCode {
+			t.Fatalf("expected code %d, got %d", protocol.RequestCanceledStreamErrorCode, streamErr.ErrorCode)
+		}
+	case <-ctx.Done():
+		t.Fatal("target kept sending after the requester canceled")
+	}
+}
@@ -0,0 +1,419 @@
+package room
+
+import (
+	"container/heap"
+	"context"
+	"io"
+	"math"
+	"sync"
+	"time"
+
+	"friendnet.org/common"
+)
+
+// relayChunkSize is the largest number of bytes a proxy writes per grant from the RelayScheduler.
+// Smaller chunks interleave clients more finely at the cost of more scheduling overhead.
+const relayChunkSize = 16 * 1024
+
+// relayRateTau is the time constant of the moving average used for per-client relay byte rates.
+const relayRateTau = 5 * time.Second
+
+// RelayConfig is the configuration for sharing relay bandwidth between clients.
+type RelayConfig struct {
+	// The maximum number of bytes per second relayed through the server for all clients combined.
+	// While the relay is saturated, bandwidth is shared between clients in proportion to their weights, no matter how
+	// many proxied streams each one has open.
+	// 0 means unlimited, in which case proxied streams are not scheduled, but relayed bytes are still counted.
+	MaxBytesPerSecond int64
+
+	// The weight of clients without a more specific weight.
+	// 0 means 1.
+	DefaultWeight float64
+
+	// The weight of guest clients without a user-specific weight.
+	// 0 means DefaultWeight.
+	GuestWeight float64
+
+	// Weights for specific users.
+	// Keys are "room/username" with the normalized room name and username.
+	UserWeights map[string]float64
+
+	// Windows with a different bandwidth limit than MaxBytesPerSecond.
+	// If windows overlap, the first one applies.
+	Schedule []RelayLimitWindow
+}
+
+// RelayLimitWindow is a time window during which the relay has a different bandwidth limit.
+type RelayLimitWindow struct {
+	common.TimeWindow
+
+	// The maximum number of bytes per second relayed during the window.
+	// 0 means unlimited.
+	MaxBytesPerSecond int64
+}
+
+// relayMaxWait is the longest the scheduler waits before checking the bandwidth limit again, so that schedule changes
+// apply promptly to waiting writes.
+const relayMaxWait = 1 * time.Second
+
+// RelayScheduler paces proxied streams so that clients share relay bandwidth fairly.
+//
+// It implements start-time fair queueing over a token bucket: each proxied write is tagged with a virtual finish
+// time based on its size and the weight of the client that opened the proxy, and waiting writes are granted tokens
+// in tag order. A client with many streams open therefore gets the same share as one with a single stream.
+//
+// A nil *RelayScheduler does not pace anything.
+type RelayScheduler struct {
+	mu sync.Mutex
+
+	cfg RelayConfig
+
+	// Token bucket state. Only used while the bandwidth limit is positive.
+	tokens     float64
+	lastRefill time.Time
+
+	// The virtual time, which is the start tag of the last granted write.
+	virtualTime float64
+
+	// Incremented whenever virtual time restarts.
+	// A flow whose finish tag is from an older epoch treats it as zero.
+	epoch uint64
+
+	waiters relayWaiterHeap
+
+	// Signaled when a waiter is added.
+	wake chan struct{}
+}
+
+// NewRelayScheduler creates a new RelayScheduler with the specified configuration.
+// The scheduler runs until ctx is canceled.
+func NewRelayScheduler(ctx context.Context, cfg RelayConfig) *RelayScheduler {
+	if cfg.DefaultWeight <= 0 {
+		cfg.DefaultWeight = 1
+	}
+	if cfg.GuestWeight <= 0 {
+		cfg.GuestWeight = cfg.DefaultWeight
+	}
+
+	s := &RelayScheduler{
+		cfg:        cfg,
+		wake:       make(chan struct{}, 1),
+		lastRefill: time.Now(),
+	}
+	s.tokens = relayBurst(s.limitNoLock(s.lastRefill))
+
+	go s.run(ctx)
+
+	return s
+}
+
+// relayBurst returns the number of tokens the bucket can hold for the specified bandwidth limit.
+// It allows a tenth of a second of bursting, but always at least one full chunk so that large writes can proceed.
+func relayBurst(limit int64) float64 {
+	return max(float64(relayChunkSize), float64(limit)/10)
+}
+
+// limitNoLock returns the bandwidth limit in bytes per second at the specified time, or 0 if there is none.
+// The caller must hold the lock.
+func (s *RelayScheduler) limitNoLock(t time.Time) int64 {
+	for _, w := range s.cfg.Schedule {
+		if w.Contains(t) {
+			return w.MaxBytesPerSecond
+		}
+	}
+	return s.cfg.MaxBytesPerSecond
+}
+
+// Limits returns the default bandwidth limit, the schedule, and the limit that applies right now.
+// Limits are in bytes per second, with 0 meaning unlimited.
+func (s *RelayScheduler) Limits() (maxBytesPerSecond int64, schedule []RelayLimitWindow, current int64) {
+	if s == nil {
+		return 0, nil, 0
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.cfg.MaxBytesPerSecond, s.cfg.Schedule, s.limitNoLock(time.Now())
+}
+
+// SetLimits replaces the default bandwidth limit and the schedule.
+// They apply immediately, including to writes that are already waiting.
+func (s *RelayScheduler) SetLimits(maxBytesPerSecond int64, schedule []RelayLimitWindow) {
+	s.mu.Lock()
+	s.cfg.MaxBytesPerSecond = maxBytesPerSecond
+	s.cfg.Schedule = schedule
+	s.mu.Unlock()
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+}
+
+// weightFor returns the relay weight for a client.
+func (s *RelayScheduler) weightFor(room common.NormalizedRoomName, username common.NormalizedUsername, isGuest bool) float64 {
+	if s == nil {
+		return 1
+	}
+
+	if weight, has := s.cfg.UserWeights[room.String()+"/"+username.String()]; has && weight > 0 {
+		return weight
+	}
+	if isGuest {
+		return s.cfg.GuestWeight
+	}
+	return s.cfg.DefaultWeight
+}
+
+// acquire waits until n bytes may be relayed on behalf of the flow, then counts them towards it.
+// Returns the context's error if it is canceled first, in which case nothing is counted.
+func (s *RelayScheduler) acquire(ctx context.Context, flow *relayFlow, n int) error {
+	if s == nil {
+		flow.record(n)
+		return nil
+	}
+
+	s.mu.Lock()
+	if s.limitNoLock(time.Now()) <= 0 && s.waiters.Len() == 0 {
+		s.mu.Unlock()
+		flow.record(n)
+		return nil
+	}
+
+	var lastFinish float64
+	if flow.finishEpoch == s.epoch {
+		lastFinish = flow.finish
+	}
+	start := max(s.virtualTime, lastFinish)
+	flow.finish = start + float64(n)/flow.getWeight()
+	flow.finishEpoch = s.epoch
+	w := &relayWaiter{
+		start:  start,
+		finish: flow.finish,
+		n:      n,
+		ready:  make(chan struct{}),
+	}
+	heap.Push(&s.waiters, w)
+	s.mu.Unlock()
+
+	select {
+	case s.wake <- struct{}{}:
+	default:
+	}
+
+	select {
+	case <-w.ready:
+		flow.record(n)
+		return nil
+	case <-ctx.Done():
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if w.granted {
+			flow.record(n)
+			return nil
+		}
+		w.canceled = true
+		return ctx.Err()
+	}
+}
+
+// run grants tokens to waiters in virtual finish time order until ctx is canceled.
+func (s *RelayScheduler) run(ctx context.Context) {
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+
+	for {
+		wait := s.grant()
+
+		if wait > 0 {
+			timer.Reset(wait)
+			select {
+			case <-timer.C:
+			case <-s.wake:
+				// New waiters or limits may change who goes next.
+				timer.Stop()
+			case <-ctx.Done():
+				return
+			}
+			continue
+		}
+
+		select {
+		case <-s.wake:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// grant grants tokens to as many waiters as it can.
+// Returns how long to wait until the next waiter can be granted tokens, or 0 if there are no waiters left.
+func (s *RelayScheduler) grant() time.Duration {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := time.Now()
+	limit := s.limitNoLock(now)
+	rate := float64(limit)
+	s.tokens = min(relayBurst(limit), s.tokens+now.Sub(s.lastRefill).Seconds()*rate)
+	s.lastRefill = now
+
+	for s.waiters.Len() > 0 {
+		w := s.waiters[0]
+		if w.canceled {
+			heap.Pop(&s.waiters)
+			continue
+		}
+
+		// Without a limit, everyone waiting can go right away.
+		if limit > 0 && s.tokens < float64(w.n) {
+			missing := float64(w.n) - s.tokens
+			return min(relayMaxWait, max(time.Millisecond, time.Duration(missing/rate*float64(time.Second))))
+		}
+
+		heap.Pop(&s.waiters)
+		if limit > 0 {
+			s.tokens -= float64(w.n)
+		}
+		s.virtualTime = max(s.virtualTime, w.start)
+		w.granted = true
+		close(w.ready)
+	}
+
+	// Nobody is waiting, so restart virtual time.
+	// Otherwise, flows that wrote while the relay was idle would carry finish tags far ahead of the others.
+	s.virtualTime = 0
+	s.epoch++
+
+	return 0
+}
+
+// relayWaiter is a write waiting for relay bandwidth.
+type relayWaiter struct {
+	start  float64
+	finish float64
+	n      int
+
+	// Closed once the waiter is granted tokens.
+	ready chan struct{}
+
+	// Guarded by RelayScheduler.mu.
+	granted  bool
+	canceled bool
+}
+
+// relayWaiterHeap is a min-heap of waiters by virtual finish time.
+type relayWaiterHeap []*relayWaiter
+
+func (h relayWaiterHeap) Len() int           { return len(h) }
+func (h relayWaiterHeap) Less(i, j int) bool { return h[i].finish < h[j].finish }
+func (h relayWaiterHeap) Swap(i, j int)      { h[i], h[j] = h[j], h[i] }
+
+func (h *relayWaiterHeap) Push(x any) {
+	*h = append(*h, x.(*relayWaiter))
+}
+
+func (h *relayWaiterHeap) Pop() any {
+	old := *h
+	w := old[len(old)-1]
+	old[len(old)-1] = nil
+	*h = old[:len(old)-1]
+	return w
+}
+
+// relayFlow is a client's share of the relay.
+// It tracks the client's weight and how many bytes have been relayed on its behalf.
+type relayFlow struct {
+	mu sync.Mutex
+
+	weight float64
+
+	// The total number of bytes relayed.
+	total int64
+
+	// The moving average of bytes relayed per second, as of rateTs.
+	rate   float64
+	rateTs time.Time
+
+	// The virtual finish tag of the flow's last write, valid for finishEpoch.
+	// Guarded by RelayScheduler.mu.
+	finish      float64
+	finishEpoch uint64
+}
+
+// newRelayFlow creates a new relayFlow with the specified weight.
+func newRelayFlow(weight float64) *relayFlow {
+	return &relayFlow{
+		weight: weight,
+		rateTs: time.Now(),
+	}
+}
+
+// getWeight returns the flow's weight.
+func (f *relayFlow) getWeight() float64 {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.weight
+}
+
+// setWeight updates the flow's weight.
+// It applies to writes scheduled after it is called.
+func (f *relayFlow) setWeight(weight float64) {
+	f.mu.Lock()
+	f.weight = weight
+	f.mu.Unlock()
+}
+
+// record counts n bytes as relayed now.
+func (f *relayFlow) record(n int) {
+	now := time.Now()
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	f.total += int64(n)
+	f.rate = f.decayedRateNoLock(now) + float64(n)/relayRateTau.Seconds()
+	f.rateTs = now
+}
+
+// decayedRateNoLock returns the moving average rate as of now.
+func (f *relayFlow) decayedRateNoLock(now time.Time) float64 {
+	elapsed := now.Sub(f.rateTs)
+	if elapsed <= 0 {
+		return f.rate
+	}
+	return f.rate * math.Exp(-elapsed.Seconds()/relayRateTau.Seconds())
+}
+
+// stats returns the total number of bytes relayed and the recent rate in bytes per second.
+func (f *relayFlow) stats() (total int64, bytesPerSecond float64) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.total, f.decayedRateNoLock(time.Now())
+}
+
+// relayWriter wraps a writer and paces writes through a RelayScheduler.
+type relayWriter struct {
+	ctx   context.Context
+	w     io.Writer
+	sched *RelayScheduler
+	flow  *relayFlow
+}
+
+func (r *relayWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		chunk := p[:min(len(p), relayChunkSize)]
+		if err := r.sched.acquire(r.ctx, r.flow, len(chunk)); err != nil {
+			return written, err
+		}
+
+		n, err := r.w.Write(chunk)
+		written += n
+		if err != nil {
+			return written, err
+		}
+		p = p[n:]
+	}
+	return written, nil
+}
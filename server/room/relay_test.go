@@ -0,0 +1,87 @@
+package room
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRelaySchedulerFairness(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 500*time.Millisecond)
+	defer cancel()
+
+	sched := NewRelayScheduler(ctx, RelayConfig{MaxBytesPerSecond: 4 * 1024 * 1024})
+
+	// One client saturates the relay with many streams, one uses a single stream,
+	// and one uses a single stream with double weight.
+	greedy := newRelayFlow(1)
+	modest := newRelayFlow(1)
+	heavy := newRelayFlow(2)
+
+	var wg sync.WaitGroup
+	stream := func(flow *relayFlow) {
+		wg.Go(func() {
+			for sched.acquire(ctx, flow, relayChunkSize) == nil {
+			}
+		})
+	}
+	for range 8 {
+		stream(greedy)
+	}
+	stream(modest)
+	stream(heavy)
+	wg.Wait()
+
+	greedyTotal, _ := greedy.stats()
+	modestTotal, _ := modest.stats()
+	heavyTotal, _ := heavy.stats()
+	if modestTotal == 0 {
+		t.Fatalf("single stream got no bandwidth, greedy client got %d bytes", greedyTotal)
+	}
+
+	if ratio := float64(greedyTotal) / float64(modestTotal); ratio < 0.5 || ratio > 2 {
+		t.Errorf("greedy client got %d bytes and single stream got %d, want about equal shares", greedyTotal, modestTotal)
+	}
+	if ratio := float64(heavyTotal) / float64(modestTotal); ratio < 1.3 || ratio > 3 {
+		t.Errorf("double weight client got %d bytes and single stream got %d, want about double", heavyTotal, modestTotal)
+	}
+}
+
+func TestRelaySchedulerSetLimits(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	// The limit is so low that a second chunk would take about a minute.
+	sched := NewRelayScheduler(ctx, RelayConfig{MaxBytesPerSecond: 256})
+	flow := newRelayFlow(1)
+	if err := sched.acquire(ctx, flow, relayChunkSize); err != nil {
+		t.Fatal(err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- sched.acquire(ctx, flow, relayChunkSize)
+	}()
+
+	select {
+	case err := <-done:
+		t.Fatalf("second chunk was not limited, err %v", err)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	// Removing the limit lets the waiting chunk through.
+	sched.SetLimits(0, nil)
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatal(err)
+		}
+	case <-time.After(500 * time.Millisecond):
+		t.Fatal("waiting chunk was not released after removing the limit")
+	}
+
+	if _, _, current := sched.Limits(); current != 0 {
+		t.Errorf("got current limit %d, want unlimited", current)
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"runtime/debug"
 	"sync"
 	"time"
+	"unicode/utf8"
 
 	"friendnet.org/common"
 	"friendnet.org/common/machine"
@@ -24,6 +25,52 @@ var ErrRoomClosed = errors.New("room closed")
 var ErrUsernameAlreadyConnected = errors.New("client with same username already connected to room")
 var ErrAccountExists = errors.New("account with same username already exists")
 var ErrNoSuchAccount = errors.New("no such account")
+var ErrInvalidInviteCode = errors.New("invalid invite code")
+var ErrNoSuchInviteCode = errors.New("no such invite code")
+var ErrRoomFull = errors.New("room is full")
+var ErrNoSuchProxy = errors.New("no such proxy")
+var ErrDescriptionTooLong = fmt.Errorf("room description is longer than %d characters", MaxDescriptionLength)
+var ErrMotdTooLong = fmt.Errorf("message of the day is longer than %d characters", MaxMotdLength)
+var ErrNoticeEmpty = errors.New("notice text is empty")
+var ErrNoticeTooLong = fmt.Errorf("notice text is longer than %d characters", MaxNoticeLength)
+
+// MaxDescriptionLength is the maximum number of characters in a room description.
+const MaxDescriptionLength = 500
+
+// MaxMotdLength is the maximum number of characters in a room's message of the day.
+const MaxMotdLength = 2000
+
+// MaxNoticeLength is the maximum number of characters in a notice sent with Room.SendNotice.
+const MaxNoticeLength = 1000
+
+// Limits are a room's capacity and concurrency limits.
+// A value of 0 means unlimited.
+type Limits struct {
+	// The maximum number of online clients.
+	MaxClients int
+
+	// The maximum number of concurrent proxied streams each client can open.
+	MaxProxyStreamsPerClient int
+}
+
+// Metadata is descriptive information about a room.
+type Metadata struct {
+	// A description of the room, shown to people looking for rooms to join.
+	// At most MaxDescriptionLength characters.
+	Description string
+
+	// Whether the room is included in public room listings.
+	// Unlisted rooms can still be joined by anyone who knows their name.
+	Listed bool
+}
+
+// Validate returns ErrDescriptionTooLong if the description is too long.
+func (m Metadata) Validate() error {
+	if utf8.RuneCountInString(m.Description) > MaxDescriptionLength {
+		return ErrDescriptionTooLong
+	}
+	return nil
+}
 
 // Room is a server room that manages connected clients.
 type Room struct {
@@ -32,13 +79,30 @@ type Room struct {
 	mu       sync.RWMutex
 	isClosed bool
 
-	storage           *storage.Storage
+	storage           storage.Storage
 	connMethodSupport machine.ConnMethodSupport
 	passReqs          pass.Requirements
 
 	// The room's name.
 	Name common.NormalizedRoomName
 
+	// When the room was created.
+	CreatedTs time.Time
+
+	metadata Metadata
+
+	// The message of the day sent to clients when they join, or empty if there is none.
+	motd string
+
+	limits Limits
+
+	// How long proxied directory listings are cached, or 0 if caching is disabled.
+	dirCacheTtl time.Duration
+
+	// The maximum number of requests each client can have in flight at once.
+	// 0 means unlimited.
+	maxRequestsPerClient int
+
 	// The room's token manager.
 	TokenManager *TokenManager
 
@@ -49,19 +113,45 @@ type Room struct {
 
 	logic Logic
 
+	// Paces proxied streams between clients.
+	relay *RelayScheduler
+
+	// Whether new proxied streams are refused.
+	drain *Drain
+
+	// The server info reported to clients.
+	serverInfo *ServerInfo
+
 	// Key is the string value of a common.NormalizedUsername.
 	clients map[string]*Client
+
+	// Currently open proxied streams.
+	// Key is the proxy's ID.
+	proxies map[string]*ClientProxy
+
+	// The number of bytes proxied by streams that were already closed.
+	// Guarded by mu.
+	closedProxyBytes int64
 }
 
 // NewRoom creates a new room instance.
 // The room manages clients within it.
 func NewRoom(
 	logger *slog.Logger,
-	storage *storage.Storage,
+	storage storage.Storage,
 	connMethodSupport machine.ConnMethodSupport,
 	passReqs pass.Requirements,
 	name common.NormalizedRoomName,
+	createdTs time.Time,
+	metadata Metadata,
+	motd string,
+	limits Limits,
+	dirCacheTtl time.Duration,
+	maxRequestsPerClient int,
 	logic Logic,
+	relay *RelayScheduler,
+	drain *Drain,
+	serverInfo *ServerInfo,
 ) *Room {
 	ctx, ctxCancel := context.WithCancel(context.Background())
 
@@ -72,16 +162,26 @@ func NewRoom(
 		connMethodSupport: connMethodSupport,
 		passReqs:          passReqs,
 
-		Name: name,
+		Name:                 name,
+		CreatedTs:            createdTs,
+		metadata:             metadata,
+		motd:                 motd,
+		limits:               limits,
+		dirCacheTtl:          dirCacheTtl,
+		maxRequestsPerClient: maxRequestsPerClient,
 
 		TokenManager: NewTokenManager(ctx, DefaultTokenValidDuration, DefaultTokenExpiredGcInterval),
 
 		Context:   ctx,
 		ctxCancel: ctxCancel,
 
-		logic: logic,
+		logic:      logic,
+		relay:      relay,
+		drain:      drain,
+		serverInfo: serverInfo,
 
 		clients: make(map[string]*Client),
+		proxies: make(map[string]*ClientProxy),
 	}
 }
 
@@ -109,6 +209,54 @@ func (r *Room) Close() error {
 
 	r.mu.Unlock()
 
+	disconnectClients(clients)
+
+	r.mu.Lock()
+	r.clients = nil
+	r.mu.Unlock()
+
+	return nil
+}
+
+// DisconnectAll disconnects all clients in the room and cancels all proxied streams, without closing the room.
+// Clients may reconnect afterward.
+// Returns the number of clients that were disconnected.
+// Returns ErrRoomClosed if the room is closed.
+func (r *Room) DisconnectAll() (int, error) {
+	r.mu.RLock()
+	if r.isClosed {
+		r.mu.RUnlock()
+		return 0, ErrRoomClosed
+	}
+
+	clients := r.snapshotClientsNoLock()
+
+	r.mu.RUnlock()
+
+	disconnectClients(clients)
+
+	r.mu.Lock()
+	for _, client := range clients {
+		r.handleDisconnect(client)
+	}
+	r.mu.Unlock()
+
+	for _, proxy := range r.GetProxies() {
+		_ = proxy.Close()
+	}
+
+	r.logger.Info("disconnected all clients",
+		"service", "room.Room",
+		"room", r.Name.String(),
+		"count", len(clients),
+	)
+
+	return len(clients), nil
+}
+
+// disconnectClients tells the clients that the room is shutting down and then closes their connections.
+// It does not remove them from the room.
+func disconnectClients(clients []*Client) {
 	// Signal to the client connections that the server is shutting down.
 	// Give them 5 seconds to respond before closing the connections.
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
@@ -129,16 +277,10 @@ func (r *Room) Close() error {
 	var wg sync.WaitGroup
 	for _, client := range clients {
 		wg.Go(func() {
-			_ = client.conn.CloseWithReason("room closed")
+			_ = client.conn.CloseWithCode(protocol.CloseCodeShutdown, "room closed")
 		})
 	}
 	wg.Wait()
-
-	r.mu.Lock()
-	r.clients = nil
-	r.mu.Unlock()
-
-	return nil
 }
 
 // ClientCount returns the current number of clients.
@@ -194,47 +336,91 @@ func (r *Room) Broadcast(typ pb.MsgType, msg proto.Message) {
 	}()
 }
 
+// SendNotice broadcasts a notice from the server's operators to all clients in the room.
+// Like Room.Broadcast, it does not wait for the notice to be sent.
+// Returns the number of clients the notice is being sent to.
+// Returns ErrNoticeEmpty or ErrNoticeTooLong if the text is empty or too long, or ErrRoomClosed if the room is closed.
+func (r *Room) SendNotice(text string) (int, error) {
+	if text == "" {
+		return 0, ErrNoticeEmpty
+	}
+	if utf8.RuneCountInString(text) > MaxNoticeLength {
+		return 0, ErrNoticeTooLong
+	}
+
+	r.mu.RLock()
+	if r.isClosed {
+		r.mu.RUnlock()
+		return 0, ErrRoomClosed
+	}
+	count := len(r.clients)
+	r.mu.RUnlock()
+
+	r.Broadcast(pb.MsgType_MSG_TYPE_SERVER_NOTICE, &pb.MsgServerNotice{
+		Text: text,
+	})
+
+	r.logger.Info("sent notice",
+		"service", "room.Room",
+		"room", r.Name.String(),
+		"recipients", count,
+	)
+
+	return count, nil
+}
+
 // Onboard takes ownership of a connection and adds it to the room.
 // The connection must already have been authenticated.
 //
 // If onboarding is successful, it will write the auth accepted message to authBidi and close it.
 //
+// If isGuest is true, the client will be restricted to browsing and downloading.
+//
 // If there is an existing client with the username, returns ErrUsernameAlreadyConnected.
+// If the room already has its maximum number of online clients, returns ErrRoomFull.
 // This method will not close the connection if it returns an error; it is the caller's responsibility to close it if an error is returned.
 func (r *Room) Onboard(
 	authBidi protocol.ProtoBidi,
 	conn protocol.ProtoConn,
 	version *pb.ProtoVersion,
 	username common.NormalizedUsername,
+	isGuest bool,
 ) error {
-	r.mu.RLock()
-	if r.isClosed {
-		r.mu.RUnlock()
-		return ErrRoomClosed
-	}
-
-	_, has := r.clients[username.String()]
-	if has {
-		r.mu.RUnlock()
-		return ErrUsernameAlreadyConnected
-	}
-
-	r.mu.RUnlock()
-
 	client := NewClient(
 		r.logger,
 		conn,
 		version,
 		r,
 		username,
+		isGuest,
 		r.logic,
 	)
 
+	// The checks and the insert happen under the same lock, so concurrent onboards cannot exceed the room's limits.
 	r.mu.Lock()
+	if r.isClosed {
+		r.mu.Unlock()
+		return ErrRoomClosed
+	}
+
+	_, has := r.clients[username.String()]
+	if has {
+		r.mu.Unlock()
+		return ErrUsernameAlreadyConnected
+	}
+
+	if r.limits.MaxClients > 0 && len(r.clients) >= r.limits.MaxClients {
+		r.mu.Unlock()
+		return ErrRoomFull
+	}
+
 	r.handleConnect(client)
 	r.mu.Unlock()
 
-	err := authBidi.Write(pb.MsgType_MSG_TYPE_AUTH_ACCEPTED, &pb.MsgAuthAccepted{})
+	err := authBidi.Write(pb.MsgType_MSG_TYPE_AUTH_ACCEPTED, &pb.MsgAuthAccepted{
+		Motd:       r.Motd(),
+		ServerTsMs: time.Now().UnixMilli(),
+	})
 	if err != nil {
 		r.mu.Lock()
 		r.handleDisconnect(client)
@@ -299,6 +485,217 @@ func (r *Room) Onboard(
 	return nil
 }
 
+// Metadata returns the room's current metadata.
+func (r *Room) Metadata() Metadata {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.metadata
+}
+
+// SetMetadata updates the room's metadata and saves it to storage.
+// Returns ErrDescriptionTooLong if the description is too long.
+func (r *Room) SetMetadata(ctx context.Context, metadata Metadata) error {
+	if err := metadata.Validate(); err != nil {
+		return err
+	}
+
+	r.mu.RLock()
+	if r.isClosed {
+		r.mu.RUnlock()
+		return ErrRoomClosed
+	}
+	r.mu.RUnlock()
+
+	err := r.storage.UpdateRoomMetadata(ctx, r.Name, metadata.Description, metadata.Listed)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.metadata = metadata
+	r.mu.Unlock()
+
+	return nil
+}
+
+// Motd returns the room's message of the day, or empty if there is none.
+func (r *Room) Motd() string {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.motd
+}
+
+// SetMotd updates the room's message of the day and saves it to storage.
+// It is sent to clients that join afterward. An empty message removes it.
+// Returns ErrMotdTooLong if the message is too long.
+func (r *Room) SetMotd(ctx context.Context, motd string) error {
+	if utf8.RuneCountInString(motd) > MaxMotdLength {
+		return ErrMotdTooLong
+	}
+
+	r.mu.RLock()
+	if r.isClosed {
+		r.mu.RUnlock()
+		return ErrRoomClosed
+	}
+	r.mu.RUnlock()
+
+	err := r.storage.UpdateRoomMotd(ctx, r.Name, motd)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.motd = motd
+	r.mu.Unlock()
+
+	return nil
+}
+
+// Limits returns the room's current limits.
+func (r *Room) Limits() Limits {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.limits
+}
+
+// SetLimits updates the room's limits and saves them to storage.
+// Lowered limits only apply to new clients and proxied streams; existing ones are left alone.
+func (r *Room) SetLimits(ctx context.Context, limits Limits) error {
+	r.mu.RLock()
+	if r.isClosed {
+		r.mu.RUnlock()
+		return ErrRoomClosed
+	}
+	r.mu.RUnlock()
+
+	err := r.storage.UpdateRoomLimits(ctx, r.Name, limits.MaxClients, limits.MaxProxyStreamsPerClient)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.limits = limits
+	r.mu.Unlock()
+
+	return nil
+}
+
+// DirCacheTtl returns how long proxied directory listings are cached, or 0 if caching is disabled.
+func (r *Room) DirCacheTtl() time.Duration {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.dirCacheTtl
+}
+
+// SetDirCacheTtl updates how long proxied directory listings are cached and saves it to storage.
+// A TTL of 0 disables caching and drops all cached listings.
+func (r *Room) SetDirCacheTtl(ctx context.Context, ttl time.Duration) error {
+	r.mu.RLock()
+	if r.isClosed {
+		r.mu.RUnlock()
+		return ErrRoomClosed
+	}
+	r.mu.RUnlock()
+
+	err := r.storage.UpdateRoomDirCacheTtl(ctx, r.Name, ttl)
+	if err != nil {
+		return err
+	}
+
+	r.mu.Lock()
+	r.dirCacheTtl = ttl
+	clients := r.snapshotClientsNoLock()
+	r.mu.Unlock()
+
+	if ttl == 0 {
+		for _, client := range clients {
+			client.dirCache.clear()
+		}
+	}
+
+	return nil
+}
+
+// GetProxies returns all currently open proxied streams in the room.
+// Note that this method creates a new slice each time it is called.
+func (r *Room) GetProxies() []*ClientProxy {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	proxies := make([]*ClientProxy, 0, len(r.proxies))
+	for _, proxy := range r.proxies {
+		proxies = append(proxies, proxy)
+	}
+	return proxies
+}
+
+// ActiveProxyCount returns the number of open proxied streams in the room that are not idle.
+// Draining waits for these. Idle streams are warm streams that no request was written on yet, which clients close on
+// their own once they stop making requests, so there is nothing to wait for.
+func (r *Room) ActiveProxyCount() int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	var n int
+	for _, proxy := range r.proxies {
+		if !proxy.IsIdle() {
+			n++
+		}
+	}
+	return n
+}
+
+// CancelProxy closes the open proxied stream with the specified ID.
+// If there is no such proxy, returns ErrNoSuchProxy.
+func (r *Room) CancelProxy(id string) error {
+	r.mu.RLock()
+	proxy, has := r.proxies[id]
+	r.mu.RUnlock()
+
+	if !has {
+		return ErrNoSuchProxy
+	}
+
+	r.logger.Info("canceling proxied stream",
+		"service", "room.Room",
+		"room", r.Name.String(),
+		"id", id,
+		"origin", proxy.Origin.String(),
+		"target", proxy.Target.String(),
+	)
+
+	return proxy.Close()
+}
+
+func (r *Room) registerProxy(proxy *ClientProxy) {
+	r.mu.Lock()
+	r.proxies[proxy.Id] = proxy
+	r.mu.Unlock()
+}
+
+func (r *Room) unregisterProxy(proxy *ClientProxy) {
+	r.mu.Lock()
+	if _, has := r.proxies[proxy.Id]; has {
+		delete(r.proxies, proxy.Id)
+		r.closedProxyBytes += proxy.BytesToTarget() + proxy.BytesToOrigin()
+	}
+	r.mu.Unlock()
+}
+
+// RelayedBytes returns the total number of bytes proxied in both directions by streams opened in the room since it
+// was opened, including streams that are still open.
+func (r *Room) RelayedBytes() int64 {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+
+	total := r.closedProxyBytes
+	for _, proxy := range r.proxies {
+		total += proxy.BytesToTarget() + proxy.BytesToOrigin()
+	}
+	return total
+}
+
 // GetClientByUsername returns the client with the specified username, if any.
 // The bool value is whether there was a client with that username.
 // Always returns false if the room is closed.
@@ -314,9 +711,10 @@ func (r *Room) GetClientByUsername(username common.NormalizedUsername) (*Client,
 }
 
 // CreateAccount creates a new account in the room.
+// If isGuest is true, the account is created as a guest account.
 // Returns ErrAccountExists if an account with the same username already exists.
 // Returns a password.Error if the password does not meet the room's requirements.
-func (r *Room) CreateAccount(ctx context.Context, username common.NormalizedUsername, password string) error {
+func (r *Room) CreateAccount(ctx context.Context, username common.NormalizedUsername, password string, isGuest bool) error {
 	r.mu.RLock()
 	if r.isClosed {
 		r.mu.RUnlock()
@@ -345,7 +743,7 @@ func (r *Room) CreateAccount(ctx context.Context, username common.NormalizedUser
 		)
 	}
 
-	err = r.storage.CreateAccount(ctx, r.Name, username, hash)
+	err = r.storage.CreateAccount(ctx, r.Name, username, hash, isGuest)
 	if err != nil {
 		return fmt.Errorf(`failed to create account %q@%q in CreateAccount: %w`,
 			username.String(),
@@ -357,6 +755,90 @@ func (r *Room) CreateAccount(ctx context.Context, username common.NormalizedUser
 	return nil
 }
 
+// CreateAccountWithInviteCode redeems a single-use invite code and creates a new account in the room.
+// The invite code is only redeemed if the account is created.
+// Returns ErrInvalidInviteCode if the invite code does not exist for the room or was already used.
+// Returns ErrAccountExists if an account with the same username already exists.
+// Returns a password.Error if the password does not meet the room's requirements.
+func (r *Room) CreateAccountWithInviteCode(
+	ctx context.Context,
+	username common.NormalizedUsername,
+	password string,
+	inviteCode string,
+) error {
+	r.mu.RLock()
+	if r.isClosed {
+		r.mu.RUnlock()
+		return ErrRoomClosed
+	}
+	r.mu.RUnlock()
+
+	hash, err := pass.HashWithRequirements(username, password, r.passReqs)
+	if err != nil {
+		return fmt.Errorf(`failed to hash password for account %q@%q in CreateAccountWithInviteCode: %w`,
+			username.String(),
+			r.Name.String(),
+			err,
+		)
+	}
+
+	err = r.storage.CreateAccountWithInviteCode(ctx, r.Name, username, hash, inviteCode)
+	if err != nil {
+		if errors.Is(err, storage.ErrInvalidInviteCode) {
+			return ErrInvalidInviteCode
+		}
+		if errors.Is(err, storage.ErrRecordExists) {
+			return ErrAccountExists
+		}
+
+		return fmt.Errorf(`failed to create account %q@%q in CreateAccountWithInviteCode: %w`,
+			username.String(),
+			r.Name.String(),
+			err,
+		)
+	}
+
+	return nil
+}
+
+// CreateInviteCode creates a new single-use invite code for registering an account in the room.
+func (r *Room) CreateInviteCode(ctx context.Context) (storage.InviteCodeRecord, error) {
+	r.mu.RLock()
+	if r.isClosed {
+		r.mu.RUnlock()
+		return storage.InviteCodeRecord{}, ErrRoomClosed
+	}
+	r.mu.RUnlock()
+
+	record, err := r.storage.CreateInviteCode(ctx, r.Name, common.RandomB64UrlStr(12))
+	if err != nil {
+		return storage.InviteCodeRecord{}, fmt.Errorf(`failed to create invite code for room %q: %w`,
+			r.Name.String(),
+			err,
+		)
+	}
+
+	return record, nil
+}
+
+// GetInviteCodes returns all unused invite codes for the room.
+func (r *Room) GetInviteCodes(ctx context.Context) ([]storage.InviteCodeRecord, error) {
+	return r.storage.GetInviteCodesByRoom(ctx, r.Name)
+}
+
+// DeleteInviteCode deletes an unused invite code for the room.
+// Returns ErrNoSuchInviteCode if the invite code does not exist.
+func (r *Room) DeleteInviteCode(ctx context.Context, code string) error {
+	deleted, err := r.storage.DeleteInviteCode(ctx, r.Name, code)
+	if err != nil {
+		return err
+	}
+	if !deleted {
+		return ErrNoSuchInviteCode
+	}
+	return nil
+}
+
 // DeleteAccount deletes an account from the room.
 // If the account does not exist, returns ErrNoSuchAccount.
 func (r *Room) DeleteAccount(ctx context.Context, username common.NormalizedUsername) error {
@@ -435,6 +917,45 @@ func (r *Room) UpdateAccountPassword(ctx context.Context, username common.Normal
 	return nil
 }
 
+// SetAccountGuest sets whether an account in the room is a guest account.
+// If the user is online, the change applies to their current session immediately.
+// If the account does not exist, returns ErrNoSuchAccount.
+func (r *Room) SetAccountGuest(ctx context.Context, username common.NormalizedUsername, isGuest bool) error {
+	r.mu.RLock()
+	if r.isClosed {
+		r.mu.RUnlock()
+		return ErrRoomClosed
+	}
+	r.mu.RUnlock()
+
+	_, has, err := r.storage.GetAccountByRoomAndUsername(ctx, r.Name, username)
+	if err != nil {
+		return fmt.Errorf(`failed to check if account %q@%q exists in SetAccountGuest: %w`,
+			username.String(),
+			r.Name.String(),
+			err,
+		)
+	}
+	if !has {
+		return ErrNoSuchAccount
+	}
+
+	err = r.storage.UpdateAccountIsGuest(ctx, r.Name, username, isGuest)
+	if err != nil {
+		return fmt.Errorf(`failed to update guest flag for account %q@%q in SetAccountGuest: %w`,
+			username.String(),
+			r.Name.String(),
+			err,
+		)
+	}
+
+	if client, online := r.GetClientByUsername(username); online {
+		client.setGuest(isGuest)
+	}
+
+	return nil
+}
+
 // VerifyAccountPassword verifies a password for an account in the room.
 // If the account does not exist, returns ErrNoSuchAccount.
 // Returns true if the password matches, false otherwise.
@@ -499,9 +1020,7 @@ func (r *Room) handleConnect(client *Client) {
 	r.clients[client.Username.String()] = client
 
 	r.Broadcast(pb.MsgType_MSG_TYPE_CLIENT_ONLINE, &pb.MsgClientOnline{
-		Info: &pb.OnlineUserInfo{
-			Username: client.Username.String(),
-		},
+		Info: client.Info(),
 	})
 
 	r.logger.Info("client connected",
@@ -526,7 +1045,7 @@ func (r *Room) handleDisconnect(client *Client) {
 	delete(r.clients, unStr)
 
 	// In case the connection was not closed, mark it as closed here.
-	_ = client.conn.CloseWithReason("disconnected")
+	_ = client.conn.CloseWithCode(protocol.CloseCodeNormal, "disconnected")
 
 	r.Broadcast(pb.MsgType_MSG_TYPE_CLIENT_OFFLINE, &pb.MsgClientOffline{
 		Username: client.Username.String(),
@@ -543,23 +1062,25 @@ func (r *Room) handleDisconnect(client *Client) {
 // If there is no client with that username, this is a no-op.
 func (r *Room) KickClientByUsername(username common.NormalizedUsername) error {
 	r.mu.Lock()
+	defer r.mu.Unlock()
 	if r.isClosed {
-		r.mu.Unlock()
 		return ErrRoomClosed
 	}
 
 	client, has := r.clients[username.String()]
 	if !has {
-		r.mu.Unlock()
 		return nil
 	}
 
+	// Close with the kicked code before handleDisconnect closes the connection normally.
+	err := client.conn.CloseWithCode(protocol.CloseCodeKicked, "kicked")
 	r.handleDisconnect(client)
-	r.mu.Unlock()
 
-	if client != nil {
-		return client.conn.CloseWithReason("kicked")
-	}
+	r.logger.Info("kicked client",
+		"service", "room.Room",
+		"room", r.Name.String(),
+		"username", username.String(),
+	)
 
-	return nil
+	return err
 }
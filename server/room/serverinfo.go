@@ -0,0 +1,32 @@
+package room
+
+import "sync/atomic"
+
+// SoftwareInfo describes the server software that rooms report to clients that ask for server info.
+type SoftwareInfo struct {
+	// The server software's version.
+	Version string
+
+	// The optional features that are enabled on the server.
+	Features []string
+}
+
+// ServerInfo holds the SoftwareInfo that every room reports.
+// It is shared by every room.
+// The zero value reports an empty SoftwareInfo.
+type ServerInfo struct {
+	software atomic.Pointer[SoftwareInfo]
+}
+
+// Set replaces the reported software info.
+func (i *ServerInfo) Set(software SoftwareInfo) {
+	i.software.Store(&software)
+}
+
+// Software returns the reported software info.
+func (i *ServerInfo) Software() SoftwareInfo {
+	if software := i.software.Load(); software != nil {
+		return *software
+	}
+	return SoftwareInfo{}
+}
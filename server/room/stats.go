@@ -0,0 +1,89 @@
+package room
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"friendnet.org/server/storage"
+)
+
+// StatsConfig is the configuration for RunStatsRecorder.
+type StatsConfig struct {
+	// How often to take a snapshot of each room's usage.
+	Interval time.Duration
+
+	// How long snapshots are kept before they are deleted.
+	// Specify 0 to keep snapshots forever.
+	Retention time.Duration
+}
+
+// RunStatsRecorder periodically records a snapshot of the online client count and relayed bytes of every room in the
+// manager to storage, deleting snapshots older than the configured retention.
+// The relayed bytes of each snapshot are the bytes relayed since the room's previous snapshot.
+// It blocks until ctx is done.
+func RunStatsRecorder(ctx context.Context, logger *slog.Logger, st storage.Storage, mgr *Manager, cfg StatsConfig) {
+	if cfg.Interval <= 0 {
+		panic("stats recorder interval must be positive")
+	}
+
+	ticker := time.NewTicker(cfg.Interval)
+	defer ticker.Stop()
+
+	// The total relayed bytes of each room at its previous snapshot.
+	// Keyed by instance so that a deleted and recreated room starts over.
+	prevBytes := make(map[*Room]int64)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		now := time.Now()
+		rooms := mgr.GetAll()
+		nextBytes := make(map[*Room]int64, len(rooms))
+
+		for _, r := range rooms {
+			total := r.RelayedBytes()
+			nextBytes[r] = total
+
+			err := st.CreateRoomStat(ctx, storage.RoomStatRecord{
+				Room:          r.Name,
+				Ts:            now,
+				OnlineClients: r.ClientCount(),
+				RelayedBytes:  total - prevBytes[r],
+			})
+			if err != nil {
+				logger.Error("failed to record room stats",
+					"service", "room.StatsRecorder",
+					"room", r.Name.String(),
+					"err", err,
+				)
+			}
+		}
+
+		prevBytes = nextBytes
+
+		if cfg.Retention <= 0 {
+			continue
+		}
+
+		deleted, err := st.DeleteRoomStatsBefore(ctx, now.Add(-cfg.Retention))
+		if err != nil {
+			logger.Error("failed to delete old room stats",
+				"service", "room.StatsRecorder",
+				"err", err,
+			)
+			continue
+		}
+
+		if deleted > 0 {
+			logger.Debug("deleted old room stats",
+				"service", "room.StatsRecorder",
+				"total", deleted,
+			)
+		}
+	}
+}
@@ -0,0 +1,63 @@
+package room
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"friendnet.org/server/storage"
+)
+
+// RunRoomStorageCloser periodically closes the databases of rooms that nobody has been online in since the previous
+// check, for storage that keeps each room's data in a separate database.
+// Databases that are closed are opened again by the storage the next time the room's data is used, such as when a
+// client authenticates to it.
+// It blocks until ctx is done.
+func RunRoomStorageCloser(ctx context.Context, logger *slog.Logger, closer storage.RoomCloser, mgr *Manager, interval time.Duration) {
+	if interval <= 0 {
+		panic("room storage closer interval must be positive")
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	// The rooms that were idle at the previous check.
+	// A room's database is only closed if it is still idle, so that rooms that are briefly empty keep it open.
+	prevIdle := make(map[string]struct{})
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+
+		idle := make(map[string]struct{})
+		for _, name := range closer.OpenRooms() {
+			r, has := mgr.GetRoomByName(name)
+			if has && r.ClientCount() > 0 {
+				continue
+			}
+
+			if _, wasIdle := prevIdle[name.String()]; !wasIdle {
+				idle[name.String()] = struct{}{}
+				continue
+			}
+
+			if err := closer.CloseRoom(name); err != nil {
+				logger.Error("failed to close room database",
+					"service", "room.StorageCloser",
+					"room", name.String(),
+					"err", err,
+				)
+				continue
+			}
+			logger.Debug("closed database of idle room",
+				"service", "room.StorageCloser",
+				"room", name.String(),
+			)
+		}
+
+		prevIdle = idle
+	}
+}
@@ -5,12 +5,21 @@ import (
 	"crypto/rand"
 	"encoding/base64"
 	"errors"
+	"fmt"
+	"math"
+	"os"
+	"slices"
+	"strings"
+	"time"
 
 	"connectrpc.com/connect"
 	"friendnet.org/common"
 	"friendnet.org/common/password"
+	"friendnet.org/protocol"
 	v1 "friendnet.org/protocol/pb/serverrpc/v1"
 	"friendnet.org/protocol/pb/serverrpc/v1/serverrpcv1connect"
+	"friendnet.org/server/config"
+	"friendnet.org/server/lobby"
 	"friendnet.org/server/room"
 	"friendnet.org/server/storage"
 	"friendnet.org/updater"
@@ -23,16 +32,30 @@ var errRoomExists = connect.NewError(connect.CodeAlreadyExists, errors.New("room
 var errAccountExists = connect.NewError(connect.CodeAlreadyExists, errors.New("account already exists"))
 var errInvalidRoomName = connect.NewError(connect.CodeInvalidArgument, errors.New("invalid room name"))
 var errInvalidUsername = connect.NewError(connect.CodeInvalidArgument, errors.New("invalid username"))
+var errInviteCodeNotFound = connect.NewError(connect.CodeNotFound, errors.New("invite code not found"))
+var errStreamNotFound = connect.NewError(connect.CodeNotFound, errors.New("stream not found"))
 
 type RpcServer struct {
 	s     *Server
 	iface common.RpcServerConfig
+
+	// The fingerprint of the server's certificate, included in invite bundles.
+	certFingerprint string
+
+	// The path to the config file the server was started with, or empty if there is none.
+	configPath string
 }
 
-func NewRpcServer(s *Server, iface common.RpcServerConfig) *RpcServer {
+// NewRpcServer creates a new RpcServer for the server.
+// The certificate fingerprint is included in invite bundles created through it.
+// The config path is the file that ValidateConfig validates when it is not given a config, and may be empty.
+func NewRpcServer(s *Server, iface common.RpcServerConfig, certFingerprint string, configPath string) *RpcServer {
 	return &RpcServer{
 		s:     s,
 		iface: iface,
+
+		certFingerprint: certFingerprint,
+		configPath:      configPath,
 	}
 }
 
@@ -46,19 +69,68 @@ func (s *RpcServer) roomToInfo(r *room.Room) *v1.RoomInfo {
 	if r == nil {
 		return nil
 	}
+	limits := r.Limits()
+	metadata := r.Metadata()
 	return &v1.RoomInfo{
-		Name:            r.Name.String(),
-		OnlineUserCount: uint32(r.ClientCount()),
+		Name:                     r.Name.String(),
+		OnlineUserCount:          uint32(r.ClientCount()),
+		MaxClients:               uint32(limits.MaxClients),
+		MaxProxyStreamsPerClient: uint32(limits.MaxProxyStreamsPerClient),
+		DirCacheTtlMs:            uint32(r.DirCacheTtl().Milliseconds()),
+		CreatedTs:                r.CreatedTs.Unix(),
+		Description:              metadata.Description,
+		Listed:                   metadata.Listed,
+		Motd:                     r.Motd(),
+	}
+}
+
+// rttStatsToPb converts round-trip time statistics to their RPC representation.
+func rttStatsToPb(rtt common.RttStats) *v1.RttStats {
+	res := &v1.RttStats{
+		LastUs:          rtt.Last.Microseconds(),
+		MinUs:           rtt.Min.Microseconds(),
+		AvgUs:           rtt.Avg.Microseconds(),
+		MaxUs:           rtt.Max.Microseconds(),
+		Samples:         uint32(rtt.Samples),
+		Lost:            rtt.Lost,
+		ConsecutiveLost: uint32(rtt.ConsecutiveLost),
 	}
+	if rtt.ClockOffsetKnown {
+		res.ClockOffsetUs = new(rtt.ClockOffset.Microseconds())
+	}
+	return res
 }
+
 func (s *RpcServer) clientToInfo(c *room.Client) *v1.OnlineUserInfo {
+	relayed, relayRate := c.RelayStats()
 	return &v1.OnlineUserInfo{
-		Username: c.Username.String(),
+		Username:            c.Username.String(),
+		Rtt:                 rttStatsToPb(c.RttStats()),
+		RelayedBytes:        relayed,
+		RelayBytesPerSecond: int64(relayRate),
 	}
 }
 func (s *RpcServer) accountToInfo(r storage.AccountRecord) *v1.AccountInfo {
 	return &v1.AccountInfo{
 		Username: r.Username.String(),
+		IsGuest:  r.IsGuest,
+	}
+}
+func (s *RpcServer) inviteCodeToInfo(r storage.InviteCodeRecord) *v1.InviteCodeInfo {
+	return &v1.InviteCodeInfo{
+		Code:      r.Code,
+		CreatedTs: r.CreatedTs.Unix(),
+	}
+}
+func (s *RpcServer) proxyToInfo(r *room.Room, p *room.ClientProxy) *v1.StreamInfo {
+	return &v1.StreamInfo{
+		Id:             p.Id,
+		Room:           r.Name.String(),
+		OriginUsername: p.Origin.String(),
+		TargetUsername: p.Target.String(),
+		BytesToTarget:  p.BytesToTarget(),
+		BytesToOrigin:  p.BytesToOrigin(),
+		CreatedTs:      p.CreatedTs.Unix(),
 	}
 }
 
@@ -97,11 +169,14 @@ func (s *RpcServer) getOrGenPass(pass string) (string, bool) {
 	return pass, false
 }
 
-func (s *RpcServer) GetRooms(context.Context, *v1.GetRoomsRequest) (*v1.GetRoomsResponse, error) {
+func (s *RpcServer) GetRooms(_ context.Context, req *v1.GetRoomsRequest) (*v1.GetRoomsResponse, error) {
 	rooms := s.s.RoomManager.GetAll()
-	infos := make([]*v1.RoomInfo, len(rooms))
-	for i, r := range rooms {
-		infos[i] = s.roomToInfo(r)
+	infos := make([]*v1.RoomInfo, 0, len(rooms))
+	for _, r := range rooms {
+		if !req.IncludeUnlisted && !r.Metadata().Listed {
+			continue
+		}
+		infos = append(infos, s.roomToInfo(r))
 	}
 
 	return &v1.GetRoomsResponse{
@@ -130,25 +205,13 @@ func (s *RpcServer) GetOnlineUsers(_ context.Context, req *v1.GetOnlineUsersRequ
 		infos[i] = s.clientToInfo(c)
 	}
 
-	const pageSize = 50
-
-	// Send pages of statuses.
-	sent := 0
-	for sent < len(clients) {
-		end := sent + pageSize
-		if end > len(clients) {
-			end = len(clients)
-		}
-
+	for page := range slices.Chunk(infos, protocol.PageSize(req.PageSize)) {
 		err = stream.Send(&v1.GetOnlineUsersResponse{
-			Users: infos[sent:end],
+			Users: page,
 		})
 		if err != nil {
 			return err
 		}
-
-		// We could have sent less than pageSize, but in that case it would break anyway, so we don't care about being accurate here.
-		sent += pageSize
 	}
 
 	return nil
@@ -174,7 +237,11 @@ func (s *RpcServer) GetAccounts(ctx context.Context, req *v1.GetAccountsRequest)
 		return nil, err
 	}
 
-	records, err := s.s.storage.GetAccountsByRoom(ctx, r.Name)
+	records, next, err := s.s.storage.GetAccountsByRoomPage(ctx, r.Name, req.Cursor, common.RpcPageLimit(req.Limit))
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.s.storage.CountAccountsByRoom(ctx, r.Name)
 	if err != nil {
 		return nil, err
 	}
@@ -185,7 +252,9 @@ func (s *RpcServer) GetAccounts(ctx context.Context, req *v1.GetAccountsRequest)
 	}
 
 	return &v1.GetAccountsResponse{
-		Accounts: infos,
+		Accounts:   infos,
+		NextCursor: next,
+		Total:      uint32(total),
 	}, nil
 }
 func (s *RpcServer) CreateRoom(ctx context.Context, req *v1.CreateRoomRequest) (*v1.CreateRoomResponse, error) {
@@ -194,11 +263,17 @@ func (s *RpcServer) CreateRoom(ctx context.Context, req *v1.CreateRoomRequest) (
 		return nil, errInvalidRoomName
 	}
 
-	r, err := s.s.RoomManager.CreateRoom(ctx, name)
+	r, err := s.s.RoomManager.CreateRoom(ctx, name, room.Metadata{
+		Description: req.Description,
+		Listed:      req.Listed == nil || *req.Listed,
+	})
 	if err != nil {
 		if errors.Is(err, room.ErrRoomExists) {
 			return nil, errRoomExists
 		}
+		if errors.Is(err, room.ErrDescriptionTooLong) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
 
 		return nil, err
 	}
@@ -220,6 +295,132 @@ func (s *RpcServer) DeleteRoom(ctx context.Context, req *v1.DeleteRoomRequest) (
 
 	return &v1.DeleteRoomResponse{}, nil
 }
+func (s *RpcServer) SetRoomLimits(ctx context.Context, req *v1.SetRoomLimitsRequest) (*v1.SetRoomLimitsResponse, error) {
+	r, err := s.getRoom(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.SetLimits(ctx, room.Limits{
+		MaxClients:               int(req.MaxClients),
+		MaxProxyStreamsPerClient: int(req.MaxProxyStreamsPerClient),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.SetRoomLimitsResponse{
+		Room: s.roomToInfo(r),
+	}, nil
+}
+func (s *RpcServer) SetRoomDirCacheTtl(ctx context.Context, req *v1.SetRoomDirCacheTtlRequest) (*v1.SetRoomDirCacheTtlResponse, error) {
+	r, err := s.getRoom(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.SetDirCacheTtl(ctx, time.Duration(req.TtlMs)*time.Millisecond)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.SetRoomDirCacheTtlResponse{
+		Room: s.roomToInfo(r),
+	}, nil
+}
+func (s *RpcServer) SetRoomMetadata(ctx context.Context, req *v1.SetRoomMetadataRequest) (*v1.SetRoomMetadataResponse, error) {
+	r, err := s.getRoom(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.SetMetadata(ctx, room.Metadata{
+		Description: req.Description,
+		Listed:      req.Listed,
+	})
+	if err != nil {
+		if errors.Is(err, room.ErrDescriptionTooLong) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, err
+	}
+
+	return &v1.SetRoomMetadataResponse{
+		Room: s.roomToInfo(r),
+	}, nil
+}
+func (s *RpcServer) CloseRoom(_ context.Context, req *v1.CloseRoomRequest) (*v1.CloseRoomResponse, error) {
+	r, err := s.getRoom(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := r.DisconnectAll()
+	if err != nil {
+		if errors.Is(err, room.ErrRoomClosed) {
+			return nil, errRoomNotFound
+		}
+		return nil, err
+	}
+
+	return &v1.CloseRoomResponse{
+		DisconnectedUserCount: uint32(count),
+	}, nil
+}
+func (s *RpcServer) KickUser(_ context.Context, req *v1.KickUserRequest) (*v1.KickUserResponse, error) {
+	r, err := s.getRoom(req.Room)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := s.getClient(r, req.Username)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = r.KickClientByUsername(client.Username)
+
+	return &v1.KickUserResponse{}, nil
+}
+func (s *RpcServer) BroadcastMessage(_ context.Context, req *v1.BroadcastMessageRequest) (*v1.BroadcastMessageResponse, error) {
+	r, err := s.getRoom(req.Room)
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := r.SendNotice(req.Text)
+	if err != nil {
+		if errors.Is(err, room.ErrNoticeEmpty) || errors.Is(err, room.ErrNoticeTooLong) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		if errors.Is(err, room.ErrRoomClosed) {
+			return nil, errRoomNotFound
+		}
+		return nil, err
+	}
+
+	return &v1.BroadcastMessageResponse{
+		RecipientCount: uint32(count),
+	}, nil
+}
+func (s *RpcServer) SetRoomMotd(ctx context.Context, req *v1.SetRoomMotdRequest) (*v1.SetRoomMotdResponse, error) {
+	r, err := s.getRoom(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.SetMotd(ctx, req.Motd)
+	if err != nil {
+		if errors.Is(err, room.ErrMotdTooLong) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, err
+	}
+
+	return &v1.SetRoomMotdResponse{
+		Room: s.roomToInfo(r),
+	}, nil
+}
 func (s *RpcServer) CreateAccount(ctx context.Context, req *v1.CreateAccountRequest) (*v1.CreateAccountResponse, error) {
 	r, err := s.getRoom(req.Room)
 	if err != nil {
@@ -233,7 +434,7 @@ func (s *RpcServer) CreateAccount(ctx context.Context, req *v1.CreateAccountRequ
 
 	pass, wasGen := s.getOrGenPass(req.Password)
 
-	err = r.CreateAccount(ctx, username, pass)
+	err = r.CreateAccount(ctx, username, pass, req.IsGuest)
 	if err != nil {
 		if errors.Is(err, room.ErrAccountExists) {
 			return nil, errAccountExists
@@ -248,6 +449,7 @@ func (s *RpcServer) CreateAccount(ctx context.Context, req *v1.CreateAccountRequ
 	res := &v1.CreateAccountResponse{
 		Account: &v1.AccountInfo{
 			Username: username.String(),
+			IsGuest:  req.IsGuest,
 		},
 	}
 	if wasGen {
@@ -310,13 +512,569 @@ func (s *RpcServer) UpdateAccountPassword(ctx context.Context, req *v1.UpdateAcc
 		GeneratedPassword: passOrNil,
 	}, nil
 }
+func (s *RpcServer) SetAccountGuest(ctx context.Context, req *v1.SetAccountGuestRequest) (*v1.SetAccountGuestResponse, error) {
+	r, err := s.getRoom(req.Room)
+	if err != nil {
+		return nil, err
+	}
+
+	username, ok := common.NormalizeUsername(req.Username)
+	if !ok {
+		return nil, errAccountNotFound
+	}
+
+	err = r.SetAccountGuest(ctx, username, req.IsGuest)
+	if err != nil {
+		if errors.Is(err, room.ErrNoSuchAccount) {
+			return nil, errAccountNotFound
+		}
+		return nil, err
+	}
+
+	return &v1.SetAccountGuestResponse{}, nil
+}
+
+func (s *RpcServer) CreateInviteCode(ctx context.Context, req *v1.CreateInviteCodeRequest) (*v1.CreateInviteCodeResponse, error) {
+	r, err := s.getRoom(req.Room)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := r.CreateInviteCode(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	return &v1.CreateInviteCodeResponse{
+		InviteCode: s.inviteCodeToInfo(record),
+	}, nil
+}
+func (s *RpcServer) GetInviteCodes(ctx context.Context, req *v1.GetInviteCodesRequest) (*v1.GetInviteCodesResponse, error) {
+	r, err := s.getRoom(req.Room)
+	if err != nil {
+		return nil, err
+	}
+
+	records, err := r.GetInviteCodes(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*v1.InviteCodeInfo, len(records))
+	for i, record := range records {
+		infos[i] = s.inviteCodeToInfo(record)
+	}
+
+	return &v1.GetInviteCodesResponse{
+		InviteCodes: infos,
+	}, nil
+}
+func (s *RpcServer) DeleteInviteCode(ctx context.Context, req *v1.DeleteInviteCodeRequest) (*v1.DeleteInviteCodeResponse, error) {
+	r, err := s.getRoom(req.Room)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.DeleteInviteCode(ctx, req.Code)
+	if err != nil {
+		if errors.Is(err, room.ErrNoSuchInviteCode) {
+			return nil, errInviteCodeNotFound
+		}
+
+		return nil, err
+	}
+
+	return &v1.DeleteInviteCodeResponse{}, nil
+}
+func (s *RpcServer) CreateInviteBundle(ctx context.Context, req *v1.CreateInviteBundleRequest) (*v1.CreateInviteBundleResponse, error) {
+	r, err := s.getRoom(req.Room)
+	if err != nil {
+		return nil, err
+	}
+
+	address := strings.TrimSpace(req.Address)
+	if address == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("address is required"))
+	}
+
+	bundle := protocol.InviteBundle{
+		Address:         address,
+		Room:            r.Name.String(),
+		CertFingerprint: s.certFingerprint,
+	}
+
+	var codeInfo *v1.InviteCodeInfo
+	if req.CreateInviteCode {
+		record, err := r.CreateInviteCode(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		bundle.InviteCode = record.Code
+		codeInfo = s.inviteCodeToInfo(record)
+	}
+
+	return &v1.CreateInviteBundleResponse{
+		Url:        bundle.Url(),
+		InviteCode: codeInfo,
+	}, nil
+}
+func (s *RpcServer) ListStreams(_ context.Context, req *v1.ListStreamsRequest) (*v1.ListStreamsResponse, error) {
+	var rooms []*room.Room
+	if req.Room == "" {
+		rooms = s.s.RoomManager.GetAll()
+	} else {
+		r, err := s.getRoom(req.Room)
+		if err != nil {
+			return nil, err
+		}
+		rooms = []*room.Room{r}
+	}
+
+	infos := make([]*v1.StreamInfo, 0)
+	for _, r := range rooms {
+		for _, p := range r.GetProxies() {
+			infos = append(infos, s.proxyToInfo(r, p))
+		}
+	}
+
+	return &v1.ListStreamsResponse{
+		Streams: infos,
+	}, nil
+}
+func (s *RpcServer) CancelStream(_ context.Context, req *v1.CancelStreamRequest) (*v1.CancelStreamResponse, error) {
+	r, err := s.getRoom(req.Room)
+	if err != nil {
+		return nil, err
+	}
+
+	err = r.CancelProxy(req.Id)
+	if err != nil {
+		if errors.Is(err, room.ErrNoSuchProxy) {
+			return nil, errStreamNotFound
+		}
+
+		// The proxy is already closed at this point; failures are from closing its streams.
+		s.s.logger.Warn("error while canceling proxied stream",
+			"service", "server.RpcServer",
+			"room", r.Name.String(),
+			"id", req.Id,
+			"err", err,
+		)
+	}
+
+	return &v1.CancelStreamResponse{}, nil
+}
+
+func (s *RpcServer) GetMigrationStatus(ctx context.Context, _ *v1.GetMigrationStatusRequest) (*v1.GetMigrationStatusResponse, error) {
+	statuses, err := s.s.storage.GetMigrationStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]*v1.MigrationInfo, len(statuses))
+	for i, status := range statuses {
+		info := &v1.MigrationInfo{
+			Name:    status.Name,
+			Applied: status.Applied,
+			Unknown: status.Unknown,
+		}
+		if status.Applied {
+			info.AppliedTs = status.AppliedTs.Unix()
+		}
+		infos[i] = info
+	}
+
+	return &v1.GetMigrationStatusResponse{
+		Migrations: infos,
+	}, nil
+}
+
+func (s *RpcServer) BackupDatabase(ctx context.Context, req *v1.BackupDatabaseRequest) (*v1.BackupDatabaseResponse, error) {
+	if req.Path == "" {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("path is required"))
+	}
+
+	if err := s.s.storage.Backup(ctx, req.Path); err != nil {
+		if errors.Is(err, storage.ErrNotSupported) {
+			return nil, connect.NewError(connect.CodeUnimplemented, err)
+		}
+		if errors.Is(err, os.ErrExist) {
+			return nil, connect.NewError(connect.CodeAlreadyExists, err)
+		}
+		return nil, err
+	}
+
+	return &v1.BackupDatabaseResponse{}, nil
+}
+
+func (s *RpcServer) CheckDatabaseIntegrity(ctx context.Context, _ *v1.CheckDatabaseIntegrityRequest) (*v1.CheckDatabaseIntegrityResponse, error) {
+	problems, err := s.s.storage.CheckIntegrity(ctx)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotSupported) {
+			return nil, connect.NewError(connect.CodeUnimplemented, err)
+		}
+		return nil, err
+	}
+
+	return &v1.CheckDatabaseIntegrityResponse{
+		Problems: problems,
+	}, nil
+}
+
+func (s *RpcServer) GetRelayLimits(_ context.Context, _ *v1.GetRelayLimitsRequest) (*v1.GetRelayLimitsResponse, error) {
+	maxBytesPerSecond, schedule, current := s.s.RoomManager.Relay().Limits()
+
+	windows := make([]*v1.RelayLimitWindow, len(schedule))
+	for i, w := range schedule {
+		windows[i] = &v1.RelayLimitWindow{
+			Weekdays:          uint32(w.Weekdays),
+			StartMinute:       uint32(w.StartMinute),
+			EndMinute:         uint32(w.EndMinute),
+			MaxBytesPerSecond: uint64(w.MaxBytesPerSecond),
+		}
+	}
+
+	return &v1.GetRelayLimitsResponse{
+		MaxBytesPerSecond:        uint64(maxBytesPerSecond),
+		Schedule:                 windows,
+		CurrentMaxBytesPerSecond: uint64(current),
+	}, nil
+}
+
+func (s *RpcServer) SetRelayLimits(_ context.Context, req *v1.SetRelayLimitsRequest) (*v1.SetRelayLimitsResponse, error) {
+	schedule := make([]room.RelayLimitWindow, len(req.Schedule))
+	for i, w := range req.Schedule {
+		if w.Weekdays > common.AllWeekdays {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf(`%w: weekday mask %d has bits past Saturday set`, common.ErrInvalidTimeWindow, w.Weekdays))
+		}
+		schedule[i] = room.RelayLimitWindow{
+			TimeWindow: common.TimeWindow{
+				Weekdays:    uint8(w.Weekdays),
+				StartMinute: int(min(w.StartMinute, common.MinutesPerDay)),
+				EndMinute:   int(min(w.EndMinute, common.MinutesPerDay)),
+			},
+			MaxBytesPerSecond: int64(min(w.MaxBytesPerSecond, math.MaxInt64)),
+		}
+		if err := schedule[i].Validate(); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+	}
+
+	s.s.RoomManager.Relay().SetLimits(int64(min(req.MaxBytesPerSecond, math.MaxInt64)), schedule)
+
+	return &v1.SetRelayLimitsResponse{}, nil
+}
+
+// lobbySettingsToPb converts lobby settings to their protobuf representation.
+func lobbySettingsToPb(settings lobby.Settings) *v1.LobbySettings {
+	return &v1.LobbySettings{
+		TimeoutSeconds:     uint32(settings.Timeout / time.Second),
+		MinProtocolVersion: protocol.FormatProtoVersion(settings.MinVersion),
+		MaxProtocolVersion: protocol.FormatProtoVersion(settings.MaxVersion),
+		MaxConcurrent:      uint32(settings.MaxConcurrent),
+
+		MaxConnsPerIpPerMinute: uint32(settings.MaxConnsPerIpPerMinute),
+	}
+}
+
+func (s *RpcServer) GetLobbySettings(_ context.Context, _ *v1.GetLobbySettingsRequest) (*v1.GetLobbySettingsResponse, error) {
+	return &v1.GetLobbySettingsResponse{
+		Settings: lobbySettingsToPb(s.s.Lobby().Settings()),
+	}, nil
+}
+
+func (s *RpcServer) UpdateLobbySettings(_ context.Context, req *v1.UpdateLobbySettingsRequest) (*v1.UpdateLobbySettingsResponse, error) {
+	settings := s.s.Lobby().Settings()
+
+	if req.TimeoutSeconds != nil {
+		settings.Timeout = time.Duration(*req.TimeoutSeconds) * time.Second
+	}
+	if req.MinProtocolVersion != nil {
+		ver, err := protocol.ParseProtoVersion(*req.MinProtocolVersion)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		settings.MinVersion = ver
+	}
+	if req.MaxProtocolVersion != nil {
+		ver, err := protocol.ParseProtoVersion(*req.MaxProtocolVersion)
+		if err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		settings.MaxVersion = ver
+	}
+	if req.MaxConcurrent != nil {
+		settings.MaxConcurrent = int(*req.MaxConcurrent)
+	}
+	if req.MaxConnsPerIpPerMinute != nil {
+		settings.MaxConnsPerIpPerMinute = int(*req.MaxConnsPerIpPerMinute)
+	}
+
+	if err := s.s.Lobby().SetSettings(settings); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	s.s.logger.Info("updated lobby settings",
+		"service", "server.RpcServer",
+		"timeout", settings.Timeout,
+		"min_version", protocol.FormatProtoVersion(settings.MinVersion),
+		"max_version", protocol.FormatProtoVersion(settings.MaxVersion),
+		"max_concurrent", settings.MaxConcurrent,
+		"max_conns_per_ip_per_minute", settings.MaxConnsPerIpPerMinute,
+	)
+
+	return &v1.UpdateLobbySettingsResponse{
+		Settings: lobbySettingsToPb(settings),
+	}, nil
+}
+
+func (s *RpcServer) GetLobbyStats(_ context.Context, _ *v1.GetLobbyStatsRequest) (*v1.GetLobbyStatsResponse, error) {
+	stats := s.s.Lobby().Stats()
+
+	return &v1.GetLobbyStatsResponse{
+		Accepted:            stats.Accepted,
+		RejectedDraining:    stats.RejectedDraining,
+		RejectedRateLimited: stats.RejectedRateLimited,
+		RejectedBusy:        stats.RejectedBusy,
+		Onboarding:          uint32(max(stats.Onboarding, 0)),
+	}, nil
+}
+
+func (s *RpcServer) GetRoomStats(ctx context.Context, req *v1.GetRoomStatsRequest) (*v1.GetRoomStatsResponse, error) {
+	r, err := s.getRoom(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	from := time.Unix(req.FromTs, 0)
+	to := time.Now()
+	if req.ToTs != 0 {
+		to = time.Unix(req.ToTs, 0)
+	}
+	if to.Before(from) {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("to_ts cannot be before from_ts"))
+	}
+
+	records, err := s.s.storage.GetRoomStats(ctx, r.Name, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	stats := make([]*v1.RoomStat, 0, len(records))
+	for _, record := range records {
+		stats = append(stats, &v1.RoomStat{
+			Ts:            record.Ts.Unix(),
+			OnlineClients: uint32(record.OnlineClients),
+			RelayedBytes:  record.RelayedBytes,
+		})
+	}
+
+	return &v1.GetRoomStatsResponse{
+		Stats: stats,
+	}, nil
+}
+
+// featureAdminUi is the feature name reported by GetServerInfo when the RPC interface serves the admin UI.
+const featureAdminUi = "admin_ui"
 
 func (s *RpcServer) GetServerInfo(_ context.Context, _ *v1.GetServerInfoRequest) (*v1.GetServerInfoResponse, error) {
+	features := s.s.Features()
+	if s.iface.EnableAdminUi {
+		features = append(features, featureAdminUi)
+	}
+
+	// The role was checked when the interface was created.
+	allowedMethods, _ := config.RpcRoles.AllowedMethods(s.iface)
+
+	startTs := s.s.StartTs()
 	return &v1.GetServerInfoResponse{
 		Version: updater.CurrentUpdate.Version,
 		Rpc: &v1.GetServerInfoResponse_Rpc{
-			AllowedMethods:      s.iface.AllowedMethods,
+			AllowedMethods:      allowedMethods,
 			RequiresBearerToken: s.iface.BearerToken != "",
+			Role:                s.iface.Role,
 		},
+		Draining:        s.s.RoomManager.Drain().IsDraining(),
+		ProtocolVersion: protocol.FormatProtoVersion(protocol.CurrentProtocolVersion),
+		BuildCommit:     common.BuildCommit(),
+		StartTs:         startTs.Unix(),
+		UptimeSeconds:   int64(time.Since(startTs).Seconds()),
+		Features:        features,
+	}, nil
+}
+
+// drainReportInterval is how often Drain reports progress.
+const drainReportInterval = 1 * time.Second
+
+func (s *RpcServer) Drain(ctx context.Context, _ *v1.DrainRequest, stream *connect.ServerStream[v1.DrainResponse]) error {
+	if s.s.RoomManager.Drain().Start() {
+		s.s.logger.Info("draining server, new connections and proxied streams will be refused",
+			"service", "server.RpcServer",
+		)
+	}
+
+	ticker := time.NewTicker(drainReportInterval)
+	defer ticker.Stop()
+
+	for {
+		var streams, clients int
+		for _, r := range s.s.RoomManager.GetAll() {
+			streams += r.ActiveProxyCount()
+			clients += len(r.GetAllClients())
+		}
+
+		err := stream.Send(&v1.DrainResponse{
+			ActiveStreams: uint32(streams),
+			OnlineClients: uint32(clients),
+		})
+		if err != nil {
+			return err
+		}
+		if streams == 0 {
+			return nil
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *RpcServer) ValidateConfig(_ context.Context, req *v1.ValidateConfigRequest) (*v1.ValidateConfigResponse, error) {
+	data := []byte(req.ConfigJson)
+	if req.ConfigJson == "" {
+		if s.configPath == "" {
+			return nil, connect.NewError(connect.CodeFailedPrecondition, errors.New("server was not started with a config file"))
+		}
+
+		var err error
+		data, err = os.ReadFile(s.configPath)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to read config file %q: %w`, s.configPath, err)
+		}
+	}
+
+	_, problems := config.ValidateConfig(data)
+
+	res := make([]*v1.ConfigProblem, len(problems))
+	for i, problem := range problems {
+		res[i] = &v1.ConfigProblem{
+			Field:   problem.Field,
+			Message: problem.Message,
+			Warning: problem.Warning,
+		}
+	}
+
+	return &v1.ValidateConfigResponse{
+		Problems: res,
+	}, nil
+}
+
+// RoomArchiveVersion is the version of the room archive format made by ExportRoom.
+// ImportRoom only accepts archives of this version.
+const RoomArchiveVersion = 1
+
+func (s *RpcServer) ExportRoom(ctx context.Context, req *v1.ExportRoomRequest) (*v1.ExportRoomResponse, error) {
+	r, err := s.getRoom(req.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	archive, err := r.Export(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accounts := make([]*v1.RoomArchive_Account, len(archive.Accounts))
+	for i, account := range archive.Accounts {
+		accounts[i] = &v1.RoomArchive_Account{
+			Username:     account.Username.String(),
+			PasswordHash: account.PasswordHash,
+			IsGuest:      account.IsGuest,
+		}
+	}
+
+	return &v1.ExportRoomResponse{
+		Archive: &v1.RoomArchive{
+			Version:                  RoomArchiveVersion,
+			Name:                     r.Name.String(),
+			Description:              archive.Metadata.Description,
+			Listed:                   archive.Metadata.Listed,
+			Motd:                     archive.Motd,
+			MaxClients:               uint32(archive.Limits.MaxClients),
+			MaxProxyStreamsPerClient: uint32(archive.Limits.MaxProxyStreamsPerClient),
+			DirCacheTtlMs:            uint32(archive.DirCacheTtl.Milliseconds()),
+			Accounts:                 accounts,
+			InviteCodes:              archive.InviteCodes,
+		},
+	}, nil
+}
+
+func (s *RpcServer) ImportRoom(ctx context.Context, req *v1.ImportRoomRequest) (*v1.ImportRoomResponse, error) {
+	pbArchive := req.GetArchive()
+	if pbArchive == nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, errors.New("archive is required"))
+	}
+	if pbArchive.Version != RoomArchiveVersion {
+		return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf(`unsupported room archive version %d, expected %d`, pbArchive.Version, RoomArchiveVersion))
+	}
+
+	nameStr := pbArchive.Name
+	if req.Name != nil {
+		nameStr = *req.Name
+	}
+	name, ok := common.NormalizeRoomName(nameStr)
+	if !ok {
+		return nil, errInvalidRoomName
+	}
+
+	accounts := make([]room.ArchivedAccount, len(pbArchive.Accounts))
+	for i, account := range pbArchive.Accounts {
+		username, ok := common.NormalizeUsername(account.Username)
+		if !ok {
+			return nil, connect.NewError(connect.CodeInvalidArgument, fmt.Errorf(`invalid username %q in archive`, account.Username))
+		}
+		accounts[i] = room.ArchivedAccount{
+			Username:     username,
+			PasswordHash: account.PasswordHash,
+			IsGuest:      account.IsGuest,
+		}
+	}
+
+	r, err := s.s.RoomManager.ImportRoom(ctx, name, room.Archive{
+		Metadata: room.Metadata{
+			Description: pbArchive.Description,
+			Listed:      pbArchive.Listed,
+		},
+		Motd: pbArchive.Motd,
+		Limits: room.Limits{
+			MaxClients:               int(pbArchive.MaxClients),
+			MaxProxyStreamsPerClient: int(pbArchive.MaxProxyStreamsPerClient),
+		},
+		DirCacheTtl: time.Duration(pbArchive.DirCacheTtlMs) * time.Millisecond,
+		Accounts:    accounts,
+		InviteCodes: pbArchive.InviteCodes,
+	})
+	if err != nil {
+		if errors.Is(err, room.ErrRoomExists) {
+			return nil, errRoomExists
+		}
+		if errors.Is(err, room.ErrInvalidArchive) {
+			return nil, connect.NewError(connect.CodeInvalidArgument, err)
+		}
+		return nil, err
+	}
+
+	s.s.logger.Info("imported room",
+		"service", "server.RpcServer",
+		"room", name.String(),
+		"accounts", len(accounts),
+	)
+
+	return &v1.ImportRoomResponse{
+		Room: s.roomToInfo(r),
 	}, nil
 }
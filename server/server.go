@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"log/slog"
 	"sync"
+	"time"
 
 	"friendnet.org/common/machine"
 	"friendnet.org/common/password"
@@ -14,6 +15,7 @@ import (
 	"friendnet.org/server/lobby"
 	"friendnet.org/server/room"
 	"friendnet.org/server/storage"
+	"friendnet.org/updater"
 )
 
 // Server is a FriendNet server.
@@ -29,9 +31,13 @@ type Server struct {
 	ctx       context.Context
 	ctxCancel context.CancelFunc
 
-	logger  *slog.Logger
-	storage *storage.Storage
-	lobby   *lobby.Lobby
+	logger     *slog.Logger
+	storage    storage.Storage
+	lobby      *lobby.Lobby
+	connLimits protocol.ConnLimits
+
+	// When the server was created.
+	startTs time.Time
 
 	// The server's room.Manager instance.
 	// Do not update or close it.
@@ -41,16 +47,29 @@ type Server struct {
 // NewServer creates a new FriendNet server.
 // It uses the specified storage instance.
 // It does not start listening until Listen is called.
+// If authLimiterCfg is nil, authentication attempts will not be rate-limited.
+// The registration config determines whether clients can register their own accounts.
+// The lobby settings can be changed later with the lobby returned by Server.Lobby.
+// The connection limits apply to every client connection accepted by Listen.
+// The relay config determines how bandwidth for proxied streams is shared between clients.
 // Note that Server.Close does not close the storage instance.
 func NewServer(
 	logger *slog.Logger,
-	storage *storage.Storage,
+	storage storage.Storage,
 	connMethodSupport machine.ConnMethodSupport,
 	passReqs password.Requirements,
+	authLimiterCfg *lobby.AuthLimiterConfig,
+	registration lobby.RegistrationConfig,
+	lobbySettings lobby.Settings,
+	connLimits protocol.ConnLimits,
+	relayCfg room.RelayConfig,
 ) (*Server, error) {
 	if storage == nil {
 		panic("storage cannot be nil")
 	}
+	if err := lobbySettings.Validate(); err != nil {
+		return nil, err
+	}
 
 	ctx, ctxCancel := context.WithCancel(context.Background())
 
@@ -61,27 +80,42 @@ func NewServer(
 		connMethodSupport,
 		passReqs,
 		room.NewLogicImpl(logger),
+		connLimits.MaxConcurrentRequests,
+		room.NewRelayScheduler(ctx, relayCfg),
 	)
 	if err != nil {
 		ctxCancel()
 		return nil, err
 	}
 
+	var authLimiter *lobby.AuthLimiter
+	if authLimiterCfg != nil {
+		authLimiter = lobby.NewAuthLimiter(ctx, logger, storage, *authLimiterCfg)
+	}
+
 	l := lobby.NewLobby(
 		logger,
 		storage,
 		roomMgr,
-		lobby.DefaultTimeout,
-		protocol.CurrentProtocolVersion,
+		authLimiter,
+		registration,
+		lobbySettings,
 	)
 
+	roomMgr.ServerInfo().Set(room.SoftwareInfo{
+		Version:  updater.CurrentUpdate.Version,
+		Features: features(l),
+	})
+
 	s := &Server{
 		ctx:       ctx,
 		ctxCancel: ctxCancel,
 
-		logger:  logger,
-		storage: storage,
-		lobby:   l,
+		logger:     logger,
+		storage:    storage,
+		lobby:      l,
+		connLimits: connLimits,
+		startTs:    time.Now(),
 
 		RoomManager: roomMgr,
 	}
@@ -89,6 +123,44 @@ func NewServer(
 	return s, nil
 }
 
+// StartTs returns when the server started.
+func (s *Server) StartTs() time.Time {
+	return s.startTs
+}
+
+// Feature names reported by Server.Features.
+const (
+	featureRegistration  = "registration"
+	featureInviteCodes   = "invite_codes"
+	featureAuthRateLimit = "auth_rate_limit"
+)
+
+// features returns the names of the optional features enabled in a lobby.
+func features(l *lobby.Lobby) []string {
+	var features []string
+	if registration := l.Registration(); registration.Enabled {
+		features = append(features, featureRegistration)
+		if registration.RequireInviteCode {
+			features = append(features, featureInviteCodes)
+		}
+	}
+	if l.IsAuthRateLimited() {
+		features = append(features, featureAuthRateLimit)
+	}
+	return features
+}
+
+// Features returns the names of the optional features enabled on the server.
+// They are also reported to clients that ask for server info.
+func (s *Server) Features() []string {
+	return features(s.lobby)
+}
+
+// Lobby returns the server's lobby, where new connections are authenticated.
+func (s *Server) Lobby() *lobby.Lobby {
+	return s.lobby
+}
+
 // Close closes the server.
 // Subsequent calls are no-op.
 func (s *Server) Close() error {
@@ -136,7 +208,26 @@ func (s *Server) ListenWith(listener protocol.ProtoListener) error {
 // This function can be called concurrently with other listeners to listen on multiple interfaces.
 // Returns nil when Server.Close is called.
 func (s *Server) Listen(address string, tlsCfg *tls.Config) error {
-	listener, err := protocol.NewQuicProtoListener(address, tlsCfg)
+	return s.listen(address, false, tlsCfg)
+}
+
+// ListenReusePort is like Listen, but binds with SO_REUSEPORT so that another server process can listen on the same
+// address at the same time, such as a newer version taking over while this one drains.
+// See protocol.ListenUDP for how connections are handed off.
+func (s *Server) ListenReusePort(address string, tlsCfg *tls.Config) error {
+	return s.listen(address, true, tlsCfg)
+}
+
+func (s *Server) listen(address string, reusePort bool, tlsCfg *tls.Config) error {
+	// Clients that connected before may reconnect with 0-RTT.
+	// The lobby only acts on replay-safe messages until the handshake completes.
+	var listener protocol.ProtoListener
+	var err error
+	if reusePort {
+		listener, err = protocol.NewQuicEarlyProtoListenerReusePort(address, tlsCfg, s.connLimits)
+	} else {
+		listener, err = protocol.NewQuicEarlyProtoListener(address, tlsCfg, s.connLimits)
+	}
 	if err != nil {
 		return fmt.Errorf("failed to create listener: %w", err)
 	}
@@ -0,0 +1,143 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"friendnet.org/common"
+)
+
+// ScheduledBackupConfig is the configuration for RunScheduledBackups.
+type ScheduledBackupConfig struct {
+	// The directory to write backups to.
+	// It is created if it does not exist.
+	Dir string
+
+	// How often to back up the database.
+	Interval time.Duration
+
+	// The number of most recent backups to keep.
+	// Older backups in Dir are deleted after each new backup.
+	// Specify 0 to keep all backups.
+	Keep int
+}
+
+// backupPrefix and backupSuffix surround the time in the names of scheduled backups.
+const backupPrefix = "server-"
+const backupSuffix = ".db"
+
+// RunScheduledBackups periodically backs up the storage's database to files in a directory, deleting the oldest
+// backups beyond the configured number to keep.
+// If the most recent backup in the directory is older than the interval, a backup is made immediately.
+// It blocks until ctx is done.
+func RunScheduledBackups(ctx context.Context, logger *slog.Logger, st Storage, cfg ScheduledBackupConfig) {
+	if cfg.Interval <= 0 {
+		panic("scheduled backup interval must be positive")
+	}
+
+	wait := time.Duration(0)
+	if backups, err := listScheduledBackups(cfg.Dir); err != nil {
+		logger.Warn("failed to list existing backups",
+			"service", "storage.ScheduledBackups",
+			"dir", cfg.Dir,
+			"err", err,
+		)
+	} else if len(backups) > 0 {
+		wait = max(0, time.Until(backups[len(backups)-1].Add(cfg.Interval)))
+	}
+
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-timer.C:
+		}
+
+		path, err := doScheduledBackup(ctx, st, cfg)
+		if err != nil {
+			logger.Error("failed to back up database",
+				"service", "storage.ScheduledBackups",
+				"err", err,
+			)
+		} else {
+			logger.Info("backed up database",
+				"service", "storage.ScheduledBackups",
+				"path", path,
+			)
+		}
+
+		timer.Reset(cfg.Interval)
+	}
+}
+
+// doScheduledBackup writes a new backup to the backup directory and deletes old ones.
+// Returns the path of the new backup.
+func doScheduledBackup(ctx context.Context, st Storage, cfg ScheduledBackupConfig) (string, error) {
+	path := filepath.Join(cfg.Dir, backupPrefix+time.Now().Format(common.SqliteBackupTimeFormat)+backupSuffix)
+	if err := st.Backup(ctx, path); err != nil {
+		return "", err
+	}
+
+	if cfg.Keep <= 0 {
+		return path, nil
+	}
+
+	backups, err := listScheduledBackups(cfg.Dir)
+	if err != nil {
+		return path, fmt.Errorf(`backed up database to %q, but failed to list old backups: %w`, path, err)
+	}
+	for len(backups) > cfg.Keep {
+		old := filepath.Join(cfg.Dir, backupPrefix+backups[0].Format(common.SqliteBackupTimeFormat)+backupSuffix)
+		if err = os.Remove(old); err != nil {
+			return path, fmt.Errorf(`backed up database to %q, but failed to delete old backup: %w`, path, err)
+		}
+		if err = os.RemoveAll(old + RoomBackupDirSuffix); err != nil {
+			return path, fmt.Errorf(`backed up database to %q, but failed to delete old room backups: %w`, path, err)
+		}
+		backups = backups[1:]
+	}
+
+	return path, nil
+}
+
+// listScheduledBackups returns the times of the scheduled backups in the directory, oldest first.
+// Files that are not scheduled backups are ignored.
+func listScheduledBackups(dir string) ([]time.Time, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	var res []time.Time
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasPrefix(name, backupPrefix) || !strings.HasSuffix(name, backupSuffix) {
+			continue
+		}
+
+		ts, err := time.ParseInLocation(
+			common.SqliteBackupTimeFormat,
+			strings.TrimSuffix(strings.TrimPrefix(name, backupPrefix), backupSuffix),
+			time.Local,
+		)
+		if err != nil {
+			continue
+		}
+		res = append(res, ts)
+	}
+
+	slices.SortFunc(res, time.Time.Compare)
+	return res, nil
+}
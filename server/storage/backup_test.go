@@ -0,0 +1,62 @@
+package storage
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+)
+
+func TestScheduledBackupRotation(t *testing.T) {
+	dir := t.TempDir()
+	backupDir := filepath.Join(dir, "backups")
+
+	st, err := NewSqliteStorage(slog.New(slog.DiscardHandler), filepath.Join(dir, "test.db"))
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	defer func() {
+		_ = st.Close()
+	}()
+
+	// Create some older backups, and a file that is not a backup.
+	if err = os.MkdirAll(backupDir, 0o700); err != nil {
+		t.Fatal(err)
+	}
+	for i := 3; i > 0; i-- {
+		name := backupPrefix + time.Now().Add(-time.Duration(i)*time.Hour).Format(common.SqliteBackupTimeFormat) + backupSuffix
+		if err = os.WriteFile(filepath.Join(backupDir, name), nil, 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = os.WriteFile(filepath.Join(backupDir, "notes.txt"), nil, 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	path, err := doScheduledBackup(context.Background(), st, ScheduledBackupConfig{
+		Dir:      backupDir,
+		Interval: time.Hour,
+		Keep:     2,
+	})
+	if err != nil {
+		t.Fatalf("failed to back up: %v", err)
+	}
+	if info, err := os.Stat(path); err != nil || info.Size() == 0 {
+		t.Fatalf("backup %q is missing or empty: %v", path, err)
+	}
+
+	backups, err := listScheduledBackups(backupDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 2 {
+		t.Fatalf("expected 2 backups after rotation, got %d", len(backups))
+	}
+	if _, err = os.Stat(filepath.Join(backupDir, "notes.txt")); err != nil {
+		t.Fatalf("unrelated file was deleted: %v", err)
+	}
+}
@@ -0,0 +1,36 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddAccountIsGuest struct {
+}
+
+var _ common.Migration = (*M20261016AddAccountIsGuest)(nil)
+
+func (m *M20261016AddAccountIsGuest) Name() string {
+	return "20261016_add_account_is_guest"
+}
+
+func (m *M20261016AddAccountIsGuest) Apply(tx *sql.Tx) error {
+	const q = `
+alter table account
+    add is_guest integer default 0 not null;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddAccountIsGuest) Revert(tx *sql.Tx) error {
+	const q = `
+alter table account
+    drop column is_guest;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
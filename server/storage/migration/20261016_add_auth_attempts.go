@@ -0,0 +1,45 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddAuthAttempts struct {
+}
+
+var _ common.Migration = (*M20261016AddAuthAttempts)(nil)
+
+func (m *M20261016AddAuthAttempts) Name() string {
+	return "20261016_add_auth_attempts"
+}
+
+func (m *M20261016AddAuthAttempts) Apply(tx *sql.Tx) error {
+	const q = `
+create table auth_attempt
+(
+    kind text not null,
+    subject text not null,
+    failures integer not null default 0,
+    window_start_ts integer default (strftime('%s', 'now')) not null,
+    locked_until_ts integer default 0 not null,
+    primary key (kind, subject)
+);
+
+create index auth_attempt_window_start_ts_index
+    on auth_attempt (window_start_ts);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddAuthAttempts) Revert(tx *sql.Tx) error {
+	const q = `
+drop table auth_attempt;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
@@ -0,0 +1,47 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddInviteCodes struct {
+}
+
+var _ common.Migration = (*M20261016AddInviteCodes)(nil)
+
+func (m *M20261016AddInviteCodes) Name() string {
+	return "20261016_add_invite_codes"
+}
+
+func (m *M20261016AddInviteCodes) Apply(tx *sql.Tx) error {
+	const q = `
+create table invite_code
+(
+    code text not null
+        constraint invite_code_pk
+            primary key,
+    room text not null
+		constraint invite_code_room_room_name_fk
+        references room
+		on delete cascade,
+    created_ts integer default (strftime('%s', 'now')) not null
+);
+
+create index invite_code_room_index
+    on invite_code (room);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddInviteCodes) Revert(tx *sql.Tx) error {
+	const q = `
+drop table invite_code;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
@@ -0,0 +1,36 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddRoomDirCacheTtl struct {
+}
+
+var _ common.Migration = (*M20261016AddRoomDirCacheTtl)(nil)
+
+func (m *M20261016AddRoomDirCacheTtl) Name() string {
+	return "20261016_add_room_dir_cache_ttl"
+}
+
+func (m *M20261016AddRoomDirCacheTtl) Apply(tx *sql.Tx) error {
+	const q = `
+alter table room
+    add dir_cache_ttl_ms integer default 0 not null;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddRoomDirCacheTtl) Revert(tx *sql.Tx) error {
+	const q = `
+alter table room
+    drop column dir_cache_ttl_ms;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
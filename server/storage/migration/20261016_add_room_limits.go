@@ -0,0 +1,42 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddRoomLimits struct {
+}
+
+var _ common.Migration = (*M20261016AddRoomLimits)(nil)
+
+func (m *M20261016AddRoomLimits) Name() string {
+	return "20261016_add_room_limits"
+}
+
+func (m *M20261016AddRoomLimits) Apply(tx *sql.Tx) error {
+	const q = `
+alter table room
+    add max_clients integer default 0 not null;
+
+alter table room
+    add max_proxy_streams_per_client integer default 0 not null;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddRoomLimits) Revert(tx *sql.Tx) error {
+	const q = `
+alter table room
+    drop column max_proxy_streams_per_client;
+
+alter table room
+    drop column max_clients;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
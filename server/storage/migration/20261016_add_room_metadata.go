@@ -0,0 +1,42 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddRoomMetadata struct {
+}
+
+var _ common.Migration = (*M20261016AddRoomMetadata)(nil)
+
+func (m *M20261016AddRoomMetadata) Name() string {
+	return "20261016_add_room_metadata"
+}
+
+func (m *M20261016AddRoomMetadata) Apply(tx *sql.Tx) error {
+	const q = `
+alter table room
+    add description text default '' not null;
+
+alter table room
+    add listed integer default 1 not null;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddRoomMetadata) Revert(tx *sql.Tx) error {
+	const q = `
+alter table room
+    drop column listed;
+
+alter table room
+    drop column description;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
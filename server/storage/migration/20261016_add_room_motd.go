@@ -0,0 +1,36 @@
+package migration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddRoomMotd struct {
+}
+
+var _ common.Migration = (*M20261016AddRoomMotd)(nil)
+
+func (m *M20261016AddRoomMotd) Name() string {
+	return "20261016_add_room_motd"
+}
+
+func (m *M20261016AddRoomMotd) Apply(tx *sql.Tx) error {
+	const q = `
+alter table room
+    add motd text default '' not null;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddRoomMotd) Revert(tx *sql.Tx) error {
+	const q = `
+alter table room
+    drop column motd;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
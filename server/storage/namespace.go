@@ -0,0 +1,638 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"friendnet.org/common"
+)
+
+// With a room directory, the accounts, invite codes and usage snapshots of each room are kept in a separate SQLite
+// database in the directory, named after the room, so that a single room's data can be archived or moved on its own.
+// Room records and authentication attempts stay in the main database, which is the source of truth for which rooms
+// exist. Each room database has a copy of its room record only so that its foreign keys hold.
+//
+// Room databases are opened the first time they are used, and stay open until they are closed with CloseRoom, which
+// room.RunRoomStorageCloser does for rooms that nobody is online in. When a room database is created, any data the
+// room already has in the main database is moved to it.
+
+// roomDbSuffix is the file extension of room databases.
+const roomDbSuffix = ".db"
+
+// RoomBackupDirSuffix is appended to the path of a backup to get the directory that the room databases are backed up
+// to, when the storage has a room directory.
+const RoomBackupDirSuffix = ".rooms"
+
+// RoomCloser is implemented by Storage implementations that keep the data of each room in a separate database.
+type RoomCloser interface {
+	// OpenRooms returns the names of the rooms whose databases are open.
+	OpenRooms() []common.NormalizedRoomName
+
+	// CloseRoom closes the database of the room with the specified name, if it is open and not in use.
+	// It is opened again the next time it is used.
+	CloseRoom(room common.NormalizedRoomName) error
+}
+
+// openRoomDb is an open room database.
+type openRoomDb struct {
+	db *sqlStorage
+
+	// The number of calls using the database.
+	// It is only closed while this is 0.
+	users int
+}
+
+// namespacedStorage implements Storage with a main SQLite database and a separate SQLite database for each room.
+// Everything that is not scoped to a room goes to the main database through the embedded sqlStorage.
+type namespacedStorage struct {
+	*sqlStorage
+
+	logger *slog.Logger
+	dir    string
+
+	mu       sync.Mutex
+	isClosed bool
+
+	// Key is the string value of a common.NormalizedRoomName.
+	rooms map[string]*openRoomDb
+}
+
+var _ Storage = (*namespacedStorage)(nil)
+var _ RoomCloser = (*namespacedStorage)(nil)
+
+// NewNamespacedSqliteStorage creates a new SQLite storage instance using the specified main DB path, which keeps each
+// room's accounts, invite codes and usage snapshots in a separate database in roomDir.
+// The room directory is created if it does not exist.
+// See NewSqliteStorage for how the main database is opened.
+func NewNamespacedSqliteStorage(logger *slog.Logger, path string, roomDir string) (Storage, error) {
+	if roomDir == "" {
+		panic("room directory is required for namespaced storage")
+	}
+
+	dir, err := filepath.Abs(roomDir)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to resolve room storage directory: %w`, err)
+	}
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf(`failed to create room storage directory %q: %w`, dir, err)
+	}
+
+	main, err := newSqliteStorage(logger, path, true)
+	if err != nil {
+		return nil, err
+	}
+
+	return &namespacedStorage{
+		sqlStorage: main,
+
+		logger: logger,
+		dir:    dir,
+
+		rooms: make(map[string]*openRoomDb),
+	}, nil
+}
+
+// roomPath returns the path of the database of the room with the specified name.
+func (s *namespacedStorage) roomPath(room common.NormalizedRoomName) string {
+	return filepath.Join(s.dir, room.String()+roomDbSuffix)
+}
+
+// acquire returns the database of the room with the specified name, opening it if necessary.
+// Returns false if the room does not exist, in which case no database is opened.
+// If a database is returned, the returned function must be called when done with it.
+func (s *namespacedStorage) acquire(ctx context.Context, room common.NormalizedRoomName) (*sqlStorage, func(), bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.isClosed {
+		return nil, nil, false, errors.New("storage is closed")
+	}
+
+	entry, has := s.rooms[room.String()]
+	if !has {
+		record, has, err := s.sqlStorage.GetRoomByName(ctx, room)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		if !has {
+			return nil, nil, false, nil
+		}
+
+		db, err := s.openRoom(ctx, record)
+		if err != nil {
+			return nil, nil, false, err
+		}
+		entry = &openRoomDb{db: db}
+		s.rooms[room.String()] = entry
+	}
+
+	entry.users++
+	release := func() {
+		s.mu.Lock()
+		entry.users--
+		s.mu.Unlock()
+	}
+	return entry.db, release, true, nil
+}
+
+// openRoom opens the database of the room with the specified record, creating it if it does not exist.
+// A new database gets the room's data from the main database.
+func (s *namespacedStorage) openRoom(ctx context.Context, record RoomRecord) (*sqlStorage, error) {
+	path := s.roomPath(record.Name)
+	_, statErr := os.Stat(path)
+	isNew := os.IsNotExist(statErr)
+
+	db, err := newSqliteStorage(s.logger, path, false)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to open database for room %q: %w`, record.Name.String(), err)
+	}
+
+	_, err = db.exec(ctx, `insert into room (name, created_ts) values (?, ?) on conflict do nothing`,
+		record.Name.String(),
+		record.CreatedTs.Unix(),
+	)
+	if err == nil && isNew {
+		err = s.moveRoomData(ctx, db, record.Name)
+	}
+	if err != nil {
+		_ = db.Close()
+		if isNew {
+			_ = removeSqliteFiles(path)
+		}
+		return nil, fmt.Errorf(`failed to set up database for room %q: %w`, record.Name.String(), err)
+	}
+
+	return db, nil
+}
+
+// moveRoomData copies the accounts, invite codes and usage snapshots of a room from the main database to the room's
+// database, and then deletes them from the main database.
+func (s *namespacedStorage) moveRoomData(ctx context.Context, dst *sqlStorage, room common.NormalizedRoomName) error {
+	accounts, err := s.sqlStorage.GetAccountsByRoom(ctx, room)
+	if err != nil {
+		return err
+	}
+	codes, err := s.sqlStorage.GetInviteCodesByRoom(ctx, room)
+	if err != nil {
+		return err
+	}
+	stats, err := s.sqlStorage.GetRoomStats(ctx, room, time.Unix(0, 0), time.Now().Add(24*time.Hour))
+	if err != nil {
+		return err
+	}
+	if len(accounts) == 0 && len(codes) == 0 && len(stats) == 0 {
+		return nil
+	}
+
+	tx, err := dst.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf(`failed to begin transaction: %w`, err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	for _, rec := range accounts {
+		_, err = tx.ExecContext(ctx, `insert into account (room, username, password_hash, created_ts, is_guest) values (?, ?, ?, ?, ?)`,
+			room.String(),
+			rec.Username.String(),
+			rec.PasswordHash,
+			rec.CreatedTs.Unix(),
+			rec.IsGuest,
+		)
+		if err != nil {
+			return fmt.Errorf(`failed to copy account %q: %w`, rec.Username.String(), err)
+		}
+	}
+	for _, rec := range codes {
+		_, err = tx.ExecContext(ctx, `insert into invite_code (code, room, created_ts) values (?, ?, ?)`,
+			rec.Code,
+			room.String(),
+			rec.CreatedTs.Unix(),
+		)
+		if err != nil {
+			return fmt.Errorf(`failed to copy invite code: %w`, err)
+		}
+	}
+	for _, rec := range stats {
+		_, err = tx.ExecContext(ctx, `insert into room_stat (room, ts, online_clients, relayed_bytes) values (?, ?, ?, ?)`,
+			room.String(),
+			rec.Ts.Unix(),
+			rec.OnlineClients,
+			rec.RelayedBytes,
+		)
+		if err != nil {
+			return fmt.Errorf(`failed to copy room stat: %w`, err)
+		}
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf(`failed to commit transaction: %w`, err)
+	}
+
+	// The room database has the data now, so failing to delete it from the main database only leaves a stale copy.
+	for _, table := range []string{"account", "invite_code", "room_stat"} {
+		if _, err = s.sqlStorage.exec(ctx, `delete from `+table+` where room = ?`, room.String()); err != nil {
+			s.logger.Error("failed to delete room data from main database after moving it to room database",
+				"service", "storage.Storage",
+				"room", room.String(),
+				"table", table,
+				"err", err,
+			)
+		}
+	}
+
+	s.logger.Info("moved room data to room database",
+		"service", "storage.Storage",
+		"room", room.String(),
+		"accounts", len(accounts),
+		"invite_codes", len(codes),
+		"stats", len(stats),
+	)
+	return nil
+}
+
+// removeSqliteFiles removes an SQLite database file along with its write-ahead log and shared memory files.
+func removeSqliteFiles(path string) error {
+	errs := make([]error, 0, 3)
+	for _, suffix := range []string{"", "-wal", "-shm"} {
+		if err := os.Remove(path + suffix); err != nil && !os.IsNotExist(err) {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+func (s *namespacedStorage) OpenRooms() []common.NormalizedRoomName {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	res := make([]common.NormalizedRoomName, 0, len(s.rooms))
+	for name := range s.rooms {
+		res = append(res, common.UncheckedCreateNormalizedRoomName(name))
+	}
+	return res
+}
+
+func (s *namespacedStorage) CloseRoom(room common.NormalizedRoomName) error {
+	s.mu.Lock()
+	entry, has := s.rooms[room.String()]
+	if !has || entry.users > 0 {
+		s.mu.Unlock()
+		return nil
+	}
+	delete(s.rooms, room.String())
+	s.mu.Unlock()
+
+	return entry.db.Close()
+}
+
+func (s *namespacedStorage) Close() error {
+	s.mu.Lock()
+	if s.isClosed {
+		s.mu.Unlock()
+		return nil
+	}
+	s.isClosed = true
+	rooms := s.rooms
+	s.rooms = nil
+	s.mu.Unlock()
+
+	errs := make([]error, 0, len(rooms)+1)
+	for _, entry := range rooms {
+		errs = append(errs, entry.db.Close())
+	}
+	errs = append(errs, s.sqlStorage.Close())
+	return errors.Join(errs...)
+}
+
+// roomsWithDb returns the rooms that have a database, whether it is open or not.
+func (s *namespacedStorage) roomsWithDb(ctx context.Context) ([]common.NormalizedRoomName, error) {
+	records, err := s.sqlStorage.GetRooms(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]common.NormalizedRoomName, 0, len(records))
+	for _, record := range records {
+		if _, err = os.Stat(s.roomPath(record.Name)); err == nil {
+			res = append(res, record.Name)
+		}
+	}
+	return res, nil
+}
+
+// Backup writes a consistent copy of the main database to dest, and copies of the room databases to the directory at
+// dest with RoomBackupDirSuffix appended.
+func (s *namespacedStorage) Backup(ctx context.Context, dest string) error {
+	if err := s.sqlStorage.Backup(ctx, dest); err != nil {
+		return err
+	}
+
+	rooms, err := s.roomsWithDb(ctx)
+	if err != nil {
+		return err
+	}
+	if len(rooms) == 0 {
+		return nil
+	}
+
+	dir := dest + RoomBackupDirSuffix
+	if err = os.MkdirAll(dir, 0700); err != nil {
+		return fmt.Errorf(`failed to create room backup directory %q: %w`, dir, err)
+	}
+	for _, room := range rooms {
+		db, release, has, err := s.acquire(ctx, room)
+		if err != nil {
+			return err
+		}
+		if !has {
+			continue
+		}
+		err = db.Backup(ctx, filepath.Join(dir, room.String()+roomDbSuffix))
+		release()
+		if err != nil {
+			return fmt.Errorf(`failed to back up database for room %q: %w`, room.String(), err)
+		}
+	}
+	return nil
+}
+
+func (s *namespacedStorage) CheckIntegrity(ctx context.Context) ([]string, error) {
+	problems, err := s.sqlStorage.CheckIntegrity(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rooms, err := s.roomsWithDb(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, room := range rooms {
+		db, release, has, err := s.acquire(ctx, room)
+		if err != nil {
+			return nil, err
+		}
+		if !has {
+			continue
+		}
+		roomProblems, err := db.CheckIntegrity(ctx)
+		release()
+		if err != nil {
+			return nil, fmt.Errorf(`failed to check database for room %q: %w`, room.String(), err)
+		}
+		for _, problem := range roomProblems {
+			problems = append(problems, fmt.Sprintf("room %s: %s", room.String(), problem))
+		}
+	}
+	return problems, nil
+}
+
+// DeleteRoomByName deletes the room record with the specified name from the main database, and deletes the room's
+// database.
+func (s *namespacedStorage) DeleteRoomByName(ctx context.Context, room common.NormalizedRoomName) error {
+	if err := s.sqlStorage.DeleteRoomByName(ctx, room); err != nil {
+		return err
+	}
+
+	s.mu.Lock()
+	entry, has := s.rooms[room.String()]
+	delete(s.rooms, room.String())
+	s.mu.Unlock()
+
+	if has {
+		_ = entry.db.Close()
+	}
+	if err := removeSqliteFiles(s.roomPath(room)); err != nil {
+		return fmt.Errorf(`deleted room %q, but failed to delete its database: %w`, room.String(), err)
+	}
+	return nil
+}
+
+// errNoRoom returns the error for writing data to a room that does not exist.
+func errNoRoom(room common.NormalizedRoomName) error {
+	return fmt.Errorf(`room %q does not exist`, room.String())
+}
+
+func (s *namespacedStorage) CreateAccount(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	username common.NormalizedUsername,
+	passwordHash string,
+	isGuest bool,
+) error {
+	db, release, has, err := s.acquire(ctx, room)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errNoRoom(room)
+	}
+	defer release()
+	return db.CreateAccount(ctx, room, username, passwordHash, isGuest)
+}
+
+func (s *namespacedStorage) GetAccountByRoomAndUsername(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	username common.NormalizedUsername,
+) (record AccountRecord, has bool, err error) {
+	db, release, has, err := s.acquire(ctx, room)
+	if err != nil || !has {
+		return record, false, err
+	}
+	defer release()
+	return db.GetAccountByRoomAndUsername(ctx, room, username)
+}
+
+func (s *namespacedStorage) GetAccountsByRoom(ctx context.Context, room common.NormalizedRoomName) ([]AccountRecord, error) {
+	db, release, has, err := s.acquire(ctx, room)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return make([]AccountRecord, 0), nil
+	}
+	defer release()
+	return db.GetAccountsByRoom(ctx, room)
+}
+
+func (s *namespacedStorage) GetAccountsByRoomPage(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	cursor string,
+	limit int,
+) (records []AccountRecord, nextCursor string, err error) {
+	db, release, has, err := s.acquire(ctx, room)
+	if err != nil {
+		return nil, "", err
+	}
+	if !has {
+		return make([]AccountRecord, 0), "", nil
+	}
+	defer release()
+	return db.GetAccountsByRoomPage(ctx, room, cursor, limit)
+}
+
+func (s *namespacedStorage) CountAccountsByRoom(ctx context.Context, room common.NormalizedRoomName) (int, error) {
+	db, release, has, err := s.acquire(ctx, room)
+	if err != nil || !has {
+		return 0, err
+	}
+	defer release()
+	return db.CountAccountsByRoom(ctx, room)
+}
+
+func (s *namespacedStorage) UpdateAccountPasswordHash(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	username common.NormalizedUsername,
+	passwordHash string,
+) error {
+	db, release, has, err := s.acquire(ctx, room)
+	if err != nil || !has {
+		return err
+	}
+	defer release()
+	return db.UpdateAccountPasswordHash(ctx, room, username, passwordHash)
+}
+
+func (s *namespacedStorage) UpdateAccountIsGuest(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	username common.NormalizedUsername,
+	isGuest bool,
+) error {
+	db, release, has, err := s.acquire(ctx, room)
+	if err != nil || !has {
+		return err
+	}
+	defer release()
+	return db.UpdateAccountIsGuest(ctx, room, username, isGuest)
+}
+
+func (s *namespacedStorage) DeleteAccountByRoomAndUsername(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	username common.NormalizedUsername,
+) error {
+	db, release, has, err := s.acquire(ctx, room)
+	if err != nil || !has {
+		return err
+	}
+	defer release()
+	return db.DeleteAccountByRoomAndUsername(ctx, room, username)
+}
+
+func (s *namespacedStorage) CreateInviteCode(ctx context.Context, room common.NormalizedRoomName, code string) (InviteCodeRecord, error) {
+	db, release, has, err := s.acquire(ctx, room)
+	if err != nil {
+		return InviteCodeRecord{}, err
+	}
+	if !has {
+		return InviteCodeRecord{}, errNoRoom(room)
+	}
+	defer release()
+	return db.CreateInviteCode(ctx, room, code)
+}
+
+func (s *namespacedStorage) GetInviteCodesByRoom(ctx context.Context, room common.NormalizedRoomName) ([]InviteCodeRecord, error) {
+	db, release, has, err := s.acquire(ctx, room)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return make([]InviteCodeRecord, 0), nil
+	}
+	defer release()
+	return db.GetInviteCodesByRoom(ctx, room)
+}
+
+func (s *namespacedStorage) DeleteInviteCode(ctx context.Context, room common.NormalizedRoomName, code string) (bool, error) {
+	db, release, has, err := s.acquire(ctx, room)
+	if err != nil || !has {
+		return false, err
+	}
+	defer release()
+	return db.DeleteInviteCode(ctx, room, code)
+}
+
+func (s *namespacedStorage) CreateAccountWithInviteCode(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	username common.NormalizedUsername,
+	passwordHash string,
+	code string,
+) error {
+	db, release, has, err := s.acquire(ctx, room)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return ErrInvalidInviteCode
+	}
+	defer release()
+	return db.CreateAccountWithInviteCode(ctx, room, username, passwordHash, code)
+}
+
+func (s *namespacedStorage) CreateRoomStat(ctx context.Context, record RoomStatRecord) error {
+	db, release, has, err := s.acquire(ctx, record.Room)
+	if err != nil {
+		return err
+	}
+	if !has {
+		return errNoRoom(record.Room)
+	}
+	defer release()
+	return db.CreateRoomStat(ctx, record)
+}
+
+func (s *namespacedStorage) GetRoomStats(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	from time.Time,
+	to time.Time,
+) ([]RoomStatRecord, error) {
+	db, release, has, err := s.acquire(ctx, room)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return make([]RoomStatRecord, 0), nil
+	}
+	defer release()
+	return db.GetRoomStats(ctx, room, from, to)
+}
+
+// DeleteRoomStatsBefore deletes usage snapshots taken before the specified time from the databases of all rooms that
+// have one.
+func (s *namespacedStorage) DeleteRoomStatsBefore(ctx context.Context, before time.Time) (int64, error) {
+	rooms, err := s.roomsWithDb(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var total int64
+	for _, room := range rooms {
+		db, release, has, err := s.acquire(ctx, room)
+		if err != nil {
+			return total, err
+		}
+		if !has {
+			continue
+		}
+		deleted, err := db.DeleteRoomStatsBefore(ctx, before)
+		release()
+		if err != nil {
+			return total, fmt.Errorf(`failed to delete old stats for room %q: %w`, room.String(), err)
+		}
+		total += deleted
+	}
+	return total, nil
+}
@@ -0,0 +1,88 @@
+package storage
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"friendnet.org/common"
+)
+
+func TestNamespacedStorageMovesAndIsolatesRoomData(t *testing.T) {
+	ctx := context.Background()
+	logger := slog.New(slog.DiscardHandler)
+	dir := t.TempDir()
+	mainPath := filepath.Join(dir, "server.db")
+	roomDir := filepath.Join(dir, "rooms")
+
+	room := common.UncheckedCreateNormalizedRoomName("lobby")
+	other := common.UncheckedCreateNormalizedRoomName("other")
+	alice := common.UncheckedCreateNormalizedUsername("alice")
+	bob := common.UncheckedCreateNormalizedUsername("bob")
+
+	// Data created before the room directory was configured is moved to the room's database.
+	plain, err := NewSqliteStorage(logger, mainPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = plain.CreateRoom(ctx, room, "", true); err != nil {
+		t.Fatal(err)
+	}
+	if err = plain.CreateAccount(ctx, room, alice, "hash", false); err != nil {
+		t.Fatal(err)
+	}
+	if err = plain.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	st, err := NewNamespacedSqliteStorage(logger, mainPath, roomDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		_ = st.Close()
+	}()
+	ns := st.(*namespacedStorage)
+
+	if _, has, err := st.GetAccountByRoomAndUsername(ctx, room, alice); err != nil || !has {
+		t.Fatalf("expected existing account to be moved to room database, got %t, %v", has, err)
+	}
+	if _, has, _ := ns.sqlStorage.GetAccountByRoomAndUsername(ctx, room, alice); has {
+		t.Fatal("expected moved account to be deleted from main database")
+	}
+	if _, err = os.Stat(filepath.Join(roomDir, "lobby.db")); err != nil {
+		t.Fatalf("expected room database file: %v", err)
+	}
+
+	// Rooms that do not exist do not get a database.
+	if _, has, err := st.GetAccountByRoomAndUsername(ctx, other, alice); err != nil || has {
+		t.Fatalf("got %t, %v for room that does not exist", has, err)
+	}
+	if _, err = os.Stat(filepath.Join(roomDir, "other.db")); !os.IsNotExist(err) {
+		t.Fatalf("expected no database for room that does not exist, got %v", err)
+	}
+
+	// Closed room databases are opened again when they are used.
+	if err = st.(RoomCloser).CloseRoom(room); err != nil {
+		t.Fatal(err)
+	}
+	if open := st.(RoomCloser).OpenRooms(); len(open) != 0 {
+		t.Fatalf("expected no open rooms, got %v", open)
+	}
+	if err = st.CreateAccount(ctx, room, bob, "hash", true); err != nil {
+		t.Fatal(err)
+	}
+	if count, err := st.CountAccountsByRoom(ctx, room); err != nil || count != 2 {
+		t.Fatalf("got %d, %v accounts after reopening room database", count, err)
+	}
+
+	// Deleting a room deletes its database.
+	if err = st.DeleteRoomByName(ctx, room); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(filepath.Join(roomDir, "lobby.db")); !os.IsNotExist(err) {
+		t.Fatalf("expected room database to be deleted, got %v", err)
+	}
+}
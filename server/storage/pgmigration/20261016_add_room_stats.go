@@ -0,0 +1,47 @@
+package pgmigration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+type M20261016AddRoomStats struct {
+}
+
+var _ common.Migration = (*M20261016AddRoomStats)(nil)
+
+func (m *M20261016AddRoomStats) Name() string {
+	return "20261016_add_room_stats"
+}
+
+func (m *M20261016AddRoomStats) Apply(tx *sql.Tx) error {
+	const q = `
+create table room_stat
+(
+    room text not null
+        constraint room_stat_room_room_name_fk
+        references room
+        on delete cascade,
+    ts bigint not null,
+    online_clients integer not null,
+    relayed_bytes bigint not null,
+    primary key (room, ts)
+);
+
+create index room_stat_ts_index
+    on room_stat (ts);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016AddRoomStats) Revert(tx *sql.Tx) error {
+	const q = `
+drop table room_stat;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
@@ -0,0 +1,96 @@
+package pgmigration
+
+import (
+	"database/sql"
+
+	"friendnet.org/common"
+)
+
+// M20261016InitialSchema creates the PostgreSQL schema.
+// It matches the SQLite schema after all of its migrations up to M20261016AddRoomDirCacheTtl, including column order.
+// Later migrations are mirrored by their own PostgreSQL migrations.
+type M20261016InitialSchema struct {
+}
+
+var _ common.Migration = (*M20261016InitialSchema)(nil)
+
+func (m *M20261016InitialSchema) Name() string {
+	return "20261016_initial_schema"
+}
+
+func (m *M20261016InitialSchema) Apply(tx *sql.Tx) error {
+	const q = `
+create table room
+(
+    name text not null
+        constraint room_pk
+            primary key,
+    created_ts bigint default extract(epoch from now())::bigint not null,
+    max_clients integer default 0 not null,
+    max_proxy_streams_per_client integer default 0 not null,
+    dir_cache_ttl_ms bigint default 0 not null
+);
+
+create index room_created_ts_index
+    on room (created_ts);
+
+create table account
+(
+    room text not null
+        constraint account_room_room_name_fk
+        references room
+        on delete cascade,
+    username text not null,
+    password_hash text not null,
+    created_ts bigint default extract(epoch from now())::bigint not null,
+    is_guest boolean default false not null,
+    primary key (room, username)
+);
+
+create index account_created_ts_index
+    on account (created_ts);
+
+create table auth_attempt
+(
+    kind text not null,
+    subject text not null,
+    failures integer not null default 0,
+    window_start_ts bigint default extract(epoch from now())::bigint not null,
+    locked_until_ts bigint default 0 not null,
+    primary key (kind, subject)
+);
+
+create index auth_attempt_window_start_ts_index
+    on auth_attempt (window_start_ts);
+
+create table invite_code
+(
+    code text not null
+        constraint invite_code_pk
+            primary key,
+    room text not null
+        constraint invite_code_room_room_name_fk
+        references room
+        on delete cascade,
+    created_ts bigint default extract(epoch from now())::bigint not null
+);
+
+create index invite_code_room_index
+    on invite_code (room);
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
+
+func (m *M20261016InitialSchema) Revert(tx *sql.Tx) error {
+	const q = `
+drop table invite_code;
+drop table auth_attempt;
+drop table account;
+drop table room;
+	`
+
+	_, err := tx.Exec(q)
+	return err
+}
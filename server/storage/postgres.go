@@ -0,0 +1,144 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strconv"
+	"strings"
+
+	"friendnet.org/common"
+	"friendnet.org/server/storage/pgmigration"
+	"github.com/jackc/pgx/v5/pgconn"
+	_ "github.com/jackc/pgx/v5/stdlib"
+)
+
+// postgresMigrations are the migrations for PostgreSQL storage, in order.
+var postgresMigrations = []common.Migration{
+	&pgmigration.M20261016InitialSchema{},
+	&pgmigration.M20261016AddRoomMetadata{},
+	&pgmigration.M20261016AddRoomMotd{},
+	&pgmigration.M20261016AddRoomStats{},
+}
+
+// openPostgres connects to the PostgreSQL database at the specified URL without applying migrations.
+func openPostgres(ctx context.Context, url string) (*sql.DB, error) {
+	if url == "" {
+		panic("url is required for storage")
+	}
+
+	db, err := sql.Open("pgx", url)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf(`failed to connect to PostgreSQL database: %w`, err)
+	}
+
+	return db, nil
+}
+
+// OpenPostgresMigrator connects to the PostgreSQL database at the specified URL without applying migrations, and
+// returns a migrator for it.
+// The caller must close the returned database.
+func OpenPostgresMigrator(ctx context.Context, url string) (*common.Migrator, *sql.DB, error) {
+	db, err := openPostgres(ctx, url)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return common.NewPostgresMigrator(db, postgresMigrations), db, nil
+}
+
+// NewPostgresStorage creates a new PostgreSQL storage instance using the specified connection URL.
+// The URL may be a URL or a key/value connection string, as accepted by libpq.
+// Pending migrations are applied.
+//
+// Unlike SQLite storage, any number of server instances may share the same PostgreSQL database.
+func NewPostgresStorage(ctx context.Context, logger *slog.Logger, url string) (Storage, error) {
+	db, err := openPostgres(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	migrator := common.NewPostgresMigrator(db, postgresMigrations)
+	applied, err := migrator.Migrate(ctx)
+	if err != nil {
+		_ = db.Close()
+		return nil, fmt.Errorf(`failed to apply server database migrations: %w`, err)
+	}
+	if len(applied) > 0 {
+		logger.Info("applied server database migrations",
+			"service", "storage.Storage",
+			"migrations", applied,
+		)
+	}
+
+	return &sqlStorage{
+		db:          db,
+		migrator:    migrator,
+		rebind:      rebindPostgres,
+		isDuplicate: isDuplicatePostgres,
+	}, nil
+}
+
+// pgUniqueViolation is the SQLSTATE of a PostgreSQL unique constraint violation.
+const pgUniqueViolation = "23505"
+
+// isDuplicatePostgres reports whether err is a PostgreSQL unique constraint violation.
+func isDuplicatePostgres(err error) bool {
+	pgErr, ok := errors.AsType[*pgconn.PgError](err)
+	return ok && pgErr.Code == pgUniqueViolation
+}
+
+// rebindPostgres converts "?" placeholders to PostgreSQL's numbered "$n" placeholders.
+// A "?" inside a string literal, quoted identifier or comment is left as-is.
+func rebindPostgres(query string) string {
+	var b strings.Builder
+	b.Grow(len(query) + 8)
+
+	n := 0
+	for i := 0; i < len(query); i++ {
+		c := query[i]
+		switch {
+		case c == '\'' || c == '"':
+			// Copy up to and including the closing quote.
+			// A doubled quote is an escaped quote, which is the same as closing and reopening.
+			end := strings.IndexByte(query[i+1:], c)
+			if end < 0 {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			b.WriteString(query[i : i+end+2])
+			i += end + 1
+		case c == '-' && strings.HasPrefix(query[i:], "--"):
+			end := strings.IndexByte(query[i:], '\n')
+			if end < 0 {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			b.WriteString(query[i : i+end+1])
+			i += end
+		case c == '/' && strings.HasPrefix(query[i:], "/*"):
+			end := strings.Index(query[i+2:], "*/")
+			if end < 0 {
+				b.WriteString(query[i:])
+				return b.String()
+			}
+			b.WriteString(query[i : i+end+4])
+			i += end + 3
+		case c == '?':
+			n++
+			b.WriteByte('$')
+			b.WriteString(strconv.Itoa(n))
+		default:
+			b.WriteByte(c)
+		}
+	}
+
+	return b.String()
+}
@@ -0,0 +1,120 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+)
+
+// postgresTestUrlEnv is the environment variable with the URL of a PostgreSQL database to run tests against.
+// Tests that need PostgreSQL are skipped if it is not set.
+// The database's schema is migrated, so it should be dedicated to tests.
+const postgresTestUrlEnv = "FRIENDNET_TEST_POSTGRES_URL"
+
+func TestRebindPostgres(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		query string
+		want  string
+	}{
+		{
+			query: `select * from room where name = ? and listed = ?`,
+			want:  `select * from room where name = $1 and listed = $2`,
+		},
+		{
+			query: `update room set motd = 'why?' where name = ?`,
+			want:  `update room set motd = 'why?' where name = $1`,
+		},
+		{
+			query: `select 'it''s ?', ? from "odd?name" where a = ?`,
+			want:  `select 'it''s ?', $1 from "odd?name" where a = $2`,
+		},
+		{
+			query: "select ? -- really?\nfrom room where name = ? /* or? */ and b = ?",
+			want:  "select $1 -- really?\nfrom room where name = $2 /* or? */ and b = $3",
+		},
+		{
+			query: `select 'unterminated ?`,
+			want:  `select 'unterminated ?`,
+		},
+	}
+	for _, test := range tests {
+		if got := rebindPostgres(test.query); got != test.want {
+			t.Errorf("rebindPostgres(%q) = %q, want %q", test.query, got, test.want)
+		}
+	}
+}
+
+func TestPostgresStorage(t *testing.T) {
+	url := os.Getenv(postgresTestUrlEnv)
+	if url == "" {
+		t.Skip(postgresTestUrlEnv + " is not set")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	st, err := NewPostgresStorage(ctx, slog.New(slog.DiscardHandler), url)
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	defer func() {
+		_ = st.Close()
+	}()
+
+	// Names are unique per run, so the same database can be reused.
+	room := common.UncheckedCreateNormalizedRoomName("room" + strconv.FormatInt(time.Now().UnixNano(), 36))
+	defer func() {
+		_ = st.DeleteRoomByName(context.Background(), room)
+	}()
+
+	testDuplicateRecords(t, st, room)
+
+	// A "?" in a value is passed through as-is rather than treated as a placeholder.
+	if err = st.UpdateRoomMotd(ctx, room, "why?"); err != nil {
+		t.Fatal(err)
+	}
+	record, has, err := st.GetRoomByName(ctx, room)
+	if err != nil || !has {
+		t.Fatalf("failed to get room: %v (has: %t)", err, has)
+	}
+	if record.Motd != "why?" {
+		t.Fatalf("expected MOTD %q, got %q", "why?", record.Motd)
+	}
+}
+
+// testDuplicateRecords checks that creating records that already exist returns ErrRecordExists, and that other
+// constraint violations do not.
+// The specified room must not exist yet.
+func testDuplicateRecords(t *testing.T, st Storage, room common.NormalizedRoomName) {
+	t.Helper()
+	ctx := context.Background()
+
+	if err := st.CreateRoom(ctx, room, "", true); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.CreateRoom(ctx, room, "", true); !errors.Is(err, ErrRecordExists) {
+		t.Fatalf("expected ErrRecordExists for a duplicate room, got %v", err)
+	}
+
+	username := common.UncheckedCreateNormalizedUsername("user")
+	if err := st.CreateAccount(ctx, room, username, "hash", false); err != nil {
+		t.Fatal(err)
+	}
+	if err := st.CreateAccount(ctx, room, username, "hash", false); !errors.Is(err, ErrRecordExists) {
+		t.Fatalf("expected ErrRecordExists for a duplicate account, got %v", err)
+	}
+
+	// An account in a room that does not exist violates a foreign key, which is not a duplicate.
+	missing := common.UncheckedCreateNormalizedRoomName(room.String() + "missing")
+	if err := st.CreateAccount(ctx, missing, username, "hash", false); err == nil || errors.Is(err, ErrRecordExists) {
+		t.Fatalf("expected an error other than ErrRecordExists for a missing room, got %v", err)
+	}
+}
@@ -11,13 +11,37 @@ import (
 type RoomRecord struct {
 	Name      common.NormalizedRoomName
 	CreatedTs time.Time
+
+	// The maximum number of online clients, or 0 if unlimited.
+	MaxClients int
+
+	// The maximum number of concurrent proxied streams per client, or 0 if unlimited.
+	MaxProxyStreamsPerClient int
+
+	// How long proxied directory listings are cached, or 0 if caching is disabled.
+	DirCacheTtl time.Duration
+
+	// A description of the room, shown to people looking for rooms to join.
+	Description string
+
+	// Whether the room is included in public room listings.
+	Listed bool
+
+	// The message of the day shown to clients when they join the room, or empty if there is none.
+	Motd string
 }
 
 func ScanRoomRecord(row common.Scannable) (record RoomRecord, has bool, err error) {
 	var name string
 	var createdTs int64
+	var maxClients int
+	var maxProxyStreamsPerClient int
+	var dirCacheTtlMs int64
+	var description string
+	var listed bool
+	var motd string
 
-	err = row.Scan(&name, &createdTs)
+	err = row.Scan(&name, &createdTs, &maxClients, &maxProxyStreamsPerClient, &dirCacheTtlMs, &description, &listed, &motd)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return record, false, nil
@@ -27,6 +51,12 @@ func ScanRoomRecord(row common.Scannable) (record RoomRecord, has bool, err erro
 
 	record.Name = common.UncheckedCreateNormalizedRoomName(name)
 	record.CreatedTs = time.Unix(createdTs, 0)
+	record.MaxClients = maxClients
+	record.MaxProxyStreamsPerClient = maxProxyStreamsPerClient
+	record.DirCacheTtl = time.Duration(dirCacheTtlMs) * time.Millisecond
+	record.Description = description
+	record.Listed = listed
+	record.Motd = motd
 
 	return record, true, nil
 }
@@ -36,6 +66,7 @@ type AccountRecord struct {
 	Username     common.NormalizedUsername
 	PasswordHash string
 	CreatedTs    time.Time
+	IsGuest      bool
 }
 
 func ScanAccountRecord(row common.Scannable) (record AccountRecord, has bool, err error) {
@@ -43,8 +74,9 @@ func ScanAccountRecord(row common.Scannable) (record AccountRecord, has bool, er
 	var username string
 	var passwordHash string
 	var createdTs int64
+	var isGuest bool
 
-	err = row.Scan(&room, &username, &passwordHash, &createdTs)
+	err = row.Scan(&room, &username, &passwordHash, &createdTs, &isGuest)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return record, false, nil
@@ -56,6 +88,113 @@ func ScanAccountRecord(row common.Scannable) (record AccountRecord, has bool, er
 	record.Username = common.UncheckedCreateNormalizedUsername(username)
 	record.PasswordHash = passwordHash
 	record.CreatedTs = time.Unix(createdTs, 0)
+	record.IsGuest = isGuest
+
+	return record, true, nil
+}
+
+// AuthAttemptKind is the kind of subject that authentication attempts are counted for.
+type AuthAttemptKind string
+
+const (
+	// AuthAttemptKindIp counts attempts per remote IP address.
+	AuthAttemptKindIp AuthAttemptKind = "ip"
+
+	// AuthAttemptKindAccount counts attempts per account.
+	// The subject is in the format "<room>/<username>".
+	AuthAttemptKindAccount AuthAttemptKind = "account"
+)
+
+type AuthAttemptRecord struct {
+	Kind          AuthAttemptKind
+	Subject       string
+	Failures      int
+	WindowStartTs time.Time
+	LockedUntilTs time.Time
+}
+
+func ScanAuthAttemptRecord(row common.Scannable) (record AuthAttemptRecord, has bool, err error) {
+	var kind string
+	var subject string
+	var failures int
+	var windowStartTs int64
+	var lockedUntilTs int64
+
+	err = row.Scan(&kind, &subject, &failures, &windowStartTs, &lockedUntilTs)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Kind = AuthAttemptKind(kind)
+	record.Subject = subject
+	record.Failures = failures
+	record.WindowStartTs = time.Unix(windowStartTs, 0)
+	record.LockedUntilTs = time.Unix(lockedUntilTs, 0)
+
+	return record, true, nil
+}
+
+type InviteCodeRecord struct {
+	Code      string
+	Room      common.NormalizedRoomName
+	CreatedTs time.Time
+}
+
+func ScanInviteCodeRecord(row common.Scannable) (record InviteCodeRecord, has bool, err error) {
+	var code string
+	var room string
+	var createdTs int64
+
+	err = row.Scan(&code, &room, &createdTs)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Code = code
+	record.Room = common.UncheckedCreateNormalizedRoomName(room)
+	record.CreatedTs = time.Unix(createdTs, 0)
+
+	return record, true, nil
+}
+
+// RoomStatRecord is a snapshot of a room's usage.
+type RoomStatRecord struct {
+	Room common.NormalizedRoomName
+
+	// When the snapshot was taken.
+	Ts time.Time
+
+	// The number of clients online in the room.
+	OnlineClients int
+
+	// The number of bytes relayed through the server for proxied streams in the room since the previous snapshot.
+	RelayedBytes int64
+}
+
+func ScanRoomStatRecord(row common.Scannable) (record RoomStatRecord, has bool, err error) {
+	var room string
+	var ts int64
+	var onlineClients int
+	var relayedBytes int64
+
+	err = row.Scan(&room, &ts, &onlineClients, &relayedBytes)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return record, false, nil
+		}
+		return record, false, err
+	}
+
+	record.Room = common.UncheckedCreateNormalizedRoomName(room)
+	record.Ts = time.Unix(ts, 0)
+	record.OnlineClients = onlineClients
+	record.RelayedBytes = relayedBytes
 
 	return record, true, nil
 }
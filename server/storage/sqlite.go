@@ -0,0 +1,167 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"strings"
+
+	"friendnet.org/common"
+	"friendnet.org/server/storage/migration"
+	"modernc.org/sqlite"
+	sqlite3 "modernc.org/sqlite/lib"
+)
+
+// sqliteMigrations are the migrations for SQLite storage, in order.
+var sqliteMigrations = []common.Migration{
+	&migration.M20260208InitialSchema{},
+	&migration.M20261016AddAuthAttempts{},
+	&migration.M20261016AddInviteCodes{},
+	&migration.M20261016AddAccountIsGuest{},
+	&migration.M20261016AddRoomLimits{},
+	&migration.M20261016AddRoomDirCacheTtl{},
+	&migration.M20261016AddRoomMetadata{},
+	&migration.M20261016AddRoomMotd{},
+	&migration.M20261016AddRoomStats{},
+}
+
+// openSqlite opens the SQLite database at the specified path without applying migrations.
+// Returns the database and its absolute path.
+func openSqlite(path string) (*sql.DB, string, error) {
+	if path == "" {
+		panic("path is required for storage")
+	}
+
+	// Resolve full path.
+	var err error
+	path, err = filepath.Abs(path)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to resolve storage path: %w", err)
+	}
+
+	db, err := sql.Open("sqlite", path)
+	if err != nil {
+		return nil, "", err
+	}
+
+	db.SetMaxOpenConns(1)
+	db.SetMaxIdleConns(1)
+
+	return db, path, nil
+}
+
+// OpenSqliteMigrator opens the SQLite database at the specified path without applying migrations, and returns a
+// migrator for it.
+// The caller must close the returned database.
+func OpenSqliteMigrator(path string) (*common.Migrator, *sql.DB, error) {
+	db, _, err := openSqlite(path)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return common.NewSqliteMigrator(db, sqliteMigrations), db, nil
+}
+
+// NewSqliteStorage creates a new SQLite storage instance using the specified DB path.
+// Pending migrations are applied. If the database already has migrations applied, it is backed up first;
+// see common.BackupSqlite.
+func NewSqliteStorage(logger *slog.Logger, path string) (Storage, error) {
+	return newSqliteStorage(logger, path, true)
+}
+
+// newSqliteStorage creates a new SQLite storage instance like NewSqliteStorage.
+// The integrity check on startup can be skipped for databases that are opened often, such as room databases.
+//
+//goland:noinspection SqlNoDataSourceInspection
+func newSqliteStorage(logger *slog.Logger, path string, checkIntegrity bool) (*sqlStorage, error) {
+	db, path, err := openSqlite(path)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err != nil {
+			_ = db.Close()
+		}
+	}()
+
+	ctx := context.Background()
+	migrator := common.NewSqliteMigrator(db, sqliteMigrations)
+
+	statuses, err := migrator.Status(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to check server database migrations: %w`, err)
+	}
+	var hasApplied, hasPending bool
+	for _, status := range statuses {
+		hasApplied = hasApplied || status.Applied
+		hasPending = hasPending || !status.Applied
+	}
+	if hasApplied && hasPending {
+		var backupPath string
+		backupPath, err = common.BackupSqlite(ctx, db, path)
+		if err != nil {
+			return nil, fmt.Errorf(`failed to back up server database before applying migrations: %w`, err)
+		}
+		logger.Info("backed up server database before applying migrations",
+			"service", "storage.Storage",
+			"path", backupPath,
+		)
+	}
+
+	applied, err := migrator.Migrate(ctx)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to apply server database migrations: %w`, err)
+	}
+	if len(applied) > 0 {
+		logger.Info("applied server database migrations",
+			"service", "storage.Storage",
+			"migrations", applied,
+		)
+	}
+
+	// Set important pragmas.
+	startupStmts := []string{
+		`PRAGMA foreign_keys = ON`,
+		`PRAGMA journal_mode = WAL`,
+		`PRAGMA synchronous = NORMAL`,
+	}
+	for _, stmt := range startupStmts {
+		_, err = db.Exec(stmt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to run startup statement: %q: %w", stmt, err)
+		}
+	}
+
+	// Check database integrity.
+	if checkIntegrity {
+		var problems []string
+		problems, err = common.CheckSqliteIntegrity(ctx, db)
+		if err != nil {
+			return nil, err
+		}
+		if len(problems) > 0 {
+			err = fmt.Errorf("database integrity check failed: %s", strings.Join(problems, "; "))
+			return nil, err
+		}
+	}
+
+	return &sqlStorage{
+		db:          db,
+		migrator:    migrator,
+		isSqlite:    true,
+		rebind:      func(query string) string { return query },
+		isDuplicate: isDuplicateSqlite,
+	}, nil
+}
+
+// isDuplicateSqlite reports whether err is a SQLite unique or primary key constraint violation.
+func isDuplicateSqlite(err error) bool {
+	sqliteErr, ok := errors.AsType[*sqlite.Error](err)
+	if !ok {
+		return false
+	}
+	return sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_UNIQUE || sqliteErr.Code() == sqlite3.SQLITE_CONSTRAINT_PRIMARYKEY
+}
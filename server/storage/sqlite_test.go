@@ -0,0 +1,72 @@
+package storage
+
+import (
+	"context"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestSqliteMigrationsRevertAndBackup(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "test.db")
+
+	st, err := NewSqliteStorage(slog.New(slog.DiscardHandler), path)
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	if err = st.Close(); err != nil {
+		t.Fatalf("failed to close storage: %v", err)
+	}
+
+	migrator, db, err := OpenSqliteMigrator(path)
+	if err != nil {
+		t.Fatalf("failed to open migrator: %v", err)
+	}
+	reverted, err := migrator.Revert(ctx, 2)
+	if err != nil {
+		t.Fatalf("failed to revert migrations: %v", err)
+	}
+	if len(reverted) != 2 || reverted[0] != sqliteMigrations[len(sqliteMigrations)-1].Name() {
+		t.Fatalf("unexpected reverted migrations: %v", reverted)
+	}
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		t.Fatalf("failed to get pending migrations: %v", err)
+	}
+	if len(pending) != 2 {
+		t.Fatalf("expected 2 pending migrations, got %v", pending)
+	}
+	_ = db.Close()
+
+	// Reopening applies the reverted migrations again, backing up the database first.
+	st, err = NewSqliteStorage(slog.New(slog.DiscardHandler), path)
+	if err != nil {
+		t.Fatalf("failed to reopen storage: %v", err)
+	}
+	defer func() {
+		_ = st.Close()
+	}()
+
+	statuses, err := st.GetMigrationStatus(ctx)
+	if err != nil {
+		t.Fatalf("failed to get migration status: %v", err)
+	}
+	for _, status := range statuses {
+		if !status.Applied || status.Unknown {
+			t.Fatalf("unexpected migration status: %+v", status)
+		}
+	}
+
+	backups, err := filepath.Glob(path + ".*.bak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 1 {
+		t.Fatalf("expected 1 backup, got %v", backups)
+	}
+	if _, err = os.Stat(backups[0]); err != nil {
+		t.Fatalf("failed to stat backup: %v", err)
+	}
+}
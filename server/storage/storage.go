@@ -4,98 +4,248 @@ import (
 	"context"
 	"database/sql"
 	"fmt"
-	"path/filepath"
-	"strings"
+	"time"
 
 	"friendnet.org/common"
-	"friendnet.org/server/storage/migration"
-	_ "modernc.org/sqlite"
 )
 
 // ErrRecordExists is returned when trying to create a duplicate record.
 var ErrRecordExists = fmt.Errorf("record already exists")
 
+// ErrNotSupported is returned when an operation is not supported by the storage backend.
+var ErrNotSupported = fmt.Errorf("not supported by storage backend")
+
+// ErrInvalidInviteCode is returned when trying to redeem an invite code that does not exist for the room.
+var ErrInvalidInviteCode = fmt.Errorf("invalid invite code")
+
 // Storage manages application state storage.
-type Storage struct {
-	// The underlying SQLite database connection.
-	Db *sql.DB
+// It is implemented for SQLite by NewSqliteStorage and for PostgreSQL by NewPostgresStorage.
+type Storage interface {
+	// Close closes the underlying database connection.
+	Close() error
+
+	// GetMigrationStatus returns the state of the database's schema migrations.
+	// See common.Migrator.Status.
+	GetMigrationStatus(ctx context.Context) ([]common.MigrationStatus, error)
+
+	// Backup writes a consistent copy of the database to the file at dest while it stays in use.
+	// Returns an error if dest already exists.
+	// Returns ErrNotSupported if the server cannot back up the database itself, as with PostgreSQL.
+	Backup(ctx context.Context, dest string) error
+
+	// CheckIntegrity checks the database for corruption.
+	// Returns the problems found, or nil if the database is intact.
+	// Returns ErrNotSupported if the database does not support integrity checks, as with PostgreSQL.
+	CheckIntegrity(ctx context.Context) ([]string, error)
+
+	// CreateRoom creates a new room record with the specified metadata.
+	// If the room already exists, returns ErrRecordExists.
+	CreateRoom(ctx context.Context, room common.NormalizedRoomName, description string, listed bool) error
+
+	// GetRoomByName returns the room record with the specified name, if any.
+	// If the room does not exist, `has` will be false.
+	GetRoomByName(ctx context.Context, room common.NormalizedRoomName) (record RoomRecord, has bool, err error)
+
+	// GetRooms returns all room records.
+	GetRooms(ctx context.Context) ([]RoomRecord, error)
+
+	// UpdateRoomLimits updates the capacity and concurrency limits of the room with the specified name.
+	// A limit of 0 means unlimited.
+	// If the room does not exist, this is a no-op.
+	UpdateRoomLimits(ctx context.Context, room common.NormalizedRoomName, maxClients int, maxProxyStreamsPerClient int) error
+
+	// UpdateRoomDirCacheTtl updates how long directory listings proxied in the room with the specified name are cached.
+	// A TTL of 0 disables caching.
+	// If the room does not exist, this is a no-op.
+	UpdateRoomDirCacheTtl(ctx context.Context, room common.NormalizedRoomName, ttl time.Duration) error
+
+	// UpdateRoomMetadata updates the description and listed flag of the room with the specified name.
+	// If the room does not exist, this is a no-op.
+	UpdateRoomMetadata(ctx context.Context, room common.NormalizedRoomName, description string, listed bool) error
+
+	// UpdateRoomMotd updates the message of the day of the room with the specified name.
+	// An empty message removes it.
+	// If the room does not exist, this is a no-op.
+	UpdateRoomMotd(ctx context.Context, room common.NormalizedRoomName, motd string) error
+
+	// DeleteRoomByName will delete the room record with the specified name.
+	// Any accounts associated with it will also be deleted.
+	// If the room does not exist, this is a no-op.
+	DeleteRoomByName(ctx context.Context, room common.NormalizedRoomName) error
+
+	// CreateAccount creates a new account record.
+	// If the account already exists, returns ErrRecordExists.
+	CreateAccount(
+		ctx context.Context,
+		room common.NormalizedRoomName,
+		username common.NormalizedUsername,
+		passwordHash string,
+		isGuest bool,
+	) error
+
+	// GetAccountByRoomAndUsername returns the account record with the specified room and username, if any.
+	GetAccountByRoomAndUsername(
+		ctx context.Context,
+		room common.NormalizedRoomName,
+		username common.NormalizedUsername,
+	) (record AccountRecord, has bool, err error)
+
+	// GetAccountsByRoom returns all account records for the specified room.
+	GetAccountsByRoom(ctx context.Context, room common.NormalizedRoomName) ([]AccountRecord, error)
+
+	// GetAccountsByRoomPage returns up to limit account records for the specified room, ordered by username, starting
+	// after the username in cursor.
+	// Specify an empty cursor to start from the beginning.
+	// The returned cursor can be passed to get the next page, and is empty when there are no more records.
+	GetAccountsByRoomPage(
+		ctx context.Context,
+		room common.NormalizedRoomName,
+		cursor string,
+		limit int,
+	) (records []AccountRecord, nextCursor string, err error)
+
+	// CountAccountsByRoom returns the number of accounts in the specified room.
+	CountAccountsByRoom(ctx context.Context, room common.NormalizedRoomName) (int, error)
+
+	// UpdateAccountPasswordHash updates the password hash of the account with the specified room and username.
+	// If the account does not exist, this is a no-op.
+	UpdateAccountPasswordHash(
+		ctx context.Context,
+		room common.NormalizedRoomName,
+		username common.NormalizedUsername,
+		passwordHash string,
+	) error
+
+	// UpdateAccountIsGuest updates whether the account with the specified room and username is a guest account.
+	// If the account does not exist, this is a no-op.
+	UpdateAccountIsGuest(
+		ctx context.Context,
+		room common.NormalizedRoomName,
+		username common.NormalizedUsername,
+		isGuest bool,
+	) error
+
+	// DeleteAccountByRoomAndUsername deletes the account with the specified room and username.
+	// If the account does not exist, this is a no-op.
+	DeleteAccountByRoomAndUsername(ctx context.Context, room common.NormalizedRoomName, username common.NormalizedUsername) error
+
+	// GetAuthAttempt returns the authentication attempt record with the specified kind and subject, if any.
+	GetAuthAttempt(ctx context.Context, kind AuthAttemptKind, subject string) (record AuthAttemptRecord, has bool, err error)
+
+	// IncrementAuthFailures increments the failure counter for the specified kind and subject and returns the new count.
+	// If the record's window started before windowStart, the counter is reset and a new window is started at the current time.
+	IncrementAuthFailures(ctx context.Context, kind AuthAttemptKind, subject string, windowStart time.Time) (int, error)
+
+	// LockAuthSubject locks out the specified kind and subject until the specified time.
+	// If no record exists, this is a no-op.
+	LockAuthSubject(ctx context.Context, kind AuthAttemptKind, subject string, until time.Time) error
+
+	// DeleteAuthAttempt deletes the authentication attempt record with the specified kind and subject.
+	// If the record does not exist, this is a no-op.
+	DeleteAuthAttempt(ctx context.Context, kind AuthAttemptKind, subject string) error
+
+	// DeleteStaleAuthAttempts deletes authentication attempt records whose window started before the specified time
+	// and that are no longer locked out.
+	// Returns the number of records deleted.
+	DeleteStaleAuthAttempts(ctx context.Context, before time.Time) (int64, error)
+
+	// CreateInviteCode creates a new invite code record for the specified room.
+	// If the code already exists, returns ErrRecordExists.
+	CreateInviteCode(ctx context.Context, room common.NormalizedRoomName, code string) (InviteCodeRecord, error)
+
+	// GetInviteCodesByRoom returns all unused invite code records for the specified room.
+	GetInviteCodesByRoom(ctx context.Context, room common.NormalizedRoomName) ([]InviteCodeRecord, error)
+
+	// DeleteInviteCode deletes the invite code with the specified room and code.
+	// Returns whether an invite code was deleted.
+	DeleteInviteCode(ctx context.Context, room common.NormalizedRoomName, code string) (bool, error)
+
+	// CreateAccountWithInviteCode redeems the specified invite code and creates a new account record in one transaction.
+	// If the invite code does not exist for the room, returns ErrInvalidInviteCode.
+	// If the account already exists, returns ErrRecordExists and the invite code is not redeemed.
+	CreateAccountWithInviteCode(
+		ctx context.Context,
+		room common.NormalizedRoomName,
+		username common.NormalizedUsername,
+		passwordHash string,
+		code string,
+	) error
+
+	// CreateRoomStat records a snapshot of the usage of the room with the specified name.
+	// If a snapshot already exists for the room at the same second, it is replaced.
+	CreateRoomStat(ctx context.Context, record RoomStatRecord) error
+
+	// GetRoomStats returns the usage snapshots of the room with the specified name taken in [from, to), oldest first.
+	GetRoomStats(ctx context.Context, room common.NormalizedRoomName, from time.Time, to time.Time) ([]RoomStatRecord, error)
+
+	// DeleteRoomStatsBefore deletes usage snapshots of all rooms taken before the specified time.
+	// Returns the number of snapshots deleted.
+	DeleteRoomStatsBefore(ctx context.Context, before time.Time) (int64, error)
 }
 
-func (s *Storage) Close() error {
-	return s.Db.Close()
-}
+// sqlStorage implements Storage on top of database/sql.
+// The SQLite and PostgreSQL implementations only differ in how they are opened and in their placeholder syntax.
+// Queries are written with "?" placeholders, and converted with rebind.
+type sqlStorage struct {
+	db       *sql.DB
+	migrator *common.Migrator
 
-// NewStorage creates a new storage instance using the specified DB path.
-//
-//goland:noinspection SqlNoDataSourceInspection
-func NewStorage(path string) (*Storage, error) {
-	if path == "" {
-		panic("path is required for storage")
-	}
+	// Whether the database is SQLite, which supports backups and integrity checks.
+	isSqlite bool
 
-	// Resolve full path.
-	var err error
-	path, err = filepath.Abs(path)
-	if err != nil {
-		return nil, fmt.Errorf("failed to resolve storage path: %w", err)
-	}
+	// Converts a query's "?" placeholders to the syntax of the database.
+	rebind func(query string) string
 
-	db, err := sql.Open("sqlite", path)
-	if err != nil {
-		return nil, err
-	}
-	defer func() {
-		if err != nil {
-			_ = db.Close()
-		}
-	}()
+	// Reports whether an error returned by the database is a unique constraint violation.
+	isDuplicate func(err error) bool
+}
 
-	db.SetMaxOpenConns(1)
-	db.SetMaxIdleConns(1)
+var _ Storage = (*sqlStorage)(nil)
 
-	err = common.DoMigrations(db, []common.Migration{
-		&migration.M20260208InitialSchema{},
-	})
-	if err != nil {
-		return nil, fmt.Errorf(`failed to apply server database migrations: %w`, err)
-	}
+func (s *sqlStorage) Close() error {
+	return s.db.Close()
+}
 
-	// Set important pragmas.
-	startupStmts := []string{
-		`PRAGMA foreign_keys = ON`,
-		`PRAGMA journal_mode = WAL`,
-		`PRAGMA synchronous = NORMAL`,
-	}
-	for _, stmt := range startupStmts {
-		_, err = db.Exec(stmt)
-		if err != nil {
-			return nil, fmt.Errorf("failed to run startup statement: %q: %w", stmt, err)
-		}
-	}
+func (s *sqlStorage) GetMigrationStatus(ctx context.Context) ([]common.MigrationStatus, error) {
+	return s.migrator.Status(ctx)
+}
 
-	// Check database integrity.
-	icRes := db.QueryRow(`PRAGMA integrity_check`)
-	var icVal string
-	err = icRes.Scan(&icVal)
-	if err != nil {
-		return nil, fmt.Errorf("failed to check database integrity: %w", err)
+func (s *sqlStorage) Backup(ctx context.Context, dest string) error {
+	if !s.isSqlite {
+		return ErrNotSupported
 	}
+	return common.BackupSqliteTo(ctx, s.db, dest)
+}
 
-	if icVal != "ok" {
-		return nil, fmt.Errorf("database integrity check failed: %s", icVal)
+func (s *sqlStorage) CheckIntegrity(ctx context.Context) ([]string, error) {
+	if !s.isSqlite {
+		return nil, ErrNotSupported
 	}
+	return common.CheckSqliteIntegrity(ctx, s.db)
+}
 
-	return &Storage{
-		Db: db,
-	}, nil
+func (s *sqlStorage) exec(ctx context.Context, query string, args ...any) (sql.Result, error) {
+	return s.db.ExecContext(ctx, s.rebind(query), args...)
 }
 
-// CreateRoom creates a new room record.
+func (s *sqlStorage) query(ctx context.Context, query string, args ...any) (*sql.Rows, error) {
+	return s.db.QueryContext(ctx, s.rebind(query), args...)
+}
+
+func (s *sqlStorage) queryRow(ctx context.Context, query string, args ...any) *sql.Row {
+	return s.db.QueryRowContext(ctx, s.rebind(query), args...)
+}
+
+// CreateRoom creates a new room record with the specified metadata.
 // If the room already exists, returns ErrRecordExists.
-func (s *Storage) CreateRoom(ctx context.Context, room common.NormalizedRoomName) error {
-	_, err := s.Db.ExecContext(ctx, `insert into room (name) values (?)`, room.String())
+func (s *sqlStorage) CreateRoom(ctx context.Context, room common.NormalizedRoomName, description string, listed bool) error {
+	_, err := s.exec(ctx, `insert into room (name, description, listed) values (?, ?, ?)`,
+		room.String(),
+		description,
+		listed,
+	)
 	if err != nil {
-		if strings.Contains(err.Error(), "constraint") {
+		if s.isDuplicate(err) {
 			return ErrRecordExists
 		}
 
@@ -106,14 +256,14 @@ func (s *Storage) CreateRoom(ctx context.Context, room common.NormalizedRoomName
 
 // GetRoomByName returns the room record with the specified name, if any.
 // If the room does not exist, `has` will be false.
-func (s *Storage) GetRoomByName(ctx context.Context, room common.NormalizedRoomName) (record RoomRecord, has bool, err error) {
-	row := s.Db.QueryRowContext(ctx, `select * from room where name = ?`, room.String())
+func (s *sqlStorage) GetRoomByName(ctx context.Context, room common.NormalizedRoomName) (record RoomRecord, has bool, err error) {
+	row := s.queryRow(ctx, `select * from room where name = ?`, room.String())
 	return ScanRoomRecord(row)
 }
 
 // GetRooms returns all room records.
-func (s *Storage) GetRooms(ctx context.Context) ([]RoomRecord, error) {
-	rows, err := s.Db.QueryContext(ctx, `select * from room`)
+func (s *sqlStorage) GetRooms(ctx context.Context) ([]RoomRecord, error) {
+	rows, err := s.query(ctx, `select * from room`)
 	if err != nil {
 		return nil, fmt.Errorf(`failed to query rooms: %w`, err)
 	}
@@ -136,14 +286,76 @@ func (s *Storage) GetRooms(ctx context.Context) ([]RoomRecord, error) {
 	return records, nil
 }
 
+// UpdateRoomLimits updates the capacity and concurrency limits of the room with the specified name.
+// A limit of 0 means unlimited.
+// If the room does not exist, this is a no-op.
+func (s *sqlStorage) UpdateRoomLimits(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	maxClients int,
+	maxProxyStreamsPerClient int,
+) error {
+	_, err := s.exec(ctx, `update room set max_clients = ?, max_proxy_streams_per_client = ? where name = ?`,
+		maxClients,
+		maxProxyStreamsPerClient,
+		room.String(),
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to update limits for room %q: %w`, room.String(), err)
+	}
+	return nil
+}
+
+// UpdateRoomDirCacheTtl updates how long directory listings proxied in the room with the specified name are cached.
+// A TTL of 0 disables caching.
+// If the room does not exist, this is a no-op.
+func (s *sqlStorage) UpdateRoomDirCacheTtl(ctx context.Context, room common.NormalizedRoomName, ttl time.Duration) error {
+	_, err := s.exec(ctx, `update room set dir_cache_ttl_ms = ? where name = ?`,
+		ttl.Milliseconds(),
+		room.String(),
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to update directory cache TTL for room %q: %w`, room.String(), err)
+	}
+	return nil
+}
+
+// UpdateRoomMetadata updates the description and listed flag of the room with the specified name.
+// If the room does not exist, this is a no-op.
+func (s *sqlStorage) UpdateRoomMetadata(ctx context.Context, room common.NormalizedRoomName, description string, listed bool) error {
+	_, err := s.exec(ctx, `update room set description = ?, listed = ? where name = ?`,
+		description,
+		listed,
+		room.String(),
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to update metadata for room %q: %w`, room.String(), err)
+	}
+	return nil
+}
+
+// UpdateRoomMotd updates the message of the day of the room with the specified name.
+// An empty message removes it.
+// If the room does not exist, this is a no-op.
+func (s *sqlStorage) UpdateRoomMotd(ctx context.Context, room common.NormalizedRoomName, motd string) error {
+	_, err := s.exec(ctx, `update room set motd = ? where name = ?`,
+		motd,
+		room.String(),
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to update message of the day for room %q: %w`, room.String(), err)
+	}
+	return nil
+}
+
 // DeleteRoomByName will delete the room record with the specified name.
 // Any accounts associated with it will also be deleted.
 // If the room does not exist, this is a no-op.
-func (s *Storage) DeleteRoomByName(
+func (s *sqlStorage) DeleteRoomByName(
 	ctx context.Context,
 	room common.NormalizedRoomName,
 ) error {
-	_, err := s.Db.ExecContext(ctx, `delete from room where name = ?`, room.String())
+	_, err := s.exec(ctx, `delete from room where name = ?`, room.String())
 	if err != nil {
 		return fmt.Errorf(`failed to delete room with name %q: %w`, room.String(), err)
 	}
@@ -151,19 +363,21 @@ func (s *Storage) DeleteRoomByName(
 }
 
 // CreateAccount creates a new account record.
-func (s *Storage) CreateAccount(
+func (s *sqlStorage) CreateAccount(
 	ctx context.Context,
 	room common.NormalizedRoomName,
 	username common.NormalizedUsername,
 	passwordHash string,
+	isGuest bool,
 ) error {
-	_, err := s.Db.ExecContext(ctx, `insert into account (room, username, password_hash) values (?, ?, ?)`,
+	_, err := s.exec(ctx, `insert into account (room, username, password_hash, is_guest) values (?, ?, ?, ?)`,
 		room.String(),
 		username.String(),
 		passwordHash,
+		isGuest,
 	)
 	if err != nil {
-		if strings.Contains(err.Error(), "constraint") {
+		if s.isDuplicate(err) {
 			return ErrRecordExists
 		}
 
@@ -174,12 +388,12 @@ func (s *Storage) CreateAccount(
 }
 
 // GetAccountByRoomAndUsername returns the account record with the specified room and username, if any.
-func (s *Storage) GetAccountByRoomAndUsername(
+func (s *sqlStorage) GetAccountByRoomAndUsername(
 	ctx context.Context,
 	room common.NormalizedRoomName,
 	username common.NormalizedUsername,
 ) (record AccountRecord, has bool, err error) {
-	row := s.Db.QueryRowContext(ctx, `select * from account where room = ? and username = ?`,
+	row := s.queryRow(ctx, `select * from account where room = ? and username = ?`,
 		room.String(),
 		username.String(),
 	)
@@ -187,8 +401,8 @@ func (s *Storage) GetAccountByRoomAndUsername(
 }
 
 // GetAccountsByRoom returns all account records for the specified room.
-func (s *Storage) GetAccountsByRoom(ctx context.Context, room common.NormalizedRoomName) ([]AccountRecord, error) {
-	rows, err := s.Db.QueryContext(ctx, `select * from account where room = ?`, room.String())
+func (s *sqlStorage) GetAccountsByRoom(ctx context.Context, room common.NormalizedRoomName) ([]AccountRecord, error) {
+	rows, err := s.query(ctx, `select * from account where room = ?`, room.String())
 	if err != nil {
 		return nil, fmt.Errorf(`failed to query accounts for room %q: %w`, room.String(), err)
 	}
@@ -210,15 +424,70 @@ func (s *Storage) GetAccountsByRoom(ctx context.Context, room common.NormalizedR
 	return records, nil
 }
 
+// GetAccountsByRoomPage returns up to limit account records for the specified room, ordered by username, starting
+// after the username in cursor.
+// Specify an empty cursor to start from the beginning.
+// The returned cursor can be passed to get the next page, and is empty when there are no more records.
+func (s *sqlStorage) GetAccountsByRoomPage(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	cursor string,
+	limit int,
+) ([]AccountRecord, string, error) {
+	if limit <= 0 {
+		panic("limit must be positive")
+	}
+
+	rows, err := s.query(ctx, `select * from account where room = ? and username > ? order by username limit ?`,
+		room.String(),
+		cursor,
+		limit,
+	)
+	if err != nil {
+		return nil, "", fmt.Errorf(`failed to query accounts for room %q: %w`, room.String(), err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]AccountRecord, 0, limit)
+	for rows.Next() {
+		var record AccountRecord
+		record, _, err = ScanAccountRecord(rows)
+		if err != nil {
+			return nil, "", err
+		}
+
+		records = append(records, record)
+	}
+
+	var next string
+	if len(records) == limit {
+		next = records[len(records)-1].Username.String()
+	}
+
+	return records, next, nil
+}
+
+// CountAccountsByRoom returns the number of accounts in the specified room.
+func (s *sqlStorage) CountAccountsByRoom(ctx context.Context, room common.NormalizedRoomName) (int, error) {
+	var count int
+	err := s.queryRow(ctx, `select count(*) from account where room = ?`, room.String()).Scan(&count)
+	if err != nil {
+		return 0, fmt.Errorf(`failed to count accounts for room %q: %w`, room.String(), err)
+	}
+	return count, nil
+}
+
 // UpdateAccountPasswordHash updates the password hash of the account with the specified room and username.
 // If the account does not exist, this is a no-op.
-func (s *Storage) UpdateAccountPasswordHash(
+func (s *sqlStorage) UpdateAccountPasswordHash(
 	ctx context.Context,
 	room common.NormalizedRoomName,
 	username common.NormalizedUsername,
 	passwordHash string,
 ) error {
-	_, err := s.Db.ExecContext(ctx, `update account set password_hash = ? where room = ? and username = ?`,
+	_, err := s.exec(ctx, `update account set password_hash = ? where room = ? and username = ?`,
 		passwordHash,
 		room.String(),
 		username.String(),
@@ -233,14 +502,37 @@ func (s *Storage) UpdateAccountPasswordHash(
 	return nil
 }
 
+// UpdateAccountIsGuest updates whether the account with the specified room and username is a guest account.
+// If the account does not exist, this is a no-op.
+func (s *sqlStorage) UpdateAccountIsGuest(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	username common.NormalizedUsername,
+	isGuest bool,
+) error {
+	_, err := s.exec(ctx, `update account set is_guest = ? where room = ? and username = ?`,
+		isGuest,
+		room.String(),
+		username.String(),
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to update guest flag for account with room %q and username %q: %w`,
+			room.String(),
+			username.String(),
+			err,
+		)
+	}
+	return nil
+}
+
 // DeleteAccountByRoomAndUsername deletes the account with the specified room and username.
 // If the account does not exist, this is a no-op.
-func (s *Storage) DeleteAccountByRoomAndUsername(
+func (s *sqlStorage) DeleteAccountByRoomAndUsername(
 	ctx context.Context,
 	room common.NormalizedRoomName,
 	username common.NormalizedUsername,
 ) error {
-	_, err := s.Db.ExecContext(ctx, `delete from account where room = ? and username = ?`,
+	_, err := s.exec(ctx, `delete from account where room = ? and username = ?`,
 		room.String(),
 		username.String(),
 	)
@@ -253,3 +545,291 @@ func (s *Storage) DeleteAccountByRoomAndUsername(
 	}
 	return nil
 }
+
+// GetAuthAttempt returns the authentication attempt record with the specified kind and subject, if any.
+func (s *sqlStorage) GetAuthAttempt(
+	ctx context.Context,
+	kind AuthAttemptKind,
+	subject string,
+) (record AuthAttemptRecord, has bool, err error) {
+	row := s.queryRow(ctx, `select * from auth_attempt where kind = ? and subject = ?`,
+		string(kind),
+		subject,
+	)
+	return ScanAuthAttemptRecord(row)
+}
+
+// IncrementAuthFailures increments the failure counter for the specified kind and subject and returns the new count.
+// If the record's window started before windowStart, the counter is reset and a new window is started at the current time.
+func (s *sqlStorage) IncrementAuthFailures(
+	ctx context.Context,
+	kind AuthAttemptKind,
+	subject string,
+	windowStart time.Time,
+) (int, error) {
+	now := time.Now().Unix()
+	cutoff := windowStart.Unix()
+
+	row := s.queryRow(ctx, `
+insert into auth_attempt (kind, subject, failures, window_start_ts) values (?, ?, 1, ?)
+on conflict (kind, subject) do update set
+	failures = case when auth_attempt.window_start_ts < ? then 1 else auth_attempt.failures + 1 end,
+	window_start_ts = case when auth_attempt.window_start_ts < ? then ? else auth_attempt.window_start_ts end
+returning failures
+	`,
+		string(kind),
+		subject,
+		now,
+		cutoff,
+		cutoff,
+		now,
+	)
+
+	var failures int
+	if err := row.Scan(&failures); err != nil {
+		return 0, fmt.Errorf(`failed to increment auth failures for %s %q: %w`, kind, subject, err)
+	}
+
+	return failures, nil
+}
+
+// LockAuthSubject locks out the specified kind and subject until the specified time.
+// If no record exists, this is a no-op.
+func (s *sqlStorage) LockAuthSubject(
+	ctx context.Context,
+	kind AuthAttemptKind,
+	subject string,
+	until time.Time,
+) error {
+	_, err := s.exec(ctx, `update auth_attempt set locked_until_ts = ? where kind = ? and subject = ?`,
+		until.Unix(),
+		string(kind),
+		subject,
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to lock %s %q: %w`, kind, subject, err)
+	}
+	return nil
+}
+
+// DeleteAuthAttempt deletes the authentication attempt record with the specified kind and subject.
+// If the record does not exist, this is a no-op.
+func (s *sqlStorage) DeleteAuthAttempt(
+	ctx context.Context,
+	kind AuthAttemptKind,
+	subject string,
+) error {
+	_, err := s.exec(ctx, `delete from auth_attempt where kind = ? and subject = ?`,
+		string(kind),
+		subject,
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to delete auth attempt record for %s %q: %w`, kind, subject, err)
+	}
+	return nil
+}
+
+// DeleteStaleAuthAttempts deletes authentication attempt records whose window started before the specified time
+// and that are no longer locked out.
+// Returns the number of records deleted.
+func (s *sqlStorage) DeleteStaleAuthAttempts(ctx context.Context, before time.Time) (int64, error) {
+	res, err := s.exec(ctx, `delete from auth_attempt where window_start_ts < ? and locked_until_ts < ?`,
+		before.Unix(),
+		time.Now().Unix(),
+	)
+	if err != nil {
+		return 0, fmt.Errorf(`failed to delete stale auth attempt records: %w`, err)
+	}
+	num, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(`failed to get number of deleted stale auth attempt records: %w`, err)
+	}
+	return num, nil
+}
+
+// CreateInviteCode creates a new invite code record for the specified room.
+// If the code already exists, returns ErrRecordExists.
+func (s *sqlStorage) CreateInviteCode(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	code string,
+) (InviteCodeRecord, error) {
+	row := s.queryRow(ctx, `insert into invite_code (code, room) values (?, ?) returning *`,
+		code,
+		room.String(),
+	)
+	record, _, err := ScanInviteCodeRecord(row)
+	if err != nil {
+		if s.isDuplicate(err) {
+			return InviteCodeRecord{}, ErrRecordExists
+		}
+
+		return InviteCodeRecord{}, fmt.Errorf(`failed to create invite code for room %q: %w`, room.String(), err)
+	}
+	return record, nil
+}
+
+// GetInviteCodesByRoom returns all unused invite code records for the specified room.
+func (s *sqlStorage) GetInviteCodesByRoom(ctx context.Context, room common.NormalizedRoomName) ([]InviteCodeRecord, error) {
+	rows, err := s.query(ctx, `select * from invite_code where room = ? order by created_ts`, room.String())
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query invite codes for room %q: %w`, room.String(), err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]InviteCodeRecord, 0)
+	for rows.Next() {
+		var record InviteCodeRecord
+		record, _, err = ScanInviteCodeRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// DeleteInviteCode deletes the invite code with the specified room and code.
+// Returns whether an invite code was deleted.
+func (s *sqlStorage) DeleteInviteCode(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	code string,
+) (bool, error) {
+	res, err := s.exec(ctx, `delete from invite_code where room = ? and code = ?`,
+		room.String(),
+		code,
+	)
+	if err != nil {
+		return false, fmt.Errorf(`failed to delete invite code for room %q: %w`, room.String(), err)
+	}
+	num, err := res.RowsAffected()
+	if err != nil {
+		return false, fmt.Errorf(`failed to get number of deleted invite codes for room %q: %w`, room.String(), err)
+	}
+	return num > 0, nil
+}
+
+// CreateAccountWithInviteCode redeems the specified invite code and creates a new account record in one transaction.
+// If the invite code does not exist for the room, returns ErrInvalidInviteCode.
+// If the account already exists, returns ErrRecordExists and the invite code is not redeemed.
+func (s *sqlStorage) CreateAccountWithInviteCode(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	username common.NormalizedUsername,
+	passwordHash string,
+	code string,
+) error {
+	tx, err := s.db.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf(`failed to begin transaction: %w`, err)
+	}
+	defer func() {
+		_ = tx.Rollback()
+	}()
+
+	res, err := tx.ExecContext(ctx, s.rebind(`delete from invite_code where room = ? and code = ?`),
+		room.String(),
+		code,
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to redeem invite code for room %q: %w`, room.String(), err)
+	}
+	num, err := res.RowsAffected()
+	if err != nil {
+		return fmt.Errorf(`failed to get number of redeemed invite codes for room %q: %w`, room.String(), err)
+	}
+	if num == 0 {
+		return ErrInvalidInviteCode
+	}
+
+	_, err = tx.ExecContext(ctx, s.rebind(`insert into account (room, username, password_hash) values (?, ?, ?)`),
+		room.String(),
+		username.String(),
+		passwordHash,
+	)
+	if err != nil {
+		if s.isDuplicate(err) {
+			return ErrRecordExists
+		}
+
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf(`failed to commit transaction: %w`, err)
+	}
+
+	return nil
+}
+
+// CreateRoomStat records a snapshot of the usage of the room with the specified name.
+// If a snapshot already exists for the room at the same second, it is replaced.
+func (s *sqlStorage) CreateRoomStat(ctx context.Context, record RoomStatRecord) error {
+	_, err := s.exec(ctx, `
+insert into room_stat (room, ts, online_clients, relayed_bytes) values (?, ?, ?, ?)
+on conflict (room, ts) do update set
+	online_clients = excluded.online_clients,
+	relayed_bytes = excluded.relayed_bytes
+	`,
+		record.Room.String(),
+		record.Ts.Unix(),
+		record.OnlineClients,
+		record.RelayedBytes,
+	)
+	if err != nil {
+		return fmt.Errorf(`failed to record stats for room %q: %w`, record.Room.String(), err)
+	}
+	return nil
+}
+
+// GetRoomStats returns the usage snapshots of the room with the specified name taken in [from, to), oldest first.
+func (s *sqlStorage) GetRoomStats(
+	ctx context.Context,
+	room common.NormalizedRoomName,
+	from time.Time,
+	to time.Time,
+) ([]RoomStatRecord, error) {
+	rows, err := s.query(ctx, `select * from room_stat where room = ? and ts >= ? and ts < ? order by ts`,
+		room.String(),
+		from.Unix(),
+		to.Unix(),
+	)
+	if err != nil {
+		return nil, fmt.Errorf(`failed to query stats for room %q: %w`, room.String(), err)
+	}
+	defer func() {
+		_ = rows.Close()
+	}()
+
+	records := make([]RoomStatRecord, 0)
+	for rows.Next() {
+		var record RoomStatRecord
+		record, _, err = ScanRoomStatRecord(rows)
+		if err != nil {
+			return nil, err
+		}
+
+		records = append(records, record)
+	}
+
+	return records, nil
+}
+
+// DeleteRoomStatsBefore deletes usage snapshots of all rooms taken before the specified time.
+// Returns the number of snapshots deleted.
+func (s *sqlStorage) DeleteRoomStatsBefore(ctx context.Context, before time.Time) (int64, error) {
+	res, err := s.exec(ctx, `delete from room_stat where ts < ?`, before.Unix())
+	if err != nil {
+		return 0, fmt.Errorf(`failed to delete old room stats: %w`, err)
+	}
+	num, err := res.RowsAffected()
+	if err != nil {
+		return 0, fmt.Errorf(`failed to get number of deleted room stats: %w`, err)
+	}
+	return num, nil
+}
@@ -0,0 +1,180 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"friendnet.org/common"
+)
+
+func TestGetAccountsByRoomPage(t *testing.T) {
+	ctx := context.Background()
+
+	st, err := NewSqliteStorage(slog.New(slog.DiscardHandler), filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	defer func() {
+		_ = st.Close()
+	}()
+
+	room := common.UncheckedCreateNormalizedRoomName("room")
+	if err = st.CreateRoom(ctx, room, "", true); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 5; i++ {
+		username := common.UncheckedCreateNormalizedUsername(fmt.Sprintf("user%d", i))
+		if err = st.CreateAccount(ctx, room, username, "hash", false); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	var got []string
+	cursor := ""
+	pages := 0
+	for {
+		records, next, err := st.GetAccountsByRoomPage(ctx, room, cursor, 2)
+		if err != nil {
+			t.Fatalf("failed to get page: %v", err)
+		}
+		pages++
+		for _, record := range records {
+			got = append(got, record.Username.String())
+		}
+		if next == "" {
+			break
+		}
+		cursor = next
+	}
+
+	if want := "[user0 user1 user2 user3 user4]"; fmt.Sprint(got) != want {
+		t.Fatalf("expected %s, got %v", want, got)
+	}
+	if pages != 3 {
+		t.Fatalf("expected 3 pages, got %d", pages)
+	}
+
+	count, err := st.CountAccountsByRoom(ctx, room)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 5 {
+		t.Fatalf("expected 5 accounts, got %d", count)
+	}
+}
+
+func TestGetRoomsReturnsMetadata(t *testing.T) {
+	ctx := context.Background()
+
+	st, err := NewSqliteStorage(slog.New(slog.DiscardHandler), filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	defer func() {
+		_ = st.Close()
+	}()
+
+	public := common.UncheckedCreateNormalizedRoomName("public")
+	hidden := common.UncheckedCreateNormalizedRoomName("hidden")
+	if err = st.CreateRoom(ctx, public, "A public room", true); err != nil {
+		t.Fatal(err)
+	}
+	if err = st.CreateRoom(ctx, hidden, "", false); err != nil {
+		t.Fatal(err)
+	}
+	if err = st.UpdateRoomMetadata(ctx, hidden, "Now described", false); err != nil {
+		t.Fatal(err)
+	}
+	if err = st.UpdateRoomMotd(ctx, public, "Welcome!"); err != nil {
+		t.Fatal(err)
+	}
+
+	records, err := st.GetRooms(ctx)
+	if err != nil {
+		t.Fatalf("failed to get rooms: %v", err)
+	}
+	if len(records) != 2 {
+		t.Fatalf("expected 2 rooms, got %d", len(records))
+	}
+
+	byName := make(map[string]RoomRecord)
+	for _, record := range records {
+		byName[record.Name.String()] = record
+	}
+	if r := byName["public"]; r.Description != "A public room" || !r.Listed || r.Motd != "Welcome!" || r.CreatedTs.IsZero() {
+		t.Fatalf("unexpected public room record: %+v", r)
+	}
+	if r := byName["hidden"]; r.Description != "Now described" || r.Listed || r.Motd != "" {
+		t.Fatalf("unexpected hidden room record: %+v", r)
+	}
+}
+
+func TestRoomStatsRange(t *testing.T) {
+	ctx := context.Background()
+
+	st, err := NewSqliteStorage(slog.New(slog.DiscardHandler), filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	defer func() {
+		_ = st.Close()
+	}()
+
+	room := common.UncheckedCreateNormalizedRoomName("room")
+	if err = st.CreateRoom(ctx, room, "", true); err != nil {
+		t.Fatal(err)
+	}
+
+	base := time.Unix(1_000_000, 0)
+	for i := 0; i < 4; i++ {
+		err = st.CreateRoomStat(ctx, RoomStatRecord{
+			Room:          room,
+			Ts:            base.Add(time.Duration(i) * time.Minute),
+			OnlineClients: i,
+			RelayedBytes:  int64(i * 100),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	records, err := st.GetRoomStats(ctx, room, base.Add(time.Minute), base.Add(3*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].OnlineClients != 1 || records[1].RelayedBytes != 200 {
+		t.Fatalf("expected snapshots 1 and 2, got %+v", records)
+	}
+
+	deleted, err := st.DeleteRoomStatsBefore(ctx, base.Add(2*time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 2 {
+		t.Fatalf("expected 2 deleted snapshots, got %d", deleted)
+	}
+
+	records, err = st.GetRoomStats(ctx, room, time.Unix(0, 0), base.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 2 || records[0].OnlineClients != 2 {
+		t.Fatalf("expected snapshots 2 and 3, got %+v", records)
+	}
+}
+
+func TestSqliteDuplicateRecords(t *testing.T) {
+	st, err := NewSqliteStorage(slog.New(slog.DiscardHandler), filepath.Join(t.TempDir(), "test.db"))
+	if err != nil {
+		t.Fatalf("failed to create storage: %v", err)
+	}
+	defer func() {
+		_ = st.Close()
+	}()
+
+	testDuplicateRecords(t, st, common.UncheckedCreateNormalizedRoomName("room"))
+}
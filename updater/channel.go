@@ -0,0 +1,47 @@
+package updater
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// ErrInvalidChannel is returned when trying to use an unknown release channel.
+var ErrInvalidChannel = errors.New("invalid release channel")
+
+// Channel is a release channel that updates are checked on.
+type Channel string
+
+const (
+	// ChannelStable is the channel for regular releases.
+	ChannelStable Channel = "stable"
+
+	// ChannelBeta is the channel for pre-releases, which get new features first but may be less reliable.
+	ChannelBeta Channel = "beta"
+)
+
+// ParseChannel parses a channel name.
+// An empty name is ChannelStable.
+// Returns an error wrapping ErrInvalidChannel if the name is unknown.
+func ParseChannel(name string) (Channel, error) {
+	switch Channel(name) {
+	case "", ChannelStable:
+		return ChannelStable, nil
+	case ChannelBeta:
+		return ChannelBeta, nil
+	default:
+		return "", fmt.Errorf(`%w: %q`, ErrInvalidChannel, name)
+	}
+}
+
+// ChannelUrl returns the base URL that updates on a channel are checked at.
+//
+// The stable channel uses baseUrl itself, so that clients from before channels existed keep working.
+// Other channels use a subdirectory with the channel's name, such as https://friendnet.org/updater/client/beta.
+func ChannelUrl(baseUrl string, channel Channel) string {
+	baseUrl = strings.TrimSuffix(baseUrl, "/")
+	if channel == ChannelStable {
+		return baseUrl
+	}
+	return baseUrl + "/" + string(channel)
+}
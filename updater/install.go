@@ -0,0 +1,168 @@
+package updater
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"runtime"
+)
+
+// MaxBinarySize is the maximum size of an update binary, in bytes.
+const MaxBinarySize = 512 * 1024 * 1024
+
+// stagedSuffix is appended to the executable's path to get the path a downloaded update is staged at.
+const stagedSuffix = ".update"
+
+// oldSuffix is appended to the executable's path to get the path the previous binary is moved to when installing an
+// update.
+const oldSuffix = ".old"
+
+// ErrNoAsset is returned when an update has no binary for the current platform.
+var ErrNoAsset = errors.New("updater: update has no binary for this platform")
+
+// ErrChecksumMismatch is returned when a downloaded binary does not match the checksum in the release manifest.
+var ErrChecksumMismatch = errors.New("updater: downloaded binary does not match its checksum")
+
+// ExecutablePath returns the path of the running executable, with symbolic links resolved.
+// This is the path that updates replace.
+func ExecutablePath() (string, error) {
+	exePath, err := os.Executable()
+	if err != nil {
+		return "", err
+	}
+	return filepath.EvalSymlinks(exePath)
+}
+
+// StageUpdate downloads the binary for the current platform from an update and stages it next to the executable at
+// exePath, verifying it against the checksum in the update's manifest.
+// The update must come from CheckUpdate or UpdateChecker, which verify the manifest's signature.
+//
+// The executable is not replaced until InstallStagedUpdate is called, typically when the program exits, so the update
+// takes effect on restart. Staging another update replaces the staged one.
+//
+// Returns ErrNoAsset if the update has no binary for the current platform.
+// Returns ErrChecksumMismatch if the downloaded binary does not match its checksum.
+func StageUpdate(ctx context.Context, update UpdateInfo, exePath string) error {
+	asset, has := update.AssetFor(runtime.GOOS, runtime.GOARCH)
+	if !has {
+		return ErrNoAsset
+	}
+	if asset.Size <= 0 || asset.Size > MaxBinarySize {
+		return fmt.Errorf(`updater: invalid binary size %d (max: %d)`, asset.Size, MaxBinarySize)
+	}
+	sum, err := hex.DecodeString(asset.Sha256)
+	if err != nil || len(sum) != sha256.Size {
+		return fmt.Errorf(`updater: invalid binary checksum %q`, asset.Sha256)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, "GET", asset.Url, nil)
+	if err != nil {
+		return fmt.Errorf("GET %q: %w", asset.Url, err)
+	}
+	res, err := http.DefaultClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("GET %q: %w", asset.Url, err)
+	}
+	defer func() {
+		_ = res.Body.Close()
+	}()
+	if res.StatusCode != http.StatusOK {
+		return fmt.Errorf("GET %q: server returned status %d %s", asset.Url, res.StatusCode, res.Status)
+	}
+
+	// The binary is written to a temporary file in the same directory first so that a partial download is never
+	// staged, and so that it can be renamed into place.
+	tmp, err := os.CreateTemp(filepath.Dir(exePath), filepath.Base(exePath)+".download-*")
+	if err != nil {
+		return fmt.Errorf(`updater: failed to create temporary file: %w`, err)
+	}
+	tmpPath := tmp.Name()
+	success := false
+	defer func() {
+		if !success {
+			_ = tmp.Close()
+			_ = os.Remove(tmpPath)
+		}
+	}()
+
+	hash := sha256.New()
+	n, err := io.Copy(io.MultiWriter(tmp, hash), io.LimitReader(res.Body, asset.Size+1))
+	if err != nil {
+		return fmt.Errorf("GET %q: failed to read body: %w", asset.Url, err)
+	}
+	if n != asset.Size {
+		return fmt.Errorf(`%w: expected %d bytes, got %d`, ErrChecksumMismatch, asset.Size, n)
+	}
+	if !bytes.Equal(hash.Sum(nil), sum) {
+		return ErrChecksumMismatch
+	}
+
+	if err = tmp.Chmod(0755); err != nil {
+		return fmt.Errorf(`updater: failed to make binary executable: %w`, err)
+	}
+	if err = tmp.Close(); err != nil {
+		return fmt.Errorf(`updater: failed to write binary: %w`, err)
+	}
+	if err = os.Rename(tmpPath, exePath+stagedSuffix); err != nil {
+		return fmt.Errorf(`updater: failed to stage binary: %w`, err)
+	}
+
+	success = true
+	return nil
+}
+
+// HasStagedUpdate returns whether an update is staged to replace the executable at exePath.
+func HasStagedUpdate(exePath string) bool {
+	_, err := os.Stat(exePath + stagedSuffix)
+	return err == nil
+}
+
+// InstallStagedUpdate replaces the executable at exePath with the update staged by StageUpdate, if there is one.
+// The previous binary is kept next to it until RemoveOldBinary is called.
+// It is safe to call while the executable is running, including on Windows; the running program is unaffected, and
+// the update takes effect the next time it starts.
+//
+// Returns false if there was no staged update.
+func InstallStagedUpdate(exePath string) (bool, error) {
+	stagedPath := exePath + stagedSuffix
+	if _, err := os.Stat(stagedPath); err != nil {
+		if errors.Is(err, fs.ErrNotExist) {
+			return false, nil
+		}
+		return false, err
+	}
+
+	oldPath := exePath + oldSuffix
+	if err := os.Remove(oldPath); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return false, fmt.Errorf(`updater: failed to remove previous binary: %w`, err)
+	}
+	if err := os.Rename(exePath, oldPath); err != nil {
+		return false, fmt.Errorf(`updater: failed to move current binary: %w`, err)
+	}
+	if err := os.Rename(stagedPath, exePath); err != nil {
+		// Put the current binary back so that the program can still start.
+		_ = os.Rename(oldPath, exePath)
+		return false, fmt.Errorf(`updater: failed to install staged binary: %w`, err)
+	}
+
+	return true, nil
+}
+
+// RemoveOldBinary removes the previous binary left next to the executable at exePath by InstallStagedUpdate, if any.
+// It should be called when the program starts, since the previous binary cannot be removed while it is running on
+// Windows.
+func RemoveOldBinary(exePath string) error {
+	err := os.Remove(exePath + oldSuffix)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+	return nil
+}
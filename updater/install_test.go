@@ -0,0 +1,99 @@
+package updater
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"testing"
+)
+
+func testUpdate(url string, content []byte) UpdateInfo {
+	sum := sha256.Sum256(content)
+	return UpdateInfo{
+		Version: "9.9.9",
+		Assets: []Asset{{
+			Os:     runtime.GOOS,
+			Arch:   runtime.GOARCH,
+			Url:    url,
+			Size:   int64(len(content)),
+			Sha256: hex.EncodeToString(sum[:]),
+		}},
+	}
+}
+
+func TestStageAndInstallUpdate(t *testing.T) {
+	newBinary := []byte("new binary")
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write(newBinary)
+	}))
+	defer srv.Close()
+
+	exePath := filepath.Join(t.TempDir(), "friendnet")
+	if err := os.WriteFile(exePath, []byte("old binary"), 0755); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := StageUpdate(context.Background(), testUpdate(srv.URL, newBinary), exePath); err != nil {
+		t.Fatal(err)
+	}
+	if !HasStagedUpdate(exePath) {
+		t.Fatal("expected update to be staged")
+	}
+
+	installed, err := InstallStagedUpdate(exePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !installed {
+		t.Fatal("expected staged update to be installed")
+	}
+	content, err := os.ReadFile(exePath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(content) != string(newBinary) {
+		t.Fatalf("unexpected executable content %q", content)
+	}
+
+	if err = RemoveOldBinary(exePath); err != nil {
+		t.Fatal(err)
+	}
+	if installed, err = InstallStagedUpdate(exePath); err != nil || installed {
+		t.Fatalf("expected nothing to install, got %v, %v", installed, err)
+	}
+}
+
+func TestStageUpdateChecksumMismatch(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		_, _ = w.Write([]byte("tampered!!"))
+	}))
+	defer srv.Close()
+
+	dir := t.TempDir()
+	exePath := filepath.Join(dir, "friendnet")
+
+	err := StageUpdate(context.Background(), testUpdate(srv.URL, []byte("new binary")), exePath)
+	if !errors.Is(err, ErrChecksumMismatch) {
+		t.Fatalf("expected ErrChecksumMismatch, got %v", err)
+	}
+	if HasStagedUpdate(exePath) {
+		t.Fatal("expected nothing to be staged")
+	}
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 0 {
+		t.Fatalf("expected temporary files to be removed, found %d entries", len(entries))
+	}
+
+	if err = StageUpdate(context.Background(), UpdateInfo{}, exePath); !errors.Is(err, ErrNoAsset) {
+		t.Fatalf("expected ErrNoAsset, got %v", err)
+	}
+}
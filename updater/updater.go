@@ -28,6 +28,40 @@ type UpdateInfo struct {
 
 	// The URL to the update.
 	Url string `json:"url"`
+
+	// The binaries that the update can be installed from, one per platform.
+	// Updates without assets can only be installed manually from Url.
+	Assets []Asset `json:"assets,omitempty"`
+}
+
+// Asset is a binary for one platform that an update can be installed from.
+// Assets are listed in the signed release manifest, so their checksums can be trusted as much as the manifest itself.
+type Asset struct {
+	// The operating system the binary is for, as in runtime.GOOS.
+	Os string `json:"os"`
+
+	// The architecture the binary is for, as in runtime.GOARCH.
+	Arch string `json:"arch"`
+
+	// The URL to download the binary from.
+	Url string `json:"url"`
+
+	// The size of the binary, in bytes.
+	Size int64 `json:"size"`
+
+	// The hex-encoded SHA-256 hash of the binary.
+	Sha256 string `json:"sha256"`
+}
+
+// AssetFor returns the update's binary for the specified operating system and architecture.
+// Returns false if the update has no binary for the platform.
+func (u UpdateInfo) AssetFor(goos string, goarch string) (Asset, bool) {
+	for _, asset := range u.Assets {
+		if asset.Os == goos && asset.Arch == goarch {
+			return asset, true
+		}
+	}
+	return Asset{}, false
 }
 
 func doReq(ctx context.Context, url string) ([]byte, error) {
@@ -159,6 +193,7 @@ type UpdateChecker struct {
 	// Do not update.
 	CurrentUpdate UpdateInfo
 
+	// The base URL to check. Protected by mu.
 	baseUrl  string
 	pubkey   ed25519.PublicKey
 	interval time.Duration
@@ -252,9 +287,10 @@ func (c *UpdateChecker) loop() {
 
 		c.mu.Lock()
 		latest := c.latest
+		baseUrl := c.baseUrl
 		c.mu.Unlock()
 
-		newUpdate, err := CheckUpdate(ctx, c.baseUrl, latest, c.pubkey)
+		newUpdate, err := CheckUpdate(ctx, baseUrl, latest, c.pubkey)
 		if err != nil {
 			if errors.Is(err, context.DeadlineExceeded) ||
 				errors.Is(err, context.Canceled) {
@@ -281,6 +317,11 @@ func (c *UpdateChecker) loop() {
 
 		if newUpdate != nil {
 			c.mu.Lock()
+			if c.baseUrl != baseUrl {
+				// The base URL changed while checking, so the update is from the wrong source.
+				c.mu.Unlock()
+				return
+			}
 			c.latest = *newUpdate
 			c.mu.Unlock()
 
@@ -346,6 +387,22 @@ func (c *UpdateChecker) CheckNow() (*UpdateInfo, error) {
 	return c.GetNewUpdate()
 }
 
+// SetBaseUrl changes the base URL that updates are checked at, such as when switching channels.
+// Cached update information is cleared, since it came from the previous base URL.
+// Call CheckNow to check the new base URL right away.
+func (c *UpdateChecker) SetBaseUrl(baseUrl string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.baseUrl == baseUrl {
+		return
+	}
+	c.baseUrl = baseUrl
+	c.latest = c.CurrentUpdate
+	c.newUpdate = nil
+	c.newUpdateErr = nil
+}
+
 // NewUpdateChan returns a channel that is closed when there is a new update available.
 // The channel is closed immediately when the updater is closed.
 func (c *UpdateChecker) NewUpdateChan() <-chan struct{} {
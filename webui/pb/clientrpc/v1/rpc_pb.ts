@@ -10,7 +10,7 @@ import type { Message } from "@bufbuild/protobuf";
  * Describes the file pb/clientrpc/v1/rpc.proto.
  */
 export const file_pb_clientrpc_v1_rpc: GenFile = /*@__PURE__*/
-  fileDesc("ChlwYi9jbGllbnRycGMvdjEvcnBjLnByb3RvEg9wYi5jbGllbnRycGMudjEijQoKBUV2ZW50EikKBHR5cGUYASABKA4yGy5wYi5jbGllbnRycGMudjEuRXZlbnQuVHlwZRJGCgtzZXJ2ZXJfY29ubhgCIAEoCzIsLnBiLmNsaWVudHJwYy52MS5FdmVudC5TZXJ2ZXJDb25uU3RhdGVDaGFuZ2VIAIgBARI/Cg1jbGllbnRfb25saW5lGAMgASgLMiMucGIuY2xpZW50cnBjLnYxLkV2ZW50LkNsaWVudE9ubGluZUgBiAEBEkEKDmNsaWVudF9vZmZsaW5lGAQgASgLMiQucGIuY2xpZW50cnBjLnYxLkV2ZW50LkNsaWVudE9mZmxpbmVIAogBARI5CgpuZXdfdXBkYXRlGAUgASgLMiAucGIuY2xpZW50cnBjLnYxLkV2ZW50Lk5ld1VwZGF0ZUgDiAEBElIKF2Rvd25sb2FkX3N0YXR1c191cGRhdGVzGAYgASgLMiwucGIuY2xpZW50cnBjLnYxLkV2ZW50LkRvd25sb2FkU3RhdHVzVXBkYXRlc0gEiAEBEjoKC25ld19kbV9pdGVtGAcgASgLMiAucGIuY2xpZW50cnBjLnYxLkV2ZW50Lk5ld0RtSXRlbUgFiAEBEkIKD2RtX2l0ZW1fcmVtb3ZlZBgIIAEoCzIkLnBiLmNsaWVudHJwYy52MS5FdmVudC5EbUl0ZW1SZW1vdmVkSAaIAQEaSAoVU2VydmVyQ29ublN0YXRlQ2hhbmdlEi8KBXN0YXRlGAIgASgOMiAucGIuY2xpZW50cnBjLnYxLlNlcnZlckNvbm5TdGF0ZRo9CgxDbGllbnRPbmxpbmUSLQoEaW5mbxgBIAEoCzIfLnBiLmNsaWVudHJwYy52MS5PbmxpbmVVc2VySW5mbxohCg1DbGllbnRPZmZsaW5lEhAKCHVzZXJuYW1lGAEgASgJGjYKCU5ld1VwZGF0ZRIpCgRpbmZvGAEgASgLMhsucGIuY2xpZW50cnBjLnYxLlVwZGF0ZUluZm8aTQoVRG93bmxvYWRTdGF0dXNVcGRhdGVzEjQKBWZpbGVzGAEgAygLMiUucGIuY2xpZW50cnBjLnYxLkRvd25sb2FkU3RhdHVzVXBkYXRlGj8KCU5ld0RtSXRlbRIyCgRpdGVtGAEgASgLMiQucGIuY2xpZW50cnBjLnYxLkRvd25sb2FkTWFuYWdlckl0ZW0aHQoNRG1JdGVtUmVtb3ZlZBIMCgR1dWlkGAEgASgJIuYBCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABINCglUWVBFX1NUT1AQARIhCh1UWVBFX1NFUlZFUl9DT05OX1NUQVRFX0NIQU5HRRACEhYKElRZUEVfQ0xJRU5UX09OTElORRADEhcKE1RZUEVfQ0xJRU5UX09GRkxJTkUQBBITCg9UWVBFX05FV19VUERBVEUQBRIgChxUWVBFX0RPV05MT0FEX1NUQVRVU19VUERBVEVTEAYSFAoQVFlQRV9ORVdfRE1fSVRFTRAHEhgKFFRZUEVfRE1fSVRFTV9SRU1PVkVEEAhCDgoMX3NlcnZlcl9jb25uQhAKDl9jbGllbnRfb25saW5lQhEKD19jbGllbnRfb2ZmbGluZUINCgtfbmV3X3VwZGF0ZUIaChhfZG93bmxvYWRfc3RhdHVzX3VwZGF0ZXNCDgoMX25ld19kbV9pdGVtQhIKEF9kbV9pdGVtX3JlbW92ZWQiIwoMRXZlbnRDb250ZXh0EhMKC3NlcnZlcl91dWlkGAEgASgJIjoKDkxvZ01lc3NhZ2VBdHRyEgwKBGtpbmQYASABKAkSCwoDa2V5GAIgASgJEg0KBXZhbHVlGAMgASgJIm4KCkxvZ01lc3NhZ2USCwoDdWlkGAEgASgJEhIKCmNyZWF0ZWRfdHMYAiABKAMSDwoHbWVzc2FnZRgDIAEoCRIuCgVhdHRycxgEIAMoCzIfLnBiLmNsaWVudHJwYy52MS5Mb2dNZXNzYWdlQXR0ciK5AQoURG93bmxvYWRTdGF0dXNVcGRhdGUSDAoEdXVpZBgBIAEoCRIvCgZzdGF0dXMYAiABKA4yHy5wYi5jbGllbnRycGMudjEuRG93bmxvYWRTdGF0dXMSEgoKZG93bmxvYWRlZBgDIAEoBBIRCglmaWxlX3NpemUYBCABKAMSDQoFc3BlZWQYBSABKAQSGgoNZXJyb3JfbWVzc2FnZRgGIAEoCUgAiAEBQhAKDl9lcnJvcl9tZXNzYWdlIrIDChNEb3dubG9hZE1hbmFnZXJJdGVtEjcKBHR5cGUYASABKA4yKS5wYi5jbGllbnRycGMudjEuRG93bmxvYWRNYW5hZ2VySXRlbS5UeXBlEgwKBHV1aWQYAiABKAkSEwoLc2VydmVyX3V1aWQYAyABKAkSFQoNcGVlcl91c2VybmFtZRgEIAEoCRIRCglmaWxlX3BhdGgYBSABKAkSRAoIZG93bmxvYWQYBiABKAsyLS5wYi5jbGllbnRycGMudjEuRG93bmxvYWRNYW5hZ2VySXRlbS5Eb3dubG9hZEgAiAEBGpABCghEb3dubG9hZBIvCgZzdGF0dXMYASABKA4yHy5wYi5jbGllbnRycGMudjEuRG93bmxvYWRTdGF0dXMSEgoKZG93bmxvYWRlZBgCIAEoBBIRCglmaWxlX3NpemUYAyABKAMSGgoNZXJyb3JfbWVzc2FnZRgGIAEoCUgAiAEBQhAKDl9lcnJvcl9tZXNzYWdlIi8KBFR5cGUSFAoQVFlQRV9VTlNQRUNJRklFRBAAEhEKDVRZUEVfRE9XTkxPQUQQAUILCglfZG93bmxvYWQiZQoKVXBkYXRlSW5mbxIQCghpc192YWxpZBgBIAEoCBISCgpjcmVhdGVkX3RzGAIgASgDEg8KB3ZlcnNpb24YAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSCwoDdXJsGAUgASgJIt4BCgpTZXJ2ZXJJbmZvEjAKBXN0YXRlGAEgASgLMiEucGIuY2xpZW50cnBjLnYxLlNlcnZlckluZm8uU3RhdGUSDAoEdXVpZBgCIAEoCRIMCgRuYW1lGAMgASgJEg8KB2FkZHJlc3MYBCABKAkSDAoEcm9vbRgFIAEoCRIQCgh1c2VybmFtZRgGIAEoCRISCgpjcmVhdGVkX3RzGAcgASgDGj0KBVN0YXRlEjQKCmNvbm5fc3RhdGUYASABKA4yIC5wYi5jbGllbnRycGMudjEuU2VydmVyQ29ublN0YXRlInQKCVNoYXJlSW5mbxIMCgR1dWlkGAEgASgJEhMKC3NlcnZlcl91dWlkGAIgASgJEgwKBG5hbWUYAyABKAkSDAoEcGF0aBgEIAEoCRIUCgxmb2xsb3dfbGlua3MYBSABKAgSEgoKY3JlYXRlZF90cxgGIAEoAyIiCg5PbmxpbmVVc2VySW5mbxIQCgh1c2VybmFtZRgBIAEoCSI2CghGaWxlTWV0YRIMCgRuYW1lGAEgASgJEg4KBmlzX2RpchgCIAEoCBIMCgRzaXplGAMgASgEIuUBCg5EaXJlY3RTZXR0aW5ncxIPCgdkaXNhYmxlGAEgASgIEhEKCWFkZHJlc3NlcxgCIAMoCRIUCgxkZWZhdWx0X3BvcnQYAyABKA0SJgoeZGlzYWJsZV9wcm9iZV9pcHNfdG9fYWR2ZXJ0aXNlGAQgASgIEh0KFWFkdmVydGlzZV9wcml2YXRlX2lwcxgFIAEoCBIjChtkaXNhYmxlX3B1YmxpY19pcF9kaXNjb3ZlcnkYBiABKAgSFAoMZGlzYWJsZV91cG5wGAcgASgIEhcKD3VwbnBfdGltZW91dF9tcxgIIAEoDSJwChBUcmFuc2ZlclNldHRpbmdzEhwKFGRvd25sb2FkX2NvbmN1cnJlbmN5GAEgASgNEh8KF2luY29tcGxldGVfZG93bmxvYWRfZGlyGAIgASgJEh0KFWNvbXBsZXRlX2Rvd25sb2FkX2RpchgDIAEoCSIVChNTdHJlYW1FdmVudHNSZXF1ZXN0Im0KFFN0cmVhbUV2ZW50c1Jlc3BvbnNlEiUKBWV2ZW50GAEgASgLMhYucGIuY2xpZW50cnBjLnYxLkV2ZW50Ei4KB2NvbnRleHQYAiABKAsyHS5wYi5jbGllbnRycGMudjEuRXZlbnRDb250ZXh0IksKEVN0cmVhbUxvZ3NSZXF1ZXN0Eh8KEnNlbmRfbG9nc19hZnRlcl90cxgBIAEoA0gAiAEBQhUKE19zZW5kX2xvZ3NfYWZ0ZXJfdHMiPwoSU3RyZWFtTG9nc1Jlc3BvbnNlEikKBGxvZ3MYASADKAsyGy5wYi5jbGllbnRycGMudjEuTG9nTWVzc2FnZSINCgtTdG9wUmVxdWVzdCIOCgxTdG9wUmVzcG9uc2UiFgoUR2V0Q2xpZW50SW5mb1JlcXVlc3QiFwoVR2V0Q2xpZW50SW5mb1Jlc3BvbnNlIhMKEUdldFNlcnZlcnNSZXF1ZXN0IkIKEkdldFNlcnZlcnNSZXNwb25zZRIsCgdzZXJ2ZXJzGAEgAygLMhsucGIuY2xpZW50cnBjLnYxLlNlcnZlckluZm8iZgoTQ3JlYXRlU2VydmVyUmVxdWVzdBIMCgRuYW1lGAEgASgJEg8KB2FkZHJlc3MYAiABKAkSDAoEcm9vbRgDIAEoCRIQCgh1c2VybmFtZRgEIAEoCRIQCghwYXNzd29yZBgFIAEoCSJDChRDcmVhdGVTZXJ2ZXJSZXNwb25zZRIrCgZzZXJ2ZXIYASABKAsyGy5wYi5jbGllbnRycGMudjEuU2VydmVySW5mbyIjChNEZWxldGVTZXJ2ZXJSZXF1ZXN0EgwKBHV1aWQYASABKAkiFgoURGVsZXRlU2VydmVyUmVzcG9uc2UiJAoUQ29ubmVjdFNlcnZlclJlcXVlc3QSDAoEdXVpZBgBIAEoCSIXChVDb25uZWN0U2VydmVyUmVzcG9uc2UiJwoXRGlzY29ubmVjdFNlcnZlclJlcXVlc3QSDAoEdXVpZBgBIAEoCSIaChhEaXNjb25uZWN0U2VydmVyUmVzcG9uc2UixQEKE1VwZGF0ZVNlcnZlclJlcXVlc3QSDAoEdXVpZBgBIAEoCRIRCgRuYW1lGAIgASgJSACIAQESFAoHYWRkcmVzcxgDIAEoCUgBiAEBEhEKBHJvb20YBCABKAlIAogBARIVCgh1c2VybmFtZRgFIAEoCUgDiAEBEhUKCHBhc3N3b3JkGAYgASgJSASIAQFCBwoFX25hbWVCCgoIX2FkZHJlc3NCBwoFX3Jvb21CCwoJX3VzZXJuYW1lQgsKCV9wYXNzd29yZCJDChRVcGRhdGVTZXJ2ZXJSZXNwb25zZRIrCgZzZXJ2ZXIYASABKAsyGy5wYi5jbGllbnRycGMudjEuU2VydmVySW5mbyInChBHZXRTaGFyZXNSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJIj8KEUdldFNoYXJlc1Jlc3BvbnNlEioKBnNoYXJlcxgBIAMoCzIaLnBiLmNsaWVudHJwYy52MS5TaGFyZUluZm8iWwoSQ3JlYXRlU2hhcmVSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJEgwKBG5hbWUYAiABKAkSDAoEcGF0aBgDIAEoCRIUCgxmb2xsb3dfbGlua3MYBCABKAgiQAoTQ3JlYXRlU2hhcmVSZXNwb25zZRIpCgVzaGFyZRgBIAEoCzIaLnBiLmNsaWVudHJwYy52MS5TaGFyZUluZm8iNwoSRGVsZXRlU2hhcmVSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJEgwKBG5hbWUYAiABKAkiFQoTRGVsZXRlU2hhcmVSZXNwb25zZSJJChJHZXREaXJGaWxlc1JlcXVlc3QSEwoLc2VydmVyX3V1aWQYASABKAkSEAoIdXNlcm5hbWUYAiABKAkSDAoEcGF0aBgDIAEoCSJBChNHZXREaXJGaWxlc1Jlc3BvbnNlEioKB2NvbnRlbnQYAiADKAsyGS5wYi5jbGllbnRycGMudjEuRmlsZU1ldGEiSQoSR2V0RmlsZU1ldGFSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEgwKBHBhdGgYAyABKAkiPgoTR2V0RmlsZU1ldGFSZXNwb25zZRInCgRtZXRhGAEgASgLMhkucGIuY2xpZW50cnBjLnYxLkZpbGVNZXRhIiwKFUdldE9ubGluZVVzZXJzUmVxdWVzdBITCgtzZXJ2ZXJfdXVpZBgBIAEoCSJIChZHZXRPbmxpbmVVc2Vyc1Jlc3BvbnNlEi4KBXVzZXJzGAEgAygLMh8ucGIuY2xpZW50cnBjLnYxLk9ubGluZVVzZXJJbmZvImMKHENoYW5nZUFjY291bnRQYXNzd29yZFJlcXVlc3QSEwoLc2VydmVyX3V1aWQYASABKAkSGAoQY3VycmVudF9wYXNzd29yZBgCIAEoCRIUCgxuZXdfcGFzc3dvcmQYAyABKAkiHwodQ2hhbmdlQWNjb3VudFBhc3N3b3JkUmVzcG9uc2UiJAoUU2VydmVyQ29ubmVjdFJlcXVlc3QSDAoEdXVpZBgBIAEoCSIXChVTZXJ2ZXJDb25uZWN0UmVzcG9uc2UiJwoXU2VydmVyRGlzY29ubmVjdFJlcXVlc3QSDAoEdXVpZBgBIAEoCSIaChhTZXJ2ZXJEaXNjb25uZWN0UmVzcG9uc2UiGgoYR2V0RGlyZWN0U2V0dGluZ3NSZXF1ZXN0Ik4KGUdldERpcmVjdFNldHRpbmdzUmVzcG9uc2USMQoIc2V0dGluZ3MYASABKAsyHy5wYi5jbGllbnRycGMudjEuRGlyZWN0U2V0dGluZ3MiUAobVXBkYXRlRGlyZWN0U2V0dGluZ3NSZXF1ZXN0EjEKCHNldHRpbmdzGAEgASgLMh8ucGIuY2xpZW50cnBjLnYxLkRpcmVjdFNldHRpbmdzIh4KHFVwZGF0ZURpcmVjdFNldHRpbmdzUmVzcG9uc2UiHAoaR2V0VHJhbnNmZXJTZXR0aW5nc1JlcXVlc3QiUgobR2V0VHJhbnNmZXJTZXR0aW5nc1Jlc3BvbnNlEjMKCHNldHRpbmdzGAEgASgLMiEucGIuY2xpZW50cnBjLnYxLlRyYW5zZmVyU2V0dGluZ3MiVAodVXBkYXRlVHJhbnNmZXJTZXR0aW5nc1JlcXVlc3QSMwoIc2V0dGluZ3MYASABKAsyIS5wYi5jbGllbnRycGMudjEuVHJhbnNmZXJTZXR0aW5ncyIgCh5VcGRhdGVUcmFuc2ZlclNldHRpbmdzUmVzcG9uc2UiNgoRSW5kZXhTaGFyZVJlcXVlc3QSEwoLc2VydmVyX3V1aWQYASABKAkSDAoEbmFtZRgCIAEoCSIUChJJbmRleFNoYXJlUmVzcG9uc2UiXQoTU3RyZWFtU2VhcmNoUmVxdWVzdBITCgtzZXJ2ZXJfdXVpZBgBIAEoCRIVCgh1c2VybmFtZRgCIAEoCUgAiAEBEg0KBXF1ZXJ5GAMgASgJQgsKCV91c2VybmFtZSJ6ChRTdHJlYW1TZWFyY2hSZXNwb25zZRIQCgh1c2VybmFtZRgBIAEoCRIWCg5kaXJlY3RvcnlfcGF0aBgCIAEoCRInCgRmaWxlGAMgASgLMhkucGIuY2xpZW50cnBjLnYxLkZpbGVNZXRhEg8KB3NuaXBwZXQYBCABKAkiFgoUR2V0VXBkYXRlSW5mb1JlcXVlc3QiiwEKFUdldFVwZGF0ZUluZm9SZXNwb25zZRIxCgxjdXJyZW50X2luZm8YASABKAsyGy5wYi5jbGllbnRycGMudjEuVXBkYXRlSW5mbxIyCghuZXdfaW5mbxgCIAEoCzIbLnBiLmNsaWVudHJwYy52MS5VcGRhdGVJbmZvSACIAQFCCwoJX25ld19pbmZvIhoKGENoZWNrRm9yTmV3VXBkYXRlUmVxdWVzdCJcChlDaGVja0Zvck5ld1VwZGF0ZVJlc3BvbnNlEjIKCG5ld19pbmZvGAEgASgLMhsucGIuY2xpZW50cnBjLnYxLlVwZGF0ZUluZm9IAIgBAUILCglfbmV3X2luZm8iIAoeR2V0RG93bmxvYWRNYW5hZ2VySXRlbXNSZXF1ZXN0IlYKH0dldERvd25sb2FkTWFuYWdlckl0ZW1zUmVzcG9uc2USMwoFaXRlbXMYASADKAsyJC5wYi5jbGllbnRycGMudjEuRG93bmxvYWRNYW5hZ2VySXRlbSJZChhRdWV1ZUZpbGVEb3dubG9hZFJlcXVlc3QSEwoLc2VydmVyX3V1aWQYASABKAkSFQoNcGVlcl91c2VybmFtZRgCIAEoCRIRCglmaWxlX3BhdGgYAyABKAkiGwoZUXVldWVGaWxlRG93bmxvYWRSZXNwb25zZSIpChlDYW5jZWxGaWxlRG93bmxvYWRSZXF1ZXN0EgwKBHV1aWQYASABKAkiHAoaQ2FuY2VsRmlsZURvd25sb2FkUmVzcG9uc2UiMAogUmVtb3ZlRG93bmxvYWRNYW5hZ2VySXRlbVJlcXVlc3QSDAoEdXVpZBgBIAEoCSIjCiFSZW1vdmVEb3dubG9hZE1hbmFnZXJJdGVtUmVzcG9uc2UiKQoZUmVzdW1lRmlsZURvd25sb2FkUmVxdWVzdBIMCgR1dWlkGAEgASgJIhwKGlJlc3VtZUZpbGVEb3dubG9hZFJlc3BvbnNlKr0BCg5Eb3dubG9hZFN0YXR1cxIfChtET1dOTE9BRF9TVEFUVVNfVU5TUEVDSUZJRUQQABIaChZET1dOTE9BRF9TVEFUVVNfUVVFVUVEEAESGwoXRE9XTkxPQURfU1RBVFVTX1BFTkRJTkcQAhIcChhET1dOTE9BRF9TVEFUVVNfQ0FOQ0VMRUQQAxIYChRET1dOTE9BRF9TVEFUVVNfRE9ORRAEEhkKFURPV05MT0FEX1NUQVRVU19FUlJPUhAFKo0BCg9TZXJ2ZXJDb25uU3RhdGUSIQodU0VSVkVSX0NPTk5fU1RBVEVfVU5TUEVDSUZJRUQQABIcChhTRVJWRVJfQ09OTl9TVEFURV9DTE9TRUQQARIdChlTRVJWRVJfQ09OTl9TVEFURV9PUEVOSU5HEAISGgoWU0VSVkVSX0NPTk5fU1RBVEVfT1BFThADMucZChBDbGllbnRScGNTZXJ2aWNlElkKClN0cmVhbUxvZ3MSIi5wYi5jbGllbnRycGMudjEuU3RyZWFtTG9nc1JlcXVlc3QaIy5wYi5jbGllbnRycGMudjEuU3RyZWFtTG9nc1Jlc3BvbnNlIgAwARJfCgxTdHJlYW1FdmVudHMSJC5wYi5jbGllbnRycGMudjEuU3RyZWFtRXZlbnRzUmVxdWVzdBolLnBiLmNsaWVudHJwYy52MS5TdHJlYW1FdmVudHNSZXNwb25zZSIAMAESRQoEU3RvcBIcLnBiLmNsaWVudHJwYy52MS5TdG9wUmVxdWVzdBodLnBiLmNsaWVudHJwYy52MS5TdG9wUmVzcG9uc2UiABJgCg1HZXRDbGllbnRJbmZvEiUucGIuY2xpZW50cnBjLnYxLkdldENsaWVudEluZm9SZXF1ZXN0GiYucGIuY2xpZW50cnBjLnYxLkdldENsaWVudEluZm9SZXNwb25zZSIAElcKCkdldFNlcnZlcnMSIi5wYi5jbGllbnRycGMudjEuR2V0U2VydmVyc1JlcXVlc3QaIy5wYi5jbGllbnRycGMudjEuR2V0U2VydmVyc1Jlc3BvbnNlIgASXQoMQ3JlYXRlU2VydmVyEiQucGIuY2xpZW50cnBjLnYxLkNyZWF0ZVNlcnZlclJlcXVlc3QaJS5wYi5jbGllbnRycGMudjEuQ3JlYXRlU2VydmVyUmVzcG9uc2UiABJdCgxEZWxldGVTZXJ2ZXISJC5wYi5jbGllbnRycGMudjEuRGVsZXRlU2VydmVyUmVxdWVzdBolLnBiLmNsaWVudHJwYy52MS5EZWxldGVTZXJ2ZXJSZXNwb25zZSIAEmAKDUNvbm5lY3RTZXJ2ZXISJS5wYi5jbGllbnRycGMudjEuQ29ubmVjdFNlcnZlclJlcXVlc3QaJi5wYi5jbGllbnRycGMudjEuQ29ubmVjdFNlcnZlclJlc3BvbnNlIgASaQoQRGlzY29ubmVjdFNlcnZlchIoLnBiLmNsaWVudHJwYy52MS5EaXNjb25uZWN0U2VydmVyUmVxdWVzdBopLnBiLmNsaWVudHJwYy52MS5EaXNjb25uZWN0U2VydmVyUmVzcG9uc2UiABJdCgxVcGRhdGVTZXJ2ZXISJC5wYi5jbGllbnRycGMudjEuVXBkYXRlU2VydmVyUmVxdWVzdBolLnBiLmNsaWVudHJwYy52MS5VcGRhdGVTZXJ2ZXJSZXNwb25zZSIAElQKCUdldFNoYXJlcxIhLnBiLmNsaWVudHJwYy52MS5HZXRTaGFyZXNSZXF1ZXN0GiIucGIuY2xpZW50cnBjLnYxLkdldFNoYXJlc1Jlc3BvbnNlIgASWgoLQ3JlYXRlU2hhcmUSIy5wYi5jbGllbnRycGMudjEuQ3JlYXRlU2hhcmVSZXF1ZXN0GiQucGIuY2xpZW50cnBjLnYxLkNyZWF0ZVNoYXJlUmVzcG9uc2UiABJaCgtEZWxldGVTaGFyZRIjLnBiLmNsaWVudHJwYy52MS5EZWxldGVTaGFyZVJlcXVlc3QaJC5wYi5jbGllbnRycGMudjEuRGVsZXRlU2hhcmVSZXNwb25zZSIAElwKC0dldERpckZpbGVzEiMucGIuY2xpZW50cnBjLnYxLkdldERpckZpbGVzUmVxdWVzdBokLnBiLmNsaWVudHJwYy52MS5HZXREaXJGaWxlc1Jlc3BvbnNlIgAwARJaCgtHZXRGaWxlTWV0YRIjLnBiLmNsaWVudHJwYy52MS5HZXRGaWxlTWV0YVJlcXVlc3QaJC5wYi5jbGllbnRycGMudjEuR2V0RmlsZU1ldGFSZXNwb25zZSIAEmUKDkdldE9ubGluZVVzZXJzEiYucGIuY2xpZW50cnBjLnYxLkdldE9ubGluZVVzZXJzUmVxdWVzdBonLnBiLmNsaWVudHJwYy52MS5HZXRPbmxpbmVVc2Vyc1Jlc3BvbnNlIgAwARJ4ChVDaGFuZ2VBY2NvdW50UGFzc3dvcmQSLS5wYi5jbGllbnRycGMudjEuQ2hhbmdlQWNjb3VudFBhc3N3b3JkUmVxdWVzdBouLnBiLmNsaWVudHJwYy52MS5DaGFuZ2VBY2NvdW50UGFzc3dvcmRSZXNwb25zZSIAEmAKDVNlcnZlckNvbm5lY3QSJS5wYi5jbGllbnRycGMudjEuU2VydmVyQ29ubmVjdFJlcXVlc3QaJi5wYi5jbGllbnRycGMudjEuU2VydmVyQ29ubmVjdFJlc3BvbnNlIgASaQoQU2VydmVyRGlzY29ubmVjdBIoLnBiLmNsaWVudHJwYy52MS5TZXJ2ZXJEaXNjb25uZWN0UmVxdWVzdBopLnBiLmNsaWVudHJwYy52MS5TZXJ2ZXJEaXNjb25uZWN0UmVzcG9uc2UiABJsChFHZXREaXJlY3RTZXR0aW5ncxIpLnBiLmNsaWVudHJwYy52MS5HZXREaXJlY3RTZXR0aW5nc1JlcXVlc3QaKi5wYi5jbGllbnRycGMudjEuR2V0RGlyZWN0U2V0dGluZ3NSZXNwb25zZSIAEnUKFFVwZGF0ZURpcmVjdFNldHRpbmdzEiwucGIuY2xpZW50cnBjLnYxLlVwZGF0ZURpcmVjdFNldHRpbmdzUmVxdWVzdBotLnBiLmNsaWVudHJwYy52MS5VcGRhdGVEaXJlY3RTZXR0aW5nc1Jlc3BvbnNlIgAScgoTR2V0VHJhbnNmZXJTZXR0aW5ncxIrLnBiLmNsaWVudHJwYy52MS5HZXRUcmFuc2ZlclNldHRpbmdzUmVxdWVzdBosLnBiLmNsaWVudHJwYy52MS5HZXRUcmFuc2ZlclNldHRpbmdzUmVzcG9uc2UiABJ7ChZVcGRhdGVUcmFuc2ZlclNldHRpbmdzEi4ucGIuY2xpZW50cnBjLnYxLlVwZGF0ZVRyYW5zZmVyU2V0dGluZ3NSZXF1ZXN0Gi8ucGIuY2xpZW50cnBjLnYxLlVwZGF0ZVRyYW5zZmVyU2V0dGluZ3NSZXNwb25zZSIAElcKCkluZGV4U2hhcmUSIi5wYi5jbGllbnRycGMudjEuSW5kZXhTaGFyZVJlcXVlc3QaIy5wYi5jbGllbnRycGMudjEuSW5kZXhTaGFyZVJlc3BvbnNlIgASXwoMU3RyZWFtU2VhcmNoEiQucGIuY2xpZW50cnBjLnYxLlN0cmVhbVNlYXJjaFJlcXVlc3QaJS5wYi5jbGllbnRycGMudjEuU3RyZWFtU2VhcmNoUmVzcG9uc2UiADABEmAKDUdldFVwZGF0ZUluZm8SJS5wYi5jbGllbnRycGMudjEuR2V0VXBkYXRlSW5mb1JlcXVlc3QaJi5wYi5jbGllbnRycGMudjEuR2V0VXBkYXRlSW5mb1Jlc3BvbnNlIgASbAoRQ2hlY2tGb3JOZXdVcGRhdGUSKS5wYi5jbGllbnRycGMudjEuQ2hlY2tGb3JOZXdVcGRhdGVSZXF1ZXN0GioucGIuY2xpZW50cnBjLnYxLkNoZWNrRm9yTmV3VXBkYXRlUmVzcG9uc2UiABJ+ChdHZXREb3dubG9hZE1hbmFnZXJJdGVtcxIvLnBiLmNsaWVudHJwYy52MS5HZXREb3dubG9hZE1hbmFnZXJJdGVtc1JlcXVlc3QaMC5wYi5jbGllbnRycGMudjEuR2V0RG93bmxvYWRNYW5hZ2VySXRlbXNSZXNwb25zZSIAEmwKEVF1ZXVlRmlsZURvd25sb2FkEikucGIuY2xpZW50cnBjLnYxLlF1ZXVlRmlsZURvd25sb2FkUmVxdWVzdBoqLnBiLmNsaWVudHJwYy52MS5RdWV1ZUZpbGVEb3dubG9hZFJlc3BvbnNlIgASbwoSQ2FuY2VsRmlsZURvd25sb2FkEioucGIuY2xpZW50cnBjLnYxLkNhbmNlbEZpbGVEb3dubG9hZFJlcXVlc3QaKy5wYi5jbGllbnRycGMudjEuQ2FuY2VsRmlsZURvd25sb2FkUmVzcG9uc2UiABKEAQoZUmVtb3ZlRG93bmxvYWRNYW5hZ2VySXRlbRIxLnBiLmNsaWVudHJwYy52MS5SZW1vdmVEb3dubG9hZE1hbmFnZXJJdGVtUmVxdWVzdBoyLnBiLmNsaWVudHJwYy52MS5SZW1vdmVEb3dubG9hZE1hbmFnZXJJdGVtUmVzcG9uc2UiABJvChJSZXN1bWVGaWxlRG93bmxvYWQSKi5wYi5jbGllbnRycGMudjEuUmVzdW1lRmlsZURvd25sb2FkUmVxdWVzdBorLnBiLmNsaWVudHJwYy52MS5SZXN1bWVGaWxlRG93bmxvYWRSZXNwb25zZSIAQiJaIGZyaWVuZG5ldC5vcmcvcHJvdG9jb2wvY2xpZW50cnBjYgZwcm90bzM");
+  fileDesc("ChlwYi9jbGllbnRycGMvdjEvcnBjLnByb3RvEg9wYi5jbGllbnRycGMudjEi3RIKBUV2ZW50EikKBHR5cGUYASABKA4yGy5wYi5jbGllbnRycGMudjEuRXZlbnQuVHlwZRJGCgtzZXJ2ZXJfY29ubhgCIAEoCzIsLnBiLmNsaWVudHJwYy52MS5FdmVudC5TZXJ2ZXJDb25uU3RhdGVDaGFuZ2VIAIgBARI/Cg1jbGllbnRfb25saW5lGAMgASgLMiMucGIuY2xpZW50cnBjLnYxLkV2ZW50LkNsaWVudE9ubGluZUgBiAEBEkEKDmNsaWVudF9vZmZsaW5lGAQgASgLMiQucGIuY2xpZW50cnBjLnYxLkV2ZW50LkNsaWVudE9mZmxpbmVIAogBARI5CgpuZXdfdXBkYXRlGAUgASgLMiAucGIuY2xpZW50cnBjLnYxLkV2ZW50Lk5ld1VwZGF0ZUgDiAEBElIKF2Rvd25sb2FkX3N0YXR1c191cGRhdGVzGAYgASgLMiwucGIuY2xpZW50cnBjLnYxLkV2ZW50LkRvd25sb2FkU3RhdHVzVXBkYXRlc0gEiAEBEjoKC25ld19kbV9pdGVtGAcgASgLMiAucGIuY2xpZW50cnBjLnYxLkV2ZW50Lk5ld0RtSXRlbUgFiAEBEkIKD2RtX2l0ZW1fcmVtb3ZlZBgIIAEoCzIkLnBiLmNsaWVudHJwYy52MS5FdmVudC5EbUl0ZW1SZW1vdmVkSAaIAQESPwoNc2hhcmVfY2hhbmdlZBgJIAEoCzIjLnBiLmNsaWVudHJwYy52MS5FdmVudC5TaGFyZUNoYW5nZWRIB4gBARI/Cg1zZXJ2ZXJfbm90aWNlGAogASgLMiMucGIuY2xpZW50cnBjLnYxLkV2ZW50LlNlcnZlck5vdGljZUgIiAEBEj8KDXVwbG9hZF91cGRhdGUYCyABKAsyIy5wYi5jbGllbnRycGMudjEuRXZlbnQuVXBsb2FkVXBkYXRlSAmIAQESSwoTZG93bmxvYWRzX3JlY292ZXJlZBgMIAEoCzIpLnBiLmNsaWVudHJwYy52MS5FdmVudC5Eb3dubG9hZHNSZWNvdmVyZWRICogBARJBCg5zaHV0ZG93bl9kcmFpbhgNIAEoCzIkLnBiLmNsaWVudHJwYy52MS5FdmVudC5TaHV0ZG93bkRyYWluSAuIAQESNwoJcm9vbV9tb3RkGA4gASgLMh8ucGIuY2xpZW50cnBjLnYxLkV2ZW50LlJvb21Nb3RkSAyIAQESOQoKY2xvY2tfc2tldxgPIAEoCzIgLnBiLmNsaWVudHJwYy52MS5FdmVudC5DbG9ja1NrZXdIDYgBARpIChVTZXJ2ZXJDb25uU3RhdGVDaGFuZ2USLwoFc3RhdGUYAiABKA4yIC5wYi5jbGllbnRycGMudjEuU2VydmVyQ29ublN0YXRlGj0KDENsaWVudE9ubGluZRItCgRpbmZvGAEgASgLMh8ucGIuY2xpZW50cnBjLnYxLk9ubGluZVVzZXJJbmZvGiEKDUNsaWVudE9mZmxpbmUSEAoIdXNlcm5hbWUYASABKAkaNgoJTmV3VXBkYXRlEikKBGluZm8YASABKAsyGy5wYi5jbGllbnRycGMudjEuVXBkYXRlSW5mbxpNChVEb3dubG9hZFN0YXR1c1VwZGF0ZXMSNAoFZmlsZXMYASADKAsyJS5wYi5jbGllbnRycGMudjEuRG93bmxvYWRTdGF0dXNVcGRhdGUaPwoJTmV3RG1JdGVtEjIKBGl0ZW0YASABKAsyJC5wYi5jbGllbnRycGMudjEuRG93bmxvYWRNYW5hZ2VySXRlbRodCg1EbUl0ZW1SZW1vdmVkEgwKBHV1aWQYASABKAkaQwoMU2hhcmVDaGFuZ2VkEhIKCnNoYXJlX25hbWUYASABKAkSEAoIcmV2aXNpb24YAiABKAQSDQoFcGF0aHMYAyADKAkaHAoMU2VydmVyTm90aWNlEgwKBHRleHQYASABKAkaOwoMVXBsb2FkVXBkYXRlEisKBnVwbG9hZBgBIAEoCzIbLnBiLmNsaWVudHJwYy52MS5VcGxvYWRJbmZvGksKEkRvd25sb2Fkc1JlY292ZXJlZBI1Cglkb3dubG9hZHMYASADKAsyIi5wYi5jbGllbnRycGMudjEuUmVjb3ZlcmVkRG93bmxvYWQaPAoNU2h1dGRvd25EcmFpbhIWCg5hY3RpdmVfdXBsb2FkcxgBIAEoDRITCgtkZWFkbGluZV90cxgCIAEoAxoYCghSb29tTW90ZBIMCgR0ZXh0GAEgASgJGhwKCUNsb2NrU2tldxIPCgdza2V3X21zGAEgASgDIo4DCgRUeXBlEhQKEFRZUEVfVU5TUEVDSUZJRUQQABINCglUWVBFX1NUT1AQARIhCh1UWVBFX1NFUlZFUl9DT05OX1NUQVRFX0NIQU5HRRACEhYKElRZUEVfQ0xJRU5UX09OTElORRADEhcKE1RZUEVfQ0xJRU5UX09GRkxJTkUQBBITCg9UWVBFX05FV19VUERBVEUQBRIgChxUWVBFX0RPV05MT0FEX1NUQVRVU19VUERBVEVTEAYSFAoQVFlQRV9ORVdfRE1fSVRFTRAHEhgKFFRZUEVfRE1fSVRFTV9SRU1PVkVEEAgSFgoSVFlQRV9TSEFSRV9DSEFOR0VEEAkSFgoSVFlQRV9TRVJWRVJfTk9USUNFEAoSFgoSVFlQRV9VUExPQURfVVBEQVRFEAsSHAoYVFlQRV9ET1dOTE9BRFNfUkVDT1ZFUkVEEAwSFwoTVFlQRV9TSFVURE9XTl9EUkFJThANEhIKDlRZUEVfUk9PTV9NT1REEA4SEwoPVFlQRV9DTE9DS19TS0VXEA9CDgoMX3NlcnZlcl9jb25uQhAKDl9jbGllbnRfb25saW5lQhEKD19jbGllbnRfb2ZmbGluZUINCgtfbmV3X3VwZGF0ZUIaChhfZG93bmxvYWRfc3RhdHVzX3VwZGF0ZXNCDgoMX25ld19kbV9pdGVtQhIKEF9kbV9pdGVtX3JlbW92ZWRCEAoOX3NoYXJlX2NoYW5nZWRCEAoOX3NlcnZlcl9ub3RpY2VCEAoOX3VwbG9hZF91cGRhdGVCFgoUX2Rvd25sb2Fkc19yZWNvdmVyZWRCEQoPX3NodXRkb3duX2RyYWluQgwKCl9yb29tX21vdGRCDQoLX2Nsb2NrX3NrZXciIwoMRXZlbnRDb250ZXh0EhMKC3NlcnZlcl91dWlkGAEgASgJIjoKDkxvZ01lc3NhZ2VBdHRyEgwKBGtpbmQYASABKAkSCwoDa2V5GAIgASgJEg0KBXZhbHVlGAMgASgJIm4KCkxvZ01lc3NhZ2USCwoDdWlkGAEgASgJEhIKCmNyZWF0ZWRfdHMYAiABKAMSDwoHbWVzc2FnZRgDIAEoCRIuCgVhdHRycxgEIAMoCzIfLnBiLmNsaWVudHJwYy52MS5Mb2dNZXNzYWdlQXR0ciLrAQoURG93bmxvYWRTdGF0dXNVcGRhdGUSDAoEdXVpZBgBIAEoCRIvCgZzdGF0dXMYAiABKA4yHy5wYi5jbGllbnRycGMudjEuRG93bmxvYWRTdGF0dXMSEgoKZG93bmxvYWRlZBgDIAEoBBIRCglmaWxlX3NpemUYBCABKAMSDQoFc3BlZWQYBSABKAQSGgoNZXJyb3JfbWVzc2FnZRgGIAEoCUgAiAEBEjAKC3NjYW5fc3RhdHVzGAcgASgOMhsucGIuY2xpZW50cnBjLnYxLlNjYW5TdGF0dXNCEAoOX2Vycm9yX21lc3NhZ2UiSAoRUmVjb3ZlcmVkRG93bmxvYWQSDAoEdXVpZBgBIAEoCRISCgpkb3dubG9hZGVkGAIgASgEEhEKCWRpc2NhcmRlZBgDIAEoBCK0AgoKVXBsb2FkSW5mbxIMCgR1dWlkGAEgASgJEhMKC3NlcnZlcl91dWlkGAIgASgJEhUKDXBlZXJfdXNlcm5hbWUYAyABKAkSEQoJZmlsZV9wYXRoGAQgASgJEi0KBnN0YXR1cxgFIAEoDjIdLnBiLmNsaWVudHJwYy52MS5VcGxvYWRTdGF0dXMSDgoGb2Zmc2V0GAYgASgEEhIKCmJ5dGVzX3NlbnQYByABKAQSEQoJZmlsZV9zaXplGAggASgEEg0KBXNwZWVkGAkgASgEEhIKCnN0YXJ0ZWRfdHMYCiABKAMSFQoIZW5kZWRfdHMYCyABKANIAIgBARIaCg1lcnJvcl9tZXNzYWdlGAwgASgJSAGIAQFCCwoJX2VuZGVkX3RzQhAKDl9lcnJvcl9tZXNzYWdlIuQDChNEb3dubG9hZE1hbmFnZXJJdGVtEjcKBHR5cGUYASABKA4yKS5wYi5jbGllbnRycGMudjEuRG93bmxvYWRNYW5hZ2VySXRlbS5UeXBlEgwKBHV1aWQYAiABKAkSEwoLc2VydmVyX3V1aWQYAyABKAkSFQoNcGVlcl91c2VybmFtZRgEIAEoCRIRCglmaWxlX3BhdGgYBSABKAkSRAoIZG93bmxvYWQYBiABKAsyLS5wYi5jbGllbnRycGMudjEuRG93bmxvYWRNYW5hZ2VySXRlbS5Eb3dubG9hZEgAiAEBGsIBCghEb3dubG9hZBIvCgZzdGF0dXMYASABKA4yHy5wYi5jbGllbnRycGMudjEuRG93bmxvYWRTdGF0dXMSEgoKZG93bmxvYWRlZBgCIAEoBBIRCglmaWxlX3NpemUYAyABKAMSGgoNZXJyb3JfbWVzc2FnZRgGIAEoCUgAiAEBEjAKC3NjYW5fc3RhdHVzGAcgASgOMhsucGIuY2xpZW50cnBjLnYxLlNjYW5TdGF0dXNCEAoOX2Vycm9yX21lc3NhZ2UiLwoEVHlwZRIUChBUWVBFX1VOU1BFQ0lGSUVEEAASEQoNVFlQRV9ET1dOTE9BRBABQgsKCV9kb3dubG9hZCKjAQoQRG93bmxvYWRIb29rSW5mbxIMCgR1dWlkGAEgASgJEhIKCmNyZWF0ZWRfdHMYAiABKAMSLwoEdHlwZRgDIAEoDjIhLnBiLmNsaWVudHJwYy52MS5Eb3dubG9hZEhvb2tUeXBlEg4KBnRhcmdldBgEIAEoCRIaCg1kb3dubG9hZF91dWlkGAUgASgJSACIAQFCEAoOX2Rvd25sb2FkX3V1aWQieAoKVXBkYXRlSW5mbxIQCghpc192YWxpZBgBIAEoCBISCgpjcmVhdGVkX3RzGAIgASgDEg8KB3ZlcnNpb24YAyABKAkSEwoLZGVzY3JpcHRpb24YBCABKAkSCwoDdXJsGAUgASgJEhEKCWNhbl9hcHBseRgGIAEoCCJTCg5VcGRhdGVTZXR0aW5ncxIvCgdjaGFubmVsGAEgASgOMh4ucGIuY2xpZW50cnBjLnYxLlVwZGF0ZUNoYW5uZWwSEAoIYmFzZV91cmwYAiABKAkidwoJRXJyb3JJbmZvEiwKBnJlYXNvbhgBIAEoDjIcLnBiLmNsaWVudHJwYy52MS5FcnJvclJlYXNvbhIUCgdtZXNzYWdlGAIgASgJSACIAQESEQoEaG9zdBgDIAEoCUgBiAEBQgoKCF9tZXNzYWdlQgcKBV9ob3N0IrYBCghSdHRTdGF0cxIPCgdsYXN0X3VzGAEgASgDEg4KBm1pbl91cxgCIAEoAxIOCgZhdmdfdXMYAyABKAMSDgoGbWF4X3VzGAQgASgDEg8KB3NhbXBsZXMYBSABKA0SDAoEbG9zdBgGIAEoBBIYChBjb25zZWN1dGl2ZV9sb3N0GAcgASgNEhwKD2Nsb2NrX29mZnNldF91cxgIIAEoA0gAiAEBQhIKEF9jbG9ja19vZmZzZXRfdXMihAMKClNlcnZlckluZm8SMAoFc3RhdGUYASABKAsyIS5wYi5jbGllbnRycGMudjEuU2VydmVySW5mby5TdGF0ZRIMCgR1dWlkGAIgASgJEgwKBG5hbWUYAyABKAkSDwoHYWRkcmVzcxgEIAEoCRIMCgRyb29tGAUgASgJEhAKCHVzZXJuYW1lGAYgASgJEhIKCmNyZWF0ZWRfdHMYByABKAMa4gEKBVN0YXRlEjQKCmNvbm5fc3RhdGUYASABKA4yIC5wYi5jbGllbnRycGMudjEuU2VydmVyQ29ublN0YXRlEiYKA3J0dBgCIAEoCzIZLnBiLmNsaWVudHJwYy52MS5SdHRTdGF0cxIRCgRtb3RkGAMgASgJSACIAQESMQoGcmVtb3RlGAQgASgLMiEucGIuY2xpZW50cnBjLnYxLlJlbW90ZVNlcnZlckluZm8SGgoNY2xvY2tfc2tld19tcxgFIAEoA0gBiAEBQgcKBV9tb3RkQhAKDl9jbG9ja19za2V3X21zIukBChBSZW1vdGVTZXJ2ZXJJbmZvEg8KB3ZlcnNpb24YASABKAkSGAoQcHJvdG9jb2xfdmVyc2lvbhgCIAEoCRIQCghmZWF0dXJlcxgDIAMoCRITCgttYXhfY2xpZW50cxgEIAEoDRIkChxtYXhfcHJveHlfc3RyZWFtc19wZXJfY2xpZW50GAUgASgNEh8KF21heF9jb25jdXJyZW50X3JlcXVlc3RzGAYgASgNEhgKEGRpcl9jYWNoZV90dGxfbXMYByABKA0SIgoacmVsYXlfbWF4X2J5dGVzX3Blcl9zZWNvbmQYCCABKAMiyQIKCVNoYXJlSW5mbxIMCgR1dWlkGAEgASgJEhMKC3NlcnZlcl91dWlkGAIgASgJEgwKBG5hbWUYAyABKAkSDAoEcGF0aBgEIAEoCRIUCgxmb2xsb3dfbGlua3MYBSABKAgSEgoKY3JlYXRlZF90cxgGIAEoAxIrCgZtb3VudHMYByADKAsyGy5wYi5jbGllbnRycGMudjEuU2hhcmVNb3VudBI5Cg1jb25mbGljdF9ydWxlGAggASgOMiIucGIuY2xpZW50cnBjLnYxLlNoYXJlQ29uZmxpY3RSdWxlEhgKEGV4Y2x1ZGVfcGF0dGVybnMYCSADKAkSGAoQY2FzZV9pbnNlbnNpdGl2ZRgKIAEoCBI3Cgx1bmljb2RlX2Zvcm0YCyABKA4yIS5wYi5jbGllbnRycGMudjEuU2hhcmVVbmljb2RlRm9ybSIwCgpTaGFyZU1vdW50EhQKDHZpcnR1YWxfcGF0aBgBIAEoCRIMCgRwYXRoGAIgASgJIqsBCg1TaGFyZUxpbmtJbmZvEg0KBXRva2VuGAEgASgJEhMKC3NlcnZlcl91dWlkGAIgASgJEhIKCnNoYXJlX25hbWUYAyABKAkSDAoEcGF0aBgEIAEoCRISCgpjcmVhdGVkX3RzGAUgASgDEhcKCmV4cGlyZXNfdHMYBiABKANIAIgBARIQCgN1cmwYByABKAlIAYgBAUINCgtfZXhwaXJlc190c0IGCgRfdXJsIrMBCg5PbmxpbmVVc2VySW5mbxIQCgh1c2VybmFtZRgBIAEoCRIwCgZmcmllbmQYAiABKAsyGy5wYi5jbGllbnRycGMudjEuRnJpZW5kSW5mb0gAiAEBEg8KB2Jsb2NrZWQYAyABKAgSMgoKZGlyZWN0X3J0dBgEIAEoCzIZLnBiLmNsaWVudHJwYy52MS5SdHRTdGF0c0gBiAEBQgkKB19mcmllbmRCDQoLX2RpcmVjdF9ydHQirQEKCkZyaWVuZEluZm8SEwoLc2VydmVyX3V1aWQYASABKAkSEAoIdXNlcm5hbWUYAiABKAkSEAoIbmlja25hbWUYAyABKAkSDAoEbm90ZRgEIAEoCRIwCgt0cnVzdF9sZXZlbBgFIAEoDjIbLnBiLmNsaWVudHJwYy52MS5UcnVzdExldmVsEhIKCmNyZWF0ZWRfdHMYBiABKAMSEgoKdXBkYXRlZF90cxgHIAEoAyJgCghGaWxlTWV0YRIMCgRuYW1lGAEgASgJEg4KBmlzX2RpchgCIAEoCBIMCgRzaXplGAMgASgEEhgKC21vZGlmaWVkX3RzGAQgASgDSACIAQFCDgoMX21vZGlmaWVkX3RzIuUBCg5EaXJlY3RTZXR0aW5ncxIPCgdkaXNhYmxlGAEgASgIEhEKCWFkZHJlc3NlcxgCIAMoCRIUCgxkZWZhdWx0X3BvcnQYAyABKA0SJgoeZGlzYWJsZV9wcm9iZV9pcHNfdG9fYWR2ZXJ0aXNlGAQgASgIEh0KFWFkdmVydGlzZV9wcml2YXRlX2lwcxgFIAEoCBIjChtkaXNhYmxlX3B1YmxpY19pcF9kaXNjb3ZlcnkYBiABKAgSFAoMZGlzYWJsZV91cG5wGAcgASgIEhcKD3VwbnBfdGltZW91dF9tcxgIIAEoDSKxAwoQVHJhbnNmZXJTZXR0aW5ncxIcChRkb3dubG9hZF9jb25jdXJyZW5jeRgBIAEoDRIfChdpbmNvbXBsZXRlX2Rvd25sb2FkX2RpchgCIAEoCRIdChVjb21wbGV0ZV9kb3dubG9hZF9kaXIYAyABKAkSHgoWZG93bmxvYWRfcGF0aF90ZW1wbGF0ZRgEIAEoCRJoCh1zZXJ2ZXJfY29tcGxldGVfZG93bmxvYWRfZGlycxgFIAMoCzJBLnBiLmNsaWVudHJwYy52MS5UcmFuc2ZlclNldHRpbmdzLlNlcnZlckNvbXBsZXRlRG93bmxvYWREaXJzRW50cnkSJAoccGFydF9maWxlc19pbl9pbmNvbXBsZXRlX2RpchgGIAEoCBIeChZzaHV0ZG93bl9ncmFjZV9zZWNvbmRzGAcgASgNEhQKDHNjYW5fY29tbWFuZBgIIAEoCRIWCg5xdWFyYW50aW5lX2RpchgJIAEoCRpBCh9TZXJ2ZXJDb21wbGV0ZURvd25sb2FkRGlyc0VudHJ5EgsKA2tleRgBIAEoCRINCgV2YWx1ZRgCIAEoCToCOAEibgoUTm90aWZpY2F0aW9uU2V0dGluZ3MSDwoHZGVza3RvcBgBIAEoCBITCgt3ZWJob29rX3VybBgCIAEoCRIZChFkb3dubG9hZF9jb21wbGV0ZRgDIAEoCBIVCg1mcmllbmRfb25saW5lGAQgASgIIhUKE1N0cmVhbUV2ZW50c1JlcXVlc3QibQoUU3RyZWFtRXZlbnRzUmVzcG9uc2USJQoFZXZlbnQYASABKAsyFi5wYi5jbGllbnRycGMudjEuRXZlbnQSLgoHY29udGV4dBgCIAEoCzIdLnBiLmNsaWVudHJwYy52MS5FdmVudENvbnRleHQiSwoRU3RyZWFtTG9nc1JlcXVlc3QSHwoSc2VuZF9sb2dzX2FmdGVyX3RzGAEgASgDSACIAQFCFQoTX3NlbmRfbG9nc19hZnRlcl90cyI/ChJTdHJlYW1Mb2dzUmVzcG9uc2USKQoEbG9ncxgBIAMoCzIbLnBiLmNsaWVudHJwYy52MS5Mb2dNZXNzYWdlIg0KC1N0b3BSZXF1ZXN0Ig4KDFN0b3BSZXNwb25zZSIWChRHZXRDbGllbnRJbmZvUmVxdWVzdCKUAQoVR2V0Q2xpZW50SW5mb1Jlc3BvbnNlEg8KB3ZlcnNpb24YASABKAkSGAoQcHJvdG9jb2xfdmVyc2lvbhgCIAEoCRIUCgxidWlsZF9jb21taXQYAyABKAkSEAoIc3RhcnRfdHMYBCABKAMSFgoOdXB0aW1lX3NlY29uZHMYBSABKAMSEAoIZmVhdHVyZXMYBiADKAkiFwoVR2V0TWVtb3J5VXNhZ2VSZXF1ZXN0IsUCChZHZXRNZW1vcnlVc2FnZVJlc3BvbnNlEhIKCmxvd19tZW1vcnkYASABKAgSGgoSbG93X21lbW9yeV9zZXR0aW5nGAIgASgIEhIKCnVzZWRfYnl0ZXMYAyABKAQSEgoKaGVhcF9ieXRlcxgEIAEoBBIYChBzb2Z0X2xpbWl0X2J5dGVzGAUgASgEEiEKGXJlYWRfYWhlYWRfYnVmZmVyZWRfYnl0ZXMYBiABKAQSHAoUbWF4X3JlYWRfYWhlYWRfYnl0ZXMYByABKAQSIAoYbWF4X2Rvd25sb2FkX2NvbmN1cnJlbmN5GAggASgNEhwKFG1heF9pbmNvbWluZ19zdHJlYW1zGAkgASgNEh8KF21heF9jb25jdXJyZW50X3JlcXVlc3RzGAogASgNEhcKD21heF9pbmRleF9maWxlcxgLIAEoDSIqChdTZXRMb3dNZW1vcnlNb2RlUmVxdWVzdBIPCgdlbmFibGVkGAEgASgIIhoKGFNldExvd01lbW9yeU1vZGVSZXNwb25zZSIyChFHZXRTZXJ2ZXJzUmVxdWVzdBINCgVsaW1pdBgBIAEoDRIOCgZjdXJzb3IYAiABKAkiZgoSR2V0U2VydmVyc1Jlc3BvbnNlEiwKB3NlcnZlcnMYASADKAsyGy5wYi5jbGllbnRycGMudjEuU2VydmVySW5mbxITCgtuZXh0X2N1cnNvchgCIAEoCRINCgV0b3RhbBgDIAEoDSKFAQoTQ3JlYXRlU2VydmVyUmVxdWVzdBIMCgRuYW1lGAEgASgJEg8KB2FkZHJlc3MYAiABKAkSDAoEcm9vbRgDIAEoCRIQCgh1c2VybmFtZRgEIAEoCRIQCghwYXNzd29yZBgFIAEoCRIdChVhY2NvdW50X3RlbXBsYXRlX3V1aWQYBiABKAkiWgoUQ3JlYXRlU2VydmVyUmVzcG9uc2USKwoGc2VydmVyGAEgASgLMhsucGIuY2xpZW50cnBjLnYxLlNlcnZlckluZm8SFQoNZmFpbGVkX3NoYXJlcxgCIAMoCSJ5ChlJbXBvcnRJbnZpdGVCdW5kbGVSZXF1ZXN0EgsKA3VybBgBIAEoCRIMCgRuYW1lGAIgASgJEhAKCHVzZXJuYW1lGAMgASgJEhAKCHBhc3N3b3JkGAQgASgJEh0KFWFjY291bnRfdGVtcGxhdGVfdXVpZBgFIAEoCSJgChpJbXBvcnRJbnZpdGVCdW5kbGVSZXNwb25zZRIrCgZzZXJ2ZXIYASABKAsyGy5wYi5jbGllbnRycGMudjEuU2VydmVySW5mbxIVCg1mYWlsZWRfc2hhcmVzGAIgAygJIkgKFEFjY291bnRUZW1wbGF0ZVNoYXJlEgwKBG5hbWUYASABKAkSDAoEcGF0aBgCIAEoCRIUCgxmb2xsb3dfbGlua3MYAyABKAgiigEKD0FjY291bnRUZW1wbGF0ZRIMCgR1dWlkGAEgASgJEgwKBG5hbWUYAiABKAkSEAoIdXNlcm5hbWUYAyABKAkSNQoGc2hhcmVzGAQgAygLMiUucGIuY2xpZW50cnBjLnYxLkFjY291bnRUZW1wbGF0ZVNoYXJlEhIKCmNyZWF0ZWRfdHMYBSABKAMihwEKHENyZWF0ZUFjY291bnRUZW1wbGF0ZVJlcXVlc3QSDAoEbmFtZRgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRIQCghwYXNzd29yZBgDIAEoCRI1CgZzaGFyZXMYBCADKAsyJS5wYi5jbGllbnRycGMudjEuQWNjb3VudFRlbXBsYXRlU2hhcmUiUwodQ3JlYXRlQWNjb3VudFRlbXBsYXRlUmVzcG9uc2USMgoIdGVtcGxhdGUYASABKAsyIC5wYi5jbGllbnRycGMudjEuQWNjb3VudFRlbXBsYXRlIksKJkNyZWF0ZUFjY291bnRUZW1wbGF0ZUZyb21TZXJ2ZXJSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJEgwKBG5hbWUYAiABKAkiXQonQ3JlYXRlQWNjb3VudFRlbXBsYXRlRnJvbVNlcnZlclJlc3BvbnNlEjIKCHRlbXBsYXRlGAEgASgLMiAucGIuY2xpZW50cnBjLnYxLkFjY291bnRUZW1wbGF0ZSIcChpHZXRBY2NvdW50VGVtcGxhdGVzUmVxdWVzdCJSChtHZXRBY2NvdW50VGVtcGxhdGVzUmVzcG9uc2USMwoJdGVtcGxhdGVzGAEgAygLMiAucGIuY2xpZW50cnBjLnYxLkFjY291bnRUZW1wbGF0ZSIsChxEZWxldGVBY2NvdW50VGVtcGxhdGVSZXF1ZXN0EgwKBHV1aWQYASABKAkiHwodRGVsZXRlQWNjb3VudFRlbXBsYXRlUmVzcG9uc2UikwEKC0hvc3RpbmdJbmZvEg8KB2hvc3RpbmcYASABKAgSDAoEcm9vbRgCIAEoCRIMCgRwb3J0GAMgASgNEhgKEGNlcnRfZmluZ2VycHJpbnQYBCABKAkSEwoLc2VydmVyX3V1aWQYBSABKAkSFgoOb25saW5lX2NsaWVudHMYBiABKA0SEAoIc3RhcnRfdHMYByABKAMiQwoTU3RhcnRIb3N0aW5nUmVxdWVzdBIMCgRyb29tGAEgASgJEgwKBHBvcnQYAiABKA0SEAoIdXNlcm5hbWUYAyABKAkiQgoUU3RhcnRIb3N0aW5nUmVzcG9uc2USKgoEaW5mbxgBIAEoCzIcLnBiLmNsaWVudHJwYy52MS5Ib3N0aW5nSW5mbyIUChJTdG9wSG9zdGluZ1JlcXVlc3QiFQoTU3RvcEhvc3RpbmdSZXNwb25zZSIXChVHZXRIb3N0aW5nSW5mb1JlcXVlc3QiRAoWR2V0SG9zdGluZ0luZm9SZXNwb25zZRIqCgRpbmZvGAEgASgLMhwucGIuY2xpZW50cnBjLnYxLkhvc3RpbmdJbmZvIi0KGkNyZWF0ZUhvc3RpbmdJbnZpdGVSZXF1ZXN0Eg8KB2FkZHJlc3MYASABKAkiKgobQ3JlYXRlSG9zdGluZ0ludml0ZVJlc3BvbnNlEgsKA3VybBgBIAEoCSIjChNEZWxldGVTZXJ2ZXJSZXF1ZXN0EgwKBHV1aWQYASABKAkiFgoURGVsZXRlU2VydmVyUmVzcG9uc2UiJAoUQ29ubmVjdFNlcnZlclJlcXVlc3QSDAoEdXVpZBgBIAEoCSIXChVDb25uZWN0U2VydmVyUmVzcG9uc2UiJwoXRGlzY29ubmVjdFNlcnZlclJlcXVlc3QSDAoEdXVpZBgBIAEoCSIaChhEaXNjb25uZWN0U2VydmVyUmVzcG9uc2UixQEKE1VwZGF0ZVNlcnZlclJlcXVlc3QSDAoEdXVpZBgBIAEoCRIRCgRuYW1lGAIgASgJSACIAQESFAoHYWRkcmVzcxgDIAEoCUgBiAEBEhEKBHJvb20YBCABKAlIAogBARIVCgh1c2VybmFtZRgFIAEoCUgDiAEBEhUKCHBhc3N3b3JkGAYgASgJSASIAQFCBwoFX25hbWVCCgoIX2FkZHJlc3NCBwoFX3Jvb21CCwoJX3VzZXJuYW1lQgsKCV9wYXNzd29yZCJDChRVcGRhdGVTZXJ2ZXJSZXNwb25zZRIrCgZzZXJ2ZXIYASABKAsyGy5wYi5jbGllbnRycGMudjEuU2VydmVySW5mbyJGChBHZXRTaGFyZXNSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJEg0KBWxpbWl0GAIgASgNEg4KBmN1cnNvchgDIAEoCSJjChFHZXRTaGFyZXNSZXNwb25zZRIqCgZzaGFyZXMYASADKAsyGi5wYi5jbGllbnRycGMudjEuU2hhcmVJbmZvEhMKC25leHRfY3Vyc29yGAIgASgJEg0KBXRvdGFsGAMgASgNIrACChJDcmVhdGVTaGFyZVJlcXVlc3QSEwoLc2VydmVyX3V1aWQYASABKAkSDAoEbmFtZRgCIAEoCRIMCgRwYXRoGAMgASgJEhQKDGZvbGxvd19saW5rcxgEIAEoCBIrCgZtb3VudHMYBSADKAsyGy5wYi5jbGllbnRycGMudjEuU2hhcmVNb3VudBI5Cg1jb25mbGljdF9ydWxlGAYgASgOMiIucGIuY2xpZW50cnBjLnYxLlNoYXJlQ29uZmxpY3RSdWxlEhgKEGV4Y2x1ZGVfcGF0dGVybnMYByADKAkSGAoQY2FzZV9pbnNlbnNpdGl2ZRgIIAEoCBI3Cgx1bmljb2RlX2Zvcm0YCSABKA4yIS5wYi5jbGllbnRycGMudjEuU2hhcmVVbmljb2RlRm9ybSJAChNDcmVhdGVTaGFyZVJlc3BvbnNlEikKBXNoYXJlGAEgASgLMhoucGIuY2xpZW50cnBjLnYxLlNoYXJlSW5mbyI3ChJEZWxldGVTaGFyZVJlcXVlc3QSEwoLc2VydmVyX3V1aWQYASABKAkSDAoEbmFtZRgCIAEoCSIVChNEZWxldGVTaGFyZVJlc3BvbnNlIl0KHlNldFNoYXJlRXhjbHVkZVBhdHRlcm5zUmVxdWVzdBITCgtzZXJ2ZXJfdXVpZBgBIAEoCRIMCgRuYW1lGAIgASgJEhgKEGV4Y2x1ZGVfcGF0dGVybnMYAyADKAkiTAofU2V0U2hhcmVFeGNsdWRlUGF0dGVybnNSZXNwb25zZRIpCgVzaGFyZRgBIAEoCzIaLnBiLmNsaWVudHJwYy52MS5TaGFyZUluZm8idwoQU2hhcmVIZWFsdGhJc3N1ZRIMCgRwYXRoGAEgASgJEjMKBGtpbmQYAiABKA4yJS5wYi5jbGllbnRycGMudjEuU2hhcmVIZWFsdGhJc3N1ZUtpbmQSFAoHbWVzc2FnZRgDIAEoCUgAiAEBQgoKCF9tZXNzYWdlImQKF0NoZWNrU2hhcmVIZWFsdGhSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJEgwKBG5hbWUYAiABKAkSFwoKbWF4X2lzc3VlcxgDIAEoDUgAiAEBQg0KC19tYXhfaXNzdWVzImAKGENoZWNrU2hhcmVIZWFsdGhSZXNwb25zZRIxCgZpc3N1ZXMYASADKAsyIS5wYi5jbGllbnRycGMudjEuU2hhcmVIZWFsdGhJc3N1ZRIRCgl0cnVuY2F0ZWQYAiABKAgiWQoQU2hhcmVJbXBvcnRFbnRyeRITCgtzZXJ2ZXJfdXVpZBgBIAEoCRIMCgRuYW1lGAIgASgJEgwKBHBhdGgYAyABKAkSFAoMZm9sbG93X2xpbmtzGAQgASgIIkIKDVNoYXJlTWFuaWZlc3QSMQoGc2hhcmVzGAEgAygLMiEucGIuY2xpZW50cnBjLnYxLlNoYXJlSW1wb3J0RW50cnkinQEKEVNoYXJlSW1wb3J0UmVzdWx0EjAKBWVudHJ5GAEgASgLMiEucGIuY2xpZW50cnBjLnYxLlNoYXJlSW1wb3J0RW50cnkSEgoFZXJyb3IYAiABKAlIAIgBARIuCgVzaGFyZRgDIAEoCzIaLnBiLmNsaWVudHJwYy52MS5TaGFyZUluZm9IAYgBAUIICgZfZXJyb3JCCAoGX3NoYXJlIogBChNJbXBvcnRTaGFyZXNSZXF1ZXN0EjIKB2VudHJpZXMYASADKAsyIS5wYi5jbGllbnRycGMudjEuU2hhcmVJbXBvcnRFbnRyeRIaCg1tYW5pZmVzdF9wYXRoGAIgASgJSACIAQESDwoHZHJ5X3J1bhgDIAEoCEIQCg5fbWFuaWZlc3RfcGF0aCJdChRJbXBvcnRTaGFyZXNSZXNwb25zZRIzCgdyZXN1bHRzGAEgAygLMiIucGIuY2xpZW50cnBjLnYxLlNoYXJlSW1wb3J0UmVzdWx0EhAKCGltcG9ydGVkGAIgASgIIocBChZDcmVhdGVTaGFyZUxpbmtSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJEhIKCnNoYXJlX25hbWUYAiABKAkSDAoEcGF0aBgDIAEoCRIfChJleHBpcmVzX2luX3NlY29uZHMYBCABKA1IAIgBAUIVChNfZXhwaXJlc19pbl9zZWNvbmRzIkcKF0NyZWF0ZVNoYXJlTGlua1Jlc3BvbnNlEiwKBGxpbmsYASABKAsyHi5wYi5jbGllbnRycGMudjEuU2hhcmVMaW5rSW5mbyI/ChRHZXRTaGFyZUxpbmtzUmVxdWVzdBITCgtzZXJ2ZXJfdXVpZBgBIAEoCRISCgpzaGFyZV9uYW1lGAIgASgJIkYKFUdldFNoYXJlTGlua3NSZXNwb25zZRItCgVsaW5rcxgBIAMoCzIeLnBiLmNsaWVudHJwYy52MS5TaGFyZUxpbmtJbmZvIicKFkRlbGV0ZVNoYXJlTGlua1JlcXVlc3QSDQoFdG9rZW4YASABKAkiGQoXRGVsZXRlU2hhcmVMaW5rUmVzcG9uc2UiSQoSR2V0RGlyRmlsZXNSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEgwKBHBhdGgYAyABKAkiQQoTR2V0RGlyRmlsZXNSZXNwb25zZRIqCgdjb250ZW50GAIgAygLMhkucGIuY2xpZW50cnBjLnYxLkZpbGVNZXRhIn4KF1N0cmVhbURpckFyY2hpdmVSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEgwKBHBhdGgYAyABKAkSLgoGZm9ybWF0GAQgASgOMh4ucGIuY2xpZW50cnBjLnYxLkFyY2hpdmVGb3JtYXQiKAoYU3RyZWFtRGlyQXJjaGl2ZVJlc3BvbnNlEgwKBGRhdGEYASABKAwiSQoSR2V0RmlsZU1ldGFSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEgwKBHBhdGgYAyABKAkiPgoTR2V0RmlsZU1ldGFSZXNwb25zZRInCgRtZXRhGAEgASgLMhkucGIuY2xpZW50cnBjLnYxLkZpbGVNZXRhIpYBChVDcmVhdGVGaWxlTGlua1JlcXVlc3QSEwoLc2VydmVyX3V1aWQYASABKAkSFQoIdXNlcm5hbWUYAiABKAlIAIgBARIMCgRwYXRoGAMgASgJEh8KEmV4cGlyZXNfaW5fc2Vjb25kcxgEIAEoDUgBiAEBQgsKCV91c2VybmFtZUIVChNfZXhwaXJlc19pbl9zZWNvbmRzIkkKFkNyZWF0ZUZpbGVMaW5rUmVzcG9uc2USDQoFdG9rZW4YASABKAkSDAoEcGF0aBgCIAEoCRISCgpleHBpcmVzX3RzGAMgASgDIrcBChBEaWFnbm9zdGljUmVzdWx0Ei0KBHN0ZXAYASABKA4yHy5wYi5jbGllbnRycGMudjEuRGlhZ25vc3RpY1N0ZXASMQoGc3RhdHVzGAIgASgOMiEucGIuY2xpZW50cnBjLnYxLkRpYWdub3N0aWNTdGF0dXMSDgoGZGV0YWlsGAMgASgJEhIKBWVycm9yGAQgASgJSACIAQESEwoLZHVyYXRpb25fdXMYBSABKANCCAoGX2Vycm9yIiYKD0RpYWdub3NlUmVxdWVzdBITCgtzZXJ2ZXJfdXVpZBgBIAEoCSJGChBEaWFnbm9zZVJlc3BvbnNlEjIKB3Jlc3VsdHMYASADKAsyIS5wYi5jbGllbnRycGMudjEuRGlhZ25vc3RpY1Jlc3VsdCK2AQoSTWVhc3VyZVBlZXJSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJEicKBHBhdGgYAyABKA4yGS5wYi5jbGllbnRycGMudjEuUGVlclBhdGgSEgoFcGluZ3MYBCABKA1IAIgBARIdChB0aHJvdWdocHV0X2J5dGVzGAUgASgESAGIAQFCCAoGX3BpbmdzQhMKEV90aHJvdWdocHV0X2J5dGVzIrABChNNZWFzdXJlUGVlclJlc3BvbnNlEicKBHBhdGgYASABKA4yGS5wYi5jbGllbnRycGMudjEuUGVlclBhdGgSFgoObGF0ZW5jeV9taW5fdXMYAiABKAMSFgoObGF0ZW5jeV9hdmdfdXMYAyABKAMSFgoObGF0ZW5jeV9tYXhfdXMYBCABKAMSFAoMZG93bmxvYWRfYnBzGAUgASgBEhIKCnVwbG9hZF9icHMYBiABKAEiLAoVR2V0T25saW5lVXNlcnNSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJIkgKFkdldE9ubGluZVVzZXJzUmVzcG9uc2USLgoFdXNlcnMYASADKAsyHy5wYi5jbGllbnRycGMudjEuT25saW5lVXNlckluZm8iYwocQ2hhbmdlQWNjb3VudFBhc3N3b3JkUmVxdWVzdBITCgtzZXJ2ZXJfdXVpZBgBIAEoCRIYChBjdXJyZW50X3Bhc3N3b3JkGAIgASgJEhQKDG5ld19wYXNzd29yZBgDIAEoCSIfCh1DaGFuZ2VBY2NvdW50UGFzc3dvcmRSZXNwb25zZSIkChRTZXJ2ZXJDb25uZWN0UmVxdWVzdBIMCgR1dWlkGAEgASgJIhcKFVNlcnZlckNvbm5lY3RSZXNwb25zZSInChdTZXJ2ZXJEaXNjb25uZWN0UmVxdWVzdBIMCgR1dWlkGAEgASgJIhoKGFNlcnZlckRpc2Nvbm5lY3RSZXNwb25zZSIaChhHZXREaXJlY3RTZXR0aW5nc1JlcXVlc3QiTgoZR2V0RGlyZWN0U2V0dGluZ3NSZXNwb25zZRIxCghzZXR0aW5ncxgBIAEoCzIfLnBiLmNsaWVudHJwYy52MS5EaXJlY3RTZXR0aW5ncyJQChtVcGRhdGVEaXJlY3RTZXR0aW5nc1JlcXVlc3QSMQoIc2V0dGluZ3MYASABKAsyHy5wYi5jbGllbnRycGMudjEuRGlyZWN0U2V0dGluZ3MiHgocVXBkYXRlRGlyZWN0U2V0dGluZ3NSZXNwb25zZSIcChpHZXRUcmFuc2ZlclNldHRpbmdzUmVxdWVzdCJSChtHZXRUcmFuc2ZlclNldHRpbmdzUmVzcG9uc2USMwoIc2V0dGluZ3MYASABKAsyIS5wYi5jbGllbnRycGMudjEuVHJhbnNmZXJTZXR0aW5ncyJUCh1VcGRhdGVUcmFuc2ZlclNldHRpbmdzUmVxdWVzdBIzCghzZXR0aW5ncxgBIAEoCzIhLnBiLmNsaWVudHJwYy52MS5UcmFuc2ZlclNldHRpbmdzIiAKHlVwZGF0ZVRyYW5zZmVyU2V0dGluZ3NSZXNwb25zZSIgCh5HZXROb3RpZmljYXRpb25TZXR0aW5nc1JlcXVlc3QiWgofR2V0Tm90aWZpY2F0aW9uU2V0dGluZ3NSZXNwb25zZRI3CghzZXR0aW5ncxgBIAEoCzIlLnBiLmNsaWVudHJwYy52MS5Ob3RpZmljYXRpb25TZXR0aW5ncyJcCiFVcGRhdGVOb3RpZmljYXRpb25TZXR0aW5nc1JlcXVlc3QSNwoIc2V0dGluZ3MYASABKAsyJS5wYi5jbGllbnRycGMudjEuTm90aWZpY2F0aW9uU2V0dGluZ3MiJAoiVXBkYXRlTm90aWZpY2F0aW9uU2V0dGluZ3NSZXNwb25zZSInChNFeHBvcnRDb25maWdSZXF1ZXN0EhAKCHBhc3N3b3JkGAEgASgJIiYKFEV4cG9ydENvbmZpZ1Jlc3BvbnNlEg4KBmJ1bmRsZRgBIAEoDCI3ChNJbXBvcnRDb25maWdSZXF1ZXN0Eg4KBmJ1bmRsZRgBIAEoDBIQCghwYXNzd29yZBgCIAEoCSJ0ChRJbXBvcnRDb25maWdSZXNwb25zZRIsCgdzZXJ2ZXJzGAEgAygLMhsucGIuY2xpZW50cnBjLnYxLlNlcnZlckluZm8SFwoPc2tpcHBlZF9zZXJ2ZXJzGAIgASgNEhUKDWZhaWxlZF9zaGFyZXMYAyADKAkiJQoVQmFja3VwRGF0YWJhc2VSZXF1ZXN0EgwKBHBhdGgYASABKAkiGAoWQmFja3VwRGF0YWJhc2VSZXNwb25zZSIfCh1DaGVja0RhdGFiYXNlSW50ZWdyaXR5UmVxdWVzdCIyCh5DaGVja0RhdGFiYXNlSW50ZWdyaXR5UmVzcG9uc2USEAoIcHJvYmxlbXMYASADKAkiNgoRSW5kZXhTaGFyZVJlcXVlc3QSEwoLc2VydmVyX3V1aWQYASABKAkSDAoEbmFtZRgCIAEoCSIUChJJbmRleFNoYXJlUmVzcG9uc2UiXQoTU3RyZWFtU2VhcmNoUmVxdWVzdBITCgtzZXJ2ZXJfdXVpZBgBIAEoCRIVCgh1c2VybmFtZRgCIAEoCUgAiAEBEg0KBXF1ZXJ5GAMgASgJQgsKCV91c2VybmFtZSK3AQoUU3RyZWFtU2VhcmNoUmVzcG9uc2USEAoIdXNlcm5hbWUYASABKAkSFgoOZGlyZWN0b3J5X3BhdGgYAiABKAkSJwoEZmlsZRgDIAEoCzIZLnBiLmNsaWVudHJwYy52MS5GaWxlTWV0YRIPCgdzbmlwcGV0GAQgASgJEjAKBmZyaWVuZBgFIAEoCzIbLnBiLmNsaWVudHJwYy52MS5GcmllbmRJbmZvSACIAQFCCQoHX2ZyaWVuZCIWChRHZXRVcGRhdGVJbmZvUmVxdWVzdCKiAQoVR2V0VXBkYXRlSW5mb1Jlc3BvbnNlEjEKDGN1cnJlbnRfaW5mbxgBIAEoCzIbLnBiLmNsaWVudHJwYy52MS5VcGRhdGVJbmZvEjIKCG5ld19pbmZvGAIgASgLMhsucGIuY2xpZW50cnBjLnYxLlVwZGF0ZUluZm9IAIgBARIVCg11cGRhdGVfc3RhZ2VkGAMgASgIQgsKCV9uZXdfaW5mbyIaChhDaGVja0Zvck5ld1VwZGF0ZVJlcXVlc3QiXAoZQ2hlY2tGb3JOZXdVcGRhdGVSZXNwb25zZRIyCghuZXdfaW5mbxgBIAEoCzIbLnBiLmNsaWVudHJwYy52MS5VcGRhdGVJbmZvSACIAQFCCwoJX25ld19pbmZvIhQKEkFwcGx5VXBkYXRlUmVxdWVzdCJAChNBcHBseVVwZGF0ZVJlc3BvbnNlEikKBGluZm8YASABKAsyGy5wYi5jbGllbnRycGMudjEuVXBkYXRlSW5mbyIaChhHZXRVcGRhdGVTZXR0aW5nc1JlcXVlc3QiTgoZR2V0VXBkYXRlU2V0dGluZ3NSZXNwb25zZRIxCghzZXR0aW5ncxgBIAEoCzIfLnBiLmNsaWVudHJwYy52MS5VcGRhdGVTZXR0aW5ncyJQChtVcGRhdGVVcGRhdGVTZXR0aW5nc1JlcXVlc3QSMQoIc2V0dGluZ3MYASABKAsyHy5wYi5jbGllbnRycGMudjEuVXBkYXRlU2V0dGluZ3MiHgocVXBkYXRlVXBkYXRlU2V0dGluZ3NSZXNwb25zZSIgCh5HZXREb3dubG9hZE1hbmFnZXJJdGVtc1JlcXVlc3QiVgofR2V0RG93bmxvYWRNYW5hZ2VySXRlbXNSZXNwb25zZRIzCgVpdGVtcxgBIAMoCzIkLnBiLmNsaWVudHJwYy52MS5Eb3dubG9hZE1hbmFnZXJJdGVtIpUBChhRdWV1ZUZpbGVEb3dubG9hZFJlcXVlc3QSEwoLc2VydmVyX3V1aWQYASABKAkSFQoNcGVlcl91c2VybmFtZRgCIAEoCRIRCglmaWxlX3BhdGgYAyABKAkSOgoQZHVwbGljYXRlX2FjdGlvbhgEIAEoDjIgLnBiLmNsaWVudHJwYy52MS5EdXBsaWNhdGVBY3Rpb24iiwEKGVF1ZXVlRmlsZURvd25sb2FkUmVzcG9uc2USNgoJZHVwbGljYXRlGAEgASgLMh4ucGIuY2xpZW50cnBjLnYxLkR1cGxpY2F0ZUZpbGVIAIgBARIYCgtsaW5rZWRfcGF0aBgCIAEoCUgBiAEBQgwKCl9kdXBsaWNhdGVCDgoMX2xpbmtlZF9wYXRoIkgKDUR1cGxpY2F0ZUZpbGUSEgoKbG9jYWxfcGF0aBgBIAEoCRIMCgRzaXplGAIgASgEEhUKDWRvd25sb2FkZWRfdHMYAyABKAMiKQoZQ2FuY2VsRmlsZURvd25sb2FkUmVxdWVzdBIMCgR1dWlkGAEgASgJIhwKGkNhbmNlbEZpbGVEb3dubG9hZFJlc3BvbnNlIjAKIFJlbW92ZURvd25sb2FkTWFuYWdlckl0ZW1SZXF1ZXN0EgwKBHV1aWQYASABKAkiIwohUmVtb3ZlRG93bmxvYWRNYW5hZ2VySXRlbVJlc3BvbnNlIigKGFBhdXNlRmlsZURvd25sb2FkUmVxdWVzdBIMCgR1dWlkGAEgASgJIhsKGVBhdXNlRmlsZURvd25sb2FkUmVzcG9uc2UiKQoZUmVzdW1lRmlsZURvd25sb2FkUmVxdWVzdBIMCgR1dWlkGAEgASgJIhwKGlJlc3VtZUZpbGVEb3dubG9hZFJlc3BvbnNlIhkKF0dldERvd25sb2FkSG9va3NSZXF1ZXN0IkwKGEdldERvd25sb2FkSG9va3NSZXNwb25zZRIwCgVob29rcxgBIAMoCzIhLnBiLmNsaWVudHJwYy52MS5Eb3dubG9hZEhvb2tJbmZvIooBChlDcmVhdGVEb3dubG9hZEhvb2tSZXF1ZXN0Ei8KBHR5cGUYASABKA4yIS5wYi5jbGllbnRycGMudjEuRG93bmxvYWRIb29rVHlwZRIOCgZ0YXJnZXQYAiABKAkSGgoNZG93bmxvYWRfdXVpZBgDIAEoCUgAiAEBQhAKDl9kb3dubG9hZF91dWlkIk0KGkNyZWF0ZURvd25sb2FkSG9va1Jlc3BvbnNlEi8KBGhvb2sYASABKAsyIS5wYi5jbGllbnRycGMudjEuRG93bmxvYWRIb29rSW5mbyIpChlEZWxldGVEb3dubG9hZEhvb2tSZXF1ZXN0EgwKBHV1aWQYASABKAkiHAoaRGVsZXRlRG93bmxvYWRIb29rUmVzcG9uc2UiKgoRR2V0VXBsb2Fkc1JlcXVlc3QSFQoNaGlzdG9yeV9saW1pdBgBIAEoDSJvChJHZXRVcGxvYWRzUmVzcG9uc2USKwoGYWN0aXZlGAEgAygLMhsucGIuY2xpZW50cnBjLnYxLlVwbG9hZEluZm8SLAoHaGlzdG9yeRgCIAMoCzIbLnBiLmNsaWVudHJwYy52MS5VcGxvYWRJbmZvIhsKGUNsZWFyVXBsb2FkSGlzdG9yeVJlcXVlc3QiHAoaQ2xlYXJVcGxvYWRIaXN0b3J5UmVzcG9uc2UiKAoRR2V0RnJpZW5kc1JlcXVlc3QSEwoLc2VydmVyX3V1aWQYASABKAkiQgoSR2V0RnJpZW5kc1Jlc3BvbnNlEiwKB2ZyaWVuZHMYASADKAsyGy5wYi5jbGllbnRycGMudjEuRnJpZW5kSW5mbyKLAQoQU2V0RnJpZW5kUmVxdWVzdBITCgtzZXJ2ZXJfdXVpZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCRIQCghuaWNrbmFtZRgDIAEoCRIMCgRub3RlGAQgASgJEjAKC3RydXN0X2xldmVsGAUgASgOMhsucGIuY2xpZW50cnBjLnYxLlRydXN0TGV2ZWwiQAoRU2V0RnJpZW5kUmVzcG9uc2USKwoGZnJpZW5kGAEgASgLMhsucGIuY2xpZW50cnBjLnYxLkZyaWVuZEluZm8iPAoTRGVsZXRlRnJpZW5kUmVxdWVzdBITCgtzZXJ2ZXJfdXVpZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCSIWChREZWxldGVGcmllbmRSZXNwb25zZSI3Cg9CbG9ja2VkUGVlckluZm8SEAoIdXNlcm5hbWUYASABKAkSEgoKY3JlYXRlZF90cxgCIAEoAyItChZHZXRCbG9ja2VkUGVlcnNSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJIkoKF0dldEJsb2NrZWRQZWVyc1Jlc3BvbnNlEi8KBXBlZXJzGAEgAygLMiAucGIuY2xpZW50cnBjLnYxLkJsb2NrZWRQZWVySW5mbyI5ChBCbG9ja1BlZXJSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJEhAKCHVzZXJuYW1lGAIgASgJIhMKEUJsb2NrUGVlclJlc3BvbnNlIjsKElVuYmxvY2tQZWVyUmVxdWVzdBITCgtzZXJ2ZXJfdXVpZBgBIAEoCRIQCgh1c2VybmFtZRgCIAEoCSIVChNVbmJsb2NrUGVlclJlc3BvbnNlIhUKE0dldEludGVyZXN0c1JlcXVlc3QiKQoUR2V0SW50ZXJlc3RzUmVzcG9uc2USEQoJaW50ZXJlc3RzGAEgAygJIigKE1NldEludGVyZXN0c1JlcXVlc3QSEQoJaW50ZXJlc3RzGAEgAygJIikKFFNldEludGVyZXN0c1Jlc3BvbnNlEhEKCWludGVyZXN0cxgBIAMoCSJVCg9TaW1pbGFyVXNlckluZm8SEAoIdXNlcm5hbWUYASABKAkSGAoQc2hhcmVkX2ludGVyZXN0cxgCIAMoCRIWCg5pbnRlcmVzdF9jb3VudBgDIAEoDSJLChZHZXRTaW1pbGFyVXNlcnNSZXF1ZXN0EhMKC3NlcnZlcl91dWlkGAEgASgJEhIKBWxpbWl0GAIgASgNSACIAQFCCAoGX2xpbWl0IkoKF0dldFNpbWlsYXJVc2Vyc1Jlc3BvbnNlEi8KBXVzZXJzGAEgAygLMiAucGIuY2xpZW50cnBjLnYxLlNpbWlsYXJVc2VySW5mbyJICgpDb25uV2luZG93EhAKCHdlZWtkYXlzGAEgASgNEhQKDHN0YXJ0X21pbnV0ZRgCIAEoDRISCgplbmRfbWludXRlGAMgASgNIi8KGEdldFNlcnZlclNjaGVkdWxlUmVxdWVzdBITCgtzZXJ2ZXJfdXVpZBgBIAEoCSJeChlHZXRTZXJ2ZXJTY2hlZHVsZVJlc3BvbnNlEiwKB3dpbmRvd3MYASADKAsyGy5wYi5jbGllbnRycGMudjEuQ29ubldpbmRvdxITCgthbGxvd2VkX25vdxgCIAEoCCJdChhTZXRTZXJ2ZXJTY2hlZHVsZVJlcXVlc3QSEwoLc2VydmVyX3V1aWQYASABKAkSLAoHd2luZG93cxgCIAMoCzIbLnBiLmNsaWVudHJwYy52MS5Db25uV2luZG93IhsKGVNldFNlcnZlclNjaGVkdWxlUmVzcG9uc2UiVQoKU25vb3plSW5mbxIOCgZhY3RpdmUYASABKAgSFQoIdW50aWxfdHMYAiABKANIAIgBARITCgtoaWRlX3NoYXJlcxgDIAEoCEILCglfdW50aWxfdHMiEgoQR2V0U25vb3plUmVxdWVzdCJAChFHZXRTbm9vemVSZXNwb25zZRIrCgZzbm9vemUYASABKAsyGy5wYi5jbGllbnRycGMudjEuU25vb3plSW5mbyJYCg1Tbm9vemVSZXF1ZXN0Eh0KEGR1cmF0aW9uX3NlY29uZHMYASABKA1IAIgBARITCgtoaWRlX3NoYXJlcxgCIAEoCEITChFfZHVyYXRpb25fc2Vjb25kcyI9Cg5Tbm9vemVSZXNwb25zZRIrCgZzbm9vemUYASABKAsyGy5wYi5jbGllbnRycGMudjEuU25vb3plSW5mbyIRCg9VbnNub296ZVJlcXVlc3QiEgoQVW5zbm9vemVSZXNwb25zZSJ/Cg5SdW5TZXNzaW9uSW5mbxIMCgR1dWlkGAEgASgJEhIKCnN0YXJ0ZWRfdHMYAiABKAMSFwoKc3RvcHBlZF90cxgDIAEoA0gAiAEBEg8KB2NyYXNoZWQYBCABKAgSEgoKaXNfY3VycmVudBgFIAEoCEINCgtfc3RvcHBlZF90cyLeAQoPQ29ublNlc3Npb25JbmZvEgwKBHV1aWQYASABKAkSEAoIcnVuX3V1aWQYAiABKAkSEwoLc2VydmVyX3V1aWQYAyABKAkSFAoMY29ubmVjdGVkX3RzGAQgASgDEhwKD2Rpc2Nvbm5lY3RlZF90cxgFIAEoA0gAiAEBEhgKEGR1cmF0aW9uX3NlY29uZHMYBiABKAMSHgoRZGlzY29ubmVjdF9yZWFzb24YByABKAlIAYgBAUISChBfZGlzY29ubmVjdGVkX3RzQhQKEl9kaXNjb25uZWN0X3JlYXNvbiIlChRHZXRSdW5IaXN0b3J5UmVxdWVzdBINCgVsaW1pdBgBIAEoDSJGChVHZXRSdW5IaXN0b3J5UmVzcG9uc2USLQoEcnVucxgBIAMoCzIfLnBiLmNsaWVudHJwYy52MS5SdW5TZXNzaW9uSW5mbyI7ChVHZXRDb25uSGlzdG9yeVJlcXVlc3QSEwoLc2VydmVyX3V1aWQYASABKAkSDQoFbGltaXQYAiABKA0iTAoWR2V0Q29ubkhpc3RvcnlSZXNwb25zZRIyCghzZXNzaW9ucxgBIAMoCzIgLnBiLmNsaWVudHJwYy52MS5Db25uU2Vzc2lvbkluZm8ilgEKDVRyYXNoZWRTZXJ2ZXISDAoEdXVpZBgBIAEoCRIMCgRuYW1lGAIgASgJEg8KB2FkZHJlc3MYAyABKAkSDAoEcm9vbRgEIAEoCRIQCgh1c2VybmFtZRgFIAEoCRISCgpjcmVhdGVkX3RzGAYgASgDEhIKCmRlbGV0ZWRfdHMYByABKAMSEAoIcHVyZ2VfdHMYCCABKAMiXwoMVHJhc2hlZFNoYXJlEikKBXNoYXJlGAEgASgLMhoucGIuY2xpZW50cnBjLnYxLlNoYXJlSW5mbxISCgpkZWxldGVkX3RzGAIgASgDEhAKCHB1cmdlX3RzGAMgASgDIhEKD0dldFRyYXNoUmVxdWVzdCJyChBHZXRUcmFzaFJlc3BvbnNlEi8KB3NlcnZlcnMYASADKAsyHi5wYi5jbGllbnRycGMudjEuVHJhc2hlZFNlcnZlchItCgZzaGFyZXMYAiADKAsyHS5wYi5jbGllbnRycGMudjEuVHJhc2hlZFNoYXJlIiQKFFJlc3RvcmVTZXJ2ZXJSZXF1ZXN0EgwKBHV1aWQYASABKAkiRAoVUmVzdG9yZVNlcnZlclJlc3BvbnNlEisKBnNlcnZlchgBIAEoCzIbLnBiLmNsaWVudHJwYy52MS5TZXJ2ZXJJbmZvIiIKElB1cmdlU2VydmVyUmVxdWVzdBIMCgR1dWlkGAEgASgJIhUKE1B1cmdlU2VydmVyUmVzcG9uc2UiOAoTUmVzdG9yZVNoYXJlUmVxdWVzdBITCgtzZXJ2ZXJfdXVpZBgBIAEoCRIMCgRuYW1lGAIgASgJIkEKFFJlc3RvcmVTaGFyZVJlc3BvbnNlEikKBXNoYXJlGAEgASgLMhoucGIuY2xpZW50cnBjLnYxLlNoYXJlSW5mbyI2ChFQdXJnZVNoYXJlUmVxdWVzdBITCgtzZXJ2ZXJfdXVpZBgBIAEoCRIMCgRuYW1lGAIgASgJIhQKElB1cmdlU2hhcmVSZXNwb25zZSJyCgpQbHVnaW5JbmZvEgwKBG5hbWUYASABKAkSEgoKY3JlYXRlZF90cxgCIAEoAxIsCgZzY29wZXMYAyADKA4yHC5wYi5jbGllbnRycGMudjEuUGx1Z2luU2NvcGUSFAoMb3Blbl9zdHJlYW1zGAQgASgNIqUDCgtQbHVnaW5FdmVudBIuCgR0eXBlGAEgASgOMiAucGIuY2xpZW50cnBjLnYxLlBsdWdpbkV2ZW50VHlwZRJDCgxjbGllbnRfZXZlbnQYAiABKAsyKC5wYi5jbGllbnRycGMudjEuUGx1Z2luRXZlbnQuQ2xpZW50RXZlbnRIAIgBARI4CgZzZWFyY2gYAyABKAsyIy5wYi5jbGllbnRycGMudjEuUGx1Z2luRXZlbnQuU2VhcmNoSAGIAQEaZAoLQ2xpZW50RXZlbnQSJQoFZXZlbnQYASABKAsyFi5wYi5jbGllbnRycGMudjEuRXZlbnQSLgoHY29udGV4dBgCIAEoCzIdLnBiLmNsaWVudHJwYy52MS5FdmVudENvbnRleHQaZQoGU2VhcmNoEgoKAmlkGAEgASgJEhMKC3NlcnZlcl91dWlkGAIgASgJEg0KBXF1ZXJ5GAMgASgJEhMKC21heF9yZXN1bHRzGAQgASgNEhYKDmRlYWRsaW5lX3RzX21zGAUgASgDQg8KDV9jbGllbnRfZXZlbnRCCQoHX3NlYXJjaCJmChJQbHVnaW5TZWFyY2hSZXN1bHQSFgoOZGlyZWN0b3J5X3BhdGgYASABKAkSJwoEZmlsZRgCIAEoCzIZLnBiLmNsaWVudHJwYy52MS5GaWxlTWV0YRIPCgdzbmlwcGV0GAMgASgJIhMKEUdldFBsdWdpbnNSZXF1ZXN0IkIKEkdldFBsdWdpbnNSZXNwb25zZRIsCgdwbHVnaW5zGAEgAygLMhsucGIuY2xpZW50cnBjLnYxLlBsdWdpbkluZm8iUQoTQ3JlYXRlUGx1Z2luUmVxdWVzdBIMCgRuYW1lGAEgASgJEiwKBnNjb3BlcxgCIAMoDjIcLnBiLmNsaWVudHJwYy52MS5QbHVnaW5TY29wZSJSChRDcmVhdGVQbHVnaW5SZXNwb25zZRIrCgZwbHVnaW4YASABKAsyGy5wYi5jbGllbnRycGMudjEuUGx1Z2luSW5mbxINCgV0b2tlbhgCIAEoCSIjChNEZWxldGVQbHVnaW5SZXF1ZXN0EgwKBG5hbWUYASABKAkiFgoURGVsZXRlUGx1Z2luUmVzcG9uc2UiTAoZU3RyZWFtUGx1Z2luRXZlbnRzUmVxdWVzdBIvCgV0eXBlcxgBIAMoDjIgLnBiLmNsaWVudHJwYy52MS5QbHVnaW5FdmVudFR5cGUiSQoaU3RyZWFtUGx1Z2luRXZlbnRzUmVzcG9uc2USKwoFZXZlbnQYASABKAsyHC5wYi5jbGllbnRycGMudjEuUGx1Z2luRXZlbnQiYQoWUmVzcG9uZFRvU2VhcmNoUmVxdWVzdBIRCglzZWFyY2hfaWQYASABKAkSNAoHcmVzdWx0cxgCIAMoCzIjLnBiLmNsaWVudHJwYy52MS5QbHVnaW5TZWFyY2hSZXN1bHQiGQoXUmVzcG9uZFRvU2VhcmNoUmVzcG9uc2UilQEKDUJyaWRnZVJlcXVlc3QSMAoEdHlwZRgBIAEoDjIiLnBiLmNsaWVudHJwYy52MS5CcmlkZ2VSZXF1ZXN0VHlwZRITCgtzZXJ2ZXJfdXVpZBgCIAEoCRIQCgh1c2VybmFtZRgDIAEoCRIMCgRwYXRoGAQgASgJEg4KBm9mZnNldBgFIAEoBBINCgVsaW1pdBgGIAEoBCJkCgtCcmlkZ2VFcnJvchIMCgRjb2RlGAEgASgJEg8KB21lc3NhZ2UYAiABKAkSLQoEaW5mbxgDIAEoCzIaLnBiLmNsaWVudHJwYy52MS5FcnJvckluZm9IAIgBAUIHCgVfaW5mbyKtAQoOQnJpZGdlUmVzcG9uc2USMAoFZXJyb3IYASABKAsyHC5wYi5jbGllbnRycGMudjEuQnJpZGdlRXJyb3JIAIgBARIsCgRtZXRhGAIgASgLMhkucGIuY2xpZW50cnBjLnYxLkZpbGVNZXRhSAGIAQESKAoFZmlsZXMYAyADKAsyGS5wYi5jbGllbnRycGMudjEuRmlsZU1ldGFCCAoGX2Vycm9yQgcKBV9tZXRhKtkBCg5Eb3dubG9hZFN0YXR1cxIfChtET1dOTE9BRF9TVEFUVVNfVU5TUEVDSUZJRUQQABIaChZET1dOTE9BRF9TVEFUVVNfUVVFVUVEEAESGwoXRE9XTkxPQURfU1RBVFVTX1BFTkRJTkcQAhIcChhET1dOTE9BRF9TVEFUVVNfQ0FOQ0VMRUQQAxIYChRET1dOTE9BRF9TVEFUVVNfRE9ORRAEEhkKFURPV05MT0FEX1NUQVRVU19FUlJPUhAFEhoKFkRPV05MT0FEX1NUQVRVU19QQVVTRUQQBipyCgpTY2FuU3RhdHVzEhsKF1NDQU5fU1RBVFVTX1VOU1BFQ0lGSUVEEAASFQoRU0NBTl9TVEFUVVNfQ0xFQU4QARIYChRTQ0FOX1NUQVRVU19JTkZFQ1RFRBACEhYKElNDQU5fU1RBVFVTX0ZBSUxFRBADKpkBCgxVcGxvYWRTdGF0dXMSHQoZVVBMT0FEX1NUQVRVU19VTlNQRUNJRklFRBAAEh0KGVVQTE9BRF9TVEFUVVNfSU5fUFJPR1JFU1MQARIWChJVUExPQURfU1RBVFVTX0RPTkUQAhIaChZVUExPQURfU1RBVFVTX0NBTkNFTEVEEAMSFwoTVVBMT0FEX1NUQVRVU19FUlJPUhAEKmIKDUFyY2hpdmVGb3JtYXQSHgoaQVJDSElWRV9GT1JNQVRfVU5TUEVDSUZJRUQQABIWChJBUkNISVZFX0ZPUk1BVF9aSVAQARIZChVBUkNISVZFX0ZPUk1BVF9UQVJfR1oQAipQCghQZWVyUGF0aBIZChVQRUVSX1BBVEhfVU5TUEVDSUZJRUQQABITCg9QRUVSX1BBVEhfUFJPWFkQARIUChBQRUVSX1BBVEhfRElSRUNUEAIqdgoQRG93bmxvYWRIb29rVHlwZRIiCh5ET1dOTE9BRF9IT09LX1RZUEVfVU5TUEVDSUZJRUQQABIeChpET1dOTE9BRF9IT09LX1RZUEVfQ09NTUFORBABEh4KGkRPV05MT0FEX0hPT0tfVFlQRV9XRUJIT09LEAIqYwoNVXBkYXRlQ2hhbm5lbBIeChpVUERBVEVfQ0hBTk5FTF9VTlNQRUNJRklFRBAAEhkKFVVQREFURV9DSEFOTkVMX1NUQUJMRRABEhcKE1VQREFURV9DSEFOTkVMX0JFVEEQAirDBQoLRXJyb3JSZWFzb24SHAoYRVJST1JfUkVBU09OX1VOU1BFQ0lGSUVEEAASKQolRVJST1JfUkVBU09OX0FVVEhfSU5WQUxJRF9DUkVERU5USUFMUxABEhwKGEVSUk9SX1JFQVNPTl9BVVRIX0JBTk5FRBACEicKI0VSUk9SX1JFQVNPTl9BVVRIX0FMUkVBRFlfQ09OTkVDVEVEEAMSIgoeRVJST1JfUkVBU09OX0FVVEhfUkFURV9MSU1JVEVEEAQSKwonRVJST1JfUkVBU09OX0FVVEhfUkVHSVNUUkFUSU9OX0RJU0FCTEVEEAUSKQolRVJST1JfUkVBU09OX0FVVEhfSU5WQUxJRF9JTlZJVEVfQ09ERRAGEiQKIEVSUk9SX1JFQVNPTl9BVVRIX1VTRVJOQU1FX1RBS0VOEAcSJgoiRVJST1JfUkVBU09OX0FVVEhfSU5WQUxJRF9QQVNTV09SRBAIEh4KGkVSUk9SX1JFQVNPTl9BVVRIX1JFSkVDVEVEEAkSIAocRVJST1JfUkVBU09OX1ZFUlNJT05fVE9PX09MRBAKEiAKHEVSUk9SX1JFQVNPTl9WRVJTSU9OX1RPT19ORVcQCxIhCh1FUlJPUl9SRUFTT05fVkVSU0lPTl9SRUpFQ1RFRBAMEh4KGkVSUk9SX1JFQVNPTl9DRVJUX01JU01BVENIEA0SKgomRVJST1JfUkVBU09OX0NFUlRfRklOR0VSUFJJTlRfTUlTTUFUQ0gQDhIjCh9FUlJPUl9SRUFTT05fQ0VSVF9OT1RfVkFMSURfTk9XEA8SIAocRVJST1JfUkVBU09OX05PX1NFUlZFUl9DRVJUUxAQEiEKHUVSUk9SX1JFQVNPTl9QRUVSX1VOUkVBQ0hBQkxFEBESHQoZRVJST1JfUkVBU09OX1BFRVJfVElNRU9VVBASKo0BCg9TZXJ2ZXJDb25uU3RhdGUSIQodU0VSVkVSX0NPTk5fU1RBVEVfVU5TUEVDSUZJRUQQABIcChhTRVJWRVJfQ09OTl9TVEFURV9DTE9TRUQQARIdChlTRVJWRVJfQ09OTl9TVEFURV9PUEVOSU5HEAISGgoWU0VSVkVSX0NPTk5fU1RBVEVfT1BFThADKosBChBTaGFyZVVuaWNvZGVGb3JtEiIKHlNIQVJFX1VOSUNPREVfRk9STV9VTlNQRUNJRklFRBAAEhsKF1NIQVJFX1VOSUNPREVfRk9STV9OT05FEAESGgoWU0hBUkVfVU5JQ09ERV9GT1JNX05GQxACEhoKFlNIQVJFX1VOSUNPREVfRk9STV9ORkQQAyp3ChFTaGFyZUNvbmZsaWN0UnVsZRIjCh9TSEFSRV9DT05GTElDVF9SVUxFX1VOU1BFQ0lGSUVEEAASHQoZU0hBUkVfQ09ORkxJQ1RfUlVMRV9GSVJTVBABEh4KGlNIQVJFX0NPTkZMSUNUX1JVTEVfTkVXRVNUEAIqXgoKVHJ1c3RMZXZlbBIbChdUUlVTVF9MRVZFTF9VTlNQRUNJRklFRBAAEhoKFlRSVVNUX0xFVkVMX0RJU1RSVVNURUQQARIXChNUUlVTVF9MRVZFTF9UUlVTVEVEEAIqjwIKFFNoYXJlSGVhbHRoSXNzdWVLaW5kEicKI1NIQVJFX0hFQUxUSF9JU1NVRV9LSU5EX1VOU1BFQ0lGSUVEEAASKAokU0hBUkVfSEVBTFRIX0lTU1VFX0tJTkRfSU5WQUxJRF9OQU1FEAESJgoiU0hBUkVfSEVBTFRIX0lTU1VFX0tJTkRfVU5SRUFEQUJMRRACEicKI1NIQVJFX0hFQUxUSF9JU1NVRV9LSU5EX0JST0tFTl9MSU5LEAMSKAokU0hBUkVfSEVBTFRIX0lTU1VFX0tJTkRfV0lORE9XU19OQU1FEAQSKQolU0hBUkVfSEVBTFRIX0lTU1VFX0tJTkRfTkFNRV9UT09fTE9ORxAFKt4BCg5EaWFnbm9zdGljU3RlcBIfChtESUFHTk9TVElDX1NURVBfVU5TUEVDSUZJRUQQABIbChdESUFHTk9TVElDX1NURVBfUkVTT0xWRRABEh0KGURJQUdOT1NUSUNfU1RFUF9VRFBfUFJPQkUQAhIiCh5ESUFHTk9TVElDX1NURVBfUVVJQ19IQU5EU0hBS0UQAxInCiNESUFHTk9TVElDX1NURVBfVkVSU0lPTl9ORUdPVElBVElPThAEEiIKHkRJQUdOT1NUSUNfU1RFUF9BVVRIRU5USUNBVElPThAFKrABChBEaWFnbm9zdGljU3RhdHVzEiEKHURJQUdOT1NUSUNfU1RBVFVTX1VOU1BFQ0lGSUVEEAASGAoURElBR05PU1RJQ19TVEFUVVNfT0sQARIcChhESUFHTk9TVElDX1NUQVRVU19GQUlMRUQQAhIiCh5ESUFHTk9TVElDX1NUQVRVU19JTkNPTkNMVVNJVkUQAxIdChlESUFHTk9TVElDX1NUQVRVU19TS0lQUEVEEAQqjQEKD0R1cGxpY2F0ZUFjdGlvbhIgChxEVVBMSUNBVEVfQUNUSU9OX1VOU1BFQ0lGSUVEEAASHQoZRFVQTElDQVRFX0FDVElPTl9ET1dOTE9BRBABEh4KGkRVUExJQ0FURV9BQ1RJT05fSEFSRF9MSU5LEAISGQoVRFVQTElDQVRFX0FDVElPTl9DT1BZEAMqqQEKC1BsdWdpblNjb3BlEhwKGFBMVUdJTl9TQ09QRV9VTlNQRUNJRklFRBAAEhcKE1BMVUdJTl9TQ09QRV9FVkVOVFMQARIXChNQTFVHSU5fU0NPUEVfU0VBUkNIEAISFQoRUExVR0lOX1NDT1BFX1JFQUQQAxIaChZQTFVHSU5fU0NPUEVfRE9XTkxPQURTEAQSFwoTUExVR0lOX1NDT1BFX1NIQVJFUxAFKnYKD1BsdWdpbkV2ZW50VHlwZRIhCh1QTFVHSU5fRVZFTlRfVFlQRV9VTlNQRUNJRklFRBAAEiIKHlBMVUdJTl9FVkVOVF9UWVBFX0NMSUVOVF9FVkVOVBABEhwKGFBMVUdJTl9FVkVOVF9UWVBFX1NFQVJDSBACKqgBChFCcmlkZ2VSZXF1ZXN0VHlwZRIjCh9CUklER0VfUkVRVUVTVF9UWVBFX1VOU1BFQ0lGSUVEEAASJQohQlJJREdFX1JFUVVFU1RfVFlQRV9HRVRfRklMRV9NRVRBEAESJQohQlJJREdFX1JFUVVFU1RfVFlQRV9HRVRfRElSX0ZJTEVTEAISIAocQlJJREdFX1JFUVVFU1RfVFlQRV9HRVRfRklMRRADMsxLChBDbGllbnRScGNTZXJ2aWNlElkKClN0cmVhbUxvZ3MSIi5wYi5jbGllbnRycGMudjEuU3RyZWFtTG9nc1JlcXVlc3QaIy5wYi5jbGllbnRycGMudjEuU3RyZWFtTG9nc1Jlc3BvbnNlIgAwARJfCgxTdHJlYW1FdmVudHMSJC5wYi5jbGllbnRycGMudjEuU3RyZWFtRXZlbnRzUmVxdWVzdBolLnBiLmNsaWVudHJwYy52MS5TdHJlYW1FdmVudHNSZXNwb25zZSIAMAESRQoEU3RvcBIcLnBiLmNsaWVudHJwYy52MS5TdG9wUmVxdWVzdBodLnBiLmNsaWVudHJwYy52MS5TdG9wUmVzcG9uc2UiABJgCg1HZXRDbGllbnRJbmZvEiUucGIuY2xpZW50cnBjLnYxLkdldENsaWVudEluZm9SZXF1ZXN0GiYucGIuY2xpZW50cnBjLnYxLkdldENsaWVudEluZm9SZXNwb25zZSIAEmMKDkdldE1lbW9yeVVzYWdlEiYucGIuY2xpZW50cnBjLnYxLkdldE1lbW9yeVVzYWdlUmVxdWVzdBonLnBiLmNsaWVudHJwYy52MS5HZXRNZW1vcnlVc2FnZVJlc3BvbnNlIgASaQoQU2V0TG93TWVtb3J5TW9kZRIoLnBiLmNsaWVudHJwYy52MS5TZXRMb3dNZW1vcnlNb2RlUmVxdWVzdBopLnBiLmNsaWVudHJwYy52MS5TZXRMb3dNZW1vcnlNb2RlUmVzcG9uc2UiABJXCgpHZXRTZXJ2ZXJzEiIucGIuY2xpZW50cnBjLnYxLkdldFNlcnZlcnNSZXF1ZXN0GiMucGIuY2xpZW50cnBjLnYxLkdldFNlcnZlcnNSZXNwb25zZSIAEl0KDENyZWF0ZVNlcnZlchIkLnBiLmNsaWVudHJwYy52MS5DcmVhdGVTZXJ2ZXJSZXF1ZXN0GiUucGIuY2xpZW50cnBjLnYxLkNyZWF0ZVNlcnZlclJlc3BvbnNlIgASbwoSSW1wb3J0SW52aXRlQnVuZGxlEioucGIuY2xpZW50cnBjLnYxLkltcG9ydEludml0ZUJ1bmRsZVJlcXVlc3QaKy5wYi5jbGllbnRycGMudjEuSW1wb3J0SW52aXRlQnVuZGxlUmVzcG9uc2UiABJ4ChVDcmVhdGVBY2NvdW50VGVtcGxhdGUSLS5wYi5jbGllbnRycGMudjEuQ3JlYXRlQWNjb3VudFRlbXBsYXRlUmVxdWVzdBouLnBiLmNsaWVudHJwYy52MS5DcmVhdGVBY2NvdW50VGVtcGxhdGVSZXNwb25zZSIAEpYBCh9DcmVhdGVBY2NvdW50VGVtcGxhdGVGcm9tU2VydmVyEjcucGIuY2xpZW50cnBjLnYxLkNyZWF0ZUFjY291bnRUZW1wbGF0ZUZyb21TZXJ2ZXJSZXF1ZXN0GjgucGIuY2xpZW50cnBjLnYxLkNyZWF0ZUFjY291bnRUZW1wbGF0ZUZyb21TZXJ2ZXJSZXNwb25zZSIAEnIKE0dldEFjY291bnRUZW1wbGF0ZXMSKy5wYi5jbGllbnRycGMudjEuR2V0QWNjb3VudFRlbXBsYXRlc1JlcXVlc3QaLC5wYi5jbGllbnRycGMudjEuR2V0QWNjb3VudFRlbXBsYXRlc1Jlc3BvbnNlIgASeAoVRGVsZXRlQWNjb3VudFRlbXBsYXRlEi0ucGIuY2xpZW50cnBjLnYxLkRlbGV0ZUFjY291bnRUZW1wbGF0ZVJlcXVlc3QaLi5wYi5jbGllbnRycGMudjEuRGVsZXRlQWNjb3VudFRlbXBsYXRlUmVzcG9uc2UiABJdCgxTdGFydEhvc3RpbmcSJC5wYi5jbGllbnRycGMudjEuU3RhcnRIb3N0aW5nUmVxdWVzdBolLnBiLmNsaWVudHJwYy52MS5TdGFydEhvc3RpbmdSZXNwb25zZSIAEloKC1N0b3BIb3N0aW5nEiMucGIuY2xpZW50cnBjLnYxLlN0b3BIb3N0aW5nUmVxdWVzdBokLnBiLmNsaWVudHJwYy52MS5TdG9wSG9zdGluZ1Jlc3BvbnNlIgASYwoOR2V0SG9zdGluZ0luZm8SJi5wYi5jbGllbnRycGMudjEuR2V0SG9zdGluZ0luZm9SZXF1ZXN0GicucGIuY2xpZW50cnBjLnYxLkdldEhvc3RpbmdJbmZvUmVzcG9uc2UiABJyChNDcmVhdGVIb3N0aW5nSW52aXRlEisucGIuY2xpZW50cnBjLnYxLkNyZWF0ZUhvc3RpbmdJbnZpdGVSZXF1ZXN0GiwucGIuY2xpZW50cnBjLnYxLkNyZWF0ZUhvc3RpbmdJbnZpdGVSZXNwb25zZSIAEl0KDERlbGV0ZVNlcnZlchIkLnBiLmNsaWVudHJwYy52MS5EZWxldGVTZXJ2ZXJSZXF1ZXN0GiUucGIuY2xpZW50cnBjLnYxLkRlbGV0ZVNlcnZlclJlc3BvbnNlIgASYAoNQ29ubmVjdFNlcnZlchIlLnBiLmNsaWVudHJwYy52MS5Db25uZWN0U2VydmVyUmVxdWVzdBomLnBiLmNsaWVudHJwYy52MS5Db25uZWN0U2VydmVyUmVzcG9uc2UiABJpChBEaXNjb25uZWN0U2VydmVyEigucGIuY2xpZW50cnBjLnYxLkRpc2Nvbm5lY3RTZXJ2ZXJSZXF1ZXN0GikucGIuY2xpZW50cnBjLnYxLkRpc2Nvbm5lY3RTZXJ2ZXJSZXNwb25zZSIAEl0KDFVwZGF0ZVNlcnZlchIkLnBiLmNsaWVudHJwYy52MS5VcGRhdGVTZXJ2ZXJSZXF1ZXN0GiUucGIuY2xpZW50cnBjLnYxLlVwZGF0ZVNlcnZlclJlc3BvbnNlIgASVAoJR2V0U2hhcmVzEiEucGIuY2xpZW50cnBjLnYxLkdldFNoYXJlc1JlcXVlc3QaIi5wYi5jbGllbnRycGMudjEuR2V0U2hhcmVzUmVzcG9uc2UiABJaCgtDcmVhdGVTaGFyZRIjLnBiLmNsaWVudHJwYy52MS5DcmVhdGVTaGFyZVJlcXVlc3QaJC5wYi5jbGllbnRycGMudjEuQ3JlYXRlU2hhcmVSZXNwb25zZSIAEloKC0RlbGV0ZVNoYXJlEiMucGIuY2xpZW50cnBjLnYxLkRlbGV0ZVNoYXJlUmVxdWVzdBokLnBiLmNsaWVudHJwYy52MS5EZWxldGVTaGFyZVJlc3BvbnNlIgASfgoXU2V0U2hhcmVFeGNsdWRlUGF0dGVybnMSLy5wYi5jbGllbnRycGMudjEuU2V0U2hhcmVFeGNsdWRlUGF0dGVybnNSZXF1ZXN0GjAucGIuY2xpZW50cnBjLnYxLlNldFNoYXJlRXhjbHVkZVBhdHRlcm5zUmVzcG9uc2UiABJpChBDaGVja1NoYXJlSGVhbHRoEigucGIuY2xpZW50cnBjLnYxLkNoZWNrU2hhcmVIZWFsdGhSZXF1ZXN0GikucGIuY2xpZW50cnBjLnYxLkNoZWNrU2hhcmVIZWFsdGhSZXNwb25zZSIAEl0KDEltcG9ydFNoYXJlcxIkLnBiLmNsaWVudHJwYy52MS5JbXBvcnRTaGFyZXNSZXF1ZXN0GiUucGIuY2xpZW50cnBjLnYxLkltcG9ydFNoYXJlc1Jlc3BvbnNlIgASZgoPQ3JlYXRlU2hhcmVMaW5rEicucGIuY2xpZW50cnBjLnYxLkNyZWF0ZVNoYXJlTGlua1JlcXVlc3QaKC5wYi5jbGllbnRycGMudjEuQ3JlYXRlU2hhcmVMaW5rUmVzcG9uc2UiABJgCg1HZXRTaGFyZUxpbmtzEiUucGIuY2xpZW50cnBjLnYxLkdldFNoYXJlTGlua3NSZXF1ZXN0GiYucGIuY2xpZW50cnBjLnYxLkdldFNoYXJlTGlua3NSZXNwb25zZSIAEmYKD0RlbGV0ZVNoYXJlTGluaxInLnBiLmNsaWVudHJwYy52MS5EZWxldGVTaGFyZUxpbmtSZXF1ZXN0GigucGIuY2xpZW50cnBjLnYxLkRlbGV0ZVNoYXJlTGlua1Jlc3BvbnNlIgASXAoLR2V0RGlyRmlsZXMSIy5wYi5jbGllbnRycGMudjEuR2V0RGlyRmlsZXNSZXF1ZXN0GiQucGIuY2xpZW50cnBjLnYxLkdldERpckZpbGVzUmVzcG9uc2UiADABEmsKEFN0cmVhbURpckFyY2hpdmUSKC5wYi5jbGllbnRycGMudjEuU3RyZWFtRGlyQXJjaGl2ZVJlcXVlc3QaKS5wYi5jbGllbnRycGMudjEuU3RyZWFtRGlyQXJjaGl2ZVJlc3BvbnNlIgAwARJaCgtHZXRGaWxlTWV0YRIjLnBiLmNsaWVudHJwYy52MS5HZXRGaWxlTWV0YVJlcXVlc3QaJC5wYi5jbGllbnRycGMudjEuR2V0RmlsZU1ldGFSZXNwb25zZSIAEmMKDkNyZWF0ZUZpbGVMaW5rEiYucGIuY2xpZW50cnBjLnYxLkNyZWF0ZUZpbGVMaW5rUmVxdWVzdBonLnBiLmNsaWVudHJwYy52MS5DcmVhdGVGaWxlTGlua1Jlc3BvbnNlIgASWgoLTWVhc3VyZVBlZXISIy5wYi5jbGllbnRycGMudjEuTWVhc3VyZVBlZXJSZXF1ZXN0GiQucGIuY2xpZW50cnBjLnYxLk1lYXN1cmVQZWVyUmVzcG9uc2UiABJRCghEaWFnbm9zZRIgLnBiLmNsaWVudHJwYy52MS5EaWFnbm9zZVJlcXVlc3QaIS5wYi5jbGllbnRycGMudjEuRGlhZ25vc2VSZXNwb25zZSIAEmUKDkdldE9ubGluZVVzZXJzEiYucGIuY2xpZW50cnBjLnYxLkdldE9ubGluZVVzZXJzUmVxdWVzdBonLnBiLmNsaWVudHJwYy52MS5HZXRPbmxpbmVVc2Vyc1Jlc3BvbnNlIgAwARJ4ChVDaGFuZ2VBY2NvdW50UGFzc3dvcmQSLS5wYi5jbGllbnRycGMudjEuQ2hhbmdlQWNjb3VudFBhc3N3b3JkUmVxdWVzdBouLnBiLmNsaWVudHJwYy52MS5DaGFuZ2VBY2NvdW50UGFzc3dvcmRSZXNwb25zZSIAEmAKDVNlcnZlckNvbm5lY3QSJS5wYi5jbGllbnRycGMudjEuU2VydmVyQ29ubmVjdFJlcXVlc3QaJi5wYi5jbGllbnRycGMudjEuU2VydmVyQ29ubmVjdFJlc3BvbnNlIgASaQoQU2VydmVyRGlzY29ubmVjdBIoLnBiLmNsaWVudHJwYy52MS5TZXJ2ZXJEaXNjb25uZWN0UmVxdWVzdBopLnBiLmNsaWVudHJwYy52MS5TZXJ2ZXJEaXNjb25uZWN0UmVzcG9uc2UiABJsChFHZXREaXJlY3RTZXR0aW5ncxIpLnBiLmNsaWVudHJwYy52MS5HZXREaXJlY3RTZXR0aW5nc1JlcXVlc3QaKi5wYi5jbGllbnRycGMudjEuR2V0RGlyZWN0U2V0dGluZ3NSZXNwb25zZSIAEnUKFFVwZGF0ZURpcmVjdFNldHRpbmdzEiwucGIuY2xpZW50cnBjLnYxLlVwZGF0ZURpcmVjdFNldHRpbmdzUmVxdWVzdBotLnBiLmNsaWVudHJwYy52MS5VcGRhdGVEaXJlY3RTZXR0aW5nc1Jlc3BvbnNlIgAScgoTR2V0VHJhbnNmZXJTZXR0aW5ncxIrLnBiLmNsaWVudHJwYy52MS5HZXRUcmFuc2ZlclNldHRpbmdzUmVxdWVzdBosLnBiLmNsaWVudHJwYy52MS5HZXRUcmFuc2ZlclNldHRpbmdzUmVzcG9uc2UiABJ7ChZVcGRhdGVUcmFuc2ZlclNldHRpbmdzEi4ucGIuY2xpZW50cnBjLnYxLlVwZGF0ZVRyYW5zZmVyU2V0dGluZ3NSZXF1ZXN0Gi8ucGIuY2xpZW50cnBjLnYxLlVwZGF0ZVRyYW5zZmVyU2V0dGluZ3NSZXNwb25zZSIAEn4KF0dldE5vdGlmaWNhdGlvblNldHRpbmdzEi8ucGIuY2xpZW50cnBjLnYxLkdldE5vdGlmaWNhdGlvblNldHRpbmdzUmVxdWVzdBowLnBiLmNsaWVudHJwYy52MS5HZXROb3RpZmljYXRpb25TZXR0aW5nc1Jlc3BvbnNlIgAShwEKGlVwZGF0ZU5vdGlmaWNhdGlvblNldHRpbmdzEjIucGIuY2xpZW50cnBjLnYxLlVwZGF0ZU5vdGlmaWNhdGlvblNldHRpbmdzUmVxdWVzdBozLnBiLmNsaWVudHJwYy52MS5VcGRhdGVOb3RpZmljYXRpb25TZXR0aW5nc1Jlc3BvbnNlIgASXQoMRXhwb3J0Q29uZmlnEiQucGIuY2xpZW50cnBjLnYxLkV4cG9ydENvbmZpZ1JlcXVlc3QaJS5wYi5jbGllbnRycGMudjEuRXhwb3J0Q29uZmlnUmVzcG9uc2UiABJdCgxJbXBvcnRDb25maWcSJC5wYi5jbGllbnRycGMudjEuSW1wb3J0Q29uZmlnUmVxdWVzdBolLnBiLmNsaWVudHJwYy52MS5JbXBvcnRDb25maWdSZXNwb25zZSIAEmMKDkJhY2t1cERhdGFiYXNlEiYucGIuY2xpZW50cnBjLnYxLkJhY2t1cERhdGFiYXNlUmVxdWVzdBonLnBiLmNsaWVudHJwYy52MS5CYWNrdXBEYXRhYmFzZVJlc3BvbnNlIgASewoWQ2hlY2tEYXRhYmFzZUludGVncml0eRIuLnBiLmNsaWVudHJwYy52MS5DaGVja0RhdGFiYXNlSW50ZWdyaXR5UmVxdWVzdBovLnBiLmNsaWVudHJwYy52MS5DaGVja0RhdGFiYXNlSW50ZWdyaXR5UmVzcG9uc2UiABJXCgpJbmRleFNoYXJlEiIucGIuY2xpZW50cnBjLnYxLkluZGV4U2hhcmVSZXF1ZXN0GiMucGIuY2xpZW50cnBjLnYxLkluZGV4U2hhcmVSZXNwb25zZSIAEl8KDFN0cmVhbVNlYXJjaBIkLnBiLmNsaWVudHJwYy52MS5TdHJlYW1TZWFyY2hSZXF1ZXN0GiUucGIuY2xpZW50cnBjLnYxLlN0cmVhbVNlYXJjaFJlc3BvbnNlIgAwARJgCg1HZXRVcGRhdGVJbmZvEiUucGIuY2xpZW50cnBjLnYxLkdldFVwZGF0ZUluZm9SZXF1ZXN0GiYucGIuY2xpZW50cnBjLnYxLkdldFVwZGF0ZUluZm9SZXNwb25zZSIAEmwKEUNoZWNrRm9yTmV3VXBkYXRlEikucGIuY2xpZW50cnBjLnYxLkNoZWNrRm9yTmV3VXBkYXRlUmVxdWVzdBoqLnBiLmNsaWVudHJwYy52MS5DaGVja0Zvck5ld1VwZGF0ZVJlc3BvbnNlIgASWgoLQXBwbHlVcGRhdGUSIy5wYi5jbGllbnRycGMudjEuQXBwbHlVcGRhdGVSZXF1ZXN0GiQucGIuY2xpZW50cnBjLnYxLkFwcGx5VXBkYXRlUmVzcG9uc2UiABJsChFHZXRVcGRhdGVTZXR0aW5ncxIpLnBiLmNsaWVudHJwYy52MS5HZXRVcGRhdGVTZXR0aW5nc1JlcXVlc3QaKi5wYi5jbGllbnRycGMudjEuR2V0VXBkYXRlU2V0dGluZ3NSZXNwb25zZSIAEnUKFFVwZGF0ZVVwZGF0ZVNldHRpbmdzEiwucGIuY2xpZW50cnBjLnYxLlVwZGF0ZVVwZGF0ZVNldHRpbmdzUmVxdWVzdBotLnBiLmNsaWVudHJwYy52MS5VcGRhdGVVcGRhdGVTZXR0aW5nc1Jlc3BvbnNlIgASfgoXR2V0RG93bmxvYWRNYW5hZ2VySXRlbXMSLy5wYi5jbGllbnRycGMudjEuR2V0RG93bmxvYWRNYW5hZ2VySXRlbXNSZXF1ZXN0GjAucGIuY2xpZW50cnBjLnYxLkdldERvd25sb2FkTWFuYWdlckl0ZW1zUmVzcG9uc2UiABJsChFRdWV1ZUZpbGVEb3dubG9hZBIpLnBiLmNsaWVudHJwYy52MS5RdWV1ZUZpbGVEb3dubG9hZFJlcXVlc3QaKi5wYi5jbGllbnRycGMudjEuUXVldWVGaWxlRG93bmxvYWRSZXNwb25zZSIAEm8KEkNhbmNlbEZpbGVEb3dubG9hZBIqLnBiLmNsaWVudHJwYy52MS5DYW5jZWxGaWxlRG93bmxvYWRSZXF1ZXN0GisucGIuY2xpZW50cnBjLnYxLkNhbmNlbEZpbGVEb3dubG9hZFJlc3BvbnNlIgAShAEKGVJlbW92ZURvd25sb2FkTWFuYWdlckl0ZW0SMS5wYi5jbGllbnRycGMudjEuUmVtb3ZlRG93bmxvYWRNYW5hZ2VySXRlbVJlcXVlc3QaMi5wYi5jbGllbnRycGMudjEuUmVtb3ZlRG93bmxvYWRNYW5hZ2VySXRlbVJlc3BvbnNlIgASbAoRUGF1c2VGaWxlRG93bmxvYWQSKS5wYi5jbGllbnRycGMudjEuUGF1c2VGaWxlRG93bmxvYWRSZXF1ZXN0GioucGIuY2xpZW50cnBjLnYxLlBhdXNlRmlsZURvd25sb2FkUmVzcG9uc2UiABJvChJSZXN1bWVGaWxlRG93bmxvYWQSKi5wYi5jbGllbnRycGMudjEuUmVzdW1lRmlsZURvd25sb2FkUmVxdWVzdBorLnBiLmNsaWVudHJwYy52MS5SZXN1bWVGaWxlRG93bmxvYWRSZXNwb25zZSIAEmkKEEdldERvd25sb2FkSG9va3MSKC5wYi5jbGllbnRycGMudjEuR2V0RG93bmxvYWRIb29rc1JlcXVlc3QaKS5wYi5jbGllbnRycGMudjEuR2V0RG93bmxvYWRIb29rc1Jlc3BvbnNlIgASbwoSQ3JlYXRlRG93bmxvYWRIb29rEioucGIuY2xpZW50cnBjLnYxLkNyZWF0ZURvd25sb2FkSG9va1JlcXVlc3QaKy5wYi5jbGllbnRycGMudjEuQ3JlYXRlRG93bmxvYWRIb29rUmVzcG9uc2UiABJvChJEZWxldGVEb3dubG9hZEhvb2sSKi5wYi5jbGllbnRycGMudjEuRGVsZXRlRG93bmxvYWRIb29rUmVxdWVzdBorLnBiLmNsaWVudHJwYy52MS5EZWxldGVEb3dubG9hZEhvb2tSZXNwb25zZSIAElcKCkdldFVwbG9hZHMSIi5wYi5jbGllbnRycGMudjEuR2V0VXBsb2Fkc1JlcXVlc3QaIy5wYi5jbGllbnRycGMudjEuR2V0VXBsb2Fkc1Jlc3BvbnNlIgASbwoSQ2xlYXJVcGxvYWRIaXN0b3J5EioucGIuY2xpZW50cnBjLnYxLkNsZWFyVXBsb2FkSGlzdG9yeVJlcXVlc3QaKy5wYi5jbGllbnRycGMudjEuQ2xlYXJVcGxvYWRIaXN0b3J5UmVzcG9uc2UiABJXCgpHZXRGcmllbmRzEiIucGIuY2xpZW50cnBjLnYxLkdldEZyaWVuZHNSZXF1ZXN0GiMucGIuY2xpZW50cnBjLnYxLkdldEZyaWVuZHNSZXNwb25zZSIAElQKCVNldEZyaWVuZBIhLnBiLmNsaWVudHJwYy52MS5TZXRGcmllbmRSZXF1ZXN0GiIucGIuY2xpZW50cnBjLnYxLlNldEZyaWVuZFJlc3BvbnNlIgASXQoMRGVsZXRlRnJpZW5kEiQucGIuY2xpZW50cnBjLnYxLkRlbGV0ZUZyaWVuZFJlcXVlc3QaJS5wYi5jbGllbnRycGMudjEuRGVsZXRlRnJpZW5kUmVzcG9uc2UiABJmCg9HZXRCbG9ja2VkUGVlcnMSJy5wYi5jbGllbnRycGMudjEuR2V0QmxvY2tlZFBlZXJzUmVxdWVzdBooLnBiLmNsaWVudHJwYy52MS5HZXRCbG9ja2VkUGVlcnNSZXNwb25zZSIAElQKCUJsb2NrUGVlchIhLnBiLmNsaWVudHJwYy52MS5CbG9ja1BlZXJSZXF1ZXN0GiIucGIuY2xpZW50cnBjLnYxLkJsb2NrUGVlclJlc3BvbnNlIgASWgoLVW5ibG9ja1BlZXISIy5wYi5jbGllbnRycGMudjEuVW5ibG9ja1BlZXJSZXF1ZXN0GiQucGIuY2xpZW50cnBjLnYxLlVuYmxvY2tQZWVyUmVzcG9uc2UiABJdCgxHZXRJbnRlcmVzdHMSJC5wYi5jbGllbnRycGMudjEuR2V0SW50ZXJlc3RzUmVxdWVzdBolLnBiLmNsaWVudHJwYy52MS5HZXRJbnRlcmVzdHNSZXNwb25zZSIAEl0KDFNldEludGVyZXN0cxIkLnBiLmNsaWVudHJwYy52MS5TZXRJbnRlcmVzdHNSZXF1ZXN0GiUucGIuY2xpZW50cnBjLnYxLlNldEludGVyZXN0c1Jlc3BvbnNlIgASZgoPR2V0U2ltaWxhclVzZXJzEicucGIuY2xpZW50cnBjLnYxLkdldFNpbWlsYXJVc2Vyc1JlcXVlc3QaKC5wYi5jbGllbnRycGMudjEuR2V0U2ltaWxhclVzZXJzUmVzcG9uc2UiABJsChFHZXRTZXJ2ZXJTY2hlZHVsZRIpLnBiLmNsaWVudHJwYy52MS5HZXRTZXJ2ZXJTY2hlZHVsZVJlcXVlc3QaKi5wYi5jbGllbnRycGMudjEuR2V0U2VydmVyU2NoZWR1bGVSZXNwb25zZSIAEmwKEVNldFNlcnZlclNjaGVkdWxlEikucGIuY2xpZW50cnBjLnYxLlNldFNlcnZlclNjaGVkdWxlUmVxdWVzdBoqLnBiLmNsaWVudHJwYy52MS5TZXRTZXJ2ZXJTY2hlZHVsZVJlc3BvbnNlIgASVAoJR2V0U25vb3plEiEucGIuY2xpZW50cnBjLnYxLkdldFNub296ZVJlcXVlc3QaIi5wYi5jbGllbnRycGMudjEuR2V0U25vb3plUmVzcG9uc2UiABJLCgZTbm9vemUSHi5wYi5jbGllbnRycGMudjEuU25vb3plUmVxdWVzdBofLnBiLmNsaWVudHJwYy52MS5Tbm9vemVSZXNwb25zZSIAElEKCFVuc25vb3plEiAucGIuY2xpZW50cnBjLnYxLlVuc25vb3plUmVxdWVzdBohLnBiLmNsaWVudHJwYy52MS5VbnNub296ZVJlc3BvbnNlIgASYAoNR2V0UnVuSGlzdG9yeRIlLnBiLmNsaWVudHJwYy52MS5HZXRSdW5IaXN0b3J5UmVxdWVzdBomLnBiLmNsaWVudHJwYy52MS5HZXRSdW5IaXN0b3J5UmVzcG9uc2UiABJjCg5HZXRDb25uSGlzdG9yeRImLnBiLmNsaWVudHJwYy52MS5HZXRDb25uSGlzdG9yeVJlcXVlc3QaJy5wYi5jbGllbnRycGMudjEuR2V0Q29ubkhpc3RvcnlSZXNwb25zZSIAElEKCEdldFRyYXNoEiAucGIuY2xpZW50cnBjLnYxLkdldFRyYXNoUmVxdWVzdBohLnBiLmNsaWVudHJwYy52MS5HZXRUcmFzaFJlc3BvbnNlIgASYAoNUmVzdG9yZVNlcnZlchIlLnBiLmNsaWVudHJwYy52MS5SZXN0b3JlU2VydmVyUmVxdWVzdBomLnBiLmNsaWVudHJwYy52MS5SZXN0b3JlU2VydmVyUmVzcG9uc2UiABJaCgtQdXJnZVNlcnZlchIjLnBiLmNsaWVudHJwYy52MS5QdXJnZVNlcnZlclJlcXVlc3QaJC5wYi5jbGllbnRycGMudjEuUHVyZ2VTZXJ2ZXJSZXNwb25zZSIAEl0KDFJlc3RvcmVTaGFyZRIkLnBiLmNsaWVudHJwYy52MS5SZXN0b3JlU2hhcmVSZXF1ZXN0GiUucGIuY2xpZW50cnBjLnYxLlJlc3RvcmVTaGFyZVJlc3BvbnNlIgASVwoKUHVyZ2VTaGFyZRIiLnBiLmNsaWVudHJwYy52MS5QdXJnZVNoYXJlUmVxdWVzdBojLnBiLmNsaWVudHJwYy52MS5QdXJnZVNoYXJlUmVzcG9uc2UiABJXCgpHZXRQbHVnaW5zEiIucGIuY2xpZW50cnBjLnYxLkdldFBsdWdpbnNSZXF1ZXN0GiMucGIuY2xpZW50cnBjLnYxLkdldFBsdWdpbnNSZXNwb25zZSIAEl0KDENyZWF0ZVBsdWdpbhIkLnBiLmNsaWVudHJwYy52MS5DcmVhdGVQbHVnaW5SZXF1ZXN0GiUucGIuY2xpZW50cnBjLnYxLkNyZWF0ZVBsdWdpblJlc3BvbnNlIgASXQoMRGVsZXRlUGx1Z2luEiQucGIuY2xpZW50cnBjLnYxLkRlbGV0ZVBsdWdpblJlcXVlc3QaJS5wYi5jbGllbnRycGMudjEuRGVsZXRlUGx1Z2luUmVzcG9uc2UiABJxChJTdHJlYW1QbHVnaW5FdmVudHMSKi5wYi5jbGllbnRycGMudjEuU3RyZWFtUGx1Z2luRXZlbnRzUmVxdWVzdBorLnBiLmNsaWVudHJwYy52MS5TdHJlYW1QbHVnaW5FdmVudHNSZXNwb25zZSIAMAESZgoPUmVzcG9uZFRvU2VhcmNoEicucGIuY2xpZW50cnBjLnYxLlJlc3BvbmRUb1NlYXJjaFJlcXVlc3QaKC5wYi5jbGllbnRycGMudjEuUmVzcG9uZFRvU2VhcmNoUmVzcG9uc2UiAEIiWiBmcmllbmRuZXQub3JnL3Byb3RvY29sL2NsaWVudHJwY2IGcHJvdG8z");
 
 /**
  * Event is an event.
@@ -61,6 +61,41 @@ export type Event = Message<"pb.clientrpc.v1.Event"> & {
    * @generated from field: optional pb.clientrpc.v1.Event.DmItemRemoved dm_item_removed = 8;
    */
   dmItemRemoved?: Event_DmItemRemoved;
+
+  /**
+   * @generated from field: optional pb.clientrpc.v1.Event.ShareChanged share_changed = 9;
+   */
+  shareChanged?: Event_ShareChanged;
+
+  /**
+   * @generated from field: optional pb.clientrpc.v1.Event.ServerNotice server_notice = 10;
+   */
+  serverNotice?: Event_ServerNotice;
+
+  /**
+   * @generated from field: optional pb.clientrpc.v1.Event.UploadUpdate upload_update = 11;
+   */
+  uploadUpdate?: Event_UploadUpdate;
+
+  /**
+   * @generated from field: optional pb.clientrpc.v1.Event.DownloadsRecovered downloads_recovered = 12;
+   */
+  downloadsRecovered?: Event_DownloadsRecovered;
+
+  /**
+   * @generated from field: optional pb.clientrpc.v1.Event.ShutdownDrain shutdown_drain = 13;
+   */
+  shutdownDrain?: Event_ShutdownDrain;
+
+  /**
+   * @generated from field: optional pb.clientrpc.v1.Event.RoomMotd room_motd = 14;
+   */
+  roomMotd?: Event_RoomMotd;
+
+  /**
+   * @generated from field: optional pb.clientrpc.v1.Event.ClockSkew clock_skew = 15;
+   */
+  clockSkew?: Event_ClockSkew;
 };
 
 /**
@@ -203,6 +238,164 @@ export type Event_DmItemRemoved = Message<"pb.clientrpc.v1.Event.DmItemRemoved">
 export const Event_DmItemRemovedSchema: GenMessage<Event_DmItemRemoved> = /*@__PURE__*/
   messageDesc(file_pb_clientrpc_v1_rpc, 0, 6);
 
+/**
+ * @generated from message pb.clientrpc.v1.Event.ShareChanged
+ */
+export type Event_ShareChanged = Message<"pb.clientrpc.v1.Event.ShareChanged"> & {
+  /**
+   * The share's name.
+   *
+   * @generated from field: string share_name = 1;
+   */
+  shareName: string;
+
+  /**
+   * The share's new revision.
+   * It starts at 0 when the client starts and increases by 1 for every batch of changes.
+   *
+   * @generated from field: uint64 revision = 2;
+   */
+  revision: bigint;
+
+  /**
+   * The paths within the share that changed.
+   * A path of "/" means the entire share should be considered changed.
+   *
+   * @generated from field: repeated string paths = 3;
+   */
+  paths: string[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.Event.ShareChanged.
+ * Use `create(Event_ShareChangedSchema)` to create a new message.
+ */
+export const Event_ShareChangedSchema: GenMessage<Event_ShareChanged> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 0, 7);
+
+/**
+ * @generated from message pb.clientrpc.v1.Event.ServerNotice
+ */
+export type Event_ServerNotice = Message<"pb.clientrpc.v1.Event.ServerNotice"> & {
+  /**
+   * The notice's text.
+   *
+   * @generated from field: string text = 1;
+   */
+  text: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.Event.ServerNotice.
+ * Use `create(Event_ServerNoticeSchema)` to create a new message.
+ */
+export const Event_ServerNoticeSchema: GenMessage<Event_ServerNotice> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 0, 8);
+
+/**
+ * @generated from message pb.clientrpc.v1.Event.UploadUpdate
+ */
+export type Event_UploadUpdate = Message<"pb.clientrpc.v1.Event.UploadUpdate"> & {
+  /**
+   * The upload's current state.
+   *
+   * @generated from field: pb.clientrpc.v1.UploadInfo upload = 1;
+   */
+  upload?: UploadInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.Event.UploadUpdate.
+ * Use `create(Event_UploadUpdateSchema)` to create a new message.
+ */
+export const Event_UploadUpdateSchema: GenMessage<Event_UploadUpdate> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 0, 9);
+
+/**
+ * @generated from message pb.clientrpc.v1.Event.DownloadsRecovered
+ */
+export type Event_DownloadsRecovered = Message<"pb.clientrpc.v1.Event.DownloadsRecovered"> & {
+  /**
+   * The downloads that were recovered.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.RecoveredDownload downloads = 1;
+   */
+  downloads: RecoveredDownload[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.Event.DownloadsRecovered.
+ * Use `create(Event_DownloadsRecoveredSchema)` to create a new message.
+ */
+export const Event_DownloadsRecoveredSchema: GenMessage<Event_DownloadsRecovered> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 0, 10);
+
+/**
+ * @generated from message pb.clientrpc.v1.Event.ShutdownDrain
+ */
+export type Event_ShutdownDrain = Message<"pb.clientrpc.v1.Event.ShutdownDrain"> & {
+  /**
+   * The number of uploads that are still active.
+   * Once it is 0, the client continues shutting down.
+   *
+   * @generated from field: uint32 active_uploads = 1;
+   */
+  activeUploads: number;
+
+  /**
+   * The UNIX timestamp, in seconds, after which the client shuts down even if uploads are still active.
+   *
+   * @generated from field: int64 deadline_ts = 2;
+   */
+  deadlineTs: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.Event.ShutdownDrain.
+ * Use `create(Event_ShutdownDrainSchema)` to create a new message.
+ */
+export const Event_ShutdownDrainSchema: GenMessage<Event_ShutdownDrain> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 0, 11);
+
+/**
+ * @generated from message pb.clientrpc.v1.Event.RoomMotd
+ */
+export type Event_RoomMotd = Message<"pb.clientrpc.v1.Event.RoomMotd"> & {
+  /**
+   * The message of the day's text.
+   *
+   * @generated from field: string text = 1;
+   */
+  text: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.Event.RoomMotd.
+ * Use `create(Event_RoomMotdSchema)` to create a new message.
+ */
+export const Event_RoomMotdSchema: GenMessage<Event_RoomMotd> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 0, 12);
+
+/**
+ * @generated from message pb.clientrpc.v1.Event.ClockSkew
+ */
+export type Event_ClockSkew = Message<"pb.clientrpc.v1.Event.ClockSkew"> & {
+  /**
+   * How far the server's clock is ahead of the local clock, in milliseconds.
+   * Negative if the server's clock is behind.
+   *
+   * @generated from field: int64 skew_ms = 1;
+   */
+  skewMs: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.Event.ClockSkew.
+ * Use `create(Event_ClockSkewSchema)` to create a new message.
+ */
+export const Event_ClockSkewSchema: GenMessage<Event_ClockSkew> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 0, 13);
+
 /**
  * @generated from enum pb.clientrpc.v1.Event.Type
  */
@@ -269,6 +462,60 @@ export enum Event_Type {
    * @generated from enum value: TYPE_DM_ITEM_REMOVED = 8;
    */
   DM_ITEM_REMOVED = 8,
+
+  /**
+   * Files in a share were added, removed or modified.
+   *
+   * @generated from enum value: TYPE_SHARE_CHANGED = 9;
+   */
+  SHARE_CHANGED = 9,
+
+  /**
+   * A server sent a notice from its operators.
+   *
+   * @generated from enum value: TYPE_SERVER_NOTICE = 10;
+   */
+  SERVER_NOTICE = 10,
+
+  /**
+   * An upload to a peer started, progressed or ended.
+   *
+   * @generated from enum value: TYPE_UPLOAD_UPDATE = 11;
+   */
+  UPLOAD_UPDATE = 11,
+
+  /**
+   * Downloads that were interrupted when the client last stopped were recovered and queued again.
+   * It is sent once per client run, to the first event stream opened after starting.
+   *
+   * @generated from enum value: TYPE_DOWNLOADS_RECOVERED = 12;
+   */
+  DOWNLOADS_RECOVERED = 12,
+
+  /**
+   * The client is shutting down and waiting for active uploads to finish.
+   * It is sent when waiting starts and whenever the number of active uploads changes.
+   *
+   * @generated from enum value: TYPE_SHUTDOWN_DRAIN = 13;
+   */
+  SHUTDOWN_DRAIN = 13,
+
+  /**
+   * A server connection opened to a room that has a message of the day.
+   * It is sent every time the connection opens, including reconnects.
+   *
+   * @generated from enum value: TYPE_ROOM_MOTD = 14;
+   */
+  ROOM_MOTD = 14,
+
+  /**
+   * A server connection opened and the local clock differs from the server's by at least the warning threshold.
+   * Clocks that are far off break certificate validation and token expiry.
+   * It is sent every time the connection opens, including reconnects.
+   *
+   * @generated from enum value: TYPE_CLOCK_SKEW = 15;
+   */
+  CLOCK_SKEW = 15,
 }
 
 /**
@@ -423,6 +670,13 @@ export type DownloadStatusUpdate = Message<"pb.clientrpc.v1.DownloadStatusUpdate
    * @generated from field: optional string error_message = 6;
    */
   errorMessage?: string;
+
+  /**
+   * The result of scanning the download once it completed.
+   *
+   * @generated from field: pb.clientrpc.v1.ScanStatus scan_status = 7;
+   */
+  scanStatus: ScanStatus;
 };
 
 /**
@@ -432,6 +686,140 @@ export type DownloadStatusUpdate = Message<"pb.clientrpc.v1.DownloadStatusUpdate
 export const DownloadStatusUpdateSchema: GenMessage<DownloadStatusUpdate> = /*@__PURE__*/
   messageDesc(file_pb_clientrpc_v1_rpc, 4);
 
+/**
+ * RecoveredDownload is a download that was interrupted when the client last stopped, and was queued again.
+ *
+ * @generated from message pb.clientrpc.v1.RecoveredDownload
+ */
+export type RecoveredDownload = Message<"pb.clientrpc.v1.RecoveredDownload"> & {
+  /**
+   * The download manager item's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+
+  /**
+   * The number of bytes in the partial file that the download continues from.
+   *
+   * @generated from field: uint64 downloaded = 2;
+   */
+  downloaded: bigint;
+
+  /**
+   * The number of bytes that were recorded as downloaded but were missing from the partial file, and will be
+   * downloaded again.
+   *
+   * @generated from field: uint64 discarded = 3;
+   */
+  discarded: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.RecoveredDownload.
+ * Use `create(RecoveredDownloadSchema)` to create a new message.
+ */
+export const RecoveredDownloadSchema: GenMessage<RecoveredDownload> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 5);
+
+/**
+ * UploadInfo is information about a file upload to a peer.
+ *
+ * @generated from message pb.clientrpc.v1.UploadInfo
+ */
+export type UploadInfo = Message<"pb.clientrpc.v1.UploadInfo"> & {
+  /**
+   * The upload's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+
+  /**
+   * The UUID of the server the peer is on.
+   *
+   * @generated from field: string server_uuid = 2;
+   */
+  serverUuid: string;
+
+  /**
+   * The username of the peer the file is being uploaded to.
+   *
+   * @generated from field: string peer_username = 3;
+   */
+  peerUsername: string;
+
+  /**
+   * The file's path within the shares.
+   *
+   * @generated from field: string file_path = 4;
+   */
+  filePath: string;
+
+  /**
+   * The upload status.
+   *
+   * @generated from field: pb.clientrpc.v1.UploadStatus status = 5;
+   */
+  status: UploadStatus;
+
+  /**
+   * The byte offset the peer requested the file from.
+   *
+   * @generated from field: uint64 offset = 6;
+   */
+  offset: bigint;
+
+  /**
+   * The number of bytes sent so far.
+   *
+   * @generated from field: uint64 bytes_sent = 7;
+   */
+  bytesSent: bigint;
+
+  /**
+   * The file's size in bytes.
+   *
+   * @generated from field: uint64 file_size = 8;
+   */
+  fileSize: bigint;
+
+  /**
+   * The current upload speed, in bytes per second.
+   *
+   * @generated from field: uint64 speed = 9;
+   */
+  speed: bigint;
+
+  /**
+   * The UNIX timestamp when the upload started.
+   *
+   * @generated from field: int64 started_ts = 10;
+   */
+  startedTs: bigint;
+
+  /**
+   * The UNIX timestamp when the upload ended, if it has.
+   *
+   * @generated from field: optional int64 ended_ts = 11;
+   */
+  endedTs?: bigint;
+
+  /**
+   * The error message, if applicable.
+   *
+   * @generated from field: optional string error_message = 12;
+   */
+  errorMessage?: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UploadInfo.
+ * Use `create(UploadInfoSchema)` to create a new message.
+ */
+export const UploadInfoSchema: GenMessage<UploadInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 6);
+
 /**
  * DownloadManagerItem is an item in the download manager.
  *
@@ -486,7 +874,7 @@ export type DownloadManagerItem = Message<"pb.clientrpc.v1.DownloadManagerItem">
  * Use `create(DownloadManagerItemSchema)` to create a new message.
  */
 export const DownloadManagerItemSchema: GenMessage<DownloadManagerItem> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 5);
+  messageDesc(file_pb_clientrpc_v1_rpc, 7);
 
 /**
  * @generated from message pb.clientrpc.v1.DownloadManagerItem.Download
@@ -519,6 +907,13 @@ export type DownloadManagerItem_Download = Message<"pb.clientrpc.v1.DownloadMana
    * @generated from field: optional string error_message = 6;
    */
   errorMessage?: string;
+
+  /**
+   * The result of scanning the download once it completed.
+   *
+   * @generated from field: pb.clientrpc.v1.ScanStatus scan_status = 7;
+   */
+  scanStatus: ScanStatus;
 };
 
 /**
@@ -526,7 +921,7 @@ export type DownloadManagerItem_Download = Message<"pb.clientrpc.v1.DownloadMana
  * Use `create(DownloadManagerItem_DownloadSchema)` to create a new message.
  */
 export const DownloadManagerItem_DownloadSchema: GenMessage<DownloadManagerItem_Download> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 5, 0);
+  messageDesc(file_pb_clientrpc_v1_rpc, 7, 0);
 
 /**
  * @generated from enum pb.clientrpc.v1.DownloadManagerItem.Type
@@ -551,64 +946,259 @@ export enum DownloadManagerItem_Type {
  * Describes the enum pb.clientrpc.v1.DownloadManagerItem.Type.
  */
 export const DownloadManagerItem_TypeSchema: GenEnum<DownloadManagerItem_Type> = /*@__PURE__*/
-  enumDesc(file_pb_clientrpc_v1_rpc, 5, 0);
+  enumDesc(file_pb_clientrpc_v1_rpc, 7, 0);
 
 /**
- * Information about an update.
+ * DownloadHookInfo is information about a download post-processing hook.
  *
- * @generated from message pb.clientrpc.v1.UpdateInfo
+ * @generated from message pb.clientrpc.v1.DownloadHookInfo
  */
-export type UpdateInfo = Message<"pb.clientrpc.v1.UpdateInfo"> & {
+export type DownloadHookInfo = Message<"pb.clientrpc.v1.DownloadHookInfo"> & {
   /**
-   * Whether the checked update was valid.
-   * If false, no other fields will be filled.
-   * An invalid update is caused by an invalid signature on an update,
-   * which is indicative of a larger problem.
+   * The hook's UUID.
    *
-   * @generated from field: bool is_valid = 1;
+   * @generated from field: string uuid = 1;
    */
-  isValid: boolean;
+  uuid: string;
 
   /**
-   * The release timestamp.
+   * The UNIX timestamp when the hook was created.
    *
    * @generated from field: int64 created_ts = 2;
    */
   createdTs: bigint;
 
   /**
-   * The version string.
+   * The hook's type.
    *
-   * @generated from field: string version = 3;
+   * @generated from field: pb.clientrpc.v1.DownloadHookType type = 3;
    */
-  version: string;
+  type: DownloadHookType;
 
   /**
-   * The description.
+   * The hook's target.
+   * For command hooks, it is the absolute path of the executable to run.
+   * For webhooks, it is the HTTP or HTTPS URL to send the request to.
    *
-   * @generated from field: string description = 4;
+   * @generated from field: string target = 4;
    */
-  description: string;
+  target: string;
 
   /**
-   * The URL to get the update.
-   * It is not a URL to a binary, it is a URL to a page to get the binary.
+   * The UUID of the download the hook applies to, or omitted if the hook applies to all downloads.
    *
-   * @generated from field: string url = 5;
+   * @generated from field: optional string download_uuid = 5;
    */
-  url: string;
+  downloadUuid?: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.UpdateInfo.
+ * Describes the message pb.clientrpc.v1.DownloadHookInfo.
+ * Use `create(DownloadHookInfoSchema)` to create a new message.
+ */
+export const DownloadHookInfoSchema: GenMessage<DownloadHookInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 8);
+
+/**
+ * Information about an update.
+ *
+ * @generated from message pb.clientrpc.v1.UpdateInfo
+ */
+export type UpdateInfo = Message<"pb.clientrpc.v1.UpdateInfo"> & {
+  /**
+   * Whether the checked update was valid.
+   * If false, no other fields will be filled.
+   * An invalid update is caused by an invalid signature on an update,
+   * which is indicative of a larger problem.
+   *
+   * @generated from field: bool is_valid = 1;
+   */
+  isValid: boolean;
+
+  /**
+   * The release timestamp.
+   *
+   * @generated from field: int64 created_ts = 2;
+   */
+  createdTs: bigint;
+
+  /**
+   * The version string.
+   *
+   * @generated from field: string version = 3;
+   */
+  version: string;
+
+  /**
+   * The description.
+   *
+   * @generated from field: string description = 4;
+   */
+  description: string;
+
+  /**
+   * The URL to get the update.
+   * It is not a URL to a binary, it is a URL to a page to get the binary.
+   *
+   * @generated from field: string url = 5;
+   */
+  url: string;
+
+  /**
+   * Whether the update has a binary for this platform that ApplyUpdate can install.
+   * If false, the update must be installed manually from url.
+   *
+   * @generated from field: bool can_apply = 6;
+   */
+  canApply: boolean;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UpdateInfo.
  * Use `create(UpdateInfoSchema)` to create a new message.
  */
 export const UpdateInfoSchema: GenMessage<UpdateInfo> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 6);
+  messageDesc(file_pb_clientrpc_v1_rpc, 9);
+
+/**
+ * UpdateSettings are the client's update settings.
+ *
+ * @generated from message pb.clientrpc.v1.UpdateSettings
+ */
+export type UpdateSettings = Message<"pb.clientrpc.v1.UpdateSettings"> & {
+  /**
+   * The release channel to check for updates on.
+   *
+   * @generated from field: pb.clientrpc.v1.UpdateChannel channel = 1;
+   */
+  channel: UpdateChannel;
+
+  /**
+   * The base URL of the release endpoint to check for updates at, or empty for the official one.
+   * Must be an HTTP or HTTPS URL. Releases must still be signed with the official key.
+   *
+   * @generated from field: string base_url = 2;
+   */
+  baseUrl: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UpdateSettings.
+ * Use `create(UpdateSettingsSchema)` to create a new message.
+ */
+export const UpdateSettingsSchema: GenMessage<UpdateSettings> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 10);
+
+/**
+ * ErrorInfo is attached as a detail to RPC errors with a known cause, so that clients can show an actionable message.
+ *
+ * @generated from message pb.clientrpc.v1.ErrorInfo
+ */
+export type ErrorInfo = Message<"pb.clientrpc.v1.ErrorInfo"> & {
+  /**
+   * The cause of the error.
+   *
+   * @generated from field: pb.clientrpc.v1.ErrorReason reason = 1;
+   */
+  reason: ErrorReason;
+
+  /**
+   * The message the server sent with the rejection, if any.
+   *
+   * @generated from field: optional string message = 2;
+   */
+  message?: string;
+
+  /**
+   * The hostname the error is about, for certificate errors.
+   *
+   * @generated from field: optional string host = 3;
+   */
+  host?: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ErrorInfo.
+ * Use `create(ErrorInfoSchema)` to create a new message.
+ */
+export const ErrorInfoSchema: GenMessage<ErrorInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 11);
 
 /**
  * Information about a server.
+ * RttStats is round-trip time statistics for pings sent over a connection.
  *
+ * @generated from message pb.clientrpc.v1.RttStats
+ */
+export type RttStats = Message<"pb.clientrpc.v1.RttStats"> & {
+  /**
+   * The most recent round-trip time, in microseconds.
+   * 0 if no ping has succeeded yet.
+   *
+   * @generated from field: int64 last_us = 1;
+   */
+  lastUs: bigint;
+
+  /**
+   * The minimum round-trip time over recent pings, in microseconds.
+   *
+   * @generated from field: int64 min_us = 2;
+   */
+  minUs: bigint;
+
+  /**
+   * The average round-trip time over recent pings, in microseconds.
+   *
+   * @generated from field: int64 avg_us = 3;
+   */
+  avgUs: bigint;
+
+  /**
+   * The maximum round-trip time over recent pings, in microseconds.
+   *
+   * @generated from field: int64 max_us = 4;
+   */
+  maxUs: bigint;
+
+  /**
+   * The number of recent pings the minimum, average and maximum were computed from.
+   *
+   * @generated from field: uint32 samples = 5;
+   */
+  samples: number;
+
+  /**
+   * The total number of pings that failed.
+   *
+   * @generated from field: uint64 lost = 6;
+   */
+  lost: bigint;
+
+  /**
+   * The number of pings that failed in a row since the last successful one.
+   *
+   * @generated from field: uint32 consecutive_lost = 7;
+   */
+  consecutiveLost: number;
+
+  /**
+   * The estimated offset of the other side's clock from the local clock, in microseconds.
+   * Positive if the other side's clock is ahead.
+   * Only set if the other side reported when it received and answered pings.
+   *
+   * @generated from field: optional int64 clock_offset_us = 8;
+   */
+  clockOffsetUs?: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.RttStats.
+ * Use `create(RttStatsSchema)` to create a new message.
+ */
+export const RttStatsSchema: GenMessage<RttStats> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 12);
+
+/**
  * @generated from message pb.clientrpc.v1.ServerInfo
  */
 export type ServerInfo = Message<"pb.clientrpc.v1.ServerInfo"> & {
@@ -667,7 +1257,7 @@ export type ServerInfo = Message<"pb.clientrpc.v1.ServerInfo"> & {
  * Use `create(ServerInfoSchema)` to create a new message.
  */
 export const ServerInfoSchema: GenMessage<ServerInfo> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 7);
+  messageDesc(file_pb_clientrpc_v1_rpc, 13);
 
 /**
  * @generated from message pb.clientrpc.v1.ServerInfo.State
@@ -679,6 +1269,39 @@ export type ServerInfo_State = Message<"pb.clientrpc.v1.ServerInfo.State"> & {
    * @generated from field: pb.clientrpc.v1.ServerConnState conn_state = 1;
    */
   connState: ServerConnState;
+
+  /**
+   * Round-trip time statistics for pings sent to the server.
+   * Only set while the connection is open.
+   *
+   * @generated from field: pb.clientrpc.v1.RttStats rtt = 2;
+   */
+  rtt?: RttStats;
+
+  /**
+   * The room's message of the day, as of when the connection opened.
+   * Only set while the connection is open and the room has one.
+   *
+   * @generated from field: optional string motd = 3;
+   */
+  motd?: string;
+
+  /**
+   * Information the server reported about its software and the room's policies.
+   * Only set while the connection is open and the server supports reporting it.
+   *
+   * @generated from field: pb.clientrpc.v1.RemoteServerInfo remote = 4;
+   */
+  remote?: RemoteServerInfo;
+
+  /**
+   * How far the server's clock was ahead of the local clock when the connection opened, in milliseconds.
+   * Negative if the server's clock was behind.
+   * Only set while the connection is open and the server reported its time.
+   *
+   * @generated from field: optional int64 clock_skew_ms = 5;
+   */
+  clockSkewMs?: bigint;
 };
 
 /**
@@ -686,7 +1309,78 @@ export type ServerInfo_State = Message<"pb.clientrpc.v1.ServerInfo.State"> & {
  * Use `create(ServerInfo_StateSchema)` to create a new message.
  */
 export const ServerInfo_StateSchema: GenMessage<ServerInfo_State> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 7, 0);
+  messageDesc(file_pb_clientrpc_v1_rpc, 13, 0);
+
+/**
+ * Information a connected server reported about its software and the policies of the room the client is in.
+ *
+ * @generated from message pb.clientrpc.v1.RemoteServerInfo
+ */
+export type RemoteServerInfo = Message<"pb.clientrpc.v1.RemoteServerInfo"> & {
+  /**
+   * The server software's version.
+   *
+   * @generated from field: string version = 1;
+   */
+  version: string;
+
+  /**
+   * The protocol version the server speaks, in "MAJOR.MINOR.PATCH" format.
+   *
+   * @generated from field: string protocol_version = 2;
+   */
+  protocolVersion: string;
+
+  /**
+   * The optional features that are enabled on the server, such as "registration".
+   *
+   * @generated from field: repeated string features = 3;
+   */
+  features: string[];
+
+  /**
+   * The maximum number of online clients in the room, or 0 if unlimited.
+   *
+   * @generated from field: uint32 max_clients = 4;
+   */
+  maxClients: number;
+
+  /**
+   * The maximum number of concurrent proxied streams each client can open, or 0 if unlimited.
+   *
+   * @generated from field: uint32 max_proxy_streams_per_client = 5;
+   */
+  maxProxyStreamsPerClient: number;
+
+  /**
+   * The maximum number of requests each client can have in flight at once, or 0 if unlimited.
+   *
+   * @generated from field: uint32 max_concurrent_requests = 6;
+   */
+  maxConcurrentRequests: number;
+
+  /**
+   * How long the server caches directory listings it proxies, in milliseconds, or 0 if it does not cache them.
+   *
+   * @generated from field: uint32 dir_cache_ttl_ms = 7;
+   */
+  dirCacheTtlMs: number;
+
+  /**
+   * The maximum number of bytes per second relayed through the server for all clients combined, as of when the
+   * client connected, or 0 if unlimited.
+   *
+   * @generated from field: int64 relay_max_bytes_per_second = 8;
+   */
+  relayMaxBytesPerSecond: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.RemoteServerInfo.
+ * Use `create(RemoteServerInfoSchema)` to create a new message.
+ */
+export const RemoteServerInfoSchema: GenMessage<RemoteServerInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 14);
 
 /**
  * Information about a server share.
@@ -736,6 +1430,42 @@ export type ShareInfo = Message<"pb.clientrpc.v1.ShareInfo"> & {
    * @generated from field: int64 created_ts = 6;
    */
   createdTs: bigint;
+
+  /**
+   * Directories mounted under virtual paths in the share, in addition to its own path, which is mounted at "/".
+   *
+   * @generated from field: repeated pb.clientrpc.v1.ShareMount mounts = 7;
+   */
+  mounts: ShareMount[];
+
+  /**
+   * How files that exist at the same path in more than one mounted directory are resolved.
+   *
+   * @generated from field: pb.clientrpc.v1.ShareConflictRule conflict_rule = 8;
+   */
+  conflictRule: ShareConflictRule;
+
+  /**
+   * Patterns of paths that are hidden from the share and left out of its search index.
+   * See CreateShareRequest.exclude_patterns for their syntax.
+   *
+   * @generated from field: repeated string exclude_patterns = 9;
+   */
+  excludePatterns: string[];
+
+  /**
+   * Whether paths requested by peers are matched regardless of case.
+   *
+   * @generated from field: bool case_insensitive = 10;
+   */
+  caseInsensitive: boolean;
+
+  /**
+   * The Unicode normalization form that paths requested by peers are matched in.
+   *
+   * @generated from field: pb.clientrpc.v1.ShareUnicodeForm unicode_form = 11;
+   */
+  unicodeForm: ShareUnicodeForm;
 };
 
 /**
@@ -743,67 +1473,257 @@ export type ShareInfo = Message<"pb.clientrpc.v1.ShareInfo"> & {
  * Use `create(ShareInfoSchema)` to create a new message.
  */
 export const ShareInfoSchema: GenMessage<ShareInfo> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 8);
+  messageDesc(file_pb_clientrpc_v1_rpc, 15);
 
 /**
- * OnlineUserInfo is information about an online user.
+ * ShareMount is a directory on disk that is mounted under a virtual path in a share.
+ * Directories mounted at the same path are merged, and directories leading up to the virtual path always exist.
  *
- * @generated from message pb.clientrpc.v1.OnlineUserInfo
+ * @generated from message pb.clientrpc.v1.ShareMount
  */
-export type OnlineUserInfo = Message<"pb.clientrpc.v1.OnlineUserInfo"> & {
+export type ShareMount = Message<"pb.clientrpc.v1.ShareMount"> & {
   /**
-   * The user's username.
+   * The path in the share that the directory appears at, such as "/music".
+   * Use "/" to merge the directory with the share's own path.
    *
-   * @generated from field: string username = 1;
+   * @generated from field: string virtual_path = 1;
    */
-  username: string;
+  virtualPath: string;
+
+  /**
+   * The directory's absolute path on disk.
+   *
+   * @generated from field: string path = 2;
+   */
+  path: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.OnlineUserInfo.
- * Use `create(OnlineUserInfoSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.ShareMount.
+ * Use `create(ShareMountSchema)` to create a new message.
  */
-export const OnlineUserInfoSchema: GenMessage<OnlineUserInfo> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 9);
+export const ShareMountSchema: GenMessage<ShareMount> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 16);
 
 /**
- * FileMeta is metadata about a file/folder.
+ * ShareLinkInfo is a link that gives read-only access to a path in a share through the public HTTPS gateway, to people
+ * who do not use FriendNet.
  *
- * @generated from message pb.clientrpc.v1.FileMeta
+ * @generated from message pb.clientrpc.v1.ShareLinkInfo
  */
-export type FileMeta = Message<"pb.clientrpc.v1.FileMeta"> & {
+export type ShareLinkInfo = Message<"pb.clientrpc.v1.ShareLinkInfo"> & {
   /**
-   * The file's name.
+   * The link's token.
+   * Anyone who knows it can read the files the link points to.
    *
-   * @generated from field: string name = 1;
+   * @generated from field: string token = 1;
    */
-  name: string;
+  token: string;
 
   /**
-   * Whether the file is a directory.
+   * The UUID of the share's server.
    *
-   * @generated from field: bool is_dir = 2;
+   * @generated from field: string server_uuid = 2;
    */
-  isDir: boolean;
+  serverUuid: string;
 
   /**
-   * The file's size, in bytes.
-   * Always zero if the file is a folder.
+   * The share's name.
    *
-   * @generated from field: uint64 size = 3;
+   * @generated from field: string share_name = 3;
    */
-  size: bigint;
-};
-
-/**
- * Describes the message pb.clientrpc.v1.FileMeta.
- * Use `create(FileMetaSchema)` to create a new message.
- */
-export const FileMetaSchema: GenMessage<FileMeta> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 10);
+  shareName: string;
 
-/**
- * DirectSettings is direct connection settings for the client.
+  /**
+   * The path within the share that the link points to.
+   * A link to a directory gives access to everything in it.
+   *
+   * @generated from field: string path = 4;
+   */
+  path: string;
+
+  /**
+   * The UNIX timestamp when the link was created.
+   *
+   * @generated from field: int64 created_ts = 5;
+   */
+  createdTs: bigint;
+
+  /**
+   * The UNIX timestamp when the link expires, if it does.
+   *
+   * @generated from field: optional int64 expires_ts = 6;
+   */
+  expiresTs?: bigint;
+
+  /**
+   * The link's URL.
+   * Only set if the client has the public gateway enabled.
+   *
+   * @generated from field: optional string url = 7;
+   */
+  url?: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ShareLinkInfo.
+ * Use `create(ShareLinkInfoSchema)` to create a new message.
+ */
+export const ShareLinkInfoSchema: GenMessage<ShareLinkInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 17);
+
+/**
+ * OnlineUserInfo is information about an online user.
+ *
+ * @generated from message pb.clientrpc.v1.OnlineUserInfo
+ */
+export type OnlineUserInfo = Message<"pb.clientrpc.v1.OnlineUserInfo"> & {
+  /**
+   * The user's username.
+   *
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * The local friend info for the user, if there is any.
+   *
+   * @generated from field: optional pb.clientrpc.v1.FriendInfo friend = 2;
+   */
+  friend?: FriendInfo;
+
+  /**
+   * Whether the user is on the local block list.
+   *
+   * @generated from field: bool blocked = 3;
+   */
+  blocked: boolean;
+
+  /**
+   * Round-trip time statistics for pings sent to the user over direct connections.
+   * Only set while there is a direct connection to the user.
+   *
+   * @generated from field: optional pb.clientrpc.v1.RttStats direct_rtt = 4;
+   */
+  directRtt?: RttStats;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.OnlineUserInfo.
+ * Use `create(OnlineUserInfoSchema)` to create a new message.
+ */
+export const OnlineUserInfoSchema: GenMessage<OnlineUserInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 18);
+
+/**
+ * FriendInfo is local information the user attached to a peer on a server.
+ * It is never shared with the server or other peers.
+ *
+ * @generated from message pb.clientrpc.v1.FriendInfo
+ */
+export type FriendInfo = Message<"pb.clientrpc.v1.FriendInfo"> & {
+  /**
+   * The UUID of the server the peer is on.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The peer's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * The nickname to show for the peer, or empty to show the username.
+   *
+   * @generated from field: string nickname = 3;
+   */
+  nickname: string;
+
+  /**
+   * A free-form note about the peer.
+   *
+   * @generated from field: string note = 4;
+   */
+  note: string;
+
+  /**
+   * How much the peer is trusted.
+   *
+   * @generated from field: pb.clientrpc.v1.TrustLevel trust_level = 5;
+   */
+  trustLevel: TrustLevel;
+
+  /**
+   * The UNIX timestamp when the friend info was created.
+   *
+   * @generated from field: int64 created_ts = 6;
+   */
+  createdTs: bigint;
+
+  /**
+   * The UNIX timestamp when the friend info was last updated.
+   *
+   * @generated from field: int64 updated_ts = 7;
+   */
+  updatedTs: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.FriendInfo.
+ * Use `create(FriendInfoSchema)` to create a new message.
+ */
+export const FriendInfoSchema: GenMessage<FriendInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 19);
+
+/**
+ * FileMeta is metadata about a file/folder.
+ *
+ * @generated from message pb.clientrpc.v1.FileMeta
+ */
+export type FileMeta = Message<"pb.clientrpc.v1.FileMeta"> & {
+  /**
+   * The file's name.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * Whether the file is a directory.
+   *
+   * @generated from field: bool is_dir = 2;
+   */
+  isDir: boolean;
+
+  /**
+   * The file's size, in bytes.
+   * Always zero if the file is a folder.
+   *
+   * @generated from field: uint64 size = 3;
+   */
+  size: bigint;
+
+  /**
+   * The UNIX timestamp when the file was last modified, if known.
+   *
+   * @generated from field: optional int64 modified_ts = 4;
+   */
+  modifiedTs?: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.FileMeta.
+ * Use `create(FileMetaSchema)` to create a new message.
+ */
+export const FileMetaSchema: GenMessage<FileMeta> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 20);
+
+/**
+ * DirectSettings is direct connection settings for the client.
  *
  * @generated from message pb.clientrpc.v1.DirectSettings
  */
@@ -888,7 +1808,7 @@ export type DirectSettings = Message<"pb.clientrpc.v1.DirectSettings"> & {
  * Use `create(DirectSettingsSchema)` to create a new message.
  */
 export const DirectSettingsSchema: GenMessage<DirectSettings> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 11);
+  messageDesc(file_pb_clientrpc_v1_rpc, 21);
 
 /**
  * TransferSettings are transfer (download and upload) settings for the client.
@@ -918,6 +1838,57 @@ export type TransferSettings = Message<"pb.clientrpc.v1.TransferSettings"> & {
    * @generated from field: string complete_download_dir = 3;
    */
   completeDownloadDir: string;
+
+  /**
+   * The template for paths of complete downloads, relative to the complete download directory.
+   * Placeholders are {peer}, {server}, {server_uuid}, {room}, {path}, {dir} and {name}.
+   * Must contain {path} or {name}.
+   * If empty, the default "{peer}-{server_uuid}/{path}" is used.
+   *
+   * @generated from field: string download_path_template = 4;
+   */
+  downloadPathTemplate: string;
+
+  /**
+   * Directories to store complete downloads from specific servers in, instead of complete_download_dir.
+   * Keys are server UUIDs, and values must be absolute paths.
+   *
+   * @generated from field: map<string, string> server_complete_download_dirs = 5;
+   */
+  serverCompleteDownloadDirs: { [key: string]: string };
+
+  /**
+   * Whether to keep partial downloads in incomplete_download_dir.
+   * Otherwise, they are kept next to where they are saved once complete, with a ".part" suffix.
+   *
+   * @generated from field: bool part_files_in_incomplete_dir = 6;
+   */
+  partFilesInIncompleteDir: boolean;
+
+  /**
+   * How long active uploads are given to finish when the client shuts down, in seconds.
+   * New uploads are rejected while waiting. If 0, the client shuts down without waiting.
+   *
+   * @generated from field: uint32 shutdown_grace_seconds = 7;
+   */
+  shutdownGraceSeconds: number;
+
+  /**
+   * The absolute path of a command, such as clamdscan, that scans completed downloads before they are moved to
+   * complete_download_dir. It gets the file's path as its only argument, and must exit with 0 if nothing was found,
+   * or 1 if a threat was found. If empty, downloads are not scanned.
+   *
+   * @generated from field: string scan_command = 8;
+   */
+  scanCommand: string;
+
+  /**
+   * The directory that downloads with threats are moved to.
+   * Must be an absolute path. Changes take effect after the client restarts.
+   *
+   * @generated from field: string quarantine_dir = 9;
+   */
+  quarantineDir: string;
 };
 
 /**
@@ -925,7 +1896,50 @@ export type TransferSettings = Message<"pb.clientrpc.v1.TransferSettings"> & {
  * Use `create(TransferSettingsSchema)` to create a new message.
  */
 export const TransferSettingsSchema: GenMessage<TransferSettings> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 12);
+  messageDesc(file_pb_clientrpc_v1_rpc, 22);
+
+/**
+ * NotificationSettings are settings for notifying the user about client events.
+ *
+ * @generated from message pb.clientrpc.v1.NotificationSettings
+ */
+export type NotificationSettings = Message<"pb.clientrpc.v1.NotificationSettings"> & {
+  /**
+   * Whether to show desktop notifications.
+   *
+   * @generated from field: bool desktop = 1;
+   */
+  desktop: boolean;
+
+  /**
+   * The URL to send notifications to as JSON in POST requests, in addition to desktop notifications.
+   * Must be an HTTP or HTTPS URL. If empty, no webhook is used.
+   *
+   * @generated from field: string webhook_url = 2;
+   */
+  webhookUrl: string;
+
+  /**
+   * Whether to notify when a download completes.
+   *
+   * @generated from field: bool download_complete = 3;
+   */
+  downloadComplete: boolean;
+
+  /**
+   * Whether to notify when a friend comes online.
+   *
+   * @generated from field: bool friend_online = 4;
+   */
+  friendOnline: boolean;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.NotificationSettings.
+ * Use `create(NotificationSettingsSchema)` to create a new message.
+ */
+export const NotificationSettingsSchema: GenMessage<NotificationSettings> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 23);
 
 /**
  * @generated from message pb.clientrpc.v1.StreamEventsRequest
@@ -938,7 +1952,7 @@ export type StreamEventsRequest = Message<"pb.clientrpc.v1.StreamEventsRequest">
  * Use `create(StreamEventsRequestSchema)` to create a new message.
  */
 export const StreamEventsRequestSchema: GenMessage<StreamEventsRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 13);
+  messageDesc(file_pb_clientrpc_v1_rpc, 24);
 
 /**
  * @generated from message pb.clientrpc.v1.StreamEventsResponse
@@ -964,7 +1978,7 @@ export type StreamEventsResponse = Message<"pb.clientrpc.v1.StreamEventsResponse
  * Use `create(StreamEventsResponseSchema)` to create a new message.
  */
 export const StreamEventsResponseSchema: GenMessage<StreamEventsResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 14);
+  messageDesc(file_pb_clientrpc_v1_rpc, 25);
 
 /**
  * @generated from message pb.clientrpc.v1.StreamLogsRequest
@@ -984,7 +1998,7 @@ export type StreamLogsRequest = Message<"pb.clientrpc.v1.StreamLogsRequest"> & {
  * Use `create(StreamLogsRequestSchema)` to create a new message.
  */
 export const StreamLogsRequestSchema: GenMessage<StreamLogsRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 15);
+  messageDesc(file_pb_clientrpc_v1_rpc, 26);
 
 /**
  * @generated from message pb.clientrpc.v1.StreamLogsResponse
@@ -1006,7 +2020,7 @@ export type StreamLogsResponse = Message<"pb.clientrpc.v1.StreamLogsResponse"> &
  * Use `create(StreamLogsResponseSchema)` to create a new message.
  */
 export const StreamLogsResponseSchema: GenMessage<StreamLogsResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 16);
+  messageDesc(file_pb_clientrpc_v1_rpc, 27);
 
 /**
  * @generated from message pb.clientrpc.v1.StopRequest
@@ -1019,7 +2033,7 @@ export type StopRequest = Message<"pb.clientrpc.v1.StopRequest"> & {
  * Use `create(StopRequestSchema)` to create a new message.
  */
 export const StopRequestSchema: GenMessage<StopRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 17);
+  messageDesc(file_pb_clientrpc_v1_rpc, 28);
 
 /**
  * @generated from message pb.clientrpc.v1.StopResponse
@@ -1032,7 +2046,7 @@ export type StopResponse = Message<"pb.clientrpc.v1.StopResponse"> & {
  * Use `create(StopResponseSchema)` to create a new message.
  */
 export const StopResponseSchema: GenMessage<StopResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 18);
+  messageDesc(file_pb_clientrpc_v1_rpc, 29);
 
 /**
  * @generated from message pb.clientrpc.v1.GetClientInfoRequest
@@ -1045,12 +2059,60 @@ export type GetClientInfoRequest = Message<"pb.clientrpc.v1.GetClientInfoRequest
  * Use `create(GetClientInfoRequestSchema)` to create a new message.
  */
 export const GetClientInfoRequestSchema: GenMessage<GetClientInfoRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 19);
+  messageDesc(file_pb_clientrpc_v1_rpc, 30);
 
 /**
  * @generated from message pb.clientrpc.v1.GetClientInfoResponse
  */
 export type GetClientInfoResponse = Message<"pb.clientrpc.v1.GetClientInfoResponse"> & {
+  /**
+   * The client's version.
+   *
+   * @generated from field: string version = 1;
+   */
+  version: string;
+
+  /**
+   * The protocol version the client speaks, such as "1.0.1".
+   *
+   * @generated from field: string protocol_version = 2;
+   */
+  protocolVersion: string;
+
+  /**
+   * The commit the client was built from, or empty if unknown.
+   * Ends with "-dirty" if the build had uncommitted changes.
+   *
+   * @generated from field: string build_commit = 3;
+   */
+  buildCommit: string;
+
+  /**
+   * The UNIX timestamp when the client started.
+   *
+   * @generated from field: int64 start_ts = 4;
+   */
+  startTs: bigint;
+
+  /**
+   * How long the client has been running, in seconds.
+   *
+   * @generated from field: int64 uptime_seconds = 5;
+   */
+  uptimeSeconds: bigint;
+
+  /**
+   * The optional features that are enabled on the client.
+   * Unknown features must be ignored.
+   *
+   * Possible values:
+   *  - "share_gateway": The public share link gateway is enabled, so share links have URLs.
+   *  - "self_update": The client can install updates with ApplyUpdate.
+   *  - "keychain": Secrets are stored in the OS keychain.
+   *
+   * @generated from field: repeated string features = 6;
+   */
+  features: string[];
 };
 
 /**
@@ -1058,1246 +2120,6004 @@ export type GetClientInfoResponse = Message<"pb.clientrpc.v1.GetClientInfoRespon
  * Use `create(GetClientInfoResponseSchema)` to create a new message.
  */
 export const GetClientInfoResponseSchema: GenMessage<GetClientInfoResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 20);
+  messageDesc(file_pb_clientrpc_v1_rpc, 31);
 
 /**
- * @generated from message pb.clientrpc.v1.GetServersRequest
+ * @generated from message pb.clientrpc.v1.GetMemoryUsageRequest
  */
-export type GetServersRequest = Message<"pb.clientrpc.v1.GetServersRequest"> & {
+export type GetMemoryUsageRequest = Message<"pb.clientrpc.v1.GetMemoryUsageRequest"> & {
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetServersRequest.
- * Use `create(GetServersRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.GetMemoryUsageRequest.
+ * Use `create(GetMemoryUsageRequestSchema)` to create a new message.
  */
-export const GetServersRequestSchema: GenMessage<GetServersRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 21);
+export const GetMemoryUsageRequestSchema: GenMessage<GetMemoryUsageRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 32);
 
 /**
- * @generated from message pb.clientrpc.v1.GetServersResponse
+ * @generated from message pb.clientrpc.v1.GetMemoryUsageResponse
  */
-export type GetServersResponse = Message<"pb.clientrpc.v1.GetServersResponse"> & {
+export type GetMemoryUsageResponse = Message<"pb.clientrpc.v1.GetMemoryUsageResponse"> & {
   /**
-   * The server records.
+   * Whether the client is running in low-memory mode.
    *
-   * @generated from field: repeated pb.clientrpc.v1.ServerInfo servers = 1;
+   * @generated from field: bool low_memory = 1;
    */
-  servers: ServerInfo[];
-};
+  lowMemory: boolean;
 
-/**
- * Describes the message pb.clientrpc.v1.GetServersResponse.
- * Use `create(GetServersResponseSchema)` to create a new message.
- */
-export const GetServersResponseSchema: GenMessage<GetServersResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 22);
+  /**
+   * Whether the client will run in low-memory mode after it restarts, as set by SetLowMemoryMode.
+   * The client also runs in low-memory mode when started with the -lowmem flag, regardless of this setting.
+   *
+   * @generated from field: bool low_memory_setting = 2;
+   */
+  lowMemorySetting: boolean;
 
-/**
- * @generated from message pb.clientrpc.v1.CreateServerRequest
- */
-export type CreateServerRequest = Message<"pb.clientrpc.v1.CreateServerRequest"> & {
   /**
-   * The name given to the server record.
+   * The memory the client has obtained from the OS and not returned, in bytes.
+   * This is what soft_limit_bytes applies to.
    *
-   * @generated from field: string name = 1;
+   * @generated from field: uint64 used_bytes = 3;
    */
-  name: string;
+  usedBytes: bigint;
 
   /**
-   * The server's address.
+   * The memory used by live and not yet collected objects, in bytes.
    *
-   * @generated from field: string address = 2;
+   * @generated from field: uint64 heap_bytes = 4;
    */
-  address: string;
+  heapBytes: bigint;
 
   /**
-   * The room to connect to.
+   * The soft memory limit, in bytes, or 0 if there is none.
+   * The client collects garbage more often as it gets close to the limit, but may exceed it.
    *
-   * @generated from field: string room = 3;
+   * @generated from field: uint64 soft_limit_bytes = 5;
    */
-  room: string;
+  softLimitBytes: bigint;
 
   /**
-   * The username to use.
+   * The number of bytes currently held in read-ahead buffers of files being streamed.
    *
-   * @generated from field: string username = 4;
+   * @generated from field: uint64 read_ahead_buffered_bytes = 6;
    */
-  username: string;
+  readAheadBufferedBytes: bigint;
 
   /**
-   * The password to use.
+   * The maximum number of bytes read ahead from each file being streamed.
    *
-   * @generated from field: string password = 5;
+   * @generated from field: uint64 max_read_ahead_bytes = 7;
    */
-  password: string;
-};
+  maxReadAheadBytes: bigint;
 
-/**
- * Describes the message pb.clientrpc.v1.CreateServerRequest.
- * Use `create(CreateServerRequestSchema)` to create a new message.
- */
-export const CreateServerRequestSchema: GenMessage<CreateServerRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 23);
+  /**
+   * The maximum number of concurrent downloads, or 0 if only the download concurrency setting applies.
+   *
+   * @generated from field: uint32 max_download_concurrency = 8;
+   */
+  maxDownloadConcurrency: number;
 
-/**
- * @generated from message pb.clientrpc.v1.CreateServerResponse
- */
-export type CreateServerResponse = Message<"pb.clientrpc.v1.CreateServerResponse"> & {
   /**
-   * The newly created server record.
+   * The maximum number of streams a peer may have open at once on a direct connection.
    *
-   * @generated from field: pb.clientrpc.v1.ServerInfo server = 1;
+   * @generated from field: uint32 max_incoming_streams = 9;
    */
-  server?: ServerInfo;
+  maxIncomingStreams: number;
+
+  /**
+   * The maximum number of requests handled for a peer at once, or 0 for no limit.
+   *
+   * @generated from field: uint32 max_concurrent_requests = 10;
+   */
+  maxConcurrentRequests: number;
+
+  /**
+   * The maximum number of files indexed per share.
+   *
+   * @generated from field: uint32 max_index_files = 11;
+   */
+  maxIndexFiles: number;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.CreateServerResponse.
- * Use `create(CreateServerResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.GetMemoryUsageResponse.
+ * Use `create(GetMemoryUsageResponseSchema)` to create a new message.
  */
-export const CreateServerResponseSchema: GenMessage<CreateServerResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 24);
+export const GetMemoryUsageResponseSchema: GenMessage<GetMemoryUsageResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 33);
 
 /**
- * @generated from message pb.clientrpc.v1.DeleteServerRequest
+ * @generated from message pb.clientrpc.v1.SetLowMemoryModeRequest
  */
-export type DeleteServerRequest = Message<"pb.clientrpc.v1.DeleteServerRequest"> & {
+export type SetLowMemoryModeRequest = Message<"pb.clientrpc.v1.SetLowMemoryModeRequest"> & {
   /**
-   * The server's UUID.
+   * Whether to run in low-memory mode.
    *
-   * @generated from field: string uuid = 1;
+   * @generated from field: bool enabled = 1;
    */
-  uuid: string;
+  enabled: boolean;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.DeleteServerRequest.
- * Use `create(DeleteServerRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.SetLowMemoryModeRequest.
+ * Use `create(SetLowMemoryModeRequestSchema)` to create a new message.
  */
-export const DeleteServerRequestSchema: GenMessage<DeleteServerRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 25);
+export const SetLowMemoryModeRequestSchema: GenMessage<SetLowMemoryModeRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 34);
 
 /**
- * @generated from message pb.clientrpc.v1.DeleteServerResponse
+ * @generated from message pb.clientrpc.v1.SetLowMemoryModeResponse
  */
-export type DeleteServerResponse = Message<"pb.clientrpc.v1.DeleteServerResponse"> & {
+export type SetLowMemoryModeResponse = Message<"pb.clientrpc.v1.SetLowMemoryModeResponse"> & {
 };
 
 /**
- * Describes the message pb.clientrpc.v1.DeleteServerResponse.
- * Use `create(DeleteServerResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.SetLowMemoryModeResponse.
+ * Use `create(SetLowMemoryModeResponseSchema)` to create a new message.
  */
-export const DeleteServerResponseSchema: GenMessage<DeleteServerResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 26);
+export const SetLowMemoryModeResponseSchema: GenMessage<SetLowMemoryModeResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 35);
 
 /**
- * @generated from message pb.clientrpc.v1.ConnectServerRequest
+ * @generated from message pb.clientrpc.v1.GetServersRequest
  */
-export type ConnectServerRequest = Message<"pb.clientrpc.v1.ConnectServerRequest"> & {
+export type GetServersRequest = Message<"pb.clientrpc.v1.GetServersRequest"> & {
   /**
-   * The server's UUID.
+   * The maximum number of servers to return.
+   * If 0, defaults to 500. Values above 1000 are treated as 1000.
    *
-   * @generated from field: string uuid = 1;
+   * @generated from field: uint32 limit = 1;
    */
-  uuid: string;
-};
-
-/**
- * Describes the message pb.clientrpc.v1.ConnectServerRequest.
- * Use `create(ConnectServerRequestSchema)` to create a new message.
- */
-export const ConnectServerRequestSchema: GenMessage<ConnectServerRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 27);
+  limit: number;
 
-/**
- * @generated from message pb.clientrpc.v1.ConnectServerResponse
- */
-export type ConnectServerResponse = Message<"pb.clientrpc.v1.ConnectServerResponse"> & {
+  /**
+   * The cursor returned by a previous call, to get the next page.
+   * Empty to start from the beginning.
+   *
+   * @generated from field: string cursor = 2;
+   */
+  cursor: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.ConnectServerResponse.
- * Use `create(ConnectServerResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.GetServersRequest.
+ * Use `create(GetServersRequestSchema)` to create a new message.
  */
-export const ConnectServerResponseSchema: GenMessage<ConnectServerResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 28);
+export const GetServersRequestSchema: GenMessage<GetServersRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 36);
 
 /**
- * @generated from message pb.clientrpc.v1.DisconnectServerRequest
+ * @generated from message pb.clientrpc.v1.GetServersResponse
  */
-export type DisconnectServerRequest = Message<"pb.clientrpc.v1.DisconnectServerRequest"> & {
+export type GetServersResponse = Message<"pb.clientrpc.v1.GetServersResponse"> & {
   /**
-   * The server's UUID.
+   * A page of server records, ordered by UUID.
    *
-   * @generated from field: string uuid = 1;
+   * @generated from field: repeated pb.clientrpc.v1.ServerInfo servers = 1;
    */
-  uuid: string;
-};
+  servers: ServerInfo[];
 
-/**
- * Describes the message pb.clientrpc.v1.DisconnectServerRequest.
- * Use `create(DisconnectServerRequestSchema)` to create a new message.
- */
-export const DisconnectServerRequestSchema: GenMessage<DisconnectServerRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 29);
+  /**
+   * The cursor to pass to get the next page, or empty if this is the last page.
+   *
+   * @generated from field: string next_cursor = 2;
+   */
+  nextCursor: string;
 
-/**
- * @generated from message pb.clientrpc.v1.DisconnectServerResponse
- */
-export type DisconnectServerResponse = Message<"pb.clientrpc.v1.DisconnectServerResponse"> & {
+  /**
+   * The total number of servers.
+   *
+   * @generated from field: uint32 total = 3;
+   */
+  total: number;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.DisconnectServerResponse.
- * Use `create(DisconnectServerResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.GetServersResponse.
+ * Use `create(GetServersResponseSchema)` to create a new message.
  */
-export const DisconnectServerResponseSchema: GenMessage<DisconnectServerResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 30);
+export const GetServersResponseSchema: GenMessage<GetServersResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 37);
 
 /**
- * @generated from message pb.clientrpc.v1.UpdateServerRequest
+ * @generated from message pb.clientrpc.v1.CreateServerRequest
  */
-export type UpdateServerRequest = Message<"pb.clientrpc.v1.UpdateServerRequest"> & {
+export type CreateServerRequest = Message<"pb.clientrpc.v1.CreateServerRequest"> & {
   /**
-   * The server's UUID.
+   * The name given to the server record.
    *
-   * @generated from field: string uuid = 1;
+   * @generated from field: string name = 1;
    */
-  uuid: string;
+  name: string;
 
   /**
-   * The new name, if any.
+   * The server's address.
    *
-   * @generated from field: optional string name = 2;
+   * @generated from field: string address = 2;
    */
-  name?: string;
+  address: string;
 
   /**
-   * The new address, if any.
+   * The room to connect to.
    *
-   * @generated from field: optional string address = 3;
+   * @generated from field: string room = 3;
    */
-  address?: string;
+  room: string;
 
   /**
-   * The new room, if any.
+   * The username to use.
    *
-   * @generated from field: optional string room = 4;
+   * @generated from field: string username = 4;
    */
-  room?: string;
+  username: string;
 
   /**
-   * The new username, if any.
+   * The password to use.
    *
-   * @generated from field: optional string username = 5;
+   * @generated from field: string password = 5;
    */
-  username?: string;
+  password: string;
 
   /**
-   * The new password, if any.
+   * The UUID of an account template to use.
+   * If set, the template's username and password are used instead of the ones above, and its shares are created on
+   * the server.
+   * Optional.
    *
-   * @generated from field: optional string password = 6;
+   * @generated from field: string account_template_uuid = 6;
    */
-  password?: string;
+  accountTemplateUuid: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.UpdateServerRequest.
- * Use `create(UpdateServerRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.CreateServerRequest.
+ * Use `create(CreateServerRequestSchema)` to create a new message.
  */
-export const UpdateServerRequestSchema: GenMessage<UpdateServerRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 31);
+export const CreateServerRequestSchema: GenMessage<CreateServerRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 38);
 
 /**
- * @generated from message pb.clientrpc.v1.UpdateServerResponse
+ * @generated from message pb.clientrpc.v1.CreateServerResponse
  */
-export type UpdateServerResponse = Message<"pb.clientrpc.v1.UpdateServerResponse"> & {
+export type CreateServerResponse = Message<"pb.clientrpc.v1.CreateServerResponse"> & {
   /**
-   * The server after update.
+   * The newly created server record.
    *
    * @generated from field: pb.clientrpc.v1.ServerInfo server = 1;
    */
   server?: ServerInfo;
+
+  /**
+   * Descriptions of the account template's shares that could not be created, such as because their path no longer
+   * exists.
+   *
+   * @generated from field: repeated string failed_shares = 2;
+   */
+  failedShares: string[];
 };
 
 /**
- * Describes the message pb.clientrpc.v1.UpdateServerResponse.
- * Use `create(UpdateServerResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.CreateServerResponse.
+ * Use `create(CreateServerResponseSchema)` to create a new message.
  */
-export const UpdateServerResponseSchema: GenMessage<UpdateServerResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 32);
+export const CreateServerResponseSchema: GenMessage<CreateServerResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 39);
 
 /**
- * @generated from message pb.clientrpc.v1.GetSharesRequest
+ * @generated from message pb.clientrpc.v1.ImportInviteBundleRequest
  */
-export type GetSharesRequest = Message<"pb.clientrpc.v1.GetSharesRequest"> & {
+export type ImportInviteBundleRequest = Message<"pb.clientrpc.v1.ImportInviteBundleRequest"> & {
   /**
-   * The UUID of the server to get shares for.
+   * The invite bundle URL, starting with friendnet://invite.
    *
-   * @generated from field: string server_uuid = 1;
+   * @generated from field: string url = 1;
    */
-  serverUuid: string;
+  url: string;
+
+  /**
+   * The name given to the server record.
+   * If empty, the bundle's address is used.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * The username to use.
+   * If the bundle has an invite code, an account with this username is registered.
+   *
+   * @generated from field: string username = 3;
+   */
+  username: string;
+
+  /**
+   * The password to use.
+   *
+   * @generated from field: string password = 4;
+   */
+  password: string;
+
+  /**
+   * The UUID of an account template to use.
+   * If set, the template's username and password are used instead of the ones above, and its shares are created on
+   * the server.
+   * Optional.
+   *
+   * @generated from field: string account_template_uuid = 5;
+   */
+  accountTemplateUuid: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetSharesRequest.
- * Use `create(GetSharesRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.ImportInviteBundleRequest.
+ * Use `create(ImportInviteBundleRequestSchema)` to create a new message.
  */
-export const GetSharesRequestSchema: GenMessage<GetSharesRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 33);
+export const ImportInviteBundleRequestSchema: GenMessage<ImportInviteBundleRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 40);
 
 /**
- * @generated from message pb.clientrpc.v1.GetSharesResponse
+ * @generated from message pb.clientrpc.v1.ImportInviteBundleResponse
  */
-export type GetSharesResponse = Message<"pb.clientrpc.v1.GetSharesResponse"> & {
+export type ImportInviteBundleResponse = Message<"pb.clientrpc.v1.ImportInviteBundleResponse"> & {
   /**
-   * The shares.
+   * The newly created server record.
    *
-   * @generated from field: repeated pb.clientrpc.v1.ShareInfo shares = 1;
+   * @generated from field: pb.clientrpc.v1.ServerInfo server = 1;
    */
-  shares: ShareInfo[];
+  server?: ServerInfo;
+
+  /**
+   * Descriptions of the account template's shares that could not be created, such as because their path no longer
+   * exists.
+   *
+   * @generated from field: repeated string failed_shares = 2;
+   */
+  failedShares: string[];
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetSharesResponse.
- * Use `create(GetSharesResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.ImportInviteBundleResponse.
+ * Use `create(ImportInviteBundleResponseSchema)` to create a new message.
  */
-export const GetSharesResponseSchema: GenMessage<GetSharesResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 34);
+export const ImportInviteBundleResponseSchema: GenMessage<ImportInviteBundleResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 41);
 
 /**
- * @generated from message pb.clientrpc.v1.CreateShareRequest
+ * A share in an account template.
+ *
+ * @generated from message pb.clientrpc.v1.AccountTemplateShare
  */
-export type CreateShareRequest = Message<"pb.clientrpc.v1.CreateShareRequest"> & {
-  /**
-   * The UUID of the associated server.
-   *
-   * @generated from field: string server_uuid = 1;
-   */
-  serverUuid: string;
-
+export type AccountTemplateShare = Message<"pb.clientrpc.v1.AccountTemplateShare"> & {
   /**
    * The share's name.
    *
-   * @generated from field: string name = 2;
+   * @generated from field: string name = 1;
    */
   name: string;
 
   /**
    * The share's path on disk.
    *
-   * @generated from field: string path = 3;
+   * @generated from field: string path = 2;
    */
   path: string;
 
   /**
    * Whether to follow links.
    *
-   * @generated from field: bool follow_links = 4;
+   * @generated from field: bool follow_links = 3;
    */
   followLinks: boolean;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.CreateShareRequest.
- * Use `create(CreateShareRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.AccountTemplateShare.
+ * Use `create(AccountTemplateShareSchema)` to create a new message.
  */
-export const CreateShareRequestSchema: GenMessage<CreateShareRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 35);
+export const AccountTemplateShareSchema: GenMessage<AccountTemplateShare> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 42);
 
 /**
- * @generated from message pb.clientrpc.v1.CreateShareResponse
+ * AccountTemplate is a username, password and list of shares that can be reused when adding servers where the same
+ * account is used.
+ *
+ * @generated from message pb.clientrpc.v1.AccountTemplate
  */
-export type CreateShareResponse = Message<"pb.clientrpc.v1.CreateShareResponse"> & {
+export type AccountTemplate = Message<"pb.clientrpc.v1.AccountTemplate"> & {
   /**
-   * The newly created share.
+   * The template's UUID.
    *
-   * @generated from field: pb.clientrpc.v1.ShareInfo share = 1;
+   * @generated from field: string uuid = 1;
    */
-  share?: ShareInfo;
-};
-
-/**
- * Describes the message pb.clientrpc.v1.CreateShareResponse.
- * Use `create(CreateShareResponseSchema)` to create a new message.
- */
-export const CreateShareResponseSchema: GenMessage<CreateShareResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 36);
+  uuid: string;
 
-/**
- * @generated from message pb.clientrpc.v1.DeleteShareRequest
- */
-export type DeleteShareRequest = Message<"pb.clientrpc.v1.DeleteShareRequest"> & {
   /**
-   * The associated server UUID.
+   * The name given to the template.
    *
-   * @generated from field: string server_uuid = 1;
+   * @generated from field: string name = 2;
    */
-  serverUuid: string;
+  name: string;
 
   /**
-   * The share's name.
+   * The username servers created from the template use.
    *
-   * @generated from field: string name = 2;
+   * @generated from field: string username = 3;
    */
-  name: string;
-};
+  username: string;
 
-/**
- * Describes the message pb.clientrpc.v1.DeleteShareRequest.
- * Use `create(DeleteShareRequestSchema)` to create a new message.
- */
-export const DeleteShareRequestSchema: GenMessage<DeleteShareRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 37);
+  /**
+   * The shares created on servers created from the template.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.AccountTemplateShare shares = 4;
+   */
+  shares: AccountTemplateShare[];
 
-/**
- * @generated from message pb.clientrpc.v1.DeleteShareResponse
- */
-export type DeleteShareResponse = Message<"pb.clientrpc.v1.DeleteShareResponse"> & {
+  /**
+   * The UNIX timestamp when the template was created.
+   *
+   * @generated from field: int64 created_ts = 5;
+   */
+  createdTs: bigint;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.DeleteShareResponse.
- * Use `create(DeleteShareResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.AccountTemplate.
+ * Use `create(AccountTemplateSchema)` to create a new message.
  */
-export const DeleteShareResponseSchema: GenMessage<DeleteShareResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 38);
+export const AccountTemplateSchema: GenMessage<AccountTemplate> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 43);
 
 /**
- * @generated from message pb.clientrpc.v1.GetDirFilesRequest
+ * @generated from message pb.clientrpc.v1.CreateAccountTemplateRequest
  */
-export type GetDirFilesRequest = Message<"pb.clientrpc.v1.GetDirFilesRequest"> & {
+export type CreateAccountTemplateRequest = Message<"pb.clientrpc.v1.CreateAccountTemplateRequest"> & {
   /**
-   * The server's UUID.
+   * The name given to the template.
    *
-   * @generated from field: string server_uuid = 1;
+   * @generated from field: string name = 1;
    */
-  serverUuid: string;
+  name: string;
 
   /**
-   * The online user's username.
+   * The username to use.
    *
    * @generated from field: string username = 2;
    */
   username: string;
 
   /**
-   * The path to get the contents of.
+   * The password to use.
    *
-   * @generated from field: string path = 3;
+   * @generated from field: string password = 3;
    */
-  path: string;
+  password: string;
+
+  /**
+   * The shares to create on servers created from the template.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.AccountTemplateShare shares = 4;
+   */
+  shares: AccountTemplateShare[];
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetDirFilesRequest.
- * Use `create(GetDirFilesRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.CreateAccountTemplateRequest.
+ * Use `create(CreateAccountTemplateRequestSchema)` to create a new message.
  */
-export const GetDirFilesRequestSchema: GenMessage<GetDirFilesRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 39);
+export const CreateAccountTemplateRequestSchema: GenMessage<CreateAccountTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 44);
 
 /**
- * @generated from message pb.clientrpc.v1.GetDirFilesResponse
+ * @generated from message pb.clientrpc.v1.CreateAccountTemplateResponse
  */
-export type GetDirFilesResponse = Message<"pb.clientrpc.v1.GetDirFilesResponse"> & {
+export type CreateAccountTemplateResponse = Message<"pb.clientrpc.v1.CreateAccountTemplateResponse"> & {
   /**
-   * The directory's files.
+   * The newly created template.
    *
-   * @generated from field: repeated pb.clientrpc.v1.FileMeta content = 2;
+   * @generated from field: pb.clientrpc.v1.AccountTemplate template = 1;
    */
-  content: FileMeta[];
+  template?: AccountTemplate;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetDirFilesResponse.
- * Use `create(GetDirFilesResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.CreateAccountTemplateResponse.
+ * Use `create(CreateAccountTemplateResponseSchema)` to create a new message.
  */
-export const GetDirFilesResponseSchema: GenMessage<GetDirFilesResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 40);
+export const CreateAccountTemplateResponseSchema: GenMessage<CreateAccountTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 45);
 
 /**
- * @generated from message pb.clientrpc.v1.GetFileMetaRequest
+ * @generated from message pb.clientrpc.v1.CreateAccountTemplateFromServerRequest
  */
-export type GetFileMetaRequest = Message<"pb.clientrpc.v1.GetFileMetaRequest"> & {
+export type CreateAccountTemplateFromServerRequest = Message<"pb.clientrpc.v1.CreateAccountTemplateFromServerRequest"> & {
   /**
-   * The server's UUID.
+   * The UUID of the server to copy the credentials and shares of.
    *
    * @generated from field: string server_uuid = 1;
    */
   serverUuid: string;
 
   /**
-   * The online user's username.
+   * The name given to the template.
+   * If empty, the server's name is used.
    *
-   * @generated from field: string username = 2;
+   * @generated from field: string name = 2;
    */
-  username: string;
+  name: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CreateAccountTemplateFromServerRequest.
+ * Use `create(CreateAccountTemplateFromServerRequestSchema)` to create a new message.
+ */
+export const CreateAccountTemplateFromServerRequestSchema: GenMessage<CreateAccountTemplateFromServerRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 46);
 
+/**
+ * @generated from message pb.clientrpc.v1.CreateAccountTemplateFromServerResponse
+ */
+export type CreateAccountTemplateFromServerResponse = Message<"pb.clientrpc.v1.CreateAccountTemplateFromServerResponse"> & {
   /**
-   * The path to get the contents of.
+   * The newly created template.
    *
-   * @generated from field: string path = 3;
+   * @generated from field: pb.clientrpc.v1.AccountTemplate template = 1;
    */
-  path: string;
+  template?: AccountTemplate;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetFileMetaRequest.
- * Use `create(GetFileMetaRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.CreateAccountTemplateFromServerResponse.
+ * Use `create(CreateAccountTemplateFromServerResponseSchema)` to create a new message.
  */
-export const GetFileMetaRequestSchema: GenMessage<GetFileMetaRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 41);
+export const CreateAccountTemplateFromServerResponseSchema: GenMessage<CreateAccountTemplateFromServerResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 47);
 
 /**
- * @generated from message pb.clientrpc.v1.GetFileMetaResponse
+ * @generated from message pb.clientrpc.v1.GetAccountTemplatesRequest
  */
-export type GetFileMetaResponse = Message<"pb.clientrpc.v1.GetFileMetaResponse"> & {
-  /**
-   * The file's metadata.
-   *
-   * @generated from field: pb.clientrpc.v1.FileMeta meta = 1;
-   */
-  meta?: FileMeta;
+export type GetAccountTemplatesRequest = Message<"pb.clientrpc.v1.GetAccountTemplatesRequest"> & {
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetFileMetaResponse.
- * Use `create(GetFileMetaResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.GetAccountTemplatesRequest.
+ * Use `create(GetAccountTemplatesRequestSchema)` to create a new message.
  */
-export const GetFileMetaResponseSchema: GenMessage<GetFileMetaResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 42);
+export const GetAccountTemplatesRequestSchema: GenMessage<GetAccountTemplatesRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 48);
 
 /**
- * @generated from message pb.clientrpc.v1.GetOnlineUsersRequest
+ * @generated from message pb.clientrpc.v1.GetAccountTemplatesResponse
  */
-export type GetOnlineUsersRequest = Message<"pb.clientrpc.v1.GetOnlineUsersRequest"> & {
+export type GetAccountTemplatesResponse = Message<"pb.clientrpc.v1.GetAccountTemplatesResponse"> & {
   /**
-   * The server's UUID.
+   * All account templates, ordered by creation time.
    *
-   * @generated from field: string server_uuid = 1;
+   * @generated from field: repeated pb.clientrpc.v1.AccountTemplate templates = 1;
    */
-  serverUuid: string;
+  templates: AccountTemplate[];
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetOnlineUsersRequest.
- * Use `create(GetOnlineUsersRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.GetAccountTemplatesResponse.
+ * Use `create(GetAccountTemplatesResponseSchema)` to create a new message.
  */
-export const GetOnlineUsersRequestSchema: GenMessage<GetOnlineUsersRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 43);
+export const GetAccountTemplatesResponseSchema: GenMessage<GetAccountTemplatesResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 49);
 
 /**
- * @generated from message pb.clientrpc.v1.GetOnlineUsersResponse
+ * @generated from message pb.clientrpc.v1.DeleteAccountTemplateRequest
  */
-export type GetOnlineUsersResponse = Message<"pb.clientrpc.v1.GetOnlineUsersResponse"> & {
+export type DeleteAccountTemplateRequest = Message<"pb.clientrpc.v1.DeleteAccountTemplateRequest"> & {
   /**
-   * The users.
+   * The UUID of the template to delete.
    *
-   * @generated from field: repeated pb.clientrpc.v1.OnlineUserInfo users = 1;
+   * @generated from field: string uuid = 1;
    */
-  users: OnlineUserInfo[];
+  uuid: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetOnlineUsersResponse.
- * Use `create(GetOnlineUsersResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.DeleteAccountTemplateRequest.
+ * Use `create(DeleteAccountTemplateRequestSchema)` to create a new message.
  */
-export const GetOnlineUsersResponseSchema: GenMessage<GetOnlineUsersResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 44);
+export const DeleteAccountTemplateRequestSchema: GenMessage<DeleteAccountTemplateRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 50);
 
 /**
- * @generated from message pb.clientrpc.v1.ChangeAccountPasswordRequest
+ * @generated from message pb.clientrpc.v1.DeleteAccountTemplateResponse
  */
-export type ChangeAccountPasswordRequest = Message<"pb.clientrpc.v1.ChangeAccountPasswordRequest"> & {
+export type DeleteAccountTemplateResponse = Message<"pb.clientrpc.v1.DeleteAccountTemplateResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DeleteAccountTemplateResponse.
+ * Use `create(DeleteAccountTemplateResponseSchema)` to create a new message.
+ */
+export const DeleteAccountTemplateResponseSchema: GenMessage<DeleteAccountTemplateResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 51);
+
+/**
+ * HostingInfo is the status of the room the client hosts on its embedded server.
+ *
+ * @generated from message pb.clientrpc.v1.HostingInfo
+ */
+export type HostingInfo = Message<"pb.clientrpc.v1.HostingInfo"> & {
   /**
-   * The server's UUID.
+   * Whether the client is hosting a room.
+   * If false, the other fields are empty.
    *
-   * @generated from field: string server_uuid = 1;
+   * @generated from field: bool hosting = 1;
+   */
+  hosting: boolean;
+
+  /**
+   * The name of the hosted room.
+   *
+   * @generated from field: string room = 2;
+   */
+  room: string;
+
+  /**
+   * The UDP port the embedded server listens on.
+   * Friends must be able to reach it, so it may need to be forwarded on the host's router.
+   *
+   * @generated from field: uint32 port = 3;
+   */
+  port: number;
+
+  /**
+   * The fingerprint of the embedded server's certificate.
+   *
+   * @generated from field: string cert_fingerprint = 4;
+   */
+  certFingerprint: string;
+
+  /**
+   * The UUID of the server the host joined the room with, or empty if it was deleted.
+   *
+   * @generated from field: string server_uuid = 5;
    */
   serverUuid: string;
 
   /**
-   * The account's current password.
+   * The number of clients online in the room, including the host.
    *
-   * @generated from field: string current_password = 2;
+   * @generated from field: uint32 online_clients = 6;
    */
-  currentPassword: string;
+  onlineClients: number;
 
   /**
-   * The account's new password.
+   * The UNIX timestamp when the embedded server started.
    *
-   * @generated from field: string new_password = 3;
+   * @generated from field: int64 start_ts = 7;
    */
-  newPassword: string;
+  startTs: bigint;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.ChangeAccountPasswordRequest.
- * Use `create(ChangeAccountPasswordRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.HostingInfo.
+ * Use `create(HostingInfoSchema)` to create a new message.
  */
-export const ChangeAccountPasswordRequestSchema: GenMessage<ChangeAccountPasswordRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 45);
+export const HostingInfoSchema: GenMessage<HostingInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 52);
 
 /**
- * @generated from message pb.clientrpc.v1.ChangeAccountPasswordResponse
+ * @generated from message pb.clientrpc.v1.StartHostingRequest
  */
-export type ChangeAccountPasswordResponse = Message<"pb.clientrpc.v1.ChangeAccountPasswordResponse"> & {
+export type StartHostingRequest = Message<"pb.clientrpc.v1.StartHostingRequest"> & {
+  /**
+   * The name of the room to host.
+   * It is created if it does not exist yet.
+   *
+   * @generated from field: string room = 1;
+   */
+  room: string;
+
+  /**
+   * The UDP port to listen on.
+   * If 0, defaults to 20038.
+   *
+   * @generated from field: uint32 port = 2;
+   */
+  port: number;
+
+  /**
+   * The username of the host's account in the room.
+   * Only used the first time the room is hosted, when the account and a server to join the room with are created.
+   *
+   * @generated from field: string username = 3;
+   */
+  username: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.ChangeAccountPasswordResponse.
- * Use `create(ChangeAccountPasswordResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.StartHostingRequest.
+ * Use `create(StartHostingRequestSchema)` to create a new message.
  */
-export const ChangeAccountPasswordResponseSchema: GenMessage<ChangeAccountPasswordResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 46);
+export const StartHostingRequestSchema: GenMessage<StartHostingRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 53);
 
 /**
- * @generated from message pb.clientrpc.v1.ServerConnectRequest
+ * @generated from message pb.clientrpc.v1.StartHostingResponse
  */
-export type ServerConnectRequest = Message<"pb.clientrpc.v1.ServerConnectRequest"> & {
+export type StartHostingResponse = Message<"pb.clientrpc.v1.StartHostingResponse"> & {
   /**
-   * The server's UUID.
+   * The status of the hosted room.
    *
-   * @generated from field: string uuid = 1;
+   * @generated from field: pb.clientrpc.v1.HostingInfo info = 1;
    */
-  uuid: string;
+  info?: HostingInfo;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.ServerConnectRequest.
- * Use `create(ServerConnectRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.StartHostingResponse.
+ * Use `create(StartHostingResponseSchema)` to create a new message.
  */
-export const ServerConnectRequestSchema: GenMessage<ServerConnectRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 47);
+export const StartHostingResponseSchema: GenMessage<StartHostingResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 54);
 
 /**
- * @generated from message pb.clientrpc.v1.ServerConnectResponse
+ * @generated from message pb.clientrpc.v1.StopHostingRequest
  */
-export type ServerConnectResponse = Message<"pb.clientrpc.v1.ServerConnectResponse"> & {
+export type StopHostingRequest = Message<"pb.clientrpc.v1.StopHostingRequest"> & {
 };
 
 /**
- * Describes the message pb.clientrpc.v1.ServerConnectResponse.
- * Use `create(ServerConnectResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.StopHostingRequest.
+ * Use `create(StopHostingRequestSchema)` to create a new message.
  */
-export const ServerConnectResponseSchema: GenMessage<ServerConnectResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 48);
+export const StopHostingRequestSchema: GenMessage<StopHostingRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 55);
 
 /**
- * @generated from message pb.clientrpc.v1.ServerDisconnectRequest
+ * @generated from message pb.clientrpc.v1.StopHostingResponse
  */
-export type ServerDisconnectRequest = Message<"pb.clientrpc.v1.ServerDisconnectRequest"> & {
-  /**
-   * The server's UUID.
-   *
-   * @generated from field: string uuid = 1;
-   */
-  uuid: string;
+export type StopHostingResponse = Message<"pb.clientrpc.v1.StopHostingResponse"> & {
 };
 
 /**
- * Describes the message pb.clientrpc.v1.ServerDisconnectRequest.
- * Use `create(ServerDisconnectRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.StopHostingResponse.
+ * Use `create(StopHostingResponseSchema)` to create a new message.
  */
-export const ServerDisconnectRequestSchema: GenMessage<ServerDisconnectRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 49);
+export const StopHostingResponseSchema: GenMessage<StopHostingResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 56);
 
 /**
- * @generated from message pb.clientrpc.v1.ServerDisconnectResponse
+ * @generated from message pb.clientrpc.v1.GetHostingInfoRequest
  */
-export type ServerDisconnectResponse = Message<"pb.clientrpc.v1.ServerDisconnectResponse"> & {
+export type GetHostingInfoRequest = Message<"pb.clientrpc.v1.GetHostingInfoRequest"> & {
 };
 
 /**
- * Describes the message pb.clientrpc.v1.ServerDisconnectResponse.
- * Use `create(ServerDisconnectResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.GetHostingInfoRequest.
+ * Use `create(GetHostingInfoRequestSchema)` to create a new message.
  */
-export const ServerDisconnectResponseSchema: GenMessage<ServerDisconnectResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 50);
+export const GetHostingInfoRequestSchema: GenMessage<GetHostingInfoRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 57);
 
 /**
- * @generated from message pb.clientrpc.v1.GetDirectSettingsRequest
+ * @generated from message pb.clientrpc.v1.GetHostingInfoResponse
  */
-export type GetDirectSettingsRequest = Message<"pb.clientrpc.v1.GetDirectSettingsRequest"> & {
+export type GetHostingInfoResponse = Message<"pb.clientrpc.v1.GetHostingInfoResponse"> & {
+  /**
+   * The status of the hosted room.
+   *
+   * @generated from field: pb.clientrpc.v1.HostingInfo info = 1;
+   */
+  info?: HostingInfo;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetDirectSettingsRequest.
- * Use `create(GetDirectSettingsRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.GetHostingInfoResponse.
+ * Use `create(GetHostingInfoResponseSchema)` to create a new message.
  */
-export const GetDirectSettingsRequestSchema: GenMessage<GetDirectSettingsRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 51);
+export const GetHostingInfoResponseSchema: GenMessage<GetHostingInfoResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 58);
 
 /**
- * @generated from message pb.clientrpc.v1.GetDirectSettingsResponse
+ * @generated from message pb.clientrpc.v1.CreateHostingInviteRequest
  */
-export type GetDirectSettingsResponse = Message<"pb.clientrpc.v1.GetDirectSettingsResponse"> & {
+export type CreateHostingInviteRequest = Message<"pb.clientrpc.v1.CreateHostingInviteRequest"> & {
   /**
-   * The client's direct connection settings.
+   * The address friends connect to, such as the host's public IP address.
+   * If it does not have a port, the embedded server's port is added.
    *
-   * @generated from field: pb.clientrpc.v1.DirectSettings settings = 1;
+   * @generated from field: string address = 1;
    */
-  settings?: DirectSettings;
+  address: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetDirectSettingsResponse.
- * Use `create(GetDirectSettingsResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.CreateHostingInviteRequest.
+ * Use `create(CreateHostingInviteRequestSchema)` to create a new message.
  */
-export const GetDirectSettingsResponseSchema: GenMessage<GetDirectSettingsResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 52);
+export const CreateHostingInviteRequestSchema: GenMessage<CreateHostingInviteRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 59);
 
 /**
- * @generated from message pb.clientrpc.v1.UpdateDirectSettingsRequest
+ * @generated from message pb.clientrpc.v1.CreateHostingInviteResponse
  */
-export type UpdateDirectSettingsRequest = Message<"pb.clientrpc.v1.UpdateDirectSettingsRequest"> & {
+export type CreateHostingInviteResponse = Message<"pb.clientrpc.v1.CreateHostingInviteResponse"> & {
   /**
-   * The settings to update.
-   * All fields must be filled.
+   * The invite link, with a new single-use invite code friends can register with.
    *
-   * @generated from field: pb.clientrpc.v1.DirectSettings settings = 1;
+   * @generated from field: string url = 1;
    */
-  settings?: DirectSettings;
+  url: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.UpdateDirectSettingsRequest.
- * Use `create(UpdateDirectSettingsRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.CreateHostingInviteResponse.
+ * Use `create(CreateHostingInviteResponseSchema)` to create a new message.
  */
-export const UpdateDirectSettingsRequestSchema: GenMessage<UpdateDirectSettingsRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 53);
+export const CreateHostingInviteResponseSchema: GenMessage<CreateHostingInviteResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 60);
 
 /**
- * @generated from message pb.clientrpc.v1.UpdateDirectSettingsResponse
+ * @generated from message pb.clientrpc.v1.DeleteServerRequest
  */
-export type UpdateDirectSettingsResponse = Message<"pb.clientrpc.v1.UpdateDirectSettingsResponse"> & {
+export type DeleteServerRequest = Message<"pb.clientrpc.v1.DeleteServerRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.UpdateDirectSettingsResponse.
- * Use `create(UpdateDirectSettingsResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.DeleteServerRequest.
+ * Use `create(DeleteServerRequestSchema)` to create a new message.
  */
-export const UpdateDirectSettingsResponseSchema: GenMessage<UpdateDirectSettingsResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 54);
+export const DeleteServerRequestSchema: GenMessage<DeleteServerRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 61);
 
 /**
- * @generated from message pb.clientrpc.v1.GetTransferSettingsRequest
+ * @generated from message pb.clientrpc.v1.DeleteServerResponse
  */
-export type GetTransferSettingsRequest = Message<"pb.clientrpc.v1.GetTransferSettingsRequest"> & {
+export type DeleteServerResponse = Message<"pb.clientrpc.v1.DeleteServerResponse"> & {
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetTransferSettingsRequest.
- * Use `create(GetTransferSettingsRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.DeleteServerResponse.
+ * Use `create(DeleteServerResponseSchema)` to create a new message.
  */
-export const GetTransferSettingsRequestSchema: GenMessage<GetTransferSettingsRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 55);
+export const DeleteServerResponseSchema: GenMessage<DeleteServerResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 62);
 
 /**
- * @generated from message pb.clientrpc.v1.GetTransferSettingsResponse
+ * @generated from message pb.clientrpc.v1.ConnectServerRequest
  */
-export type GetTransferSettingsResponse = Message<"pb.clientrpc.v1.GetTransferSettingsResponse"> & {
+export type ConnectServerRequest = Message<"pb.clientrpc.v1.ConnectServerRequest"> & {
   /**
-   * The client's transfer settings.
+   * The server's UUID.
    *
-   * @generated from field: pb.clientrpc.v1.TransferSettings settings = 1;
+   * @generated from field: string uuid = 1;
    */
-  settings?: TransferSettings;
+  uuid: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetTransferSettingsResponse.
- * Use `create(GetTransferSettingsResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.ConnectServerRequest.
+ * Use `create(ConnectServerRequestSchema)` to create a new message.
  */
-export const GetTransferSettingsResponseSchema: GenMessage<GetTransferSettingsResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 56);
+export const ConnectServerRequestSchema: GenMessage<ConnectServerRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 63);
 
 /**
- * @generated from message pb.clientrpc.v1.UpdateTransferSettingsRequest
+ * @generated from message pb.clientrpc.v1.ConnectServerResponse
  */
-export type UpdateTransferSettingsRequest = Message<"pb.clientrpc.v1.UpdateTransferSettingsRequest"> & {
+export type ConnectServerResponse = Message<"pb.clientrpc.v1.ConnectServerResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ConnectServerResponse.
+ * Use `create(ConnectServerResponseSchema)` to create a new message.
+ */
+export const ConnectServerResponseSchema: GenMessage<ConnectServerResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 64);
+
+/**
+ * @generated from message pb.clientrpc.v1.DisconnectServerRequest
+ */
+export type DisconnectServerRequest = Message<"pb.clientrpc.v1.DisconnectServerRequest"> & {
   /**
-   * The settings to update.
-   * All fields must be filled.
+   * The server's UUID.
    *
-   * @generated from field: pb.clientrpc.v1.TransferSettings settings = 1;
+   * @generated from field: string uuid = 1;
    */
-  settings?: TransferSettings;
+  uuid: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.UpdateTransferSettingsRequest.
- * Use `create(UpdateTransferSettingsRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.DisconnectServerRequest.
+ * Use `create(DisconnectServerRequestSchema)` to create a new message.
  */
-export const UpdateTransferSettingsRequestSchema: GenMessage<UpdateTransferSettingsRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 57);
+export const DisconnectServerRequestSchema: GenMessage<DisconnectServerRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 65);
 
 /**
- * @generated from message pb.clientrpc.v1.UpdateTransferSettingsResponse
+ * @generated from message pb.clientrpc.v1.DisconnectServerResponse
  */
-export type UpdateTransferSettingsResponse = Message<"pb.clientrpc.v1.UpdateTransferSettingsResponse"> & {
+export type DisconnectServerResponse = Message<"pb.clientrpc.v1.DisconnectServerResponse"> & {
 };
 
 /**
- * Describes the message pb.clientrpc.v1.UpdateTransferSettingsResponse.
- * Use `create(UpdateTransferSettingsResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.DisconnectServerResponse.
+ * Use `create(DisconnectServerResponseSchema)` to create a new message.
  */
-export const UpdateTransferSettingsResponseSchema: GenMessage<UpdateTransferSettingsResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 58);
+export const DisconnectServerResponseSchema: GenMessage<DisconnectServerResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 66);
 
 /**
- * @generated from message pb.clientrpc.v1.IndexShareRequest
+ * @generated from message pb.clientrpc.v1.UpdateServerRequest
  */
-export type IndexShareRequest = Message<"pb.clientrpc.v1.IndexShareRequest"> & {
+export type UpdateServerRequest = Message<"pb.clientrpc.v1.UpdateServerRequest"> & {
   /**
-   * The associated server UUID.
+   * The server's UUID.
    *
-   * @generated from field: string server_uuid = 1;
+   * @generated from field: string uuid = 1;
    */
-  serverUuid: string;
+  uuid: string;
 
   /**
-   * The share's name.
+   * The new name, if any.
    *
-   * @generated from field: string name = 2;
+   * @generated from field: optional string name = 2;
    */
-  name: string;
-};
-
-/**
- * Describes the message pb.clientrpc.v1.IndexShareRequest.
- * Use `create(IndexShareRequestSchema)` to create a new message.
- */
-export const IndexShareRequestSchema: GenMessage<IndexShareRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 59);
-
-/**
- * @generated from message pb.clientrpc.v1.IndexShareResponse
- */
-export type IndexShareResponse = Message<"pb.clientrpc.v1.IndexShareResponse"> & {
-};
+  name?: string;
 
-/**
- * Describes the message pb.clientrpc.v1.IndexShareResponse.
- * Use `create(IndexShareResponseSchema)` to create a new message.
- */
-export const IndexShareResponseSchema: GenMessage<IndexShareResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 60);
+  /**
+   * The new address, if any.
+   *
+   * @generated from field: optional string address = 3;
+   */
+  address?: string;
 
-/**
- * @generated from message pb.clientrpc.v1.StreamSearchRequest
- */
-export type StreamSearchRequest = Message<"pb.clientrpc.v1.StreamSearchRequest"> & {
   /**
-   * The associated server's UUID.
+   * The new room, if any.
    *
-   * @generated from field: string server_uuid = 1;
+   * @generated from field: optional string room = 4;
    */
-  serverUuid: string;
+  room?: string;
 
   /**
-   * The username of the client to search, or omit to search all clients.
+   * The new username, if any.
    *
-   * @generated from field: optional string username = 2;
+   * @generated from field: optional string username = 5;
    */
   username?: string;
 
   /**
-   * The search query.
+   * The new password, if any.
    *
-   * @generated from field: string query = 3;
+   * @generated from field: optional string password = 6;
    */
-  query: string;
+  password?: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.StreamSearchRequest.
- * Use `create(StreamSearchRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.UpdateServerRequest.
+ * Use `create(UpdateServerRequestSchema)` to create a new message.
  */
-export const StreamSearchRequestSchema: GenMessage<StreamSearchRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 61);
+export const UpdateServerRequestSchema: GenMessage<UpdateServerRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 67);
 
 /**
- * @generated from message pb.clientrpc.v1.StreamSearchResponse
+ * @generated from message pb.clientrpc.v1.UpdateServerResponse
  */
-export type StreamSearchResponse = Message<"pb.clientrpc.v1.StreamSearchResponse"> & {
+export type UpdateServerResponse = Message<"pb.clientrpc.v1.UpdateServerResponse"> & {
   /**
-   * The username of the client the result came from.
+   * The server after update.
    *
-   * @generated from field: string username = 1;
+   * @generated from field: pb.clientrpc.v1.ServerInfo server = 1;
    */
-  username: string;
+  server?: ServerInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UpdateServerResponse.
+ * Use `create(UpdateServerResponseSchema)` to create a new message.
+ */
+export const UpdateServerResponseSchema: GenMessage<UpdateServerResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 68);
 
+/**
+ * @generated from message pb.clientrpc.v1.GetSharesRequest
+ */
+export type GetSharesRequest = Message<"pb.clientrpc.v1.GetSharesRequest"> & {
   /**
-   * The file's containing directory path.
+   * The UUID of the server to get shares for.
    *
-   * @generated from field: string directory_path = 2;
+   * @generated from field: string server_uuid = 1;
    */
-  directoryPath: string;
+  serverUuid: string;
 
   /**
-   * The file that was found.
+   * The maximum number of shares to return.
+   * If 0, defaults to 500. Values above 1000 are treated as 1000.
    *
-   * @generated from field: pb.clientrpc.v1.FileMeta file = 3;
+   * @generated from field: uint32 limit = 2;
    */
-  file?: FileMeta;
+  limit: number;
 
   /**
-   * A snippet of text highlighting matched terms.
+   * The cursor returned by a previous call, to get the next page.
+   * Empty to start from the beginning.
    *
-   * @generated from field: string snippet = 4;
+   * @generated from field: string cursor = 3;
    */
-  snippet: string;
+  cursor: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.StreamSearchResponse.
- * Use `create(StreamSearchResponseSchema)` to create a new message.
- */
-export const StreamSearchResponseSchema: GenMessage<StreamSearchResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 62);
-
-/**
- * @generated from message pb.clientrpc.v1.GetUpdateInfoRequest
+ * Describes the message pb.clientrpc.v1.GetSharesRequest.
+ * Use `create(GetSharesRequestSchema)` to create a new message.
  */
-export type GetUpdateInfoRequest = Message<"pb.clientrpc.v1.GetUpdateInfoRequest"> & {
-};
+export const GetSharesRequestSchema: GenMessage<GetSharesRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 69);
 
 /**
- * Describes the message pb.clientrpc.v1.GetUpdateInfoRequest.
- * Use `create(GetUpdateInfoRequestSchema)` to create a new message.
+ * @generated from message pb.clientrpc.v1.GetSharesResponse
  */
-export const GetUpdateInfoRequestSchema: GenMessage<GetUpdateInfoRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 63);
+export type GetSharesResponse = Message<"pb.clientrpc.v1.GetSharesResponse"> & {
+  /**
+   * A page of shares, ordered by name.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.ShareInfo shares = 1;
+   */
+  shares: ShareInfo[];
 
-/**
- * @generated from message pb.clientrpc.v1.GetUpdateInfoResponse
- */
-export type GetUpdateInfoResponse = Message<"pb.clientrpc.v1.GetUpdateInfoResponse"> & {
   /**
-   * The current update the client is running.
+   * The cursor to pass to get the next page, or empty if this is the last page.
    *
-   * @generated from field: pb.clientrpc.v1.UpdateInfo current_info = 1;
+   * @generated from field: string next_cursor = 2;
    */
-  currentInfo?: UpdateInfo;
+  nextCursor: string;
 
   /**
-   * The new update's info, or no new update.
-   * This is cached info.
+   * The total number of shares for the server.
    *
-   * @generated from field: optional pb.clientrpc.v1.UpdateInfo new_info = 2;
+   * @generated from field: uint32 total = 3;
    */
-  newInfo?: UpdateInfo;
+  total: number;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetUpdateInfoResponse.
- * Use `create(GetUpdateInfoResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.GetSharesResponse.
+ * Use `create(GetSharesResponseSchema)` to create a new message.
  */
-export const GetUpdateInfoResponseSchema: GenMessage<GetUpdateInfoResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 64);
+export const GetSharesResponseSchema: GenMessage<GetSharesResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 70);
 
 /**
- * @generated from message pb.clientrpc.v1.CheckForNewUpdateRequest
+ * @generated from message pb.clientrpc.v1.CreateShareRequest
  */
-export type CheckForNewUpdateRequest = Message<"pb.clientrpc.v1.CheckForNewUpdateRequest"> & {
+export type CreateShareRequest = Message<"pb.clientrpc.v1.CreateShareRequest"> & {
+  /**
+   * The UUID of the associated server.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The share's name.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * The share's path on disk.
+   *
+   * @generated from field: string path = 3;
+   */
+  path: string;
+
+  /**
+   * Whether to follow links.
+   *
+   * @generated from field: bool follow_links = 4;
+   */
+  followLinks: boolean;
+
+  /**
+   * Directories to mount under virtual paths in the share, in addition to its own path, which is mounted at "/".
+   * Optional.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.ShareMount mounts = 5;
+   */
+  mounts: ShareMount[];
+
+  /**
+   * How files that exist at the same path in more than one mounted directory are resolved.
+   *
+   * @generated from field: pb.clientrpc.v1.ShareConflictRule conflict_rule = 6;
+   */
+  conflictRule: ShareConflictRule;
+
+  /**
+   * Patterns of paths to hide from the share and leave out of its search index.
+   * Optional.
+   *
+   * Patterns are globs by default. A pattern without a slash, such as "*.tmp", matches names at any depth. A pattern
+   * with a slash, such as "/photos/private", matches a path from the share's root. A pattern that ends with a slash,
+   * such as ".git/", only matches directories. Patterns that start with "re:" are regular expressions that match
+   * anywhere in the full path. Excluding a directory excludes everything in it.
+   * On Windows and macOS, where file systems ignore case, patterns ignore case too.
+   *
+   * @generated from field: repeated string exclude_patterns = 7;
+   */
+  excludePatterns: string[];
+
+  /**
+   * Whether to match paths requested by peers regardless of case, for peers on platforms with case-insensitive
+   * filesystems, such as Windows and macOS.
+   * Paths that exist exactly as requested are always used as-is.
+   *
+   * @generated from field: bool case_insensitive = 8;
+   */
+  caseInsensitive: boolean;
+
+  /**
+   * The Unicode normalization form to match paths requested by peers in.
+   *
+   * @generated from field: pb.clientrpc.v1.ShareUnicodeForm unicode_form = 9;
+   */
+  unicodeForm: ShareUnicodeForm;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.CheckForNewUpdateRequest.
- * Use `create(CheckForNewUpdateRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.CreateShareRequest.
+ * Use `create(CreateShareRequestSchema)` to create a new message.
  */
-export const CheckForNewUpdateRequestSchema: GenMessage<CheckForNewUpdateRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 65);
+export const CreateShareRequestSchema: GenMessage<CreateShareRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 71);
 
 /**
- * @generated from message pb.clientrpc.v1.CheckForNewUpdateResponse
+ * @generated from message pb.clientrpc.v1.CreateShareResponse
  */
-export type CheckForNewUpdateResponse = Message<"pb.clientrpc.v1.CheckForNewUpdateResponse"> & {
+export type CreateShareResponse = Message<"pb.clientrpc.v1.CreateShareResponse"> & {
   /**
-   * The new update's info, or no new update.
+   * The newly created share.
    *
-   * @generated from field: optional pb.clientrpc.v1.UpdateInfo new_info = 1;
+   * @generated from field: pb.clientrpc.v1.ShareInfo share = 1;
    */
-  newInfo?: UpdateInfo;
+  share?: ShareInfo;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.CheckForNewUpdateResponse.
- * Use `create(CheckForNewUpdateResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.CreateShareResponse.
+ * Use `create(CreateShareResponseSchema)` to create a new message.
  */
-export const CheckForNewUpdateResponseSchema: GenMessage<CheckForNewUpdateResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 66);
+export const CreateShareResponseSchema: GenMessage<CreateShareResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 72);
 
 /**
- * @generated from message pb.clientrpc.v1.GetDownloadManagerItemsRequest
+ * @generated from message pb.clientrpc.v1.DeleteShareRequest
  */
-export type GetDownloadManagerItemsRequest = Message<"pb.clientrpc.v1.GetDownloadManagerItemsRequest"> & {
+export type DeleteShareRequest = Message<"pb.clientrpc.v1.DeleteShareRequest"> & {
+  /**
+   * The associated server UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The share's name.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetDownloadManagerItemsRequest.
- * Use `create(GetDownloadManagerItemsRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.DeleteShareRequest.
+ * Use `create(DeleteShareRequestSchema)` to create a new message.
  */
-export const GetDownloadManagerItemsRequestSchema: GenMessage<GetDownloadManagerItemsRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 67);
+export const DeleteShareRequestSchema: GenMessage<DeleteShareRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 73);
 
 /**
- * @generated from message pb.clientrpc.v1.GetDownloadManagerItemsResponse
+ * @generated from message pb.clientrpc.v1.DeleteShareResponse
  */
-export type GetDownloadManagerItemsResponse = Message<"pb.clientrpc.v1.GetDownloadManagerItemsResponse"> & {
+export type DeleteShareResponse = Message<"pb.clientrpc.v1.DeleteShareResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DeleteShareResponse.
+ * Use `create(DeleteShareResponseSchema)` to create a new message.
+ */
+export const DeleteShareResponseSchema: GenMessage<DeleteShareResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 74);
+
+/**
+ * @generated from message pb.clientrpc.v1.SetShareExcludePatternsRequest
+ */
+export type SetShareExcludePatternsRequest = Message<"pb.clientrpc.v1.SetShareExcludePatternsRequest"> & {
   /**
-   * The download manager items.
+   * The associated server UUID.
    *
-   * @generated from field: repeated pb.clientrpc.v1.DownloadManagerItem items = 1;
+   * @generated from field: string server_uuid = 1;
    */
-  items: DownloadManagerItem[];
+  serverUuid: string;
+
+  /**
+   * The share's name.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * The new exclude patterns, replacing the existing ones.
+   * See CreateShareRequest.exclude_patterns for their syntax.
+   *
+   * @generated from field: repeated string exclude_patterns = 3;
+   */
+  excludePatterns: string[];
 };
 
 /**
- * Describes the message pb.clientrpc.v1.GetDownloadManagerItemsResponse.
- * Use `create(GetDownloadManagerItemsResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.SetShareExcludePatternsRequest.
+ * Use `create(SetShareExcludePatternsRequestSchema)` to create a new message.
  */
-export const GetDownloadManagerItemsResponseSchema: GenMessage<GetDownloadManagerItemsResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 68);
+export const SetShareExcludePatternsRequestSchema: GenMessage<SetShareExcludePatternsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 75);
 
 /**
- * @generated from message pb.clientrpc.v1.QueueFileDownloadRequest
+ * @generated from message pb.clientrpc.v1.SetShareExcludePatternsResponse
  */
-export type QueueFileDownloadRequest = Message<"pb.clientrpc.v1.QueueFileDownloadRequest"> & {
+export type SetShareExcludePatternsResponse = Message<"pb.clientrpc.v1.SetShareExcludePatternsResponse"> & {
   /**
-   * The UUID of the server the peer exists on.
+   * The updated share.
+   *
+   * @generated from field: pb.clientrpc.v1.ShareInfo share = 1;
+   */
+  share?: ShareInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.SetShareExcludePatternsResponse.
+ * Use `create(SetShareExcludePatternsResponseSchema)` to create a new message.
+ */
+export const SetShareExcludePatternsResponseSchema: GenMessage<SetShareExcludePatternsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 76);
+
+/**
+ * ShareHealthIssue is a problem with an entry in a share.
+ *
+ * @generated from message pb.clientrpc.v1.ShareHealthIssue
+ */
+export type ShareHealthIssue = Message<"pb.clientrpc.v1.ShareHealthIssue"> & {
+  /**
+   * The entry's path in the share.
+   * Invalid UTF-8 in names is replaced with U+FFFD.
+   *
+   * @generated from field: string path = 1;
+   */
+  path: string;
+
+  /**
+   * The kind of problem.
+   *
+   * @generated from field: pb.clientrpc.v1.ShareHealthIssueKind kind = 2;
+   */
+  kind: ShareHealthIssueKind;
+
+  /**
+   * The error that occurred, if any.
+   *
+   * @generated from field: optional string message = 3;
+   */
+  message?: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ShareHealthIssue.
+ * Use `create(ShareHealthIssueSchema)` to create a new message.
+ */
+export const ShareHealthIssueSchema: GenMessage<ShareHealthIssue> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 77);
+
+/**
+ * @generated from message pb.clientrpc.v1.CheckShareHealthRequest
+ */
+export type CheckShareHealthRequest = Message<"pb.clientrpc.v1.CheckShareHealthRequest"> & {
+  /**
+   * The associated server UUID.
    *
    * @generated from field: string server_uuid = 1;
    */
   serverUuid: string;
 
   /**
-   * The peer's username.
+   * The share's name.
    *
-   * @generated from field: string peer_username = 2;
+   * @generated from field: string name = 2;
    */
-  peerUsername: string;
+  name: string;
 
   /**
-   * The path of the file within the peer.
+   * The maximum number of issues to report.
+   * Optional, defaults to 1000. Cannot exceed 10000.
    *
-   * @generated from field: string file_path = 3;
+   * @generated from field: optional uint32 max_issues = 3;
    */
-  filePath: string;
+  maxIssues?: number;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.QueueFileDownloadRequest.
- * Use `create(QueueFileDownloadRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.CheckShareHealthRequest.
+ * Use `create(CheckShareHealthRequestSchema)` to create a new message.
  */
-export const QueueFileDownloadRequestSchema: GenMessage<QueueFileDownloadRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 69);
+export const CheckShareHealthRequestSchema: GenMessage<CheckShareHealthRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 78);
 
 /**
- * @generated from message pb.clientrpc.v1.QueueFileDownloadResponse
+ * @generated from message pb.clientrpc.v1.CheckShareHealthResponse
  */
-export type QueueFileDownloadResponse = Message<"pb.clientrpc.v1.QueueFileDownloadResponse"> & {
+export type CheckShareHealthResponse = Message<"pb.clientrpc.v1.CheckShareHealthResponse"> & {
+  /**
+   * The issues that were found.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.ShareHealthIssue issues = 1;
+   */
+  issues: ShareHealthIssue[];
+
+  /**
+   * Whether the check stopped early because it found the maximum number of issues.
+   *
+   * @generated from field: bool truncated = 2;
+   */
+  truncated: boolean;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.QueueFileDownloadResponse.
- * Use `create(QueueFileDownloadResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.CheckShareHealthResponse.
+ * Use `create(CheckShareHealthResponseSchema)` to create a new message.
  */
-export const QueueFileDownloadResponseSchema: GenMessage<QueueFileDownloadResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 70);
+export const CheckShareHealthResponseSchema: GenMessage<CheckShareHealthResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 79);
 
 /**
- * @generated from message pb.clientrpc.v1.CancelFileDownloadRequest
+ * ShareImportEntry is a share to create with ImportShares.
+ *
+ * @generated from message pb.clientrpc.v1.ShareImportEntry
  */
-export type CancelFileDownloadRequest = Message<"pb.clientrpc.v1.CancelFileDownloadRequest"> & {
+export type ShareImportEntry = Message<"pb.clientrpc.v1.ShareImportEntry"> & {
   /**
-   * The file download's UUID.
+   * The UUID of the server to create the share on.
    *
-   * @generated from field: string uuid = 1;
+   * @generated from field: string server_uuid = 1;
    */
-  uuid: string;
+  serverUuid: string;
+
+  /**
+   * The share's name.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * The share's absolute path on disk.
+   *
+   * @generated from field: string path = 3;
+   */
+  path: string;
+
+  /**
+   * Whether to follow links.
+   *
+   * @generated from field: bool follow_links = 4;
+   */
+  followLinks: boolean;
 };
 
 /**
- * Describes the message pb.clientrpc.v1.CancelFileDownloadRequest.
- * Use `create(CancelFileDownloadRequestSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.ShareImportEntry.
+ * Use `create(ShareImportEntrySchema)` to create a new message.
  */
-export const CancelFileDownloadRequestSchema: GenMessage<CancelFileDownloadRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 71);
+export const ShareImportEntrySchema: GenMessage<ShareImportEntry> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 80);
 
 /**
- * @generated from message pb.clientrpc.v1.CancelFileDownloadResponse
+ * ShareManifest is the format of share manifest files read by ImportShares.
+ * Manifest files are in the protobuf JSON format, for example:
+ * {"shares": [{"server_uuid": "...", "name": "music", "path": "/home/me/Music"}]}
+ *
+ * @generated from message pb.clientrpc.v1.ShareManifest
  */
-export type CancelFileDownloadResponse = Message<"pb.clientrpc.v1.CancelFileDownloadResponse"> & {
+export type ShareManifest = Message<"pb.clientrpc.v1.ShareManifest"> & {
+  /**
+   * The shares to create.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.ShareImportEntry shares = 1;
+   */
+  shares: ShareImportEntry[];
 };
 
 /**
- * Describes the message pb.clientrpc.v1.CancelFileDownloadResponse.
- * Use `create(CancelFileDownloadResponseSchema)` to create a new message.
+ * Describes the message pb.clientrpc.v1.ShareManifest.
+ * Use `create(ShareManifestSchema)` to create a new message.
  */
-export const CancelFileDownloadResponseSchema: GenMessage<CancelFileDownloadResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 72);
+export const ShareManifestSchema: GenMessage<ShareManifest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 81);
 
 /**
- * @generated from message pb.clientrpc.v1.RemoveDownloadManagerItemRequest
+ * ShareImportResult is the result of importing a single share.
+ *
+ * @generated from message pb.clientrpc.v1.ShareImportResult
  */
-export type RemoveDownloadManagerItemRequest = Message<"pb.clientrpc.v1.RemoveDownloadManagerItemRequest"> & {
+export type ShareImportResult = Message<"pb.clientrpc.v1.ShareImportResult"> & {
+  /**
+   * The entry the result is for.
+   *
+   * @generated from field: pb.clientrpc.v1.ShareImportEntry entry = 1;
+   */
+  entry?: ShareImportEntry;
+
+  /**
+   * Why the entry is invalid or its share could not be created.
+   * Omitted if the entry is valid and, unless it was a dry run, its share was created.
+   *
+   * @generated from field: optional string error = 2;
+   */
+  error?: string;
+
+  /**
+   * The created share.
+   * Omitted if the share was not created.
+   *
+   * @generated from field: optional pb.clientrpc.v1.ShareInfo share = 3;
+   */
+  share?: ShareInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ShareImportResult.
+ * Use `create(ShareImportResultSchema)` to create a new message.
+ */
+export const ShareImportResultSchema: GenMessage<ShareImportResult> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 82);
+
+/**
+ * @generated from message pb.clientrpc.v1.ImportSharesRequest
+ */
+export type ImportSharesRequest = Message<"pb.clientrpc.v1.ImportSharesRequest"> & {
+  /**
+   * The shares to create.
+   * Must be empty if manifest_path is set.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.ShareImportEntry entries = 1;
+   */
+  entries: ShareImportEntry[];
+
+  /**
+   * The path of a manifest file on the client's machine to read the shares to create from, in the ShareManifest
+   * format.
+   *
+   * @generated from field: optional string manifest_path = 2;
+   */
+  manifestPath?: string;
+
+  /**
+   * If true, the shares are only validated and none are created.
+   *
+   * @generated from field: bool dry_run = 3;
+   */
+  dryRun: boolean;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ImportSharesRequest.
+ * Use `create(ImportSharesRequestSchema)` to create a new message.
+ */
+export const ImportSharesRequestSchema: GenMessage<ImportSharesRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 83);
+
+/**
+ * @generated from message pb.clientrpc.v1.ImportSharesResponse
+ */
+export type ImportSharesResponse = Message<"pb.clientrpc.v1.ImportSharesResponse"> & {
+  /**
+   * The result of each share, in the same order as the entries.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.ShareImportResult results = 1;
+   */
+  results: ShareImportResult[];
+
+  /**
+   * Whether the shares were created.
+   * If false, no shares were created.
+   *
+   * @generated from field: bool imported = 2;
+   */
+  imported: boolean;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ImportSharesResponse.
+ * Use `create(ImportSharesResponseSchema)` to create a new message.
+ */
+export const ImportSharesResponseSchema: GenMessage<ImportSharesResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 84);
+
+/**
+ * @generated from message pb.clientrpc.v1.CreateShareLinkRequest
+ */
+export type CreateShareLinkRequest = Message<"pb.clientrpc.v1.CreateShareLinkRequest"> & {
+  /**
+   * The associated server UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The share's name.
+   *
+   * @generated from field: string share_name = 2;
+   */
+  shareName: string;
+
+  /**
+   * The path within the share to link to.
+   *
+   * @generated from field: string path = 3;
+   */
+  path: string;
+
+  /**
+   * How long the link is valid for, in seconds.
+   * If not set, the link never expires.
+   *
+   * @generated from field: optional uint32 expires_in_seconds = 4;
+   */
+  expiresInSeconds?: number;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CreateShareLinkRequest.
+ * Use `create(CreateShareLinkRequestSchema)` to create a new message.
+ */
+export const CreateShareLinkRequestSchema: GenMessage<CreateShareLinkRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 85);
+
+/**
+ * @generated from message pb.clientrpc.v1.CreateShareLinkResponse
+ */
+export type CreateShareLinkResponse = Message<"pb.clientrpc.v1.CreateShareLinkResponse"> & {
+  /**
+   * The new link.
+   *
+   * @generated from field: pb.clientrpc.v1.ShareLinkInfo link = 1;
+   */
+  link?: ShareLinkInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CreateShareLinkResponse.
+ * Use `create(CreateShareLinkResponseSchema)` to create a new message.
+ */
+export const CreateShareLinkResponseSchema: GenMessage<CreateShareLinkResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 86);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetShareLinksRequest
+ */
+export type GetShareLinksRequest = Message<"pb.clientrpc.v1.GetShareLinksRequest"> & {
+  /**
+   * The associated server UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The share's name.
+   *
+   * @generated from field: string share_name = 2;
+   */
+  shareName: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetShareLinksRequest.
+ * Use `create(GetShareLinksRequestSchema)` to create a new message.
+ */
+export const GetShareLinksRequestSchema: GenMessage<GetShareLinksRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 87);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetShareLinksResponse
+ */
+export type GetShareLinksResponse = Message<"pb.clientrpc.v1.GetShareLinksResponse"> & {
+  /**
+   * The share's links, including expired ones, ordered by creation time.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.ShareLinkInfo links = 1;
+   */
+  links: ShareLinkInfo[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetShareLinksResponse.
+ * Use `create(GetShareLinksResponseSchema)` to create a new message.
+ */
+export const GetShareLinksResponseSchema: GenMessage<GetShareLinksResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 88);
+
+/**
+ * @generated from message pb.clientrpc.v1.DeleteShareLinkRequest
+ */
+export type DeleteShareLinkRequest = Message<"pb.clientrpc.v1.DeleteShareLinkRequest"> & {
+  /**
+   * The link's token.
+   *
+   * @generated from field: string token = 1;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DeleteShareLinkRequest.
+ * Use `create(DeleteShareLinkRequestSchema)` to create a new message.
+ */
+export const DeleteShareLinkRequestSchema: GenMessage<DeleteShareLinkRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 89);
+
+/**
+ * @generated from message pb.clientrpc.v1.DeleteShareLinkResponse
+ */
+export type DeleteShareLinkResponse = Message<"pb.clientrpc.v1.DeleteShareLinkResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DeleteShareLinkResponse.
+ * Use `create(DeleteShareLinkResponseSchema)` to create a new message.
+ */
+export const DeleteShareLinkResponseSchema: GenMessage<DeleteShareLinkResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 90);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetDirFilesRequest
+ */
+export type GetDirFilesRequest = Message<"pb.clientrpc.v1.GetDirFilesRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The online user's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * The path to get the contents of.
+   *
+   * @generated from field: string path = 3;
+   */
+  path: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetDirFilesRequest.
+ * Use `create(GetDirFilesRequestSchema)` to create a new message.
+ */
+export const GetDirFilesRequestSchema: GenMessage<GetDirFilesRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 91);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetDirFilesResponse
+ */
+export type GetDirFilesResponse = Message<"pb.clientrpc.v1.GetDirFilesResponse"> & {
+  /**
+   * The directory's files.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.FileMeta content = 2;
+   */
+  content: FileMeta[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetDirFilesResponse.
+ * Use `create(GetDirFilesResponseSchema)` to create a new message.
+ */
+export const GetDirFilesResponseSchema: GenMessage<GetDirFilesResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 92);
+
+/**
+ * @generated from message pb.clientrpc.v1.StreamDirArchiveRequest
+ */
+export type StreamDirArchiveRequest = Message<"pb.clientrpc.v1.StreamDirArchiveRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The online user's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * The path of the directory to archive.
+   *
+   * @generated from field: string path = 3;
+   */
+  path: string;
+
+  /**
+   * The archive format.
+   *
+   * @generated from field: pb.clientrpc.v1.ArchiveFormat format = 4;
+   */
+  format: ArchiveFormat;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.StreamDirArchiveRequest.
+ * Use `create(StreamDirArchiveRequestSchema)` to create a new message.
+ */
+export const StreamDirArchiveRequestSchema: GenMessage<StreamDirArchiveRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 93);
+
+/**
+ * @generated from message pb.clientrpc.v1.StreamDirArchiveResponse
+ */
+export type StreamDirArchiveResponse = Message<"pb.clientrpc.v1.StreamDirArchiveResponse"> & {
+  /**
+   * The next chunk of archive data.
+   *
+   * @generated from field: bytes data = 1;
+   */
+  data: Uint8Array;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.StreamDirArchiveResponse.
+ * Use `create(StreamDirArchiveResponseSchema)` to create a new message.
+ */
+export const StreamDirArchiveResponseSchema: GenMessage<StreamDirArchiveResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 94);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetFileMetaRequest
+ */
+export type GetFileMetaRequest = Message<"pb.clientrpc.v1.GetFileMetaRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The online user's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * The path to get the contents of.
+   *
+   * @generated from field: string path = 3;
+   */
+  path: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetFileMetaRequest.
+ * Use `create(GetFileMetaRequestSchema)` to create a new message.
+ */
+export const GetFileMetaRequestSchema: GenMessage<GetFileMetaRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 95);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetFileMetaResponse
+ */
+export type GetFileMetaResponse = Message<"pb.clientrpc.v1.GetFileMetaResponse"> & {
+  /**
+   * The file's metadata.
+   *
+   * @generated from field: pb.clientrpc.v1.FileMeta meta = 1;
+   */
+  meta?: FileMeta;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetFileMetaResponse.
+ * Use `create(GetFileMetaResponseSchema)` to create a new message.
+ */
+export const GetFileMetaResponseSchema: GenMessage<GetFileMetaResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 96);
+
+/**
+ * @generated from message pb.clientrpc.v1.CreateFileLinkRequest
+ */
+export type CreateFileLinkRequest = Message<"pb.clientrpc.v1.CreateFileLinkRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The username of the user who shares the file.
+   * If not set, the file is one of the local user's own shared files.
+   *
+   * @generated from field: optional string username = 2;
+   */
+  username?: string;
+
+  /**
+   * The file's path.
+   *
+   * @generated from field: string path = 3;
+   */
+  path: string;
+
+  /**
+   * How long the link is valid for, in seconds.
+   * Defaults to 1 hour, and can be at most 7 days.
+   *
+   * @generated from field: optional uint32 expires_in_seconds = 4;
+   */
+  expiresInSeconds?: number;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CreateFileLinkRequest.
+ * Use `create(CreateFileLinkRequestSchema)` to create a new message.
+ */
+export const CreateFileLinkRequestSchema: GenMessage<CreateFileLinkRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 97);
+
+/**
+ * @generated from message pb.clientrpc.v1.CreateFileLinkResponse
+ */
+export type CreateFileLinkResponse = Message<"pb.clientrpc.v1.CreateFileLinkResponse"> & {
+  /**
+   * The link's token.
+   *
+   * @generated from field: string token = 1;
+   */
+  token: string;
+
+  /**
+   * The link's path on the client's web server, like /content/link/TOKEN.
+   *
+   * @generated from field: string path = 2;
+   */
+  path: string;
+
+  /**
+   * The UNIX timestamp when the link expires.
+   *
+   * @generated from field: int64 expires_ts = 3;
+   */
+  expiresTs: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CreateFileLinkResponse.
+ * Use `create(CreateFileLinkResponseSchema)` to create a new message.
+ */
+export const CreateFileLinkResponseSchema: GenMessage<CreateFileLinkResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 98);
+
+/**
+ * DiagnosticResult is the result of a diagnostic step.
+ *
+ * @generated from message pb.clientrpc.v1.DiagnosticResult
+ */
+export type DiagnosticResult = Message<"pb.clientrpc.v1.DiagnosticResult"> & {
+  /**
+   * The step.
+   *
+   * @generated from field: pb.clientrpc.v1.DiagnosticStep step = 1;
+   */
+  step: DiagnosticStep;
+
+  /**
+   * The outcome of the step.
+   *
+   * @generated from field: pb.clientrpc.v1.DiagnosticStatus status = 2;
+   */
+  status: DiagnosticStatus;
+
+  /**
+   * A human-readable description of what the step found.
+   *
+   * @generated from field: string detail = 3;
+   */
+  detail: string;
+
+  /**
+   * The error the step failed with, if any.
+   *
+   * @generated from field: optional string error = 4;
+   */
+  error?: string;
+
+  /**
+   * How long the step took, in microseconds.
+   *
+   * @generated from field: int64 duration_us = 5;
+   */
+  durationUs: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DiagnosticResult.
+ * Use `create(DiagnosticResultSchema)` to create a new message.
+ */
+export const DiagnosticResultSchema: GenMessage<DiagnosticResult> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 99);
+
+/**
+ * @generated from message pb.clientrpc.v1.DiagnoseRequest
+ */
+export type DiagnoseRequest = Message<"pb.clientrpc.v1.DiagnoseRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DiagnoseRequest.
+ * Use `create(DiagnoseRequestSchema)` to create a new message.
+ */
+export const DiagnoseRequestSchema: GenMessage<DiagnoseRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 100);
+
+/**
+ * @generated from message pb.clientrpc.v1.DiagnoseResponse
+ */
+export type DiagnoseResponse = Message<"pb.clientrpc.v1.DiagnoseResponse"> & {
+  /**
+   * The result of each step, in the order they were run.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.DiagnosticResult results = 1;
+   */
+  results: DiagnosticResult[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DiagnoseResponse.
+ * Use `create(DiagnoseResponseSchema)` to create a new message.
+ */
+export const DiagnoseResponseSchema: GenMessage<DiagnoseResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 101);
+
+/**
+ * @generated from message pb.clientrpc.v1.MeasurePeerRequest
+ */
+export type MeasurePeerRequest = Message<"pb.clientrpc.v1.MeasurePeerRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The online user's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * The path to measure.
+   * If unspecified, the path that normal traffic to the peer would use is measured.
+   *
+   * @generated from field: pb.clientrpc.v1.PeerPath path = 3;
+   */
+  path: PeerPath;
+
+  /**
+   * The number of echoes to measure latency with.
+   * Defaults to 5 if unspecified. Capped at 100.
+   *
+   * @generated from field: optional uint32 pings = 4;
+   */
+  pings?: number;
+
+  /**
+   * The approximate number of bytes to transfer in each direction to measure throughput with.
+   * Defaults to 4 MiB if unspecified. Capped at 64 MiB.
+   * If 0, throughput is not measured.
+   *
+   * @generated from field: optional uint64 throughput_bytes = 5;
+   */
+  throughputBytes?: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.MeasurePeerRequest.
+ * Use `create(MeasurePeerRequestSchema)` to create a new message.
+ */
+export const MeasurePeerRequestSchema: GenMessage<MeasurePeerRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 102);
+
+/**
+ * @generated from message pb.clientrpc.v1.MeasurePeerResponse
+ */
+export type MeasurePeerResponse = Message<"pb.clientrpc.v1.MeasurePeerResponse"> & {
+  /**
+   * The path that was measured.
+   *
+   * @generated from field: pb.clientrpc.v1.PeerPath path = 1;
+   */
+  path: PeerPath;
+
+  /**
+   * The minimum echo round trip time, in microseconds.
+   *
+   * @generated from field: int64 latency_min_us = 2;
+   */
+  latencyMinUs: bigint;
+
+  /**
+   * The average echo round trip time, in microseconds.
+   *
+   * @generated from field: int64 latency_avg_us = 3;
+   */
+  latencyAvgUs: bigint;
+
+  /**
+   * The maximum echo round trip time, in microseconds.
+   *
+   * @generated from field: int64 latency_max_us = 4;
+   */
+  latencyMaxUs: bigint;
+
+  /**
+   * The rate at which the peer sent us data, in bytes per second.
+   *
+   * @generated from field: double download_bps = 5;
+   */
+  downloadBps: number;
+
+  /**
+   * The rate at which we sent the peer data, in bytes per second.
+   *
+   * @generated from field: double upload_bps = 6;
+   */
+  uploadBps: number;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.MeasurePeerResponse.
+ * Use `create(MeasurePeerResponseSchema)` to create a new message.
+ */
+export const MeasurePeerResponseSchema: GenMessage<MeasurePeerResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 103);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetOnlineUsersRequest
+ */
+export type GetOnlineUsersRequest = Message<"pb.clientrpc.v1.GetOnlineUsersRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetOnlineUsersRequest.
+ * Use `create(GetOnlineUsersRequestSchema)` to create a new message.
+ */
+export const GetOnlineUsersRequestSchema: GenMessage<GetOnlineUsersRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 104);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetOnlineUsersResponse
+ */
+export type GetOnlineUsersResponse = Message<"pb.clientrpc.v1.GetOnlineUsersResponse"> & {
+  /**
+   * The users.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.OnlineUserInfo users = 1;
+   */
+  users: OnlineUserInfo[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetOnlineUsersResponse.
+ * Use `create(GetOnlineUsersResponseSchema)` to create a new message.
+ */
+export const GetOnlineUsersResponseSchema: GenMessage<GetOnlineUsersResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 105);
+
+/**
+ * @generated from message pb.clientrpc.v1.ChangeAccountPasswordRequest
+ */
+export type ChangeAccountPasswordRequest = Message<"pb.clientrpc.v1.ChangeAccountPasswordRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The account's current password.
+   *
+   * @generated from field: string current_password = 2;
+   */
+  currentPassword: string;
+
+  /**
+   * The account's new password.
+   *
+   * @generated from field: string new_password = 3;
+   */
+  newPassword: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ChangeAccountPasswordRequest.
+ * Use `create(ChangeAccountPasswordRequestSchema)` to create a new message.
+ */
+export const ChangeAccountPasswordRequestSchema: GenMessage<ChangeAccountPasswordRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 106);
+
+/**
+ * @generated from message pb.clientrpc.v1.ChangeAccountPasswordResponse
+ */
+export type ChangeAccountPasswordResponse = Message<"pb.clientrpc.v1.ChangeAccountPasswordResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ChangeAccountPasswordResponse.
+ * Use `create(ChangeAccountPasswordResponseSchema)` to create a new message.
+ */
+export const ChangeAccountPasswordResponseSchema: GenMessage<ChangeAccountPasswordResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 107);
+
+/**
+ * @generated from message pb.clientrpc.v1.ServerConnectRequest
+ */
+export type ServerConnectRequest = Message<"pb.clientrpc.v1.ServerConnectRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ServerConnectRequest.
+ * Use `create(ServerConnectRequestSchema)` to create a new message.
+ */
+export const ServerConnectRequestSchema: GenMessage<ServerConnectRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 108);
+
+/**
+ * @generated from message pb.clientrpc.v1.ServerConnectResponse
+ */
+export type ServerConnectResponse = Message<"pb.clientrpc.v1.ServerConnectResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ServerConnectResponse.
+ * Use `create(ServerConnectResponseSchema)` to create a new message.
+ */
+export const ServerConnectResponseSchema: GenMessage<ServerConnectResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 109);
+
+/**
+ * @generated from message pb.clientrpc.v1.ServerDisconnectRequest
+ */
+export type ServerDisconnectRequest = Message<"pb.clientrpc.v1.ServerDisconnectRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ServerDisconnectRequest.
+ * Use `create(ServerDisconnectRequestSchema)` to create a new message.
+ */
+export const ServerDisconnectRequestSchema: GenMessage<ServerDisconnectRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 110);
+
+/**
+ * @generated from message pb.clientrpc.v1.ServerDisconnectResponse
+ */
+export type ServerDisconnectResponse = Message<"pb.clientrpc.v1.ServerDisconnectResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ServerDisconnectResponse.
+ * Use `create(ServerDisconnectResponseSchema)` to create a new message.
+ */
+export const ServerDisconnectResponseSchema: GenMessage<ServerDisconnectResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 111);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetDirectSettingsRequest
+ */
+export type GetDirectSettingsRequest = Message<"pb.clientrpc.v1.GetDirectSettingsRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetDirectSettingsRequest.
+ * Use `create(GetDirectSettingsRequestSchema)` to create a new message.
+ */
+export const GetDirectSettingsRequestSchema: GenMessage<GetDirectSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 112);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetDirectSettingsResponse
+ */
+export type GetDirectSettingsResponse = Message<"pb.clientrpc.v1.GetDirectSettingsResponse"> & {
+  /**
+   * The client's direct connection settings.
+   *
+   * @generated from field: pb.clientrpc.v1.DirectSettings settings = 1;
+   */
+  settings?: DirectSettings;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetDirectSettingsResponse.
+ * Use `create(GetDirectSettingsResponseSchema)` to create a new message.
+ */
+export const GetDirectSettingsResponseSchema: GenMessage<GetDirectSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 113);
+
+/**
+ * @generated from message pb.clientrpc.v1.UpdateDirectSettingsRequest
+ */
+export type UpdateDirectSettingsRequest = Message<"pb.clientrpc.v1.UpdateDirectSettingsRequest"> & {
+  /**
+   * The settings to update.
+   * All fields must be filled.
+   *
+   * @generated from field: pb.clientrpc.v1.DirectSettings settings = 1;
+   */
+  settings?: DirectSettings;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UpdateDirectSettingsRequest.
+ * Use `create(UpdateDirectSettingsRequestSchema)` to create a new message.
+ */
+export const UpdateDirectSettingsRequestSchema: GenMessage<UpdateDirectSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 114);
+
+/**
+ * @generated from message pb.clientrpc.v1.UpdateDirectSettingsResponse
+ */
+export type UpdateDirectSettingsResponse = Message<"pb.clientrpc.v1.UpdateDirectSettingsResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UpdateDirectSettingsResponse.
+ * Use `create(UpdateDirectSettingsResponseSchema)` to create a new message.
+ */
+export const UpdateDirectSettingsResponseSchema: GenMessage<UpdateDirectSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 115);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetTransferSettingsRequest
+ */
+export type GetTransferSettingsRequest = Message<"pb.clientrpc.v1.GetTransferSettingsRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetTransferSettingsRequest.
+ * Use `create(GetTransferSettingsRequestSchema)` to create a new message.
+ */
+export const GetTransferSettingsRequestSchema: GenMessage<GetTransferSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 116);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetTransferSettingsResponse
+ */
+export type GetTransferSettingsResponse = Message<"pb.clientrpc.v1.GetTransferSettingsResponse"> & {
+  /**
+   * The client's transfer settings.
+   *
+   * @generated from field: pb.clientrpc.v1.TransferSettings settings = 1;
+   */
+  settings?: TransferSettings;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetTransferSettingsResponse.
+ * Use `create(GetTransferSettingsResponseSchema)` to create a new message.
+ */
+export const GetTransferSettingsResponseSchema: GenMessage<GetTransferSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 117);
+
+/**
+ * @generated from message pb.clientrpc.v1.UpdateTransferSettingsRequest
+ */
+export type UpdateTransferSettingsRequest = Message<"pb.clientrpc.v1.UpdateTransferSettingsRequest"> & {
+  /**
+   * The settings to update.
+   * All fields must be filled.
+   *
+   * @generated from field: pb.clientrpc.v1.TransferSettings settings = 1;
+   */
+  settings?: TransferSettings;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UpdateTransferSettingsRequest.
+ * Use `create(UpdateTransferSettingsRequestSchema)` to create a new message.
+ */
+export const UpdateTransferSettingsRequestSchema: GenMessage<UpdateTransferSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 118);
+
+/**
+ * @generated from message pb.clientrpc.v1.UpdateTransferSettingsResponse
+ */
+export type UpdateTransferSettingsResponse = Message<"pb.clientrpc.v1.UpdateTransferSettingsResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UpdateTransferSettingsResponse.
+ * Use `create(UpdateTransferSettingsResponseSchema)` to create a new message.
+ */
+export const UpdateTransferSettingsResponseSchema: GenMessage<UpdateTransferSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 119);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetNotificationSettingsRequest
+ */
+export type GetNotificationSettingsRequest = Message<"pb.clientrpc.v1.GetNotificationSettingsRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetNotificationSettingsRequest.
+ * Use `create(GetNotificationSettingsRequestSchema)` to create a new message.
+ */
+export const GetNotificationSettingsRequestSchema: GenMessage<GetNotificationSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 120);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetNotificationSettingsResponse
+ */
+export type GetNotificationSettingsResponse = Message<"pb.clientrpc.v1.GetNotificationSettingsResponse"> & {
+  /**
+   * The notification settings.
+   *
+   * @generated from field: pb.clientrpc.v1.NotificationSettings settings = 1;
+   */
+  settings?: NotificationSettings;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetNotificationSettingsResponse.
+ * Use `create(GetNotificationSettingsResponseSchema)` to create a new message.
+ */
+export const GetNotificationSettingsResponseSchema: GenMessage<GetNotificationSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 121);
+
+/**
+ * @generated from message pb.clientrpc.v1.UpdateNotificationSettingsRequest
+ */
+export type UpdateNotificationSettingsRequest = Message<"pb.clientrpc.v1.UpdateNotificationSettingsRequest"> & {
+  /**
+   * The new notification settings.
+   * All fields must be filled.
+   *
+   * @generated from field: pb.clientrpc.v1.NotificationSettings settings = 1;
+   */
+  settings?: NotificationSettings;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UpdateNotificationSettingsRequest.
+ * Use `create(UpdateNotificationSettingsRequestSchema)` to create a new message.
+ */
+export const UpdateNotificationSettingsRequestSchema: GenMessage<UpdateNotificationSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 122);
+
+/**
+ * @generated from message pb.clientrpc.v1.UpdateNotificationSettingsResponse
+ */
+export type UpdateNotificationSettingsResponse = Message<"pb.clientrpc.v1.UpdateNotificationSettingsResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UpdateNotificationSettingsResponse.
+ * Use `create(UpdateNotificationSettingsResponseSchema)` to create a new message.
+ */
+export const UpdateNotificationSettingsResponseSchema: GenMessage<UpdateNotificationSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 123);
+
+/**
+ * @generated from message pb.clientrpc.v1.ExportConfigRequest
+ */
+export type ExportConfigRequest = Message<"pb.clientrpc.v1.ExportConfigRequest"> & {
+  /**
+   * The password to encrypt the bundle with.
+   * Must not be empty.
+   *
+   * @generated from field: string password = 1;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ExportConfigRequest.
+ * Use `create(ExportConfigRequestSchema)` to create a new message.
+ */
+export const ExportConfigRequestSchema: GenMessage<ExportConfigRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 124);
+
+/**
+ * @generated from message pb.clientrpc.v1.ExportConfigResponse
+ */
+export type ExportConfigResponse = Message<"pb.clientrpc.v1.ExportConfigResponse"> & {
+  /**
+   * The encrypted configuration bundle.
+   *
+   * @generated from field: bytes bundle = 1;
+   */
+  bundle: Uint8Array;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ExportConfigResponse.
+ * Use `create(ExportConfigResponseSchema)` to create a new message.
+ */
+export const ExportConfigResponseSchema: GenMessage<ExportConfigResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 125);
+
+/**
+ * @generated from message pb.clientrpc.v1.ImportConfigRequest
+ */
+export type ImportConfigRequest = Message<"pb.clientrpc.v1.ImportConfigRequest"> & {
+  /**
+   * The encrypted configuration bundle, as returned by ExportConfig.
+   *
+   * @generated from field: bytes bundle = 1;
+   */
+  bundle: Uint8Array;
+
+  /**
+   * The password the bundle was encrypted with.
+   *
+   * @generated from field: string password = 2;
+   */
+  password: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ImportConfigRequest.
+ * Use `create(ImportConfigRequestSchema)` to create a new message.
+ */
+export const ImportConfigRequestSchema: GenMessage<ImportConfigRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 126);
+
+/**
+ * @generated from message pb.clientrpc.v1.ImportConfigResponse
+ */
+export type ImportConfigResponse = Message<"pb.clientrpc.v1.ImportConfigResponse"> & {
+  /**
+   * The servers that were created.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.ServerInfo servers = 1;
+   */
+  servers: ServerInfo[];
+
+  /**
+   * The number of servers that were skipped because a server with the same address, room and username already exists.
+   *
+   * @generated from field: uint32 skipped_servers = 2;
+   */
+  skippedServers: number;
+
+  /**
+   * Descriptions of the shares that could not be created, such as because their path does not exist on this machine.
+   * The rest of the import still succeeds.
+   *
+   * @generated from field: repeated string failed_shares = 3;
+   */
+  failedShares: string[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ImportConfigResponse.
+ * Use `create(ImportConfigResponseSchema)` to create a new message.
+ */
+export const ImportConfigResponseSchema: GenMessage<ImportConfigResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 127);
+
+/**
+ * @generated from message pb.clientrpc.v1.BackupDatabaseRequest
+ */
+export type BackupDatabaseRequest = Message<"pb.clientrpc.v1.BackupDatabaseRequest"> & {
+  /**
+   * The path of the file to write the backup to.
+   * Relative paths are resolved against the client's working directory.
+   * The file must not already exist.
+   *
+   * @generated from field: string path = 1;
+   */
+  path: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.BackupDatabaseRequest.
+ * Use `create(BackupDatabaseRequestSchema)` to create a new message.
+ */
+export const BackupDatabaseRequestSchema: GenMessage<BackupDatabaseRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 128);
+
+/**
+ * @generated from message pb.clientrpc.v1.BackupDatabaseResponse
+ */
+export type BackupDatabaseResponse = Message<"pb.clientrpc.v1.BackupDatabaseResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.BackupDatabaseResponse.
+ * Use `create(BackupDatabaseResponseSchema)` to create a new message.
+ */
+export const BackupDatabaseResponseSchema: GenMessage<BackupDatabaseResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 129);
+
+/**
+ * @generated from message pb.clientrpc.v1.CheckDatabaseIntegrityRequest
+ */
+export type CheckDatabaseIntegrityRequest = Message<"pb.clientrpc.v1.CheckDatabaseIntegrityRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CheckDatabaseIntegrityRequest.
+ * Use `create(CheckDatabaseIntegrityRequestSchema)` to create a new message.
+ */
+export const CheckDatabaseIntegrityRequestSchema: GenMessage<CheckDatabaseIntegrityRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 130);
+
+/**
+ * @generated from message pb.clientrpc.v1.CheckDatabaseIntegrityResponse
+ */
+export type CheckDatabaseIntegrityResponse = Message<"pb.clientrpc.v1.CheckDatabaseIntegrityResponse"> & {
+  /**
+   * The problems found, or empty if the database is intact.
+   *
+   * @generated from field: repeated string problems = 1;
+   */
+  problems: string[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CheckDatabaseIntegrityResponse.
+ * Use `create(CheckDatabaseIntegrityResponseSchema)` to create a new message.
+ */
+export const CheckDatabaseIntegrityResponseSchema: GenMessage<CheckDatabaseIntegrityResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 131);
+
+/**
+ * @generated from message pb.clientrpc.v1.IndexShareRequest
+ */
+export type IndexShareRequest = Message<"pb.clientrpc.v1.IndexShareRequest"> & {
+  /**
+   * The associated server UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The share's name.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.IndexShareRequest.
+ * Use `create(IndexShareRequestSchema)` to create a new message.
+ */
+export const IndexShareRequestSchema: GenMessage<IndexShareRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 132);
+
+/**
+ * @generated from message pb.clientrpc.v1.IndexShareResponse
+ */
+export type IndexShareResponse = Message<"pb.clientrpc.v1.IndexShareResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.IndexShareResponse.
+ * Use `create(IndexShareResponseSchema)` to create a new message.
+ */
+export const IndexShareResponseSchema: GenMessage<IndexShareResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 133);
+
+/**
+ * @generated from message pb.clientrpc.v1.StreamSearchRequest
+ */
+export type StreamSearchRequest = Message<"pb.clientrpc.v1.StreamSearchRequest"> & {
+  /**
+   * The associated server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The username of the client to search, or omit to search all clients.
+   *
+   * @generated from field: optional string username = 2;
+   */
+  username?: string;
+
+  /**
+   * The search query.
+   *
+   * @generated from field: string query = 3;
+   */
+  query: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.StreamSearchRequest.
+ * Use `create(StreamSearchRequestSchema)` to create a new message.
+ */
+export const StreamSearchRequestSchema: GenMessage<StreamSearchRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 134);
+
+/**
+ * @generated from message pb.clientrpc.v1.StreamSearchResponse
+ */
+export type StreamSearchResponse = Message<"pb.clientrpc.v1.StreamSearchResponse"> & {
+  /**
+   * The username of the client the result came from.
+   *
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * The file's containing directory path.
+   *
+   * @generated from field: string directory_path = 2;
+   */
+  directoryPath: string;
+
+  /**
+   * The file that was found.
+   *
+   * @generated from field: pb.clientrpc.v1.FileMeta file = 3;
+   */
+  file?: FileMeta;
+
+  /**
+   * A snippet of text highlighting matched terms.
+   *
+   * @generated from field: string snippet = 4;
+   */
+  snippet: string;
+
+  /**
+   * The local friend info for the client the result came from, if there is any.
+   *
+   * @generated from field: optional pb.clientrpc.v1.FriendInfo friend = 5;
+   */
+  friend?: FriendInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.StreamSearchResponse.
+ * Use `create(StreamSearchResponseSchema)` to create a new message.
+ */
+export const StreamSearchResponseSchema: GenMessage<StreamSearchResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 135);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetUpdateInfoRequest
+ */
+export type GetUpdateInfoRequest = Message<"pb.clientrpc.v1.GetUpdateInfoRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetUpdateInfoRequest.
+ * Use `create(GetUpdateInfoRequestSchema)` to create a new message.
+ */
+export const GetUpdateInfoRequestSchema: GenMessage<GetUpdateInfoRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 136);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetUpdateInfoResponse
+ */
+export type GetUpdateInfoResponse = Message<"pb.clientrpc.v1.GetUpdateInfoResponse"> & {
+  /**
+   * The current update the client is running.
+   *
+   * @generated from field: pb.clientrpc.v1.UpdateInfo current_info = 1;
+   */
+  currentInfo?: UpdateInfo;
+
+  /**
+   * The new update's info, or no new update.
+   * This is cached info.
+   *
+   * @generated from field: optional pb.clientrpc.v1.UpdateInfo new_info = 2;
+   */
+  newInfo?: UpdateInfo;
+
+  /**
+   * Whether an update was downloaded with ApplyUpdate and will be installed when the client restarts.
+   *
+   * @generated from field: bool update_staged = 3;
+   */
+  updateStaged: boolean;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetUpdateInfoResponse.
+ * Use `create(GetUpdateInfoResponseSchema)` to create a new message.
+ */
+export const GetUpdateInfoResponseSchema: GenMessage<GetUpdateInfoResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 137);
+
+/**
+ * @generated from message pb.clientrpc.v1.CheckForNewUpdateRequest
+ */
+export type CheckForNewUpdateRequest = Message<"pb.clientrpc.v1.CheckForNewUpdateRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CheckForNewUpdateRequest.
+ * Use `create(CheckForNewUpdateRequestSchema)` to create a new message.
+ */
+export const CheckForNewUpdateRequestSchema: GenMessage<CheckForNewUpdateRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 138);
+
+/**
+ * @generated from message pb.clientrpc.v1.CheckForNewUpdateResponse
+ */
+export type CheckForNewUpdateResponse = Message<"pb.clientrpc.v1.CheckForNewUpdateResponse"> & {
+  /**
+   * The new update's info, or no new update.
+   *
+   * @generated from field: optional pb.clientrpc.v1.UpdateInfo new_info = 1;
+   */
+  newInfo?: UpdateInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CheckForNewUpdateResponse.
+ * Use `create(CheckForNewUpdateResponseSchema)` to create a new message.
+ */
+export const CheckForNewUpdateResponseSchema: GenMessage<CheckForNewUpdateResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 139);
+
+/**
+ * @generated from message pb.clientrpc.v1.ApplyUpdateRequest
+ */
+export type ApplyUpdateRequest = Message<"pb.clientrpc.v1.ApplyUpdateRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ApplyUpdateRequest.
+ * Use `create(ApplyUpdateRequestSchema)` to create a new message.
+ */
+export const ApplyUpdateRequestSchema: GenMessage<ApplyUpdateRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 140);
+
+/**
+ * @generated from message pb.clientrpc.v1.ApplyUpdateResponse
+ */
+export type ApplyUpdateResponse = Message<"pb.clientrpc.v1.ApplyUpdateResponse"> & {
+  /**
+   * The update that was downloaded.
+   * It will be installed when the client restarts.
+   *
+   * @generated from field: pb.clientrpc.v1.UpdateInfo info = 1;
+   */
+  info?: UpdateInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ApplyUpdateResponse.
+ * Use `create(ApplyUpdateResponseSchema)` to create a new message.
+ */
+export const ApplyUpdateResponseSchema: GenMessage<ApplyUpdateResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 141);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetUpdateSettingsRequest
+ */
+export type GetUpdateSettingsRequest = Message<"pb.clientrpc.v1.GetUpdateSettingsRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetUpdateSettingsRequest.
+ * Use `create(GetUpdateSettingsRequestSchema)` to create a new message.
+ */
+export const GetUpdateSettingsRequestSchema: GenMessage<GetUpdateSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 142);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetUpdateSettingsResponse
+ */
+export type GetUpdateSettingsResponse = Message<"pb.clientrpc.v1.GetUpdateSettingsResponse"> & {
+  /**
+   * The update settings.
+   *
+   * @generated from field: pb.clientrpc.v1.UpdateSettings settings = 1;
+   */
+  settings?: UpdateSettings;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetUpdateSettingsResponse.
+ * Use `create(GetUpdateSettingsResponseSchema)` to create a new message.
+ */
+export const GetUpdateSettingsResponseSchema: GenMessage<GetUpdateSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 143);
+
+/**
+ * @generated from message pb.clientrpc.v1.UpdateUpdateSettingsRequest
+ */
+export type UpdateUpdateSettingsRequest = Message<"pb.clientrpc.v1.UpdateUpdateSettingsRequest"> & {
+  /**
+   * The new update settings.
+   * All fields must be filled.
+   *
+   * @generated from field: pb.clientrpc.v1.UpdateSettings settings = 1;
+   */
+  settings?: UpdateSettings;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UpdateUpdateSettingsRequest.
+ * Use `create(UpdateUpdateSettingsRequestSchema)` to create a new message.
+ */
+export const UpdateUpdateSettingsRequestSchema: GenMessage<UpdateUpdateSettingsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 144);
+
+/**
+ * @generated from message pb.clientrpc.v1.UpdateUpdateSettingsResponse
+ */
+export type UpdateUpdateSettingsResponse = Message<"pb.clientrpc.v1.UpdateUpdateSettingsResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UpdateUpdateSettingsResponse.
+ * Use `create(UpdateUpdateSettingsResponseSchema)` to create a new message.
+ */
+export const UpdateUpdateSettingsResponseSchema: GenMessage<UpdateUpdateSettingsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 145);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetDownloadManagerItemsRequest
+ */
+export type GetDownloadManagerItemsRequest = Message<"pb.clientrpc.v1.GetDownloadManagerItemsRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetDownloadManagerItemsRequest.
+ * Use `create(GetDownloadManagerItemsRequestSchema)` to create a new message.
+ */
+export const GetDownloadManagerItemsRequestSchema: GenMessage<GetDownloadManagerItemsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 146);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetDownloadManagerItemsResponse
+ */
+export type GetDownloadManagerItemsResponse = Message<"pb.clientrpc.v1.GetDownloadManagerItemsResponse"> & {
+  /**
+   * The download manager items.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.DownloadManagerItem items = 1;
+   */
+  items: DownloadManagerItem[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetDownloadManagerItemsResponse.
+ * Use `create(GetDownloadManagerItemsResponseSchema)` to create a new message.
+ */
+export const GetDownloadManagerItemsResponseSchema: GenMessage<GetDownloadManagerItemsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 147);
+
+/**
+ * @generated from message pb.clientrpc.v1.QueueFileDownloadRequest
+ */
+export type QueueFileDownloadRequest = Message<"pb.clientrpc.v1.QueueFileDownloadRequest"> & {
+  /**
+   * The UUID of the server the peer exists on.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The peer's username.
+   *
+   * @generated from field: string peer_username = 2;
+   */
+  peerUsername: string;
+
+  /**
+   * The path of the file within the peer.
+   *
+   * @generated from field: string file_path = 3;
+   */
+  filePath: string;
+
+  /**
+   * What to do if the file was already downloaded before.
+   *
+   * @generated from field: pb.clientrpc.v1.DuplicateAction duplicate_action = 4;
+   */
+  duplicateAction: DuplicateAction;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.QueueFileDownloadRequest.
+ * Use `create(QueueFileDownloadRequestSchema)` to create a new message.
+ */
+export const QueueFileDownloadRequestSchema: GenMessage<QueueFileDownloadRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 148);
+
+/**
+ * @generated from message pb.clientrpc.v1.QueueFileDownloadResponse
+ */
+export type QueueFileDownloadResponse = Message<"pb.clientrpc.v1.QueueFileDownloadResponse"> & {
+  /**
+   * The file that was already downloaded with the same content, if any.
+   * If duplicate_action was DUPLICATE_ACTION_UNSPECIFIED and this is set, the download was not queued.
+   *
+   * @generated from field: optional pb.clientrpc.v1.DuplicateFile duplicate = 1;
+   */
+  duplicate?: DuplicateFile;
+
+  /**
+   * The path the duplicate was hard linked or copied to, if duplicate_action was DUPLICATE_ACTION_HARD_LINK or
+   * DUPLICATE_ACTION_COPY and a duplicate was found.
+   *
+   * @generated from field: optional string linked_path = 2;
+   */
+  linkedPath?: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.QueueFileDownloadResponse.
+ * Use `create(QueueFileDownloadResponseSchema)` to create a new message.
+ */
+export const QueueFileDownloadResponseSchema: GenMessage<QueueFileDownloadResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 149);
+
+/**
+ * A file that was already downloaded.
+ *
+ * @generated from message pb.clientrpc.v1.DuplicateFile
+ */
+export type DuplicateFile = Message<"pb.clientrpc.v1.DuplicateFile"> & {
+  /**
+   * The file's local path.
+   *
+   * @generated from field: string local_path = 1;
+   */
+  localPath: string;
+
+  /**
+   * The file's size, in bytes.
+   *
+   * @generated from field: uint64 size = 2;
+   */
+  size: bigint;
+
+  /**
+   * The UNIX timestamp when the file was downloaded.
+   *
+   * @generated from field: int64 downloaded_ts = 3;
+   */
+  downloadedTs: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DuplicateFile.
+ * Use `create(DuplicateFileSchema)` to create a new message.
+ */
+export const DuplicateFileSchema: GenMessage<DuplicateFile> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 150);
+
+/**
+ * @generated from message pb.clientrpc.v1.CancelFileDownloadRequest
+ */
+export type CancelFileDownloadRequest = Message<"pb.clientrpc.v1.CancelFileDownloadRequest"> & {
+  /**
+   * The file download's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CancelFileDownloadRequest.
+ * Use `create(CancelFileDownloadRequestSchema)` to create a new message.
+ */
+export const CancelFileDownloadRequestSchema: GenMessage<CancelFileDownloadRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 151);
+
+/**
+ * @generated from message pb.clientrpc.v1.CancelFileDownloadResponse
+ */
+export type CancelFileDownloadResponse = Message<"pb.clientrpc.v1.CancelFileDownloadResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CancelFileDownloadResponse.
+ * Use `create(CancelFileDownloadResponseSchema)` to create a new message.
+ */
+export const CancelFileDownloadResponseSchema: GenMessage<CancelFileDownloadResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 152);
+
+/**
+ * @generated from message pb.clientrpc.v1.RemoveDownloadManagerItemRequest
+ */
+export type RemoveDownloadManagerItemRequest = Message<"pb.clientrpc.v1.RemoveDownloadManagerItemRequest"> & {
+  /**
+   * The item's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.RemoveDownloadManagerItemRequest.
+ * Use `create(RemoveDownloadManagerItemRequestSchema)` to create a new message.
+ */
+export const RemoveDownloadManagerItemRequestSchema: GenMessage<RemoveDownloadManagerItemRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 153);
+
+/**
+ * @generated from message pb.clientrpc.v1.RemoveDownloadManagerItemResponse
+ */
+export type RemoveDownloadManagerItemResponse = Message<"pb.clientrpc.v1.RemoveDownloadManagerItemResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.RemoveDownloadManagerItemResponse.
+ * Use `create(RemoveDownloadManagerItemResponseSchema)` to create a new message.
+ */
+export const RemoveDownloadManagerItemResponseSchema: GenMessage<RemoveDownloadManagerItemResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 154);
+
+/**
+ * @generated from message pb.clientrpc.v1.PauseFileDownloadRequest
+ */
+export type PauseFileDownloadRequest = Message<"pb.clientrpc.v1.PauseFileDownloadRequest"> & {
+  /**
+   * The file download's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.PauseFileDownloadRequest.
+ * Use `create(PauseFileDownloadRequestSchema)` to create a new message.
+ */
+export const PauseFileDownloadRequestSchema: GenMessage<PauseFileDownloadRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 155);
+
+/**
+ * @generated from message pb.clientrpc.v1.PauseFileDownloadResponse
+ */
+export type PauseFileDownloadResponse = Message<"pb.clientrpc.v1.PauseFileDownloadResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.PauseFileDownloadResponse.
+ * Use `create(PauseFileDownloadResponseSchema)` to create a new message.
+ */
+export const PauseFileDownloadResponseSchema: GenMessage<PauseFileDownloadResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 156);
+
+/**
+ * @generated from message pb.clientrpc.v1.ResumeFileDownloadRequest
+ */
+export type ResumeFileDownloadRequest = Message<"pb.clientrpc.v1.ResumeFileDownloadRequest"> & {
+  /**
+   * The item's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ResumeFileDownloadRequest.
+ * Use `create(ResumeFileDownloadRequestSchema)` to create a new message.
+ */
+export const ResumeFileDownloadRequestSchema: GenMessage<ResumeFileDownloadRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 157);
+
+/**
+ * @generated from message pb.clientrpc.v1.ResumeFileDownloadResponse
+ */
+export type ResumeFileDownloadResponse = Message<"pb.clientrpc.v1.ResumeFileDownloadResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ResumeFileDownloadResponse.
+ * Use `create(ResumeFileDownloadResponseSchema)` to create a new message.
+ */
+export const ResumeFileDownloadResponseSchema: GenMessage<ResumeFileDownloadResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 158);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetDownloadHooksRequest
+ */
+export type GetDownloadHooksRequest = Message<"pb.clientrpc.v1.GetDownloadHooksRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetDownloadHooksRequest.
+ * Use `create(GetDownloadHooksRequestSchema)` to create a new message.
+ */
+export const GetDownloadHooksRequestSchema: GenMessage<GetDownloadHooksRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 159);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetDownloadHooksResponse
+ */
+export type GetDownloadHooksResponse = Message<"pb.clientrpc.v1.GetDownloadHooksResponse"> & {
+  /**
+   * All download hooks, both global and per-download.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.DownloadHookInfo hooks = 1;
+   */
+  hooks: DownloadHookInfo[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetDownloadHooksResponse.
+ * Use `create(GetDownloadHooksResponseSchema)` to create a new message.
+ */
+export const GetDownloadHooksResponseSchema: GenMessage<GetDownloadHooksResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 160);
+
+/**
+ * @generated from message pb.clientrpc.v1.CreateDownloadHookRequest
+ */
+export type CreateDownloadHookRequest = Message<"pb.clientrpc.v1.CreateDownloadHookRequest"> & {
+  /**
+   * The hook's type.
+   *
+   * @generated from field: pb.clientrpc.v1.DownloadHookType type = 1;
+   */
+  type: DownloadHookType;
+
+  /**
+   * The hook's target.
+   * See DownloadHookInfo.target.
+   *
+   * @generated from field: string target = 2;
+   */
+  target: string;
+
+  /**
+   * The UUID of the download the hook applies to, or omit to apply it to all downloads.
+   *
+   * @generated from field: optional string download_uuid = 3;
+   */
+  downloadUuid?: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CreateDownloadHookRequest.
+ * Use `create(CreateDownloadHookRequestSchema)` to create a new message.
+ */
+export const CreateDownloadHookRequestSchema: GenMessage<CreateDownloadHookRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 161);
+
+/**
+ * @generated from message pb.clientrpc.v1.CreateDownloadHookResponse
+ */
+export type CreateDownloadHookResponse = Message<"pb.clientrpc.v1.CreateDownloadHookResponse"> & {
+  /**
+   * The new hook.
+   *
+   * @generated from field: pb.clientrpc.v1.DownloadHookInfo hook = 1;
+   */
+  hook?: DownloadHookInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CreateDownloadHookResponse.
+ * Use `create(CreateDownloadHookResponseSchema)` to create a new message.
+ */
+export const CreateDownloadHookResponseSchema: GenMessage<CreateDownloadHookResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 162);
+
+/**
+ * @generated from message pb.clientrpc.v1.DeleteDownloadHookRequest
+ */
+export type DeleteDownloadHookRequest = Message<"pb.clientrpc.v1.DeleteDownloadHookRequest"> & {
+  /**
+   * The hook's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DeleteDownloadHookRequest.
+ * Use `create(DeleteDownloadHookRequestSchema)` to create a new message.
+ */
+export const DeleteDownloadHookRequestSchema: GenMessage<DeleteDownloadHookRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 163);
+
+/**
+ * @generated from message pb.clientrpc.v1.DeleteDownloadHookResponse
+ */
+export type DeleteDownloadHookResponse = Message<"pb.clientrpc.v1.DeleteDownloadHookResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DeleteDownloadHookResponse.
+ * Use `create(DeleteDownloadHookResponseSchema)` to create a new message.
+ */
+export const DeleteDownloadHookResponseSchema: GenMessage<DeleteDownloadHookResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 164);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetUploadsRequest
+ */
+export type GetUploadsRequest = Message<"pb.clientrpc.v1.GetUploadsRequest"> & {
+  /**
+   * The maximum number of finished uploads to return.
+   * 0 means 100.
+   *
+   * @generated from field: uint32 history_limit = 1;
+   */
+  historyLimit: number;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetUploadsRequest.
+ * Use `create(GetUploadsRequestSchema)` to create a new message.
+ */
+export const GetUploadsRequestSchema: GenMessage<GetUploadsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 165);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetUploadsResponse
+ */
+export type GetUploadsResponse = Message<"pb.clientrpc.v1.GetUploadsResponse"> & {
+  /**
+   * Uploads that are in progress.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.UploadInfo active = 1;
+   */
+  active: UploadInfo[];
+
+  /**
+   * Finished uploads, newest first.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.UploadInfo history = 2;
+   */
+  history: UploadInfo[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetUploadsResponse.
+ * Use `create(GetUploadsResponseSchema)` to create a new message.
+ */
+export const GetUploadsResponseSchema: GenMessage<GetUploadsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 166);
+
+/**
+ * @generated from message pb.clientrpc.v1.ClearUploadHistoryRequest
+ */
+export type ClearUploadHistoryRequest = Message<"pb.clientrpc.v1.ClearUploadHistoryRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ClearUploadHistoryRequest.
+ * Use `create(ClearUploadHistoryRequestSchema)` to create a new message.
+ */
+export const ClearUploadHistoryRequestSchema: GenMessage<ClearUploadHistoryRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 167);
+
+/**
+ * @generated from message pb.clientrpc.v1.ClearUploadHistoryResponse
+ */
+export type ClearUploadHistoryResponse = Message<"pb.clientrpc.v1.ClearUploadHistoryResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ClearUploadHistoryResponse.
+ * Use `create(ClearUploadHistoryResponseSchema)` to create a new message.
+ */
+export const ClearUploadHistoryResponseSchema: GenMessage<ClearUploadHistoryResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 168);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetFriendsRequest
+ */
+export type GetFriendsRequest = Message<"pb.clientrpc.v1.GetFriendsRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetFriendsRequest.
+ * Use `create(GetFriendsRequestSchema)` to create a new message.
+ */
+export const GetFriendsRequestSchema: GenMessage<GetFriendsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 169);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetFriendsResponse
+ */
+export type GetFriendsResponse = Message<"pb.clientrpc.v1.GetFriendsResponse"> & {
+  /**
+   * The friend info for all peers on the server that have any.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.FriendInfo friends = 1;
+   */
+  friends: FriendInfo[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetFriendsResponse.
+ * Use `create(GetFriendsResponseSchema)` to create a new message.
+ */
+export const GetFriendsResponseSchema: GenMessage<GetFriendsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 170);
+
+/**
+ * @generated from message pb.clientrpc.v1.SetFriendRequest
+ */
+export type SetFriendRequest = Message<"pb.clientrpc.v1.SetFriendRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The peer's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+
+  /**
+   * See FriendInfo.nickname.
+   * At most 64 characters.
+   *
+   * @generated from field: string nickname = 3;
+   */
+  nickname: string;
+
+  /**
+   * See FriendInfo.note.
+   * At most 4096 characters.
+   *
+   * @generated from field: string note = 4;
+   */
+  note: string;
+
+  /**
+   * See FriendInfo.trust_level.
+   *
+   * @generated from field: pb.clientrpc.v1.TrustLevel trust_level = 5;
+   */
+  trustLevel: TrustLevel;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.SetFriendRequest.
+ * Use `create(SetFriendRequestSchema)` to create a new message.
+ */
+export const SetFriendRequestSchema: GenMessage<SetFriendRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 171);
+
+/**
+ * @generated from message pb.clientrpc.v1.SetFriendResponse
+ */
+export type SetFriendResponse = Message<"pb.clientrpc.v1.SetFriendResponse"> & {
+  /**
+   * The friend info as stored.
+   *
+   * @generated from field: pb.clientrpc.v1.FriendInfo friend = 1;
+   */
+  friend?: FriendInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.SetFriendResponse.
+ * Use `create(SetFriendResponseSchema)` to create a new message.
+ */
+export const SetFriendResponseSchema: GenMessage<SetFriendResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 172);
+
+/**
+ * @generated from message pb.clientrpc.v1.DeleteFriendRequest
+ */
+export type DeleteFriendRequest = Message<"pb.clientrpc.v1.DeleteFriendRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The peer's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DeleteFriendRequest.
+ * Use `create(DeleteFriendRequestSchema)` to create a new message.
+ */
+export const DeleteFriendRequestSchema: GenMessage<DeleteFriendRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 173);
+
+/**
+ * @generated from message pb.clientrpc.v1.DeleteFriendResponse
+ */
+export type DeleteFriendResponse = Message<"pb.clientrpc.v1.DeleteFriendResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DeleteFriendResponse.
+ * Use `create(DeleteFriendResponseSchema)` to create a new message.
+ */
+export const DeleteFriendResponseSchema: GenMessage<DeleteFriendResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 174);
+
+/**
+ * BlockedPeerInfo is a peer on the local block list.
+ *
+ * @generated from message pb.clientrpc.v1.BlockedPeerInfo
+ */
+export type BlockedPeerInfo = Message<"pb.clientrpc.v1.BlockedPeerInfo"> & {
+  /**
+   * The peer's username.
+   *
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * The UNIX timestamp when the peer was blocked.
+   *
+   * @generated from field: int64 created_ts = 2;
+   */
+  createdTs: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.BlockedPeerInfo.
+ * Use `create(BlockedPeerInfoSchema)` to create a new message.
+ */
+export const BlockedPeerInfoSchema: GenMessage<BlockedPeerInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 175);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetBlockedPeersRequest
+ */
+export type GetBlockedPeersRequest = Message<"pb.clientrpc.v1.GetBlockedPeersRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetBlockedPeersRequest.
+ * Use `create(GetBlockedPeersRequestSchema)` to create a new message.
+ */
+export const GetBlockedPeersRequestSchema: GenMessage<GetBlockedPeersRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 176);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetBlockedPeersResponse
+ */
+export type GetBlockedPeersResponse = Message<"pb.clientrpc.v1.GetBlockedPeersResponse"> & {
+  /**
+   * The blocked peers on the server, ordered by username.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.BlockedPeerInfo peers = 1;
+   */
+  peers: BlockedPeerInfo[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetBlockedPeersResponse.
+ * Use `create(GetBlockedPeersResponseSchema)` to create a new message.
+ */
+export const GetBlockedPeersResponseSchema: GenMessage<GetBlockedPeersResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 177);
+
+/**
+ * @generated from message pb.clientrpc.v1.BlockPeerRequest
+ */
+export type BlockPeerRequest = Message<"pb.clientrpc.v1.BlockPeerRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The peer's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.BlockPeerRequest.
+ * Use `create(BlockPeerRequestSchema)` to create a new message.
+ */
+export const BlockPeerRequestSchema: GenMessage<BlockPeerRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 178);
+
+/**
+ * @generated from message pb.clientrpc.v1.BlockPeerResponse
+ */
+export type BlockPeerResponse = Message<"pb.clientrpc.v1.BlockPeerResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.BlockPeerResponse.
+ * Use `create(BlockPeerResponseSchema)` to create a new message.
+ */
+export const BlockPeerResponseSchema: GenMessage<BlockPeerResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 179);
+
+/**
+ * @generated from message pb.clientrpc.v1.UnblockPeerRequest
+ */
+export type UnblockPeerRequest = Message<"pb.clientrpc.v1.UnblockPeerRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The peer's username.
+   *
+   * @generated from field: string username = 2;
+   */
+  username: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UnblockPeerRequest.
+ * Use `create(UnblockPeerRequestSchema)` to create a new message.
+ */
+export const UnblockPeerRequestSchema: GenMessage<UnblockPeerRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 180);
+
+/**
+ * @generated from message pb.clientrpc.v1.UnblockPeerResponse
+ */
+export type UnblockPeerResponse = Message<"pb.clientrpc.v1.UnblockPeerResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UnblockPeerResponse.
+ * Use `create(UnblockPeerResponseSchema)` to create a new message.
+ */
+export const UnblockPeerResponseSchema: GenMessage<UnblockPeerResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 181);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetInterestsRequest
+ */
+export type GetInterestsRequest = Message<"pb.clientrpc.v1.GetInterestsRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetInterestsRequest.
+ * Use `create(GetInterestsRequestSchema)` to create a new message.
+ */
+export const GetInterestsRequestSchema: GenMessage<GetInterestsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 182);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetInterestsResponse
+ */
+export type GetInterestsResponse = Message<"pb.clientrpc.v1.GetInterestsResponse"> & {
+  /**
+   * The interests published to every server, normalized.
+   *
+   * @generated from field: repeated string interests = 1;
+   */
+  interests: string[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetInterestsResponse.
+ * Use `create(GetInterestsResponseSchema)` to create a new message.
+ */
+export const GetInterestsResponseSchema: GenMessage<GetInterestsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 183);
+
+/**
+ * @generated from message pb.clientrpc.v1.SetInterestsRequest
+ */
+export type SetInterestsRequest = Message<"pb.clientrpc.v1.SetInterestsRequest"> & {
+  /**
+   * The interests to publish to every server, such as "jazz" or "field recordings".
+   * They are normalized by lowercasing them and collapsing whitespace, and duplicates are removed.
+   * Empty to clear them.
+   *
+   * @generated from field: repeated string interests = 1;
+   */
+  interests: string[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.SetInterestsRequest.
+ * Use `create(SetInterestsRequestSchema)` to create a new message.
+ */
+export const SetInterestsRequestSchema: GenMessage<SetInterestsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 184);
+
+/**
+ * @generated from message pb.clientrpc.v1.SetInterestsResponse
+ */
+export type SetInterestsResponse = Message<"pb.clientrpc.v1.SetInterestsResponse"> & {
+  /**
+   * The interests that were stored, normalized.
+   *
+   * @generated from field: repeated string interests = 1;
+   */
+  interests: string[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.SetInterestsResponse.
+ * Use `create(SetInterestsResponseSchema)` to create a new message.
+ */
+export const SetInterestsResponseSchema: GenMessage<SetInterestsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 185);
+
+/**
+ * SimilarUserInfo is an online user whose interests overlap with the client's.
+ *
+ * @generated from message pb.clientrpc.v1.SimilarUserInfo
+ */
+export type SimilarUserInfo = Message<"pb.clientrpc.v1.SimilarUserInfo"> & {
+  /**
+   * The user's username.
+   *
+   * @generated from field: string username = 1;
+   */
+  username: string;
+
+  /**
+   * The interests the user shares with the client.
+   *
+   * @generated from field: repeated string shared_interests = 2;
+   */
+  sharedInterests: string[];
+
+  /**
+   * The total number of interests the user published.
+   *
+   * @generated from field: uint32 interest_count = 3;
+   */
+  interestCount: number;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.SimilarUserInfo.
+ * Use `create(SimilarUserInfoSchema)` to create a new message.
+ */
+export const SimilarUserInfoSchema: GenMessage<SimilarUserInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 186);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetSimilarUsersRequest
+ */
+export type GetSimilarUsersRequest = Message<"pb.clientrpc.v1.GetSimilarUsersRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The maximum number of users to return.
+   * If unspecified or 0, the server picks a default.
+   *
+   * @generated from field: optional uint32 limit = 2;
+   */
+  limit?: number;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetSimilarUsersRequest.
+ * Use `create(GetSimilarUsersRequestSchema)` to create a new message.
+ */
+export const GetSimilarUsersRequestSchema: GenMessage<GetSimilarUsersRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 187);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetSimilarUsersResponse
+ */
+export type GetSimilarUsersResponse = Message<"pb.clientrpc.v1.GetSimilarUsersResponse"> & {
+  /**
+   * The users, ordered by the number of shared interests, most first.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.SimilarUserInfo users = 1;
+   */
+  users: SimilarUserInfo[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetSimilarUsersResponse.
+ * Use `create(GetSimilarUsersResponseSchema)` to create a new message.
+ */
+export const GetSimilarUsersResponseSchema: GenMessage<GetSimilarUsersResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 188);
+
+/**
+ * ConnWindow is a time window during which a server connection is allowed.
+ * Times are in the client's local time zone.
+ *
+ * @generated from message pb.clientrpc.v1.ConnWindow
+ */
+export type ConnWindow = Message<"pb.clientrpc.v1.ConnWindow"> & {
+  /**
+   * The days of the week the window starts on, as a bit mask where bit 0 is Sunday and bit 6 is Saturday.
+   * 0 means every day.
+   *
+   * @generated from field: uint32 weekdays = 1;
+   */
+  weekdays: number;
+
+  /**
+   * The minute of the day the window starts at, from 0 to 1439.
+   *
+   * @generated from field: uint32 start_minute = 2;
+   */
+  startMinute: number;
+
+  /**
+   * The minute of the day the window ends at, from 0 to 1439.
+   * If it is not after start_minute, the window ends on the next day.
+   *
+   * @generated from field: uint32 end_minute = 3;
+   */
+  endMinute: number;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ConnWindow.
+ * Use `create(ConnWindowSchema)` to create a new message.
+ */
+export const ConnWindowSchema: GenMessage<ConnWindow> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 189);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetServerScheduleRequest
+ */
+export type GetServerScheduleRequest = Message<"pb.clientrpc.v1.GetServerScheduleRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetServerScheduleRequest.
+ * Use `create(GetServerScheduleRequestSchema)` to create a new message.
+ */
+export const GetServerScheduleRequestSchema: GenMessage<GetServerScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 190);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetServerScheduleResponse
+ */
+export type GetServerScheduleResponse = Message<"pb.clientrpc.v1.GetServerScheduleResponse"> & {
+  /**
+   * The windows during which the connection is allowed.
+   * If empty, connecting is allowed at any time.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.ConnWindow windows = 1;
+   */
+  windows: ConnWindow[];
+
+  /**
+   * Whether the schedule allows connecting right now.
+   *
+   * @generated from field: bool allowed_now = 2;
+   */
+  allowedNow: boolean;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetServerScheduleResponse.
+ * Use `create(GetServerScheduleResponseSchema)` to create a new message.
+ */
+export const GetServerScheduleResponseSchema: GenMessage<GetServerScheduleResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 191);
+
+/**
+ * @generated from message pb.clientrpc.v1.SetServerScheduleRequest
+ */
+export type SetServerScheduleRequest = Message<"pb.clientrpc.v1.SetServerScheduleRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The windows during which the connection is allowed.
+   * If empty, connecting is allowed at any time.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.ConnWindow windows = 2;
+   */
+  windows: ConnWindow[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.SetServerScheduleRequest.
+ * Use `create(SetServerScheduleRequestSchema)` to create a new message.
+ */
+export const SetServerScheduleRequestSchema: GenMessage<SetServerScheduleRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 192);
+
+/**
+ * @generated from message pb.clientrpc.v1.SetServerScheduleResponse
+ */
+export type SetServerScheduleResponse = Message<"pb.clientrpc.v1.SetServerScheduleResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.SetServerScheduleResponse.
+ * Use `create(SetServerScheduleResponseSchema)` to create a new message.
+ */
+export const SetServerScheduleResponseSchema: GenMessage<SetServerScheduleResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 193);
+
+/**
+ * SnoozeInfo is the state of the client's snooze.
+ * While snoozed, all uploads and downloads on every server are paused.
+ *
+ * @generated from message pb.clientrpc.v1.SnoozeInfo
+ */
+export type SnoozeInfo = Message<"pb.clientrpc.v1.SnoozeInfo"> & {
+  /**
+   * Whether the client is snoozed.
+   *
+   * @generated from field: bool active = 1;
+   */
+  active: boolean;
+
+  /**
+   * The UNIX timestamp, in seconds, at which the snooze ends.
+   * If unset while active, the snooze lasts until it is ended with Unsnooze.
+   *
+   * @generated from field: optional int64 until_ts = 2;
+   */
+  untilTs?: bigint;
+
+  /**
+   * Whether shares are hidden from peers while snoozed.
+   * Peers see no shares and get no search results.
+   *
+   * @generated from field: bool hide_shares = 3;
+   */
+  hideShares: boolean;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.SnoozeInfo.
+ * Use `create(SnoozeInfoSchema)` to create a new message.
+ */
+export const SnoozeInfoSchema: GenMessage<SnoozeInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 194);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetSnoozeRequest
+ */
+export type GetSnoozeRequest = Message<"pb.clientrpc.v1.GetSnoozeRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetSnoozeRequest.
+ * Use `create(GetSnoozeRequestSchema)` to create a new message.
+ */
+export const GetSnoozeRequestSchema: GenMessage<GetSnoozeRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 195);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetSnoozeResponse
+ */
+export type GetSnoozeResponse = Message<"pb.clientrpc.v1.GetSnoozeResponse"> & {
+  /**
+   * @generated from field: pb.clientrpc.v1.SnoozeInfo snooze = 1;
+   */
+  snooze?: SnoozeInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetSnoozeResponse.
+ * Use `create(GetSnoozeResponseSchema)` to create a new message.
+ */
+export const GetSnoozeResponseSchema: GenMessage<GetSnoozeResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 196);
+
+/**
+ * @generated from message pb.clientrpc.v1.SnoozeRequest
+ */
+export type SnoozeRequest = Message<"pb.clientrpc.v1.SnoozeRequest"> & {
+  /**
+   * How long to snooze for, in seconds.
+   * If unset, the snooze lasts until it is ended with Unsnooze.
+   *
+   * @generated from field: optional uint32 duration_seconds = 1;
+   */
+  durationSeconds?: number;
+
+  /**
+   * Whether to hide shares from peers while snoozed.
+   *
+   * @generated from field: bool hide_shares = 2;
+   */
+  hideShares: boolean;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.SnoozeRequest.
+ * Use `create(SnoozeRequestSchema)` to create a new message.
+ */
+export const SnoozeRequestSchema: GenMessage<SnoozeRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 197);
+
+/**
+ * @generated from message pb.clientrpc.v1.SnoozeResponse
+ */
+export type SnoozeResponse = Message<"pb.clientrpc.v1.SnoozeResponse"> & {
+  /**
+   * @generated from field: pb.clientrpc.v1.SnoozeInfo snooze = 1;
+   */
+  snooze?: SnoozeInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.SnoozeResponse.
+ * Use `create(SnoozeResponseSchema)` to create a new message.
+ */
+export const SnoozeResponseSchema: GenMessage<SnoozeResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 198);
+
+/**
+ * @generated from message pb.clientrpc.v1.UnsnoozeRequest
+ */
+export type UnsnoozeRequest = Message<"pb.clientrpc.v1.UnsnoozeRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UnsnoozeRequest.
+ * Use `create(UnsnoozeRequestSchema)` to create a new message.
+ */
+export const UnsnoozeRequestSchema: GenMessage<UnsnoozeRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 199);
+
+/**
+ * @generated from message pb.clientrpc.v1.UnsnoozeResponse
+ */
+export type UnsnoozeResponse = Message<"pb.clientrpc.v1.UnsnoozeResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.UnsnoozeResponse.
+ * Use `create(UnsnoozeResponseSchema)` to create a new message.
+ */
+export const UnsnoozeResponseSchema: GenMessage<UnsnoozeResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 200);
+
+/**
+ * RunSessionInfo is information about a run of the client, from when it started to when it stopped.
+ *
+ * @generated from message pb.clientrpc.v1.RunSessionInfo
+ */
+export type RunSessionInfo = Message<"pb.clientrpc.v1.RunSessionInfo"> & {
+  /**
+   * The run's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+
+  /**
+   * The UNIX timestamp when the client started.
+   *
+   * @generated from field: int64 started_ts = 2;
+   */
+  startedTs: bigint;
+
+  /**
+   * The UNIX timestamp when the client stopped, if it has.
+   * If the client crashed, this is the last time it was known to be running.
+   *
+   * @generated from field: optional int64 stopped_ts = 3;
+   */
+  stoppedTs?: bigint;
+
+  /**
+   * Whether the client stopped without shutting down cleanly, such as when it crashed or the computer lost power.
+   *
+   * @generated from field: bool crashed = 4;
+   */
+  crashed: boolean;
+
+  /**
+   * Whether this is the current run.
+   *
+   * @generated from field: bool is_current = 5;
+   */
+  isCurrent: boolean;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.RunSessionInfo.
+ * Use `create(RunSessionInfoSchema)` to create a new message.
+ */
+export const RunSessionInfoSchema: GenMessage<RunSessionInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 201);
+
+/**
+ * ConnSessionInfo is information about a connection to a server, from when it opened to when it closed.
+ *
+ * @generated from message pb.clientrpc.v1.ConnSessionInfo
+ */
+export type ConnSessionInfo = Message<"pb.clientrpc.v1.ConnSessionInfo"> & {
+  /**
+   * The connection session's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+
+  /**
+   * The UUID of the run the connection was made in.
+   *
+   * @generated from field: string run_uuid = 2;
+   */
+  runUuid: string;
+
+  /**
+   * The UUID of the server.
+   *
+   * @generated from field: string server_uuid = 3;
+   */
+  serverUuid: string;
+
+  /**
+   * The UNIX timestamp when the connection opened.
+   *
+   * @generated from field: int64 connected_ts = 4;
+   */
+  connectedTs: bigint;
+
+  /**
+   * The UNIX timestamp when the connection closed, if it has.
+   *
+   * @generated from field: optional int64 disconnected_ts = 5;
+   */
+  disconnectedTs?: bigint;
+
+  /**
+   * How long the connection was open, in seconds.
+   * For connections that are still open, this is how long they have been open so far.
+   *
+   * @generated from field: int64 duration_seconds = 6;
+   */
+  durationSeconds: bigint;
+
+  /**
+   * Why the connection closed, if it has, such as "connection lost" or "closed by server: ...".
+   *
+   * @generated from field: optional string disconnect_reason = 7;
+   */
+  disconnectReason?: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.ConnSessionInfo.
+ * Use `create(ConnSessionInfoSchema)` to create a new message.
+ */
+export const ConnSessionInfoSchema: GenMessage<ConnSessionInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 202);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetRunHistoryRequest
+ */
+export type GetRunHistoryRequest = Message<"pb.clientrpc.v1.GetRunHistoryRequest"> & {
+  /**
+   * The maximum number of runs to return.
+   * 0 means 100.
+   *
+   * @generated from field: uint32 limit = 1;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetRunHistoryRequest.
+ * Use `create(GetRunHistoryRequestSchema)` to create a new message.
+ */
+export const GetRunHistoryRequestSchema: GenMessage<GetRunHistoryRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 203);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetRunHistoryResponse
+ */
+export type GetRunHistoryResponse = Message<"pb.clientrpc.v1.GetRunHistoryResponse"> & {
+  /**
+   * Runs of the client, newest first, including the current one.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.RunSessionInfo runs = 1;
+   */
+  runs: RunSessionInfo[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetRunHistoryResponse.
+ * Use `create(GetRunHistoryResponseSchema)` to create a new message.
+ */
+export const GetRunHistoryResponseSchema: GenMessage<GetRunHistoryResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 204);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetConnHistoryRequest
+ */
+export type GetConnHistoryRequest = Message<"pb.clientrpc.v1.GetConnHistoryRequest"> & {
+  /**
+   * The UUID of the server to return connections for.
+   * Empty to return connections for all servers.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The maximum number of connections to return.
+   * 0 means 100.
+   *
+   * @generated from field: uint32 limit = 2;
+   */
+  limit: number;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetConnHistoryRequest.
+ * Use `create(GetConnHistoryRequestSchema)` to create a new message.
+ */
+export const GetConnHistoryRequestSchema: GenMessage<GetConnHistoryRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 205);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetConnHistoryResponse
+ */
+export type GetConnHistoryResponse = Message<"pb.clientrpc.v1.GetConnHistoryResponse"> & {
+  /**
+   * Connections to servers, newest first.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.ConnSessionInfo sessions = 1;
+   */
+  sessions: ConnSessionInfo[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetConnHistoryResponse.
+ * Use `create(GetConnHistoryResponseSchema)` to create a new message.
+ */
+export const GetConnHistoryResponseSchema: GenMessage<GetConnHistoryResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 206);
+
+/**
+ * TrashedServer is a deleted server that can still be restored.
+ *
+ * @generated from message pb.clientrpc.v1.TrashedServer
+ */
+export type TrashedServer = Message<"pb.clientrpc.v1.TrashedServer"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+
+  /**
+   * The name given to the server.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+
+  /**
+   * The server's address.
+   *
+   * @generated from field: string address = 3;
+   */
+  address: string;
+
+  /**
+   * The room to connect to.
+   *
+   * @generated from field: string room = 4;
+   */
+  room: string;
+
+  /**
+   * The username to use for authentication.
+   *
+   * @generated from field: string username = 5;
+   */
+  username: string;
+
+  /**
+   * The UNIX timestamp when the server was created.
+   *
+   * @generated from field: int64 created_ts = 6;
+   */
+  createdTs: bigint;
+
+  /**
+   * The UNIX timestamp when the server was deleted.
+   *
+   * @generated from field: int64 deleted_ts = 7;
+   */
+  deletedTs: bigint;
+
+  /**
+   * The UNIX timestamp after which the server is purged for good.
+   *
+   * @generated from field: int64 purge_ts = 8;
+   */
+  purgeTs: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.TrashedServer.
+ * Use `create(TrashedServerSchema)` to create a new message.
+ */
+export const TrashedServerSchema: GenMessage<TrashedServer> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 207);
+
+/**
+ * TrashedShare is a deleted share that can still be restored.
+ *
+ * @generated from message pb.clientrpc.v1.TrashedShare
+ */
+export type TrashedShare = Message<"pb.clientrpc.v1.TrashedShare"> & {
+  /**
+   * The share's info.
+   *
+   * @generated from field: pb.clientrpc.v1.ShareInfo share = 1;
+   */
+  share?: ShareInfo;
+
+  /**
+   * The UNIX timestamp when the share was deleted.
+   *
+   * @generated from field: int64 deleted_ts = 2;
+   */
+  deletedTs: bigint;
+
+  /**
+   * The UNIX timestamp after which the share is purged for good.
+   *
+   * @generated from field: int64 purge_ts = 3;
+   */
+  purgeTs: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.TrashedShare.
+ * Use `create(TrashedShareSchema)` to create a new message.
+ */
+export const TrashedShareSchema: GenMessage<TrashedShare> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 208);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetTrashRequest
+ */
+export type GetTrashRequest = Message<"pb.clientrpc.v1.GetTrashRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetTrashRequest.
+ * Use `create(GetTrashRequestSchema)` to create a new message.
+ */
+export const GetTrashRequestSchema: GenMessage<GetTrashRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 209);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetTrashResponse
+ */
+export type GetTrashResponse = Message<"pb.clientrpc.v1.GetTrashResponse"> & {
+  /**
+   * Deleted servers, most recently deleted first.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.TrashedServer servers = 1;
+   */
+  servers: TrashedServer[];
+
+  /**
+   * Deleted shares, most recently deleted first.
+   * Shares of deleted servers are not included, since they are restored or purged along with their server.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.TrashedShare shares = 2;
+   */
+  shares: TrashedShare[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetTrashResponse.
+ * Use `create(GetTrashResponseSchema)` to create a new message.
+ */
+export const GetTrashResponseSchema: GenMessage<GetTrashResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 210);
+
+/**
+ * @generated from message pb.clientrpc.v1.RestoreServerRequest
+ */
+export type RestoreServerRequest = Message<"pb.clientrpc.v1.RestoreServerRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.RestoreServerRequest.
+ * Use `create(RestoreServerRequestSchema)` to create a new message.
+ */
+export const RestoreServerRequestSchema: GenMessage<RestoreServerRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 211);
+
+/**
+ * @generated from message pb.clientrpc.v1.RestoreServerResponse
+ */
+export type RestoreServerResponse = Message<"pb.clientrpc.v1.RestoreServerResponse"> & {
+  /**
+   * The restored server.
+   *
+   * @generated from field: pb.clientrpc.v1.ServerInfo server = 1;
+   */
+  server?: ServerInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.RestoreServerResponse.
+ * Use `create(RestoreServerResponseSchema)` to create a new message.
+ */
+export const RestoreServerResponseSchema: GenMessage<RestoreServerResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 212);
+
+/**
+ * @generated from message pb.clientrpc.v1.PurgeServerRequest
+ */
+export type PurgeServerRequest = Message<"pb.clientrpc.v1.PurgeServerRequest"> & {
+  /**
+   * The server's UUID.
+   *
+   * @generated from field: string uuid = 1;
+   */
+  uuid: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.PurgeServerRequest.
+ * Use `create(PurgeServerRequestSchema)` to create a new message.
+ */
+export const PurgeServerRequestSchema: GenMessage<PurgeServerRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 213);
+
+/**
+ * @generated from message pb.clientrpc.v1.PurgeServerResponse
+ */
+export type PurgeServerResponse = Message<"pb.clientrpc.v1.PurgeServerResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.PurgeServerResponse.
+ * Use `create(PurgeServerResponseSchema)` to create a new message.
+ */
+export const PurgeServerResponseSchema: GenMessage<PurgeServerResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 214);
+
+/**
+ * @generated from message pb.clientrpc.v1.RestoreShareRequest
+ */
+export type RestoreShareRequest = Message<"pb.clientrpc.v1.RestoreShareRequest"> & {
+  /**
+   * The associated server UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The share's name.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.RestoreShareRequest.
+ * Use `create(RestoreShareRequestSchema)` to create a new message.
+ */
+export const RestoreShareRequestSchema: GenMessage<RestoreShareRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 215);
+
+/**
+ * @generated from message pb.clientrpc.v1.RestoreShareResponse
+ */
+export type RestoreShareResponse = Message<"pb.clientrpc.v1.RestoreShareResponse"> & {
+  /**
+   * The restored share.
+   *
+   * @generated from field: pb.clientrpc.v1.ShareInfo share = 1;
+   */
+  share?: ShareInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.RestoreShareResponse.
+ * Use `create(RestoreShareResponseSchema)` to create a new message.
+ */
+export const RestoreShareResponseSchema: GenMessage<RestoreShareResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 216);
+
+/**
+ * @generated from message pb.clientrpc.v1.PurgeShareRequest
+ */
+export type PurgeShareRequest = Message<"pb.clientrpc.v1.PurgeShareRequest"> & {
+  /**
+   * The associated server UUID.
+   *
+   * @generated from field: string server_uuid = 1;
+   */
+  serverUuid: string;
+
+  /**
+   * The share's name.
+   *
+   * @generated from field: string name = 2;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.PurgeShareRequest.
+ * Use `create(PurgeShareRequestSchema)` to create a new message.
+ */
+export const PurgeShareRequestSchema: GenMessage<PurgeShareRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 217);
+
+/**
+ * @generated from message pb.clientrpc.v1.PurgeShareResponse
+ */
+export type PurgeShareResponse = Message<"pb.clientrpc.v1.PurgeShareResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.PurgeShareResponse.
+ * Use `create(PurgeShareResponseSchema)` to create a new message.
+ */
+export const PurgeShareResponseSchema: GenMessage<PurgeShareResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 218);
+
+/**
+ * PluginInfo is information about a plugin that is allowed to use the RPC interface.
+ *
+ * @generated from message pb.clientrpc.v1.PluginInfo
+ */
+export type PluginInfo = Message<"pb.clientrpc.v1.PluginInfo"> & {
+  /**
+   * The plugin's unique name.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The UNIX timestamp when the plugin was created.
+   *
+   * @generated from field: int64 created_ts = 2;
+   */
+  createdTs: bigint;
+
+  /**
+   * The scopes granted to the plugin.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.PluginScope scopes = 3;
+   */
+  scopes: PluginScope[];
+
+  /**
+   * The number of event streams the plugin currently has open.
+   *
+   * @generated from field: uint32 open_streams = 4;
+   */
+  openStreams: number;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.PluginInfo.
+ * Use `create(PluginInfoSchema)` to create a new message.
+ */
+export const PluginInfoSchema: GenMessage<PluginInfo> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 219);
+
+/**
+ * PluginEvent is an event sent to a plugin.
+ *
+ * @generated from message pb.clientrpc.v1.PluginEvent
+ */
+export type PluginEvent = Message<"pb.clientrpc.v1.PluginEvent"> & {
+  /**
+   * The event type.
+   * The appropriate field will be filled based on the type.
+   *
+   * @generated from field: pb.clientrpc.v1.PluginEventType type = 1;
+   */
+  type: PluginEventType;
+
+  /**
+   * @generated from field: optional pb.clientrpc.v1.PluginEvent.ClientEvent client_event = 2;
+   */
+  clientEvent?: PluginEvent_ClientEvent;
+
+  /**
+   * @generated from field: optional pb.clientrpc.v1.PluginEvent.Search search = 3;
+   */
+  search?: PluginEvent_Search;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.PluginEvent.
+ * Use `create(PluginEventSchema)` to create a new message.
+ */
+export const PluginEventSchema: GenMessage<PluginEvent> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 220);
+
+/**
+ * @generated from message pb.clientrpc.v1.PluginEvent.ClientEvent
+ */
+export type PluginEvent_ClientEvent = Message<"pb.clientrpc.v1.PluginEvent.ClientEvent"> & {
+  /**
+   * The event.
+   *
+   * @generated from field: pb.clientrpc.v1.Event event = 1;
+   */
+  event?: Event;
+
+  /**
+   * The event's context.
+   *
+   * @generated from field: pb.clientrpc.v1.EventContext context = 2;
+   */
+  context?: EventContext;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.PluginEvent.ClientEvent.
+ * Use `create(PluginEvent_ClientEventSchema)` to create a new message.
+ */
+export const PluginEvent_ClientEventSchema: GenMessage<PluginEvent_ClientEvent> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 220, 0);
+
+/**
+ * @generated from message pb.clientrpc.v1.PluginEvent.Search
+ */
+export type PluginEvent_Search = Message<"pb.clientrpc.v1.PluginEvent.Search"> & {
+  /**
+   * The search's ID, to pass to RespondToSearch.
+   *
+   * @generated from field: string id = 1;
+   */
+  id: string;
+
+  /**
+   * The UUID of the server the search came from.
+   *
+   * @generated from field: string server_uuid = 2;
+   */
+  serverUuid: string;
+
+  /**
+   * The search query.
+   *
+   * @generated from field: string query = 3;
+   */
+  query: string;
+
+  /**
+   * The maximum number of results the plugin can add.
+   *
+   * @generated from field: uint32 max_results = 4;
+   */
+  maxResults: number;
+
+  /**
+   * The UNIX timestamp, in milliseconds, after which responses are no longer accepted.
+   *
+   * @generated from field: int64 deadline_ts_ms = 5;
+   */
+  deadlineTsMs: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.PluginEvent.Search.
+ * Use `create(PluginEvent_SearchSchema)` to create a new message.
+ */
+export const PluginEvent_SearchSchema: GenMessage<PluginEvent_Search> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 220, 1);
+
+/**
+ * PluginSearchResult is a search result added by a plugin.
+ *
+ * @generated from message pb.clientrpc.v1.PluginSearchResult
+ */
+export type PluginSearchResult = Message<"pb.clientrpc.v1.PluginSearchResult"> & {
+  /**
+   * The path of the folder containing the file, starting with the name of the share it is in.
+   * Peers download results from the client's shares, so results outside them cannot be downloaded.
+   *
+   * @generated from field: string directory_path = 1;
+   */
+  directoryPath: string;
+
+  /**
+   * The file's metadata.
+   *
+   * @generated from field: pb.clientrpc.v1.FileMeta file = 2;
+   */
+  file?: FileMeta;
+
+  /**
+   * A snippet of text highlighting matched terms, or empty.
+   *
+   * @generated from field: string snippet = 3;
+   */
+  snippet: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.PluginSearchResult.
+ * Use `create(PluginSearchResultSchema)` to create a new message.
+ */
+export const PluginSearchResultSchema: GenMessage<PluginSearchResult> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 221);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetPluginsRequest
+ */
+export type GetPluginsRequest = Message<"pb.clientrpc.v1.GetPluginsRequest"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetPluginsRequest.
+ * Use `create(GetPluginsRequestSchema)` to create a new message.
+ */
+export const GetPluginsRequestSchema: GenMessage<GetPluginsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 222);
+
+/**
+ * @generated from message pb.clientrpc.v1.GetPluginsResponse
+ */
+export type GetPluginsResponse = Message<"pb.clientrpc.v1.GetPluginsResponse"> & {
+  /**
+   * All plugins, ordered by name.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.PluginInfo plugins = 1;
+   */
+  plugins: PluginInfo[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.GetPluginsResponse.
+ * Use `create(GetPluginsResponseSchema)` to create a new message.
+ */
+export const GetPluginsResponseSchema: GenMessage<GetPluginsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 223);
+
+/**
+ * @generated from message pb.clientrpc.v1.CreatePluginRequest
+ */
+export type CreatePluginRequest = Message<"pb.clientrpc.v1.CreatePluginRequest"> & {
+  /**
+   * The plugin's name.
+   * Must be between 1 and 64 characters long and unique.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+
+  /**
+   * The scopes to grant to the plugin.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.PluginScope scopes = 2;
+   */
+  scopes: PluginScope[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CreatePluginRequest.
+ * Use `create(CreatePluginRequestSchema)` to create a new message.
+ */
+export const CreatePluginRequestSchema: GenMessage<CreatePluginRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 224);
+
+/**
+ * @generated from message pb.clientrpc.v1.CreatePluginResponse
+ */
+export type CreatePluginResponse = Message<"pb.clientrpc.v1.CreatePluginResponse"> & {
+  /**
+   * The new plugin.
+   *
+   * @generated from field: pb.clientrpc.v1.PluginInfo plugin = 1;
+   */
+  plugin?: PluginInfo;
+
+  /**
+   * The plugin's bearer token.
+   * It is only returned once; the client does not keep it.
+   *
+   * @generated from field: string token = 2;
+   */
+  token: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.CreatePluginResponse.
+ * Use `create(CreatePluginResponseSchema)` to create a new message.
+ */
+export const CreatePluginResponseSchema: GenMessage<CreatePluginResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 225);
+
+/**
+ * @generated from message pb.clientrpc.v1.DeletePluginRequest
+ */
+export type DeletePluginRequest = Message<"pb.clientrpc.v1.DeletePluginRequest"> & {
+  /**
+   * The plugin's name.
+   *
+   * @generated from field: string name = 1;
+   */
+  name: string;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DeletePluginRequest.
+ * Use `create(DeletePluginRequestSchema)` to create a new message.
+ */
+export const DeletePluginRequestSchema: GenMessage<DeletePluginRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 226);
+
+/**
+ * @generated from message pb.clientrpc.v1.DeletePluginResponse
+ */
+export type DeletePluginResponse = Message<"pb.clientrpc.v1.DeletePluginResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.DeletePluginResponse.
+ * Use `create(DeletePluginResponseSchema)` to create a new message.
+ */
+export const DeletePluginResponseSchema: GenMessage<DeletePluginResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 227);
+
+/**
+ * @generated from message pb.clientrpc.v1.StreamPluginEventsRequest
+ */
+export type StreamPluginEventsRequest = Message<"pb.clientrpc.v1.StreamPluginEventsRequest"> & {
+  /**
+   * The types of events to receive.
+   * The plugin must have the scope each type requires.
+   * If empty, all types the plugin's scopes allow are received.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.PluginEventType types = 1;
+   */
+  types: PluginEventType[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.StreamPluginEventsRequest.
+ * Use `create(StreamPluginEventsRequestSchema)` to create a new message.
+ */
+export const StreamPluginEventsRequestSchema: GenMessage<StreamPluginEventsRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 228);
+
+/**
+ * @generated from message pb.clientrpc.v1.StreamPluginEventsResponse
+ */
+export type StreamPluginEventsResponse = Message<"pb.clientrpc.v1.StreamPluginEventsResponse"> & {
+  /**
+   * The event.
+   *
+   * @generated from field: pb.clientrpc.v1.PluginEvent event = 1;
+   */
+  event?: PluginEvent;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.StreamPluginEventsResponse.
+ * Use `create(StreamPluginEventsResponseSchema)` to create a new message.
+ */
+export const StreamPluginEventsResponseSchema: GenMessage<StreamPluginEventsResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 229);
+
+/**
+ * @generated from message pb.clientrpc.v1.RespondToSearchRequest
+ */
+export type RespondToSearchRequest = Message<"pb.clientrpc.v1.RespondToSearchRequest"> & {
+  /**
+   * The search's ID.
+   *
+   * @generated from field: string search_id = 1;
+   */
+  searchId: string;
+
+  /**
+   * The results to add to the search.
+   * Results past the search's max_results are ignored.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.PluginSearchResult results = 2;
+   */
+  results: PluginSearchResult[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.RespondToSearchRequest.
+ * Use `create(RespondToSearchRequestSchema)` to create a new message.
+ */
+export const RespondToSearchRequestSchema: GenMessage<RespondToSearchRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 230);
+
+/**
+ * @generated from message pb.clientrpc.v1.RespondToSearchResponse
+ */
+export type RespondToSearchResponse = Message<"pb.clientrpc.v1.RespondToSearchResponse"> & {
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.RespondToSearchResponse.
+ * Use `create(RespondToSearchResponseSchema)` to create a new message.
+ */
+export const RespondToSearchResponseSchema: GenMessage<RespondToSearchResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 231);
+
+/**
+ * BridgeRequest is the first message a browser sends on a bridge stream.
+ * Bridge messages are length-delimited with a varint prefix, like protodelim in Go or sizeDelimitedEncode in
+ * protobuf-es.
+ *
+ * @generated from message pb.clientrpc.v1.BridgeRequest
+ */
+export type BridgeRequest = Message<"pb.clientrpc.v1.BridgeRequest"> & {
+  /**
+   * The kind of request.
+   *
+   * @generated from field: pb.clientrpc.v1.BridgeRequestType type = 1;
+   */
+  type: BridgeRequestType;
+
+  /**
+   * The UUID of the server the peer is on.
+   *
+   * @generated from field: string server_uuid = 2;
+   */
+  serverUuid: string;
+
+  /**
+   * The peer's username.
+   *
+   * @generated from field: string username = 3;
+   */
+  username: string;
+
+  /**
+   * The path of the file or folder.
+   *
+   * @generated from field: string path = 4;
+   */
+  path: string;
+
+  /**
+   * For GET_FILE, the offset to start reading the file at.
+   *
+   * @generated from field: uint64 offset = 5;
+   */
+  offset: bigint;
+
+  /**
+   * For GET_FILE, the maximum number of bytes to read, or 0 to read until the end of the file.
+   *
+   * @generated from field: uint64 limit = 6;
+   */
+  limit: bigint;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.BridgeRequest.
+ * Use `create(BridgeRequestSchema)` to create a new message.
+ */
+export const BridgeRequestSchema: GenMessage<BridgeRequest> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 232);
+
+/**
+ * BridgeError is an error that a bridge request failed with.
+ *
+ * @generated from message pb.clientrpc.v1.BridgeError
+ */
+export type BridgeError = Message<"pb.clientrpc.v1.BridgeError"> & {
+  /**
+   * The Connect error code the equivalent RPC would have failed with, such as "not_found".
+   *
+   * @generated from field: string code = 1;
+   */
+  code: string;
+
+  /**
+   * A human-readable description of the error.
+   *
+   * @generated from field: string message = 2;
+   */
+  message: string;
+
+  /**
+   * Details about the error, if it has a known cause.
+   *
+   * @generated from field: optional pb.clientrpc.v1.ErrorInfo info = 3;
+   */
+  info?: ErrorInfo;
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.BridgeError.
+ * Use `create(BridgeErrorSchema)` to create a new message.
+ */
+export const BridgeErrorSchema: GenMessage<BridgeError> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 233);
+
+/**
+ * BridgeResponse is sent by the client in answer to a BridgeRequest.
+ *
+ * @generated from message pb.clientrpc.v1.BridgeResponse
+ */
+export type BridgeResponse = Message<"pb.clientrpc.v1.BridgeResponse"> & {
+  /**
+   * Set if the request failed. No more messages are sent on the stream afterward.
+   *
+   * @generated from field: optional pb.clientrpc.v1.BridgeError error = 1;
+   */
+  error?: BridgeError;
+
+  /**
+   * For GET_FILE_META and GET_FILE, the metadata of the file.
+   *
+   * @generated from field: optional pb.clientrpc.v1.FileMeta meta = 2;
+   */
+  meta?: FileMeta;
+
+  /**
+   * For GET_DIR_FILES, a batch of the folder's contents.
+   *
+   * @generated from field: repeated pb.clientrpc.v1.FileMeta files = 3;
+   */
+  files: FileMeta[];
+};
+
+/**
+ * Describes the message pb.clientrpc.v1.BridgeResponse.
+ * Use `create(BridgeResponseSchema)` to create a new message.
+ */
+export const BridgeResponseSchema: GenMessage<BridgeResponse> = /*@__PURE__*/
+  messageDesc(file_pb_clientrpc_v1_rpc, 234);
+
+/**
+ * DownloadStatus is the status of a file download.
+ *
+ * @generated from enum pb.clientrpc.v1.DownloadStatus
+ */
+export enum DownloadStatus {
+  /**
+   * Do not use.
+   *
+   * @generated from enum value: DOWNLOAD_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Queued.
+   *
+   * @generated from enum value: DOWNLOAD_STATUS_QUEUED = 1;
+   */
+  QUEUED = 1,
+
+  /**
+   * Pending.
+   *
+   * @generated from enum value: DOWNLOAD_STATUS_PENDING = 2;
+   */
+  PENDING = 2,
+
+  /**
+   * Canceled.
+   *
+   * @generated from enum value: DOWNLOAD_STATUS_CANCELED = 3;
+   */
+  CANCELED = 3,
+
+  /**
+   * Done.
+   *
+   * @generated from enum value: DOWNLOAD_STATUS_DONE = 4;
+   */
+  DONE = 4,
+
+  /**
+   * Failed to download due to an error.
+   *
+   * @generated from enum value: DOWNLOAD_STATUS_ERROR = 5;
+   */
+  ERROR = 5,
+
+  /**
+   * Paused by the user.
+   *
+   * @generated from enum value: DOWNLOAD_STATUS_PAUSED = 6;
+   */
+  PAUSED = 6,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.DownloadStatus.
+ */
+export const DownloadStatusSchema: GenEnum<DownloadStatus> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 0);
+
+/**
+ * ScanStatus is the result of scanning a completed download with the configured scan command.
+ *
+ * @generated from enum pb.clientrpc.v1.ScanStatus
+ */
+export enum ScanStatus {
+  /**
+   * The download was not scanned, either because it is not complete or because no scan command is configured.
+   *
+   * @generated from enum value: SCAN_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The scan command found nothing.
+   *
+   * @generated from enum value: SCAN_STATUS_CLEAN = 1;
+   */
+  CLEAN = 1,
+
+  /**
+   * The scan command found a threat, and the file was moved to the quarantine directory.
+   *
+   * @generated from enum value: SCAN_STATUS_INFECTED = 2;
+   */
+  INFECTED = 2,
+
+  /**
+   * The scan command failed to scan the file.
+   * The file is kept as a partial download, so that it is scanned again when the download is retried.
+   *
+   * @generated from enum value: SCAN_STATUS_FAILED = 3;
+   */
+  FAILED = 3,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.ScanStatus.
+ */
+export const ScanStatusSchema: GenEnum<ScanStatus> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 1);
+
+/**
+ * UploadStatus is the status of a file upload to a peer.
+ *
+ * @generated from enum pb.clientrpc.v1.UploadStatus
+ */
+export enum UploadStatus {
+  /**
+   * Do not use.
+   *
+   * @generated from enum value: UPLOAD_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * In progress.
+   *
+   * @generated from enum value: UPLOAD_STATUS_IN_PROGRESS = 1;
+   */
+  IN_PROGRESS = 1,
+
+  /**
+   * All requested bytes were sent.
+   *
+   * @generated from enum value: UPLOAD_STATUS_DONE = 2;
+   */
+  DONE = 2,
+
+  /**
+   * The peer stopped the transfer, or the connection closed.
+   *
+   * @generated from enum value: UPLOAD_STATUS_CANCELED = 3;
+   */
+  CANCELED = 3,
+
+  /**
+   * Failed due to an error.
+   *
+   * @generated from enum value: UPLOAD_STATUS_ERROR = 4;
+   */
+  ERROR = 4,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.UploadStatus.
+ */
+export const UploadStatusSchema: GenEnum<UploadStatus> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 2);
+
+/**
+ * ArchiveFormat is an archive format that a directory can be streamed as.
+ *
+ * @generated from enum pb.clientrpc.v1.ArchiveFormat
+ */
+export enum ArchiveFormat {
+  /**
+   * Do not use.
+   *
+   * @generated from enum value: ARCHIVE_FORMAT_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * A zip archive.
+   *
+   * @generated from enum value: ARCHIVE_FORMAT_ZIP = 1;
+   */
+  ZIP = 1,
+
+  /**
+   * A gzip-compressed tar archive.
+   *
+   * @generated from enum value: ARCHIVE_FORMAT_TAR_GZ = 2;
+   */
+  TAR_GZ = 2,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.ArchiveFormat.
+ */
+export const ArchiveFormatSchema: GenEnum<ArchiveFormat> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 3);
+
+/**
+ * PeerPath is the path that traffic to a peer takes.
+ *
+ * @generated from enum pb.clientrpc.v1.PeerPath
+ */
+export enum PeerPath {
+  /**
+   * Use whichever path normal traffic to the peer would use.
+   *
+   * @generated from enum value: PEER_PATH_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Traffic is proxied through the server.
+   *
+   * @generated from enum value: PEER_PATH_PROXY = 1;
+   */
+  PROXY = 1,
+
+  /**
+   * Traffic goes over a direct connection to the peer.
+   *
+   * @generated from enum value: PEER_PATH_DIRECT = 2;
+   */
+  DIRECT = 2,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.PeerPath.
+ */
+export const PeerPathSchema: GenEnum<PeerPath> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 4);
+
+/**
+ * DownloadHookType is the type of a download hook.
+ *
+ * @generated from enum pb.clientrpc.v1.DownloadHookType
+ */
+export enum DownloadHookType {
+  /**
+   * Do not use.
+   *
+   * @generated from enum value: DOWNLOAD_HOOK_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Runs an executable with the completed file's path as its only argument.
+   * The executable does not inherit the client's environment; it only receives a minimal set of variables and
+   * FRIENDNET_-prefixed variables describing the download.
+   *
+   * @generated from enum value: DOWNLOAD_HOOK_TYPE_COMMAND = 1;
+   */
+  COMMAND = 1,
+
+  /**
+   * Sends an HTTP POST request with a JSON body describing the download.
+   *
+   * @generated from enum value: DOWNLOAD_HOOK_TYPE_WEBHOOK = 2;
+   */
+  WEBHOOK = 2,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.DownloadHookType.
+ */
+export const DownloadHookTypeSchema: GenEnum<DownloadHookType> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 5);
+
+/**
+ * UpdateChannel is a release channel that the client checks for updates on.
+ *
+ * @generated from enum pb.clientrpc.v1.UpdateChannel
+ */
+export enum UpdateChannel {
+  /**
+   * Same as UPDATE_CHANNEL_STABLE.
+   *
+   * @generated from enum value: UPDATE_CHANNEL_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Regular releases.
+   *
+   * @generated from enum value: UPDATE_CHANNEL_STABLE = 1;
+   */
+  STABLE = 1,
+
+  /**
+   * Pre-releases, which get new features first but may be less reliable.
+   *
+   * @generated from enum value: UPDATE_CHANNEL_BETA = 2;
+   */
+  BETA = 2,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.UpdateChannel.
+ */
+export const UpdateChannelSchema: GenEnum<UpdateChannel> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 6);
+
+/**
+ * ErrorReason is the known cause of an RPC error.
+ *
+ * @generated from enum pb.clientrpc.v1.ErrorReason
+ */
+export enum ErrorReason {
+  /**
+   * Do not use.
+   *
+   * @generated from enum value: ERROR_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The server rejected the credentials.
+   *
+   * @generated from enum value: ERROR_REASON_AUTH_INVALID_CREDENTIALS = 1;
+   */
+  AUTH_INVALID_CREDENTIALS = 1,
+
+  /**
+   * The account is banned.
+   *
+   * @generated from enum value: ERROR_REASON_AUTH_BANNED = 2;
+   */
+  AUTH_BANNED = 2,
+
+  /**
+   * The account is already connected to the room.
+   *
+   * @generated from enum value: ERROR_REASON_AUTH_ALREADY_CONNECTED = 3;
+   */
+  AUTH_ALREADY_CONNECTED = 3,
+
+  /**
+   * Too many authentication attempts were made.
+   *
+   * @generated from enum value: ERROR_REASON_AUTH_RATE_LIMITED = 4;
+   */
+  AUTH_RATE_LIMITED = 4,
+
+  /**
+   * The server does not allow registration.
+   *
+   * @generated from enum value: ERROR_REASON_AUTH_REGISTRATION_DISABLED = 5;
+   */
+  AUTH_REGISTRATION_DISABLED = 5,
+
+  /**
+   * The invite code is invalid or was used up.
+   *
+   * @generated from enum value: ERROR_REASON_AUTH_INVALID_INVITE_CODE = 6;
+   */
+  AUTH_INVALID_INVITE_CODE = 6,
+
+  /**
+   * An account with the username already exists.
+   *
+   * @generated from enum value: ERROR_REASON_AUTH_USERNAME_TAKEN = 7;
+   */
+  AUTH_USERNAME_TAKEN = 7,
+
+  /**
+   * The password does not meet the server's requirements.
+   *
+   * @generated from enum value: ERROR_REASON_AUTH_INVALID_PASSWORD = 8;
+   */
+  AUTH_INVALID_PASSWORD = 8,
+
+  /**
+   * The server rejected authentication for another reason.
+   *
+   * @generated from enum value: ERROR_REASON_AUTH_REJECTED = 9;
+   */
+  AUTH_REJECTED = 9,
+
+  /**
+   * The server does not support the client's protocol version because the client is too old.
+   *
+   * @generated from enum value: ERROR_REASON_VERSION_TOO_OLD = 10;
+   */
+  VERSION_TOO_OLD = 10,
+
+  /**
+   * The server does not support the client's protocol version because the client is too new.
+   *
+   * @generated from enum value: ERROR_REASON_VERSION_TOO_NEW = 11;
+   */
+  VERSION_TOO_NEW = 11,
+
+  /**
+   * The server rejected the client's protocol version for another reason.
+   *
+   * @generated from enum value: ERROR_REASON_VERSION_REJECTED = 12;
+   */
+  VERSION_REJECTED = 12,
+
+  /**
+   * The server's certificate is different from the one seen on earlier connections.
+   * The server may have been reinstalled, or the connection may be intercepted.
+   *
+   * @generated from enum value: ERROR_REASON_CERT_MISMATCH = 13;
+   */
+  CERT_MISMATCH = 13,
+
+  /**
+   * The server's certificate does not match the fingerprint in the invite.
+   *
+   * @generated from enum value: ERROR_REASON_CERT_FINGERPRINT_MISMATCH = 14;
+   */
+  CERT_FINGERPRINT_MISMATCH = 14,
+
+  /**
+   * The server's certificate is expired or not valid yet.
+   *
+   * @generated from enum value: ERROR_REASON_CERT_NOT_VALID_NOW = 15;
+   */
+  CERT_NOT_VALID_NOW = 15,
+
+  /**
+   * The server did not present a certificate.
+   *
+   * @generated from enum value: ERROR_REASON_NO_SERVER_CERTS = 16;
+   */
+  NO_SERVER_CERTS = 16,
+
+  /**
+   * The peer could not be reached through the server.
+   *
+   * @generated from enum value: ERROR_REASON_PEER_UNREACHABLE = 17;
+   */
+  PEER_UNREACHABLE = 17,
+
+  /**
+   * The peer did not reply to the request in time, even after retrying.
+   *
+   * @generated from enum value: ERROR_REASON_PEER_TIMEOUT = 18;
+   */
+  PEER_TIMEOUT = 18,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.ErrorReason.
+ */
+export const ErrorReasonSchema: GenEnum<ErrorReason> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 7);
+
+/**
+ * ServerConnState is possible connection states for a server.
+ *
+ * @generated from enum pb.clientrpc.v1.ServerConnState
+ */
+export enum ServerConnState {
+  /**
+   * Do not use.
+   *
+   * @generated from enum value: SERVER_CONN_STATE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Closed.
+   *
+   * @generated from enum value: SERVER_CONN_STATE_CLOSED = 1;
+   */
+  CLOSED = 1,
+
+  /**
+   * Opening.
+   *
+   * @generated from enum value: SERVER_CONN_STATE_OPENING = 2;
+   */
+  OPENING = 2,
+
+  /**
+   * Open.
+   *
+   * @generated from enum value: SERVER_CONN_STATE_OPEN = 3;
+   */
+  OPEN = 3,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.ServerConnState.
+ */
+export const ServerConnStateSchema: GenEnum<ServerConnState> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 8);
+
+/**
+ * ShareUnicodeForm is a Unicode normalization form that paths requested by peers are matched in.
+ * Peers on macOS typically request names in NFD, while peers on Windows and Linux typically request names in NFC, so
+ * matching in either form lets files be found no matter which form their names are stored in.
+ *
+ * @generated from enum pb.clientrpc.v1.ShareUnicodeForm
+ */
+export enum ShareUnicodeForm {
+  /**
+   * Same as SHARE_UNICODE_FORM_NONE.
+   *
+   * @generated from enum value: SHARE_UNICODE_FORM_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Match paths without normalizing them.
+   *
+   * @generated from enum value: SHARE_UNICODE_FORM_NONE = 1;
+   */
+  NONE = 1,
+
+  /**
+   * Match paths in Normalization Form C (composed).
+   *
+   * @generated from enum value: SHARE_UNICODE_FORM_NFC = 2;
+   */
+  NFC = 2,
+
+  /**
+   * Match paths in Normalization Form D (decomposed).
+   *
+   * @generated from enum value: SHARE_UNICODE_FORM_NFD = 3;
+   */
+  NFD = 3,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.ShareUnicodeForm.
+ */
+export const ShareUnicodeFormSchema: GenEnum<ShareUnicodeForm> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 9);
+
+/**
+ * ShareConflictRule decides which file is served when more than one of a share's mounted directories has a file at the
+ * same path.
+ * Directories never conflict: directories at the same path are merged, and a directory always wins over a file.
+ *
+ * @generated from enum pb.clientrpc.v1.ShareConflictRule
+ */
+export enum ShareConflictRule {
+  /**
+   * Same as SHARE_CONFLICT_RULE_FIRST.
+   *
+   * @generated from enum value: SHARE_CONFLICT_RULE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Serve the file from the directory that comes first, starting with the share's own path.
+   *
+   * @generated from enum value: SHARE_CONFLICT_RULE_FIRST = 1;
+   */
+  FIRST = 1,
+
+  /**
+   * Serve the file that was modified most recently.
+   * Ties are broken by the order of the directories.
+   *
+   * @generated from enum value: SHARE_CONFLICT_RULE_NEWEST = 2;
+   */
+  NEWEST = 2,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.ShareConflictRule.
+ */
+export const ShareConflictRuleSchema: GenEnum<ShareConflictRule> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 10);
+
+/**
+ * TrustLevel is how much the local user trusts a peer.
+ *
+ * @generated from enum pb.clientrpc.v1.TrustLevel
+ */
+export enum TrustLevel {
+  /**
+   * No trust level was set.
+   *
+   * @generated from enum value: TRUST_LEVEL_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The peer is not trusted.
+   *
+   * @generated from enum value: TRUST_LEVEL_DISTRUSTED = 1;
+   */
+  DISTRUSTED = 1,
+
+  /**
+   * The peer is trusted.
+   *
+   * @generated from enum value: TRUST_LEVEL_TRUSTED = 2;
+   */
+  TRUSTED = 2,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.TrustLevel.
+ */
+export const TrustLevelSchema: GenEnum<TrustLevel> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 11);
+
+/**
+ * ShareHealthIssueKind is a kind of problem with an entry in a share.
+ *
+ * @generated from enum pb.clientrpc.v1.ShareHealthIssueKind
+ */
+export enum ShareHealthIssueKind {
+  /**
+   * @generated from enum value: SHARE_HEALTH_ISSUE_KIND_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The entry's name is not valid UTF-8. It is not shared.
+   *
+   * @generated from enum value: SHARE_HEALTH_ISSUE_KIND_INVALID_NAME = 1;
+   */
+  INVALID_NAME = 1,
+
+  /**
+   * The entry could not be read, such as because of missing permissions. It is not shared.
+   *
+   * @generated from enum value: SHARE_HEALTH_ISSUE_KIND_UNREADABLE = 2;
+   */
+  UNREADABLE = 2,
+
+  /**
+   * The entry is a symbolic link whose target does not exist. It is not shared.
+   *
+   * @generated from enum value: SHARE_HEALTH_ISSUE_KIND_BROKEN_LINK = 3;
+   */
+  BROKEN_LINK = 3,
+
+  /**
+   * The entry's name is reserved on Windows or has characters Windows does not allow, such as "CON" or "a:b".
+   * It is shared, but peers on Windows save it under a different name.
+   *
+   * @generated from enum value: SHARE_HEALTH_ISSUE_KIND_WINDOWS_NAME = 4;
+   */
+  WINDOWS_NAME = 4,
+
+  /**
+   * The entry's name is longer than most filesystems can store. It is shared, but peers may not be able to save it.
+   *
+   * @generated from enum value: SHARE_HEALTH_ISSUE_KIND_NAME_TOO_LONG = 5;
+   */
+  NAME_TOO_LONG = 5,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.ShareHealthIssueKind.
+ */
+export const ShareHealthIssueKindSchema: GenEnum<ShareHealthIssueKind> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 12);
+
+/**
+ * DiagnosticStep is a step of connecting to a server that Diagnose checks.
+ *
+ * @generated from enum pb.clientrpc.v1.DiagnosticStep
+ */
+export enum DiagnosticStep {
+  /**
+   * Do not use.
+   *
+   * @generated from enum value: DIAGNOSTIC_STEP_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Resolving the server address to IP addresses, including SRV and well-known lookups.
+   *
+   * @generated from enum value: DIAGNOSTIC_STEP_RESOLVE = 1;
+   */
+  RESOLVE = 1,
+
+  /**
+   * Sending a UDP packet to the server to check whether its host rejects it.
+   *
+   * @generated from enum value: DIAGNOSTIC_STEP_UDP_PROBE = 2;
+   */
+  UDP_PROBE = 2,
+
+  /**
+   * Connecting to the server over QUIC and verifying its certificate.
+   *
+   * @generated from enum value: DIAGNOSTIC_STEP_QUIC_HANDSHAKE = 3;
+   */
+  QUIC_HANDSHAKE = 3,
+
+  /**
+   * Negotiating the protocol version.
+   *
+   * @generated from enum value: DIAGNOSTIC_STEP_VERSION_NEGOTIATION = 4;
+   */
+  VERSION_NEGOTIATION = 4,
+
+  /**
+   * Authenticating with the server's credentials.
+   *
+   * @generated from enum value: DIAGNOSTIC_STEP_AUTHENTICATION = 5;
+   */
+  AUTHENTICATION = 5,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.DiagnosticStep.
+ */
+export const DiagnosticStepSchema: GenEnum<DiagnosticStep> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 13);
+
+/**
+ * DiagnosticStatus is the outcome of a diagnostic step.
+ *
+ * @generated from enum pb.clientrpc.v1.DiagnosticStatus
+ */
+export enum DiagnosticStatus {
+  /**
+   * Do not use.
+   *
+   * @generated from enum value: DIAGNOSTIC_STATUS_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The step succeeded.
+   *
+   * @generated from enum value: DIAGNOSTIC_STATUS_OK = 1;
+   */
+  OK = 1,
+
+  /**
+   * The step failed.
+   *
+   * @generated from enum value: DIAGNOSTIC_STATUS_FAILED = 2;
+   */
+  FAILED = 2,
+
   /**
-   * The item's UUID.
+   * The step could not tell whether there is a problem.
+   * For example, servers do not reply to UDP probes, so a probe that was not rejected may still have been dropped.
    *
-   * @generated from field: string uuid = 1;
+   * @generated from enum value: DIAGNOSTIC_STATUS_INCONCLUSIVE = 3;
    */
-  uuid: string;
-};
+  INCONCLUSIVE = 3,
 
-/**
- * Describes the message pb.clientrpc.v1.RemoveDownloadManagerItemRequest.
- * Use `create(RemoveDownloadManagerItemRequestSchema)` to create a new message.
- */
-export const RemoveDownloadManagerItemRequestSchema: GenMessage<RemoveDownloadManagerItemRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 73);
+  /**
+   * The step was not run because an earlier step failed.
+   *
+   * @generated from enum value: DIAGNOSTIC_STATUS_SKIPPED = 4;
+   */
+  SKIPPED = 4,
+}
 
 /**
- * @generated from message pb.clientrpc.v1.RemoveDownloadManagerItemResponse
+ * Describes the enum pb.clientrpc.v1.DiagnosticStatus.
  */
-export type RemoveDownloadManagerItemResponse = Message<"pb.clientrpc.v1.RemoveDownloadManagerItemResponse"> & {
-};
+export const DiagnosticStatusSchema: GenEnum<DiagnosticStatus> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 14);
 
 /**
- * Describes the message pb.clientrpc.v1.RemoveDownloadManagerItemResponse.
- * Use `create(RemoveDownloadManagerItemResponseSchema)` to create a new message.
+ * What to do when queueing a download for a file that was already downloaded.
+ * Files are matched by their SHA-256 hash, so this only works with peers that can provide hashes.
+ *
+ * @generated from enum pb.clientrpc.v1.DuplicateAction
  */
-export const RemoveDownloadManagerItemResponseSchema: GenMessage<RemoveDownloadManagerItemResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 74);
+export enum DuplicateAction {
+  /**
+   * Do not queue the download, and return the duplicate so that the user can choose what to do.
+   *
+   * @generated from enum value: DUPLICATE_ACTION_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
 
-/**
- * @generated from message pb.clientrpc.v1.ResumeFileDownloadRequest
- */
-export type ResumeFileDownloadRequest = Message<"pb.clientrpc.v1.ResumeFileDownloadRequest"> & {
   /**
-   * The item's UUID.
+   * Download the file again without checking for duplicates.
    *
-   * @generated from field: string uuid = 1;
+   * @generated from enum value: DUPLICATE_ACTION_DOWNLOAD = 1;
    */
-  uuid: string;
-};
+  DOWNLOAD = 1,
 
-/**
- * Describes the message pb.clientrpc.v1.ResumeFileDownloadRequest.
- * Use `create(ResumeFileDownloadRequestSchema)` to create a new message.
- */
-export const ResumeFileDownloadRequestSchema: GenMessage<ResumeFileDownloadRequest> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 75);
+  /**
+   * Hard link the existing file to where the download would be saved instead of downloading it.
+   * Only works if both are on the same filesystem.
+   *
+   * @generated from enum value: DUPLICATE_ACTION_HARD_LINK = 2;
+   */
+  HARD_LINK = 2,
 
-/**
- * @generated from message pb.clientrpc.v1.ResumeFileDownloadResponse
- */
-export type ResumeFileDownloadResponse = Message<"pb.clientrpc.v1.ResumeFileDownloadResponse"> & {
-};
+  /**
+   * Copy the existing file to where the download would be saved instead of downloading it.
+   *
+   * @generated from enum value: DUPLICATE_ACTION_COPY = 3;
+   */
+  COPY = 3,
+}
 
 /**
- * Describes the message pb.clientrpc.v1.ResumeFileDownloadResponse.
- * Use `create(ResumeFileDownloadResponseSchema)` to create a new message.
+ * Describes the enum pb.clientrpc.v1.DuplicateAction.
  */
-export const ResumeFileDownloadResponseSchema: GenMessage<ResumeFileDownloadResponse> = /*@__PURE__*/
-  messageDesc(file_pb_clientrpc_v1_rpc, 76);
+export const DuplicateActionSchema: GenEnum<DuplicateAction> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 15);
 
 /**
- * DownloadStatus is the status of a file download.
+ * PluginScope is a permission that can be granted to a plugin.
+ * Plugins authenticate with their own token and can only call the methods their scopes allow.
  *
- * @generated from enum pb.clientrpc.v1.DownloadStatus
+ * @generated from enum pb.clientrpc.v1.PluginScope
  */
-export enum DownloadStatus {
+export enum PluginScope {
   /**
    * Do not use.
    *
-   * @generated from enum value: DOWNLOAD_STATUS_UNSPECIFIED = 0;
+   * @generated from enum value: PLUGIN_SCOPE_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * Queued.
+   * Receive client events, such as downloads progressing and peers going online.
+   * Allows StreamEvents, and PLUGIN_EVENT_TYPE_CLIENT_EVENT events on StreamPluginEvents.
    *
-   * @generated from enum value: DOWNLOAD_STATUS_QUEUED = 1;
+   * @generated from enum value: PLUGIN_SCOPE_EVENTS = 1;
    */
-  QUEUED = 1,
+  EVENTS = 1,
 
   /**
-   * Pending.
+   * Receive searches made by peers, and answer them with results of the plugin's own.
+   * Allows RespondToSearch, and PLUGIN_EVENT_TYPE_SEARCH events on StreamPluginEvents.
    *
-   * @generated from enum value: DOWNLOAD_STATUS_PENDING = 2;
+   * @generated from enum value: PLUGIN_SCOPE_SEARCH = 2;
    */
-  PENDING = 2,
+  SEARCH = 2,
 
   /**
-   * Canceled.
+   * Read servers, shares, online users and peers' files, and search rooms.
+   * Allows GetClientInfo, GetServers, GetShares, GetOnlineUsers, GetDirFiles, GetFileMeta, StreamSearch,
+   * GetDownloadManagerItems, GetUploads and GetFriends.
    *
-   * @generated from enum value: DOWNLOAD_STATUS_CANCELED = 3;
+   * @generated from enum value: PLUGIN_SCOPE_READ = 3;
    */
-  CANCELED = 3,
+  READ = 3,
 
   /**
-   * Done.
+   * Queue and control downloads.
+   * Allows QueueFileDownload, CancelFileDownload, PauseFileDownload, ResumeFileDownload and
+   * RemoveDownloadManagerItem.
    *
-   * @generated from enum value: DOWNLOAD_STATUS_DONE = 4;
+   * @generated from enum value: PLUGIN_SCOPE_DOWNLOADS = 4;
    */
-  DONE = 4,
+  DOWNLOADS = 4,
 
   /**
-   * Failed to download due to an error.
+   * Create, index and delete shares, such as to share new folders automatically.
+   * Allows CreateShare, IndexShare, DeleteShare and ImportShares.
    *
-   * @generated from enum value: DOWNLOAD_STATUS_ERROR = 5;
+   * @generated from enum value: PLUGIN_SCOPE_SHARES = 5;
    */
-  ERROR = 5,
+  SHARES = 5,
 }
 
 /**
- * Describes the enum pb.clientrpc.v1.DownloadStatus.
+ * Describes the enum pb.clientrpc.v1.PluginScope.
  */
-export const DownloadStatusSchema: GenEnum<DownloadStatus> = /*@__PURE__*/
-  enumDesc(file_pb_clientrpc_v1_rpc, 0);
+export const PluginScopeSchema: GenEnum<PluginScope> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 16);
 
 /**
- * ServerConnState is possible connection states for a server.
+ * PluginEventType is a type of event sent to plugins.
  *
- * @generated from enum pb.clientrpc.v1.ServerConnState
+ * @generated from enum pb.clientrpc.v1.PluginEventType
  */
-export enum ServerConnState {
+export enum PluginEventType {
   /**
    * Do not use.
    *
-   * @generated from enum value: SERVER_CONN_STATE_UNSPECIFIED = 0;
+   * @generated from enum value: PLUGIN_EVENT_TYPE_UNSPECIFIED = 0;
    */
   UNSPECIFIED = 0,
 
   /**
-   * Closed.
+   * A client event, the same as sent by StreamEvents.
+   * Requires PLUGIN_SCOPE_EVENTS.
    *
-   * @generated from enum value: SERVER_CONN_STATE_CLOSED = 1;
+   * @generated from enum value: PLUGIN_EVENT_TYPE_CLIENT_EVENT = 1;
    */
-  CLOSED = 1,
+  CLIENT_EVENT = 1,
 
   /**
-   * Opening.
+   * A peer searched the client's shares.
+   * The plugin can add results with RespondToSearch before the search's deadline.
+   * Requires PLUGIN_SCOPE_SEARCH.
    *
-   * @generated from enum value: SERVER_CONN_STATE_OPENING = 2;
+   * @generated from enum value: PLUGIN_EVENT_TYPE_SEARCH = 2;
    */
-  OPENING = 2,
+  SEARCH = 2,
+}
+
+/**
+ * Describes the enum pb.clientrpc.v1.PluginEventType.
+ */
+export const PluginEventTypeSchema: GenEnum<PluginEventType> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 17);
+
+/**
+ * BridgeRequestType is the kind of request sent on a bridge stream.
+ *
+ * @generated from enum pb.clientrpc.v1.BridgeRequestType
+ */
+export enum BridgeRequestType {
+  /**
+   * Do not use.
+   *
+   * @generated from enum value: BRIDGE_REQUEST_TYPE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
 
   /**
-   * Open.
+   * Gets the metadata of a peer's file or folder.
+   * Answered with one BridgeResponse with meta set.
    *
-   * @generated from enum value: SERVER_CONN_STATE_OPEN = 3;
+   * @generated from enum value: BRIDGE_REQUEST_TYPE_GET_FILE_META = 1;
    */
-  OPEN = 3,
+  GET_FILE_META = 1,
+
+  /**
+   * Lists the contents of a peer's folder.
+   * Answered with one or more BridgeResponses with files set, then the stream is closed.
+   *
+   * @generated from enum value: BRIDGE_REQUEST_TYPE_GET_DIR_FILES = 2;
+   */
+  GET_DIR_FILES = 2,
+
+  /**
+   * Downloads a peer's file.
+   * Answered with one BridgeResponse with meta set, followed by the raw file content, then the stream is closed.
+   *
+   * @generated from enum value: BRIDGE_REQUEST_TYPE_GET_FILE = 3;
+   */
+  GET_FILE = 3,
 }
 
 /**
- * Describes the enum pb.clientrpc.v1.ServerConnState.
+ * Describes the enum pb.clientrpc.v1.BridgeRequestType.
  */
-export const ServerConnStateSchema: GenEnum<ServerConnState> = /*@__PURE__*/
-  enumDesc(file_pb_clientrpc_v1_rpc, 1);
+export const BridgeRequestTypeSchema: GenEnum<BridgeRequestType> = /*@__PURE__*/
+  enumDesc(file_pb_clientrpc_v1_rpc, 18);
 
 /**
  * ClientRpcService provides an RPC interface to a running FriendNet client.
@@ -2340,7 +8160,8 @@ export const ClientRpcService: GenService<{
     output: typeof StopResponseSchema;
   },
   /**
-   * GetClientInfo returns information about the FriendNet client.
+   * GetClientInfo returns information about the FriendNet client, such as its version and enabled features, so that
+   * UIs and CLIs can display it and only offer features the client supports.
    *
    * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetClientInfo
    */
@@ -2350,7 +8171,30 @@ export const ClientRpcService: GenService<{
     output: typeof GetClientInfoResponseSchema;
   },
   /**
-   * GetServers returns a list of all servers.
+   * GetMemoryUsage returns how much memory the client is using, and the limits of its memory budget.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetMemoryUsage
+   */
+  getMemoryUsage: {
+    methodKind: "unary";
+    input: typeof GetMemoryUsageRequestSchema;
+    output: typeof GetMemoryUsageResponseSchema;
+  },
+  /**
+   * SetLowMemoryMode sets whether the client runs in low-memory mode, which caps cache sizes, read-ahead, concurrent
+   * streams, downloads and buffers for devices with little memory, such as a Raspberry Pi or a NAS.
+   * Changes will not take effect until the client is restarted.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.SetLowMemoryMode
+   */
+  setLowMemoryMode: {
+    methodKind: "unary";
+    input: typeof SetLowMemoryModeRequestSchema;
+    output: typeof SetLowMemoryModeResponseSchema;
+  },
+  /**
+   * GetServers returns a page of servers.
+   * Use the returned cursor to get the following pages.
    *
    * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetServers
    */
@@ -2361,6 +8205,9 @@ export const ClientRpcService: GenService<{
   },
   /**
    * CreateServer creates a new server and automatically connects to it.
+   * If an account template is specified, its credentials are used and its shares are created on the server.
+   *
+   * Returns NOT_FOUND if the account template does not exist.
    *
    * @generated from rpc pb.clientrpc.v1.ClientRpcService.CreateServer
    */
@@ -2370,7 +8217,127 @@ export const ClientRpcService: GenService<{
     output: typeof CreateServerResponseSchema;
   },
   /**
-   * DeleteServer disconnects and deletes a server.
+   * ImportInviteBundle creates a new server from an invite bundle URL and automatically connects to it.
+   * If the bundle has a certificate fingerprint, the server's certificate is checked against it and trusted.
+   * If the bundle has an invite code, a new account is registered with it first.
+   * If an account template is specified, its credentials are used and its shares are created on the server.
+   *
+   * Returns INVALID_ARGUMENT if the URL is not a valid invite bundle URL.
+   * Returns NOT_FOUND if the account template does not exist.
+   * Returns FAILED_PRECONDITION if the server's certificate does not match the bundle's fingerprint.
+   * Returns PERMISSION_DENIED if the server rejected the registration.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.ImportInviteBundle
+   */
+  importInviteBundle: {
+    methodKind: "unary";
+    input: typeof ImportInviteBundleRequestSchema;
+    output: typeof ImportInviteBundleResponseSchema;
+  },
+  /**
+   * CreateAccountTemplate creates an account template, which holds a username, password and list of shares that can
+   * be reused when adding servers where the same account is used.
+   *
+   * Returns INVALID_ARGUMENT if the username or a share name is invalid.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.CreateAccountTemplate
+   */
+  createAccountTemplate: {
+    methodKind: "unary";
+    input: typeof CreateAccountTemplateRequestSchema;
+    output: typeof CreateAccountTemplateResponseSchema;
+  },
+  /**
+   * CreateAccountTemplateFromServer creates an account template with the credentials and shares of an existing server.
+   * Internal shares are not included.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.CreateAccountTemplateFromServer
+   */
+  createAccountTemplateFromServer: {
+    methodKind: "unary";
+    input: typeof CreateAccountTemplateFromServerRequestSchema;
+    output: typeof CreateAccountTemplateFromServerResponseSchema;
+  },
+  /**
+   * GetAccountTemplates returns all account templates.
+   * Passwords are not included.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetAccountTemplates
+   */
+  getAccountTemplates: {
+    methodKind: "unary";
+    input: typeof GetAccountTemplatesRequestSchema;
+    output: typeof GetAccountTemplatesResponseSchema;
+  },
+  /**
+   * DeleteAccountTemplate deletes an account template.
+   * Servers created from it are not affected.
+   *
+   * Returns NOT_FOUND if no such template exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.DeleteAccountTemplate
+   */
+  deleteAccountTemplate: {
+    methodKind: "unary";
+    input: typeof DeleteAccountTemplateRequestSchema;
+    output: typeof DeleteAccountTemplateResponseSchema;
+  },
+  /**
+   * StartHosting starts an embedded FriendNet server in the client and hosts a room on it, so that friends can join
+   * without anyone deploying a separate server. The client joins the room itself, and hosts it again whenever it
+   * restarts, until StopHosting is called.
+   *
+   * Returns INVALID_ARGUMENT if the room name, username or port is invalid.
+   * Returns ALREADY_EXISTS if the client is already hosting a room.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.StartHosting
+   */
+  startHosting: {
+    methodKind: "unary";
+    input: typeof StartHostingRequestSchema;
+    output: typeof StartHostingResponseSchema;
+  },
+  /**
+   * StopHosting stops the embedded server, disconnecting everyone in the hosted room.
+   * The room and its accounts are kept, so it can be hosted again later.
+   *
+   * Returns FAILED_PRECONDITION if the client is not hosting a room.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.StopHosting
+   */
+  stopHosting: {
+    methodKind: "unary";
+    input: typeof StopHostingRequestSchema;
+    output: typeof StopHostingResponseSchema;
+  },
+  /**
+   * GetHostingInfo returns the status of the room the client hosts on its embedded server.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetHostingInfo
+   */
+  getHostingInfo: {
+    methodKind: "unary";
+    input: typeof GetHostingInfoRequestSchema;
+    output: typeof GetHostingInfoResponseSchema;
+  },
+  /**
+   * CreateHostingInvite creates an invite link for the hosted room that friends can join with.
+   *
+   * Returns INVALID_ARGUMENT if the address is empty.
+   * Returns FAILED_PRECONDITION if the client is not hosting a room.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.CreateHostingInvite
+   */
+  createHostingInvite: {
+    methodKind: "unary";
+    input: typeof CreateHostingInviteRequestSchema;
+    output: typeof CreateHostingInviteResponseSchema;
+  },
+  /**
+   * DeleteServer disconnects a server and moves it to the trash, along with its shares.
+   * It can be restored with RestoreServer until it is purged.
    *
    * Returns NOT_FOUND if no such server exists.
    *
@@ -2423,7 +8390,8 @@ export const ClientRpcService: GenService<{
     output: typeof UpdateServerResponseSchema;
   },
   /**
-   * GetShares returns shares for a server.
+   * GetShares returns a page of shares for a server.
+   * Use the returned cursor to get the following pages.
    *
    * Returns NOT_FOUND if no such server exists.
    *
@@ -2436,9 +8404,11 @@ export const ClientRpcService: GenService<{
   },
   /**
    * CreateShare creates a new server share.
+   * A deleted share with the same name is purged.
    *
    * Returns NOT_FOUND if no such server exists.
-   * Returns INVALID_ARGUMENT if the share name is invalid.
+   * Returns INVALID_ARGUMENT if the share name, a mount, the conflict rule, an exclude pattern or the Unicode form is
+   * invalid.
    * Returns ALREADY_EXISTS if a share with the same name already exists.
    *
    * @generated from rpc pb.clientrpc.v1.ClientRpcService.CreateShare
@@ -2449,7 +8419,8 @@ export const ClientRpcService: GenService<{
     output: typeof CreateShareResponseSchema;
   },
   /**
-   * DeleteShare deletes an existing server share.
+   * DeleteShare stops sharing an existing server share and moves it to the trash.
+   * It can be restored with RestoreShare until it is purged.
    *
    * Returns NOT_FOUND if no such server exists.
    * Returns NOT_FOUND if no such share exists.
@@ -2461,6 +8432,93 @@ export const ClientRpcService: GenService<{
     input: typeof DeleteShareRequestSchema;
     output: typeof DeleteShareResponseSchema;
   },
+  /**
+   * SetShareExcludePatterns replaces the exclude patterns of a share.
+   * The share's search index is rebuilt in the background.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   * Returns NOT_FOUND if no such share exists.
+   * Returns INVALID_ARGUMENT if a pattern is invalid.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.SetShareExcludePatterns
+   */
+  setShareExcludePatterns: {
+    methodKind: "unary";
+    input: typeof SetShareExcludePatternsRequestSchema;
+    output: typeof SetShareExcludePatternsResponseSchema;
+  },
+  /**
+   * CheckShareHealth scans a share for entries that cannot be shared, such as names that are not valid UTF-8 or
+   * directories that cannot be read, and entries that peers may have trouble saving, such as names that are reserved
+   * on Windows.
+   * Entries that cannot be shared are left out of listings, so this is how to find them.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   * Returns NOT_FOUND if no such share exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.CheckShareHealth
+   */
+  checkShareHealth: {
+    methodKind: "unary";
+    input: typeof CheckShareHealthRequestSchema;
+    output: typeof CheckShareHealthResponseSchema;
+  },
+  /**
+   * ImportShares creates many shares at once, such as when migrating a large list of shared folders from another
+   * program.
+   * All entries are validated before any share is created. If any entry is invalid or a share cannot be created, no
+   * shares are created, and the results describe the problem with each entry.
+   * Shares in the trash with the same names as imported shares are purged.
+   *
+   * Returns INVALID_ARGUMENT if neither or both of entries and manifest_path are set, the manifest cannot be read or
+   * parsed, or there are more than 10000 entries.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.ImportShares
+   */
+  importShares: {
+    methodKind: "unary";
+    input: typeof ImportSharesRequestSchema;
+    output: typeof ImportSharesResponseSchema;
+  },
+  /**
+   * CreateShareLink creates a link that gives read-only access to a path in a share through the public HTTPS
+   * gateway.
+   * Links can be created while the gateway is disabled, but only work once it is enabled.
+   *
+   * Returns NOT_FOUND if no such server, share or path exists.
+   * Returns INVALID_ARGUMENT if the path is invalid.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.CreateShareLink
+   */
+  createShareLink: {
+    methodKind: "unary";
+    input: typeof CreateShareLinkRequestSchema;
+    output: typeof CreateShareLinkResponseSchema;
+  },
+  /**
+   * GetShareLinks returns the links to a share.
+   *
+   * Returns NOT_FOUND if no such server or share exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetShareLinks
+   */
+  getShareLinks: {
+    methodKind: "unary";
+    input: typeof GetShareLinksRequestSchema;
+    output: typeof GetShareLinksResponseSchema;
+  },
+  /**
+   * DeleteShareLink deletes a share link, so that it stops working immediately.
+   *
+   * Returns NOT_FOUND if no such link exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.DeleteShareLink
+   */
+  deleteShareLink: {
+    methodKind: "unary";
+    input: typeof DeleteShareLinkRequestSchema;
+    output: typeof DeleteShareLinkResponseSchema;
+  },
   /**
    * GetDirFiles requests the files within a directory shared by an online user.
    * Each message will contain files within the path.
@@ -2478,18 +8536,85 @@ export const ClientRpcService: GenService<{
     output: typeof GetDirFilesResponseSchema;
   },
   /**
-   * GetFileMeta returns metadata about a path shared by an online user.
+   * StreamDirArchive streams a directory shared by an online user as an archive.
+   * The archive is built on the fly, so its size is not known in advance.
+   * Concatenating the data of all messages produces the archive.
+   *
+   * Returns INVALID_ARGUMENT if the format is unspecified.
+   * Returns INVALID_ARGUMENT if the path is not a directory.
+   * Returns NOT_FOUND if no such server exists.
+   * Returns NOT_FOUND if no such path exists.
+   * Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.StreamDirArchive
+   */
+  streamDirArchive: {
+    methodKind: "server_streaming";
+    input: typeof StreamDirArchiveRequestSchema;
+    output: typeof StreamDirArchiveResponseSchema;
+  },
+  /**
+   * GetFileMeta returns metadata about a path shared by an online user.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   * Returns NOT_FOUND if no such path exists.
+   * Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetFileMeta
+   */
+  getFileMeta: {
+    methodKind: "unary";
+    input: typeof GetFileMetaRequestSchema;
+    output: typeof GetFileMetaResponseSchema;
+  },
+  /**
+   * CreateFileLink creates a link that downloads a file through the file server without the bearer token, so it can
+   * be given to someone else.
+   * The link can only be downloaded once and expires after a while. Links stop working when the client restarts.
+   * The first client that uses the link can retry until its download finishes, and keep using it for a minute after.
+   * Links are served on the web UI address, so they only work for others if they can reach that address.
+   *
+   * Returns NOT_FOUND if no such server or file exists.
+   * Returns INVALID_ARGUMENT if the path is a directory or the expiry is out of range.
+   * Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.CreateFileLink
+   */
+  createFileLink: {
+    methodKind: "unary";
+    input: typeof CreateFileLinkRequestSchema;
+    output: typeof CreateFileLinkResponseSchema;
+  },
+  /**
+   * MeasurePeer measures the latency and throughput to an online user.
+   * Measuring generates real traffic, so it should only be used on request.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   * Returns FAILED_PRECONDITION if the path is PEER_PATH_DIRECT and no direct connection could be established.
+   * Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.MeasurePeer
+   */
+  measurePeer: {
+    methodKind: "unary";
+    input: typeof MeasurePeerRequestSchema;
+    output: typeof MeasurePeerResponseSchema;
+  },
+  /**
+   * Diagnose runs the steps of connecting to a server one by one on a separate connection and returns the result of
+   * each, so that connection problems can be narrowed down to DNS, the network, the server's certificate, its
+   * protocol version or the credentials.
+   * Steps after one that failed are skipped.
+   * It works whether or not the server is connected, and does not affect its connection.
    *
    * Returns NOT_FOUND if no such server exists.
-   * Returns NOT_FOUND if no such path exists.
-   * Returns UNAVAILABLE if the user is offline or otherwise cannot be reached.
    *
-   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetFileMeta
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.Diagnose
    */
-  getFileMeta: {
+  diagnose: {
     methodKind: "unary";
-    input: typeof GetFileMetaRequestSchema;
-    output: typeof GetFileMetaResponseSchema;
+    input: typeof DiagnoseRequestSchema;
+    output: typeof DiagnoseResponseSchema;
   },
   /**
    * GetOnlineUsers returns a list of online users in a server.
@@ -2593,6 +8718,81 @@ export const ClientRpcService: GenService<{
     input: typeof UpdateTransferSettingsRequestSchema;
     output: typeof UpdateTransferSettingsResponseSchema;
   },
+  /**
+   * GetNotificationSettings returns the client's notification settings.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetNotificationSettings
+   */
+  getNotificationSettings: {
+    methodKind: "unary";
+    input: typeof GetNotificationSettingsRequestSchema;
+    output: typeof GetNotificationSettingsResponseSchema;
+  },
+  /**
+   * UpdateNotificationSettings updates the client's notification settings.
+   * The settings take effect immediately.
+   * All fields must be filled, default values will not be omitted.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.UpdateNotificationSettings
+   */
+  updateNotificationSettings: {
+    methodKind: "unary";
+    input: typeof UpdateNotificationSettingsRequestSchema;
+    output: typeof UpdateNotificationSettingsResponseSchema;
+  },
+  /**
+   * ExportConfig exports the client's servers, their shares and the trusted server certificates as a bundle
+   * encrypted with the specified password.
+   * The bundle contains server passwords, so it should be treated as sensitive even though it is encrypted.
+   *
+   * Returns INVALID_ARGUMENT if the password is empty.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.ExportConfig
+   */
+  exportConfig: {
+    methodKind: "unary";
+    input: typeof ExportConfigRequestSchema;
+    output: typeof ExportConfigResponseSchema;
+  },
+  /**
+   * ImportConfig imports a bundle created by ExportConfig.
+   * Servers that already exist are skipped, and certificates are only imported for hostnames that do not already
+   * have one.
+   *
+   * Returns INVALID_ARGUMENT if the bundle is not valid.
+   * Returns PERMISSION_DENIED if the password is incorrect or the bundle is corrupted.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.ImportConfig
+   */
+  importConfig: {
+    methodKind: "unary";
+    input: typeof ImportConfigRequestSchema;
+    output: typeof ImportConfigResponseSchema;
+  },
+  /**
+   * BackupDatabase writes a consistent copy of the client's database to a file while the client keeps running.
+   *
+   * Returns INVALID_ARGUMENT if the path is empty.
+   * Returns ALREADY_EXISTS if the file already exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.BackupDatabase
+   */
+  backupDatabase: {
+    methodKind: "unary";
+    input: typeof BackupDatabaseRequestSchema;
+    output: typeof BackupDatabaseResponseSchema;
+  },
+  /**
+   * CheckDatabaseIntegrity checks the client's database for corruption.
+   * It may take a while if large shares are indexed.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.CheckDatabaseIntegrity
+   */
+  checkDatabaseIntegrity: {
+    methodKind: "unary";
+    input: typeof CheckDatabaseIntegrityRequestSchema;
+    output: typeof CheckDatabaseIntegrityResponseSchema;
+  },
   /**
    * IndexShare requests that a share be indexed.
    * The share will be scheduled to be indexed in the background.
@@ -2648,6 +8848,47 @@ export const ClientRpcService: GenService<{
     input: typeof CheckForNewUpdateRequestSchema;
     output: typeof CheckForNewUpdateResponseSchema;
   },
+  /**
+   * ApplyUpdate downloads the new update found by the last check for this platform, verifies it against the checksum
+   * in its signed release manifest, and stages it to replace the client's executable.
+   * The executable is replaced when the client shuts down, so the update takes effect when it is restarted.
+   *
+   * Returns FAILED_PRECONDITION if there is no new update, or if it has no binary for this platform.
+   * Returns FAILED_PRECONDITION if self-updating is disabled, such as when the client was installed by a package
+   * manager.
+   * Returns DATA_LOSS if the downloaded binary does not match its checksum.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.ApplyUpdate
+   */
+  applyUpdate: {
+    methodKind: "unary";
+    input: typeof ApplyUpdateRequestSchema;
+    output: typeof ApplyUpdateResponseSchema;
+  },
+  /**
+   * GetUpdateSettings returns the client's update settings.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetUpdateSettings
+   */
+  getUpdateSettings: {
+    methodKind: "unary";
+    input: typeof GetUpdateSettingsRequestSchema;
+    output: typeof GetUpdateSettingsResponseSchema;
+  },
+  /**
+   * UpdateUpdateSettings updates the client's update settings.
+   * The settings take effect immediately, and cached update info is cleared.
+   * All fields must be filled, default values will not be omitted.
+   *
+   * Returns INVALID_ARGUMENT if the base URL is not an HTTP or HTTPS URL.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.UpdateUpdateSettings
+   */
+  updateUpdateSettings: {
+    methodKind: "unary";
+    input: typeof UpdateUpdateSettingsRequestSchema;
+    output: typeof UpdateUpdateSettingsResponseSchema;
+  },
   /**
    * GetDownloadManagerItems returns all download manager items.
    *
@@ -2660,8 +8901,13 @@ export const ClientRpcService: GenService<{
   },
   /**
    * QueueFileDownload queues a file download.
+   * If the peer can provide the file's hash and a file with the same hash was already downloaded, the request's
+   * duplicate_action decides what happens. If the peer cannot be reached or does not provide hashes, the download is
+   * queued normally. Peers only provide hashes they already know, so a duplicate may not be found the first time a
+   * file is queued.
    *
    * Returns NOT_FOUND if no such server exists.
+   * Returns FAILED_PRECONDITION if the duplicate could not be hard linked, such as when it is on another filesystem.
    *
    * @generated from rpc pb.clientrpc.v1.ClientRpcService.QueueFileDownload
    */
@@ -2695,8 +8941,24 @@ export const ClientRpcService: GenService<{
     input: typeof RemoveDownloadManagerItemRequestSchema;
     output: typeof RemoveDownloadManagerItemResponseSchema;
   },
+  /**
+   * PauseFileDownload pauses a file download.
+   * If the download is in progress, its transfer is paused without closing the connection to the peer.
+   * If it is queued, it will not be started until it is resumed.
+   *
+   * Returns NOT_FOUND if no such download exists.
+   * Returns FAILED_PRECONDITION if the download is already done, canceled or failed.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.PauseFileDownload
+   */
+  pauseFileDownload: {
+    methodKind: "unary";
+    input: typeof PauseFileDownloadRequestSchema;
+    output: typeof PauseFileDownloadResponseSchema;
+  },
   /**
    * ResumeFileDownload resumes or starts the a file download.
+   * Paused in-progress transfers continue where they stopped.
    *
    * Returns NOT_FOUND if no such download exists.
    *
@@ -2707,6 +8969,394 @@ export const ClientRpcService: GenService<{
     input: typeof ResumeFileDownloadRequestSchema;
     output: typeof ResumeFileDownloadResponseSchema;
   },
+  /**
+   * GetDownloadHooks returns all download post-processing hooks.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetDownloadHooks
+   */
+  getDownloadHooks: {
+    methodKind: "unary";
+    input: typeof GetDownloadHooksRequestSchema;
+    output: typeof GetDownloadHooksResponseSchema;
+  },
+  /**
+   * CreateDownloadHook creates a hook that runs when a download completes.
+   * Global hooks run before per-download hooks, in the order they were created.
+   *
+   * Returns INVALID_ARGUMENT if the type is unspecified or the target is invalid for the type.
+   * Returns NOT_FOUND if a download UUID was specified but no such download exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.CreateDownloadHook
+   */
+  createDownloadHook: {
+    methodKind: "unary";
+    input: typeof CreateDownloadHookRequestSchema;
+    output: typeof CreateDownloadHookResponseSchema;
+  },
+  /**
+   * DeleteDownloadHook deletes a download hook.
+   *
+   * Returns NOT_FOUND if no such hook exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.DeleteDownloadHook
+   */
+  deleteDownloadHook: {
+    methodKind: "unary";
+    input: typeof DeleteDownloadHookRequestSchema;
+    output: typeof DeleteDownloadHookResponseSchema;
+  },
+  /**
+   * GetUploads returns uploads to peers that are in progress, and the history of finished uploads.
+   * Live updates are sent as TYPE_UPLOAD_UPDATE events.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetUploads
+   */
+  getUploads: {
+    methodKind: "unary";
+    input: typeof GetUploadsRequestSchema;
+    output: typeof GetUploadsResponseSchema;
+  },
+  /**
+   * ClearUploadHistory deletes the history of finished uploads.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.ClearUploadHistory
+   */
+  clearUploadHistory: {
+    methodKind: "unary";
+    input: typeof ClearUploadHistoryRequestSchema;
+    output: typeof ClearUploadHistoryResponseSchema;
+  },
+  /**
+   * GetFriends returns the friend info for peers on a server.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetFriends
+   */
+  getFriends: {
+    methodKind: "unary";
+    input: typeof GetFriendsRequestSchema;
+    output: typeof GetFriendsResponseSchema;
+  },
+  /**
+   * SetFriend creates or replaces the friend info for a peer on a server.
+   * The peer does not need to be online.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   * Returns INVALID_ARGUMENT if the username is invalid or the nickname or note is too long.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.SetFriend
+   */
+  setFriend: {
+    methodKind: "unary";
+    input: typeof SetFriendRequestSchema;
+    output: typeof SetFriendResponseSchema;
+  },
+  /**
+   * DeleteFriend deletes the friend info for a peer on a server.
+   *
+   * Returns NOT_FOUND if no such server or friend info exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.DeleteFriend
+   */
+  deleteFriend: {
+    methodKind: "unary";
+    input: typeof DeleteFriendRequestSchema;
+    output: typeof DeleteFriendResponseSchema;
+  },
+  /**
+   * GetBlockedPeers returns the peers on the local block list of a server.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetBlockedPeers
+   */
+  getBlockedPeers: {
+    methodKind: "unary";
+    input: typeof GetBlockedPeersRequestSchema;
+    output: typeof GetBlockedPeersResponseSchema;
+  },
+  /**
+   * BlockPeer adds a peer on a server to the local block list.
+   * Requests from blocked peers are rejected with permission errors, and their results are left out of searches.
+   * Blocking a peer that is already blocked does nothing.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   * Returns INVALID_ARGUMENT if the username is invalid.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.BlockPeer
+   */
+  blockPeer: {
+    methodKind: "unary";
+    input: typeof BlockPeerRequestSchema;
+    output: typeof BlockPeerResponseSchema;
+  },
+  /**
+   * UnblockPeer removes a peer on a server from the local block list.
+   *
+   * Returns NOT_FOUND if no such server exists or the peer is not blocked.
+   * Returns INVALID_ARGUMENT if the username is invalid.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.UnblockPeer
+   */
+  unblockPeer: {
+    methodKind: "unary";
+    input: typeof UnblockPeerRequestSchema;
+    output: typeof UnblockPeerResponseSchema;
+  },
+  /**
+   * GetInterests returns the interests published to every server.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetInterests
+   */
+  getInterests: {
+    methodKind: "unary";
+    input: typeof GetInterestsRequestSchema;
+    output: typeof GetInterestsResponseSchema;
+  },
+  /**
+   * SetInterests replaces the interests published to every server, so that users with similar interests can find
+   * each other. They are published to connected servers right away, and to other servers when they connect.
+   * There can be at most 50 interests, each at most 64 characters long.
+   *
+   * Returns INVALID_ARGUMENT if an interest is invalid or there are too many.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.SetInterests
+   */
+  setInterests: {
+    methodKind: "unary";
+    input: typeof SetInterestsRequestSchema;
+    output: typeof SetInterestsResponseSchema;
+  },
+  /**
+   * GetSimilarUsers returns the online users in the server's room whose interests overlap with the client's, most
+   * overlapping first, to help find whose shares to browse.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   * Returns UNIMPLEMENTED if the server does not support interests.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetSimilarUsers
+   */
+  getSimilarUsers: {
+    methodKind: "unary";
+    input: typeof GetSimilarUsersRequestSchema;
+    output: typeof GetSimilarUsersResponseSchema;
+  },
+  /**
+   * GetServerSchedule returns the windows during which a server connection is allowed.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetServerSchedule
+   */
+  getServerSchedule: {
+    methodKind: "unary";
+    input: typeof GetServerScheduleRequestSchema;
+    output: typeof GetServerScheduleResponseSchema;
+  },
+  /**
+   * SetServerSchedule replaces the windows during which a server connection is allowed.
+   * When a window ends, the connection is closed, and when one starts, it is opened again, unless it was
+   * disconnected manually. Connecting manually outside a window keeps the connection open until the next window
+   * starts or ends.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   * Returns INVALID_ARGUMENT if a window is invalid.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.SetServerSchedule
+   */
+  setServerSchedule: {
+    methodKind: "unary";
+    input: typeof SetServerScheduleRequestSchema;
+    output: typeof SetServerScheduleResponseSchema;
+  },
+  /**
+   * GetSnooze returns the state of the client's snooze.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetSnooze
+   */
+  getSnooze: {
+    methodKind: "unary";
+    input: typeof GetSnoozeRequestSchema;
+    output: typeof GetSnoozeResponseSchema;
+  },
+  /**
+   * Snooze pauses all uploads and downloads on every server, and optionally hides shares from peers, for a duration
+   * or until Unsnooze is called.
+   * Snoozing while already snoozed replaces the current snooze.
+   * The snooze is kept across client restarts.
+   *
+   * Returns INVALID_ARGUMENT if the duration is 0.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.Snooze
+   */
+  snooze: {
+    methodKind: "unary";
+    input: typeof SnoozeRequestSchema;
+    output: typeof SnoozeResponseSchema;
+  },
+  /**
+   * Unsnooze ends the client's snooze, resuming uploads and downloads and showing shares again.
+   * Does nothing if the client is not snoozed.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.Unsnooze
+   */
+  unsnooze: {
+    methodKind: "unary";
+    input: typeof UnsnoozeRequestSchema;
+    output: typeof UnsnoozeResponseSchema;
+  },
+  /**
+   * GetRunHistory returns the history of runs of the client, including when each started and stopped and whether it
+   * crashed.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetRunHistory
+   */
+  getRunHistory: {
+    methodKind: "unary";
+    input: typeof GetRunHistoryRequestSchema;
+    output: typeof GetRunHistoryResponseSchema;
+  },
+  /**
+   * GetConnHistory returns the history of connections to servers, including how long each was open and why it
+   * closed, such as to audit connection stability.
+   *
+   * Returns NOT_FOUND if a server UUID is specified and no such server exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetConnHistory
+   */
+  getConnHistory: {
+    methodKind: "unary";
+    input: typeof GetConnHistoryRequestSchema;
+    output: typeof GetConnHistoryResponseSchema;
+  },
+  /**
+   * GetTrash returns the deleted servers and shares that can still be restored.
+   * Deleted servers and shares are purged for good once they have been in the trash for longer than the retention
+   * period in the "trash_retention_days" setting.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetTrash
+   */
+  getTrash: {
+    methodKind: "unary";
+    input: typeof GetTrashRequestSchema;
+    output: typeof GetTrashResponseSchema;
+  },
+  /**
+   * RestoreServer takes a deleted server out of the trash, along with its shares, and starts managing a connection
+   * to it again.
+   *
+   * Returns NOT_FOUND if no such server is in the trash.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.RestoreServer
+   */
+  restoreServer: {
+    methodKind: "unary";
+    input: typeof RestoreServerRequestSchema;
+    output: typeof RestoreServerResponseSchema;
+  },
+  /**
+   * PurgeServer permanently deletes a server in the trash, along with its shares.
+   *
+   * Returns NOT_FOUND if no such server is in the trash.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.PurgeServer
+   */
+  purgeServer: {
+    methodKind: "unary";
+    input: typeof PurgeServerRequestSchema;
+    output: typeof PurgeServerResponseSchema;
+  },
+  /**
+   * RestoreShare takes a deleted share out of the trash and shares it again.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   * Returns NOT_FOUND if no such share is in the trash.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.RestoreShare
+   */
+  restoreShare: {
+    methodKind: "unary";
+    input: typeof RestoreShareRequestSchema;
+    output: typeof RestoreShareResponseSchema;
+  },
+  /**
+   * PurgeShare permanently deletes a share in the trash.
+   *
+   * Returns NOT_FOUND if no such server exists.
+   * Returns NOT_FOUND if no such share is in the trash.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.PurgeShare
+   */
+  purgeShare: {
+    methodKind: "unary";
+    input: typeof PurgeShareRequestSchema;
+    output: typeof PurgeShareResponseSchema;
+  },
+  /**
+   * GetPlugins returns all plugins.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.GetPlugins
+   */
+  getPlugins: {
+    methodKind: "unary";
+    input: typeof GetPluginsRequestSchema;
+    output: typeof GetPluginsResponseSchema;
+  },
+  /**
+   * CreatePlugin creates a plugin with the specified scopes and returns its bearer token.
+   * Plugins use the token in place of the client's own to call the methods their scopes allow.
+   *
+   * Returns INVALID_ARGUMENT if the name is invalid or a scope is unspecified.
+   * Returns ALREADY_EXISTS if a plugin with the same name already exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.CreatePlugin
+   */
+  createPlugin: {
+    methodKind: "unary";
+    input: typeof CreatePluginRequestSchema;
+    output: typeof CreatePluginResponseSchema;
+  },
+  /**
+   * DeletePlugin deletes a plugin, revoking its token and closing its event streams.
+   *
+   * Returns NOT_FOUND if no such plugin exists.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.DeletePlugin
+   */
+  deletePlugin: {
+    methodKind: "unary";
+    input: typeof DeletePluginRequestSchema;
+    output: typeof DeletePluginResponseSchema;
+  },
+  /**
+   * StreamPluginEvents returns an ongoing stream of the events a plugin registered for.
+   * Only plugins can call it.
+   *
+   * Returns FAILED_PRECONDITION if not called with a plugin's token.
+   * Returns PERMISSION_DENIED if the plugin does not have the scope for one of the requested types.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.StreamPluginEvents
+   */
+  streamPluginEvents: {
+    methodKind: "server_streaming";
+    input: typeof StreamPluginEventsRequestSchema;
+    output: typeof StreamPluginEventsResponseSchema;
+  },
+  /**
+   * RespondToSearch adds a plugin's results to a search it received as a PLUGIN_EVENT_TYPE_SEARCH event.
+   * Only plugins can call it, once per search.
+   *
+   * Returns FAILED_PRECONDITION if not called with a plugin's token.
+   * Returns NOT_FOUND if no such search is waiting for the plugin's response, such as if its deadline passed.
+   *
+   * @generated from rpc pb.clientrpc.v1.ClientRpcService.RespondToSearch
+   */
+  respondToSearch: {
+    methodKind: "unary";
+    input: typeof RespondToSearchRequestSchema;
+    output: typeof RespondToSearchResponseSchema;
+  },
 }> = /*@__PURE__*/
   serviceDesc(file_pb_clientrpc_v1_rpc, 0);
 
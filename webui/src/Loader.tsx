@@ -1,4 +1,10 @@
-import { Component, ErrorBoundary, Show, Suspense } from 'solid-js'
+import {
+	Component,
+	createSignal,
+	ErrorBoundary,
+	Show,
+	Suspense,
+} from 'solid-js'
 import {
 	bearerTokenKey,
 	FileServerUrlCtx,
@@ -7,7 +13,12 @@ import {
 	rpcUrlKey,
 } from './ctx'
 import App from './App'
-import { createClient, Interceptor } from '@connectrpc/connect'
+import {
+	Code,
+	ConnectError,
+	createClient,
+	Interceptor,
+} from '@connectrpc/connect'
 import {
 	ClientRpcService,
 	GetClientInfoResponse,
@@ -34,19 +45,48 @@ const NoRpc: Component = () => {
 	)
 }
 
-const NoToken: Component = () => {
+const NoToken: Component<{ sameOrigin: boolean }> = (props) => {
 	return (
 		<div>
 			<h1>Missing Token</h1>
 			<p>
-				The URL you opened should have contained a bearer token in it,
-				ending in something like <code>?token=</code>.
+				Open the web UI from the FriendNet client to sign in
+				automatically.
 			</p>
-			<p>You can manually enter the token below:</p>
-			<form method="get" action="">
-				<input type="text" name="token" placeholder="Bearer token" />
-				<input type="submit" />
-			</form>
+			<p>
+				You can also manually enter the bearer token shown in the
+				client's log below:
+			</p>
+			<Show
+				when={props.sameOrigin}
+				fallback={
+					<form method="get" action="">
+						<input
+							type="text"
+							name="token"
+							placeholder="Bearer token"
+						/>
+						<input type="submit" />
+					</form>
+				}
+			>
+				{/* Exchanges the token for a session cookie. */}
+				<form method="post" action="/auth/session">
+					<input
+						type="hidden"
+						name="next"
+						value={
+							window.location.pathname + window.location.search
+						}
+					/>
+					<input
+						type="password"
+						name="token"
+						placeholder="Bearer token"
+					/>
+					<input type="submit" />
+				</form>
+			</Show>
 		</div>
 	)
 }
@@ -78,15 +118,15 @@ export const Loader: Component = () => {
 		}
 	}
 
+	// Without a token, the session cookie set by the client's session endpoint is used.
+	// The token query parameter is still accepted for old links.
 	let bearerToken = params.get('token')
 	if (bearerToken) {
 		localStorage.setItem(bearerTokenKey, bearerToken)
 	} else {
 		bearerToken = localStorage.getItem(bearerTokenKey)
-		if (!bearerToken) {
-			return <NoToken />
-		}
 	}
+	const sameOrigin = new URL(rpcUrl).origin === window.location.origin
 
 	// Clear out params from query.
 	{
@@ -135,7 +175,9 @@ export const Loader: Component = () => {
 			baseUrl: rpcUrl,
 			interceptors: [
 				((next) => async (req) => {
-					req.header.set('Authorization', `Bearer ${bearerToken}`)
+					if (bearerToken) {
+						req.header.set('Authorization', `Bearer ${bearerToken}`)
+					}
 					return next(req)
 				}) satisfies Interceptor,
 			],
@@ -148,8 +190,24 @@ export const Loader: Component = () => {
 		fileServerUrl: string
 	}
 
-	const everything = createAsync(async (): Promise<Everything> => {
-		const clientInfo = await client.getClientInfo({})
+	const [needsToken, setNeedsToken] = createSignal(false)
+	const loadEverything = async (): Promise<Everything | undefined> => {
+		let clientInfo: GetClientInfoResponse
+		try {
+			clientInfo = await client.getClientInfo({})
+		} catch (err) {
+			if (
+				err instanceof ConnectError &&
+				(err.code === Code.Unauthenticated ||
+					err.code === Code.PermissionDenied)
+			) {
+				// Neither a stored token nor a session cookie was accepted.
+				localStorage.removeItem(bearerTokenKey)
+				setNeedsToken(true)
+				return undefined
+			}
+			throw err
+		}
 
 		// Load initial state.
 		const state = new State(client)
@@ -160,14 +218,15 @@ export const Loader: Component = () => {
 		if (!fileServerUrl.endsWith('/')) {
 			fileServerUrl += '/'
 		}
-		fileServerUrl += 'content/' + bearerToken
+		fileServerUrl += 'content/' + (bearerToken ?? 'session')
 
 		return {
 			clientInfo,
 			state,
 			fileServerUrl,
 		}
-	})
+	}
+	const everything = createAsync(loadEverything)
 
 	return (
 		<Suspense fallback={<div>Loading...</div>}>
@@ -187,6 +246,9 @@ export const Loader: Component = () => {
 					</div>
 				}
 			>
+				<Show when={needsToken()}>
+					<NoToken sameOrigin={sameOrigin} />
+				</Show>
 				<Show when={everything()}>
 					<FileServerUrlCtx.Provider
 						value={everything()!.fileServerUrl}
@@ -8,11 +8,6 @@ export const AppName = 'FriendNet Web UI'
  */
 export const UserProfilePath = '/__profile/index.html'
 
-/**
- * The default port for servers.
- */
-export const DefaultServerPort = 20038
-
 /**
  * The timeout to use for RPC requests.
  */
@@ -77,6 +77,14 @@ export const Layout: Component<LayoutProps> = (props) => {
 							</Show>
 						</Show>
 					</A>
+					<Show when={state.shutdownDrain()}>
+						{' '}
+						<span class={styles.shutdownDrain}>
+							Shutting down, waiting for{' '}
+							{state.shutdownDrain()!.activeUploads} upload(s)
+							to finish...
+						</span>
+					</Show>
 				</div>
 
 				<button
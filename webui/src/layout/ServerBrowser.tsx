@@ -8,7 +8,7 @@ import { OnlineUser, Server } from '../state'
 import { A } from '@solidjs/router'
 import { Code, ConnectError } from '@connectrpc/connect'
 import { makeBrowsePath, sleep } from '../util'
-import { ServerConnState } from '../../pb/clientrpc/v1/rpc_pb'
+import { ServerConnState, TrustLevel } from '../../pb/clientrpc/v1/rpc_pb'
 
 const indicatorTitle = {
 	[ServerConnState.UNSPECIFIED]: 'Disconnected',
@@ -45,7 +45,27 @@ const OnlineUserEntry: Component<{ server: Server; user: OnlineUser }> = (
 		<div class={styles.onlineUser}>
 			<div class={styles.onlineUserName}>
 				<div class={styles.onlineUserStatus} />
-				<span>{props.user.username}</span>
+				<span title={props.user.friend()?.note || props.user.username}>
+					{props.user.displayName()}
+				</span>
+				<Show
+					when={
+						props.user.friend()?.trustLevel === TrustLevel.TRUSTED
+					}
+				>
+					<span title="Trusted">⭐</span>
+				</Show>
+				<Show
+					when={
+						props.user.friend()?.trustLevel ===
+						TrustLevel.DISTRUSTED
+					}
+				>
+					<span title="Distrusted">⚠️</span>
+				</Show>
+				<Show when={props.user.blocked()}>
+					<span title="Blocked">🚫</span>
+				</Show>
 			</div>
 			<div class={styles.onlineUserOptions}>
 				<A
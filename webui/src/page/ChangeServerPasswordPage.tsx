@@ -3,6 +3,7 @@ import { Component, createSignal, Show } from 'solid-js'
 import stylesCommon from '../common.module.css'
 import { useGlobalState } from '../ctx'
 import { ConnectError } from '@connectrpc/connect'
+import { describeRpcError } from '../rpcerror'
 import { useLocation, useParams } from '@solidjs/router'
 
 const Page: Component = () => {
@@ -45,7 +46,7 @@ const Page: Component = () => {
 			setNewPass('')
 		} catch (err) {
 			if (err instanceof ConnectError) {
-				setError(err.message)
+				setError(describeRpcError(err))
 			} else {
 				console.error('failed to update server:', err)
 				setError('Internal error, check console')
@@ -3,11 +3,17 @@ import { Component, createSignal, Show } from 'solid-js'
 import stylesCommon from '../common.module.css'
 import { useGlobalState } from '../ctx'
 import { ConnectError } from '@connectrpc/connect'
-import { DefaultServerPort } from '../constant'
+import { describeRpcError } from '../rpcerror'
+import { useSearchParams } from '@solidjs/router'
 
 export const CreateServerPage: Component = () => {
 	const state = useGlobalState()
 
+	const [searchParams] = useSearchParams<{
+		invite?: string
+	}>()
+
+	const [invite, setInvite] = createSignal(searchParams.invite ?? '')
 	const [name, setName] = createSignal('')
 	const [address, setAddress] = createSignal('')
 	const [room, setRoom] = createSignal('')
@@ -29,32 +35,42 @@ export const CreateServerPage: Component = () => {
 		setCreating(true)
 
 		try {
-			if (
-				!name() ||
-				!address() ||
-				!room() ||
-				!username() ||
-				!password()
-			) {
-				setError('Missing params')
-				return
-			}
-
-			let addr = address()
-			if (!addr.includes(':')) {
-				addr += ':' + DefaultServerPort
+			if (invite()) {
+				if (!username() || !password()) {
+					setError('Missing params')
+					return
+				}
+
+				await state.importInviteBundle({
+					url: invite(),
+					name: name(),
+					username: username(),
+					password: password(),
+				})
+			} else {
+				if (
+					!name() ||
+					!address() ||
+					!room() ||
+					!username() ||
+					!password()
+				) {
+					setError('Missing params')
+					return
+				}
+
+				await state.createServer({
+					name: name(),
+					address: address(),
+					room: room(),
+					username: username(),
+					password: password(),
+				})
 			}
 
-			await state.createServer({
-				name: name(),
-				address: addr,
-				room: room(),
-				username: username(),
-				password: password(),
-			})
-
 			setSuccess(true)
 
+			setInvite('')
 			setName('')
 			setAddress('')
 			setRoom('')
@@ -62,7 +78,7 @@ export const CreateServerPage: Component = () => {
 			setPassword('')
 		} catch (err) {
 			if (err instanceof ConnectError) {
-				setError(err.message)
+				setError(describeRpcError(err))
 			} else {
 				console.error('failed to create server:', err)
 				setError('Internal error, check console')
@@ -93,60 +109,85 @@ export const CreateServerPage: Component = () => {
 					<tbody>
 						<tr>
 							<td>
-								<label for="create-server-name">Name</label>
-							</td>
-							<td>
-								<input
-									id="create-server-name"
-									type="text"
-									placeholder=""
-									value={name()}
-									onChange={(e) =>
-										setName(e.currentTarget.value)
-									}
-									required={true}
-								/>
-							</td>
-						</tr>
-
-						<tr>
-							<td>
-								<label for="create-server-address">
-									Address
+								<label for="create-server-invite">
+									Invite Link
 								</label>
 							</td>
 							<td>
 								<input
-									id="create-server-address"
+									id="create-server-invite"
 									type="text"
-									placeholder="example.com, example.com:20038, etc."
-									value={address()}
+									placeholder="friendnet://invite?... (optional)"
+									value={invite()}
 									onChange={(e) =>
-										setAddress(e.currentTarget.value)
+										setInvite(e.currentTarget.value)
 									}
-									required={true}
 								/>
 							</td>
 						</tr>
 
 						<tr>
 							<td>
-								<label for="create-server-room">Room</label>
+								<label for="create-server-name">Name</label>
 							</td>
 							<td>
 								<input
-									id="create-server-room"
+									id="create-server-name"
 									type="text"
-									placeholder=""
-									value={room()}
+									placeholder={
+										invite()
+											? 'Defaults to the address'
+											: ''
+									}
+									value={name()}
 									onChange={(e) =>
-										setRoom(e.currentTarget.value)
+										setName(e.currentTarget.value)
 									}
-									required={true}
+									required={!invite()}
 								/>
 							</td>
 						</tr>
 
+						<Show when={!invite()}>
+							<tr>
+								<td>
+									<label for="create-server-address">
+										Address
+									</label>
+								</td>
+								<td>
+									<input
+										id="create-server-address"
+										type="text"
+										placeholder="example.com, example.com:20038, etc."
+										value={address()}
+										onChange={(e) =>
+											setAddress(e.currentTarget.value)
+										}
+										required={true}
+									/>
+								</td>
+							</tr>
+
+							<tr>
+								<td>
+									<label for="create-server-room">Room</label>
+								</td>
+								<td>
+									<input
+										id="create-server-room"
+										type="text"
+										placeholder=""
+										value={room()}
+										onChange={(e) =>
+											setRoom(e.currentTarget.value)
+										}
+										required={true}
+									/>
+								</td>
+							</tr>
+						</Show>
+
 						<tr>
 							<td>
 								<label for="create-server-username">
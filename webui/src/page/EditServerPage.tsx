@@ -1,11 +1,241 @@
-import { Component, createSignal, Show } from 'solid-js'
+import { Component, createSignal, For, onMount, Show } from 'solid-js'
 
 import stylesCommon from '../common.module.css'
-import { useGlobalState } from '../ctx'
+import { useGlobalState, useRpcClient } from '../ctx'
 import { ConnectError } from '@connectrpc/connect'
-import { DefaultServerPort } from '../constant'
+import { describeRpcError } from '../rpcerror'
 import { useLocation, useParams } from '@solidjs/router'
 
+const weekdayNames = ['Sun', 'Mon', 'Tue', 'Wed', 'Thu', 'Fri', 'Sat']
+
+type WindowDraft = {
+	weekdays: number
+	start: string
+	end: string
+}
+
+function minuteToTime(minute: number): string {
+	const h = Math.floor(minute / 60)
+	const m = minute % 60
+	return `${String(h).padStart(2, '0')}:${String(m).padStart(2, '0')}`
+}
+
+function timeToMinute(time: string): number {
+	const [h, m] = time.split(':').map(Number)
+	return h * 60 + m
+}
+
+function hasDay(weekdays: number, day: number): boolean {
+	return (weekdays & (1 << day)) !== 0
+}
+
+function setDay(weekdays: number, day: number, on: boolean): number {
+	return on ? weekdays | (1 << day) : weekdays & ~(1 << day)
+}
+
+/**
+ * An editor for the windows during which a server connection is allowed.
+ */
+const ScheduleEditor: Component<{ serverUuid: string }> = (props) => {
+	const client = useRpcClient()
+
+	const [windows, setWindows] = createSignal<WindowDraft[]>([])
+	const [allowedNow, setAllowedNow] = createSignal(true)
+
+	const [error, setError] = createSignal('')
+	const [isSaving, setSaving] = createSignal(false)
+	const [isSuccess, setSuccess] = createSignal(false)
+
+	const load = async () => {
+		const res = await client.getServerSchedule({
+			serverUuid: props.serverUuid,
+		})
+		setWindows(
+			res.windows.map((w) => ({
+				weekdays: w.weekdays,
+				start: minuteToTime(w.startMinute),
+				end: minuteToTime(w.endMinute),
+			})),
+		)
+		setAllowedNow(res.allowedNow)
+	}
+
+	onMount(() => {
+		load().catch((err) => {
+			console.error('failed to load server schedule:', err)
+			setError('Failed to load schedule, check console')
+		})
+	})
+
+	const updateWindow = (index: number, update: Partial<WindowDraft>) => {
+		setWindows(
+			windows().map((w, i) => (i === index ? { ...w, ...update } : w)),
+		)
+	}
+
+	const submit = async function (event: SubmitEvent) {
+		event.preventDefault()
+
+		if (isSaving()) {
+			return
+		}
+
+		setError('')
+		setSuccess(false)
+		setSaving(true)
+
+		try {
+			await client.setServerSchedule({
+				serverUuid: props.serverUuid,
+				windows: windows().map((w) => ({
+					weekdays: w.weekdays,
+					startMinute: timeToMinute(w.start),
+					endMinute: timeToMinute(w.end),
+				})),
+			})
+			await load()
+
+			setSuccess(true)
+		} catch (err) {
+			if (err instanceof ConnectError) {
+				setError(describeRpcError(err))
+			} else {
+				console.error('failed to update server schedule:', err)
+				setError('Internal error, check console')
+			}
+		} finally {
+			setSaving(false)
+		}
+	}
+
+	return (
+		<>
+			<h2>Connection Schedule</h2>
+
+			<Show when={error()}>
+				<div class={stylesCommon.errorMessage}>{error()}</div>
+			</Show>
+			<Show when={isSuccess()}>
+				<div class={stylesCommon.successMessage}>Saved</div>
+			</Show>
+
+			<p>
+				<Show
+					when={windows().length > 0}
+					fallback="The server is connected to at any time."
+				>
+					The server is only connected to during these windows.
+					Windows that end before they start continue into the next
+					day.{' '}
+					<Show when={!allowedNow()}>
+						<b>No window is active right now.</b>
+					</Show>
+				</Show>
+			</p>
+
+			<form onSubmit={submit} class={stylesCommon.form}>
+				<table>
+					<tbody>
+						<For each={windows()}>
+							{(w, i) => (
+								<tr>
+									<td>
+										<For each={weekdayNames}>
+											{(name, day) => (
+												<label>
+													<input
+														type="checkbox"
+														checked={hasDay(
+															w.weekdays,
+															day(),
+														)}
+														onChange={(e) =>
+															updateWindow(i(), {
+																weekdays:
+																	setDay(
+																		w.weekdays,
+																		day(),
+																		e
+																			.currentTarget
+																			.checked,
+																	),
+															})
+														}
+													/>
+													{name}
+												</label>
+											)}
+										</For>
+									</td>
+									<td>
+										<input
+											type="time"
+											value={w.start}
+											required={true}
+											onChange={(e) =>
+												updateWindow(i(), {
+													start: e.currentTarget
+														.value,
+												})
+											}
+										/>
+										{' – '}
+										<input
+											type="time"
+											value={w.end}
+											required={true}
+											onChange={(e) =>
+												updateWindow(i(), {
+													end: e.currentTarget.value,
+												})
+											}
+										/>
+									</td>
+									<td>
+										<button
+											type="button"
+											onClick={() =>
+												setWindows(
+													windows().filter(
+														(_, j) => j !== i(),
+													),
+												)
+											}
+										>
+											Remove
+										</button>
+									</td>
+								</tr>
+							)}
+						</For>
+					</tbody>
+				</table>
+
+				<p>
+					<small>No days selected means every day.</small>
+				</p>
+
+				<button
+					type="button"
+					onClick={() =>
+						setWindows([
+							...windows(),
+							{ weekdays: 0, start: '22:00', end: '08:00' },
+						])
+					}
+				>
+					Add Window
+				</button>
+				<input
+					type="submit"
+					value="Save Schedule"
+					disabled={isSaving()}
+				/>
+			</form>
+		</>
+	)
+}
+
 const Page: Component = () => {
 	const { uuid } = useParams<{ uuid: string }>()
 	const state = useGlobalState()
@@ -41,14 +271,9 @@ const Page: Component = () => {
 				return
 			}
 
-			let addr = address()
-			if (!addr.includes(':')) {
-				addr += ':' + DefaultServerPort
-			}
-
 			await server.update({
 				name: name(),
-				address: addr,
+				address: address(),
 				room: room(),
 				username: username(),
 				password: password() || undefined,
@@ -63,7 +288,7 @@ const Page: Component = () => {
 			setPassword('')
 		} catch (err) {
 			if (err instanceof ConnectError) {
-				setError(err.message)
+				setError(describeRpcError(err))
 			} else {
 				console.error('failed to update server:', err)
 				setError('Internal error, check console')
@@ -197,6 +422,8 @@ const Page: Component = () => {
 					disabled={isSaving()}
 				/>
 			</form>
+
+			<ScheduleEditor serverUuid={uuid} />
 		</div>
 	)
 }
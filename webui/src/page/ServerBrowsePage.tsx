@@ -4,6 +4,7 @@ import styles from './ServerBrowsePage.module.css'
 
 import { useFileServerUrl, useGlobalState, useRpcClient } from '../ctx'
 import { ConnectError } from '@connectrpc/connect'
+import { describeRpcError } from '../rpcerror'
 import { A, useLocation, useParams } from '@solidjs/router'
 import { FileMeta } from '../../pb/clientrpc/v1/rpc_pb'
 import {
@@ -67,7 +68,7 @@ const Page: Component = () => {
 			}
 		} catch (err) {
 			if (err instanceof ConnectError) {
-				setError(err.message)
+				setError(describeRpcError(err))
 			} else {
 				console.error('failed to browse path:', err)
 				setError('Internal error, check console')
@@ -1,9 +1,247 @@
 import styles from './ServerProfilePage.module.css'
+import stylesCommon from '../common.module.css'
 
-import { Component, For, Show, Suspense } from 'solid-js'
+import {
+	Component,
+	createSignal,
+	For,
+	onMount,
+	Show,
+	Suspense,
+} from 'solid-js'
 import { useFileServerUrl, useGlobalState } from '../ctx'
 import { createAsync, useLocation, useParams } from '@solidjs/router'
 import { makeFileUrl } from '../util'
+import { Server } from '../state'
+import { ConnectError } from '@connectrpc/connect'
+import { describeRpcError } from '../rpcerror'
+import { TrustLevel } from '../../pb/clientrpc/v1/rpc_pb'
+
+/**
+ * A form for editing the local nickname, note, and trust level of a user.
+ */
+const FriendEditor: Component<{ server: Server; username: string }> = (
+	props,
+) => {
+	const [nickname, setNickname] = createSignal('')
+	const [note, setNote] = createSignal('')
+	const [trustLevel, setTrustLevel] = createSignal(TrustLevel.UNSPECIFIED)
+
+	const [error, setError] = createSignal('')
+	const [isSaving, setSaving] = createSignal(false)
+	const [isSuccess, setSuccess] = createSignal(false)
+
+	const load = () => {
+		const friend = props.server.getFriend(props.username)
+		setNickname(friend?.nickname ?? '')
+		setNote(friend?.note ?? '')
+		setTrustLevel(friend?.trustLevel ?? TrustLevel.UNSPECIFIED)
+	}
+
+	onMount(() => {
+		load()
+		props.server
+			.refreshFriends()
+			.then(load)
+			.catch((err) => {
+				console.error('failed to refresh friends:', err)
+			})
+	})
+
+	const run = async (action: () => Promise<unknown>) => {
+		if (isSaving()) {
+			return
+		}
+
+		setError('')
+		setSuccess(false)
+		setSaving(true)
+
+		try {
+			await action()
+			load()
+			setSuccess(true)
+		} catch (err) {
+			if (err instanceof ConnectError) {
+				setError(describeRpcError(err))
+			} else {
+				console.error('failed to update friend:', err)
+				setError('Internal error, check console')
+			}
+		} finally {
+			setSaving(false)
+		}
+	}
+
+	const submit = (event: SubmitEvent) => {
+		event.preventDefault()
+		run(() =>
+			props.server.setFriend({
+				username: props.username,
+				nickname: nickname(),
+				note: note(),
+				trustLevel: trustLevel(),
+			}),
+		)
+	}
+
+	return (
+		<details class={styles.friend}>
+			<summary>
+				Nickname and notes
+				<Show when={props.server.getFriend(props.username)?.nickname}>
+					{(nick) => <> ({nick()})</>}
+				</Show>
+			</summary>
+
+			<Show when={error()}>
+				<div class={stylesCommon.errorMessage}>{error()}</div>
+			</Show>
+			<Show when={isSuccess()}>
+				<div class={stylesCommon.successMessage}>Saved</div>
+			</Show>
+
+			<form onSubmit={submit} class={stylesCommon.form}>
+				<table>
+					<tbody>
+						<tr>
+							<td>
+								<label for="friend-nickname">Nickname</label>
+							</td>
+							<td>
+								<input
+									id="friend-nickname"
+									type="text"
+									maxLength={64}
+									value={nickname()}
+									onChange={(e) =>
+										setNickname(e.currentTarget.value)
+									}
+								/>
+							</td>
+						</tr>
+
+						<tr>
+							<td>
+								<label for="friend-trust">Trust</label>
+							</td>
+							<td>
+								<select
+									id="friend-trust"
+									value={trustLevel()}
+									onChange={(e) =>
+										setTrustLevel(
+											Number(
+												e.currentTarget.value,
+											) as TrustLevel,
+										)
+									}
+								>
+									<option value={TrustLevel.UNSPECIFIED}>
+										Neutral
+									</option>
+									<option value={TrustLevel.TRUSTED}>
+										Trusted
+									</option>
+									<option value={TrustLevel.DISTRUSTED}>
+										Distrusted
+									</option>
+								</select>
+							</td>
+						</tr>
+
+						<tr>
+							<td>
+								<label for="friend-note">Note</label>
+							</td>
+							<td>
+								<textarea
+									id="friend-note"
+									maxLength={4096}
+									rows={4}
+									value={note()}
+									onChange={(e) =>
+										setNote(e.currentTarget.value)
+									}
+								/>
+							</td>
+						</tr>
+					</tbody>
+				</table>
+
+				<input type="submit" value="Save" disabled={isSaving()} />
+				<Show when={props.server.getFriend(props.username)}>
+					<button
+						type="button"
+						disabled={isSaving()}
+						onClick={() =>
+							run(() =>
+								props.server.deleteFriend(props.username),
+							)
+						}
+					>
+						Clear
+					</button>
+				</Show>
+			</form>
+		</details>
+	)
+}
+
+/**
+ * A button that adds a user to or removes them from the block list.
+ */
+const BlockButton: Component<{ server: Server; username: string }> = (
+	props,
+) => {
+	const [isPending, setPending] = createSignal(false)
+
+	onMount(() => {
+		props.server.refreshBlockedPeers().catch((err) => {
+			console.error('failed to refresh blocked peers:', err)
+		})
+	})
+
+	const toggle = async () => {
+		if (isPending()) {
+			return
+		}
+
+		const blocked = props.server.isBlocked(props.username)
+		if (
+			!blocked &&
+			!confirm(
+				`Block ${props.username}? Their requests will be rejected and their files will not show up in searches.`,
+			)
+		) {
+			return
+		}
+
+		setPending(true)
+		try {
+			if (blocked) {
+				await props.server.unblockPeer(props.username)
+			} else {
+				await props.server.blockPeer(props.username)
+			}
+		} catch (err) {
+			console.error('failed to update block list:', err)
+			alert('Failed to update block list, see console for details')
+		} finally {
+			setPending(false)
+		}
+	}
+
+	return (
+		<div class={styles.friend}>
+			<button type="button" disabled={isPending()} onClick={toggle}>
+				{props.server.isBlocked(props.username)
+					? '✅ Unblock'
+					: '🚫 Block'}
+			</button>
+		</div>
+	)
+}
 
 const Page: Component = () => {
 	const { uuid, username } = useParams<{ uuid: string; username: string }>()
@@ -197,6 +435,10 @@ const Page: Component = () => {
 
 	return (
 		<div class={styles.container}>
+			<Show when={username !== server.username()}>
+				<FriendEditor server={server} username={username} />
+				<BlockButton server={server} username={username} />
+			</Show>
 			<Suspense fallback={<i>Loading profile...</i>}>
 				{resolved()}
 			</Suspense>
@@ -10,6 +10,7 @@ import {
 } from 'solid-js'
 import { useFileServerUrl, useGlobalState, useRpcClient } from '../ctx'
 import { Code, ConnectError } from '@connectrpc/connect'
+import { describeRpcError } from '../rpcerror'
 import { A, useLocation, useParams, useSearchParams } from '@solidjs/router'
 import { FileTable, FileTableItem } from '../FileTable'
 import { StreamSearchResponse } from '../../pb/clientrpc/v1/rpc_pb'
@@ -130,7 +131,7 @@ const Page: Component = () => {
 					return
 				}
 
-				setError(err.message)
+				setError(describeRpcError(err))
 			} else {
 				console.error('failed to stream search results:', err)
 				setError('Internal error, check console')
@@ -195,9 +196,12 @@ const Page: Component = () => {
 					const filePath =
 						item.data.directoryPath + '/' + item.meta.name
 					const username = item.data.username
+					const nickname = item.data.friend?.nickname
 
 					const prefix = (
-						<div class={styles.username}>👤{username}</div>
+						<div class={styles.username} title={username}>
+							👤{nickname || username}
+						</div>
 					)
 
 					if (item.meta.isDir) {
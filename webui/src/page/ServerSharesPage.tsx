@@ -5,7 +5,9 @@ import stylesCommon from '../common.module.css'
 
 import { useGlobalState } from '../ctx'
 import { ConnectError } from '@connectrpc/connect'
+import { describeRpcError } from '../rpcerror'
 import { useLocation, useParams } from '@solidjs/router'
+import { ShareConflictRule, ShareUnicodeForm } from '../protobuf'
 
 const Page: Component = () => {
 	const { uuid } = useParams<{ uuid: string }>()
@@ -26,6 +28,8 @@ const Page: Component = () => {
 	const [name, setName] = createSignal('')
 	const [path, setPath] = createSignal('')
 	const [followLinks, setFollowLinks] = createSignal(true)
+	const [excludePatterns, setExcludePatterns] = createSignal('')
+	const [looseMatching, setLooseMatching] = createSignal(false)
 
 	const [error, setError] = createSignal('')
 	const [isAdding, setAdding] = createSignal(false)
@@ -51,15 +55,27 @@ const Page: Component = () => {
 				name: name(),
 				path: path(),
 				followLinks: followLinks(),
+				mounts: [],
+				conflictRule: ShareConflictRule.FIRST,
+				excludePatterns: excludePatterns()
+					.split('\n')
+					.map((x) => x.trim())
+					.filter((x) => x !== ''),
+				caseInsensitive: looseMatching(),
+				unicodeForm: looseMatching()
+					? ShareUnicodeForm.NFC
+					: ShareUnicodeForm.NONE,
 			})
 
 			setSuccess(true)
 
 			setName('')
 			setPath('')
+			setExcludePatterns('')
+			setLooseMatching(false)
 		} catch (err) {
 			if (err instanceof ConnectError) {
-				setError(err.message)
+				setError(describeRpcError(err))
 			} else {
 				console.error('failed to create share:', err)
 				setError('Internal error, check console')
@@ -101,7 +117,7 @@ const Page: Component = () => {
 			console.error('failed to index share:', err)
 
 			if (err instanceof ConnectError) {
-				alert(err.message)
+				alert(describeRpcError(err))
 			}
 		} finally {
 			indexPendingNames.delete(name)
@@ -132,6 +148,10 @@ const Page: Component = () => {
 								Follow Symbolic Links?{' '}
 								{share.followLinks ? 'Yes' : 'No'}
 								<br />
+								<Show when={share.excludePatterns.length > 0}>
+									Excluded: {share.excludePatterns.join(', ')}
+									<br />
+								</Show>
 								<button onClick={() => doDelete(share.name)}>
 									Delete
 								</button>{' '}
@@ -209,6 +229,46 @@ const Page: Component = () => {
 								/>
 							</td>
 						</tr>
+
+						<tr>
+							<td>
+								<label for="add-share-exclude-patterns">
+									Exclude patterns (one per line)
+								</label>
+							</td>
+							<td>
+								<textarea
+									id="add-share-exclude-patterns"
+									placeholder={'*.tmp\n.git/\n/private'}
+									value={excludePatterns()}
+									onChange={(e) =>
+										setExcludePatterns(
+											e.currentTarget.value,
+										)
+									}
+								/>
+							</td>
+						</tr>
+
+						<tr>
+							<td>
+								<label for="add-share-loose-matching">
+									Match paths from other systems loosely?
+								</label>
+							</td>
+							<td>
+								<input
+									id="add-share-loose-matching"
+									type="checkbox"
+									checked={looseMatching()}
+									onChange={(e) =>
+										setLooseMatching(
+											e.currentTarget.checked,
+										)
+									}
+								/>
+							</td>
+						</tr>
 					</tbody>
 				</table>
 
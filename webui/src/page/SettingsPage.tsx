@@ -2,7 +2,14 @@ import { Component, createSignal, For, onMount, Show } from 'solid-js'
 
 import stylesCommon from '../common.module.css'
 import { ConnectError } from '@connectrpc/connect'
-import { useRpcClient } from '../ctx'
+import { describeRpcError } from '../rpcerror'
+import { useGlobalState, useRpcClient } from '../ctx'
+import {
+	PluginInfo,
+	PluginScope,
+	TrashedServer,
+	TrashedShare,
+} from '../protobuf'
 
 const P2pSettings: Component = () => {
 	const client = useRpcClient()
@@ -52,7 +59,7 @@ const P2pSettings: Component = () => {
 			setSuccess(true)
 		} catch (err) {
 			if (err instanceof ConnectError) {
-				setError(err.message)
+				setError(describeRpcError(err))
 			} else {
 				console.error('failed to update settings:', err)
 				setError('Internal error, check console')
@@ -370,12 +377,32 @@ const P2pSettings: Component = () => {
 
 const TransferSettings: Component = () => {
 	const client = useRpcClient()
+	const state = useGlobalState()
 
 	const [isLoading, setLoading] = createSignal(false)
 
 	const [concurrency, setConcurrency] = createSignal(1)
 	const [incompleteDir, setIncompleteDir] = createSignal('')
 	const [completeDir, setCompleteDir] = createSignal('')
+	const [pathTemplate, setPathTemplate] = createSignal('')
+	const [partFilesInIncomplete, setPartFilesInIncomplete] =
+		createSignal(false)
+	const [shutdownGrace, setShutdownGrace] = createSignal(0)
+	const [scanCommand, setScanCommand] = createSignal('')
+	const [quarantineDir, setQuarantineDir] = createSignal('')
+	const [serverDirs, setServerDirs] = createSignal<Record<string, string>>(
+		{},
+	)
+
+	const setServerDir = function (serverUuid: string, dir: string) {
+		const dirs = { ...serverDirs() }
+		if (dir === '') {
+			delete dirs[serverUuid]
+		} else {
+			dirs[serverUuid] = dir
+		}
+		setServerDirs(dirs)
+	}
 
 	const [error, setError] = createSignal('')
 	const [isSaving, setSaving] = createSignal(false)
@@ -397,13 +424,19 @@ const TransferSettings: Component = () => {
 					downloadConcurrency: concurrency(),
 					incompleteDownloadDir: incompleteDir(),
 					completeDownloadDir: completeDir(),
+					downloadPathTemplate: pathTemplate(),
+					serverCompleteDownloadDirs: serverDirs(),
+					partFilesInIncompleteDir: partFilesInIncomplete(),
+					shutdownGraceSeconds: shutdownGrace(),
+					scanCommand: scanCommand(),
+					quarantineDir: quarantineDir(),
 				},
 			})
 
 			setSuccess(true)
 		} catch (err) {
 			if (err instanceof ConnectError) {
-				setError(err.message)
+				setError(describeRpcError(err))
 			} else {
 				console.error('failed to update settings:', err)
 				setError('Internal error, check console')
@@ -422,6 +455,12 @@ const TransferSettings: Component = () => {
 			setConcurrency(cfg.downloadConcurrency)
 			setIncompleteDir(cfg.incompleteDownloadDir)
 			setCompleteDir(cfg.completeDownloadDir)
+			setPathTemplate(cfg.downloadPathTemplate)
+			setServerDirs(cfg.serverCompleteDownloadDirs)
+			setPartFilesInIncomplete(cfg.partFilesInIncompleteDir)
+			setShutdownGrace(cfg.shutdownGraceSeconds)
+			setScanCommand(cfg.scanCommand)
+			setQuarantineDir(cfg.quarantineDir)
 		} catch (err) {
 			console.error('failed to get transfer settings:', err)
 			setError('Internal error, check console')
@@ -441,6 +480,9 @@ const TransferSettings: Component = () => {
 			<p>
 				The complete/incomplete download directory settings require a
 				restart to take effect, but the rest will apply immediately.
+				Downloads are checked for enough free disk space before they
+				start, and are verified against the peer's copy before they
+				are saved.
 			</p>
 
 			<br />
@@ -487,6 +529,33 @@ const TransferSettings: Component = () => {
 									</td>
 								</tr>
 
+								<tr>
+									<td>
+										<label
+											for="setting-trans-shutdown-grace"
+											style="cursor:help"
+											title="When the client shuts down, it stops accepting new uploads and waits up to this many seconds for active uploads to finish. Set to 0 to shut down immediately."
+										>
+											Shutdown Grace Period
+											(seconds)<sup>🛈</sup>
+										</label>
+									</td>
+									<td>
+										<input
+											id="setting-trans-shutdown-grace"
+											type="number"
+											min={0}
+											max={3600}
+											onChange={(e) =>
+												setShutdownGrace(
+													Number(e.target.value),
+												)
+											}
+											value={shutdownGrace()}
+										/>
+									</td>
+								</tr>
+
 								<tr>
 									<td>
 										<label for="setting-trans-incomplete">
@@ -507,6 +576,31 @@ const TransferSettings: Component = () => {
 									</td>
 								</tr>
 
+								<tr>
+									<td>
+										<label
+											for="setting-trans-part-incomplete"
+											style="cursor:help"
+											title="If checked, downloads in progress are kept in the incomplete downloads location. Otherwise, they are kept next to where they will be saved, with a .part extension."
+										>
+											Keep Partial Downloads in Incomplete
+											Location<sup>🛈</sup>
+										</label>
+									</td>
+									<td>
+										<input
+											type="checkbox"
+											id="setting-trans-part-incomplete"
+											onChange={(e) =>
+												setPartFilesInIncomplete(
+													e.currentTarget.checked,
+												)
+											}
+											checked={partFilesInIncomplete()}
+										/>
+									</td>
+								</tr>
+
 								<tr>
 									<td>
 										<label for="setting-trans-complete">
@@ -526,6 +620,114 @@ const TransferSettings: Component = () => {
 										/>
 									</td>
 								</tr>
+
+								<tr>
+									<td>
+										<label
+											for="setting-trans-template"
+											style="cursor:help"
+											title="Where complete downloads are saved inside their location. Use {peer}, {server}, {server_uuid}, {room}, {path}, {dir} and {name}. Must contain {path} or {name}."
+										>
+											Download Path Template<sup>🛈</sup>
+										</label>
+									</td>
+									<td>
+										<input
+											type="text"
+											id="setting-trans-template"
+											value={pathTemplate()}
+											onInput={(e) =>
+												setPathTemplate(
+													e.currentTarget.value,
+												)
+											}
+										/>
+									</td>
+								</tr>
+
+								<For each={state.servers()}>
+									{(server) => (
+										<tr>
+											<td>
+												<label
+													for={`setting-trans-server-${server.uuid}`}
+													style="cursor:help"
+													title="Where complete downloads from this server are saved. Leave empty to use the complete downloads location."
+												>
+													Complete Downloads Location
+													for {server.name()}
+													<sup>🛈</sup>
+												</label>
+											</td>
+											<td>
+												<input
+													type="text"
+													id={`setting-trans-server-${server.uuid}`}
+													value={
+														serverDirs()[
+															server.uuid
+														] ?? ''
+													}
+													onInput={(e) =>
+														setServerDir(
+															server.uuid,
+															e.currentTarget
+																.value,
+														)
+													}
+												/>
+											</td>
+										</tr>
+									)}
+								</For>
+
+								<tr>
+									<td>
+										<label
+											for="setting-trans-scan-command"
+											style="cursor:help"
+											title="The absolute path of a command, such as clamdscan, that scans downloads before they are saved. It must exit with 0 if nothing was found, or 1 if a threat was found. Leave empty to not scan downloads."
+										>
+											Scan Command<sup>🛈</sup>
+										</label>
+									</td>
+									<td>
+										<input
+											type="text"
+											id="setting-trans-scan-command"
+											value={scanCommand()}
+											onInput={(e) =>
+												setScanCommand(
+													e.currentTarget.value,
+												)
+											}
+										/>
+									</td>
+								</tr>
+
+								<tr>
+									<td>
+										<label
+											for="setting-trans-quarantine"
+											style="cursor:help"
+											title="Where downloads that the scan command found a threat in are moved to. Requires a restart to take effect."
+										>
+											Quarantine Location<sup>🛈</sup>
+										</label>
+									</td>
+									<td>
+										<input
+											type="text"
+											id="setting-trans-quarantine"
+											value={quarantineDir()}
+											onInput={(e) =>
+												setQuarantineDir(
+													e.currentTarget.value,
+												)
+											}
+										/>
+									</td>
+								</tr>
 							</tbody>
 						</table>
 
@@ -543,6 +745,737 @@ const TransferSettings: Component = () => {
 	)
 }
 
+const BackupSettings: Component = () => {
+	const client = useRpcClient()
+	const state = useGlobalState()
+
+	const [exportPassword, setExportPassword] = createSignal('')
+	const [importPassword, setImportPassword] = createSignal('')
+	const [importFile, setImportFile] = createSignal<File | undefined>()
+
+	const [error, setError] = createSignal('')
+	const [success, setSuccess] = createSignal('')
+	const [failedShares, setFailedShares] = createSignal<string[]>([])
+	const [isWorking, setWorking] = createSignal(false)
+
+	const handleErr = function (err: unknown, action: string) {
+		if (err instanceof ConnectError) {
+			setError(describeRpcError(err))
+		} else {
+			console.error(`failed to ${action}:`, err)
+			setError('Internal error, check console')
+		}
+	}
+
+	const submitExport = async function (event: SubmitEvent) {
+		event.preventDefault()
+
+		if (isWorking()) {
+			return
+		}
+
+		setError('')
+		setSuccess('')
+		setFailedShares([])
+		setWorking(true)
+
+		try {
+			const res = await client.exportConfig({
+				password: exportPassword(),
+			})
+
+			const url = URL.createObjectURL(
+				new Blob([res.bundle], { type: 'application/octet-stream' }),
+			)
+			const link = document.createElement('a')
+			link.href = url
+			link.download = 'friendnet-config.fnconf'
+			link.click()
+			URL.revokeObjectURL(url)
+
+			setExportPassword('')
+			setSuccess('Configuration exported.')
+		} catch (err) {
+			handleErr(err, 'export configuration')
+		} finally {
+			setWorking(false)
+		}
+	}
+
+	const submitImport = async function (event: SubmitEvent) {
+		event.preventDefault()
+
+		if (isWorking()) {
+			return
+		}
+
+		const file = importFile()
+		if (!file) {
+			setError('No file selected')
+			return
+		}
+
+		setError('')
+		setSuccess('')
+		setFailedShares([])
+		setWorking(true)
+
+		try {
+			const res = await client.importConfig({
+				bundle: new Uint8Array(await file.arrayBuffer()),
+				password: importPassword(),
+			})
+
+			await state.refreshServers()
+
+			setImportPassword('')
+			setFailedShares(res.failedShares)
+			setSuccess(
+				`Imported ${res.servers.length} server(s), skipped ${res.skippedServers} existing server(s).`,
+			)
+		} catch (err) {
+			handleErr(err, 'import configuration')
+		} finally {
+			setWorking(false)
+		}
+	}
+
+	return (
+		<div>
+			<h2>Backup and Migration</h2>
+
+			<p>
+				Export your servers, shares and trusted server certificates to
+				a file encrypted with a password, then import it on another
+				machine or keep it as a backup.
+			</p>
+			<p>
+				The file contains your server passwords, so choose a strong
+				password and keep the file somewhere safe.
+			</p>
+
+			<br />
+
+			<Show when={error()}>
+				<div class={stylesCommon.errorMessage}>{error()}</div>
+			</Show>
+			<Show when={success()}>
+				<div class={stylesCommon.successMessage}>
+					{success()}
+					<Show when={failedShares().length > 0}>
+						<br />
+						Some shares could not be created:
+						<ul>
+							<For each={failedShares()}>
+								{(desc) => <li>{desc}</li>}
+							</For>
+						</ul>
+					</Show>
+				</div>
+			</Show>
+
+			<form onSubmit={submitExport} class={stylesCommon.form}>
+				<table>
+					<tbody>
+						<tr>
+							<td>
+								<label for="setting-export-password">
+									Password
+								</label>
+							</td>
+							<td>
+								<input
+									id="setting-export-password"
+									type="password"
+									value={exportPassword()}
+									onInput={(e) =>
+										setExportPassword(e.currentTarget.value)
+									}
+									required={true}
+								/>
+							</td>
+						</tr>
+					</tbody>
+				</table>
+
+				<input
+					type="submit"
+					value="Export Configuration"
+					disabled={isWorking()}
+				/>
+			</form>
+
+			<br />
+
+			<form onSubmit={submitImport} class={stylesCommon.form}>
+				<table>
+					<tbody>
+						<tr>
+							<td>
+								<label for="setting-import-file">File</label>
+							</td>
+							<td>
+								<input
+									id="setting-import-file"
+									type="file"
+									onChange={(e) =>
+										setImportFile(
+											e.currentTarget.files?.[0],
+										)
+									}
+									required={true}
+								/>
+							</td>
+						</tr>
+
+						<tr>
+							<td>
+								<label for="setting-import-password">
+									Password
+								</label>
+							</td>
+							<td>
+								<input
+									id="setting-import-password"
+									type="password"
+									value={importPassword()}
+									onInput={(e) =>
+										setImportPassword(e.currentTarget.value)
+									}
+									required={true}
+								/>
+							</td>
+						</tr>
+					</tbody>
+				</table>
+
+				<input
+					type="submit"
+					value="Import Configuration"
+					disabled={isWorking()}
+				/>
+			</form>
+		</div>
+	)
+}
+
+const DatabaseSettings: Component = () => {
+	const client = useRpcClient()
+
+	const [backupPath, setBackupPath] = createSignal('')
+
+	const [error, setError] = createSignal('')
+	const [success, setSuccess] = createSignal('')
+	const [problems, setProblems] = createSignal<string[]>([])
+	const [isWorking, setWorking] = createSignal(false)
+
+	const handleErr = function (err: unknown, action: string) {
+		if (err instanceof ConnectError) {
+			setError(describeRpcError(err))
+		} else {
+			console.error(`failed to ${action}:`, err)
+			setError('Internal error, check console')
+		}
+	}
+
+	const submitBackup = async function (event: SubmitEvent) {
+		event.preventDefault()
+
+		if (isWorking()) {
+			return
+		}
+
+		setError('')
+		setSuccess('')
+		setProblems([])
+		setWorking(true)
+
+		try {
+			await client.backupDatabase({
+				path: backupPath(),
+			})
+
+			setSuccess(`Database backed up to ${backupPath()}.`)
+			setBackupPath('')
+		} catch (err) {
+			handleErr(err, 'back up database')
+		} finally {
+			setWorking(false)
+		}
+	}
+
+	const checkIntegrity = async function () {
+		if (isWorking()) {
+			return
+		}
+
+		setError('')
+		setSuccess('')
+		setProblems([])
+		setWorking(true)
+
+		try {
+			const res = await client.checkDatabaseIntegrity({})
+			if (res.problems.length === 0) {
+				setSuccess('The database is intact.')
+			} else {
+				setProblems(res.problems)
+			}
+		} catch (err) {
+			handleErr(err, 'check database integrity')
+		} finally {
+			setWorking(false)
+		}
+	}
+
+	return (
+		<div>
+			<h2>Database</h2>
+
+			<p>
+				Back up the client's database, which holds all of its servers,
+				shares, settings and download history, to a file on this
+				machine while the client keeps running.
+			</p>
+
+			<br />
+
+			<Show when={error()}>
+				<div class={stylesCommon.errorMessage}>{error()}</div>
+			</Show>
+			<Show when={success()}>
+				<div class={stylesCommon.successMessage}>{success()}</div>
+			</Show>
+			<Show when={problems().length > 0}>
+				<div class={stylesCommon.errorMessage}>
+					The integrity check found problems:
+					<ul>
+						<For each={problems()}>
+							{(problem) => <li>{problem}</li>}
+						</For>
+					</ul>
+				</div>
+			</Show>
+
+			<form onSubmit={submitBackup} class={stylesCommon.form}>
+				<table>
+					<tbody>
+						<tr>
+							<td>
+								<label for="setting-backup-path">
+									Backup File Path
+								</label>
+							</td>
+							<td>
+								<input
+									id="setting-backup-path"
+									type="text"
+									value={backupPath()}
+									onInput={(e) =>
+										setBackupPath(e.currentTarget.value)
+									}
+									required={true}
+								/>
+							</td>
+						</tr>
+					</tbody>
+				</table>
+
+				<input
+					type="submit"
+					value="Back Up Database"
+					disabled={isWorking()}
+				/>
+			</form>
+
+			<br />
+
+			<button onClick={checkIntegrity} disabled={isWorking()}>
+				Check Integrity
+			</button>
+		</div>
+	)
+}
+
+const formatTs = (ts: bigint) => new Date(Number(ts) * 1000).toLocaleString()
+
+const TrashSettings: Component = () => {
+	const client = useRpcClient()
+	const state = useGlobalState()
+
+	const [servers, setServers] = createSignal<TrashedServer[]>([])
+	const [shares, setShares] = createSignal<TrashedShare[]>([])
+	const [error, setError] = createSignal('')
+	const [isWorking, setWorking] = createSignal(false)
+
+	const refresh = async function () {
+		const res = await client.getTrash({})
+		setServers(res.servers)
+		setShares(res.shares)
+	}
+
+	const run = async function (action: string, fn: () => Promise<unknown>) {
+		if (isWorking()) {
+			return
+		}
+
+		setError('')
+		setWorking(true)
+		try {
+			await fn()
+			await refresh()
+		} catch (err) {
+			if (err instanceof ConnectError) {
+				setError(describeRpcError(err))
+			} else {
+				console.error(`failed to ${action}:`, err)
+				setError('Internal error, check console')
+			}
+		} finally {
+			setWorking(false)
+		}
+	}
+
+	const restoreServer = (uuid: string) =>
+		run('restore server', async () => {
+			await client.restoreServer({ uuid })
+			await state.refreshServers()
+		})
+	const purgeServer = (uuid: string) => {
+		if (!confirm('Delete this server for good? This cannot be undone.')) {
+			return
+		}
+		run('purge server', () => client.purgeServer({ uuid }))
+	}
+	const restoreShare = (serverUuid: string, name: string) =>
+		run('restore share', async () => {
+			await client.restoreShare({ serverUuid, name })
+			await state.getServerByUuid(serverUuid)?.refreshShares()
+		})
+	const purgeShare = (serverUuid: string, name: string) => {
+		if (!confirm('Delete this share for good? This cannot be undone.')) {
+			return
+		}
+		run('purge share', () => client.purgeShare({ serverUuid, name }))
+	}
+
+	onMount(async () => {
+		try {
+			await refresh()
+		} catch (err) {
+			console.error('failed to get trash:', err)
+			setError('Internal error, check console')
+		}
+	})
+
+	return (
+		<div>
+			<h2>Trash</h2>
+
+			<p>
+				Deleted servers and shares are kept here for a while, so they
+				can be restored. They are deleted for good once they have been
+				in the trash for longer than the retention period.
+			</p>
+
+			<Show when={error()}>
+				<div class={stylesCommon.errorMessage}>{error()}</div>
+			</Show>
+
+			<Show
+				when={servers().length > 0 || shares().length > 0}
+				fallback={<p>The trash is empty.</p>}
+			>
+				<table>
+					<tbody>
+						<For each={servers()}>
+							{(server) => (
+								<tr>
+									<td>
+										Server <b>{server.name}</b> (
+										{server.address})
+									</td>
+									<td>
+										Deleted {formatTs(server.deletedTs)},
+										purged {formatTs(server.purgeTs)}
+									</td>
+									<td>
+										<button
+											onClick={() =>
+												restoreServer(server.uuid)
+											}
+											disabled={isWorking()}
+										>
+											Restore
+										</button>{' '}
+										<button
+											onClick={() =>
+												purgeServer(server.uuid)
+											}
+											disabled={isWorking()}
+										>
+											Delete Now
+										</button>
+									</td>
+								</tr>
+							)}
+						</For>
+						<For each={shares()}>
+							{(trashed) => (
+								<tr>
+									<td>
+										Share <b>{trashed.share!.name}</b> on{' '}
+										{state
+											.getServerByUuid(
+												trashed.share!.serverUuid,
+											)
+											?.name() ??
+											trashed.share!.serverUuid}
+									</td>
+									<td>
+										Deleted {formatTs(trashed.deletedTs)},
+										purged {formatTs(trashed.purgeTs)}
+									</td>
+									<td>
+										<button
+											onClick={() =>
+												restoreShare(
+													trashed.share!.serverUuid,
+													trashed.share!.name,
+												)
+											}
+											disabled={isWorking()}
+										>
+											Restore
+										</button>{' '}
+										<button
+											onClick={() =>
+												purgeShare(
+													trashed.share!.serverUuid,
+													trashed.share!.name,
+												)
+											}
+											disabled={isWorking()}
+										>
+											Delete Now
+										</button>
+									</td>
+								</tr>
+							)}
+						</For>
+					</tbody>
+				</table>
+			</Show>
+		</div>
+	)
+}
+
+const pluginScopeLabels: [PluginScope, string][] = [
+	[PluginScope.EVENTS, 'Receive client events'],
+	[PluginScope.SEARCH, 'Answer searches from peers'],
+	[PluginScope.READ, 'Read servers, shares and files'],
+	[PluginScope.DOWNLOADS, 'Queue and control downloads'],
+	[PluginScope.SHARES, 'Create and delete shares'],
+]
+
+const PluginSettings: Component = () => {
+	const client = useRpcClient()
+
+	const [plugins, setPlugins] = createSignal<PluginInfo[]>([])
+	const [name, setName] = createSignal('')
+	const [scopes, setScopes] = createSignal<PluginScope[]>([])
+	const [newToken, setNewToken] = createSignal('')
+	const [error, setError] = createSignal('')
+	const [isWorking, setWorking] = createSignal(false)
+
+	const refresh = async function () {
+		const res = await client.getPlugins({})
+		setPlugins(res.plugins)
+	}
+
+	const run = async function (action: string, fn: () => Promise<unknown>) {
+		if (isWorking()) {
+			return
+		}
+
+		setError('')
+		setWorking(true)
+		try {
+			await fn()
+			await refresh()
+		} catch (err) {
+			if (err instanceof ConnectError) {
+				setError(describeRpcError(err))
+			} else {
+				console.error(`failed to ${action}:`, err)
+				setError('Internal error, check console')
+			}
+		} finally {
+			setWorking(false)
+		}
+	}
+
+	const toggleScope = function (scope: PluginScope, enabled: boolean) {
+		setScopes((prev) =>
+			enabled ? [...prev, scope] : prev.filter((s) => s !== scope),
+		)
+	}
+
+	const submit = function (event: SubmitEvent) {
+		event.preventDefault()
+
+		setNewToken('')
+		run('create plugin', async () => {
+			const res = await client.createPlugin({
+				name: name(),
+				scopes: scopes(),
+			})
+			setNewToken(res.token)
+			setName('')
+			setScopes([])
+		})
+	}
+
+	const deletePlugin = (pluginName: string) => {
+		if (
+			!confirm(
+				`Delete plugin ${pluginName}? Its token will stop working.`,
+			)
+		) {
+			return
+		}
+		run('delete plugin', () => client.deletePlugin({ name: pluginName }))
+	}
+
+	const scopeLabel = (scope: PluginScope) =>
+		pluginScopeLabels.find(([s]) => s === scope)?.[1] ?? String(scope)
+
+	onMount(async () => {
+		try {
+			await refresh()
+		} catch (err) {
+			console.error('failed to get plugins:', err)
+			setError('Internal error, check console')
+		}
+	})
+
+	return (
+		<div>
+			<h2>Plugins</h2>
+
+			<p>
+				Plugins are programs such as search bots and auto-sharing
+				scripts that use the client's RPC interface with their own
+				token. They can only do what their scopes allow.
+			</p>
+
+			<Show when={error()}>
+				<div class={stylesCommon.errorMessage}>{error()}</div>
+			</Show>
+			<Show when={newToken()}>
+				<div class={stylesCommon.successMessage}>
+					Plugin created. Its token is <code>{newToken()}</code>.
+					Copy it now, since it will not be shown again.
+				</div>
+			</Show>
+
+			<Show when={plugins().length > 0} fallback={<p>No plugins.</p>}>
+				<table>
+					<tbody>
+						<For each={plugins()}>
+							{(plugin) => (
+								<tr>
+									<td>
+										<b>{plugin.name}</b>
+									</td>
+									<td>
+										{plugin.scopes
+											.map(scopeLabel)
+											.join(', ') || 'No scopes'}
+									</td>
+									<td>
+										{plugin.openStreams > 0
+											? 'Connected'
+											: 'Not connected'}
+									</td>
+									<td>
+										<button
+											onClick={() =>
+												deletePlugin(plugin.name)
+											}
+											disabled={isWorking()}
+										>
+											Delete
+										</button>
+									</td>
+								</tr>
+							)}
+						</For>
+					</tbody>
+				</table>
+			</Show>
+
+			<br />
+
+			<form onSubmit={submit} class={stylesCommon.form}>
+				<table>
+					<tbody>
+						<tr>
+							<td>
+								<label for="setting-plugin-name">Name</label>
+							</td>
+							<td>
+								<input
+									id="setting-plugin-name"
+									type="text"
+									value={name()}
+									onInput={(e) =>
+										setName(e.currentTarget.value)
+									}
+									maxLength={64}
+									required={true}
+								/>
+							</td>
+						</tr>
+						<For each={pluginScopeLabels}>
+							{([scope, label]) => (
+								<tr>
+									<td>
+										<label
+											for={`setting-plugin-scope-${scope}`}
+										>
+											{label}
+										</label>
+									</td>
+									<td>
+										<input
+											id={`setting-plugin-scope-${scope}`}
+											type="checkbox"
+											onChange={(e) =>
+												toggleScope(
+													scope,
+													e.currentTarget.checked,
+												)
+											}
+											checked={scopes().includes(scope)}
+										/>
+									</td>
+								</tr>
+							)}
+						</For>
+					</tbody>
+				</table>
+
+				<input
+					type="submit"
+					value="Create Plugin"
+					disabled={isWorking()}
+				/>
+			</form>
+		</div>
+	)
+}
+
 export const SettingsPage: Component = () => {
 	return (
 		<div
@@ -555,6 +1488,10 @@ export const SettingsPage: Component = () => {
 
 			<P2pSettings />
 			<TransferSettings />
+			<BackupSettings />
+			<DatabaseSettings />
+			<TrashSettings />
+			<PluginSettings />
 		</div>
 	)
 }
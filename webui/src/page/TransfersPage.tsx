@@ -3,6 +3,7 @@ import styles from './TransfersPage.module.css'
 import {
 	Component,
 	createMemo,
+	createSignal,
 	For,
 	JSX,
 	Match,
@@ -14,9 +15,16 @@ import { useGlobalState, useRpcClient } from '../ctx'
 import { formatSize, formatSpeed, makeBrowsePath } from '../util'
 import { Download } from '../transfer'
 import { A } from '@solidjs/router'
-import { DownloadStatus } from '../../pb/clientrpc/v1/rpc_pb'
+import {
+	DownloadStatus,
+	ScanStatus,
+	SnoozeInfo,
+	UploadInfo,
+	UploadStatus,
+} from '../../pb/clientrpc/v1/rpc_pb'
 import { RpcClient } from '../protobuf'
 import { Code, ConnectError } from '@connectrpc/connect'
+import { describeRpcError } from '../rpcerror'
 
 async function doRemove(client: RpcClient, uuid: string): Promise<void> {
 	try {
@@ -25,7 +33,7 @@ async function doRemove(client: RpcClient, uuid: string): Promise<void> {
 		if (err instanceof ConnectError) {
 			if (err.code !== Code.NotFound) {
 				console.error('failed to remove item:', err)
-				alert('Failed to remove item: ' + err.message)
+				alert('Failed to remove item: ' + describeRpcError(err))
 				return
 			}
 		} else {
@@ -52,6 +60,101 @@ async function doResume(client: RpcClient, uuid: string): Promise<void> {
 	}
 }
 
+/**
+ * Snooze durations that can be picked, in seconds.
+ * Undefined snoozes until resumed manually.
+ */
+const snoozeDurations: { label: string; seconds: number | undefined }[] = [
+	{ label: '15 minutes', seconds: 15 * 60 },
+	{ label: '1 hour', seconds: 60 * 60 },
+	{ label: 'Until resumed', seconds: undefined },
+]
+
+/**
+ * Pauses all uploads and downloads, optionally hiding shares, for a duration.
+ */
+const SnoozeControl: Component = () => {
+	const client = useRpcClient()
+
+	const [snooze, setSnooze] = createSignal<SnoozeInfo | null>(null)
+	const [durationIdx, setDurationIdx] = createSignal(0)
+	const [hideShares, setHideShares] = createSignal(false)
+
+	const load = async () => {
+		const res = await client.getSnooze({})
+		setSnooze(res.snooze ?? null)
+	}
+
+	onMount(() => {
+		load().catch((err) => console.error('failed to load snooze:', err))
+	})
+
+	const doSnooze = async function () {
+		try {
+			const res = await client.snooze({
+				durationSeconds: snoozeDurations[durationIdx()].seconds,
+				hideShares: hideShares(),
+			})
+			setSnooze(res.snooze ?? null)
+		} catch (err) {
+			console.error('failed to snooze:', err)
+			alert('Failed to snooze, check console for details')
+		}
+	}
+
+	const doUnsnooze = async function () {
+		try {
+			await client.unsnooze({})
+			await load()
+		} catch (err) {
+			console.error('failed to end snooze:', err)
+			alert('Failed to end snooze, check console for details')
+		}
+	}
+
+	return (
+		<div class={styles.snooze}>
+			<Show
+				when={snooze()?.active}
+				fallback={
+					<>
+						<select
+							value={durationIdx()}
+							onChange={(e) =>
+								setDurationIdx(Number(e.currentTarget.value))
+							}
+						>
+							<For each={snoozeDurations}>
+								{(d, i) => <option value={i()}>{d.label}</option>}
+							</For>
+						</select>{' '}
+						<label>
+							<input
+								type="checkbox"
+								checked={hideShares()}
+								onChange={(e) =>
+									setHideShares(e.currentTarget.checked)
+								}
+							/>{' '}
+							Hide shares
+						</label>{' '}
+						<button onClick={doSnooze}>💤 Snooze transfers</button>
+					</>
+				}
+			>
+				<span class={styles.info}>
+					💤 Transfers are snoozed
+					{snooze()?.untilTs != null
+						? ` until ${new Date(Number(snooze()!.untilTs) * 1000).toLocaleTimeString()}`
+						: ' until resumed'}
+					{snooze()?.hideShares ? ', shares are hidden' : ''}
+				</span>{' '}
+				<button onClick={doUnsnooze}>Resume</button>
+			</Show>
+		</div>
+	)
+}
+
 const DownloadFolder: Component<{
 	server: Download['server']
 	peerUsername: Download['peerUsername']
@@ -151,6 +254,13 @@ const DownloadItem: Component<{ item: Download }> = (props) => {
 						</Match>
 						<Match when={item.status() === DownloadStatus.DONE}>
 							<b>Done</b>
+							<Show
+								when={
+									item.scanStatus() === ScanStatus.CLEAN
+								}
+							>
+								{' (scanned)'}
+							</Show>
 						</Match>
 						<Match when={item.status() === DownloadStatus.PENDING}>
 							<button
@@ -175,6 +285,13 @@ const DownloadItem: Component<{ item: Download }> = (props) => {
 							>
 								🔄
 							</button>
+							<Show
+								when={
+									item.scanStatus() === ScanStatus.INFECTED
+								}
+							>
+								<b>Quarantined</b>{' '}
+							</Show>
 							<span class={styles.errorMessage}>
 								Error: {item.errorMessage()}
 							</span>
@@ -198,6 +315,70 @@ const DownloadItem: Component<{ item: Download }> = (props) => {
 	)
 }
 
+const UploadItem: Component<{ upload: UploadInfo }> = (props) => {
+	const state = useGlobalState()
+
+	const upload = props.upload
+	const server = state.getServerByUuid(upload.serverUuid)
+	const filename = upload.filePath.substring(
+		upload.filePath.lastIndexOf('/') + 1,
+	)
+	const total = Number(upload.fileSize - upload.offset)
+	const sent = Number(upload.bytesSent)
+
+	return (
+		<div
+			classList={{
+				[styles.transfer]: true,
+				[styles.pending]: upload.status === UploadStatus.IN_PROGRESS,
+				[styles.done]: upload.status === UploadStatus.DONE,
+				[styles.canceled]: upload.status === UploadStatus.CANCELED,
+				[styles.error]: upload.status === UploadStatus.ERROR,
+			}}
+		>
+			<div class={styles.info} title={upload.filePath}>
+				{filename}
+			</div>
+			<div class={styles.progress}>
+				<Show when={upload.status === UploadStatus.IN_PROGRESS}>
+					<progress value={total === 0 ? 1 : sent / total} max="1" />
+				</Show>
+				<div class={styles.stats}>
+					{'👤 '}
+					{upload.peerUsername}
+					{' @ '}
+					{server?.name() ?? upload.serverUuid}
+					{' | '}
+					{formatSize(sent, 2)}
+					{' / '}
+					{formatSize(total, 2)}
+					{' | '}
+					<Switch>
+						<Match when={upload.status === UploadStatus.IN_PROGRESS}>
+							{formatSpeed(Number(upload.speed))}
+						</Match>
+						<Match when={upload.status === UploadStatus.DONE}>
+							<b>Done</b>
+						</Match>
+						<Match when={upload.status === UploadStatus.CANCELED}>
+							<b>Canceled</b>
+						</Match>
+						<Match when={upload.status === UploadStatus.ERROR}>
+							<span class={styles.errorMessage}>
+								Error: {upload.errorMessage}
+							</span>
+						</Match>
+					</Switch>
+					{' | '}
+					{new Date(
+						Number(upload.endedTs ?? upload.startedTs) * 1000,
+					).toLocaleString()}
+				</div>
+			</div>
+		</div>
+	)
+}
+
 /**
  * The transfers page shows active transfers (uploads and downloads) and allows management of them.
  */
@@ -210,8 +391,20 @@ export const TransfersPage: Component = () => {
 		trans
 			.refreshItems()
 			.catch((err) => console.error('failed to refresh transfers:', err))
+		trans
+			.refreshUploads()
+			.catch((err) => console.error('failed to refresh uploads:', err))
 	})
 
+	const clearUploadHistory = async function () {
+		try {
+			await trans.clearUploadHistory()
+		} catch (err) {
+			console.error('failed to clear upload history:', err)
+			alert('Failed to clear upload history, check console for details')
+		}
+	}
+
 	const sortedItems = createMemo(() => {
 		const items = trans.downloads()
 
@@ -289,6 +482,8 @@ export const TransfersPage: Component = () => {
 
 	return (
 		<div class={styles.container}>
+			<SnoozeControl />
+
 			<h1>Downloads</h1>
 
 			<Show
@@ -297,6 +492,28 @@ export const TransfersPage: Component = () => {
 			>
 				{markup()}
 			</Show>
+
+			<h1>Uploads</h1>
+
+			<Show
+				when={
+					trans.activeUploads().length > 0 ||
+					trans.uploadHistory().length > 0
+				}
+				fallback={<i>No uploads yet.</i>}
+			>
+				<For each={trans.activeUploads()}>
+					{(upload) => <UploadItem upload={upload} />}
+				</For>
+				<Show when={trans.uploadHistory().length > 0}>
+					<button onClick={clearUploadHistory}>
+						Clear Upload History
+					</button>
+				</Show>
+				<For each={trans.uploadHistory()}>
+					{(upload) => <UploadItem upload={upload} />}
+				</For>
+			</Show>
 		</div>
 	)
 }
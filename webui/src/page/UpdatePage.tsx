@@ -1,14 +1,62 @@
 import styles from './UpdatePage.module.css'
 
-import { Component, createSignal, Show } from 'solid-js'
-import { useGlobalState } from '../ctx'
+import { Component, createSignal, onMount, Show } from 'solid-js'
+import { ConnectError } from '@connectrpc/connect'
+import { describeRpcError } from '../rpcerror'
+import { useGlobalState, useRpcClient } from '../ctx'
+import { UpdateChannel } from '../protobuf'
 
 /**
  * The update page shows information about the current update and the latest update, if known.
- * It also allows for manually checking for updates.
+ * It also allows for manually checking for updates, installing them, and choosing the release channel.
  */
 export const UpdatePage: Component = () => {
 	const state = useGlobalState()
+	const client = useRpcClient()
+
+	const [channel, setChannel] = createSignal(UpdateChannel.STABLE)
+	const [baseUrl, setBaseUrl] = createSignal('')
+	onMount(async () => {
+		try {
+			const res = await client.getUpdateSettings({})
+			setChannel(res.settings!.channel || UpdateChannel.STABLE)
+			setBaseUrl(res.settings!.baseUrl)
+		} catch (err) {
+			console.error('failed to get update settings:', err)
+		}
+	})
+	const changeChannel = async (newChannel: UpdateChannel) => {
+		try {
+			await client.updateUpdateSettings({
+				settings: {
+					channel: newChannel,
+					baseUrl: baseUrl(),
+				},
+			})
+			setChannel(newChannel)
+			await doCheck()
+		} catch (err) {
+			alert('Internal error, check console')
+			console.error('failed to update update settings:', err)
+		}
+	}
+
+	const [isApplying, setApplying] = createSignal(false)
+	const doApply = async () => {
+		setApplying(true)
+		try {
+			await state.applyUpdate()
+		} catch (err) {
+			if (err instanceof ConnectError) {
+				alert(describeRpcError(err))
+			} else {
+				alert('Internal error, check console')
+			}
+			console.error('failed to apply update:', err)
+		} finally {
+			setApplying(false)
+		}
+	}
 
 	const [isChecking, setChecking] = createSignal(false)
 	const doCheck = async () => {
@@ -56,6 +104,26 @@ export const UpdatePage: Component = () => {
 						<pre class={styles.description}>
 							{state.latestUpdate()!.description}
 						</pre>
+						<Show
+							when={state.updateStaged()}
+							fallback={
+								<Show when={state.latestUpdate()!.canApply}>
+									<button
+										disabled={isApplying()}
+										onClick={doApply}
+									>
+										{isApplying()
+											? 'Downloading...'
+											: 'Install Update'}
+									</button>
+								</Show>
+							}
+						>
+							<p>
+								The update was downloaded and will be installed
+								when you restart the client.
+							</p>
+						</Show>
 						<a href={state.latestUpdate()!.url} target="_blank">
 							[Download]
 						</a>
@@ -75,6 +143,23 @@ export const UpdatePage: Component = () => {
 			>
 				<button disabled={true}>Checking...</button>
 			</Show>
+
+			<p>
+				<label>
+					Release channel:{' '}
+					<select
+						value={channel()}
+						onChange={(e) =>
+							changeChannel(
+								Number(e.currentTarget.value) as UpdateChannel,
+							)
+						}
+					>
+						<option value={UpdateChannel.STABLE}>Stable</option>
+						<option value={UpdateChannel.BETA}>Beta</option>
+					</select>
+				</label>
+			</p>
 		</div>
 	)
 }
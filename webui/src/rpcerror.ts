@@ -0,0 +1,75 @@
+import type { ConnectError } from '@connectrpc/connect'
+import {
+	type ErrorInfo,
+	ErrorInfoSchema,
+	ErrorReason,
+} from '../pb/clientrpc/v1/rpc_pb'
+
+const reasonMessages: Partial<Record<ErrorReason, string>> = {
+	[ErrorReason.AUTH_INVALID_CREDENTIALS]:
+		'The server rejected your username or password',
+	[ErrorReason.AUTH_BANNED]: 'You are banned from this room',
+	[ErrorReason.AUTH_ALREADY_CONNECTED]:
+		'You are already connected to this room from another client',
+	[ErrorReason.AUTH_RATE_LIMITED]:
+		'Too many login attempts, wait a while and try again',
+	[ErrorReason.AUTH_REGISTRATION_DISABLED]:
+		'Registration is disabled in this room, ask its admin for an account',
+	[ErrorReason.AUTH_INVALID_INVITE_CODE]:
+		'The invite code is invalid or has expired',
+	[ErrorReason.AUTH_USERNAME_TAKEN]: 'That username is already taken',
+	[ErrorReason.AUTH_INVALID_PASSWORD]:
+		"The password does not meet the server's requirements",
+	[ErrorReason.AUTH_REJECTED]: 'The server rejected the login',
+	[ErrorReason.VERSION_TOO_OLD]:
+		'This client is too old for the server, update FriendNet',
+	[ErrorReason.VERSION_TOO_NEW]:
+		'This client is too new for the server, ask its admin to update it',
+	[ErrorReason.VERSION_REJECTED]:
+		"The server does not support this client's version",
+	[ErrorReason.CERT_NOT_VALID_NOW]:
+		"The server's certificate is expired or not valid yet, check your system clock",
+	[ErrorReason.NO_SERVER_CERTS]: 'The server did not present a certificate',
+	[ErrorReason.PEER_UNREACHABLE]:
+		'The user could not be reached, they may be offline',
+	[ErrorReason.PEER_TIMEOUT]: 'The user took too long to respond',
+}
+
+/**
+ * Returns a message to show for an RPC error.
+ * Errors with a known cause are described with an actionable message, and others fall back to the error's own message.
+ * @param err The error.
+ * @returns The message.
+ */
+export function describeRpcError(err: ConnectError): string {
+	const [info] = err.findDetails(ErrorInfoSchema)
+	if (info === undefined) {
+		return err.message
+	}
+	return describeErrorInfo(info) ?? err.message
+}
+
+/**
+ * Returns a message describing the cause of an error, or undefined if the cause is not known.
+ * @param info The error's details.
+ * @returns The message.
+ */
+export function describeErrorInfo(info: ErrorInfo): string | undefined {
+	let msg: string | undefined
+	switch (info.reason) {
+		case ErrorReason.CERT_MISMATCH:
+		case ErrorReason.CERT_FINGERPRINT_MISMATCH:
+			msg = `The certificate of ${info.host ?? 'the server'} changed since you last connected, it may be impersonated`
+			break
+		default:
+			msg = reasonMessages[info.reason]
+	}
+	if (msg === undefined) {
+		return undefined
+	}
+
+	if (info.message) {
+		return `${msg}: ${info.message}`
+	}
+	return msg
+}
@@ -1,15 +1,20 @@
 import { Accessor, createSignal, Setter } from 'solid-js'
 import {
+	BlockedPeerInfo,
 	CreateServerRequest,
 	CreateShareRequest,
 	Event,
+	Event_ShutdownDrain,
 	Event_Type,
 	EventContext,
+	FriendInfo,
+	ImportInviteBundleRequest,
 	LogMessage,
 	LogMessageAttr,
 	OnlineUserInfo,
 	ServerConnState,
 	ServerInfo,
+	SetFriendRequest,
 	ShareInfo,
 	UpdateInfo,
 	UpdateServerRequest,
@@ -62,12 +67,36 @@ class Refresher {
 export class OnlineUser {
 	readonly username: string
 
+	/**
+	 * The user's friend info, if they are a friend.
+	 */
+	friend: Accessor<FriendInfo | undefined>
+	readonly setFriend: Setter<FriendInfo | undefined>
+
+	/**
+	 * Whether the user is on the block list.
+	 */
+	blocked: Accessor<boolean>
+	readonly setBlocked: Setter<boolean>
+
 	constructor(info: OnlineUserInfo) {
 		this.username = info.username
+		;[this.friend, this.setFriend] = createSignal<FriendInfo | undefined>(
+			info.friend,
+		)
+		;[this.blocked, this.setBlocked] = createSignal(info.blocked)
 	}
 
-	updateFromInfo(_info: OnlineUserInfo): void {
-		// Nothing to do for now.
+	/**
+	 * Returns the user's nickname if they have one, otherwise their username.
+	 */
+	displayName(): string {
+		return this.friend()?.nickname || this.username
+	}
+
+	updateFromInfo(info: OnlineUserInfo): void {
+		this.setFriend(info.friend)
+		this.setBlocked(info.blocked)
 	}
 }
 
@@ -80,6 +109,7 @@ export class ServerShare {
 	readonly path: string
 	readonly followLinks: boolean
 	readonly createdTs: Date
+	readonly excludePatterns: string[]
 
 	constructor(info: ShareInfo) {
 		this.uuid = info.uuid
@@ -87,6 +117,7 @@ export class ServerShare {
 		this.path = info.path
 		this.followLinks = info.followLinks
 		this.createdTs = new Date(Number(info.createdTs) * 1_000)
+		this.excludePatterns = info.excludePatterns
 	}
 
 	updateFromInfo(_info: ShareInfo): void {
@@ -125,6 +156,12 @@ export class Server {
 	shares: Accessor<ServerShare[]>
 	#setShares: Setter<ServerShare[]>
 
+	friends: Accessor<FriendInfo[]>
+	#setFriends: Setter<FriendInfo[]>
+
+	blockedPeers: Accessor<BlockedPeerInfo[]>
+	#setBlockedPeers: Setter<BlockedPeerInfo[]>
+
 	constructor(client: RpcClient, refresher: Refresher, info: ServerInfo) {
 		this.#client = client
 		this.#refresher = refresher
@@ -142,6 +179,10 @@ export class Server {
 			[],
 		)
 		;[this.shares, this.#setShares] = createSignal<ServerShare[]>([])
+		;[this.friends, this.#setFriends] = createSignal<FriendInfo[]>([])
+		;[this.blockedPeers, this.#setBlockedPeers] = createSignal<
+			BlockedPeerInfo[]
+		>([])
 
 		this.updateFromInfo(info)
 	}
@@ -151,6 +192,12 @@ export class Server {
 
 		if (state === ServerConnState.OPEN) {
 			this.refreshOnlineUsers()
+			this.refreshFriends().catch((err) => {
+				console.error('failed to refresh friends', err)
+			})
+			this.refreshBlockedPeers().catch((err) => {
+				console.error('failed to refresh blocked peers', err)
+			})
 		} else {
 			this.#setOnlineUsers([])
 		}
@@ -163,6 +210,12 @@ export class Server {
 	 * @param info The online user's info.
 	 */
 	setUserOnline(info: OnlineUserInfo) {
+		// Online events do not include friend info, so use what is known.
+		if (!info.friend) {
+			info.friend = this.getFriend(info.username)
+		}
+		info.blocked ||= this.isBlocked(info.username)
+
 		const cur = this.onlineUsers().find((x) => x.username === info.username)
 		if (cur) {
 			cur.updateFromInfo(info)
@@ -192,13 +245,139 @@ export class Server {
 		this.#refresher.onlineUsers.add(this.uuid)
 	}
 
+	/**
+	 * Returns the friend info for a user, if they are a friend.
+	 * @param username The user's username.
+	 */
+	getFriend(username: string): FriendInfo | undefined {
+		return this.friends().find((x) => x.username === username)
+	}
+
+	async refreshFriends(): Promise<void> {
+		const { friends } = await this.#client.getFriends({
+			serverUuid: this.uuid,
+		})
+		this.#setFriends(friends)
+
+		for (const user of this.onlineUsers()) {
+			user.setFriend(friends.find((x) => x.username === user.username))
+		}
+	}
+
+	/**
+	 * Sets the nickname, note, and trust level for a user.
+	 * @param req The friend info to set.
+	 */
+	async setFriend(
+		req: Omit<SetFriendRequest, '$typeName' | 'serverUuid'>,
+	): Promise<FriendInfo> {
+		const { friend } = await this.#client.setFriend({
+			serverUuid: this.uuid,
+			...req,
+		})
+
+		const newFriends = this.friends().filter(
+			(x) => x.username !== friend!.username,
+		)
+		newFriends.push(friend!)
+		newFriends.sort((a, b) => a.username.localeCompare(b.username))
+		this.#setFriends(newFriends)
+
+		this.onlineUsers()
+			.find((x) => x.username === friend!.username)
+			?.setFriend(friend)
+
+		return friend!
+	}
+
+	/**
+	 * Deletes the friend info for a user.
+	 * @param username The user's username.
+	 * @returns Whether the user had friend info.
+	 */
+	async deleteFriend(username: string): Promise<boolean> {
+		try {
+			await this.#client.deleteFriend({ serverUuid: this.uuid, username })
+		} catch (err) {
+			if (err instanceof ConnectError && err.code === Code.NotFound) {
+				return false
+			}
+
+			throw err
+		}
+
+		this.#setFriends(this.friends().filter((x) => x.username !== username))
+		this.onlineUsers()
+			.find((x) => x.username === username)
+			?.setFriend(undefined)
+		return true
+	}
+
+	/**
+	 * Returns whether a user is on the block list.
+	 * @param username The user's username.
+	 */
+	isBlocked(username: string): boolean {
+		return this.blockedPeers().some((x) => x.username === username)
+	}
+
+	async refreshBlockedPeers(): Promise<void> {
+		const { peers } = await this.#client.getBlockedPeers({
+			serverUuid: this.uuid,
+		})
+		this.#setBlockedPeers(peers)
+
+		for (const user of this.onlineUsers()) {
+			user.setBlocked(peers.some((x) => x.username === user.username))
+		}
+	}
+
+	/**
+	 * Adds a user to the block list.
+	 * Their requests are rejected, and their results are left out of searches.
+	 * @param username The user's username.
+	 */
+	async blockPeer(username: string): Promise<void> {
+		await this.#client.blockPeer({ serverUuid: this.uuid, username })
+		await this.refreshBlockedPeers()
+	}
+
+	/**
+	 * Removes a user from the block list.
+	 * @param username The user's username.
+	 * @returns Whether the user was blocked.
+	 */
+	async unblockPeer(username: string): Promise<boolean> {
+		try {
+			await this.#client.unblockPeer({ serverUuid: this.uuid, username })
+		} catch (err) {
+			if (err instanceof ConnectError && err.code === Code.NotFound) {
+				return false
+			}
+
+			throw err
+		}
+
+		await this.refreshBlockedPeers()
+		return true
+	}
+
 	async refreshShares(): Promise<void> {
-		const res = await this.#client.getShares({ serverUuid: this.uuid })
+		const infos: ShareInfo[] = []
+		let cursor = ''
+		do {
+			const res = await this.#client.getShares({
+				serverUuid: this.uuid,
+				cursor,
+			})
+			infos.push(...res.shares)
+			cursor = res.nextCursor
+		} while (cursor)
 
 		const curShares = this.shares()
 		const newShares: ServerShare[] = []
 
-		for (const info of res.shares) {
+		for (const info of infos) {
 			const cur = curShares.find((x) => x.path === info.path)
 			if (cur) {
 				cur.updateFromInfo(info)
@@ -706,6 +885,23 @@ export class State {
 	readonly latestUpdate: Accessor<UpdateInfo | undefined>
 	readonly #setLatestUpdate: Setter<UpdateInfo | undefined>
 
+	/**
+	 * Whether an update was downloaded and will be installed when the client restarts.
+	 */
+	readonly updateStaged: Accessor<boolean>
+	readonly #setUpdateStaged: Setter<boolean>
+
+	/**
+	 * Set while the client is shutting down and waiting for uploads to finish.
+	 */
+	readonly shutdownDrain: Accessor<Event_ShutdownDrain | undefined>
+	readonly #setShutdownDrain: Setter<Event_ShutdownDrain | undefined>
+
+	/**
+	 * UUIDs of servers the user was already warned about clock differences with.
+	 */
+	readonly #clockSkewWarned = new Set<string>()
+
 	constructor(client: RpcClient) {
 		this.#client = client
 
@@ -725,10 +921,15 @@ export class State {
 				version: '',
 				description: '',
 				url: '',
+				canApply: false,
 			})
 		;[this.latestUpdate, this.#setLatestUpdate] = createSignal<
 			UpdateInfo | undefined
 		>()
+		;[this.updateStaged, this.#setUpdateStaged] = createSignal(false)
+		;[this.shutdownDrain, this.#setShutdownDrain] = createSignal<
+			Event_ShutdownDrain | undefined
+		>()
 
 		// Listen to server events.
 		this.event.addEventListener(Event_Type.STOP, () => {
@@ -737,6 +938,9 @@ export class State {
 				setTimeout(() => window.location.assign('about:blank'), 100)
 			}
 		})
+		this.event.addEventListener(Event_Type.SHUTDOWN_DRAIN, (event) => {
+			this.#setShutdownDrain(event.shutdownDrain!)
+		})
 		this.event.addEventListener(Event_Type.NEW_UPDATE, (event) => {
 			this.#setLatestUpdate(event.newUpdate!.info)
 		})
@@ -767,6 +971,52 @@ export class State {
 
 			server.setUserOffline(event.clientOffline!.username)
 		})
+		this.event.addEventListener(Event_Type.SERVER_NOTICE, (event, ctx) => {
+			const server = this.getServerByUuid(ctx.serverUuid)
+			if (server == null) {
+				return
+			}
+
+			alert(
+				`Notice from ${server.name()}:\n\n${event.serverNotice!.text}`,
+			)
+		})
+		this.event.addEventListener(Event_Type.CLOCK_SKEW, (event, ctx) => {
+			const server = this.getServerByUuid(ctx.serverUuid)
+			if (server == null) {
+				return
+			}
+
+			const skewMs = Number(event.clockSkew!.skewMs)
+			console.warn(
+				`clock differs from server ${server.name()} by ${skewMs}ms`,
+			)
+
+			// Only warn once per server, since the event is sent on every reconnect.
+			if (this.#clockSkewWarned.has(ctx.serverUuid)) {
+				return
+			}
+			this.#clockSkewWarned.add(ctx.serverUuid)
+
+			const seconds = Math.round(Math.abs(skewMs) / 1000)
+			alert(
+				`Your computer's clock is ${seconds} seconds ${skewMs > 0 ? 'behind' : 'ahead of'} the clock of ${server.name()}.\n\nConnections to other users may fail until you correct it.`,
+			)
+		})
+		this.event.addEventListener(Event_Type.DOWNLOADS_RECOVERED, (event) => {
+			const downloads = event.downloadsRecovered!.downloads
+			console.info('recovered interrupted downloads:', downloads)
+
+			this.transfer
+				.refreshItems()
+				.catch((err) =>
+					console.error('failed to refresh transfers:', err),
+				)
+
+			alert(
+				`${downloads.length} download(s) were interrupted when FriendNet last stopped, and were queued again.`,
+			)
+		})
 
 		// Periodically refresh state.
 		setInterval(() => {
@@ -798,6 +1048,7 @@ export class State {
 		const res = await this.#client.getUpdateInfo({})
 		this.#setCurrentUpdate(res.currentInfo!)
 		this.#setLatestUpdate(res.newInfo)
+		this.#setUpdateStaged(res.updateStaged)
 	}
 
 	/**
@@ -809,6 +1060,15 @@ export class State {
 		this.#setLatestUpdate(res.newInfo)
 	}
 
+	/**
+	 * Downloads the new update so that it is installed when the client restarts.
+	 * Only possible if {@link latestUpdate} can be applied.
+	 */
+	async applyUpdate() {
+		await this.#client.applyUpdate({})
+		this.#setUpdateStaged(true)
+	}
+
 	/**
 	 * Sets a file to be previewed.
 	 * @param serverUuid The UUID of the server the file is exposed through.
@@ -854,7 +1114,13 @@ export class State {
 	 * Any existing servers whose information was updated will be updated in-place.
 	 */
 	async refreshServers(): Promise<void> {
-		const { servers } = await this.#client.getServers({})
+		const servers: ServerInfo[] = []
+		let cursor = ''
+		do {
+			const res = await this.#client.getServers({ cursor })
+			servers.push(...res.servers)
+			cursor = res.nextCursor
+		} while (cursor)
 
 		const curServers = this.servers()
 		const newServers: Server[] = []
@@ -893,6 +1159,24 @@ export class State {
 		return res.server!.uuid
 	}
 
+	/**
+	 * Creates a new server from an invite bundle URL and adds it to the list.
+	 * @param req The import invite bundle request.
+	 * @returns The newly created server's UUID.
+	 */
+	async importInviteBundle(
+		req: Omit<ImportInviteBundleRequest, '$typeName'>,
+	): Promise<string> {
+		const res = await this.#client.importInviteBundle(req)
+
+		this.#setServers([
+			...this.servers(),
+			new Server(this.#client, this.refresher, res.server!),
+		])
+
+		return res.server!.uuid
+	}
+
 	/**
 	 * Deletes the server with the specified UUID from the list.
 	 * @param uuid The UUID of the server to delete.
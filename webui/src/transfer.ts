@@ -5,9 +5,14 @@ import {
 	DownloadManagerItem_Type,
 	DownloadStatus,
 	DownloadStatusUpdate,
+	DuplicateAction,
 	Event_Type,
+	ScanStatus,
+	UploadInfo,
+	UploadStatus,
 } from '../pb/clientrpc/v1/rpc_pb'
 import { RpcClient } from './protobuf'
+import { Code, ConnectError } from '@connectrpc/connect'
 
 export class Download {
 	readonly uuid: string
@@ -23,6 +28,8 @@ export class Download {
 	readonly #setFileSizeBytes: Setter<number | -1>
 	readonly errorMessage: Accessor<string | undefined>
 	readonly #setErrorMessage: Setter<string | undefined>
+	readonly scanStatus: Accessor<ScanStatus>
+	readonly #setScanStatus: Setter<ScanStatus>
 	readonly lastSpeedBytesPerSecond: Accessor<number>
 	readonly #setLastSpeedBytesPerSecond: Setter<number>
 
@@ -45,6 +52,9 @@ export class Download {
 		;[this.downloadedBytes, this.#setDownloadedBytes] = createSignal(0)
 		;[this.fileSizeBytes, this.#setFileSizeBytes] = createSignal(0)
 		;[this.errorMessage, this.#setErrorMessage] = createSignal()
+		;[this.scanStatus, this.#setScanStatus] = createSignal<ScanStatus>(
+			ScanStatus.UNSPECIFIED,
+		)
 		;[this.lastSpeedBytesPerSecond, this.#setLastSpeedBytesPerSecond] =
 			createSignal(0)
 
@@ -56,6 +66,7 @@ export class Download {
 		this.#setDownloadedBytes(Number(update.downloaded))
 		this.#setFileSizeBytes(Number(update.fileSize))
 		this.#setErrorMessage(update.errorMessage)
+		this.#setScanStatus(update.scanStatus)
 		this.#setLastSpeedBytesPerSecond(Number(update.speed))
 	}
 	updateFromItem(item: DownloadManagerItem) {
@@ -71,6 +82,7 @@ export class Download {
 		this.#setDownloadedBytes(Number(dl.downloaded))
 		this.#setFileSizeBytes(Number(dl.fileSize))
 		this.#setErrorMessage(dl.errorMessage)
+		this.#setScanStatus(dl.scanStatus)
 		this.#setLastSpeedBytesPerSecond(0)
 	}
 }
@@ -85,10 +97,28 @@ export class TransferManager {
 	readonly downloads: Accessor<Download[]>
 	readonly #setDownloads: Setter<Download[]>
 
+	/**
+	 * Uploads to peers that are in progress, oldest first.
+	 */
+	readonly activeUploads: Accessor<UploadInfo[]>
+	readonly #setActiveUploads: Setter<UploadInfo[]>
+
+	/**
+	 * Finished uploads to peers, newest first.
+	 */
+	readonly uploadHistory: Accessor<UploadInfo[]>
+	readonly #setUploadHistory: Setter<UploadInfo[]>
+
 	constructor(state: State, client: RpcClient) {
 		this.#state = state
 		this.#client = client
 		;[this.downloads, this.#setDownloads] = createSignal<Download[]>([])
+		;[this.activeUploads, this.#setActiveUploads] = createSignal<
+			UploadInfo[]
+		>([])
+		;[this.uploadHistory, this.#setUploadHistory] = createSignal<
+			UploadInfo[]
+		>([])
 
 		// Listen for event bus download manager updates.
 		this.#state.event.addEventListener(
@@ -122,6 +152,56 @@ export class TransferManager {
 				)
 			},
 		)
+
+		// Listen for uploads to peers.
+		this.#state.event.addEventListener(
+			Event_Type.UPLOAD_UPDATE,
+			(event, ctx) => {
+				const upload = {
+					...event.uploadUpdate!.upload!,
+					serverUuid: ctx.serverUuid,
+				}
+				this.#onUploadUpdate(upload)
+			},
+		)
+	}
+
+	#onUploadUpdate(upload: UploadInfo) {
+		// Events can arrive out of order, so ignore progress for uploads that already finished.
+		if (this.uploadHistory().some((x) => x.uuid === upload.uuid)) {
+			return
+		}
+
+		const active = this.activeUploads()
+		if (upload.status === UploadStatus.IN_PROGRESS) {
+			const i = active.findIndex((x) => x.uuid === upload.uuid)
+			if (i === -1) {
+				this.#setActiveUploads([...active, upload])
+			} else if (active[i].bytesSent <= upload.bytesSent) {
+				this.#setActiveUploads(active.with(i, upload))
+			}
+			return
+		}
+
+		this.#setActiveUploads(active.filter((x) => x.uuid !== upload.uuid))
+		this.#setUploadHistory([upload, ...this.uploadHistory()])
+	}
+
+	/**
+	 * Refreshes uploads that are in progress and the upload history.
+	 */
+	async refreshUploads(): Promise<void> {
+		const { active, history } = await this.#client.getUploads({})
+		this.#setActiveUploads(active)
+		this.#setUploadHistory(history)
+	}
+
+	/**
+	 * Deletes the history of finished uploads.
+	 */
+	async clearUploadHistory(): Promise<void> {
+		await this.#client.clearUploadHistory({})
+		this.#setUploadHistory([])
 	}
 
 	/**
@@ -152,6 +232,8 @@ export class TransferManager {
 
 	/**
 	 * Queues a file download.
+	 * If the file was already downloaded before, the user is asked whether to hard link or copy the existing file
+	 * instead of downloading it again.
 	 * @param serverUuid The UUID of the server the peer is on.
 	 * @param peerUsername The peer's username.
 	 * @param filePath The file path within the peer.
@@ -161,10 +243,51 @@ export class TransferManager {
 		peerUsername: string,
 		filePath: string,
 	): Promise<void> {
-		await this.#client.queueFileDownload({
+		const res = await this.#client.queueFileDownload({
 			serverUuid,
 			peerUsername,
 			filePath,
 		})
+		if (!res.duplicate) {
+			return
+		}
+
+		const queueWith = (duplicateAction: DuplicateAction) =>
+			this.#client.queueFileDownload({
+				serverUuid,
+				peerUsername,
+				filePath,
+				duplicateAction,
+			})
+
+		const existing = res.duplicate.localPath
+		if (
+			confirm(
+				`You already downloaded this file to:\n${existing}\n\nPress OK to hard link the existing file instead of downloading it again, or Cancel for other options.`,
+			)
+		) {
+			try {
+				await queueWith(DuplicateAction.HARD_LINK)
+				return
+			} catch (err) {
+				if (
+					!(err instanceof ConnectError) ||
+					err.code !== Code.FailedPrecondition
+				) {
+					throw err
+				}
+				console.warn('failed to hard link existing file:', err)
+			}
+		}
+
+		if (
+			confirm(
+				'Press OK to copy the existing file, or Cancel to download it again.',
+			)
+		) {
+			await queueWith(DuplicateAction.COPY)
+		} else {
+			await queueWith(DuplicateAction.DOWNLOAD)
+		}
 	}
 }
@@ -1,8 +1,60 @@
-import { defineConfig } from 'vite'
+import { defineConfig, Plugin } from 'vite'
 import solidPlugin from 'vite-plugin-solid'
+import { readdirSync, readFileSync, writeFileSync } from 'node:fs'
+import { join, resolve } from 'node:path'
+import { brotliCompressSync, constants, gzipSync } from 'node:zlib'
+
+/**
+ * File extensions that are worth compressing.
+ */
+const compressibleExts = ['.html', '.js', '.css', '.svg', '.json', '.txt']
+
+/**
+ * Writes Brotli and gzip compressed copies of compressible build output next to the original files, so that the
+ * client can serve them without compressing anything at runtime.
+ */
+function precompress(): Plugin {
+	let outDir = ''
+
+	return {
+		name: 'precompress',
+		apply: 'build',
+		configResolved(config) {
+			outDir = resolve(config.root, config.build.outDir)
+		},
+		closeBundle() {
+			const files = readdirSync(outDir, {
+				recursive: true,
+				encoding: 'utf8',
+			})
+			for (const file of files) {
+				if (!compressibleExts.some((ext) => file.endsWith(ext))) {
+					continue
+				}
+
+				const path = join(outDir, file)
+				const data = readFileSync(path)
+				if (data.length < 1024) {
+					continue
+				}
+
+				writeFileSync(
+					path + '.br',
+					brotliCompressSync(data, {
+						params: {
+							[constants.BROTLI_PARAM_QUALITY]:
+								constants.BROTLI_MAX_QUALITY,
+						},
+					}),
+				)
+				writeFileSync(path + '.gz', gzipSync(data, { level: 9 }))
+			}
+		},
+	}
+}
 
 export default defineConfig({
-	plugins: [solidPlugin()],
+	plugins: [solidPlugin(), precompress()],
 	server: {
 		port: 5173,
 	},
@@ -5,8 +5,14 @@ package webui
 
 import (
 	"embed"
+	"io"
 	"io/fs"
+	"mime"
 	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"path"
+	"strconv"
 	"strings"
 )
 
@@ -19,26 +25,123 @@ var Dist = func() fs.FS {
 	return dist
 }()
 
+const csp = "default-src 'self'; script-src 'self'; style-src 'self' 'unsafe-inline'; img-src 'self' data: http: https:; font-src 'self' data:; connect-src 'self' http: https:; media-src 'self' data: http: https:; frame-src 'self' http: https:"
+
+// hashedAssetsDir is the directory in Dist where Vite puts assets with content hashes in their names.
+// Since their names change whenever their content does, they can be cached forever.
+const hashedAssetsDir = "assets/"
+
+// precompressed are the encodings that the build writes precompressed copies of files for, in order of preference.
+// A file's precompressed copy has the encoding's extension appended to its name.
+var precompressed = []struct {
+	encoding string
+	ext      string
+}{
+	{encoding: "br", ext: ".br"},
+	{encoding: "gzip", ext: ".gz"},
+}
+
 // Handler is an http.Handler that serves the web UI with the proper security headers.
+//
+// By default, it serves the embedded files in Dist. Hashed assets are cached by browsers indefinitely, and precompressed
+// copies of files are served to browsers that accept them.
+//
+// If DevServer is set, requests are instead proxied to a frontend dev server, such as the one started with
+// "npm run dev", so that changes to the web UI show up without rebuilding the client.
 type Handler struct {
+	// The URL of the frontend dev server to proxy to, or nil to serve the embedded files.
+	DevServer *url.URL
 }
 
 func (h Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
-	w.Header().Set("Content-Security-Policy", "default-src 'self'; script-src 'self'; style-src 'self' 'unsafe-inline'; img-src 'self' data: http: https:; font-src 'self' data:; connect-src 'self' http: https:; media-src 'self' data: http: https:; frame-src 'self' http: https:")
+	if h.DevServer != nil {
+		// The dev server injects scripts and opens a WebSocket for hot reloading, which the CSP would block.
+		proxy := &httputil.ReverseProxy{
+			Rewrite: func(pr *httputil.ProxyRequest) {
+				pr.SetURL(h.DevServer)
+			},
+		}
+		proxy.ServeHTTP(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Security-Policy", csp)
+
+	name := strings.TrimPrefix(path.Clean("/"+r.URL.Path), "/")
+	if name == "" || !isFile(name) {
+		// File doesn't exist, serve index.html
+		name = "index.html"
+	}
+
+	if strings.HasPrefix(name, hashedAssetsDir) {
+		w.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	} else {
+		w.Header().Set("Cache-Control", "no-cache")
+	}
+
+	serveFile(w, r, name)
+}
 
-	fileServer := http.FileServer(http.FS(Dist))
+// isFile returns whether name is a regular file in Dist.
+func isFile(name string) bool {
+	stat, err := fs.Stat(Dist, name)
+	return err == nil && stat.Mode().IsRegular()
+}
+
+// serveFile serves the file in Dist with the specified name, using a precompressed copy if the client accepts one.
+func serveFile(w http.ResponseWriter, r *http.Request, name string) {
+	if ctype := mime.TypeByExtension(path.Ext(name)); ctype != "" {
+		w.Header().Set("Content-Type", ctype)
+	}
+
+	toOpen := name
+	acceptEncoding := r.Header.Get("Accept-Encoding")
+	for _, pc := range precompressed {
+		if !acceptsEncoding(acceptEncoding, pc.encoding) || !isFile(name+pc.ext) {
+			continue
+		}
 
-	// Try to serve the requested file
-	f, err := Dist.Open(strings.TrimPrefix(r.URL.Path, "/"))
-	if err == nil {
+		w.Header().Set("Content-Encoding", pc.encoding)
+		toOpen = name + pc.ext
+		break
+	}
+	w.Header().Add("Vary", "Accept-Encoding")
+
+	f, err := Dist.Open(toOpen)
+	if err != nil {
+		http.Error(w, "not found", http.StatusNotFound)
+		return
+	}
+	defer func() {
 		_ = f.Close()
-		fileServer.ServeHTTP(w, r)
+	}()
+
+	stat, err := f.Stat()
+	if err != nil {
+		http.Error(w, "internal error", http.StatusInternalServerError)
 		return
 	}
 
-	// File doesn't exist, serve index.html
-	r.URL.Path = "/"
-	fileServer.ServeHTTP(w, r)
+	// Files in embed.FS always implement io.ReadSeeker.
+	http.ServeContent(w, r, name, stat.ModTime(), f.(io.ReadSeeker))
+}
+
+// acceptsEncoding returns whether an Accept-Encoding header value accepts the specified content encoding.
+func acceptsEncoding(header string, encoding string) bool {
+	for part := range strings.SplitSeq(header, ",") {
+		enc, params, _ := strings.Cut(part, ";")
+		if !strings.EqualFold(strings.TrimSpace(enc), encoding) {
+			continue
+		}
+
+		q, hasQ := strings.CutPrefix(strings.TrimSpace(params), "q=")
+		if !hasQ {
+			return true
+		}
+		weight, err := strconv.ParseFloat(q, 64)
+		return err == nil && weight > 0
+	}
+	return false
 }
 
 var _ http.Handler = (*Handler)(nil)